@@ -0,0 +1,190 @@
+// Package config loads settings into structs from environment variables and an optional config file.
+//
+// Fields are described with struct tags:
+//
+//	env:"NAME"              the environment variable, and config file key, the field is read from
+//	default:"value"         used when neither sets the field; without it the field keeps its current value
+//	required:"true"         the field must be set
+//	requiredWith:"OTHER"    the field must be set whenever OTHER is
+//
+// Environment variables take precedence over the config file. Nested structs without an env tag are
+// walked recursively. Supported field types are strings, bools, integers, time.Duration and []string,
+// the last written as space-separated values.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Problem is one misconfigured setting.
+type Problem struct {
+	Key     string
+	Message string
+}
+
+// Error lists everything wrong with a configuration, so it can all be fixed in one go.
+type Error struct {
+	Problems []Problem
+}
+
+func (e *Error) Error() string {
+	lines := make([]string, 0, len(e.Problems))
+	for _, p := range e.Problems {
+		lines = append(lines, fmt.Sprintf("%s: %s", p.Key, p.Message))
+	}
+	return fmt.Sprintf("invalid configuration:\n  %s", strings.Join(lines, "\n  "))
+}
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// Load fills the tagged fields of dst, a pointer to a struct, from the environment and, when path is
+// not empty, from the YAML file at path, a flat map of the same keys as the environment variables.
+// Every problem found is returned together as an *Error.
+func Load(dst any, path string) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config: dst must be a pointer to a struct, got %T", dst)
+	}
+
+	file := map[string]string{}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("config: %w", err)
+		}
+		var raw map[string]any
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return fmt.Errorf("config: parse %s: %w", path, err)
+		}
+		for key, value := range raw {
+			file[key] = fmt.Sprint(value)
+		}
+	}
+
+	l := &loader{file: file, set: map[string]bool{}, known: map[string]bool{}}
+	l.walk(v.Elem())
+	l.checkRequiredWith()
+
+	for key := range file {
+		if !l.known[key] {
+			l.problem(key, "unknown key in "+path)
+		}
+	}
+
+	if len(l.problems) > 0 {
+		sort.SliceStable(l.problems, func(i, j int) bool { return l.problems[i].Key < l.problems[j].Key })
+		return &Error{Problems: l.problems}
+	}
+	return nil
+}
+
+type loader struct {
+	file     map[string]string
+	set      map[string]bool // keys given a value by the environment or file
+	known    map[string]bool
+	pending  []requiredWith
+	problems []Problem
+}
+
+type requiredWith struct {
+	key, other string
+}
+
+func (l *loader) problem(key, message string) {
+	l.problems = append(l.problems, Problem{Key: key, Message: message})
+}
+
+func (l *loader) walk(v reflect.Value) {
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		value := v.Field(i)
+
+		key := field.Tag.Get("env")
+		if key == "" {
+			if field.Type.Kind() == reflect.Struct {
+				l.walk(value)
+			}
+			continue
+		}
+		l.known[key] = true
+
+		raw, ok := os.LookupEnv(key)
+		if !ok || raw == "" {
+			raw, ok = l.file[key]
+		}
+		if ok && raw != "" {
+			l.set[key] = true
+		} else if def, hasDefault := field.Tag.Lookup("default"); hasDefault {
+			raw, ok = def, true
+		}
+
+		if !ok || raw == "" {
+			if field.Tag.Get("required") == "true" {
+				l.problem(key, "required but not set")
+			}
+		} else if err := setField(value, raw); err != nil {
+			l.problem(key, err.Error())
+		}
+
+		if other := field.Tag.Get("requiredWith"); other != "" {
+			l.pending = append(l.pending, requiredWith{key: key, other: other})
+		}
+	}
+}
+
+func (l *loader) checkRequiredWith() {
+	for _, r := range l.pending {
+		if l.set[r.other] && !l.set[r.key] {
+			l.problem(r.key, fmt.Sprintf("required when %s is set", r.other))
+		}
+	}
+}
+
+func setField(value reflect.Value, raw string) error {
+	if value.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", raw)
+		}
+		value.SetInt(int64(d))
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", raw)
+		}
+		value.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, value.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", raw)
+		}
+		value.SetInt(n)
+	case reflect.Slice:
+		if value.Type().Elem().Kind() != reflect.String {
+			return errors.New("unsupported field type " + value.Type().String())
+		}
+		value.Set(reflect.ValueOf(strings.Fields(raw)))
+	default:
+		return errors.New("unsupported field type " + value.Type().String())
+	}
+	return nil
+}
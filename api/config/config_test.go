@@ -0,0 +1,144 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+type testPool struct {
+	MaxConns int32 `env:"CONFIGTEST_POOL_MAX_CONNS" default:"10"`
+}
+
+type testConfig struct {
+	URL      string        `env:"CONFIGTEST_URL" required:"true"`
+	Port     int           `env:"CONFIGTEST_PORT" default:"8080"`
+	Debug    bool          `env:"CONFIGTEST_DEBUG"`
+	Timeout  time.Duration `env:"CONFIGTEST_TIMEOUT" default:"30s"`
+	Admins   []string      `env:"CONFIGTEST_ADMINS"`
+	Token    string        `env:"CONFIGTEST_TOKEN"`
+	Account  string        `env:"CONFIGTEST_ACCOUNT" requiredWith:"CONFIGTEST_TOKEN"`
+	Interval time.Duration `env:"CONFIGTEST_INTERVAL"` // preset by the caller
+	Pool     testPool
+	internal string `env:"CONFIGTEST_URL"` // unexported, so never loaded
+}
+
+func TestLoad(t *testing.T) {
+	base := testConfig{URL: "postgres://db", Port: 8080, Timeout: 30 * time.Second, Interval: time.Minute, Pool: testPool{MaxConns: 10}}
+
+	tests := []struct {
+		name         string
+		env          map[string]string
+		file         string
+		want         func(c *testConfig)
+		wantProblems []string // keys, in order
+	}{
+		{"required only", map[string]string{"CONFIGTEST_URL": "postgres://db"}, "", func(c *testConfig) {}, nil},
+		{
+			"every type",
+			map[string]string{
+				"CONFIGTEST_URL":            "postgres://db",
+				"CONFIGTEST_PORT":           "9000",
+				"CONFIGTEST_DEBUG":          "true",
+				"CONFIGTEST_TIMEOUT":        "1m30s",
+				"CONFIGTEST_ADMINS":         " a@loco.dev  b@loco.dev ",
+				"CONFIGTEST_INTERVAL":       "5s",
+				"CONFIGTEST_POOL_MAX_CONNS": "25",
+			},
+			"",
+			func(c *testConfig) {
+				c.Port, c.Debug, c.Timeout = 9000, true, 90*time.Second
+				c.Admins = []string{"a@loco.dev", "b@loco.dev"}
+				c.Interval = 5 * time.Second
+				c.Pool.MaxConns = 25
+			},
+			nil,
+		},
+		{"empty is unset", map[string]string{"CONFIGTEST_URL": "postgres://db", "CONFIGTEST_PORT": ""}, "", func(c *testConfig) {}, nil},
+		{
+			"requiredWith met",
+			map[string]string{"CONFIGTEST_URL": "postgres://db", "CONFIGTEST_TOKEN": "t", "CONFIGTEST_ACCOUNT": "a"},
+			"",
+			func(c *testConfig) { c.Token, c.Account = "t", "a" },
+			nil,
+		},
+		{
+			"file, with the environment first",
+			map[string]string{"CONFIGTEST_PORT": "9000"},
+			"CONFIGTEST_URL: postgres://file\nCONFIGTEST_PORT: 7000\nCONFIGTEST_DEBUG: true\n",
+			func(c *testConfig) { c.URL, c.Port, c.Debug = "postgres://file", 9000, true },
+			nil,
+		},
+		{"required missing", map[string]string{}, "", nil, []string{"CONFIGTEST_URL"}},
+		{"requiredWith missing", map[string]string{"CONFIGTEST_URL": "postgres://db", "CONFIGTEST_TOKEN": "t"}, "", nil, []string{"CONFIGTEST_ACCOUNT"}},
+		{
+			"every problem at once",
+			map[string]string{
+				"CONFIGTEST_PORT":           "eighty",
+				"CONFIGTEST_DEBUG":          "yes please",
+				"CONFIGTEST_TIMEOUT":        "30",
+				"CONFIGTEST_POOL_MAX_CONNS": "99999999999",
+			},
+			"",
+			nil,
+			[]string{"CONFIGTEST_DEBUG", "CONFIGTEST_POOL_MAX_CONNS", "CONFIGTEST_PORT", "CONFIGTEST_TIMEOUT", "CONFIGTEST_URL"},
+		},
+		{"unknown file key", map[string]string{"CONFIGTEST_URL": "postgres://db"}, "CONFIGTEST_PROT: 9000\n", nil, []string{"CONFIGTEST_PROT"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"URL", "PORT", "DEBUG", "TIMEOUT", "ADMINS", "TOKEN", "ACCOUNT", "INTERVAL", "POOL_MAX_CONNS"} {
+				t.Setenv("CONFIGTEST_"+key, tt.env["CONFIGTEST_"+key])
+			}
+			var path string
+			if tt.file != "" {
+				path = filepath.Join(t.TempDir(), "config.yaml")
+				if err := os.WriteFile(path, []byte(tt.file), 0o600); err != nil {
+					t.Fatalf("unexpected error writing config file: %v", err)
+				}
+			}
+
+			got := testConfig{Interval: time.Minute}
+			err := Load(&got, path)
+
+			var cfgErr *Error
+			if tt.wantProblems != nil {
+				if !errors.As(err, &cfgErr) {
+					t.Fatalf("expected an *Error, got %v", err)
+				}
+				var keys []string
+				for _, p := range cfgErr.Problems {
+					keys = append(keys, p.Key)
+				}
+				if !slices.Equal(keys, tt.wantProblems) {
+					t.Errorf("expected problems with %v, got %v", tt.wantProblems, cfgErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want := base
+			tt.want(&want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %+v, got %+v", want, got)
+			}
+		})
+	}
+}
+
+func TestLoadInvalidDestination(t *testing.T) {
+	var c testConfig
+	for _, dst := range []any{c, &c.URL, nil} {
+		if err := Load(dst, ""); err == nil {
+			t.Errorf("%T: expected an error", dst)
+		}
+	}
+	if err := Load(&c, filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Errorf("expected an error for a missing config file")
+	}
+}
@@ -0,0 +1,55 @@
+package contextkeys
+
+import (
+	"context"
+
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// Auth is who a request acts as. It is set once, by the auth interceptor after checking the
+// caller's token or by jobs acting on someone's behalf, and read through the accessors below
+// rather than with ctx.Value, so a mistyped key or type assertion can't silently drop it.
+type Auth struct {
+	Entity genDb.Entity
+	Scopes []genDb.EntityScope
+	// Token is the loco token the caller presented; empty for jobs acting without one.
+	Token string
+	// ImpersonatorID is the platform admin acting as Entity through an impersonation token, or 0.
+	ImpersonatorID int64
+}
+
+// WithAuth returns a copy of ctx acting as auth.
+func WithAuth(ctx context.Context, auth Auth) context.Context {
+	return context.WithValue(ctx, AuthKey, auth)
+}
+
+// AuthFrom returns who ctx acts as, and false for unauthenticated requests.
+func AuthFrom(ctx context.Context) (Auth, bool) {
+	auth, ok := ctx.Value(AuthKey).(Auth)
+	return auth, ok
+}
+
+// Entity returns the user or service account ctx acts as.
+func Entity(ctx context.Context) (genDb.Entity, bool) {
+	auth, ok := AuthFrom(ctx)
+	return auth.Entity, ok
+}
+
+// Scopes returns the scopes of the entity ctx acts as.
+func Scopes(ctx context.Context) ([]genDb.EntityScope, bool) {
+	auth, ok := AuthFrom(ctx)
+	return auth.Scopes, ok
+}
+
+// Token returns the token ctx's caller presented, and false when there is none.
+func Token(ctx context.Context) (string, bool) {
+	auth, _ := AuthFrom(ctx)
+	return auth.Token, auth.Token != ""
+}
+
+// Impersonator returns the platform admin behind an impersonation token, and false for requests
+// not made through one.
+func Impersonator(ctx context.Context) (int64, bool) {
+	auth, _ := AuthFrom(ctx)
+	return auth.ImpersonatorID, auth.ImpersonatorID != 0
+}
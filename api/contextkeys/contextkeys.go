@@ -3,12 +3,11 @@ package contextkeys
 type ContextKey string
 
 const (
-	EntityKey           ContextKey = "entity"
-	EntityScopesKey     ContextKey = "entityScopes"
+	AuthKey             ContextKey = "auth" // Auth; read it through AuthFrom and the accessors next to it
 	ExternalUsernameKey ContextKey = "externalUsername"
 	RequestIDKey        ContextKey = "requestId"
 	MethodKey           ContextKey = "method"
 	PathKey             ContextKey = "path"
 	SourceIPKey         ContextKey = "sourceIp"
-	TokenKey            ContextKey = "token"
+	ReadYourWritesKey   ContextKey = "readYourWrites"
 )
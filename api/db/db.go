@@ -7,31 +7,96 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	genDb "github.com/team-loco/loco/api/gen/db"
 )
 
-// DB wraps the connection pool
+// DB wraps the connection pools of the primary and, optionally, a read replica
 type DB struct {
-	pool *pgxpool.Pool
+	pool    *pgxpool.Pool
+	replica *pgxpool.Pool
 }
 
-// NewDB creates a new database connection pool
-func NewDB(ctx context.Context, databaseURL string) (*DB, error) {
+// PoolConfig sizes the connection pools. Zero fields fall back to DefaultPoolConfig.
+type PoolConfig struct {
+	MaxConns          int32         `env:"DB_MAX_CONNS"`
+	MinConns          int32         `env:"DB_MIN_CONNS"`
+	MaxConnLifetime   time.Duration `env:"DB_MAX_CONN_LIFETIME"`
+	MaxConnIdleTime   time.Duration `env:"DB_MAX_CONN_IDLE_TIME"`
+	HealthCheckPeriod time.Duration `env:"DB_HEALTH_CHECK_PERIOD"` // how often idle connections are checked
+}
+
+// DefaultPoolConfig is used for any PoolConfig field left zero.
+var DefaultPoolConfig = PoolConfig{
+	MaxConns:          25,
+	MinConns:          5,
+	MaxConnLifetime:   5 * time.Minute,
+	MaxConnIdleTime:   2 * time.Minute,
+	HealthCheckPeriod: time.Minute,
+}
+
+func (c PoolConfig) withDefaults() PoolConfig {
+	if c.MaxConns <= 0 {
+		c.MaxConns = DefaultPoolConfig.MaxConns
+	}
+	if c.MinConns <= 0 {
+		c.MinConns = min(DefaultPoolConfig.MinConns, c.MaxConns)
+	}
+	if c.MaxConnLifetime <= 0 {
+		c.MaxConnLifetime = DefaultPoolConfig.MaxConnLifetime
+	}
+	if c.MaxConnIdleTime <= 0 {
+		c.MaxConnIdleTime = DefaultPoolConfig.MaxConnIdleTime
+	}
+	if c.HealthCheckPeriod <= 0 {
+		c.HealthCheckPeriod = DefaultPoolConfig.HealthCheckPeriod
+	}
+	return c
+}
+
+// NewDB creates a new database connection pool. When replicaURL is set, a second pool is opened
+// to the replica and Conn routes read-only queries to it. Both pools are sized by poolCfg.
+func NewDB(ctx context.Context, databaseURL string, replicaURL string, poolCfg PoolConfig) (*DB, error) {
 	if databaseURL == "" {
 		return nil, fmt.Errorf("DATABASE_URL not set")
 	}
+	if poolCfg.MinConns > 0 && poolCfg.MaxConns > 0 && poolCfg.MinConns > poolCfg.MaxConns {
+		return nil, fmt.Errorf("min conns (%d) exceeds max conns (%d)", poolCfg.MinConns, poolCfg.MaxConns)
+	}
+	poolCfg = poolCfg.withDefaults()
+
+	pool, err := newPool(ctx, databaseURL, poolCfg)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("Database connection established")
+
+	d := &DB{pool: pool}
+	if replicaURL != "" {
+		replica, err := newPool(ctx, replicaURL, poolCfg)
+		if err != nil {
+			pool.Close()
+			return nil, fmt.Errorf("replica: %w", err)
+		}
+		slog.Info("Read replica connection established")
+		d.replica = replica
+	}
+
+	return d, nil
+}
 
+func newPool(ctx context.Context, databaseURL string, poolCfg PoolConfig) (*pgxpool.Pool, error) {
 	cfg, err := pgxpool.ParseConfig(databaseURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse database config: %w", err)
 	}
 
-	// todo: are these the best settings
 	// todo: ensure we use pool all the time
 	// todo: ensure we use pg tx where necessary.
-	cfg.MaxConns = 25
-	cfg.MinConns = 5
-	cfg.MaxConnLifetime = 5 * time.Minute
-	cfg.MaxConnIdleTime = 2 * time.Minute
+	cfg.MaxConns = poolCfg.MaxConns
+	cfg.MinConns = poolCfg.MinConns
+	cfg.MaxConnLifetime = poolCfg.MaxConnLifetime
+	cfg.MaxConnIdleTime = poolCfg.MaxConnIdleTime
+	cfg.HealthCheckPeriod = poolCfg.HealthCheckPeriod
 	cfg.ConnConfig.ConnectTimeout = 5 * time.Second
 
 	pool, err := pgxpool.NewWithConfig(ctx, cfg)
@@ -41,39 +106,46 @@ func NewDB(ctx context.Context, databaseURL string) (*DB, error) {
 
 	// Verify connection
 	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	slog.Info("Database connection established")
-
-	return &DB{pool: pool}, nil
+	return pool, nil
 }
 
-// Close closes the connection pool
+// Close closes the connection pools
 func (d *DB) Close() {
 	if d.pool != nil {
 		d.pool.Close()
 	}
+	if d.replica != nil {
+		d.replica.Close()
+	}
 }
 
-// Pool returns the underlying pgxpool.Pool
+// Ping checks that the primary and, if configured, the replica accept queries
+func (d *DB) Ping(ctx context.Context) error {
+	if err := d.pool.Ping(ctx); err != nil {
+		return fmt.Errorf("primary: %w", err)
+	}
+	if d.replica != nil {
+		if err := d.replica.Ping(ctx); err != nil {
+			return fmt.Errorf("replica: %w", err)
+		}
+	}
+	return nil
+}
+
+// Pool returns the underlying pgxpool.Pool of the primary
 func (d *DB) Pool() *pgxpool.Pool {
 	return d.pool
 }
 
-// RunMigrations runs SQL migrations from a file or string
-func (d *DB) RunMigrations(ctx context.Context, migrationSQL string) error {
-	conn, err := d.pool.Acquire(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to acquire connection: %w", err)
-	}
-	defer conn.Release()
-
-	_, err = conn.Exec(ctx, migrationSQL)
-	if err != nil {
-		return fmt.Errorf("failed to run migrations: %w", err)
+// Conn returns what generated queries should run on: the primary pool, or a Router
+// sending read-only queries to the replica when one is configured.
+func (d *DB) Conn() genDb.DBTX {
+	if d.replica == nil {
+		return d.pool
 	}
-
-	slog.Info("Migrations completed successfully")
-	return nil
+	return &Router{primary: d.pool, replica: d.replica}
 }
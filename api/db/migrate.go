@@ -0,0 +1,244 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// migrationLockID is the advisory lock held while migrating, so replicas starting together take turns.
+const migrationLockID = 7_264_021_850
+
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_(.+)\.sql$`)
+
+// ErrDownMigration is returned for a NNN_name.down.sql file. Migrations only go forward: a change is
+// undone by a new migration, which is reviewed and applied like any other.
+var ErrDownMigration = errors.New("down migrations are not supported; undo a migration with a new one")
+
+// Migration is one numbered schema change.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+}
+
+// MigrationStatus is a migration together with when it was applied.
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time // nil when pending
+}
+
+// LoadMigrations reads NNN_name.sql files from fsys.
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		if strings.HasSuffix(match[2], ".down") {
+			return nil, fmt.Errorf("%w: %s", ErrDownMigration, entry.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", entry.Name(), err)
+		}
+
+		if m, ok := byVersion[version]; ok {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, m.Name, match[2])
+		}
+		byVersion[version] = &Migration{Version: version, Name: match[2], Up: string(data)}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Migrator applies migrations, recording which are applied in schema_migrations.
+type Migrator struct {
+	migrations []Migration
+	// locked runs fn on a connection holding the migration lock
+	locked func(ctx context.Context, fn func(conn migrationConn) error) error
+}
+
+// migrationConn is the connection a Migrator works on while it holds the migration lock
+type migrationConn interface {
+	// appliedVersions returns when each applied migration was applied
+	appliedVersions(ctx context.Context) (map[int]time.Time, error)
+	// run applies a migration and records it as applied in one transaction
+	run(ctx context.Context, migration Migration) error
+	// record marks a migration as applied without running it
+	record(ctx context.Context, migration Migration) error
+}
+
+// NewMigrator creates a Migrator for the migrations in fsys.
+func NewMigrator(pool *pgxpool.Pool, fsys fs.FS) (*Migrator, error) {
+	migrations, err := LoadMigrations(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{migrations: migrations, locked: poolLocker(pool)}, nil
+}
+
+// Up applies every pending migration in order, each in its own transaction, and returns how many it applied.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := m.locked(ctx, func(conn migrationConn) error {
+		done, err := conn.appliedVersions(ctx)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+			if err := conn.run(ctx, migration); err != nil {
+				return err
+			}
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Baseline records every migration up to and including version as applied without running it,
+// for databases whose schema was created before migrations were tracked.
+func (m *Migrator) Baseline(ctx context.Context, version int) error {
+	return m.locked(ctx, func(conn migrationConn) error {
+		for _, migration := range m.migrations {
+			if migration.Version > version {
+				break
+			}
+			if err := conn.record(ctx, migration); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Status lists every known migration and when it was applied.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var statuses []MigrationStatus
+	err := m.locked(ctx, func(conn migrationConn) error {
+		done, err := conn.appliedVersions(ctx)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			status := MigrationStatus{Migration: migration}
+			if appliedAt, ok := done[migration.Version]; ok {
+				status.AppliedAt = &appliedAt
+			}
+			statuses = append(statuses, status)
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// poolLocker runs fn on a connection from pool holding the migration lock, creating
+// schema_migrations if needed.
+func poolLocker(pool *pgxpool.Pool) func(ctx context.Context, fn func(conn migrationConn) error) error {
+	return func(ctx context.Context, fn func(conn migrationConn) error) error {
+		conn, err := pool.Acquire(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to acquire connection: %w", err)
+		}
+		defer conn.Release()
+
+		if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+			return fmt.Errorf("failed to take migration lock: %w", err)
+		}
+		defer func() {
+			if _, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock($1)`, migrationLockID); err != nil {
+				slog.Error("failed to release migration lock", "error", err)
+			}
+		}()
+
+		if _, err := conn.Exec(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`); err != nil {
+			return fmt.Errorf("failed to create schema_migrations: %w", err)
+		}
+
+		return fn(pgMigrationConn{conn})
+	}
+}
+
+// pgMigrationConn keeps schema_migrations in Postgres
+type pgMigrationConn struct {
+	conn *pgxpool.Conn
+}
+
+func (c pgMigrationConn) run(ctx context.Context, migration Migration) error {
+	tx, err := c.conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, migration.Up); err != nil {
+		return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	if _, err := tx.Exec(ctx, `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, migration.Version, migration.Name); err != nil {
+		return fmt.Errorf("record migration %d: %w", migration.Version, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit migration %d: %w", migration.Version, err)
+	}
+
+	slog.Info("applied migration", "version", migration.Version, "name", migration.Name)
+	return nil
+}
+
+func (c pgMigrationConn) record(ctx context.Context, migration Migration) error {
+	if _, err := c.conn.Exec(ctx,
+		`INSERT INTO schema_migrations (version, name) VALUES ($1, $2) ON CONFLICT (version) DO NOTHING`,
+		migration.Version, migration.Name,
+	); err != nil {
+		return fmt.Errorf("record migration %d: %w", migration.Version, err)
+	}
+	return nil
+}
+
+func (c pgMigrationConn) appliedVersions(ctx context.Context) (map[int]time.Time, error) {
+	rows, err := c.conn.Query(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	done := map[int]time.Time{}
+	var version int
+	var appliedAt time.Time
+	_, err = pgx.ForEachRow(rows, []any{&version, &appliedAt}, func() error {
+		done[version] = appliedAt
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	return done, nil
+}
@@ -0,0 +1,175 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// fakeMigrationConn keeps schema_migrations in memory. A migration whose version is failOn fails
+// to run and, as its transaction is rolled back, stays unrecorded.
+type fakeMigrationConn struct {
+	done   map[int]time.Time
+	failOn int
+	ran    []string
+}
+
+func (c *fakeMigrationConn) appliedVersions(ctx context.Context) (map[int]time.Time, error) {
+	return c.done, nil
+}
+
+func (c *fakeMigrationConn) run(ctx context.Context, migration Migration) error {
+	if migration.Version == c.failOn {
+		return fmt.Errorf("migration %d_%s: syntax error", migration.Version, migration.Name)
+	}
+	c.ran = append(c.ran, migration.Up)
+	c.done[migration.Version] = time.Now()
+	return nil
+}
+
+func (c *fakeMigrationConn) record(ctx context.Context, migration Migration) error {
+	if _, ok := c.done[migration.Version]; !ok {
+		c.done[migration.Version] = time.Now()
+	}
+	return nil
+}
+
+func newTestMigrator(t *testing.T, conn *fakeMigrationConn) *Migrator {
+	t.Helper()
+	migrations, err := LoadMigrations(fstest.MapFS{
+		"001_users.sql":   {Data: []byte("up 1")},
+		"002_orgs.sql":    {Data: []byte("up 2")},
+		"010_tokens.sql":  {Data: []byte("up 10")},
+		"003_indexes.sql": {Data: []byte("up 3")},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error loading migrations: %v", err)
+	}
+	return &Migrator{
+		migrations: migrations,
+		locked: func(ctx context.Context, fn func(conn migrationConn) error) error {
+			return fn(conn)
+		},
+	}
+}
+
+func doneVersions(conn *fakeMigrationConn) []int {
+	var versions []int
+	for version := range conn.done {
+		versions = append(versions, version)
+	}
+	slices.Sort(versions)
+	return versions
+}
+
+func TestLoadMigrations(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		want    []string // version_name
+		wantErr error
+	}{
+		{
+			"ordered by version, not by file name",
+			fstest.MapFS{"10_c.sql": {Data: []byte("c")}, "9_b.sql": {Data: []byte("b")}, "001_a.sql": {Data: []byte("a")}},
+			[]string{"1_a", "9_b", "10_c"},
+			nil,
+		},
+		{
+			"other files are ignored",
+			fstest.MapFS{"1_a.sql": {Data: []byte("a")}, "README.md": {}, "a_1.sql": {}, "2_b.sql/3_c.sql": {}},
+			[]string{"1_a"},
+			nil,
+		},
+		{"down file", fstest.MapFS{"1_a.sql": {Data: []byte("up")}, "1_a.down.sql": {Data: []byte("down")}}, nil, ErrDownMigration},
+		{"down file without an up file", fstest.MapFS{"1_a.sql": {Data: []byte("a")}, "2_b.down.sql": {Data: []byte("b")}}, nil, ErrDownMigration},
+		{"one version with two names", fstest.MapFS{"1_a.sql": {Data: []byte("a")}, "1_b.sql": {Data: []byte("b")}}, nil, errors.New("any")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := LoadMigrations(tt.files)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if errors.Is(tt.wantErr, ErrDownMigration) && !errors.Is(err, ErrDownMigration) {
+				t.Errorf("expected %v, got %v", ErrDownMigration, err)
+			}
+			var got []string
+			for _, m := range migrations {
+				got = append(got, fmt.Sprintf("%d_%s", m.Version, m.Name))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMigratorUp(t *testing.T) {
+	tests := []struct {
+		name        string
+		applied     []int
+		failOn      int
+		wantRan     []string
+		wantApplied int
+		wantErr     bool
+		wantDone    []int
+	}{
+		{"fresh database, in version order", nil, 0, []string{"up 1", "up 2", "up 3", "up 10"}, 4, false, []int{1, 2, 3, 10}},
+		{"applied versions are skipped", []int{1, 2}, 0, []string{"up 3", "up 10"}, 2, false, []int{1, 2, 3, 10}},
+		{"a gap is filled", []int{1, 2, 10}, 0, []string{"up 3"}, 1, false, []int{1, 2, 3, 10}},
+		{"up to date", []int{1, 2, 3, 10}, 0, nil, 0, false, []int{1, 2, 3, 10}},
+		{"a failure stops the later ones", nil, 3, []string{"up 1", "up 2"}, 2, true, []int{1, 2}},
+		{"a failure after applied versions", []int{1, 2}, 3, nil, 0, true, []int{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeMigrationConn{done: map[int]time.Time{}, failOn: tt.failOn}
+			for _, version := range tt.applied {
+				conn.done[version] = time.Now()
+			}
+
+			applied, err := newTestMigrator(t, conn).Up(t.Context())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+			if applied != tt.wantApplied {
+				t.Errorf("expected %d applied, got %d", tt.wantApplied, applied)
+			}
+			if !slices.Equal(conn.ran, tt.wantRan) {
+				t.Errorf("expected %v to run, got %v", tt.wantRan, conn.ran)
+			}
+			if got := doneVersions(conn); !slices.Equal(got, tt.wantDone) {
+				t.Errorf("expected versions %v applied afterwards, got %v", tt.wantDone, got)
+			}
+		})
+	}
+}
+
+func TestMigratorBaseline(t *testing.T) {
+	conn := &fakeMigrationConn{done: map[int]time.Time{}}
+	m := newTestMigrator(t, conn)
+	if err := m.Baseline(t.Context(), 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := doneVersions(conn); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("expected versions up to 3 recorded, got %v", got)
+	}
+	if len(conn.ran) != 0 {
+		t.Errorf("expected baselined migrations not to run, got %v", conn.ran)
+	}
+
+	statuses, err := m.Status(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, status := range statuses {
+		if pending := status.AppliedAt == nil; pending != (status.Version == 10) {
+			t.Errorf("migration %d: expected pending=%v, got %v", status.Version, status.Version == 10, pending)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync/atomic"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/team-loco/loco/api/contextkeys"
+)
+
+// Router runs generated queries on the primary, except Get* and List* queries that are
+// plain SELECTs without a locking clause such as FOR UPDATE, which go to the replica. Once
+// a context marked with WithReadYourWrites has written, through the Router or in a
+// transaction from DB.Begin, its later reads go to the primary as well, so a request that
+// reads back what it just wrote is not caught out by replication lag.
+type Router struct {
+	primary *pgxpool.Pool
+	replica *pgxpool.Pool
+}
+
+// WithReadYourWrites marks ctx, usually a request's, so reads made after its first write are sent to the primary.
+func WithReadYourWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextkeys.ReadYourWritesKey, &atomic.Bool{})
+}
+
+// Begin starts a transaction on the primary. Its writes don't pass through the Router, so Begin
+// marks ctx as written up front: reads after the commit are then sent to the primary too.
+func (d *DB) Begin(ctx context.Context) (pgx.Tx, error) {
+	markWritten(ctx)
+	return d.pool.Begin(ctx)
+}
+
+func (r *Router) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	markWritten(ctx)
+	return r.primary.Exec(ctx, sql, args...)
+}
+
+func (r *Router) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return r.pick(ctx, sql).Query(ctx, sql, args...)
+}
+
+func (r *Router) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return r.pick(ctx, sql).QueryRow(ctx, sql, args...)
+}
+
+func (r *Router) pick(ctx context.Context, sql string) *pgxpool.Pool {
+	if !isReadOnly(sql) {
+		markWritten(ctx)
+		return r.primary
+	}
+	if written, ok := ctx.Value(contextkeys.ReadYourWritesKey).(*atomic.Bool); ok && written.Load() {
+		return r.primary
+	}
+	return r.replica
+}
+
+func markWritten(ctx context.Context) {
+	if written, ok := ctx.Value(contextkeys.ReadYourWritesKey).(*atomic.Bool); ok {
+		written.Store(true)
+	}
+}
+
+// lockingClause matches a SELECT's row locking clause. A locking read takes row locks, which
+// only the primary can, and is made to be followed by a write in the same transaction.
+var lockingClause = regexp.MustCompile(`(?i)\bFOR\s+(NO\s+KEY\s+UPDATE|UPDATE|KEY\s+SHARE|SHARE)\b`)
+
+// isReadOnly reports whether sql is a Get* or List* query from sqlc whose statement is a
+// plain SELECT. Anything else, including a WITH that could hide a write or a SELECT ... FOR
+// UPDATE, is treated as a write.
+func isReadOnly(sql string) bool {
+	header, body, ok := strings.Cut(sql, "\n")
+	if !ok {
+		return false
+	}
+	name, ok := strings.CutPrefix(header, "-- name: ")
+	if !ok || !(strings.HasPrefix(name, "Get") || strings.HasPrefix(name, "List")) {
+		return false
+	}
+	return len(body) >= 6 && strings.EqualFold(body[:6], "SELECT") && !lockingClause.MatchString(body)
+}
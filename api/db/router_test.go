@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// newTestPool returns a pool that never connects until used; nothing listens on its address, so
+// a query on it fails fast instead of reaching a database.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	pool, err := pgxpool.New(t.Context(), "postgres://loco@127.0.0.1:1/loco?connect_timeout=1")
+	if err != nil {
+		t.Fatalf("unexpected error creating pool: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+const (
+	getQuery    = "-- name: GetResourceByID :one\nSELECT id FROM resources WHERE id = $1"
+	listQuery   = "-- name: ListResources :many\nselect id FROM resources"
+	createQuery = "-- name: CreateResource :one\nINSERT INTO resources (name) VALUES ($1) RETURNING id"
+)
+
+func TestIsReadOnly(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want bool
+	}{
+		{"get select", getQuery, true},
+		{"list select, any case", listQuery, true},
+		{"create", createQuery, false},
+		{"get that writes", "-- name: GetOrCreateUser :one\nINSERT INTO users (email) VALUES ($1) RETURNING id", false},
+		{"get behind a with", "-- name: GetStats :one\nWITH x AS (DELETE FROM t RETURNING 1) SELECT count(*) FROM x", false},
+		{"list for update", "-- name: ListResourceRegionsForUpdate :many\nSELECT id FROM resource_regions\nWHERE resource_id = $1\nFOR UPDATE", false},
+		{"get for share", "-- name: GetResourceByID :one\nSELECT id FROM resources WHERE id = $1 for share", false},
+		{"get for no key update", "-- name: GetResourceByID :one\nSELECT id FROM resources WHERE id = $1 FOR NO KEY UPDATE SKIP LOCKED", false},
+		{"column named like a lock", "-- name: ListFormats :many\nSELECT format_update FROM t", true},
+		{"no sqlc header", "SELECT 1", false},
+		{"header only", "-- name: GetThing :one", false},
+	}
+	for _, tt := range tests {
+		if got := isReadOnly(tt.sql); got != tt.want {
+			t.Errorf("%s: expected isReadOnly=%v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestRouterPick(t *testing.T) {
+	primary, replica := newTestPool(t), newTestPool(t)
+	r := &Router{primary: primary, replica: replica}
+
+	t.Run("reads go to the replica", func(t *testing.T) {
+		ctx := WithReadYourWrites(t.Context())
+		if r.pick(ctx, getQuery) != replica {
+			t.Errorf("expected a get to go to the replica")
+		}
+		if r.pick(ctx, listQuery) != replica {
+			t.Errorf("expected a list to go to the replica")
+		}
+	})
+
+	t.Run("a write then a read in the same request", func(t *testing.T) {
+		ctx := WithReadYourWrites(t.Context())
+		if r.pick(ctx, createQuery) != primary {
+			t.Fatalf("expected a create to go to the primary")
+		}
+		if r.pick(ctx, getQuery) != primary {
+			t.Errorf("expected a read after a write to go to the primary")
+		}
+		if r.pick(WithReadYourWrites(t.Context()), getQuery) != replica {
+			t.Errorf("expected another request's read to still go to the replica")
+		}
+	})
+
+	t.Run("without read-your-writes reads stay on the replica", func(t *testing.T) {
+		ctx := t.Context()
+		r.pick(ctx, createQuery)
+		if r.pick(ctx, getQuery) != replica {
+			t.Errorf("expected a read to go to the replica")
+		}
+	})
+
+	t.Run("a transaction then a read in the same request", func(t *testing.T) {
+		d := &DB{pool: primary, replica: replica}
+		ctx := WithReadYourWrites(t.Context())
+
+		beginCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+		defer cancel()
+		if tx, err := d.Begin(beginCtx); err == nil {
+			tx.Rollback(ctx)
+			t.Fatalf("expected begin to fail without a database")
+		}
+
+		if r.pick(ctx, getQuery) != primary {
+			t.Errorf("expected a read after a transaction to go to the primary")
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// Beginner starts transactions. Services and the token vending machine take a Beginner rather
+// than a *pgxpool.Pool, which satisfies it, so tests can run them without a database.
+type Beginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// QueriesFor returns the generated queries to run inside tx. A tx that carries its own
+// queries, as fake ones in tests do, implements Querier() and those are used instead.
+func QueriesFor(tx pgx.Tx) genDb.Querier {
+	if q, ok := tx.(interface{ Querier() genDb.Querier }); ok {
+		return q.Querier()
+	}
+	return genDb.New(tx)
+}
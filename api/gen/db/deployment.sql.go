@@ -11,24 +11,39 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const clearDeploymentDrift = `-- name: ClearDeploymentDrift :exec
+UPDATE deployments
+SET drift = NULL, drift_detected_at = NULL
+WHERE id = $1 AND drift IS NOT NULL
+`
+
+func (q *Queries) ClearDeploymentDrift(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, clearDeploymentDrift, id)
+	return err
+}
+
 const createDeployment = `-- name: CreateDeployment :one
 
-INSERT INTO deployments (resource_id, resource_region_id, cluster_id, region, replicas, status, is_active, message, spec, spec_version)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+INSERT INTO deployments (resource_id, resource_region_id, cluster_id, region, replicas, status, is_active, message, spec, spec_version, annotation_message, annotation_author, annotation_git_sha, created_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 RETURNING id
 `
 
 type CreateDeploymentParams struct {
-	ResourceID       int64            `json:"resourceId"`
-	ResourceRegionID int64            `json:"resourceRegionId"`
-	ClusterID        int64            `json:"clusterId"`
-	Region           string           `json:"region"`
-	Replicas         int32            `json:"replicas"`
-	Status           DeploymentStatus `json:"status"`
-	IsActive         bool             `json:"isActive"`
-	Message          string           `json:"message"`
-	Spec             []byte           `json:"spec"`
-	SpecVersion      int32            `json:"specVersion"`
+	ResourceID        int64            `json:"resourceId"`
+	ResourceRegionID  int64            `json:"resourceRegionId"`
+	ClusterID         int64            `json:"clusterId"`
+	Region            string           `json:"region"`
+	Replicas          int32            `json:"replicas"`
+	Status            DeploymentStatus `json:"status"`
+	IsActive          bool             `json:"isActive"`
+	Message           string           `json:"message"`
+	Spec              []byte           `json:"spec"`
+	SpecVersion       int32            `json:"specVersion"`
+	AnnotationMessage string           `json:"annotationMessage"`
+	AnnotationAuthor  string           `json:"annotationAuthor"`
+	AnnotationGitSha  string           `json:"annotationGitSha"`
+	CreatedBy         pgtype.Int8      `json:"createdBy"`
 }
 
 // Deployment queries
@@ -44,14 +59,38 @@ func (q *Queries) CreateDeployment(ctx context.Context, arg CreateDeploymentPara
 		arg.Message,
 		arg.Spec,
 		arg.SpecVersion,
+		arg.AnnotationMessage,
+		arg.AnnotationAuthor,
+		arg.AnnotationGitSha,
+		arg.CreatedBy,
 	)
 	var id int64
 	err := row.Scan(&id)
 	return id, err
 }
 
+const failStuckDeployment = `-- name: FailStuckDeployment :execrows
+UPDATE deployments
+SET status = 'failed', message = $2, completed_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND status IN ('pending', 'deploying')
+`
+
+type FailStuckDeploymentParams struct {
+	ID      int64  `json:"id"`
+	Message string `json:"message"`
+}
+
+// leaves the deployment alone if it finished or failed since it was listed.
+func (q *Queries) FailStuckDeployment(ctx context.Context, arg FailStuckDeploymentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, failStuckDeployment, arg.ID, arg.Message)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getActiveDeploymentForResourceAndRegion = `-- name: GetActiveDeploymentForResourceAndRegion :one
-SELECT id, resource_id, resource_region_id, cluster_id, region, replicas, status, is_active, message, spec, spec_version, created_at, started_at, completed_at, updated_at FROM deployments
+SELECT id, resource_id, resource_region_id, cluster_id, region, replicas, status, is_active, message, spec, spec_version, created_at, started_at, completed_at, updated_at, drift, drift_detected_at, version, annotation_message, annotation_author, annotation_git_sha, created_by FROM deployments
 WHERE resource_id = $1 AND region = $2 AND is_active = true
 ORDER BY created_at DESC
 LIMIT 1
@@ -81,12 +120,19 @@ func (q *Queries) GetActiveDeploymentForResourceAndRegion(ctx context.Context, a
 		&i.StartedAt,
 		&i.CompletedAt,
 		&i.UpdatedAt,
+		&i.Drift,
+		&i.DriftDetectedAt,
+		&i.Version,
+		&i.AnnotationMessage,
+		&i.AnnotationAuthor,
+		&i.AnnotationGitSha,
+		&i.CreatedBy,
 	)
 	return i, err
 }
 
 const getDeploymentByID = `-- name: GetDeploymentByID :one
-SELECT id, resource_id, resource_region_id, cluster_id, region, replicas, status, is_active, message, spec, spec_version, created_at, started_at, completed_at, updated_at FROM deployments WHERE id = $1
+SELECT id, resource_id, resource_region_id, cluster_id, region, replicas, status, is_active, message, spec, spec_version, created_at, started_at, completed_at, updated_at, drift, drift_detected_at, version, annotation_message, annotation_author, annotation_git_sha, created_by FROM deployments WHERE id = $1
 `
 
 func (q *Queries) GetDeploymentByID(ctx context.Context, id int64) (Deployment, error) {
@@ -108,6 +154,13 @@ func (q *Queries) GetDeploymentByID(ctx context.Context, id int64) (Deployment,
 		&i.StartedAt,
 		&i.CompletedAt,
 		&i.UpdatedAt,
+		&i.Drift,
+		&i.DriftDetectedAt,
+		&i.Version,
+		&i.AnnotationMessage,
+		&i.AnnotationAuthor,
+		&i.AnnotationGitSha,
+		&i.CreatedBy,
 	)
 	return i, err
 }
@@ -123,6 +176,57 @@ func (q *Queries) GetDeploymentResourceID(ctx context.Context, id int64) (int64,
 	return resource_id, err
 }
 
+const getPreviousDeploymentInRegion = `-- name: GetPreviousDeploymentInRegion :one
+SELECT id, resource_id, resource_region_id, cluster_id, region, replicas, status, is_active, message, spec, spec_version, created_at, started_at, completed_at, updated_at, drift, drift_detected_at, version, annotation_message, annotation_author, annotation_git_sha, created_by FROM deployments d
+WHERE d.resource_id = $1
+  AND d.region = $2
+  AND (d.created_at, d.id) < ($3::timestamptz, $4::bigint)
+ORDER BY d.created_at DESC, d.id DESC
+LIMIT 1
+`
+
+type GetPreviousDeploymentInRegionParams struct {
+	ResourceID int64              `json:"resourceId"`
+	Region     string             `json:"region"`
+	CreatedAt  pgtype.Timestamptz `json:"createdAt"`
+	ID         int64              `json:"id"`
+}
+
+func (q *Queries) GetPreviousDeploymentInRegion(ctx context.Context, arg GetPreviousDeploymentInRegionParams) (Deployment, error) {
+	row := q.db.QueryRow(ctx, getPreviousDeploymentInRegion,
+		arg.ResourceID,
+		arg.Region,
+		arg.CreatedAt,
+		arg.ID,
+	)
+	var i Deployment
+	err := row.Scan(
+		&i.ID,
+		&i.ResourceID,
+		&i.ResourceRegionID,
+		&i.ClusterID,
+		&i.Region,
+		&i.Replicas,
+		&i.Status,
+		&i.IsActive,
+		&i.Message,
+		&i.Spec,
+		&i.SpecVersion,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.UpdatedAt,
+		&i.Drift,
+		&i.DriftDetectedAt,
+		&i.Version,
+		&i.AnnotationMessage,
+		&i.AnnotationAuthor,
+		&i.AnnotationGitSha,
+		&i.CreatedBy,
+	)
+	return i, err
+}
+
 const listActiveDeployments = `-- name: ListActiveDeployments :many
 SELECT resource_id FROM deployments WHERE is_active = true
 `
@@ -147,8 +251,53 @@ func (q *Queries) ListActiveDeployments(ctx context.Context) ([]int64, error) {
 	return items, nil
 }
 
+const listActiveDeploymentsForDrift = `-- name: ListActiveDeploymentsForDrift :many
+SELECT d.id, d.resource_id, d.region, d.spec, d.drift, r.workspace_id, r.type
+FROM deployments d
+JOIN resources r ON r.id = d.resource_id
+WHERE d.is_active = true
+`
+
+type ListActiveDeploymentsForDriftRow struct {
+	ID          int64        `json:"id"`
+	ResourceID  int64        `json:"resourceId"`
+	Region      string       `json:"region"`
+	Spec        []byte       `json:"spec"`
+	Drift       []byte       `json:"drift"`
+	WorkspaceID int64        `json:"workspaceId"`
+	Type        ResourceType `json:"type"`
+}
+
+func (q *Queries) ListActiveDeploymentsForDrift(ctx context.Context) ([]ListActiveDeploymentsForDriftRow, error) {
+	rows, err := q.db.Query(ctx, listActiveDeploymentsForDrift)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListActiveDeploymentsForDriftRow
+	for rows.Next() {
+		var i ListActiveDeploymentsForDriftRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResourceID,
+			&i.Region,
+			&i.Spec,
+			&i.Drift,
+			&i.WorkspaceID,
+			&i.Type,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listActiveDeploymentsForResource = `-- name: ListActiveDeploymentsForResource :many
-SELECT id, resource_id, resource_region_id, cluster_id, region, replicas, status, is_active, message, spec, spec_version, created_at, started_at, completed_at, updated_at FROM deployments
+SELECT id, resource_id, resource_region_id, cluster_id, region, replicas, status, is_active, message, spec, spec_version, created_at, started_at, completed_at, updated_at, drift, drift_detected_at, version, annotation_message, annotation_author, annotation_git_sha, created_by FROM deployments
 WHERE resource_id = $1 AND is_active = true
 ORDER BY created_at DESC
 `
@@ -178,6 +327,13 @@ func (q *Queries) ListActiveDeploymentsForResource(ctx context.Context, resource
 			&i.StartedAt,
 			&i.CompletedAt,
 			&i.UpdatedAt,
+			&i.Drift,
+			&i.DriftDetectedAt,
+			&i.Version,
+			&i.AnnotationMessage,
+			&i.AnnotationAuthor,
+			&i.AnnotationGitSha,
+			&i.CreatedBy,
 		); err != nil {
 			return nil, err
 		}
@@ -190,25 +346,54 @@ func (q *Queries) ListActiveDeploymentsForResource(ctx context.Context, resource
 }
 
 const listDeploymentsForResource = `-- name: ListDeploymentsForResource :many
-SELECT id, resource_id, resource_region_id, cluster_id, region, replicas, status, is_active, message, spec, spec_version, created_at, started_at, completed_at, updated_at FROM deployments d
+SELECT id, resource_id, resource_region_id, cluster_id, region, replicas, status, is_active, message, spec, spec_version, created_at, started_at, completed_at, updated_at, drift, drift_detected_at, version, annotation_message, annotation_author, annotation_git_sha, created_by FROM deployments d
 WHERE d.resource_id = $1
-  AND ($3::text IS NULL
-       OR (d.created_at, d.id) < (
-         (SELECT created_at FROM deployments WHERE id = $3::bigint),
-         $3::bigint
+  AND ($3::deployment_status[] IS NULL OR d.status = ANY($3::deployment_status[]))
+  AND ($4::timestamptz IS NULL OR d.created_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR d.created_at < $5::timestamptz)
+  AND ($6::bigint IS NULL OR d.created_by = $6::bigint)
+  AND (NOT $7::bool OR d.is_active)
+  AND ($8::text IS NULL
+       OR (NOT $9::bool AND (d.created_at, d.id) < (
+         (SELECT created_at FROM deployments WHERE id = $8::bigint),
+         $8::bigint
        ))
-ORDER BY d.created_at DESC, d.id DESC
+       OR ($9::bool AND (d.created_at, d.id) > (
+         (SELECT created_at FROM deployments WHERE id = $8::bigint),
+         $8::bigint
+       )))
+ORDER BY
+  CASE WHEN $9::bool THEN d.created_at END ASC,
+  CASE WHEN $9::bool THEN d.id END ASC,
+  d.created_at DESC, d.id DESC
 LIMIT $2
 `
 
 type ListDeploymentsForResourceParams struct {
-	ResourceID int64       `json:"resourceId"`
-	Limit      int32       `json:"limit"`
-	PageToken  pgtype.Text `json:"pageToken"`
+	ResourceID    int64              `json:"resourceId"`
+	Limit         int32              `json:"limit"`
+	Statuses      []DeploymentStatus `json:"statuses"`
+	CreatedAfter  pgtype.Timestamptz `json:"createdAfter"`
+	CreatedBefore pgtype.Timestamptz `json:"createdBefore"`
+	CreatedBy     pgtype.Int8        `json:"createdBy"`
+	ActiveOnly    bool               `json:"activeOnly"`
+	PageToken     pgtype.Text        `json:"pageToken"`
+	OldestFirst   bool               `json:"oldestFirst"`
 }
 
+// newest first unless oldest_first; each filter left NULL matches every deployment.
 func (q *Queries) ListDeploymentsForResource(ctx context.Context, arg ListDeploymentsForResourceParams) ([]Deployment, error) {
-	rows, err := q.db.Query(ctx, listDeploymentsForResource, arg.ResourceID, arg.Limit, arg.PageToken)
+	rows, err := q.db.Query(ctx, listDeploymentsForResource,
+		arg.ResourceID,
+		arg.Limit,
+		arg.Statuses,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CreatedBy,
+		arg.ActiveOnly,
+		arg.PageToken,
+		arg.OldestFirst,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -232,6 +417,59 @@ func (q *Queries) ListDeploymentsForResource(ctx context.Context, arg ListDeploy
 			&i.StartedAt,
 			&i.CompletedAt,
 			&i.UpdatedAt,
+			&i.Drift,
+			&i.DriftDetectedAt,
+			&i.Version,
+			&i.AnnotationMessage,
+			&i.AnnotationAuthor,
+			&i.AnnotationGitSha,
+			&i.CreatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStuckDeployments = `-- name: ListStuckDeployments :many
+SELECT id, resource_id, region, status, message, created_at
+FROM deployments
+WHERE is_active = true
+  AND status IN ('pending', 'deploying')
+  AND created_at < $1::timestamptz
+ORDER BY created_at, id
+`
+
+type ListStuckDeploymentsRow struct {
+	ID         int64              `json:"id"`
+	ResourceID int64              `json:"resourceId"`
+	Region     string             `json:"region"`
+	Status     DeploymentStatus   `json:"status"`
+	Message    string             `json:"message"`
+	CreatedAt  pgtype.Timestamptz `json:"createdAt"`
+}
+
+// active deployments still pending or deploying since before the cutoff, oldest first
+func (q *Queries) ListStuckDeployments(ctx context.Context, cutoff pgtype.Timestamptz) ([]ListStuckDeploymentsRow, error) {
+	rows, err := q.db.Query(ctx, listStuckDeployments, cutoff)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListStuckDeploymentsRow
+	for rows.Next() {
+		var i ListStuckDeploymentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResourceID,
+			&i.Region,
+			&i.Status,
+			&i.Message,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -243,6 +481,16 @@ func (q *Queries) ListDeploymentsForResource(ctx context.Context, arg ListDeploy
 	return items, nil
 }
 
+const lockResourceDeployments = `-- name: LockResourceDeployments :exec
+SELECT pg_advisory_xact_lock(hashtextextended('deployments', $1::bigint))
+`
+
+// holds the resource's deployment lock until the transaction ends, so its deployments are created one at a time.
+func (q *Queries) LockResourceDeployments(ctx context.Context, resourceID int64) error {
+	_, err := q.db.Exec(ctx, lockResourceDeployments, resourceID)
+	return err
+}
+
 const markDeploymentNotActive = `-- name: MarkDeploymentNotActive :exec
 UPDATE deployments
 SET is_active = false, updated_at = NOW()
@@ -265,6 +513,48 @@ func (q *Queries) MarkPreviousDeploymentsNotActive(ctx context.Context, resource
 	return err
 }
 
+const pruneDeploymentHistory = `-- name: PruneDeploymentHistory :execrows
+DELETE FROM deployments d
+USING (
+    SELECT d2.id,
+           w.deployment_history_limit AS history_limit,
+           ROW_NUMBER() OVER (PARTITION BY d2.resource_id ORDER BY d2.created_at DESC, d2.id DESC) AS position
+    FROM deployments d2
+    JOIN resources r ON r.id = d2.resource_id
+    JOIN workspaces w ON w.id = r.workspace_id
+    WHERE d2.is_active = false
+) ranked
+WHERE d.id = ranked.id
+  AND ranked.history_limit > 0
+  AND ranked.position > ranked.history_limit
+`
+
+// deletes inactive deployments beyond each workspace's deployment_history_limit, newest kept first
+func (q *Queries) PruneDeploymentHistory(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, pruneDeploymentHistory)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setDeploymentDrift = `-- name: SetDeploymentDrift :exec
+UPDATE deployments
+SET drift = $2, drift_detected_at = COALESCE(drift_detected_at, NOW())
+WHERE id = $1
+`
+
+type SetDeploymentDriftParams struct {
+	ID    int64  `json:"id"`
+	Drift []byte `json:"drift"`
+}
+
+// keeps the original detection time while the deployment stays drifted.
+func (q *Queries) SetDeploymentDrift(ctx context.Context, arg SetDeploymentDriftParams) error {
+	_, err := q.db.Exec(ctx, setDeploymentDrift, arg.ID, arg.Drift)
+	return err
+}
+
 const updateActiveDeploymentStatus = `-- name: UpdateActiveDeploymentStatus :exec
 UPDATE deployments
 SET status = $2, message = $3, updated_at = NOW()
@@ -50,9 +50,10 @@ INSERT INTO resource_domains (
     domain_source,
     subdomain_label,
     platform_domain_id,
-    is_primary
+    is_primary,
+    status
 )
-VALUES ($1, $2, $3, $4, $5, $6)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING id
 `
 
@@ -63,6 +64,7 @@ type CreateResourceDomainParams struct {
 	SubdomainLabel   pgtype.Text  `json:"subdomainLabel"`
 	PlatformDomainID pgtype.Int8  `json:"platformDomainId"`
 	IsPrimary        bool         `json:"isPrimary"`
+	Status           DomainStatus `json:"status"`
 }
 
 func (q *Queries) CreateResourceDomain(ctx context.Context, arg CreateResourceDomainParams) (int64, error) {
@@ -73,6 +75,7 @@ func (q *Queries) CreateResourceDomain(ctx context.Context, arg CreateResourceDo
 		arg.SubdomainLabel,
 		arg.PlatformDomainID,
 		arg.IsPrimary,
+		arg.Status,
 	)
 	var id int64
 	err := row.Scan(&id)
@@ -103,7 +106,7 @@ func (q *Queries) DeleteResourceDomain(ctx context.Context, id int64) error {
 
 const getDomainByResourceId = `-- name: GetDomainByResourceId :one
 SELECT 
-    rd.id, rd.resource_id, rd.domain, rd.domain_source, rd.subdomain_label, rd.platform_domain_id, rd.is_primary, rd.created_at, rd.updated_at,
+    rd.id, rd.resource_id, rd.domain, rd.domain_source, rd.subdomain_label, rd.platform_domain_id, rd.is_primary, rd.created_at, rd.updated_at, rd.access_control, rd.status, rd.status_message, rd.status_checked_at, rd.redirect, rd.tls,
     pd.domain as platform_base_domain
 FROM resource_domains rd
 LEFT JOIN platform_domains pd ON rd.platform_domain_id = pd.id
@@ -120,6 +123,12 @@ type GetDomainByResourceIdRow struct {
 	IsPrimary          bool               `json:"isPrimary"`
 	CreatedAt          pgtype.Timestamptz `json:"createdAt"`
 	UpdatedAt          pgtype.Timestamptz `json:"updatedAt"`
+	AccessControl      []byte             `json:"accessControl"`
+	Status             DomainStatus       `json:"status"`
+	StatusMessage      string             `json:"statusMessage"`
+	StatusCheckedAt    pgtype.Timestamptz `json:"statusCheckedAt"`
+	Redirect           []byte             `json:"redirect"`
+	Tls                []byte             `json:"tls"`
 	PlatformBaseDomain pgtype.Text        `json:"platformBaseDomain"`
 }
 
@@ -136,6 +145,12 @@ func (q *Queries) GetDomainByResourceId(ctx context.Context, resourceID int64) (
 		&i.IsPrimary,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AccessControl,
+		&i.Status,
+		&i.StatusMessage,
+		&i.StatusCheckedAt,
+		&i.Redirect,
+		&i.Tls,
 		&i.PlatformBaseDomain,
 	)
 	return i, err
@@ -185,7 +200,13 @@ SELECT
     rd.platform_domain_id,
     rd.is_primary,
     rd.created_at,
-    rd.updated_at
+    rd.updated_at,
+    rd.access_control,
+    rd.status,
+    rd.status_message,
+    rd.status_checked_at,
+    rd.redirect,
+    rd.tls
 FROM resource_domains rd
 WHERE rd.id = $1
 `
@@ -203,6 +224,56 @@ func (q *Queries) GetResourceDomainByID(ctx context.Context, id int64) (Resource
 		&i.IsPrimary,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AccessControl,
+		&i.Status,
+		&i.StatusMessage,
+		&i.StatusCheckedAt,
+		&i.Redirect,
+		&i.Tls,
+	)
+	return i, err
+}
+
+const getResourceDomainByName = `-- name: GetResourceDomainByName :one
+SELECT 
+    rd.id,
+    rd.resource_id,
+    rd.domain,
+    rd.domain_source,
+    rd.subdomain_label,
+    rd.platform_domain_id,
+    rd.is_primary,
+    rd.created_at,
+    rd.updated_at,
+    rd.access_control,
+    rd.status,
+    rd.status_message,
+    rd.status_checked_at,
+    rd.redirect,
+    rd.tls
+FROM resource_domains rd
+WHERE rd.domain = $1
+`
+
+func (q *Queries) GetResourceDomainByName(ctx context.Context, domain string) (ResourceDomain, error) {
+	row := q.db.QueryRow(ctx, getResourceDomainByName, domain)
+	var i ResourceDomain
+	err := row.Scan(
+		&i.ID,
+		&i.ResourceID,
+		&i.Domain,
+		&i.DomainSource,
+		&i.SubdomainLabel,
+		&i.PlatformDomainID,
+		&i.IsPrimary,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AccessControl,
+		&i.Status,
+		&i.StatusMessage,
+		&i.StatusCheckedAt,
+		&i.Redirect,
+		&i.Tls,
 	)
 	return i, err
 }
@@ -218,6 +289,26 @@ func (q *Queries) GetResourceDomainCount(ctx context.Context, resourceID int64)
 	return count, err
 }
 
+const getResourceIngressEndpoint = `-- name: GetResourceIngressEndpoint :one
+SELECT cl.endpoint
+FROM resource_regions rr
+JOIN clusters cl ON cl.region = rr.region
+WHERE rr.resource_id = $1
+  AND rr.is_primary
+  AND cl.is_active = true
+  AND cl.endpoint IS NOT NULL
+ORDER BY cl.is_default DESC, cl.created_at ASC
+LIMIT 1
+`
+
+// the ingress endpoint of the cluster serving the resource's primary region.
+func (q *Queries) GetResourceIngressEndpoint(ctx context.Context, resourceID int64) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, getResourceIngressEndpoint, resourceID)
+	var endpoint pgtype.Text
+	err := row.Scan(&endpoint)
+	return endpoint, err
+}
+
 const listActivePlatformDomains = `-- name: ListActivePlatformDomains :many
 SELECT id, domain, is_active, created_at FROM platform_domains
 WHERE is_active = true
@@ -297,6 +388,51 @@ func (q *Queries) ListAllLocoOwnedDomains(ctx context.Context) ([]ListAllLocoOwn
 	return items, nil
 }
 
+const listPendingCustomDomains = `-- name: ListPendingCustomDomains :many
+SELECT rd.id, rd.domain, c.endpoint
+FROM resource_domains rd
+LEFT JOIN resource_regions rr ON rr.resource_id = rd.resource_id AND rr.is_primary
+LEFT JOIN LATERAL (
+    SELECT cl.endpoint
+    FROM clusters cl
+    WHERE cl.region = rr.region
+      AND cl.is_active = true
+      AND cl.endpoint IS NOT NULL
+    ORDER BY cl.is_default DESC, cl.created_at ASC
+    LIMIT 1
+) c ON true
+WHERE rd.status = 'pending'
+ORDER BY rd.status_checked_at ASC NULLS FIRST
+`
+
+type ListPendingCustomDomainsRow struct {
+	ID       int64       `json:"id"`
+	Domain   string      `json:"domain"`
+	Endpoint pgtype.Text `json:"endpoint"`
+}
+
+// pending user-provided domains with the ingress endpoint their records should point at, which is
+// unset when the resource's primary region has no cluster with an endpoint.
+func (q *Queries) ListPendingCustomDomains(ctx context.Context) ([]ListPendingCustomDomainsRow, error) {
+	rows, err := q.db.Query(ctx, listPendingCustomDomains)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPendingCustomDomainsRow
+	for rows.Next() {
+		var i ListPendingCustomDomainsRow
+		if err := rows.Scan(&i.ID, &i.Domain, &i.Endpoint); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPlatformDomains = `-- name: ListPlatformDomains :many
 SELECT id, domain, is_active, created_at FROM platform_domains
 WHERE ($1::boolean IS NULL OR is_active = $1::boolean)
@@ -338,7 +474,13 @@ SELECT
     rd.platform_domain_id,
     rd.is_primary,
     rd.created_at,
-    rd.updated_at
+    rd.updated_at,
+    rd.access_control,
+    rd.status,
+    rd.status_message,
+    rd.status_checked_at,
+    rd.redirect,
+    rd.tls
 FROM resource_domains rd
 WHERE rd.resource_id = $1
 ORDER BY rd.is_primary DESC, rd.created_at ASC
@@ -363,6 +505,12 @@ func (q *Queries) ListResourceDomains(ctx context.Context, resourceID int64) ([]
 			&i.IsPrimary,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.AccessControl,
+			&i.Status,
+			&i.StatusMessage,
+			&i.StatusCheckedAt,
+			&i.Redirect,
+			&i.Tls,
 		); err != nil {
 			return nil, err
 		}
@@ -374,6 +522,112 @@ func (q *Queries) ListResourceDomains(ctx context.Context, resourceID int64) ([]
 	return items, nil
 }
 
+const listResourceDomainsForResources = `-- name: ListResourceDomainsForResources :many
+SELECT 
+    rd.id,
+    rd.resource_id,
+    rd.domain,
+    rd.domain_source,
+    rd.subdomain_label,
+    rd.platform_domain_id,
+    rd.is_primary,
+    rd.created_at,
+    rd.updated_at,
+    rd.access_control,
+    rd.status,
+    rd.status_message,
+    rd.status_checked_at,
+    rd.redirect,
+    rd.tls
+FROM resource_domains rd
+WHERE rd.resource_id = ANY($1::bigint[])
+ORDER BY rd.resource_id, rd.is_primary DESC, rd.created_at ASC
+`
+
+// the domains of several resources at once, in the order ListResourceDomains gives each.
+func (q *Queries) ListResourceDomainsForResources(ctx context.Context, resourceIds []int64) ([]ResourceDomain, error) {
+	rows, err := q.db.Query(ctx, listResourceDomainsForResources, resourceIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ResourceDomain
+	for rows.Next() {
+		var i ResourceDomain
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResourceID,
+			&i.Domain,
+			&i.DomainSource,
+			&i.SubdomainLabel,
+			&i.PlatformDomainID,
+			&i.IsPrimary,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AccessControl,
+			&i.Status,
+			&i.StatusMessage,
+			&i.StatusCheckedAt,
+			&i.Redirect,
+			&i.Tls,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockResourceDomains = `-- name: LockResourceDomains :exec
+SELECT id FROM resources WHERE id = $1 FOR UPDATE
+`
+
+// holds the resource's row lock until the transaction ends, so its domains are added one at a time.
+func (q *Queries) LockResourceDomains(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, lockResourceDomains, id)
+	return err
+}
+
+const setResourceDomainAccessControl = `-- name: SetResourceDomainAccessControl :one
+UPDATE resource_domains
+SET access_control = $3,
+    updated_at = NOW()
+WHERE id = $1 AND resource_id = $2
+RETURNING id, resource_id, domain, domain_source, subdomain_label, platform_domain_id, is_primary, created_at, updated_at, access_control, status, status_message, status_checked_at, redirect, tls
+`
+
+type SetResourceDomainAccessControlParams struct {
+	ID            int64  `json:"id"`
+	ResourceID    int64  `json:"resourceId"`
+	AccessControl []byte `json:"accessControl"`
+}
+
+func (q *Queries) SetResourceDomainAccessControl(ctx context.Context, arg SetResourceDomainAccessControlParams) (ResourceDomain, error) {
+	row := q.db.QueryRow(ctx, setResourceDomainAccessControl, arg.ID, arg.ResourceID, arg.AccessControl)
+	var i ResourceDomain
+	err := row.Scan(
+		&i.ID,
+		&i.ResourceID,
+		&i.Domain,
+		&i.DomainSource,
+		&i.SubdomainLabel,
+		&i.PlatformDomainID,
+		&i.IsPrimary,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AccessControl,
+		&i.Status,
+		&i.StatusMessage,
+		&i.StatusCheckedAt,
+		&i.Redirect,
+		&i.Tls,
+	)
+	return i, err
+}
+
 const setResourceDomainPrimary = `-- name: SetResourceDomainPrimary :one
 UPDATE resource_domains
 SET is_primary = true
@@ -393,9 +647,104 @@ func (q *Queries) SetResourceDomainPrimary(ctx context.Context, arg SetResourceD
 	return id, err
 }
 
+const setResourceDomainRedirect = `-- name: SetResourceDomainRedirect :one
+UPDATE resource_domains
+SET redirect = $3,
+    updated_at = NOW()
+WHERE id = $1 AND resource_id = $2
+RETURNING id, resource_id, domain, domain_source, subdomain_label, platform_domain_id, is_primary, created_at, updated_at, access_control, status, status_message, status_checked_at, redirect, tls
+`
+
+type SetResourceDomainRedirectParams struct {
+	ID         int64  `json:"id"`
+	ResourceID int64  `json:"resourceId"`
+	Redirect   []byte `json:"redirect"`
+}
+
+func (q *Queries) SetResourceDomainRedirect(ctx context.Context, arg SetResourceDomainRedirectParams) (ResourceDomain, error) {
+	row := q.db.QueryRow(ctx, setResourceDomainRedirect, arg.ID, arg.ResourceID, arg.Redirect)
+	var i ResourceDomain
+	err := row.Scan(
+		&i.ID,
+		&i.ResourceID,
+		&i.Domain,
+		&i.DomainSource,
+		&i.SubdomainLabel,
+		&i.PlatformDomainID,
+		&i.IsPrimary,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AccessControl,
+		&i.Status,
+		&i.StatusMessage,
+		&i.StatusCheckedAt,
+		&i.Redirect,
+		&i.Tls,
+	)
+	return i, err
+}
+
+const setResourceDomainStatus = `-- name: SetResourceDomainStatus :exec
+UPDATE resource_domains
+SET status = $2,
+    status_message = $3,
+    status_checked_at = NOW()
+WHERE id = $1
+`
+
+type SetResourceDomainStatusParams struct {
+	ID            int64        `json:"id"`
+	Status        DomainStatus `json:"status"`
+	StatusMessage string       `json:"statusMessage"`
+}
+
+func (q *Queries) SetResourceDomainStatus(ctx context.Context, arg SetResourceDomainStatusParams) error {
+	_, err := q.db.Exec(ctx, setResourceDomainStatus, arg.ID, arg.Status, arg.StatusMessage)
+	return err
+}
+
+const setResourceDomainTLSPolicy = `-- name: SetResourceDomainTLSPolicy :one
+UPDATE resource_domains
+SET tls = $3,
+    updated_at = NOW()
+WHERE id = $1 AND resource_id = $2
+RETURNING id, resource_id, domain, domain_source, subdomain_label, platform_domain_id, is_primary, created_at, updated_at, access_control, status, status_message, status_checked_at, redirect, tls
+`
+
+type SetResourceDomainTLSPolicyParams struct {
+	ID         int64  `json:"id"`
+	ResourceID int64  `json:"resourceId"`
+	Tls        []byte `json:"tls"`
+}
+
+func (q *Queries) SetResourceDomainTLSPolicy(ctx context.Context, arg SetResourceDomainTLSPolicyParams) (ResourceDomain, error) {
+	row := q.db.QueryRow(ctx, setResourceDomainTLSPolicy, arg.ID, arg.ResourceID, arg.Tls)
+	var i ResourceDomain
+	err := row.Scan(
+		&i.ID,
+		&i.ResourceID,
+		&i.Domain,
+		&i.DomainSource,
+		&i.SubdomainLabel,
+		&i.PlatformDomainID,
+		&i.IsPrimary,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AccessControl,
+		&i.Status,
+		&i.StatusMessage,
+		&i.StatusCheckedAt,
+		&i.Redirect,
+		&i.Tls,
+	)
+	return i, err
+}
+
 const updateResourceDomain = `-- name: UpdateResourceDomain :one
 UPDATE resource_domains
 SET domain = $2,
+    status = CASE WHEN domain_source = 'user_provided' THEN 'pending' ELSE status END,
+    status_message = '',
     updated_at = NOW()
 WHERE id = $1
 RETURNING id
@@ -406,6 +755,7 @@ type UpdateResourceDomainParams struct {
 	Domain string `json:"domain"`
 }
 
+// a user-provided domain that changes needs its records checked again.
 func (q *Queries) UpdateResourceDomain(ctx context.Context, arg UpdateResourceDomainParams) (int64, error) {
 	row := q.db.QueryRow(ctx, updateResourceDomain, arg.ID, arg.Domain)
 	var id int64
@@ -19,8 +19,29 @@ const (
 	ScopeRead  Scope = "read"
 	ScopeWrite Scope = "write"
 	ScopeAdmin Scope = "admin"
+
+	// action-level scopes grant a single kind of operation. Each is implied by one of the scopes above;
+	// see [CoarseScope].
+	ScopeLogsRead     Scope = "logs:read"
+	ScopeEnvWrite     Scope = "env:write"
+	ScopeDeployCreate Scope = "deploy:create"
+	ScopeDomainManage Scope = "domain:manage"
 )
 
+// CoarseScope returns the read, write or admin scope that implies an action-level scope, or the scope
+// itself when it is not action-level. Roles only grant coarse scopes, so they keep allowing every operation
+// they did before action-level scopes existed.
+func CoarseScope(scope Scope) Scope {
+	switch scope {
+	case ScopeLogsRead:
+		return ScopeRead
+	case ScopeEnvWrite, ScopeDeployCreate, ScopeDomainManage:
+		return ScopeWrite
+	default:
+		return scope
+	}
+}
+
 // TokenHead represents a token without the actual token string.
 type TokenHead struct {
 	Name       string        `json:"name"`
@@ -12,6 +12,222 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AlertCondition string
+
+const (
+	AlertConditionDeploymentFailed AlertCondition = "deployment_failed"
+	AlertConditionRestarts         AlertCondition = "restarts"
+	AlertConditionLatencyP95       AlertCondition = "latency_p95"
+)
+
+func (e *AlertCondition) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AlertCondition(s)
+	case string:
+		*e = AlertCondition(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AlertCondition: %T", src)
+	}
+	return nil
+}
+
+type NullAlertCondition struct {
+	AlertCondition AlertCondition `json:"alertCondition"`
+	Valid          bool           `json:"valid"` // Valid is true if AlertCondition is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAlertCondition) Scan(value interface{}) error {
+	if value == nil {
+		ns.AlertCondition, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AlertCondition.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAlertCondition) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AlertCondition), nil
+}
+
+type AnnouncementKind string
+
+const (
+	AnnouncementKindReleaseNote AnnouncementKind = "release_note"
+	AnnouncementKindDeprecation AnnouncementKind = "deprecation"
+	AnnouncementKindMaintenance AnnouncementKind = "maintenance"
+)
+
+func (e *AnnouncementKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AnnouncementKind(s)
+	case string:
+		*e = AnnouncementKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AnnouncementKind: %T", src)
+	}
+	return nil
+}
+
+type NullAnnouncementKind struct {
+	AnnouncementKind AnnouncementKind `json:"announcementKind"`
+	Valid            bool             `json:"valid"` // Valid is true if AnnouncementKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAnnouncementKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.AnnouncementKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AnnouncementKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAnnouncementKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AnnouncementKind), nil
+}
+
+type ApprovalAction string
+
+const (
+	ApprovalActionDeleteResource       ApprovalAction = "delete_resource"
+	ApprovalActionDeleteWorkspace      ApprovalAction = "delete_workspace"
+	ApprovalActionDeleteResourceDomain ApprovalAction = "delete_resource_domain"
+)
+
+func (e *ApprovalAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ApprovalAction(s)
+	case string:
+		*e = ApprovalAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ApprovalAction: %T", src)
+	}
+	return nil
+}
+
+type NullApprovalAction struct {
+	ApprovalAction ApprovalAction `json:"approvalAction"`
+	Valid          bool           `json:"valid"` // Valid is true if ApprovalAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullApprovalAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.ApprovalAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ApprovalAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullApprovalAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ApprovalAction), nil
+}
+
+type ApprovalStatus string
+
+const (
+	ApprovalStatusPending   ApprovalStatus = "pending"
+	ApprovalStatusExecuted  ApprovalStatus = "executed"
+	ApprovalStatusCancelled ApprovalStatus = "cancelled"
+)
+
+func (e *ApprovalStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ApprovalStatus(s)
+	case string:
+		*e = ApprovalStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ApprovalStatus: %T", src)
+	}
+	return nil
+}
+
+type NullApprovalStatus struct {
+	ApprovalStatus ApprovalStatus `json:"approvalStatus"`
+	Valid          bool           `json:"valid"` // Valid is true if ApprovalStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullApprovalStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ApprovalStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ApprovalStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullApprovalStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ApprovalStatus), nil
+}
+
+type ConfigRolloutStatus string
+
+const (
+	ConfigRolloutStatusPending   ConfigRolloutStatus = "pending"
+	ConfigRolloutStatusRunning   ConfigRolloutStatus = "running"
+	ConfigRolloutStatusCompleted ConfigRolloutStatus = "completed"
+	ConfigRolloutStatusFailed    ConfigRolloutStatus = "failed"
+)
+
+func (e *ConfigRolloutStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ConfigRolloutStatus(s)
+	case string:
+		*e = ConfigRolloutStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ConfigRolloutStatus: %T", src)
+	}
+	return nil
+}
+
+type NullConfigRolloutStatus struct {
+	ConfigRolloutStatus ConfigRolloutStatus `json:"configRolloutStatus"`
+	Valid               bool                `json:"valid"` // Valid is true if ConfigRolloutStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullConfigRolloutStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ConfigRolloutStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ConfigRolloutStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullConfigRolloutStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ConfigRolloutStatus), nil
+}
+
 type DeploymentStatus string
 
 const (
@@ -58,6 +274,49 @@ func (ns NullDeploymentStatus) Value() (driver.Value, error) {
 	return string(ns.DeploymentStatus), nil
 }
 
+type DiscrepancyKind string
+
+const (
+	DiscrepancyKindMissing  DiscrepancyKind = "missing"
+	DiscrepancyKindStale    DiscrepancyKind = "stale"
+	DiscrepancyKindOrphaned DiscrepancyKind = "orphaned"
+)
+
+func (e *DiscrepancyKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DiscrepancyKind(s)
+	case string:
+		*e = DiscrepancyKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DiscrepancyKind: %T", src)
+	}
+	return nil
+}
+
+type NullDiscrepancyKind struct {
+	DiscrepancyKind DiscrepancyKind `json:"discrepancyKind"`
+	Valid           bool            `json:"valid"` // Valid is true if DiscrepancyKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDiscrepancyKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.DiscrepancyKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DiscrepancyKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDiscrepancyKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DiscrepancyKind), nil
+}
+
 type DomainSource string
 
 const (
@@ -100,14 +359,58 @@ func (ns NullDomainSource) Value() (driver.Value, error) {
 	return string(ns.DomainSource), nil
 }
 
+type DomainStatus string
+
+const (
+	DomainStatusPending DomainStatus = "pending"
+	DomainStatusActive  DomainStatus = "active"
+)
+
+func (e *DomainStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DomainStatus(s)
+	case string:
+		*e = DomainStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DomainStatus: %T", src)
+	}
+	return nil
+}
+
+type NullDomainStatus struct {
+	DomainStatus DomainStatus `json:"domainStatus"`
+	Valid        bool         `json:"valid"` // Valid is true if DomainStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDomainStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.DomainStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DomainStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDomainStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DomainStatus), nil
+}
+
 type EntityType string
 
 const (
-	EntityTypeSystem       EntityType = "system"
-	EntityTypeOrganization EntityType = "organization"
-	EntityTypeWorkspace    EntityType = "workspace"
-	EntityTypeResource     EntityType = "resource"
-	EntityTypeUser         EntityType = "user"
+	EntityTypeSystem         EntityType = "system"
+	EntityTypeOrganization   EntityType = "organization"
+	EntityTypeWorkspace      EntityType = "workspace"
+	EntityTypeResource       EntityType = "resource"
+	EntityTypeUser           EntityType = "user"
+	EntityTypeEnvironment    EntityType = "environment"
+	EntityTypeServiceAccount EntityType = "service_account"
 )
 
 func (e *EntityType) Scan(src interface{}) error {
@@ -145,6 +448,140 @@ func (ns NullEntityType) Value() (driver.Value, error) {
 	return string(ns.EntityType), nil
 }
 
+type NotificationChannel string
+
+const (
+	NotificationChannelEmail    NotificationChannel = "email"
+	NotificationChannelWebhook  NotificationChannel = "webhook"
+	NotificationChannelSlack    NotificationChannel = "slack"
+	NotificationChannelSlackApp NotificationChannel = "slack_app"
+)
+
+func (e *NotificationChannel) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = NotificationChannel(s)
+	case string:
+		*e = NotificationChannel(s)
+	default:
+		return fmt.Errorf("unsupported scan type for NotificationChannel: %T", src)
+	}
+	return nil
+}
+
+type NullNotificationChannel struct {
+	NotificationChannel NotificationChannel `json:"notificationChannel"`
+	Valid               bool                `json:"valid"` // Valid is true if NotificationChannel is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullNotificationChannel) Scan(value interface{}) error {
+	if value == nil {
+		ns.NotificationChannel, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.NotificationChannel.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullNotificationChannel) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.NotificationChannel), nil
+}
+
+type NotificationEvent string
+
+const (
+	NotificationEventDeploymentFailed   NotificationEvent = "deployment_failed"
+	NotificationEventDomainVerification NotificationEvent = "domain_verification"
+	NotificationEventInvitation         NotificationEvent = "invitation"
+	NotificationEventAlert              NotificationEvent = "alert"
+	NotificationEventDeployment         NotificationEvent = "deployment"
+	NotificationEventWorkspaceDigest    NotificationEvent = "workspace_digest"
+)
+
+func (e *NotificationEvent) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = NotificationEvent(s)
+	case string:
+		*e = NotificationEvent(s)
+	default:
+		return fmt.Errorf("unsupported scan type for NotificationEvent: %T", src)
+	}
+	return nil
+}
+
+type NullNotificationEvent struct {
+	NotificationEvent NotificationEvent `json:"notificationEvent"`
+	Valid             bool              `json:"valid"` // Valid is true if NotificationEvent is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullNotificationEvent) Scan(value interface{}) error {
+	if value == nil {
+		ns.NotificationEvent, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.NotificationEvent.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullNotificationEvent) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.NotificationEvent), nil
+}
+
+type OrgDeletionStatus string
+
+const (
+	OrgDeletionStatusPending   OrgDeletionStatus = "pending"
+	OrgDeletionStatusRunning   OrgDeletionStatus = "running"
+	OrgDeletionStatusCompleted OrgDeletionStatus = "completed"
+	OrgDeletionStatusFailed    OrgDeletionStatus = "failed"
+)
+
+func (e *OrgDeletionStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrgDeletionStatus(s)
+	case string:
+		*e = OrgDeletionStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrgDeletionStatus: %T", src)
+	}
+	return nil
+}
+
+type NullOrgDeletionStatus struct {
+	OrgDeletionStatus OrgDeletionStatus `json:"orgDeletionStatus"`
+	Valid             bool              `json:"valid"` // Valid is true if OrgDeletionStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrgDeletionStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrgDeletionStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrgDeletionStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrgDeletionStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrgDeletionStatus), nil
+}
+
 type RegionIntentStatus string
 
 const (
@@ -191,6 +628,49 @@ func (ns NullRegionIntentStatus) Value() (driver.Value, error) {
 	return string(ns.RegionIntentStatus), nil
 }
 
+type ResourceActivityKind string
+
+const (
+	ResourceActivityKindScaled        ResourceActivityKind = "scaled"
+	ResourceActivityKindEnvChanged    ResourceActivityKind = "env_changed"
+	ResourceActivityKindDomainChanged ResourceActivityKind = "domain_changed"
+)
+
+func (e *ResourceActivityKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ResourceActivityKind(s)
+	case string:
+		*e = ResourceActivityKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ResourceActivityKind: %T", src)
+	}
+	return nil
+}
+
+type NullResourceActivityKind struct {
+	ResourceActivityKind ResourceActivityKind `json:"resourceActivityKind"`
+	Valid                bool                 `json:"valid"` // Valid is true if ResourceActivityKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullResourceActivityKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.ResourceActivityKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ResourceActivityKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullResourceActivityKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ResourceActivityKind), nil
+}
+
 type ResourceStatus string
 
 const (
@@ -282,6 +762,53 @@ func (ns NullResourceType) Value() (driver.Value, error) {
 	return string(ns.ResourceType), nil
 }
 
+type SubscriptionStatus string
+
+const (
+	SubscriptionStatusNone       SubscriptionStatus = "none"
+	SubscriptionStatusIncomplete SubscriptionStatus = "incomplete"
+	SubscriptionStatusTrialing   SubscriptionStatus = "trialing"
+	SubscriptionStatusActive     SubscriptionStatus = "active"
+	SubscriptionStatusPastDue    SubscriptionStatus = "past_due"
+	SubscriptionStatusUnpaid     SubscriptionStatus = "unpaid"
+	SubscriptionStatusCanceled   SubscriptionStatus = "canceled"
+)
+
+func (e *SubscriptionStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SubscriptionStatus(s)
+	case string:
+		*e = SubscriptionStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SubscriptionStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSubscriptionStatus struct {
+	SubscriptionStatus SubscriptionStatus `json:"subscriptionStatus"`
+	Valid              bool               `json:"valid"` // Valid is true if SubscriptionStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSubscriptionStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SubscriptionStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SubscriptionStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSubscriptionStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SubscriptionStatus), nil
+}
+
 type WorkspaceRole string
 
 const (
@@ -325,6 +852,92 @@ func (ns NullWorkspaceRole) Value() (driver.Value, error) {
 	return string(ns.WorkspaceRole), nil
 }
 
+type AdminAuditLog struct {
+	ID         int64              `json:"id"`
+	ActorID    pgtype.Int8        `json:"actorId"`
+	Action     string             `json:"action"`
+	TargetType string             `json:"targetType"`
+	TargetID   int64              `json:"targetId"`
+	Reason     string             `json:"reason"`
+	Detail     string             `json:"detail"`
+	CreatedAt  pgtype.Timestamptz `json:"createdAt"`
+}
+
+type AlertRule struct {
+	ID               int64              `json:"id"`
+	WorkspaceID      int64              `json:"workspaceId"`
+	ResourceID       pgtype.Int8        `json:"resourceId"`
+	Name             string             `json:"name"`
+	Condition        AlertCondition     `json:"condition"`
+	Threshold        float64            `json:"threshold"`
+	WindowSeconds    int32              `json:"windowSeconds"`
+	Enabled          bool               `json:"enabled"`
+	CreatedBy        pgtype.Int8        `json:"createdBy"`
+	NextEvaluationAt pgtype.Timestamptz `json:"nextEvaluationAt"`
+	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type AlertState struct {
+	RuleID     int64              `json:"ruleId"`
+	ResourceID int64              `json:"resourceId"`
+	Firing     bool               `json:"firing"`
+	Value      float64            `json:"value"`
+	FiredAt    pgtype.Timestamptz `json:"firedAt"`
+	Data       []byte             `json:"data"`
+	UpdatedAt  pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type AlertTarget struct {
+	ID      int64               `json:"id"`
+	RuleID  int64               `json:"ruleId"`
+	Channel NotificationChannel `json:"channel"`
+	Target  string              `json:"target"`
+}
+
+type Announcement struct {
+	ID        int64              `json:"id"`
+	Kind      AnnouncementKind   `json:"kind"`
+	Title     string             `json:"title"`
+	Body      string             `json:"body"`
+	Link      pgtype.Text        `json:"link"`
+	CreatedBy pgtype.Int8        `json:"createdBy"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	ExpiresAt pgtype.Timestamptz `json:"expiresAt"`
+}
+
+type AnnouncementRead struct {
+	UserID     int64              `json:"userId"`
+	LastReadID int64              `json:"lastReadId"`
+	UpdatedAt  pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type ApprovalRequest struct {
+	ID              int64              `json:"id"`
+	Action          ApprovalAction     `json:"action"`
+	TargetID        int64              `json:"targetId"`
+	WorkspaceID     int64              `json:"workspaceId"`
+	RequestedByType EntityType         `json:"requestedByType"`
+	RequestedByID   int64              `json:"requestedById"`
+	ApprovedBy      pgtype.Int8        `json:"approvedBy"`
+	Status          ApprovalStatus     `json:"status"`
+	ExpiresAt       pgtype.Timestamptz `json:"expiresAt"`
+	CreatedAt       pgtype.Timestamptz `json:"createdAt"`
+	DecidedAt       pgtype.Timestamptz `json:"decidedAt"`
+}
+
+type BillingPlan struct {
+	ID            int64              `json:"id"`
+	Name          string             `json:"name"`
+	Description   string             `json:"description"`
+	StripePriceID string             `json:"stripePriceId"`
+	MonthlyCents  int64              `json:"monthlyCents"`
+	Currency      string             `json:"currency"`
+	IsActive      bool               `json:"isActive"`
+	CreatedAt     pgtype.Timestamptz `json:"createdAt"`
+	IdleSleep     bool               `json:"idleSleep"`
+}
+
 type Cluster struct {
 	ID              int64              `json:"id"`
 	Name            string             `json:"name"`
@@ -339,30 +952,234 @@ type Cluster struct {
 	UpdatedAt       pgtype.Timestamptz `json:"updatedAt"`
 }
 
+type ClusterDiscrepancy struct {
+	ResourceID   int64              `json:"resourceId"`
+	WorkspaceID  int64              `json:"workspaceId"`
+	DeploymentID pgtype.Int8        `json:"deploymentId"`
+	Region       string             `json:"region"`
+	Kind         DiscrepancyKind    `json:"kind"`
+	Detail       string             `json:"detail"`
+	RepairedAt   pgtype.Timestamptz `json:"repairedAt"`
+	DetectedAt   pgtype.Timestamptz `json:"detectedAt"`
+	UpdatedAt    pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type ClusterNodePool struct {
+	ID        int64              `json:"id"`
+	ClusterID int64              `json:"clusterId"`
+	Name      string             `json:"name"`
+	Labels    []byte             `json:"labels"`
+	Taints    []byte             `json:"taints"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+}
+
+type ClusterPricing struct {
+	ClusterID           int64              `json:"clusterId"`
+	Currency            string             `json:"currency"`
+	CpuCoreHourMicros   int64              `json:"cpuCoreHourMicros"`
+	MemoryGibHourMicros int64              `json:"memoryGibHourMicros"`
+	UpdatedAt           pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type ConfigGroup struct {
+	ID          int64              `json:"id"`
+	WorkspaceID int64              `json:"workspaceId"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Env         []byte             `json:"env"`
+	CreatedBy   pgtype.Int8        `json:"createdBy"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type ConfigGroupResource struct {
+	ConfigGroupID int64              `json:"configGroupId"`
+	ResourceID    int64              `json:"resourceId"`
+	AttachedAt    pgtype.Timestamptz `json:"attachedAt"`
+}
+
+type ConfigRollout struct {
+	ID                  int64               `json:"id"`
+	ConfigGroupID       int64               `json:"configGroupId"`
+	Status              ConfigRolloutStatus `json:"status"`
+	PreviousEnv         []byte              `json:"previousEnv"`
+	ResourceIds         []int64             `json:"resourceIds"`
+	CompletedResources  int32               `json:"completedResources"`
+	CurrentDeploymentID pgtype.Int8         `json:"currentDeploymentId"`
+	Error               string              `json:"error"`
+	CreatedBy           pgtype.Int8         `json:"createdBy"`
+	CreatedAt           pgtype.Timestamptz  `json:"createdAt"`
+	UpdatedAt           pgtype.Timestamptz  `json:"updatedAt"`
+	CompletedAt         pgtype.Timestamptz  `json:"completedAt"`
+}
+
 type Deployment struct {
+	ID                int64              `json:"id"`
+	ResourceID        int64              `json:"resourceId"`
+	ResourceRegionID  int64              `json:"resourceRegionId"`
+	ClusterID         int64              `json:"clusterId"`
+	Region            string             `json:"region"`
+	Replicas          int32              `json:"replicas"`
+	Status            DeploymentStatus   `json:"status"`
+	IsActive          bool               `json:"isActive"`
+	Message           string             `json:"message"`
+	Spec              []byte             `json:"spec"`
+	SpecVersion       int32              `json:"specVersion"`
+	CreatedAt         pgtype.Timestamptz `json:"createdAt"`
+	StartedAt         pgtype.Timestamptz `json:"startedAt"`
+	CompletedAt       pgtype.Timestamptz `json:"completedAt"`
+	UpdatedAt         pgtype.Timestamptz `json:"updatedAt"`
+	Drift             []byte             `json:"drift"`
+	DriftDetectedAt   pgtype.Timestamptz `json:"driftDetectedAt"`
+	Version           int64              `json:"version"`
+	AnnotationMessage string             `json:"annotationMessage"`
+	AnnotationAuthor  string             `json:"annotationAuthor"`
+	AnnotationGitSha  string             `json:"annotationGitSha"`
+	CreatedBy         pgtype.Int8        `json:"createdBy"`
+}
+
+type DeploymentProvenance struct {
+	DeploymentID  int64              `json:"deploymentId"`
+	Image         string             `json:"image"`
+	CommitSha     string             `json:"commitSha"`
+	RepositoryUrl string             `json:"repositoryUrl"`
+	Error         pgtype.Text        `json:"error"`
+	FetchedAt     pgtype.Timestamptz `json:"fetchedAt"`
+}
+
+type DeprecatedUsage struct {
+	Surface      string             `json:"surface"`
+	Client       string             `json:"client"`
+	UserAgent    string             `json:"userAgent"`
+	RequestCount int64              `json:"requestCount"`
+	FirstSeenAt  pgtype.Timestamptz `json:"firstSeenAt"`
+	LastSeenAt   pgtype.Timestamptz `json:"lastSeenAt"`
+}
+
+type DirectoryGroupRole struct {
+	OrgID     int64         `json:"orgId"`
+	GroupName string        `json:"groupName"`
+	Scopes    []EntityScope `json:"scopes"`
+}
+
+type DnsRecord struct {
 	ID               int64              `json:"id"`
-	ResourceID       int64              `json:"resourceId"`
-	ResourceRegionID int64              `json:"resourceRegionId"`
-	ClusterID        int64              `json:"clusterId"`
-	Region           string             `json:"region"`
-	Replicas         int32              `json:"replicas"`
-	Status           DeploymentStatus   `json:"status"`
-	IsActive         bool               `json:"isActive"`
-	Message          string             `json:"message"`
-	Spec             []byte             `json:"spec"`
-	SpecVersion      int32              `json:"specVersion"`
+	Hostname         string             `json:"hostname"`
+	RecordType       string             `json:"recordType"`
+	Target           string             `json:"target"`
+	ProviderRecordID string             `json:"providerRecordId"`
+	Status           string             `json:"status"`
+	LastError        string             `json:"lastError"`
+	SyncedAt         pgtype.Timestamptz `json:"syncedAt"`
 	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
-	StartedAt        pgtype.Timestamptz `json:"startedAt"`
-	CompletedAt      pgtype.Timestamptz `json:"completedAt"`
 	UpdatedAt        pgtype.Timestamptz `json:"updatedAt"`
 }
 
-type Organization struct {
-	ID        int64              `json:"id"`
-	Name      string             `json:"name"`
-	CreatedBy int64              `json:"createdBy"`
+type EmailToken struct {
+	TokenHash string             `json:"tokenHash"`
+	UserID    int64              `json:"userId"`
+	Purpose   string             `json:"purpose"`
+	ExpiresAt pgtype.Timestamptz `json:"expiresAt"`
 	CreatedAt pgtype.Timestamptz `json:"createdAt"`
-	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type Environment struct {
+	ID          int64              `json:"id"`
+	WorkspaceID int64              `json:"workspaceId"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Env         []byte             `json:"env"`
+	CreatedBy   pgtype.Int8        `json:"createdBy"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type GitopsApplication struct {
+	ResourceID   int64              `json:"resourceId"`
+	Repo         string             `json:"repo"`
+	Path         string             `json:"path"`
+	CommitSha    string             `json:"commitSha"`
+	SpecHash     string             `json:"specHash"`
+	DeploymentID pgtype.Int8        `json:"deploymentId"`
+	AppliedAt    pgtype.Timestamptz `json:"appliedAt"`
+}
+
+type ImageScan struct {
+	Image     string             `json:"image"`
+	Critical  int32              `json:"critical"`
+	High      int32              `json:"high"`
+	Medium    int32              `json:"medium"`
+	Low       int32              `json:"low"`
+	Findings  []byte             `json:"findings"`
+	Error     pgtype.Text        `json:"error"`
+	ScannedAt pgtype.Timestamptz `json:"scannedAt"`
+}
+
+type Notification struct {
+	ID          int64              `json:"id"`
+	UserID      int64              `json:"userId"`
+	DedupeKey   string             `json:"dedupeKey"`
+	Event       NotificationEvent  `json:"event"`
+	WorkspaceID pgtype.Int8        `json:"workspaceId"`
+	Subject     string             `json:"subject"`
+	Body        string             `json:"body"`
+	Data        []byte             `json:"data"`
+	ReadAt      pgtype.Timestamptz `json:"readAt"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+}
+
+type NotificationOutbox struct {
+	ID            int64               `json:"id"`
+	DedupeKey     string              `json:"dedupeKey"`
+	UserID        pgtype.Int8         `json:"userId"`
+	Event         NotificationEvent   `json:"event"`
+	Channel       NotificationChannel `json:"channel"`
+	Target        string              `json:"target"`
+	Subject       string              `json:"subject"`
+	Body          string              `json:"body"`
+	Payload       []byte              `json:"payload"`
+	Attempts      int32               `json:"attempts"`
+	LastError     string              `json:"lastError"`
+	NextAttemptAt pgtype.Timestamptz  `json:"nextAttemptAt"`
+	SentAt        pgtype.Timestamptz  `json:"sentAt"`
+	CreatedAt     pgtype.Timestamptz  `json:"createdAt"`
+}
+
+type OrgBilling struct {
+	OrgID                int64              `json:"orgId"`
+	StripeCustomerID     string             `json:"stripeCustomerId"`
+	PlanID               pgtype.Int8        `json:"planId"`
+	StripeSubscriptionID pgtype.Text        `json:"stripeSubscriptionId"`
+	SubscriptionStatus   SubscriptionStatus `json:"subscriptionStatus"`
+	CurrentPeriodEnd     pgtype.Timestamptz `json:"currentPeriodEnd"`
+	Delinquent           bool               `json:"delinquent"`
+	CreatedAt            pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt            pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type OrgDeletion struct {
+	ID               int64              `json:"id"`
+	OrgID            int64              `json:"orgId"`
+	OrgName          string             `json:"orgName"`
+	Status           OrgDeletionStatus  `json:"status"`
+	TotalResources   int32              `json:"totalResources"`
+	DeletedResources int32              `json:"deletedResources"`
+	Error            string             `json:"error"`
+	RequestedByType  EntityType         `json:"requestedByType"`
+	RequestedByID    int64              `json:"requestedById"`
+	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamptz `json:"updatedAt"`
+	CompletedAt      pgtype.Timestamptz `json:"completedAt"`
+}
+
+type Organization struct {
+	ID                 int64              `json:"id"`
+	Name               string             `json:"name"`
+	CreatedBy          int64              `json:"createdBy"`
+	CreatedAt          pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt          pgtype.Timestamptz `json:"updatedAt"`
+	DeletionProtection bool               `json:"deletionProtection"`
+	RequireMfa         bool               `json:"requireMfa"`
 }
 
 type OrganizationMember struct {
@@ -378,19 +1195,58 @@ type PlatformDomain struct {
 	CreatedAt pgtype.Timestamptz `json:"createdAt"`
 }
 
-type Resource struct {
+type Policy struct {
 	ID          int64              `json:"id"`
-	WorkspaceID int64              `json:"workspaceId"`
+	OrgID       int64              `json:"orgId"`
+	WorkspaceID pgtype.Int8        `json:"workspaceId"`
 	Name        string             `json:"name"`
-	Type        ResourceType       `json:"type"`
 	Description string             `json:"description"`
-	Status      ResourceStatus     `json:"status"`
-	Spec        []byte             `json:"spec"`
-	SpecVersion int32              `json:"specVersion"`
+	Rule        []byte             `json:"rule"`
+	Enabled     bool               `json:"enabled"`
+	CreatedBy   pgtype.Int8        `json:"createdBy"`
 	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
 	UpdatedAt   pgtype.Timestamptz `json:"updatedAt"`
 }
 
+type RefreshToken struct {
+	TokenHash   string             `json:"tokenHash"`
+	FamilyID    string             `json:"familyId"`
+	UserID      int64              `json:"userId"`
+	AccessToken pgtype.Text        `json:"accessToken"`
+	ExpiresAt   pgtype.Timestamptz `json:"expiresAt"`
+	UsedAt      pgtype.Timestamptz `json:"usedAt"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	MfaVerified bool               `json:"mfaVerified"`
+}
+
+type Resource struct {
+	ID                 int64              `json:"id"`
+	WorkspaceID        int64              `json:"workspaceId"`
+	Name               string             `json:"name"`
+	Type               ResourceType       `json:"type"`
+	Description        string             `json:"description"`
+	Status             ResourceStatus     `json:"status"`
+	Spec               []byte             `json:"spec"`
+	SpecVersion        int32              `json:"specVersion"`
+	CreatedAt          pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt          pgtype.Timestamptz `json:"updatedAt"`
+	MaintenanceMessage pgtype.Text        `json:"maintenanceMessage"`
+	EnvironmentID      pgtype.Int8        `json:"environmentId"`
+	ExternalID         pgtype.Text        `json:"externalId"`
+	Labels             []byte             `json:"labels"`
+	SuspendedByArchive bool               `json:"suspendedByArchive"`
+	Version            int64              `json:"version"`
+}
+
+type ResourceActivity struct {
+	ID         int64                `json:"id"`
+	ResourceID int64                `json:"resourceId"`
+	Kind       ResourceActivityKind `json:"kind"`
+	Summary    string               `json:"summary"`
+	ActorID    pgtype.Int8          `json:"actorId"`
+	CreatedAt  pgtype.Timestamptz   `json:"createdAt"`
+}
+
 type ResourceDomain struct {
 	ID               int64              `json:"id"`
 	ResourceID       int64              `json:"resourceId"`
@@ -401,26 +1257,155 @@ type ResourceDomain struct {
 	IsPrimary        bool               `json:"isPrimary"`
 	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
 	UpdatedAt        pgtype.Timestamptz `json:"updatedAt"`
+	AccessControl    []byte             `json:"accessControl"`
+	Status           DomainStatus       `json:"status"`
+	StatusMessage    string             `json:"statusMessage"`
+	StatusCheckedAt  pgtype.Timestamptz `json:"statusCheckedAt"`
+	Redirect         []byte             `json:"redirect"`
+	Tls              []byte             `json:"tls"`
+}
+
+type ResourceLock struct {
+	ResourceID   int64              `json:"resourceId"`
+	Reason       string             `json:"reason"`
+	LockedByType EntityType         `json:"lockedByType"`
+	LockedByID   int64              `json:"lockedById"`
+	CreatedAt    pgtype.Timestamptz `json:"createdAt"`
+}
+
+type ResourceProbe struct {
+	ID         int64              `json:"id"`
+	ResourceID int64              `json:"resourceId"`
+	Url        string             `json:"url"`
+	Ok         bool               `json:"ok"`
+	StatusCode int32              `json:"statusCode"`
+	LatencyMs  int32              `json:"latencyMs"`
+	Error      string             `json:"error"`
+	CheckedAt  pgtype.Timestamptz `json:"checkedAt"`
+}
+
+type ResourceProbeState struct {
+	ResourceID          int64              `json:"resourceId"`
+	ConsecutiveFailures int32              `json:"consecutiveFailures"`
+	NextProbeAt         pgtype.Timestamptz `json:"nextProbeAt"`
 }
 
 type ResourceRegion struct {
+	ID               int64              `json:"id"`
+	ResourceID       int64              `json:"resourceId"`
+	Region           string             `json:"region"`
+	IsPrimary        bool               `json:"isPrimary"`
+	Status           RegionIntentStatus `json:"status"`
+	LastError        pgtype.Text        `json:"lastError"`
+	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamptz `json:"updatedAt"`
+	FailoverPriority int32              `json:"failoverPriority"`
+}
+
+type ResourceSleep struct {
+	ResourceID int64              `json:"resourceId"`
+	AsleepAt   pgtype.Timestamptz `json:"asleepAt"`
+	WokeAt     pgtype.Timestamptz `json:"wokeAt"`
+}
+
+type ResourceStatusHistory struct {
 	ID         int64              `json:"id"`
 	ResourceID int64              `json:"resourceId"`
-	Region     string             `json:"region"`
-	IsPrimary  bool               `json:"isPrimary"`
-	Status     RegionIntentStatus `json:"status"`
-	LastError  pgtype.Text        `json:"lastError"`
-	CreatedAt  pgtype.Timestamptz `json:"createdAt"`
-	UpdatedAt  pgtype.Timestamptz `json:"updatedAt"`
+	Status     ResourceStatus     `json:"status"`
+	StartedAt  pgtype.Timestamptz `json:"startedAt"`
+}
+
+type ScimGroup struct {
+	ID          int64              `json:"id"`
+	OrgID       int64              `json:"orgId"`
+	DisplayName string             `json:"displayName"`
+	ExternalID  pgtype.Text        `json:"externalId"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type ScimGroupMember struct {
+	GroupID    int64 `json:"groupId"`
+	ScimUserID int64 `json:"scimUserId"`
+}
+
+type ScimUser struct {
+	ID            int64              `json:"id"`
+	OrgID         int64              `json:"orgId"`
+	UserID        int64              `json:"userId"`
+	ExternalID    pgtype.Text        `json:"externalId"`
+	UserName      string             `json:"userName"`
+	Active        bool               `json:"active"`
+	GrantedScopes []EntityScope      `json:"grantedScopes"`
+	CreatedAt     pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt     pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type ServiceAccount struct {
+	ID          int64              `json:"id"`
+	WorkspaceID int64              `json:"workspaceId"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	CreatedBy   pgtype.Int8        `json:"createdBy"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type ServiceAccountScope struct {
+	ServiceAccountID int64      `json:"serviceAccountId"`
+	Scope            Scope      `json:"scope"`
+	EntityType       EntityType `json:"entityType"`
+	EntityID         int64      `json:"entityId"`
+}
+
+type SlackInstallation struct {
+	OrgID       int64              `json:"orgId"`
+	TeamID      string             `json:"teamId"`
+	TeamName    string             `json:"teamName"`
+	BotToken    string             `json:"botToken"`
+	InstalledBy pgtype.Int8        `json:"installedBy"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type StatusPage struct {
+	ResourceID  int64              `json:"resourceId"`
+	Enabled     bool               `json:"enabled"`
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	LogoUrl     string             `json:"logoUrl"`
+	AccentColor string             `json:"accentColor"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamptz `json:"updatedAt"`
 }
 
 type Token struct {
-	Name       string        `json:"name"`
-	Token      string        `json:"token"`
-	Scopes     []EntityScope `json:"scopes"`
-	EntityType EntityType    `json:"entityType"`
-	EntityID   int64         `json:"entityId"`
-	ExpiresAt  time.Time     `json:"expiresAt"`
+	Name           string        `json:"name"`
+	Token          string        `json:"token"`
+	Scopes         []EntityScope `json:"scopes"`
+	EntityType     EntityType    `json:"entityType"`
+	EntityID       int64         `json:"entityId"`
+	ExpiresAt      time.Time     `json:"expiresAt"`
+	ParentToken    pgtype.Text   `json:"parentToken"`
+	ImpersonatorID pgtype.Int8   `json:"impersonatorId"`
+	MfaPending     bool          `json:"mfaPending"`
+}
+
+type UsageRecord struct {
+	ID            int64              `json:"id"`
+	OrgID         int64              `json:"orgId"`
+	ResourceID    pgtype.Int8        `json:"resourceId"`
+	DeploymentID  int64              `json:"deploymentId"`
+	Region        string             `json:"region"`
+	Hour          pgtype.Timestamptz `json:"hour"`
+	Replicas      int32              `json:"replicas"`
+	CpuMillicores int64              `json:"cpuMillicores"`
+	MemoryBytes   int64              `json:"memoryBytes"`
+	CostMicros    int64              `json:"costMicros"`
+	Currency      string             `json:"currency"`
+	Billable      bool               `json:"billable"`
+	ReportedAt    pgtype.Timestamptz `json:"reportedAt"`
+	CreatedAt     pgtype.Timestamptz `json:"createdAt"`
 }
 
 type User struct {
@@ -433,6 +1418,21 @@ type User struct {
 	UpdatedAt  pgtype.Timestamptz `json:"updatedAt"`
 }
 
+type UserNotificationPreference struct {
+	UserID     int64              `json:"userId"`
+	Event      NotificationEvent  `json:"event"`
+	Email      bool               `json:"email"`
+	WebhookUrl string             `json:"webhookUrl"`
+	UpdatedAt  pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type UserPassword struct {
+	UserID       int64              `json:"userId"`
+	PasswordHash string             `json:"passwordHash"`
+	VerifiedAt   pgtype.Timestamptz `json:"verifiedAt"`
+	UpdatedAt    pgtype.Timestamptz `json:"updatedAt"`
+}
+
 type UserScope struct {
 	UserID     int64      `json:"userId"`
 	Scope      Scope      `json:"scope"`
@@ -440,6 +1440,14 @@ type UserScope struct {
 	EntityID   int64      `json:"entityId"`
 }
 
+type UserTotp struct {
+	UserID    int64              `json:"userId"`
+	Secret    []byte             `json:"secret"`
+	EnabledAt pgtype.Timestamptz `json:"enabledAt"`
+	LastStep  int64              `json:"lastStep"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+}
+
 type UserWithScopesView struct {
 	ID         int64              `json:"id"`
 	ExternalID string             `json:"externalId"`
@@ -452,12 +1460,33 @@ type UserWithScopesView struct {
 }
 
 type Workspace struct {
-	ID          int64              `json:"id"`
-	OrgID       int64              `json:"orgId"`
-	Name        string             `json:"name"`
-	Description pgtype.Text        `json:"description"`
-	CreatedBy   int64              `json:"createdBy"`
-	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	ID                           int64              `json:"id"`
+	OrgID                        int64              `json:"orgId"`
+	Name                         string             `json:"name"`
+	Description                  pgtype.Text        `json:"description"`
+	CreatedBy                    int64              `json:"createdBy"`
+	CreatedAt                    pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt                    pgtype.Timestamptz `json:"updatedAt"`
+	DeploymentHistoryLimit       int32              `json:"deploymentHistoryLimit"`
+	ExternalID                   pgtype.Text        `json:"externalId"`
+	Labels                       []byte             `json:"labels"`
+	ArchivedAt                   pgtype.Timestamptz `json:"archivedAt"`
+	BlockCriticalVulnerabilities bool               `json:"blockCriticalVulnerabilities"`
+}
+
+type WorkspaceDigest struct {
+	WorkspaceID       int64              `json:"workspaceId"`
+	WeekStart         pgtype.Timestamptz `json:"weekStart"`
+	Deployments       int32              `json:"deployments"`
+	FailedDeployments int32              `json:"failedDeployments"`
+	TopResources      []byte             `json:"topResources"`
+	CreatedAt         pgtype.Timestamptz `json:"createdAt"`
+}
+
+type WorkspaceEnv struct {
+	WorkspaceID int64              `json:"workspaceId"`
+	Env         []byte             `json:"env"`
+	UpdatedBy   pgtype.Int8        `json:"updatedBy"`
 	UpdatedAt   pgtype.Timestamptz `json:"updatedAt"`
 }
 
@@ -467,3 +1496,20 @@ type WorkspaceMember struct {
 	Role        WorkspaceRole      `json:"role"`
 	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
 }
+
+type WorkspaceNotificationDefault struct {
+	WorkspaceID int64              `json:"workspaceId"`
+	Event       NotificationEvent  `json:"event"`
+	Email       bool               `json:"email"`
+	UpdatedAt   pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type WorkspaceSlackChannel struct {
+	WorkspaceID int64              `json:"workspaceId"`
+	ChannelID   string             `json:"channelId"`
+	ChannelName string             `json:"channelName"`
+	Deployments bool               `json:"deployments"`
+	Alerts      bool               `json:"alerts"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamptz `json:"updatedAt"`
+}
@@ -29,7 +29,7 @@ func (q *Queries) AddOrgMember(ctx context.Context, arg AddOrgMemberParams) erro
 const createOrg = `-- name: CreateOrg :one
 INSERT INTO organizations (name, created_by)
 VALUES ($1, $2)
-RETURNING id, name, created_by, created_at, updated_at
+RETURNING id, name, created_by, created_at, updated_at, deletion_protection, require_mfa
 `
 
 type CreateOrgParams struct {
@@ -46,6 +46,8 @@ func (q *Queries) CreateOrg(ctx context.Context, arg CreateOrgParams) (Organizat
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletionProtection,
+		&i.RequireMfa,
 	)
 	return i, err
 }
@@ -73,7 +75,7 @@ func (q *Queries) DeleteOrg(ctx context.Context, id int64) error {
 }
 
 const getOrgByID = `-- name: GetOrgByID :one
-SELECT id, name, created_by, created_at, updated_at FROM organizations WHERE id = $1
+SELECT id, name, created_by, created_at, updated_at, deletion_protection, require_mfa FROM organizations WHERE id = $1
 `
 
 func (q *Queries) GetOrgByID(ctx context.Context, id int64) (Organization, error) {
@@ -85,12 +87,14 @@ func (q *Queries) GetOrgByID(ctx context.Context, id int64) (Organization, error
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletionProtection,
+		&i.RequireMfa,
 	)
 	return i, err
 }
 
 const getOrgByName = `-- name: GetOrgByName :one
-SELECT id, name, created_by, created_at, updated_at FROM organizations WHERE name = $1
+SELECT id, name, created_by, created_at, updated_at, deletion_protection, require_mfa FROM organizations WHERE name = $1
 `
 
 func (q *Queries) GetOrgByName(ctx context.Context, name string) (Organization, error) {
@@ -102,6 +106,8 @@ func (q *Queries) GetOrgByName(ctx context.Context, name string) (Organization,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletionProtection,
+		&i.RequireMfa,
 	)
 	return i, err
 }
@@ -139,7 +145,7 @@ func (q *Queries) IsOrgNameUnique(ctx context.Context, name string) (bool, error
 }
 
 const listOrgsForUser = `-- name: ListOrgsForUser :many
-SELECT DISTINCT o.id, o.name, o.created_by, o.created_at, o.updated_at
+SELECT DISTINCT o.id, o.name, o.created_by, o.created_at, o.updated_at, o.deletion_protection, o.require_mfa
 FROM organizations o
 JOIN organization_members om ON om.organization_id = o.id
 WHERE om.user_id = $1
@@ -173,6 +179,8 @@ func (q *Queries) ListOrgsForUser(ctx context.Context, arg ListOrgsForUserParams
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DeletionProtection,
+			&i.RequireMfa,
 		); err != nil {
 			return nil, err
 		}
@@ -256,7 +264,7 @@ const updateOrgName = `-- name: UpdateOrgName :one
 UPDATE organizations
 SET name = $2, updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, created_by, created_at, updated_at
+RETURNING id, name, created_by, created_at, updated_at, deletion_protection, require_mfa
 `
 
 type UpdateOrgNameParams struct {
@@ -273,6 +281,8 @@ func (q *Queries) UpdateOrgName(ctx context.Context, arg UpdateOrgNameParams) (O
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletionProtection,
+		&i.RequireMfa,
 	)
 	return i, err
 }
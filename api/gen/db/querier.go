@@ -14,131 +14,511 @@ type Querier interface {
 	AddOrgMember(ctx context.Context, arg AddOrgMemberParams) error
 	// Organization members queries
 	AddOrganizationMember(ctx context.Context, arg AddOrganizationMemberParams) (AddOrganizationMemberRow, error)
+	AddScimGroupMember(ctx context.Context, arg AddScimGroupMemberParams) error
+	AddServiceAccountScope(ctx context.Context, arg AddServiceAccountScopeParams) error
 	AddUserScope(ctx context.Context, arg AddUserScopeParams) error
 	// Workspace members queries
 	AddWorkspaceMember(ctx context.Context, arg AddWorkspaceMemberParams) (AddWorkspaceMemberRow, error)
+	ArchiveWorkspace(ctx context.Context, id int64) (Workspace, error)
+	AttachConfigGroup(ctx context.Context, arg AttachConfigGroupParams) error
+	CancelApprovalRequest(ctx context.Context, id int64) (ApprovalRequest, error)
 	CheckDomainAvailability(ctx context.Context, domain string) (bool, error)
 	CheckUserHasOrganizations(ctx context.Context, createdBy int64) (bool, error)
 	CheckUserHasWorkspaces(ctx context.Context, userID int64) (bool, error)
+	// takes due rules for this replica by pushing next_evaluation_at an interval out.
+	ClaimAlertRules(ctx context.Context, arg ClaimAlertRulesParams) ([]AlertRule, error)
+	// picks up the oldest pending rollout, or a running one whose worker stopped reporting progress.
+	ClaimConfigRollout(ctx context.Context, staleBefore pgtype.Timestamptz) (ConfigRollout, error)
+	// leases due deliveries to one dispatcher by pushing next_attempt_at past the lease.
+	ClaimNotificationDeliveries(ctx context.Context, arg ClaimNotificationDeliveriesParams) ([]NotificationOutbox, error)
+	// picks up the oldest pending deletion, or a running one whose worker stopped reporting progress.
+	ClaimOrgDeletion(ctx context.Context, staleBefore pgtype.Timestamptz) (OrgDeletion, error)
+	// takes due probes for this replica by pushing next_probe_at an interval out.
+	ClaimProbeTargets(ctx context.Context, arg ClaimProbeTargetsParams) ([]ClaimProbeTargetsRow, error)
+	ClearDeploymentDrift(ctx context.Context, id int64) error
+	ClearPrimaryResourceRegion(ctx context.Context, resourceID int64) error
+	// restores a degraded resource once probes pass again, unless a deployment is what degraded it.
+	ClearResourceProbeDegraded(ctx context.Context, id int64) (int64, error)
+	CompleteConfigRollout(ctx context.Context, id int64) error
+	CompleteOrgDeletion(ctx context.Context, id int64) error
+	// deletes an unexpired token, returning the user it was sent to.
+	ConsumeEmailToken(ctx context.Context, arg ConsumeEmailTokenParams) (int64, error)
+	CountEnvironmentResources(ctx context.Context, environmentID int64) (int64, error)
+	CountScimGroups(ctx context.Context, arg CountScimGroupsParams) (int64, error)
+	CountScimUsers(ctx context.Context, arg CountScimUsersParams) (int64, error)
+	CountUnreadAnnouncements(ctx context.Context, afterID int64) (int64, error)
+	CountUnreadNotifications(ctx context.Context, userID int64) (int64, error)
+	CreateAlertRule(ctx context.Context, arg CreateAlertRuleParams) (AlertRule, error)
+	CreateAlertTarget(ctx context.Context, arg CreateAlertTargetParams) error
+	CreateAnnouncement(ctx context.Context, arg CreateAnnouncementParams) (int64, error)
+	// Two-person approval queries
+	CreateApprovalRequest(ctx context.Context, arg CreateApprovalRequestParams) (ApprovalRequest, error)
+	CreateCluster(ctx context.Context, arg CreateClusterParams) (Cluster, error)
+	CreateClusterNodePool(ctx context.Context, arg CreateClusterNodePoolParams) (ClusterNodePool, error)
+	// Config group queries
+	CreateConfigGroup(ctx context.Context, arg CreateConfigGroupParams) (ConfigGroup, error)
+	CreateConfigRollout(ctx context.Context, arg CreateConfigRolloutParams) (ConfigRollout, error)
 	// Deployment queries
 	CreateDeployment(ctx context.Context, arg CreateDeploymentParams) (int64, error)
+	CreateEmailToken(ctx context.Context, arg CreateEmailTokenParams) error
+	// Environment queries
+	CreateEnvironment(ctx context.Context, arg CreateEnvironmentParams) (Environment, error)
+	CreateInboxNotification(ctx context.Context, arg CreateInboxNotificationParams) error
 	CreateOrg(ctx context.Context, arg CreateOrgParams) (Organization, error)
+	// keeps the customer of an org that already has one, so a lost race returns the winner's row.
+	CreateOrgBilling(ctx context.Context, arg CreateOrgBillingParams) (OrgBilling, error)
+	CreateOrgDeletion(ctx context.Context, arg CreateOrgDeletionParams) (OrgDeletion, error)
 	// Organization queries
-	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organization, error)
+	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (CreateOrganizationRow, error)
 	CreatePlatformDomain(ctx context.Context, arg CreatePlatformDomainParams) (int64, error)
+	CreatePolicy(ctx context.Context, arg CreatePolicyParams) (Policy, error)
+	CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) error
 	// Resource queries
 	CreateResource(ctx context.Context, arg CreateResourceParams) (int64, error)
+	CreateResourceActivity(ctx context.Context, arg CreateResourceActivityParams) error
 	CreateResourceDomain(ctx context.Context, arg CreateResourceDomainParams) (int64, error)
 	CreateResourceRegion(ctx context.Context, arg CreateResourceRegionParams) (ResourceRegion, error)
+	CreateScimGroup(ctx context.Context, arg CreateScimGroupParams) (ScimGroup, error)
+	// Directory sync (SCIM) queries
+	CreateScimUser(ctx context.Context, arg CreateScimUserParams) (ScimUser, error)
+	// Service account queries
+	CreateServiceAccount(ctx context.Context, arg CreateServiceAccountParams) (ServiceAccount, error)
 	// User queries for sqlc
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateUserPassword(ctx context.Context, arg CreateUserPasswordParams) error
 	CreateWorkspace(ctx context.Context, arg CreateWorkspaceParams) (int64, error)
+	// leaves an existing digest alone, so only the replica that stores it sends it.
+	CreateWorkspaceDigest(ctx context.Context, arg CreateWorkspaceDigestParams) (int64, error)
 	DeactivatePlatformDomain(ctx context.Context, id int64) (int64, error)
+	DeleteAlertRule(ctx context.Context, id int64) (int64, error)
+	DeleteAlertTargets(ctx context.Context, ruleID int64) error
+	DeleteAnnouncement(ctx context.Context, id int64) (int64, error)
+	DeleteClusterNodePools(ctx context.Context, clusterID int64) error
+	DeleteConfigGroup(ctx context.Context, id int64) error
+	DeleteDNSRecord(ctx context.Context, id int64) error
+	DeleteDirectoryGroupRoles(ctx context.Context, arg DeleteDirectoryGroupRolesParams) error
+	// invalidates the tokens of a purpose sent to a user, e.g. when a newer one is sent.
+	DeleteEmailTokens(ctx context.Context, arg DeleteEmailTokensParams) error
 	DeleteEmptyWorkspacesForOrg(ctx context.Context, orgID int64) error
+	DeleteEnvironment(ctx context.Context, id int64) error
+	DeleteExpiredRefreshTokens(ctx context.Context) error
 	DeleteExpiredTokens(ctx context.Context) error
 	DeleteOrg(ctx context.Context, id int64) error
 	DeleteOrganization(ctx context.Context, id int64) error
+	DeletePolicy(ctx context.Context, id int64) (int64, error)
+	// ends a session, returning the login tokens issued in it so they can be revoked too.
+	DeleteRefreshTokenFamily(ctx context.Context, familyID string) ([]pgtype.Text, error)
+	// ends the session a login token was issued in, returning the login tokens issued in it.
+	DeleteRefreshTokenFamilyByAccessToken(ctx context.Context, accessToken pgtype.Text) ([]pgtype.Text, error)
+	DeleteRefreshTokensForUser(ctx context.Context, userID int64) error
+	DeleteResolvedClusterDiscrepancies(ctx context.Context, foundResourceIds []int64) (int64, error)
 	DeleteResource(ctx context.Context, id int64) error
 	DeleteResourceDomain(ctx context.Context, id int64) error
+	DeleteResourceProbesBefore(ctx context.Context, before pgtype.Timestamptz) (int64, error)
+	DeleteScimGroup(ctx context.Context, id int64) error
+	DeleteScimUser(ctx context.Context, id int64) error
+	DeleteServiceAccount(ctx context.Context, id int64) error
+	DeleteSlackChannelsForOrg(ctx context.Context, orgID int64) error
+	DeleteSlackInstallation(ctx context.Context, orgID int64) (int64, error)
 	DeleteToken(ctx context.Context, name string) error
 	DeleteTokenByNameAndEntity(ctx context.Context, arg DeleteTokenByNameAndEntityParams) error
 	DeleteTokensForEntity(ctx context.Context, arg DeleteTokensForEntityParams) error
 	DeleteUser(ctx context.Context, id int64) error
+	DeleteUserNotificationPreference(ctx context.Context, arg DeleteUserNotificationPreferenceParams) error
+	DeleteUserTOTP(ctx context.Context, userID int64) error
 	DeleteWorkspace(ctx context.Context, id int64) error
 	DeleteWorkspaceMember(ctx context.Context, arg DeleteWorkspaceMemberParams) error
+	DeleteWorkspaceNotificationDefault(ctx context.Context, arg DeleteWorkspaceNotificationDefaultParams) error
+	DeleteWorkspaceSlackChannel(ctx context.Context, workspaceID int64) (int64, error)
+	DetachConfigGroup(ctx context.Context, arg DetachConfigGroupParams) (int64, error)
+	// unpaid invoices of the ended subscription keep the org delinquent until they are paid.
+	EndOrgSubscription(ctx context.Context, arg EndOrgSubscriptionParams) (int64, error)
+	EnqueueNotification(ctx context.Context, arg EnqueueNotificationParams) error
+	FailConfigRollout(ctx context.Context, arg FailConfigRolloutParams) error
+	FailOrgDeletion(ctx context.Context, arg FailOrgDeletionParams) error
+	// leaves the deployment alone if it finished or failed since it was listed.
+	FailStuckDeployment(ctx context.Context, arg FailStuckDeploymentParams) (int64, error)
 	GetActiveClusterByRegion(ctx context.Context, region string) (Cluster, error)
+	GetActiveConfigRollout(ctx context.Context, configGroupID int64) (ConfigRollout, error)
 	GetActiveDeploymentForResourceAndRegion(ctx context.Context, arg GetActiveDeploymentForResourceAndRegionParams) (Deployment, error)
+	GetAlertRule(ctx context.Context, id int64) (AlertRule, error)
+	GetAlertState(ctx context.Context, arg GetAlertStateParams) (AlertState, error)
+	GetAnnouncementReadMarker(ctx context.Context, userID int64) (int64, error)
+	GetApprovalRequest(ctx context.Context, id int64) (ApprovalRequest, error)
+	GetBillingPlan(ctx context.Context, id int64) (BillingPlan, error)
+	GetCluster(ctx context.Context, id int64) (Cluster, error)
 	GetClusterDetails(ctx context.Context, id int64) (GetClusterDetailsRow, error)
+	GetConfigGroup(ctx context.Context, id int64) (ConfigGroup, error)
+	GetConfigRollout(ctx context.Context, id int64) (ConfigRollout, error)
 	GetDeploymentByID(ctx context.Context, id int64) (Deployment, error)
+	GetDeploymentNotificationContext(ctx context.Context, id int64) (GetDeploymentNotificationContextRow, error)
+	// Deployment provenance queries
+	GetDeploymentProvenance(ctx context.Context, deploymentID int64) (DeploymentProvenance, error)
 	GetDeploymentResourceID(ctx context.Context, id int64) (int64, error)
 	GetDomainByResourceId(ctx context.Context, resourceID int64) (GetDomainByResourceIdRow, error)
+	GetEnvironmentByID(ctx context.Context, id int64) (Environment, error)
+	GetEnvironmentByName(ctx context.Context, arg GetEnvironmentByNameParams) (Environment, error)
+	GetEnvironmentWorkspaceOrganizationID(ctx context.Context, id int64) (GetEnvironmentWorkspaceOrganizationIDRow, error)
 	// todo: eventually remove
 	GetFirstActiveCluster(ctx context.Context) (Cluster, error)
+	// GitOps queries
+	GetGitOpsApplication(ctx context.Context, resourceID int64) (GitopsApplication, error)
+	// Image scan queries
+	GetImageScan(ctx context.Context, image string) (ImageScan, error)
+	GetLatestAnnouncementID(ctx context.Context) (int64, error)
+	GetLatestDeploymentID(ctx context.Context, resourceID int64) (int64, error)
+	GetLatestResourceProbe(ctx context.Context, resourceID int64) (ResourceProbe, error)
+	GetLatestWorkspaceDigest(ctx context.Context, workspaceID int64) (WorkspaceDigest, error)
+	GetOrgBilling(ctx context.Context, orgID int64) (OrgBilling, error)
 	GetOrgByID(ctx context.Context, id int64) (Organization, error)
 	GetOrgByName(ctx context.Context, name string) (Organization, error)
-	GetOrganizationByID(ctx context.Context, id int64) (Organization, error)
-	GetOrganizationByName(ctx context.Context, name string) (Organization, error)
+	GetOrgDeletion(ctx context.Context, id int64) (OrgDeletion, error)
+	GetOrganizationByID(ctx context.Context, id int64) (GetOrganizationByIDRow, error)
+	GetOrganizationByName(ctx context.Context, name string) (GetOrganizationByNameRow, error)
 	GetOrganizationIDByWorkspaceID(ctx context.Context, id int64) (int64, error)
 	GetOrganizationMember(ctx context.Context, arg GetOrganizationMemberParams) (GetOrganizationMemberRow, error)
+	GetPendingApprovalRequest(ctx context.Context, arg GetPendingApprovalRequestParams) (ApprovalRequest, error)
 	GetPlatformDomain(ctx context.Context, id int64) (PlatformDomain, error)
 	GetPlatformDomainByName(ctx context.Context, domain string) (PlatformDomain, error)
+	GetPolicy(ctx context.Context, id int64) (Policy, error)
+	GetPreviousDeploymentInRegion(ctx context.Context, arg GetPreviousDeploymentInRegionParams) (Deployment, error)
+	GetPrimaryDomainForResource(ctx context.Context, resourceID int64) (string, error)
+	GetResourceByExternalID(ctx context.Context, arg GetResourceByExternalIDParams) (Resource, error)
 	GetResourceByID(ctx context.Context, id int64) (Resource, error)
 	GetResourceByNameAndWorkspace(ctx context.Context, arg GetResourceByNameAndWorkspaceParams) (Resource, error)
+	// used to find the counterpart of a resource when promoting between environments.
+	GetResourceByNameInEnvironment(ctx context.Context, arg GetResourceByNameInEnvironmentParams) (Resource, error)
 	GetResourceDomainByID(ctx context.Context, id int64) (ResourceDomain, error)
+	GetResourceDomainByName(ctx context.Context, domain string) (ResourceDomain, error)
 	GetResourceDomainCount(ctx context.Context, resourceID int64) (int64, error)
+	// the ingress endpoint of the cluster serving the resource's primary region.
+	GetResourceIngressEndpoint(ctx context.Context, resourceID int64) (pgtype.Text, error)
+	GetResourceLock(ctx context.Context, resourceID int64) (ResourceLock, error)
+	// empty for orgs without a plan.
+	GetResourcePlanName(ctx context.Context, id int64) (string, error)
+	GetResourceProbeLatencyP95(ctx context.Context, arg GetResourceProbeLatencyP95Params) (GetResourceProbeLatencyP95Row, error)
+	GetResourceProbeStats(ctx context.Context, arg GetResourceProbeStatsParams) (GetResourceProbeStatsRow, error)
+	// what protects resource x from being deleted?
+	GetResourceProtection(ctx context.Context, id int64) (GetResourceProtectionRow, error)
 	GetResourceRegionByResourceAndRegion(ctx context.Context, arg GetResourceRegionByResourceAndRegionParams) (ResourceRegion, error)
+	GetResourceSleepByDomain(ctx context.Context, domain string) (GetResourceSleepByDomainRow, error)
 	GetResourceWorkspaceID(ctx context.Context, id int64) (int64, error)
+	GetScimGroup(ctx context.Context, arg GetScimGroupParams) (ScimGroup, error)
+	GetScimUser(ctx context.Context, arg GetScimUserParams) (ScimUser, error)
+	GetServiceAccountByID(ctx context.Context, id int64) (ServiceAccount, error)
+	// what scopes does service account x have?
+	GetServiceAccountScopes(ctx context.Context, serviceAccountID int64) ([]EntityScope, error)
+	GetServiceAccountWorkspaceOrganizationID(ctx context.Context, id int64) (GetServiceAccountWorkspaceOrganizationIDRow, error)
+	// any installation of the team will do: they all act as the same bot.
+	GetSlackBotToken(ctx context.Context, teamID string) (string, error)
+	GetSlackInstallation(ctx context.Context, orgID int64) (SlackInstallation, error)
+	GetStatusPage(ctx context.Context, resourceID int64) (StatusPage, error)
+	// finds the enabled status page of the resource whose primary domain is given.
+	GetStatusPageByDomain(ctx context.Context, domain string) (GetStatusPageByDomainRow, error)
 	GetToken(ctx context.Context, token string) (Token, error)
 	GetTokenByName(ctx context.Context, arg GetTokenByNameParams) (GetTokenByNameRow, error)
+	// finds the session of a refresh token that was already used, to revoke it when the token is replayed.
+	GetUsedRefreshTokenFamily(ctx context.Context, tokenHash string) (string, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByExternalID(ctx context.Context, externalID string) (User, error)
 	GetUserByID(ctx context.Context, id int64) (User, error)
+	GetUserPassword(ctx context.Context, userID int64) (UserPassword, error)
 	// what scopes does user x have?
 	GetUserScopes(ctx context.Context, userID int64) ([]EntityScope, error)
 	// what scopes does user x have on entity y?
 	GetUserScopesOnEntity(ctx context.Context, arg GetUserScopesOnEntityParams) ([]EntityScope, error)
 	GetUserScopesOnOrganization(ctx context.Context, arg GetUserScopesOnOrganizationParams) ([]EntityScope, error)
 	GetUserScopesOnWorkspace(ctx context.Context, arg GetUserScopesOnWorkspaceParams) ([]EntityScope, error)
+	GetUserTOTP(ctx context.Context, userID int64) (UserTotp, error)
 	GetUserWithScopesByEmail(ctx context.Context, email string) (UserWithScopesView, error)
 	// what users have scope z on entity y?
 	GetUsersWithScopeOnEntity(ctx context.Context, arg GetUsersWithScopeOnEntityParams) ([]int64, error)
+	GetWorkspaceByExternalID(ctx context.Context, arg GetWorkspaceByExternalIDParams) (Workspace, error)
 	GetWorkspaceByIDQuery(ctx context.Context, id int64) (Workspace, error)
+	// deployments started in a workspace over [since, until), and how many of them failed.
+	GetWorkspaceDeploymentCounts(ctx context.Context, arg GetWorkspaceDeploymentCountsParams) (GetWorkspaceDeploymentCountsRow, error)
+	GetWorkspaceDigest(ctx context.Context, arg GetWorkspaceDigestParams) (WorkspaceDigest, error)
+	// Workspace env queries
+	GetWorkspaceEnv(ctx context.Context, workspaceID int64) (WorkspaceEnv, error)
 	GetWorkspaceMember(ctx context.Context, arg GetWorkspaceMemberParams) (GetWorkspaceMemberRow, error)
 	GetWorkspaceMemberRole(ctx context.Context, arg GetWorkspaceMemberRoleParams) (WorkspaceRole, error)
 	GetWorkspaceMembers(ctx context.Context, workspaceID int64) ([]WorkspaceMember, error)
 	GetWorkspaceOrgID(ctx context.Context, id int64) (int64, error)
 	GetWorkspaceOrganizationIDByResourceID(ctx context.Context, id int64) (GetWorkspaceOrganizationIDByResourceIDRow, error)
+	// what protects workspace x from being deleted?
+	GetWorkspaceProtection(ctx context.Context, id int64) (GetWorkspaceProtectionRow, error)
+	GetWorkspaceSlackChannel(ctx context.Context, workspaceID int64) (WorkspaceSlackChannel, error)
+	// where a workspace's messages go, when its org has Slack installed and it has a channel.
+	GetWorkspaceSlackTarget(ctx context.Context, workspaceID int64) (GetWorkspaceSlackTargetRow, error)
+	// Platform admin queries
+	InsertAdminAuditEntry(ctx context.Context, arg InsertAdminAuditEntryParams) (AdminAuditLog, error)
 	IsOrgMember(ctx context.Context, arg IsOrgMemberParams) (bool, error)
 	IsOrgNameUnique(ctx context.Context, name string) (bool, error)
 	IsOrganizationNameUnique(ctx context.Context, name string) (bool, error)
+	// false for orgs that never set up billing.
+	IsResourceOrgDelinquent(ctx context.Context, id int64) (bool, error)
+	IsResourceWorkspaceArchived(ctx context.Context, id int64) (bool, error)
 	IsWorkspaceMember(ctx context.Context, arg IsWorkspaceMemberParams) (bool, error)
 	IsWorkspaceNameUniqueInOrg(ctx context.Context, arg IsWorkspaceNameUniqueInOrgParams) (bool, error)
 	ListActiveDeployments(ctx context.Context) ([]int64, error)
 	ListActiveDeploymentsByResourceID(ctx context.Context, resourceID int64) ([]DeploymentStatus, error)
+	ListActiveDeploymentsForDrift(ctx context.Context) ([]ListActiveDeploymentsForDriftRow, error)
+	// Cluster reconciliation queries
+	// newest first, so the first deployment seen for a resource is the one to recreate when the region is unknown.
+	ListActiveDeploymentsForReconcile(ctx context.Context) ([]ListActiveDeploymentsForReconcileRow, error)
 	ListActiveDeploymentsForResource(ctx context.Context, resourceID int64) ([]Deployment, error)
 	ListActivePlatformDomains(ctx context.Context) ([]PlatformDomain, error)
+	ListAdminAuditEntries(ctx context.Context, arg ListAdminAuditEntriesParams) ([]AdminAuditLog, error)
+	// the services a rule watches: its resource, or every service in its workspace.
+	ListAlertRuleResources(ctx context.Context, arg ListAlertRuleResourcesParams) ([]ListAlertRuleResourcesRow, error)
+	ListAlertRules(ctx context.Context, workspaceID int64) ([]AlertRule, error)
+	ListAlertTargets(ctx context.Context, ruleIds []int64) ([]AlertTarget, error)
 	ListAllLocoOwnedDomains(ctx context.Context) ([]ListAllLocoOwnedDomainsRow, error)
+	// every org on the platform, newest first, optionally those whose name contains query.
+	ListAllOrgs(ctx context.Context, arg ListAllOrgsParams) ([]Organization, error)
+	// lists every notification of a user, oldest first, for their data export.
+	ListAllUserNotifications(ctx context.Context, userID int64) ([]Notification, error)
+	// lists unexpired announcements newest first. after_id limits results to
+	// announcements newer than a user's read marker.
+	ListAnnouncements(ctx context.Context, arg ListAnnouncementsParams) ([]Announcement, error)
+	ListApprovalRequestsForWorkspace(ctx context.Context, arg ListApprovalRequestsForWorkspaceParams) ([]ApprovalRequest, error)
+	ListBillingPlans(ctx context.Context) ([]BillingPlan, error)
+	ListClusterDiscrepancies(ctx context.Context, workspaceID pgtype.Int8) ([]ClusterDiscrepancy, error)
+	ListClusterNodePools(ctx context.Context, clusterID int64) ([]ClusterNodePool, error)
+	ListClusters(ctx context.Context) ([]Cluster, error)
 	ListClustersActive(ctx context.Context) ([]Cluster, error)
+	ListConfigGroupResourceIDs(ctx context.Context, configGroupID int64) ([]int64, error)
+	ListConfigGroupsForWorkspace(ctx context.Context, workspaceID int64) ([]ConfigGroup, error)
+	ListDNSRecords(ctx context.Context) ([]DnsRecord, error)
+	// newest first unless oldest_first; each filter left NULL matches every deployment.
 	ListDeploymentsForResource(ctx context.Context, arg ListDeploymentsForResourceParams) ([]Deployment, error)
+	ListDeprecatedUsage(ctx context.Context) ([]DeprecatedUsage, error)
+	ListDirectoryGroupRoles(ctx context.Context, orgID int64) ([]DirectoryGroupRole, error)
+	// which entities sit above workspaces, environments, resources and service accounts x, y, z? resolves many in one round trip.
+	ListEntityParents(ctx context.Context, arg ListEntityParentsParams) ([]ListEntityParentsRow, error)
+	ListEnvironmentsForWorkspace(ctx context.Context, workspaceID int64) ([]Environment, error)
+	ListFailedDeploymentsAfter(ctx context.Context, arg ListFailedDeploymentsAfterParams) ([]ListFailedDeploymentsAfterRow, error)
+	ListFailedDeploymentsSince(ctx context.Context, arg ListFailedDeploymentsSinceParams) ([]ListFailedDeploymentsSinceRow, error)
+	ListFiringAlertStates(ctx context.Context, ruleIds []int64) ([]AlertState, error)
+	// running services of free-tier orgs whose deployments and last wake are older than idle_for, and
+	// whose domains are all under app_domain.
+	ListIdleSleepCandidates(ctx context.Context, arg ListIdleSleepCandidatesParams) ([]ListIdleSleepCandidatesRow, error)
+	// running deployments of awake resources with what their cluster charges, and whether their org has a subscription to bill.
+	ListMeteredDeployments(ctx context.Context) ([]ListMeteredDeploymentsRow, error)
+	ListMultiRegionResources(ctx context.Context) ([]ListMultiRegionResourcesRow, error)
+	// who hears about event in workspace x, and how: members with a scope on the workspace or a
+	// workspace_members row, optionally narrowed to one user.
+	ListNotificationRecipients(ctx context.Context, arg ListNotificationRecipientsParams) ([]ListNotificationRecipientsRow, error)
+	// lists a user's notifications newest first.
+	ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error)
+	// Cascading organization deletion queries
+	// everything a deletion of organization x would tear down, for the dry-run report.
+	ListOrgDeletionResources(ctx context.Context, orgID int64) ([]ListOrgDeletionResourcesRow, error)
+	// an org's usage per resource and region over [since, until).
+	ListOrgUsage(ctx context.Context, arg ListOrgUsageParams) ([]ListOrgUsageRow, error)
 	ListOrganizationMembers(ctx context.Context, organizationID int64) ([]ListOrganizationMembersRow, error)
 	ListOrgsForUser(ctx context.Context, arg ListOrgsForUserParams) ([]Organization, error)
+	// pending user-provided domains with the ingress endpoint their records should point at, which is
+	// unset when the resource's primary region has no cluster with an endpoint.
+	ListPendingCustomDomains(ctx context.Context) ([]ListPendingCustomDomainsRow, error)
+	ListPlatformAdmins(ctx context.Context) ([]User, error)
+	// platform-provided domains with the ingress endpoint of the cluster serving their resource's
+	// primary region; domains whose region has no cluster with an endpoint are left out.
+	ListPlatformDomainTargets(ctx context.Context) ([]ListPlatformDomainTargetsRow, error)
 	ListPlatformDomains(ctx context.Context, activeOnly pgtype.Bool) ([]PlatformDomain, error)
+	ListPolicies(ctx context.Context, orgID int64) ([]Policy, error)
+	// node pools of the region's active clusters
+	ListRegionNodePools(ctx context.Context, region string) ([]ClusterNodePool, error)
+	// the pricing of each region's serving cluster, picked as in GetActiveClusterByRegion but healthy or not
+	ListRegionPricing(ctx context.Context) ([]ListRegionPricingRow, error)
+	// in the order their env vars apply, so later groups win.
+	ListResourceConfigGroups(ctx context.Context, resourceID int64) ([]ConfigGroup, error)
 	ListResourceDomains(ctx context.Context, resourceID int64) ([]ResourceDomain, error)
+	// the domains of several resources at once, in the order ListResourceDomains gives each.
+	ListResourceDomainsForResources(ctx context.Context, resourceIds []int64) ([]ResourceDomain, error)
+	ListResourceIDsForOrg(ctx context.Context, orgID int64) ([]int64, error)
+	// active regions of a resource with the ingress endpoint of their serving cluster, in failover order
+	ListResourceRegionOrigins(ctx context.Context, resourceID int64) ([]ListResourceRegionOriginsRow, error)
 	ListResourceRegions(ctx context.Context, resourceID int64) ([]ResourceRegion, error)
+	// the regions of several resources at once, in the order ListResourceRegions gives each.
+	ListResourceRegionsForResources(ctx context.Context, resourceIds []int64) ([]ResourceRegion, error)
+	ListResourceRegionsForUpdate(ctx context.Context, resourceID int64) ([]ResourceRegion, error)
+	// lists the statuses a resource has been in since a time, starting with the one it was in then.
+	ListResourceStatusHistory(ctx context.Context, arg ListResourceStatusHistoryParams) ([]ResourceStatusHistory, error)
+	// a resource's deployments, recorded changes and incidents, newest first. An incident is a stretch
+	// of degraded or unavailable status, ending when the status next changes. Pages continue after
+	// the (occurred_at, kind, id) of the previous page's last entry.
+	ListResourceTimeline(ctx context.Context, arg ListResourceTimelineParams) ([]ListResourceTimelineRow, error)
 	ListResourcesForWorkspace(ctx context.Context, arg ListResourcesForWorkspaceParams) ([]Resource, error)
+	ListScimGroupMembers(ctx context.Context, groupID int64) ([]ListScimGroupMembersRow, error)
+	ListScimGroupMembersByName(ctx context.Context, arg ListScimGroupMembersByNameParams) ([]int64, error)
+	ListScimGroups(ctx context.Context, arg ListScimGroupsParams) ([]ScimGroup, error)
+	// which roles do the groups of directory user x map to?
+	ListScimUserGroupScopes(ctx context.Context, scimUserID int64) ([][]EntityScope, error)
+	ListScimUsers(ctx context.Context, arg ListScimUsersParams) ([]ScimUser, error)
+	ListServiceAccountsForWorkspace(ctx context.Context, workspaceID int64) ([]ServiceAccount, error)
+	// the workspaces a slash command sent from a channel acts on.
+	ListSlackChannelWorkspaces(ctx context.Context, arg ListSlackChannelWorkspacesParams) ([]ListSlackChannelWorkspacesRow, error)
+	// lists the organizations a user administers or created that no other user administers.
+	ListSolelyOwnedOrganizations(ctx context.Context, userID int64) ([]Organization, error)
+	// active deployments still pending or deploying since before the cutoff, oldest first
+	ListStuckDeployments(ctx context.Context, cutoff pgtype.Timestamptz) ([]ListStuckDeploymentsRow, error)
 	// which tokens exist on behalf of entity y?
 	ListTokensForEntity(ctx context.Context, arg ListTokensForEntityParams) ([]ListTokensForEntityRow, error)
-	ListUserOrganizations(ctx context.Context, userID int64) ([]Organization, error)
-	ListUserWorkspaces(ctx context.Context, userID int64) ([]Workspace, error)
+	ListUnreportedUsage(ctx context.Context, limit int32) ([]ListUnreportedUsageRow, error)
+	// lists the organizations of a user that require MFA.
+	ListUserMFAOrganizations(ctx context.Context, userID int64) ([]ListUserMFAOrganizationsRow, error)
+	// Notification preference, delivery and inbox queries
+	ListUserNotificationPreferences(ctx context.Context, userID int64) ([]UserNotificationPreference, error)
+	ListUserOrganizations(ctx context.Context, userID int64) ([]ListUserOrganizationsRow, error)
+	ListUserWorkspaces(ctx context.Context, userID int64) ([]ListUserWorkspacesRow, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	// services whose active deployment predates the workspace's last env change, so still run with the old values.
+	ListWorkspaceEnvStaleResources(ctx context.Context, workspaceID int64) ([]ListWorkspaceEnvStaleResourcesRow, error)
 	ListWorkspaceMembers(ctx context.Context, workspaceID int64) ([]ListWorkspaceMembersRow, error)
 	ListWorkspaceMembersWithUserDetails(ctx context.Context, arg ListWorkspaceMembersWithUserDetailsParams) ([]ListWorkspaceMembersWithUserDetailsRow, error)
+	ListWorkspaceNamesForOrg(ctx context.Context, orgID int64) ([]ListWorkspaceNamesForOrgRow, error)
+	ListWorkspaceNotificationDefaults(ctx context.Context, workspaceID int64) ([]WorkspaceNotificationDefault, error)
+	// the enabled policies resources and deployments of a workspace are checked against.
+	ListWorkspacePolicies(ctx context.Context, id int64) ([]Policy, error)
+	// a workspace's resources by metered replica hours over [since, until), busiest first.
+	ListWorkspaceTopResources(ctx context.Context, arg ListWorkspaceTopResourcesParams) ([]ListWorkspaceTopResourcesRow, error)
 	ListWorkspacesForOrg(ctx context.Context, arg ListWorkspacesForOrgParams) ([]ListWorkspacesForOrgRow, error)
 	ListWorkspacesForUser(ctx context.Context, arg ListWorkspacesForUserParams) ([]Workspace, error)
 	ListWorkspacesInOrg(ctx context.Context, arg ListWorkspacesInOrgParams) ([]Workspace, error)
+	// active workspaces that existed before the week ended and have no digest for it yet.
+	ListWorkspacesWithoutDigest(ctx context.Context, arg ListWorkspacesWithoutDigestParams) ([]ListWorkspacesWithoutDigestRow, error)
+	// Resource lock and deletion protection queries
+	LockResource(ctx context.Context, arg LockResourceParams) (ResourceLock, error)
+	// holds the resource's deployment lock until the transaction ends, so its deployments are created one at a time.
+	LockResourceDeployments(ctx context.Context, resourceID int64) error
+	// holds the resource's row lock until the transaction ends, so its domains are added one at a time.
+	LockResourceDomains(ctx context.Context, id int64) error
+	MarkAllNotificationsRead(ctx context.Context, arg MarkAllNotificationsReadParams) error
+	// claims a pending request so only one approver executes it
+	MarkApprovalRequestExecuted(ctx context.Context, arg MarkApprovalRequestExecutedParams) (ApprovalRequest, error)
 	MarkDeploymentNotActive(ctx context.Context, id int64) error
+	MarkNotificationFailed(ctx context.Context, arg MarkNotificationFailedParams) error
+	// keeps the original read_at of a notification that was already read.
+	MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) (int64, error)
+	MarkNotificationSent(ctx context.Context, id int64) error
 	MarkPreviousDeploymentsNotActive(ctx context.Context, resourceID int64) error
+	// degrades a healthy resource whose probes keep failing; other statuses say more already.
+	MarkResourceProbeDegraded(ctx context.Context, id int64) (int64, error)
+	// records that the session a login token was issued in passed a TOTP check.
+	MarkSessionMFAVerified(ctx context.Context, accessToken pgtype.Text) error
+	MarkUsageReported(ctx context.Context, id int64) error
+	MarkUserPasswordVerified(ctx context.Context, userID int64) error
 	OrgHasWorkspacesWithResources(ctx context.Context, orgID int64) (bool, error)
+	// deletes inactive deployments beyond each workspace's deployment_history_limit, newest kept first
+	PruneDeploymentHistory(ctx context.Context) (int64, error)
+	RecordConfigRolloutDeployment(ctx context.Context, arg RecordConfigRolloutDeploymentParams) error
+	RecordConfigRolloutProgress(ctx context.Context, id int64) error
+	RecordDeprecatedUsage(ctx context.Context, arg RecordDeprecatedUsageParams) error
+	RecordOrgDeletionProgress(ctx context.Context, id int64) error
+	// stores a probe result and returns how many probes in a row have now failed.
+	RecordResourceProbe(ctx context.Context, arg RecordResourceProbeParams) (int32, error)
+	// records an hour of a deployment once, however many replicas meter it.
+	RecordUsage(ctx context.Context, arg RecordUsageParams) (int64, error)
+	RemoveAllScimGroupMembers(ctx context.Context, groupID int64) error
 	RemoveAllScopesForEntity(ctx context.Context, arg RemoveAllScopesForEntityParams) error
 	RemoveAllScopesForUserOnEntity(ctx context.Context, arg RemoveAllScopesForUserOnEntityParams) error
+	RemoveAllServiceAccountScopes(ctx context.Context, serviceAccountID int64) error
 	RemoveOrganizationMember(ctx context.Context, arg RemoveOrganizationMemberParams) error
+	RemoveScimGroupMember(ctx context.Context, arg RemoveScimGroupMemberParams) error
 	RemoveUserScope(ctx context.Context, arg RemoveUserScopeParams) error
 	RemoveWorkspace(ctx context.Context, id int64) error
 	RemoveWorkspaceMember(ctx context.Context, arg RemoveWorkspaceMemberParams) error
+	// puts a claimed request back when executing it failed
+	ReopenApprovalRequest(ctx context.Context, id int64) error
+	ResumeResource(ctx context.Context, id int64) (Resource, error)
+	ResumeWorkspaceResourcesFromArchive(ctx context.Context, workspaceID int64) ([]Resource, error)
+	SetDNSRecordError(ctx context.Context, arg SetDNSRecordErrorParams) error
+	// keeps the original detection time while the deployment stays drifted.
+	SetDeploymentDrift(ctx context.Context, arg SetDeploymentDriftParams) error
+	SetOrgDeletionProtection(ctx context.Context, arg SetOrgDeletionProtectionParams) (Organization, error)
+	SetOrgDelinquent(ctx context.Context, arg SetOrgDelinquentParams) (int64, error)
+	SetOrgRequireMFA(ctx context.Context, arg SetOrgRequireMFAParams) (Organization, error)
+	SetOrgSubscription(ctx context.Context, arg SetOrgSubscriptionParams) (OrgBilling, error)
+	SetPlatformDomainActive(ctx context.Context, arg SetPlatformDomainActiveParams) (PlatformDomain, error)
+	SetResourceDomainAccessControl(ctx context.Context, arg SetResourceDomainAccessControlParams) (ResourceDomain, error)
 	SetResourceDomainPrimary(ctx context.Context, arg SetResourceDomainPrimaryParams) (int64, error)
+	SetResourceDomainRedirect(ctx context.Context, arg SetResourceDomainRedirectParams) (ResourceDomain, error)
+	SetResourceDomainStatus(ctx context.Context, arg SetResourceDomainStatusParams) error
+	SetResourceDomainTLSPolicy(ctx context.Context, arg SetResourceDomainTLSPolicyParams) (ResourceDomain, error)
+	SetScimUserGrantedScopes(ctx context.Context, arg SetScimUserGrantedScopesParams) error
+	// replaces a password after a reset. Following the emailed link proves the address, so it is verified too.
+	SetUserPasswordHash(ctx context.Context, arg SetUserPasswordHashParams) error
+	// affects no rows when the resource is already asleep, so one replica puts it to sleep.
+	SleepResource(ctx context.Context, resourceID int64) (int64, error)
 	StoreToken(ctx context.Context, arg StoreTokenParams) error
+	SuspendResource(ctx context.Context, arg SuspendResourceParams) (Resource, error)
+	// resources archiving workspace x suspends: those not already suspended.
+	SuspendWorkspaceResourcesForArchive(ctx context.Context, arg SuspendWorkspaceResourcesForArchiveParams) ([]Resource, error)
+	// applies a subscription webhook; events of a subscription the org has since replaced match nothing.
+	SyncOrgSubscription(ctx context.Context, arg SyncOrgSubscriptionParams) (int64, error)
+	// schedules a probe for every service with a primary domain that has none yet.
+	SyncProbeTargets(ctx context.Context) error
+	// hands the organizations a user created to another of their admins, before the user is deleted.
+	TransferCreatedOrganizations(ctx context.Context, userID int64) error
+	// hands the workspaces a user created to the creator of their organization, before the user is deleted.
+	TransferCreatedWorkspaces(ctx context.Context, createdBy int64) error
+	UnarchiveWorkspace(ctx context.Context, id int64) (Workspace, error)
+	UnlockResource(ctx context.Context, resourceID int64) error
 	UpdateActiveDeploymentStatus(ctx context.Context, arg UpdateActiveDeploymentStatusParams) error
+	UpdateAlertRule(ctx context.Context, arg UpdateAlertRuleParams) (AlertRule, error)
+	UpdateCluster(ctx context.Context, arg UpdateClusterParams) (Cluster, error)
+	UpdateConfigGroup(ctx context.Context, arg UpdateConfigGroupParams) (ConfigGroup, error)
 	UpdateDeploymentStatus(ctx context.Context, arg UpdateDeploymentStatusParams) error
 	UpdateDeploymentStatusAndActive(ctx context.Context, arg UpdateDeploymentStatusAndActiveParams) error
 	UpdateDeploymentStatusWithMessage(ctx context.Context, arg UpdateDeploymentStatusWithMessageParams) error
+	UpdateEnvironment(ctx context.Context, arg UpdateEnvironmentParams) (Environment, error)
 	UpdateOrgName(ctx context.Context, arg UpdateOrgNameParams) (Organization, error)
+	UpdatePolicy(ctx context.Context, arg UpdatePolicyParams) (Policy, error)
+	// only updates a resource still at version, when one is given.
 	UpdateResource(ctx context.Context, arg UpdateResourceParams) (int64, error)
+	// a user-provided domain that changes needs its records checked again.
 	UpdateResourceDomain(ctx context.Context, arg UpdateResourceDomainParams) (int64, error)
 	UpdateResourceDomainPrimary(ctx context.Context, resourceID int64) error
+	UpdateResourceRegionFailover(ctx context.Context, arg UpdateResourceRegionFailoverParams) error
+	UpdateResourceSpec(ctx context.Context, arg UpdateResourceSpecParams) error
+	// suspended resources keep their status until resumed.
 	UpdateResourceStatus(ctx context.Context, arg UpdateResourceStatusParams) error
+	UpdateScimGroup(ctx context.Context, arg UpdateScimGroupParams) (ScimGroup, error)
+	UpdateScimUser(ctx context.Context, arg UpdateScimUserParams) (ScimUser, error)
 	UpdateUserAvatarURL(ctx context.Context, arg UpdateUserAvatarURLParams) (User, error)
 	UpdateWorkspace(ctx context.Context, arg UpdateWorkspaceParams) (int64, error)
+	UpsertAlertState(ctx context.Context, arg UpsertAlertStateParams) error
+	// never moves the marker backwards.
+	UpsertAnnouncementReadMarker(ctx context.Context, arg UpsertAnnouncementReadMarkerParams) error
+	// keeps the original detection time while the resource stays out of sync.
+	UpsertClusterDiscrepancy(ctx context.Context, arg UpsertClusterDiscrepancyParams) error
+	// records a record as published, or as failed with the error when publishing it did not succeed.
+	UpsertDNSRecord(ctx context.Context, arg UpsertDNSRecordParams) error
+	UpsertDeploymentProvenance(ctx context.Context, arg UpsertDeploymentProvenanceParams) error
+	UpsertDirectoryGroupRoles(ctx context.Context, arg UpsertDirectoryGroupRolesParams) error
+	UpsertGitOpsApplication(ctx context.Context, arg UpsertGitOpsApplicationParams) error
+	UpsertImageScan(ctx context.Context, arg UpsertImageScanParams) (ImageScan, error)
+	// Slack installation and channel mapping queries
+	UpsertSlackInstallation(ctx context.Context, arg UpsertSlackInstallationParams) error
+	UpsertStatusPage(ctx context.Context, arg UpsertStatusPageParams) (StatusPage, error)
+	UpsertUserNotificationPreference(ctx context.Context, arg UpsertUserNotificationPreferenceParams) error
+	// starts an enrollment, replacing one that was never confirmed.
+	UpsertUserTOTP(ctx context.Context, arg UpsertUserTOTPParams) error
+	UpsertWorkspaceEnv(ctx context.Context, arg UpsertWorkspaceEnvParams) (WorkspaceEnv, error)
 	UpsertWorkspaceMember(ctx context.Context, arg UpsertWorkspaceMemberParams) (int64, error)
+	UpsertWorkspaceNotificationDefault(ctx context.Context, arg UpsertWorkspaceNotificationDefaultParams) error
+	UpsertWorkspaceSlackChannel(ctx context.Context, arg UpsertWorkspaceSlackChannelParams) (WorkspaceSlackChannel, error)
+	// marks an unused, unexpired refresh token used, returning the session it belongs to.
+	UseRefreshToken(ctx context.Context, tokenHash string) (UseRefreshTokenRow, error)
+	// records the step of an accepted code, and enables the second factor if it was being enrolled. No rows
+	// are affected when the step was already used.
+	UseUserTOTPStep(ctx context.Context, arg UseUserTOTPStepParams) (int64, error)
+	// does user x belong to an organization requiring MFA?
+	UserRequiresMFA(ctx context.Context, userID int64) (bool, error)
+	// affects no rows when the resource is awake, so one replica wakes it.
+	WakeResource(ctx context.Context, resourceID int64) (int64, error)
+	WorkspaceHasResourcesWithLabels(ctx context.Context, arg WorkspaceHasResourcesWithLabelsParams) (bool, error)
 }
 
 var _ Querier = (*Queries)(nil)
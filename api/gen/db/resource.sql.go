@@ -11,21 +11,35 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const clearPrimaryResourceRegion = `-- name: ClearPrimaryResourceRegion :exec
+UPDATE resource_regions
+SET is_primary = false, updated_at = NOW()
+WHERE resource_id = $1 AND is_primary = true
+`
+
+func (q *Queries) ClearPrimaryResourceRegion(ctx context.Context, resourceID int64) error {
+	_, err := q.db.Exec(ctx, clearPrimaryResourceRegion, resourceID)
+	return err
+}
+
 const createResource = `-- name: CreateResource :one
 
-INSERT INTO resources (workspace_id, name, type, description, status, spec, spec_version)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO resources (workspace_id, name, type, description, status, spec, spec_version, environment_id, external_id, labels)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 RETURNING id
 `
 
 type CreateResourceParams struct {
-	WorkspaceID int64          `json:"workspaceId"`
-	Name        string         `json:"name"`
-	Type        ResourceType   `json:"type"`
-	Description string         `json:"description"`
-	Status      ResourceStatus `json:"status"`
-	Spec        []byte         `json:"spec"`
-	SpecVersion int32          `json:"specVersion"`
+	WorkspaceID   int64          `json:"workspaceId"`
+	Name          string         `json:"name"`
+	Type          ResourceType   `json:"type"`
+	Description   string         `json:"description"`
+	Status        ResourceStatus `json:"status"`
+	Spec          []byte         `json:"spec"`
+	SpecVersion   int32          `json:"specVersion"`
+	EnvironmentID pgtype.Int8    `json:"environmentId"`
+	ExternalID    pgtype.Text    `json:"externalId"`
+	Labels        []byte         `json:"labels"`
 }
 
 // Resource queries
@@ -38,6 +52,9 @@ func (q *Queries) CreateResource(ctx context.Context, arg CreateResourceParams)
 		arg.Status,
 		arg.Spec,
 		arg.SpecVersion,
+		arg.EnvironmentID,
+		arg.ExternalID,
+		arg.Labels,
 	)
 	var id int64
 	err := row.Scan(&id)
@@ -45,16 +62,17 @@ func (q *Queries) CreateResource(ctx context.Context, arg CreateResourceParams)
 }
 
 const createResourceRegion = `-- name: CreateResourceRegion :one
-INSERT INTO resource_regions (resource_id, region, is_primary, status)
-VALUES ($1, $2, $3, $4)
-RETURNING id, resource_id, region, is_primary, status, last_error, created_at, updated_at
+INSERT INTO resource_regions (resource_id, region, is_primary, status, failover_priority)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, resource_id, region, is_primary, status, last_error, created_at, updated_at, failover_priority
 `
 
 type CreateResourceRegionParams struct {
-	ResourceID int64              `json:"resourceId"`
-	Region     string             `json:"region"`
-	IsPrimary  bool               `json:"isPrimary"`
-	Status     RegionIntentStatus `json:"status"`
+	ResourceID       int64              `json:"resourceId"`
+	Region           string             `json:"region"`
+	IsPrimary        bool               `json:"isPrimary"`
+	Status           RegionIntentStatus `json:"status"`
+	FailoverPriority int32              `json:"failoverPriority"`
 }
 
 func (q *Queries) CreateResourceRegion(ctx context.Context, arg CreateResourceRegionParams) (ResourceRegion, error) {
@@ -63,6 +81,7 @@ func (q *Queries) CreateResourceRegion(ctx context.Context, arg CreateResourceRe
 		arg.Region,
 		arg.IsPrimary,
 		arg.Status,
+		arg.FailoverPriority,
 	)
 	var i ResourceRegion
 	err := row.Scan(
@@ -74,6 +93,7 @@ func (q *Queries) CreateResourceRegion(ctx context.Context, arg CreateResourceRe
 		&i.LastError,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.FailoverPriority,
 	)
 	return i, err
 }
@@ -161,8 +181,43 @@ func (q *Queries) GetFirstActiveCluster(ctx context.Context) (Cluster, error) {
 	return i, err
 }
 
+const getResourceByExternalID = `-- name: GetResourceByExternalID :one
+SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at, r.maintenance_message, r.environment_id, r.external_id, r.labels, r.suspended_by_archive, r.version
+FROM resources r
+WHERE r.workspace_id = $1 AND r.external_id = $2
+`
+
+type GetResourceByExternalIDParams struct {
+	WorkspaceID int64       `json:"workspaceId"`
+	ExternalID  pgtype.Text `json:"externalId"`
+}
+
+func (q *Queries) GetResourceByExternalID(ctx context.Context, arg GetResourceByExternalIDParams) (Resource, error) {
+	row := q.db.QueryRow(ctx, getResourceByExternalID, arg.WorkspaceID, arg.ExternalID)
+	var i Resource
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.Name,
+		&i.Type,
+		&i.Description,
+		&i.Status,
+		&i.Spec,
+		&i.SpecVersion,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.MaintenanceMessage,
+		&i.EnvironmentID,
+		&i.ExternalID,
+		&i.Labels,
+		&i.SuspendedByArchive,
+		&i.Version,
+	)
+	return i, err
+}
+
 const getResourceByID = `-- name: GetResourceByID :one
-SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at
+SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at, r.maintenance_message, r.environment_id, r.external_id, r.labels, r.suspended_by_archive, r.version
 FROM resources r
 WHERE r.id = $1
 `
@@ -181,23 +236,31 @@ func (q *Queries) GetResourceByID(ctx context.Context, id int64) (Resource, erro
 		&i.SpecVersion,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MaintenanceMessage,
+		&i.EnvironmentID,
+		&i.ExternalID,
+		&i.Labels,
+		&i.SuspendedByArchive,
+		&i.Version,
 	)
 	return i, err
 }
 
 const getResourceByNameAndWorkspace = `-- name: GetResourceByNameAndWorkspace :one
-SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at
+SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at, r.maintenance_message, r.environment_id, r.external_id, r.labels, r.suspended_by_archive, r.version
 FROM resources r
 WHERE r.workspace_id = $1 AND r.name = $2
+  AND r.environment_id IS NOT DISTINCT FROM $3::bigint
 `
 
 type GetResourceByNameAndWorkspaceParams struct {
-	WorkspaceID int64  `json:"workspaceId"`
-	Name        string `json:"name"`
+	WorkspaceID   int64       `json:"workspaceId"`
+	Name          string      `json:"name"`
+	EnvironmentID pgtype.Int8 `json:"environmentId"`
 }
 
 func (q *Queries) GetResourceByNameAndWorkspace(ctx context.Context, arg GetResourceByNameAndWorkspaceParams) (Resource, error) {
-	row := q.db.QueryRow(ctx, getResourceByNameAndWorkspace, arg.WorkspaceID, arg.Name)
+	row := q.db.QueryRow(ctx, getResourceByNameAndWorkspace, arg.WorkspaceID, arg.Name, arg.EnvironmentID)
 	var i Resource
 	err := row.Scan(
 		&i.ID,
@@ -210,12 +273,18 @@ func (q *Queries) GetResourceByNameAndWorkspace(ctx context.Context, arg GetReso
 		&i.SpecVersion,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MaintenanceMessage,
+		&i.EnvironmentID,
+		&i.ExternalID,
+		&i.Labels,
+		&i.SuspendedByArchive,
+		&i.Version,
 	)
 	return i, err
 }
 
 const getResourceRegionByResourceAndRegion = `-- name: GetResourceRegionByResourceAndRegion :one
-SELECT id, resource_id, region, is_primary, status, last_error, created_at, updated_at
+SELECT id, resource_id, region, is_primary, status, last_error, created_at, updated_at, failover_priority
 FROM resource_regions
 WHERE resource_id = $1 AND region = $2
 `
@@ -237,6 +306,7 @@ func (q *Queries) GetResourceRegionByResourceAndRegion(ctx context.Context, arg
 		&i.LastError,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.FailoverPriority,
 	)
 	return i, err
 }
@@ -253,18 +323,19 @@ func (q *Queries) GetResourceWorkspaceID(ctx context.Context, id int64) (int64,
 }
 
 const getWorkspaceOrganizationIDByResourceID = `-- name: GetWorkspaceOrganizationIDByResourceID :one
-SELECT workspace_id, w.org_id FROM resources r JOIN workspaces w ON r.workspace_id = w.id WHERE r.id = $1
+SELECT workspace_id, w.org_id, r.environment_id FROM resources r JOIN workspaces w ON r.workspace_id = w.id WHERE r.id = $1
 `
 
 type GetWorkspaceOrganizationIDByResourceIDRow struct {
-	WorkspaceID int64 `json:"workspaceId"`
-	OrgID       int64 `json:"orgId"`
+	WorkspaceID   int64       `json:"workspaceId"`
+	OrgID         int64       `json:"orgId"`
+	EnvironmentID pgtype.Int8 `json:"environmentId"`
 }
 
 func (q *Queries) GetWorkspaceOrganizationIDByResourceID(ctx context.Context, id int64) (GetWorkspaceOrganizationIDByResourceIDRow, error) {
 	row := q.db.QueryRow(ctx, getWorkspaceOrganizationIDByResourceID, id)
 	var i GetWorkspaceOrganizationIDByResourceIDRow
-	err := row.Scan(&i.WorkspaceID, &i.OrgID)
+	err := row.Scan(&i.WorkspaceID, &i.OrgID, &i.EnvironmentID)
 	return i, err
 }
 
@@ -332,11 +403,170 @@ func (q *Queries) ListClustersActive(ctx context.Context) ([]Cluster, error) {
 	return items, nil
 }
 
+const listMultiRegionResources = `-- name: ListMultiRegionResources :many
+SELECT r.id AS resource_id, rd.domain
+FROM resources r
+JOIN resource_domains rd ON rd.resource_id = r.id AND rd.is_primary = true
+WHERE (SELECT COUNT(*) FROM resource_regions rr WHERE rr.resource_id = r.id) > 1
+ORDER BY r.id
+`
+
+type ListMultiRegionResourcesRow struct {
+	ResourceID int64  `json:"resourceId"`
+	Domain     string `json:"domain"`
+}
+
+func (q *Queries) ListMultiRegionResources(ctx context.Context) ([]ListMultiRegionResourcesRow, error) {
+	rows, err := q.db.Query(ctx, listMultiRegionResources)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMultiRegionResourcesRow
+	for rows.Next() {
+		var i ListMultiRegionResourcesRow
+		if err := rows.Scan(&i.ResourceID, &i.Domain); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRegionNodePools = `-- name: ListRegionNodePools :many
+SELECT p.id, p.cluster_id, p.name, p.labels, p.taints, p.created_at FROM cluster_node_pools p
+JOIN clusters cl ON cl.id = p.cluster_id
+WHERE cl.region = $1 AND cl.is_active = true
+ORDER BY p.cluster_id, p.name
+`
+
+// node pools of the region's active clusters
+func (q *Queries) ListRegionNodePools(ctx context.Context, region string) ([]ClusterNodePool, error) {
+	rows, err := q.db.Query(ctx, listRegionNodePools, region)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClusterNodePool
+	for rows.Next() {
+		var i ClusterNodePool
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClusterID,
+			&i.Name,
+			&i.Labels,
+			&i.Taints,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRegionPricing = `-- name: ListRegionPricing :many
+SELECT DISTINCT ON (cl.region) cl.region, p.currency, p.cpu_core_hour_micros, p.memory_gib_hour_micros
+FROM clusters cl
+JOIN cluster_pricing p ON p.cluster_id = cl.id
+WHERE cl.is_active = true
+ORDER BY cl.region ASC, cl.is_default DESC, cl.created_at ASC
+`
+
+type ListRegionPricingRow struct {
+	Region              string `json:"region"`
+	Currency            string `json:"currency"`
+	CpuCoreHourMicros   int64  `json:"cpuCoreHourMicros"`
+	MemoryGibHourMicros int64  `json:"memoryGibHourMicros"`
+}
+
+// the pricing of each region's serving cluster, picked as in GetActiveClusterByRegion but healthy or not
+func (q *Queries) ListRegionPricing(ctx context.Context) ([]ListRegionPricingRow, error) {
+	rows, err := q.db.Query(ctx, listRegionPricing)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRegionPricingRow
+	for rows.Next() {
+		var i ListRegionPricingRow
+		if err := rows.Scan(
+			&i.Region,
+			&i.Currency,
+			&i.CpuCoreHourMicros,
+			&i.MemoryGibHourMicros,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResourceRegionOrigins = `-- name: ListResourceRegionOrigins :many
+SELECT rr.region, rr.is_primary, rr.failover_priority, c.endpoint
+FROM resource_regions rr
+JOIN LATERAL (
+    SELECT cl.endpoint
+    FROM clusters cl
+    WHERE cl.region = rr.region
+      AND cl.is_active = true
+      AND cl.health_status = 'healthy'
+      AND cl.endpoint IS NOT NULL
+    ORDER BY cl.is_default DESC, cl.created_at ASC
+    LIMIT 1
+) c ON true
+WHERE rr.resource_id = $1 AND rr.status = 'active'
+ORDER BY rr.failover_priority ASC, rr.region ASC
+`
+
+type ListResourceRegionOriginsRow struct {
+	Region           string      `json:"region"`
+	IsPrimary        bool        `json:"isPrimary"`
+	FailoverPriority int32       `json:"failoverPriority"`
+	Endpoint         pgtype.Text `json:"endpoint"`
+}
+
+// active regions of a resource with the ingress endpoint of their serving cluster, in failover order
+func (q *Queries) ListResourceRegionOrigins(ctx context.Context, resourceID int64) ([]ListResourceRegionOriginsRow, error) {
+	rows, err := q.db.Query(ctx, listResourceRegionOrigins, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListResourceRegionOriginsRow
+	for rows.Next() {
+		var i ListResourceRegionOriginsRow
+		if err := rows.Scan(
+			&i.Region,
+			&i.IsPrimary,
+			&i.FailoverPriority,
+			&i.Endpoint,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listResourceRegions = `-- name: ListResourceRegions :many
-SELECT id, resource_id, region, is_primary, status, last_error, created_at, updated_at
+SELECT id, resource_id, region, is_primary, status, last_error, created_at, updated_at, failover_priority
 FROM resource_regions
 WHERE resource_id = $1
-ORDER BY is_primary DESC, region ASC
+ORDER BY is_primary DESC, failover_priority ASC, region ASC
 `
 
 func (q *Queries) ListResourceRegions(ctx context.Context, resourceID int64) ([]ResourceRegion, error) {
@@ -357,6 +587,83 @@ func (q *Queries) ListResourceRegions(ctx context.Context, resourceID int64) ([]
 			&i.LastError,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.FailoverPriority,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResourceRegionsForResources = `-- name: ListResourceRegionsForResources :many
+SELECT id, resource_id, region, is_primary, status, last_error, created_at, updated_at, failover_priority
+FROM resource_regions
+WHERE resource_id = ANY($1::bigint[])
+ORDER BY resource_id, is_primary DESC, failover_priority ASC, region ASC
+`
+
+// the regions of several resources at once, in the order ListResourceRegions gives each.
+func (q *Queries) ListResourceRegionsForResources(ctx context.Context, resourceIds []int64) ([]ResourceRegion, error) {
+	rows, err := q.db.Query(ctx, listResourceRegionsForResources, resourceIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ResourceRegion
+	for rows.Next() {
+		var i ResourceRegion
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResourceID,
+			&i.Region,
+			&i.IsPrimary,
+			&i.Status,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FailoverPriority,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResourceRegionsForUpdate = `-- name: ListResourceRegionsForUpdate :many
+SELECT id, resource_id, region, is_primary, status, last_error, created_at, updated_at, failover_priority
+FROM resource_regions
+WHERE resource_id = $1
+ORDER BY is_primary DESC, failover_priority ASC, region ASC
+FOR UPDATE
+`
+
+func (q *Queries) ListResourceRegionsForUpdate(ctx context.Context, resourceID int64) ([]ResourceRegion, error) {
+	rows, err := q.db.Query(ctx, listResourceRegionsForUpdate, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ResourceRegion
+	for rows.Next() {
+		var i ResourceRegion
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResourceID,
+			&i.Region,
+			&i.IsPrimary,
+			&i.Status,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FailoverPriority,
 		); err != nil {
 			return nil, err
 		}
@@ -369,26 +676,33 @@ func (q *Queries) ListResourceRegions(ctx context.Context, resourceID int64) ([]
 }
 
 const listResourcesForWorkspace = `-- name: ListResourcesForWorkspace :many
-SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at
+SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at, r.maintenance_message, r.environment_id, r.external_id, r.labels, r.suspended_by_archive, r.version
 FROM resources r
 WHERE r.workspace_id = $1
-   AND ($3::text IS NULL
+   AND ($3::bigint IS NULL OR r.environment_id = $3::bigint)
+   AND ($4::text IS NULL
         OR (r.created_at, r.id) < (
-          (SELECT created_at FROM resources WHERE id = $3::bigint),
-          $3::bigint
+          (SELECT created_at FROM resources WHERE id = $4::bigint),
+          $4::bigint
         ))
 ORDER BY r.created_at DESC, r.id DESC
 LIMIT $2
 `
 
 type ListResourcesForWorkspaceParams struct {
-	WorkspaceID int64       `json:"workspaceId"`
-	Limit       int32       `json:"limit"`
-	PageToken   pgtype.Text `json:"pageToken"`
+	WorkspaceID   int64       `json:"workspaceId"`
+	Limit         int32       `json:"limit"`
+	EnvironmentID pgtype.Int8 `json:"environmentId"`
+	PageToken     pgtype.Text `json:"pageToken"`
 }
 
 func (q *Queries) ListResourcesForWorkspace(ctx context.Context, arg ListResourcesForWorkspaceParams) ([]Resource, error) {
-	rows, err := q.db.Query(ctx, listResourcesForWorkspace, arg.WorkspaceID, arg.Limit, arg.PageToken)
+	rows, err := q.db.Query(ctx, listResourcesForWorkspace,
+		arg.WorkspaceID,
+		arg.Limit,
+		arg.EnvironmentID,
+		arg.PageToken,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -407,6 +721,173 @@ func (q *Queries) ListResourcesForWorkspace(ctx context.Context, arg ListResourc
 			&i.SpecVersion,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.MaintenanceMessage,
+			&i.EnvironmentID,
+			&i.ExternalID,
+			&i.Labels,
+			&i.SuspendedByArchive,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resumeResource = `-- name: ResumeResource :one
+UPDATE resources
+SET status = 'deploying', maintenance_message = NULL, suspended_by_archive = FALSE, updated_at = NOW()
+WHERE id = $1
+RETURNING id, workspace_id, name, type, description, status, spec, spec_version, created_at, updated_at, maintenance_message, environment_id, external_id, labels, suspended_by_archive, version
+`
+
+func (q *Queries) ResumeResource(ctx context.Context, id int64) (Resource, error) {
+	row := q.db.QueryRow(ctx, resumeResource, id)
+	var i Resource
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.Name,
+		&i.Type,
+		&i.Description,
+		&i.Status,
+		&i.Spec,
+		&i.SpecVersion,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.MaintenanceMessage,
+		&i.EnvironmentID,
+		&i.ExternalID,
+		&i.Labels,
+		&i.SuspendedByArchive,
+		&i.Version,
+	)
+	return i, err
+}
+
+const resumeWorkspaceResourcesFromArchive = `-- name: ResumeWorkspaceResourcesFromArchive :many
+UPDATE resources
+SET status = 'deploying', maintenance_message = NULL, suspended_by_archive = FALSE, updated_at = NOW()
+WHERE workspace_id = $1 AND suspended_by_archive
+RETURNING id, workspace_id, name, type, description, status, spec, spec_version, created_at, updated_at, maintenance_message, environment_id, external_id, labels, suspended_by_archive, version
+`
+
+func (q *Queries) ResumeWorkspaceResourcesFromArchive(ctx context.Context, workspaceID int64) ([]Resource, error) {
+	rows, err := q.db.Query(ctx, resumeWorkspaceResourcesFromArchive, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Resource
+	for rows.Next() {
+		var i Resource
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.Name,
+			&i.Type,
+			&i.Description,
+			&i.Status,
+			&i.Spec,
+			&i.SpecVersion,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.MaintenanceMessage,
+			&i.EnvironmentID,
+			&i.ExternalID,
+			&i.Labels,
+			&i.SuspendedByArchive,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const suspendResource = `-- name: SuspendResource :one
+UPDATE resources
+SET status = 'suspended', maintenance_message = $2, suspended_by_archive = FALSE, updated_at = NOW()
+WHERE id = $1
+RETURNING id, workspace_id, name, type, description, status, spec, spec_version, created_at, updated_at, maintenance_message, environment_id, external_id, labels, suspended_by_archive, version
+`
+
+type SuspendResourceParams struct {
+	ID                 int64       `json:"id"`
+	MaintenanceMessage pgtype.Text `json:"maintenanceMessage"`
+}
+
+func (q *Queries) SuspendResource(ctx context.Context, arg SuspendResourceParams) (Resource, error) {
+	row := q.db.QueryRow(ctx, suspendResource, arg.ID, arg.MaintenanceMessage)
+	var i Resource
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.Name,
+		&i.Type,
+		&i.Description,
+		&i.Status,
+		&i.Spec,
+		&i.SpecVersion,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.MaintenanceMessage,
+		&i.EnvironmentID,
+		&i.ExternalID,
+		&i.Labels,
+		&i.SuspendedByArchive,
+		&i.Version,
+	)
+	return i, err
+}
+
+const suspendWorkspaceResourcesForArchive = `-- name: SuspendWorkspaceResourcesForArchive :many
+UPDATE resources
+SET status = 'suspended', maintenance_message = $2, suspended_by_archive = TRUE, updated_at = NOW()
+WHERE workspace_id = $1 AND status <> 'suspended'
+RETURNING id, workspace_id, name, type, description, status, spec, spec_version, created_at, updated_at, maintenance_message, environment_id, external_id, labels, suspended_by_archive, version
+`
+
+type SuspendWorkspaceResourcesForArchiveParams struct {
+	WorkspaceID        int64       `json:"workspaceId"`
+	MaintenanceMessage pgtype.Text `json:"maintenanceMessage"`
+}
+
+// resources archiving workspace x suspends: those not already suspended.
+func (q *Queries) SuspendWorkspaceResourcesForArchive(ctx context.Context, arg SuspendWorkspaceResourcesForArchiveParams) ([]Resource, error) {
+	rows, err := q.db.Query(ctx, suspendWorkspaceResourcesForArchive, arg.WorkspaceID, arg.MaintenanceMessage)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Resource
+	for rows.Next() {
+		var i Resource
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.Name,
+			&i.Type,
+			&i.Description,
+			&i.Status,
+			&i.Spec,
+			&i.SpecVersion,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.MaintenanceMessage,
+			&i.EnvironmentID,
+			&i.ExternalID,
+			&i.Labels,
+			&i.SuspendedByArchive,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -421,27 +902,81 @@ func (q *Queries) ListResourcesForWorkspace(ctx context.Context, arg ListResourc
 const updateResource = `-- name: UpdateResource :one
 UPDATE resources
 SET name = COALESCE($2, name),
+    description = COALESCE($3, description),
+    spec = COALESCE($4, spec),
+    labels = COALESCE($5, labels),
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND ($6::bigint IS NULL OR version = $6::bigint)
 RETURNING id
 `
 
 type UpdateResourceParams struct {
-	ID   int64       `json:"id"`
-	Name pgtype.Text `json:"name"`
+	ID          int64       `json:"id"`
+	Name        pgtype.Text `json:"name"`
+	Description pgtype.Text `json:"description"`
+	Spec        []byte      `json:"spec"`
+	Labels      []byte      `json:"labels"`
+	Version     pgtype.Int8 `json:"version"`
 }
 
+// only updates a resource still at version, when one is given.
 func (q *Queries) UpdateResource(ctx context.Context, arg UpdateResourceParams) (int64, error) {
-	row := q.db.QueryRow(ctx, updateResource, arg.ID, arg.Name)
+	row := q.db.QueryRow(ctx, updateResource,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.Spec,
+		arg.Labels,
+		arg.Version,
+	)
 	var id int64
 	err := row.Scan(&id)
 	return id, err
 }
 
+const updateResourceRegionFailover = `-- name: UpdateResourceRegionFailover :exec
+UPDATE resource_regions
+SET is_primary = $3, failover_priority = $4, updated_at = NOW()
+WHERE resource_id = $1 AND region = $2
+`
+
+type UpdateResourceRegionFailoverParams struct {
+	ResourceID       int64  `json:"resourceId"`
+	Region           string `json:"region"`
+	IsPrimary        bool   `json:"isPrimary"`
+	FailoverPriority int32  `json:"failoverPriority"`
+}
+
+func (q *Queries) UpdateResourceRegionFailover(ctx context.Context, arg UpdateResourceRegionFailoverParams) error {
+	_, err := q.db.Exec(ctx, updateResourceRegionFailover,
+		arg.ResourceID,
+		arg.Region,
+		arg.IsPrimary,
+		arg.FailoverPriority,
+	)
+	return err
+}
+
+const updateResourceSpec = `-- name: UpdateResourceSpec :exec
+UPDATE resources
+SET spec = $2, updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateResourceSpecParams struct {
+	ID   int64  `json:"id"`
+	Spec []byte `json:"spec"`
+}
+
+func (q *Queries) UpdateResourceSpec(ctx context.Context, arg UpdateResourceSpecParams) error {
+	_, err := q.db.Exec(ctx, updateResourceSpec, arg.ID, arg.Spec)
+	return err
+}
+
 const updateResourceStatus = `-- name: UpdateResourceStatus :exec
 UPDATE resources
 SET status = $2, updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND status <> 'suspended'
 `
 
 type UpdateResourceStatusParams struct {
@@ -449,6 +984,7 @@ type UpdateResourceStatusParams struct {
 	Status ResourceStatus `json:"status"`
 }
 
+// suspended resources keep their status until resumed.
 func (q *Queries) UpdateResourceStatus(ctx context.Context, arg UpdateResourceStatusParams) error {
 	_, err := q.db.Exec(ctx, updateResourceStatus, arg.ID, arg.Status)
 	return err
@@ -8,6 +8,8 @@ package db
 import (
 	"context"
 	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const addUserScope = `-- name: AddUserScope :exec
@@ -79,7 +81,7 @@ func (q *Queries) DeleteTokensForEntity(ctx context.Context, arg DeleteTokensFor
 }
 
 const getToken = `-- name: GetToken :one
-SELECT name, token, scopes, entity_type, entity_id, expires_at FROM tokens WHERE token = $1 AND expires_at > NOW()
+SELECT name, token, scopes, entity_type, entity_id, expires_at, parent_token, impersonator_id, mfa_pending FROM tokens WHERE token = $1 AND expires_at > NOW()
 `
 
 func (q *Queries) GetToken(ctx context.Context, token string) (Token, error) {
@@ -92,6 +94,9 @@ func (q *Queries) GetToken(ctx context.Context, token string) (Token, error) {
 		&i.EntityType,
 		&i.EntityID,
 		&i.ExpiresAt,
+		&i.ParentToken,
+		&i.ImpersonatorID,
+		&i.MfaPending,
 	)
 	return i, err
 }
@@ -369,6 +374,74 @@ func (q *Queries) GetUsersWithScopeOnEntity(ctx context.Context, arg GetUsersWit
 	return items, nil
 }
 
+const listEntityParents = `-- name: ListEntityParents :many
+SELECT 'workspace'::entity_type AS entity_type, w.id AS entity_id, w.org_id, NULL::bigint AS workspace_id, NULL::bigint AS environment_id
+FROM workspaces w
+WHERE w.id = ANY($1::bigint[])
+UNION ALL
+SELECT 'environment'::entity_type, e.id, w.org_id, e.workspace_id, NULL::bigint
+FROM environments e
+JOIN workspaces w ON e.workspace_id = w.id
+WHERE e.id = ANY($2::bigint[])
+UNION ALL
+SELECT 'resource'::entity_type, r.id, w.org_id, r.workspace_id, r.environment_id
+FROM resources r
+JOIN workspaces w ON r.workspace_id = w.id
+WHERE r.id = ANY($3::bigint[])
+UNION ALL
+SELECT 'service_account'::entity_type, sa.id, w.org_id, sa.workspace_id, NULL::bigint
+FROM service_accounts sa
+JOIN workspaces w ON sa.workspace_id = w.id
+WHERE sa.id = ANY($4::bigint[])
+`
+
+type ListEntityParentsParams struct {
+	WorkspaceIds      []int64 `json:"workspaceIds"`
+	EnvironmentIds    []int64 `json:"environmentIds"`
+	ResourceIds       []int64 `json:"resourceIds"`
+	ServiceAccountIds []int64 `json:"serviceAccountIds"`
+}
+
+type ListEntityParentsRow struct {
+	EntityType    EntityType  `json:"entityType"`
+	EntityID      int64       `json:"entityId"`
+	OrgID         int64       `json:"orgId"`
+	WorkspaceID   pgtype.Int8 `json:"workspaceId"`
+	EnvironmentID pgtype.Int8 `json:"environmentId"`
+}
+
+// which entities sit above workspaces, environments, resources and service accounts x, y, z? resolves many in one round trip.
+func (q *Queries) ListEntityParents(ctx context.Context, arg ListEntityParentsParams) ([]ListEntityParentsRow, error) {
+	rows, err := q.db.Query(ctx, listEntityParents,
+		arg.WorkspaceIds,
+		arg.EnvironmentIds,
+		arg.ResourceIds,
+		arg.ServiceAccountIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEntityParentsRow
+	for rows.Next() {
+		var i ListEntityParentsRow
+		if err := rows.Scan(
+			&i.EntityType,
+			&i.EntityID,
+			&i.OrgID,
+			&i.WorkspaceID,
+			&i.EnvironmentID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTokensForEntity = `-- name: ListTokensForEntity :many
 SELECT name, entity_type, entity_id, scopes, expires_at FROM tokens WHERE entity_type = $1 AND entity_id = $2
 `
@@ -464,16 +537,19 @@ func (q *Queries) RemoveUserScope(ctx context.Context, arg RemoveUserScopeParams
 }
 
 const storeToken = `-- name: StoreToken :exec
-INSERT INTO tokens (name, token, entity_type, entity_id, scopes, expires_at) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING
+INSERT INTO tokens (name, token, entity_type, entity_id, scopes, expires_at, parent_token, impersonator_id, mfa_pending) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT DO NOTHING
 `
 
 type StoreTokenParams struct {
-	Name       string        `json:"name"`
-	Token      string        `json:"token"`
-	EntityType EntityType    `json:"entityType"`
-	EntityID   int64         `json:"entityId"`
-	Scopes     []EntityScope `json:"scopes"`
-	ExpiresAt  time.Time     `json:"expiresAt"`
+	Name           string        `json:"name"`
+	Token          string        `json:"token"`
+	EntityType     EntityType    `json:"entityType"`
+	EntityID       int64         `json:"entityId"`
+	Scopes         []EntityScope `json:"scopes"`
+	ExpiresAt      time.Time     `json:"expiresAt"`
+	ParentToken    pgtype.Text   `json:"parentToken"`
+	ImpersonatorID pgtype.Int8   `json:"impersonatorId"`
+	MfaPending     bool          `json:"mfaPending"`
 }
 
 func (q *Queries) StoreToken(ctx context.Context, arg StoreTokenParams) error {
@@ -484,6 +560,9 @@ func (q *Queries) StoreToken(ctx context.Context, arg StoreTokenParams) error {
 		arg.EntityID,
 		arg.Scopes,
 		arg.ExpiresAt,
+		arg.ParentToken,
+		arg.ImpersonatorID,
+		arg.MfaPending,
 	)
 	return err
 }
@@ -98,10 +98,18 @@ type CreateOrganizationParams struct {
 	CreatedBy int64  `json:"createdBy"`
 }
 
+type CreateOrganizationRow struct {
+	ID        int64              `json:"id"`
+	Name      string             `json:"name"`
+	CreatedBy int64              `json:"createdBy"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+}
+
 // Organization queries
-func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organization, error) {
+func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (CreateOrganizationRow, error) {
 	row := q.db.QueryRow(ctx, createOrganization, arg.Name, arg.CreatedBy)
-	var i Organization
+	var i CreateOrganizationRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
@@ -180,9 +188,17 @@ FROM organizations
 WHERE id = $1
 `
 
-func (q *Queries) GetOrganizationByID(ctx context.Context, id int64) (Organization, error) {
+type GetOrganizationByIDRow struct {
+	ID        int64              `json:"id"`
+	Name      string             `json:"name"`
+	CreatedBy int64              `json:"createdBy"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+}
+
+func (q *Queries) GetOrganizationByID(ctx context.Context, id int64) (GetOrganizationByIDRow, error) {
 	row := q.db.QueryRow(ctx, getOrganizationByID, id)
-	var i Organization
+	var i GetOrganizationByIDRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
@@ -199,9 +215,17 @@ FROM organizations
 WHERE name = $1
 `
 
-func (q *Queries) GetOrganizationByName(ctx context.Context, name string) (Organization, error) {
+type GetOrganizationByNameRow struct {
+	ID        int64              `json:"id"`
+	Name      string             `json:"name"`
+	CreatedBy int64              `json:"createdBy"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+}
+
+func (q *Queries) GetOrganizationByName(ctx context.Context, name string) (GetOrganizationByNameRow, error) {
 	row := q.db.QueryRow(ctx, getOrganizationByName, name)
-	var i Organization
+	var i GetOrganizationByNameRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
@@ -334,6 +358,45 @@ func (q *Queries) IsOrganizationNameUnique(ctx context.Context, name string) (bo
 	return is_unique, err
 }
 
+const listAllUserNotifications = `-- name: ListAllUserNotifications :many
+SELECT id, user_id, dedupe_key, event, workspace_id, subject, body, data, read_at, created_at
+FROM notifications
+WHERE user_id = $1
+ORDER BY id
+`
+
+// lists every notification of a user, oldest first, for their data export.
+func (q *Queries) ListAllUserNotifications(ctx context.Context, userID int64) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, listAllUserNotifications, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Notification{}
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.DedupeKey,
+			&i.Event,
+			&i.WorkspaceID,
+			&i.Subject,
+			&i.Body,
+			&i.Data,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOrganizationMembers = `-- name: ListOrganizationMembers :many
 SELECT organization_id, user_id
 FROM organization_members
@@ -366,6 +429,50 @@ func (q *Queries) ListOrganizationMembers(ctx context.Context, organizationID in
 	return items, nil
 }
 
+const listSolelyOwnedOrganizations = `-- name: ListSolelyOwnedOrganizations :many
+SELECT o.id, o.name, o.created_by, o.created_at, o.updated_at, o.deletion_protection, o.require_mfa
+FROM organizations o
+WHERE (o.created_by = $1
+       OR EXISTS (
+         SELECT 1 FROM user_scopes us
+         WHERE us.user_id = $1 AND us.scope = 'admin' AND us.entity_type = 'organization' AND us.entity_id = o.id
+       ))
+  AND NOT EXISTS (
+    SELECT 1 FROM user_scopes us
+    WHERE us.user_id <> $1 AND us.scope = 'admin' AND us.entity_type = 'organization' AND us.entity_id = o.id
+  )
+ORDER BY o.id
+`
+
+// lists the organizations a user administers or created that no other user administers.
+func (q *Queries) ListSolelyOwnedOrganizations(ctx context.Context, userID int64) ([]Organization, error) {
+	rows, err := q.db.Query(ctx, listSolelyOwnedOrganizations, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Organization{}
+	for rows.Next() {
+		var i Organization
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletionProtection,
+			&i.RequireMfa,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listUserOrganizations = `-- name: ListUserOrganizations :many
 SELECT DISTINCT o.id, o.name, o.created_by, o.created_at, o.updated_at
 FROM organizations o
@@ -374,15 +481,23 @@ WHERE om.user_id = $1
 ORDER BY o.created_at DESC
 `
 
-func (q *Queries) ListUserOrganizations(ctx context.Context, userID int64) ([]Organization, error) {
+type ListUserOrganizationsRow struct {
+	ID        int64              `json:"id"`
+	Name      string             `json:"name"`
+	CreatedBy int64              `json:"createdBy"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+}
+
+func (q *Queries) ListUserOrganizations(ctx context.Context, userID int64) ([]ListUserOrganizationsRow, error) {
 	rows, err := q.db.Query(ctx, listUserOrganizations, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Organization
+	var items []ListUserOrganizationsRow
 	for rows.Next() {
-		var i Organization
+		var i ListUserOrganizationsRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
@@ -408,15 +523,25 @@ WHERE wm.user_id = $1
 ORDER BY w.created_at DESC
 `
 
-func (q *Queries) ListUserWorkspaces(ctx context.Context, userID int64) ([]Workspace, error) {
+type ListUserWorkspacesRow struct {
+	ID          int64              `json:"id"`
+	OrgID       int64              `json:"orgId"`
+	Name        string             `json:"name"`
+	Description pgtype.Text        `json:"description"`
+	CreatedBy   int64              `json:"createdBy"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamptz `json:"updatedAt"`
+}
+
+func (q *Queries) ListUserWorkspaces(ctx context.Context, userID int64) ([]ListUserWorkspacesRow, error) {
 	rows, err := q.db.Query(ctx, listUserWorkspaces, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Workspace
+	var items []ListUserWorkspacesRow
 	for rows.Next() {
-		var i Workspace
+		var i ListUserWorkspacesRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.OrgID,
@@ -543,6 +668,35 @@ func (q *Queries) RemoveWorkspaceMember(ctx context.Context, arg RemoveWorkspace
 	return err
 }
 
+const transferCreatedOrganizations = `-- name: TransferCreatedOrganizations :exec
+UPDATE organizations o
+SET created_by = (
+      SELECT MIN(us.user_id) FROM user_scopes us
+      WHERE us.user_id <> $1 AND us.scope = 'admin' AND us.entity_type = 'organization' AND us.entity_id = o.id
+    ),
+    updated_at = NOW()
+WHERE o.created_by = $1
+`
+
+// hands the organizations a user created to another of their admins, before the user is deleted.
+func (q *Queries) TransferCreatedOrganizations(ctx context.Context, userID int64) error {
+	_, err := q.db.Exec(ctx, transferCreatedOrganizations, userID)
+	return err
+}
+
+const transferCreatedWorkspaces = `-- name: TransferCreatedWorkspaces :exec
+UPDATE workspaces w
+SET created_by = o.created_by, updated_at = NOW()
+FROM organizations o
+WHERE o.id = w.org_id AND w.created_by = $1
+`
+
+// hands the workspaces a user created to the creator of their organization, before the user is deleted.
+func (q *Queries) TransferCreatedWorkspaces(ctx context.Context, createdBy int64) error {
+	_, err := q.db.Exec(ctx, transferCreatedWorkspaces, createdBy)
+	return err
+}
+
 const updateUserAvatarURL = `-- name: UpdateUserAvatarURL :one
 UPDATE users
 SET avatar_url = $2, updated_at = NOW()
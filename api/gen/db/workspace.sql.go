@@ -11,9 +11,36 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const archiveWorkspace = `-- name: ArchiveWorkspace :one
+UPDATE workspaces
+SET archived_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND archived_at IS NULL
+RETURNING id, org_id, name, description, created_by, created_at, updated_at, deployment_history_limit, external_id, labels, archived_at, block_critical_vulnerabilities
+`
+
+func (q *Queries) ArchiveWorkspace(ctx context.Context, id int64) (Workspace, error) {
+	row := q.db.QueryRow(ctx, archiveWorkspace, id)
+	var i Workspace
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeploymentHistoryLimit,
+		&i.ExternalID,
+		&i.Labels,
+		&i.ArchivedAt,
+		&i.BlockCriticalVulnerabilities,
+	)
+	return i, err
+}
+
 const createWorkspace = `-- name: CreateWorkspace :one
-INSERT INTO workspaces (org_id, name, description, created_by)
-VALUES ($1, $2, $3, $4)
+INSERT INTO workspaces (org_id, name, description, created_by, external_id, labels)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING id
 `
 
@@ -22,6 +49,8 @@ type CreateWorkspaceParams struct {
 	Name        string      `json:"name"`
 	Description pgtype.Text `json:"description"`
 	CreatedBy   int64       `json:"createdBy"`
+	ExternalID  pgtype.Text `json:"externalId"`
+	Labels      []byte      `json:"labels"`
 }
 
 func (q *Queries) CreateWorkspace(ctx context.Context, arg CreateWorkspaceParams) (int64, error) {
@@ -30,6 +59,8 @@ func (q *Queries) CreateWorkspace(ctx context.Context, arg CreateWorkspaceParams
 		arg.Name,
 		arg.Description,
 		arg.CreatedBy,
+		arg.ExternalID,
+		arg.Labels,
 	)
 	var id int64
 	err := row.Scan(&id)
@@ -62,8 +93,37 @@ func (q *Queries) GetOrganizationIDByWorkspaceID(ctx context.Context, id int64)
 	return org_id, err
 }
 
+const getWorkspaceByExternalID = `-- name: GetWorkspaceByExternalID :one
+SELECT id, org_id, name, description, created_by, created_at, updated_at, deployment_history_limit, external_id, labels, archived_at, block_critical_vulnerabilities FROM workspaces WHERE org_id = $1 AND external_id = $2
+`
+
+type GetWorkspaceByExternalIDParams struct {
+	OrgID      int64       `json:"orgId"`
+	ExternalID pgtype.Text `json:"externalId"`
+}
+
+func (q *Queries) GetWorkspaceByExternalID(ctx context.Context, arg GetWorkspaceByExternalIDParams) (Workspace, error) {
+	row := q.db.QueryRow(ctx, getWorkspaceByExternalID, arg.OrgID, arg.ExternalID)
+	var i Workspace
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeploymentHistoryLimit,
+		&i.ExternalID,
+		&i.Labels,
+		&i.ArchivedAt,
+		&i.BlockCriticalVulnerabilities,
+	)
+	return i, err
+}
+
 const getWorkspaceByIDQuery = `-- name: GetWorkspaceByIDQuery :one
-SELECT id, org_id, name, description, created_by, created_at, updated_at FROM workspaces WHERE id = $1
+SELECT id, org_id, name, description, created_by, created_at, updated_at, deployment_history_limit, external_id, labels, archived_at, block_critical_vulnerabilities FROM workspaces WHERE id = $1
 `
 
 func (q *Queries) GetWorkspaceByIDQuery(ctx context.Context, id int64) (Workspace, error) {
@@ -77,6 +137,11 @@ func (q *Queries) GetWorkspaceByIDQuery(ctx context.Context, id int64) (Workspac
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeploymentHistoryLimit,
+		&i.ExternalID,
+		&i.Labels,
+		&i.ArchivedAt,
+		&i.BlockCriticalVulnerabilities,
 	)
 	return i, err
 }
@@ -140,6 +205,20 @@ func (q *Queries) GetWorkspaceOrgID(ctx context.Context, id int64) (int64, error
 	return org_id, err
 }
 
+const isResourceWorkspaceArchived = `-- name: IsResourceWorkspaceArchived :one
+SELECT (w.archived_at IS NOT NULL)::boolean AS archived
+FROM resources r
+JOIN workspaces w ON w.id = r.workspace_id
+WHERE r.id = $1
+`
+
+func (q *Queries) IsResourceWorkspaceArchived(ctx context.Context, id int64) (bool, error) {
+	row := q.db.QueryRow(ctx, isResourceWorkspaceArchived, id)
+	var archived bool
+	err := row.Scan(&archived)
+	return archived, err
+}
+
 const isWorkspaceMember = `-- name: IsWorkspaceMember :one
 SELECT EXISTS(
   SELECT 1 FROM workspace_members
@@ -238,27 +317,34 @@ func (q *Queries) ListWorkspaceMembersWithUserDetails(ctx context.Context, arg L
 }
 
 const listWorkspacesForUser = `-- name: ListWorkspacesForUser :many
-SELECT DISTINCT w.id, w.org_id, w.name, w.description, w.created_by, w.created_at, w.updated_at
+SELECT DISTINCT w.id, w.org_id, w.name, w.description, w.created_by, w.created_at, w.updated_at, w.deployment_history_limit, w.external_id, w.labels, w.archived_at, w.block_critical_vulnerabilities
 FROM workspaces w
 JOIN workspace_members wm ON wm.workspace_id = w.id
 WHERE wm.user_id = $1
-  AND ($3::text IS NULL
+  AND ($3::boolean OR w.archived_at IS NULL)
+  AND ($4::text IS NULL
        OR (w.created_at, w.id) < (
-         (SELECT created_at FROM workspaces WHERE id = $3::bigint),
-         $3::bigint
+         (SELECT created_at FROM workspaces WHERE id = $4::bigint),
+         $4::bigint
        ))
 ORDER BY w.created_at DESC, w.id DESC
 LIMIT $2
 `
 
 type ListWorkspacesForUserParams struct {
-	UserID    int64       `json:"userId"`
-	Limit     int32       `json:"limit"`
-	PageToken pgtype.Text `json:"pageToken"`
+	UserID          int64       `json:"userId"`
+	Limit           int32       `json:"limit"`
+	IncludeArchived bool        `json:"includeArchived"`
+	PageToken       pgtype.Text `json:"pageToken"`
 }
 
 func (q *Queries) ListWorkspacesForUser(ctx context.Context, arg ListWorkspacesForUserParams) ([]Workspace, error) {
-	rows, err := q.db.Query(ctx, listWorkspacesForUser, arg.UserID, arg.Limit, arg.PageToken)
+	rows, err := q.db.Query(ctx, listWorkspacesForUser,
+		arg.UserID,
+		arg.Limit,
+		arg.IncludeArchived,
+		arg.PageToken,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -274,6 +360,11 @@ func (q *Queries) ListWorkspacesForUser(ctx context.Context, arg ListWorkspacesF
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DeploymentHistoryLimit,
+			&i.ExternalID,
+			&i.Labels,
+			&i.ArchivedAt,
+			&i.BlockCriticalVulnerabilities,
 		); err != nil {
 			return nil, err
 		}
@@ -286,25 +377,32 @@ func (q *Queries) ListWorkspacesForUser(ctx context.Context, arg ListWorkspacesF
 }
 
 const listWorkspacesInOrg = `-- name: ListWorkspacesInOrg :many
-SELECT w.id, w.org_id, w.name, w.description, w.created_by, w.created_at, w.updated_at FROM workspaces w
+SELECT w.id, w.org_id, w.name, w.description, w.created_by, w.created_at, w.updated_at, w.deployment_history_limit, w.external_id, w.labels, w.archived_at, w.block_critical_vulnerabilities FROM workspaces w
 WHERE w.org_id = $1
-  AND ($3::text IS NULL
+  AND ($3::boolean OR w.archived_at IS NULL)
+  AND ($4::text IS NULL
        OR (w.created_at, w.id) < (
-         (SELECT created_at FROM workspaces WHERE id = $3::bigint),
-         $3::bigint
+         (SELECT created_at FROM workspaces WHERE id = $4::bigint),
+         $4::bigint
        ))
 ORDER BY w.created_at DESC, w.id DESC
 LIMIT $2
 `
 
 type ListWorkspacesInOrgParams struct {
-	OrgID     int64       `json:"orgId"`
-	Limit     int32       `json:"limit"`
-	PageToken pgtype.Text `json:"pageToken"`
+	OrgID           int64       `json:"orgId"`
+	Limit           int32       `json:"limit"`
+	IncludeArchived bool        `json:"includeArchived"`
+	PageToken       pgtype.Text `json:"pageToken"`
 }
 
 func (q *Queries) ListWorkspacesInOrg(ctx context.Context, arg ListWorkspacesInOrgParams) ([]Workspace, error) {
-	rows, err := q.db.Query(ctx, listWorkspacesInOrg, arg.OrgID, arg.Limit, arg.PageToken)
+	rows, err := q.db.Query(ctx, listWorkspacesInOrg,
+		arg.OrgID,
+		arg.Limit,
+		arg.IncludeArchived,
+		arg.PageToken,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -320,6 +418,11 @@ func (q *Queries) ListWorkspacesInOrg(ctx context.Context, arg ListWorkspacesInO
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DeploymentHistoryLimit,
+			&i.ExternalID,
+			&i.Labels,
+			&i.ArchivedAt,
+			&i.BlockCriticalVulnerabilities,
 		); err != nil {
 			return nil, err
 		}
@@ -340,23 +443,63 @@ func (q *Queries) RemoveWorkspace(ctx context.Context, id int64) error {
 	return err
 }
 
+const unarchiveWorkspace = `-- name: UnarchiveWorkspace :one
+UPDATE workspaces
+SET archived_at = NULL, updated_at = NOW()
+WHERE id = $1 AND archived_at IS NOT NULL
+RETURNING id, org_id, name, description, created_by, created_at, updated_at, deployment_history_limit, external_id, labels, archived_at, block_critical_vulnerabilities
+`
+
+func (q *Queries) UnarchiveWorkspace(ctx context.Context, id int64) (Workspace, error) {
+	row := q.db.QueryRow(ctx, unarchiveWorkspace, id)
+	var i Workspace
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeploymentHistoryLimit,
+		&i.ExternalID,
+		&i.Labels,
+		&i.ArchivedAt,
+		&i.BlockCriticalVulnerabilities,
+	)
+	return i, err
+}
+
 const updateWorkspace = `-- name: UpdateWorkspace :one
 UPDATE workspaces
 SET name = COALESCE($2, name),
     description = COALESCE($3, description),
+    deployment_history_limit = COALESCE($4, deployment_history_limit),
+    labels = COALESCE($5, labels),
+    block_critical_vulnerabilities = COALESCE($6, block_critical_vulnerabilities),
     updated_at = NOW()
 WHERE id = $1
 RETURNING id
 `
 
 type UpdateWorkspaceParams struct {
-	ID          int64       `json:"id"`
-	Name        pgtype.Text `json:"name"`
-	Description pgtype.Text `json:"description"`
+	ID                           int64       `json:"id"`
+	Name                         pgtype.Text `json:"name"`
+	Description                  pgtype.Text `json:"description"`
+	DeploymentHistoryLimit       pgtype.Int4 `json:"deploymentHistoryLimit"`
+	Labels                       []byte      `json:"labels"`
+	BlockCriticalVulnerabilities pgtype.Bool `json:"blockCriticalVulnerabilities"`
 }
 
 func (q *Queries) UpdateWorkspace(ctx context.Context, arg UpdateWorkspaceParams) (int64, error) {
-	row := q.db.QueryRow(ctx, updateWorkspace, arg.ID, arg.Name, arg.Description)
+	row := q.db.QueryRow(ctx, updateWorkspace,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.DeploymentHistoryLimit,
+		arg.Labels,
+		arg.BlockCriticalVulnerabilities,
+	)
 	var id int64
 	err := row.Scan(&id)
 	return id, err
@@ -14,6 +14,7 @@ require (
 	github.com/rs/cors v1.11.1
 	github.com/team-loco/loco/controller v0.0.0
 	github.com/team-loco/loco/shared v0.0.0
+	golang.org/x/crypto v0.46.0
 	golang.org/x/oauth2 v0.34.0
 	k8s.io/api v0.35.0
 	k8s.io/apimachinery v0.35.0
@@ -100,7 +101,7 @@ require (
 	sigs.k8s.io/json v0.0.0-20250730193827-2d320260d730 // indirect
 	sigs.k8s.io/randfill v1.0.0 // indirect
 	sigs.k8s.io/structured-merge-diff/v6 v6.3.1 // indirect
-	sigs.k8s.io/yaml v1.6.0 // indirect
+	sigs.k8s.io/yaml v1.6.0
 )
 
 replace github.com/team-loco/loco/shared => ../shared
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/team-loco/loco/api/db"
+	"github.com/team-loco/loco/api/pkg/kube"
+)
+
+// readinessTimeout bounds each dependency check, so a hung dependency fails the probe instead of stalling it.
+const readinessTimeout = 2 * time.Second
+
+// livenessHandler only reports that the process is serving HTTP. It must not check dependencies:
+// restarting the API does not fix an unreachable database.
+func livenessHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintln(w, "ok")
+}
+
+// readinessHandler reports whether the API can serve requests, i.e. the database and the Kubernetes
+// API server both answer. It responds 503 when either check fails, listing each check's result.
+func readinessHandler(dbConn *db.DB, kubeClient *kube.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		checks := []struct {
+			name  string
+			check func(ctx context.Context) error
+		}{
+			{"database", dbConn.Ping},
+			{"kubernetes", func(ctx context.Context) error {
+				return kubeClient.ClientSet.Discovery().RESTClient().Get().AbsPath("/version").Do(ctx).Error()
+			}},
+		}
+
+		status := http.StatusOK
+		results := make([]string, 0, len(checks))
+		for _, c := range checks {
+			ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+			err := c.check(ctx)
+			cancel()
+			if err != nil {
+				slog.WarnContext(r.Context(), "readiness check failed", "check", c.name, "error", err)
+				status = http.StatusServiceUnavailable
+				results = append(results, fmt.Sprintf("%s: %v", c.name, err))
+				continue
+			}
+			results = append(results, c.name+": ok")
+		}
+
+		w.WriteHeader(status)
+		for _, result := range results {
+			fmt.Fprintln(w, result)
+		}
+	}
+}
@@ -22,16 +22,23 @@ func (l CustomHandler) Handle(ctx context.Context, r slog.Record) error {
 	method := ctx.Value(contextkeys.MethodKey).(string)
 
 	// can be null on routes where oAuth Middleware is skipped.
-	entity := ctx.Value(contextkeys.EntityKey)
+	var entity any
+	if e, ok := contextkeys.Entity(ctx); ok {
+		entity = e
+	}
 
-	requestGroup := slog.Group(
-		"request",
+	attrs := []any{
 		slog.String("requestId", requestId),
 		slog.String("sourceIp", sourceIp),
 		slog.String("method", method),
 		slog.String("path", path),
 		slog.Any("entity", entity),
-	)
+	}
+	// requests made through an impersonation token name the admin behind them
+	if impersonator, ok := contextkeys.Impersonator(ctx); ok {
+		attrs = append(attrs, slog.Int64("impersonatorId", impersonator))
+	}
+	requestGroup := slog.Group("request", attrs...)
 
 	r.AddAttrs(requestGroup)
 
@@ -2,14 +2,15 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"log/slog"
 	"net/http"
-	"net/url"
 	"os"
 	"os/signal"
-	"strconv"
+	"slices"
+	"strings"
 	"syscall"
 	"time"
 
@@ -19,20 +20,60 @@ import (
 	charmLog "github.com/charmbracelet/log"
 
 	"github.com/rs/cors"
+	"github.com/team-loco/loco/api/config"
 	"github.com/team-loco/loco/api/db"
 	genDb "github.com/team-loco/loco/api/gen/db"
 	"github.com/team-loco/loco/api/middleware"
+	"github.com/team-loco/loco/api/migrations"
+	"github.com/team-loco/loco/api/pkg/alert"
+	"github.com/team-loco/loco/api/pkg/billing"
+	"github.com/team-loco/loco/api/pkg/changes"
+	"github.com/team-loco/loco/api/pkg/configrollout"
+	"github.com/team-loco/loco/api/pkg/customdomain"
+	"github.com/team-loco/loco/api/pkg/deprecation"
+	"github.com/team-loco/loco/api/pkg/digest"
+	"github.com/team-loco/loco/api/pkg/dnsrecord"
+	"github.com/team-loco/loco/api/pkg/drift"
+	"github.com/team-loco/loco/api/pkg/geodns"
+	"github.com/team-loco/loco/api/pkg/gitops"
+	"github.com/team-loco/loco/api/pkg/idle"
+	"github.com/team-loco/loco/api/pkg/imagescan"
 	"github.com/team-loco/loco/api/pkg/kube"
+	"github.com/team-loco/loco/api/pkg/notify"
+	"github.com/team-loco/loco/api/pkg/orgdeletion"
+	"github.com/team-loco/loco/api/pkg/probe"
+	"github.com/team-loco/loco/api/pkg/reconcile"
+	"github.com/team-loco/loco/api/pkg/registry"
+	"github.com/team-loco/loco/api/pkg/requestid"
+	"github.com/team-loco/loco/api/pkg/rest"
+	"github.com/team-loco/loco/api/pkg/retention"
+	"github.com/team-loco/loco/api/pkg/scim"
+	"github.com/team-loco/loco/api/pkg/slack"
+	"github.com/team-loco/loco/api/pkg/statuspage"
 	"github.com/team-loco/loco/api/pkg/statuswatcher"
+	"github.com/team-loco/loco/api/pkg/totp"
 	"github.com/team-loco/loco/api/service"
 	"github.com/team-loco/loco/api/tvm"
 	"github.com/team-loco/loco/shared"
+	"github.com/team-loco/loco/shared/proto/admin/v1/adminv1connect"
+	"github.com/team-loco/loco/shared/proto/alert/v1/alertv1connect"
+	"github.com/team-loco/loco/shared/proto/announcement/v1/announcementv1connect"
+	"github.com/team-loco/loco/shared/proto/approval/v1/approvalv1connect"
+	"github.com/team-loco/loco/shared/proto/billing/v1/billingv1connect"
+	"github.com/team-loco/loco/shared/proto/configgroup/v1/configgroupv1connect"
 	"github.com/team-loco/loco/shared/proto/deployment/v1/deploymentv1connect"
 	"github.com/team-loco/loco/shared/proto/domain/v1/domainv1connect"
+	"github.com/team-loco/loco/shared/proto/environment/v1/environmentv1connect"
+	"github.com/team-loco/loco/shared/proto/notification/v1/notificationv1connect"
 	"github.com/team-loco/loco/shared/proto/oauth/v1/oauthv1connect"
 	"github.com/team-loco/loco/shared/proto/org/v1/orgv1connect"
+	"github.com/team-loco/loco/shared/proto/policy/v1/policyv1connect"
 	"github.com/team-loco/loco/shared/proto/registry/v1/registryv1connect"
 	"github.com/team-loco/loco/shared/proto/resource/v1/resourcev1connect"
+	"github.com/team-loco/loco/shared/proto/secret/v1/secretv1connect"
+	"github.com/team-loco/loco/shared/proto/serviceaccount/v1/serviceaccountv1connect"
+	"github.com/team-loco/loco/shared/proto/slack/v1/slackv1connect"
+	"github.com/team-loco/loco/shared/proto/template/v1/templatev1connect"
 	"github.com/team-loco/loco/shared/proto/token/v1/tokenv1connect"
 	"github.com/team-loco/loco/shared/proto/user/v1/userv1connect"
 	"github.com/team-loco/loco/shared/proto/workspace/v1/workspacev1connect"
@@ -40,112 +81,218 @@ import (
 	"golang.org/x/net/http2/h2c"
 )
 
+// ApiConfig is read from the environment and, when CONFIG_FILE is set, from that YAML file; see the config package.
 type ApiConfig struct {
-	Env             string // Environment (e.g., dev, prod)
-	ProjectID       string // GitLab project ID
-	GitlabURL       string // Container registry URL
-	RegistryURL     string // Container registry URL
-	DeployTokenName string // Deploy token name
-	GitlabPAT       string // GitLab Personal Access Token
-	DatabaseURL     string // PostgreSQL connection string
-	LogLevel        slog.Level
-	Port            string
-	RegistryTag     string
-	LocoNamespace   string // Loco system namespace
-	LocoDomainBase  string // Base domain (e.g., deploy-app.com)
-	LocoDomainAPI   string // API domain (e.g., api.deploy-app.com)
+	Env             string        `env:"APP_ENV"`                      // Environment (e.g., dev, prod)
+	ProjectID       string        `env:"GITLAB_PROJECT_ID"`            // GitLab project ID
+	GitlabURL       string        `env:"GITLAB_URL"`                   // Container registry URL
+	RegistryURL     string        `env:"GITLAB_REGISTRY_URL"`          // Container registry URL
+	DeployTokenName string        `env:"GITLAB_DEPLOY_TOKEN_NAME"`     // Deploy token name
+	GitlabPAT       string        `env:"GITLAB_PAT"`                   // GitLab Personal Access Token
+	DatabaseURL     string        `env:"DATABASE_URL" required:"true"` // PostgreSQL connection string
+	ReplicaURL      string        `env:"DATABASE_REPLICA_URL"`         // read replica connection string; read-only queries go to it when set
+	MigrateOnStart  bool          `env:"MIGRATE_ON_START"`             // apply pending migrations before serving
+	DBPool          db.PoolConfig // DB_* settings; unset values fall back to db.DefaultPoolConfig
+	LogLevel        slog.Level    `env:"LOG_LEVEL"`
+	Port            string        `env:"PORT"`
+	RegistryTag     string        `env:"REGISTRY_TAG"`
+	LocoNamespace   string        `env:"LOCO_NAMESPACE" required:"true"` // Loco system namespace
+	LocoDomainBase  string        `env:"LOCO_DOMAIN_BASE"`               // Base domain (e.g., deploy-app.com)
+	LocoDomainAPI   string        `env:"LOCO_DOMAIN_API"`                // API domain (e.g., api.deploy-app.com)
+
+	DeploymentPruneInterval time.Duration `env:"DEPLOYMENT_PRUNE_INTERVAL"`        // how often old deployments are pruned
+	DriftCheckInterval      time.Duration `env:"DRIFT_CHECK_INTERVAL"`             // how often active deployments are compared with the cluster
+	DriftAutoRemediate      bool          `env:"DRIFT_AUTO_REMEDIATE"`             // re-apply the desired state when drift is found
+	DeploymentTimeout       time.Duration `env:"DEPLOYMENT_TIMEOUT"`               // how long a deployment may stay pending or deploying before it is failed
+	WatchdogInterval        time.Duration `env:"DEPLOYMENT_WATCHDOG_INTERVAL"`     // how often deployments are checked against DEPLOYMENT_TIMEOUT
+	ReconcileInterval       time.Duration `env:"RECONCILE_INTERVAL"`               // how often active deployments are compared with the cluster's Applications
+	ReconcileRepair         bool          `env:"RECONCILE_REPAIR" default:"true"`  // recreate missing and stale Applications
+	ClusterRegion           string        `env:"LOCO_REGION"`                      // region of the cluster the API manages; empty when unknown
+	TokenCacheTTL           time.Duration `env:"TOKEN_CACHE_TTL" default:"30s"`    // how long verified tokens are cached per replica; 0 disables
+	RefreshTokenTTL         time.Duration `env:"REFRESH_TOKEN_TTL" default:"720h"` // how long a login session lasts unused; 0 disables refresh tokens
+	PlatformAdminEmails     []string      `env:"PLATFORM_ADMIN_EMAILS"`            // space-separated; made platform admins when they log in
+	MFAEncryptionKey        string        `env:"MFA_ENCRYPTION_KEY"`               // base64 32 byte key TOTP secrets are sealed with; enables MFA when set
+	HealthProbeInterval     time.Duration `env:"HEALTH_PROBE_INTERVAL"`            // how often each service's primary domain is probed
+	AlertEvaluationInterval time.Duration `env:"ALERT_EVALUATION_INTERVAL"`        // how often each alert rule is evaluated
+	DomainCheckInterval     time.Duration `env:"CUSTOM_DOMAIN_CHECK_INTERVAL"`     // how often pending custom domains are looked up
+
+	CloudflareAPIToken  string `env:"CLOUDFLARE_API_TOKEN"` // enables geo DNS for multi-region resources when set
+	CloudflareAccountID string `env:"CLOUDFLARE_ACCOUNT_ID" requiredWith:"CLOUDFLARE_API_TOKEN"`
+	CloudflareZoneID    string `env:"CLOUDFLARE_ZONE_ID" requiredWith:"CLOUDFLARE_API_TOKEN"`
+	GeoDNSSteering      string `env:"GEO_DNS_STEERING"` // "dynamic_latency" (default) or "geo"
+
+	GitOpsRepo        string        `env:"GITOPS_REPO"`                                    // GitHub repo ("owner/name") of manifests; enables the gitops syncer when set
+	GitOpsBranch      string        `env:"GITOPS_BRANCH"`                                  // defaults to main
+	GitOpsPath        string        `env:"GITOPS_PATH" default:"loco"`                     // directory of manifests within the repo
+	GitOpsGitHubToken string        `env:"GITOPS_GITHUB_TOKEN" requiredWith:"GITOPS_REPO"` // reads the repo and writes commit statuses
+	GitOpsLocoToken   string        `env:"GITOPS_LOCO_TOKEN" requiredWith:"GITOPS_REPO"`   // loco API token changes are applied with
+	GitOpsInterval    time.Duration `env:"GITOPS_INTERVAL"`
+
+	OAuth   service.OAuthConfig // identity providers besides GitHub
+	CORS    CORSConfig          // CORS_* settings for the web UI; defaults to the base domain, plus localhost outside production
+	Notify  notify.Config       // NOTIFY_*, SMTP_* and SES_* settings; email notifications are off without a provider
+	Slack   slack.Config        // SLACK_* settings of the Slack app; the integration is off without a client ID
+	Billing billing.Config      // STRIPE_* settings; usage is metered but nothing is charged without a secret key
+	Idle    idle.Config         // IDLE_* settings; free-tier services never sleep without a Prometheus URL
+	Digest  digest.Config       // DIGEST_* settings; weekly workspace digests are stored but not sent unless enabled
+	DNS     dnsrecord.Config    // DNS_* settings; platform subdomains get no records without a provider
+
+	ImageScan imagescan.Config // IMAGE_SCAN_* and TRIVY_* settings; deployed images are not scanned unless enabled
+	Registry  registry.Config  // IMAGE_PIN_DIGESTS and REGISTRY_* settings for resolving deployed images to digests
 }
 
-func newApiConfig() *ApiConfig {
-	logLevelStr := os.Getenv("LOG_LEVEL")
-	logLevel := slog.LevelInfo
-	if logLevelStr != "" {
-		if parsed, err := strconv.Atoi(logLevelStr); err == nil {
-			logLevel = slog.Level(parsed)
-		}
+// deprecatedSurfaces adds sunset dates and migration links to deprecated RPCs and
+// request fields. Anything marked `deprecated = true` in the protos is reported
+// even without an entry here.
+var deprecatedSurfaces = deprecation.Registry{}
+
+// newApiConfig loads the configuration, returning a *config.Error listing every misconfigured setting.
+func newApiConfig() (*ApiConfig, error) {
+	// defaults that live next to the code using them
+	ac := &ApiConfig{
+		LogLevel:                slog.LevelInfo,
+		DeploymentPruneInterval: retention.DefaultInterval,
+		DriftCheckInterval:      drift.DefaultInterval,
+		ReconcileInterval:       reconcile.DefaultInterval,
+		GitOpsInterval:          gitops.DefaultInterval,
+		DeploymentTimeout:       statuswatcher.DefaultDeploymentTimeout,
+		WatchdogInterval:        statuswatcher.DefaultWatchdogInterval,
 	}
-
-	return &ApiConfig{
-		Env:             os.Getenv("APP_ENV"),
-		ProjectID:       os.Getenv("GITLAB_PROJECT_ID"),
-		GitlabURL:       os.Getenv("GITLAB_URL"),
-		RegistryURL:     os.Getenv("GITLAB_REGISTRY_URL"),
-		DeployTokenName: os.Getenv("GITLAB_DEPLOY_TOKEN_NAME"),
-		GitlabPAT:       os.Getenv("GITLAB_PAT"),
-		DatabaseURL:     os.Getenv("DATABASE_URL"),
-		Port:            os.Getenv("PORT"),
-		LogLevel:        logLevel,
-		RegistryTag:     os.Getenv("REGISTRY_TAG"),
-		LocoNamespace:   os.Getenv("LOCO_NAMESPACE"),
-		LocoDomainBase:  os.Getenv("LOCO_DOMAIN_BASE"),
-		LocoDomainAPI:   os.Getenv("LOCO_DOMAIN_API"),
+	if err := config.Load(ac, os.Getenv("CONFIG_FILE")); err != nil {
+		return nil, err
 	}
+	return ac, nil
+}
+
+// CORSConfig decides which web UIs may call the API from a browser.
+type CORSConfig struct {
+	AllowedOrigins   []string      `env:"CORS_ALLOWED_ORIGINS"`                  // space-separated, e.g. https://app.example.com; each may hold one * wildcard
+	AllowCredentials bool          `env:"CORS_ALLOW_CREDENTIALS" default:"true"` // let browsers send cookies with cross-origin requests
+	MaxAge           time.Duration `env:"CORS_MAX_AGE" default:"10m"`            // how long browsers may cache a preflight response
 }
 
-func isAllowedOrigin(hostname, baseDomain string) bool {
-	if hostname == "localhost" {
-		return true
+// isProduction reports whether APP_ENV names a production environment.
+func isProduction(env string) bool {
+	return strings.EqualFold(env, "prod") || strings.EqualFold(env, "production")
+}
+
+// origins returns the configured origins or, when none are, the web UI on the base domain, and
+// outside production a UI served from localhost on any port.
+func (c CORSConfig) origins(env, baseDomain string) []string {
+	if len(c.AllowedOrigins) > 0 {
+		return c.AllowedOrigins
 	}
-	if baseDomain == "" {
-		return false
+	var origins []string
+	if baseDomain != "" {
+		origins = append(origins, "https://"+baseDomain, "https://www."+baseDomain)
 	}
-	return hostname == baseDomain || hostname == "www."+baseDomain
+	if !isProduction(env) {
+		origins = append(origins, "http://localhost", "http://localhost:*", "https://localhost", "https://localhost:*")
+	}
+	return origins
 }
 
-func withCORS(baseDomain string) func(http.Handler) http.Handler {
+func withCORS(c CORSConfig, env, baseDomain string) func(http.Handler) http.Handler {
+	origins := c.origins(env, baseDomain)
+	slog.Info("allowing cross-origin requests", "origins", origins, "credentials", c.AllowCredentials)
 	return func(h http.Handler) http.Handler {
 		middleware := cors.New(cors.Options{
-			AllowOriginFunc: func(origin string) bool {
-				u, err := url.Parse(origin)
-				if err != nil {
-					return false
-				}
-				return isAllowedOrigin(u.Hostname(), baseDomain)
-			},
+			AllowedOrigins:   origins,
 			AllowedMethods:   connectcors.AllowedMethods(),
 			AllowedHeaders:   connectcors.AllowedHeaders(),
-			ExposedHeaders:   connectcors.ExposedHeaders(),
-			AllowCredentials: true,
+			ExposedHeaders:   append(connectcors.ExposedHeaders(), requestid.Header),
+			AllowCredentials: c.AllowCredentials,
+			MaxAge:           int(c.MaxAge.Seconds()),
 		})
 		return middleware.Handler(h)
 	}
 }
 
 func main() {
-	ac := newApiConfig()
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	ac, err := newApiConfig()
+	if err != nil {
+		var cfgErr *config.Error
+		if errors.As(err, &cfgErr) {
+			for _, problem := range cfgErr.Problems {
+				slog.Error("misconfigured setting", "key", problem.Key, "problem", problem.Message)
+			}
+			os.Exit(1)
+		}
+		log.Fatal(err)
+	}
 
-	dbConn, err := db.NewDB(context.Background(), ac.DatabaseURL)
+	dbConn, err := db.NewDB(context.Background(), ac.DatabaseURL, ac.ReplicaURL, ac.DBPool)
 	if err != nil {
 		log.Fatal(err)
 	}
 	defer dbConn.Close()
 
+	if ac.MigrateOnStart {
+		migrator, err := db.NewMigrator(dbConn.Pool(), migrations.FS)
+		if err != nil {
+			log.Fatal(err)
+		}
+		applied, err := migrator.Up(context.Background())
+		if err != nil {
+			log.Fatal(err)
+		}
+		slog.Info("migrations up to date", "applied", applied)
+	}
+
 	pool := dbConn.Pool()
-	queries := genDb.New(pool)
+	queries := genDb.New(dbConn.Conn())
 
-	machine := tvm.NewVendingMachine(pool, queries, tvm.Config{
+	var mfaSealer *totp.Sealer
+	if ac.MFAEncryptionKey != "" {
+		mfaSealer, err = totp.NewSealer(ac.MFAEncryptionKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// tokens are verified against the primary so a token works on the request right after it is issued
+	machine := tvm.NewVendingMachine(pool, genDb.New(pool), tvm.Config{
 		MaxTokenDuration:   time.Hour * 24 * 30,
 		LoginTokenDuration: time.Hour * 1,
+		CacheTTL:           ac.TokenCacheTTL,
+
+		RefreshTokenDuration: ac.RefreshTokenTTL,
+
+		PlatformAdminEmails: ac.PlatformAdminEmails,
+		MFASealer:           mfaSealer,
 	})
 
 	logger := slog.New(CustomHandler{Handler: getLoggerHandler(ac)})
 	slog.SetDefault(logger)
 
 	mux := http.NewServeMux()
-	interceptors := connect.WithInterceptors(middleware.NewGithubAuthInterceptor(machine))
+	deprecationTracker := deprecation.NewTracker(queries, deprecation.DefaultFlushInterval)
+	interceptors := connect.WithInterceptors(
+		middleware.NewGithubAuthInterceptor(machine),
+		middleware.NewImpersonationAuditInterceptor(genDb.New(pool)),
+		middleware.NewDeprecationInterceptor(deprecatedSurfaces, deprecationTracker),
+	)
 
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		fmt.Fprintln(w, "Loco Service is Running")
 	})
 
-	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprintln(w, "Server is healthy.")
-	})
-
 	kubeClient := kube.NewClient(ac.Env)
 
+	// /livez and /readyz back the API's own liveness and readiness probes; /health is kept for
+	// existing monitors and checks the same dependencies as /readyz.
+	mux.HandleFunc("/livez", livenessHandler)
+	mux.HandleFunc("/readyz", readinessHandler(dbConn, kubeClient))
+	mux.HandleFunc("/health", readinessHandler(dbConn, kubeClient))
+
 	watcher := statuswatcher.NewStatusWatcher(kubeClient, queries)
 	watcherCtx, watcherCancel := context.WithCancel(context.Background())
 	defer watcherCancel()
@@ -156,21 +303,207 @@ func main() {
 		}
 	}()
 
+	feed := changes.NewFeed(pool)
+	go func() {
+		if err := feed.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("change feed failed", "error", err)
+		}
+	}()
+
+	// tokens revoked and roles changed through other replicas are dropped from this replica's token cache,
+	// and the whole cache is flushed when such a change may have been missed
+	tokenChanges, stopTokenChanges := feed.SubscribeOrFlush(func(e changes.Event) bool {
+		return e.Table == changes.TableTokens || e.Table == changes.TableUserScopes
+	}, machine.FlushTokenCache)
+	defer stopTokenChanges()
+	go func() {
+		for {
+			select {
+			case <-watcherCtx.Done():
+				return
+			case e := <-tokenChanges:
+				machine.InvalidateEntityTokens(genDb.Entity{Type: genDb.EntityType(e.EntityType), ID: e.EntityID}, e.Name)
+			}
+		}
+	}()
+
+	go func() {
+		if err := deprecationTracker.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("deprecated usage tracker failed", "error", err)
+		}
+	}()
+
+	watchdog := statuswatcher.NewWatchdog(kubeClient, queries, ac.LocoNamespace, ac.WatchdogInterval, ac.DeploymentTimeout)
+	go func() {
+		if err := watchdog.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("deployment watchdog failed", "error", err)
+		}
+	}()
+
+	pruner := retention.NewPruner(queries, ac.DeploymentPruneInterval)
+	go func() {
+		if err := pruner.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("deployment history pruner failed", "error", err)
+		}
+	}()
+
+	detector := drift.NewDetector(kubeClient, queries, ac.LocoNamespace, ac.DriftCheckInterval, ac.DriftAutoRemediate)
+	go func() {
+		if err := detector.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("drift detector failed", "error", err)
+		}
+	}()
+
 	httpClient := shared.NewHTTPClient()
+	httpClient.Transport = requestid.Transport(httpClient.Transport)
+
+	if ac.CloudflareAPIToken != "" {
+		provider := geodns.NewCloudflareProvider(httpClient, ac.CloudflareAPIToken, ac.CloudflareAccountID, ac.CloudflareZoneID, ac.GeoDNSSteering)
+		dnsSyncer := geodns.NewSyncer(queries, provider, geodns.DefaultInterval)
+		go func() {
+			if err := dnsSyncer.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+				slog.Error("geo dns syncer failed", "error", err)
+			}
+		}()
+	}
 
-	oAuthServiceHandler, err := service.NewOAuthServer(pool, queries, httpClient, machine)
+	dnsProvider, err := dnsrecord.NewProvider(ac.DNS, httpClient)
 	if err != nil {
 		log.Fatal(err)
 	}
-	userServiceHandler := service.NewUserServer(pool, queries, machine)
-	orgServiceHandler := service.NewOrgServer(pool, queries, machine)
-	workspaceServiceHandler := service.NewWorkspaceServer(pool, queries, machine)
-	resourceServiceHandler := service.NewResourceServer(pool, queries, machine, kubeClient, ac.LocoNamespace)
-	deploymentServiceHandler := service.NewDeploymentServer(pool, queries, machine, kubeClient, ac.LocoNamespace)
-	domainServiceHandler := service.NewDomainServer(pool, queries, machine)
-	tokenServiceHandler := service.NewTokenServer(pool, queries, machine)
+	if dnsProvider != nil {
+		// records are read back right after they are written, so the syncer stays on the primary
+		recordSyncer := dnsrecord.NewSyncer(genDb.New(pool), dnsProvider, feed, ac.DNS.TTL, ac.DNS.SyncInterval)
+		go func() {
+			if err := recordSyncer.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+				slog.Error("dns record syncer failed", "error", err)
+			}
+		}()
+	}
+
+	emailSender, err := notify.NewEmailSender(ac.Notify, httpClient)
+	if err != nil {
+		log.Fatal(err)
+	}
+	notifier := notify.NewNotifier(queries, feed, emailSender != nil)
+	go func() {
+		if err := notifier.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("notifier failed", "error", err)
+		}
+	}()
+
+	slackClient := slack.NewClient(ac.Slack, httpClient)
+
+	// on the primary, so deliveries just marked sent are not claimed again
+	dispatcher := notify.NewDispatcher(genDb.New(pool), emailSender, slackClient, httpClient, notify.DefaultInterval)
+	go func() {
+		if err := dispatcher.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("notification dispatcher failed", "error", err)
+		}
+	}()
+
+	domainChecker := customdomain.NewChecker(queries, ac.DomainCheckInterval)
+	go func() {
+		if err := domainChecker.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("custom domain checker failed", "error", err)
+		}
+	}()
+
+	prober := probe.NewProber(queries, httpClient, ac.HealthProbeInterval)
+	go func() {
+		if err := prober.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("health prober failed", "error", err)
+		}
+	}()
+
+	// alert state is read back right after it is written, so the engine stays on the primary
+	alertEngine := alert.NewEngine(genDb.New(pool), kubeClient, notifier, ac.AlertEvaluationInterval)
+	go func() {
+		if err := alertEngine.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("alert engine failed", "error", err)
+		}
+	}()
+
+	stripeClient := billing.NewClient(ac.Billing, httpClient)
+	meter := billing.NewMeter(queries, stripeClient, ac.Billing.MeterInterval)
+	go func() {
+		if err := meter.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("usage meter failed", "error", err)
+		}
+	}()
+
+	digestCompiler := digest.NewCompiler(queries, notifier, ac.Digest)
+	go func() {
+		if err := digestCompiler.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("workspace digest compiler failed", "error", err)
+		}
+	}()
+
+	idleSleep := ac.Idle.PrometheusURL != ""
+	if idleSleep {
+		sleeper := idle.NewSleeper(queries, kubeClient, httpClient, ac.LocoNamespace, ac.LocoDomainBase, ac.Idle)
+		go func() {
+			if err := sleeper.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+				slog.Error("idle sleeper failed", "error", err)
+			}
+		}()
+	}
+
+	oAuthServiceHandler, err := service.NewOAuthServer(dbConn, queries, httpClient, machine, emailSender, ac.OAuth)
+	if err != nil {
+		log.Fatal(err)
+	}
+	userServiceHandler := service.NewUserServer(dbConn, queries, machine)
+	directory := scim.NewDirectory(queries, machine)
+	orgServiceHandler := service.NewOrgServer(dbConn, queries, machine, directory)
+	workspaceServiceHandler := service.NewWorkspaceServer(dbConn, queries, machine, kubeClient, ac.LocoNamespace, notifier)
+	resourceServiceHandler := service.NewResourceServer(dbConn, queries, machine, kubeClient, ac.LocoNamespace, feed)
+	scanner := imagescan.NewScanner(queries, ac.ImageScan)
+	resolver := registry.NewResolver(ac.Registry, httpClient, ac.RegistryURL)
+	verifier, err := service.NewSignatureVerifier(ac.Registry, resolver)
+	if err != nil {
+		log.Fatal(err)
+	}
+	deploymentServiceHandler := service.NewDeploymentServer(dbConn, queries, machine, kubeClient, ac.LocoNamespace, feed, scanner, resolver, verifier)
+	domainServiceHandler := service.NewDomainServer(dbConn, queries, machine, kubeClient, ac.LocoNamespace)
+	tokenServiceHandler := service.NewTokenServer(dbConn, queries, machine)
+	announcementServiceHandler := service.NewAnnouncementServer(dbConn, queries, machine)
+	environmentServiceHandler := service.NewEnvironmentServer(dbConn, queries, machine)
+	configGroupServiceHandler := service.NewConfigGroupServer(dbConn, queries, machine)
+	secretServiceHandler := service.NewSecretServer(dbConn, queries, machine, kubeClient, ac.LocoNamespace)
+	serviceAccountServiceHandler := service.NewServiceAccountServer(dbConn, queries, machine)
+	notificationServiceHandler := service.NewNotificationServer(dbConn, queries, machine)
+	alertServiceHandler := service.NewAlertServer(dbConn, queries, machine)
+	policyServiceHandler := service.NewPolicyServer(dbConn, queries, machine)
+	adminServiceHandler := service.NewAdminServer(dbConn, queries, machine, resourceServiceHandler)
+	slackServiceHandler := service.NewSlackServer(dbConn, queries, machine, slackClient, resourceServiceHandler, deploymentServiceHandler)
+	billingServiceHandler := service.NewBillingServer(dbConn, queries, machine, stripeClient)
+	approvalServiceHandler := service.NewApprovalServer(dbConn, queries, machine, resourceServiceHandler, workspaceServiceHandler, domainServiceHandler)
+	templateServiceHandler := service.NewTemplateServer(dbConn, queries, machine, resourceServiceHandler, deploymentServiceHandler)
+	reconciler := reconcile.NewReconciler(kubeClient, queries, deploymentServiceHandler, ac.LocoNamespace, ac.ClusterRegion, ac.ReconcileInterval, ac.ReconcileRepair)
+	go func() {
+		if err := reconciler.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("cluster reconciler failed", "error", err)
+		}
+	}()
+
+	// on the primary, since it lists what is left right after deleting
+	orgDeletionWorker := orgdeletion.NewWorker(genDb.New(pool), resourceServiceHandler, orgdeletion.DefaultInterval)
+	go func() {
+		if err := orgDeletionWorker.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("org deletion worker failed", "error", err)
+		}
+	}()
+
+	configRolloutWorker := configrollout.NewWorker(genDb.New(pool), deploymentServiceHandler, configrollout.DefaultInterval)
+	go func() {
+		if err := configRolloutWorker.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("config rollout worker failed", "error", err)
+		}
+	}()
+
 	registryServiceHandler := service.NewRegistryServer(
-		pool,
+		dbConn,
 		queries,
 		ac.GitlabURL,
 		ac.GitlabPAT,
@@ -181,102 +514,73 @@ func main() {
 		machine,
 	)
 
-	oauthPath, oauthHandler := oauthv1connect.NewOAuthServiceHandler(oAuthServiceHandler, interceptors)
-	userPath, userHandler := userv1connect.NewUserServiceHandler(userServiceHandler, interceptors)
-	orgPath, orgHandler := orgv1connect.NewOrgServiceHandler(orgServiceHandler, interceptors)
-	workspacePath, workspaceHandler := workspacev1connect.NewWorkspaceServiceHandler(workspaceServiceHandler, interceptors)
-	resourcePath, resourceHandler := resourcev1connect.NewResourceServiceHandler(resourceServiceHandler, interceptors)
-	deploymentPath, deploymentHandler := deploymentv1connect.NewDeploymentServiceHandler(deploymentServiceHandler, interceptors)
-	domainPath, domainHandler := domainv1connect.NewDomainServiceHandler(domainServiceHandler, interceptors)
-	tokenPath, tokenHandler := tokenv1connect.NewTokenServiceHandler(tokenServiceHandler, interceptors)
-	registryPath, registryHandler := registryv1connect.NewRegistryServiceHandler(registryServiceHandler, interceptors)
-
-	reflector := grpcreflect.NewStaticReflector(
-		// oauth service
-		oauthv1connect.OAuthServiceGetOAuthDetailsProcedure,
-		oauthv1connect.OAuthServiceExchangeOAuthTokenProcedure,
-		oauthv1connect.OAuthServiceGetOAuthAuthorizationURLProcedure,
-		oauthv1connect.OAuthServiceExchangeOAuthCodeProcedure,
-
-		// user service
-		userv1connect.UserServiceCreateUserProcedure,
-		userv1connect.UserServiceGetUserProcedure,
-		userv1connect.UserServiceWhoAmIProcedure,
-		userv1connect.UserServiceUpdateUserProcedure,
-		userv1connect.UserServiceListUsersProcedure,
-		userv1connect.UserServiceDeleteUserProcedure,
-
-		// org service
-		orgv1connect.OrgServiceCreateOrgProcedure,
-		orgv1connect.OrgServiceGetOrgProcedure,
-		orgv1connect.OrgServiceListUserOrgsProcedure,
-		orgv1connect.OrgServiceListOrgUsersProcedure,
-		orgv1connect.OrgServiceListOrgWorkspacesProcedure,
-		orgv1connect.OrgServiceUpdateOrgProcedure,
-		orgv1connect.OrgServiceDeleteOrgProcedure,
-
-		// workspace service
-		workspacev1connect.WorkspaceServiceCreateWorkspaceProcedure,
-		workspacev1connect.WorkspaceServiceGetWorkspaceProcedure,
-		workspacev1connect.WorkspaceServiceListUserWorkspacesProcedure,
-		workspacev1connect.WorkspaceServiceListOrgWorkspacesProcedure,
-		workspacev1connect.WorkspaceServiceUpdateWorkspaceProcedure,
-		workspacev1connect.WorkspaceServiceDeleteWorkspaceProcedure,
-		workspacev1connect.WorkspaceServiceCreateMemberProcedure,
-		workspacev1connect.WorkspaceServiceDeleteMemberProcedure,
-		workspacev1connect.WorkspaceServiceListWorkspaceMembersProcedure,
-
-		// resource service
-		resourcev1connect.ResourceServiceCreateResourceProcedure,
-		resourcev1connect.ResourceServiceGetResourceProcedure,
-		resourcev1connect.ResourceServiceListWorkspaceResourcesProcedure,
-		resourcev1connect.ResourceServiceUpdateResourceProcedure,
-		resourcev1connect.ResourceServiceDeleteResourceProcedure,
-
-		// deployment service
-		deploymentv1connect.DeploymentServiceCreateDeploymentProcedure,
-		deploymentv1connect.DeploymentServiceGetDeploymentProcedure,
-		deploymentv1connect.DeploymentServiceListDeploymentsProcedure,
-		deploymentv1connect.DeploymentServiceWatchDeploymentProcedure,
-
-		// domain service
-		domainv1connect.DomainServiceCreatePlatformDomainProcedure,
-		domainv1connect.DomainServiceGetPlatformDomainProcedure,
-		domainv1connect.DomainServiceListPlatformDomainsProcedure,
-		domainv1connect.DomainServiceUpdatePlatformDomainProcedure,
-		domainv1connect.DomainServiceDeletePlatformDomainProcedure,
-		domainv1connect.DomainServiceCreateResourceDomainProcedure,
-		domainv1connect.DomainServiceUpdateResourceDomainProcedure,
-		domainv1connect.DomainServiceSetPrimaryResourceDomainProcedure,
-		domainv1connect.DomainServiceDeleteResourceDomainProcedure,
-		domainv1connect.DomainServiceListLocoOwnedDomainsProcedure,
-		domainv1connect.DomainServiceCheckDomainAvailabilityProcedure,
-
-		// token service
-		tokenv1connect.TokenServiceCreateTokenProcedure,
-		tokenv1connect.TokenServiceListTokensProcedure,
-		tokenv1connect.TokenServiceGetTokenProcedure,
-		tokenv1connect.TokenServiceRevokeTokenProcedure,
-
-		// registry service
-		registryv1connect.RegistryServiceGetGitlabTokenProcedure,
-	)
+	if ac.GitOpsRepo != "" {
+		source := gitops.NewGitHubSource(httpClient, ac.GitOpsGitHubToken, ac.GitOpsRepo, ac.GitOpsBranch, ac.GitOpsPath)
+		gitopsSyncer := gitops.NewSyncer(queries, machine, resourceServiceHandler, deploymentServiceHandler, source, ac.GitOpsLocoToken, ac.GitOpsInterval)
+		go func() {
+			if err := gitopsSyncer.Start(watcherCtx); err != nil && !errors.Is(err, context.Canceled) {
+				slog.Error("gitops syncer failed", "error", err)
+			}
+		}()
+	}
+
+	// each service's name is taken from the path it is mounted on, so reflection lists every
+	// service grpcurl can call without keeping a list by hand
+	var serviceNames []string
+	mount := func(path string, handler http.Handler) {
+		mux.Handle(path, handler)
+		serviceNames = append(serviceNames, strings.Trim(path, "/"))
+	}
+	mount(oauthv1connect.NewOAuthServiceHandler(oAuthServiceHandler, interceptors))
+	mount(userv1connect.NewUserServiceHandler(userServiceHandler, interceptors))
+	mount(orgv1connect.NewOrgServiceHandler(orgServiceHandler, interceptors))
+	mount(workspacev1connect.NewWorkspaceServiceHandler(workspaceServiceHandler, interceptors))
+	mount(resourcev1connect.NewResourceServiceHandler(resourceServiceHandler, interceptors))
+	mount(deploymentv1connect.NewDeploymentServiceHandler(deploymentServiceHandler, interceptors))
+	mount(domainv1connect.NewDomainServiceHandler(domainServiceHandler, interceptors))
+	mount(tokenv1connect.NewTokenServiceHandler(tokenServiceHandler, interceptors))
+	mount(registryv1connect.NewRegistryServiceHandler(registryServiceHandler, interceptors))
+	mount(announcementv1connect.NewAnnouncementServiceHandler(announcementServiceHandler, interceptors))
+	mount(environmentv1connect.NewEnvironmentServiceHandler(environmentServiceHandler, interceptors))
+	mount(configgroupv1connect.NewConfigGroupServiceHandler(configGroupServiceHandler, interceptors))
+	mount(secretv1connect.NewSecretServiceHandler(secretServiceHandler, interceptors))
+	mount(serviceaccountv1connect.NewServiceAccountServiceHandler(serviceAccountServiceHandler, interceptors))
+	mount(templatev1connect.NewTemplateServiceHandler(templateServiceHandler, interceptors))
+	mount(approvalv1connect.NewApprovalServiceHandler(approvalServiceHandler, interceptors))
+	mount(notificationv1connect.NewNotificationServiceHandler(notificationServiceHandler, interceptors))
+	mount(alertv1connect.NewAlertServiceHandler(alertServiceHandler, interceptors))
+	mount(policyv1connect.NewPolicyServiceHandler(policyServiceHandler, interceptors))
+	mount(adminv1connect.NewAdminServiceHandler(adminServiceHandler, interceptors))
+	mount(slackv1connect.NewSlackServiceHandler(slackServiceHandler, interceptors))
+	mount(billingv1connect.NewBillingServiceHandler(billingServiceHandler, interceptors))
 
 	// mount both old and new reflectors for backwards compatibility
+	reflector := grpcreflect.NewStaticReflector(serviceNames...)
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
 
-	mux.Handle(oauthPath, oauthHandler)
-	mux.Handle(userPath, userHandler)
-	mux.Handle(orgPath, orgHandler)
-	mux.Handle(workspacePath, workspaceHandler)
-	mux.Handle(resourcePath, resourceHandler)
-	mux.Handle(deploymentPath, deploymentHandler)
-	mux.Handle(domainPath, domainHandler)
-	mux.Handle(tokenPath, tokenHandler)
-	mux.Handle(registryPath, registryHandler)
-
-	muxWCors := withCORS(ac.LocoDomainBase)(mux)
+	// the REST facade covers the public services; platform admin tooling speaks Connect
+	restServices := slices.DeleteFunc(slices.Clone(serviceNames), func(name string) bool {
+		return name == adminv1connect.AdminServiceName
+	})
+	restHandler, err := rest.NewHandler(mux, restServices...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	mux.Handle(rest.BasePath, restHandler)
+
+	mux.Handle(slack.BasePath, slack.NewHandler(slackClient, slackServiceHandler))
+	mux.Handle(billing.BasePath, billing.NewHandler(stripeClient, queries))
+	mux.Handle(scim.BasePath, scim.NewHandler(queries, machine, directory))
+
+	// sleeping services route their requests here until they are awake again
+	var muxWWake http.Handler = mux
+	if idleSleep {
+		muxWWake = idle.NewWaker(queries, kubeClient, ac.LocoNamespace, ac.LocoDomainBase, ac.LocoDomainAPI).Wrap(mux)
+	}
+	// status.<domain> hosts get the resource's public status page instead of the API
+	muxWStatusPages := statuspage.NewHandler(queries).Wrap(muxWWake)
+	muxWCors := withCORS(ac.CORS, ac.Env, ac.LocoDomainBase)(muxWStatusPages)
 	muxWTiming := middleware.Timing(muxWCors)
 	muxWContext := middleware.SetContext(muxWTiming)
 
@@ -5,8 +5,9 @@ import (
 	"log/slog"
 	"net/http"
 
-	"github.com/google/uuid"
 	"github.com/team-loco/loco/api/contextkeys"
+	"github.com/team-loco/loco/api/db"
+	"github.com/team-loco/loco/api/pkg/requestid"
 )
 
 func SetContext(next http.Handler) http.Handler {
@@ -16,20 +17,18 @@ func SetContext(next http.Handler) http.Handler {
 			slog.String("content-type", r.Header.Get("Content-Type")),
 		)
 
-		requestHeader := w.Header().Get("X-Loco-Request-Id")
-
-		// only generate a new request header if one already doesn't exist
-		if requestHeader == "" {
-			requestHeader = uuid.NewString()
-		}
+		// keep the caller's ID so its own logs line up with ours
+		requestID := requestid.FromRequest(r)
 
 		ctx := r.Context()
-		ctx = context.WithValue(ctx, contextkeys.RequestIDKey, requestHeader)
+		ctx = context.WithValue(ctx, contextkeys.RequestIDKey, requestID)
 		ctx = context.WithValue(ctx, contextkeys.MethodKey, r.Method)
 		ctx = context.WithValue(ctx, contextkeys.PathKey, r.URL.Path)
 		ctx = context.WithValue(ctx, contextkeys.SourceIPKey, r.RemoteAddr)
+		// reads after the request's first write go to the primary, see db.Router
+		ctx = db.WithReadYourWrites(ctx)
 
-		w.Header().Set("X-Loco-Request-Id", requestHeader)
+		w.Header().Set(requestid.Header, requestID)
 
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
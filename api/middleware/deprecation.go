@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/team-loco/loco/api/contextkeys"
+	"github.com/team-loco/loco/api/pkg/deprecation"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type deprecationInterceptor struct {
+	registry deprecation.Registry
+	tracker  *deprecation.Tracker
+}
+
+// NewDeprecationInterceptor adds Deprecation/Sunset headers to responses of deprecated
+// RPCs, or of requests that set deprecated fields, and records the caller in tracker.
+// It must run after the auth interceptor so the caller is known.
+func NewDeprecationInterceptor(registry deprecation.Registry, tracker *deprecation.Tracker) *deprecationInterceptor {
+	return &deprecationInterceptor{
+		registry: registry,
+		tracker:  tracker,
+	}
+}
+
+func (i *deprecationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		var msg protoreflect.Message
+		if m, ok := req.Any().(proto.Message); ok {
+			msg = m.ProtoReflect()
+		}
+		notices := i.lookup(ctx, req.Spec(), msg, req.Header().Get("User-Agent"))
+
+		resp, err := next(ctx, req)
+		if len(notices) == 0 {
+			return resp, err
+		}
+
+		if err != nil {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				deprecation.SetHeaders(connectErr.Meta(), notices)
+			}
+			return resp, err
+		}
+		deprecation.SetHeaders(resp.Header(), notices)
+		return resp, nil
+	})
+}
+
+func (i *deprecationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler only checks the procedure: request fields arrive after headers are sent.
+func (i *deprecationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(
+		ctx context.Context,
+		conn connect.StreamingHandlerConn,
+	) error {
+		notices := i.lookup(ctx, conn.Spec(), nil, conn.RequestHeader().Get("User-Agent"))
+		deprecation.SetHeaders(conn.ResponseHeader(), notices)
+		return next(ctx, conn)
+	})
+}
+
+// lookup finds deprecated surfaces used by the request and records them against the caller.
+func (i *deprecationInterceptor) lookup(ctx context.Context, spec connect.Spec, msg protoreflect.Message, userAgent string) map[string]deprecation.Notice {
+	method, _ := spec.Schema.(protoreflect.MethodDescriptor)
+	notices := i.registry.Lookup(spec.Procedure, method, msg)
+	if len(notices) == 0 {
+		return nil
+	}
+
+	client := "anonymous"
+	if entity, ok := contextkeys.Entity(ctx); ok {
+		client = fmt.Sprintf("%s:%d", entity.Type, entity.ID)
+	}
+
+	for surface := range notices {
+		i.tracker.Record(surface, client, userAgent)
+		slog.DebugContext(ctx, "deprecated surface used", "surface", surface, "client", client)
+	}
+	return notices
+}
@@ -16,6 +16,33 @@ import (
 
 // TODO: repeated code !!
 
+// publicProcedures are called before the caller has a loco token, to get one.
+var publicProcedures = map[string]bool{
+	"/oauth.v1.OAuthService/GetOAuthDetails":          true,
+	"/oauth.v1.OAuthService/GetOAuthAuthorizationURL": true,
+	"/oauth.v1.OAuthService/ExchangeOAuthCode":        true,
+	"/oauth.v1.OAuthService/ExchangeOAuthToken":       true,
+	"/oauth.v1.OAuthService/SignUpWithEmail":          true,
+	"/oauth.v1.OAuthService/VerifyEmail":              true,
+	"/oauth.v1.OAuthService/ResendVerificationEmail":  true,
+	"/oauth.v1.OAuthService/LoginWithEmail":           true,
+	"/oauth.v1.OAuthService/RequestPasswordReset":     true,
+	"/oauth.v1.OAuthService/ResetPassword":            true,
+	"/oauth.v1.OAuthService/RefreshToken":             true,
+}
+
+// mfaPendingProcedures are all a login waiting on a TOTP check may call: the scopes of its token
+// would satisfy much more, so the interceptor refuses it everywhere else.
+var mfaPendingProcedures = map[string]bool{
+	"/user.v1.UserService/GetMFAStatus": true,
+	"/user.v1.UserService/EnrollTOTP":   true,
+	"/user.v1.UserService/ConfirmTOTP":  true,
+	"/user.v1.UserService/VerifyMFA":    true,
+}
+
+// ErrMFANotPassed is returned to a login waiting on a TOTP check that calls anything but the MFA procedures.
+var ErrMFANotPassed = errors.New("this login must pass MFA first")
+
 type githubAuthInterceptor struct {
 	machine *tvm.VendingMachine
 }
@@ -53,10 +80,7 @@ func (i *githubAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryF
 		req connect.AnyRequest,
 	) (connect.AnyResponse, error) {
 		// todo: need to fix the service name
-		if req.Spec().Procedure == "/oauth.v1.OAuthService/GetOAuthDetails" ||
-			req.Spec().Procedure == "/oauth.v1.OAuthService/GetOAuthAuthorizationURL" ||
-			req.Spec().Procedure == "/oauth.v1.OAuthService/ExchangeOAuthCode" ||
-			req.Spec().Procedure == "/oauth.v1.OAuthService/ExchangeOAuthToken" {
+		if publicProcedures[req.Spec().Procedure] {
 			return next(ctx, req)
 		}
 
@@ -66,18 +90,22 @@ func (i *githubAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryF
 			return nil, connect.NewError(connect.CodeUnauthenticated, err)
 		}
 
-		entity, scopes, err := i.machine.GetToken(ctx, token)
+		claims, err := i.machine.GetClaims(ctx, token)
 		if err != nil {
 			slog.Error(err.Error())
 			return nil, connect.NewError(connect.CodeUnauthenticated, err)
 		}
+		if claims.MFAPending && !mfaPendingProcedures[req.Spec().Procedure] {
+			return nil, connect.NewError(connect.CodePermissionDenied, ErrMFANotPassed)
+		}
+		entity := claims.Entity
 
-		c := context.WithValue(ctx, contextkeys.EntityKey, genDb.Entity{
-			Type: entity.Type,
-			ID:   entity.ID,
+		c := contextkeys.WithAuth(ctx, contextkeys.Auth{
+			Entity:         genDb.Entity{Type: entity.Type, ID: entity.ID},
+			Scopes:         claims.Scopes,
+			Token:          token,
+			ImpersonatorID: claims.ImpersonatorID,
 		})
-		c = context.WithValue(c, contextkeys.EntityScopesKey, scopes)
-		c = context.WithValue(c, contextkeys.TokenKey, token)
 
 		slog.InfoContext(c, "claims validated; populating ctx", slog.Int64("userId", entity.ID))
 
@@ -101,10 +129,7 @@ func (i *githubAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandl
 		ctx context.Context,
 		conn connect.StreamingHandlerConn,
 	) error {
-		if conn.Spec().Procedure == "/oauth.v1.OAuthService/GetOAuthDetails" ||
-			conn.Spec().Procedure == "/oauth.v1.OAuthService/GetOAuthAuthorizationURL" ||
-			conn.Spec().Procedure == "/oauth.v1.OAuthService/ExchangeOAuthCode" ||
-			conn.Spec().Procedure == "/oauth.v1.OAuthService/ExchangeOAuthToken" {
+		if publicProcedures[conn.Spec().Procedure] {
 			return next(ctx, conn)
 		}
 
@@ -114,20 +139,24 @@ func (i *githubAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandl
 			return connect.NewError(connect.CodeUnauthenticated, err)
 		}
 
-		entity, scopes, err := i.machine.GetToken(ctx, token)
+		claims, err := i.machine.GetClaims(ctx, token)
 		if err != nil {
 			slog.Error(err.Error())
 			return connect.NewError(connect.CodeUnauthenticated, err)
 		}
+		if claims.MFAPending && !mfaPendingProcedures[conn.Spec().Procedure] {
+			return connect.NewError(connect.CodePermissionDenied, ErrMFANotPassed)
+		}
+		entity := claims.Entity
 
 		slog.InfoContext(ctx, "claims validated; populating ctx", slog.Int64("entityId", entity.ID), slog.Any("entityType", entity.Type))
 
-		c := context.WithValue(ctx, contextkeys.EntityKey, genDb.Entity{
-			Type: entity.Type,
-			ID:   entity.ID,
+		c := contextkeys.WithAuth(ctx, contextkeys.Auth{
+			Entity:         genDb.Entity{Type: entity.Type, ID: entity.ID},
+			Scopes:         claims.Scopes,
+			Token:          token,
+			ImpersonatorID: claims.ImpersonatorID,
 		})
-		c = context.WithValue(ctx, contextkeys.EntityScopesKey, scopes)
-		c = context.WithValue(ctx, contextkeys.TokenKey, token)
 
 		return next(c, conn)
 	})
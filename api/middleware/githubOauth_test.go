@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/testutil"
+	registryv1 "github.com/team-loco/loco/shared/proto/registry/v1"
+	"github.com/team-loco/loco/shared/proto/registry/v1/registryv1connect"
+	userv1 "github.com/team-loco/loco/shared/proto/user/v1"
+	"github.com/team-loco/loco/shared/proto/user/v1/userv1connect"
+)
+
+// newAuthServer serves the user and registry services, which answer every call as unimplemented,
+// behind the auth interceptor. It knows a full login token and a login token waiting on MFA.
+func newAuthServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	store := testutil.NewStore()
+	store.GetTokenFunc = func(ctx context.Context, token string) (genDb.Token, error) {
+		scopes := testutil.Scopes(genDb.EntityTypeUser, 1, genDb.ScopeRead, genDb.ScopeWrite)
+		switch token {
+		case "full":
+			return genDb.Token{Token: token, EntityType: genDb.EntityTypeUser, EntityID: 1, Scopes: scopes, ExpiresAt: time.Now().Add(time.Hour)}, nil
+		case "pending":
+			return genDb.Token{Token: token, EntityType: genDb.EntityTypeUser, EntityID: 1, Scopes: scopes, ExpiresAt: time.Now().Add(time.Hour), MfaPending: true}, nil
+		}
+		return genDb.Token{}, pgx.ErrNoRows
+	}
+
+	interceptors := connect.WithInterceptors(NewGithubAuthInterceptor(testutil.NewVendingMachine(t, store)))
+	mux := http.NewServeMux()
+	mux.Handle(userv1connect.NewUserServiceHandler(userv1connect.UnimplementedUserServiceHandler{}, interceptors))
+	mux.Handle(registryv1connect.NewRegistryServiceHandler(registryv1connect.UnimplementedRegistryServiceHandler{}, interceptors))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func withToken[T any](msg *T, token string) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func TestMFAPendingToken(t *testing.T) {
+	server := newAuthServer(t)
+	users := userv1connect.NewUserServiceClient(server.Client(), server.URL)
+	registry := registryv1connect.NewRegistryServiceClient(server.Client(), server.URL)
+
+	ctx := t.Context()
+	calls := map[string]func(token string) error{
+		"GetGitlabToken": func(token string) error {
+			_, err := registry.GetGitlabToken(ctx, withToken(&registryv1.GetGitlabTokenRequest{}, token))
+			return err
+		},
+		"UpdateUser": func(token string) error {
+			_, err := users.UpdateUser(ctx, withToken(&userv1.UpdateUserRequest{}, token))
+			return err
+		},
+		"ExportMyData": func(token string) error {
+			_, err := users.ExportMyData(ctx, withToken(&userv1.ExportMyDataRequest{}, token))
+			return err
+		},
+		"GetMFAStatus": func(token string) error {
+			_, err := users.GetMFAStatus(ctx, withToken(&userv1.GetMFAStatusRequest{}, token))
+			return err
+		},
+		"EnrollTOTP": func(token string) error {
+			_, err := users.EnrollTOTP(ctx, withToken(&userv1.EnrollTOTPRequest{}, token))
+			return err
+		},
+		"ConfirmTOTP": func(token string) error {
+			_, err := users.ConfirmTOTP(ctx, withToken(&userv1.ConfirmTOTPRequest{}, token))
+			return err
+		},
+		"VerifyMFA": func(token string) error {
+			_, err := users.VerifyMFA(ctx, withToken(&userv1.VerifyMFARequest{}, token))
+			return err
+		},
+	}
+
+	tests := []struct {
+		procedure string
+		token     string
+		wantCode  connect.Code // CodeUnimplemented when the call reaches the service
+	}{
+		{"GetGitlabToken", "pending", connect.CodePermissionDenied},
+		{"UpdateUser", "pending", connect.CodePermissionDenied},
+		{"ExportMyData", "pending", connect.CodePermissionDenied},
+		{"GetMFAStatus", "pending", connect.CodeUnimplemented},
+		{"EnrollTOTP", "pending", connect.CodeUnimplemented},
+		{"ConfirmTOTP", "pending", connect.CodeUnimplemented},
+		{"VerifyMFA", "pending", connect.CodeUnimplemented},
+		{"GetGitlabToken", "full", connect.CodeUnimplemented},
+		{"UpdateUser", "full", connect.CodeUnimplemented},
+		{"UpdateUser", "unknown", connect.CodeUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.procedure+" with a "+tt.token+" token", func(t *testing.T) {
+			err := calls[tt.procedure](tt.token)
+			if code := connect.CodeOf(err); code != tt.wantCode {
+				t.Errorf("expected %v, got %v", tt.wantCode, err)
+			}
+			var connectErr *connect.Error
+			if tt.wantCode == connect.CodePermissionDenied && (!errors.As(err, &connectErr) || connectErr.Message() != ErrMFANotPassed.Error()) {
+				t.Errorf("expected %v, got %v", ErrMFANotPassed, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// auditImpersonatedRequest is the admin audit log action of a request made through an impersonation token.
+const auditImpersonatedRequest = "impersonated_request"
+
+type impersonationAuditInterceptor struct {
+	queries genDb.Querier
+}
+
+// NewImpersonationAuditInterceptor records every request made through an impersonation token in the
+// admin audit log, against the impersonated user, before it is handled. A request that cannot be
+// recorded is refused. It must run after the auth interceptor so the impersonator is known.
+func NewImpersonationAuditInterceptor(queries genDb.Querier) *impersonationAuditInterceptor {
+	return &impersonationAuditInterceptor{queries: queries}
+}
+
+func (i *impersonationAuditInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		if err := i.record(ctx, req.Spec().Procedure); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	})
+}
+
+func (i *impersonationAuditInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *impersonationAuditInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(
+		ctx context.Context,
+		conn connect.StreamingHandlerConn,
+	) error {
+		if err := i.record(ctx, conn.Spec().Procedure); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	})
+}
+
+func (i *impersonationAuditInterceptor) record(ctx context.Context, procedure string) error {
+	impersonator, ok := contextkeys.Impersonator(ctx)
+	if !ok {
+		return nil
+	}
+	entity, _ := contextkeys.Entity(ctx)
+
+	if _, err := i.queries.InsertAdminAuditEntry(ctx, genDb.InsertAdminAuditEntryParams{
+		ActorID:    pgtype.Int8{Int64: impersonator, Valid: true},
+		Action:     auditImpersonatedRequest,
+		TargetType: string(entity.Type),
+		TargetID:   entity.ID,
+		Detail:     procedure,
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to record impersonated request", "procedure", procedure, "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/team-loco/loco/api/db"
+	"github.com/team-loco/loco/api/migrations"
+)
+
+const migrateUsage = `usage: loco-api migrate <command>
+
+commands:
+  up                 apply every pending migration
+  status             list migrations and when they were applied
+  baseline <version> mark migrations up to version as applied without running them,
+                     for databases created before migrations were tracked
+`
+
+// runMigrate implements `loco-api migrate`, connecting to DATABASE_URL.
+func runMigrate(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, migrateUsage)
+		return fmt.Errorf("missing migrate command")
+	}
+
+	ctx := context.Background()
+	dbConn, err := db.NewDB(ctx, os.Getenv("DATABASE_URL"), "", db.PoolConfig{MaxConns: 1, MinConns: 1})
+	if err != nil {
+		return err
+	}
+	defer dbConn.Close()
+
+	migrator, err := db.NewMigrator(dbConn.Pool(), migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("applied %d migration(s)\n", applied)
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED")
+		for _, status := range statuses {
+			applied := "pending"
+			if status.AppliedAt != nil {
+				applied = status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%03d\t%s\t%s\n", status.Version, status.Name, applied)
+		}
+		return w.Flush()
+
+	case "baseline":
+		if len(args) != 2 {
+			return fmt.Errorf("baseline needs a version")
+		}
+		version, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid version %q: %w", args[1], err)
+		}
+		if err := migrator.Baseline(ctx, version); err != nil {
+			return err
+		}
+		fmt.Printf("marked migrations up to %d as applied\n", version)
+
+	default:
+		fmt.Fprint(os.Stderr, migrateUsage)
+		return fmt.Errorf("unknown migrate command %q", args[0])
+	}
+	return nil
+}
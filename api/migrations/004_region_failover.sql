@@ -0,0 +1,11 @@
+-- failover_priority orders a resource's regions for failover. The primary region is
+-- always 0 and standbys take over in ascending order.
+ALTER TABLE resource_regions ADD COLUMN failover_priority INT NOT NULL DEFAULT 0;
+
+UPDATE resource_regions rr
+SET failover_priority = ranked.priority
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY resource_id ORDER BY is_primary DESC, region ASC) - 1 AS priority
+    FROM resource_regions
+) ranked
+WHERE rr.id = ranked.id;
@@ -0,0 +1,5 @@
+-- deployment_history_limit caps how many inactive deployments are kept per resource.
+-- Older ones are pruned by the API's retention worker. 0 keeps history forever.
+ALTER TABLE workspaces
+    ADD COLUMN deployment_history_limit INT NOT NULL DEFAULT 50
+    CHECK (deployment_history_limit >= 0);
@@ -0,0 +1,7 @@
+-- drift records differences between an active deployment's spec and the live
+-- cluster objects, as found by the API's drift detector. NULL means in sync.
+ALTER TABLE deployments
+    ADD COLUMN drift JSONB,
+    ADD COLUMN drift_detected_at TIMESTAMPTZ;
+
+CREATE INDEX idx_deployments_drifted ON deployments (resource_id) WHERE drift IS NOT NULL;
@@ -0,0 +1,23 @@
+CREATE TYPE announcement_kind AS ENUM ('release_note', 'deprecation', 'maintenance');
+
+-- Platform-wide announcements published by system admins.
+CREATE TABLE announcements (
+    id BIGSERIAL PRIMARY KEY,
+    kind announcement_kind NOT NULL,
+    title TEXT NOT NULL,
+    body TEXT NOT NULL,
+    link TEXT,
+    created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ -- hidden from feeds after this time; NULL never expires
+);
+
+CREATE INDEX idx_announcements_expires_at ON announcements (expires_at);
+
+-- announcement_reads tracks how far each user has read the feed.
+-- Announcements with an id above last_read_id are unread.
+CREATE TABLE announcement_reads (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    last_read_id BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
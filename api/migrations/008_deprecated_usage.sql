@@ -0,0 +1,11 @@
+-- deprecated_usage aggregates calls to deprecated RPCs and request fields per client,
+-- so owners can be contacted before the surface is removed.
+CREATE TABLE deprecated_usage (
+    surface TEXT NOT NULL,                  -- procedure or fully qualified field name
+    client TEXT NOT NULL,                   -- caller entity, e.g. "user:42", or "anonymous"
+    user_agent TEXT NOT NULL DEFAULT '',
+    request_count BIGINT NOT NULL DEFAULT 0,
+    first_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (surface, client, user_agent)
+);
@@ -0,0 +1,2 @@
+-- maintenance_message is shown by the gateway while a resource is suspended.
+ALTER TABLE resources ADD COLUMN maintenance_message TEXT;
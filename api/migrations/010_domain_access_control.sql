@@ -0,0 +1,3 @@
+-- access_control restricts who can reach a domain (CIDR allowlist, basic auth or OIDC).
+-- NULL means the domain is public.
+ALTER TABLE resource_domains ADD COLUMN access_control JSONB;
@@ -0,0 +1,26 @@
+-- environments sit between a workspace and its resources, e.g. dev, staging and prod.
+ALTER TYPE entity_type ADD VALUE IF NOT EXISTS 'environment';
+
+CREATE TABLE environments (
+    id BIGSERIAL PRIMARY KEY,
+    workspace_id BIGINT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    env JSONB NOT NULL DEFAULT '{}', -- env var overlay applied on top of every resource in the environment
+    created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (workspace_id, name)
+);
+
+CREATE INDEX idx_environments_workspace_id ON environments (workspace_id);
+
+-- resources without an environment live directly in the workspace, as before.
+ALTER TABLE resources ADD COLUMN environment_id BIGINT REFERENCES environments(id) ON DELETE RESTRICT;
+
+-- the same resource name can now exist once per environment, e.g. "api" in staging and in prod.
+ALTER TABLE resources DROP CONSTRAINT resources_workspace_id_name_key;
+CREATE UNIQUE INDEX uniq_resources_workspace_environment_name
+  ON resources (workspace_id, COALESCE(environment_id, 0), name);
+
+CREATE INDEX idx_resources_environment_id ON resources (environment_id);
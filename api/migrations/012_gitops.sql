@@ -0,0 +1,10 @@
+-- gitops_applications records which commit each git-managed resource was last applied from.
+CREATE TABLE gitops_applications (
+    resource_id BIGINT PRIMARY KEY REFERENCES resources(id) ON DELETE CASCADE,
+    repo TEXT NOT NULL,
+    path TEXT NOT NULL, -- manifest file within the repo
+    commit_sha TEXT NOT NULL,
+    spec_hash TEXT NOT NULL, -- hash of the applied deployment spec, so unchanged manifests are skipped
+    deployment_id BIGINT REFERENCES deployments(id) ON DELETE SET NULL,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
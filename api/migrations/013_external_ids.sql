@@ -0,0 +1,9 @@
+-- external ids are chosen by API clients such as infrastructure-as-code tools. creating an object
+-- again with the same external id returns the existing one instead of a duplicate.
+ALTER TABLE workspaces ADD COLUMN external_id TEXT;
+CREATE UNIQUE INDEX uniq_workspaces_org_external_id
+  ON workspaces (org_id, external_id) WHERE external_id IS NOT NULL;
+
+ALTER TABLE resources ADD COLUMN external_id TEXT;
+CREATE UNIQUE INDEX uniq_resources_workspace_external_id
+  ON resources (workspace_id, external_id) WHERE external_id IS NOT NULL;
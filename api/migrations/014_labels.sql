@@ -0,0 +1,3 @@
+-- labels are free-form key/value pairs callers attach to workspaces and resources.
+ALTER TABLE workspaces ADD COLUMN labels JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE resources ADD COLUMN labels JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,4 @@
+-- minted tokens are children of the token they were minted from; revoking the parent revokes them too
+ALTER TABLE tokens ADD COLUMN parent_token TEXT REFERENCES tokens (token) ON DELETE CASCADE;
+
+CREATE INDEX tokens_parent_token_idx ON tokens (parent_token) WHERE parent_token IS NOT NULL;
@@ -0,0 +1,25 @@
+-- service accounts are non-human principals owned by a workspace, such as CI pipelines, the controller or agents.
+-- they hold their own scopes and tokens instead of borrowing a user's.
+ALTER TYPE entity_type ADD VALUE IF NOT EXISTS 'service_account';
+
+CREATE TABLE service_accounts (
+    id BIGSERIAL PRIMARY KEY,
+    workspace_id BIGINT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (workspace_id, name)
+);
+
+CREATE INDEX idx_service_accounts_workspace_id ON service_accounts (workspace_id);
+
+-- what a service account may do; its tokens carry a subset of these. scopes never reach outside the owning workspace.
+CREATE TABLE service_account_scopes (
+    service_account_id BIGINT NOT NULL REFERENCES service_accounts(id) ON DELETE CASCADE,
+    scope TEXT NOT NULL, -- e.g. 'read', 'write', 'admin'
+    entity_type entity_type NOT NULL,
+    entity_id BIGINT NOT NULL,
+    UNIQUE (service_account_id, scope, entity_type, entity_id)
+);
@@ -0,0 +1,44 @@
+-- directory sync: an org's identity provider provisions its members over SCIM and its groups map to roles.
+
+-- users the directory provisioned into an org. granted_scopes are the scopes the directory gave the user,
+-- so exactly those are taken away when group membership changes or the user is deprovisioned.
+CREATE TABLE scim_users (
+    id BIGSERIAL PRIMARY KEY,
+    org_id BIGINT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    external_id TEXT, -- the identity provider's id for the user
+    user_name TEXT NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    granted_scopes JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (org_id, user_id),
+    UNIQUE (org_id, user_name)
+);
+
+CREATE TABLE scim_groups (
+    id BIGSERIAL PRIMARY KEY,
+    org_id BIGINT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    display_name TEXT NOT NULL,
+    external_id TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (org_id, display_name)
+);
+
+CREATE TABLE scim_group_members (
+    group_id BIGINT NOT NULL REFERENCES scim_groups(id) ON DELETE CASCADE,
+    scim_user_id BIGINT NOT NULL REFERENCES scim_users(id) ON DELETE CASCADE,
+    PRIMARY KEY (group_id, scim_user_id)
+);
+
+CREATE INDEX idx_scim_group_members_scim_user_id ON scim_group_members (scim_user_id);
+
+-- the roles members of a directory group get, keyed by the group's display name so a mapping
+-- can be set up before the identity provider pushes the group.
+CREATE TABLE directory_group_roles (
+    org_id BIGINT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    group_name TEXT NOT NULL,
+    scopes JSONB NOT NULL DEFAULT '[]',
+    PRIMARY KEY (org_id, group_name)
+);
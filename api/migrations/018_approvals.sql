@@ -0,0 +1,21 @@
+-- two-person approval: destructive operations on production-tagged workspaces and resources wait for a second admin.
+CREATE TYPE approval_action AS ENUM ('delete_resource', 'delete_workspace', 'delete_resource_domain');
+CREATE TYPE approval_status AS ENUM ('pending', 'executed', 'cancelled');
+
+CREATE TABLE approval_requests (
+    id BIGSERIAL PRIMARY KEY,
+    action approval_action NOT NULL,
+    target_id BIGINT NOT NULL, -- the resource, workspace or resource domain the action is on
+    workspace_id BIGINT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    requested_by_type entity_type NOT NULL,
+    requested_by_id BIGINT NOT NULL,
+    approved_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    status approval_status NOT NULL DEFAULT 'pending',
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    decided_at TIMESTAMPTZ
+);
+
+-- asking again for the same action returns the pending request instead of stacking up new ones
+CREATE UNIQUE INDEX uniq_approval_requests_pending ON approval_requests (action, target_id) WHERE status = 'pending';
+CREATE INDEX idx_approval_requests_workspace_id ON approval_requests (workspace_id, created_at DESC);
@@ -0,0 +1,11 @@
+-- a locked resource cannot be deleted or scaled to zero until an admin unlocks it.
+CREATE TABLE resource_locks (
+    resource_id BIGINT PRIMARY KEY REFERENCES resources(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL DEFAULT '',
+    locked_by_type entity_type NOT NULL,
+    locked_by_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- with deletion protection on, no resource, workspace or the organization itself can be deleted.
+ALTER TABLE organizations ADD COLUMN deletion_protection BOOLEAN NOT NULL DEFAULT FALSE;
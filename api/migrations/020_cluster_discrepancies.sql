@@ -0,0 +1,19 @@
+-- cluster_discrepancies holds what the API's reconciler last found wrong between active
+-- deployments and the Applications in the cluster. Rows are removed once a run finds the
+-- resource in sync again. resource_id has no foreign key so orphaned Applications, whose
+-- resource no longer exists, can be reported too.
+CREATE TYPE discrepancy_kind AS ENUM ('missing', 'stale', 'orphaned');
+
+CREATE TABLE cluster_discrepancies (
+    resource_id BIGINT PRIMARY KEY,
+    workspace_id BIGINT NOT NULL,
+    deployment_id BIGINT REFERENCES deployments(id) ON DELETE SET NULL,
+    region TEXT NOT NULL DEFAULT '',
+    kind discrepancy_kind NOT NULL,
+    detail TEXT NOT NULL DEFAULT '',
+    repaired_at TIMESTAMPTZ,
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_cluster_discrepancies_workspace_id ON cluster_discrepancies (workspace_id);
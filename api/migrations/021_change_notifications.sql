@@ -0,0 +1,74 @@
+-- changes to deployment and resource status, and to tokens and user scopes, are announced on the
+-- loco_changes channel so every API replica can push them to streaming clients and drop cached tokens.
+CREATE FUNCTION notify_deployment_change() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('loco_changes', json_build_object(
+        'table', TG_TABLE_NAME, 'id', NEW.id, 'resource_id', NEW.resource_id, 'status', NEW.status
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER deployments_notify_insert
+    AFTER INSERT ON deployments
+    FOR EACH ROW EXECUTE FUNCTION notify_deployment_change();
+
+CREATE TRIGGER deployments_notify_update
+    AFTER UPDATE ON deployments
+    FOR EACH ROW
+    WHEN (OLD.status IS DISTINCT FROM NEW.status
+        OR OLD.message IS DISTINCT FROM NEW.message
+        OR OLD.is_active IS DISTINCT FROM NEW.is_active
+        OR OLD.replicas IS DISTINCT FROM NEW.replicas
+        OR OLD.drift IS DISTINCT FROM NEW.drift)
+    EXECUTE FUNCTION notify_deployment_change();
+
+CREATE FUNCTION notify_resource_change() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('loco_changes', json_build_object(
+        'table', TG_TABLE_NAME, 'id', NEW.id, 'resource_id', NEW.id, 'status', NEW.status
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER resources_notify_update
+    AFTER UPDATE ON resources
+    FOR EACH ROW
+    WHEN (OLD.status IS DISTINCT FROM NEW.status
+        OR OLD.maintenance_message IS DISTINCT FROM NEW.maintenance_message)
+    EXECUTE FUNCTION notify_resource_change();
+
+-- the token itself is never put on the channel, only whose it was
+CREATE FUNCTION notify_token_change() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('loco_changes', json_build_object(
+        'table', TG_TABLE_NAME, 'entity_type', OLD.entity_type, 'entity_id', OLD.entity_id, 'name', OLD.name
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tokens_notify
+    AFTER UPDATE OR DELETE ON tokens
+    FOR EACH ROW EXECUTE FUNCTION notify_token_change();
+
+CREATE FUNCTION notify_user_scope_change() RETURNS trigger AS $$
+DECLARE
+    changed user_scopes;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := OLD;
+    ELSE
+        changed := NEW;
+    END IF;
+    PERFORM pg_notify('loco_changes', json_build_object(
+        'table', TG_TABLE_NAME, 'entity_type', 'user', 'entity_id', changed.user_id
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER user_scopes_notify
+    AFTER INSERT OR DELETE ON user_scopes
+    FOR EACH ROW EXECUTE FUNCTION notify_user_scope_change();
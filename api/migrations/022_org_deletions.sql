@@ -0,0 +1,22 @@
+-- org_deletions tracks cascading organization deletions, which tear down every resource in the
+-- organization in the background before deleting it. org_id has no foreign key so the record
+-- outlives the organization.
+CREATE TYPE org_deletion_status AS ENUM ('pending', 'running', 'completed', 'failed');
+
+CREATE TABLE org_deletions (
+    id BIGSERIAL PRIMARY KEY,
+    org_id BIGINT NOT NULL,
+    org_name TEXT NOT NULL,
+    status org_deletion_status NOT NULL DEFAULT 'pending',
+    total_resources INTEGER NOT NULL DEFAULT 0,
+    deleted_resources INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    requested_by_type entity_type NOT NULL,
+    requested_by_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ
+);
+
+-- an organization has at most one deletion in flight
+CREATE UNIQUE INDEX idx_org_deletions_active ON org_deletions (org_id) WHERE status IN ('pending', 'running');
@@ -0,0 +1,5 @@
+-- an archived workspace is hidden from default listings, takes no new deployments and has its
+-- resources suspended. suspended_by_archive marks the resources archiving suspended, so only
+-- those are resumed on unarchive and ones the user suspended themselves stay suspended.
+ALTER TABLE workspaces ADD COLUMN archived_at TIMESTAMPTZ;
+ALTER TABLE resources ADD COLUMN suspended_by_archive BOOLEAN NOT NULL DEFAULT FALSE;
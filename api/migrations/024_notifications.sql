@@ -0,0 +1,42 @@
+-- notification preferences and the outbox deliveries are sent from. A user's preference for an
+-- event wins over their workspace's default, which wins over the built-in default of email only.
+CREATE TYPE notification_event AS ENUM ('deployment_failed', 'domain_verification', 'invitation');
+CREATE TYPE notification_channel AS ENUM ('email', 'webhook');
+
+CREATE TABLE user_notification_preferences (
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event notification_event NOT NULL,
+    email BOOLEAN NOT NULL,
+    webhook_url TEXT NOT NULL DEFAULT '', -- empty for no webhook
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, event)
+);
+
+CREATE TABLE workspace_notification_defaults (
+    workspace_id BIGINT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    event notification_event NOT NULL,
+    email BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (workspace_id, event)
+);
+
+-- one row per delivery. dedupe_key makes enqueueing idempotent, since every API replica sees
+-- the same events.
+CREATE TABLE notification_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    dedupe_key TEXT NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event notification_event NOT NULL,
+    channel notification_channel NOT NULL,
+    target TEXT NOT NULL, -- email address or webhook URL
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notification_outbox_pending ON notification_outbox (next_attempt_at) WHERE sent_at IS NULL;
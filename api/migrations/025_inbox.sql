@@ -0,0 +1,18 @@
+-- in-app notifications, one row per recipient, read by `loco inbox` and the web UI. Every event
+-- the notifier handles lands here, whatever the user's email and webhook preferences.
+CREATE TABLE notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    dedupe_key TEXT NOT NULL,
+    event notification_event NOT NULL,
+    workspace_id BIGINT REFERENCES workspaces(id) ON DELETE CASCADE,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, dedupe_key)
+);
+
+CREATE INDEX idx_notifications_user ON notifications (user_id, id DESC);
+CREATE INDEX idx_notifications_unread ON notifications (user_id) WHERE read_at IS NULL;
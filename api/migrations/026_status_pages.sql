@@ -0,0 +1,43 @@
+-- public status pages, served by the API at status.<primary domain> once enabled, and the
+-- status history their uptime and incidents are worked out from.
+CREATE TABLE status_pages (
+    resource_id BIGINT PRIMARY KEY REFERENCES resources(id) ON DELETE CASCADE,
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    title TEXT NOT NULL DEFAULT '', -- the resource name when empty
+    description TEXT NOT NULL DEFAULT '',
+    logo_url TEXT NOT NULL DEFAULT '',
+    accent_color TEXT NOT NULL DEFAULT '', -- #rgb or #rrggbb
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- one row each time a resource's status changes, written by trigger so no change is missed
+CREATE TABLE resource_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    resource_id BIGINT NOT NULL REFERENCES resources(id) ON DELETE CASCADE,
+    status resource_status NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_resource_status_history_resource ON resource_status_history (resource_id, started_at);
+
+CREATE FUNCTION record_resource_status() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO resource_status_history (resource_id, status) VALUES (NEW.id, NEW.status);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER resources_record_status_insert
+    AFTER INSERT ON resources
+    FOR EACH ROW EXECUTE FUNCTION record_resource_status();
+
+CREATE TRIGGER resources_record_status_update
+    AFTER UPDATE ON resources
+    FOR EACH ROW
+    WHEN (OLD.status IS DISTINCT FROM NEW.status)
+    EXECUTE FUNCTION record_resource_status();
+
+-- history for existing resources starts now
+INSERT INTO resource_status_history (resource_id, status)
+SELECT id, status FROM resources;
@@ -0,0 +1,24 @@
+-- health probes the API sends to each service's primary domain from outside the cluster.
+-- resource_probe_state schedules them, so every resource is probed by one replica at a time,
+-- and counts consecutive failures; resource_probes keeps each result for status pages and alerts.
+CREATE TABLE resource_probe_state (
+    resource_id BIGINT PRIMARY KEY REFERENCES resources(id) ON DELETE CASCADE,
+    consecutive_failures INT NOT NULL DEFAULT 0,
+    next_probe_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_resource_probe_state_due ON resource_probe_state (next_probe_at);
+
+CREATE TABLE resource_probes (
+    id BIGSERIAL PRIMARY KEY,
+    resource_id BIGINT NOT NULL REFERENCES resources(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    ok BOOLEAN NOT NULL,
+    status_code INT NOT NULL, -- 0 when no response was received
+    latency_ms INT NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    checked_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_resource_probes_resource ON resource_probes (resource_id, checked_at DESC);
+CREATE INDEX idx_resource_probes_checked_at ON resource_probes (checked_at);
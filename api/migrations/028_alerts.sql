@@ -0,0 +1,48 @@
+-- alert rules workspace admins define on resource health and deployments, and what the alert
+-- engine remembers about each. Alerts go out through the notification outbox to the rule's
+-- targets rather than to users, so outbox rows no longer need a user.
+ALTER TYPE notification_event ADD VALUE 'alert';
+ALTER TYPE notification_channel ADD VALUE 'slack';
+ALTER TABLE notification_outbox ALTER COLUMN user_id DROP NOT NULL;
+
+CREATE TYPE alert_condition AS ENUM ('deployment_failed', 'restarts', 'latency_p95');
+
+CREATE TABLE alert_rules (
+    id BIGSERIAL PRIMARY KEY,
+    workspace_id BIGINT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    resource_id BIGINT REFERENCES resources(id) ON DELETE CASCADE, -- NULL for every service in the workspace
+    name TEXT NOT NULL,
+    condition alert_condition NOT NULL,
+    threshold DOUBLE PRECISION NOT NULL DEFAULT 0, -- restarts, or milliseconds for latency_p95
+    window_seconds INT NOT NULL DEFAULT 600,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    next_evaluation_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_alert_rules_workspace ON alert_rules (workspace_id);
+CREATE INDEX idx_alert_rules_due ON alert_rules (next_evaluation_at) WHERE enabled;
+
+CREATE TABLE alert_targets (
+    id BIGSERIAL PRIMARY KEY,
+    rule_id BIGINT NOT NULL REFERENCES alert_rules(id) ON DELETE CASCADE,
+    channel notification_channel NOT NULL,
+    target TEXT NOT NULL -- email address, webhook URL or Slack incoming webhook URL
+);
+
+CREATE INDEX idx_alert_targets_rule ON alert_targets (rule_id);
+
+-- per rule and resource: whether the alert is firing, and what the condition needs to remember
+-- between evaluations, such as restart counts already seen
+CREATE TABLE alert_states (
+    rule_id BIGINT NOT NULL REFERENCES alert_rules(id) ON DELETE CASCADE,
+    resource_id BIGINT NOT NULL REFERENCES resources(id) ON DELETE CASCADE,
+    firing BOOLEAN NOT NULL DEFAULT FALSE,
+    value DOUBLE PRECISION NOT NULL DEFAULT 0,
+    fired_at TIMESTAMPTZ,
+    data JSONB NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (rule_id, resource_id)
+);
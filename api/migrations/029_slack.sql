@@ -0,0 +1,28 @@
+-- the Slack app installed in an organization, and the channel each workspace posts deployment
+-- and alert messages to. Messages go out through the notification outbox like other deliveries.
+ALTER TYPE notification_event ADD VALUE 'deployment';
+ALTER TYPE notification_channel ADD VALUE 'slack_app';
+
+CREATE TABLE slack_installations (
+    org_id BIGINT PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+    team_id TEXT NOT NULL,
+    team_name TEXT NOT NULL,
+    bot_token TEXT NOT NULL,
+    installed_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_slack_installations_team ON slack_installations (team_id);
+
+CREATE TABLE workspace_slack_channels (
+    workspace_id BIGINT PRIMARY KEY REFERENCES workspaces(id) ON DELETE CASCADE,
+    channel_id TEXT NOT NULL,
+    channel_name TEXT NOT NULL,
+    deployments BOOLEAN NOT NULL DEFAULT TRUE, -- post deployment lifecycle messages
+    alerts BOOLEAN NOT NULL DEFAULT TRUE,      -- post alerts of the workspace's rules
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_workspace_slack_channels_channel ON workspace_slack_channels (channel_id);
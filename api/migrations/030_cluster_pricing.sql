@@ -0,0 +1,10 @@
+-- what a cluster charges for the CPU and memory its services request, used to estimate the monthly
+-- cost of a resource spec before it is deployed. Prices are in millionths of the currency unit per
+-- hour, so sub-cent rates like $0.0316 per vCPU-hour stay exact.
+CREATE TABLE cluster_pricing (
+    cluster_id BIGINT PRIMARY KEY REFERENCES clusters(id) ON DELETE CASCADE,
+    currency TEXT NOT NULL DEFAULT 'USD',
+    cpu_core_hour_micros BIGINT NOT NULL,  -- per vCPU requested
+    memory_gib_hour_micros BIGINT NOT NULL, -- per GiB of memory requested
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,48 @@
+-- billing: the plans orgs can subscribe to, each org's Stripe customer and subscription, and the
+-- hourly usage of its running deployments. usage_records is the metering table invoices are driven
+-- from; records made while an org has a subscription are billable and reported to Stripe once.
+CREATE TABLE billing_plans (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    stripe_price_id TEXT NOT NULL, -- the plan's recurring base price
+    monthly_cents BIGINT NOT NULL,
+    currency TEXT NOT NULL DEFAULT 'USD',
+    is_active BOOLEAN NOT NULL DEFAULT true, -- inactive plans keep their subscribers but take no new ones
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TYPE subscription_status AS ENUM ('none', 'incomplete', 'trialing', 'active', 'past_due', 'unpaid', 'canceled');
+
+CREATE TABLE org_billing (
+    org_id BIGINT PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+    stripe_customer_id TEXT NOT NULL UNIQUE,
+    plan_id BIGINT REFERENCES billing_plans(id) ON DELETE SET NULL,
+    stripe_subscription_id TEXT,
+    subscription_status subscription_status NOT NULL DEFAULT 'none',
+    current_period_end TIMESTAMPTZ,
+    delinquent BOOLEAN NOT NULL DEFAULT false, -- an invoice is unpaid; new deployments are blocked
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE usage_records (
+    id BIGSERIAL PRIMARY KEY,
+    org_id BIGINT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    resource_id BIGINT REFERENCES resources(id) ON DELETE SET NULL,
+    deployment_id BIGINT NOT NULL,
+    region TEXT NOT NULL,
+    hour TIMESTAMPTZ NOT NULL, -- start of the hour the deployment ran
+    replicas INT NOT NULL,
+    cpu_millicores BIGINT NOT NULL, -- per replica
+    memory_bytes BIGINT NOT NULL, -- per replica
+    cost_micros BIGINT NOT NULL, -- millionths of currency
+    currency TEXT NOT NULL,
+    billable BOOLEAN NOT NULL,
+    reported_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (deployment_id, hour)
+);
+
+CREATE INDEX idx_usage_records_org_hour ON usage_records (org_id, hour);
+CREATE INDEX idx_usage_records_unreported ON usage_records (id) WHERE billable AND reported_at IS NULL;
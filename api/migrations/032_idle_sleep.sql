@@ -0,0 +1,10 @@
+-- idle sleep: free-tier services without traffic are scaled to zero and woken by their next request.
+-- orgs on a plan are exempt unless the plan has idle_sleep. resource_sleep holds a row once a resource
+-- first sleeps; asleep_at is set while it sleeps and woke_at is when it last woke.
+ALTER TABLE billing_plans ADD COLUMN idle_sleep BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE resource_sleep (
+    resource_id BIGINT PRIMARY KEY REFERENCES resources(id) ON DELETE CASCADE,
+    asleep_at TIMESTAMPTZ,
+    woke_at TIMESTAMPTZ
+);
@@ -0,0 +1,29 @@
+-- versions for optimistic concurrency: a resource's version goes up whenever its name, description,
+-- spec or labels change, and a deployment's whenever it is scaled, respecified or superseded. status
+-- changes made by the controller leave them alone so they do not fail an editor's update.
+ALTER TABLE resources ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE deployments ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+CREATE FUNCTION bump_version() RETURNS trigger AS $$
+BEGIN
+    NEW.version := OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER resources_bump_version
+    BEFORE UPDATE ON resources
+    FOR EACH ROW
+    WHEN (OLD.name IS DISTINCT FROM NEW.name
+        OR OLD.description IS DISTINCT FROM NEW.description
+        OR OLD.spec IS DISTINCT FROM NEW.spec
+        OR OLD.labels IS DISTINCT FROM NEW.labels)
+    EXECUTE FUNCTION bump_version();
+
+CREATE TRIGGER deployments_bump_version
+    BEFORE UPDATE ON deployments
+    FOR EACH ROW
+    WHEN (OLD.replicas IS DISTINCT FROM NEW.replicas
+        OR OLD.spec IS DISTINCT FROM NEW.spec
+        OR OLD.is_active IS DISTINCT FROM NEW.is_active)
+    EXECUTE FUNCTION bump_version();
@@ -0,0 +1,15 @@
+-- image scans: the latest Trivy scan of each image deployed, by image reference. a failed scan keeps
+-- its error and no findings. workspaces with block_critical_vulnerabilities refuse to deploy images
+-- with critical findings, or images that could not be scanned.
+ALTER TABLE workspaces ADD COLUMN block_critical_vulnerabilities BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE image_scans (
+    image TEXT PRIMARY KEY,
+    critical INT NOT NULL DEFAULT 0,
+    high INT NOT NULL DEFAULT 0,
+    medium INT NOT NULL DEFAULT 0,
+    low INT NOT NULL DEFAULT 0,
+    findings JSONB NOT NULL DEFAULT '[]', -- every vulnerability found, most severe first
+    error TEXT,
+    scanned_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
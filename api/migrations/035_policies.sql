@@ -0,0 +1,16 @@
+-- deploy-time policies org admins define, such as required labels or allowed registries. The
+-- enabled policies of an org are checked whenever a resource is created or deployed in one of
+-- its workspaces, or in the one workspace a policy is limited to.
+CREATE TABLE policies (
+    id BIGSERIAL PRIMARY KEY,
+    org_id BIGINT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    workspace_id BIGINT REFERENCES workspaces(id) ON DELETE CASCADE, -- NULL for every workspace of the org
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    rule JSONB NOT NULL, -- policy.v1.PolicyRule
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (org_id, name)
+);
@@ -0,0 +1,15 @@
+-- what platform admins did through the AdminService: impersonating users, force-deleting resources,
+-- managing clusters, platform domains and other admins. actor_id is the admin, target_type and
+-- target_id what was acted on, e.g. 'resource' 42.
+CREATE TABLE admin_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    actor_id BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target_id BIGINT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    detail TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_admin_audit_log_target ON admin_audit_log (target_type, target_id);
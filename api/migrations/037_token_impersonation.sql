@@ -0,0 +1,2 @@
+-- impersonation tokens name the platform admin acting as the token's user; they go away with the admin
+ALTER TABLE tokens ADD COLUMN impersonator_id BIGINT REFERENCES users (id) ON DELETE CASCADE;
@@ -0,0 +1,19 @@
+-- passwords of users who sign up with an email address instead of an identity provider. They
+-- cannot log in until verified_at is set by the link emailed to them.
+CREATE TABLE user_passwords (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    password_hash TEXT NOT NULL,
+    verified_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- single-use tokens sent in verification and password reset emails. Only their sha256 is kept.
+CREATE TABLE email_tokens (
+    token_hash TEXT PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    purpose TEXT NOT NULL CHECK (purpose IN ('verify', 'reset')),
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_email_tokens_user ON email_tokens (user_id, purpose);
@@ -0,0 +1,16 @@
+-- TOTP second factors. secret is sealed with MFA_ENCRYPTION_KEY; enabled_at is set once the user has
+-- confirmed enrollment with a code. last_step is the time step of the last accepted code, so a code
+-- cannot be used twice.
+CREATE TABLE user_totp (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret BYTEA NOT NULL,
+    enabled_at TIMESTAMPTZ,
+    last_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- members of an organization requiring MFA only get a full login token after passing a TOTP check.
+ALTER TABLE organizations ADD COLUMN require_mfa BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- login tokens waiting on a TOTP check; they only allow enrolling in and completing MFA.
+ALTER TABLE tokens ADD COLUMN mfa_pending BOOLEAN NOT NULL DEFAULT FALSE;
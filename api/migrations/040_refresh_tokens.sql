@@ -0,0 +1,16 @@
+-- refresh tokens trade for a new login token and a new refresh token. Each can be used once; the ones
+-- descending from one login share family_id, so presenting a used one revokes the whole session. Only
+-- their sha256 is kept. access_token is the login token issued alongside, revoked with the session.
+CREATE TABLE refresh_tokens (
+    token_hash TEXT PRIMARY KEY,
+    family_id TEXT NOT NULL,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    access_token TEXT,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_refresh_tokens_family ON refresh_tokens (family_id);
+CREATE INDEX idx_refresh_tokens_user ON refresh_tokens (user_id);
+CREATE INDEX idx_refresh_tokens_access_token ON refresh_tokens (access_token) WHERE access_token IS NOT NULL;
@@ -0,0 +1,9 @@
+-- env vars shared by every resource in a workspace. A resource's own env vars win over them, and an
+-- environment's overlay wins over both. updated_at only moves when the values change, so resources
+-- whose active deployment is older run with stale values.
+CREATE TABLE workspace_env (
+    workspace_id BIGINT PRIMARY KEY REFERENCES workspaces(id) ON DELETE CASCADE,
+    env JSONB NOT NULL DEFAULT '{}',
+    updated_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
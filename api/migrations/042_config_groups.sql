@@ -0,0 +1,46 @@
+-- config groups are named env var bundles attached to any number of resources in a workspace. Their
+-- values sit between the workspace's shared env vars and each resource's own, which win over them;
+-- when a resource has several groups, the one attached last wins.
+CREATE TABLE config_groups (
+    id BIGSERIAL PRIMARY KEY,
+    workspace_id BIGINT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    env JSONB NOT NULL DEFAULT '{}',
+    created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (workspace_id, name)
+);
+
+CREATE TABLE config_group_resources (
+    config_group_id BIGINT NOT NULL REFERENCES config_groups(id) ON DELETE CASCADE,
+    resource_id BIGINT NOT NULL REFERENCES resources(id) ON DELETE CASCADE,
+    attached_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (config_group_id, resource_id)
+);
+
+CREATE INDEX idx_config_group_resources_resource_id ON config_group_resources (resource_id);
+
+-- changing a config group's env vars redeploys its resources one at a time in the background, stopping
+-- at the first that fails. previous_env is what the group held before, to tell its values on a running
+-- Application apart from the resource's own.
+CREATE TYPE config_rollout_status AS ENUM ('pending', 'running', 'completed', 'failed');
+
+CREATE TABLE config_rollouts (
+    id BIGSERIAL PRIMARY KEY,
+    config_group_id BIGINT NOT NULL REFERENCES config_groups(id) ON DELETE CASCADE,
+    status config_rollout_status NOT NULL DEFAULT 'pending',
+    previous_env JSONB NOT NULL DEFAULT '{}',
+    resource_ids BIGINT[] NOT NULL, -- attached when the rollout started, in rollout order
+    completed_resources INTEGER NOT NULL DEFAULT 0,
+    current_deployment_id BIGINT, -- deployment of resource_ids[completed_resources + 1] being waited on
+    error TEXT NOT NULL DEFAULT '',
+    created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ
+);
+
+-- a group has at most one rollout in flight
+CREATE UNIQUE INDEX idx_config_rollouts_active ON config_rollouts (config_group_id) WHERE status IN ('pending', 'running');
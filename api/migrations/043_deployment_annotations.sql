@@ -0,0 +1,6 @@
+-- what a deployment shipped: release notes, who shipped it and the commit it was built from. set
+-- once when the deployment is created; empty when the deployer did not say.
+ALTER TABLE deployments
+    ADD COLUMN annotation_message TEXT NOT NULL DEFAULT '',
+    ADD COLUMN annotation_author TEXT NOT NULL DEFAULT '',
+    ADD COLUMN annotation_git_sha TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,11 @@
+-- where a deployment's image came from, read from its OCI source labels
+-- (org.opencontainers.image.revision and .source) after the deployment is created. a failed lookup
+-- keeps its error; images without the labels leave the commit and repository empty.
+CREATE TABLE deployment_provenance (
+    deployment_id BIGINT PRIMARY KEY REFERENCES deployments(id) ON DELETE CASCADE,
+    image TEXT NOT NULL,
+    commit_sha TEXT NOT NULL DEFAULT '',
+    repository_url TEXT NOT NULL DEFAULT '',
+    error TEXT,
+    fetched_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,11 @@
+-- node pools a cluster offers, e.g. GPU or arm64 nodes. services asking to run on particular nodes
+-- or tolerate taints are checked against the pools of each of their regions' clusters.
+CREATE TABLE cluster_node_pools (
+    id BIGSERIAL PRIMARY KEY,
+    cluster_id BIGINT NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    labels JSONB NOT NULL DEFAULT '{}', -- node labels, e.g. {"kubernetes.io/arch": "arm64"}
+    taints JSONB NOT NULL DEFAULT '[]', -- [{"key": ..., "value": ..., "effect": ...}]
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (cluster_id, name)
+);
@@ -0,0 +1,36 @@
+-- DNS records published for platform-provided subdomains, pointing at the ingress of the cluster
+-- serving the resource's primary region. a record outlives its domain until it is removed from
+-- the provider, so it is keyed by hostname rather than by the domain.
+CREATE TABLE dns_records (
+    id BIGSERIAL PRIMARY KEY,
+    hostname TEXT NOT NULL UNIQUE,
+    record_type TEXT NOT NULL, -- A, AAAA or CNAME
+    target TEXT NOT NULL,
+    provider_record_id TEXT NOT NULL DEFAULT '', -- the provider's ID for the record, when it has one
+    status TEXT NOT NULL CHECK (status IN ('published', 'failed')),
+    last_error TEXT NOT NULL DEFAULT '',
+    synced_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- domains being added and removed are announced so their records follow right away
+CREATE FUNCTION notify_resource_domain_change() RETURNS trigger AS $$
+DECLARE
+    changed resource_domains;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := OLD;
+    ELSE
+        changed := NEW;
+    END IF;
+    PERFORM pg_notify('loco_changes', json_build_object(
+        'table', TG_TABLE_NAME, 'id', changed.id, 'resource_id', changed.resource_id
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER resource_domains_notify
+    AFTER INSERT OR DELETE ON resource_domains
+    FOR EACH ROW EXECUTE FUNCTION notify_resource_domain_change();
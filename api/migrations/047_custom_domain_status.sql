@@ -0,0 +1,10 @@
+-- user-provided domains start out pending and become active once their DNS records point at
+-- the platform. existing domains are taken to be set up already.
+CREATE TYPE domain_status AS ENUM ('pending', 'active');
+
+ALTER TABLE resource_domains
+    ADD COLUMN status domain_status NOT NULL DEFAULT 'active',
+    ADD COLUMN status_message TEXT NOT NULL DEFAULT '', -- why a pending domain is not active yet
+    ADD COLUMN status_checked_at TIMESTAMPTZ;
+
+CREATE INDEX idx_resource_domains_pending ON resource_domains(id) WHERE status = 'pending';
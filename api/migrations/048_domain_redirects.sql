@@ -0,0 +1,3 @@
+-- redirect sends every request for a domain elsewhere instead of serving the resource, e.g.
+-- www to the apex or an old domain to a new one. NULL serves the domain normally.
+ALTER TABLE resource_domains ADD COLUMN redirect JSONB;
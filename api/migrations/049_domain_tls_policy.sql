@@ -0,0 +1,3 @@
+-- tls tightens TLS for a domain while it serves its resource: a minimum TLS version, HSTS and
+-- client certificates. NULL keeps the platform's defaults.
+ALTER TABLE resource_domains ADD COLUMN tls JSONB;
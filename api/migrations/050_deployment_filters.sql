@@ -0,0 +1,5 @@
+-- created_by is the user who deployed; NULL for deployments the platform made itself, e.g. rollbacks.
+ALTER TABLE deployments ADD COLUMN created_by BIGINT REFERENCES users(id) ON DELETE SET NULL;
+
+-- listing a resource's deployments filtered by status, e.g. its last few failures
+CREATE INDEX idx_deployments_resource_status_created_id ON deployments (resource_id, status, created_at DESC, id DESC);
@@ -0,0 +1,14 @@
+-- changes to a resource that leave no row of their own, for its activity timeline. Deployments
+-- and incidents are read from deployments and resource_status_history instead.
+CREATE TYPE resource_activity_kind AS ENUM ('scaled', 'env_changed', 'domain_changed');
+
+CREATE TABLE resource_activity (
+    id BIGSERIAL PRIMARY KEY,
+    resource_id BIGINT NOT NULL REFERENCES resources(id) ON DELETE CASCADE,
+    kind resource_activity_kind NOT NULL,
+    summary TEXT NOT NULL,
+    actor_id BIGINT REFERENCES users(id) ON DELETE SET NULL, -- NULL for changes the platform made itself
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_resource_activity_resource_created ON resource_activity (resource_id, created_at DESC, id DESC);
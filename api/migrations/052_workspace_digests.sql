@@ -0,0 +1,13 @@
+-- weekly summaries of each workspace's activity, compiled by the digest job once the week is over.
+-- Weeks start on Monday at midnight UTC.
+ALTER TYPE notification_event ADD VALUE 'workspace_digest';
+
+CREATE TABLE workspace_digests (
+    workspace_id BIGINT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    week_start TIMESTAMPTZ NOT NULL,
+    deployments INT NOT NULL,
+    failed_deployments INT NOT NULL,
+    top_resources JSONB NOT NULL DEFAULT '[]', -- busiest resources by metered replica hours
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (workspace_id, week_start)
+);
@@ -0,0 +1,4 @@
+-- mfa_verified marks the sessions that passed a TOTP check, carried from each refresh token to the next.
+-- A session that did not is revoked on refresh once its user needs MFA, so sessions from before an org
+-- required MFA cannot outlive the requirement. Existing sessions have not passed one as far as we know.
+ALTER TABLE refresh_tokens ADD COLUMN mfa_verified BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,10 @@
+// Package migrations embeds the schema migrations so the API binary can apply them itself.
+//
+// Each migration is NNN_name.sql, applied in order of NNN. Migrations only go forward: to undo
+// one, add a new migration that reverses it.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
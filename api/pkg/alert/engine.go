@@ -0,0 +1,362 @@
+// Package alert evaluates the alert rules workspace admins define against deployments, pod
+// restarts and health probe latency, and notifies each rule's targets when an alert fires and
+// when it resolves.
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/kube"
+	"github.com/team-loco/loco/api/pkg/notify"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultInterval is how often each rule is evaluated when no interval is given.
+const DefaultInterval = 30 * time.Second
+
+// batchSize is how many rules are claimed at a time.
+const batchSize = 50
+
+// Engine evaluates enabled rules once per interval. Rules are claimed from the database, so each
+// is evaluated by one replica at a time however many are running.
+type Engine struct {
+	queries    genDb.Querier
+	kubeClient *kube.Client
+	notifier   *notify.Notifier
+	interval   time.Duration
+}
+
+// NewEngine creates an Engine that evaluates each rule every interval (DefaultInterval if zero).
+// kubeClient may be nil, in which case restart rules never fire.
+func NewEngine(queries genDb.Querier, kubeClient *kube.Client, notifier *notify.Notifier, interval time.Duration) *Engine {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Engine{
+		queries:    queries,
+		kubeClient: kubeClient,
+		notifier:   notifier,
+		interval:   interval,
+	}
+}
+
+// Start evaluates due rules once immediately and then on every tick until ctx is canceled.
+func (e *Engine) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting alert engine", "interval", e.interval)
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		e.evaluateDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *Engine) evaluateDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		rules, err := e.queries.ClaimAlertRules(ctx, genDb.ClaimAlertRulesParams{
+			Interval:  pgtype.Interval{Microseconds: e.interval.Microseconds(), Valid: true},
+			BatchSize: batchSize,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to claim alert rules", "error", err)
+			return
+		}
+		if len(rules) == 0 {
+			return
+		}
+
+		ruleIDs := make([]int64, 0, len(rules))
+		for _, rule := range rules {
+			ruleIDs = append(ruleIDs, rule.ID)
+		}
+		dbTargets, err := e.queries.ListAlertTargets(ctx, ruleIDs)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to list alert targets", "error", err)
+			return
+		}
+		targets := map[int64][]notify.Target{}
+		for _, t := range dbTargets {
+			targets[t.RuleID] = append(targets[t.RuleID], notify.Target{Channel: t.Channel, Address: t.Target})
+		}
+
+		for _, rule := range rules {
+			if ctx.Err() != nil {
+				return
+			}
+			e.evaluateRule(ctx, rule, targets[rule.ID])
+		}
+	}
+}
+
+func (e *Engine) evaluateRule(ctx context.Context, rule genDb.AlertRule, targets []notify.Target) {
+	logger := slog.With("ruleId", rule.ID, "condition", rule.Condition)
+
+	resources, err := e.queries.ListAlertRuleResources(ctx, genDb.ListAlertRuleResourcesParams{
+		WorkspaceID: rule.WorkspaceID,
+		ResourceID:  rule.ResourceID,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to list alert rule resources", "error", err)
+		return
+	}
+
+	for _, resource := range resources {
+		if err := e.evaluate(ctx, rule, resource, targets); err != nil {
+			logger.ErrorContext(ctx, "failed to evaluate alert rule", "resourceId", resource.ID, "error", err)
+		}
+	}
+}
+
+// state is what is remembered about a rule and resource between evaluations.
+type state struct {
+	firing  bool
+	value   float64
+	firedAt pgtype.Timestamptz
+	data    stateData
+}
+
+// stateData is the condition-specific part of the state, stored as JSON.
+type stateData struct {
+	// LastDeploymentID is the newest deployment already checked for failure.
+	LastDeploymentID int64 `json:"last_deployment_id,omitempty"`
+	// PodRestarts is the restart count last seen for each pod, by pod UID.
+	PodRestarts map[string]int32 `json:"pod_restarts,omitempty"`
+	// Restarts are the restarts seen within the rule's window.
+	Restarts []restartSample `json:"restarts,omitempty"`
+}
+
+type restartSample struct {
+	At    time.Time `json:"at"`
+	Count int32     `json:"count"`
+}
+
+func (e *Engine) evaluate(ctx context.Context, rule genDb.AlertRule, resource genDb.ListAlertRuleResourcesRow, targets []notify.Target) error {
+	current, found, err := e.loadState(ctx, rule.ID, resource.ID)
+	if err != nil {
+		return err
+	}
+	next := current
+
+	switch rule.Condition {
+	case genDb.AlertConditionDeploymentFailed:
+		if err := e.checkDeployments(ctx, rule, resource, found, &next, targets); err != nil {
+			return err
+		}
+	case genDb.AlertConditionRestarts:
+		restarts, ok, err := e.countRestarts(ctx, rule, resource, found, &next)
+		if err != nil {
+			return err
+		}
+		if ok {
+			next.value = float64(restarts)
+			e.transition(ctx, rule, resource, &next, restarts > int32(rule.Threshold), targets,
+				fmt.Sprintf("%d restarts in the last %s (threshold %g)", restarts, window(rule), rule.Threshold))
+		}
+	case genDb.AlertConditionLatencyP95:
+		latency, err := e.queries.GetResourceProbeLatencyP95(ctx, genDb.GetResourceProbeLatencyP95Params{
+			ResourceID: resource.ID,
+			Since:      pgtype.Timestamptz{Time: time.Now().Add(-window(rule)), Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("get probe latency: %w", err)
+		}
+		if latency.Probes > 0 {
+			next.value = latency.P95
+			e.transition(ctx, rule, resource, &next, latency.P95 > rule.Threshold, targets,
+				fmt.Sprintf("p95 latency %.0f ms over the last %s (threshold %g ms)", latency.P95, window(rule), rule.Threshold))
+		}
+	default:
+		return fmt.Errorf("unknown condition %q", rule.Condition)
+	}
+
+	return e.saveState(ctx, rule.ID, resource.ID, next)
+}
+
+// checkDeployments alerts once for every deployment that failed since the last evaluation.
+func (e *Engine) checkDeployments(ctx context.Context, rule genDb.AlertRule, resource genDb.ListAlertRuleResourcesRow, found bool, next *state, targets []notify.Target) error {
+	if !found {
+		// deployments that failed before the rule existed are not alerted on
+		latest, err := e.queries.GetLatestDeploymentID(ctx, resource.ID)
+		if err != nil {
+			return fmt.Errorf("get latest deployment: %w", err)
+		}
+		next.data.LastDeploymentID = latest
+		return nil
+	}
+
+	failed, err := e.queries.ListFailedDeploymentsAfter(ctx, genDb.ListFailedDeploymentsAfterParams{
+		ResourceID: resource.ID,
+		AfterID:    next.data.LastDeploymentID,
+	})
+	if err != nil {
+		return fmt.Errorf("list failed deployments: %w", err)
+	}
+	for _, deployment := range failed {
+		body := fmt.Sprintf("Deployment %d of %s in %s failed.", deployment.ID, resource.Name, deployment.Region)
+		if deployment.Message != "" {
+			body += "\n\n" + deployment.Message
+		}
+		e.send(ctx, rule, resource, targets, true, map[string]any{"deployment_id": deployment.ID}, fmt.Sprint(deployment.ID),
+			fmt.Sprintf("[%s] Deployment of %s failed", rule.Name, resource.Name), body)
+		next.data.LastDeploymentID = deployment.ID
+	}
+	if len(failed) > 0 {
+		next.value = float64(len(failed))
+		next.firedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	}
+
+	// deployments that did not fail are passed over too
+	latest, err := e.queries.GetLatestDeploymentID(ctx, resource.ID)
+	if err != nil {
+		return fmt.Errorf("get latest deployment: %w", err)
+	}
+	if latest > next.data.LastDeploymentID {
+		next.data.LastDeploymentID = latest
+	}
+	return nil
+}
+
+// countRestarts returns how many times the resource's containers restarted within the rule's
+// window. ok is false when the pods could not be seen.
+func (e *Engine) countRestarts(ctx context.Context, rule genDb.AlertRule, resource genDb.ListAlertRuleResourcesRow, found bool, next *state) (int32, bool, error) {
+	if e.kubeClient == nil {
+		return 0, false, nil
+	}
+
+	namespace := fmt.Sprintf("wks-%d-res-%d", resource.WorkspaceID, resource.ID)
+	pods, err := e.kubeClient.ClientSet.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, false, fmt.Errorf("list pods: %w", err)
+	}
+
+	now := time.Now()
+	seen := map[string]int32{}
+	var restarted int32
+	for _, pod := range pods.Items {
+		var count int32
+		for _, status := range pod.Status.ContainerStatuses {
+			count += status.RestartCount
+		}
+		uid := string(pod.UID)
+		seen[uid] = count
+
+		previous, known := next.data.PodRestarts[uid]
+		switch {
+		case known:
+			restarted += max(0, count-previous)
+		case found:
+			// a pod that appeared since the last evaluation restarted since then too
+			restarted += count
+		}
+	}
+	next.data.PodRestarts = seen
+
+	if restarted > 0 {
+		next.data.Restarts = append(next.data.Restarts, restartSample{At: now, Count: restarted})
+	}
+	cutoff := now.Add(-window(rule))
+	var total int32
+	kept := next.data.Restarts[:0]
+	for _, sample := range next.data.Restarts {
+		if sample.At.Before(cutoff) {
+			continue
+		}
+		kept = append(kept, sample)
+		total += sample.Count
+	}
+	next.data.Restarts = kept
+	return total, true, nil
+}
+
+// transition fires the alert when breached and it is not firing yet, and resolves it once it is no longer breached.
+func (e *Engine) transition(ctx context.Context, rule genDb.AlertRule, resource genDb.ListAlertRuleResourcesRow, next *state, breached bool, targets []notify.Target, detail string) {
+	switch {
+	case breached && !next.firing:
+		next.firing = true
+		next.firedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+		e.send(ctx, rule, resource, targets, true, map[string]any{"value": next.value}, fmt.Sprintf("%d:fired", next.firedAt.Time.Unix()),
+			fmt.Sprintf("[%s] %s is alerting", rule.Name, resource.Name), detail)
+	case !breached && next.firing:
+		next.firing = false
+		e.send(ctx, rule, resource, targets, false, map[string]any{"value": next.value}, fmt.Sprintf("%d:resolved", next.firedAt.Time.Unix()),
+			fmt.Sprintf("[%s] %s resolved", rule.Name, resource.Name), detail)
+	}
+}
+
+func (e *Engine) send(ctx context.Context, rule genDb.AlertRule, resource genDb.ListAlertRuleResourcesRow, targets []notify.Target, firing bool, data map[string]any, key, subject, body string) {
+	slog.InfoContext(ctx, "alert", "ruleId", rule.ID, "resourceId", resource.ID, "subject", subject)
+	data["rule_id"] = rule.ID
+	data["resource_id"] = resource.ID
+	data["condition"] = string(rule.Condition)
+	data["state"] = alertState(firing)
+	err := e.notifier.NotifyTargets(ctx, notify.Event{
+		Kind:        genDb.NotificationEventAlert,
+		Key:         fmt.Sprintf("%d:%d:%s", rule.ID, resource.ID, key),
+		WorkspaceID: rule.WorkspaceID,
+		Subject:     subject,
+		Body:        body,
+		Data:        data,
+	}, targets)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to queue alert", "ruleId", rule.ID, "resourceId", resource.ID, "error", err)
+	}
+}
+
+func (e *Engine) loadState(ctx context.Context, ruleID, resourceID int64) (state, bool, error) {
+	row, err := e.queries.GetAlertState(ctx, genDb.GetAlertStateParams{RuleID: ruleID, ResourceID: resourceID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return state{}, false, nil
+		}
+		return state{}, false, fmt.Errorf("get alert state: %w", err)
+	}
+	s := state{firing: row.Firing, value: row.Value, firedAt: row.FiredAt}
+	if err := json.Unmarshal(row.Data, &s.data); err != nil {
+		return state{}, false, fmt.Errorf("decode alert state: %w", err)
+	}
+	return s, true, nil
+}
+
+func (e *Engine) saveState(ctx context.Context, ruleID, resourceID int64, s state) error {
+	data, err := json.Marshal(s.data)
+	if err != nil {
+		return err
+	}
+	if err := e.queries.UpsertAlertState(ctx, genDb.UpsertAlertStateParams{
+		RuleID:     ruleID,
+		ResourceID: resourceID,
+		Firing:     s.firing,
+		Value:      s.value,
+		FiredAt:    s.firedAt,
+		Data:       data,
+	}); err != nil {
+		return fmt.Errorf("save alert state: %w", err)
+	}
+	return nil
+}
+
+func alertState(firing bool) string {
+	if firing {
+		return "firing"
+	}
+	return "resolved"
+}
+
+func window(rule genDb.AlertRule) time.Duration {
+	return time.Duration(rule.WindowSeconds) * time.Second
+}
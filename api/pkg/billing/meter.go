@@ -0,0 +1,174 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/cost"
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// DefaultInterval is how often running deployments are metered when no interval is given.
+const DefaultInterval = 5 * time.Minute
+
+// reportBatchSize is how many usage records are reported to Stripe per tick.
+const reportBatchSize = 500
+
+// Meter records an hour of usage for every running service deployment whose cluster has pricing,
+// at the CPU and memory it runs with and the replicas it was deployed with, so a deployment seen
+// running during an hour is charged for that hour. Usage of orgs with a subscription is reported
+// to Stripe's meter, which the usage price of the subscription invoices at the end of each period.
+type Meter struct {
+	queries  genDb.Querier
+	client   *Client
+	interval time.Duration
+}
+
+// NewMeter creates a Meter that meters every interval (DefaultInterval if zero). client is nil when
+// billing is not configured; usage is then recorded but not reported.
+func NewMeter(queries genDb.Querier, client *Client, interval time.Duration) *Meter {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Meter{
+		queries:  queries,
+		client:   client,
+		interval: interval,
+	}
+}
+
+// Start meters once immediately and then every interval until ctx is canceled.
+func (m *Meter) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting usage meter", "interval", m.interval, "reporting", m.client != nil)
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		m.record(ctx, time.Now())
+		if m.client != nil {
+			m.report(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Meter) record(ctx context.Context, now time.Time) {
+	deployments, err := m.queries.ListMeteredDeployments(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list metered deployments", "error", err)
+		return
+	}
+
+	hour := pgtype.Timestamptz{Time: now.UTC().Truncate(time.Hour), Valid: true}
+	recorded := 0
+	for _, d := range deployments {
+		if d.ResourceType != genDb.ResourceTypeService {
+			continue
+		}
+		cpu, memory, err := serviceResources(d)
+		if err != nil {
+			slog.WarnContext(ctx, "skipping deployment that cannot be metered", "deploymentId", d.DeploymentID, "error", err)
+			continue
+		}
+		replicas := max(d.Replicas, 1)
+		pricing := cost.Pricing{
+			Currency:            d.Currency,
+			CpuCoreHourMicros:   d.CpuCoreHourMicros,
+			MemoryGibHourMicros: d.MemoryGibHourMicros,
+		}
+
+		n, err := m.queries.RecordUsage(ctx, genDb.RecordUsageParams{
+			OrgID:         d.OrgID,
+			ResourceID:    pgtype.Int8{Int64: d.ResourceID, Valid: true},
+			DeploymentID:  d.DeploymentID,
+			Region:        d.Region,
+			Hour:          hour,
+			Replicas:      replicas,
+			CpuMillicores: cpu.MilliValue(),
+			MemoryBytes:   memory.Value(),
+			CostMicros:    cost.HourlyMicros(cpu, memory, replicas, pricing),
+			Currency:      d.Currency,
+			Billable:      d.Billable,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to record usage", "deploymentId", d.DeploymentID, "error", err)
+			continue
+		}
+		recorded += int(n)
+	}
+	if recorded > 0 {
+		slog.InfoContext(ctx, "recorded usage", "hour", hour.Time, "deployments", recorded)
+	}
+}
+
+// serviceResources returns the CPU and memory a service deployment runs with: its own when it set
+// them, else its region's in the resource spec.
+func serviceResources(d genDb.ListMeteredDeploymentsRow) (resource.Quantity, resource.Quantity, error) {
+	var spec resourcev1.ServiceSpec
+	if err := protojson.Unmarshal(d.ResourceSpec, &spec); err != nil {
+		return resource.Quantity{}, resource.Quantity{}, fmt.Errorf("invalid resource spec: %w", err)
+	}
+	target := spec.GetRegions()[d.Region]
+	cpu, memory := target.GetCpu(), target.GetMemory()
+
+	if len(d.Spec) > 0 {
+		var deploymentSpec deploymentv1.ServiceDeploymentSpec
+		if err := protojson.Unmarshal(d.Spec, &deploymentSpec); err != nil {
+			return resource.Quantity{}, resource.Quantity{}, fmt.Errorf("invalid deployment spec: %w", err)
+		}
+		if deploymentSpec.GetCpu() != "" {
+			cpu = deploymentSpec.GetCpu()
+		}
+		if deploymentSpec.GetMemory() != "" {
+			memory = deploymentSpec.GetMemory()
+		}
+	}
+
+	cpuQuantity, err := resource.ParseQuantity(cpu)
+	if err != nil {
+		return resource.Quantity{}, resource.Quantity{}, fmt.Errorf("invalid cpu %q", cpu)
+	}
+	memoryQuantity, err := resource.ParseQuantity(memory)
+	if err != nil {
+		return resource.Quantity{}, resource.Quantity{}, fmt.Errorf("invalid memory %q", memory)
+	}
+	return cpuQuantity, memoryQuantity, nil
+}
+
+// report sends billable usage to Stripe. A record is marked reported only after Stripe accepted
+// it; its identifier keeps a retry after a failed mark from counting twice.
+func (m *Meter) report(ctx context.Context) {
+	records, err := m.queries.ListUnreportedUsage(ctx, reportBatchSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list unreported usage", "error", err)
+		return
+	}
+
+	for _, u := range records {
+		identifier := fmt.Sprintf("loco-usage-%d", u.ID)
+		if err := m.client.ReportUsage(ctx, u.StripeCustomerID, u.CostMicros, u.Hour.Time, identifier); err != nil {
+			slog.WarnContext(ctx, "failed to report usage", "usageId", u.ID, "error", err)
+			return
+		}
+		if err := m.queries.MarkUsageReported(ctx, u.ID); err != nil {
+			slog.ErrorContext(ctx, "failed to mark usage reported", "usageId", u.ID, "error", err)
+			return
+		}
+	}
+	if len(records) > 0 {
+		slog.InfoContext(ctx, "reported usage", "records", len(records))
+	}
+}
@@ -0,0 +1,345 @@
+// Package billing charges organizations through Stripe: each org is a Stripe customer subscribed
+// to one of the plans in billing_plans plus a metered usage price, the Meter records the hourly
+// usage of running deployments and reports it to Stripe, and the webhook Handler keeps
+// subscription status and delinquency in step with Stripe's invoices.
+package billing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// APIURL is the base of the Stripe API.
+const APIURL = "https://api.stripe.com/v1/"
+
+// apiVersion pins the shape of Stripe's responses and webhook payloads.
+const apiVersion = "2024-06-20"
+
+// ErrNotConfigured is returned when no Stripe key is set.
+var ErrNotConfigured = errors.New("billing is not configured")
+
+// Config holds the Stripe credentials and prices. Billing is enabled when StripeSecretKey is set;
+// without it usage is still metered but nothing is charged.
+type Config struct {
+	StripeSecretKey     string        `env:"STRIPE_SECRET_KEY"`
+	StripeWebhookSecret string        `env:"STRIPE_WEBHOOK_SECRET" requiredWith:"STRIPE_SECRET_KEY"` // signs events sent to the API's /billing/stripe/webhook
+	UsagePriceID        string        `env:"STRIPE_USAGE_PRICE_ID" requiredWith:"STRIPE_SECRET_KEY"` // metered price every subscription gets; one unit is a millionth of the currency
+	MeterEventName      string        `env:"STRIPE_METER_EVENT_NAME" default:"loco_usage"`           // event name of the meter behind the usage price
+	MeterInterval       time.Duration `env:"BILLING_METER_INTERVAL"`                                 // how often running deployments are metered
+}
+
+// Client calls the Stripe API.
+type Client struct {
+	cfg        Config
+	httpClient *http.Client
+	apiURL     string
+}
+
+// NewClient creates a Client, or returns nil when cfg has no secret key. A nil Client's methods
+// return ErrNotConfigured.
+func NewClient(cfg Config, httpClient *http.Client) *Client {
+	if cfg.StripeSecretKey == "" {
+		return nil
+	}
+	return &Client{cfg: cfg, httpClient: httpClient, apiURL: APIURL}
+}
+
+// Error is an error returned by the Stripe API.
+type Error struct {
+	StatusCode int
+	Type       string
+	Code       string
+	Message    string
+}
+
+func (e *Error) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("stripe: %s (%s)", e.Message, e.Code)
+	}
+	return "stripe: " + e.Message
+}
+
+// Subscription is a customer's Stripe subscription.
+type Subscription struct {
+	ID               string
+	CustomerID       string
+	Status           string
+	CurrentPeriodEnd time.Time
+	Items            []SubscriptionItem
+}
+
+// SubscriptionItem is one price of a subscription.
+type SubscriptionItem struct {
+	ID      string
+	PriceID string
+}
+
+// PaymentMethod is a card or other method a customer pays with.
+type PaymentMethod struct {
+	ID       string
+	Type     string
+	Brand    string // cards only
+	Last4    string // cards only
+	ExpMonth int32  // cards only
+	ExpYear  int32  // cards only
+}
+
+// subscriptionJSON is a subscription as the API and webhooks encode it.
+type subscriptionJSON struct {
+	ID               string `json:"id"`
+	Customer         string `json:"customer"`
+	Status           string `json:"status"`
+	CurrentPeriodEnd int64  `json:"current_period_end"`
+	Items            struct {
+		Data []struct {
+			ID    string `json:"id"`
+			Price struct {
+				ID string `json:"id"`
+			} `json:"price"`
+		} `json:"data"`
+	} `json:"items"`
+}
+
+func (s subscriptionJSON) subscription() Subscription {
+	sub := Subscription{
+		ID:         s.ID,
+		CustomerID: s.Customer,
+		Status:     s.Status,
+	}
+	if s.CurrentPeriodEnd > 0 {
+		sub.CurrentPeriodEnd = time.Unix(s.CurrentPeriodEnd, 0).UTC()
+	}
+	for _, item := range s.Items.Data {
+		sub.Items = append(sub.Items, SubscriptionItem{ID: item.ID, PriceID: item.Price.ID})
+	}
+	return sub
+}
+
+// PlanItem returns the item of a subscription that is not the usage price.
+func (c *Client) PlanItem(sub Subscription) (SubscriptionItem, bool) {
+	for _, item := range sub.Items {
+		if c == nil || item.PriceID != c.cfg.UsagePriceID {
+			return item, true
+		}
+	}
+	return SubscriptionItem{}, false
+}
+
+// CreateCustomer creates the customer an org is billed as.
+func (c *Client) CreateCustomer(ctx context.Context, orgID int64, name string) (string, error) {
+	if c == nil {
+		return "", ErrNotConfigured
+	}
+	form := url.Values{}
+	form.Set("name", name)
+	form.Set("metadata[org_id]", strconv.FormatInt(orgID, 10))
+
+	var resp struct {
+		ID string `json:"id"`
+	}
+	// the key makes a retried create return the first customer instead of a second one
+	key := fmt.Sprintf("loco-org-%d-customer", orgID)
+	if err := c.call(ctx, http.MethodPost, "customers", key, form, &resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// CreateSubscription subscribes a customer to a plan's price and the usage price. The
+// subscription stays incomplete until its first invoice is paid.
+func (c *Client) CreateSubscription(ctx context.Context, customerID, planPriceID, idempotencyKey string) (Subscription, error) {
+	if c == nil {
+		return Subscription{}, ErrNotConfigured
+	}
+	form := url.Values{}
+	form.Set("customer", customerID)
+	form.Set("items[0][price]", planPriceID)
+	form.Set("items[1][price]", c.cfg.UsagePriceID)
+	form.Set("payment_behavior", "default_incomplete")
+
+	var resp subscriptionJSON
+	if err := c.call(ctx, http.MethodPost, "subscriptions", idempotencyKey, form, &resp); err != nil {
+		return Subscription{}, err
+	}
+	return resp.subscription(), nil
+}
+
+// GetSubscription returns a subscription.
+func (c *Client) GetSubscription(ctx context.Context, subscriptionID string) (Subscription, error) {
+	if c == nil {
+		return Subscription{}, ErrNotConfigured
+	}
+	var resp subscriptionJSON
+	if err := c.call(ctx, http.MethodGet, "subscriptions/"+url.PathEscape(subscriptionID), "", nil, &resp); err != nil {
+		return Subscription{}, err
+	}
+	return resp.subscription(), nil
+}
+
+// ChangeSubscriptionPrice moves an item of a subscription to another price, prorating the change.
+func (c *Client) ChangeSubscriptionPrice(ctx context.Context, subscriptionID, itemID, priceID string) (Subscription, error) {
+	if c == nil {
+		return Subscription{}, ErrNotConfigured
+	}
+	form := url.Values{}
+	form.Set("items[0][id]", itemID)
+	form.Set("items[0][price]", priceID)
+	form.Set("proration_behavior", "create_prorations")
+
+	var resp subscriptionJSON
+	if err := c.call(ctx, http.MethodPost, "subscriptions/"+url.PathEscape(subscriptionID), "", form, &resp); err != nil {
+		return Subscription{}, err
+	}
+	return resp.subscription(), nil
+}
+
+// CancelSubscription ends a subscription now. Usage reported so far is still invoiced.
+func (c *Client) CancelSubscription(ctx context.Context, subscriptionID string) (Subscription, error) {
+	if c == nil {
+		return Subscription{}, ErrNotConfigured
+	}
+	form := url.Values{}
+	form.Set("invoice_now", "true")
+
+	var resp subscriptionJSON
+	if err := c.call(ctx, http.MethodDelete, "subscriptions/"+url.PathEscape(subscriptionID), "", form, &resp); err != nil {
+		return Subscription{}, err
+	}
+	return resp.subscription(), nil
+}
+
+// CreatePortalSession returns the URL of a Stripe billing portal session, where the customer adds
+// and removes payment methods and pays open invoices.
+func (c *Client) CreatePortalSession(ctx context.Context, customerID, returnURL string) (string, error) {
+	if c == nil {
+		return "", ErrNotConfigured
+	}
+	form := url.Values{}
+	form.Set("customer", customerID)
+	if returnURL != "" {
+		form.Set("return_url", returnURL)
+	}
+
+	var resp struct {
+		URL string `json:"url"`
+	}
+	if err := c.call(ctx, http.MethodPost, "billing_portal/sessions", "", form, &resp); err != nil {
+		return "", err
+	}
+	return resp.URL, nil
+}
+
+// ListPaymentMethods returns the payment methods attached to a customer.
+func (c *Client) ListPaymentMethods(ctx context.Context, customerID string) ([]PaymentMethod, error) {
+	if c == nil {
+		return nil, ErrNotConfigured
+	}
+	var resp struct {
+		Data []struct {
+			ID   string `json:"id"`
+			Type string `json:"type"`
+			Card *struct {
+				Brand    string `json:"brand"`
+				Last4    string `json:"last4"`
+				ExpMonth int32  `json:"exp_month"`
+				ExpYear  int32  `json:"exp_year"`
+			} `json:"card"`
+		} `json:"data"`
+	}
+	path := "customers/" + url.PathEscape(customerID) + "/payment_methods?limit=100"
+	if err := c.call(ctx, http.MethodGet, path, "", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	methods := make([]PaymentMethod, 0, len(resp.Data))
+	for _, pm := range resp.Data {
+		method := PaymentMethod{ID: pm.ID, Type: pm.Type}
+		if pm.Card != nil {
+			method.Brand = pm.Card.Brand
+			method.Last4 = pm.Card.Last4
+			method.ExpMonth = pm.Card.ExpMonth
+			method.ExpYear = pm.Card.ExpYear
+		}
+		methods = append(methods, method)
+	}
+	return methods, nil
+}
+
+// ReportUsage sends a meter event adding value to the customer's usage at the given time.
+// Stripe drops a second event with the same identifier, so reports can be retried.
+func (c *Client) ReportUsage(ctx context.Context, customerID string, value int64, at time.Time, identifier string) error {
+	if c == nil {
+		return ErrNotConfigured
+	}
+	form := url.Values{}
+	form.Set("event_name", c.cfg.MeterEventName)
+	form.Set("payload[stripe_customer_id]", customerID)
+	form.Set("payload[value]", strconv.FormatInt(value, 10))
+	form.Set("timestamp", strconv.FormatInt(at.Unix(), 10))
+	form.Set("identifier", identifier)
+	return c.call(ctx, http.MethodPost, "billing/meter_events", "", form, nil)
+}
+
+// call makes a form-encoded request to the API and decodes the response into out, when given.
+func (c *Client) call(ctx context.Context, method, path, idempotencyKey string, form url.Values, out any) error {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.apiURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.cfg.StripeSecretKey)
+	req.Header.Set("Stripe-Version", apiVersion)
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("stripe %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("stripe %s: %w", path, err)
+	}
+	if resp.StatusCode >= 300 {
+		var apiErr struct {
+			Error struct {
+				Type    string `json:"type"`
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal(raw, &apiErr); err != nil || apiErr.Error.Message == "" {
+			return &Error{StatusCode: resp.StatusCode, Message: resp.Status}
+		}
+		return &Error{
+			StatusCode: resp.StatusCode,
+			Type:       apiErr.Error.Type,
+			Code:       apiErr.Error.Code,
+			Message:    apiErr.Error.Message,
+		}
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return fmt.Errorf("stripe %s: decode response: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package billing
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// BasePath is where the webhook handler is mounted.
+const BasePath = "/billing/stripe/webhook"
+
+const (
+	// maxClockSkew is how old an event may be before it is taken for a replay
+	maxClockSkew = 5 * time.Minute
+	maxBodyBytes = 256 << 10
+)
+
+var ErrInvalidSignature = errors.New("invalid stripe signature")
+
+// Status maps a Stripe subscription status to the one stored for the org.
+func Status(stripeStatus string) genDb.SubscriptionStatus {
+	switch stripeStatus {
+	case "trialing":
+		return genDb.SubscriptionStatusTrialing
+	case "active":
+		return genDb.SubscriptionStatusActive
+	case "past_due":
+		return genDb.SubscriptionStatusPastDue
+	case "unpaid":
+		return genDb.SubscriptionStatusUnpaid
+	case "canceled", "incomplete_expired":
+		return genDb.SubscriptionStatusCanceled
+	default: // incomplete, paused
+		return genDb.SubscriptionStatusIncomplete
+	}
+}
+
+// Handler receives Stripe's webhook events and applies subscription and invoice changes to
+// org_billing. Orgs go delinquent when an invoice fails to be paid and recover when it is paid.
+type Handler struct {
+	client  *Client
+	queries genDb.Querier
+}
+
+// NewHandler creates a Handler for [BasePath].
+func NewHandler(client *Client, queries genDb.Querier) *Handler {
+	return &Handler{client: client, queries: queries}
+}
+
+type event struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.client == nil {
+		http.Error(w, ErrNotConfigured.Error(), http.StatusNotFound)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	ctx := r.Context()
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+		http.Error(w, "request too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err := verifySignature(h.client.cfg.StripeWebhookSecret, r.Header.Get("Stripe-Signature"), body, time.Now()); err != nil {
+		slog.WarnContext(ctx, "rejected stripe webhook", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var e event
+	if err := json.Unmarshal(body, &e); err != nil {
+		http.Error(w, "invalid event", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.apply(ctx, e); err != nil {
+		// Stripe retries events that are not acknowledged
+		slog.ErrorContext(ctx, "failed to apply stripe event", "eventId", e.ID, "type", e.Type, "error", err)
+		http.Error(w, "failed to apply event", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) apply(ctx context.Context, e event) error {
+	switch e.Type {
+	case "customer.subscription.created", "customer.subscription.updated":
+		var s subscriptionJSON
+		if err := json.Unmarshal(e.Data.Object, &s); err != nil {
+			return err
+		}
+		sub := s.subscription()
+		params := genDb.SyncOrgSubscriptionParams{
+			SubscriptionStatus:   Status(sub.Status),
+			StripeCustomerID:     sub.CustomerID,
+			StripeSubscriptionID: pgtype.Text{String: sub.ID, Valid: true},
+		}
+		if !sub.CurrentPeriodEnd.IsZero() {
+			params.CurrentPeriodEnd = pgtype.Timestamptz{Time: sub.CurrentPeriodEnd, Valid: true}
+		}
+		if item, ok := h.client.PlanItem(sub); ok {
+			params.StripePriceID = item.PriceID
+		}
+		n, err := h.queries.SyncOrgSubscription(ctx, params)
+		if err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "synced subscription", "subscriptionId", sub.ID, "status", sub.Status, "matched", n > 0)
+
+	case "customer.subscription.deleted":
+		var s subscriptionJSON
+		if err := json.Unmarshal(e.Data.Object, &s); err != nil {
+			return err
+		}
+		if _, err := h.queries.EndOrgSubscription(ctx, genDb.EndOrgSubscriptionParams{
+			StripeCustomerID:     s.Customer,
+			StripeSubscriptionID: pgtype.Text{String: s.ID, Valid: true},
+		}); err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "subscription ended", "subscriptionId", s.ID)
+
+	case "invoice.payment_failed", "invoice.paid":
+		var invoice struct {
+			ID       string `json:"id"`
+			Customer string `json:"customer"`
+		}
+		if err := json.Unmarshal(e.Data.Object, &invoice); err != nil {
+			return err
+		}
+		delinquent := e.Type == "invoice.payment_failed"
+		if _, err := h.queries.SetOrgDelinquent(ctx, genDb.SetOrgDelinquentParams{
+			StripeCustomerID: invoice.Customer,
+			Delinquent:       delinquent,
+		}); err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "updated delinquency", "invoiceId", invoice.ID, "customerId", invoice.Customer, "delinquent", delinquent)
+	}
+	return nil
+}
+
+// verifySignature checks the Stripe-Signature header: an HMAC-SHA256 of "timestamp.body" under the
+// webhook secret, in one of possibly several v1 entries. Without a secret nothing verifies.
+func verifySignature(secret, header string, body []byte, now time.Time) error {
+	if secret == "" {
+		return ErrInvalidSignature
+	}
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return ErrInvalidSignature
+	}
+	if d := now.Sub(time.Unix(ts, 0)); d > maxClockSkew || d < -maxClockSkew {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+	for _, sig := range signatures {
+		given, err := hex.DecodeString(sig)
+		if err == nil && hmac.Equal(given, expected) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
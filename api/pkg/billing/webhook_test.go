@@ -0,0 +1,61 @@
+package billing
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func sign(secret string, timestamp int64, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.%s", timestamp, body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifySignature(t *testing.T) {
+	const secret = "whsec_test"
+	const body = `{"id":"evt_1","type":"invoice.paid"}`
+	now := time.Unix(1_700_000_000, 0)
+	ts := now.Unix()
+	good := sign(secret, ts, body)
+
+	tests := []struct {
+		name    string
+		secret  string
+		header  string
+		body    string
+		wantErr bool
+	}{
+		{"valid", secret, fmt.Sprintf("t=%d,v1=%s", ts, good), body, false},
+		{"valid with a v0 entry", secret, fmt.Sprintf("t=%d,v1=%s,v0=%s", ts, good, sign("other", ts, body)), body, false},
+		{"valid among several v1 entries", secret, fmt.Sprintf("t=%d,v1=%s,v1=%s", ts, sign("old_secret", ts, body), good), body, false},
+		{"within the allowed skew", secret, fmt.Sprintf("t=%d,v1=%s", ts-299, sign(secret, ts-299, body)), body, false},
+		{"ahead within the allowed skew", secret, fmt.Sprintf("t=%d,v1=%s", ts+299, sign(secret, ts+299, body)), body, false},
+		{"wrong secret", secret, fmt.Sprintf("t=%d,v1=%s", ts, sign("whsec_other", ts, body)), body, true},
+		{"several v1 entries, none valid", secret, fmt.Sprintf("t=%d,v1=%s,v1=%s", ts, sign("a", ts, body), sign("b", ts, body)), body, true},
+		{"body changed", secret, fmt.Sprintf("t=%d,v1=%s", ts, good), `{"id":"evt_2","type":"invoice.paid"}`, true},
+		{"timestamp changed", secret, fmt.Sprintf("t=%d,v1=%s", ts+1, good), body, true},
+		{"stale", secret, fmt.Sprintf("t=%d,v1=%s", ts-301, sign(secret, ts-301, body)), body, true},
+		{"from the future", secret, fmt.Sprintf("t=%d,v1=%s", ts+301, sign(secret, ts+301, body)), body, true},
+		{"signature only in v0", secret, fmt.Sprintf("t=%d,v0=%s", ts, good), body, true},
+		{"no timestamp", secret, "v1=" + good, body, true},
+		{"timestamp not a number", secret, "t=now,v1=" + good, body, true},
+		{"signature not hex", secret, fmt.Sprintf("t=%d,v1=%s", ts, "zz"+good[2:]), body, true},
+		{"truncated signature", secret, fmt.Sprintf("t=%d,v1=%s", ts, good[:32]), body, true},
+		{"empty header", secret, "", body, true},
+		{"no secret", "", fmt.Sprintf("t=%d,v1=%s", ts, sign("", ts, body)), body, true},
+	}
+	for _, tt := range tests {
+		err := verifySignature(tt.secret, tt.header, []byte(tt.body), now)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: expected error=%v, got %v", tt.name, tt.wantErr, err)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("%s: expected %v, got %v", tt.name, ErrInvalidSignature, err)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Package bundle defines the declarative YAML format a workspace is exported to and imported from.
+// A bundle never carries env var values: each one is a reference that is resolved on import.
+package bundle
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	APIVersion = "loco.dev/v1"
+	Kind       = "WorkspaceBundle"
+)
+
+var ErrInvalidBundle = errors.New("invalid bundle")
+
+// Bundle is the exported state of a workspace.
+type Bundle struct {
+	APIVersion   string        `json:"apiVersion"`
+	Kind         string        `json:"kind"`
+	Workspace    Workspace     `json:"workspace"`
+	Environments []Environment `json:"environments,omitempty"`
+	Resources    []Resource    `json:"resources,omitempty"`
+}
+
+// Workspace records where a bundle came from. It is informational on import.
+type Workspace struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type Environment struct {
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	Env         map[string]SecretRef `json:"env,omitempty"`
+}
+
+type Resource struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	// Environment is the name of the environment the resource belongs to, if any.
+	Environment string               `json:"environment,omitempty"`
+	Spec        json.RawMessage      `json:"spec"`
+	Domains     []Domain             `json:"domains,omitempty"`
+	Env         map[string]SecretRef `json:"env,omitempty"`
+	Links       []Link               `json:"links,omitempty"`
+}
+
+// Domain is a domain of a resource. Platform domains are recorded by base domain and subdomain so
+// they can be matched to the platform domains of another control plane.
+type Domain struct {
+	Domain         string `json:"domain"`
+	PlatformDomain string `json:"platformDomain,omitempty"`
+	Subdomain      string `json:"subdomain,omitempty"`
+	Primary        bool   `json:"primary,omitempty"`
+}
+
+// Link points a scale trigger of a resource at the queue resource it watches, by name
+// instead of by ID.
+type Link struct {
+	Region      string `json:"region"`
+	Trigger     int    `json:"trigger"`
+	Resource    string `json:"resource"`
+	Environment string `json:"environment,omitempty"`
+}
+
+// SecretRef stands in for an env var value.
+type SecretRef struct {
+	SecretRef string `json:"secretRef"`
+}
+
+// EnvironmentSecret names the reference of an environment's env var.
+func EnvironmentSecret(environment, key string) string {
+	return fmt.Sprintf("environments/%s/%s", environment, key)
+}
+
+// ResourceSecret names the reference of a resource's env var.
+func ResourceSecret(environment, resource, key string) string {
+	if environment == "" {
+		return fmt.Sprintf("resources/%s/%s", resource, key)
+	}
+	return fmt.Sprintf("resources/%s/%s/%s", environment, resource, key)
+}
+
+// Marshal renders a bundle as YAML.
+func Marshal(b *Bundle) ([]byte, error) {
+	b.APIVersion = APIVersion
+	b.Kind = Kind
+	return yaml.Marshal(b)
+}
+
+// Unmarshal parses a YAML bundle, rejecting unknown fields and other versions.
+func Unmarshal(data []byte) (*Bundle, error) {
+	var b Bundle
+	if err := yaml.UnmarshalStrict(data, &b); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+	}
+	if b.APIVersion != APIVersion || b.Kind != Kind {
+		return nil, fmt.Errorf("%w: expected apiVersion %s and kind %s", ErrInvalidBundle, APIVersion, Kind)
+	}
+	for i, r := range b.Resources {
+		if r.Name == "" {
+			return nil, fmt.Errorf("%w: resource %d has no name", ErrInvalidBundle, i)
+		}
+		if len(r.Spec) == 0 {
+			return nil, fmt.Errorf("%w: resource %s has no spec", ErrInvalidBundle, r.Name)
+		}
+	}
+	return &b, nil
+}
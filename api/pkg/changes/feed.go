@@ -0,0 +1,199 @@
+// Package changes delivers the change notifications Postgres triggers send on the loco_changes channel.
+package changes
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Channel is the Postgres notification channel the triggers in migration 021 publish to.
+const Channel = "loco_changes"
+
+const (
+	minBackoff = 500 * time.Millisecond
+	maxBackoff = 30 * time.Second
+
+	// subscriptionBuffer is how many events a subscriber may fall behind before events are dropped for it
+	// and, if it subscribed with SubscribeOrFlush, it is told to flush
+	subscriptionBuffer = 16
+)
+
+// Tables that announce changes.
+const (
+	TableDeployments     = "deployments"
+	TableResources       = "resources"
+	TableResourceDomains = "resource_domains"
+	TableTokens          = "tokens"
+	TableUserScopes      = "user_scopes"
+)
+
+// Event is a single change. Deployment and resource changes set ID, ResourceID and Status;
+// resource domain changes set ID and ResourceID; token and user scope changes set EntityType,
+// EntityID and, for tokens, Name.
+type Event struct {
+	Table      string `json:"table"`
+	ID         int64  `json:"id"`
+	ResourceID int64  `json:"resource_id"`
+	Status     string `json:"status"`
+	EntityType string `json:"entity_type"`
+	EntityID   int64  `json:"entity_id"`
+	Name       string `json:"name"`
+}
+
+// Feed holds a connection listening on Channel and fans the events out to subscribers.
+// Delivery is best effort: events are lost while the connection is re-established and
+// dropped for subscribers that fall behind, so subscribers should still re-read state
+// now and then rather than rely on seeing every event. Subscribers that cannot miss an
+// event use SubscribeOrFlush to be told when they may have.
+type Feed struct {
+	pool *pgxpool.Pool
+
+	mu     sync.Mutex
+	nextID int
+	subs   map[int]subscription
+}
+
+type subscription struct {
+	match func(Event) bool
+	ch    chan Event
+	flush func()
+}
+
+// NewFeed creates a Feed that listens on a connection from pool once started.
+func NewFeed(pool *pgxpool.Pool) *Feed {
+	return &Feed{
+		pool: pool,
+		subs: map[int]subscription{},
+	}
+}
+
+// Subscribe returns a channel receiving the events match accepts, and a function that
+// ends the subscription. A nil Feed returns a channel that never receives.
+func (f *Feed) Subscribe(match func(Event) bool) (<-chan Event, func()) {
+	return f.SubscribeOrFlush(match, nil)
+}
+
+// SubscribeOrFlush is Subscribe for subscribers that must not silently miss events, such as
+// caches that a change invalidates. flush is called whenever events the subscriber accepts
+// may have been missed: when one is dropped because the subscriber fell behind, and each
+// time the connection is established, since nothing is heard while it is down. flush must
+// not block; it is expected to discard whatever state the missed events could have changed.
+func (f *Feed) SubscribeOrFlush(match func(Event) bool, flush func()) (<-chan Event, func()) {
+	if f == nil {
+		return nil, func() {}
+	}
+
+	ch := make(chan Event, subscriptionBuffer)
+	f.mu.Lock()
+	id := f.nextID
+	f.nextID++
+	f.subs[id] = subscription{match: match, ch: ch, flush: flush}
+	f.mu.Unlock()
+
+	return ch, func() {
+		f.mu.Lock()
+		delete(f.subs, id)
+		f.mu.Unlock()
+	}
+}
+
+// Start listens until ctx is canceled, reconnecting with backoff when the connection is lost.
+func (f *Feed) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting change feed", "channel", Channel)
+
+	backoff := minBackoff
+	for {
+		started := time.Now()
+		err := f.listen(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if time.Since(started) > maxBackoff {
+			backoff = minBackoff
+		}
+		slog.WarnContext(ctx, "change feed connection lost, reconnecting", "error", err, "backoff", backoff)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// listen holds one connection until it fails.
+func (f *Feed) listen(ctx context.Context) error {
+	pooled, err := f.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// a connection that was listening must not go back to the pool
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+Channel); err != nil {
+		return err
+	}
+	// events published before LISTEN, e.g. while reconnecting, were never heard
+	f.flushAll()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var event Event
+		if err := json.Unmarshal([]byte(notification.Payload), &event); err != nil {
+			slog.WarnContext(ctx, "ignoring malformed change notification", "payload", notification.Payload, "error", err)
+			continue
+		}
+		f.publish(event)
+	}
+}
+
+func (f *Feed) publish(event Event) {
+	var dropped []func()
+	f.mu.Lock()
+	for _, sub := range f.subs {
+		if !sub.match(event) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			slog.Warn("change feed subscriber fell behind, dropping event", "table", event.Table, "id", event.ID, "entity_type", event.EntityType, "entity_id", event.EntityID)
+			if sub.flush != nil {
+				dropped = append(dropped, sub.flush)
+			}
+		}
+	}
+	f.mu.Unlock()
+
+	// called without the lock, so a flush may unsubscribe
+	for _, flush := range dropped {
+		flush()
+	}
+}
+
+// flushAll tells every subscriber from SubscribeOrFlush to flush.
+func (f *Feed) flushAll() {
+	var flushes []func()
+	f.mu.Lock()
+	for _, sub := range f.subs {
+		if sub.flush != nil {
+			flushes = append(flushes, sub.flush)
+		}
+	}
+	f.mu.Unlock()
+
+	for _, flush := range flushes {
+		flush()
+	}
+}
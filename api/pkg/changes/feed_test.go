@@ -0,0 +1,43 @@
+package changes
+
+import (
+	"testing"
+)
+
+func TestPublishOverflow(t *testing.T) {
+	f := NewFeed(nil)
+	tokens := func(e Event) bool { return e.Table == TableTokens }
+
+	var flushed int
+	flushing, stopFlushing := f.SubscribeOrFlush(tokens, func() { flushed++ })
+	defer stopFlushing()
+	plain, stopPlain := f.Subscribe(tokens)
+	defer stopPlain()
+
+	for i := range subscriptionBuffer {
+		f.publish(Event{Table: TableTokens, EntityID: int64(i)})
+	}
+	f.publish(Event{Table: TableDeployments})
+	if flushed != 0 {
+		t.Fatalf("expected no flush while the buffer has room, got %d", flushed)
+	}
+
+	f.publish(Event{Table: TableTokens, EntityID: subscriptionBuffer})
+	if flushed != 1 {
+		t.Errorf("expected 1 flush when an event is dropped, got %d", flushed)
+	}
+	if len(flushing) != subscriptionBuffer || len(plain) != subscriptionBuffer {
+		t.Errorf("expected both buffers to stay full at %d, got %d and %d", subscriptionBuffer, len(flushing), len(plain))
+	}
+
+	f.flushAll()
+	if flushed != 2 {
+		t.Errorf("expected a flush when the connection is established, got %d", flushed)
+	}
+
+	stopFlushing()
+	f.publish(Event{Table: TableTokens})
+	if flushed != 2 {
+		t.Errorf("expected no flush after unsubscribing, got %d", flushed)
+	}
+}
@@ -0,0 +1,209 @@
+// Package configrollout redeploys the resources of a config group in the background after its env
+// vars changed, one resource at a time.
+package configrollout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// DefaultInterval is how often pending rollouts are looked for when no interval is given.
+const DefaultInterval = 15 * time.Second
+
+const (
+	// pollInterval is how often the deployment of the resource being redeployed is checked.
+	pollInterval = 5 * time.Second
+	// deployTimeout is how long a resource's deployment may take to come up before the rollout fails.
+	deployTimeout = 10 * time.Minute
+	// staleAfter is how long a running rollout may go without progress before another worker takes it
+	// over, as when the replica running it was stopped. Progress is recorded on every poll.
+	staleAfter = 5 * time.Minute
+)
+
+// ErrResourceBusy is returned by a Redeployer when another deployment of the resource is in progress.
+// The redeploy is retried until deployTimeout.
+var ErrResourceBusy = errors.New("resource is busy")
+
+// Redeployer deploys a resource again with its config groups' current env vars.
+type Redeployer interface {
+	// RolloutConfig returns the new deployment, or 0 when the resource has nothing deployed. previous
+	// is what the group held before the change.
+	RolloutConfig(ctx context.Context, resourceID int64, previous map[string]string) (int64, error)
+}
+
+// Worker claims pending config rollouts one at a time and redeploys each resource of the rollout in
+// turn, waiting for its deployment to come up before moving to the next. A deployment that fails
+// stops the rollout, leaving the remaining resources on their current deployment.
+type Worker struct {
+	queries    genDb.Querier
+	redeployer Redeployer
+	interval   time.Duration
+}
+
+// NewWorker creates a Worker that looks for rollouts every interval (DefaultInterval if zero).
+func NewWorker(queries genDb.Querier, redeployer Redeployer, interval time.Duration) *Worker {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Worker{
+		queries:    queries,
+		redeployer: redeployer,
+		interval:   interval,
+	}
+}
+
+// Start works through pending rollouts once immediately and then on every tick until ctx is canceled.
+func (w *Worker) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting config rollout worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.drain(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain runs claimed rollouts until none are left.
+func (w *Worker) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		rollout, err := w.queries.ClaimConfigRollout(ctx, pgtype.Timestamptz{Time: time.Now().Add(-staleAfter), Valid: true})
+		if err != nil {
+			if !errors.Is(err, pgx.ErrNoRows) {
+				slog.ErrorContext(ctx, "failed to claim config rollout", "error", err)
+			}
+			return
+		}
+
+		logger := slog.With("rolloutId", rollout.ID, "configGroupId", rollout.ConfigGroupID)
+		logger.InfoContext(ctx, "rolling out config group", "resources", len(rollout.ResourceIds), "completed", rollout.CompletedResources)
+
+		if err := w.run(ctx, rollout); err != nil {
+			if ctx.Err() != nil {
+				// left running; picked up again once stale
+				return
+			}
+			logger.ErrorContext(ctx, "config rollout failed", "error", err)
+			if err := w.queries.FailConfigRollout(ctx, genDb.FailConfigRolloutParams{ID: rollout.ID, Error: err.Error()}); err != nil {
+				logger.ErrorContext(ctx, "failed to record config rollout failure", "error", err)
+			}
+			continue
+		}
+
+		if err := w.queries.CompleteConfigRollout(ctx, rollout.ID); err != nil {
+			logger.ErrorContext(ctx, "failed to record config rollout completion", "error", err)
+			continue
+		}
+		logger.InfoContext(ctx, "rolled out config group")
+	}
+}
+
+// run redeploys the resources the rollout has not reached yet. A rollout taken over from a stopped
+// worker resumes by waiting on the deployment that worker started, if any.
+func (w *Worker) run(ctx context.Context, rollout genDb.ConfigRollout) error {
+	var previous map[string]string
+	if err := json.Unmarshal(rollout.PreviousEnv, &previous); err != nil {
+		return fmt.Errorf("invalid previous env: %w", err)
+	}
+
+	for i := int(rollout.CompletedResources); i < len(rollout.ResourceIds); i++ {
+		resourceID := rollout.ResourceIds[i]
+
+		deploymentID := rollout.CurrentDeploymentID.Int64
+		if !rollout.CurrentDeploymentID.Valid {
+			var err error
+			deploymentID, err = w.redeploy(ctx, rollout.ID, resourceID, previous)
+			if err != nil {
+				return fmt.Errorf("redeploy resource %d: %w", resourceID, err)
+			}
+		}
+		rollout.CurrentDeploymentID = pgtype.Int8{}
+
+		if deploymentID != 0 {
+			if err := w.wait(ctx, rollout.ID, deploymentID); err != nil {
+				return fmt.Errorf("resource %d: %w", resourceID, err)
+			}
+		}
+		if err := w.queries.RecordConfigRolloutProgress(ctx, rollout.ID); err != nil {
+			return fmt.Errorf("record progress: %w", err)
+		}
+	}
+	return nil
+}
+
+// redeploy starts the deployment of a resource, retrying while another of its deployments is in
+// progress, and records it on the rollout.
+func (w *Worker) redeploy(ctx context.Context, rolloutID, resourceID int64, previous map[string]string) (int64, error) {
+	deadline := time.Now().Add(deployTimeout)
+	for {
+		deploymentID, err := w.redeployer.RolloutConfig(ctx, resourceID, previous)
+		if errors.Is(err, ErrResourceBusy) && time.Now().Before(deadline) {
+			if err := w.sleep(ctx, rolloutID, pgtype.Int8{}); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		if deploymentID != 0 {
+			if err := w.queries.RecordConfigRolloutDeployment(ctx, genDb.RecordConfigRolloutDeploymentParams{
+				ID:                  rolloutID,
+				CurrentDeploymentID: pgtype.Int8{Int64: deploymentID, Valid: true},
+			}); err != nil {
+				return 0, fmt.Errorf("record deployment: %w", err)
+			}
+		}
+		return deploymentID, nil
+	}
+}
+
+// wait polls a deployment until it is up or has failed.
+func (w *Worker) wait(ctx context.Context, rolloutID, deploymentID int64) error {
+	deadline := time.Now().Add(deployTimeout)
+	for {
+		deployment, err := w.queries.GetDeploymentByID(ctx, deploymentID)
+		if err != nil {
+			return fmt.Errorf("get deployment %d: %w", deploymentID, err)
+		}
+		switch deployment.Status {
+		case genDb.DeploymentStatusRunning, genDb.DeploymentStatusSucceeded:
+			return nil
+		case genDb.DeploymentStatusFailed, genDb.DeploymentStatusCanceled:
+			return fmt.Errorf("deployment %d %s: %s", deploymentID, deployment.Status, deployment.Message)
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("deployment %d did not come up within %s", deploymentID, deployTimeout)
+		}
+		if err := w.sleep(ctx, rolloutID, pgtype.Int8{Int64: deploymentID, Valid: true}); err != nil {
+			return err
+		}
+	}
+}
+
+// sleep waits for the next poll, recording progress so the rollout is not taken for stale.
+func (w *Worker) sleep(ctx context.Context, rolloutID int64, deploymentID pgtype.Int8) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(pollInterval):
+	}
+	return w.queries.RecordConfigRolloutDeployment(ctx, genDb.RecordConfigRolloutDeploymentParams{
+		ID:                  rolloutID,
+		CurrentDeploymentID: deploymentID,
+	})
+}
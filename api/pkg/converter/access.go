@@ -0,0 +1,104 @@
+package converter
+
+import (
+	"fmt"
+
+	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	domainv1 "github.com/team-loco/loco/shared/proto/domain/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// DeserializeAccessControl deserializes an AccessControl from JSON bytes (as stored in DB).
+// A domain without access control returns nil.
+func DeserializeAccessControl(data []byte) (*domainv1.AccessControl, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var accessControl domainv1.AccessControl
+	if err := protojson.Unmarshal(data, &accessControl); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal access control: %w", err)
+	}
+	return &accessControl, nil
+}
+
+// ProtoToAccessControlSpec converts a stored AccessControl, whose passwords are already
+// hashed, into the controller's AccessControlSpec.
+func ProtoToAccessControlSpec(accessControl *domainv1.AccessControl) *locoControllerV1.AccessControlSpec {
+	if accessControl == nil {
+		return nil
+	}
+
+	spec := &locoControllerV1.AccessControlSpec{
+		AllowedCIDRs: accessControl.GetAllowedCidrs(),
+	}
+	if basicAuth := accessControl.GetBasicAuth(); basicAuth != nil {
+		spec.BasicAuth = &locoControllerV1.BasicAuthSpec{}
+		for _, user := range basicAuth.GetUsers() {
+			spec.BasicAuth.Users = append(spec.BasicAuth.Users, user.GetUsername()+":"+user.GetPassword())
+		}
+	}
+	if oidc := accessControl.GetOidc(); oidc != nil {
+		spec.OIDC = &locoControllerV1.OIDCSpec{
+			Issuer:       oidc.GetIssuer(),
+			ClientID:     oidc.GetClientId(),
+			ClientSecret: oidc.GetClientSecret(),
+			RedirectURL:  oidc.GetRedirectUrl(),
+		}
+	}
+	return spec
+}
+
+// DeserializeRedirect deserializes a DomainRedirect from JSON bytes (as stored in DB).
+// A domain that does not redirect returns nil.
+func DeserializeRedirect(data []byte) (*domainv1.DomainRedirect, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var redirect domainv1.DomainRedirect
+	if err := protojson.Unmarshal(data, &redirect); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal redirect: %w", err)
+	}
+	return &redirect, nil
+}
+
+// ProtoToRedirectSpec converts the redirect stored on hostName into the controller's RedirectSpec.
+func ProtoToRedirectSpec(hostName string, redirect *domainv1.DomainRedirect) locoControllerV1.RedirectSpec {
+	return locoControllerV1.RedirectSpec{
+		HostName:       hostName,
+		TargetHostName: redirect.GetTargetDomain(),
+		StatusCode:     redirect.GetStatusCode(),
+		PreservePath:   redirect.GetPreservePath(),
+	}
+}
+
+// DeserializeTLSPolicy deserializes a TLSPolicy from JSON bytes (as stored in DB).
+// A domain with the platform's TLS defaults returns nil.
+func DeserializeTLSPolicy(data []byte) (*domainv1.TLSPolicy, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var tls domainv1.TLSPolicy
+	if err := protojson.Unmarshal(data, &tls); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal TLS policy: %w", err)
+	}
+	return &tls, nil
+}
+
+// ProtoToTLSSpec converts a TLSPolicy into the controller's TLSSpec.
+func ProtoToTLSSpec(tls *domainv1.TLSPolicy) *locoControllerV1.TLSSpec {
+	if tls == nil {
+		return nil
+	}
+
+	spec := &locoControllerV1.TLSSpec{
+		HSTSMaxAge:          tls.GetHstsMaxAge(),
+		ClientCACertificate: tls.GetClientCaCertificate(),
+	}
+	switch tls.GetMinVersion() {
+	case domainv1.TLSVersion_TLS_VERSION_1_2:
+		spec.MinVersion = "1.2"
+	case domainv1.TLSVersion_TLS_VERSION_1_3:
+		spec.MinVersion = "1.3"
+	}
+	return spec
+}
@@ -6,6 +6,7 @@ import (
 	genDb "github.com/team-loco/loco/api/gen/db"
 	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
 	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	policyv1 "github.com/team-loco/loco/shared/proto/policy/v1"
 	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -148,6 +149,10 @@ func MergeDeploymentSpec(
 		Build: requestServiceSpec.Build,
 		Port:  requestServiceSpec.Port,
 		Env:   requestServiceSpec.Env,
+		Hooks: requestServiceSpec.Hooks,
+
+		InitContainers: requestServiceSpec.InitContainers,
+		Sidecars:       requestServiceSpec.Sidecars,
 	}
 
 	// merge CPU (request > resource default)
@@ -218,9 +223,14 @@ func ProtoToServiceDeploymentSpec(spec *deploymentv1.DeploymentSpec) *locoContro
 	var scalers *locoControllerV1.ScalersSpec
 	if serviceSpec.GetScalers() != nil {
 		scalers = &locoControllerV1.ScalersSpec{
-			Enabled:      serviceSpec.GetScalers().GetEnabled(),
-			CPUTarget:    serviceSpec.GetScalers().GetCpuTarget(),
-			MemoryTarget: serviceSpec.GetScalers().GetMemoryTarget(),
+			Enabled:         serviceSpec.GetScalers().GetEnabled(),
+			CPUTarget:       serviceSpec.GetScalers().GetCpuTarget(),
+			MemoryTarget:    serviceSpec.GetScalers().GetMemoryTarget(),
+			Triggers:        ProtoToScaleTriggers(serviceSpec.GetScalers().GetTriggers()),
+			Schedules:       ProtoToCronSchedules(serviceSpec.GetScalers().GetSchedules()),
+			PollingInterval: serviceSpec.GetScalers().GetPollingInterval(),
+			CooldownPeriod:  serviceSpec.GetScalers().GetCooldownPeriod(),
+			IdleAtZero:      serviceSpec.GetScalers().GetIdleAtZero(),
 		}
 	}
 
@@ -237,6 +247,7 @@ func ProtoToServiceDeploymentSpec(spec *deploymentv1.DeploymentSpec) *locoContro
 
 	return &locoControllerV1.ServiceDeploymentSpec{
 		Image:          serviceSpec.GetBuild().GetImage(),
+		ImageDigest:    serviceSpec.GetBuild().GetImageDigest(),
 		Port:           serviceSpec.GetPort(),
 		DockerfilePath: serviceSpec.GetBuild().GetDockerfilePath(),
 		BuildType:      serviceSpec.GetBuild().GetType(),
@@ -247,7 +258,116 @@ func ProtoToServiceDeploymentSpec(spec *deploymentv1.DeploymentSpec) *locoContro
 		Scalers:        scalers,
 		HealthCheck:    healthCheck,
 		Env:            serviceSpec.GetEnv(),
+		Hooks:          ProtoToHookSpecs(serviceSpec.GetHooks()),
+		InitContainers: ProtoToContainerSpecs(serviceSpec.GetInitContainers()),
+		Sidecars:       ProtoToContainerSpecs(serviceSpec.GetSidecars()),
+
+		SignaturePolicies: ProtoToSignaturePolicies(serviceSpec.GetBuild().GetSignaturePolicies()),
+	}
+}
+
+// ProtoToSignaturePolicies converts proto RequireSignature rules to controller SignaturePolicySpecs
+func ProtoToSignaturePolicies(policies []*policyv1.RequireSignature) []locoControllerV1.SignaturePolicySpec {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	specs := make([]locoControllerV1.SignaturePolicySpec, 0, len(policies))
+	for _, p := range policies {
+		spec := locoControllerV1.SignaturePolicySpec{PublicKeys: p.GetPublicKeys()}
+		for _, identity := range p.GetIdentities() {
+			spec.Identities = append(spec.Identities, locoControllerV1.SignerIdentitySpec{
+				Issuer:        identity.GetIssuer(),
+				Subject:       identity.GetSubject(),
+				SubjectRegexp: identity.GetSubjectRegexp(),
+			})
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+// ProtoToHookSpecs converts proto DeploymentHooks to controller HookSpecs
+func ProtoToHookSpecs(hooks []*deploymentv1.DeploymentHook) []locoControllerV1.HookSpec {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	specs := make([]locoControllerV1.HookSpec, 0, len(hooks))
+	for _, h := range hooks {
+		phase := ""
+		switch h.GetPhase() {
+		case deploymentv1.HookPhase_HOOK_PHASE_PRE_DEPLOY:
+			phase = locoControllerV1.HookPhasePreDeploy
+		case deploymentv1.HookPhase_HOOK_PHASE_POST_DEPLOY:
+			phase = locoControllerV1.HookPhasePostDeploy
+		}
+		specs = append(specs, locoControllerV1.HookSpec{
+			Name:           h.GetName(),
+			Phase:          phase,
+			Command:        h.GetCommand(),
+			Image:          h.GetImage(),
+			TimeoutSeconds: h.GetTimeoutSeconds(),
+		})
+	}
+	return specs
+}
+
+// ProtoToContainerSpecs converts proto Containers to controller ContainerSpecs
+func ProtoToContainerSpecs(containers []*deploymentv1.Container) []locoControllerV1.ContainerSpec {
+	if len(containers) == 0 {
+		return nil
+	}
+
+	specs := make([]locoControllerV1.ContainerSpec, 0, len(containers))
+	for _, c := range containers {
+		specs = append(specs, locoControllerV1.ContainerSpec{
+			Name:       c.GetName(),
+			Image:      c.GetImage(),
+			Command:    c.GetCommand(),
+			Args:       c.GetArgs(),
+			Env:        c.GetEnv(),
+			InheritEnv: c.GetInheritEnv(),
+			CPU:        c.GetCpu(),
+			Memory:     c.GetMemory(),
+		})
+	}
+	return specs
+}
+
+// ProtoToScaleTriggers converts proto ScaleTriggers to controller ScaleTriggerSpecs
+func ProtoToScaleTriggers(triggers []*deploymentv1.ScaleTrigger) []locoControllerV1.ScaleTriggerSpec {
+	if len(triggers) == 0 {
+		return nil
+	}
+
+	specs := make([]locoControllerV1.ScaleTriggerSpec, 0, len(triggers))
+	for _, t := range triggers {
+		specs = append(specs, locoControllerV1.ScaleTriggerSpec{
+			Type:             t.GetType(),
+			Metadata:         t.GetMetadata(),
+			LinkedResourceId: t.GetQueueResourceId(),
+		})
+	}
+	return specs
+}
+
+// ProtoToCronSchedules converts proto CronSchedules to controller CronScheduleSpecs
+func ProtoToCronSchedules(schedules []*deploymentv1.CronSchedule) []locoControllerV1.CronScheduleSpec {
+	if len(schedules) == 0 {
+		return nil
+	}
+
+	specs := make([]locoControllerV1.CronScheduleSpec, 0, len(schedules))
+	for _, sc := range schedules {
+		specs = append(specs, locoControllerV1.CronScheduleSpec{
+			Timezone:        sc.GetTimezone(),
+			Start:           sc.GetStart(),
+			End:             sc.GetEnd(),
+			DesiredReplicas: sc.GetDesiredReplicas(),
+		})
 	}
+	return specs
 }
 
 // ProtoToObsSpec converts a proto ObservabilityConfig to a controller ObsSpec
@@ -290,14 +410,78 @@ func ProtoToObsSpec(obs *resourcev1.ObservabilityConfig) *locoControllerV1.ObsSp
 	}
 }
 
+// ProtoToScaleToZeroSpec converts a proto ScaleToZeroConfig to a controller ScaleToZeroSpec
+func ProtoToScaleToZeroSpec(cfg *resourcev1.ScaleToZeroConfig) *locoControllerV1.ScaleToZeroSpec {
+	if cfg == nil {
+		return nil
+	}
+	return &locoControllerV1.ScaleToZeroSpec{
+		ScaledownPeriod:   cfg.GetScaledownPeriod(),
+		TargetConcurrency: cfg.GetTargetConcurrency(),
+	}
+}
+
+func ProtoToSchedulingSpec(cfg *resourcev1.SchedulingConfig) *locoControllerV1.SchedulingSpec {
+	if cfg == nil {
+		return nil
+	}
+	spec := &locoControllerV1.SchedulingSpec{NodeSelector: cfg.GetNodeSelector()}
+	for _, t := range cfg.GetTolerations() {
+		spec.Tolerations = append(spec.Tolerations, locoControllerV1.TolerationSpec{
+			Key:    t.GetKey(),
+			Value:  t.GetValue(),
+			Effect: t.GetEffect(),
+		})
+	}
+	for _, s := range cfg.GetSpread() {
+		spec.Spread = append(spec.Spread, locoControllerV1.SpreadConstraintSpec{
+			TopologyKey: s.GetTopologyKey(),
+			MaxSkew:     s.GetMaxSkew(),
+			Required:    s.GetRequired(),
+		})
+	}
+	return spec
+}
+
 func ProtoToRoutingSpec(routing *resourcev1.RoutingConfig, hostname string) *locoControllerV1.RoutingSpec {
 	if routing == nil {
 		return nil
 	}
 
+	var errorPage *locoControllerV1.ErrorPageSpec
+	if routing.GetErrorPage() != nil {
+		errorPage = &locoControllerV1.ErrorPageSpec{
+			Disabled: routing.GetErrorPage().GetDisabled(),
+			Title:    routing.GetErrorPage().GetTitle(),
+			Message:  routing.GetErrorPage().GetMessage(),
+			HTML:     routing.GetErrorPage().GetHtml(),
+		}
+	}
+
 	return &locoControllerV1.RoutingSpec{
 		HostName:    hostname,
 		PathPrefix:  routing.GetPathPrefix(),
 		IdleTimeout: routing.GetIdleTimeout(),
+		ErrorPage:   errorPage,
+		Protocol:    routing.GetProtocol(),
+
+		RequestTimeout: routing.GetRequestTimeout(),
+		MaxBodySize:    routing.GetMaxBodySize(),
+		Ports:          ProtoToPortSpecs(routing.GetPorts()),
+	}
+}
+
+func ProtoToPortSpecs(ports []*resourcev1.PortConfig) []locoControllerV1.PortSpec {
+	if len(ports) == 0 {
+		return nil
+	}
+	specs := make([]locoControllerV1.PortSpec, 0, len(ports))
+	for _, p := range ports {
+		specs = append(specs, locoControllerV1.PortSpec{
+			Name:       p.GetName(),
+			Port:       p.GetPort(),
+			PathPrefix: p.GetPathPrefix(),
+		})
 	}
+	return specs
 }
@@ -0,0 +1,163 @@
+// Package cost estimates what a service will cost per month from the CPU, memory and replicas its
+// spec requests in each region, priced by the cluster serving the region.
+package cost
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	genDb "github.com/team-loco/loco/api/gen/db"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// HoursPerMonth is the average length of a month, as cloud providers bill it.
+const HoursPerMonth = 730
+
+// microsPerCent converts prices in millionths of the currency unit to cents.
+const microsPerCent = 10_000
+
+const bytesPerGiB = 1 << 30
+
+var (
+	ErrNoRegions       = errors.New("the spec has no enabled regions")
+	ErrMixedCurrencies = errors.New("the spec's regions are priced in different currencies")
+)
+
+// Pricing is what the cluster serving a region charges per hour, in millionths of Currency.
+type Pricing struct {
+	Currency            string
+	CpuCoreHourMicros   int64
+	MemoryGibHourMicros int64
+}
+
+// Prices maps regions to their pricing.
+type Prices map[string]Pricing
+
+// PricesFromRows indexes the rows of ListRegionPricing by region.
+func PricesFromRows(rows []genDb.ListRegionPricingRow) Prices {
+	prices := make(Prices, len(rows))
+	for _, row := range rows {
+		prices[row.Region] = Pricing{
+			Currency:            row.Currency,
+			CpuCoreHourMicros:   row.CpuCoreHourMicros,
+			MemoryGibHourMicros: row.MemoryGibHourMicros,
+		}
+	}
+	return prices
+}
+
+// UnpricedRegionError is returned for a region no active cluster has pricing for.
+type UnpricedRegionError struct {
+	Region string
+}
+
+func (e *UnpricedRegionError) Error() string {
+	return fmt.Sprintf("region %q has no pricing", e.Region)
+}
+
+// InvalidTargetError is returned for a region whose CPU, memory or replicas cannot be priced.
+type InvalidTargetError struct {
+	Region string
+	Reason string
+}
+
+func (e *InvalidTargetError) Error() string {
+	return fmt.Sprintf("region %q: %s", e.Region, e.Reason)
+}
+
+// Region is the estimate for one region. Costs are in cents per month, at the minimum and maximum
+// number of replicas.
+type Region struct {
+	Region      string
+	MinReplicas int32
+	MaxReplicas int32
+	MinMonthly  int64
+	MaxMonthly  int64
+}
+
+// Estimate is the monthly cost of a spec across its regions.
+type Estimate struct {
+	Currency   string
+	Regions    []Region // ordered by region name
+	MinMonthly int64    // cents
+	MaxMonthly int64    // cents
+}
+
+// EstimateService prices the enabled regions of spec.
+func EstimateService(spec *resourcev1.ServiceSpec, prices Prices) (Estimate, error) {
+	names := make([]string, 0, len(spec.GetRegions()))
+	for name, target := range spec.GetRegions() {
+		if target.GetEnabled() {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return Estimate{}, ErrNoRegions
+	}
+	sort.Strings(names)
+
+	var estimate Estimate
+	for _, name := range names {
+		pricing, ok := prices[name]
+		if !ok {
+			return Estimate{}, &UnpricedRegionError{Region: name}
+		}
+		if estimate.Currency == "" {
+			estimate.Currency = pricing.Currency
+		} else if estimate.Currency != pricing.Currency {
+			return Estimate{}, ErrMixedCurrencies
+		}
+
+		region, err := estimateRegion(name, spec.GetRegions()[name], pricing)
+		if err != nil {
+			return Estimate{}, err
+		}
+		estimate.Regions = append(estimate.Regions, region)
+		estimate.MinMonthly += region.MinMonthly
+		estimate.MaxMonthly += region.MaxMonthly
+	}
+	return estimate, nil
+}
+
+func estimateRegion(name string, target *resourcev1.RegionTarget, pricing Pricing) (Region, error) {
+	cpu, err := resource.ParseQuantity(target.GetCpu())
+	if err != nil {
+		return Region{}, &InvalidTargetError{Region: name, Reason: fmt.Sprintf("invalid cpu %q", target.GetCpu())}
+	}
+	memory, err := resource.ParseQuantity(target.GetMemory())
+	if err != nil {
+		return Region{}, &InvalidTargetError{Region: name, Reason: fmt.Sprintf("invalid memory %q", target.GetMemory())}
+	}
+
+	minReplicas := max(target.GetMinReplicas(), 1)
+	maxReplicas := max(target.GetMaxReplicas(), minReplicas)
+
+	replicaHourMicros := replicaHourMicros(cpu, memory, pricing)
+
+	return Region{
+		Region:      name,
+		MinReplicas: minReplicas,
+		MaxReplicas: maxReplicas,
+		MinMonthly:  monthlyCents(replicaHourMicros, minReplicas),
+		MaxMonthly:  monthlyCents(replicaHourMicros, maxReplicas),
+	}, nil
+}
+
+// HourlyMicros is what replicas with cpu and memory each cost for an hour, in millionths of the
+// pricing's currency.
+func HourlyMicros(cpu, memory resource.Quantity, replicas int32, pricing Pricing) int64 {
+	return int64(math.Round(replicaHourMicros(cpu, memory, pricing) * float64(replicas)))
+}
+
+func replicaHourMicros(cpu, memory resource.Quantity, pricing Pricing) float64 {
+	cores := float64(cpu.MilliValue()) / 1000
+	gib := float64(memory.Value()) / bytesPerGiB
+	return cores*float64(pricing.CpuCoreHourMicros) + gib*float64(pricing.MemoryGibHourMicros)
+}
+
+func monthlyCents(replicaHourMicros float64, replicas int32) int64 {
+	return int64(math.Round(replicaHourMicros * float64(replicas) * HoursPerMonth / microsPerCent))
+}
@@ -0,0 +1,124 @@
+package customdomain
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/dnsrecord"
+)
+
+// DefaultInterval is how often pending domains are checked when no interval is given.
+const DefaultInterval = time.Minute
+
+// lookupTimeout bounds the lookups for one domain
+const lookupTimeout = 10 * time.Second
+
+// Checker periodically looks up pending user-provided domains and makes them active once they
+// resolve to the ingress of their resource's cluster. Until then, the reason is kept on the
+// domain so users can see what is still missing.
+type Checker struct {
+	queries  genDb.Querier
+	resolver *net.Resolver
+	interval time.Duration
+}
+
+// NewChecker creates a Checker that runs every interval (DefaultInterval if zero).
+func NewChecker(queries genDb.Querier, interval time.Duration) *Checker {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Checker{
+		queries:  queries,
+		resolver: net.DefaultResolver,
+		interval: interval,
+	}
+}
+
+// Start checks once immediately and then on every tick until ctx is canceled.
+func (c *Checker) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting custom domain checker", "interval", c.interval)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.checkPending(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Checker) checkPending(ctx context.Context) {
+	domains, err := c.queries.ListPendingCustomDomains(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list pending custom domains", "error", err)
+		return
+	}
+
+	for _, domain := range domains {
+		status := genDb.DomainStatusPending
+		message := "the resource's region has no ingress to point the domain at yet"
+		if domain.Endpoint.Valid {
+			var resolved bool
+			resolved, message = Check(ctx, c.resolver, domain.Domain, domain.Endpoint.String)
+			if resolved {
+				status = genDb.DomainStatusActive
+			}
+		}
+
+		if err := c.queries.SetResourceDomainStatus(ctx, genDb.SetResourceDomainStatusParams{
+			ID:            domain.ID,
+			Status:        status,
+			StatusMessage: message,
+		}); err != nil {
+			slog.ErrorContext(ctx, "failed to update custom domain status", "domain", domain.Domain, "error", err)
+			continue
+		}
+		if status == genDb.DomainStatusActive {
+			slog.InfoContext(ctx, "custom domain is active", "domainId", domain.ID, "domain", domain.Domain)
+		}
+	}
+}
+
+// Check reports whether domain resolves to the ingress endpoint, directly through a CNAME or to
+// one of its addresses, as ALIAS records and flattened CNAMEs do. When it does not, the message
+// says what domain resolves to instead; otherwise it is empty.
+func Check(ctx context.Context, resolver *net.Resolver, domain, endpoint string) (bool, string) {
+	ctx, cancel := context.WithTimeout(ctx, lookupTimeout)
+	defer cancel()
+
+	target := dnsrecord.RecordFor(domain, endpoint, 0)
+	if target.Type == dnsrecord.TypeCNAME {
+		if cname, err := resolver.LookupCNAME(ctx, domain); err == nil && strings.EqualFold(strings.TrimSuffix(cname, "."), target.Target) {
+			return true, ""
+		}
+	}
+
+	addresses, err := resolver.LookupHost(ctx, domain)
+	if err != nil {
+		return false, fmt.Sprintf("%s does not resolve yet", domain)
+	}
+	wanted := []string{target.Target}
+	if target.Type == dnsrecord.TypeCNAME {
+		if wanted, err = resolver.LookupHost(ctx, target.Target); err != nil {
+			return false, fmt.Sprintf("failed to resolve the ingress %s: %v", target.Target, err)
+		}
+	}
+	for _, address := range addresses {
+		if slices.Contains(wanted, address) {
+			return true, ""
+		}
+	}
+	slices.Sort(addresses)
+	return false, fmt.Sprintf("%s resolves to %s rather than to %s", domain, strings.Join(addresses, ", "), target.Target)
+}
@@ -0,0 +1,131 @@
+// Package customdomain tells users how to point their own domains at the platform and checks,
+// in the background, when the records they created have propagated.
+package customdomain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/team-loco/loco/api/pkg/dnsrecord"
+	"golang.org/x/net/publicsuffix"
+)
+
+// DNS providers setup instructions are tailored to. ProviderOther covers every provider not listed.
+const (
+	ProviderCloudflare = "cloudflare"
+	ProviderRoute53    = "route53"
+	ProviderDNSimple   = "dnsimple"
+	ProviderNamecheap  = "namecheap"
+	ProviderGoDaddy    = "godaddy"
+	ProviderOther      = "other"
+)
+
+// Providers lists the providers instructions are given for, in the order they are returned.
+var Providers = []string{ProviderCloudflare, ProviderRoute53, ProviderDNSimple, ProviderNamecheap, ProviderGoDaddy, ProviderOther}
+
+// Record is a DNS record a user creates at their provider. Name is relative to the zone, with "@"
+// for the apex.
+type Record struct {
+	Type  string
+	Name  string
+	Value string
+}
+
+// Instructions are the records to create with one provider.
+type Instructions struct {
+	Provider string
+	Records  []Record
+	Note     string
+}
+
+// Zone returns the registrable domain domain belongs to, e.g. example.co.uk for www.example.co.uk.
+// It fails for public suffixes such as co.uk, which nobody can own.
+func Zone(domain string) (string, error) {
+	return publicsuffix.EffectiveTLDPlusOne(domain)
+}
+
+// IsApex reports whether domain is a registrable domain itself rather than a subdomain of one.
+// The DNS does not allow a CNAME at the apex, so apex domains need an ALIAS, ANAME or A records.
+func IsApex(domain string) bool {
+	zone, err := Zone(domain)
+	return err == nil && zone == domain
+}
+
+// Setup returns the records pointing domain at the ingress endpoint for each of providers.
+// addresses are the endpoint's current IP addresses, used where a provider has no way to point an
+// apex at a hostname.
+func Setup(domain, endpoint string, providers []string, addresses []string) []Instructions {
+	target := dnsrecord.RecordFor(domain, endpoint, 0)
+	apex := IsApex(domain)
+	name := "@"
+	if zone, err := Zone(domain); err == nil && !apex {
+		name = strings.TrimSuffix(domain, "."+zone)
+	}
+
+	instructions := make([]Instructions, 0, len(providers))
+	for _, provider := range providers {
+		// a subdomain, or any domain pointed at an address, needs the same record everywhere
+		if !apex || target.Type != dnsrecord.TypeCNAME {
+			instructions = append(instructions, Instructions{
+				Provider: provider,
+				Records:  []Record{{Type: target.Type, Name: name, Value: target.Target}},
+			})
+			continue
+		}
+		instructions = append(instructions, apexInstructions(provider, target.Target, addresses))
+	}
+	return instructions
+}
+
+// apexInstructions points an apex domain at the ingress hostname the way provider allows.
+func apexInstructions(provider, target string, addresses []string) Instructions {
+	alias := Instructions{Provider: provider, Records: []Record{{Type: "ALIAS", Name: "@", Value: target}}}
+	switch provider {
+	case ProviderCloudflare:
+		return Instructions{
+			Provider: provider,
+			Records:  []Record{{Type: dnsrecord.TypeCNAME, Name: "@", Value: target}},
+			Note:     "Cloudflare flattens a CNAME at the apex. Leave it DNS only rather than proxied so the platform can issue the certificate.",
+		}
+	case ProviderRoute53:
+		if strings.HasSuffix(target, ".elb.amazonaws.com") {
+			return Instructions{
+				Provider: provider,
+				Records:  []Record{{Type: dnsrecord.TypeA, Name: "@", Value: target}},
+				Note:     "Create it as an alias record routing traffic to the load balancer.",
+			}
+		}
+		return addressInstructions(provider, addresses, "Route 53 aliases only point at AWS resources")
+	case ProviderDNSimple:
+		return alias
+	case ProviderNamecheap:
+		alias.Note = "Namecheap lists ALIAS records under Advanced DNS."
+		return alias
+	case ProviderGoDaddy:
+		return addressInstructions(provider, addresses, "GoDaddy has no ALIAS records")
+	default:
+		alias.Note = "Use an ALIAS or ANAME record if the provider has one; otherwise create A records"
+		if len(addresses) > 0 {
+			alias.Note += " for " + strings.Join(addresses, ", ") + ", which can change"
+		}
+		alias.Note += "."
+		return alias
+	}
+}
+
+// addressInstructions points an apex domain at the ingress's current addresses, for providers
+// that cannot point it at a hostname.
+func addressInstructions(provider string, addresses []string, reason string) Instructions {
+	instructions := Instructions{Provider: provider}
+	for _, address := range addresses {
+		record := dnsrecord.RecordFor("", address, 0)
+		instructions.Records = append(instructions.Records, Record{Type: record.Type, Name: "@", Value: record.Target})
+	}
+	if len(addresses) == 0 {
+		instructions.Note = reason + ", and the ingress's addresses could not be resolved; try again shortly."
+		return instructions
+	}
+	instructions.Note = fmt.Sprintf("%s, so the apex points at the ingress's current addresses, which can change. "+
+		"Pointing www at the platform and redirecting the apex to it avoids this.", reason)
+	return instructions
+}
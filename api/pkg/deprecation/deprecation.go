@@ -0,0 +1,112 @@
+// Package deprecation tracks deprecated RPCs and request fields and records who still uses them.
+package deprecation
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Notice describes a deprecated surface. Surfaces marked `deprecated = true` in the
+// protos are picked up automatically; a Notice adds dates and a migration link.
+type Notice struct {
+	Since  time.Time // when the surface was deprecated; zero if unknown
+	Sunset time.Time // when it will be removed; zero if not scheduled
+	Link   string    // migration guide
+}
+
+// Registry maps a surface to its notice. Keys are procedures
+// ("/resource.v1.ResourceService/ListResourceEvents") or fully qualified
+// request fields ("resource.v1.WatchLogsRequest.limit").
+type Registry map[string]Notice
+
+// Lookup returns the notices for the procedure and any deprecated fields set on msg.
+// The map is nil when nothing deprecated was used.
+func (r Registry) Lookup(procedure string, method protoreflect.MethodDescriptor, msg protoreflect.Message) map[string]Notice {
+	var used map[string]Notice
+	mark := func(surface string, flagged bool) {
+		notice, ok := r[surface]
+		if !ok && !flagged {
+			return
+		}
+		if used == nil {
+			used = map[string]Notice{}
+		}
+		used[surface] = notice
+	}
+
+	flagged := false
+	if method != nil {
+		if opts, ok := method.Options().(*descriptorpb.MethodOptions); ok {
+			flagged = opts.GetDeprecated()
+		}
+	}
+	mark(procedure, flagged)
+
+	if msg != nil {
+		r.walk(msg, mark)
+	}
+	return used
+}
+
+// walk visits every populated field of msg, descending into nested messages.
+func (r Registry) walk(msg protoreflect.Message, mark func(string, bool)) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		flagged := false
+		if opts, ok := fd.Options().(*descriptorpb.FieldOptions); ok {
+			flagged = opts.GetDeprecated()
+		}
+		mark(string(fd.FullName()), flagged)
+
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				r.walk(list.Get(i).Message(), mark)
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				r.walk(mv.Message(), mark)
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			r.walk(v.Message(), mark)
+		}
+		return true
+	})
+}
+
+// SetHeaders writes Deprecation (RFC 9745), Sunset (RFC 8594) and Link headers for the
+// given notices. When several surfaces were used, the earliest dates win.
+func SetHeaders(h http.Header, notices map[string]Notice) {
+	if len(notices) == 0 {
+		return
+	}
+
+	var since, sunset time.Time
+	links := map[string]bool{}
+	for _, n := range notices {
+		if !n.Since.IsZero() && (since.IsZero() || n.Since.Before(since)) {
+			since = n.Since
+		}
+		if !n.Sunset.IsZero() && (sunset.IsZero() || n.Sunset.Before(sunset)) {
+			sunset = n.Sunset
+		}
+		if n.Link != "" && !links[n.Link] {
+			links[n.Link] = true
+			h.Add("Link", "<"+n.Link+`>; rel="deprecation"; type="text/html"`)
+		}
+	}
+
+	if since.IsZero() {
+		h.Set("Deprecation", "true")
+	} else {
+		h.Set("Deprecation", "@"+strconv.FormatInt(since.Unix(), 10))
+	}
+	if !sunset.IsZero() {
+		h.Set("Sunset", sunset.UTC().Format(http.TimeFormat))
+	}
+}
@@ -0,0 +1,98 @@
+package deprecation
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// DefaultFlushInterval is how often usage counts are written when no interval is given.
+const DefaultFlushInterval = time.Minute
+
+type usageKey struct {
+	surface   string
+	client    string
+	userAgent string
+}
+
+type usage struct {
+	count    int64
+	lastSeen time.Time
+}
+
+// Tracker counts deprecated surface usage in memory and periodically adds the
+// counts to deprecated_usage, keeping the request path free of database writes.
+type Tracker struct {
+	queries  genDb.Querier
+	interval time.Duration
+
+	mu      sync.Mutex
+	pending map[usageKey]usage
+}
+
+// NewTracker creates a Tracker that flushes every interval (DefaultFlushInterval if zero).
+func NewTracker(queries genDb.Querier, interval time.Duration) *Tracker {
+	if interval <= 0 {
+		interval = DefaultFlushInterval
+	}
+	return &Tracker{
+		queries:  queries,
+		interval: interval,
+		pending:  map[usageKey]usage{},
+	}
+}
+
+// Record counts one use of surface by client.
+func (t *Tracker) Record(surface, client, userAgent string) {
+	key := usageKey{surface: surface, client: client, userAgent: userAgent}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	u := t.pending[key]
+	u.count++
+	u.lastSeen = time.Now()
+	t.pending[key] = u
+}
+
+// Start flushes on every tick until ctx is canceled, then flushes once more.
+func (t *Tracker) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting deprecated usage tracker", "interval", t.interval)
+
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.flush(context.WithoutCancel(ctx))
+			return ctx.Err()
+		case <-ticker.C:
+			t.flush(ctx)
+		}
+	}
+}
+
+func (t *Tracker) flush(ctx context.Context) {
+	t.mu.Lock()
+	pending := t.pending
+	t.pending = map[usageKey]usage{}
+	t.mu.Unlock()
+
+	for key, u := range pending {
+		err := t.queries.RecordDeprecatedUsage(ctx, genDb.RecordDeprecatedUsageParams{
+			Surface:      key.surface,
+			Client:       key.client,
+			UserAgent:    key.userAgent,
+			RequestCount: u.count,
+			SeenAt:       pgtype.Timestamptz{Time: u.lastSeen, Valid: true},
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to record deprecated usage", "surface", key.surface, "client", key.client, "error", err)
+		}
+	}
+}
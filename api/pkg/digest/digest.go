@@ -0,0 +1,260 @@
+// Package digest compiles a weekly summary of each workspace's activity: how many deployments it
+// started, how many of them failed, and which resources used the most. Once a week is over the
+// Compiler stores every workspace's digest and, when enabled, sends it to the workspace's members
+// through the notifier, which honours their workspace_digest email preference.
+package digest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/notify"
+)
+
+// DefaultInterval is how often the Compiler looks for workspaces without last week's digest when
+// no interval is given.
+const DefaultInterval = time.Hour
+
+// Week is the period a digest covers.
+const Week = 7 * 24 * time.Hour
+
+// topResources is how many of a workspace's busiest resources a digest lists
+const topResources = 5
+
+// Config holds the digest settings.
+type Config struct {
+	Email         bool          `env:"DIGEST_EMAIL"`          // send each digest to workspace members; they are only stored otherwise
+	CheckInterval time.Duration `env:"DIGEST_CHECK_INTERVAL"` // how often workspaces without last week's digest are looked for
+}
+
+// Resource is one of a workspace's busiest resources over a week.
+type Resource struct {
+	ResourceID   int64  `json:"resource_id"`
+	Name         string `json:"name"`
+	ReplicaHours int64  `json:"replica_hours"`
+	CostMicros   int64  `json:"cost_micros"`
+	Currency     string `json:"currency"`
+}
+
+// Digest summarizes a workspace's activity over the week starting at WeekStart.
+type Digest struct {
+	WorkspaceID       int64
+	WeekStart         time.Time
+	Deployments       int32
+	FailedDeployments int32
+	TopResources      []Resource
+}
+
+// WeekEnd returns when the digest's week ends.
+func (d Digest) WeekEnd() time.Time {
+	return d.WeekStart.Add(Week)
+}
+
+// FailureRate returns the share of the week's deployments that failed, or 0 without deployments.
+func (d Digest) FailureRate() float64 {
+	if d.Deployments == 0 {
+		return 0
+	}
+	return float64(d.FailedDeployments) / float64(d.Deployments)
+}
+
+// WeekStart returns the start of the week t falls in: Monday at midnight UTC.
+func WeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	// Sunday is 0, so it belongs to the week that started six days before
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+// Compile summarizes the workspace's activity over the week starting at weekStart, which may
+// still be in progress.
+func Compile(ctx context.Context, queries genDb.Querier, workspaceID int64, weekStart time.Time) (Digest, error) {
+	since := pgtype.Timestamptz{Time: weekStart, Valid: true}
+	until := pgtype.Timestamptz{Time: weekStart.Add(Week), Valid: true}
+
+	counts, err := queries.GetWorkspaceDeploymentCounts(ctx, genDb.GetWorkspaceDeploymentCountsParams{
+		WorkspaceID: workspaceID,
+		Since:       since,
+		Until:       until,
+	})
+	if err != nil {
+		return Digest{}, fmt.Errorf("count deployments: %w", err)
+	}
+
+	rows, err := queries.ListWorkspaceTopResources(ctx, genDb.ListWorkspaceTopResourcesParams{
+		WorkspaceID: workspaceID,
+		Since:       since,
+		Until:       until,
+		RowLimit:    topResources,
+	})
+	if err != nil {
+		return Digest{}, fmt.Errorf("list top resources: %w", err)
+	}
+	resources := make([]Resource, 0, len(rows))
+	for _, row := range rows {
+		resources = append(resources, Resource{
+			ResourceID:   row.ResourceID,
+			Name:         row.ResourceName,
+			ReplicaHours: row.ReplicaHours,
+			CostMicros:   row.CostMicros,
+			Currency:     row.Currency,
+		})
+	}
+
+	return Digest{
+		WorkspaceID:       workspaceID,
+		WeekStart:         weekStart,
+		Deployments:       counts.Deployments,
+		FailedDeployments: counts.FailedDeployments,
+		TopResources:      resources,
+	}, nil
+}
+
+// FromRow returns the digest stored in row.
+func FromRow(row genDb.WorkspaceDigest) (Digest, error) {
+	var resources []Resource
+	if err := json.Unmarshal(row.TopResources, &resources); err != nil {
+		return Digest{}, fmt.Errorf("invalid top resources of workspace %d digest: %w", row.WorkspaceID, err)
+	}
+	return Digest{
+		WorkspaceID:       row.WorkspaceID,
+		WeekStart:         row.WeekStart.Time.UTC(),
+		Deployments:       row.Deployments,
+		FailedDeployments: row.FailedDeployments,
+		TopResources:      resources,
+	}, nil
+}
+
+// Compiler stores every workspace's digest of the last finished week, and sends it when email is on.
+type Compiler struct {
+	queries  genDb.Querier
+	notifier *notify.Notifier
+	email    bool
+	interval time.Duration
+}
+
+// NewCompiler creates a Compiler that checks every cfg.CheckInterval (DefaultInterval if zero).
+// notifier may be nil, in which case digests are only stored.
+func NewCompiler(queries genDb.Querier, notifier *notify.Notifier, cfg Config) *Compiler {
+	interval := cfg.CheckInterval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Compiler{
+		queries:  queries,
+		notifier: notifier,
+		email:    cfg.Email,
+		interval: interval,
+	}
+}
+
+// Start compiles once immediately and then every interval until ctx is canceled. Every replica
+// does this; storing a digest is what claims sending it.
+func (c *Compiler) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting workspace digest compiler", "interval", c.interval, "email", c.email)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.compile(ctx, WeekStart(time.Now()).Add(-Week))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Compiler) compile(ctx context.Context, weekStart time.Time) {
+	workspaces, err := c.queries.ListWorkspacesWithoutDigest(ctx, genDb.ListWorkspacesWithoutDigestParams{
+		WeekStart: pgtype.Timestamptz{Time: weekStart, Valid: true},
+		WeekEnd:   pgtype.Timestamptz{Time: weekStart.Add(Week), Valid: true},
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list workspaces without digest", "weekStart", weekStart, "error", err)
+		return
+	}
+
+	for _, workspace := range workspaces {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := c.compileWorkspace(ctx, workspace.ID, workspace.Name, weekStart); err != nil {
+			slog.ErrorContext(ctx, "failed to compile workspace digest", "workspaceId", workspace.ID, "weekStart", weekStart, "error", err)
+		}
+	}
+}
+
+func (c *Compiler) compileWorkspace(ctx context.Context, workspaceID int64, workspaceName string, weekStart time.Time) error {
+	digest, err := Compile(ctx, c.queries, workspaceID, weekStart)
+	if err != nil {
+		return err
+	}
+	topResources, err := json.Marshal(digest.TopResources)
+	if err != nil {
+		return err
+	}
+
+	stored, err := c.queries.CreateWorkspaceDigest(ctx, genDb.CreateWorkspaceDigestParams{
+		WorkspaceID:       workspaceID,
+		WeekStart:         pgtype.Timestamptz{Time: weekStart, Valid: true},
+		Deployments:       digest.Deployments,
+		FailedDeployments: digest.FailedDeployments,
+		TopResources:      topResources,
+	})
+	if err != nil {
+		return fmt.Errorf("store digest: %w", err)
+	}
+	if stored == 0 {
+		// another replica got there first and sends it
+		return nil
+	}
+	slog.InfoContext(ctx, "compiled workspace digest", "workspaceId", workspaceID, "weekStart", weekStart,
+		"deployments", digest.Deployments, "failedDeployments", digest.FailedDeployments)
+
+	if !c.email {
+		return nil
+	}
+	return c.notifier.Notify(ctx, notify.Event{
+		Kind:        genDb.NotificationEventWorkspaceDigest,
+		Key:         fmt.Sprintf("%d:%s", workspaceID, weekStart.Format(time.DateOnly)),
+		WorkspaceID: workspaceID,
+		Subject:     fmt.Sprintf("Your week in %s", workspaceName),
+		Body:        body(digest, workspaceName),
+		Data: map[string]any{
+			"week_start":         weekStart.Format(time.DateOnly),
+			"deployments":        digest.Deployments,
+			"failed_deployments": digest.FailedDeployments,
+		},
+	})
+}
+
+// body renders digest as the text of its email
+func body(digest Digest, workspaceName string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s, week of %s to %s\n\n", workspaceName,
+		digest.WeekStart.Format("Jan 2"), digest.WeekEnd().Add(-time.Second).Format("Jan 2, 2006"))
+
+	if digest.Deployments == 0 {
+		b.WriteString("No deployments.\n")
+	} else {
+		fmt.Fprintf(&b, "Deployments: %d, %d failed (%.1f%%)\n", digest.Deployments, digest.FailedDeployments, 100*digest.FailureRate())
+	}
+
+	if len(digest.TopResources) > 0 {
+		b.WriteString("\nBusiest resources:\n")
+		for _, resource := range digest.TopResources {
+			fmt.Fprintf(&b, "  %s: %d replica hours, %.2f %s\n", resource.Name, resource.ReplicaHours,
+				float64(resource.CostMicros)/1e6, resource.Currency)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,142 @@
+package dnsrecord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const cloudflareAPIBase = "https://api.cloudflare.com/client/v4"
+
+// CloudflareProvider manages records in a Cloudflare zone. Records are DNS only rather than
+// proxied, so TLS stays with the cluster's gateway.
+type CloudflareProvider struct {
+	httpClient *http.Client
+	apiToken   string
+	zoneID     string
+}
+
+// NewCloudflareProvider creates a CloudflareProvider for zoneID.
+func NewCloudflareProvider(httpClient *http.Client, apiToken, zoneID string) *CloudflareProvider {
+	return &CloudflareProvider{
+		httpClient: httpClient,
+		apiToken:   apiToken,
+		zoneID:     zoneID,
+	}
+}
+
+type cfRecord struct {
+	ID      string `json:"id,omitempty"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+	TTL     int    `json:"ttl"`
+	Proxied bool   `json:"proxied"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type cfResponse struct {
+	Success bool            `json:"success"`
+	Errors  []cfError       `json:"errors"`
+	Result  json.RawMessage `json:"result"`
+}
+
+type cfError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (p *CloudflareProvider) Upsert(ctx context.Context, record Record) (string, error) {
+	existing, err := p.find(ctx, record)
+	if err != nil {
+		return "", err
+	}
+
+	cfRec := cfRecord{
+		Type:    record.Type,
+		Name:    record.Hostname,
+		Content: record.Target,
+		TTL:     record.TTL,
+		Comment: "managed by loco",
+	}
+	if existing != nil {
+		err = p.do(ctx, http.MethodPut, "/dns_records/"+existing.ID, cfRec, &cfRec)
+	} else {
+		err = p.do(ctx, http.MethodPost, "/dns_records", cfRec, &cfRec)
+	}
+	if err != nil {
+		return "", fmt.Errorf("upsert %s record %s: %w", record.Type, record.Hostname, err)
+	}
+	return cfRec.ID, nil
+}
+
+func (p *CloudflareProvider) Delete(ctx context.Context, record Record) error {
+	existing, err := p.find(ctx, record)
+	if err != nil || existing == nil {
+		return err
+	}
+	if err := p.do(ctx, http.MethodDelete, "/dns_records/"+existing.ID, nil, nil); err != nil {
+		return fmt.Errorf("delete %s record %s: %w", record.Type, record.Hostname, err)
+	}
+	return nil
+}
+
+// find returns the zone's record of the record's hostname and type, or nil if there is none
+func (p *CloudflareProvider) find(ctx context.Context, record Record) (*cfRecord, error) {
+	query := url.Values{"name": {record.Hostname}, "type": {record.Type}}
+	var records []cfRecord
+	if err := p.do(ctx, http.MethodGet, "/dns_records?"+query.Encode(), nil, &records); err != nil {
+		return nil, fmt.Errorf("list records for %s: %w", record.Hostname, err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	return &records[0], nil
+}
+
+// do sends a request for the zone to the Cloudflare API and decodes the result envelope into out
+func (p *CloudflareProvider) do(ctx context.Context, method, path string, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/zones/%s%s", cloudflareAPIBase, p.zoneID, path), reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.apiToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var envelope cfResponse
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("decode cloudflare response (status %d): %w", resp.StatusCode, err)
+	}
+	if !envelope.Success {
+		messages := make([]string, 0, len(envelope.Errors))
+		for _, e := range envelope.Errors {
+			messages = append(messages, fmt.Sprintf("%d: %s", e.Code, e.Message))
+		}
+		return fmt.Errorf("cloudflare api error (status %d): %s", resp.StatusCode, strings.Join(messages, "; "))
+	}
+
+	if out != nil && len(envelope.Result) > 0 {
+		return json.Unmarshal(envelope.Result, out)
+	}
+	return nil
+}
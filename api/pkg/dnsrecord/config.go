@@ -0,0 +1,48 @@
+package dnsrecord
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// DNS providers.
+const (
+	ProviderCloudflare = "cloudflare"
+	ProviderRoute53    = "route53"
+)
+
+// Config selects and configures the DNS provider platform subdomains are published with.
+type Config struct {
+	Provider     string        `env:"DNS_PROVIDER"`                 // "cloudflare", "route53", or empty to publish no records
+	TTL          int           `env:"DNS_RECORD_TTL" default:"300"` // seconds
+	SyncInterval time.Duration `env:"DNS_SYNC_INTERVAL"`
+
+	CloudflareAPIToken string `env:"DNS_CLOUDFLARE_API_TOKEN"`
+	CloudflareZoneID   string `env:"DNS_CLOUDFLARE_ZONE_ID"`
+
+	Route53HostedZoneID    string `env:"DNS_ROUTE53_HOSTED_ZONE_ID"`
+	Route53AccessKeyID     string `env:"DNS_ROUTE53_ACCESS_KEY_ID"`
+	Route53SecretAccessKey string `env:"DNS_ROUTE53_SECRET_ACCESS_KEY"`
+}
+
+// NewProvider creates the provider cfg selects, or nil when no provider is configured.
+func NewProvider(cfg Config, httpClient *http.Client) (Provider, error) {
+	switch cfg.Provider {
+	case "":
+		return nil, nil
+	case ProviderCloudflare:
+		if cfg.CloudflareAPIToken == "" || cfg.CloudflareZoneID == "" {
+			return nil, errors.New("DNS_CLOUDFLARE_API_TOKEN and DNS_CLOUDFLARE_ZONE_ID are required for the cloudflare dns provider")
+		}
+		return NewCloudflareProvider(httpClient, cfg.CloudflareAPIToken, cfg.CloudflareZoneID), nil
+	case ProviderRoute53:
+		if cfg.Route53HostedZoneID == "" || cfg.Route53AccessKeyID == "" || cfg.Route53SecretAccessKey == "" {
+			return nil, errors.New("DNS_ROUTE53_HOSTED_ZONE_ID, DNS_ROUTE53_ACCESS_KEY_ID and DNS_ROUTE53_SECRET_ACCESS_KEY are required for the route53 dns provider")
+		}
+		return NewRoute53Provider(httpClient, cfg.Route53HostedZoneID, cfg.Route53AccessKeyID, cfg.Route53SecretAccessKey), nil
+	default:
+		return nil, fmt.Errorf("unknown dns provider %q", cfg.Provider)
+	}
+}
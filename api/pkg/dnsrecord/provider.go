@@ -0,0 +1,59 @@
+// Package dnsrecord publishes DNS records for platform-provided subdomains, pointing each at the
+// ingress of the cluster serving its resource, and removes them once the domain is gone.
+package dnsrecord
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// Record types published.
+const (
+	TypeA     = "A"
+	TypeAAAA  = "AAAA"
+	TypeCNAME = "CNAME"
+)
+
+// Record is a DNS record for one hostname.
+type Record struct {
+	Hostname string
+	Type     string
+	Target   string
+	TTL      int // seconds
+}
+
+// Provider manages records with an external DNS provider. Both methods must be idempotent, since
+// a sync that failed part way is retried, possibly by another API replica.
+type Provider interface {
+	// Upsert creates the record, or updates the hostname's record of the same type, and returns
+	// the provider's ID for it, or "" when the provider has none.
+	Upsert(ctx context.Context, record Record) (string, error)
+	// Delete removes the record, succeeding when it does not exist.
+	Delete(ctx context.Context, record Record) error
+}
+
+// RecordFor returns the record pointing hostname at a cluster ingress endpoint: an A or AAAA record
+// for an IP address, a CNAME otherwise. Endpoints may be given as URLs or with a port, neither of
+// which DNS has a place for.
+func RecordFor(hostname, endpoint string, ttl int) Record {
+	host := endpoint
+	if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(strings.Trim(host, "[]"), ".")
+
+	record := Record{Hostname: hostname, Type: TypeCNAME, Target: host, TTL: ttl}
+	if ip := net.ParseIP(host); ip != nil {
+		record.Type = TypeAAAA
+		if ip.To4() != nil {
+			record.Type = TypeA
+		}
+		record.Target = ip.String()
+	}
+	return record
+}
@@ -0,0 +1,169 @@
+package dnsrecord
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const route53Host = "route53.amazonaws.com"
+
+// Route53Provider manages records in a Route 53 hosted zone, signing requests with AWS Signature
+// Version 4.
+type Route53Provider struct {
+	httpClient      *http.Client
+	hostedZoneID    string
+	accessKeyID     string
+	secretAccessKey string
+}
+
+// NewRoute53Provider creates a Route53Provider for hostedZoneID, given with or without its
+// "/hostedzone/" prefix.
+func NewRoute53Provider(httpClient *http.Client, hostedZoneID, accessKeyID, secretAccessKey string) *Route53Provider {
+	return &Route53Provider{
+		httpClient:      httpClient,
+		hostedZoneID:    strings.TrimPrefix(hostedZoneID, "/hostedzone/"),
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+	}
+}
+
+type r53ChangeRequest struct {
+	XMLName xml.Name    `xml:"https://route53.amazonaws.com/doc/2013-04-01/ ChangeResourceRecordSetsRequest"`
+	Changes []r53Change `xml:"ChangeBatch>Changes>Change"`
+}
+
+type r53Change struct {
+	Action    string       `xml:"Action"`
+	RecordSet r53RecordSet `xml:"ResourceRecordSet"`
+}
+
+type r53RecordSet struct {
+	Name   string   `xml:"Name"`
+	Type   string   `xml:"Type"`
+	TTL    int      `xml:"TTL"`
+	Values []string `xml:"ResourceRecords>ResourceRecord>Value"`
+}
+
+// Upsert creates or replaces the record with an UPSERT change. Route 53 has no IDs for records.
+func (p *Route53Provider) Upsert(ctx context.Context, record Record) (string, error) {
+	if err := p.change(ctx, "UPSERT", record); err != nil {
+		return "", fmt.Errorf("upsert %s record %s: %w", record.Type, record.Hostname, err)
+	}
+	return "", nil
+}
+
+// Delete removes the record with a DELETE change, which Route 53 only accepts for a record
+// matching the one published exactly.
+func (p *Route53Provider) Delete(ctx context.Context, record Record) error {
+	err := p.change(ctx, "DELETE", record)
+	if err != nil && strings.Contains(err.Error(), "not found") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("delete %s record %s: %w", record.Type, record.Hostname, err)
+	}
+	return nil
+}
+
+// change submits a single change to the hosted zone
+func (p *Route53Provider) change(ctx context.Context, action string, record Record) error {
+	body, err := xml.Marshal(r53ChangeRequest{Changes: []r53Change{{
+		Action: action,
+		RecordSet: r53RecordSet{
+			Name:   record.Hostname,
+			Type:   record.Type,
+			TTL:    record.TTL,
+			Values: []string{record.Target},
+		},
+	}}})
+	if err != nil {
+		return err
+	}
+	body = append([]byte(xml.Header), body...)
+
+	path := fmt.Sprintf("/2013-04-01/hostedzone/%s/rrset", p.hostedZoneID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+route53Host+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/xml")
+	p.sign(req, body, time.Now().UTC())
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("route53 request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("route53 returned %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return nil
+}
+
+// sign adds a Signature Version 4 Authorization header for the route53 service, which is global
+// and signed for us-east-1.
+func (p *Route53Provider) sign(req *http.Request, body []byte, now time.Time) {
+	const (
+		region  = "us-east-1"
+		service = "route53"
+	)
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("Host", route53Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "content-type;host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "content-type:" + req.Header.Get("Content-Type") + "\n" +
+		"host:" + route53Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	credentialScope := strings.Join([]string{date, region, service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		credentialScope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+p.secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		p.accessKeyID, credentialScope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
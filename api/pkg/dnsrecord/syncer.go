@@ -0,0 +1,167 @@
+package dnsrecord
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/changes"
+)
+
+// DefaultInterval is how often the syncer reconciles records when no interval is given.
+const DefaultInterval = time.Minute
+
+// Record statuses stored in dns_records.
+const (
+	statusPublished = "published"
+	statusFailed    = "failed"
+)
+
+// Syncer keeps the provider's records in line with the platform-provided domains, publishing a
+// record for each and deleting records whose domain is gone. What was published is tracked in
+// dns_records, so records are only sent to the provider when they change, and failures are kept
+// there for operators until a later sync succeeds.
+type Syncer struct {
+	queries  genDb.Querier
+	provider Provider
+	feed     *changes.Feed
+	ttl      int
+	interval time.Duration
+}
+
+// NewSyncer creates a Syncer that runs whenever domains are added or removed and otherwise every
+// interval (DefaultInterval if zero), which also picks up clusters changing endpoint. feed may be
+// nil, in which case domain changes wait for the next interval.
+func NewSyncer(queries genDb.Querier, provider Provider, feed *changes.Feed, ttl int, interval time.Duration) *Syncer {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Syncer{
+		queries:  queries,
+		provider: provider,
+		feed:     feed,
+		ttl:      ttl,
+		interval: interval,
+	}
+}
+
+// Start syncs once immediately and then on every domain change and tick until ctx is canceled.
+func (s *Syncer) Start(ctx context.Context) error {
+	events, stop := s.feed.Subscribe(func(e changes.Event) bool {
+		return e.Table == changes.TableResourceDomains
+	})
+	defer stop()
+
+	slog.InfoContext(ctx, "starting dns record syncer", "interval", s.interval)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.sync(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case <-events:
+		}
+	}
+}
+
+func (s *Syncer) sync(ctx context.Context) {
+	targets, err := s.queries.ListPlatformDomainTargets(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list platform domains", "error", err)
+		return
+	}
+	stored, err := s.queries.ListDNSRecords(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list dns records", "error", err)
+		return
+	}
+	byHostname := make(map[string]genDb.DnsRecord, len(stored))
+	for _, record := range stored {
+		byHostname[record.Hostname] = record
+	}
+
+	wanted := make(map[string]bool, len(targets))
+	for _, target := range targets {
+		wanted[target.Domain] = true
+		record := RecordFor(target.Domain, target.Endpoint.String, s.ttl)
+		existing, ok := byHostname[target.Domain]
+		if ok && existing.Status == statusPublished && existing.RecordType == record.Type && existing.Target == record.Target {
+			continue
+		}
+		s.publish(ctx, record, existing, ok)
+	}
+
+	for _, record := range stored {
+		if wanted[record.Hostname] {
+			continue
+		}
+		published := Record{Hostname: record.Hostname, Type: record.RecordType, Target: record.Target, TTL: s.ttl}
+		if err := s.provider.Delete(ctx, published); err != nil {
+			s.failed(ctx, record.ID, published, err)
+			continue
+		}
+		if err := s.queries.DeleteDNSRecord(ctx, record.ID); err != nil {
+			slog.ErrorContext(ctx, "failed to delete dns record", "hostname", record.Hostname, "error", err)
+			continue
+		}
+		slog.InfoContext(ctx, "deleted dns record", "hostname", record.Hostname)
+	}
+}
+
+// publish upserts record with the provider, first deleting the hostname's record when its type
+// changes, since providers keep records of different types side by side.
+func (s *Syncer) publish(ctx context.Context, record Record, existing genDb.DnsRecord, stored bool) {
+	if stored && existing.RecordType != record.Type {
+		previous := Record{Hostname: existing.Hostname, Type: existing.RecordType, Target: existing.Target, TTL: s.ttl}
+		if err := s.provider.Delete(ctx, previous); err != nil {
+			s.failed(ctx, existing.ID, previous, err)
+			return
+		}
+	}
+
+	providerID, err := s.provider.Upsert(ctx, record)
+	if err != nil {
+		if stored {
+			// the stored record still describes what the provider may hold
+			s.failed(ctx, existing.ID, record, err)
+			return
+		}
+		slog.ErrorContext(ctx, "failed to publish dns record", "hostname", record.Hostname, "error", err)
+		if err := s.queries.UpsertDNSRecord(ctx, genDb.UpsertDNSRecordParams{
+			Hostname:   record.Hostname,
+			RecordType: record.Type,
+			Target:     record.Target,
+			Status:     statusFailed,
+			LastError:  err.Error(),
+		}); err != nil {
+			slog.ErrorContext(ctx, "failed to record dns record failure", "hostname", record.Hostname, "error", err)
+		}
+		return
+	}
+
+	if err := s.queries.UpsertDNSRecord(ctx, genDb.UpsertDNSRecordParams{
+		Hostname:         record.Hostname,
+		RecordType:       record.Type,
+		Target:           record.Target,
+		ProviderRecordID: providerID,
+		Status:           statusPublished,
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to store dns record", "hostname", record.Hostname, "error", err)
+		return
+	}
+	slog.InfoContext(ctx, "published dns record", "hostname", record.Hostname, "type", record.Type, "target", record.Target)
+}
+
+// failed logs a provider error for a stored record and keeps it on the record
+func (s *Syncer) failed(ctx context.Context, id int64, record Record, err error) {
+	slog.ErrorContext(ctx, "failed to sync dns record", "hostname", record.Hostname, "type", record.Type, "error", err)
+	if err := s.queries.SetDNSRecordError(ctx, genDb.SetDNSRecordErrorParams{ID: id, LastError: err.Error()}); err != nil {
+		slog.ErrorContext(ctx, "failed to record dns record failure", "hostname", record.Hostname, "error", err)
+	}
+}
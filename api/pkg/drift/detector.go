@@ -0,0 +1,264 @@
+// Package drift detects differences between active deployments and the live cluster.
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/converter"
+	"github.com/team-loco/loco/api/pkg/kube"
+	"github.com/team-loco/loco/api/pkg/registry"
+	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	crClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultInterval is how often the detector runs when no interval is given.
+const DefaultInterval = 5 * time.Minute
+
+// RemediatedAtAnnotation is set on an Application to make the controller
+// reconcile it again, which overwrites manual edits to the objects it owns.
+const RemediatedAtAnnotation = "loco.io/drift-remediated-at"
+
+// Field is a single drifted value. It is stored as JSON in deployments.drift.
+type Field struct {
+	Field    string `json:"field"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+// Detector periodically compares each active deployment's spec with the
+// Kubernetes Deployment running it, records any drift on the deployment row
+// and, when remediate is set, asks the controller to reconcile it away.
+type Detector struct {
+	kubeClient    *kube.Client
+	queries       genDb.Querier
+	locoNamespace string
+	interval      time.Duration
+	remediate     bool
+}
+
+// NewDetector creates a Detector that runs every interval (DefaultInterval if zero).
+func NewDetector(kubeClient *kube.Client, queries genDb.Querier, locoNamespace string, interval time.Duration, remediate bool) *Detector {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Detector{
+		kubeClient:    kubeClient,
+		queries:       queries,
+		locoNamespace: locoNamespace,
+		interval:      interval,
+		remediate:     remediate,
+	}
+}
+
+// Start checks once immediately and then on every tick until ctx is canceled.
+func (d *Detector) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting drift detector", "interval", d.interval, "remediate", d.remediate)
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.detect(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Detector) detect(ctx context.Context) {
+	deployments, err := d.queries.ListActiveDeploymentsForDrift(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list active deployments", "error", err)
+		return
+	}
+
+	for _, deployment := range deployments {
+		if ctx.Err() != nil {
+			return
+		}
+		if deployment.Type != genDb.ResourceTypeService {
+			continue
+		}
+		d.check(ctx, deployment)
+	}
+}
+
+func (d *Detector) check(ctx context.Context, deployment genDb.ListActiveDeploymentsForDriftRow) {
+	name := fmt.Sprintf("resource-%d", deployment.ResourceID)
+
+	app := &locoControllerV1.Application{}
+	if err := d.kubeClient.ControllerClient.Get(ctx, crClient.ObjectKey{Name: name, Namespace: d.locoNamespace}, app); err != nil {
+		if crClient.IgnoreNotFound(err) != nil {
+			slog.WarnContext(ctx, "failed to get Application", "resourceId", deployment.ResourceID, "error", err)
+		}
+		return
+	}
+	// each cluster only runs the active deployment for its own region
+	if app.Spec.Region != deployment.Region {
+		return
+	}
+	// the controller is still rolling out; differences are expected
+	if app.Status.Phase != "Ready" {
+		return
+	}
+
+	spec, err := converter.DeserializeDeploymentSpec(deployment.Spec, string(deployment.Type))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to deserialize deployment spec", "deploymentId", deployment.ID, "error", err)
+		return
+	}
+
+	live := &appsv1.Deployment{}
+	key := crClient.ObjectKey{
+		Name:      name,
+		Namespace: fmt.Sprintf("wks-%d-res-%d", deployment.WorkspaceID, deployment.ResourceID),
+	}
+	if err := d.kubeClient.ControllerClient.Get(ctx, key, live); err != nil {
+		if crClient.IgnoreNotFound(err) != nil {
+			slog.WarnContext(ctx, "failed to get Deployment", "resourceId", deployment.ResourceID, "error", err)
+			return
+		}
+		d.record(ctx, deployment, app, []Field{{Field: "deployment", Expected: "present", Actual: "missing"}})
+		return
+	}
+
+	scalesToZero := app.Spec.ServiceSpec != nil && app.Spec.ServiceSpec.ScaleToZero != nil
+	d.record(ctx, deployment, app, compare(spec.GetService(), live, scalesToZero))
+}
+
+// record stores fields on the deployment row, or clears a previous record when there is no drift.
+func (d *Detector) record(ctx context.Context, deployment genDb.ListActiveDeploymentsForDriftRow, app *locoControllerV1.Application, fields []Field) {
+	if len(fields) == 0 {
+		if len(deployment.Drift) > 0 {
+			if err := d.queries.ClearDeploymentDrift(ctx, deployment.ID); err != nil {
+				slog.ErrorContext(ctx, "failed to clear deployment drift", "deploymentId", deployment.ID, "error", err)
+				return
+			}
+			slog.InfoContext(ctx, "deployment drift resolved", "deploymentId", deployment.ID, "resourceId", deployment.ResourceID)
+		}
+		return
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to marshal drift", "deploymentId", deployment.ID, "error", err)
+		return
+	}
+	if err := d.queries.SetDeploymentDrift(ctx, genDb.SetDeploymentDriftParams{
+		ID:    deployment.ID,
+		Drift: data,
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to record deployment drift", "deploymentId", deployment.ID, "error", err)
+		return
+	}
+	slog.WarnContext(ctx, "deployment drift detected",
+		"deploymentId", deployment.ID,
+		"resourceId", deployment.ResourceID,
+		"fields", len(fields),
+	)
+
+	if d.remediate {
+		d.remediateDrift(ctx, app)
+	}
+}
+
+// remediateDrift touches the Application so the controller re-applies the desired state.
+func (d *Detector) remediateDrift(ctx context.Context, app *locoControllerV1.Application) {
+	patch := crClient.MergeFrom(app.DeepCopy())
+	if app.Annotations == nil {
+		app.Annotations = map[string]string{}
+	}
+	app.Annotations[RemediatedAtAnnotation] = time.Now().UTC().Format(time.RFC3339)
+
+	if err := d.kubeClient.ControllerClient.Patch(ctx, app, patch); err != nil {
+		slog.ErrorContext(ctx, "failed to remediate drift", "resourceId", app.Spec.ResourceId, "error", err)
+		return
+	}
+	slog.InfoContext(ctx, "requested drift remediation", "resourceId", app.Spec.ResourceId)
+}
+
+// compare returns the fields of live that differ from spec. Replicas are skipped
+// when KEDA owns the replica count, through triggers, schedules or by scaling to zero.
+func compare(spec *deploymentv1.ServiceDeploymentSpec, live *appsv1.Deployment, scalesToZero bool) []Field {
+	var fields []Field
+	add := func(field, expected, actual string) {
+		fields = append(fields, Field{Field: field, Expected: expected, Actual: actual})
+	}
+
+	if !scalesToZero && (spec.GetScalers() == nil || !spec.GetScalers().GetEnabled() || len(spec.GetScalers().GetTriggers())+len(spec.GetScalers().GetSchedules()) == 0) {
+		actual := int32(1)
+		if live.Spec.Replicas != nil {
+			actual = *live.Spec.Replicas
+		}
+		if spec.MinReplicas != nil && spec.GetMinReplicas() != actual {
+			add("replicas", strconv.Itoa(int(spec.GetMinReplicas())), strconv.Itoa(int(actual)))
+		}
+	}
+
+	containers := live.Spec.Template.Spec.Containers
+	if len(containers) == 0 {
+		add("container", "present", "missing")
+		return fields
+	}
+	container := containers[0]
+
+	if image := registry.Pin(spec.GetBuild().GetImage(), spec.GetBuild().GetImageDigest()); image != "" && image != container.Image {
+		add("image", image, container.Image)
+	}
+
+	if spec.GetPort() > 0 {
+		actual := int32(0)
+		if len(container.Ports) > 0 {
+			actual = container.Ports[0].ContainerPort
+		}
+		if actual != spec.GetPort() {
+			add("port", strconv.Itoa(int(spec.GetPort())), strconv.Itoa(int(actual)))
+		}
+	}
+
+	fields = append(fields, compareQuantity("cpu", spec.Cpu, container.Resources, corev1.ResourceCPU)...)
+	fields = append(fields, compareQuantity("memory", spec.Memory, container.Resources, corev1.ResourceMemory)...)
+
+	return fields
+}
+
+// compareQuantity checks both the request and the limit, which the controller sets to the same value.
+func compareQuantity(field string, expected *string, resources corev1.ResourceRequirements, name corev1.ResourceName) []Field {
+	if expected == nil || *expected == "" {
+		return nil
+	}
+	want, err := resource.ParseQuantity(*expected)
+	if err != nil {
+		return nil
+	}
+
+	var fields []Field
+	for _, kind := range []string{"requests", "limits"} {
+		list := resources.Requests
+		if kind == "limits" {
+			list = resources.Limits
+		}
+		actual, ok := list[name]
+		if !ok {
+			fields = append(fields, Field{Field: field + "." + kind, Expected: want.String(), Actual: ""})
+			continue
+		}
+		if actual.Cmp(want) != 0 {
+			fields = append(fields, Field{Field: field + "." + kind, Expected: want.String(), Actual: actual.String()})
+		}
+	}
+	return fields
+}
@@ -0,0 +1,312 @@
+package geodns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+const cloudflareAPIBase = "https://api.cloudflare.com/client/v4"
+
+// Steering policies supported by Cloudflare load balancers.
+const (
+	SteeringLatency = "dynamic_latency"
+	SteeringGeo     = "geo"
+)
+
+// cloudflareRegions maps loco regions to Cloudflare geo steering regions.
+// Regions without an entry are still served through default pools.
+var cloudflareRegions = map[string]string{
+	"us-east-1":      "ENAM",
+	"us-east-2":      "ENAM",
+	"us-west-1":      "WNAM",
+	"us-west-2":      "WNAM",
+	"ca-central-1":   "ENAM",
+	"sa-east-1":      "SSAM",
+	"eu-west-1":      "WEU",
+	"eu-west-2":      "WEU",
+	"eu-west-3":      "WEU",
+	"eu-central-1":   "EEU",
+	"eu-north-1":     "EEU",
+	"ap-south-1":     "SAS",
+	"ap-southeast-1": "SEAS",
+	"ap-southeast-2": "OC",
+	"ap-northeast-1": "NEAS",
+	"ap-northeast-2": "NEAS",
+	"me-south-1":     "ME",
+	"af-south-1":     "SAF",
+}
+
+// CloudflareProvider publishes resources as Cloudflare load balancers with one pool per region.
+// Pools and load balancers are tagged through their description so they can be found again.
+type CloudflareProvider struct {
+	httpClient *http.Client
+	apiToken   string
+	accountID  string
+	zoneID     string
+	steering   string
+}
+
+// NewCloudflareProvider creates a CloudflareProvider. steering defaults to SteeringLatency.
+func NewCloudflareProvider(httpClient *http.Client, apiToken, accountID, zoneID, steering string) *CloudflareProvider {
+	if steering == "" {
+		steering = SteeringLatency
+	}
+	return &CloudflareProvider{
+		httpClient: httpClient,
+		apiToken:   apiToken,
+		accountID:  accountID,
+		zoneID:     zoneID,
+		steering:   steering,
+	}
+}
+
+type cfOrigin struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Enabled bool   `json:"enabled"`
+}
+
+type cfPool struct {
+	ID          string     `json:"id,omitempty"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Enabled     bool       `json:"enabled"`
+	Origins     []cfOrigin `json:"origins"`
+}
+
+type cfLoadBalancer struct {
+	ID             string              `json:"id,omitempty"`
+	Name           string              `json:"name"`
+	Description    string              `json:"description"`
+	DefaultPools   []string            `json:"default_pools"`
+	FallbackPool   string              `json:"fallback_pool"`
+	RegionPools    map[string][]string `json:"region_pools,omitempty"`
+	SteeringPolicy string              `json:"steering_policy"`
+	Proxied        bool                `json:"proxied"`
+}
+
+type cfResponse struct {
+	Success bool            `json:"success"`
+	Errors  []cfError       `json:"errors"`
+	Result  json.RawMessage `json:"result"`
+}
+
+type cfError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (p *CloudflareProvider) Publish(ctx context.Context, resourceID int64, hostname string, origins []Origin) error {
+	if len(origins) == 0 {
+		return p.Unpublish(ctx, resourceID)
+	}
+
+	tag := resourceTag(resourceID)
+
+	existingPools, err := p.listPools(ctx, tag)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]cfPool, len(existingPools))
+	for _, pool := range existingPools {
+		byName[pool.Name] = pool
+	}
+
+	// pools are upserted in failover order so default_pools follows it
+	poolIDs := make([]string, 0, len(origins))
+	regionPools := map[string][]string{}
+	fallback := ""
+	wanted := make(map[string]bool, len(origins))
+	for _, origin := range origins {
+		pool := cfPool{
+			Name:        fmt.Sprintf("%s-%s", tag, origin.Region),
+			Description: tag,
+			Enabled:     true,
+			Origins: []cfOrigin{
+				{Name: origin.Region, Address: origin.Address, Enabled: true},
+			},
+		}
+		wanted[pool.Name] = true
+
+		if existing, ok := byName[pool.Name]; ok {
+			pool.ID = existing.ID
+			err = p.do(ctx, http.MethodPut, p.accountPath("/load_balancers/pools/"+existing.ID), pool, &pool)
+		} else {
+			err = p.do(ctx, http.MethodPost, p.accountPath("/load_balancers/pools"), pool, &pool)
+		}
+		if err != nil {
+			return fmt.Errorf("upsert pool %s: %w", pool.Name, err)
+		}
+
+		poolIDs = append(poolIDs, pool.ID)
+		if origin.Primary || fallback == "" {
+			fallback = pool.ID
+		}
+		if cfRegion, ok := cloudflareRegions[origin.Region]; ok {
+			regionPools[cfRegion] = append(regionPools[cfRegion], pool.ID)
+		}
+	}
+
+	lb := cfLoadBalancer{
+		Name:           hostname,
+		Description:    tag,
+		DefaultPools:   poolIDs,
+		FallbackPool:   fallback,
+		SteeringPolicy: p.steering,
+		Proxied:        true,
+	}
+	if p.steering == SteeringGeo {
+		lb.RegionPools = regionPools
+	}
+
+	existingLB, err := p.findLoadBalancer(ctx, tag)
+	if err != nil {
+		return err
+	}
+	if existingLB != nil {
+		err = p.do(ctx, http.MethodPut, p.zonePath("/load_balancers/"+existingLB.ID), lb, nil)
+	} else {
+		err = p.do(ctx, http.MethodPost, p.zonePath("/load_balancers"), lb, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("upsert load balancer %s: %w", hostname, err)
+	}
+
+	// regions that went away are only dropped once the load balancer stops referencing them
+	for _, pool := range existingPools {
+		if wanted[pool.Name] {
+			continue
+		}
+		if err := p.do(ctx, http.MethodDelete, p.accountPath("/load_balancers/pools/"+pool.ID), nil, nil); err != nil {
+			slog.WarnContext(ctx, "failed to delete stale pool", "pool", pool.Name, "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (p *CloudflareProvider) Unpublish(ctx context.Context, resourceID int64) error {
+	tag := resourceTag(resourceID)
+
+	lb, err := p.findLoadBalancer(ctx, tag)
+	if err != nil {
+		return err
+	}
+	if lb != nil {
+		if err := p.do(ctx, http.MethodDelete, p.zonePath("/load_balancers/"+lb.ID), nil, nil); err != nil {
+			return fmt.Errorf("delete load balancer %s: %w", lb.Name, err)
+		}
+	}
+
+	pools, err := p.listPools(ctx, tag)
+	if err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		if err := p.do(ctx, http.MethodDelete, p.accountPath("/load_balancers/pools/"+pool.ID), nil, nil); err != nil {
+			return fmt.Errorf("delete pool %s: %w", pool.Name, err)
+		}
+	}
+	return nil
+}
+
+func (p *CloudflareProvider) Published(ctx context.Context) ([]int64, error) {
+	var lbs []cfLoadBalancer
+	if err := p.do(ctx, http.MethodGet, p.zonePath("/load_balancers"), nil, &lbs); err != nil {
+		return nil, fmt.Errorf("list load balancers: %w", err)
+	}
+
+	var ids []int64
+	for _, lb := range lbs {
+		if id, ok := parseResourceTag(lb.Description); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// listPools returns the pools tagged for a resource
+func (p *CloudflareProvider) listPools(ctx context.Context, tag string) ([]cfPool, error) {
+	var pools []cfPool
+	if err := p.do(ctx, http.MethodGet, p.accountPath("/load_balancers/pools"), nil, &pools); err != nil {
+		return nil, fmt.Errorf("list pools: %w", err)
+	}
+
+	var tagged []cfPool
+	for _, pool := range pools {
+		if pool.Description == tag {
+			tagged = append(tagged, pool)
+		}
+	}
+	return tagged, nil
+}
+
+// findLoadBalancer returns the load balancer tagged for a resource, or nil if there is none
+func (p *CloudflareProvider) findLoadBalancer(ctx context.Context, tag string) (*cfLoadBalancer, error) {
+	var lbs []cfLoadBalancer
+	if err := p.do(ctx, http.MethodGet, p.zonePath("/load_balancers"), nil, &lbs); err != nil {
+		return nil, fmt.Errorf("list load balancers: %w", err)
+	}
+	for i := range lbs {
+		if lbs[i].Description == tag {
+			return &lbs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (p *CloudflareProvider) accountPath(path string) string {
+	return fmt.Sprintf("/accounts/%s%s", p.accountID, path)
+}
+
+func (p *CloudflareProvider) zonePath(path string) string {
+	return fmt.Sprintf("/zones/%s%s", p.zoneID, path)
+}
+
+// do sends a request to the Cloudflare API and decodes the result envelope into out
+func (p *CloudflareProvider) do(ctx context.Context, method, path string, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, cloudflareAPIBase+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.apiToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var envelope cfResponse
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("decode cloudflare response (status %d): %w", resp.StatusCode, err)
+	}
+	if !envelope.Success {
+		messages := make([]string, 0, len(envelope.Errors))
+		for _, e := range envelope.Errors {
+			messages = append(messages, fmt.Sprintf("%d: %s", e.Code, e.Message))
+		}
+		return fmt.Errorf("cloudflare api error (status %d): %s", resp.StatusCode, strings.Join(messages, "; "))
+	}
+
+	if out != nil && len(envelope.Result) > 0 {
+		return json.Unmarshal(envelope.Result, out)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+// Package geodns publishes latency/geo steered DNS for resources that run in more than one region.
+package geodns
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Origin is a region a resource is served from.
+type Origin struct {
+	Region  string // loco region, e.g. "us-east-1"
+	Address string // ingress endpoint of the region's cluster
+	Primary bool
+}
+
+// Provider publishes steered DNS records with an external DNS provider.
+// Implementations must be idempotent: Publish is called on every sync.
+type Provider interface {
+	// Publish creates or updates routing for hostname across origins, given in failover order.
+	Publish(ctx context.Context, resourceID int64, hostname string, origins []Origin) error
+	// Unpublish removes all routing previously published for a resource.
+	Unpublish(ctx context.Context, resourceID int64) error
+	// Published lists the resources that currently have routing published.
+	Published(ctx context.Context) ([]int64, error)
+}
+
+const tagPrefix = "loco-res-"
+
+// resourceTag identifies provider objects owned by a resource
+func resourceTag(resourceID int64) string {
+	return fmt.Sprintf("%s%d", tagPrefix, resourceID)
+}
+
+// parseResourceTag extracts the resource ID from a tag produced by resourceTag
+func parseResourceTag(tag string) (int64, bool) {
+	if !strings.HasPrefix(tag, tagPrefix) {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(strings.TrimPrefix(tag, tagPrefix), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
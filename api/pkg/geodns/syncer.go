@@ -0,0 +1,103 @@
+package geodns
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// DefaultInterval is how often the syncer reconciles DNS when no interval is given.
+const DefaultInterval = time.Minute
+
+// Syncer keeps steered DNS in line with the regions resources are actively served from.
+// Resources with fewer than two active regions are served by their regular record and
+// have any steered routing removed.
+type Syncer struct {
+	queries  genDb.Querier
+	provider Provider
+	interval time.Duration
+}
+
+// NewSyncer creates a Syncer that runs every interval (DefaultInterval if zero).
+func NewSyncer(queries genDb.Querier, provider Provider, interval time.Duration) *Syncer {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Syncer{
+		queries:  queries,
+		provider: provider,
+		interval: interval,
+	}
+}
+
+// Start syncs once immediately and then on every tick until ctx is canceled.
+func (s *Syncer) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting geo dns syncer", "interval", s.interval)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.sync(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Syncer) sync(ctx context.Context) {
+	resources, err := s.queries.ListMultiRegionResources(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list multi-region resources", "error", err)
+		return
+	}
+
+	routed := make(map[int64]bool, len(resources))
+	for _, res := range resources {
+		rows, err := s.queries.ListResourceRegionOrigins(ctx, res.ResourceID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to list region origins", "resourceId", res.ResourceID, "error", err)
+			// keep whatever is published until the next sync
+			routed[res.ResourceID] = true
+			continue
+		}
+		if len(rows) < 2 {
+			continue
+		}
+
+		origins := make([]Origin, 0, len(rows))
+		for _, row := range rows {
+			origins = append(origins, Origin{
+				Region:  row.Region,
+				Address: row.Endpoint.String,
+				Primary: row.IsPrimary,
+			})
+		}
+
+		if err := s.provider.Publish(ctx, res.ResourceID, res.Domain, origins); err != nil {
+			slog.ErrorContext(ctx, "failed to publish geo dns", "resourceId", res.ResourceID, "hostname", res.Domain, "error", err)
+		}
+		routed[res.ResourceID] = true
+	}
+
+	published, err := s.provider.Published(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list published geo dns", "error", err)
+		return
+	}
+	for _, id := range published {
+		if routed[id] {
+			continue
+		}
+		if err := s.provider.Unpublish(ctx, id); err != nil {
+			slog.ErrorContext(ctx, "failed to unpublish geo dns", "resourceId", id, "error", err)
+			continue
+		}
+		slog.InfoContext(ctx, "unpublished geo dns", "resourceId", id)
+	}
+}
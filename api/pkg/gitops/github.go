@@ -0,0 +1,166 @@
+package gitops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+const githubAPIBase = "https://api.github.com"
+
+// statusContext groups loco's commit statuses on GitHub.
+const statusContext = "loco/gitops"
+
+// GitHubSource reads manifests through the GitHub REST API, so no git binary is needed.
+// Files ending in .yaml or .yml under dir are treated as manifests.
+type GitHubSource struct {
+	httpClient *http.Client
+	token      string
+	repo       string
+	branch     string
+	dir        string
+}
+
+// NewGitHubSource creates a GitHubSource for repo ("owner/name"). branch defaults to main.
+func NewGitHubSource(httpClient *http.Client, token, repo, branch, dir string) *GitHubSource {
+	if branch == "" {
+		branch = "main"
+	}
+	return &GitHubSource{
+		httpClient: httpClient,
+		token:      token,
+		repo:       repo,
+		branch:     branch,
+		dir:        strings.Trim(dir, "/"),
+	}
+}
+
+type ghCommit struct {
+	SHA string `json:"sha"`
+}
+
+type ghTree struct {
+	Tree []struct {
+		Path string `json:"path"`
+		Type string `json:"type"`
+	} `json:"tree"`
+	Truncated bool `json:"truncated"`
+}
+
+type ghStatus struct {
+	State       string `json:"state"`
+	Description string `json:"description"`
+	Context     string `json:"context"`
+}
+
+func (g *GitHubSource) Repo() string {
+	return g.repo
+}
+
+func (g *GitHubSource) Head(ctx context.Context) (string, error) {
+	var commit ghCommit
+	if err := g.do(ctx, http.MethodGet, "/commits/"+url.PathEscape(g.branch), "", nil, &commit); err != nil {
+		return "", fmt.Errorf("get head of %s: %w", g.branch, err)
+	}
+	return commit.SHA, nil
+}
+
+func (g *GitHubSource) Manifests(ctx context.Context, sha string) (map[string][]byte, error) {
+	var tree ghTree
+	if err := g.do(ctx, http.MethodGet, "/git/trees/"+sha+"?recursive=1", "", nil, &tree); err != nil {
+		return nil, fmt.Errorf("list tree %s: %w", sha, err)
+	}
+	if tree.Truncated {
+		return nil, fmt.Errorf("tree %s is too large to list", sha)
+	}
+
+	files := map[string][]byte{}
+	for _, entry := range tree.Tree {
+		if entry.Type != "blob" || !g.inDir(entry.Path) {
+			continue
+		}
+		if ext := path.Ext(entry.Path); ext != ".yaml" && ext != ".yml" {
+			continue
+		}
+
+		var raw bytes.Buffer
+		contentPath := fmt.Sprintf("/contents/%s?ref=%s", (&url.URL{Path: entry.Path}).EscapedPath(), sha)
+		if err := g.do(ctx, http.MethodGet, contentPath, "application/vnd.github.raw", nil, &raw); err != nil {
+			return nil, fmt.Errorf("read %s: %w", entry.Path, err)
+		}
+		files[entry.Path] = raw.Bytes()
+	}
+	return files, nil
+}
+
+func (g *GitHubSource) ReportStatus(ctx context.Context, sha, state, description string) error {
+	// GitHub rejects descriptions longer than 140 characters
+	if len(description) > 140 {
+		description = description[:137] + "..."
+	}
+	status := ghStatus{State: state, Description: description, Context: statusContext}
+	if err := g.do(ctx, http.MethodPost, "/statuses/"+sha, "", status, nil); err != nil {
+		return fmt.Errorf("report status of %s: %w", sha, err)
+	}
+	return nil
+}
+
+func (g *GitHubSource) inDir(p string) bool {
+	return g.dir == "" || strings.HasPrefix(p, g.dir+"/")
+}
+
+// do sends a request for a repo-relative path. A *bytes.Buffer out receives the raw body,
+// anything else is decoded as JSON.
+func (g *GitHubSource) do(ctx context.Context, method, repoPath, accept string, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/repos/%s%s", githubAPIBase, g.repo, repoPath), reqBody)
+	if err != nil {
+		return err
+	}
+	if accept == "" {
+		accept = "application/vnd.github+json"
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if g.token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.token)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("github api error (status %d): %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	switch out := out.(type) {
+	case nil:
+		return nil
+	case *bytes.Buffer:
+		_, err = out.ReadFrom(resp.Body)
+		return err
+	default:
+		return json.NewDecoder(resp.Body).Decode(out)
+	}
+}
@@ -0,0 +1,124 @@
+// Package gitops reconciles resources from loco manifests kept in a git repository.
+// Each manifest describes one service; changes merged to the watched branch are applied
+// through the resource and deployment services and the deployed commit is reported back.
+package gitops
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	APIVersion = "loco.dev/v1"
+	Kind       = "Application"
+)
+
+var ErrInvalidManifest = errors.New("invalid manifest")
+
+// Manifest is a service managed from git.
+type Manifest struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Metadata   Metadata `json:"metadata"`
+	Spec       Spec     `json:"spec"`
+}
+
+type Metadata struct {
+	Name        string `json:"name"`
+	WorkspaceID int64  `json:"workspaceId"`
+	// Environment is the name of the environment the resource belongs to, if any.
+	Environment string `json:"environment,omitempty"`
+}
+
+type Spec struct {
+	Region      string `json:"region"`
+	Description string `json:"description,omitempty"`
+	Domain      Domain `json:"domain"`
+	// Resource is a resource.v1.ServiceSpec. It is only used when the resource is created.
+	Resource json.RawMessage `json:"resource,omitempty"`
+	// Deployment is a deployment.v1.ServiceDeploymentSpec. A new deployment is rolled out whenever it changes.
+	Deployment json.RawMessage `json:"deployment"`
+}
+
+// Domain is either a subdomain of a platform domain or a custom domain.
+type Domain struct {
+	PlatformDomain string `json:"platformDomain,omitempty"`
+	Subdomain      string `json:"subdomain,omitempty"`
+	Domain         string `json:"domain,omitempty"`
+}
+
+// ParseManifest parses a YAML manifest, rejecting unknown fields and other versions.
+func ParseManifest(data []byte) (*Manifest, error) {
+	var m Manifest
+	if err := yaml.UnmarshalStrict(data, &m); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidManifest, err)
+	}
+	if m.APIVersion != APIVersion || m.Kind != Kind {
+		return nil, fmt.Errorf("%w: expected apiVersion %s and kind %s", ErrInvalidManifest, APIVersion, Kind)
+	}
+	if m.Metadata.Name == "" || m.Metadata.WorkspaceID == 0 {
+		return nil, fmt.Errorf("%w: metadata.name and metadata.workspaceId are required", ErrInvalidManifest)
+	}
+	if m.Spec.Region == "" {
+		return nil, fmt.Errorf("%w: spec.region is required", ErrInvalidManifest)
+	}
+	if len(m.Spec.Deployment) == 0 {
+		return nil, fmt.Errorf("%w: spec.deployment is required", ErrInvalidManifest)
+	}
+	if (m.Spec.Domain.Domain == "") == (m.Spec.Domain.Subdomain == "") {
+		return nil, fmt.Errorf("%w: spec.domain needs exactly one of subdomain or domain", ErrInvalidManifest)
+	}
+	if m.Spec.Domain.Subdomain != "" && m.Spec.Domain.PlatformDomain == "" {
+		return nil, fmt.Errorf("%w: spec.domain.platformDomain is required with a subdomain", ErrInvalidManifest)
+	}
+	return &m, nil
+}
+
+// ServiceSpec decodes spec.resource and targets it at spec.region, sized like the deployment.
+func (m *Manifest) ServiceSpec(deployment *deploymentv1.ServiceDeploymentSpec) (*resourcev1.ServiceSpec, error) {
+	spec := &resourcev1.ServiceSpec{}
+	if len(m.Spec.Resource) > 0 {
+		if err := protojson.Unmarshal(m.Spec.Resource, spec); err != nil {
+			return nil, fmt.Errorf("%w: spec.resource: %w", ErrInvalidManifest, err)
+		}
+	}
+	spec.Regions = map[string]*resourcev1.RegionTarget{
+		m.Spec.Region: {
+			Enabled:     true,
+			Primary:     true,
+			Cpu:         deployment.GetCpu(),
+			Memory:      deployment.GetMemory(),
+			MinReplicas: deployment.GetMinReplicas(),
+			MaxReplicas: deployment.GetMaxReplicas(),
+		},
+	}
+	return spec, nil
+}
+
+// DeploymentSpec decodes spec.deployment.
+func (m *Manifest) DeploymentSpec() (*deploymentv1.ServiceDeploymentSpec, error) {
+	spec := &deploymentv1.ServiceDeploymentSpec{}
+	if err := protojson.Unmarshal(m.Spec.Deployment, spec); err != nil {
+		return nil, fmt.Errorf("%w: spec.deployment: %w", ErrInvalidManifest, err)
+	}
+	return spec, nil
+}
+
+// specHash identifies a deployment spec so unchanged manifests are not redeployed.
+func specHash(region string, spec *deploymentv1.ServiceDeploymentSpec) (string, error) {
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(append([]byte(region+"\x00"), encoded...))
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,22 @@
+package gitops
+
+import "context"
+
+// Commit states reported back to the source.
+const (
+	StatePending = "pending"
+	StateSuccess = "success"
+	StateFailure = "failure"
+)
+
+// Source is a git repository of manifests.
+type Source interface {
+	// Repo names the repository, e.g. "team-loco/infra".
+	Repo() string
+	// Head returns the commit at the tip of the watched branch.
+	Head(ctx context.Context) (string, error)
+	// Manifests returns the manifest files at a commit, keyed by path.
+	Manifests(ctx context.Context, sha string) (map[string][]byte, error)
+	// ReportStatus records the outcome of applying a commit on the commit itself.
+	ReportStatus(ctx context.Context, sha, state, description string) error
+}
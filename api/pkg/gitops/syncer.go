@@ -0,0 +1,276 @@
+package gitops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm"
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	"github.com/team-loco/loco/shared/proto/deployment/v1/deploymentv1connect"
+	domainv1 "github.com/team-loco/loco/shared/proto/domain/v1"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+	"github.com/team-loco/loco/shared/proto/resource/v1/resourcev1connect"
+)
+
+// DefaultInterval is how often the syncer polls the repository when no interval is given.
+const DefaultInterval = time.Minute
+
+// Syncer applies the manifests at the head of a branch. Missing resources are created and a
+// new deployment is rolled out whenever a manifest's deployment spec changes. Changes go through
+// the resource and deployment services as the entity owning token, so they are authorized and
+// audited like any other request.
+//
+// Resources are never deleted when their manifest is removed, and spec.resource is only used
+// when a resource is first created.
+type Syncer struct {
+	queries     genDb.Querier
+	machine     *tvm.VendingMachine
+	resources   resourcev1connect.ResourceServiceHandler
+	deployments deploymentv1connect.DeploymentServiceHandler
+	source      Source
+	token       string
+	interval    time.Duration
+
+	// lastApplied is the last commit that applied cleanly, so an unchanged branch is not re-read
+	lastApplied string
+}
+
+// NewSyncer creates a Syncer that runs every interval (DefaultInterval if zero).
+// token is a loco API token the changes are made with.
+func NewSyncer(
+	queries genDb.Querier,
+	machine *tvm.VendingMachine,
+	resources resourcev1connect.ResourceServiceHandler,
+	deployments deploymentv1connect.DeploymentServiceHandler,
+	source Source,
+	token string,
+	interval time.Duration,
+) *Syncer {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Syncer{
+		queries:     queries,
+		machine:     machine,
+		resources:   resources,
+		deployments: deployments,
+		source:      source,
+		token:       token,
+		interval:    interval,
+	}
+}
+
+// Start syncs once immediately and then on every tick until ctx is canceled.
+func (s *Syncer) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting gitops syncer", "repo", s.source.Repo(), "interval", s.interval)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.sync(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Syncer) sync(ctx context.Context) {
+	sha, err := s.source.Head(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get gitops head", "repo", s.source.Repo(), "error", err)
+		return
+	}
+	if sha == s.lastApplied {
+		return
+	}
+
+	if err := s.source.ReportStatus(ctx, sha, StatePending, "applying manifests"); err != nil {
+		slog.WarnContext(ctx, "failed to report gitops status", "sha", sha, "error", err)
+	}
+
+	applied, failed := s.apply(ctx, sha)
+
+	state, description := StateSuccess, fmt.Sprintf("applied %d manifests", applied)
+	if len(failed) > 0 {
+		state, description = StateFailure, fmt.Sprintf("%d manifests failed: %v", len(failed), failed)
+	} else {
+		s.lastApplied = sha
+	}
+	slog.InfoContext(ctx, "gitops sync finished", "repo", s.source.Repo(), "sha", sha, "applied", applied, "failed", len(failed))
+
+	if err := s.source.ReportStatus(ctx, sha, state, description); err != nil {
+		slog.WarnContext(ctx, "failed to report gitops status", "sha", sha, "error", err)
+	}
+}
+
+// apply applies every manifest at sha, returning how many applied and the paths that failed
+func (s *Syncer) apply(ctx context.Context, sha string) (int, []string) {
+	entity, scopes, err := s.machine.GetToken(ctx, s.token)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to resolve gitops token", "error", err)
+		return 0, []string{"(token)"}
+	}
+	ctx = contextkeys.WithAuth(ctx, contextkeys.Auth{
+		Entity: genDb.Entity{Type: entity.Type, ID: entity.ID},
+		Scopes: scopes,
+		Token:  s.token,
+	})
+
+	files, err := s.source.Manifests(ctx, sha)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read gitops manifests", "sha", sha, "error", err)
+		return 0, []string{"(tree)"}
+	}
+
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	applied := 0
+	var failed []string
+	for _, p := range paths {
+		if err := s.applyManifest(ctx, sha, p, files[p]); err != nil {
+			slog.ErrorContext(ctx, "failed to apply manifest", "path", p, "sha", sha, "error", err)
+			failed = append(failed, p)
+			continue
+		}
+		applied++
+	}
+	return applied, failed
+}
+
+func (s *Syncer) applyManifest(ctx context.Context, sha, path string, data []byte) error {
+	m, err := ParseManifest(data)
+	if err != nil {
+		return err
+	}
+	deploymentSpec, err := m.DeploymentSpec()
+	if err != nil {
+		return err
+	}
+	hash, err := specHash(m.Spec.Region, deploymentSpec)
+	if err != nil {
+		return err
+	}
+
+	var environmentID pgtype.Int8
+	if m.Metadata.Environment != "" {
+		env, err := s.queries.GetEnvironmentByName(ctx, genDb.GetEnvironmentByNameParams{
+			WorkspaceID: m.Metadata.WorkspaceID,
+			Name:        m.Metadata.Environment,
+		})
+		if err != nil {
+			return fmt.Errorf("environment %s: %w", m.Metadata.Environment, err)
+		}
+		environmentID = pgtype.Int8{Int64: env.ID, Valid: true}
+	}
+
+	resourceID, err := s.ensureResource(ctx, m, deploymentSpec, environmentID)
+	if err != nil {
+		return err
+	}
+
+	app, err := s.queries.GetGitOpsApplication(ctx, resourceID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	if err == nil && app.SpecHash == hash {
+		return nil
+	}
+
+	deployment, err := s.deployments.CreateDeployment(ctx, connect.NewRequest(&deploymentv1.CreateDeploymentRequest{
+		ResourceId: resourceID,
+		Region:     m.Spec.Region,
+		Spec:       &deploymentv1.DeploymentSpec{Spec: &deploymentv1.DeploymentSpec_Service{Service: deploymentSpec}},
+	}))
+	if err != nil {
+		return fmt.Errorf("deploy: %w", err)
+	}
+
+	slog.InfoContext(ctx, "applied manifest", "path", path, "sha", sha, "resourceId", resourceID, "deploymentId", deployment.Msg.GetDeploymentId())
+
+	return s.queries.UpsertGitOpsApplication(ctx, genDb.UpsertGitOpsApplicationParams{
+		ResourceID:   resourceID,
+		Repo:         s.source.Repo(),
+		Path:         path,
+		CommitSha:    sha,
+		SpecHash:     hash,
+		DeploymentID: pgtype.Int8{Int64: deployment.Msg.GetDeploymentId(), Valid: true},
+	})
+}
+
+// ensureResource returns the resource a manifest describes, creating it if it does not exist yet
+func (s *Syncer) ensureResource(
+	ctx context.Context,
+	m *Manifest,
+	deploymentSpec *deploymentv1.ServiceDeploymentSpec,
+	environmentID pgtype.Int8,
+) (int64, error) {
+	existing, err := s.queries.GetResourceByNameAndWorkspace(ctx, genDb.GetResourceByNameAndWorkspaceParams{
+		WorkspaceID:   m.Metadata.WorkspaceID,
+		Name:          m.Metadata.Name,
+		EnvironmentID: environmentID,
+	})
+	if err == nil {
+		return existing.ID, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return 0, err
+	}
+
+	serviceSpec, err := m.ServiceSpec(deploymentSpec)
+	if err != nil {
+		return 0, err
+	}
+
+	domain := &domainv1.DomainInput{
+		DomainSource: domainv1.DomainType_DOMAIN_TYPE_USER_PROVIDED,
+		Domain:       &m.Spec.Domain.Domain,
+	}
+	if m.Spec.Domain.Subdomain != "" {
+		platformDomain, err := s.queries.GetPlatformDomainByName(ctx, m.Spec.Domain.PlatformDomain)
+		if err != nil {
+			return 0, fmt.Errorf("platform domain %s: %w", m.Spec.Domain.PlatformDomain, err)
+		}
+		domain = &domainv1.DomainInput{
+			DomainSource:     domainv1.DomainType_DOMAIN_TYPE_PLATFORM_PROVIDED,
+			Subdomain:        &m.Spec.Domain.Subdomain,
+			PlatformDomainId: &platformDomain.ID,
+		}
+	}
+
+	req := &resourcev1.CreateResourceRequest{
+		WorkspaceId: m.Metadata.WorkspaceID,
+		Name:        m.Metadata.Name,
+		Type:        resourcev1.ResourceType_RESOURCE_TYPE_SERVICE,
+		Domain:      domain,
+		Spec:        &resourcev1.ResourceSpec{Spec: &resourcev1.ResourceSpec_Service{Service: serviceSpec}},
+	}
+	if m.Spec.Description != "" {
+		req.Description = &m.Spec.Description
+	}
+	if environmentID.Valid {
+		req.EnvironmentId = &environmentID.Int64
+	}
+
+	created, err := s.resources.CreateResource(ctx, connect.NewRequest(req))
+	if err != nil {
+		return 0, fmt.Errorf("create resource: %w", err)
+	}
+	return created.Msg.GetResourceId(), nil
+}
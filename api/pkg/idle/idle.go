@@ -0,0 +1,159 @@
+// Package idle puts free-tier services to sleep when the gateway has seen no requests for them
+// for a while, and wakes them on their next request. A sleeping service runs no replicas and its
+// route sends requests to the API, whose Waker wakes it and answers with a page that reloads
+// until the service is back. Orgs subscribed to a plan are exempt unless the plan has idle_sleep.
+package idle
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/kube"
+	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultInterval is how often idle services are looked for when no interval is given.
+const DefaultInterval = 15 * time.Minute
+
+// Config holds the idle sleep settings. Services are put to sleep only when PrometheusURL is set.
+type Config struct {
+	PrometheusURL string        `env:"IDLE_PROMETHEUS_URL"`            // Prometheus scraping the gateway's Envoy metrics
+	SleepAfter    time.Duration `env:"IDLE_SLEEP_AFTER" default:"24h"` // how long a service goes without requests before it sleeps
+	CheckInterval time.Duration `env:"IDLE_CHECK_INTERVAL"`            // how often idle services are looked for
+}
+
+// Sleeper periodically puts to sleep the free-tier services that received no requests over the
+// last SleepAfter. Services deployed or woken within that window are left alone, and so are
+// services Prometheus has no metrics for, since their traffic is unknown.
+type Sleeper struct {
+	queries       genDb.Querier
+	kubeClient    *kube.Client
+	prometheus    *prometheus
+	locoNamespace string
+	appDomain     string
+	sleepAfter    time.Duration
+	interval      time.Duration
+}
+
+// NewSleeper creates a Sleeper that checks every cfg.CheckInterval (DefaultInterval if zero). Only
+// services reachable solely on hosts under appDomain are put to sleep, as the Waker wakes no others.
+func NewSleeper(queries genDb.Querier, kubeClient *kube.Client, httpClient *http.Client, locoNamespace, appDomain string, cfg Config) *Sleeper {
+	interval := cfg.CheckInterval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Sleeper{
+		queries:       queries,
+		kubeClient:    kubeClient,
+		prometheus:    &prometheus{httpClient: httpClient, baseURL: cfg.PrometheusURL},
+		locoNamespace: locoNamespace,
+		appDomain:     strings.ToLower(appDomain),
+		sleepAfter:    cfg.SleepAfter,
+		interval:      interval,
+	}
+}
+
+// Start checks once immediately and then every interval until ctx is canceled.
+func (s *Sleeper) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting idle sleeper", "interval", s.interval, "sleepAfter", s.sleepAfter)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.sleepIdle(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Sleeper) sleepIdle(ctx context.Context) {
+	candidates, err := s.queries.ListIdleSleepCandidates(ctx, genDb.ListIdleSleepCandidatesParams{
+		IdleFor:   pgtype.Interval{Microseconds: s.sleepAfter.Microseconds(), Valid: true},
+		AppDomain: s.appDomain,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list idle sleep candidates", "error", err)
+		return
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	requests, err := s.prometheus.routeRequests(ctx, s.sleepAfter)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to read gateway traffic", "error", err)
+		return
+	}
+
+	slept := 0
+	for _, c := range candidates {
+		route := fmt.Sprintf("wks-%d-res-%d/resource-%d-route", c.WorkspaceID, c.ResourceID, c.ResourceID)
+		n, ok := requests[route]
+		// increase() extrapolates, so a route without requests can read slightly above zero
+		if !ok || n >= 0.5 {
+			continue
+		}
+
+		rows, err := s.queries.SleepResource(ctx, c.ResourceID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to mark resource asleep", "resourceId", c.ResourceID, "error", err)
+			continue
+		}
+		if rows == 0 {
+			continue
+		}
+		if err := SetApplicationSleeping(ctx, s.kubeClient, s.locoNamespace, c.ResourceID, true); err != nil {
+			// leave the resource awake; it is tried again once it has been idle for another window
+			if _, wakeErr := s.queries.WakeResource(ctx, c.ResourceID); wakeErr != nil {
+				slog.ErrorContext(ctx, "failed to mark resource awake", "resourceId", c.ResourceID, "error", wakeErr)
+			}
+			continue
+		}
+		slept++
+	}
+	if slept > 0 {
+		slog.InfoContext(ctx, "put idle services to sleep", "count", slept)
+	}
+}
+
+// SetApplicationSleeping puts the Application of a resource to sleep or wakes it.
+// Resources that were never deployed have no Application and are left alone.
+func SetApplicationSleeping(ctx context.Context, kubeClient *kube.Client, locoNamespace string, resourceID int64, sleeping bool) error {
+	locoRes := &locoControllerV1.Application{}
+	err := kubeClient.ControllerClient.Get(ctx, client.ObjectKey{
+		Name:      fmt.Sprintf("resource-%d", resourceID),
+		Namespace: locoNamespace,
+	}, locoRes)
+	if err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil
+		}
+		slog.ErrorContext(ctx, "failed to get Application", "error", err, "resourceId", resourceID)
+		return err
+	}
+	if locoRes.Spec.Sleeping == sleeping {
+		return nil
+	}
+
+	patch := client.MergeFrom(locoRes.DeepCopy())
+	locoRes.Spec.Sleeping = sleeping
+	if err := kubeClient.ControllerClient.Patch(ctx, locoRes, patch); err != nil {
+		slog.ErrorContext(ctx, "failed to patch Application", "error", err, "resourceId", resourceID)
+		return err
+	}
+
+	slog.InfoContext(ctx, "updated Application sleep", "resourceId", resourceID, "sleeping", sleeping)
+	return nil
+}
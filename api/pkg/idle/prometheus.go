@@ -0,0 +1,77 @@
+package idle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// routeRequestsQuery counts the requests each HTTPRoute sent upstream over a window. Envoy
+// Gateway names a route's clusters httproute/<namespace>/<name>/rule/<index>.
+const routeRequestsQuery = `sum by (envoy_cluster_name) (increase(envoy_cluster_upstream_rq_total{envoy_cluster_name=~"httproute/.*"}[%s]))`
+
+// prometheus reads the gateway's Envoy metrics through the Prometheus HTTP API.
+type prometheus struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// routeRequests returns the requests each HTTPRoute received over the window, keyed by
+// "<namespace>/<name>". Routes Prometheus has no series for are missing from the map.
+func (p *prometheus) routeRequests(ctx context.Context, window time.Duration) (map[string]float64, error) {
+	query := fmt.Sprintf(routeRequestsQuery, strconv.FormatInt(int64(window.Seconds()), 10)+"s")
+	u := strings.TrimSuffix(p.baseURL, "/") + "/api/v1/query?" + url.Values{"query": {query}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("prometheus query: %w", err)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 8<<20))
+	if err != nil {
+		return nil, fmt.Errorf("prometheus query: %w", err)
+	}
+	var body struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+		Data   struct {
+			Result []struct {
+				Metric map[string]string `json:"metric"`
+				Value  [2]any            `json:"value"` // [unix time, "value"]
+			} `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, fmt.Errorf("prometheus query: %s", resp.Status)
+	}
+	if body.Status != "success" {
+		return nil, fmt.Errorf("prometheus query: %s", body.Error)
+	}
+
+	requests := make(map[string]float64, len(body.Data.Result))
+	for _, series := range body.Data.Result {
+		parts := strings.Split(series.Metric["envoy_cluster_name"], "/")
+		if len(parts) < 3 {
+			continue
+		}
+		value, _ := series.Value[1].(string)
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			continue
+		}
+		// rules of a route add up
+		requests[parts[1]+"/"+parts[2]] += n
+	}
+	return requests, nil
+}
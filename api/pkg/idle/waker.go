@@ -0,0 +1,128 @@
+package idle
+
+import (
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/kube"
+)
+
+const (
+	// wakeGrace is how long after waking requests may still reach the API while the service starts
+	wakeGrace = 3 * time.Minute
+	// retryAfter is how soon browsers reload the waking page
+	retryAfter = 5 * time.Second
+)
+
+// wakingPage reloads after retryAfter
+const wakingPage = `<!DOCTYPE html>
+<html lang="en">
+<head><meta charset="utf-8"><meta name="viewport" content="width=device-width,initial-scale=1"><meta http-equiv="refresh" content="5"><title>Waking up</title>
+<style>body{font-family:system-ui,sans-serif;background:#1b1b1b;color:#fafafa;display:flex;align-items:center;justify-content:center;min-height:100vh;margin:0}main{max-width:32rem;padding:2rem;text-align:center}h1{color:#f57900}</style>
+</head>
+<body><main><h1>Waking up</h1><p>This service was asleep after a period without traffic. It is starting and this page reloads when it is ready.</p></main></body>
+</html>
+`
+
+// Waker wakes sleeping services. Their routes send requests to the API, where Wrap recognizes
+// the service's domain, wakes it and answers with a page that reloads until it is back.
+type Waker struct {
+	queries       genDb.Querier
+	kubeClient    *kube.Client
+	locoNamespace string
+	appDomain     string
+	apiDomain     string
+}
+
+// NewWaker creates a Waker for the services on hosts under appDomain. Requests for apiDomain are
+// always the API's own.
+func NewWaker(queries genDb.Querier, kubeClient *kube.Client, locoNamespace, appDomain, apiDomain string) *Waker {
+	return &Waker{
+		queries:       queries,
+		kubeClient:    kubeClient,
+		locoNamespace: locoNamespace,
+		appDomain:     strings.ToLower(appDomain),
+		apiDomain:     strings.ToLower(apiDomain),
+	}
+}
+
+// Wrap wakes the service of a request's host when it sleeps and passes every other request to next.
+func (wk *Waker) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		domain, ok := wk.serviceDomain(r.Host)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		sleep, err := wk.queries.GetResourceSleepByDomain(ctx, domain)
+		if err != nil {
+			if !errors.Is(err, pgx.ErrNoRows) {
+				slog.ErrorContext(ctx, "failed to get resource sleep", "domain", domain, "error", err)
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		switch {
+		case sleep.AsleepAt.Valid:
+			wk.wake(r, sleep.ResourceID)
+		case sleep.WokeAt.Valid && time.Since(sleep.WokeAt.Time) < wakeGrace:
+			// the route has not moved back to the service yet
+		default:
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+		w.WriteHeader(http.StatusServiceUnavailable)
+		if r.Method != http.MethodHead {
+			_, _ = w.Write([]byte(wakingPage))
+		}
+	})
+}
+
+// wake marks the resource awake and wakes its Application. Only the request that marked it
+// awake patches the Application; when that fails the resource is marked asleep again so the
+// next request retries.
+func (wk *Waker) wake(r *http.Request, resourceID int64) {
+	ctx := r.Context()
+	rows, err := wk.queries.WakeResource(ctx, resourceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to mark resource awake", "resourceId", resourceID, "error", err)
+		return
+	}
+	if rows == 0 {
+		return
+	}
+	if err := SetApplicationSleeping(ctx, wk.kubeClient, wk.locoNamespace, resourceID, false); err != nil {
+		if _, err := wk.queries.SleepResource(ctx, resourceID); err != nil {
+			slog.ErrorContext(ctx, "failed to mark resource asleep", "resourceId", resourceID, "error", err)
+		}
+		return
+	}
+	slog.InfoContext(ctx, "woke idle service", "resourceId", resourceID, "host", r.Host)
+}
+
+// serviceDomain returns the host of a request that could be for a service: a subdomain of the app
+// domain that is not the API's own. Other hosts are passed on without a database lookup.
+func (wk *Waker) serviceDomain(host string) (string, bool) {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if wk.appDomain == "" || host == wk.apiDomain || !strings.HasSuffix(host, "."+wk.appDomain) {
+		return "", false
+	}
+	return host, true
+}
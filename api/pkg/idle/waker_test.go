@@ -0,0 +1,57 @@
+package idle
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/testutil"
+)
+
+func TestWakerHosts(t *testing.T) {
+	tests := []struct {
+		host       string
+		wantLookup string // the domain looked up, or "" when the host is passed on without one
+	}{
+		{"shop.deploy-app.com", "shop.deploy-app.com"},
+		{"Shop.Deploy-App.com:443", "shop.deploy-app.com"},
+		{"shop.deploy-app.com.", "shop.deploy-app.com"},
+		{"api.deploy-app.com", ""},
+		{"deploy-app.com", ""},
+		{"shop.example.com", ""},
+		{"deploy-app.com.example.com", ""},
+		{"evil-deploy-app.com", ""},
+		{"10.0.0.1", ""},
+		{"localhost:8000", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			store := testutil.NewStore()
+			var looked []string
+			store.GetResourceSleepByDomainFunc = func(ctx context.Context, domain string) (genDb.GetResourceSleepByDomainRow, error) {
+				looked = append(looked, domain)
+				return genDb.GetResourceSleepByDomainRow{}, pgx.ErrNoRows
+			}
+			var passed bool
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { passed = true })
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Host = tt.host
+			NewWaker(store, testutil.NewKubeClient(), "loco-system", "deploy-app.com", "api.deploy-app.com").Wrap(next).ServeHTTP(httptest.NewRecorder(), r)
+
+			if !passed {
+				t.Errorf("expected the request to be passed on")
+			}
+			if tt.wantLookup == "" && len(looked) != 0 {
+				t.Errorf("expected no lookup, got %v", looked)
+			}
+			if tt.wantLookup != "" && (len(looked) != 1 || looked[0] != tt.wantLookup) {
+				t.Errorf("expected a lookup of %s, got %v", tt.wantLookup, looked)
+			}
+		})
+	}
+}
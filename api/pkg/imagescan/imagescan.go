@@ -0,0 +1,250 @@
+// Package imagescan scans the images deployed for known vulnerabilities with Trivy, and keeps the
+// latest scan of each image in image_scans. Scans are reused for MaxAge, so redeploying an image
+// does not scan it again; a workspace that blocks critical vulnerabilities waits for the scan
+// before deploying, others deploy right away while the image is scanned in the background.
+package imagescan
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// Config holds the image scanning settings. Images are scanned only when Enabled is set. Trivy
+// reads registry credentials from TRIVY_USERNAME and TRIVY_PASSWORD in the API's environment.
+type Config struct {
+	Enabled        bool          `env:"IMAGE_SCAN_ENABLED"`
+	TrivyPath      string        `env:"TRIVY_PATH" default:"trivy"`
+	TrivyServerURL string        `env:"TRIVY_SERVER_URL"`                 // scan through a Trivy server instead of a local vulnerability database
+	Timeout        time.Duration `env:"IMAGE_SCAN_TIMEOUT" default:"5m"`  // how long one scan may take
+	MaxAge         time.Duration `env:"IMAGE_SCAN_MAX_AGE" default:"24h"` // how long a scan is reused before the image is scanned again
+}
+
+// Finding is one vulnerability found in an image, as stored in image_scans.findings.
+type Finding struct {
+	ID               string `json:"id"`
+	Package          string `json:"package"`
+	InstalledVersion string `json:"installedVersion"`
+	FixedVersion     string `json:"fixedVersion,omitempty"`
+	Severity         string `json:"severity"`
+	Title            string `json:"title,omitempty"`
+}
+
+// severityRank orders findings most severe first.
+var severityRank = map[string]int{"CRITICAL": 0, "HIGH": 1, "MEDIUM": 2, "LOW": 3}
+
+// Scanner scans images and stores their reports.
+type Scanner struct {
+	queries genDb.Querier
+	cfg     Config
+	run     func(ctx context.Context, image string) ([]byte, error)
+
+	mu       sync.Mutex
+	scanning map[string]bool // images being scanned in the background
+}
+
+// NewScanner creates a Scanner, or returns nil when scanning is not enabled.
+func NewScanner(queries genDb.Querier, cfg Config) *Scanner {
+	if !cfg.Enabled {
+		return nil
+	}
+	s := &Scanner{
+		queries:  queries,
+		cfg:      cfg,
+		scanning: map[string]bool{},
+	}
+	s.run = s.trivy
+	return s
+}
+
+// Report returns the latest scan of an image, or pgx.ErrNoRows when it was never scanned.
+func (s *Scanner) Report(ctx context.Context, image string) (genDb.ImageScan, error) {
+	return s.queries.GetImageScan(ctx, image)
+}
+
+// Stale reports whether a scan is too old, or failed, and the image should be scanned again.
+func (s *Scanner) Stale(scan genDb.ImageScan) bool {
+	return scan.Error.Valid || time.Since(scan.ScannedAt.Time) >= s.cfg.MaxAge
+}
+
+// Scan returns a scan of the image no older than MaxAge, scanning it now when there is none.
+// A scan that failed is returned with an error, and is retried on the next call.
+func (s *Scanner) Scan(ctx context.Context, image string) (genDb.ImageScan, error) {
+	scan, err := s.queries.GetImageScan(ctx, image)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return genDb.ImageScan{}, fmt.Errorf("database error: %w", err)
+	}
+	if err == nil && !s.Stale(scan) {
+		return scan, nil
+	}
+
+	scan, err = s.scan(ctx, image)
+	if err != nil {
+		return genDb.ImageScan{}, err
+	}
+	if scan.Error.Valid {
+		return scan, errors.New(scan.Error.String)
+	}
+	return scan, nil
+}
+
+// ScanInBackground scans the image unless a recent scan exists or it is already being scanned.
+func (s *Scanner) ScanInBackground(image string) {
+	s.mu.Lock()
+	if s.scanning[image] {
+		s.mu.Unlock()
+		return
+	}
+	s.scanning[image] = true
+	s.mu.Unlock()
+
+	go func() {
+		defer func() {
+			s.mu.Lock()
+			delete(s.scanning, image)
+			s.mu.Unlock()
+		}()
+		ctx := context.Background()
+		if _, err := s.Scan(ctx, image); err != nil {
+			slog.WarnContext(ctx, "background image scan failed", "image", image, "error", err)
+		}
+	}()
+}
+
+// scan runs Trivy on the image and stores the result, including a failure.
+func (s *Scanner) scan(ctx context.Context, image string) (genDb.ImageScan, error) {
+	slog.InfoContext(ctx, "scanning image", "image", image)
+
+	params := genDb.UpsertImageScanParams{Image: image, Findings: []byte("[]")}
+	findings, err := s.findings(ctx, image)
+	if err != nil {
+		slog.WarnContext(ctx, "image scan failed", "image", image, "error", err)
+		params.Error = pgtype.Text{String: err.Error(), Valid: true}
+	} else {
+		for _, f := range findings {
+			switch f.Severity {
+			case "CRITICAL":
+				params.Critical++
+			case "HIGH":
+				params.High++
+			case "MEDIUM":
+				params.Medium++
+			case "LOW":
+				params.Low++
+			}
+		}
+		if params.Findings, err = json.Marshal(findings); err != nil {
+			return genDb.ImageScan{}, err
+		}
+	}
+
+	scan, err := s.queries.UpsertImageScan(ctx, params)
+	if err != nil {
+		return genDb.ImageScan{}, fmt.Errorf("database error: %w", err)
+	}
+	slog.InfoContext(ctx, "scanned image", "image", image, "critical", scan.Critical, "high", scan.High, "failed", scan.Error.Valid)
+	return scan, nil
+}
+
+type trivyReport struct {
+	Results []struct {
+		Vulnerabilities []struct {
+			VulnerabilityID  string `json:"VulnerabilityID"`
+			PkgName          string `json:"PkgName"`
+			InstalledVersion string `json:"InstalledVersion"`
+			FixedVersion     string `json:"FixedVersion"`
+			Severity         string `json:"Severity"`
+			Title            string `json:"Title"`
+		} `json:"Vulnerabilities"`
+	} `json:"Results"`
+}
+
+// findings scans the image and returns its vulnerabilities, most severe first.
+func (s *Scanner) findings(ctx context.Context, image string) ([]Finding, error) {
+	out, err := s.run(ctx, image)
+	if err != nil {
+		return nil, err
+	}
+
+	var report trivyReport
+	if err := json.Unmarshal(out, &report); err != nil {
+		return nil, fmt.Errorf("invalid trivy report: %w", err)
+	}
+
+	findings := []Finding{}
+	for _, result := range report.Results {
+		for _, v := range result.Vulnerabilities {
+			findings = append(findings, Finding{
+				ID:               v.VulnerabilityID,
+				Package:          v.PkgName,
+				InstalledVersion: v.InstalledVersion,
+				FixedVersion:     v.FixedVersion,
+				Severity:         v.Severity,
+				Title:            v.Title,
+			})
+		}
+	}
+	slices.SortStableFunc(findings, func(a, b Finding) int {
+		return cmp.Compare(rank(a.Severity), rank(b.Severity))
+	})
+	return findings, nil
+}
+
+func rank(severity string) int {
+	if r, ok := severityRank[severity]; ok {
+		return r
+	}
+	return len(severityRank)
+}
+
+// trivy runs the Trivy CLI and returns its JSON report.
+func (s *Scanner) trivy(ctx context.Context, image string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	args := []string{"image", "--quiet", "--format", "json", "--scanners", "vuln"}
+	if s.cfg.TrivyServerURL != "" {
+		args = append(args, "--server", s.cfg.TrivyServerURL)
+	}
+	args = append(args, image)
+
+	var stderr strings.Builder
+	cmd := exec.CommandContext(ctx, s.cfg.TrivyPath, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("trivy: %w: %s", err, lastLine(msg))
+		}
+		return nil, fmt.Errorf("trivy: %w", err)
+	}
+	return out, nil
+}
+
+func lastLine(s string) string {
+	if i := strings.LastIndexByte(s, '\n'); i >= 0 {
+		return s[i+1:]
+	}
+	return s
+}
+
+// Findings decodes the findings of a scan.
+func Findings(scan genDb.ImageScan) ([]Finding, error) {
+	var findings []Finding
+	if err := json.Unmarshal(scan.Findings, &findings); err != nil {
+		return nil, fmt.Errorf("invalid findings: %w", err)
+	}
+	return findings, nil
+}
@@ -206,6 +206,26 @@ func (b *Builder) MessageFilter(pattern string) *Builder {
 	return b
 }
 
+// MessageContains filters by a plain substring of the message
+func (b *Builder) MessageContains(substr string) *Builder {
+	if substr != "" {
+		b.filters = append(b.filters, func(entry LogEntry) bool {
+			return strings.Contains(entry.Message, substr)
+		})
+	}
+	return b
+}
+
+// ExcludeContains excludes messages containing a plain substring
+func (b *Builder) ExcludeContains(substr string) *Builder {
+	if substr != "" {
+		b.filters = append(b.filters, func(entry LogEntry) bool {
+			return !strings.Contains(entry.Message, substr)
+		})
+	}
+	return b
+}
+
 // ExcludePattern excludes messages matching pattern
 func (b *Builder) ExcludePattern(pattern string) *Builder {
 	if pattern != "" {
@@ -17,6 +17,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/go-logr/logr"
+	"github.com/team-loco/loco/api/pkg/requestid"
 	locov1alpha1 "github.com/team-loco/loco/controller/api/v1alpha1"
 )
 
@@ -35,6 +36,8 @@ type Client struct {
 func NewClient(appEnv string) *Client {
 	slog.Info("Initializing Kubernetes client", "env", appEnv)
 	config := buildConfig(appEnv)
+	// calls made while serving a request carry its ID to the API server's audit log
+	config.Wrap(requestid.KubeTransport)
 
 	clientSet := buildKubeClientSet(config)
 	controllerRuntimeClient := buildControllerRuntimeClient(config)
@@ -0,0 +1,48 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Email providers.
+const (
+	ProviderSMTP = "smtp"
+	ProviderSES  = "ses"
+)
+
+// Config selects and configures the email provider.
+type Config struct {
+	EmailProvider string `env:"NOTIFY_EMAIL_PROVIDER"` // "smtp", "ses", or empty to send no email
+	EmailFrom     string `env:"NOTIFY_EMAIL_FROM" requiredWith:"NOTIFY_EMAIL_PROVIDER"`
+
+	SMTPHost     string `env:"SMTP_HOST"`
+	SMTPPort     int    `env:"SMTP_PORT"` // defaults to 587
+	SMTPUsername string `env:"SMTP_USERNAME"`
+	SMTPPassword string `env:"SMTP_PASSWORD"`
+
+	SESRegion          string `env:"SES_REGION"`
+	SESAccessKeyID     string `env:"SES_ACCESS_KEY_ID"`
+	SESSecretAccessKey string `env:"SES_SECRET_ACCESS_KEY"`
+}
+
+// NewEmailSender creates the sender cfg selects, or nil when no provider is configured.
+func NewEmailSender(cfg Config, httpClient *http.Client) (EmailSender, error) {
+	switch cfg.EmailProvider {
+	case "":
+		return nil, nil
+	case ProviderSMTP:
+		if cfg.SMTPHost == "" {
+			return nil, errors.New("SMTP_HOST is required for the smtp email provider")
+		}
+		return NewSMTPSender(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.EmailFrom), nil
+	case ProviderSES:
+		if cfg.SESRegion == "" || cfg.SESAccessKeyID == "" || cfg.SESSecretAccessKey == "" {
+			return nil, errors.New("SES_REGION, SES_ACCESS_KEY_ID and SES_SECRET_ACCESS_KEY are required for the ses email provider")
+		}
+		return NewSESSender(httpClient, cfg.SESRegion, cfg.SESAccessKeyID, cfg.SESSecretAccessKey, cfg.EmailFrom), nil
+	default:
+		return nil, fmt.Errorf("unknown email provider %q", cfg.EmailProvider)
+	}
+}
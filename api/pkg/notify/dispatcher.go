@@ -0,0 +1,170 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/slack"
+)
+
+// DefaultInterval is how often the outbox is checked when no interval is given.
+const DefaultInterval = 10 * time.Second
+
+const (
+	// maxAttempts is how many times a delivery is tried before it is given up on
+	maxAttempts = 8
+	// lease is how long a claimed delivery is left to its dispatcher before another may retry it
+	lease        = 5 * time.Minute
+	batchSize    = 50
+	sendTimeout  = 30 * time.Second
+	firstBackoff = 30 * time.Second
+	maxBackoff   = 6 * time.Hour
+)
+
+var errNoEmailSender = errors.New("no email provider is configured")
+
+// Dispatcher sends queued notifications, retrying failed deliveries with backoff.
+type Dispatcher struct {
+	queries    genDb.Querier
+	email      EmailSender
+	slack      *slack.Client
+	httpClient *http.Client
+	interval   time.Duration
+}
+
+// NewDispatcher creates a Dispatcher that checks the outbox every interval (DefaultInterval if zero).
+// email and slackClient may be nil when no provider or Slack app is configured.
+func NewDispatcher(queries genDb.Querier, email EmailSender, slackClient *slack.Client, httpClient *http.Client, interval time.Duration) *Dispatcher {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Dispatcher{
+		queries:    queries,
+		email:      email,
+		slack:      slackClient,
+		httpClient: httpClient,
+		interval:   interval,
+	}
+}
+
+// Start sends due notifications once immediately and then on every tick until ctx is canceled.
+func (d *Dispatcher) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting notification dispatcher", "interval", d.interval)
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context) {
+	deliveries, err := d.queries.ClaimNotificationDeliveries(ctx, genDb.ClaimNotificationDeliveriesParams{
+		Lease:       interval(lease),
+		MaxAttempts: maxAttempts,
+		BatchSize:   batchSize,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to claim notification deliveries", "error", err)
+		return
+	}
+
+	for _, delivery := range deliveries {
+		sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+		err := d.send(sendCtx, delivery)
+		cancel()
+
+		if err != nil {
+			slog.WarnContext(ctx, "failed to send notification",
+				"id", delivery.ID, "channel", delivery.Channel, "attempt", delivery.Attempts, "error", err)
+			if err := d.queries.MarkNotificationFailed(ctx, genDb.MarkNotificationFailedParams{
+				ID:         delivery.ID,
+				LastError:  err.Error(),
+				RetryAfter: interval(backoff(delivery.Attempts)),
+			}); err != nil {
+				slog.ErrorContext(ctx, "failed to record notification failure", "id", delivery.ID, "error", err)
+			}
+			continue
+		}
+
+		if err := d.queries.MarkNotificationSent(ctx, delivery.ID); err != nil {
+			slog.ErrorContext(ctx, "failed to record notification delivery", "id", delivery.ID, "error", err)
+		}
+	}
+}
+
+func (d *Dispatcher) send(ctx context.Context, delivery genDb.NotificationOutbox) error {
+	switch delivery.Channel {
+	case genDb.NotificationChannelEmail:
+		if d.email == nil {
+			return errNoEmailSender
+		}
+		return d.email.SendEmail(ctx, Email{To: delivery.Target, Subject: delivery.Subject, Body: delivery.Body})
+	case genDb.NotificationChannelWebhook, genDb.NotificationChannelSlack:
+		return d.postWebhook(ctx, delivery.Target, delivery.Payload)
+	case genDb.NotificationChannelSlackApp:
+		return d.postSlack(ctx, delivery.Target, delivery.Payload)
+	default:
+		return fmt.Errorf("unknown channel %q", delivery.Channel)
+	}
+}
+
+func (d *Dispatcher) postWebhook(ctx context.Context, url string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// postSlack posts a message as the Slack app. target is "team/channel"; the token is looked up at
+// send time so a reinstall takes effect for queued messages.
+func (d *Dispatcher) postSlack(ctx context.Context, target string, payload []byte) error {
+	teamID, channelID, ok := strings.Cut(target, "/")
+	if !ok {
+		return fmt.Errorf("invalid slack target %q", target)
+	}
+	token, err := d.queries.GetSlackBotToken(ctx, teamID)
+	if err != nil {
+		return fmt.Errorf("get slack bot token: %w", err)
+	}
+	return d.slack.PostMessage(ctx, token, channelID, payload)
+}
+
+// backoff doubles the wait after every attempt, up to maxBackoff
+func backoff(attempts int32) time.Duration {
+	wait := firstBackoff
+	for i := int32(1); i < attempts && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, maxBackoff)
+}
+
+func interval(d time.Duration) pgtype.Interval {
+	return pgtype.Interval{Microseconds: d.Microseconds(), Valid: true}
+}
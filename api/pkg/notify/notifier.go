@@ -0,0 +1,317 @@
+// Package notify turns platform events into in-app notifications for every recipient, and into
+// email and webhook deliveries according to each user's notification preferences. Alerts skip
+// preferences and go straight to the targets of their rule. Deployment changes and alerts are
+// also posted to a workspace's Slack channel when it has one.
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/changes"
+	"github.com/team-loco/loco/api/pkg/slack"
+)
+
+// Event is something users may be notified about.
+type Event struct {
+	Kind genDb.NotificationEvent
+	// Key identifies the occurrence, such as a deployment ID, so an event reported more than
+	// once is still delivered once per user and channel.
+	Key         string
+	WorkspaceID int64
+	UserID      int64 // when set, only this user is notified
+	Subject     string
+	Body        string
+	Data        map[string]any // added to webhook payloads and, as strings, to in-app notifications
+}
+
+// WebhookPayload is the JSON body posted to webhooks.
+type WebhookPayload struct {
+	Event       genDb.NotificationEvent `json:"event"`
+	WorkspaceID int64                   `json:"workspace_id"`
+	Subject     string                  `json:"subject"`
+	Body        string                  `json:"body"`
+	Data        map[string]any          `json:"data,omitempty"`
+}
+
+// Notifier resolves who hears about an event and queues the deliveries for the Dispatcher.
+type Notifier struct {
+	queries genDb.Querier
+	feed    *changes.Feed
+	email   bool
+}
+
+// NewNotifier creates a Notifier. Email deliveries are only queued when email is true, that is
+// when an email provider is configured. feed may be nil, in which case deployment failures are
+// not reported.
+func NewNotifier(queries genDb.Querier, feed *changes.Feed, email bool) *Notifier {
+	return &Notifier{queries: queries, feed: feed, email: email}
+}
+
+// Notify adds event to every recipient's inbox and queues deliveries for those that want them.
+// A nil Notifier does nothing.
+func (n *Notifier) Notify(ctx context.Context, event Event) error {
+	if n == nil {
+		return nil
+	}
+
+	userID := pgtype.Int8{}
+	if event.UserID != 0 {
+		userID = pgtype.Int8{Int64: event.UserID, Valid: true}
+	}
+	recipients, err := n.queries.ListNotificationRecipients(ctx, genDb.ListNotificationRecipientsParams{
+		Event:       event.Kind,
+		WorkspaceID: event.WorkspaceID,
+		UserID:      userID,
+	})
+	if err != nil {
+		return fmt.Errorf("list notification recipients: %w", err)
+	}
+
+	payload, err := json.Marshal(WebhookPayload{
+		Event:       event.Kind,
+		WorkspaceID: event.WorkspaceID,
+		Subject:     event.Subject,
+		Body:        event.Body,
+		Data:        event.Data,
+	})
+	if err != nil {
+		return err
+	}
+
+	data := make(map[string]string, len(event.Data))
+	for k, v := range event.Data {
+		data[k] = fmt.Sprint(v)
+	}
+	inboxData, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	workspaceID := pgtype.Int8{}
+	if event.WorkspaceID != 0 {
+		workspaceID = pgtype.Int8{Int64: event.WorkspaceID, Valid: true}
+	}
+
+	for _, recipient := range recipients {
+		if err := n.queries.CreateInboxNotification(ctx, genDb.CreateInboxNotificationParams{
+			UserID:      recipient.UserID,
+			DedupeKey:   fmt.Sprintf("%s:%s", event.Kind, event.Key),
+			Event:       event.Kind,
+			WorkspaceID: workspaceID,
+			Subject:     event.Subject,
+			Body:        event.Body,
+			Data:        inboxData,
+		}); err != nil {
+			return fmt.Errorf("create inbox notification: %w", err)
+		}
+		if n.email && recipient.EmailEnabled && recipient.Email != "" {
+			if err := n.enqueue(ctx, event, recipient.UserID, genDb.NotificationChannelEmail, recipient.Email, payload); err != nil {
+				return err
+			}
+		}
+		if recipient.WebhookUrl != "" {
+			if err := n.enqueue(ctx, event, recipient.UserID, genDb.NotificationChannelWebhook, recipient.WebhookUrl, payload); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (n *Notifier) enqueue(ctx context.Context, event Event, userID int64, channel genDb.NotificationChannel, target string, payload []byte) error {
+	return n.enqueueDelivery(ctx, event, fmt.Sprintf("%s:%s:%d:%s", event.Kind, event.Key, userID, channel),
+		pgtype.Int8{Int64: userID, Valid: true}, channel, target, payload)
+}
+
+// Target is a destination alerts are sent to directly, rather than through a user's preferences.
+type Target struct {
+	Channel genDb.NotificationChannel
+	Address string // email address, webhook URL or Slack incoming webhook URL
+}
+
+// NotifyTargets queues deliveries of event to each target. Slack targets get a formatted message;
+// webhooks get a WebhookPayload. Alerts also go to the Slack channel of their workspace. A nil
+// Notifier does nothing.
+func (n *Notifier) NotifyTargets(ctx context.Context, event Event, targets []Target) error {
+	if n == nil {
+		return nil
+	}
+
+	webhookPayload, err := json.Marshal(WebhookPayload{
+		Event:       event.Kind,
+		WorkspaceID: event.WorkspaceID,
+		Subject:     event.Subject,
+		Body:        event.Body,
+		Data:        event.Data,
+	})
+	if err != nil {
+		return err
+	}
+	message := alertMessage(event)
+	slackPayload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range targets {
+		payload := webhookPayload
+		switch target.Channel {
+		case genDb.NotificationChannelEmail:
+			if !n.email {
+				continue
+			}
+		case genDb.NotificationChannelSlack:
+			payload = slackPayload
+		}
+		dedupeKey := fmt.Sprintf("%s:%s:%s:%s", event.Kind, event.Key, target.Channel, target.Address)
+		if err := n.enqueueDelivery(ctx, event, dedupeKey, pgtype.Int8{}, target.Channel, target.Address, payload); err != nil {
+			return err
+		}
+	}
+
+	if event.Kind == genDb.NotificationEventAlert {
+		return n.postToChannel(ctx, event, message, func(t genDb.GetWorkspaceSlackTargetRow) bool { return t.Alerts })
+	}
+	return nil
+}
+
+// alertMessage formats an alert for Slack. The alert engine sets "state" in the event's data to
+// "firing" or "resolved".
+func alertMessage(event Event) slack.Message {
+	var fields []slack.Field
+	if condition, ok := event.Data["condition"]; ok {
+		fields = append(fields, slack.Field{Label: "Condition", Value: fmt.Sprint(condition)})
+	}
+	if value, ok := event.Data["value"]; ok {
+		fields = append(fields, slack.Field{Label: "Value", Value: fmt.Sprint(value)})
+	}
+	if deploymentID, ok := event.Data["deployment_id"]; ok {
+		fields = append(fields, slack.Field{Label: "Deployment", Value: fmt.Sprint(deploymentID)})
+	}
+	return slack.AlertMessage(event.Subject, event.Body, event.Data["state"] != "resolved", fields)
+}
+
+// postToChannel queues message for the Slack channel of the event's workspace, if it has one
+// and want accepts it.
+func (n *Notifier) postToChannel(ctx context.Context, event Event, message slack.Message, want func(genDb.GetWorkspaceSlackTargetRow) bool) error {
+	target, err := n.queries.GetWorkspaceSlackTarget(ctx, event.WorkspaceID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("get workspace slack channel: %w", err)
+	}
+	if !want(target) {
+		return nil
+	}
+
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	address := target.TeamID + "/" + target.ChannelID
+	dedupeKey := fmt.Sprintf("%s:%s:%s:%s", event.Kind, event.Key, genDb.NotificationChannelSlackApp, address)
+	return n.enqueueDelivery(ctx, event, dedupeKey, pgtype.Int8{}, genDb.NotificationChannelSlackApp, address, payload)
+}
+
+func (n *Notifier) enqueueDelivery(ctx context.Context, event Event, dedupeKey string, userID pgtype.Int8, channel genDb.NotificationChannel, target string, payload []byte) error {
+	err := n.queries.EnqueueNotification(ctx, genDb.EnqueueNotificationParams{
+		DedupeKey: dedupeKey,
+		UserID:    userID,
+		Event:     event.Kind,
+		Channel:   channel,
+		Target:    target,
+		Subject:   event.Subject,
+		Body:      event.Body,
+		Payload:   payload,
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue notification: %w", err)
+	}
+	return nil
+}
+
+// Start reports deployment changes announced on the change feed until ctx is canceled: failures
+// to users, and starts, successes and failures to Slack channels. Every replica does this; the
+// outbox's dedupe key keeps deliveries to one per change.
+func (n *Notifier) Start(ctx context.Context) error {
+	events, stop := n.feed.Subscribe(func(e changes.Event) bool {
+		if e.Table != changes.TableDeployments {
+			return false
+		}
+		switch genDb.DeploymentStatus(e.Status) {
+		case genDb.DeploymentStatusDeploying, genDb.DeploymentStatusRunning, genDb.DeploymentStatusFailed:
+			return true
+		}
+		return false
+	})
+	defer stop()
+
+	slog.InfoContext(ctx, "starting notifier")
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e := <-events:
+			if err := n.deploymentChanged(ctx, e.ID, genDb.DeploymentStatus(e.Status)); err != nil {
+				slog.ErrorContext(ctx, "failed to notify about deployment change", "deploymentId", e.ID, "status", e.Status, "error", err)
+			}
+		}
+	}
+}
+
+func (n *Notifier) deploymentChanged(ctx context.Context, deploymentID int64, status genDb.DeploymentStatus) error {
+	deployment, err := n.queries.GetDeploymentNotificationContext(ctx, deploymentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if status == genDb.DeploymentStatusFailed {
+		if err := n.deploymentFailed(ctx, deployment); err != nil {
+			return err
+		}
+	}
+
+	message := slack.DeploymentMessage(slack.Deployment{
+		ID:        deployment.DeploymentID,
+		Resource:  deployment.ResourceName,
+		Workspace: deployment.WorkspaceName,
+		Region:    deployment.Region,
+		Status:    string(status),
+		Message:   deployment.Message,
+	})
+	return n.postToChannel(ctx, Event{
+		Kind:        genDb.NotificationEventDeployment,
+		Key:         fmt.Sprintf("%d:%s", deployment.DeploymentID, status),
+		WorkspaceID: deployment.WorkspaceID,
+		Subject:     message.Text,
+	}, message, func(t genDb.GetWorkspaceSlackTargetRow) bool { return t.Deployments })
+}
+
+func (n *Notifier) deploymentFailed(ctx context.Context, deployment genDb.GetDeploymentNotificationContextRow) error {
+	body := fmt.Sprintf("Deployment %d of %s in %s failed.", deployment.DeploymentID, deployment.ResourceName, deployment.Region)
+	if deployment.Message != "" {
+		body += "\n\n" + deployment.Message
+	}
+	return n.Notify(ctx, Event{
+		Kind:        genDb.NotificationEventDeploymentFailed,
+		Key:         fmt.Sprint(deployment.DeploymentID),
+		WorkspaceID: deployment.WorkspaceID,
+		Subject:     fmt.Sprintf("Deployment of %s failed", deployment.ResourceName),
+		Body:        body,
+		Data: map[string]any{
+			"resource_id":   deployment.ResourceID,
+			"deployment_id": deployment.DeploymentID,
+			"region":        deployment.Region,
+		},
+	})
+}
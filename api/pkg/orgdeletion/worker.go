@@ -0,0 +1,126 @@
+// Package orgdeletion carries out cascading organization deletions in the background.
+package orgdeletion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// DefaultInterval is how often pending deletions are looked for when no interval is given.
+const DefaultInterval = 15 * time.Second
+
+// staleAfter is how long a running deletion may go without progress before another worker takes it over,
+// as when the replica running it was stopped.
+const staleAfter = 10 * time.Minute
+
+// Teardown removes a single resource, both its Application and its rows.
+type Teardown interface {
+	TeardownResource(ctx context.Context, resourceID int64) error
+}
+
+// Worker claims pending organization deletions one at a time, tears down each resource in the
+// organization, recording progress as it goes, and finally deletes the organization, which
+// removes its workspaces, domains and deployments with it.
+type Worker struct {
+	queries  genDb.Querier
+	teardown Teardown
+	interval time.Duration
+}
+
+// NewWorker creates a Worker that looks for deletions every interval (DefaultInterval if zero).
+func NewWorker(queries genDb.Querier, teardown Teardown, interval time.Duration) *Worker {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Worker{
+		queries:  queries,
+		teardown: teardown,
+		interval: interval,
+	}
+}
+
+// Start works through pending deletions once immediately and then on every tick until ctx is canceled.
+func (w *Worker) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting org deletion worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.drain(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain runs claimed deletions until none are left.
+func (w *Worker) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		deletion, err := w.queries.ClaimOrgDeletion(ctx, pgtype.Timestamptz{Time: time.Now().Add(-staleAfter), Valid: true})
+		if err != nil {
+			if !errors.Is(err, pgx.ErrNoRows) {
+				slog.ErrorContext(ctx, "failed to claim org deletion", "error", err)
+			}
+			return
+		}
+
+		logger := slog.With("deletionId", deletion.ID, "orgId", deletion.OrgID)
+		logger.InfoContext(ctx, "deleting org", "resources", deletion.TotalResources)
+
+		if err := w.run(ctx, deletion); err != nil {
+			if ctx.Err() != nil {
+				// left running; picked up again once stale
+				return
+			}
+			logger.ErrorContext(ctx, "org deletion failed", "error", err)
+			if err := w.queries.FailOrgDeletion(ctx, genDb.FailOrgDeletionParams{ID: deletion.ID, Error: err.Error()}); err != nil {
+				logger.ErrorContext(ctx, "failed to record org deletion failure", "error", err)
+			}
+			continue
+		}
+
+		if err := w.queries.CompleteOrgDeletion(ctx, deletion.ID); err != nil {
+			logger.ErrorContext(ctx, "failed to record org deletion completion", "error", err)
+			continue
+		}
+		logger.InfoContext(ctx, "deleted org")
+	}
+}
+
+func (w *Worker) run(ctx context.Context, deletion genDb.OrgDeletion) error {
+	// listed again on every pass in case resources were created while the deletion was pending
+	for {
+		resourceIDs, err := w.queries.ListResourceIDsForOrg(ctx, deletion.OrgID)
+		if err != nil {
+			return fmt.Errorf("list resources: %w", err)
+		}
+		if len(resourceIDs) == 0 {
+			break
+		}
+
+		for _, resourceID := range resourceIDs {
+			if err := w.teardown.TeardownResource(ctx, resourceID); err != nil {
+				return fmt.Errorf("tear down resource %d: %w", resourceID, err)
+			}
+			if err := w.queries.RecordOrgDeletionProgress(ctx, deletion.ID); err != nil {
+				return fmt.Errorf("record progress: %w", err)
+			}
+		}
+	}
+
+	if err := w.queries.DeleteOrg(ctx, deletion.OrgID); err != nil {
+		return fmt.Errorf("delete org: %w", err)
+	}
+	return nil
+}
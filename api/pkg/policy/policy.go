@@ -0,0 +1,216 @@
+// Package policy checks resources and deployments against the deploy-time policies org admins
+// define, such as required labels, allowed registries or a replica cap.
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/registry"
+	"github.com/team-loco/loco/controller/pkg/imagesig"
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	policyv1 "github.com/team-loco/loco/shared/proto/policy/v1"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var ErrInvalidRule = errors.New("invalid policy rule")
+
+// Input is what is checked: the labels and spec of a resource being created, or of the resource
+// being deployed and the deployment's spec merged with the resource's defaults.
+type Input struct {
+	Labels     map[string]string
+	Resource   *resourcev1.ServiceSpec
+	Deployment *deploymentv1.ServiceDeploymentSpec
+}
+
+// ParseRule decodes a stored rule.
+func ParseRule(data []byte) (*policyv1.PolicyRule, error) {
+	var rule policyv1.PolicyRule
+	if err := protojson.Unmarshal(data, &rule); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRule, err)
+	}
+	return &rule, nil
+}
+
+// ValidateRule checks a rule is complete before it is stored.
+func ValidateRule(rule *policyv1.PolicyRule) error {
+	switch r := rule.GetRule().(type) {
+	case *policyv1.PolicyRule_RequiredLabels:
+		if len(r.RequiredLabels.GetKeys()) == 0 {
+			return fmt.Errorf("%w: required_labels needs at least one key", ErrInvalidRule)
+		}
+		for _, key := range r.RequiredLabels.GetKeys() {
+			if key == "" {
+				return fmt.Errorf("%w: label keys cannot be empty", ErrInvalidRule)
+			}
+		}
+	case *policyv1.PolicyRule_AllowedRegistries:
+		return validateRegistries(r.AllowedRegistries)
+	case *policyv1.PolicyRule_ForbiddenRegistries:
+		return validateRegistries(r.ForbiddenRegistries)
+	case *policyv1.PolicyRule_MaxReplicas:
+		if r.MaxReplicas.GetMax() < 1 {
+			return fmt.Errorf("%w: max_replicas must be at least 1", ErrInvalidRule)
+		}
+	case *policyv1.PolicyRule_RequireHealthCheck:
+	case *policyv1.PolicyRule_RequireSignature:
+		if err := imagesig.ValidatePolicy(SignaturePolicy(r.RequireSignature)); err != nil {
+			return fmt.Errorf("%w: require_signature: %v", ErrInvalidRule, err)
+		}
+	default:
+		return fmt.Errorf("%w: a rule is required", ErrInvalidRule)
+	}
+	return nil
+}
+
+func validateRegistries(list *policyv1.RegistryList) error {
+	if len(list.GetRegistries()) == 0 {
+		return fmt.Errorf("%w: at least one registry is required", ErrInvalidRule)
+	}
+	for _, registry := range list.GetRegistries() {
+		if registry == "" || strings.Contains(registry, "://") {
+			return fmt.Errorf("%w: registry %q must be a host or repository prefix without a scheme", ErrInvalidRule, registry)
+		}
+	}
+	return nil
+}
+
+// Evaluate returns how the input breaks the policies, in policy order.
+func Evaluate(policies []genDb.Policy, in Input) ([]*policyv1.PolicyViolation, error) {
+	var violations []*policyv1.PolicyViolation
+	for _, p := range policies {
+		rule, err := ParseRule(p.Rule)
+		if err != nil {
+			return nil, fmt.Errorf("policy %d: %w", p.ID, err)
+		}
+		violate := func(field, format string, args ...any) {
+			violations = append(violations, &policyv1.PolicyViolation{
+				PolicyId:   p.ID,
+				PolicyName: p.Name,
+				Field:      field,
+				Message:    fmt.Sprintf(format, args...),
+			})
+		}
+
+		switch r := rule.GetRule().(type) {
+		case *policyv1.PolicyRule_RequiredLabels:
+			for _, key := range r.RequiredLabels.GetKeys() {
+				if _, ok := in.Labels[key]; !ok {
+					violate("labels."+key, "label %q is required", key)
+				}
+			}
+
+		case *policyv1.PolicyRule_AllowedRegistries:
+			if name, ok := imageName(in.Deployment); ok && !matchesAny(name, r.AllowedRegistries.GetRegistries()) {
+				violate("build.image", "image %s is not from an allowed registry (%s)", name, strings.Join(r.AllowedRegistries.GetRegistries(), ", "))
+			}
+
+		case *policyv1.PolicyRule_ForbiddenRegistries:
+			if name, ok := imageName(in.Deployment); ok && matchesAny(name, r.ForbiddenRegistries.GetRegistries()) {
+				violate("build.image", "image %s is from a forbidden registry", name)
+			}
+
+		case *policyv1.PolicyRule_MaxReplicas:
+			limit := r.MaxReplicas.GetMax()
+			check := func(field string, replicas int32) {
+				if replicas > limit {
+					violate(field, "%d replicas exceed the maximum of %d", replicas, limit)
+				}
+			}
+			if d := in.Deployment; d != nil {
+				check("min_replicas", d.GetMinReplicas())
+				check("max_replicas", d.GetMaxReplicas())
+				for i, schedule := range d.GetScalers().GetSchedules() {
+					check(fmt.Sprintf("scalers.schedules[%d].desired_replicas", i), schedule.GetDesiredReplicas())
+				}
+			}
+			if spec := in.Resource; spec != nil {
+				regions := make([]string, 0, len(spec.GetRegions()))
+				for region := range spec.GetRegions() {
+					regions = append(regions, region)
+				}
+				sort.Strings(regions)
+				for _, region := range regions {
+					target := spec.GetRegions()[region]
+					check("regions."+region+".min_replicas", target.GetMinReplicas())
+					check("regions."+region+".max_replicas", target.GetMaxReplicas())
+					for i, schedule := range target.GetScalers().GetSchedules() {
+						check(fmt.Sprintf("regions.%s.scalers.schedules[%d].desired_replicas", region, i), schedule.GetDesiredReplicas())
+					}
+				}
+			}
+
+		case *policyv1.PolicyRule_RequireHealthCheck:
+			if in.Deployment != nil && in.Deployment.GetHealthCheck().GetPath() == "" {
+				violate("health_check", "a health check path is required")
+			}
+
+		case *policyv1.PolicyRule_RequireSignature:
+			// needs the image's signatures from its registry; see Signatures
+		}
+	}
+	return violations, nil
+}
+
+// Signature is a policy's requirement that deployed images are signed.
+type Signature struct {
+	PolicyID   int64
+	PolicyName string
+	Rule       *policyv1.RequireSignature
+}
+
+// Signatures returns the signature requirements among the policies, in policy order. Evaluate
+// leaves them out, as checking them means fetching the image's signatures from its registry.
+func Signatures(policies []genDb.Policy) ([]Signature, error) {
+	var signatures []Signature
+	for _, p := range policies {
+		rule, err := ParseRule(p.Rule)
+		if err != nil {
+			return nil, fmt.Errorf("policy %d: %w", p.ID, err)
+		}
+		if r, ok := rule.GetRule().(*policyv1.PolicyRule_RequireSignature); ok {
+			signatures = append(signatures, Signature{PolicyID: p.ID, PolicyName: p.Name, Rule: r.RequireSignature})
+		}
+	}
+	return signatures, nil
+}
+
+// SignaturePolicy converts a signature requirement to what the verifier checks images against.
+func SignaturePolicy(rule *policyv1.RequireSignature) imagesig.Policy {
+	policy := imagesig.Policy{PublicKeys: rule.GetPublicKeys()}
+	for _, identity := range rule.GetIdentities() {
+		policy.Identities = append(policy.Identities, imagesig.Identity{
+			Issuer:        identity.GetIssuer(),
+			Subject:       identity.GetSubject(),
+			SubjectRegexp: identity.GetSubjectRegexp(),
+		})
+	}
+	return policy
+}
+
+// imageName returns the full repository name of a deployment's image, e.g. docker.io/library/nginx.
+func imageName(spec *deploymentv1.ServiceDeploymentSpec) (string, bool) {
+	if spec.GetBuild().GetImage() == "" {
+		return "", false
+	}
+	ref, err := registry.ParseReference(spec.GetBuild().GetImage())
+	if err != nil {
+		return "", false
+	}
+	return ref.Name(), true
+}
+
+// matchesAny reports whether a repository name is one of the registries or repository prefixes.
+func matchesAny(name string, registries []string) bool {
+	for _, prefix := range registries {
+		prefix = strings.TrimSuffix(prefix, "/")
+		if name == prefix || strings.HasPrefix(name, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
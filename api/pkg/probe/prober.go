@@ -0,0 +1,210 @@
+// Package probe checks each service's health from outside the cluster by requesting its health
+// path on its primary domain, recording latency and availability and degrading resources whose
+// probes keep failing.
+package probe
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/converter"
+)
+
+// DefaultInterval is how often each resource is probed when no interval is given.
+const DefaultInterval = time.Minute
+
+const (
+	// failureThreshold is how many probes in a row must fail before a resource is degraded.
+	failureThreshold = 3
+	// timeout bounds a single probe; slower responses count as failures.
+	timeout = 10 * time.Second
+	// batchSize is how many resources are claimed at a time.
+	batchSize = 50
+	// retention is how long probe results are kept, matching what status pages report on.
+	retention = 90 * 24 * time.Hour
+	// tick is how often due probes are looked for, so probes spread out over the interval.
+	tick = 10 * time.Second
+)
+
+// Prober probes every service with a primary domain once per interval. Probes are claimed from the
+// database, so each resource is probed by one replica at a time however many are running.
+type Prober struct {
+	queries    genDb.Querier
+	httpClient *http.Client
+	interval   time.Duration
+}
+
+// NewProber creates a Prober that probes each resource every interval (DefaultInterval if zero).
+func NewProber(queries genDb.Querier, httpClient *http.Client, interval time.Duration) *Prober {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Prober{
+		queries:    queries,
+		httpClient: httpClient,
+		interval:   interval,
+	}
+}
+
+// Start probes due resources once immediately and then on every tick until ctx is canceled.
+func (p *Prober) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting health prober", "interval", p.interval)
+
+	ticker := time.NewTicker(min(tick, p.interval))
+	defer ticker.Stop()
+
+	lastPrune := time.Time{}
+	for {
+		p.probeDue(ctx)
+
+		if time.Since(lastPrune) > time.Hour {
+			p.prune(ctx)
+			lastPrune = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Prober) probeDue(ctx context.Context) {
+	if err := p.queries.SyncProbeTargets(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to schedule health probes", "error", err)
+		return
+	}
+
+	for ctx.Err() == nil {
+		targets, err := p.queries.ClaimProbeTargets(ctx, genDb.ClaimProbeTargetsParams{
+			Interval:  pgtype.Interval{Microseconds: p.interval.Microseconds(), Valid: true},
+			BatchSize: batchSize,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to claim health probes", "error", err)
+			return
+		}
+		if len(targets) == 0 {
+			return
+		}
+		for _, target := range targets {
+			if ctx.Err() != nil {
+				return
+			}
+			p.probe(ctx, target)
+		}
+	}
+}
+
+func (p *Prober) probe(ctx context.Context, target genDb.ClaimProbeTargetsRow) {
+	// suspended resources serve a maintenance page and deploying ones are not expected to answer yet
+	if target.Status == genDb.ResourceStatusSuspended || target.Status == genDb.ResourceStatusDeploying {
+		return
+	}
+
+	url := "https://" + target.Domain + healthPath(target)
+	result := p.check(ctx, url)
+	if ctx.Err() != nil {
+		return
+	}
+
+	logger := slog.With("resourceId", target.ResourceID, "url", url)
+	failures, err := p.queries.RecordResourceProbe(ctx, genDb.RecordResourceProbeParams{
+		ResourceID: target.ResourceID,
+		Url:        url,
+		Ok:         result.ok,
+		StatusCode: result.statusCode,
+		LatencyMs:  int32(result.latency.Milliseconds()),
+		Error:      result.err,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to record health probe", "error", err)
+		return
+	}
+
+	switch {
+	case result.ok:
+		restored, err := p.queries.ClearResourceProbeDegraded(ctx, target.ResourceID)
+		if err != nil {
+			logger.ErrorContext(ctx, "failed to restore resource status", "error", err)
+		} else if restored > 0 {
+			logger.InfoContext(ctx, "health probes passing again, resource healthy")
+		}
+	case failures >= failureThreshold:
+		degraded, err := p.queries.MarkResourceProbeDegraded(ctx, target.ResourceID)
+		if err != nil {
+			logger.ErrorContext(ctx, "failed to degrade resource", "error", err)
+		} else if degraded > 0 {
+			logger.WarnContext(ctx, "health probes failing, resource degraded", "failures", failures, "error", result.err, "statusCode", result.statusCode)
+		}
+	}
+}
+
+type result struct {
+	ok         bool
+	statusCode int32
+	latency    time.Duration
+	err        string
+}
+
+// check requests url, counting any 2xx or 3xx response within the timeout as healthy.
+func (p *Prober) check(ctx context.Context, url string) result {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return result{err: err.Error()}
+	}
+	req.Header.Set("User-Agent", "loco-health-prober")
+
+	started := time.Now()
+	resp, err := p.httpClient.Do(req)
+	latency := time.Since(started)
+	if err != nil {
+		return result{latency: latency, err: err.Error()}
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	r := result{
+		ok:         resp.StatusCode < http.StatusBadRequest,
+		statusCode: int32(resp.StatusCode),
+		latency:    latency,
+	}
+	if !r.ok {
+		r.err = fmt.Sprintf("unexpected status %s", resp.Status)
+	}
+	return r
+}
+
+// healthPath is the resource's configured health check path, or / when it has none.
+func healthPath(target genDb.ClaimProbeTargetsRow) string {
+	spec, err := converter.DeserializeResourceSpec(target.Spec, target.Type)
+	if err != nil {
+		return "/"
+	}
+	path := spec.GetService().GetHealthCheck().GetPath()
+	if path == "" || path[0] != '/' {
+		return "/"
+	}
+	return path
+}
+
+func (p *Prober) prune(ctx context.Context) {
+	pruned, err := p.queries.DeleteResourceProbesBefore(ctx, pgtype.Timestamptz{Time: time.Now().Add(-retention), Valid: true})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to prune health probes", "error", err)
+		return
+	}
+	if pruned > 0 {
+		slog.InfoContext(ctx, "pruned health probes", "count", pruned)
+	}
+}
@@ -0,0 +1,260 @@
+// Package reconcile keeps the Applications in the cluster in line with the active deployments in the database.
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/converter"
+	"github.com/team-loco/loco/api/pkg/kube"
+	"github.com/team-loco/loco/api/pkg/registry"
+	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	crClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultInterval is how often the reconciler runs when no interval is given.
+const DefaultInterval = 10 * time.Minute
+
+// Applier writes the Application for an active deployment, creating it when it is missing.
+// env seeds the deployment's env vars, which are not stored with the deployment.
+type Applier interface {
+	ReapplyDeployment(ctx context.Context, deploymentID int64, env map[string]string) error
+}
+
+// Reconciler periodically compares active deployments with the Applications in the
+// cluster, e.g. to recover from a cluster restore. An active deployment without an
+// Application is missing; an Application running another image or region than its
+// active deployment is stale. Both are recorded in cluster_discrepancies and, when
+// repair is set, re-applied. Applications without an active deployment are only
+// reported as orphaned, never deleted.
+type Reconciler struct {
+	kubeClient    *kube.Client
+	queries       genDb.Querier
+	applier       Applier
+	locoNamespace string
+	region        string
+	interval      time.Duration
+	repair        bool
+}
+
+// NewReconciler creates a Reconciler that runs every interval (DefaultInterval if zero).
+// region is the region of the cluster kubeClient talks to; when empty, a missing
+// Application is recreated from the resource's newest active deployment.
+func NewReconciler(kubeClient *kube.Client, queries genDb.Querier, applier Applier, locoNamespace, region string, interval time.Duration, repair bool) *Reconciler {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Reconciler{
+		kubeClient:    kubeClient,
+		queries:       queries,
+		applier:       applier,
+		locoNamespace: locoNamespace,
+		region:        region,
+		interval:      interval,
+		repair:        repair,
+	}
+}
+
+// Start reconciles once immediately and then on every tick until ctx is canceled.
+func (r *Reconciler) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting cluster reconciler", "interval", r.interval, "region", r.region, "repair", r.repair)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.reconcile(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// discrepancy is a resource whose Application does not match its active deployment.
+type discrepancy struct {
+	resourceID  int64
+	workspaceID int64
+	deployment  *genDb.ListActiveDeploymentsForReconcileRow
+	app         *locoControllerV1.Application
+	kind        genDb.DiscrepancyKind
+	detail      string
+}
+
+func (r *Reconciler) reconcile(ctx context.Context) {
+	deployments, err := r.queries.ListActiveDeploymentsForReconcile(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list active deployments", "error", err)
+		return
+	}
+
+	apps := &locoControllerV1.ApplicationList{}
+	if err := r.kubeClient.ControllerClient.List(ctx, apps, crClient.InNamespace(r.locoNamespace)); err != nil {
+		slog.ErrorContext(ctx, "failed to list Applications", "error", err)
+		return
+	}
+
+	live := make(map[int64]*locoControllerV1.Application, len(apps.Items))
+	for i := range apps.Items {
+		live[apps.Items[i].Spec.ResourceId] = &apps.Items[i]
+	}
+
+	// deployments are ordered by resource, newest first
+	active := make(map[int64][]genDb.ListActiveDeploymentsForReconcileRow)
+	for _, deployment := range deployments {
+		active[deployment.ResourceID] = append(active[deployment.ResourceID], deployment)
+	}
+
+	var found []discrepancy
+	for resourceID, candidates := range active {
+		if candidates[0].Type != genDb.ResourceTypeService {
+			continue
+		}
+		if d, ok := r.compare(ctx, candidates, live[resourceID]); ok {
+			found = append(found, d)
+		}
+	}
+	for resourceID, app := range live {
+		if _, ok := active[resourceID]; ok {
+			continue
+		}
+		found = append(found, discrepancy{
+			resourceID:  resourceID,
+			workspaceID: app.Spec.WorkspaceId,
+			app:         app,
+			kind:        genDb.DiscrepancyKindOrphaned,
+			detail:      fmt.Sprintf("Application %s has no active deployment", app.Name),
+		})
+	}
+
+	resourceIDs := make([]int64, 0, len(found))
+	for _, d := range found {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := r.record(ctx, d); err != nil {
+			slog.ErrorContext(ctx, "failed to record cluster discrepancy", "resourceId", d.resourceID, "error", err)
+		}
+		resourceIDs = append(resourceIDs, d.resourceID)
+	}
+
+	resolved, err := r.queries.DeleteResolvedClusterDiscrepancies(ctx, resourceIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to clear resolved cluster discrepancies", "error", err)
+		return
+	}
+	if len(found) > 0 || resolved > 0 {
+		slog.InfoContext(ctx, "reconciled cluster", "discrepancies", len(found), "resolved", resolved)
+	}
+}
+
+// compare reports how app differs from the active deployment this cluster should be running.
+func (r *Reconciler) compare(ctx context.Context, candidates []genDb.ListActiveDeploymentsForReconcileRow, app *locoControllerV1.Application) (discrepancy, bool) {
+	deployment, ok := r.pick(candidates, app)
+	if !ok {
+		if app == nil {
+			// deployed only to other regions
+			return discrepancy{}, false
+		}
+		return discrepancy{
+			resourceID:  app.Spec.ResourceId,
+			workspaceID: app.Spec.WorkspaceId,
+			app:         app,
+			kind:        genDb.DiscrepancyKindOrphaned,
+			detail:      fmt.Sprintf("no active deployment in region %s", r.region),
+		}, true
+	}
+
+	d := discrepancy{
+		resourceID:  deployment.ResourceID,
+		workspaceID: deployment.WorkspaceID,
+		deployment:  &deployment,
+		app:         app,
+		kind:        genDb.DiscrepancyKindStale,
+	}
+
+	if app == nil {
+		d.kind = genDb.DiscrepancyKindMissing
+		d.detail = fmt.Sprintf("no Application for deployment %d", deployment.ID)
+		return d, true
+	}
+	if app.Spec.Region != deployment.Region {
+		d.detail = fmt.Sprintf("region is %s, expected %s", app.Spec.Region, deployment.Region)
+		return d, true
+	}
+
+	spec, err := converter.DeserializeDeploymentSpec(deployment.Spec, string(deployment.Type))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to deserialize deployment spec", "deploymentId", deployment.ID, "error", err)
+		return discrepancy{}, false
+	}
+	expected := registry.Pin(spec.GetService().GetBuild().GetImage(), spec.GetService().GetBuild().GetImageDigest())
+	actual := ""
+	if app.Spec.ServiceSpec != nil && app.Spec.ServiceSpec.Deployment != nil {
+		actual = app.Spec.ServiceSpec.Deployment.PinnedImage()
+	}
+	if expected != "" && expected != actual {
+		d.detail = fmt.Sprintf("image is %q, expected %q", actual, expected)
+		return d, true
+	}
+
+	return discrepancy{}, false
+}
+
+// pick chooses the deployment the Application should be running: the one for this
+// cluster's region or, without a region, the one matching the Application or the newest.
+func (r *Reconciler) pick(candidates []genDb.ListActiveDeploymentsForReconcileRow, app *locoControllerV1.Application) (genDb.ListActiveDeploymentsForReconcileRow, bool) {
+	region := r.region
+	if region == "" && app != nil {
+		region = app.Spec.Region
+	}
+	for _, candidate := range candidates {
+		if candidate.Region == region {
+			return candidate, true
+		}
+	}
+	if r.region == "" {
+		return candidates[0], true
+	}
+	return genDb.ListActiveDeploymentsForReconcileRow{}, false
+}
+
+// record repairs d when repair is set and stores it for ListClusterDiscrepancies.
+func (r *Reconciler) record(ctx context.Context, d discrepancy) error {
+	params := genDb.UpsertClusterDiscrepancyParams{
+		ResourceID:  d.resourceID,
+		WorkspaceID: d.workspaceID,
+		Kind:        d.kind,
+		Detail:      d.detail,
+	}
+	if d.deployment != nil {
+		params.DeploymentID = pgtype.Int8{Int64: d.deployment.ID, Valid: true}
+		params.Region = d.deployment.Region
+	} else if d.app != nil {
+		params.Region = d.app.Spec.Region
+	}
+
+	slog.WarnContext(ctx, "cluster discrepancy found", "resourceId", d.resourceID, "kind", d.kind, "detail", d.detail)
+
+	if r.repair && d.deployment != nil {
+		// deploy-time env vars only live on the Application, so carry over whatever is still there
+		var env map[string]string
+		if d.app != nil && d.app.Spec.ServiceSpec != nil && d.app.Spec.ServiceSpec.Deployment != nil {
+			env = d.app.Spec.ServiceSpec.Deployment.Env
+		}
+		if err := r.applier.ReapplyDeployment(ctx, d.deployment.ID, env); err != nil {
+			slog.ErrorContext(ctx, "failed to repair Application", "resourceId", d.resourceID, "deploymentId", d.deployment.ID, "error", err)
+		} else {
+			params.RepairedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+			slog.InfoContext(ctx, "repaired Application", "resourceId", d.resourceID, "deploymentId", d.deployment.ID)
+		}
+	}
+
+	return r.queries.UpsertClusterDiscrepancy(ctx, params)
+}
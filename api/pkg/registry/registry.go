@@ -0,0 +1,424 @@
+// Package registry resolves image references to the digest of their manifest through the
+// registry's HTTP API, so a deployment runs the image its tag pointed to when it was created
+// even if the tag is pushed again later.
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Config holds the settings for resolving images.
+type Config struct {
+	PinDigests bool          `env:"IMAGE_PIN_DIGESTS" default:"true"` // resolve images to digests at deployment time and refuse images the registry does not have
+	Username   string        `env:"REGISTRY_USERNAME"`                // credentials for private images, only sent to the registry of GITLAB_REGISTRY_URL
+	Password   string        `env:"REGISTRY_PASSWORD"`
+	Timeout    time.Duration `env:"REGISTRY_TIMEOUT" default:"10s"` // how long resolving one image may take
+
+	// the Fulcio roots and Rekor key keyless image signatures are checked against, as PEM files;
+	// without them only signatures made with a key are accepted
+	FulcioRootsFile string `env:"COSIGN_FULCIO_ROOTS_FILE" requiredWith:"COSIGN_REKOR_KEY_FILE"`
+	RekorKeyFile    string `env:"COSIGN_REKOR_KEY_FILE" requiredWith:"COSIGN_FULCIO_ROOTS_FILE"`
+}
+
+var (
+	ErrImageNotFound = errors.New("image not found in registry")
+	ErrAccessDenied  = errors.New("registry denied access to image")
+)
+
+// manifestTypes are the manifests asked for, so multi-platform images resolve to their index.
+var manifestTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// maxManifestBytes bounds a manifest read when the registry does not return its digest.
+const maxManifestBytes = 4 << 20
+
+// Resolver looks up the digests of images.
+type Resolver struct {
+	httpClient  *http.Client
+	cfg         Config
+	privateHost string
+}
+
+// NewResolver creates a Resolver, or returns nil when digests are not pinned. privateHost is the
+// registry the configured credentials belong to.
+func NewResolver(cfg Config, httpClient *http.Client, privateHost string) *Resolver {
+	if !cfg.PinDigests {
+		return nil
+	}
+	if u, err := url.Parse(privateHost); err == nil && u.Host != "" {
+		privateHost = u.Host
+	}
+	return &Resolver{
+		httpClient:  httpClient,
+		cfg:         cfg,
+		privateHost: privateHost,
+	}
+}
+
+// Reference is an image reference split into the parts the registry API addresses.
+type Reference struct {
+	Host       string // registry host, e.g. registry-1.docker.io
+	Repository string // e.g. library/nginx
+	Tag        string // empty when the reference has a digest
+	Digest     string // e.g. sha256:...
+}
+
+// ParseReference splits an image reference such as nginx:1.27, ghcr.io/org/app:v1 or
+// registry.example.com/app@sha256:... into its parts. Images without a tag or digest are latest.
+func ParseReference(image string) (Reference, error) {
+	var ref Reference
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, ref.Digest = name[:i], name[i+1:]
+		if !strings.HasPrefix(ref.Digest, "sha256:") {
+			return Reference{}, fmt.Errorf("invalid digest in image %q", image)
+		}
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, ref.Tag = name[:i], name[i+1:]
+	}
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = "latest"
+	}
+	if name == "" {
+		return Reference{}, fmt.Errorf("invalid image %q", image)
+	}
+
+	first, rest, found := strings.Cut(name, "/")
+	if found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		ref.Host, ref.Repository = first, rest
+	} else {
+		ref.Host, ref.Repository = "docker.io", name
+		if !found {
+			ref.Repository = "library/" + name
+		}
+	}
+	if ref.Host == "docker.io" {
+		ref.Host = "registry-1.docker.io"
+	}
+	return ref, nil
+}
+
+// Name returns the repository's full name, e.g. docker.io/library/nginx or ghcr.io/acme/app.
+func (r Reference) Name() string {
+	host := r.Host
+	if host == "registry-1.docker.io" {
+		host = "docker.io"
+	}
+	return host + "/" + r.Repository
+}
+
+// Resolve returns the digest of the manifest an image refers to: the one its tag points to now,
+// or the one it names after checking the registry has it.
+func (r *Resolver) Resolve(ctx context.Context, image string) (string, error) {
+	ref, err := ParseReference(image)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	defer cancel()
+
+	manifest := ref.Tag
+	if ref.Digest != "" {
+		manifest = ref.Digest
+	}
+	endpoint := fmt.Sprintf("https://%s/v2/%s/manifests/%s", ref.Host, ref.Repository, manifest)
+
+	resp, err := r.do(ctx, http.MethodHead, endpoint, ref, "")
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	if err := checkStatus(resp); err != nil {
+		return "", err
+	}
+	if digest := resp.Header.Get("Docker-Content-Digest"); digest != "" {
+		return digest, nil
+	}
+	if ref.Digest != "" {
+		return ref.Digest, nil
+	}
+
+	// not every registry returns the digest; it is the hash of the manifest
+	resp, err = r.do(ctx, http.MethodGet, endpoint, ref, "")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if err := checkStatus(resp); err != nil {
+		return "", err
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestBytes))
+	if err != nil {
+		return "", fmt.Errorf("failed to read manifest: %w", err)
+	}
+	sum := sha256.Sum256(body)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// The OCI source labels tying an image to the commit it was built from.
+const (
+	LabelRevision = "org.opencontainers.image.revision"
+	LabelSource   = "org.opencontainers.image.source"
+)
+
+// manifest holds the parts of an image manifest or index that lead to an image's config.
+type manifest struct {
+	Config struct {
+		Digest string `json:"digest"`
+	} `json:"config"`
+	Manifests []struct {
+		Digest   string `json:"digest"`
+		Platform *struct {
+			OS           string `json:"os"`
+			Architecture string `json:"architecture"`
+		} `json:"platform"`
+	} `json:"manifests"`
+}
+
+// Labels returns the labels set on an image's config. A multi-platform image has the labels of its
+// linux/amd64 image, or of its first one when it has none for that platform.
+func (r *Resolver) Labels(ctx context.Context, image string) (map[string]string, error) {
+	ref, err := ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	defer cancel()
+
+	reference := ref.Tag
+	if ref.Digest != "" {
+		reference = ref.Digest
+	}
+	var m manifest
+	if err := r.getJSON(ctx, fmt.Sprintf("https://%s/v2/%s/manifests/%s", ref.Host, ref.Repository, reference), ref, &m); err != nil {
+		return nil, err
+	}
+	if len(m.Manifests) > 0 {
+		reference = m.Manifests[0].Digest
+		for _, platform := range m.Manifests {
+			if platform.Platform != nil && platform.Platform.OS == "linux" && platform.Platform.Architecture == "amd64" {
+				reference = platform.Digest
+				break
+			}
+		}
+		m = manifest{}
+		if err := r.getJSON(ctx, fmt.Sprintf("https://%s/v2/%s/manifests/%s", ref.Host, ref.Repository, reference), ref, &m); err != nil {
+			return nil, err
+		}
+	}
+	if m.Config.Digest == "" {
+		return nil, fmt.Errorf("image %s has no config", image)
+	}
+
+	var config struct {
+		Config struct {
+			Labels map[string]string `json:"Labels"`
+		} `json:"config"`
+	}
+	if err := r.getJSON(ctx, fmt.Sprintf("https://%s/v2/%s/blobs/%s", ref.Host, ref.Repository, m.Config.Digest), ref, &config); err != nil {
+		return nil, err
+	}
+	return config.Config.Labels, nil
+}
+
+// Manifest returns the manifest a tag or digest names in a repository as the registry stores it.
+func (r *Resolver) Manifest(ctx context.Context, host, repository, reference string) ([]byte, error) {
+	ref := Reference{Host: host, Repository: repository}
+	return r.get(ctx, fmt.Sprintf("https://%s/v2/%s/manifests/%s", host, repository, reference), ref)
+}
+
+// Blob returns the blob with the given digest in a repository.
+func (r *Resolver) Blob(ctx context.Context, host, repository, digest string) ([]byte, error) {
+	ref := Reference{Host: host, Repository: repository}
+	return r.get(ctx, fmt.Sprintf("https://%s/v2/%s/blobs/%s", host, repository, digest), ref)
+}
+
+func (r *Resolver) get(ctx context.Context, endpoint string, ref Reference) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	defer cancel()
+
+	resp, err := r.do(ctx, http.MethodGet, endpoint, ref, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := checkStatus(resp); err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", endpoint, err)
+	}
+	return body, nil
+}
+
+// getJSON fetches a manifest or blob and decodes it into v.
+func (r *Resolver) getJSON(ctx context.Context, endpoint string, ref Reference, v any) error {
+	resp, err := r.do(ctx, http.MethodGet, endpoint, ref, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := checkStatus(resp); err != nil {
+		return err
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxManifestBytes)).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", endpoint, err)
+	}
+	return nil
+}
+
+// do sends a registry request, authenticating when the registry challenges it.
+func (r *Resolver) do(ctx context.Context, method, endpoint string, ref Reference, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", strings.Join(manifestTypes, ", "))
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("registry %s unreachable: %w", ref.Host, err)
+	}
+	if resp.StatusCode != http.StatusUnauthorized || authorization != "" {
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	authorization, err = r.authorize(ctx, ref, resp.Header.Get("WWW-Authenticate"))
+	if err != nil {
+		return nil, err
+	}
+	return r.do(ctx, method, endpoint, ref, authorization)
+}
+
+// authorize answers a registry's challenge: a bearer token for pulling the repository, fetched
+// with the credentials when the registry is the private one, or the credentials themselves.
+func (r *Resolver) authorize(ctx context.Context, ref Reference, challenge string) (string, error) {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	private := ref.Host == r.privateHost && r.cfg.Username != ""
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if !private {
+			return "", ErrAccessDenied
+		}
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(r.cfg.Username+":"+r.cfg.Password)), nil
+
+	case "bearer":
+		fields := parseChallenge(params)
+		realm, err := url.Parse(fields["realm"])
+		if err != nil || realm.Scheme == "" {
+			return "", fmt.Errorf("registry %s sent an invalid auth challenge", ref.Host)
+		}
+		query := realm.Query()
+		if service := fields["service"]; service != "" {
+			query.Set("service", service)
+		}
+		query.Set("scope", fmt.Sprintf("repository:%s:pull", ref.Repository))
+		realm.RawQuery = query.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+		if err != nil {
+			return "", err
+		}
+		if private {
+			req.SetBasicAuth(r.cfg.Username, r.cfg.Password)
+		}
+		resp, err := r.httpClient.Do(req)
+		if err != nil {
+			return "", fmt.Errorf("registry %s unreachable: %w", ref.Host, err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+			return "", ErrAccessDenied
+		}
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("registry %s token endpoint returned status %d", ref.Host, resp.StatusCode)
+		}
+		var token struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+			return "", fmt.Errorf("failed to decode registry token: %w", err)
+		}
+		if token.Token == "" {
+			token.Token = token.AccessToken
+		}
+		return "Bearer " + token.Token, nil
+
+	default:
+		return "", ErrAccessDenied
+	}
+}
+
+// parseChallenge parses the comma-separated key="value" parameters of a WWW-Authenticate header.
+func parseChallenge(params string) map[string]string {
+	fields := map[string]string{}
+	for params != "" {
+		key, rest, ok := strings.Cut(strings.TrimLeft(params, " ,"), "=")
+		if !ok {
+			break
+		}
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				break
+			}
+			value, params = rest[1:end+1], rest[end+2:]
+		} else {
+			value, params, _ = strings.Cut(rest, ",")
+		}
+		fields[strings.ToLower(strings.TrimSpace(key))] = value
+	}
+	return fields
+}
+
+func checkStatus(resp *http.Response) error {
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return ErrImageNotFound
+	case http.StatusUnauthorized, http.StatusForbidden:
+		// registries answer unauthorized for repositories that do not exist, too
+		return ErrAccessDenied
+	default:
+		return fmt.Errorf("registry returned status %d", resp.StatusCode)
+	}
+}
+
+// Pin returns the image reference by digest: repository@digest, without a tag.
+func Pin(image, digest string) string {
+	if digest == "" {
+		return image
+	}
+	name, _, _ := strings.Cut(image, "@")
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+	return name + "@" + digest
+}
@@ -0,0 +1,89 @@
+// Package requestid carries the ID of the request being served through the API and into the calls
+// it makes, so one failing request can be followed through the logs of the API, the Kubernetes
+// API server and the controller.
+package requestid
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/team-loco/loco/api/contextkeys"
+)
+
+// Header is the header a request ID is read from and echoed back in, and sent on outgoing calls.
+const Header = "X-Loco-Request-Id"
+
+// gatewayHeader is set by Envoy on every request it forwards; its ID is kept when the caller sent none.
+const gatewayHeader = "X-Request-Id"
+
+// maxLength bounds IDs taken from callers, which end up in every log line of the request.
+const maxLength = 128
+
+// FromRequest returns the ID the caller or gateway gave the request, or a new one when neither gave a usable ID.
+func FromRequest(r *http.Request) string {
+	for _, header := range []string{Header, gatewayHeader} {
+		if id := r.Header.Get(header); valid(id) {
+			return id
+		}
+	}
+	return uuid.NewString()
+}
+
+// valid accepts IDs of printable ASCII without spaces, so a caller can't forge log lines with one.
+func valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// FromContext returns the ID of the request ctx belongs to, or "" outside of one.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextkeys.RequestIDKey).(string)
+	return id
+}
+
+// auditHeader is taken by the Kubernetes API server as the audit ID of a call, so its audit log
+// entries carry the request ID.
+const auditHeader = "Audit-ID"
+
+// Transport sends the request ID of each outgoing request's context along in Header.
+func Transport(next http.RoundTripper) http.RoundTripper {
+	return roundTripper{next: orDefault(next), headers: []string{Header}}
+}
+
+// KubeTransport is Transport for calls to the Kubernetes API server, also sending the ID as the audit ID.
+func KubeTransport(next http.RoundTripper) http.RoundTripper {
+	return roundTripper{next: orDefault(next), headers: []string{Header, auditHeader}}
+}
+
+func orDefault(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		return http.DefaultTransport
+	}
+	return next
+}
+
+type roundTripper struct {
+	next    http.RoundTripper
+	headers []string
+}
+
+func (t roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	id := FromContext(req.Context())
+	if id == "" || req.Header.Get(Header) != "" {
+		return t.next.RoundTrip(req)
+	}
+	// a RoundTripper must not modify the request it was given
+	req = req.Clone(req.Context())
+	for _, header := range t.headers {
+		req.Header.Set(header, id)
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,106 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/team-loco/loco/api/contextkeys"
+)
+
+func TestFromRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    string // "" when a new ID is expected
+	}{
+		{"none given", nil, ""},
+		{"caller's", map[string]string{Header: "req-123"}, "req-123"},
+		{"gateway's", map[string]string{gatewayHeader: "3f2c8a1e-envoy"}, "3f2c8a1e-envoy"},
+		{"caller's over gateway's", map[string]string{Header: "req-123", gatewayHeader: "3f2c8a1e-envoy"}, "req-123"},
+		{"bad caller's falls back to gateway's", map[string]string{Header: "req 123", gatewayHeader: "3f2c8a1e-envoy"}, "3f2c8a1e-envoy"},
+		{"longest allowed", map[string]string{Header: strings.Repeat("a", maxLength)}, strings.Repeat("a", maxLength)},
+		{"too long", map[string]string{Header: strings.Repeat("a", maxLength+1)}, ""},
+		{"space", map[string]string{Header: "req 123"}, ""},
+		{"newline", map[string]string{Header: "req\nlevel=ERROR msg=forged"}, ""},
+		{"tab", map[string]string{Header: "req\t123"}, ""},
+		{"control character", map[string]string{Header: "req\x1b[31m"}, ""},
+		{"not ascii", map[string]string{Header: "réq-123"}, ""},
+		{"delete", map[string]string{Header: "req\x7f"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			for header, value := range tt.headers {
+				r.Header.Set(header, value)
+			}
+			got := FromRequest(r)
+			if tt.want != "" {
+				if got != tt.want {
+					t.Errorf("expected %q, got %q", tt.want, got)
+				}
+				return
+			}
+			if _, err := uuid.Parse(got); err != nil {
+				t.Errorf("expected a new uuid, got %q", got)
+			}
+		})
+	}
+
+	if a, b := FromRequest(httptest.NewRequest(http.MethodGet, "/", nil)), FromRequest(httptest.NewRequest(http.MethodGet, "/", nil)); a == b {
+		t.Errorf("expected a new ID for each request, got %q twice", a)
+	}
+}
+
+// recordHeaders keeps the headers of the last request it was sent
+type recordHeaders struct {
+	header http.Header
+}
+
+func (r *recordHeaders) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.header = req.Header
+	return httptest.NewRecorder().Result(), nil
+}
+
+func TestTransport(t *testing.T) {
+	ctx := context.WithValue(t.Context(), contextkeys.RequestIDKey, "req-123")
+
+	tests := []struct {
+		name      string
+		transport func(http.RoundTripper) http.RoundTripper
+		ctx       context.Context
+		preset    string // a Header the request already has
+		want      map[string]string
+	}{
+		{"sends the id", Transport, ctx, "", map[string]string{Header: "req-123", auditHeader: ""}},
+		{"kube also sends it as the audit id", KubeTransport, ctx, "", map[string]string{Header: "req-123", auditHeader: "req-123"}},
+		{"outside a request", KubeTransport, t.Context(), "", map[string]string{Header: "", auditHeader: ""}},
+		{"keeps a set header", KubeTransport, ctx, "other", map[string]string{Header: "other", auditHeader: ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &recordHeaders{}
+			req, err := http.NewRequestWithContext(tt.ctx, http.MethodGet, "http://loco.test/", nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.preset != "" {
+				req.Header.Set(Header, tt.preset)
+			}
+			if _, err := tt.transport(next).RoundTrip(req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for header, want := range tt.want {
+				if got := next.header.Get(header); got != want {
+					t.Errorf("expected %s %q, got %q", header, want, got)
+				}
+			}
+			if tt.preset == "" && len(req.Header) != 0 {
+				t.Errorf("expected the original request to be left alone, got %v", req.Header)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package rest
+
+import (
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// schema is an OpenAPI 3.0 schema object, kept as a map so only the keywords in use are written
+type schema = map[string]any
+
+// wellKnownSchemas describe the well-known types by their protojson form
+var wellKnownSchemas = map[protoreflect.FullName]schema{
+	"google.protobuf.Timestamp":   {"type": "string", "format": "date-time"},
+	"google.protobuf.Duration":    {"type": "string", "example": "1.5s"},
+	"google.protobuf.FieldMask":   {"type": "string", "example": "name,description"},
+	"google.protobuf.Struct":      {"type": "object", "additionalProperties": true},
+	"google.protobuf.Value":       {},
+	"google.protobuf.ListValue":   {"type": "array", "items": schema{}},
+	"google.protobuf.Empty":       {"type": "object"},
+	"google.protobuf.Any":         {"type": "object", "additionalProperties": true},
+	"google.protobuf.BoolValue":   {"type": "boolean"},
+	"google.protobuf.StringValue": {"type": "string"},
+	"google.protobuf.BytesValue":  {"type": "string", "format": "byte"},
+	"google.protobuf.Int32Value":  {"type": "integer", "format": "int32"},
+	"google.protobuf.UInt32Value": {"type": "integer", "format": "int64", "minimum": 0},
+	"google.protobuf.Int64Value":  {"type": "string", "format": "int64"},
+	"google.protobuf.UInt64Value": {"type": "string", "format": "uint64"},
+	"google.protobuf.FloatValue":  {"type": "number", "format": "float"},
+	"google.protobuf.DoubleValue": {"type": "number", "format": "double"},
+}
+
+func isWellKnown(message protoreflect.MessageDescriptor) bool {
+	_, ok := wellKnownSchemas[message.FullName()]
+	return ok
+}
+
+// buildOpenAPI describes routes as an OpenAPI 3.0 document, with a schema for every message they use
+func buildOpenAPI(routes map[string]route) schema {
+	schemas := schema{
+		"Error": schema{
+			"type": "object",
+			"properties": schema{
+				"code":    schema{"type": "string", "example": "not_found"},
+				"message": schema{"type": "string"},
+				"details": schema{"type": "array", "items": schema{"type": "object"}},
+			},
+		},
+	}
+	seen := map[protoreflect.FullName]bool{}
+
+	paths := schema{}
+	for _, rt := range routes {
+		method := rt.method
+		service := method.Parent().(protoreflect.ServiceDescriptor)
+		addMessageSchemas(schemas, seen, method.Input())
+		addMessageSchemas(schemas, seen, method.Output())
+
+		operation := func(operationID string) schema {
+			op := schema{
+				"operationId": operationID,
+				"tags":        []string{string(service.Name())},
+				"responses": schema{
+					"200": schema{
+						"description": "OK",
+						"content":     schema{"application/json": schema{"schema": ref(method.Output())}},
+					},
+					"default": schema{
+						"description": "Error",
+						"content":     schema{"application/json": schema{"schema": schema{"$ref": "#/components/schemas/Error"}}},
+					},
+				},
+			}
+			if options, ok := method.Options().(*descriptorpb.MethodOptions); ok && options.GetDeprecated() {
+				op["deprecated"] = true
+			}
+			return op
+		}
+
+		operationID := string(service.Name()) + "_" + string(method.Name())
+		post := operation(operationID)
+		post["requestBody"] = schema{
+			"required": true,
+			"content":  schema{"application/json": schema{"schema": ref(method.Input())}},
+		}
+		item := schema{"post": post}
+		if rt.read {
+			get := operation(operationID + "_Get")
+			get["parameters"] = queryParameters(method.Input())
+			item["get"] = get
+		}
+		paths[rt.path] = item
+	}
+
+	return schema{
+		"openapi": "3.0.3",
+		"info": schema{
+			"title":       "Loco API",
+			"version":     "v1",
+			"description": "The Loco Connect services as JSON over HTTP. Authenticate with a loco token as a bearer token.",
+		},
+		"paths": paths,
+		"components": schema{
+			"schemas": schemas,
+			"securitySchemes": schema{
+				"bearer": schema{"type": "http", "scheme": "bearer"},
+			},
+		},
+		"security": []schema{{"bearer": []string{}}},
+	}
+}
+
+func ref(message protoreflect.MessageDescriptor) schema {
+	if known, ok := wellKnownSchemas[message.FullName()]; ok {
+		return known
+	}
+	return schema{"$ref": "#/components/schemas/" + string(message.FullName())}
+}
+
+// addMessageSchemas adds the schema of message, and of every message its fields use, to schemas
+func addMessageSchemas(schemas schema, seen map[protoreflect.FullName]bool, message protoreflect.MessageDescriptor) {
+	if seen[message.FullName()] || isWellKnown(message) {
+		return
+	}
+	seen[message.FullName()] = true
+
+	properties := schema{}
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		properties[field.JSONName()] = fieldSchema(field)
+
+		switch {
+		case field.IsMap():
+			if value := field.MapValue(); value.Kind() == protoreflect.MessageKind {
+				addMessageSchemas(schemas, seen, value.Message())
+			}
+		case field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind:
+			addMessageSchemas(schemas, seen, field.Message())
+		}
+	}
+	schemas[string(message.FullName())] = schema{"type": "object", "properties": properties}
+}
+
+func fieldSchema(field protoreflect.FieldDescriptor) schema {
+	switch {
+	case field.IsMap():
+		return schema{"type": "object", "additionalProperties": singularSchema(field.MapValue())}
+	case field.IsList():
+		return schema{"type": "array", "items": singularSchema(field)}
+	default:
+		s := singularSchema(field)
+		if options, ok := field.Options().(*descriptorpb.FieldOptions); ok && options.GetDeprecated() {
+			s = schema{"allOf": []schema{s}, "deprecated": true}
+		}
+		return s
+	}
+}
+
+func singularSchema(field protoreflect.FieldDescriptor) schema {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return schema{"type": "boolean"}
+	case protoreflect.StringKind:
+		return schema{"type": "string"}
+	case protoreflect.BytesKind:
+		return schema{"type": "string", "format": "byte"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return schema{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return schema{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// protojson writes 64 bit integers as strings
+		return schema{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return schema{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return schema{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return schema{"type": "number", "format": "double"}
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		names := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		return schema{"type": "string", "enum": names}
+	default:
+		return ref(field.Message())
+	}
+}
+
+// queryParameters lists the fields of message a GET can set, leaving out messages and maps
+func queryParameters(message protoreflect.MessageDescriptor) []schema {
+	var parameters []schema
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.IsMap() || (field.Kind() == protoreflect.MessageKind && !isWellKnown(field.Message())) {
+			continue
+		}
+		parameters = append(parameters, schema{
+			"name":     string(field.Name()),
+			"in":       "query",
+			"schema":   fieldSchema(field),
+			"explode":  true,
+			"required": false,
+		})
+	}
+	slices.SortFunc(parameters, func(a, b schema) int {
+		return strings.Compare(a["name"].(string), b["name"].(string))
+	})
+	return parameters
+}
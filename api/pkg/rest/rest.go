@@ -0,0 +1,314 @@
+// Package rest serves the Connect services as a plain JSON-over-HTTP API with an OpenAPI
+// description, for integrations that can't speak Connect or gRPC. Nothing is generated per
+// service: routes and the OpenAPI document are derived from the service descriptors, and every
+// call is handed to the service's Connect handler as a unary JSON request, so authentication,
+// interceptors and errors behave exactly as they do for Connect clients.
+//
+// Each unary method is at /rest/{version}/{service}/{method}, with the service named after its
+// proto package and the method in kebab case, e.g. POST /rest/v1/workspace/get-workspace with the
+// request message as an application/json body; a POST with any other body is refused with 415,
+// and one without a body sends the empty message. Methods that only read, such as Get and List
+// methods, also take GET with the request's fields as query parameters:
+//
+//	curl -H "Authorization: Bearer $LOCO_TOKEN" https://api.loco.dev/rest/v1/workspace/get-workspace?workspace_id=1
+//
+// Errors are Connect's JSON errors, {"code": "not_found", "message": "..."}, with the matching
+// HTTP status. Streaming methods are not served. The document is at /rest/openapi.json.
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// BasePath is where the handler is mounted.
+const BasePath = "/rest/"
+
+// maxBodyBytes bounds request bodies before they are handed on
+const maxBodyBytes = 4 << 20
+
+// readPrefixes name the methods that only read, and so also take GET
+var readPrefixes = []string{"Get", "List", "Check", "Search"}
+
+// route is a unary method served over REST
+type route struct {
+	path      string // REST path
+	procedure string // Connect procedure, e.g. /workspace.v1.WorkspaceService/GetWorkspace
+	method    protoreflect.MethodDescriptor
+	read      bool
+}
+
+// Handler serves the REST facade, calling the Connect handlers in next.
+type Handler struct {
+	next    http.Handler
+	routes  map[string]route
+	openAPI []byte
+}
+
+// NewHandler creates a Handler for the unary methods of the named services, such as
+// "workspace.v1.WorkspaceService", whose Connect handlers are served by next. The services must
+// be registered with the global proto registry, which importing their generated code does.
+func NewHandler(next http.Handler, services ...string) (*Handler, error) {
+	h := &Handler{next: next, routes: map[string]route{}}
+
+	for _, name := range services {
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, fmt.Errorf("find service %s: %w", name, err)
+		}
+		service, ok := d.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a service", name)
+		}
+
+		base, err := servicePath(service)
+		if err != nil {
+			return nil, err
+		}
+		methods := service.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			method := methods.Get(i)
+			if method.IsStreamingClient() || method.IsStreamingServer() {
+				continue
+			}
+			r := route{
+				path:      base + "/" + kebab(string(method.Name())),
+				procedure: fmt.Sprintf("/%s/%s", service.FullName(), method.Name()),
+				method:    method,
+				read:      isRead(method),
+			}
+			if existing, ok := h.routes[r.path]; ok {
+				return nil, fmt.Errorf("%s and %s are both served at %s", existing.procedure, r.procedure, r.path)
+			}
+			h.routes[r.path] = r
+		}
+	}
+
+	openAPI, err := json.Marshal(buildOpenAPI(h.routes))
+	if err != nil {
+		return nil, fmt.Errorf("marshal openapi document: %w", err)
+	}
+	h.openAPI = openAPI
+	return h, nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == BasePath+"openapi.json" {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			writeError(w, http.StatusMethodNotAllowed, "unimplemented", "the openapi document only takes GET")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(h.openAPI)
+		return
+	}
+
+	rt, ok := h.routes[r.URL.Path]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not_found", "unknown rest endpoint")
+		return
+	}
+
+	var body []byte
+	switch {
+	case r.Method == http.MethodPost:
+		var err error
+		body, err = io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+		if err != nil {
+			writeError(w, http.StatusRequestEntityTooLarge, "resource_exhausted", "request body is too large")
+			return
+		}
+		if len(bytes.TrimSpace(body)) == 0 {
+			body = []byte("{}")
+		} else if !isJSON(r.Header.Get("Content-Type")) {
+			writeError(w, http.StatusUnsupportedMediaType, "invalid_argument", "request body must be application/json")
+			return
+		}
+	case r.Method == http.MethodGet && rt.read:
+		fields, err := queryToJSON(rt.method.Input(), r.URL.Query())
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_argument", err.Error())
+			return
+		}
+		body, err = json.Marshal(fields)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "internal", err.Error())
+			return
+		}
+	default:
+		allow := http.MethodPost
+		if rt.read {
+			allow = http.MethodGet + ", " + http.MethodPost
+		}
+		w.Header().Set("Allow", allow)
+		writeError(w, http.StatusMethodNotAllowed, "unimplemented", fmt.Sprintf("%s only takes %s", r.URL.Path, allow))
+		return
+	}
+
+	// the Connect handler sees a unary JSON call, keeping the caller's headers and context
+	call := r.Clone(r.Context())
+	call.Method = http.MethodPost
+	call.URL.Path = rt.procedure
+	call.URL.RawPath = ""
+	call.URL.RawQuery = ""
+	call.RequestURI = ""
+	call.Body = io.NopCloser(bytes.NewReader(body))
+	call.ContentLength = int64(len(body))
+	call.Header.Set("Content-Type", "application/json")
+	call.Header.Set("Connect-Protocol-Version", "1")
+	call.Header.Del("Content-Encoding")
+	h.next.ServeHTTP(w, call)
+}
+
+// isJSON reports whether a Content-Type is JSON in UTF-8, the only body the Connect handlers are
+// given
+func isJSON(contentType string) bool {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != "application/json" {
+		return false
+	}
+	charset, ok := params["charset"]
+	return !ok || strings.EqualFold(charset, "utf-8")
+}
+
+// servicePath returns the REST path of a service's methods, /rest/v1/workspace for workspace.v1
+func servicePath(service protoreflect.ServiceDescriptor) (string, error) {
+	name, version, ok := strings.Cut(string(service.ParentFile().Package()), ".")
+	if !ok || name == "" || version == "" || strings.Contains(version, ".") {
+		return "", fmt.Errorf("service %s is not in a {name}.{version} package", service.FullName())
+	}
+	return BasePath + version + "/" + name, nil
+}
+
+// isRead reports whether method only reads, by its name or its idempotency level
+func isRead(method protoreflect.MethodDescriptor) bool {
+	if options, ok := method.Options().(*descriptorpb.MethodOptions); ok &&
+		options.GetIdempotencyLevel() == descriptorpb.MethodOptions_NO_SIDE_EFFECTS {
+		return true
+	}
+	for _, prefix := range readPrefixes {
+		rest, ok := strings.CutPrefix(string(method.Name()), prefix)
+		if ok && (rest == "" || unicode.IsUpper(rune(rest[0]))) {
+			return true
+		}
+	}
+	return false
+}
+
+// kebab turns a method name into a path segment, SetResourceDomainTLSPolicy into
+// set-resource-domain-tls-policy
+func kebab(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('-')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// queryToJSON turns query parameters into the JSON form of a message. Parameters are named after
+// fields, by their proto or JSON name, with dots reaching into nested messages; repeated fields
+// take the parameter more than once. Values are left for protojson to check, except booleans,
+// which it only takes unquoted.
+func queryToJSON(message protoreflect.MessageDescriptor, query map[string][]string) (map[string]any, error) {
+	fields := map[string]any{}
+	for key, values := range query {
+		target := fields
+		desc := message
+		parts := strings.Split(key, ".")
+		for i, part := range parts {
+			field := findField(desc, part)
+			if field == nil {
+				return nil, fmt.Errorf("unknown query parameter %q", key)
+			}
+			name := field.JSONName()
+
+			if i < len(parts)-1 {
+				if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() || isWellKnown(field.Message()) {
+					return nil, fmt.Errorf("query parameter %q does not name a field", key)
+				}
+				nested, ok := target[name].(map[string]any)
+				if !ok {
+					nested = map[string]any{}
+					target[name] = nested
+				}
+				target = nested
+				desc = field.Message()
+				continue
+			}
+
+			if field.IsMap() || (field.Kind() == protoreflect.MessageKind && !isWellKnown(field.Message())) {
+				return nil, fmt.Errorf("query parameter %q must be sent in a POST body", key)
+			}
+			if !field.IsList() && len(values) > 1 {
+				return nil, fmt.Errorf("query parameter %q given more than once", key)
+			}
+			converted := make([]any, 0, len(values))
+			for _, value := range values {
+				v, err := queryValue(field, value)
+				if err != nil {
+					return nil, fmt.Errorf("query parameter %q: %w", key, err)
+				}
+				converted = append(converted, v)
+			}
+			if field.IsList() {
+				target[name] = converted
+			} else {
+				target[name] = converted[0]
+			}
+		}
+	}
+	return fields, nil
+}
+
+func findField(message protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if field := message.Fields().ByName(protoreflect.Name(name)); field != nil {
+		return field
+	}
+	return message.Fields().ByJSONName(name)
+}
+
+func queryValue(field protoreflect.FieldDescriptor, value string) (any, error) {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return strconv.ParseBool(value)
+	case protoreflect.EnumKind:
+		// protojson takes enum values by name, or by number unquoted
+		if n, err := strconv.ParseInt(value, 10, 32); err == nil {
+			return n, nil
+		}
+		return value, nil
+	case protoreflect.MessageKind:
+		if field.Message().FullName() == "google.protobuf.BoolValue" {
+			return strconv.ParseBool(value)
+		}
+		return value, nil
+	default:
+		return value, nil
+	}
+}
+
+// writeError writes an error in the shape Connect gives its JSON errors
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"code": code, "message": message})
+}
@@ -0,0 +1,189 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	workspacev1 "github.com/team-loco/loco/shared/proto/workspace/v1"
+	"github.com/team-loco/loco/shared/proto/workspace/v1/workspacev1connect"
+)
+
+// workspaces answers GetWorkspace with the workspace asked for, or with the error of the code
+// whose number is the workspace id when it is below 100, and echoes the other requests it takes
+type workspaces struct {
+	workspacev1connect.UnimplementedWorkspaceServiceHandler
+}
+
+func (workspaces) GetWorkspace(ctx context.Context, req *connect.Request[workspacev1.GetWorkspaceRequest]) (*connect.Response[workspacev1.GetWorkspaceResponse], error) {
+	if id := req.Msg.GetWorkspaceId(); id > 0 && id < 100 {
+		return nil, connect.NewError(connect.Code(id), errors.New("failed"))
+	}
+	return connect.NewResponse(&workspacev1.GetWorkspaceResponse{Workspace: &workspacev1.Workspace{
+		Id:   req.Msg.GetWorkspaceId(),
+		Name: req.Header().Get("Authorization"),
+	}}), nil
+}
+
+func (workspaces) CreateWorkspace(ctx context.Context, req *connect.Request[workspacev1.CreateWorkspaceRequest]) (*connect.Response[workspacev1.CreateWorkspaceResponse], error) {
+	return connect.NewResponse(&workspacev1.CreateWorkspaceResponse{Workspace: &workspacev1.Workspace{
+		OrgId: req.Msg.GetOrgId(),
+		Name:  req.Msg.GetName(),
+	}}), nil
+}
+
+func (workspaces) ListUserWorkspaces(ctx context.Context, req *connect.Request[workspacev1.ListUserWorkspacesRequest]) (*connect.Response[workspacev1.ListUserWorkspacesResponse], error) {
+	return connect.NewResponse(&workspacev1.ListUserWorkspacesResponse{
+		NextPageToken: fmt.Sprintf("%d/%d/%v", req.Msg.GetUserId(), req.Msg.GetPageSize(), req.Msg.GetIncludeArchived()),
+	}), nil
+}
+
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(workspacev1connect.NewWorkspaceServiceHandler(workspaces{}))
+	h, err := NewHandler(mux, workspacev1connect.WorkspaceServiceName)
+	if err != nil {
+		t.Fatalf("unexpected error creating handler: %v", err)
+	}
+	return h
+}
+
+func serve(h http.Handler, method, target, contentType, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Authorization", "Bearer token")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestKebab(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"GetWorkspace", "get-workspace"},
+		{"SetResourceDomainTLSPolicy", "set-resource-domain-tls-policy"},
+		{"ListAPIKeys", "list-api-keys"},
+		{"ListV2Things", "list-v2-things"},
+		{"Get", "get"},
+	}
+	for _, tt := range tests {
+		if got := kebab(tt.name); got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	h := newTestHandler(t)
+
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		contentType string
+		body        string
+		wantStatus  int
+		wantBody    string // a substring of the response
+	}{
+		{"post", "POST", "/rest/v1/workspace/get-workspace", "application/json", `{"workspaceId": "200"}`, 200, `"id":"200"`},
+		{"post keeps headers", "POST", "/rest/v1/workspace/get-workspace", "application/json", `{"workspace_id": 200}`, 200, `"name":"Bearer token"`},
+		{"post with charset", "POST", "/rest/v1/workspace/create-workspace", "application/json; charset=utf-8", `{"orgId": 3, "name": "ops"}`, 200, `"name":"ops"`},
+		{"post without a body", "POST", "/rest/v1/workspace/list-user-workspaces", "", "", 200, `"nextPageToken":"0/0/false"`},
+		{"post as text", "POST", "/rest/v1/workspace/create-workspace", "text/plain", `{"orgId": 3, "name": "ops"}`, 415, `"code":"invalid_argument"`},
+		{"post as a form", "POST", "/rest/v1/workspace/create-workspace", "application/x-www-form-urlencoded", "orgId=3&name=ops", 415, `"code":"invalid_argument"`},
+		{"post without a content type", "POST", "/rest/v1/workspace/create-workspace", "", `{"orgId": 3}`, 415, `"code":"invalid_argument"`},
+		{"post in another charset", "POST", "/rest/v1/workspace/create-workspace", "application/json; charset=latin1", `{"orgId": 3}`, 415, `"code":"invalid_argument"`},
+		{"get", "GET", "/rest/v1/workspace/get-workspace?workspace_id=200", "", "", 200, `"id":"200"`},
+		{"get by json name", "GET", "/rest/v1/workspace/list-user-workspaces?userId=7&page_size=20&include_archived=true", "", "", 200, `"nextPageToken":"7/20/true"`},
+		{"get an unknown parameter", "GET", "/rest/v1/workspace/get-workspace?id=200", "", "", 400, "unknown query parameter"},
+		{"get a repeated parameter", "GET", "/rest/v1/workspace/get-workspace?workspace_id=1&workspace_id=2", "", "", 400, "more than once"},
+		{"get a method that writes", "GET", "/rest/v1/workspace/create-workspace?org_id=3", "", "", 405, `"code":"unimplemented"`},
+		{"delete", "DELETE", "/rest/v1/workspace/get-workspace", "", "", 405, `"code":"unimplemented"`},
+		{"unknown method", "POST", "/rest/v1/workspace/get-workspaces", "application/json", "{}", 404, `"code":"not_found"`},
+		{"unknown service", "POST", "/rest/v1/nope/get-workspace", "application/json", "{}", 404, `"code":"not_found"`},
+		{"connect path", "POST", "/workspace.v1.WorkspaceService/GetWorkspace", "application/json", "{}", 404, `"code":"not_found"`},
+		{"openapi", "GET", "/rest/openapi.json", "", "", 200, `"/rest/v1/workspace/get-workspace"`},
+		{"openapi post", "POST", "/rest/openapi.json", "application/json", "{}", 405, `"code":"unimplemented"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(h, tt.method, tt.target, tt.contentType, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("expected the response to contain %s, got %s", tt.wantBody, rec.Body)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+				t.Errorf("expected an application/json response, got %q", ct)
+			}
+		})
+	}
+
+	t.Run("allow header", func(t *testing.T) {
+		if allow := serve(h, "PUT", "/rest/v1/workspace/get-workspace", "", "").Header().Get("Allow"); allow != "GET, POST" {
+			t.Errorf("expected a read to allow GET, POST, got %q", allow)
+		}
+		if allow := serve(h, "PUT", "/rest/v1/workspace/create-workspace", "", "").Header().Get("Allow"); allow != "POST" {
+			t.Errorf("expected a write to allow POST, got %q", allow)
+		}
+	})
+}
+
+func TestErrorStatus(t *testing.T) {
+	h := newTestHandler(t)
+
+	tests := []struct {
+		code       connect.Code
+		wantStatus int
+	}{
+		{connect.CodeInvalidArgument, http.StatusBadRequest},
+		{connect.CodeFailedPrecondition, http.StatusBadRequest},
+		{connect.CodeUnauthenticated, http.StatusUnauthorized},
+		{connect.CodePermissionDenied, http.StatusForbidden},
+		{connect.CodeNotFound, http.StatusNotFound},
+		{connect.CodeAlreadyExists, http.StatusConflict},
+		{connect.CodeAborted, http.StatusConflict},
+		{connect.CodeResourceExhausted, http.StatusTooManyRequests},
+		{connect.CodeUnimplemented, http.StatusNotImplemented},
+		{connect.CodeInternal, http.StatusInternalServerError},
+		{connect.CodeUnavailable, http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		for _, method := range []string{"GET", "POST"} {
+			target := fmt.Sprintf("/rest/v1/workspace/get-workspace?workspace_id=%d", tt.code)
+			body := ""
+			if method == "POST" {
+				target = "/rest/v1/workspace/get-workspace"
+				body = fmt.Sprintf(`{"workspaceId": %d}`, tt.code)
+			}
+			rec := serve(h, method, target, "application/json", body)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("%s %s: expected status %d, got %d", method, tt.code, tt.wantStatus, rec.Code)
+			}
+			var got struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil || got.Code != tt.code.String() {
+				t.Errorf("%s %s: expected a connect error with code %q, got %s", method, tt.code, tt.code, rec.Body)
+			}
+		}
+	}
+
+	rec := serve(h, "POST", "/rest/v1/workspace/delete-workspace", "application/json", "{}")
+	if rec.Code != http.StatusNotImplemented {
+		t.Errorf("expected an unimplemented method to be %d, got %d", http.StatusNotImplemented, rec.Code)
+	}
+}
@@ -0,0 +1,60 @@
+// Package retention enforces per-workspace history limits on deployments.
+package retention
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// DefaultInterval is how often the pruner runs when no interval is given.
+const DefaultInterval = time.Hour
+
+// Pruner periodically deletes inactive deployments that fall outside their
+// workspace's deployment_history_limit. Active deployments are never pruned.
+type Pruner struct {
+	queries  genDb.Querier
+	interval time.Duration
+}
+
+// NewPruner creates a Pruner that runs every interval (DefaultInterval if zero).
+func NewPruner(queries genDb.Querier, interval time.Duration) *Pruner {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Pruner{
+		queries:  queries,
+		interval: interval,
+	}
+}
+
+// Start prunes once immediately and then on every tick until ctx is canceled.
+func (p *Pruner) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting deployment history pruner", "interval", p.interval)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.prune(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Pruner) prune(ctx context.Context) {
+	pruned, err := p.queries.PruneDeploymentHistory(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to prune deployment history", "error", err)
+		return
+	}
+	if pruned > 0 {
+		slog.InfoContext(ctx, "pruned deployment history", "deleted", pruned)
+	}
+}
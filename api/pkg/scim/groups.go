@@ -0,0 +1,450 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+var (
+	ErrUnknownMember = errors.New("unknown group member")
+	errMemberLookup  = errors.New("look up group member")
+)
+
+type groupMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type groupResource struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	ExternalID  string        `json:"externalId,omitempty"`
+	DisplayName string        `json:"displayName"`
+	Members     []groupMember `json:"members"`
+	Meta        *meta         `json:"meta,omitempty"`
+}
+
+func (h *Handler) toGroupResource(ctx context.Context, g genDb.ScimGroup) (groupResource, error) {
+	members, err := h.queries.ListScimGroupMembers(ctx, g.ID)
+	if err != nil {
+		return groupResource{}, fmt.Errorf("list members of group %d: %w", g.ID, err)
+	}
+	res := groupResource{
+		Schemas:     []string{schemaGroup},
+		ID:          strconv.FormatInt(g.ID, 10),
+		ExternalID:  g.ExternalID.String,
+		DisplayName: g.DisplayName,
+		Members:     make([]groupMember, 0, len(members)),
+		Meta: &meta{
+			ResourceType: "Group",
+			Created:      g.CreatedAt.Time.UTC().Format(timeFormat),
+			LastModified: g.UpdatedAt.Time.UTC().Format(timeFormat),
+			Location:     fmt.Sprintf("%sorgs/%d/Groups/%d", BasePath, g.OrgID, g.ID),
+		},
+	}
+	for _, m := range members {
+		res.Members = append(res.Members, groupMember{Value: strconv.FormatInt(m.ScimUserID, 10), Display: m.UserName})
+	}
+	return res, nil
+}
+
+func (h *Handler) listGroups(w http.ResponseWriter, r *http.Request, orgID int64) {
+	attr, value, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+		return
+	}
+	var displayNameFilter, externalIDFilter pgtype.Text
+	switch attr {
+	case "":
+	case "displayname":
+		displayNameFilter = pgtype.Text{String: value, Valid: true}
+	case "externalid":
+		externalIDFilter = pgtype.Text{String: value, Valid: true}
+	default:
+		writeError(w, http.StatusBadRequest, "invalidFilter", fmt.Sprintf("cannot filter groups by %q", attr))
+		return
+	}
+
+	startIndex, count := page(r)
+	total, err := h.queries.CountScimGroups(r.Context(), genDb.CountScimGroupsParams{
+		OrgID:       orgID,
+		DisplayName: displayNameFilter,
+		ExternalID:  externalIDFilter,
+	})
+	if err != nil {
+		writeInternal(w, r, "failed to count groups", err)
+		return
+	}
+	groups, err := h.queries.ListScimGroups(r.Context(), genDb.ListScimGroupsParams{
+		OrgID:       orgID,
+		DisplayName: displayNameFilter,
+		ExternalID:  externalIDFilter,
+		Offset:      int32(startIndex - 1),
+		Limit:       int32(count),
+	})
+	if err != nil {
+		writeInternal(w, r, "failed to list groups", err)
+		return
+	}
+
+	resources := make([]any, 0, len(groups))
+	for _, g := range groups {
+		res, err := h.toGroupResource(r.Context(), g)
+		if err != nil {
+			writeInternal(w, r, "failed to read group", err)
+			return
+		}
+		resources = append(resources, res)
+	}
+	writeJSON(w, http.StatusOK, listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (h *Handler) getGroup(w http.ResponseWriter, r *http.Request, orgID int64) {
+	g, ok := h.lookupGroup(w, r, orgID)
+	if !ok {
+		return
+	}
+	h.writeGroup(w, r, http.StatusOK, g)
+}
+
+func (h *Handler) createGroup(w http.ResponseWriter, r *http.Request, orgID int64) {
+	var req groupResource
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	if req.DisplayName == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "displayName is required")
+		return
+	}
+	members, err := h.memberIDs(r.Context(), orgID, req.Members)
+	if err != nil {
+		writeMemberError(w, r, err)
+		return
+	}
+
+	g, err := h.queries.CreateScimGroup(r.Context(), genDb.CreateScimGroupParams{
+		OrgID:       orgID,
+		DisplayName: req.DisplayName,
+		ExternalID:  pgtype.Text{String: req.ExternalID, Valid: req.ExternalID != ""},
+	})
+	if isUniqueViolation(err) {
+		writeError(w, http.StatusConflict, "uniqueness", "a group with this displayName already exists")
+		return
+	}
+	if err != nil {
+		writeInternal(w, r, "failed to create group", err)
+		return
+	}
+	h.updateGroup(w, r, http.StatusCreated, g, groupUpdate{displayName: g.DisplayName, externalID: req.ExternalID, members: members})
+}
+
+func (h *Handler) replaceGroup(w http.ResponseWriter, r *http.Request, orgID int64) {
+	g, ok := h.lookupGroup(w, r, orgID)
+	if !ok {
+		return
+	}
+	var req groupResource
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	if req.DisplayName == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "displayName is required")
+		return
+	}
+	members, err := h.memberIDs(r.Context(), orgID, req.Members)
+	if err != nil {
+		writeMemberError(w, r, err)
+		return
+	}
+	h.updateGroup(w, r, http.StatusOK, g, groupUpdate{displayName: req.DisplayName, externalID: req.ExternalID, members: members})
+}
+
+func (h *Handler) patchGroup(w http.ResponseWriter, r *http.Request, orgID int64) {
+	g, ok := h.lookupGroup(w, r, orgID)
+	if !ok {
+		return
+	}
+	var req patchRequest
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	current, err := h.currentMembers(r.Context(), g.ID)
+	if err != nil {
+		writeInternal(w, r, "failed to list group members", err)
+		return
+	}
+
+	update := groupUpdate{displayName: g.DisplayName, externalID: g.ExternalID.String, members: current}
+	for _, op := range req.Operations {
+		if err := h.applyGroupPatch(r.Context(), orgID, &update, strings.ToLower(op.Op), op.Path, op.Value); err != nil {
+			writeMemberError(w, r, err)
+			return
+		}
+	}
+	h.updateGroup(w, r, http.StatusOK, g, update)
+}
+
+func (h *Handler) deleteGroup(w http.ResponseWriter, r *http.Request, orgID int64) {
+	g, ok := h.lookupGroup(w, r, orgID)
+	if !ok {
+		return
+	}
+	ctx := r.Context()
+	members, err := h.currentMembers(ctx, g.ID)
+	if err != nil {
+		writeInternal(w, r, "failed to list group members", err)
+		return
+	}
+	if err := h.queries.DeleteScimGroup(ctx, g.ID); err != nil {
+		writeInternal(w, r, "failed to delete group", err)
+		return
+	}
+	// the members lose whatever the group gave them
+	if err := h.directory.syncUsers(ctx, orgID, members); err != nil {
+		writeInternal(w, r, "failed to sync group members", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// groupUpdate is the desired state of a group.
+type groupUpdate struct {
+	displayName string
+	externalID  string
+	members     []int64
+}
+
+// applyGroupPatch applies one PatchOp operation to update.
+func (h *Handler) applyGroupPatch(ctx context.Context, orgID int64, update *groupUpdate, op, path string, value json.RawMessage) error {
+	if op != "add" && op != "replace" && op != "remove" {
+		return fmt.Errorf("unsupported patch op %q", op)
+	}
+	lowerPath := strings.ToLower(path)
+
+	// remove a single member: members[value eq "42"]
+	if filter, ok := strings.CutPrefix(lowerPath, "members["); ok {
+		if op != "remove" {
+			return fmt.Errorf("unsupported patch op %q for path %q", op, path)
+		}
+		attr, id, err := parseFilter(strings.TrimSuffix(path[len("members["):], "]"))
+		if err != nil || attr != "value" || !strings.HasSuffix(filter, "]") {
+			return fmt.Errorf("unsupported path %q", path)
+		}
+		memberID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %q", ErrUnknownMember, id)
+		}
+		update.members = slices.DeleteFunc(update.members, func(m int64) bool { return m == memberID })
+		return nil
+	}
+
+	switch lowerPath {
+	case "":
+		// the value is an object of attributes to set
+		var attrs map[string]json.RawMessage
+		if err := json.Unmarshal(value, &attrs); err != nil {
+			return fmt.Errorf("a patch without path needs an object value: %w", err)
+		}
+		for attr, v := range attrs {
+			if err := h.applyGroupPatch(ctx, orgID, update, op, attr, v); err != nil {
+				return err
+			}
+		}
+	case "displayname":
+		if op == "remove" {
+			return fmt.Errorf("displayName cannot be removed")
+		}
+		if err := json.Unmarshal(value, &update.displayName); err != nil || update.displayName == "" {
+			return fmt.Errorf("displayName must be a non-empty string")
+		}
+	case "externalid":
+		if op == "remove" {
+			update.externalID = ""
+			return nil
+		}
+		if err := json.Unmarshal(value, &update.externalID); err != nil {
+			return fmt.Errorf("externalId: %w", err)
+		}
+	case "members":
+		var members []groupMember
+		if len(value) > 0 && string(value) != "null" {
+			if err := json.Unmarshal(value, &members); err != nil {
+				return fmt.Errorf("members must be a list: %w", err)
+			}
+		}
+		ids, err := h.memberIDs(ctx, orgID, members)
+		if err != nil {
+			return err
+		}
+		switch {
+		case op == "replace":
+			update.members = ids
+		case op == "add":
+			for _, id := range ids {
+				if !slices.Contains(update.members, id) {
+					update.members = append(update.members, id)
+				}
+			}
+		case len(ids) == 0:
+			// removing members without a value removes all of them
+			update.members = nil
+		default:
+			update.members = slices.DeleteFunc(update.members, func(m int64) bool { return slices.Contains(ids, m) })
+		}
+	default:
+		return fmt.Errorf("unsupported path %q", path)
+	}
+	return nil
+}
+
+// updateGroup stores update and resyncs every user whose roles could have changed.
+func (h *Handler) updateGroup(w http.ResponseWriter, r *http.Request, status int, g genDb.ScimGroup, update groupUpdate) {
+	ctx := r.Context()
+	before, err := h.currentMembers(ctx, g.ID)
+	if err != nil {
+		writeInternal(w, r, "failed to list group members", err)
+		return
+	}
+
+	renamed := update.displayName != g.DisplayName
+	if renamed || update.externalID != g.ExternalID.String {
+		g, err = h.queries.UpdateScimGroup(ctx, genDb.UpdateScimGroupParams{
+			ID:          g.ID,
+			DisplayName: update.displayName,
+			ExternalID:  pgtype.Text{String: update.externalID, Valid: update.externalID != ""},
+		})
+		if isUniqueViolation(err) {
+			writeError(w, http.StatusConflict, "uniqueness", "a group with this displayName already exists")
+			return
+		}
+		if err != nil {
+			writeInternal(w, r, "failed to update group", err)
+			return
+		}
+	}
+
+	var changed []int64
+	for _, id := range update.members {
+		if slices.Contains(before, id) {
+			continue
+		}
+		if err := h.queries.AddScimGroupMember(ctx, genDb.AddScimGroupMemberParams{GroupID: g.ID, ScimUserID: id}); err != nil {
+			writeInternal(w, r, "failed to add group member", err)
+			return
+		}
+		changed = append(changed, id)
+	}
+	for _, id := range before {
+		if slices.Contains(update.members, id) {
+			continue
+		}
+		if err := h.queries.RemoveScimGroupMember(ctx, genDb.RemoveScimGroupMemberParams{GroupID: g.ID, ScimUserID: id}); err != nil {
+			writeInternal(w, r, "failed to remove group member", err)
+			return
+		}
+		changed = append(changed, id)
+	}
+	if renamed {
+		// roles are mapped by group name, so every member may have gained or lost roles
+		changed = append(before, changed...)
+	}
+
+	if err := h.directory.syncUsers(ctx, g.OrgID, changed); err != nil {
+		writeInternal(w, r, "failed to sync group members", err)
+		return
+	}
+	h.writeGroup(w, r, status, g)
+}
+
+// memberIDs resolves members to provisioned users of the org.
+func (h *Handler) memberIDs(ctx context.Context, orgID int64, members []groupMember) ([]int64, error) {
+	ids := make([]int64, 0, len(members))
+	for _, m := range members {
+		id, err := strconv.ParseInt(m.Value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownMember, m.Value)
+		}
+		if _, err := h.queries.GetScimUser(ctx, genDb.GetScimUserParams{OrgID: orgID, ID: id}); err != nil {
+			if isNotFound(err) {
+				return nil, fmt.Errorf("%w: %q", ErrUnknownMember, m.Value)
+			}
+			return nil, fmt.Errorf("%w %d: %w", errMemberLookup, id, err)
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (h *Handler) currentMembers(ctx context.Context, groupID int64) ([]int64, error) {
+	members, err := h.queries.ListScimGroupMembers(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.ScimUserID)
+	}
+	return ids, nil
+}
+
+// lookupGroup loads the group of the request, writing a 404 when it does not exist in the org.
+func (h *Handler) lookupGroup(w http.ResponseWriter, r *http.Request, orgID int64) (genDb.ScimGroup, bool) {
+	id, ok := resourceID(r)
+	if !ok {
+		writeError(w, http.StatusNotFound, "", "group not found")
+		return genDb.ScimGroup{}, false
+	}
+	g, err := h.queries.GetScimGroup(r.Context(), genDb.GetScimGroupParams{OrgID: orgID, ID: id})
+	if isNotFound(err) {
+		writeError(w, http.StatusNotFound, "", "group not found")
+		return genDb.ScimGroup{}, false
+	}
+	if err != nil {
+		writeInternal(w, r, "failed to get group", err)
+		return genDb.ScimGroup{}, false
+	}
+	return g, true
+}
+
+func (h *Handler) writeGroup(w http.ResponseWriter, r *http.Request, status int, g genDb.ScimGroup) {
+	res, err := h.toGroupResource(r.Context(), g)
+	if err != nil {
+		writeInternal(w, r, "failed to read group", err)
+		return
+	}
+	if status == http.StatusCreated {
+		w.Header().Set("Location", res.Meta.Location)
+	}
+	writeJSON(w, status, res)
+}
+
+// writeMemberError writes err, which comes from resolving members or applying a patch. Anything but a failed
+// lookup is a problem with the request.
+func writeMemberError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, errMemberLookup) {
+		writeInternal(w, r, "failed to resolve group members", err)
+		return
+	}
+	writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+}
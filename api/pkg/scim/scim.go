@@ -0,0 +1,239 @@
+// Package scim serves a SCIM v2 endpoint per organization, so a corporate identity provider (Okta, Entra ID,
+// Google Workspace, ...) can provision and deprovision org members and push groups. Groups are mapped to TVM
+// roles with the OrgService directory group roles RPCs; see [Directory] for how the two are kept in sync.
+//
+// The endpoint of an org is /scim/v2/orgs/{orgID} and authenticates with a loco token holding
+// organization:admin, e.g. one issued to a service account.
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+)
+
+// BasePath is where the handler is mounted.
+const BasePath = "/scim/v2/"
+
+const (
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	contentType     = "application/scim+json"
+	defaultPageSize = 100
+	maxPageSize     = 500
+	maxBodyBytes    = 1 << 20
+	timeFormat      = time.RFC3339
+)
+
+var (
+	ErrUnauthenticated = errors.New("a bearer token is required")
+	ErrInvalidFilter   = errors.New("unsupported filter")
+)
+
+// Handler serves the SCIM v2 API.
+type Handler struct {
+	queries   genDb.Querier
+	machine   *tvm.VendingMachine
+	directory *Directory
+	mux       *http.ServeMux
+}
+
+// NewHandler creates a Handler serving every org under [BasePath].
+func NewHandler(queries genDb.Querier, machine *tvm.VendingMachine, directory *Directory) *Handler {
+	h := &Handler{queries: queries, machine: machine, directory: directory, mux: http.NewServeMux()}
+
+	const org = BasePath + "orgs/{orgID}"
+	h.mux.HandleFunc("GET "+org+"/ServiceProviderConfig", h.authorized(h.serviceProviderConfig))
+
+	h.mux.HandleFunc("GET "+org+"/Users", h.authorized(h.listUsers))
+	h.mux.HandleFunc("POST "+org+"/Users", h.authorized(h.createUser))
+	h.mux.HandleFunc("GET "+org+"/Users/{id}", h.authorized(h.getUser))
+	h.mux.HandleFunc("PUT "+org+"/Users/{id}", h.authorized(h.replaceUser))
+	h.mux.HandleFunc("PATCH "+org+"/Users/{id}", h.authorized(h.patchUser))
+	h.mux.HandleFunc("DELETE "+org+"/Users/{id}", h.authorized(h.deleteUser))
+
+	h.mux.HandleFunc("GET "+org+"/Groups", h.authorized(h.listGroups))
+	h.mux.HandleFunc("POST "+org+"/Groups", h.authorized(h.createGroup))
+	h.mux.HandleFunc("GET "+org+"/Groups/{id}", h.authorized(h.getGroup))
+	h.mux.HandleFunc("PUT "+org+"/Groups/{id}", h.authorized(h.replaceGroup))
+	h.mux.HandleFunc("PATCH "+org+"/Groups/{id}", h.authorized(h.patchGroup))
+	h.mux.HandleFunc("DELETE "+org+"/Groups/{id}", h.authorized(h.deleteGroup))
+
+	h.mux.HandleFunc(BasePath, func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "", "unknown scim endpoint")
+	})
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// authorized checks the bearer token holds organization:admin on the org of the request before calling next.
+func (h *Handler) authorized(next func(w http.ResponseWriter, r *http.Request, orgID int64)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		orgID, err := strconv.ParseInt(r.PathValue("orgID"), 10, 64)
+		if err != nil {
+			writeError(w, http.StatusNotFound, "", "unknown organization")
+			return
+		}
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			writeError(w, http.StatusUnauthorized, "", ErrUnauthenticated.Error())
+			return
+		}
+		if err := h.machine.Verify(r.Context(), token, actions.New(actions.ManageDirectory, orgID)); err != nil {
+			slog.WarnContext(r.Context(), "unauthorized scim request", "orgId", orgID, "error", err)
+			writeError(w, http.StatusForbidden, "", "token cannot manage the directory of this organization")
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+		next(w, r, orgID)
+	}
+}
+
+type meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+}
+
+type listResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int64    `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// patchRequest is a SCIM PatchOp. Values are kept raw since their shape depends on the path.
+type patchRequest struct {
+	Schemas    []string `json:"schemas"`
+	Operations []struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	} `json:"Operations"`
+}
+
+func (h *Handler) serviceProviderConfig(w http.ResponseWriter, r *http.Request, orgID int64) {
+	supported := func(ok bool) map[string]bool { return map[string]bool{"supported": ok} }
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":        []string{schemaServiceProviderConfig},
+		"patch":          supported(true),
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxPageSize},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "Loco token",
+			"description": "A loco token with organization:admin on the organization",
+		}},
+		"meta": meta{ResourceType: "ServiceProviderConfig", Location: fmt.Sprintf("%sorgs/%d/ServiceProviderConfig", BasePath, orgID)},
+	})
+}
+
+// page reads the 1-based startIndex and count query parameters.
+func page(r *http.Request) (startIndex, count int) {
+	startIndex, count = 1, defaultPageSize
+	if v, err := strconv.Atoi(r.URL.Query().Get("startIndex")); err == nil && v > 1 {
+		startIndex = v
+	}
+	if v, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil && v >= 0 {
+		count = min(v, maxPageSize)
+	}
+	return startIndex, count
+}
+
+// parseFilter parses the only filters identity providers send when provisioning: `attribute eq "value"`.
+// It returns the attribute, lowercased, and the value. An empty filter returns empty strings.
+func parseFilter(filter string) (string, string, error) {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return "", "", nil
+	}
+	attr, rest, ok := strings.Cut(filter, " ")
+	if !ok {
+		return "", "", fmt.Errorf("%w: %q", ErrInvalidFilter, filter)
+	}
+	op, value, ok := strings.Cut(strings.TrimSpace(rest), " ")
+	if !ok || !strings.EqualFold(op, "eq") {
+		return "", "", fmt.Errorf("%w: %q; only eq is supported", ErrInvalidFilter, filter)
+	}
+	value, err := strconv.Unquote(strings.TrimSpace(value))
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %q; the value must be a quoted string", ErrInvalidFilter, filter)
+	}
+	return strings.ToLower(attr), value, nil
+}
+
+// resourceID parses the {id} of a request.
+func resourceID(r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	return id, err == nil
+}
+
+func decode(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error("failed to write scim response", "error", err)
+	}
+}
+
+// writeError writes a SCIM error. scimType is one of the error types of RFC 7644 section 3.12, or empty.
+func writeError(w http.ResponseWriter, status int, scimType, detail string) {
+	writeJSON(w, status, errorResponse{
+		Schemas:  []string{schemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+// writeInternal logs err and writes a generic 500.
+func writeInternal(w http.ResponseWriter, r *http.Request, msg string, err error) {
+	slog.ErrorContext(r.Context(), msg, "error", err)
+	writeError(w, http.StatusInternalServerError, "", msg)
+}
+
+func isNotFound(err error) bool {
+	return errors.Is(err, pgx.ErrNoRows)
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" // unique_violation
+}
@@ -0,0 +1,140 @@
+package scim
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm"
+)
+
+// Directory keeps the members and roles of an organization in line with what its identity provider pushed.
+//
+// Every provisioned user gets org:read plus the scopes mapped to the groups they are in. The scopes the
+// directory granted are remembered per user, so a sync only ever takes away what it gave: scopes a user got
+// some other way (for example by creating the org, or from an admin) are never touched.
+type Directory struct {
+	queries genDb.Querier
+	machine *tvm.VendingMachine
+}
+
+// NewDirectory creates a Directory.
+func NewDirectory(queries genDb.Querier, machine *tvm.VendingMachine) *Directory {
+	return &Directory{queries: queries, machine: machine}
+}
+
+// SyncUser brings the org membership and roles of a provisioned user in line with the directory. wasActive is
+// whether the user was active before the change being synced; deactivating a user revokes all of their tokens.
+func (d *Directory) SyncUser(ctx context.Context, su genDb.ScimUser, wasActive bool) error {
+	desired, err := d.desiredScopes(ctx, su)
+	if err != nil {
+		return err
+	}
+	current, err := d.queries.GetUserScopes(ctx, su.UserID)
+	if err != nil {
+		return fmt.Errorf("get user scopes: %w", err)
+	}
+
+	granted := []genDb.EntityScope{}
+	var add, remove []genDb.EntityScope
+	for _, es := range desired {
+		switch {
+		case slices.Contains(su.GrantedScopes, es):
+			granted = append(granted, es)
+		case !slices.Contains(current, es):
+			add = append(add, es)
+			granted = append(granted, es)
+		}
+		// otherwise the user holds the scope outside the directory, so it is left alone
+	}
+	for _, es := range su.GrantedScopes {
+		if !slices.Contains(desired, es) {
+			remove = append(remove, es)
+		}
+	}
+
+	if len(add) > 0 || len(remove) > 0 {
+		if err := d.machine.UpdateRoles(ctx, su.UserID, add, remove); err != nil {
+			return fmt.Errorf("update roles: %w", err)
+		}
+	}
+	if err := d.queries.SetScimUserGrantedScopes(ctx, genDb.SetScimUserGrantedScopesParams{
+		ID:            su.ID,
+		GrantedScopes: granted,
+	}); err != nil {
+		return fmt.Errorf("store granted scopes: %w", err)
+	}
+
+	if su.Active {
+		isMember, err := d.queries.IsOrgMember(ctx, genDb.IsOrgMemberParams{OrganizationID: su.OrgID, UserID: su.UserID})
+		if err != nil {
+			return fmt.Errorf("check org membership: %w", err)
+		}
+		if !isMember {
+			if err := d.queries.AddOrgMember(ctx, genDb.AddOrgMemberParams{OrganizationID: su.OrgID, UserID: su.UserID}); err != nil {
+				return fmt.Errorf("add org member: %w", err)
+			}
+		}
+		return nil
+	}
+
+	if err := d.queries.RemoveOrganizationMember(ctx, genDb.RemoveOrganizationMemberParams{OrganizationID: su.OrgID, UserID: su.UserID}); err != nil {
+		return fmt.Errorf("remove org member: %w", err)
+	}
+	if wasActive {
+		// a deprovisioned user must not keep working through tokens issued before
+		if err := d.machine.RevokeAllForEntity(ctx, genDb.Entity{Type: genDb.EntityTypeUser, ID: su.UserID}); err != nil {
+			return fmt.Errorf("revoke tokens: %w", err)
+		}
+		slog.InfoContext(ctx, "deprovisioned directory user", "orgId", su.OrgID, "userId", su.UserID)
+	}
+	return nil
+}
+
+// SyncGroup resyncs every member of the group named name, after the roles mapped to it changed.
+func (d *Directory) SyncGroup(ctx context.Context, orgID int64, name string) error {
+	ids, err := d.queries.ListScimGroupMembersByName(ctx, genDb.ListScimGroupMembersByNameParams{OrgID: orgID, DisplayName: name})
+	if err != nil {
+		return fmt.Errorf("list group members: %w", err)
+	}
+	return d.syncUsers(ctx, orgID, ids)
+}
+
+// syncUsers resyncs the given provisioned users, skipping any deleted in the meantime.
+func (d *Directory) syncUsers(ctx context.Context, orgID int64, ids []int64) error {
+	for _, id := range ids {
+		su, err := d.queries.GetScimUser(ctx, genDb.GetScimUserParams{OrgID: orgID, ID: id})
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("get directory user %d: %w", id, err)
+		}
+		if err := d.SyncUser(ctx, su, su.Active); err != nil {
+			return fmt.Errorf("sync directory user %d: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// desiredScopes is every scope the directory wants su to have.
+func (d *Directory) desiredScopes(ctx context.Context, su genDb.ScimUser) ([]genDb.EntityScope, error) {
+	if !su.Active {
+		return nil, nil
+	}
+	desired := []genDb.EntityScope{{EntityType: genDb.EntityTypeOrganization, EntityID: su.OrgID, Scope: genDb.ScopeRead}}
+	groupScopes, err := d.queries.ListScimUserGroupScopes(ctx, su.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list group scopes: %w", err)
+	}
+	for _, scopes := range groupScopes {
+		for _, es := range scopes {
+			if !slices.Contains(desired, es) {
+				desired = append(desired, es)
+			}
+		}
+	}
+	return desired, nil
+}
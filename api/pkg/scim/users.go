@@ -0,0 +1,400 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+type userName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type userEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type userResource struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        *userName   `json:"name,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []userEmail `json:"emails,omitempty"`
+	Active      *bool       `json:"active,omitempty"`
+	Meta        *meta       `json:"meta,omitempty"`
+}
+
+// email picks the address a provisioned user signs in with: the primary email, else the first one, else the
+// userName when it is an address, which is how most identity providers set it up.
+func (u userResource) email() string {
+	for _, e := range u.Emails {
+		if e.Primary && e.Value != "" {
+			return e.Value
+		}
+	}
+	if len(u.Emails) > 0 && u.Emails[0].Value != "" {
+		return u.Emails[0].Value
+	}
+	if strings.Contains(u.UserName, "@") {
+		return u.UserName
+	}
+	return ""
+}
+
+func (u userResource) displayName() string {
+	switch {
+	case u.DisplayName != "":
+		return u.DisplayName
+	case u.Name == nil:
+		return ""
+	case u.Name.Formatted != "":
+		return u.Name.Formatted
+	default:
+		return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+	}
+}
+
+func (h *Handler) toUserResource(r *http.Request, su genDb.ScimUser) (userResource, error) {
+	user, err := h.queries.GetUserByID(r.Context(), su.UserID)
+	if err != nil {
+		return userResource{}, fmt.Errorf("get user %d: %w", su.UserID, err)
+	}
+	active := su.Active
+	res := userResource{
+		Schemas:    []string{schemaUser},
+		ID:         strconv.FormatInt(su.ID, 10),
+		ExternalID: su.ExternalID.String,
+		UserName:   su.UserName,
+		Emails:     []userEmail{{Value: user.Email, Type: "work", Primary: true}},
+		Active:     &active,
+		Meta: &meta{
+			ResourceType: "User",
+			Created:      su.CreatedAt.Time.UTC().Format(timeFormat),
+			LastModified: su.UpdatedAt.Time.UTC().Format(timeFormat),
+			Location:     fmt.Sprintf("%sorgs/%d/Users/%d", BasePath, su.OrgID, su.ID),
+		},
+	}
+	if user.Name.Valid {
+		res.DisplayName = user.Name.String
+		res.Name = &userName{Formatted: user.Name.String}
+	}
+	return res, nil
+}
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request, orgID int64) {
+	attr, value, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+		return
+	}
+	var userNameFilter, externalIDFilter pgtype.Text
+	switch attr {
+	case "":
+	case "username":
+		userNameFilter = pgtype.Text{String: value, Valid: true}
+	case "externalid":
+		externalIDFilter = pgtype.Text{String: value, Valid: true}
+	default:
+		writeError(w, http.StatusBadRequest, "invalidFilter", fmt.Sprintf("cannot filter users by %q", attr))
+		return
+	}
+
+	startIndex, count := page(r)
+	total, err := h.queries.CountScimUsers(r.Context(), genDb.CountScimUsersParams{
+		OrgID:      orgID,
+		UserName:   userNameFilter,
+		ExternalID: externalIDFilter,
+	})
+	if err != nil {
+		writeInternal(w, r, "failed to count users", err)
+		return
+	}
+	users, err := h.queries.ListScimUsers(r.Context(), genDb.ListScimUsersParams{
+		OrgID:      orgID,
+		UserName:   userNameFilter,
+		ExternalID: externalIDFilter,
+		Offset:     int32(startIndex - 1),
+		Limit:      int32(count),
+	})
+	if err != nil {
+		writeInternal(w, r, "failed to list users", err)
+		return
+	}
+
+	resources := make([]any, 0, len(users))
+	for _, su := range users {
+		res, err := h.toUserResource(r, su)
+		if err != nil {
+			writeInternal(w, r, "failed to read user", err)
+			return
+		}
+		resources = append(resources, res)
+	}
+	writeJSON(w, http.StatusOK, listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (h *Handler) getUser(w http.ResponseWriter, r *http.Request, orgID int64) {
+	su, ok := h.lookupUser(w, r, orgID)
+	if !ok {
+		return
+	}
+	h.writeUser(w, r, http.StatusOK, su)
+}
+
+// createUser provisions a user. The loco user is matched by email, so people who already signed in keep their
+// account; anyone else gets an account they can sign in to through the org's identity provider.
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request, orgID int64) {
+	var req userResource
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	if req.UserName == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "userName is required")
+		return
+	}
+	email := req.email()
+	if email == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "an email address is required")
+		return
+	}
+
+	ctx := r.Context()
+	user, err := h.queries.GetUserByEmail(ctx, email)
+	if isNotFound(err) {
+		name := req.displayName()
+		user, err = h.queries.CreateUser(ctx, genDb.CreateUserParams{
+			ExternalID: fmt.Sprintf("scim:%d:%s", orgID, req.UserName),
+			Email:      email,
+			Name:       pgtype.Text{String: name, Valid: name != ""},
+		})
+	}
+	if err != nil {
+		writeInternal(w, r, "failed to find or create user", err)
+		return
+	}
+
+	active := req.Active == nil || *req.Active
+	su, err := h.queries.CreateScimUser(ctx, genDb.CreateScimUserParams{
+		OrgID:      orgID,
+		UserID:     user.ID,
+		ExternalID: pgtype.Text{String: req.ExternalID, Valid: req.ExternalID != ""},
+		UserName:   req.UserName,
+		Active:     active,
+	})
+	if isUniqueViolation(err) {
+		writeError(w, http.StatusConflict, "uniqueness", "a user with this userName or email is already provisioned")
+		return
+	}
+	if err != nil {
+		writeInternal(w, r, "failed to create user", err)
+		return
+	}
+
+	if err := h.directory.SyncUser(ctx, su, false); err != nil {
+		writeInternal(w, r, "failed to sync user", err)
+		return
+	}
+	h.writeUser(w, r, http.StatusCreated, su)
+}
+
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request, orgID int64) {
+	su, ok := h.lookupUser(w, r, orgID)
+	if !ok {
+		return
+	}
+	var req userResource
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	if req.UserName == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "userName is required")
+		return
+	}
+	h.updateUser(w, r, su, userUpdate{
+		externalID: req.ExternalID,
+		userName:   req.UserName,
+		active:     req.Active == nil || *req.Active,
+	})
+}
+
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request, orgID int64) {
+	su, ok := h.lookupUser(w, r, orgID)
+	if !ok {
+		return
+	}
+	var req patchRequest
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	update := userUpdate{externalID: su.ExternalID.String, userName: su.UserName, active: su.Active}
+	for _, op := range req.Operations {
+		if err := update.apply(strings.ToLower(op.Op), op.Path, op.Value); err != nil {
+			writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+			return
+		}
+	}
+	h.updateUser(w, r, su, update)
+}
+
+func (h *Handler) deleteUser(w http.ResponseWriter, r *http.Request, orgID int64) {
+	su, ok := h.lookupUser(w, r, orgID)
+	if !ok {
+		return
+	}
+	ctx := r.Context()
+	wasActive := su.Active
+	su.Active = false
+	if err := h.directory.SyncUser(ctx, su, wasActive); err != nil {
+		writeInternal(w, r, "failed to deprovision user", err)
+		return
+	}
+	if err := h.queries.DeleteScimUser(ctx, su.ID); err != nil {
+		writeInternal(w, r, "failed to delete user", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// userUpdate is the part of a provisioned user the identity provider can change.
+type userUpdate struct {
+	externalID string
+	userName   string
+	active     bool
+}
+
+// apply applies one PatchOp operation. Attributes loco does not store, like names and emails, are ignored:
+// they belong to the user's account, not to the org.
+func (u *userUpdate) apply(op, path string, value json.RawMessage) error {
+	if op != "add" && op != "replace" && op != "remove" {
+		return fmt.Errorf("unsupported patch op %q", op)
+	}
+	if path == "" {
+		// the value is an object of attributes to set
+		var attrs map[string]json.RawMessage
+		if err := json.Unmarshal(value, &attrs); err != nil {
+			return fmt.Errorf("a patch without path needs an object value: %w", err)
+		}
+		for attr, v := range attrs {
+			if err := u.apply(op, attr, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch strings.ToLower(path) {
+	case "active":
+		if op == "remove" {
+			return fmt.Errorf("active cannot be removed")
+		}
+		active, err := parseBool(value)
+		if err != nil {
+			return fmt.Errorf("active: %w", err)
+		}
+		u.active = active
+	case "externalid":
+		if op == "remove" {
+			u.externalID = ""
+			return nil
+		}
+		if err := json.Unmarshal(value, &u.externalID); err != nil {
+			return fmt.Errorf("externalId: %w", err)
+		}
+	case "username":
+		if op == "remove" {
+			return fmt.Errorf("userName cannot be removed")
+		}
+		if err := json.Unmarshal(value, &u.userName); err != nil || u.userName == "" {
+			return fmt.Errorf("userName must be a non-empty string")
+		}
+	}
+	return nil
+}
+
+func (h *Handler) updateUser(w http.ResponseWriter, r *http.Request, su genDb.ScimUser, update userUpdate) {
+	ctx := r.Context()
+	updated, err := h.queries.UpdateScimUser(ctx, genDb.UpdateScimUserParams{
+		ID:         su.ID,
+		ExternalID: pgtype.Text{String: update.externalID, Valid: update.externalID != ""},
+		UserName:   update.userName,
+		Active:     update.active,
+	})
+	if isUniqueViolation(err) {
+		writeError(w, http.StatusConflict, "uniqueness", "a user with this userName is already provisioned")
+		return
+	}
+	if err != nil {
+		writeInternal(w, r, "failed to update user", err)
+		return
+	}
+	if err := h.directory.SyncUser(ctx, updated, su.Active); err != nil {
+		writeInternal(w, r, "failed to sync user", err)
+		return
+	}
+	h.writeUser(w, r, http.StatusOK, updated)
+}
+
+// lookupUser loads the user of the request, writing a 404 when it does not exist in the org.
+func (h *Handler) lookupUser(w http.ResponseWriter, r *http.Request, orgID int64) (genDb.ScimUser, bool) {
+	id, ok := resourceID(r)
+	if !ok {
+		writeError(w, http.StatusNotFound, "", "user not found")
+		return genDb.ScimUser{}, false
+	}
+	su, err := h.queries.GetScimUser(r.Context(), genDb.GetScimUserParams{OrgID: orgID, ID: id})
+	if isNotFound(err) {
+		writeError(w, http.StatusNotFound, "", "user not found")
+		return genDb.ScimUser{}, false
+	}
+	if err != nil {
+		writeInternal(w, r, "failed to get user", err)
+		return genDb.ScimUser{}, false
+	}
+	return su, true
+}
+
+func (h *Handler) writeUser(w http.ResponseWriter, r *http.Request, status int, su genDb.ScimUser) {
+	res, err := h.toUserResource(r, su)
+	if err != nil {
+		writeInternal(w, r, "failed to read user", err)
+		return
+	}
+	if status == http.StatusCreated {
+		w.Header().Set("Location", res.Meta.Location)
+	}
+	writeJSON(w, status, res)
+}
+
+// parseBool reads a boolean sent either as JSON or, as some identity providers do, as a string.
+func parseBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return false, fmt.Errorf("expected a boolean, got %s", value)
+	}
+	return strconv.ParseBool(strings.ToLower(s))
+}
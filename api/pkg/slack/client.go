@@ -0,0 +1,212 @@
+// Package slack is loco's Slack app: the OAuth install that connects an organization to a Slack
+// team, the Web API calls used to post messages, the Block Kit messages for deployments and
+// alerts, and the endpoint Slack sends /loco slash commands to.
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// APIURL is the base of the Slack Web API.
+const APIURL = "https://slack.com/api/"
+
+// authorizeURL is where users are sent to install the app.
+const authorizeURL = "https://slack.com/oauth/v2/authorize"
+
+// Scopes are the bot scopes the app asks for: posting messages, receiving slash commands, reading
+// channel names, and reading the email of command senders to find their loco user.
+var Scopes = []string{"chat:write", "commands", "channels:read", "users:read", "users:read.email"}
+
+// ErrNotConfigured is returned when the app's credentials are not set.
+var ErrNotConfigured = errors.New("the slack integration is not configured")
+
+// Config holds the credentials of the Slack app. The integration is enabled when ClientID is set.
+type Config struct {
+	ClientID      string `env:"SLACK_CLIENT_ID"`
+	ClientSecret  string `env:"SLACK_CLIENT_SECRET" requiredWith:"SLACK_CLIENT_ID"`
+	SigningSecret string `env:"SLACK_SIGNING_SECRET" requiredWith:"SLACK_CLIENT_ID"` // verifies requests from Slack
+	RedirectURL   string `env:"SLACK_REDIRECT_URL" requiredWith:"SLACK_CLIENT_ID"`   // the API's /slack/oauth/callback
+}
+
+// Client calls the Slack Web API on behalf of the app.
+type Client struct {
+	cfg        Config
+	httpClient *http.Client
+	apiURL     string
+}
+
+// NewClient creates a Client, or returns nil when cfg has no client ID. A nil Client's methods
+// return ErrNotConfigured.
+func NewClient(cfg Config, httpClient *http.Client) *Client {
+	if cfg.ClientID == "" {
+		return nil
+	}
+	return &Client{cfg: cfg, httpClient: httpClient, apiURL: APIURL}
+}
+
+// Installation is the result of a completed install.
+type Installation struct {
+	TeamID   string
+	TeamName string
+	BotToken string
+}
+
+// Exchange trades the code Slack passed to the callback for the bot token of the installation.
+func (c *Client) Exchange(ctx context.Context, code string) (Installation, error) {
+	if c == nil {
+		return Installation{}, ErrNotConfigured
+	}
+	form := url.Values{}
+	form.Set("client_id", c.cfg.ClientID)
+	form.Set("client_secret", c.cfg.ClientSecret)
+	form.Set("code", code)
+	form.Set("redirect_uri", c.cfg.RedirectURL)
+
+	var resp struct {
+		AccessToken string `json:"access_token"`
+		Team        struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"team"`
+	}
+	if err := c.call(ctx, http.MethodPost, "oauth.v2.access", "", form, nil, &resp); err != nil {
+		return Installation{}, err
+	}
+	return Installation{TeamID: resp.Team.ID, TeamName: resp.Team.Name, BotToken: resp.AccessToken}, nil
+}
+
+// Revoke invalidates a bot token, removing the app's access to the team.
+func (c *Client) Revoke(ctx context.Context, token string) error {
+	if c == nil {
+		return ErrNotConfigured
+	}
+	return c.call(ctx, http.MethodPost, "auth.revoke", token, url.Values{}, nil, nil)
+}
+
+// PostMessage posts message to channel. message is a Message as JSON; its channel is set here.
+func (c *Client) PostMessage(ctx context.Context, token, channel string, message []byte) error {
+	if c == nil {
+		return ErrNotConfigured
+	}
+	var body map[string]any
+	if err := json.Unmarshal(message, &body); err != nil {
+		return fmt.Errorf("decode message: %w", err)
+	}
+	body["channel"] = channel
+	return c.call(ctx, http.MethodPost, "chat.postMessage", token, nil, body, nil)
+}
+
+// ChannelName returns the name of a channel the bot can see.
+func (c *Client) ChannelName(ctx context.Context, token, channel string) (string, error) {
+	if c == nil {
+		return "", ErrNotConfigured
+	}
+	var resp struct {
+		Channel struct {
+			Name string `json:"name"`
+		} `json:"channel"`
+	}
+	if err := c.call(ctx, http.MethodGet, "conversations.info", token, url.Values{"channel": {channel}}, nil, &resp); err != nil {
+		return "", err
+	}
+	return resp.Channel.Name, nil
+}
+
+// UserEmail returns the email address of a Slack user.
+func (c *Client) UserEmail(ctx context.Context, token, user string) (string, error) {
+	if c == nil {
+		return "", ErrNotConfigured
+	}
+	var resp struct {
+		User struct {
+			Profile struct {
+				Email string `json:"email"`
+			} `json:"profile"`
+		} `json:"user"`
+	}
+	if err := c.call(ctx, http.MethodGet, "users.info", token, url.Values{"user": {user}}, nil, &resp); err != nil {
+		return "", err
+	}
+	return resp.User.Profile.Email, nil
+}
+
+// APIError is an error reported by the Web API, such as "channel_not_found".
+type APIError struct {
+	Method string
+	Code   string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("slack %s: %s", e.Method, e.Code)
+}
+
+// call sends a Web API request. GET requests carry form as the query; POST requests carry it as a
+// form body, or jsonBody as JSON when form is nil. The response is decoded into out when given.
+func (c *Client) call(ctx context.Context, httpMethod, method, token string, form url.Values, jsonBody any, out any) error {
+	endpoint := c.apiURL + method
+	var body io.Reader
+	contentType := ""
+	switch {
+	case httpMethod == http.MethodGet:
+		endpoint += "?" + form.Encode()
+	case form != nil:
+		body = strings.NewReader(form.Encode())
+		contentType = "application/x-www-form-urlencoded"
+	default:
+		b, err := json.Marshal(jsonBody)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+		contentType = "application/json; charset=utf-8"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, httpMethod, endpoint, body)
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("slack %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("slack %s returned %d", method, resp.StatusCode)
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("slack %s: %w", method, err)
+	}
+	var status struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(raw, &status); err != nil {
+		return fmt.Errorf("slack %s: decode response: %w", method, err)
+	}
+	if !status.OK {
+		return &APIError{Method: method, Code: status.Error}
+	}
+	if out != nil {
+		if err := json.Unmarshal(raw, out); err != nil {
+			return fmt.Errorf("slack %s: decode response: %w", method, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package slack
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BasePath is where the handler is mounted.
+const BasePath = "/slack/"
+
+const (
+	// stateTTL is how long a user has to finish installing the app
+	stateTTL = 15 * time.Minute
+	// maxClockSkew is how old a request from Slack may be before it is taken for a replay
+	maxClockSkew = 5 * time.Minute
+	maxBodyBytes = 64 << 10
+)
+
+var (
+	ErrInvalidState     = errors.New("invalid or expired install state")
+	ErrInvalidSignature = errors.New("invalid slack request signature")
+)
+
+// App carries out what Slack asks of loco.
+type App interface {
+	// Install records that userID installed the app in orgID.
+	Install(ctx context.Context, orgID, userID int64, installation Installation) error
+	// Command runs a slash command and returns the reply.
+	Command(ctx context.Context, cmd Command) Message
+}
+
+// Command is a /loco slash command, such as "/loco deploy api ghcr.io/acme/api:1.2".
+type Command struct {
+	TeamID    string
+	ChannelID string
+	UserID    string // the Slack user who sent it
+	Name      string // the first word, such as "deploy"
+	Args      []string
+}
+
+// Handler serves the OAuth callback and the slash command endpoint of the app.
+type Handler struct {
+	client *Client
+	app    App
+	mux    *http.ServeMux
+}
+
+// NewHandler creates a Handler serving under [BasePath].
+func NewHandler(client *Client, app App) *Handler {
+	h := &Handler{client: client, app: app, mux: http.NewServeMux()}
+	h.mux.HandleFunc("GET "+BasePath+"oauth/callback", h.callback)
+	h.mux.HandleFunc("POST "+BasePath+"commands", h.command)
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.client == nil {
+		http.Error(w, ErrNotConfigured.Error(), http.StatusNotFound)
+		return
+	}
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) callback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+
+	if reason := q.Get("error"); reason != "" {
+		http.Error(w, "Slack was not connected: "+reason, http.StatusBadRequest)
+		return
+	}
+	orgID, userID, err := h.client.verifyState(q.Get("state"), time.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	installation, err := h.client.Exchange(ctx, q.Get("code"))
+	if err != nil {
+		slog.WarnContext(ctx, "failed to exchange slack install code", "orgId", orgID, "error", err)
+		http.Error(w, "Slack could not be connected, please try again.", http.StatusBadGateway)
+		return
+	}
+	if err := h.app.Install(ctx, orgID, userID, installation); err != nil {
+		slog.ErrorContext(ctx, "failed to save slack installation", "orgId", orgID, "error", err)
+		http.Error(w, "Slack could not be connected, please try again.", http.StatusInternalServerError)
+		return
+	}
+
+	slog.InfoContext(ctx, "installed slack app", "orgId", orgID, "teamId", installation.TeamID)
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintf(w, "Slack is connected to %s. You can close this window.\n", installation.TeamName)
+}
+
+func (h *Handler) command(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+		http.Error(w, "request too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err := h.client.verifyRequest(r.Header, body, time.Now()); err != nil {
+		slog.WarnContext(ctx, "rejected slack command", "error", err)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	words := strings.Fields(form.Get("text"))
+	cmd := Command{
+		TeamID:    form.Get("team_id"),
+		ChannelID: form.Get("channel_id"),
+		UserID:    form.Get("user_id"),
+	}
+	if len(words) > 0 {
+		cmd.Name, cmd.Args = strings.ToLower(words[0]), words[1:]
+	}
+
+	var reply Message
+	switch cmd.Name {
+	case "", "help":
+		reply = Reply("Usage:\n`%[1]s deploy <resource> <image>` deploys an image\n`%[1]s status <resource>` shows a resource's status", form.Get("command"))
+	default:
+		reply = h.app.Command(ctx, cmd)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(reply)
+}
+
+// InstallURL is where a user is sent to install the app in an organization.
+func (c *Client) InstallURL(orgID, userID int64) (string, error) {
+	if c == nil {
+		return "", ErrNotConfigured
+	}
+	q := url.Values{}
+	q.Set("client_id", c.cfg.ClientID)
+	q.Set("scope", strings.Join(Scopes, ","))
+	q.Set("redirect_uri", c.cfg.RedirectURL)
+	q.Set("state", c.signState(orgID, userID, time.Now().Add(stateTTL)))
+	return authorizeURL + "?" + q.Encode(), nil
+}
+
+// signState names the org being installed into and who installs it, signed with the client
+// secret so any replica can check it without keeping it.
+func (c *Client) signState(orgID, userID int64, expires time.Time) string {
+	payload := fmt.Sprintf("%d.%d.%d", orgID, userID, expires.Unix())
+	mac := hmac.New(sha256.New, []byte(c.cfg.ClientSecret))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (c *Client) verifyState(state string, now time.Time) (orgID, userID int64, err error) {
+	encoded, signature, ok := strings.Cut(state, ".")
+	if !ok {
+		return 0, 0, ErrInvalidState
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, 0, ErrInvalidState
+	}
+	given, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return 0, 0, ErrInvalidState
+	}
+	mac := hmac.New(sha256.New, []byte(c.cfg.ClientSecret))
+	mac.Write(payload)
+	if !hmac.Equal(given, mac.Sum(nil)) {
+		return 0, 0, ErrInvalidState
+	}
+
+	parts := strings.Split(string(payload), ".")
+	if len(parts) != 3 {
+		return 0, 0, ErrInvalidState
+	}
+	var values [3]int64
+	for i, part := range parts {
+		if values[i], err = strconv.ParseInt(part, 10, 64); err != nil {
+			return 0, 0, ErrInvalidState
+		}
+	}
+	if now.After(time.Unix(values[2], 0)) {
+		return 0, 0, ErrInvalidState
+	}
+	return values[0], values[1], nil
+}
+
+// verifyRequest checks the signature Slack puts on its requests with the app's signing secret.
+func (c *Client) verifyRequest(header http.Header, body []byte, now time.Time) error {
+	timestamp := header.Get("X-Slack-Request-Timestamp")
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if age := now.Sub(time.Unix(seconds, 0)); age > maxClockSkew || age < -maxClockSkew {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(c.cfg.SigningSecret))
+	fmt.Fprintf(mac, "v0:%s:", timestamp)
+	mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(header.Get("X-Slack-Signature")), []byte(expected)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func sign(secret, timestamp, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":" + body))
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyRequest(t *testing.T) {
+	c := NewClient(Config{ClientID: "id", ClientSecret: "secret", SigningSecret: "signing"}, http.DefaultClient)
+	now := time.Unix(1_700_000_000, 0)
+	body := "team_id=T1&user_id=U1&text=status+api"
+	stamp := func(d time.Duration) string { return strconv.FormatInt(now.Add(d).Unix(), 10) }
+
+	tests := []struct {
+		name      string
+		timestamp string
+		signature string
+		body      string
+		wantErr   bool
+	}{
+		{"valid", stamp(0), sign("signing", stamp(0), body), body, false},
+		{"a little old", stamp(-4 * time.Minute), sign("signing", stamp(-4*time.Minute), body), body, false},
+		{"a little ahead", stamp(4 * time.Minute), sign("signing", stamp(4*time.Minute), body), body, false},
+		{"too old", stamp(-6 * time.Minute), sign("signing", stamp(-6*time.Minute), body), body, true},
+		{"too far ahead", stamp(6 * time.Minute), sign("signing", stamp(6*time.Minute), body), body, true},
+		{"another secret", stamp(0), sign("other", stamp(0), body), body, true},
+		{"body changed", stamp(0), sign("signing", stamp(0), body), body + "&channel_id=C2", true},
+		{"signed with another timestamp", stamp(0), sign("signing", stamp(-time.Second), body), body, true},
+		{"no version prefix", stamp(0), sign("signing", stamp(0), body)[len("v0="):], body, true},
+		{"no signature", stamp(0), "", body, true},
+		{"no timestamp", "", sign("signing", "", body), body, true},
+		{"timestamp not a number", "now", sign("signing", "now", body), body, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.timestamp != "" {
+				header.Set("X-Slack-Request-Timestamp", tt.timestamp)
+			}
+			if tt.signature != "" {
+				header.Set("X-Slack-Signature", tt.signature)
+			}
+			err := c.verifyRequest(header, []byte(tt.body), now)
+			if tt.wantErr && !errors.Is(err, ErrInvalidSignature) {
+				t.Errorf("expected %v, got %v", ErrInvalidSignature, err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
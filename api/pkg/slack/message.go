@@ -0,0 +1,157 @@
+package slack
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Colors of the bar beside a message.
+const (
+	colorGood    = "#2eb67d"
+	colorWarning = "#ecb22e"
+	colorDanger  = "#e01e5a"
+	colorNeutral = "#868686"
+)
+
+// Message is a Slack message: Text is shown in notifications and by clients that cannot render
+// the blocks of the attachment.
+type Message struct {
+	ResponseType string       `json:"response_type,omitempty"` // "in_channel" or "ephemeral", for slash command replies
+	Text         string       `json:"text"`
+	Attachments  []Attachment `json:"attachments,omitempty"`
+}
+
+// Attachment holds the blocks of a message beside a colored bar.
+type Attachment struct {
+	Color  string  `json:"color,omitempty"`
+	Blocks []Block `json:"blocks"`
+}
+
+// Block is a Block Kit layout block. Only section and context blocks are used.
+type Block struct {
+	Type     string `json:"type"`
+	Text     *Text  `json:"text,omitempty"`
+	Fields   []Text `json:"fields,omitempty"`
+	Elements []Text `json:"elements,omitempty"`
+}
+
+// Text is a Block Kit text object.
+type Text struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// Field is a labelled value shown in two columns under a message's heading.
+type Field struct {
+	Label string
+	Value string
+}
+
+// Deployment statuses posted to channels.
+const (
+	DeploymentStarted   = "deploying"
+	DeploymentSucceeded = "running"
+	DeploymentFailed    = "failed"
+)
+
+// Deployment describes a deployment whose status changed.
+type Deployment struct {
+	ID        int64
+	Resource  string
+	Workspace string
+	Region    string
+	Status    string // one of the Deployment statuses
+	Message   string // the deployment's status message, such as why it failed
+}
+
+// DeploymentMessage formats a deployment status change.
+func DeploymentMessage(d Deployment) Message {
+	var emoji, summary, color string
+	switch d.Status {
+	case DeploymentStarted:
+		emoji, summary, color = ":rocket:", "Deploying %s", colorNeutral
+	case DeploymentSucceeded:
+		emoji, summary, color = ":white_check_mark:", "%s is live", colorGood
+	case DeploymentFailed:
+		emoji, summary, color = ":x:", "Deployment of %s failed", colorDanger
+	default:
+		emoji, summary, color = "", "Deployment of %s is "+d.Status, colorNeutral
+	}
+	heading := strings.TrimSpace(emoji + " " + fmt.Sprintf(summary, "*"+escape(d.Resource)+"*"))
+
+	fields := []Field{
+		{Label: "Workspace", Value: d.Workspace},
+		{Label: "Region", Value: d.Region},
+		{Label: "Deployment", Value: fmt.Sprint(d.ID)},
+	}
+	return build(fmt.Sprintf(summary, d.Resource), heading, color, fields, d.Message)
+}
+
+// AlertMessage formats an alert of a rule firing or resolving.
+func AlertMessage(subject, body string, firing bool, fields []Field) Message {
+	heading, color := ":rotating_light: *"+escape(subject)+"*", colorDanger
+	if !firing {
+		heading, color = ":large_green_circle: *"+escape(subject)+"*", colorGood
+	}
+	return build(subject, heading, color, fields, body)
+}
+
+// StatusMessage formats a resource's status for /loco status.
+func StatusMessage(resource, status string, fields []Field) Message {
+	color := colorNeutral
+	switch status {
+	case "healthy":
+		color = colorGood
+	case "degraded", "deploying":
+		color = colorWarning
+	case "unavailable":
+		color = colorDanger
+	}
+	return build(fmt.Sprintf("%s is %s", resource, status), fmt.Sprintf("*%s* is %s", escape(resource), escape(status)), color, fields, "")
+}
+
+// Reply is a plain reply to a slash command, shown only to the user who sent it.
+func Reply(format string, args ...any) Message {
+	return Message{ResponseType: "ephemeral", Text: fmt.Sprintf(format, args...)}
+}
+
+// build lays out a message: the heading, then fields, then detail in small print. summary is the
+// heading as plain text.
+func build(summary, heading, color string, fields []Field, detail string) Message {
+	blocks := []Block{{Type: "section", Text: &Text{Type: "mrkdwn", Text: heading}}}
+
+	var texts []Text
+	for _, f := range fields {
+		if f.Value == "" {
+			continue
+		}
+		texts = append(texts, Text{Type: "mrkdwn", Text: fmt.Sprintf("*%s*\n%s", escape(f.Label), escape(f.Value))})
+	}
+	// a section holds at most ten fields
+	for len(texts) > 0 {
+		n := min(len(texts), 10)
+		blocks = append(blocks, Block{Type: "section", Fields: texts[:n]})
+		texts = texts[n:]
+	}
+
+	if detail != "" {
+		blocks = append(blocks, Block{Type: "context", Elements: []Text{{Type: "mrkdwn", Text: escape(truncate(detail, 2000))}}})
+	}
+
+	return Message{
+		Text:        summary,
+		Attachments: []Attachment{{Color: color, Blocks: blocks}},
+	}
+}
+
+// escape keeps user-provided text from being read as mrkdwn links or mentions.
+func escape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "…"
+}
@@ -0,0 +1,112 @@
+// Package specdiff computes field-level differences between two JSON deployment specs.
+package specdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// Change is a single leaf value that differs between two specs.
+// Old is nil when the field was added and New is nil when it was removed.
+type Change struct {
+	Path string
+	Old  *string
+	New  *string
+}
+
+// Diff returns the changed leaf fields between oldSpec and newSpec, sorted by path.
+// Values are reported JSON-encoded. An empty oldSpec diffs against an empty object.
+func Diff(oldSpec, newSpec []byte) ([]Change, error) {
+	oldLeaves, err := flatten(oldSpec)
+	if err != nil {
+		return nil, fmt.Errorf("old spec: %w", err)
+	}
+	newLeaves, err := flatten(newSpec)
+	if err != nil {
+		return nil, fmt.Errorf("new spec: %w", err)
+	}
+
+	paths := make(map[string]struct{}, len(oldLeaves)+len(newLeaves))
+	for p := range oldLeaves {
+		paths[p] = struct{}{}
+	}
+	for p := range newLeaves {
+		paths[p] = struct{}{}
+	}
+
+	var changes []Change
+	for _, p := range slices.Sorted(maps.Keys(paths)) {
+		oldVal, hadOld := oldLeaves[p]
+		newVal, hasNew := newLeaves[p]
+		if hadOld && hasNew && oldVal == newVal {
+			continue
+		}
+
+		change := Change{Path: p}
+		if hadOld {
+			change.Old = &oldVal
+		}
+		if hasNew {
+			change.New = &newVal
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// flatten decodes a JSON document into a map of dotted paths to JSON-encoded leaf values
+func flatten(spec []byte) (map[string]string, error) {
+	leaves := map[string]string{}
+	if len(spec) == 0 {
+		return leaves, nil
+	}
+
+	var doc any
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return nil, err
+	}
+	if err := walk("", doc, leaves); err != nil {
+		return nil, err
+	}
+	return leaves, nil
+}
+
+func walk(prefix string, node any, leaves map[string]string) error {
+	switch v := node.(type) {
+	case map[string]any:
+		if len(v) == 0 && prefix != "" {
+			leaves[prefix] = "{}"
+		}
+		for k, child := range v {
+			if err := walk(join(prefix, k), child, leaves); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if len(v) == 0 && prefix != "" {
+			leaves[prefix] = "[]"
+		}
+		for i, child := range v {
+			if err := walk(join(prefix, strconv.Itoa(i)), child, leaves); err != nil {
+				return err
+			}
+		}
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		leaves[prefix] = string(encoded)
+	}
+	return nil
+}
+
+func join(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
@@ -0,0 +1,148 @@
+package statuspage
+
+import (
+	"errors"
+	"html/template"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// HostPrefix marks requests for status pages: status.<domain> serves the page of the resource
+// whose primary domain is <domain>. DNS and the gateway send these hosts to the API.
+const HostPrefix = "status."
+
+const defaultAccentColor = "#f57900"
+
+var pageTemplate = template.Must(template.New("status").Funcs(template.FuncMap{
+	"date": func(t time.Time) string { return t.UTC().Format("Jan 2, 2006 15:04 UTC") },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head><meta charset="utf-8"><meta name="viewport" content="width=device-width,initial-scale=1"><title>{{.Title}} status</title>
+<style>body{font-family:system-ui,sans-serif;background:#1b1b1b;color:#fafafa;margin:0}main{max-width:40rem;margin:0 auto;padding:2rem}header{display:flex;align-items:center;gap:1rem}header img{height:2.5rem}h1{color:{{.AccentColor}};margin:0}.banner{margin:2rem 0;padding:1rem 1.25rem;border-radius:.5rem;background:#262626;border-left:.35rem solid {{.AccentColor}};font-size:1.15rem}.uptime{color:#a3a3a3}ul{list-style:none;padding:0}li{padding:.75rem 0;border-bottom:1px solid #333}small{color:#a3a3a3}</style>
+</head>
+<body><main>
+<header>{{if .LogoURL}}<img src="{{.LogoURL}}" alt="">{{end}}<h1>{{.Title}}</h1></header>
+{{if .Description}}<p>{{.Description}}</p>{{end}}
+<div class="banner">{{.Status}}</div>
+<p class="uptime">{{printf "%.2f" .Summary.Uptime}}% uptime over the last 90 days{{if .Summary.Latency}} &middot; {{.Summary.Latency.Milliseconds}} ms average response time{{end}}</p>
+<h2>Incidents</h2>
+{{if .Summary.Incidents}}<ul>{{range .Summary.Incidents}}
+<li>{{.Title}}<br><small>{{date .StartedAt}}{{if .ResolvedAt.IsZero}} &middot; ongoing{{else if not (.ResolvedAt.Equal .StartedAt)}} &ndash; {{date .ResolvedAt}}{{end}}</small></li>{{end}}
+</ul>{{else}}<p>No incidents in the last 90 days.</p>{{end}}
+</main></body>
+</html>
+`))
+
+type page struct {
+	Title       string
+	Description string
+	LogoURL     string
+	AccentColor string
+	Status      string
+	Summary     Summary
+}
+
+// Handler serves status pages for requests to status.<domain> hosts.
+type Handler struct {
+	queries genDb.Querier
+}
+
+// NewHandler creates a Handler.
+func NewHandler(queries genDb.Querier) *Handler {
+	return &Handler{queries: queries}
+}
+
+// Wrap serves status pages for status.<domain> hosts and passes every other request to next.
+func (h *Handler) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := statusDomain(r.Host); !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	domain, ok := statusDomain(r.Host)
+	if !ok || r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+	statusPage, err := h.queries.GetStatusPageByDomain(ctx, domain)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			http.NotFound(w, r)
+			return
+		}
+		slog.ErrorContext(ctx, "failed to get status page", "domain", domain, "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	summary, err := Load(ctx, h.queries, statusPage.ResourceID, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to summarize resource status", "resourceId", statusPage.ResourceID, "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	p := page{
+		Title:       statusPage.Title,
+		Description: statusPage.Description,
+		LogoURL:     statusPage.LogoUrl,
+		AccentColor: statusPage.AccentColor,
+		Status:      statusLabel(statusPage.Status),
+		Summary:     summary,
+	}
+	if p.Title == "" {
+		p.Title = statusPage.ResourceName
+	}
+	if p.AccentColor == "" {
+		p.AccentColor = defaultAccentColor
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	if err := pageTemplate.Execute(w, p); err != nil {
+		slog.ErrorContext(ctx, "failed to render status page", "resourceId", statusPage.ResourceID, "error", err)
+	}
+}
+
+// statusDomain returns the resource domain a status page host is for.
+func statusDomain(host string) (string, bool) {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(host)
+	domain, ok := strings.CutPrefix(host, HostPrefix)
+	return domain, ok && domain != ""
+}
+
+func statusLabel(status genDb.ResourceStatus) string {
+	switch status {
+	case genDb.ResourceStatusHealthy, genDb.ResourceStatusDeploying:
+		return "All systems operational"
+	case genDb.ResourceStatusDegraded:
+		return "Degraded performance"
+	case genDb.ResourceStatusUnavailable:
+		return "Major outage"
+	case genDb.ResourceStatusSuspended:
+		return "Under maintenance"
+	default:
+		return "Status unknown"
+	}
+}
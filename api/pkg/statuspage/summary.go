@@ -0,0 +1,133 @@
+// Package statuspage serves the public status pages of resources: current status, uptime and
+// recent incidents, worked out from health probes, the resource's status history and failed
+// deployments.
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// Window is how far back uptime and incidents are reported.
+const Window = 90 * 24 * time.Hour
+
+// maxIncidents caps how many incidents a page lists.
+const maxIncidents = 50
+
+// Incident is a period the resource was unavailable or degraded, or a failed deployment.
+type Incident struct {
+	Title      string
+	StartedAt  time.Time
+	ResolvedAt time.Time // zero while ongoing
+}
+
+// Summary is what a status page reports about a resource.
+type Summary struct {
+	// Uptime is the percentage of health probes that passed during the window or, for resources
+	// that have not been probed, of the window the resource was available, not counting time it
+	// was suspended on purpose.
+	Uptime float64
+	// Latency is the average response time of passing probes; zero without probes.
+	Latency   time.Duration
+	Incidents []Incident // newest first
+}
+
+// Load summarizes the resource's status over the Window ending at now.
+func Load(ctx context.Context, queries genDb.Querier, resourceID int64, now time.Time) (Summary, error) {
+	since := now.Add(-Window)
+	history, err := queries.ListResourceStatusHistory(ctx, genDb.ListResourceStatusHistoryParams{
+		ResourceID: resourceID,
+		Since:      pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		return Summary{}, fmt.Errorf("list status history: %w", err)
+	}
+	failures, err := queries.ListFailedDeploymentsSince(ctx, genDb.ListFailedDeploymentsSinceParams{
+		ResourceID: resourceID,
+		Since:      pgtype.Timestamptz{Time: since, Valid: true},
+		Limit:      maxIncidents,
+	})
+	if err != nil {
+		return Summary{}, fmt.Errorf("list failed deployments: %w", err)
+	}
+	summary := summarize(history, failures, since, now)
+
+	probes, err := queries.GetResourceProbeStats(ctx, genDb.GetResourceProbeStatsParams{
+		ResourceID: resourceID,
+		Since:      pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		return Summary{}, fmt.Errorf("get probe stats: %w", err)
+	}
+	if probes.Total > 0 {
+		summary.Uptime = 100 * float64(probes.Ok) / float64(probes.Total)
+		summary.Latency = time.Duration(probes.AvgLatencyMs * float64(time.Millisecond))
+	}
+	return summary, nil
+}
+
+func summarize(history []genDb.ResourceStatusHistory, failures []genDb.ListFailedDeploymentsSinceRow, since, now time.Time) Summary {
+	var tracked, down time.Duration
+	var incidents []Incident
+
+	for i, h := range history {
+		start := h.StartedAt.Time
+		if start.Before(since) {
+			start = since
+		}
+		end := now
+		if i+1 < len(history) {
+			end = history[i+1].StartedAt.Time
+		}
+		if !end.After(start) {
+			continue
+		}
+
+		switch h.Status {
+		case genDb.ResourceStatusSuspended:
+			continue
+		case genDb.ResourceStatusUnavailable:
+			down += end.Sub(start)
+		}
+		tracked += end.Sub(start)
+
+		var title string
+		switch h.Status {
+		case genDb.ResourceStatusUnavailable:
+			title = "Outage"
+		case genDb.ResourceStatusDegraded:
+			title = "Degraded performance"
+		default:
+			continue
+		}
+		incident := Incident{Title: title, StartedAt: start}
+		if i+1 < len(history) {
+			incident.ResolvedAt = end
+		}
+		incidents = append(incidents, incident)
+	}
+
+	for _, f := range failures {
+		incidents = append(incidents, Incident{
+			Title:      fmt.Sprintf("Deployment failed in %s", f.Region),
+			StartedAt:  f.FailedAt.Time,
+			ResolvedAt: f.FailedAt.Time,
+		})
+	}
+
+	sort.SliceStable(incidents, func(i, j int) bool { return incidents[i].StartedAt.After(incidents[j].StartedAt) })
+	if len(incidents) > maxIncidents {
+		incidents = incidents[:maxIncidents]
+	}
+
+	uptime := 100.0
+	if tracked > 0 {
+		uptime = 100 * float64(tracked-down) / float64(tracked)
+	}
+	return Summary{Uptime: uptime, Incidents: incidents}
+}
@@ -0,0 +1,166 @@
+package statuswatcher
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/kube"
+	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultWatchdogInterval is how often the watchdog looks for stuck deployments when no interval is given.
+const DefaultWatchdogInterval = time.Minute
+
+// DefaultDeploymentTimeout is how long a deployment may stay pending or deploying when no timeout is given.
+const DefaultDeploymentTimeout = 30 * time.Minute
+
+// Watchdog fails active deployments that are still pending or deploying after the timeout, such
+// as those whose allocation failed without the controller ever reporting it. The failure message
+// carries the last error the controller reported for the deployment's Application. Failing a
+// deployment announces it on the change feed, so users and deployment_failed alert rules hear
+// about it like any other failure.
+type Watchdog struct {
+	kubeClient    *kube.Client
+	queries       genDb.Querier
+	locoNamespace string
+	interval      time.Duration
+	timeout       time.Duration
+}
+
+// NewWatchdog creates a Watchdog that runs every interval (DefaultWatchdogInterval if zero) and
+// fails deployments older than timeout (DefaultDeploymentTimeout if zero). kubeClient may be nil,
+// in which case failures only carry the deployment's last status message.
+func NewWatchdog(kubeClient *kube.Client, queries genDb.Querier, locoNamespace string, interval, timeout time.Duration) *Watchdog {
+	if interval <= 0 {
+		interval = DefaultWatchdogInterval
+	}
+	if timeout <= 0 {
+		timeout = DefaultDeploymentTimeout
+	}
+	return &Watchdog{
+		kubeClient:    kubeClient,
+		queries:       queries,
+		locoNamespace: locoNamespace,
+		interval:      interval,
+		timeout:       timeout,
+	}
+}
+
+// Start checks once immediately and then on every tick until ctx is canceled.
+func (w *Watchdog) Start(ctx context.Context) error {
+	slog.InfoContext(ctx, "starting deployment watchdog", "interval", w.interval, "timeout", w.timeout)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Watchdog) check(ctx context.Context) {
+	deployments, err := w.queries.ListStuckDeployments(ctx, pgtype.Timestamptz{Time: time.Now().Add(-w.timeout), Valid: true})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list stuck deployments", "error", err)
+		return
+	}
+
+	for _, deployment := range deployments {
+		if ctx.Err() != nil {
+			return
+		}
+		w.fail(ctx, deployment)
+	}
+}
+
+func (w *Watchdog) fail(ctx context.Context, deployment genDb.ListStuckDeploymentsRow) {
+	message := fmt.Sprintf("Deployment did not become ready within %s", w.timeout)
+	if lastError := w.lastError(ctx, deployment); lastError != "" {
+		message += ": " + lastError
+	}
+
+	failed, err := w.queries.FailStuckDeployment(ctx, genDb.FailStuckDeploymentParams{
+		ID:      deployment.ID,
+		Message: message,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fail stuck deployment", "deploymentId", deployment.ID, "error", err)
+		return
+	}
+	if failed == 0 {
+		// finished, or failed by another replica, since it was listed
+		return
+	}
+	slog.WarnContext(ctx, "failed stuck deployment",
+		"deploymentId", deployment.ID,
+		"resourceId", deployment.ResourceID,
+		"status", deployment.Status,
+		"since", deployment.CreatedAt.Time,
+		"message", message,
+	)
+
+	statuses, err := w.queries.ListActiveDeploymentsByResourceID(ctx, deployment.ResourceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list deployments for resource", "resourceId", deployment.ResourceID, "error", err)
+		return
+	}
+	if err := w.queries.UpdateResourceStatus(ctx, genDb.UpdateResourceStatusParams{
+		ID:     deployment.ResourceID,
+		Status: computeResourceStatus(statuses),
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to update resource status", "resourceId", deployment.ResourceID, "error", err)
+	}
+}
+
+// lastError returns the most recent failing condition of the Application running the deployment,
+// or its status message, falling back to the deployment's own message when the Application can't
+// be read or belongs to another region.
+func (w *Watchdog) lastError(ctx context.Context, deployment genDb.ListStuckDeploymentsRow) string {
+	if w.kubeClient == nil {
+		return deployment.Message
+	}
+
+	app := &locoControllerV1.Application{}
+	key := crClient.ObjectKey{Name: fmt.Sprintf("resource-%d", deployment.ResourceID), Namespace: w.locoNamespace}
+	if err := w.kubeClient.ControllerClient.Get(ctx, key, app); err != nil {
+		if crClient.IgnoreNotFound(err) != nil {
+			slog.WarnContext(ctx, "failed to get Application", "resourceId", deployment.ResourceID, "error", err)
+		}
+		return deployment.Message
+	}
+	// each cluster only runs the active deployment for its own region
+	if app.Spec.Region != deployment.Region {
+		return deployment.Message
+	}
+
+	var latest *metav1.Condition
+	for i := range app.Status.Conditions {
+		condition := &app.Status.Conditions[i]
+		if condition.Status != metav1.ConditionFalse || condition.Message == "" {
+			continue
+		}
+		if latest == nil || condition.LastTransitionTime.After(latest.LastTransitionTime.Time) {
+			latest = condition
+		}
+	}
+	switch {
+	case latest != nil:
+		return fmt.Sprintf("%s: %s", latest.Type, latest.Message)
+	case app.Status.Message != "":
+		return app.Status.Message
+	default:
+		return deployment.Message
+	}
+}
@@ -237,7 +237,7 @@ func convertPhase(phase string) genDb.DeploymentStatus {
 		return genDb.DeploymentStatusPending
 	case "Deploying":
 		return genDb.DeploymentStatusDeploying
-	case "Ready":
+	case "Ready", "Suspended", "Sleeping":
 		return genDb.DeploymentStatusRunning
 	case "Failed":
 		return genDb.DeploymentStatusFailed
@@ -0,0 +1,63 @@
+-- Platform admin queries
+
+-- name: InsertAdminAuditEntry :one
+INSERT INTO admin_audit_log (actor_id, action, target_type, target_id, reason, detail)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListAdminAuditEntries :many
+SELECT * FROM admin_audit_log
+WHERE (sqlc.narg('cursor')::bigint IS NULL OR id < sqlc.narg('cursor')::bigint)
+  AND (sqlc.narg('target_type')::text IS NULL OR target_type = sqlc.narg('target_type')::text)
+  AND (sqlc.narg('target_id')::bigint IS NULL OR target_id = sqlc.narg('target_id')::bigint)
+ORDER BY id DESC
+LIMIT $1;
+
+-- name: ListAllOrgs :many
+-- every org on the platform, newest first, optionally those whose name contains query.
+SELECT * FROM organizations
+WHERE (sqlc.narg('cursor')::bigint IS NULL OR id < sqlc.narg('cursor')::bigint)
+  AND (sqlc.narg('query')::text IS NULL OR name ILIKE '%' || sqlc.narg('query')::text || '%')
+ORDER BY id DESC
+LIMIT $1;
+
+-- name: ListPlatformAdmins :many
+SELECT u.id, u.external_id, u.email, u.name, u.avatar_url, u.created_at, u.updated_at
+FROM users u
+JOIN user_scopes us ON us.user_id = u.id
+WHERE us.entity_type = 'system' AND us.scope = 'admin'
+ORDER BY u.id;
+
+-- name: ListClusters :many
+SELECT * FROM clusters ORDER BY region, id;
+
+-- name: GetCluster :one
+SELECT * FROM clusters WHERE id = $1;
+
+-- name: CreateCluster :one
+INSERT INTO clusters (name, region, provider, is_active, is_default, endpoint)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: UpdateCluster :one
+UPDATE clusters
+SET is_active = $2, is_default = $3, endpoint = $4, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListClusterNodePools :many
+SELECT * FROM cluster_node_pools WHERE cluster_id = $1 ORDER BY name;
+
+-- name: DeleteClusterNodePools :exec
+DELETE FROM cluster_node_pools WHERE cluster_id = $1;
+
+-- name: CreateClusterNodePool :one
+INSERT INTO cluster_node_pools (cluster_id, name, labels, taints)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: SetPlatformDomainActive :one
+UPDATE platform_domains
+SET is_active = $2
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,84 @@
+-- name: CreateAlertRule :one
+INSERT INTO alert_rules (workspace_id, resource_id, name, condition, threshold, window_seconds, enabled, created_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetAlertRule :one
+SELECT * FROM alert_rules WHERE id = $1;
+
+-- name: ListAlertRules :many
+SELECT * FROM alert_rules WHERE workspace_id = $1 ORDER BY id;
+
+-- name: UpdateAlertRule :one
+UPDATE alert_rules SET
+    name = $2,
+    threshold = $3,
+    window_seconds = $4,
+    enabled = $5,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteAlertRule :execrows
+DELETE FROM alert_rules WHERE id = $1;
+
+-- name: CreateAlertTarget :exec
+INSERT INTO alert_targets (rule_id, channel, target) VALUES ($1, $2, $3);
+
+-- name: DeleteAlertTargets :exec
+DELETE FROM alert_targets WHERE rule_id = $1;
+
+-- name: ListAlertTargets :many
+SELECT * FROM alert_targets WHERE rule_id = ANY(sqlc.arg('rule_ids')::bigint[]) ORDER BY rule_id, id;
+
+-- name: ClaimAlertRules :many
+-- takes due rules for this replica by pushing next_evaluation_at an interval out.
+UPDATE alert_rules
+SET next_evaluation_at = NOW() + sqlc.arg('interval')::interval
+WHERE id IN (
+    SELECT id FROM alert_rules
+    WHERE enabled AND next_evaluation_at <= NOW()
+    ORDER BY next_evaluation_at
+    LIMIT sqlc.arg('batch_size')::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: ListAlertRuleResources :many
+-- the services a rule watches: its resource, or every service in its workspace.
+SELECT r.id, r.workspace_id, r.name
+FROM resources r
+WHERE r.workspace_id = sqlc.arg('workspace_id')
+  AND r.type = 'service'
+  AND (sqlc.narg('resource_id')::bigint IS NULL OR r.id = sqlc.narg('resource_id')::bigint)
+ORDER BY r.id;
+
+-- name: GetAlertState :one
+SELECT * FROM alert_states WHERE rule_id = $1 AND resource_id = $2;
+
+-- name: UpsertAlertState :exec
+INSERT INTO alert_states (rule_id, resource_id, firing, value, fired_at, data)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (rule_id, resource_id) DO UPDATE SET
+    firing = EXCLUDED.firing,
+    value = EXCLUDED.value,
+    fired_at = EXCLUDED.fired_at,
+    data = EXCLUDED.data,
+    updated_at = NOW();
+
+-- name: ListFiringAlertStates :many
+SELECT * FROM alert_states WHERE rule_id = ANY(sqlc.arg('rule_ids')::bigint[]) AND firing;
+
+-- name: ListFailedDeploymentsAfter :many
+SELECT id, region, message FROM deployments
+WHERE resource_id = $1 AND status = 'failed' AND id > sqlc.arg('after_id')::bigint
+ORDER BY id;
+
+-- name: GetLatestDeploymentID :one
+SELECT COALESCE(MAX(id), 0)::bigint FROM deployments WHERE resource_id = $1;
+
+-- name: GetResourceProbeLatencyP95 :one
+SELECT COUNT(*) AS probes,
+       COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY latency_ms), 0)::float8 AS p95
+FROM resource_probes
+WHERE resource_id = $1 AND checked_at >= sqlc.arg('since')::timestamptz;
@@ -0,0 +1,36 @@
+-- name: CreateAnnouncement :one
+INSERT INTO announcements (kind, title, body, link, created_by, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id;
+
+-- name: DeleteAnnouncement :execrows
+DELETE FROM announcements WHERE id = $1;
+
+-- name: ListAnnouncements :many
+-- lists unexpired announcements newest first. after_id limits results to
+-- announcements newer than a user's read marker.
+SELECT * FROM announcements
+WHERE (expires_at IS NULL OR expires_at > NOW())
+  AND id > sqlc.arg('after_id')::bigint
+  AND (sqlc.narg('page_token')::bigint IS NULL OR id < sqlc.narg('page_token')::bigint)
+ORDER BY id DESC
+LIMIT $1;
+
+-- name: CountUnreadAnnouncements :one
+SELECT COUNT(*) FROM announcements
+WHERE (expires_at IS NULL OR expires_at > NOW())
+  AND id > sqlc.arg('after_id')::bigint;
+
+-- name: GetLatestAnnouncementID :one
+SELECT COALESCE(MAX(id), 0)::bigint FROM announcements;
+
+-- name: GetAnnouncementReadMarker :one
+SELECT last_read_id FROM announcement_reads WHERE user_id = $1;
+
+-- name: UpsertAnnouncementReadMarker :exec
+-- never moves the marker backwards.
+INSERT INTO announcement_reads (user_id, last_read_id)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET last_read_id = GREATEST(announcement_reads.last_read_id, EXCLUDED.last_read_id),
+    updated_at = NOW();
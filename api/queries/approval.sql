@@ -0,0 +1,41 @@
+-- Two-person approval queries
+
+-- name: CreateApprovalRequest :one
+INSERT INTO approval_requests (action, target_id, workspace_id, requested_by_type, requested_by_id, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetApprovalRequest :one
+SELECT * FROM approval_requests WHERE id = $1;
+
+-- name: GetPendingApprovalRequest :one
+SELECT * FROM approval_requests WHERE action = $1 AND target_id = $2 AND status = 'pending';
+
+-- name: ListApprovalRequestsForWorkspace :many
+SELECT * FROM approval_requests
+WHERE workspace_id = sqlc.arg('workspace_id')
+  AND (NOT sqlc.arg('pending_only')::boolean OR (status = 'pending' AND expires_at > NOW()))
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit');
+
+-- claims a pending request so only one approver executes it
+-- name: MarkApprovalRequestExecuted :one
+UPDATE approval_requests
+SET status = 'executed', approved_by = $2, decided_at = NOW()
+WHERE id = $1 AND status = 'pending' AND expires_at > NOW()
+RETURNING *;
+
+-- puts a claimed request back when executing it failed
+-- name: ReopenApprovalRequest :exec
+UPDATE approval_requests
+SET status = 'pending', approved_by = NULL, decided_at = NULL
+WHERE id = $1 AND status = 'executed';
+
+-- name: CancelApprovalRequest :one
+UPDATE approval_requests
+SET status = 'cancelled', decided_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: WorkspaceHasResourcesWithLabels :one
+SELECT EXISTS (SELECT 1 FROM resources WHERE workspace_id = $1 AND labels @> sqlc.arg('labels')::jsonb) AS has_resources;
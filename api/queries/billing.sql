@@ -0,0 +1,110 @@
+-- name: ListBillingPlans :many
+SELECT * FROM billing_plans WHERE is_active = true ORDER BY monthly_cents ASC, id ASC;
+
+-- name: GetBillingPlan :one
+SELECT * FROM billing_plans WHERE id = $1;
+
+-- name: GetOrgBilling :one
+SELECT * FROM org_billing WHERE org_id = $1;
+
+-- name: CreateOrgBilling :one
+-- keeps the customer of an org that already has one, so a lost race returns the winner's row.
+INSERT INTO org_billing (org_id, stripe_customer_id)
+VALUES ($1, $2)
+ON CONFLICT (org_id) DO UPDATE SET updated_at = NOW()
+RETURNING *;
+
+-- name: SetOrgSubscription :one
+UPDATE org_billing
+SET plan_id = $2,
+    stripe_subscription_id = $3,
+    subscription_status = $4,
+    current_period_end = $5,
+    updated_at = NOW()
+WHERE org_id = $1
+RETURNING *;
+
+-- name: SyncOrgSubscription :execrows
+-- applies a subscription webhook; events of a subscription the org has since replaced match nothing.
+UPDATE org_billing
+SET subscription_status = sqlc.arg('subscription_status'),
+    current_period_end = sqlc.arg('current_period_end'),
+    plan_id = COALESCE((SELECT p.id FROM billing_plans p WHERE p.stripe_price_id = sqlc.arg('stripe_price_id')), plan_id),
+    delinquent = sqlc.arg('subscription_status')::subscription_status IN ('past_due', 'unpaid'),
+    updated_at = NOW()
+WHERE stripe_customer_id = sqlc.arg('stripe_customer_id') AND stripe_subscription_id = sqlc.arg('stripe_subscription_id');
+
+-- name: EndOrgSubscription :execrows
+-- unpaid invoices of the ended subscription keep the org delinquent until they are paid.
+UPDATE org_billing
+SET plan_id = NULL,
+    stripe_subscription_id = NULL,
+    subscription_status = 'canceled',
+    current_period_end = NULL,
+    updated_at = NOW()
+WHERE stripe_customer_id = $1 AND stripe_subscription_id = $2;
+
+-- name: SetOrgDelinquent :execrows
+UPDATE org_billing SET delinquent = $2, updated_at = NOW() WHERE stripe_customer_id = $1;
+
+-- name: IsResourceOrgDelinquent :one
+-- false for orgs that never set up billing.
+SELECT COALESCE((
+    SELECT b.delinquent
+    FROM resources r
+    JOIN workspaces w ON w.id = r.workspace_id
+    JOIN org_billing b ON b.org_id = w.org_id
+    WHERE r.id = $1
+), false)::boolean AS delinquent;
+
+-- name: GetResourcePlanName :one
+-- empty for orgs without a plan.
+SELECT COALESCE((
+    SELECT p.name
+    FROM resources r
+    JOIN workspaces w ON w.id = r.workspace_id
+    JOIN org_billing b ON b.org_id = w.org_id
+    JOIN billing_plans p ON p.id = b.plan_id
+    WHERE r.id = $1
+), '')::text AS plan_name;
+
+-- name: ListMeteredDeployments :many
+-- running deployments of awake resources with what their cluster charges, and whether their org has a subscription to bill.
+SELECT d.id AS deployment_id, d.resource_id, d.region, d.replicas, d.spec, r.spec AS resource_spec, r.type AS resource_type,
+       w.org_id, p.currency, p.cpu_core_hour_micros, p.memory_gib_hour_micros,
+       COALESCE(b.subscription_status IN ('trialing', 'active', 'past_due'), false)::boolean AS billable
+FROM deployments d
+JOIN resources r ON r.id = d.resource_id
+JOIN workspaces w ON w.id = r.workspace_id
+JOIN cluster_pricing p ON p.cluster_id = d.cluster_id
+LEFT JOIN org_billing b ON b.org_id = w.org_id
+LEFT JOIN resource_sleep s ON s.resource_id = r.id
+WHERE d.is_active = true AND d.status = 'running' AND s.asleep_at IS NULL
+ORDER BY d.id;
+
+-- name: RecordUsage :execrows
+-- records an hour of a deployment once, however many replicas meter it.
+INSERT INTO usage_records (org_id, resource_id, deployment_id, region, hour, replicas, cpu_millicores, memory_bytes, cost_micros, currency, billable)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (deployment_id, hour) DO NOTHING;
+
+-- name: ListUnreportedUsage :many
+SELECT u.id, u.hour, u.cost_micros, b.stripe_customer_id
+FROM usage_records u
+JOIN org_billing b ON b.org_id = u.org_id
+WHERE u.billable = true AND u.reported_at IS NULL
+ORDER BY u.id
+LIMIT $1;
+
+-- name: MarkUsageReported :exec
+UPDATE usage_records SET reported_at = NOW() WHERE id = $1;
+
+-- name: ListOrgUsage :many
+-- an org's usage per resource and region over [since, until).
+SELECT u.resource_id, COALESCE(r.name, '')::text AS resource_name, u.region, u.currency,
+       SUM(u.replicas)::bigint AS replica_hours, SUM(u.cost_micros)::bigint AS cost_micros
+FROM usage_records u
+LEFT JOIN resources r ON r.id = u.resource_id
+WHERE u.org_id = sqlc.arg('org_id') AND u.hour >= sqlc.arg('since') AND u.hour < sqlc.arg('until')
+GROUP BY u.resource_id, r.name, u.region, u.currency
+ORDER BY cost_micros DESC, u.resource_id;
@@ -0,0 +1,92 @@
+-- Config group queries
+
+-- name: CreateConfigGroup :one
+INSERT INTO config_groups (workspace_id, name, description, env, created_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetConfigGroup :one
+SELECT * FROM config_groups WHERE id = $1;
+
+-- name: ListConfigGroupsForWorkspace :many
+SELECT * FROM config_groups
+WHERE workspace_id = $1
+ORDER BY name;
+
+-- name: UpdateConfigGroup :one
+UPDATE config_groups
+SET description = COALESCE(sqlc.narg('description'), description),
+    env = COALESCE(sqlc.narg('env'), env),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteConfigGroup :exec
+DELETE FROM config_groups WHERE id = $1;
+
+-- name: AttachConfigGroup :exec
+INSERT INTO config_group_resources (config_group_id, resource_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: DetachConfigGroup :execrows
+DELETE FROM config_group_resources WHERE config_group_id = $1 AND resource_id = $2;
+
+-- name: ListConfigGroupResourceIDs :many
+SELECT resource_id FROM config_group_resources
+WHERE config_group_id = $1
+ORDER BY resource_id;
+
+-- name: ListResourceConfigGroups :many
+-- in the order their env vars apply, so later groups win.
+SELECT g.*
+FROM config_groups g
+JOIN config_group_resources cgr ON cgr.config_group_id = g.id
+WHERE cgr.resource_id = $1
+ORDER BY cgr.attached_at, g.id;
+
+-- name: CreateConfigRollout :one
+INSERT INTO config_rollouts (config_group_id, previous_env, resource_ids, created_by)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetConfigRollout :one
+SELECT * FROM config_rollouts WHERE id = $1;
+
+-- name: GetActiveConfigRollout :one
+SELECT * FROM config_rollouts
+WHERE config_group_id = $1 AND status IN ('pending', 'running');
+
+-- name: ClaimConfigRollout :one
+-- picks up the oldest pending rollout, or a running one whose worker stopped reporting progress.
+UPDATE config_rollouts
+SET status = 'running', updated_at = NOW()
+WHERE id = (
+    SELECT id FROM config_rollouts
+    WHERE status = 'pending'
+       OR (status = 'running' AND updated_at < @stale_before::timestamptz)
+    ORDER BY id
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: RecordConfigRolloutDeployment :exec
+UPDATE config_rollouts
+SET current_deployment_id = $2, updated_at = NOW()
+WHERE id = $1;
+
+-- name: RecordConfigRolloutProgress :exec
+UPDATE config_rollouts
+SET completed_resources = completed_resources + 1, current_deployment_id = NULL, updated_at = NOW()
+WHERE id = $1;
+
+-- name: CompleteConfigRollout :exec
+UPDATE config_rollouts
+SET status = 'completed', updated_at = NOW(), completed_at = NOW()
+WHERE id = $1;
+
+-- name: FailConfigRollout :exec
+UPDATE config_rollouts
+SET status = 'failed', error = $2, updated_at = NOW(), completed_at = NOW()
+WHERE id = $1;
@@ -1,22 +1,35 @@
 -- Deployment queries
 
 -- name: CreateDeployment :one
-INSERT INTO deployments (resource_id, resource_region_id, cluster_id, region, replicas, status, is_active, message, spec, spec_version)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+INSERT INTO deployments (resource_id, resource_region_id, cluster_id, region, replicas, status, is_active, message, spec, spec_version, annotation_message, annotation_author, annotation_git_sha, created_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 RETURNING id;
 
 -- name: GetDeploymentByID :one
 SELECT * FROM deployments WHERE id = $1;
 
 -- name: ListDeploymentsForResource :many
+-- newest first unless oldest_first; each filter left NULL matches every deployment.
 SELECT * FROM deployments d
 WHERE d.resource_id = $1
+  AND (sqlc.narg('statuses')::deployment_status[] IS NULL OR d.status = ANY(sqlc.narg('statuses')::deployment_status[]))
+  AND (sqlc.narg('created_after')::timestamptz IS NULL OR d.created_at >= sqlc.narg('created_after')::timestamptz)
+  AND (sqlc.narg('created_before')::timestamptz IS NULL OR d.created_at < sqlc.narg('created_before')::timestamptz)
+  AND (sqlc.narg('created_by')::bigint IS NULL OR d.created_by = sqlc.narg('created_by')::bigint)
+  AND (NOT sqlc.arg('active_only')::bool OR d.is_active)
   AND (sqlc.narg('page_token')::text IS NULL
-       OR (d.created_at, d.id) < (
+       OR (NOT sqlc.arg('oldest_first')::bool AND (d.created_at, d.id) < (
          (SELECT created_at FROM deployments WHERE id = sqlc.narg('page_token')::bigint),
          sqlc.narg('page_token')::bigint
        ))
-ORDER BY d.created_at DESC, d.id DESC
+       OR (sqlc.arg('oldest_first')::bool AND (d.created_at, d.id) > (
+         (SELECT created_at FROM deployments WHERE id = sqlc.narg('page_token')::bigint),
+         sqlc.narg('page_token')::bigint
+       )))
+ORDER BY
+  CASE WHEN sqlc.arg('oldest_first')::bool THEN d.created_at END ASC,
+  CASE WHEN sqlc.arg('oldest_first')::bool THEN d.id END ASC,
+  d.created_at DESC, d.id DESC
 LIMIT $2;
 
 -- name: MarkPreviousDeploymentsNotActive :exec
@@ -61,7 +74,67 @@ SELECT * FROM deployments
 WHERE resource_id = $1 AND is_active = true
 ORDER BY created_at DESC;
 
+-- name: ListStuckDeployments :many
+-- active deployments still pending or deploying since before the cutoff, oldest first
+SELECT id, resource_id, region, status, message, created_at
+FROM deployments
+WHERE is_active = true
+  AND status IN ('pending', 'deploying')
+  AND created_at < sqlc.arg('cutoff')::timestamptz
+ORDER BY created_at, id;
+
+-- name: FailStuckDeployment :execrows
+-- leaves the deployment alone if it finished or failed since it was listed.
+UPDATE deployments
+SET status = 'failed', message = $2, completed_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND status IN ('pending', 'deploying');
+
+-- name: ListActiveDeploymentsForDrift :many
+SELECT d.id, d.resource_id, d.region, d.spec, d.drift, r.workspace_id, r.type
+FROM deployments d
+JOIN resources r ON r.id = d.resource_id
+WHERE d.is_active = true;
+
+-- name: SetDeploymentDrift :exec
+-- keeps the original detection time while the deployment stays drifted.
+UPDATE deployments
+SET drift = $2, drift_detected_at = COALESCE(drift_detected_at, NOW())
+WHERE id = $1;
+
+-- name: ClearDeploymentDrift :exec
+UPDATE deployments
+SET drift = NULL, drift_detected_at = NULL
+WHERE id = $1 AND drift IS NOT NULL;
+
 -- name: MarkDeploymentNotActive :exec
 UPDATE deployments
 SET is_active = false, updated_at = NOW()
 WHERE id = $1;
+
+-- name: GetPreviousDeploymentInRegion :one
+SELECT * FROM deployments d
+WHERE d.resource_id = $1
+  AND d.region = $2
+  AND (d.created_at, d.id) < (sqlc.arg('created_at')::timestamptz, sqlc.arg('id')::bigint)
+ORDER BY d.created_at DESC, d.id DESC
+LIMIT 1;
+
+-- name: PruneDeploymentHistory :execrows
+-- deletes inactive deployments beyond each workspace's deployment_history_limit, newest kept first
+DELETE FROM deployments d
+USING (
+    SELECT d2.id,
+           w.deployment_history_limit AS history_limit,
+           ROW_NUMBER() OVER (PARTITION BY d2.resource_id ORDER BY d2.created_at DESC, d2.id DESC) AS position
+    FROM deployments d2
+    JOIN resources r ON r.id = d2.resource_id
+    JOIN workspaces w ON w.id = r.workspace_id
+    WHERE d2.is_active = false
+) ranked
+WHERE d.id = ranked.id
+  AND ranked.history_limit > 0
+  AND ranked.position > ranked.history_limit;
+
+-- name: LockResourceDeployments :exec
+-- holds the resource's deployment lock until the transaction ends, so its deployments are created one at a time.
+SELECT pg_advisory_xact_lock(hashtextextended('deployments', sqlc.arg('resource_id')::bigint));
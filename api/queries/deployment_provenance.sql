@@ -0,0 +1,14 @@
+-- Deployment provenance queries
+
+-- name: GetDeploymentProvenance :one
+SELECT * FROM deployment_provenance WHERE deployment_id = $1;
+
+-- name: UpsertDeploymentProvenance :exec
+INSERT INTO deployment_provenance (deployment_id, image, commit_sha, repository_url, error, fetched_at)
+VALUES ($1, $2, $3, $4, $5, NOW())
+ON CONFLICT (deployment_id) DO UPDATE
+SET image = EXCLUDED.image,
+    commit_sha = EXCLUDED.commit_sha,
+    repository_url = EXCLUDED.repository_url,
+    error = EXCLUDED.error,
+    fetched_at = EXCLUDED.fetched_at;
@@ -0,0 +1,10 @@
+-- name: RecordDeprecatedUsage :exec
+INSERT INTO deprecated_usage (surface, client, user_agent, request_count, first_seen_at, last_seen_at)
+VALUES ($1, $2, $3, $4, sqlc.arg('seen_at')::timestamptz, sqlc.arg('seen_at')::timestamptz)
+ON CONFLICT (surface, client, user_agent) DO UPDATE
+SET request_count = deprecated_usage.request_count + EXCLUDED.request_count,
+    last_seen_at = GREATEST(deprecated_usage.last_seen_at, EXCLUDED.last_seen_at);
+
+-- name: ListDeprecatedUsage :many
+SELECT * FROM deprecated_usage
+ORDER BY surface, request_count DESC;
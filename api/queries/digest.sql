@@ -0,0 +1,44 @@
+-- name: ListWorkspacesWithoutDigest :many
+-- active workspaces that existed before the week ended and have no digest for it yet.
+SELECT w.id, w.name
+FROM workspaces w
+WHERE w.archived_at IS NULL AND w.created_at < sqlc.arg('week_end')
+  AND NOT EXISTS (
+      SELECT 1 FROM workspace_digests d WHERE d.workspace_id = w.id AND d.week_start = sqlc.arg('week_start')
+  )
+ORDER BY w.id;
+
+-- name: GetWorkspaceDeploymentCounts :one
+-- deployments started in a workspace over [since, until), and how many of them failed.
+SELECT COUNT(*)::int AS deployments,
+       (COUNT(*) FILTER (WHERE d.status = 'failed'))::int AS failed_deployments
+FROM deployments d
+JOIN resources r ON r.id = d.resource_id
+WHERE r.workspace_id = sqlc.arg('workspace_id') AND d.created_at >= sqlc.arg('since') AND d.created_at < sqlc.arg('until');
+
+-- name: ListWorkspaceTopResources :many
+-- a workspace's resources by metered replica hours over [since, until), busiest first.
+SELECT r.id AS resource_id, r.name AS resource_name, u.currency,
+       SUM(u.replicas)::bigint AS replica_hours, SUM(u.cost_micros)::bigint AS cost_micros
+FROM usage_records u
+JOIN resources r ON r.id = u.resource_id
+WHERE r.workspace_id = sqlc.arg('workspace_id') AND u.hour >= sqlc.arg('since') AND u.hour < sqlc.arg('until')
+GROUP BY r.id, r.name, u.currency
+ORDER BY replica_hours DESC, r.id
+LIMIT sqlc.arg('row_limit');
+
+-- name: CreateWorkspaceDigest :execrows
+-- leaves an existing digest alone, so only the replica that stores it sends it.
+INSERT INTO workspace_digests (workspace_id, week_start, deployments, failed_deployments, top_resources)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (workspace_id, week_start) DO NOTHING;
+
+-- name: GetWorkspaceDigest :one
+SELECT * FROM workspace_digests
+WHERE workspace_id = $1 AND week_start = $2;
+
+-- name: GetLatestWorkspaceDigest :one
+SELECT * FROM workspace_digests
+WHERE workspace_id = $1
+ORDER BY week_start DESC
+LIMIT 1;
@@ -0,0 +1,40 @@
+-- name: ListPlatformDomainTargets :many
+-- platform-provided domains with the ingress endpoint of the cluster serving their resource's
+-- primary region; domains whose region has no cluster with an endpoint are left out.
+SELECT rd.domain, c.endpoint
+FROM resource_domains rd
+JOIN resource_regions rr ON rr.resource_id = rd.resource_id AND rr.is_primary
+JOIN LATERAL (
+    SELECT cl.endpoint
+    FROM clusters cl
+    WHERE cl.region = rr.region
+      AND cl.is_active = true
+      AND cl.endpoint IS NOT NULL
+    ORDER BY cl.is_default DESC, cl.created_at ASC
+    LIMIT 1
+) c ON true
+WHERE rd.domain_source = 'platform_provided'
+ORDER BY rd.domain;
+
+-- name: ListDNSRecords :many
+SELECT * FROM dns_records ORDER BY hostname;
+
+-- name: UpsertDNSRecord :exec
+-- records a record as published, or as failed with the error when publishing it did not succeed.
+INSERT INTO dns_records (hostname, record_type, target, provider_record_id, status, last_error, synced_at)
+VALUES ($1, $2, $3, $4, $5, $6, CASE WHEN $5 = 'published' THEN NOW() END)
+ON CONFLICT (hostname) DO UPDATE SET
+    record_type = EXCLUDED.record_type,
+    target = EXCLUDED.target,
+    provider_record_id = EXCLUDED.provider_record_id,
+    status = EXCLUDED.status,
+    last_error = EXCLUDED.last_error,
+    synced_at = COALESCE(EXCLUDED.synced_at, dns_records.synced_at),
+    updated_at = NOW();
+
+-- name: SetDNSRecordError :exec
+UPDATE dns_records SET status = 'failed', last_error = $2, updated_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteDNSRecord :exec
+DELETE FROM dns_records WHERE id = $1;
@@ -5,9 +5,10 @@ INSERT INTO resource_domains (
     domain_source,
     subdomain_label,
     platform_domain_id,
-    is_primary
+    is_primary,
+    status
 )
-VALUES ($1, $2, $3, $4, $5, $6)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING id;
 
 -- name: CreatePlatformDomain :one
@@ -63,10 +64,36 @@ SELECT
     rd.platform_domain_id,
     rd.is_primary,
     rd.created_at,
-    rd.updated_at
+    rd.updated_at,
+    rd.access_control,
+    rd.status,
+    rd.status_message,
+    rd.status_checked_at,
+    rd.redirect,
+    rd.tls
 FROM resource_domains rd
 WHERE rd.id = $1;
 
+-- name: GetResourceDomainByName :one
+SELECT 
+    rd.id,
+    rd.resource_id,
+    rd.domain,
+    rd.domain_source,
+    rd.subdomain_label,
+    rd.platform_domain_id,
+    rd.is_primary,
+    rd.created_at,
+    rd.updated_at,
+    rd.access_control,
+    rd.status,
+    rd.status_message,
+    rd.status_checked_at,
+    rd.redirect,
+    rd.tls
+FROM resource_domains rd
+WHERE rd.domain = $1;
+
 -- name: ListResourceDomains :many
 SELECT 
     rd.id,
@@ -77,11 +104,39 @@ SELECT
     rd.platform_domain_id,
     rd.is_primary,
     rd.created_at,
-    rd.updated_at
+    rd.updated_at,
+    rd.access_control,
+    rd.status,
+    rd.status_message,
+    rd.status_checked_at,
+    rd.redirect,
+    rd.tls
 FROM resource_domains rd
 WHERE rd.resource_id = $1
 ORDER BY rd.is_primary DESC, rd.created_at ASC;
 
+-- name: ListResourceDomainsForResources :many
+-- the domains of several resources at once, in the order ListResourceDomains gives each.
+SELECT 
+    rd.id,
+    rd.resource_id,
+    rd.domain,
+    rd.domain_source,
+    rd.subdomain_label,
+    rd.platform_domain_id,
+    rd.is_primary,
+    rd.created_at,
+    rd.updated_at,
+    rd.access_control,
+    rd.status,
+    rd.status_message,
+    rd.status_checked_at,
+    rd.redirect,
+    rd.tls
+FROM resource_domains rd
+WHERE rd.resource_id = ANY(sqlc.arg('resource_ids')::bigint[])
+ORDER BY rd.resource_id, rd.is_primary DESC, rd.created_at ASC;
+
 -- name: ListAllLocoOwnedDomains :many
 SELECT 
     rd.id,
@@ -95,6 +150,10 @@ JOIN platform_domains pd ON rd.platform_domain_id = pd.id
 WHERE rd.domain_source = 'platform_provided'
 ORDER BY rd.created_at DESC;
 
+-- name: LockResourceDomains :exec
+-- holds the resource's row lock until the transaction ends, so its domains are added one at a time.
+SELECT id FROM resources WHERE id = $1 FOR UPDATE;
+
 -- name: GetResourceDomainCount :one
 SELECT COUNT(*) as count FROM resource_domains WHERE resource_id = $1;
 
@@ -110,11 +169,72 @@ WHERE id = $1 AND resource_id = $2
 RETURNING id;
 
 -- name: UpdateResourceDomain :one
+-- a user-provided domain that changes needs its records checked again.
 UPDATE resource_domains
 SET domain = $2,
+    status = CASE WHEN domain_source = 'user_provided' THEN 'pending' ELSE status END,
+    status_message = '',
     updated_at = NOW()
 WHERE id = $1
 RETURNING id;
 
+-- name: SetResourceDomainAccessControl :one
+UPDATE resource_domains
+SET access_control = $3,
+    updated_at = NOW()
+WHERE id = $1 AND resource_id = $2
+RETURNING *;
+
+-- name: SetResourceDomainRedirect :one
+UPDATE resource_domains
+SET redirect = $3,
+    updated_at = NOW()
+WHERE id = $1 AND resource_id = $2
+RETURNING *;
+
+-- name: SetResourceDomainTLSPolicy :one
+UPDATE resource_domains
+SET tls = $3,
+    updated_at = NOW()
+WHERE id = $1 AND resource_id = $2
+RETURNING *;
+
 -- name: DeleteResourceDomain :exec
 DELETE FROM resource_domains WHERE id = $1;
+
+-- name: GetResourceIngressEndpoint :one
+-- the ingress endpoint of the cluster serving the resource's primary region.
+SELECT cl.endpoint
+FROM resource_regions rr
+JOIN clusters cl ON cl.region = rr.region
+WHERE rr.resource_id = $1
+  AND rr.is_primary
+  AND cl.is_active = true
+  AND cl.endpoint IS NOT NULL
+ORDER BY cl.is_default DESC, cl.created_at ASC
+LIMIT 1;
+
+-- name: ListPendingCustomDomains :many
+-- pending user-provided domains with the ingress endpoint their records should point at, which is
+-- unset when the resource's primary region has no cluster with an endpoint.
+SELECT rd.id, rd.domain, c.endpoint
+FROM resource_domains rd
+LEFT JOIN resource_regions rr ON rr.resource_id = rd.resource_id AND rr.is_primary
+LEFT JOIN LATERAL (
+    SELECT cl.endpoint
+    FROM clusters cl
+    WHERE cl.region = rr.region
+      AND cl.is_active = true
+      AND cl.endpoint IS NOT NULL
+    ORDER BY cl.is_default DESC, cl.created_at ASC
+    LIMIT 1
+) c ON true
+WHERE rd.status = 'pending'
+ORDER BY rd.status_checked_at ASC NULLS FIRST;
+
+-- name: SetResourceDomainStatus :exec
+UPDATE resource_domains
+SET status = $2,
+    status_message = $3,
+    status_checked_at = NOW()
+WHERE id = $1;
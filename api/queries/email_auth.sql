@@ -0,0 +1,36 @@
+-- Email and password authentication queries for sqlc
+
+-- name: CreateUserPassword :exec
+INSERT INTO user_passwords (user_id, password_hash)
+VALUES ($1, $2);
+
+-- name: GetUserPassword :one
+SELECT user_id, password_hash, verified_at, updated_at
+FROM user_passwords
+WHERE user_id = $1;
+
+-- name: MarkUserPasswordVerified :exec
+UPDATE user_passwords
+SET verified_at = COALESCE(verified_at, NOW()), updated_at = NOW()
+WHERE user_id = $1;
+
+-- name: SetUserPasswordHash :exec
+-- replaces a password after a reset. Following the emailed link proves the address, so it is verified too.
+UPDATE user_passwords
+SET password_hash = $2, verified_at = COALESCE(verified_at, NOW()), updated_at = NOW()
+WHERE user_id = $1;
+
+-- name: CreateEmailToken :exec
+INSERT INTO email_tokens (token_hash, user_id, purpose, expires_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: ConsumeEmailToken :one
+-- deletes an unexpired token, returning the user it was sent to.
+DELETE FROM email_tokens
+WHERE token_hash = $1 AND purpose = $2 AND expires_at > NOW()
+RETURNING user_id;
+
+-- name: DeleteEmailTokens :exec
+-- invalidates the tokens of a purpose sent to a user, e.g. when a newer one is sent.
+DELETE FROM email_tokens
+WHERE user_id = $1 AND purpose = $2;
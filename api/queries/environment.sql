@@ -0,0 +1,40 @@
+-- Environment queries
+
+-- name: CreateEnvironment :one
+INSERT INTO environments (workspace_id, name, description, env, created_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetEnvironmentByID :one
+SELECT * FROM environments WHERE id = $1;
+
+-- name: GetEnvironmentByName :one
+SELECT * FROM environments WHERE workspace_id = $1 AND name = $2;
+
+-- name: ListEnvironmentsForWorkspace :many
+SELECT * FROM environments
+WHERE workspace_id = $1
+ORDER BY created_at ASC, id ASC;
+
+-- name: UpdateEnvironment :one
+UPDATE environments
+SET description = COALESCE(sqlc.narg('description'), description),
+    env = COALESCE(sqlc.narg('env'), env),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteEnvironment :exec
+DELETE FROM environments WHERE id = $1;
+
+-- name: CountEnvironmentResources :one
+SELECT COUNT(*) FROM resources WHERE environment_id = sqlc.arg('environment_id')::bigint;
+
+-- name: GetEnvironmentWorkspaceOrganizationID :one
+SELECT e.workspace_id, w.org_id FROM environments e JOIN workspaces w ON e.workspace_id = w.id WHERE e.id = $1;
+
+-- name: GetResourceByNameInEnvironment :one
+-- used to find the counterpart of a resource when promoting between environments.
+SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at, r.maintenance_message, r.environment_id, r.external_id, r.labels, r.suspended_by_archive
+FROM resources r
+WHERE r.environment_id = sqlc.arg('environment_id')::bigint AND r.name = sqlc.arg('name');
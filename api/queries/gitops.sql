@@ -0,0 +1,15 @@
+-- GitOps queries
+
+-- name: GetGitOpsApplication :one
+SELECT * FROM gitops_applications WHERE resource_id = $1;
+
+-- name: UpsertGitOpsApplication :exec
+INSERT INTO gitops_applications (resource_id, repo, path, commit_sha, spec_hash, deployment_id, applied_at)
+VALUES ($1, $2, $3, $4, $5, $6, NOW())
+ON CONFLICT (resource_id) DO UPDATE
+SET repo = EXCLUDED.repo,
+    path = EXCLUDED.path,
+    commit_sha = EXCLUDED.commit_sha,
+    spec_hash = EXCLUDED.spec_hash,
+    deployment_id = EXCLUDED.deployment_id,
+    applied_at = NOW();
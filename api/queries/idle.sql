@@ -0,0 +1,46 @@
+-- name: ListIdleSleepCandidates :many
+-- running services of free-tier orgs whose deployments and last wake are older than idle_for, and
+-- whose domains are all under app_domain.
+SELECT r.id AS resource_id, r.workspace_id
+FROM resources r
+JOIN workspaces w ON w.id = r.workspace_id
+LEFT JOIN org_billing b ON b.org_id = w.org_id
+LEFT JOIN billing_plans p ON p.id = b.plan_id
+LEFT JOIN resource_sleep s ON s.resource_id = r.id
+WHERE r.type = 'service'
+  AND r.status <> 'suspended'
+  AND s.asleep_at IS NULL
+  AND (s.woke_at IS NULL OR s.woke_at < NOW() - sqlc.arg('idle_for')::interval)
+  AND NOT COALESCE(b.subscription_status IN ('trialing', 'active', 'past_due') AND NOT COALESCE(p.idle_sleep, false), false)
+  AND EXISTS (
+      SELECT 1 FROM deployments d
+      WHERE d.resource_id = r.id AND d.is_active = true AND d.status = 'running'
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM deployments d
+      WHERE d.resource_id = r.id AND d.is_active = true AND d.created_at >= NOW() - sqlc.arg('idle_for')::interval
+  )
+  -- the API only wakes services on hosts under the app domain
+  AND NOT EXISTS (
+      SELECT 1 FROM resource_domains rd
+      WHERE rd.resource_id = r.id AND rd.domain NOT LIKE ('%.' || sqlc.arg('app_domain')::text)
+  )
+ORDER BY r.id;
+
+-- name: SleepResource :execrows
+-- affects no rows when the resource is already asleep, so one replica puts it to sleep.
+INSERT INTO resource_sleep (resource_id, asleep_at)
+VALUES ($1, NOW())
+ON CONFLICT (resource_id) DO UPDATE SET asleep_at = NOW()
+WHERE resource_sleep.asleep_at IS NULL;
+
+-- name: WakeResource :execrows
+-- affects no rows when the resource is awake, so one replica wakes it.
+UPDATE resource_sleep SET asleep_at = NULL, woke_at = NOW()
+WHERE resource_id = $1 AND asleep_at IS NOT NULL;
+
+-- name: GetResourceSleepByDomain :one
+SELECT s.resource_id, s.asleep_at, s.woke_at
+FROM resource_domains rd
+JOIN resource_sleep s ON s.resource_id = rd.resource_id
+WHERE rd.domain = $1;
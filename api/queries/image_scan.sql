@@ -0,0 +1,17 @@
+-- Image scan queries
+
+-- name: GetImageScan :one
+SELECT * FROM image_scans WHERE image = $1;
+
+-- name: UpsertImageScan :one
+INSERT INTO image_scans (image, critical, high, medium, low, findings, error, scanned_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+ON CONFLICT (image) DO UPDATE
+SET critical = EXCLUDED.critical,
+    high = EXCLUDED.high,
+    medium = EXCLUDED.medium,
+    low = EXCLUDED.low,
+    findings = EXCLUDED.findings,
+    error = EXCLUDED.error,
+    scanned_at = EXCLUDED.scanned_at
+RETURNING *;
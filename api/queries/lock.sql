@@ -0,0 +1,36 @@
+-- Resource lock and deletion protection queries
+
+-- name: LockResource :one
+INSERT INTO resource_locks (resource_id, reason, locked_by_type, locked_by_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (resource_id) DO UPDATE SET reason = EXCLUDED.reason
+RETURNING *;
+
+-- name: UnlockResource :exec
+DELETE FROM resource_locks WHERE resource_id = $1;
+
+-- name: GetResourceLock :one
+SELECT * FROM resource_locks WHERE resource_id = $1;
+
+-- what protects resource x from being deleted?
+-- name: GetResourceProtection :one
+SELECT (l.resource_id IS NOT NULL)::boolean AS locked, COALESCE(l.reason, '')::text AS lock_reason, o.deletion_protection
+FROM resources r
+JOIN workspaces w ON w.id = r.workspace_id
+JOIN organizations o ON o.id = w.org_id
+LEFT JOIN resource_locks l ON l.resource_id = r.id
+WHERE r.id = $1;
+
+-- what protects workspace x from being deleted?
+-- name: GetWorkspaceProtection :one
+SELECT o.deletion_protection,
+       (SELECT COUNT(*) FROM resource_locks l JOIN resources r ON r.id = l.resource_id WHERE r.workspace_id = w.id) AS locked_resources
+FROM workspaces w
+JOIN organizations o ON o.id = w.org_id
+WHERE w.id = $1;
+
+-- name: SetOrgDeletionProtection :one
+UPDATE organizations
+SET deletion_protection = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,45 @@
+-- MFA queries for sqlc
+
+-- name: UpsertUserTOTP :exec
+-- starts an enrollment, replacing one that was never confirmed.
+INSERT INTO user_totp (user_id, secret)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE SET secret = EXCLUDED.secret, last_step = 0, created_at = NOW()
+WHERE user_totp.enabled_at IS NULL;
+
+-- name: GetUserTOTP :one
+SELECT user_id, secret, enabled_at, last_step, created_at
+FROM user_totp
+WHERE user_id = $1;
+
+-- name: UseUserTOTPStep :execrows
+-- records the step of an accepted code, and enables the second factor if it was being enrolled. No rows
+-- are affected when the step was already used.
+UPDATE user_totp
+SET last_step = $2, enabled_at = COALESCE(enabled_at, NOW())
+WHERE user_id = $1 AND last_step < $2;
+
+-- name: DeleteUserTOTP :exec
+DELETE FROM user_totp WHERE user_id = $1;
+
+-- does user x belong to an organization requiring MFA?
+-- name: UserRequiresMFA :one
+SELECT EXISTS(
+  SELECT 1 FROM organizations o
+  JOIN organization_members om ON om.organization_id = o.id
+  WHERE om.user_id = $1 AND o.require_mfa
+) AS requires_mfa;
+
+-- name: ListUserMFAOrganizations :many
+-- lists the organizations of a user that require MFA.
+SELECT o.id, o.name
+FROM organizations o
+JOIN organization_members om ON om.organization_id = o.id
+WHERE om.user_id = $1 AND o.require_mfa
+ORDER BY o.name;
+
+-- name: SetOrgRequireMFA :one
+UPDATE organizations
+SET require_mfa = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,105 @@
+-- Notification preference, delivery and inbox queries
+
+-- name: ListUserNotificationPreferences :many
+SELECT * FROM user_notification_preferences WHERE user_id = $1 ORDER BY event;
+
+-- name: UpsertUserNotificationPreference :exec
+INSERT INTO user_notification_preferences (user_id, event, email, webhook_url)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, event) DO UPDATE SET
+    email = EXCLUDED.email,
+    webhook_url = EXCLUDED.webhook_url,
+    updated_at = NOW();
+
+-- name: DeleteUserNotificationPreference :exec
+DELETE FROM user_notification_preferences WHERE user_id = $1 AND event = $2;
+
+-- name: ListWorkspaceNotificationDefaults :many
+SELECT * FROM workspace_notification_defaults WHERE workspace_id = $1 ORDER BY event;
+
+-- name: UpsertWorkspaceNotificationDefault :exec
+INSERT INTO workspace_notification_defaults (workspace_id, event, email)
+VALUES ($1, $2, $3)
+ON CONFLICT (workspace_id, event) DO UPDATE SET
+    email = EXCLUDED.email,
+    updated_at = NOW();
+
+-- name: DeleteWorkspaceNotificationDefault :exec
+DELETE FROM workspace_notification_defaults WHERE workspace_id = $1 AND event = $2;
+
+-- who hears about event in workspace x, and how: members with a scope on the workspace or a
+-- workspace_members row, optionally narrowed to one user.
+-- name: ListNotificationRecipients :many
+SELECT u.id AS user_id, u.email,
+       COALESCE(p.email, d.email, TRUE)::boolean AS email_enabled,
+       COALESCE(p.webhook_url, '')::text AS webhook_url
+FROM users u
+LEFT JOIN user_notification_preferences p ON p.user_id = u.id AND p.event = sqlc.arg('event')
+LEFT JOIN workspace_notification_defaults d ON d.workspace_id = sqlc.arg('workspace_id')::bigint AND d.event = sqlc.arg('event')
+WHERE u.id IN (
+    SELECT us.user_id FROM user_scopes us WHERE us.entity_type = 'workspace' AND us.entity_id = sqlc.arg('workspace_id')::bigint
+    UNION
+    SELECT wm.user_id FROM workspace_members wm WHERE wm.workspace_id = sqlc.arg('workspace_id')::bigint
+)
+AND (sqlc.narg('user_id')::bigint IS NULL OR u.id = sqlc.narg('user_id')::bigint)
+ORDER BY u.id;
+
+-- name: GetDeploymentNotificationContext :one
+SELECT d.id AS deployment_id, d.message, d.region, r.id AS resource_id, r.name AS resource_name, r.workspace_id,
+       w.name AS workspace_name
+FROM deployments d
+JOIN resources r ON r.id = d.resource_id
+JOIN workspaces w ON w.id = r.workspace_id
+WHERE d.id = $1;
+
+-- name: EnqueueNotification :exec
+INSERT INTO notification_outbox (dedupe_key, user_id, event, channel, target, subject, body, payload)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (dedupe_key) DO NOTHING;
+
+-- leases due deliveries to one dispatcher by pushing next_attempt_at past the lease.
+-- name: ClaimNotificationDeliveries :many
+UPDATE notification_outbox
+SET attempts = attempts + 1, next_attempt_at = NOW() + sqlc.arg('lease')::interval
+WHERE id IN (
+    SELECT id FROM notification_outbox
+    WHERE sent_at IS NULL AND next_attempt_at <= NOW() AND attempts < sqlc.arg('max_attempts')::int
+    ORDER BY id
+    LIMIT sqlc.arg('batch_size')::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkNotificationSent :exec
+UPDATE notification_outbox SET sent_at = NOW(), last_error = '' WHERE id = $1;
+
+-- name: MarkNotificationFailed :exec
+UPDATE notification_outbox
+SET last_error = $2, next_attempt_at = NOW() + sqlc.arg('retry_after')::interval
+WHERE id = $1;
+
+-- name: CreateInboxNotification :exec
+INSERT INTO notifications (user_id, dedupe_key, event, workspace_id, subject, body, data)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (user_id, dedupe_key) DO NOTHING;
+
+-- name: ListNotifications :many
+-- lists a user's notifications newest first.
+SELECT * FROM notifications
+WHERE user_id = $1
+  AND (NOT sqlc.arg('unread_only')::boolean OR read_at IS NULL)
+  AND (sqlc.narg('page_token')::bigint IS NULL OR id < sqlc.narg('page_token')::bigint)
+ORDER BY id DESC
+LIMIT $2;
+
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL;
+
+-- name: MarkNotificationRead :execrows
+-- keeps the original read_at of a notification that was already read.
+UPDATE notifications SET read_at = COALESCE(read_at, NOW())
+WHERE id = $1 AND user_id = $2;
+
+-- name: MarkAllNotificationsRead :exec
+UPDATE notifications SET read_at = NOW()
+WHERE user_id = $1 AND read_at IS NULL AND id <= sqlc.arg('up_to_id')::bigint;
@@ -0,0 +1,61 @@
+-- Cascading organization deletion queries
+
+-- everything a deletion of organization x would tear down, for the dry-run report.
+-- name: ListOrgDeletionResources :many
+SELECT r.id, r.workspace_id, r.name,
+       (l.resource_id IS NOT NULL)::boolean AS locked,
+       COALESCE((SELECT array_agg(d.domain ORDER BY d.domain) FROM resource_domains d WHERE d.resource_id = r.id), '{}')::text[] AS domains,
+       (SELECT COUNT(*) FROM deployments dp WHERE dp.resource_id = r.id) AS deployments,
+       (SELECT COUNT(*) FROM deployments dp WHERE dp.resource_id = r.id AND dp.is_active) AS active_deployments
+FROM resources r
+JOIN workspaces w ON w.id = r.workspace_id
+LEFT JOIN resource_locks l ON l.resource_id = r.id
+WHERE w.org_id = $1
+ORDER BY r.workspace_id, r.id;
+
+-- name: ListWorkspaceNamesForOrg :many
+SELECT id, name FROM workspaces WHERE org_id = $1 ORDER BY id;
+
+-- name: ListResourceIDsForOrg :many
+SELECT r.id
+FROM resources r
+JOIN workspaces w ON w.id = r.workspace_id
+WHERE w.org_id = $1
+ORDER BY r.id;
+
+-- name: CreateOrgDeletion :one
+INSERT INTO org_deletions (org_id, org_name, total_resources, requested_by_type, requested_by_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetOrgDeletion :one
+SELECT * FROM org_deletions WHERE id = $1;
+
+-- picks up the oldest pending deletion, or a running one whose worker stopped reporting progress.
+-- name: ClaimOrgDeletion :one
+UPDATE org_deletions
+SET status = 'running', updated_at = NOW()
+WHERE id = (
+    SELECT id FROM org_deletions
+    WHERE status = 'pending'
+       OR (status = 'running' AND updated_at < @stale_before::timestamptz)
+    ORDER BY id
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: RecordOrgDeletionProgress :exec
+UPDATE org_deletions
+SET deleted_resources = deleted_resources + 1, updated_at = NOW()
+WHERE id = $1;
+
+-- name: CompleteOrgDeletion :exec
+UPDATE org_deletions
+SET status = 'completed', updated_at = NOW(), completed_at = NOW()
+WHERE id = $1;
+
+-- name: FailOrgDeletion :exec
+UPDATE org_deletions
+SET status = 'failed', error = $2, updated_at = NOW(), completed_at = NOW()
+WHERE id = $1;
@@ -0,0 +1,32 @@
+-- name: CreatePolicy :one
+INSERT INTO policies (org_id, workspace_id, name, description, rule, enabled, created_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetPolicy :one
+SELECT * FROM policies WHERE id = $1;
+
+-- name: ListPolicies :many
+SELECT * FROM policies WHERE org_id = $1 ORDER BY id;
+
+-- name: ListWorkspacePolicies :many
+-- the enabled policies resources and deployments of a workspace are checked against.
+SELECT p.* FROM policies p
+JOIN workspaces w ON w.org_id = p.org_id
+WHERE w.id = $1
+  AND p.enabled
+  AND (p.workspace_id IS NULL OR p.workspace_id = w.id)
+ORDER BY p.id;
+
+-- name: UpdatePolicy :one
+UPDATE policies SET
+    name = $2,
+    description = $3,
+    rule = $4,
+    enabled = $5,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeletePolicy :execrows
+DELETE FROM policies WHERE id = $1;
@@ -0,0 +1,64 @@
+-- name: SyncProbeTargets :exec
+-- schedules a probe for every service with a primary domain that has none yet.
+INSERT INTO resource_probe_state (resource_id)
+SELECT r.id FROM resources r
+JOIN resource_domains rd ON rd.resource_id = r.id AND rd.is_primary
+WHERE r.type = 'service'
+ON CONFLICT (resource_id) DO NOTHING;
+
+-- name: ClaimProbeTargets :many
+-- takes due probes for this replica by pushing next_probe_at an interval out.
+WITH claimed AS (
+    UPDATE resource_probe_state
+    SET next_probe_at = NOW() + sqlc.arg('interval')::interval
+    WHERE resource_probe_state.resource_id IN (
+        SELECT s.resource_id FROM resource_probe_state s
+        WHERE s.next_probe_at <= NOW()
+        ORDER BY s.next_probe_at
+        LIMIT sqlc.arg('batch_size')::int
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING resource_probe_state.resource_id
+)
+SELECT r.id AS resource_id, r.status, r.spec, r.type, rd.domain
+FROM claimed c
+JOIN resources r ON r.id = c.resource_id
+JOIN resource_domains rd ON rd.resource_id = r.id AND rd.is_primary;
+
+-- name: RecordResourceProbe :one
+-- stores a probe result and returns how many probes in a row have now failed.
+WITH recorded AS (
+    INSERT INTO resource_probes (resource_id, url, ok, status_code, latency_ms, error)
+    VALUES (sqlc.arg('resource_id'), sqlc.arg('url'), sqlc.arg('ok'), sqlc.arg('status_code'), sqlc.arg('latency_ms'), sqlc.arg('error'))
+)
+UPDATE resource_probe_state
+SET consecutive_failures = CASE WHEN sqlc.arg('ok')::boolean THEN 0 ELSE consecutive_failures + 1 END
+WHERE resource_probe_state.resource_id = sqlc.arg('resource_id')
+RETURNING consecutive_failures;
+
+-- name: MarkResourceProbeDegraded :execrows
+-- degrades a healthy resource whose probes keep failing; other statuses say more already.
+UPDATE resources SET status = 'degraded', updated_at = NOW()
+WHERE id = $1 AND status = 'healthy';
+
+-- name: ClearResourceProbeDegraded :execrows
+-- restores a degraded resource once probes pass again, unless a deployment is what degraded it.
+UPDATE resources SET status = 'healthy', updated_at = NOW()
+WHERE resources.id = $1 AND resources.status = 'degraded'
+  AND NOT EXISTS (
+      SELECT 1 FROM deployments d
+      WHERE d.resource_id = resources.id AND d.is_active AND d.status IN ('failed', 'deploying')
+  );
+
+-- name: GetLatestResourceProbe :one
+SELECT * FROM resource_probes WHERE resource_id = $1 ORDER BY checked_at DESC LIMIT 1;
+
+-- name: GetResourceProbeStats :one
+SELECT COUNT(*) AS total,
+       COUNT(*) FILTER (WHERE ok) AS ok,
+       COALESCE(AVG(latency_ms) FILTER (WHERE ok), 0)::float8 AS avg_latency_ms
+FROM resource_probes
+WHERE resource_id = $1 AND checked_at >= sqlc.arg('since')::timestamptz;
+
+-- name: DeleteResourceProbesBefore :execrows
+DELETE FROM resource_probes WHERE checked_at < sqlc.arg('before')::timestamptz;
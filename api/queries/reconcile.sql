@@ -0,0 +1,32 @@
+-- Cluster reconciliation queries
+
+-- newest first, so the first deployment seen for a resource is the one to recreate when the region is unknown.
+-- name: ListActiveDeploymentsForReconcile :many
+SELECT d.id, d.resource_id, d.region, d.spec, r.workspace_id, r.type
+FROM deployments d
+JOIN resources r ON r.id = d.resource_id
+WHERE d.is_active = true
+ORDER BY d.resource_id, d.created_at DESC, d.id DESC;
+
+-- keeps the original detection time while the resource stays out of sync.
+-- name: UpsertClusterDiscrepancy :exec
+INSERT INTO cluster_discrepancies (resource_id, workspace_id, deployment_id, region, kind, detail, repaired_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (resource_id) DO UPDATE SET
+    workspace_id = EXCLUDED.workspace_id,
+    deployment_id = EXCLUDED.deployment_id,
+    region = EXCLUDED.region,
+    kind = EXCLUDED.kind,
+    detail = EXCLUDED.detail,
+    repaired_at = EXCLUDED.repaired_at,
+    detected_at = CASE WHEN cluster_discrepancies.kind = EXCLUDED.kind THEN cluster_discrepancies.detected_at ELSE NOW() END,
+    updated_at = NOW();
+
+-- name: DeleteResolvedClusterDiscrepancies :execrows
+DELETE FROM cluster_discrepancies
+WHERE NOT (resource_id = ANY(@found_resource_ids::bigint[]));
+
+-- name: ListClusterDiscrepancies :many
+SELECT * FROM cluster_discrepancies
+WHERE (sqlc.narg('workspace_id')::bigint IS NULL OR workspace_id = sqlc.narg('workspace_id')::bigint)
+ORDER BY detected_at DESC, resource_id;
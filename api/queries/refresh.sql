@@ -0,0 +1,41 @@
+-- Refresh token queries for sqlc
+
+-- name: CreateRefreshToken :exec
+INSERT INTO refresh_tokens (token_hash, family_id, user_id, access_token, expires_at, mfa_verified)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: UseRefreshToken :one
+-- marks an unused, unexpired refresh token used, returning the session it belongs to.
+UPDATE refresh_tokens
+SET used_at = NOW()
+WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()
+RETURNING family_id, user_id, mfa_verified;
+
+-- name: GetUsedRefreshTokenFamily :one
+-- finds the session of a refresh token that was already used, to revoke it when the token is replayed.
+SELECT family_id FROM refresh_tokens
+WHERE token_hash = $1 AND used_at IS NOT NULL;
+
+-- name: DeleteRefreshTokenFamily :many
+-- ends a session, returning the login tokens issued in it so they can be revoked too.
+DELETE FROM refresh_tokens
+WHERE family_id = $1
+RETURNING access_token;
+
+-- name: DeleteRefreshTokenFamilyByAccessToken :many
+-- ends the session a login token was issued in, returning the login tokens issued in it.
+DELETE FROM refresh_tokens
+WHERE family_id IN (SELECT rt.family_id FROM refresh_tokens rt WHERE rt.access_token = $1)
+RETURNING access_token;
+
+-- name: MarkSessionMFAVerified :exec
+-- records that the session a login token was issued in passed a TOTP check.
+UPDATE refresh_tokens
+SET mfa_verified = TRUE
+WHERE family_id IN (SELECT rt.family_id FROM refresh_tokens rt WHERE rt.access_token = $1);
+
+-- name: DeleteRefreshTokensForUser :exec
+DELETE FROM refresh_tokens WHERE user_id = $1;
+
+-- name: DeleteExpiredRefreshTokens :exec
+DELETE FROM refresh_tokens WHERE expires_at < NOW();
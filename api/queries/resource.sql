@@ -1,24 +1,31 @@
 -- Resource queries
 
 -- name: CreateResource :one
-INSERT INTO resources (workspace_id, name, type, description, status, spec, spec_version)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO resources (workspace_id, name, type, description, status, spec, spec_version, environment_id, external_id, labels)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 RETURNING id;
 
 -- name: GetResourceByID :one
-SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at
+SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at, r.maintenance_message, r.environment_id, r.external_id, r.labels, r.suspended_by_archive
 FROM resources r
 WHERE r.id = $1;
 
 -- name: GetResourceByNameAndWorkspace :one
-SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at
+SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at, r.maintenance_message, r.environment_id, r.external_id, r.labels, r.suspended_by_archive
 FROM resources r
-WHERE r.workspace_id = $1 AND r.name = $2;
+WHERE r.workspace_id = $1 AND r.name = $2
+  AND r.environment_id IS NOT DISTINCT FROM sqlc.narg('environment_id')::bigint;
+
+-- name: GetResourceByExternalID :one
+SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at, r.maintenance_message, r.environment_id, r.external_id, r.labels, r.suspended_by_archive
+FROM resources r
+WHERE r.workspace_id = $1 AND r.external_id = $2;
 
 -- name: ListResourcesForWorkspace :many
-SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at
+SELECT r.id, r.workspace_id, r.name, r.type, r.description, r.status, r.spec, r.spec_version, r.created_at, r.updated_at, r.maintenance_message, r.environment_id, r.external_id, r.labels, r.suspended_by_archive
 FROM resources r
 WHERE r.workspace_id = $1
+   AND (sqlc.narg('environment_id')::bigint IS NULL OR r.environment_id = sqlc.narg('environment_id')::bigint)
    AND (sqlc.narg('page_token')::text IS NULL
         OR (r.created_at, r.id) < (
           (SELECT created_at FROM resources WHERE id = sqlc.narg('page_token')::bigint),
@@ -28,31 +35,59 @@ ORDER BY r.created_at DESC, r.id DESC
 LIMIT $2;
 
 -- name: UpdateResource :one
+-- only updates a resource still at version, when one is given.
 UPDATE resources
 SET name = COALESCE(sqlc.narg('name'), name),
+    description = COALESCE(sqlc.narg('description'), description),
+    spec = COALESCE(sqlc.narg('spec'), spec),
+    labels = COALESCE(sqlc.narg('labels'), labels),
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND (sqlc.narg('version')::bigint IS NULL OR version = sqlc.narg('version')::bigint)
 RETURNING id;
 
 -- name: DeleteResource :exec
 DELETE FROM resources WHERE id = $1;
 
 -- name: CreateResourceRegion :one
-INSERT INTO resource_regions (resource_id, region, is_primary, status)
-VALUES ($1, $2, $3, $4)
-RETURNING id, resource_id, region, is_primary, status, last_error, created_at, updated_at;
+INSERT INTO resource_regions (resource_id, region, is_primary, status, failover_priority)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, resource_id, region, is_primary, status, last_error, created_at, updated_at, failover_priority;
 
 -- name: ListResourceRegions :many
-SELECT id, resource_id, region, is_primary, status, last_error, created_at, updated_at
+SELECT id, resource_id, region, is_primary, status, last_error, created_at, updated_at, failover_priority
+FROM resource_regions
+WHERE resource_id = $1
+ORDER BY is_primary DESC, failover_priority ASC, region ASC;
+
+-- name: ListResourceRegionsForResources :many
+-- the regions of several resources at once, in the order ListResourceRegions gives each.
+SELECT id, resource_id, region, is_primary, status, last_error, created_at, updated_at, failover_priority
+FROM resource_regions
+WHERE resource_id = ANY(sqlc.arg('resource_ids')::bigint[])
+ORDER BY resource_id, is_primary DESC, failover_priority ASC, region ASC;
+
+-- name: ListResourceRegionsForUpdate :many
+SELECT id, resource_id, region, is_primary, status, last_error, created_at, updated_at, failover_priority
 FROM resource_regions
 WHERE resource_id = $1
-ORDER BY is_primary DESC, region ASC;
+ORDER BY is_primary DESC, failover_priority ASC, region ASC
+FOR UPDATE;
 
 -- name: GetResourceRegionByResourceAndRegion :one
-SELECT id, resource_id, region, is_primary, status, last_error, created_at, updated_at
+SELECT id, resource_id, region, is_primary, status, last_error, created_at, updated_at, failover_priority
 FROM resource_regions
 WHERE resource_id = $1 AND region = $2;
 
+-- name: ClearPrimaryResourceRegion :exec
+UPDATE resource_regions
+SET is_primary = false, updated_at = NOW()
+WHERE resource_id = $1 AND is_primary = true;
+
+-- name: UpdateResourceRegionFailover :exec
+UPDATE resource_regions
+SET is_primary = $3, failover_priority = $4, updated_at = NOW()
+WHERE resource_id = $1 AND region = $2;
+
 -- name: GetClusterDetails :one
 SELECT id, is_active, health_status
 FROM clusters
@@ -74,6 +109,21 @@ FROM clusters
 WHERE is_active = true
 ORDER BY region ASC;
 
+-- name: ListRegionNodePools :many
+-- node pools of the region's active clusters
+SELECT p.* FROM cluster_node_pools p
+JOIN clusters cl ON cl.id = p.cluster_id
+WHERE cl.region = $1 AND cl.is_active = true
+ORDER BY p.cluster_id, p.name;
+
+-- name: ListRegionPricing :many
+-- the pricing of each region's serving cluster, picked as in GetActiveClusterByRegion but healthy or not
+SELECT DISTINCT ON (cl.region) cl.region, p.currency, p.cpu_core_hour_micros, p.memory_gib_hour_micros
+FROM clusters cl
+JOIN cluster_pricing p ON p.cluster_id = cl.id
+WHERE cl.is_active = true
+ORDER BY cl.region ASC, cl.is_default DESC, cl.created_at ASC;
+
 -- todo: eventually remove
 -- name: GetFirstActiveCluster :one
 SELECT id, name, region, provider, is_active, is_default, endpoint, health_status, last_health_check, created_at, updated_at
@@ -86,10 +136,65 @@ LIMIT 1;
 SELECT status FROM deployments
 WHERE resource_id = $1 AND is_active = true;
 
+-- name: UpdateResourceSpec :exec
+UPDATE resources
+SET spec = $2, updated_at = NOW()
+WHERE id = $1;
+
 -- name: UpdateResourceStatus :exec
+-- suspended resources keep their status until resumed.
 UPDATE resources
 SET status = $2, updated_at = NOW()
-WHERE id = $1;
+WHERE id = $1 AND status <> 'suspended';
+
+-- name: SuspendResource :one
+UPDATE resources
+SET status = 'suspended', maintenance_message = $2, suspended_by_archive = FALSE, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: ResumeResource :one
+UPDATE resources
+SET status = 'deploying', maintenance_message = NULL, suspended_by_archive = FALSE, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- resources archiving workspace x suspends: those not already suspended.
+-- name: SuspendWorkspaceResourcesForArchive :many
+UPDATE resources
+SET status = 'suspended', maintenance_message = $2, suspended_by_archive = TRUE, updated_at = NOW()
+WHERE workspace_id = $1 AND status <> 'suspended'
+RETURNING *;
+
+-- name: ResumeWorkspaceResourcesFromArchive :many
+UPDATE resources
+SET status = 'deploying', maintenance_message = NULL, suspended_by_archive = FALSE, updated_at = NOW()
+WHERE workspace_id = $1 AND suspended_by_archive
+RETURNING *;
 
 -- name: GetWorkspaceOrganizationIDByResourceID :one
-SELECT workspace_id, w.org_id FROM resources r JOIN workspaces w ON r.workspace_id = w.id WHERE r.id = $1;
+SELECT workspace_id, w.org_id, r.environment_id FROM resources r JOIN workspaces w ON r.workspace_id = w.id WHERE r.id = $1;
+
+-- name: ListMultiRegionResources :many
+SELECT r.id AS resource_id, rd.domain
+FROM resources r
+JOIN resource_domains rd ON rd.resource_id = r.id AND rd.is_primary = true
+WHERE (SELECT COUNT(*) FROM resource_regions rr WHERE rr.resource_id = r.id) > 1
+ORDER BY r.id;
+
+-- name: ListResourceRegionOrigins :many
+-- active regions of a resource with the ingress endpoint of their serving cluster, in failover order
+SELECT rr.region, rr.is_primary, rr.failover_priority, c.endpoint
+FROM resource_regions rr
+JOIN LATERAL (
+    SELECT cl.endpoint
+    FROM clusters cl
+    WHERE cl.region = rr.region
+      AND cl.is_active = true
+      AND cl.health_status = 'healthy'
+      AND cl.endpoint IS NOT NULL
+    ORDER BY cl.is_default DESC, cl.created_at ASC
+    LIMIT 1
+) c ON true
+WHERE rr.resource_id = $1 AND rr.status = 'active'
+ORDER BY rr.failover_priority ASC, rr.region ASC;
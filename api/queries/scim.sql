@@ -0,0 +1,112 @@
+-- Directory sync (SCIM) queries
+
+-- name: CreateScimUser :one
+INSERT INTO scim_users (org_id, user_id, external_id, user_name, active)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetScimUser :one
+SELECT * FROM scim_users WHERE org_id = $1 AND id = $2;
+
+-- name: ListScimUsers :many
+SELECT * FROM scim_users
+WHERE org_id = sqlc.arg('org_id')
+  AND (sqlc.narg('user_name')::text IS NULL OR user_name = sqlc.narg('user_name'))
+  AND (sqlc.narg('external_id')::text IS NULL OR external_id = sqlc.narg('external_id'))
+ORDER BY id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountScimUsers :one
+SELECT COUNT(*) FROM scim_users
+WHERE org_id = sqlc.arg('org_id')
+  AND (sqlc.narg('user_name')::text IS NULL OR user_name = sqlc.narg('user_name'))
+  AND (sqlc.narg('external_id')::text IS NULL OR external_id = sqlc.narg('external_id'));
+
+-- name: UpdateScimUser :one
+UPDATE scim_users
+SET external_id = $2,
+    user_name = $3,
+    active = $4,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: SetScimUserGrantedScopes :exec
+UPDATE scim_users SET granted_scopes = $2 WHERE id = $1;
+
+-- name: DeleteScimUser :exec
+DELETE FROM scim_users WHERE id = $1;
+
+-- name: CreateScimGroup :one
+INSERT INTO scim_groups (org_id, display_name, external_id)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetScimGroup :one
+SELECT * FROM scim_groups WHERE org_id = $1 AND id = $2;
+
+-- name: ListScimGroups :many
+SELECT * FROM scim_groups
+WHERE org_id = sqlc.arg('org_id')
+  AND (sqlc.narg('display_name')::text IS NULL OR display_name = sqlc.narg('display_name'))
+  AND (sqlc.narg('external_id')::text IS NULL OR external_id = sqlc.narg('external_id'))
+ORDER BY id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountScimGroups :one
+SELECT COUNT(*) FROM scim_groups
+WHERE org_id = sqlc.arg('org_id')
+  AND (sqlc.narg('display_name')::text IS NULL OR display_name = sqlc.narg('display_name'))
+  AND (sqlc.narg('external_id')::text IS NULL OR external_id = sqlc.narg('external_id'));
+
+-- name: UpdateScimGroup :one
+UPDATE scim_groups
+SET display_name = $2,
+    external_id = $3,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteScimGroup :exec
+DELETE FROM scim_groups WHERE id = $1;
+
+-- name: ListScimGroupMembers :many
+SELECT m.scim_user_id, u.user_name
+FROM scim_group_members m
+JOIN scim_users u ON u.id = m.scim_user_id
+WHERE m.group_id = $1
+ORDER BY m.scim_user_id;
+
+-- name: ListScimGroupMembersByName :many
+SELECT m.scim_user_id
+FROM scim_group_members m
+JOIN scim_groups g ON g.id = m.group_id
+WHERE g.org_id = $1 AND g.display_name = $2;
+
+-- name: AddScimGroupMember :exec
+INSERT INTO scim_group_members (group_id, scim_user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING;
+
+-- name: RemoveScimGroupMember :exec
+DELETE FROM scim_group_members WHERE group_id = $1 AND scim_user_id = $2;
+
+-- name: RemoveAllScimGroupMembers :exec
+DELETE FROM scim_group_members WHERE group_id = $1;
+
+-- which roles do the groups of directory user x map to?
+-- name: ListScimUserGroupScopes :many
+SELECT r.scopes
+FROM scim_group_members m
+JOIN scim_groups g ON g.id = m.group_id
+JOIN directory_group_roles r ON r.org_id = g.org_id AND r.group_name = g.display_name
+WHERE m.scim_user_id = $1;
+
+-- name: UpsertDirectoryGroupRoles :exec
+INSERT INTO directory_group_roles (org_id, group_name, scopes)
+VALUES ($1, $2, $3)
+ON CONFLICT (org_id, group_name) DO UPDATE SET scopes = EXCLUDED.scopes;
+
+-- name: DeleteDirectoryGroupRoles :exec
+DELETE FROM directory_group_roles WHERE org_id = $1 AND group_name = $2;
+
+-- name: ListDirectoryGroupRoles :many
+SELECT * FROM directory_group_roles WHERE org_id = $1 ORDER BY group_name;
@@ -0,0 +1,36 @@
+-- Service account queries
+
+-- name: CreateServiceAccount :one
+INSERT INTO service_accounts (workspace_id, name, description, created_by)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetServiceAccountByID :one
+SELECT * FROM service_accounts WHERE id = $1;
+
+-- name: ListServiceAccountsForWorkspace :many
+SELECT * FROM service_accounts
+WHERE workspace_id = $1
+ORDER BY created_at ASC, id ASC;
+
+-- name: DeleteServiceAccount :exec
+DELETE FROM service_accounts WHERE id = $1;
+
+-- what scopes does service account x have?
+-- name: GetServiceAccountScopes :many
+SELECT jsonb_build_object(
+    'scope', scope,
+    'entity_type', entity_type,
+    'entity_id', entity_id
+)::entity_scope
+FROM service_account_scopes
+WHERE service_account_id = $1;
+
+-- name: AddServiceAccountScope :exec
+INSERT INTO service_account_scopes (service_account_id, scope, entity_type, entity_id) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING;
+
+-- name: RemoveAllServiceAccountScopes :exec
+DELETE FROM service_account_scopes WHERE service_account_id = $1;
+
+-- name: GetServiceAccountWorkspaceOrganizationID :one
+SELECT sa.workspace_id, w.org_id FROM service_accounts sa JOIN workspaces w ON sa.workspace_id = w.id WHERE sa.id = $1;
@@ -0,0 +1,59 @@
+-- Slack installation and channel mapping queries
+
+-- name: UpsertSlackInstallation :exec
+INSERT INTO slack_installations (org_id, team_id, team_name, bot_token, installed_by)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (org_id) DO UPDATE SET
+    team_id = EXCLUDED.team_id,
+    team_name = EXCLUDED.team_name,
+    bot_token = EXCLUDED.bot_token,
+    installed_by = EXCLUDED.installed_by,
+    updated_at = NOW();
+
+-- name: GetSlackInstallation :one
+SELECT * FROM slack_installations WHERE org_id = $1;
+
+-- name: DeleteSlackInstallation :execrows
+DELETE FROM slack_installations WHERE org_id = $1;
+
+-- name: DeleteSlackChannelsForOrg :exec
+DELETE FROM workspace_slack_channels
+WHERE workspace_id IN (SELECT id FROM workspaces WHERE org_id = $1);
+
+-- any installation of the team will do: they all act as the same bot.
+-- name: GetSlackBotToken :one
+SELECT bot_token FROM slack_installations WHERE team_id = $1 ORDER BY updated_at DESC LIMIT 1;
+
+-- name: UpsertWorkspaceSlackChannel :one
+INSERT INTO workspace_slack_channels (workspace_id, channel_id, channel_name, deployments, alerts)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (workspace_id) DO UPDATE SET
+    channel_id = EXCLUDED.channel_id,
+    channel_name = EXCLUDED.channel_name,
+    deployments = EXCLUDED.deployments,
+    alerts = EXCLUDED.alerts,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetWorkspaceSlackChannel :one
+SELECT * FROM workspace_slack_channels WHERE workspace_id = $1;
+
+-- name: DeleteWorkspaceSlackChannel :execrows
+DELETE FROM workspace_slack_channels WHERE workspace_id = $1;
+
+-- where a workspace's messages go, when its org has Slack installed and it has a channel.
+-- name: GetWorkspaceSlackTarget :one
+SELECT i.team_id, c.channel_id, c.deployments, c.alerts
+FROM workspace_slack_channels c
+JOIN workspaces w ON w.id = c.workspace_id
+JOIN slack_installations i ON i.org_id = w.org_id
+WHERE c.workspace_id = $1;
+
+-- the workspaces a slash command sent from a channel acts on.
+-- name: ListSlackChannelWorkspaces :many
+SELECT w.id, w.name
+FROM workspace_slack_channels c
+JOIN workspaces w ON w.id = c.workspace_id
+JOIN slack_installations i ON i.org_id = w.org_id
+WHERE i.team_id = $1 AND c.channel_id = $2
+ORDER BY w.id;
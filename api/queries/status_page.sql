@@ -0,0 +1,45 @@
+-- name: GetStatusPage :one
+SELECT * FROM status_pages WHERE resource_id = $1;
+
+-- name: UpsertStatusPage :one
+INSERT INTO status_pages (resource_id, enabled, title, description, logo_url, accent_color)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (resource_id) DO UPDATE SET
+    enabled = EXCLUDED.enabled,
+    title = EXCLUDED.title,
+    description = EXCLUDED.description,
+    logo_url = EXCLUDED.logo_url,
+    accent_color = EXCLUDED.accent_color,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetStatusPageByDomain :one
+-- finds the enabled status page of the resource whose primary domain is given.
+SELECT sp.resource_id, sp.title, sp.description, sp.logo_url, sp.accent_color,
+       r.name AS resource_name, r.status
+FROM status_pages sp
+JOIN resources r ON r.id = sp.resource_id
+JOIN resource_domains rd ON rd.resource_id = sp.resource_id AND rd.is_primary
+WHERE rd.domain = $1 AND sp.enabled;
+
+-- name: GetPrimaryDomainForResource :one
+SELECT domain FROM resource_domains WHERE resource_id = $1 AND is_primary;
+
+-- name: ListResourceStatusHistory :many
+-- lists the statuses a resource has been in since a time, starting with the one it was in then.
+SELECT h.id, h.resource_id, h.status, h.started_at
+FROM resource_status_history h
+WHERE h.resource_id = sqlc.arg('resource_id')
+  AND h.started_at >= COALESCE((
+      SELECT MAX(p.started_at) FROM resource_status_history p
+      WHERE p.resource_id = sqlc.arg('resource_id') AND p.started_at <= sqlc.arg('since')::timestamptz
+  ), sqlc.arg('since')::timestamptz)
+ORDER BY h.started_at, h.id;
+
+-- name: ListFailedDeploymentsSince :many
+SELECT id, region, COALESCE(completed_at, updated_at)::timestamptz AS failed_at
+FROM deployments
+WHERE resource_id = $1 AND status = 'failed'
+  AND COALESCE(completed_at, updated_at) >= sqlc.arg('since')::timestamptz
+ORDER BY failed_at DESC
+LIMIT $2;
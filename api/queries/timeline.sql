@@ -0,0 +1,32 @@
+-- name: CreateResourceActivity :exec
+INSERT INTO resource_activity (resource_id, kind, summary, actor_id)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListResourceTimeline :many
+-- a resource's deployments, recorded changes and incidents, newest first. An incident is a stretch
+-- of degraded or unavailable status, ending when the status next changes. Pages continue after
+-- the (occurred_at, kind, id) of the previous page's last entry.
+SELECT kind, id, occurred_at, ended_at, summary, actor_id, status, region
+FROM (
+    SELECT 'deployment'::text AS kind, d.id, d.created_at AS occurred_at, NULL::timestamptz AS ended_at,
+           d.annotation_message AS summary, d.created_by AS actor_id, d.status::text AS status, d.region
+    FROM deployments d
+    WHERE d.resource_id = sqlc.arg('resource_id')
+    UNION ALL
+    SELECT a.kind::text, a.id, a.created_at, NULL::timestamptz, a.summary, a.actor_id, '', ''
+    FROM resource_activity a
+    WHERE a.resource_id = sqlc.arg('resource_id')
+    UNION ALL
+    SELECT 'incident', h.id, h.started_at, h.ended_at, '', NULL::bigint, h.status::text, ''
+    FROM (
+        SELECT id, status, started_at, LEAD(started_at) OVER (ORDER BY started_at, id) AS ended_at
+        FROM resource_status_history
+        WHERE resource_id = sqlc.arg('resource_id')
+    ) h
+    WHERE h.status IN ('degraded', 'unavailable')
+) timeline
+WHERE (cardinality(sqlc.arg('kinds')::text[]) = 0 OR kind = ANY(sqlc.arg('kinds')::text[]))
+  AND (sqlc.narg('after_occurred_at')::timestamptz IS NULL
+       OR (occurred_at, kind, id) < (sqlc.narg('after_occurred_at')::timestamptz, sqlc.narg('after_kind')::text, sqlc.narg('after_id')::bigint))
+ORDER BY occurred_at DESC, kind DESC, id DESC
+LIMIT sqlc.arg('page_size');
@@ -97,7 +97,7 @@ ORDER BY us.entity_type, us.entity_id, us.scope;
 SELECT user_id FROM user_scopes WHERE entity_type = $1 AND entity_id = $2 AND scope = $3;
 
 -- name: GetToken :one
-SELECT name, token, scopes, entity_type, entity_id, expires_at FROM tokens WHERE token = $1 AND expires_at > NOW();
+SELECT name, token, scopes, entity_type, entity_id, expires_at, parent_token, impersonator_id, mfa_pending FROM tokens WHERE token = $1 AND expires_at > NOW();
 
 -- which tokens exist on behalf of entity y?
 -- name: ListTokensForEntity :many
@@ -119,7 +119,7 @@ DELETE FROM user_scopes WHERE entity_type = $1 AND entity_id = $2;
 DELETE FROM user_scopes WHERE user_id = $1;
 
 -- name: StoreToken :exec
-INSERT INTO tokens (name, token, entity_type, entity_id, scopes, expires_at) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING;
+INSERT INTO tokens (name, token, entity_type, entity_id, scopes, expires_at, parent_token, impersonator_id, mfa_pending) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT DO NOTHING;
 
 -- name: GetTokenByName :one
 SELECT name, entity_type, entity_id, scopes, expires_at FROM tokens WHERE name = $1 AND entity_type = $2 AND entity_id = $3;
@@ -134,4 +134,24 @@ DELETE FROM tokens WHERE name = $1 AND entity_type = $2 AND entity_id = $3;
 DELETE FROM tokens WHERE entity_type = $1 AND entity_id = $2;
 
 -- name: DeleteExpiredTokens :exec
-DELETE FROM tokens WHERE expires_at < NOW();
\ No newline at end of file
+DELETE FROM tokens WHERE expires_at < NOW();
+-- which entities sit above workspaces, environments, resources and service accounts x, y, z? resolves many in one round trip.
+-- name: ListEntityParents :many
+SELECT 'workspace'::entity_type AS entity_type, w.id AS entity_id, w.org_id, NULL::bigint AS workspace_id, NULL::bigint AS environment_id
+FROM workspaces w
+WHERE w.id = ANY(sqlc.arg('workspace_ids')::bigint[])
+UNION ALL
+SELECT 'environment'::entity_type, e.id, w.org_id, e.workspace_id, NULL::bigint
+FROM environments e
+JOIN workspaces w ON e.workspace_id = w.id
+WHERE e.id = ANY(sqlc.arg('environment_ids')::bigint[])
+UNION ALL
+SELECT 'resource'::entity_type, r.id, w.org_id, r.workspace_id, r.environment_id
+FROM resources r
+JOIN workspaces w ON r.workspace_id = w.id
+WHERE r.id = ANY(sqlc.arg('resource_ids')::bigint[])
+UNION ALL
+SELECT 'service_account'::entity_type, sa.id, w.org_id, sa.workspace_id, NULL::bigint
+FROM service_accounts sa
+JOIN workspaces w ON sa.workspace_id = w.id
+WHERE sa.id = ANY(sqlc.arg('service_account_ids')::bigint[]);
@@ -49,6 +49,47 @@ SELECT EXISTS(
   WHERE user_id = $1
 ) AS has_workspaces;
 
+-- Account deletion and export queries
+
+-- name: ListSolelyOwnedOrganizations :many
+-- lists the organizations a user administers or created that no other user administers.
+SELECT o.id, o.name, o.created_by, o.created_at, o.updated_at, o.deletion_protection, o.require_mfa
+FROM organizations o
+WHERE (o.created_by = $1
+       OR EXISTS (
+         SELECT 1 FROM user_scopes us
+         WHERE us.user_id = $1 AND us.scope = 'admin' AND us.entity_type = 'organization' AND us.entity_id = o.id
+       ))
+  AND NOT EXISTS (
+    SELECT 1 FROM user_scopes us
+    WHERE us.user_id <> $1 AND us.scope = 'admin' AND us.entity_type = 'organization' AND us.entity_id = o.id
+  )
+ORDER BY o.id;
+
+-- name: TransferCreatedOrganizations :exec
+-- hands the organizations a user created to another of their admins, before the user is deleted.
+UPDATE organizations o
+SET created_by = (
+      SELECT MIN(us.user_id) FROM user_scopes us
+      WHERE us.user_id <> $1 AND us.scope = 'admin' AND us.entity_type = 'organization' AND us.entity_id = o.id
+    ),
+    updated_at = NOW()
+WHERE o.created_by = $1;
+
+-- name: TransferCreatedWorkspaces :exec
+-- hands the workspaces a user created to the creator of their organization, before the user is deleted.
+UPDATE workspaces w
+SET created_by = o.created_by, updated_at = NOW()
+FROM organizations o
+WHERE o.id = w.org_id AND w.created_by = $1;
+
+-- name: ListAllUserNotifications :many
+-- lists every notification of a user, oldest first, for their data export.
+SELECT id, user_id, dedupe_key, event, workspace_id, subject, body, data, read_at, created_at
+FROM notifications
+WHERE user_id = $1
+ORDER BY id;
+
 -- Organization queries
 
 -- name: CreateOrganization :one
@@ -1,11 +1,14 @@
 -- name: CreateWorkspace :one
-INSERT INTO workspaces (org_id, name, description, created_by)
-VALUES ($1, $2, $3, $4)
+INSERT INTO workspaces (org_id, name, description, created_by, external_id, labels)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING id;
 
 -- name: GetWorkspaceByIDQuery :one
 SELECT * FROM workspaces WHERE id = $1;
 
+-- name: GetWorkspaceByExternalID :one
+SELECT * FROM workspaces WHERE org_id = $1 AND external_id = $2;
+
 -- name: GetOrganizationIDByWorkspaceID :one
 SELECT org_id FROM workspaces WHERE id = $1;
 
@@ -14,6 +17,7 @@ SELECT DISTINCT w.*
 FROM workspaces w
 JOIN workspace_members wm ON wm.workspace_id = w.id
 WHERE wm.user_id = $1
+  AND (sqlc.arg('include_archived')::boolean OR w.archived_at IS NULL)
   AND (sqlc.narg('page_token')::text IS NULL
        OR (w.created_at, w.id) < (
          (SELECT created_at FROM workspaces WHERE id = sqlc.narg('page_token')::bigint),
@@ -25,6 +29,7 @@ LIMIT $2;
 -- name: ListWorkspacesInOrg :many
 SELECT w.* FROM workspaces w
 WHERE w.org_id = $1
+  AND (sqlc.arg('include_archived')::boolean OR w.archived_at IS NULL)
   AND (sqlc.narg('page_token')::text IS NULL
        OR (w.created_at, w.id) < (
          (SELECT created_at FROM workspaces WHERE id = sqlc.narg('page_token')::bigint),
@@ -43,6 +48,9 @@ AND name = $2;
 UPDATE workspaces
 SET name = COALESCE(sqlc.narg('name'), name),
     description = COALESCE(sqlc.narg('description'), description),
+    deployment_history_limit = COALESCE(sqlc.narg('deployment_history_limit'), deployment_history_limit),
+    labels = COALESCE(sqlc.narg('labels'), labels),
+    block_critical_vulnerabilities = COALESCE(sqlc.narg('block_critical_vulnerabilities'), block_critical_vulnerabilities),
     updated_at = NOW()
 WHERE id = $1
 RETURNING id;
@@ -93,3 +101,21 @@ LIMIT $2;
 
 -- name: GetWorkspaceOrgID :one
 SELECT org_id FROM workspaces WHERE id = $1;
+
+-- name: ArchiveWorkspace :one
+UPDATE workspaces
+SET archived_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND archived_at IS NULL
+RETURNING *;
+
+-- name: UnarchiveWorkspace :one
+UPDATE workspaces
+SET archived_at = NULL, updated_at = NOW()
+WHERE id = $1 AND archived_at IS NOT NULL
+RETURNING *;
+
+-- name: IsResourceWorkspaceArchived :one
+SELECT (w.archived_at IS NOT NULL)::boolean AS archived
+FROM resources r
+JOIN workspaces w ON w.id = r.workspace_id
+WHERE r.id = $1;
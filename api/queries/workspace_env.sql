@@ -0,0 +1,22 @@
+-- Workspace env queries
+
+-- name: GetWorkspaceEnv :one
+SELECT * FROM workspace_env WHERE workspace_id = $1;
+
+-- name: UpsertWorkspaceEnv :one
+INSERT INTO workspace_env (workspace_id, env, updated_by)
+VALUES ($1, $2, $3)
+ON CONFLICT (workspace_id) DO UPDATE
+SET env = EXCLUDED.env,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: ListWorkspaceEnvStaleResources :many
+-- services whose active deployment predates the workspace's last env change, so still run with the old values.
+SELECT DISTINCT r.id, r.name
+FROM resources r
+JOIN workspace_env we ON we.workspace_id = r.workspace_id
+JOIN deployments d ON d.resource_id = r.id AND d.is_active
+WHERE r.workspace_id = $1 AND r.type = 'service' AND d.created_at < we.updated_at
+ORDER BY r.name, r.id;
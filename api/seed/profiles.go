@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/team-loco/loco/api/gen/db"
+)
+
+// Options parameterize a profile. Zero counts take the profile's defaults.
+type Options struct {
+	Users      int
+	Workspaces int
+	Resources  int
+	Seed       uint64 // seeds every random choice, so a profile seeds the same data each run
+}
+
+// profile is a named dataset
+type profile struct {
+	description string
+	defaults    Options
+	fixed       bool // the dataset can't be resized; counts are rejected
+	seed        func(ctx context.Context, q *db.Queries, opts Options) error
+}
+
+var profiles = map[string]profile{
+	"minimal": {
+		description: "one user owning one org, workspace and app; a quick local setup",
+		defaults:    Options{Users: 1, Workspaces: 1, Resources: 1},
+		seed:        seedScaled,
+	},
+	"large-org": {
+		description: "one org with many workspaces, apps and users of mixed access; for load tests and demos",
+		defaults:    Options{Users: 50, Workspaces: 10, Resources: 500},
+		seed:        seedScaled,
+	},
+	"permissions-matrix": {
+		description: "users with overlapping org, workspace and app scopes, checked against the token vending machine",
+		fixed:       true,
+		seed:        seedPermissionsMatrix,
+	},
+}
+
+// profileNames lists the profiles for usage messages
+func profileNames() string {
+	var names []string
+	for name := range profiles {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
+
+// seedPermissionsMatrix seeds the users, orgs, workspaces and apps described at the top of seed.go
+func seedPermissionsMatrix(ctx context.Context, q *db.Queries, _ Options) error {
+	userIDs, err := seedUsers(ctx, q)
+	if err != nil {
+		return err
+	}
+	orgIDs, err := seedOrganizations(ctx, q, userIDs)
+	if err != nil {
+		return err
+	}
+	wksIDs, err := seedWorkspaces(ctx, q, orgIDs)
+	if err != nil {
+		return err
+	}
+	resourceIds, err := seedResources(ctx, q, wksIDs)
+	if err != nil {
+		return err
+	}
+	return seedUserScopes(ctx, q, orgIDs, wksIDs, resourceIds, userIDs)
+}
+
+// seedScaled seeds one org of opts.Users users, opts.Workspaces workspaces and opts.Resources apps,
+// spread round-robin over the workspaces. The first user owns the org; every other user gets
+// read access to the org, write access to a workspace, or read access to a few apps.
+func seedScaled(ctx context.Context, q *db.Queries, opts Options) error {
+	rng := rand.New(rand.NewPCG(opts.Seed, opts.Seed))
+
+	userIDs := make([]int64, 0, opts.Users)
+	for i := range opts.Users {
+		user, err := q.CreateUser(ctx, db.CreateUserParams{
+			Name:       opttext(fmt.Sprintf("Seed User %d", i+1)),
+			Email:      fmt.Sprintf("seed-user%d@example.com", i+1),
+			ExternalID: fmt.Sprintf("github-seed-user%d", i+1),
+		})
+		if err != nil {
+			return fmt.Errorf("creating user %d: %w", i+1, err)
+		}
+		userIDs = append(userIDs, user.ID)
+	}
+
+	org, err := q.CreateOrganization(ctx, db.CreateOrganizationParams{
+		Name:      "Seed Org",
+		CreatedBy: userIDs[0],
+	})
+	if err != nil {
+		return fmt.Errorf("creating org: %w", err)
+	}
+
+	wksIDs := make([]int64, 0, opts.Workspaces)
+	for i := range opts.Workspaces {
+		wksID, err := q.CreateWorkspace(ctx, db.CreateWorkspaceParams{
+			OrgID:       org.ID,
+			Name:        fmt.Sprintf("Workspace %03d", i+1),
+			Description: opttext(fmt.Sprintf("seeded workspace %d", i+1)),
+		})
+		if err != nil {
+			return fmt.Errorf("creating wks %d: %w", i+1, err)
+		}
+		wksIDs = append(wksIDs, wksID)
+	}
+
+	// mostly healthy, as a real fleet would be
+	statuses := []db.ResourceStatus{
+		db.ResourceStatusHealthy, db.ResourceStatusHealthy, db.ResourceStatusHealthy, db.ResourceStatusHealthy,
+		db.ResourceStatusHealthy, db.ResourceStatusHealthy, db.ResourceStatusDeploying, db.ResourceStatusDegraded,
+		db.ResourceStatusUnavailable, db.ResourceStatusSuspended,
+	}
+	resourceIDs := make([]int64, 0, opts.Resources)
+	for i := range opts.Resources {
+		resourceID, err := q.CreateResource(ctx, db.CreateResourceParams{
+			WorkspaceID: wksIDs[i%len(wksIDs)],
+			Name:        fmt.Sprintf("service-%04d", i+1),
+			Type:        db.ResourceTypeService,
+			Description: fmt.Sprintf("seeded app %d", i+1),
+			Status:      statuses[rng.IntN(len(statuses))],
+			Spec:        specExample,
+			SpecVersion: 1,
+		})
+		if err != nil {
+			return fmt.Errorf("creating resource %d: %w", i+1, err)
+		}
+		resourceIDs = append(resourceIDs, resourceID)
+	}
+
+	scopes := []db.AddUserScopeParams{}
+	for i, userID := range userIDs {
+		scopes = append(scopes, allScopes(userID, db.EntityTypeUser, userID)...)
+		switch {
+		case i == 0:
+			scopes = append(scopes, allScopes(userID, db.EntityTypeOrganization, org.ID)...)
+		case rng.IntN(10) == 0:
+			scopes = append(scopes, db.AddUserScopeParams{UserID: userID, EntityType: db.EntityTypeOrganization, EntityID: org.ID, Scope: db.ScopeRead})
+		case rng.IntN(2) == 0:
+			wksID := wksIDs[rng.IntN(len(wksIDs))]
+			scopes = append(scopes,
+				db.AddUserScopeParams{UserID: userID, EntityType: db.EntityTypeWorkspace, EntityID: wksID, Scope: db.ScopeRead},
+				db.AddUserScopeParams{UserID: userID, EntityType: db.EntityTypeWorkspace, EntityID: wksID, Scope: db.ScopeWrite},
+			)
+		default:
+			for _, j := range rng.Perm(len(resourceIDs))[:min(5, len(resourceIDs))] {
+				scopes = append(scopes, db.AddUserScopeParams{UserID: userID, EntityType: db.EntityTypeResource, EntityID: resourceIDs[j], Scope: db.ScopeRead})
+			}
+		}
+	}
+	for _, scope := range scopes {
+		if err := q.AddUserScope(ctx, scope); err != nil {
+			return fmt.Errorf("adding %s_%d:%s for user with id %d: %w", scope.EntityType, scope.EntityID, scope.Scope, scope.UserID, err)
+		}
+	}
+	return nil
+}
+
+// allScopes returns read, write and admin on an entity for a user
+func allScopes(userID int64, entityType db.EntityType, entityID int64) []db.AddUserScopeParams {
+	var scopes []db.AddUserScopeParams
+	for _, scope := range []db.Scope{db.ScopeRead, db.ScopeWrite, db.ScopeAdmin} {
+		scopes = append(scopes, db.AddUserScopeParams{UserID: userID, EntityType: entityType, EntityID: entityID, Scope: scope})
+	}
+	return scopes
+}
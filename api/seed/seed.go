@@ -1,7 +1,9 @@
 package main
 
 import (
+	"cmp"
 	"context"
+	"flag"
 	"fmt"
 	"log/slog"
 	"os"
@@ -9,15 +11,24 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	apiDb "github.com/team-loco/loco/api/db"
 	"github.com/team-loco/loco/api/gen/db"
 	queries "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/migrations"
 	"github.com/team-loco/loco/api/tvm"
 	"github.com/team-loco/loco/api/tvm/providers"
 )
 
-// the database we're seeding:
+// seed fills a database with one of several datasets, picked with -profile:
+//
+//	go run . -profile large-org -resources 2000 -wipe
+//
+// every profile seeds the same data each run into an empty database; -wipe empties it first.
+//
+// the permissions-matrix profile seeds:
 // - 5 users
 // - 2 organizations (org 1 and org 2)
 // - 4 workspaces (wks 1,2 in org 1, wks 3,4 in org 2)
@@ -35,9 +46,14 @@ import (
 
 var specExample, _ = os.ReadFile("spec_example.json")
 
-func Seed(ctx context.Context, pool *pgxpool.Pool, migrationFiles []string) error {
+// Seed migrates the database and seeds it with a profile, emptying it first if wipe is set.
+func Seed(ctx context.Context, pool *pgxpool.Pool, p profile, opts Options, wipe bool) error {
 	// run migrations
-	if err := runMigrations(ctx, pool, migrationFiles); err != nil {
+	migrator, err := apiDb.NewMigrator(pool, migrations.FS)
+	if err != nil {
+		return fmt.Errorf("loading migrations: %w", err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
 		return fmt.Errorf("running migrations: %w", err)
 	}
 
@@ -49,52 +65,46 @@ func Seed(ctx context.Context, pool *pgxpool.Pool, migrationFiles []string) erro
 	defer tx.Rollback(ctx)
 	q := queries.New(tx)
 
-	// perform seeding operations here
-	var userIDs []int64     // len 5
-	var orgIDs []int64      // len 2
-	var wksIDs []int64      // len 4
-	var resourceIds []int64 // len 6
-	if userIDs, err = seedUsers(ctx, q); err != nil {
-		return err
-	}
-	if orgIDs, err = seedOrganizations(ctx, q, userIDs); err != nil {
-		return err
-	}
-	if wksIDs, err = seedWorkspaces(ctx, q, orgIDs); err != nil {
-		return err
-	}
-	if resourceIds, err = seedResources(ctx, q, wksIDs); err != nil {
-		return err
+	if wipe {
+		if err := wipeTables(ctx, tx); err != nil {
+			return err
+		}
+	} else {
+		var seeded bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM users)`).Scan(&seeded); err != nil {
+			return fmt.Errorf("checking for existing data: %w", err)
+		}
+		if seeded {
+			return fmt.Errorf("the database already has users; pass -wipe to empty it first")
+		}
 	}
-	if err := seedUserScopes(ctx, q, orgIDs, wksIDs, resourceIds, userIDs); err != nil {
+
+	if err := p.seed(ctx, q, opts); err != nil {
 		return err
 	}
 	return tx.Commit(ctx)
 }
 
-func runMigrations(ctx context.Context, pool *pgxpool.Pool, filepaths []string) error {
-	if len(filepaths) == 0 {
-		return nil
+// wipeTables empties every table but schema_migrations and restarts their ids, so the ids a
+// profile seeds are the same each run
+func wipeTables(ctx context.Context, tx pgx.Tx) error {
+	rows, err := tx.Query(ctx, `
+		SELECT quote_ident(tablename) FROM pg_tables
+		WHERE schemaname = 'public' AND tablename <> 'schema_migrations'`)
+	if err != nil {
+		return fmt.Errorf("listing tables: %w", err)
 	}
-
-	tx, err := pool.Begin(ctx)
+	tables, err := pgx.CollectRows(rows, pgx.RowTo[string])
 	if err != nil {
-		return fmt.Errorf("begin transaction: %w", err)
+		return fmt.Errorf("listing tables: %w", err)
 	}
-	defer tx.Rollback(ctx)
-
-	for _, path := range filepaths {
-		data, err := os.ReadFile(path)
-		if err != nil {
-			return fmt.Errorf("read migration file %s: %w", path, err)
-		}
-		_, err = tx.Exec(ctx, string(data))
-		if err != nil {
-			return fmt.Errorf("execute migration file %s: %w", path, err)
-		}
+	if len(tables) == 0 {
+		return nil
 	}
-
-	return tx.Commit(ctx)
+	if _, err := tx.Exec(ctx, "TRUNCATE "+strings.Join(tables, ", ")+" RESTART IDENTITY CASCADE"); err != nil {
+		return fmt.Errorf("wiping tables: %w", err)
+	}
+	return nil
 }
 
 func seedUsers(ctx context.Context, queries *db.Queries) ([]int64, error) {
@@ -592,11 +602,32 @@ func opttext(s string) pgtype.Text {
 }
 
 func main() {
-	migrationFiles := os.Getenv("MIGRATION_FILES")
-	if migrationFiles == "" {
-		slog.Error("MIGRATION_FILES environment variable is not set")
-		return
+	profileName := flag.String("profile", "permissions-matrix", "dataset to seed: "+profileNames())
+	users := flag.Int("users", 0, "number of users (0 = the profile's default)")
+	workspaces := flag.Int("workspaces", 0, "number of workspaces (0 = the profile's default)")
+	resources := flag.Int("resources", 0, "number of apps (0 = the profile's default)")
+	seed := flag.Uint64("seed", 1, "seed for the profile's random choices")
+	wipe := flag.Bool("wipe", false, "empty every table before seeding")
+	flag.Parse()
+
+	p, ok := profiles[*profileName]
+	if !ok {
+		slog.Error("unknown profile", "profile", *profileName, "profiles", profileNames())
+		os.Exit(2)
+	}
+	opts := Options{Users: *users, Workspaces: *workspaces, Resources: *resources, Seed: *seed}
+	if p.fixed && (opts.Users != 0 || opts.Workspaces != 0 || opts.Resources != 0) {
+		slog.Error("profile has a fixed dataset and takes no counts", "profile", *profileName)
+		os.Exit(2)
+	}
+	if opts.Users < 0 || opts.Workspaces < 0 || opts.Resources < 0 {
+		slog.Error("counts cannot be negative")
+		os.Exit(2)
 	}
+	opts.Users = cmp.Or(opts.Users, p.defaults.Users)
+	opts.Workspaces = cmp.Or(opts.Workspaces, p.defaults.Workspaces)
+	opts.Resources = cmp.Or(opts.Resources, p.defaults.Resources)
+
 	databaseURL := os.Getenv("DATABASE_URL")
 	if databaseURL == "" {
 		slog.Error("DATABASE_URL environment variable is not set")
@@ -610,8 +641,15 @@ func main() {
 	}
 	defer pool.Close()
 
-	if err := Seed(context.Background(), pool, strings.Split(migrationFiles, ",")); err != nil {
+	slog.Info("seeding", "profile", *profileName, "description", p.description, "users", opts.Users, "workspaces", opts.Workspaces, "resources", opts.Resources, "seed", opts.Seed)
+	if err := Seed(context.Background(), pool, p, opts, *wipe); err != nil {
 		slog.Error("seeding failed", "error", err)
+		os.Exit(1)
+	}
+
+	// the permission checks expect the permissions-matrix users and ids
+	if *profileName != "permissions-matrix" {
+		return
 	}
 
 	if err := tvmtestuser1(pool); err != nil {
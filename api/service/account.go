@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm/actions"
+	userv1 "github.com/team-loco/loco/shared/proto/user/v1"
+)
+
+var (
+	ErrAccountEmailMismatch     = errors.New("confirmation email does not match the account")
+	ErrAccountSoleOrgAdmin      = errors.New("user is the only admin of organizations")
+	ErrAccountImpersonated      = errors.New("account settings cannot be used while impersonated")
+	ErrAccountRequiresUserToken = errors.New("only users can manage their account")
+)
+
+// accountExport is the archive ExportMyData returns. Token secrets are never included.
+type accountExport struct {
+	ExportedAt              time.Time                          `json:"exportedAt"`
+	User                    genDb.User                         `json:"user"`
+	Scopes                  []genDb.EntityScope                `json:"scopes"`
+	Organizations           []genDb.ListUserOrganizationsRow   `json:"organizations"`
+	Workspaces              []genDb.ListUserWorkspacesRow      `json:"workspaces"`
+	Tokens                  []genDb.ListTokensForEntityRow     `json:"tokens"`
+	NotificationPreferences []genDb.UserNotificationPreference `json:"notificationPreferences"`
+	Notifications           []exportedNotification             `json:"notifications"`
+}
+
+// exportedNotification is a notification with its data kept as JSON rather than encoded bytes.
+type exportedNotification struct {
+	ID          int64                   `json:"id"`
+	Event       genDb.NotificationEvent `json:"event"`
+	WorkspaceID pgtype.Int8             `json:"workspaceId"`
+	Subject     string                  `json:"subject"`
+	Body        string                  `json:"body"`
+	Data        json.RawMessage         `json:"data"`
+	ReadAt      pgtype.Timestamptz      `json:"readAt"`
+	CreatedAt   pgtype.Timestamptz      `json:"createdAt"`
+}
+
+// DeleteMyAccount deletes the calling user. The organizations and workspaces they created are handed
+// to another organization admin; organizations nobody else administers block the deletion.
+func (s *UserServer) DeleteMyAccount(
+	ctx context.Context,
+	req *connect.Request[userv1.DeleteMyAccountRequest],
+) (*connect.Response[userv1.DeleteMyAccountResponse], error) {
+	entity, err := s.authorizeAccount(ctx, actions.DeleteMyAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.queries.GetUserByID(ctx, entity.ID)
+	if err != nil {
+		slog.WarnContext(ctx, "user not found", "userId", entity.ID)
+		return nil, connect.NewError(connect.CodeNotFound, ErrUserNotFound)
+	}
+	if !strings.EqualFold(req.Msg.GetConfirmEmail(), user.Email) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrAccountEmailMismatch)
+	}
+
+	orgs, err := s.queries.ListSolelyOwnedOrganizations(ctx, user.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list solely owned organizations", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if len(orgs) > 0 {
+		names := make([]string, len(orgs))
+		for i, org := range orgs {
+			names[i] = org.Name
+		}
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf(
+			"%w: %s; make another member an admin or delete them first", ErrAccountSoleOrgAdmin, strings.Join(names, ", ")))
+	}
+
+	// revoke first, so a failed delete never leaves tokens of a half-deleted account
+	if err := s.tvm.RevokeAllForEntity(ctx, entity); err != nil {
+		slog.ErrorContext(ctx, "failed to revoke user tokens", "userId", user.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+	qtx := apiDb.QueriesFor(tx)
+
+	if err := qtx.TransferCreatedOrganizations(ctx, user.ID); err != nil {
+		slog.ErrorContext(ctx, "failed to transfer organizations", "userId", user.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if err := qtx.TransferCreatedWorkspaces(ctx, user.ID); err != nil {
+		slog.ErrorContext(ctx, "failed to transfer workspaces", "userId", user.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	// scopes others were granted on the user are meaningless once they are gone
+	if err := qtx.RemoveAllScopesForEntity(ctx, genDb.RemoveAllScopesForEntityParams{
+		EntityType: entity.Type,
+		EntityID:   entity.ID,
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to remove scopes on user", "userId", user.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if err := qtx.DeleteUser(ctx, user.ID); err != nil {
+		slog.ErrorContext(ctx, "failed to delete user", "userId", user.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "user deleted their account", "userId", user.ID)
+	res := connect.NewResponse(&userv1.DeleteMyAccountResponse{})
+	res.Header().Set("Set-Cookie", "loco_token=; Path=/; Max-Age=0; HttpOnly; SameSite=Lax")
+	setRefreshCookie(res.Header(), "", 0)
+	return res, nil
+}
+
+// ExportMyData returns the calling user's records as a JSON document.
+func (s *UserServer) ExportMyData(
+	ctx context.Context,
+	req *connect.Request[userv1.ExportMyDataRequest],
+) (*connect.Response[userv1.ExportMyDataResponse], error) {
+	entity, err := s.authorizeAccount(ctx, actions.ExportMyData)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.queries.GetUserByID(ctx, entity.ID)
+	if err != nil {
+		slog.WarnContext(ctx, "user not found", "userId", entity.ID)
+		return nil, connect.NewError(connect.CodeNotFound, ErrUserNotFound)
+	}
+
+	export := accountExport{ExportedAt: time.Now().UTC(), User: user}
+	if export.Scopes, err = s.queries.GetUserScopes(ctx, user.ID); err != nil {
+		return nil, exportError(ctx, "scopes", err)
+	}
+	if export.Organizations, err = s.queries.ListUserOrganizations(ctx, user.ID); err != nil {
+		return nil, exportError(ctx, "organizations", err)
+	}
+	if export.Workspaces, err = s.queries.ListUserWorkspaces(ctx, user.ID); err != nil {
+		return nil, exportError(ctx, "workspaces", err)
+	}
+	if export.Tokens, err = s.tvm.ListTokensForEntity(ctx, entity); err != nil {
+		return nil, exportError(ctx, "tokens", err)
+	}
+	if export.NotificationPreferences, err = s.queries.ListUserNotificationPreferences(ctx, user.ID); err != nil {
+		return nil, exportError(ctx, "notification preferences", err)
+	}
+	notifications, err := s.queries.ListAllUserNotifications(ctx, user.ID)
+	if err != nil {
+		return nil, exportError(ctx, "notifications", err)
+	}
+	export.Notifications = make([]exportedNotification, len(notifications))
+	for i, n := range notifications {
+		export.Notifications[i] = exportedNotification{
+			ID:          n.ID,
+			Event:       n.Event,
+			WorkspaceID: n.WorkspaceID,
+			Subject:     n.Subject,
+			Body:        n.Body,
+			Data:        json.RawMessage(n.Data),
+			ReadAt:      n.ReadAt,
+			CreatedAt:   n.CreatedAt,
+		}
+	}
+
+	archive, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to encode data export", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "user exported their data", "userId", user.ID)
+	return connect.NewResponse(&userv1.ExportMyDataResponse{
+		Archive:     archive,
+		Filename:    fmt.Sprintf("loco-export-%d-%s.json", user.ID, export.ExportedAt.Format("20060102")),
+		ContentType: "application/json",
+	}), nil
+}
+
+// authorizeAccount returns the calling user if they may perform the action on their own account.
+// Impersonating admins are turned away: deleting, exporting or securing an account is for its owner alone.
+func (s *UserServer) authorizeAccount(ctx context.Context, action actions.Action) (genDb.Entity, error) {
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return genDb.Entity{}, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+	if entity.Type != genDb.EntityTypeUser {
+		return genDb.Entity{}, connect.NewError(connect.CodePermissionDenied, ErrAccountRequiresUserToken)
+	}
+	if _, impersonated := contextkeys.Impersonator(ctx); impersonated {
+		return genDb.Entity{}, connect.NewError(connect.CodePermissionDenied, ErrAccountImpersonated)
+	}
+
+	entityScopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return genDb.Entity{}, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+	if err := s.tvm.VerifyWithGivenEntityScopes(ctx, entityScopes, actions.New(action, entity.ID)); err != nil {
+		return genDb.Entity{}, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return entity, nil
+}
+
+func exportError(ctx context.Context, what string, err error) error {
+	slog.ErrorContext(ctx, "failed to export "+what, "error", err)
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+}
@@ -0,0 +1,675 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+	adminv1 "github.com/team-loco/loco/shared/proto/admin/v1"
+	domainv1 "github.com/team-loco/loco/shared/proto/domain/v1"
+	orgv1 "github.com/team-loco/loco/shared/proto/org/v1"
+	userv1 "github.com/team-loco/loco/shared/proto/user/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const maxAdminReasonLength = 500
+
+var (
+	ErrAdminReasonRequired   = errors.New("a reason is required")
+	ErrAdminReasonTooLong    = errors.New("reason must be at most 500 characters")
+	ErrNotUserEntity         = errors.New("only users can perform platform admin actions")
+	ErrRevokeOwnPlatformRole = errors.New("cannot revoke your own platform admin role")
+	ErrImpersonateSelf       = errors.New("cannot impersonate yourself")
+	ErrClusterNameNotUnique  = errors.New("cluster name already exists")
+	ErrInvalidCluster        = errors.New("name, region and provider are required")
+	ErrInvalidAuditFilter    = errors.New("target_id requires target_type")
+)
+
+// audit log actions
+const (
+	auditImpersonateUser     = "impersonate_user"
+	auditForceDeleteResource = "force_delete_resource"
+	auditSetPlatformDomain   = "set_platform_domain_active"
+	auditCreateCluster       = "create_cluster"
+	auditUpdateCluster       = "update_cluster"
+	auditSetNodePools        = "set_cluster_node_pools"
+	auditSetPlatformAdmin    = "set_platform_admin"
+)
+
+// AdminServer implements the AdminService gRPC server, for platform operators
+type AdminServer struct {
+	db              apiDb.Beginner
+	queries         genDb.Querier
+	machine         *tvm.VendingMachine
+	resourceService *ResourceServer
+}
+
+// NewAdminServer creates a new AdminServer instance
+func NewAdminServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine, resourceService *ResourceServer) *AdminServer {
+	return &AdminServer{
+		db:              db,
+		queries:         queries,
+		machine:         machine,
+		resourceService: resourceService,
+	}
+}
+
+// ListOrgs lists every org on the platform
+func (s *AdminServer) ListOrgs(
+	ctx context.Context,
+	req *connect.Request[adminv1.ListOrgsRequest],
+) (*connect.Response[adminv1.ListOrgsResponse], error) {
+	r := req.Msg
+
+	if _, err := s.authorize(ctx, actions.ListAllOrgs); err != nil {
+		return nil, err
+	}
+
+	pageSize := normalizePageSize(r.GetPageSize())
+	params := genDb.ListAllOrgsParams{Limit: pageSize}
+	if r.GetPageToken() != "" {
+		cursorID, err := decodeCursor(r.GetPageToken())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token: %w", err))
+		}
+		params.Cursor = pgtype.Int8{Int64: cursorID, Valid: true}
+	}
+	if query := strings.TrimSpace(r.GetQuery()); query != "" {
+		params.Query = pgtype.Text{String: query, Valid: true}
+	}
+
+	orgs, err := s.queries.ListAllOrgs(ctx, params)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list orgs", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	protoOrgs := make([]*orgv1.Organization, 0, len(orgs))
+	for _, org := range orgs {
+		protoOrgs = append(protoOrgs, orgToProto(org))
+	}
+
+	var nextPageToken string
+	if len(orgs) == int(pageSize) {
+		nextPageToken = encodeCursor(orgs[len(orgs)-1].ID)
+	}
+
+	return connect.NewResponse(&adminv1.ListOrgsResponse{
+		Orgs:          protoOrgs,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// ImpersonateUser issues a short-lived token acting as a user
+func (s *AdminServer) ImpersonateUser(
+	ctx context.Context,
+	req *connect.Request[adminv1.ImpersonateUserRequest],
+) (*connect.Response[adminv1.ImpersonateUserResponse], error) {
+	r := req.Msg
+
+	admin, err := s.authorize(ctx, actions.ImpersonateUser)
+	if err != nil {
+		return nil, err
+	}
+	reason, err := requireAdminReason(r.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	if r.GetUserId() == admin.ID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrImpersonateSelf)
+	}
+
+	if _, err := s.queries.GetUserByID(ctx, r.GetUserId()); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrUserNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get user", "userId", r.GetUserId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	// recorded before the token exists, so no impersonation goes unaudited
+	if err := s.audit(ctx, admin.ID, auditImpersonateUser, "user", r.GetUserId(), reason, ""); err != nil {
+		return nil, err
+	}
+
+	duration := time.Duration(r.GetDurationSeconds()) * time.Second
+	token, expiresAt, err := s.machine.Impersonate(ctx, admin.ID, r.GetUserId(), duration)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to issue impersonation token", "userId", r.GetUserId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "impersonating user", "adminId", admin.ID, "userId", r.GetUserId(), "expiresAt", expiresAt)
+
+	return connect.NewResponse(&adminv1.ImpersonateUserResponse{
+		Token:     token,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}), nil
+}
+
+// ForceDeleteResource deletes a resource regardless of locks, deletion protection and approvals
+func (s *AdminServer) ForceDeleteResource(
+	ctx context.Context,
+	req *connect.Request[adminv1.ForceDeleteResourceRequest],
+) (*connect.Response[adminv1.ForceDeleteResourceResponse], error) {
+	r := req.Msg
+
+	admin, err := s.authorize(ctx, actions.ForceDeleteResource)
+	if err != nil {
+		return nil, err
+	}
+	reason, err := requireAdminReason(r.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := s.queries.GetResourceByID(ctx, r.GetResourceId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get resource", "resourceId", r.GetResourceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	cleanedUp, err := s.resourceService.ForceDeleteResource(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := fmt.Sprintf("%s in workspace %d", resource.Name, resource.WorkspaceID)
+	if !cleanedUp {
+		detail += "; Application left behind"
+	}
+	if err := s.audit(ctx, admin.ID, auditForceDeleteResource, "resource", resource.ID, reason, detail); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "force-deleted resource", "adminId", admin.ID, "resourceId", resource.ID, "cleanedUp", cleanedUp)
+
+	return connect.NewResponse(&adminv1.ForceDeleteResourceResponse{ApplicationCleanedUp: cleanedUp}), nil
+}
+
+// SetPlatformDomainActive activates or deactivates a platform domain
+func (s *AdminServer) SetPlatformDomainActive(
+	ctx context.Context,
+	req *connect.Request[adminv1.SetPlatformDomainActiveRequest],
+) (*connect.Response[adminv1.SetPlatformDomainActiveResponse], error) {
+	r := req.Msg
+
+	admin, err := s.authorize(ctx, actions.UpdatePlatformDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	domain, err := s.queries.SetPlatformDomainActive(ctx, genDb.SetPlatformDomainActiveParams{
+		ID:       r.GetId(),
+		IsActive: r.GetActive(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrPlatformDomainNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to update platform domain", "id", r.GetId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.audit(ctx, admin.ID, auditSetPlatformDomain, "platform_domain", domain.ID, r.GetReason(), fmt.Sprintf("%s active=%t", domain.Domain, domain.IsActive)); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&adminv1.SetPlatformDomainActiveResponse{
+		PlatformDomain: &domainv1.PlatformDomain{
+			Id:        domain.ID,
+			Domain:    domain.Domain,
+			IsActive:  domain.IsActive,
+			CreatedAt: timestamppb.New(domain.CreatedAt.Time),
+			UpdatedAt: timestamppb.New(domain.CreatedAt.Time),
+		},
+	}), nil
+}
+
+// ListClusters lists every cluster, active or not
+func (s *AdminServer) ListClusters(
+	ctx context.Context,
+	req *connect.Request[adminv1.ListClustersRequest],
+) (*connect.Response[adminv1.ListClustersResponse], error) {
+	if _, err := s.authorize(ctx, actions.ManageClusters); err != nil {
+		return nil, err
+	}
+
+	clusters, err := s.queries.ListClusters(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list clusters", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	protoClusters := make([]*adminv1.Cluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		protoClusters = append(protoClusters, clusterToProto(cluster))
+	}
+
+	return connect.NewResponse(&adminv1.ListClustersResponse{Clusters: protoClusters}), nil
+}
+
+// CreateCluster registers a cluster
+func (s *AdminServer) CreateCluster(
+	ctx context.Context,
+	req *connect.Request[adminv1.CreateClusterRequest],
+) (*connect.Response[adminv1.CreateClusterResponse], error) {
+	r := req.Msg
+
+	admin, err := s.authorize(ctx, actions.ManageClusters)
+	if err != nil {
+		return nil, err
+	}
+	if r.GetName() == "" || r.GetRegion() == "" || r.GetProvider() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidCluster)
+	}
+
+	cluster, err := s.queries.CreateCluster(ctx, genDb.CreateClusterParams{
+		Name:      r.GetName(),
+		Region:    r.GetRegion(),
+		Provider:  r.GetProvider(),
+		IsActive:  r.GetIsActive(),
+		IsDefault: r.GetIsDefault(),
+		Endpoint:  pgtype.Text{String: r.GetEndpoint(), Valid: r.GetEndpoint() != ""},
+	})
+	if err != nil {
+		if isPgConstraintViolation(err) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, ErrClusterNameNotUnique)
+		}
+		slog.ErrorContext(ctx, "failed to create cluster", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.audit(ctx, admin.ID, auditCreateCluster, "cluster", cluster.ID, r.GetReason(), fmt.Sprintf("%s in %s", cluster.Name, cluster.Region)); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "created cluster", "clusterId", cluster.ID, "name", cluster.Name, "region", cluster.Region)
+
+	return connect.NewResponse(&adminv1.CreateClusterResponse{Cluster: clusterToProto(cluster)}), nil
+}
+
+// UpdateCluster changes the given fields of a cluster
+func (s *AdminServer) UpdateCluster(
+	ctx context.Context,
+	req *connect.Request[adminv1.UpdateClusterRequest],
+) (*connect.Response[adminv1.UpdateClusterResponse], error) {
+	r := req.Msg
+
+	admin, err := s.authorize(ctx, actions.ManageClusters)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.queries.GetCluster(ctx, r.GetId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrClusterNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get cluster", "clusterId", r.GetId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	params := genDb.UpdateClusterParams{
+		ID:        existing.ID,
+		IsActive:  existing.IsActive,
+		IsDefault: existing.IsDefault,
+		Endpoint:  existing.Endpoint,
+	}
+	if r.IsActive != nil {
+		params.IsActive = r.GetIsActive()
+	}
+	if r.IsDefault != nil {
+		params.IsDefault = r.GetIsDefault()
+	}
+	if r.Endpoint != nil {
+		params.Endpoint = pgtype.Text{String: r.GetEndpoint(), Valid: r.GetEndpoint() != ""}
+	}
+
+	cluster, err := s.queries.UpdateCluster(ctx, params)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to update cluster", "clusterId", existing.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	detail := fmt.Sprintf("active=%t default=%t endpoint=%s", cluster.IsActive, cluster.IsDefault, cluster.Endpoint.String)
+	if err := s.audit(ctx, admin.ID, auditUpdateCluster, "cluster", cluster.ID, r.GetReason(), detail); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "updated cluster", "clusterId", cluster.ID, "active", cluster.IsActive, "default", cluster.IsDefault)
+
+	return connect.NewResponse(&adminv1.UpdateClusterResponse{Cluster: clusterToProto(cluster)}), nil
+}
+
+// ListClusterNodePools lists the node pools of a cluster
+func (s *AdminServer) ListClusterNodePools(
+	ctx context.Context,
+	req *connect.Request[adminv1.ListClusterNodePoolsRequest],
+) (*connect.Response[adminv1.ListClusterNodePoolsResponse], error) {
+	r := req.Msg
+
+	if _, err := s.authorize(ctx, actions.ManageClusters); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListClusterNodePools(ctx, r.GetClusterId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list node pools", "clusterId", r.GetClusterId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	pools := make([]*adminv1.NodePool, 0, len(rows))
+	for _, row := range rows {
+		pool, err := nodePoolToProto(row)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to decode node pool", "clusterId", r.GetClusterId(), "error", err)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		pools = append(pools, pool)
+	}
+
+	return connect.NewResponse(&adminv1.ListClusterNodePoolsResponse{NodePools: pools}), nil
+}
+
+// SetClusterNodePools replaces the node pools of a cluster. Services already deployed keep
+// running; their scheduling is checked against the new pools the next time their spec changes.
+func (s *AdminServer) SetClusterNodePools(
+	ctx context.Context,
+	req *connect.Request[adminv1.SetClusterNodePoolsRequest],
+) (*connect.Response[adminv1.SetClusterNodePoolsResponse], error) {
+	r := req.Msg
+
+	admin, err := s.authorize(ctx, actions.ManageClusters)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateNodePools(r.GetNodePools()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	cluster, err := s.queries.GetCluster(ctx, r.GetClusterId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrClusterNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get cluster", "clusterId", r.GetClusterId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+	qtx := apiDb.QueriesFor(tx)
+
+	if err := qtx.DeleteClusterNodePools(ctx, cluster.ID); err != nil {
+		slog.ErrorContext(ctx, "failed to delete node pools", "clusterId", cluster.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	pools := make([]*adminv1.NodePool, 0, len(r.GetNodePools()))
+	names := make([]string, 0, len(r.GetNodePools()))
+	for _, pool := range r.GetNodePools() {
+		labels, err := json.Marshal(pool.GetLabels())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		taints := make([]nodeTaint, 0, len(pool.GetTaints()))
+		for _, taint := range pool.GetTaints() {
+			taints = append(taints, nodeTaint{Key: taint.GetKey(), Value: taint.GetValue(), Effect: taint.GetEffect()})
+		}
+		taintsJSON, err := json.Marshal(taints)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		row, err := qtx.CreateClusterNodePool(ctx, genDb.CreateClusterNodePoolParams{
+			ClusterID: cluster.ID,
+			Name:      pool.GetName(),
+			Labels:    labels,
+			Taints:    taintsJSON,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to create node pool", "clusterId", cluster.ID, "pool", pool.GetName(), "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		protoPool, err := nodePoolToProto(row)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		pools = append(pools, protoPool)
+		names = append(names, pool.GetName())
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.audit(ctx, admin.ID, auditSetNodePools, "cluster", cluster.ID, r.GetReason(), "node pools: "+strings.Join(names, ", ")); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "set cluster node pools", "clusterId", cluster.ID, "pools", len(pools))
+
+	return connect.NewResponse(&adminv1.SetClusterNodePoolsResponse{NodePools: pools}), nil
+}
+
+// ListPlatformAdmins lists the users with the platform admin role
+func (s *AdminServer) ListPlatformAdmins(
+	ctx context.Context,
+	req *connect.Request[adminv1.ListPlatformAdminsRequest],
+) (*connect.Response[adminv1.ListPlatformAdminsResponse], error) {
+	if _, err := s.authorize(ctx, actions.ManagePlatformAdmins); err != nil {
+		return nil, err
+	}
+
+	admins, err := s.queries.ListPlatformAdmins(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list platform admins", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	users := make([]*userv1.User, 0, len(admins))
+	for _, admin := range admins {
+		users = append(users, dbUserToProto(admin))
+	}
+
+	return connect.NewResponse(&adminv1.ListPlatformAdminsResponse{Users: users}), nil
+}
+
+// SetPlatformAdmin grants or revokes the platform admin role
+func (s *AdminServer) SetPlatformAdmin(
+	ctx context.Context,
+	req *connect.Request[adminv1.SetPlatformAdminRequest],
+) (*connect.Response[adminv1.SetPlatformAdminResponse], error) {
+	r := req.Msg
+
+	admin, err := s.authorize(ctx, actions.ManagePlatformAdmins)
+	if err != nil {
+		return nil, err
+	}
+	reason, err := requireAdminReason(r.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	// keeps at least the caller, so the platform is never left without an admin
+	if !r.GetAdmin() && r.GetUserId() == admin.ID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrRevokeOwnPlatformRole)
+	}
+
+	if _, err := s.queries.GetUserByID(ctx, r.GetUserId()); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrUserNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get user", "userId", r.GetUserId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.machine.SetPlatformAdmin(ctx, r.GetUserId(), r.GetAdmin()); err != nil {
+		slog.ErrorContext(ctx, "failed to set platform admin", "userId", r.GetUserId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := s.audit(ctx, admin.ID, auditSetPlatformAdmin, "user", r.GetUserId(), reason, fmt.Sprintf("admin=%t", r.GetAdmin())); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "set platform admin", "adminId", admin.ID, "userId", r.GetUserId(), "admin", r.GetAdmin())
+
+	return connect.NewResponse(&adminv1.SetPlatformAdminResponse{}), nil
+}
+
+// ListAuditLog lists what platform admins did, newest first
+func (s *AdminServer) ListAuditLog(
+	ctx context.Context,
+	req *connect.Request[adminv1.ListAuditLogRequest],
+) (*connect.Response[adminv1.ListAuditLogResponse], error) {
+	r := req.Msg
+
+	if _, err := s.authorize(ctx, actions.ListAdminAuditLog); err != nil {
+		return nil, err
+	}
+	if r.TargetId != nil && r.TargetType == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidAuditFilter)
+	}
+
+	pageSize := normalizePageSize(r.GetPageSize())
+	params := genDb.ListAdminAuditEntriesParams{Limit: pageSize}
+	if r.GetPageToken() != "" {
+		cursorID, err := decodeCursor(r.GetPageToken())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token: %w", err))
+		}
+		params.Cursor = pgtype.Int8{Int64: cursorID, Valid: true}
+	}
+	if r.TargetType != nil {
+		params.TargetType = pgtype.Text{String: r.GetTargetType(), Valid: true}
+	}
+	if r.TargetId != nil {
+		params.TargetID = pgtype.Int8{Int64: r.GetTargetId(), Valid: true}
+	}
+
+	entries, err := s.queries.ListAdminAuditEntries(ctx, params)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list audit log", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	protoEntries := make([]*adminv1.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		protoEntries = append(protoEntries, auditEntryToProto(entry))
+	}
+
+	var nextPageToken string
+	if len(entries) == int(pageSize) {
+		nextPageToken = encodeCursor(entries[len(entries)-1].ID)
+	}
+
+	return connect.NewResponse(&adminv1.ListAuditLogResponse{
+		Entries:       protoEntries,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// authorize checks the caller may perform a platform admin action and returns the caller, which
+// must be a user so the audit log names a person.
+func (s *AdminServer) authorize(ctx context.Context, action actions.Action) (genDb.Entity, error) {
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return genDb.Entity{}, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return genDb.Entity{}, connect.NewError(connect.CodeInternal, fmt.Errorf("entity not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(action, 0)); err != nil {
+		slog.WarnContext(ctx, "unauthorized platform admin action", "entityType", entity.Type, "entityId", entity.ID)
+		return genDb.Entity{}, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	if entity.Type != genDb.EntityTypeUser {
+		return genDb.Entity{}, connect.NewError(connect.CodePermissionDenied, ErrNotUserEntity)
+	}
+	return entity, nil
+}
+
+// audit records a platform admin action.
+func (s *AdminServer) audit(ctx context.Context, actorID int64, action, targetType string, targetID int64, reason, detail string) error {
+	if _, err := s.queries.InsertAdminAuditEntry(ctx, genDb.InsertAdminAuditEntryParams{
+		ActorID:    pgtype.Int8{Int64: actorID, Valid: true},
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reason:     strings.TrimSpace(reason),
+		Detail:     detail,
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to record admin action", "action", action, "targetId", targetID, "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return nil
+}
+
+// requireAdminReason checks the reason given for an action that affects a tenant.
+func requireAdminReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return "", connect.NewError(connect.CodeInvalidArgument, ErrAdminReasonRequired)
+	}
+	if len(reason) > maxAdminReasonLength {
+		return "", connect.NewError(connect.CodeInvalidArgument, ErrAdminReasonTooLong)
+	}
+	return reason, nil
+}
+
+func clusterToProto(cluster genDb.Cluster) *adminv1.Cluster {
+	protoCluster := &adminv1.Cluster{
+		Id:           cluster.ID,
+		Name:         cluster.Name,
+		Region:       cluster.Region,
+		Provider:     cluster.Provider,
+		IsActive:     cluster.IsActive,
+		IsDefault:    cluster.IsDefault,
+		Endpoint:     cluster.Endpoint.String,
+		HealthStatus: cluster.HealthStatus.String,
+		CreatedAt:    timestamppb.New(cluster.CreatedAt.Time),
+		UpdatedAt:    timestamppb.New(cluster.UpdatedAt.Time),
+	}
+	if cluster.LastHealthCheck.Valid {
+		protoCluster.LastHealthCheck = timestamppb.New(cluster.LastHealthCheck.Time)
+	}
+	return protoCluster
+}
+
+func auditEntryToProto(entry genDb.AdminAuditLog) *adminv1.AuditEntry {
+	protoEntry := &adminv1.AuditEntry{
+		Id:         entry.ID,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetId:   entry.TargetID,
+		Reason:     entry.Reason,
+		Detail:     entry.Detail,
+		CreatedAt:  timestamppb.New(entry.CreatedAt.Time),
+	}
+	if entry.ActorID.Valid {
+		protoEntry.ActorId = &entry.ActorID.Int64
+	}
+	return protoEntry
+}
@@ -0,0 +1,471 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+	alertv1 "github.com/team-loco/loco/shared/proto/alert/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultAlertWindowSeconds = 600
+	maxAlertWindowSeconds     = 24 * 60 * 60
+	maxAlertRuleNameLength    = 100
+	maxAlertTargets           = 10
+)
+
+var (
+	ErrAlertRuleNotFound     = errors.New("alert rule not found")
+	ErrInvalidAlertCondition = errors.New("invalid alert condition")
+	ErrInvalidAlertRuleName  = errors.New("alert rule name must be between 1 and 100 characters")
+	ErrInvalidAlertThreshold = errors.New("alert threshold must be greater than zero")
+	ErrInvalidAlertWindow    = errors.New("alert window must be between 60 seconds and 24 hours")
+	ErrNoAlertTargets        = errors.New("alert rule needs at least one target")
+	ErrTooManyAlertTargets   = errors.New("alert rule has too many targets")
+	ErrInvalidAlertTarget    = errors.New("invalid alert target")
+)
+
+// AlertServer implements the AlertService gRPC server
+type AlertServer struct {
+	db      apiDb.Beginner
+	queries genDb.Querier
+	machine *tvm.VendingMachine
+}
+
+// NewAlertServer creates a new AlertServer instance
+func NewAlertServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine) *AlertServer {
+	return &AlertServer{db: db, queries: queries, machine: machine}
+}
+
+// CreateAlertRule creates an alert rule for a workspace
+func (s *AlertServer) CreateAlertRule(
+	ctx context.Context,
+	req *connect.Request[alertv1.CreateAlertRuleRequest],
+) (*connect.Response[alertv1.CreateAlertRuleResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.CreateAlertRule, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to create alert rule", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	condition, ok := protoAlertConditionToDb(r.GetCondition())
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidAlertCondition)
+	}
+	windowSeconds := r.GetWindowSeconds()
+	if windowSeconds == 0 {
+		windowSeconds = defaultAlertWindowSeconds
+	}
+	if err := validateAlertRule(r.GetName(), condition, r.GetThreshold(), windowSeconds); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	targets, err := protoAlertTargetsToDb(r.GetTargets())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if len(targets) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrNoAlertTargets)
+	}
+
+	resourceID := pgtype.Int8{}
+	if r.ResourceId != nil {
+		resource, err := s.queries.GetResourceByID(ctx, r.GetResourceId())
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+			}
+			slog.ErrorContext(ctx, "failed to get resource", "resourceId", r.GetResourceId(), "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		if resource.WorkspaceID != r.GetWorkspaceId() {
+			return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+		}
+		resourceID = pgtype.Int8{Int64: resource.ID, Valid: true}
+	}
+
+	createdBy := pgtype.Int8{}
+	if entity.Type == genDb.EntityTypeUser {
+		createdBy = pgtype.Int8{Int64: entity.ID, Valid: true}
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := apiDb.QueriesFor(tx)
+
+	rule, err := qtx.CreateAlertRule(ctx, genDb.CreateAlertRuleParams{
+		WorkspaceID:   r.GetWorkspaceId(),
+		ResourceID:    resourceID,
+		Name:          r.GetName(),
+		Condition:     condition,
+		Threshold:     r.GetThreshold(),
+		WindowSeconds: windowSeconds,
+		Enabled:       true,
+		CreatedBy:     createdBy,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create alert rule", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if err := replaceAlertTargets(ctx, qtx, rule.ID, targets); err != nil {
+		slog.ErrorContext(ctx, "failed to save alert targets", "ruleId", rule.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit alert rule", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "created alert rule", "ruleId", rule.ID, "workspaceId", rule.WorkspaceID, "condition", rule.Condition)
+
+	return connect.NewResponse(&alertv1.CreateAlertRuleResponse{
+		Rule: alertRuleToProto(rule, targets, nil),
+	}), nil
+}
+
+// ListAlertRules lists a workspace's alert rules
+func (s *AlertServer) ListAlertRules(
+	ctx context.Context,
+	req *connect.Request[alertv1.ListAlertRulesRequest],
+) (*connect.Response[alertv1.ListAlertRulesResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ListAlertRules, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to list alert rules", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	rules, err := s.queries.ListAlertRules(ctx, r.GetWorkspaceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list alert rules", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	ruleIDs := make([]int64, 0, len(rules))
+	for _, rule := range rules {
+		ruleIDs = append(ruleIDs, rule.ID)
+	}
+	targets, err := s.queries.ListAlertTargets(ctx, ruleIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list alert targets", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	states, err := s.queries.ListFiringAlertStates(ctx, ruleIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list firing alerts", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	targetsByRule := map[int64][]genDb.AlertTarget{}
+	for _, target := range targets {
+		targetsByRule[target.RuleID] = append(targetsByRule[target.RuleID], target)
+	}
+	firingByRule := map[int64][]int64{}
+	for _, state := range states {
+		firingByRule[state.RuleID] = append(firingByRule[state.RuleID], state.ResourceID)
+	}
+
+	protoRules := make([]*alertv1.AlertRule, 0, len(rules))
+	for _, rule := range rules {
+		protoRules = append(protoRules, alertRuleToProto(rule, targetsByRule[rule.ID], firingByRule[rule.ID]))
+	}
+
+	return connect.NewResponse(&alertv1.ListAlertRulesResponse{Rules: protoRules}), nil
+}
+
+// UpdateAlertRule changes the given fields of an alert rule
+func (s *AlertServer) UpdateAlertRule(
+	ctx context.Context,
+	req *connect.Request[alertv1.UpdateAlertRuleRequest],
+) (*connect.Response[alertv1.UpdateAlertRuleResponse], error) {
+	r := req.Msg
+
+	rule, err := s.authorizeRule(ctx, r.GetId(), actions.UpdateAlertRule)
+	if err != nil {
+		return nil, err
+	}
+
+	params := genDb.UpdateAlertRuleParams{
+		ID:            rule.ID,
+		Name:          rule.Name,
+		Threshold:     rule.Threshold,
+		WindowSeconds: rule.WindowSeconds,
+		Enabled:       rule.Enabled,
+	}
+	if r.Name != nil {
+		params.Name = r.GetName()
+	}
+	if r.Threshold != nil {
+		params.Threshold = r.GetThreshold()
+	}
+	if r.WindowSeconds != nil {
+		params.WindowSeconds = r.GetWindowSeconds()
+	}
+	if r.Enabled != nil {
+		params.Enabled = r.GetEnabled()
+	}
+	if err := validateAlertRule(params.Name, rule.Condition, params.Threshold, params.WindowSeconds); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	targets, err := protoAlertTargetsToDb(r.GetTargets())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := apiDb.QueriesFor(tx)
+
+	updated, err := qtx.UpdateAlertRule(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrAlertRuleNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to update alert rule", "ruleId", rule.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if len(targets) > 0 {
+		if err := replaceAlertTargets(ctx, qtx, rule.ID, targets); err != nil {
+			slog.ErrorContext(ctx, "failed to save alert targets", "ruleId", rule.ID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+	stored, err := qtx.ListAlertTargets(ctx, []int64{rule.ID})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list alert targets", "ruleId", rule.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	states, err := qtx.ListFiringAlertStates(ctx, []int64{rule.ID})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list firing alerts", "ruleId", rule.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit alert rule", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	firing := make([]int64, 0, len(states))
+	for _, state := range states {
+		firing = append(firing, state.ResourceID)
+	}
+
+	return connect.NewResponse(&alertv1.UpdateAlertRuleResponse{
+		Rule: alertRuleToProto(updated, stored, firing),
+	}), nil
+}
+
+// DeleteAlertRule deletes an alert rule
+func (s *AlertServer) DeleteAlertRule(
+	ctx context.Context,
+	req *connect.Request[alertv1.DeleteAlertRuleRequest],
+) (*connect.Response[alertv1.DeleteAlertRuleResponse], error) {
+	rule, err := s.authorizeRule(ctx, req.Msg.GetId(), actions.DeleteAlertRule)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.queries.DeleteAlertRule(ctx, rule.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to delete alert rule", "ruleId", rule.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, ErrAlertRuleNotFound)
+	}
+
+	slog.InfoContext(ctx, "deleted alert rule", "ruleId", rule.ID, "workspaceId", rule.WorkspaceID)
+
+	return connect.NewResponse(&alertv1.DeleteAlertRuleResponse{}), nil
+}
+
+// authorizeRule loads a rule and checks the caller may perform action on its workspace.
+func (s *AlertServer) authorizeRule(ctx context.Context, ruleID int64, action actions.Action) (genDb.AlertRule, error) {
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return genDb.AlertRule{}, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	rule, err := s.queries.GetAlertRule(ctx, ruleID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return genDb.AlertRule{}, connect.NewError(connect.CodeNotFound, ErrAlertRuleNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get alert rule", "ruleId", ruleID, "error", err)
+		return genDb.AlertRule{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(action, rule.WorkspaceID)); err != nil {
+		slog.WarnContext(ctx, "unauthorized to manage alert rule", "ruleId", ruleID, "workspaceId", rule.WorkspaceID)
+		return genDb.AlertRule{}, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return rule, nil
+}
+
+func replaceAlertTargets(ctx context.Context, qtx genDb.Querier, ruleID int64, targets []genDb.AlertTarget) error {
+	if err := qtx.DeleteAlertTargets(ctx, ruleID); err != nil {
+		return err
+	}
+	for _, target := range targets {
+		if err := qtx.CreateAlertTarget(ctx, genDb.CreateAlertTargetParams{
+			RuleID:  ruleID,
+			Channel: target.Channel,
+			Target:  target.Target,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateAlertRule(name string, condition genDb.AlertCondition, threshold float64, windowSeconds int32) error {
+	if name == "" || len(name) > maxAlertRuleNameLength {
+		return ErrInvalidAlertRuleName
+	}
+	if condition != genDb.AlertConditionDeploymentFailed && threshold <= 0 {
+		return ErrInvalidAlertThreshold
+	}
+	if windowSeconds < 60 || windowSeconds > maxAlertWindowSeconds {
+		return ErrInvalidAlertWindow
+	}
+	return nil
+}
+
+func protoAlertTargetsToDb(targets []*alertv1.AlertTarget) ([]genDb.AlertTarget, error) {
+	if len(targets) > maxAlertTargets {
+		return nil, ErrTooManyAlertTargets
+	}
+	result := make([]genDb.AlertTarget, 0, len(targets))
+	for _, target := range targets {
+		address := strings.TrimSpace(target.GetAddress())
+		var channel genDb.NotificationChannel
+		switch target.GetChannel() {
+		case alertv1.AlertChannel_ALERT_CHANNEL_EMAIL:
+			channel = genDb.NotificationChannelEmail
+			if !strings.Contains(address, "@") {
+				return nil, fmt.Errorf("%w: %q is not an email address", ErrInvalidAlertTarget, address)
+			}
+		case alertv1.AlertChannel_ALERT_CHANNEL_WEBHOOK, alertv1.AlertChannel_ALERT_CHANNEL_SLACK:
+			channel = genDb.NotificationChannelWebhook
+			if target.GetChannel() == alertv1.AlertChannel_ALERT_CHANNEL_SLACK {
+				channel = genDb.NotificationChannelSlack
+			}
+			if address == "" {
+				return nil, fmt.Errorf("%w: url is required", ErrInvalidAlertTarget)
+			}
+			if err := validateWebhookURL(address); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidAlertTarget, err)
+			}
+		default:
+			return nil, fmt.Errorf("%w: unknown channel", ErrInvalidAlertTarget)
+		}
+		result = append(result, genDb.AlertTarget{Channel: channel, Target: address})
+	}
+	return result, nil
+}
+
+func protoAlertConditionToDb(condition alertv1.AlertCondition) (genDb.AlertCondition, bool) {
+	switch condition {
+	case alertv1.AlertCondition_ALERT_CONDITION_DEPLOYMENT_FAILED:
+		return genDb.AlertConditionDeploymentFailed, true
+	case alertv1.AlertCondition_ALERT_CONDITION_RESTARTS:
+		return genDb.AlertConditionRestarts, true
+	case alertv1.AlertCondition_ALERT_CONDITION_LATENCY_P95:
+		return genDb.AlertConditionLatencyP95, true
+	default:
+		return "", false
+	}
+}
+
+func alertConditionToProto(condition genDb.AlertCondition) alertv1.AlertCondition {
+	switch condition {
+	case genDb.AlertConditionDeploymentFailed:
+		return alertv1.AlertCondition_ALERT_CONDITION_DEPLOYMENT_FAILED
+	case genDb.AlertConditionRestarts:
+		return alertv1.AlertCondition_ALERT_CONDITION_RESTARTS
+	case genDb.AlertConditionLatencyP95:
+		return alertv1.AlertCondition_ALERT_CONDITION_LATENCY_P95
+	default:
+		return alertv1.AlertCondition_ALERT_CONDITION_UNSPECIFIED
+	}
+}
+
+func alertChannelToProto(channel genDb.NotificationChannel) alertv1.AlertChannel {
+	switch channel {
+	case genDb.NotificationChannelEmail:
+		return alertv1.AlertChannel_ALERT_CHANNEL_EMAIL
+	case genDb.NotificationChannelWebhook:
+		return alertv1.AlertChannel_ALERT_CHANNEL_WEBHOOK
+	case genDb.NotificationChannelSlack:
+		return alertv1.AlertChannel_ALERT_CHANNEL_SLACK
+	default:
+		return alertv1.AlertChannel_ALERT_CHANNEL_UNSPECIFIED
+	}
+}
+
+func alertRuleToProto(rule genDb.AlertRule, targets []genDb.AlertTarget, firing []int64) *alertv1.AlertRule {
+	protoRule := &alertv1.AlertRule{
+		Id:            rule.ID,
+		WorkspaceId:   rule.WorkspaceID,
+		Name:          rule.Name,
+		Condition:     alertConditionToProto(rule.Condition),
+		Threshold:     rule.Threshold,
+		WindowSeconds: rule.WindowSeconds,
+		Enabled:       rule.Enabled,
+		Firing:        firing,
+		CreatedAt:     timestamppb.New(rule.CreatedAt.Time),
+		UpdatedAt:     timestamppb.New(rule.UpdatedAt.Time),
+	}
+	if rule.ResourceID.Valid {
+		protoRule.ResourceId = &rule.ResourceID.Int64
+	}
+	for _, target := range targets {
+		protoRule.Targets = append(protoRule.Targets, &alertv1.AlertTarget{
+			Channel: alertChannelToProto(target.Channel),
+			Address: target.Target,
+		})
+	}
+	return protoRule
+}
@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+	announcementv1 "github.com/team-loco/loco/shared/proto/announcement/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrAnnouncementNotFound = errors.New("announcement not found")
+	ErrInvalidAnnouncement  = errors.New("announcement requires a kind, title and body")
+	ErrNotAUser             = errors.New("only users can mark announcements as read")
+)
+
+type AnnouncementServer struct {
+	db      apiDb.Beginner
+	queries genDb.Querier
+	machine *tvm.VendingMachine
+}
+
+func NewAnnouncementServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine) *AnnouncementServer {
+	return &AnnouncementServer{db: db, queries: queries, machine: machine}
+}
+
+// CreateAnnouncement publishes a platform announcement (admin only)
+func (s *AnnouncementServer) CreateAnnouncement(
+	ctx context.Context,
+	req *connect.Request[announcementv1.CreateAnnouncementRequest],
+) (*connect.Response[announcementv1.CreateAnnouncementResponse], error) {
+	r := req.Msg
+
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.CreateAnnouncement, 0)); err != nil {
+		slog.WarnContext(ctx, "unauthorized to create announcement")
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	kind, ok := announcementKindToDB(r.GetKind())
+	if !ok || strings.TrimSpace(r.GetTitle()) == "" || strings.TrimSpace(r.GetBody()) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidAnnouncement)
+	}
+
+	params := genDb.CreateAnnouncementParams{
+		Kind:  kind,
+		Title: r.GetTitle(),
+		Body:  r.GetBody(),
+	}
+	if r.Link != nil {
+		params.Link = pgtype.Text{String: r.GetLink(), Valid: true}
+	}
+	if r.ExpiresAt != nil {
+		params.ExpiresAt = pgtype.Timestamptz{Time: r.GetExpiresAt().AsTime(), Valid: true}
+	}
+	if entity.Type == genDb.EntityTypeUser {
+		params.CreatedBy = pgtype.Int8{Int64: entity.ID, Valid: true}
+	}
+
+	id, err := s.queries.CreateAnnouncement(ctx, params)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create announcement", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "published announcement", "announcementId", id, "kind", kind)
+
+	return connect.NewResponse(&announcementv1.CreateAnnouncementResponse{
+		Id: id,
+	}), nil
+}
+
+// DeleteAnnouncement removes a platform announcement (admin only)
+func (s *AnnouncementServer) DeleteAnnouncement(
+	ctx context.Context,
+	req *connect.Request[announcementv1.DeleteAnnouncementRequest],
+) (*connect.Response[announcementv1.DeleteAnnouncementResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.DeleteAnnouncement, 0)); err != nil {
+		slog.WarnContext(ctx, "unauthorized to delete announcement", "announcementId", r.GetId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	deleted, err := s.queries.DeleteAnnouncement(ctx, r.GetId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to delete announcement", "announcementId", r.GetId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, ErrAnnouncementNotFound)
+	}
+
+	return connect.NewResponse(&announcementv1.DeleteAnnouncementResponse{}), nil
+}
+
+// ListAnnouncements lists unexpired announcements, newest first. Read state is only
+// tracked for users; other callers see every announcement as unread.
+func (s *AnnouncementServer) ListAnnouncements(
+	ctx context.Context,
+	req *connect.Request[announcementv1.ListAnnouncementsRequest],
+) (*connect.Response[announcementv1.ListAnnouncementsResponse], error) {
+	r := req.Msg
+
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+
+	var lastReadID int64
+	if entity.Type == genDb.EntityTypeUser {
+		scopes, ok := contextkeys.Scopes(ctx)
+		if !ok {
+			slog.ErrorContext(ctx, "entity scopes not found in context")
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+		}
+
+		if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ListAnnouncements, entity.ID)); err != nil {
+			slog.WarnContext(ctx, "unauthorized to list announcements")
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+
+		marker, err := s.queries.GetAnnouncementReadMarker(ctx, entity.ID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			slog.ErrorContext(ctx, "failed to get announcement read marker", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		lastReadID = marker
+	}
+
+	pageSize := normalizePageSize(r.GetPageSize())
+
+	var pageToken pgtype.Int8
+	if r.GetPageToken() != "" {
+		cursorID, err := decodeCursor(r.GetPageToken())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token: %w", err))
+		}
+		pageToken = pgtype.Int8{Int64: cursorID, Valid: true}
+	}
+
+	var afterID int64
+	if r.GetUnreadOnly() {
+		afterID = lastReadID
+	}
+
+	dbAnnouncements, err := s.queries.ListAnnouncements(ctx, genDb.ListAnnouncementsParams{
+		Limit:     pageSize,
+		AfterID:   afterID,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list announcements", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	unreadCount, err := s.queries.CountUnreadAnnouncements(ctx, lastReadID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count unread announcements", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	announcements := make([]*announcementv1.Announcement, 0, len(dbAnnouncements))
+	for _, a := range dbAnnouncements {
+		announcements = append(announcements, dbAnnouncementToProto(a, lastReadID))
+	}
+
+	var nextPageToken string
+	if len(dbAnnouncements) == int(pageSize) {
+		nextPageToken = encodeCursor(dbAnnouncements[len(dbAnnouncements)-1].ID)
+	}
+
+	return connect.NewResponse(&announcementv1.ListAnnouncementsResponse{
+		Announcements: announcements,
+		NextPageToken: nextPageToken,
+		UnreadCount:   unreadCount,
+	}), nil
+}
+
+// MarkAnnouncementsRead moves the caller's read marker forward
+func (s *AnnouncementServer) MarkAnnouncementsRead(
+	ctx context.Context,
+	req *connect.Request[announcementv1.MarkAnnouncementsReadRequest],
+) (*connect.Response[announcementv1.MarkAnnouncementsReadResponse], error) {
+	r := req.Msg
+
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+	if entity.Type != genDb.EntityTypeUser {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrNotAUser)
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.MarkAnnouncementsRead, entity.ID)); err != nil {
+		slog.WarnContext(ctx, "unauthorized to mark announcements read")
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	upToID := r.GetUpToId()
+	if r.UpToId == nil {
+		latest, err := s.queries.GetLatestAnnouncementID(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to get latest announcement", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		upToID = latest
+	}
+
+	if err := s.queries.UpsertAnnouncementReadMarker(ctx, genDb.UpsertAnnouncementReadMarkerParams{
+		UserID:     entity.ID,
+		LastReadID: upToID,
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to update announcement read marker", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&announcementv1.MarkAnnouncementsReadResponse{}), nil
+}
+
+func dbAnnouncementToProto(a genDb.Announcement, lastReadID int64) *announcementv1.Announcement {
+	announcement := &announcementv1.Announcement{
+		Id:        a.ID,
+		Kind:      announcementKindToProto(a.Kind),
+		Title:     a.Title,
+		Body:      a.Body,
+		CreatedAt: timestamppb.New(a.CreatedAt.Time),
+		Read:      a.ID <= lastReadID,
+	}
+	if a.Link.Valid {
+		announcement.Link = &a.Link.String
+	}
+	if a.ExpiresAt.Valid {
+		announcement.ExpiresAt = timestamppb.New(a.ExpiresAt.Time)
+	}
+	return announcement
+}
+
+// announcementKindToDB converts a proto kind to the database enum
+func announcementKindToDB(kind announcementv1.AnnouncementKind) (genDb.AnnouncementKind, bool) {
+	switch kind {
+	case announcementv1.AnnouncementKind_ANNOUNCEMENT_KIND_RELEASE_NOTE:
+		return genDb.AnnouncementKindReleaseNote, true
+	case announcementv1.AnnouncementKind_ANNOUNCEMENT_KIND_DEPRECATION:
+		return genDb.AnnouncementKindDeprecation, true
+	case announcementv1.AnnouncementKind_ANNOUNCEMENT_KIND_MAINTENANCE:
+		return genDb.AnnouncementKindMaintenance, true
+	default:
+		return "", false
+	}
+}
+
+// announcementKindToProto converts a database kind to the proto enum
+func announcementKindToProto(kind genDb.AnnouncementKind) announcementv1.AnnouncementKind {
+	switch kind {
+	case genDb.AnnouncementKindReleaseNote:
+		return announcementv1.AnnouncementKind_ANNOUNCEMENT_KIND_RELEASE_NOTE
+	case genDb.AnnouncementKindDeprecation:
+		return announcementv1.AnnouncementKind_ANNOUNCEMENT_KIND_DEPRECATION
+	case genDb.AnnouncementKindMaintenance:
+		return announcementv1.AnnouncementKind_ANNOUNCEMENT_KIND_MAINTENANCE
+	default:
+		return announcementv1.AnnouncementKind_ANNOUNCEMENT_KIND_UNSPECIFIED
+	}
+}
@@ -0,0 +1,373 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+	approvalv1 "github.com/team-loco/loco/shared/proto/approval/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrApprovalRequired        = errors.New("a second admin must approve this operation")
+	ErrApprovalRequestNotFound = errors.New("approval request not found")
+	ErrApprovalNotPending      = errors.New("approval request is no longer pending")
+	ErrSelfApproval            = errors.New("an approval request cannot be approved by its requester")
+	ErrApproverNotUser         = errors.New("only users can approve operations")
+)
+
+const (
+	// production-tagged workspaces and resources carry this label
+	productionLabelKey   = "env"
+	productionLabelValue = "production"
+
+	// approvalTTL is how long an approval request waits for a second admin
+	approvalTTL = 24 * time.Hour
+)
+
+// ApprovalServer implements the ApprovalService gRPC server
+type ApprovalServer struct {
+	db         apiDb.Beginner
+	queries    genDb.Querier
+	machine    *tvm.VendingMachine
+	resources  *ResourceServer
+	workspaces *WorkspaceServer
+	domains    *DomainServer
+}
+
+// NewApprovalServer creates a new ApprovalServer instance. Approved operations are carried out through the given servers.
+func NewApprovalServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine, resources *ResourceServer, workspaces *WorkspaceServer, domains *DomainServer) *ApprovalServer {
+	return &ApprovalServer{
+		db:         db,
+		queries:    queries,
+		machine:    machine,
+		resources:  resources,
+		workspaces: workspaces,
+		domains:    domains,
+	}
+}
+
+// ListApprovalRequests lists the approval requests of a workspace
+func (s *ApprovalServer) ListApprovalRequests(
+	ctx context.Context,
+	req *connect.Request[approvalv1.ListApprovalRequestsRequest],
+) (*connect.Response[approvalv1.ListApprovalRequestsResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ListApprovalRequests, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to list approval requests", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	requests, err := s.queries.ListApprovalRequestsForWorkspace(ctx, genDb.ListApprovalRequestsForWorkspaceParams{
+		WorkspaceID: r.GetWorkspaceId(),
+		PendingOnly: r.GetPendingOnly(),
+		Limit:       normalizePageSize(r.GetPageSize()),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list approval requests", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	approvalRequests := make([]*approvalv1.ApprovalRequest, len(requests))
+	for i, request := range requests {
+		approvalRequests[i] = approvalRequestToProto(request)
+	}
+
+	return connect.NewResponse(&approvalv1.ListApprovalRequestsResponse{
+		ApprovalRequests: approvalRequests,
+	}), nil
+}
+
+// ApproveAction approves a pending request and carries out its operation
+func (s *ApprovalServer) ApproveAction(
+	ctx context.Context,
+	req *connect.Request[approvalv1.ApproveActionRequest],
+) (*connect.Response[approvalv1.ApproveActionResponse], error) {
+	r := req.Msg
+
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+	// the second pair of eyes has to belong to a person
+	if entity.Type != genDb.EntityTypeUser {
+		return nil, connect.NewError(connect.CodePermissionDenied, ErrApproverNotUser)
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	request, err := s.queries.GetApprovalRequest(ctx, r.GetApprovalRequestId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrApprovalRequestNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get approval request", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if request.RequestedByType == entity.Type && request.RequestedByID == entity.ID {
+		return nil, connect.NewError(connect.CodePermissionDenied, ErrSelfApproval)
+	}
+
+	// the approver needs the same permissions as the operation itself
+	var execute func(ctx context.Context) error
+	switch request.Action {
+	case genDb.ApprovalActionDeleteResource:
+		if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.DeleteResource, request.TargetID)); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		resource, err := s.queries.GetResourceByID(ctx, request.TargetID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+		}
+		execute = func(ctx context.Context) error { return s.resources.deleteResource(ctx, resource) }
+	case genDb.ApprovalActionDeleteWorkspace:
+		if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.DeleteWorkspace, request.TargetID)); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		execute = func(ctx context.Context) error { return s.workspaces.deleteWorkspace(ctx, request.TargetID) }
+	case genDb.ApprovalActionDeleteResourceDomain:
+		domainRow, err := s.queries.GetResourceDomainByID(ctx, request.TargetID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("domain not found"))
+		}
+		if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.RemoveDomain, domainRow.ResourceID)); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		execute = func(ctx context.Context) error { return s.domains.deleteResourceDomain(ctx, domainRow) }
+	default:
+		slog.ErrorContext(ctx, "unknown approval action", "approvalRequestId", request.ID, "action", request.Action)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unknown approval action %q", request.Action))
+	}
+
+	// claim the request first so two approvers never both carry it out
+	claimed, err := s.queries.MarkApprovalRequestExecuted(ctx, genDb.MarkApprovalRequestExecutedParams{
+		ID:         request.ID,
+		ApprovedBy: pgtype.Int8{Int64: entity.ID, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrApprovalNotPending)
+		}
+		slog.ErrorContext(ctx, "failed to claim approval request", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := execute(ctx); err != nil {
+		if reopenErr := s.queries.ReopenApprovalRequest(ctx, request.ID); reopenErr != nil {
+			slog.ErrorContext(ctx, "failed to reopen approval request", "approvalRequestId", request.ID, "error", reopenErr)
+		}
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "approved operation", "approvalRequestId", request.ID, "action", request.Action, "targetId", request.TargetID, "approvedBy", entity.ID)
+	return connect.NewResponse(&approvalv1.ApproveActionResponse{
+		ApprovalRequest: approvalRequestToProto(claimed),
+	}), nil
+}
+
+// CancelApprovalRequest withdraws a pending request
+func (s *ApprovalServer) CancelApprovalRequest(
+	ctx context.Context,
+	req *connect.Request[approvalv1.CancelApprovalRequestRequest],
+) (*connect.Response[approvalv1.CancelApprovalRequestResponse], error) {
+	r := req.Msg
+
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	request, err := s.queries.GetApprovalRequest(ctx, r.GetApprovalRequestId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrApprovalRequestNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get approval request", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	// requesters can always withdraw their own requests
+	isRequester := request.RequestedByType == entity.Type && request.RequestedByID == entity.ID
+	if !isRequester {
+		if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.CancelApprovalRequest, request.WorkspaceID)); err != nil {
+			slog.WarnContext(ctx, "unauthorized to cancel approval request", "approvalRequestId", request.ID)
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+	}
+
+	cancelled, err := s.queries.CancelApprovalRequest(ctx, request.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrApprovalNotPending)
+		}
+		slog.ErrorContext(ctx, "failed to cancel approval request", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&approvalv1.CancelApprovalRequestResponse{
+		ApprovalRequest: approvalRequestToProto(cancelled),
+	}), nil
+}
+
+// requireApproval holds a destructive operation back until a second admin approves it. It always returns a
+// FailedPrecondition error carrying the pending ApprovalRequest as a detail, creating the request unless one is
+// already waiting.
+func requireApproval(ctx context.Context, queries genDb.Querier, action genDb.ApprovalAction, targetID, workspaceID int64) error {
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+
+	request, err := queries.GetPendingApprovalRequest(ctx, genDb.GetPendingApprovalRequestParams{Action: action, TargetID: targetID})
+	switch {
+	case err == nil && request.ExpiresAt.Time.After(time.Now()):
+		// already waiting; asking again does not restart the clock
+	case err == nil || errors.Is(err, pgx.ErrNoRows):
+		if err == nil {
+			// the pending request expired, make room for a new one
+			if _, err := queries.CancelApprovalRequest(ctx, request.ID); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+				slog.ErrorContext(ctx, "failed to cancel expired approval request", "error", err)
+				return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+			}
+		}
+		request, err = queries.CreateApprovalRequest(ctx, genDb.CreateApprovalRequestParams{
+			Action:          action,
+			TargetID:        targetID,
+			WorkspaceID:     workspaceID,
+			RequestedByType: entity.Type,
+			RequestedByID:   entity.ID,
+			ExpiresAt:       pgtype.Timestamptz{Time: time.Now().Add(approvalTTL), Valid: true},
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to create approval request", "error", err)
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		slog.InfoContext(ctx, "operation needs approval", "approvalRequestId", request.ID, "action", action, "targetId", targetID, "requestedBy", entity.ID)
+	default:
+		slog.ErrorContext(ctx, "failed to get pending approval request", "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	cerr := connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%w: approval request %d expires at %s",
+		ErrApprovalRequired, request.ID, request.ExpiresAt.Time.UTC().Format(time.RFC3339)))
+	if detail, err := connect.NewErrorDetail(approvalRequestToProto(request)); err == nil {
+		cerr.AddDetail(detail)
+	}
+	return cerr
+}
+
+// isProductionTagged reports whether labels mark their workspace or resource as production
+func isProductionTagged(labels []byte) bool {
+	return unmarshalLabels(labels)[productionLabelKey] == productionLabelValue
+}
+
+// isProductionResource reports whether a resource, or the workspace it is in, is production-tagged
+func isProductionResource(ctx context.Context, queries genDb.Querier, resource genDb.Resource) (bool, error) {
+	if isProductionTagged(resource.Labels) {
+		return true, nil
+	}
+	workspace, err := queries.GetWorkspaceByIDQuery(ctx, resource.WorkspaceID)
+	if err != nil {
+		return false, fmt.Errorf("get workspace: %w", err)
+	}
+	return isProductionTagged(workspace.Labels), nil
+}
+
+// isProductionWorkspace reports whether a workspace, or any resource in it, is production-tagged
+func isProductionWorkspace(ctx context.Context, queries genDb.Querier, workspaceID int64) (bool, error) {
+	workspace, err := queries.GetWorkspaceByIDQuery(ctx, workspaceID)
+	if err != nil {
+		return false, fmt.Errorf("get workspace: %w", err)
+	}
+	if isProductionTagged(workspace.Labels) {
+		return true, nil
+	}
+	labels, err := json.Marshal(map[string]string{productionLabelKey: productionLabelValue})
+	if err != nil {
+		return false, err
+	}
+	return queries.WorkspaceHasResourcesWithLabels(ctx, genDb.WorkspaceHasResourcesWithLabelsParams{
+		WorkspaceID: workspaceID,
+		Labels:      labels,
+	})
+}
+
+func approvalRequestToProto(request genDb.ApprovalRequest) *approvalv1.ApprovalRequest {
+	status := approvalStatusToProto(request.Status)
+	if status == approvalv1.ApprovalStatus_APPROVAL_STATUS_PENDING && !request.ExpiresAt.Time.After(time.Now()) {
+		status = approvalv1.ApprovalStatus_APPROVAL_STATUS_EXPIRED
+	}
+	out := &approvalv1.ApprovalRequest{
+		Id:              request.ID,
+		Action:          approvalActionToProto(request.Action),
+		TargetId:        request.TargetID,
+		WorkspaceId:     request.WorkspaceID,
+		RequestedByType: dbEntityTypeToProto(request.RequestedByType),
+		RequestedById:   request.RequestedByID,
+		Status:          status,
+		ExpiresAt:       timestamppb.New(request.ExpiresAt.Time),
+		CreatedAt:       timestamppb.New(request.CreatedAt.Time),
+	}
+	if request.ApprovedBy.Valid {
+		out.ApprovedBy = &request.ApprovedBy.Int64
+	}
+	if request.DecidedAt.Valid {
+		out.DecidedAt = timestamppb.New(request.DecidedAt.Time)
+	}
+	return out
+}
+
+func approvalActionToProto(action genDb.ApprovalAction) approvalv1.ApprovalAction {
+	switch action {
+	case genDb.ApprovalActionDeleteResource:
+		return approvalv1.ApprovalAction_APPROVAL_ACTION_DELETE_RESOURCE
+	case genDb.ApprovalActionDeleteWorkspace:
+		return approvalv1.ApprovalAction_APPROVAL_ACTION_DELETE_WORKSPACE
+	case genDb.ApprovalActionDeleteResourceDomain:
+		return approvalv1.ApprovalAction_APPROVAL_ACTION_DELETE_RESOURCE_DOMAIN
+	default:
+		return approvalv1.ApprovalAction_APPROVAL_ACTION_UNSPECIFIED
+	}
+}
+
+func approvalStatusToProto(status genDb.ApprovalStatus) approvalv1.ApprovalStatus {
+	switch status {
+	case genDb.ApprovalStatusPending:
+		return approvalv1.ApprovalStatus_APPROVAL_STATUS_PENDING
+	case genDb.ApprovalStatusExecuted:
+		return approvalv1.ApprovalStatus_APPROVAL_STATUS_EXECUTED
+	case genDb.ApprovalStatusCancelled:
+		return approvalv1.ApprovalStatus_APPROVAL_STATUS_CANCELLED
+	default:
+		return approvalv1.ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm/actions"
+	workspacev1 "github.com/team-loco/loco/shared/proto/workspace/v1"
+)
+
+var (
+	ErrWorkspaceArchived    = errors.New("workspace is archived")
+	ErrWorkspaceNotArchived = errors.New("workspace is not archived")
+)
+
+// ArchiveWorkspace suspends every running resource in a workspace and hides it from default listings.
+// Configuration, domains and deployment history are kept for when it is unarchived.
+func (s *WorkspaceServer) ArchiveWorkspace(
+	ctx context.Context,
+	req *connect.Request[workspacev1.ArchiveWorkspaceRequest],
+) (*connect.Response[workspacev1.ArchiveWorkspaceResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ArchiveWorkspace, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to archive workspace", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	// archiving scales every resource to zero, which a lock forbids
+	protection, err := s.queries.GetWorkspaceProtection(ctx, r.GetWorkspaceId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrWorkspaceNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get workspace protection", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if protection.LockedResources > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%w: %d must be unlocked first", ErrWorkspaceHasLocked, protection.LockedResources))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := apiDb.QueriesFor(tx)
+
+	workspace, err := qtx.ArchiveWorkspace(ctx, r.GetWorkspaceId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrWorkspaceArchived)
+		}
+		slog.ErrorContext(ctx, "failed to archive workspace", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	message := pgtype.Text{}
+	if r.Message != nil {
+		message = pgtype.Text{String: r.GetMessage(), Valid: true}
+	}
+	resources, err := qtx.SuspendWorkspaceResourcesForArchive(ctx, genDb.SuspendWorkspaceResourcesForArchiveParams{
+		WorkspaceID:        workspace.ID,
+		MaintenanceMessage: message,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to suspend workspace resources", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	for _, resource := range resources {
+		if err := setLocoResourceSuspended(ctx, s.kubeClient, resource.ID, s.locoNamespace, true, r.GetMessage()); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to suspend Application for resource %d: %w", resource.ID, err))
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit workspace archive", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "archived workspace", "workspaceId", workspace.ID, "suspendedResources", len(resources))
+	return connect.NewResponse(&workspacev1.ArchiveWorkspaceResponse{
+		Workspace:          dbWorkspaceToProto(workspace),
+		SuspendedResources: int32(len(resources)),
+	}), nil
+}
+
+// UnarchiveWorkspace reactivates a workspace, resuming the resources archiving suspended.
+// Resources that were suspended before the workspace was archived stay suspended.
+func (s *WorkspaceServer) UnarchiveWorkspace(
+	ctx context.Context,
+	req *connect.Request[workspacev1.UnarchiveWorkspaceRequest],
+) (*connect.Response[workspacev1.UnarchiveWorkspaceResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.UnarchiveWorkspace, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to unarchive workspace", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := apiDb.QueriesFor(tx)
+
+	workspace, err := qtx.UnarchiveWorkspace(ctx, r.GetWorkspaceId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			if _, getErr := qtx.GetWorkspaceByIDQuery(ctx, r.GetWorkspaceId()); errors.Is(getErr, pgx.ErrNoRows) {
+				return nil, connect.NewError(connect.CodeNotFound, ErrWorkspaceNotFound)
+			}
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrWorkspaceNotArchived)
+		}
+		slog.ErrorContext(ctx, "failed to unarchive workspace", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resources, err := qtx.ResumeWorkspaceResourcesFromArchive(ctx, workspace.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to resume workspace resources", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	for _, resource := range resources {
+		if err := setLocoResourceSuspended(ctx, s.kubeClient, resource.ID, s.locoNamespace, false, ""); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to resume Application for resource %d: %w", resource.ID, err))
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit workspace unarchive", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "unarchived workspace", "workspaceId", workspace.ID, "resumedResources", len(resources))
+	return connect.NewResponse(&workspacev1.UnarchiveWorkspaceResponse{
+		Workspace:        dbWorkspaceToProto(workspace),
+		ResumedResources: int32(len(resources)),
+	}), nil
+}
+
+// ensureResourceWorkspaceActive fails when a resource's workspace is archived, since archived workspaces take no deployments
+func ensureResourceWorkspaceActive(ctx context.Context, queries genDb.Querier, resourceID int64) error {
+	archived, err := queries.IsResourceWorkspaceArchived(ctx, resourceID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to check whether workspace is archived", "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if archived {
+		return connect.NewError(connect.CodeFailedPrecondition, ErrWorkspaceArchived)
+	}
+	return nil
+}
@@ -0,0 +1,477 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/billing"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+	billingv1 "github.com/team-loco/loco/shared/proto/billing/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrPlanNotFound      = errors.New("plan not found")
+	ErrNoSubscription    = errors.New("the organization has no subscription")
+	ErrOrgDelinquent     = errors.New("the organization has an unpaid invoice; pay it in the billing portal to deploy again")
+	ErrInvalidUsageRange = errors.New("since must be before until")
+)
+
+// BillingServer implements the BillingService gRPC server.
+type BillingServer struct {
+	db      apiDb.Beginner
+	queries genDb.Querier
+	machine *tvm.VendingMachine
+	stripe  *billing.Client
+}
+
+// NewBillingServer creates a new BillingServer instance. stripe is nil when billing is not configured.
+func NewBillingServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine, stripe *billing.Client) *BillingServer {
+	return &BillingServer{
+		db:      db,
+		queries: queries,
+		machine: machine,
+		stripe:  stripe,
+	}
+}
+
+// ListPlans returns the plans orgs can subscribe to
+func (s *BillingServer) ListPlans(
+	ctx context.Context,
+	req *connect.Request[billingv1.ListPlansRequest],
+) (*connect.Response[billingv1.ListPlansResponse], error) {
+	plans, err := s.queries.ListBillingPlans(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list billing plans", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &billingv1.ListPlansResponse{Plans: make([]*billingv1.Plan, 0, len(plans))}
+	for _, plan := range plans {
+		resp.Plans = append(resp.Plans, planToProto(plan))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetBillingAccount returns an org's subscription and payment methods
+func (s *BillingServer) GetBillingAccount(
+	ctx context.Context,
+	req *connect.Request[billingv1.GetBillingAccountRequest],
+) (*connect.Response[billingv1.GetBillingAccountResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetBilling, r.GetOrgId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to get billing account", "orgId", r.GetOrgId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	orgBilling, err := s.queries.GetOrgBilling(ctx, r.GetOrgId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return connect.NewResponse(&billingv1.GetBillingAccountResponse{Account: &billingv1.BillingAccount{
+				OrgId:  r.GetOrgId(),
+				Status: billingv1.SubscriptionStatus_SUBSCRIPTION_STATUS_NONE,
+			}}), nil
+		}
+		slog.ErrorContext(ctx, "failed to get org billing", "orgId", r.GetOrgId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	account, err := s.account(ctx, orgBilling)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&billingv1.GetBillingAccountResponse{Account: account}), nil
+}
+
+// SetPlan subscribes an org to a plan, or moves its subscription to the plan
+func (s *BillingServer) SetPlan(
+	ctx context.Context,
+	req *connect.Request[billingv1.SetPlanRequest],
+) (*connect.Response[billingv1.SetPlanResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ManageBilling, r.GetOrgId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to set plan", "orgId", r.GetOrgId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	if s.stripe == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, billing.ErrNotConfigured)
+	}
+
+	plan, err := s.queries.GetBillingPlan(ctx, r.GetPlanId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrPlanNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get billing plan", "planId", r.GetPlanId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if !plan.IsActive {
+		return nil, connect.NewError(connect.CodeNotFound, ErrPlanNotFound)
+	}
+
+	orgBilling, err := s.ensureCustomer(ctx, r.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+
+	var sub billing.Subscription
+	if orgBilling.StripeSubscriptionID.Valid {
+		current, err := s.stripe.GetSubscription(ctx, orgBilling.StripeSubscriptionID.String)
+		if err != nil {
+			return nil, stripeError(ctx, "failed to get subscription", err)
+		}
+		item, ok := s.stripe.PlanItem(current)
+		if !ok {
+			slog.ErrorContext(ctx, "subscription has no plan item", "subscriptionId", current.ID)
+			return nil, connect.NewError(connect.CodeInternal, errors.New("subscription has no plan"))
+		}
+		sub, err = s.stripe.ChangeSubscriptionPrice(ctx, current.ID, item.ID, plan.StripePriceID)
+		if err != nil {
+			return nil, stripeError(ctx, "failed to change subscription plan", err)
+		}
+	} else {
+		// concurrent requests against the same billing row share a key, so only one subscription is made
+		key := fmt.Sprintf("loco-org-%d-subscribe-%d-%d", r.GetOrgId(), plan.ID, orgBilling.UpdatedAt.Time.UnixMicro())
+		sub, err = s.stripe.CreateSubscription(ctx, orgBilling.StripeCustomerID, plan.StripePriceID, key)
+		if err != nil {
+			return nil, stripeError(ctx, "failed to create subscription", err)
+		}
+	}
+
+	params := genDb.SetOrgSubscriptionParams{
+		OrgID:                r.GetOrgId(),
+		PlanID:               pgtype.Int8{Int64: plan.ID, Valid: true},
+		StripeSubscriptionID: pgtype.Text{String: sub.ID, Valid: true},
+		SubscriptionStatus:   billing.Status(sub.Status),
+	}
+	if !sub.CurrentPeriodEnd.IsZero() {
+		params.CurrentPeriodEnd = pgtype.Timestamptz{Time: sub.CurrentPeriodEnd, Valid: true}
+	}
+	orgBilling, err = s.queries.SetOrgSubscription(ctx, params)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save subscription", "orgId", r.GetOrgId(), "subscriptionId", sub.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "set billing plan", "orgId", r.GetOrgId(), "plan", plan.Name, "subscriptionId", sub.ID)
+
+	account, err := s.account(ctx, orgBilling)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&billingv1.SetPlanResponse{Account: account}), nil
+}
+
+// CancelSubscription ends an org's subscription now
+func (s *BillingServer) CancelSubscription(
+	ctx context.Context,
+	req *connect.Request[billingv1.CancelSubscriptionRequest],
+) (*connect.Response[billingv1.CancelSubscriptionResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ManageBilling, r.GetOrgId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to cancel subscription", "orgId", r.GetOrgId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	if s.stripe == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, billing.ErrNotConfigured)
+	}
+
+	orgBilling, err := s.queries.GetOrgBilling(ctx, r.GetOrgId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrNoSubscription)
+		}
+		slog.ErrorContext(ctx, "failed to get org billing", "orgId", r.GetOrgId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if !orgBilling.StripeSubscriptionID.Valid {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrNoSubscription)
+	}
+
+	if _, err := s.stripe.CancelSubscription(ctx, orgBilling.StripeSubscriptionID.String); err != nil {
+		return nil, stripeError(ctx, "failed to cancel subscription", err)
+	}
+	// the deletion webhook does the same, whichever comes first
+	if _, err := s.queries.EndOrgSubscription(ctx, genDb.EndOrgSubscriptionParams{
+		StripeCustomerID:     orgBilling.StripeCustomerID,
+		StripeSubscriptionID: orgBilling.StripeSubscriptionID,
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to end subscription", "orgId", r.GetOrgId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "canceled subscription", "orgId", r.GetOrgId(), "subscriptionId", orgBilling.StripeSubscriptionID.String)
+
+	orgBilling, err = s.queries.GetOrgBilling(ctx, r.GetOrgId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get org billing", "orgId", r.GetOrgId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	account, err := s.account(ctx, orgBilling)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&billingv1.CancelSubscriptionResponse{Account: account}), nil
+}
+
+// CreateBillingPortalSession returns a link to Stripe's billing portal for the org
+func (s *BillingServer) CreateBillingPortalSession(
+	ctx context.Context,
+	req *connect.Request[billingv1.CreateBillingPortalSessionRequest],
+) (*connect.Response[billingv1.CreateBillingPortalSessionResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ManageBilling, r.GetOrgId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to open billing portal", "orgId", r.GetOrgId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	if s.stripe == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, billing.ErrNotConfigured)
+	}
+
+	// orgs add a payment method before they pick a plan, so the customer may not exist yet
+	orgBilling, err := s.ensureCustomer(ctx, r.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := s.stripe.CreatePortalSession(ctx, orgBilling.StripeCustomerID, r.GetReturnUrl())
+	if err != nil {
+		return nil, stripeError(ctx, "failed to create billing portal session", err)
+	}
+
+	return connect.NewResponse(&billingv1.CreateBillingPortalSessionResponse{Url: url}), nil
+}
+
+// ListUsage returns an org's metered usage per resource and region
+func (s *BillingServer) ListUsage(
+	ctx context.Context,
+	req *connect.Request[billingv1.ListUsageRequest],
+) (*connect.Response[billingv1.ListUsageResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetBilling, r.GetOrgId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to list usage", "orgId", r.GetOrgId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	now := time.Now().UTC()
+	since := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	until := now
+	if r.Since != nil {
+		since = r.GetSince().AsTime()
+	}
+	if r.Until != nil {
+		until = r.GetUntil().AsTime()
+	}
+	if !since.Before(until) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidUsageRange)
+	}
+
+	rows, err := s.queries.ListOrgUsage(ctx, genDb.ListOrgUsageParams{
+		OrgID: r.GetOrgId(),
+		Since: pgtype.Timestamptz{Time: since, Valid: true},
+		Until: pgtype.Timestamptz{Time: until, Valid: true},
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list usage", "orgId", r.GetOrgId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &billingv1.ListUsageResponse{Items: make([]*billingv1.UsageItem, 0, len(rows))}
+	for _, row := range rows {
+		item := &billingv1.UsageItem{
+			ResourceName: row.ResourceName,
+			Region:       row.Region,
+			Currency:     row.Currency,
+			ReplicaHours: row.ReplicaHours,
+			CostMicros:   row.CostMicros,
+		}
+		if row.ResourceID.Valid {
+			item.ResourceId = &row.ResourceID.Int64
+		}
+		resp.Items = append(resp.Items, item)
+
+		if resp.Currency == "" {
+			resp.Currency = row.Currency
+		}
+		if row.Currency == resp.Currency {
+			resp.TotalCostMicros += row.CostMicros
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ensureCustomer returns the org's billing row, creating its Stripe customer first if it has none.
+func (s *BillingServer) ensureCustomer(ctx context.Context, orgID int64) (genDb.OrgBilling, error) {
+	orgBilling, err := s.queries.GetOrgBilling(ctx, orgID)
+	if err == nil {
+		return orgBilling, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		slog.ErrorContext(ctx, "failed to get org billing", "orgId", orgID, "error", err)
+		return genDb.OrgBilling{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	org, err := s.queries.GetOrgByID(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return genDb.OrgBilling{}, connect.NewError(connect.CodeNotFound, ErrOrgNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get org", "orgId", orgID, "error", err)
+		return genDb.OrgBilling{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	customerID, err := s.stripe.CreateCustomer(ctx, orgID, org.Name)
+	if err != nil {
+		return genDb.OrgBilling{}, stripeError(ctx, "failed to create customer", err)
+	}
+	orgBilling, err = s.queries.CreateOrgBilling(ctx, genDb.CreateOrgBillingParams{
+		OrgID:            orgID,
+		StripeCustomerID: customerID,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save billing customer", "orgId", orgID, "error", err)
+		return genDb.OrgBilling{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	slog.InfoContext(ctx, "created billing customer", "orgId", orgID, "customerId", orgBilling.StripeCustomerID)
+	return orgBilling, nil
+}
+
+// account builds the BillingAccount of an org. Payment methods are left out when Stripe cannot be reached.
+func (s *BillingServer) account(ctx context.Context, orgBilling genDb.OrgBilling) (*billingv1.BillingAccount, error) {
+	account := &billingv1.BillingAccount{
+		OrgId:      orgBilling.OrgID,
+		Status:     subscriptionStatusToProto(orgBilling.SubscriptionStatus),
+		Delinquent: orgBilling.Delinquent,
+	}
+	if orgBilling.CurrentPeriodEnd.Valid {
+		account.CurrentPeriodEnd = timestamppb.New(orgBilling.CurrentPeriodEnd.Time)
+	}
+	if orgBilling.PlanID.Valid {
+		plan, err := s.queries.GetBillingPlan(ctx, orgBilling.PlanID.Int64)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to get billing plan", "planId", orgBilling.PlanID.Int64, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		account.Plan = planToProto(plan)
+	}
+
+	if s.stripe != nil {
+		methods, err := s.stripe.ListPaymentMethods(ctx, orgBilling.StripeCustomerID)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to list payment methods", "orgId", orgBilling.OrgID, "error", err)
+		}
+		for _, m := range methods {
+			account.PaymentMethods = append(account.PaymentMethods, &billingv1.PaymentMethod{
+				Id:       m.ID,
+				Type:     m.Type,
+				Brand:    m.Brand,
+				Last4:    m.Last4,
+				ExpMonth: m.ExpMonth,
+				ExpYear:  m.ExpYear,
+			})
+		}
+	}
+	return account, nil
+}
+
+// stripeError turns a failed Stripe call into a connect error: requests Stripe refused are the
+// caller's to fix, anything else is Stripe being unavailable.
+func stripeError(ctx context.Context, msg string, err error) error {
+	var apiErr *billing.Error
+	if errors.As(err, &apiErr) && apiErr.StatusCode < 500 {
+		slog.WarnContext(ctx, msg, "error", err)
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	slog.ErrorContext(ctx, msg, "error", err)
+	return connect.NewError(connect.CodeUnavailable, err)
+}
+
+// ensureOrgInGoodStanding fails when a resource's org is delinquent, since delinquent orgs take no new deployments
+func ensureOrgInGoodStanding(ctx context.Context, queries genDb.Querier, resourceID int64) error {
+	delinquent, err := queries.IsResourceOrgDelinquent(ctx, resourceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to check whether org is delinquent", "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if delinquent {
+		return connect.NewError(connect.CodeFailedPrecondition, ErrOrgDelinquent)
+	}
+	return nil
+}
+
+func planToProto(plan genDb.BillingPlan) *billingv1.Plan {
+	return &billingv1.Plan{
+		Id:           plan.ID,
+		Name:         plan.Name,
+		Description:  plan.Description,
+		MonthlyCents: plan.MonthlyCents,
+		Currency:     plan.Currency,
+		IdleSleep:    plan.IdleSleep,
+	}
+}
+
+func subscriptionStatusToProto(status genDb.SubscriptionStatus) billingv1.SubscriptionStatus {
+	switch status {
+	case genDb.SubscriptionStatusNone:
+		return billingv1.SubscriptionStatus_SUBSCRIPTION_STATUS_NONE
+	case genDb.SubscriptionStatusIncomplete:
+		return billingv1.SubscriptionStatus_SUBSCRIPTION_STATUS_INCOMPLETE
+	case genDb.SubscriptionStatusTrialing:
+		return billingv1.SubscriptionStatus_SUBSCRIPTION_STATUS_TRIALING
+	case genDb.SubscriptionStatusActive:
+		return billingv1.SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE
+	case genDb.SubscriptionStatusPastDue:
+		return billingv1.SubscriptionStatus_SUBSCRIPTION_STATUS_PAST_DUE
+	case genDb.SubscriptionStatusUnpaid:
+		return billingv1.SubscriptionStatus_SUBSCRIPTION_STATUS_UNPAID
+	case genDb.SubscriptionStatusCanceled:
+		return billingv1.SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELED
+	default:
+		return billingv1.SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED
+	}
+}
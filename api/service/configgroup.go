@@ -0,0 +1,597 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/configrollout"
+	"github.com/team-loco/loco/api/pkg/converter"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	configgroupv1 "github.com/team-loco/loco/shared/proto/configgroup/v1"
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	"github.com/team-loco/loco/shared/version"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	ErrConfigGroupNotFound    = errors.New("config group not found")
+	ErrConfigRolloutNotFound  = errors.New("config rollout not found")
+	ErrConfigRolloutInFlight  = errors.New("a rollout of this config group is still in progress")
+	ErrConfigGroupWorkspace   = errors.New("config group does not belong to the resource's workspace")
+	ErrConfigGroupNotAttached = errors.New("config group is not attached to this resource")
+)
+
+type ConfigGroupServer struct {
+	db      apiDb.Beginner
+	queries genDb.Querier
+	machine *tvm.VendingMachine
+}
+
+func NewConfigGroupServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine) *ConfigGroupServer {
+	return &ConfigGroupServer{db: db, queries: queries, machine: machine}
+}
+
+// CreateConfigGroup creates a config group in a workspace
+func (s *ConfigGroupServer) CreateConfigGroup(
+	ctx context.Context,
+	req *connect.Request[configgroupv1.CreateConfigGroupRequest],
+) (*connect.Response[configgroupv1.CreateConfigGroupResponse], error) {
+	r := req.Msg
+
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.CreateConfigGroup, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to create config group", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if !environmentNamePattern.MatchString(r.GetName()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("config group name must be 1-32 lowercase letters, digits or dashes"))
+	}
+
+	env, err := marshalEnvironmentEnv(r.GetEnv())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	params := genDb.CreateConfigGroupParams{
+		WorkspaceID: r.GetWorkspaceId(),
+		Name:        r.GetName(),
+		Description: r.GetDescription(),
+		Env:         env,
+	}
+	if entity.Type == genDb.EntityTypeUser {
+		params.CreatedBy = pgtype.Int8{Int64: entity.ID, Valid: true}
+	}
+
+	group, err := s.queries.CreateConfigGroup(ctx, params)
+	if err != nil {
+		if isPgConstraintViolation(err) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("a config group with this name already exists in this workspace"))
+		}
+		slog.ErrorContext(ctx, "failed to create config group", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "created config group", "configGroupId", group.ID, "workspaceId", group.WorkspaceID, "name", group.Name)
+
+	return connect.NewResponse(&configgroupv1.CreateConfigGroupResponse{
+		ConfigGroup: configGroupToProto(group, nil),
+	}), nil
+}
+
+// GetConfigGroup retrieves a config group by ID
+func (s *ConfigGroupServer) GetConfigGroup(
+	ctx context.Context,
+	req *connect.Request[configgroupv1.GetConfigGroupRequest],
+) (*connect.Response[configgroupv1.GetConfigGroupResponse], error) {
+	group, err := s.authorizeGroup(ctx, req.Msg.GetConfigGroupId(), actions.GetConfigGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceIDs, err := s.queries.ListConfigGroupResourceIDs(ctx, group.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list config group resources", "configGroupId", group.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	res := &configgroupv1.GetConfigGroupResponse{ConfigGroup: configGroupToProto(group, resourceIDs)}
+	rollout, err := s.queries.GetActiveConfigRollout(ctx, group.ID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		slog.ErrorContext(ctx, "failed to get active config rollout", "configGroupId", group.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if err == nil {
+		res.Rollout = configRolloutToProto(rollout)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// ListConfigGroups lists the config groups of a workspace, by name
+func (s *ConfigGroupServer) ListConfigGroups(
+	ctx context.Context,
+	req *connect.Request[configgroupv1.ListConfigGroupsRequest],
+) (*connect.Response[configgroupv1.ListConfigGroupsResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ListConfigGroups, r.GetWorkspaceId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	groups, err := s.queries.ListConfigGroupsForWorkspace(ctx, r.GetWorkspaceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list config groups", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	protoGroups := make([]*configgroupv1.ConfigGroup, 0, len(groups))
+	for _, group := range groups {
+		resourceIDs, err := s.queries.ListConfigGroupResourceIDs(ctx, group.ID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to list config group resources", "configGroupId", group.ID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		protoGroups = append(protoGroups, configGroupToProto(group, resourceIDs))
+	}
+
+	return connect.NewResponse(&configgroupv1.ListConfigGroupsResponse{
+		ConfigGroups: protoGroups,
+	}), nil
+}
+
+// UpdateConfigGroup updates a config group's description or env vars. Changing the env vars starts a
+// rollout redeploying the attached resources one by one, which the config rollout worker carries out.
+func (s *ConfigGroupServer) UpdateConfigGroup(
+	ctx context.Context,
+	req *connect.Request[configgroupv1.UpdateConfigGroupRequest],
+) (*connect.Response[configgroupv1.UpdateConfigGroupResponse], error) {
+	r := req.Msg
+
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+
+	group, err := s.authorizeGroup(ctx, r.GetConfigGroupId(), actions.UpdateConfigGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	params := genDb.UpdateConfigGroupParams{ID: group.ID}
+	if r.Description != nil {
+		params.Description = pgtype.Text{String: r.GetDescription(), Valid: true}
+	}
+	var previous map[string]string
+	changed := false
+	if r.GetReplaceEnv() {
+		env, err := marshalEnvironmentEnv(r.GetEnv())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err := json.Unmarshal(group.Env, &previous); err != nil {
+			slog.ErrorContext(ctx, "invalid config group env", "configGroupId", group.ID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid config group env: %w", err))
+		}
+		changed = !maps.Equal(previous, r.GetEnv())
+		params.Env = env
+	}
+
+	var createdBy pgtype.Int8
+	if entity.Type == genDb.EntityTypeUser {
+		createdBy = pgtype.Int8{Int64: entity.ID, Valid: true}
+	}
+	previousEnv, err := json.Marshal(previous)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// the new values and the rollout applying them are recorded together, so a group never holds
+	// values its resources are not redeployed with
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := apiDb.QueriesFor(tx)
+
+	if changed {
+		if _, err := qtx.GetActiveConfigRollout(ctx, group.ID); err == nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrConfigRolloutInFlight)
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			slog.ErrorContext(ctx, "failed to get active config rollout", "configGroupId", group.ID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	updated, err := qtx.UpdateConfigGroup(ctx, params)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to update config group", "configGroupId", group.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	resourceIDs, err := qtx.ListConfigGroupResourceIDs(ctx, group.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list config group resources", "configGroupId", group.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	res := &configgroupv1.UpdateConfigGroupResponse{ConfigGroup: configGroupToProto(updated, resourceIDs)}
+	if changed && len(resourceIDs) > 0 {
+		rollout, err := qtx.CreateConfigRollout(ctx, genDb.CreateConfigRolloutParams{
+			ConfigGroupID: group.ID,
+			PreviousEnv:   previousEnv,
+			ResourceIds:   resourceIDs,
+			CreatedBy:     createdBy,
+		})
+		if err != nil {
+			// a rollout started concurrently trips the unique index on in-flight rollouts
+			if isPgConstraintViolation(err) {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, ErrConfigRolloutInFlight)
+			}
+			slog.ErrorContext(ctx, "failed to create config rollout", "configGroupId", group.ID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		res.Rollout = configRolloutToProto(rollout)
+		slog.InfoContext(ctx, "started config rollout", "configGroupId", group.ID, "rolloutId", rollout.ID, "resources", len(resourceIDs))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit config group", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// DeleteConfigGroup deletes a config group, detaching it from its resources. Resources keep its env
+// vars until their next deployment.
+func (s *ConfigGroupServer) DeleteConfigGroup(
+	ctx context.Context,
+	req *connect.Request[configgroupv1.DeleteConfigGroupRequest],
+) (*connect.Response[configgroupv1.DeleteConfigGroupResponse], error) {
+	group, err := s.authorizeGroup(ctx, req.Msg.GetConfigGroupId(), actions.DeleteConfigGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.queries.GetActiveConfigRollout(ctx, group.ID); err == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrConfigRolloutInFlight)
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.queries.DeleteConfigGroup(ctx, group.ID); err != nil {
+		slog.ErrorContext(ctx, "failed to delete config group", "configGroupId", group.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "deleted config group", "configGroupId", group.ID)
+	return connect.NewResponse(&configgroupv1.DeleteConfigGroupResponse{}), nil
+}
+
+// AttachConfigGroup attaches a config group to a resource in the same workspace
+func (s *ConfigGroupServer) AttachConfigGroup(
+	ctx context.Context,
+	req *connect.Request[configgroupv1.AttachConfigGroupRequest],
+) (*connect.Response[configgroupv1.AttachConfigGroupResponse], error) {
+	r := req.Msg
+
+	group, err := s.authorizeAttachment(ctx, r.GetConfigGroupId(), r.GetResourceId(), actions.AttachConfigGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.queries.AttachConfigGroup(ctx, genDb.AttachConfigGroupParams{
+		ConfigGroupID: group.ID,
+		ResourceID:    r.GetResourceId(),
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to attach config group", "configGroupId", group.ID, "resourceId", r.GetResourceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "attached config group", "configGroupId", group.ID, "resourceId", r.GetResourceId())
+	return connect.NewResponse(&configgroupv1.AttachConfigGroupResponse{}), nil
+}
+
+// DetachConfigGroup detaches a config group from a resource
+func (s *ConfigGroupServer) DetachConfigGroup(
+	ctx context.Context,
+	req *connect.Request[configgroupv1.DetachConfigGroupRequest],
+) (*connect.Response[configgroupv1.DetachConfigGroupResponse], error) {
+	r := req.Msg
+
+	group, err := s.authorizeAttachment(ctx, r.GetConfigGroupId(), r.GetResourceId(), actions.DetachConfigGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	detached, err := s.queries.DetachConfigGroup(ctx, genDb.DetachConfigGroupParams{
+		ConfigGroupID: group.ID,
+		ResourceID:    r.GetResourceId(),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to detach config group", "configGroupId", group.ID, "resourceId", r.GetResourceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if detached == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, ErrConfigGroupNotAttached)
+	}
+
+	slog.InfoContext(ctx, "detached config group", "configGroupId", group.ID, "resourceId", r.GetResourceId())
+	return connect.NewResponse(&configgroupv1.DetachConfigGroupResponse{}), nil
+}
+
+// GetConfigRollout reports the progress of a config rollout
+func (s *ConfigGroupServer) GetConfigRollout(
+	ctx context.Context,
+	req *connect.Request[configgroupv1.GetConfigRolloutRequest],
+) (*connect.Response[configgroupv1.GetConfigRolloutResponse], error) {
+	rollout, err := s.queries.GetConfigRollout(ctx, req.Msg.GetRolloutId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrConfigRolloutNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get config rollout", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if _, err := s.authorizeGroup(ctx, rollout.ConfigGroupID, actions.GetConfigRollout); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&configgroupv1.GetConfigRolloutResponse{Rollout: configRolloutToProto(rollout)}), nil
+}
+
+// authorizeGroup loads a config group and checks the caller may perform action on its workspace.
+func (s *ConfigGroupServer) authorizeGroup(ctx context.Context, groupID int64, action actions.Action) (genDb.ConfigGroup, error) {
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return genDb.ConfigGroup{}, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	group, err := s.queries.GetConfigGroup(ctx, groupID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return genDb.ConfigGroup{}, connect.NewError(connect.CodeNotFound, ErrConfigGroupNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get config group", "configGroupId", groupID, "error", err)
+		return genDb.ConfigGroup{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(action, group.WorkspaceID)); err != nil {
+		slog.WarnContext(ctx, "unauthorized to access config group", "configGroupId", groupID)
+		return genDb.ConfigGroup{}, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return group, nil
+}
+
+// authorizeAttachment checks the caller may change the env of the resource, and that the config group
+// belongs to the resource's workspace.
+func (s *ConfigGroupServer) authorizeAttachment(ctx context.Context, groupID, resourceID int64, action actions.Action) (genDb.ConfigGroup, error) {
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return genDb.ConfigGroup{}, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(action, resourceID)); err != nil {
+		slog.WarnContext(ctx, "unauthorized to change resource config groups", "resourceId", resourceID)
+		return genDb.ConfigGroup{}, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	resource, err := s.queries.GetResourceByID(ctx, resourceID)
+	if err != nil {
+		return genDb.ConfigGroup{}, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+	}
+	group, err := s.queries.GetConfigGroup(ctx, groupID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return genDb.ConfigGroup{}, connect.NewError(connect.CodeNotFound, ErrConfigGroupNotFound)
+		}
+		return genDb.ConfigGroup{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if group.WorkspaceID != resource.WorkspaceID {
+		return genDb.ConfigGroup{}, connect.NewError(connect.CodeInvalidArgument, ErrConfigGroupWorkspace)
+	}
+	return group, nil
+}
+
+// RolloutConfig deploys a resource again to apply a change to one of its config groups, as a new
+// deployment on the active one's spec. Env vars only live on the Application, so they are carried over
+// from it less the ones still holding the group's previous values; the group's current values are then
+// merged in as on any deployment. It returns 0 when the resource has nothing deployed to redeploy.
+func (s *DeploymentServer) RolloutConfig(ctx context.Context, resourceID int64, previous map[string]string) (int64, error) {
+	resource, err := s.queries.GetResourceByID(ctx, resourceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get resource: %w", err)
+	}
+	if resource.Type != genDb.ResourceTypeService {
+		return 0, nil
+	}
+	deployments, err := s.queries.ListActiveDeploymentsForResource(ctx, resourceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list active deployments: %w", err)
+	}
+	if len(deployments) == 0 {
+		return 0, nil
+	}
+	current := deployments[0]
+
+	deploymentSpec, err := converter.DeserializeDeploymentSpec(current.Spec, string(resource.Type))
+	if err != nil {
+		return 0, fmt.Errorf("invalid deployment spec: %w", err)
+	}
+	serviceSpec := deploymentSpec.GetService()
+	if serviceSpec == nil {
+		return 0, nil
+	}
+
+	app := &locoControllerV1.Application{}
+	err = s.kubeClient.ControllerClient.Get(ctx, client.ObjectKey{Name: fmt.Sprintf("resource-%d", resource.ID), Namespace: s.locoNamespace}, app)
+	if client.IgnoreNotFound(err) != nil {
+		return 0, fmt.Errorf("failed to get Application: %w", err)
+	}
+	var env map[string]string
+	if err == nil && app.Spec.ServiceSpec != nil && app.Spec.ServiceSpec.Deployment != nil {
+		env = maps.Clone(app.Spec.ServiceSpec.Deployment.Env)
+	}
+	for key, value := range previous {
+		if current, ok := env[key]; ok && current == value {
+			delete(env, key)
+		}
+	}
+
+	cluster, err := s.queries.GetActiveClusterByRegion(ctx, current.Region)
+	if err != nil {
+		return 0, fmt.Errorf("no active cluster available for region %s: %w", current.Region, err)
+	}
+
+	deploymentID, err := createDeploymentWithCleanup(ctx, s.db, s.queries, nil, genDb.CreateDeploymentParams{
+		ResourceID:  resource.ID,
+		ClusterID:   cluster.ID,
+		Region:      current.Region,
+		Replicas:    current.Replicas,
+		Status:      genDb.DeploymentStatusPending,
+		IsActive:    true,
+		Message:     "Config group rollout",
+		Spec:        current.Spec,
+		SpecVersion: version.SpecVersionV1,
+	})
+	if err != nil {
+		if errors.Is(err, ErrDeploymentInProgress) {
+			return 0, fmt.Errorf("%w: %w", configrollout.ErrResourceBusy, err)
+		}
+		return 0, fmt.Errorf("failed to create deployment: %w", err)
+	}
+
+	domain, err := s.queries.GetDomainByResourceId(ctx, resource.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get domain: %w", err)
+	}
+	resourceSpec, err := converter.DeserializeResourceSpecByType(resource.Spec, string(resource.Type))
+	if err != nil {
+		return 0, fmt.Errorf("invalid resource spec: %w", err)
+	}
+	inherited, err := loadInheritedEnv(ctx, s.queries, resource)
+	if err != nil {
+		return 0, err
+	}
+
+	serviceSpec.Env = env
+	redeploySpec := &deploymentv1.DeploymentSpec{
+		Spec: &deploymentv1.DeploymentSpec_Service{Service: serviceSpec},
+	}
+	if err := createLocoResource(ctx, s.kubeClient, s.queries, resource, resourceSpec, domain, inherited, redeploySpec, s.locoNamespace, current.Region); err != nil {
+		return 0, fmt.Errorf("failed to update Application: %w", err)
+	}
+	markResourceAwake(ctx, s.queries, resource.ID)
+	return deploymentID, nil
+}
+
+// configGroupsEnv returns the env vars of the config groups attached to a resource merged in the order
+// they were attached, so a later group wins over an earlier one.
+func configGroupsEnv(ctx context.Context, queries genDb.Querier, resourceID int64) (map[string]string, error) {
+	groups, err := queries.ListResourceConfigGroups(ctx, resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config groups: %w", err)
+	}
+	var merged map[string]string
+	for _, group := range groups {
+		var env map[string]string
+		if err := json.Unmarshal(group.Env, &env); err != nil {
+			return nil, fmt.Errorf("invalid env for config group %d: %w", group.ID, err)
+		}
+		if merged == nil {
+			merged = make(map[string]string, len(env))
+		}
+		maps.Copy(merged, env)
+	}
+	return merged, nil
+}
+
+func configGroupToProto(group genDb.ConfigGroup, resourceIDs []int64) *configgroupv1.ConfigGroup {
+	var env map[string]string
+	if err := json.Unmarshal(group.Env, &env); err != nil {
+		slog.Warn("failed to unmarshal config group env", "configGroupId", group.ID, "error", err)
+	}
+	return &configgroupv1.ConfigGroup{
+		Id:          group.ID,
+		WorkspaceId: group.WorkspaceID,
+		Name:        group.Name,
+		Description: group.Description,
+		Env:         env,
+		ResourceIds: resourceIDs,
+		CreatedBy:   group.CreatedBy.Int64,
+		CreatedAt:   timestamppb.New(group.CreatedAt.Time),
+		UpdatedAt:   timestamppb.New(group.UpdatedAt.Time),
+	}
+}
+
+func configRolloutToProto(rollout genDb.ConfigRollout) *configgroupv1.ConfigRollout {
+	result := &configgroupv1.ConfigRollout{
+		Id:                 rollout.ID,
+		ConfigGroupId:      rollout.ConfigGroupID,
+		Status:             configRolloutStatusToProto(rollout.Status),
+		ResourceIds:        rollout.ResourceIds,
+		CompletedResources: rollout.CompletedResources,
+		Error:              rollout.Error,
+		CreatedAt:          timestamppb.New(rollout.CreatedAt.Time),
+		UpdatedAt:          timestamppb.New(rollout.UpdatedAt.Time),
+	}
+	if rollout.Status == genDb.ConfigRolloutStatusRunning && int(rollout.CompletedResources) < len(rollout.ResourceIds) {
+		result.CurrentResourceId = &rollout.ResourceIds[rollout.CompletedResources]
+	}
+	if rollout.CompletedAt.Valid {
+		result.CompletedAt = timestamppb.New(rollout.CompletedAt.Time)
+	}
+	return result
+}
+
+func configRolloutStatusToProto(status genDb.ConfigRolloutStatus) configgroupv1.ConfigRolloutStatus {
+	switch status {
+	case genDb.ConfigRolloutStatusPending:
+		return configgroupv1.ConfigRolloutStatus_CONFIG_ROLLOUT_STATUS_PENDING
+	case genDb.ConfigRolloutStatusRunning:
+		return configgroupv1.ConfigRolloutStatus_CONFIG_ROLLOUT_STATUS_RUNNING
+	case genDb.ConfigRolloutStatusCompleted:
+		return configgroupv1.ConfigRolloutStatus_CONFIG_ROLLOUT_STATUS_COMPLETED
+	case genDb.ConfigRolloutStatusFailed:
+		return configgroupv1.ConfigRolloutStatus_CONFIG_ROLLOUT_STATUS_FAILED
+	default:
+		return configgroupv1.ConfigRolloutStatus_CONFIG_ROLLOUT_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+)
+
+// ErrConfigMismatch is returned when env vars do not satisfy a service's config schema.
+var ErrConfigMismatch = errors.New("env vars do not match the config schema")
+
+// validateConfigSchema rejects a config schema that cannot be checked against: invalid or repeated
+// names, and patterns that do not compile.
+func validateConfigSchema(schema *resourcev1.ConfigSchema) error {
+	seen := make(map[string]bool, len(schema.GetKeys()))
+	for _, key := range schema.GetKeys() {
+		if !envVarNamePattern.MatchString(key.GetName()) {
+			return fmt.Errorf("config schema: invalid env var name %q", key.GetName())
+		}
+		if seen[key.GetName()] {
+			return fmt.Errorf("config schema: %s is declared twice", key.GetName())
+		}
+		seen[key.GetName()] = true
+		if _, ok := resourcev1.ConfigValueType_name[int32(key.GetType())]; !ok {
+			return fmt.Errorf("config schema: %s has unknown type %d", key.GetName(), key.GetType())
+		}
+		if _, err := configPattern(key.GetPattern()); err != nil {
+			return fmt.Errorf("config schema: %s has invalid pattern: %w", key.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// checkConfig checks env, the env vars a deployment would run with, against a service's config
+// schema. Every mismatch is reported at once, so they can all be fixed before trying again.
+func checkConfig(schema *resourcev1.ConfigSchema, env map[string]string) error {
+	var problems []string
+	for _, key := range schema.GetKeys() {
+		value, ok := env[key.GetName()]
+		if !ok {
+			if key.GetRequired() {
+				problems = append(problems, fmt.Sprintf("%s is required", key.GetName()))
+			}
+			continue
+		}
+		// secret values are resolved in the cluster, so there is nothing to check here
+		if _, _, ok := locoControllerV1.ParseSecretRef(value); ok {
+			continue
+		}
+		if err := checkConfigValue(key, value); err != nil {
+			problems = append(problems, fmt.Sprintf("%s %s", key.GetName(), err))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	slices.Sort(problems)
+	return fmt.Errorf("%w: %s", ErrConfigMismatch, strings.Join(problems, "; "))
+}
+
+func checkConfigValue(key *resourcev1.ConfigKey, value string) error {
+	switch key.GetType() {
+	case resourcev1.ConfigValueType_CONFIG_VALUE_TYPE_INT:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return errors.New("must be an integer")
+		}
+	case resourcev1.ConfigValueType_CONFIG_VALUE_TYPE_BOOL:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.New("must be true or false")
+		}
+	case resourcev1.ConfigValueType_CONFIG_VALUE_TYPE_URL:
+		if u, err := url.Parse(value); err != nil || u.Scheme == "" || u.Host == "" {
+			return errors.New("must be an absolute URL")
+		}
+	case resourcev1.ConfigValueType_CONFIG_VALUE_TYPE_DURATION:
+		if _, err := time.ParseDuration(value); err != nil {
+			return errors.New("must be a duration such as 30s or 5m")
+		}
+	}
+
+	pattern, err := configPattern(key.GetPattern())
+	if err != nil {
+		return err
+	}
+	if pattern != nil && !pattern.MatchString(value) {
+		return fmt.Errorf("must match %s", key.GetPattern())
+	}
+	return nil
+}
+
+// configPattern compiles a config key's pattern to match whole values, or returns nil when unset.
+func configPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
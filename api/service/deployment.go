@@ -6,21 +6,32 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
 	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/changes"
 	"github.com/team-loco/loco/api/pkg/converter"
+	"github.com/team-loco/loco/api/pkg/imagescan"
+	"github.com/team-loco/loco/api/pkg/klogmux"
 	"github.com/team-loco/loco/api/pkg/kube"
+	"github.com/team-loco/loco/api/pkg/policy"
+	"github.com/team-loco/loco/api/pkg/registry"
+	"github.com/team-loco/loco/api/pkg/requestid"
+	"github.com/team-loco/loco/api/pkg/specdiff"
 	timeutil "github.com/team-loco/loco/api/timeutil"
 	"github.com/team-loco/loco/api/tvm"
 	"github.com/team-loco/loco/api/tvm/actions"
 	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	"github.com/team-loco/loco/controller/pkg/imagesig"
 	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
 	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
 	"github.com/team-loco/loco/shared/version"
@@ -31,12 +42,29 @@ import (
 )
 
 var (
-	ErrDeploymentNotFound = errors.New("deployment not found")
-	ErrInvalidImage       = errors.New("invalid image reference")
-	ErrInvalidPort        = errors.New("invalid port")
-	ErrInvalidReplicas    = errors.New("replicas must be >= 1")
+	ErrDeploymentNotFound      = errors.New("deployment not found")
+	ErrDeploymentInProgress    = errors.New("another deployment of this resource is in progress, try again shortly")
+	ErrInvalidImage            = errors.New("invalid image reference")
+	ErrInvalidPort             = errors.New("invalid port")
+	ErrInvalidReplicas         = errors.New("replicas must be >= 1")
+	ErrInvalidScaleTarget      = errors.New("scale trigger must reference a queue resource in the same workspace")
+	ErrCriticalVulnerabilities = errors.New("image has critical vulnerabilities")
+	ErrImageNotScanned         = errors.New("image could not be scanned for vulnerabilities")
+	ErrImageScanDisabled       = errors.New("image scanning is not enabled")
+	ErrImageScanNotReady       = errors.New("image has not been scanned yet, try again shortly")
+	ErrInvalidAnnotation       = errors.New("invalid deployment annotation")
+	ErrSignatureUnverifiable   = errors.New("workspace policies require signed images, which cannot be checked while IMAGE_PIN_DIGESTS is off")
 )
 
+// deploymentLockTimeout is how long a deployment waits for one of the same resource to be created
+// before giving up with ErrDeploymentInProgress.
+const deploymentLockTimeout = 10 * time.Second
+
+// watchPollInterval is how often watches re-read state in case a change notification was missed.
+const watchPollInterval = 30 * time.Second
+
+var gitShaPattern = regexp.MustCompile(`^[0-9a-f]{7,64}$`)
+
 var imagePattern = regexp.MustCompile(`^([a-z0-9\-._]+(/[a-z0-9\-._]+)*)(:[a-z0-9\-._]+|@sha256:[a-f0-9]{64})?$`)
 
 func parseDeploymentPhase(status genDb.DeploymentStatus) deploymentv1.DeploymentPhase {
@@ -58,6 +86,26 @@ func parseDeploymentPhase(status genDb.DeploymentStatus) deploymentv1.Deployment
 	}
 }
 
+// deploymentPhaseToStatus is the inverse of parseDeploymentPhase
+func deploymentPhaseToStatus(phase deploymentv1.DeploymentPhase) (genDb.DeploymentStatus, error) {
+	switch phase {
+	case deploymentv1.DeploymentPhase_DEPLOYMENT_PHASE_PENDING:
+		return genDb.DeploymentStatusPending, nil
+	case deploymentv1.DeploymentPhase_DEPLOYMENT_PHASE_DEPLOYING:
+		return genDb.DeploymentStatusDeploying, nil
+	case deploymentv1.DeploymentPhase_DEPLOYMENT_PHASE_RUNNING:
+		return genDb.DeploymentStatusRunning, nil
+	case deploymentv1.DeploymentPhase_DEPLOYMENT_PHASE_SUCCEEDED:
+		return genDb.DeploymentStatusSucceeded, nil
+	case deploymentv1.DeploymentPhase_DEPLOYMENT_PHASE_FAILED:
+		return genDb.DeploymentStatusFailed, nil
+	case deploymentv1.DeploymentPhase_DEPLOYMENT_PHASE_CANCELED:
+		return genDb.DeploymentStatusCanceled, nil
+	default:
+		return "", fmt.Errorf("unknown deployment status %s", phase)
+	}
+}
+
 func deploymentToProto(d genDb.Deployment, resourceType string) *deploymentv1.Deployment {
 	deployment := &deploymentv1.Deployment{
 		Id:          d.ID,
@@ -71,6 +119,15 @@ func deploymentToProto(d genDb.Deployment, resourceType string) *deploymentv1.De
 		UpdatedAt:   timeutil.ParsePostgresTimestamp(d.UpdatedAt.Time),
 		SpecVersion: d.SpecVersion,
 		Message:     d.Message,
+		Version:     d.Version,
+		Annotation: &deploymentv1.DeploymentAnnotation{
+			Message: d.AnnotationMessage,
+			Author:  d.AnnotationAuthor,
+			GitSha:  d.AnnotationGitSha,
+		},
+	}
+	if d.CreatedBy.Valid {
+		deployment.CreatedBy = &d.CreatedBy.Int64
 	}
 
 	if len(d.Spec) > 0 {
@@ -126,21 +183,29 @@ func deploymentToProto(d genDb.Deployment, resourceType string) *deploymentv1.De
 
 // DeploymentServer implements the DeploymentService gRPC server
 type DeploymentServer struct {
-	db            *pgxpool.Pool
+	db            apiDb.Beginner
 	queries       genDb.Querier
 	kubeClient    *kube.Client
 	locoNamespace string
 	machine       *tvm.VendingMachine
+	feed          *changes.Feed
+	scanner       *imagescan.Scanner // nil when image scanning is disabled
+	resolver      *registry.Resolver // nil when images are not pinned to digests
+	verifier      *imagesig.Verifier // nil when images are not pinned to digests
 }
 
 // NewDeploymentServer creates a new DeploymentServer instance
-func NewDeploymentServer(db *pgxpool.Pool, queries genDb.Querier, machine *tvm.VendingMachine, kubeClient *kube.Client, locoNamespace string) *DeploymentServer {
+func NewDeploymentServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine, kubeClient *kube.Client, locoNamespace string, feed *changes.Feed, scanner *imagescan.Scanner, resolver *registry.Resolver, verifier *imagesig.Verifier) *DeploymentServer {
 	return &DeploymentServer{
 		db:            db,
 		queries:       queries,
 		kubeClient:    kubeClient,
 		locoNamespace: locoNamespace,
 		machine:       machine,
+		feed:          feed,
+		scanner:       scanner,
+		resolver:      resolver,
+		verifier:      verifier,
 	}
 }
 
@@ -157,7 +222,7 @@ func (s *DeploymentServer) CreateDeployment(
 		return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
 	}
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -167,6 +232,21 @@ func (s *DeploymentServer) CreateDeployment(
 		slog.WarnContext(ctx, "unauthorized to create deployment", "resourceId", r.GetResourceId())
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
+	// env vars in the spec change the resource's env, which deploy:create alone does not allow
+	if len(r.GetSpec().GetService().GetEnv()) > 0 {
+		if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.UpdateResourceEnv, r.GetResourceId())); err != nil {
+			slog.WarnContext(ctx, "unauthorized to set env vars in deployment", "resourceId", r.GetResourceId())
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+	}
+
+	// archived workspaces take no new deployments
+	if err := ensureResourceWorkspaceActive(ctx, s.queries, r.GetResourceId()); err != nil {
+		return nil, err
+	}
+	if err := ensureOrgInGoodStanding(ctx, s.queries, r.GetResourceId()); err != nil {
+		return nil, err
+	}
 
 	// todo: move below validations to a dedicated validation package.
 	if r.GetSpec() == nil {
@@ -193,6 +273,10 @@ func (s *DeploymentServer) CreateDeployment(
 		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidImage)
 	}
 
+	if err := validateDeploymentAnnotation(r.GetAnnotation()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	replicas := serviceSpec.GetMinReplicas()
 
 	domain, err := s.queries.GetDomainByResourceId(ctx, r.GetResourceId())
@@ -230,6 +314,45 @@ func (s *DeploymentServer) CreateDeployment(
 	// create spec copy without env for DB persistence (no plaintext secrets in DB)
 	mergedServiceSpec := mergedSpec.GetService()
 
+	// checked again with the rest of the spec when the Application is built; checking here keeps a
+	// deployment that could never start from being recorded
+	if err := locoControllerV1.ValidateContainerSpecs(
+		converter.ProtoToContainerSpecs(mergedServiceSpec.GetInitContainers()),
+		converter.ProtoToContainerSpecs(mergedServiceSpec.GetSidecars()),
+	); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.resolveScaleTriggers(ctx, resource, mergedServiceSpec.GetScalers()); err != nil {
+		return nil, err
+	}
+
+	if err := enforcePolicies(ctx, s.queries, resource.WorkspaceID, policy.Input{Labels: unmarshalLabels(resource.Labels), Deployment: mergedServiceSpec}); err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyImageExists(ctx, mergedServiceSpec.GetBuild()); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSignatures(ctx, resource, mergedServiceSpec.GetBuild()); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkImageScan(ctx, resource, registry.Pin(mergedServiceSpec.GetBuild().GetImage(), mergedServiceSpec.GetBuild().GetImageDigest())); err != nil {
+		return nil, err
+	}
+
+	inherited, err := loadInheritedEnv(ctx, s.queries, resource)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load inherited env", "error", err, "resourceId", resource.ID)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// a deployment missing config the service needs would only fail once it is running
+	if err := checkConfig(resourceSpec.GetService().GetConfigSchema(), inherited.apply(mergedServiceSpec.GetEnv())); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
 	// create shallow copy excluding env as it can have sensitive info.
 	// todo: consider using dedicated secrets management solution.
 	specForDBService := mergedServiceSpec
@@ -242,29 +365,37 @@ func (s *DeploymentServer) CreateDeployment(
 	}
 
 	// Create deployment transactionally, finalizing previous deployments in the same region
-	deploymentID, err := createDeploymentWithCleanup(ctx, s.db, s.queries, genDb.CreateDeploymentParams{
-		ResourceID:  r.GetResourceId(),
-		ClusterID:   cluster.ID,
-		Region:      region,
-		Replicas:    replicas,
-		Status:      genDb.DeploymentStatusPending,
-		IsActive:    true,
-		Message:     "Scheduling deployment",
-		Spec:        specJSON,
-		SpecVersion: version.SpecVersionV1,
+	deploymentID, err := createDeploymentWithCleanup(ctx, s.db, s.queries, nil, genDb.CreateDeploymentParams{
+		ResourceID:        r.GetResourceId(),
+		ClusterID:         cluster.ID,
+		Region:            region,
+		Replicas:          replicas,
+		Status:            genDb.DeploymentStatusPending,
+		IsActive:          true,
+		Message:           "Scheduling deployment",
+		Spec:              specJSON,
+		SpecVersion:       version.SpecVersionV1,
+		AnnotationMessage: r.GetAnnotation().GetMessage(),
+		AnnotationAuthor:  r.GetAnnotation().GetAuthor(),
+		AnnotationGitSha:  strings.ToLower(r.GetAnnotation().GetGitSha()),
 	})
 	if err != nil {
+		if errors.Is(err, ErrDeploymentInProgress) {
+			return nil, connect.NewError(connect.CodeAborted, err)
+		}
 		slog.ErrorContext(ctx, "failed to create deployment", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
 	// create Application in loco-system namespace (pass merged spec WITH env to controller)
-	err = createLocoResource(ctx, s.kubeClient, resource, resourceSpec, domain.Domain, mergedSpec, s.locoNamespace, region)
+	err = createLocoResource(ctx, s.kubeClient, s.queries, resource, resourceSpec, domain, inherited, mergedSpec, s.locoNamespace, region)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to create Application", "error", err, "resourceId", resource.ID)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create Application: %w", err))
 	}
 	slog.InfoContext(ctx, "created/updated Application", "resourceId", resource.ID, "resource_name", resource.Name)
+	markResourceAwake(ctx, s.queries, resource.ID)
+	s.recordProvenanceInBackground(deploymentID, registry.Pin(mergedServiceSpec.GetBuild().GetImage(), mergedServiceSpec.GetBuild().GetImageDigest()))
 
 	deployment, err := s.queries.GetDeploymentByID(ctx, deploymentID)
 	if err != nil {
@@ -275,6 +406,118 @@ func (s *DeploymentServer) CreateDeployment(
 	return connect.NewResponse(&deploymentv1.CreateDeploymentResponse{DeploymentId: deployment.ID}), nil
 }
 
+// PromoteDeployment deploys a deployment's image to the resource of the same name in another
+// environment of the workspace, e.g. from staging to prod. Only the build and port are promoted;
+// sizing, scaling and env vars come from the target resource and its environment.
+func (s *DeploymentServer) PromoteDeployment(
+	ctx context.Context,
+	req *connect.Request[deploymentv1.PromoteDeploymentRequest],
+) (*connect.Response[deploymentv1.PromoteDeploymentResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	source, err := s.queries.GetDeploymentByID(ctx, r.GetDeploymentId())
+	if err != nil {
+		slog.WarnContext(ctx, "deployment not found", "deployment_id", r.GetDeploymentId())
+		return nil, connect.NewError(connect.CodeNotFound, ErrDeploymentNotFound)
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.PromoteDeployment, source.ResourceID)); err != nil {
+		slog.WarnContext(ctx, "unauthorized to promote deployment", "deploymentId", source.ID)
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	sourceResource, err := s.queries.GetResourceByID(ctx, source.ResourceID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+	}
+	if !sourceResource.EnvironmentID.Valid {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("only deployments of resources in an environment can be promoted"))
+	}
+
+	targetEnvironment, err := s.queries.GetEnvironmentByID(ctx, r.GetTargetEnvironmentId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, ErrEnvironmentNotFound)
+	}
+	if targetEnvironment.WorkspaceID != sourceResource.WorkspaceID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrEnvironmentWorkspace)
+	}
+	if targetEnvironment.ID == sourceResource.EnvironmentID.Int64 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("deployment is already in the target environment"))
+	}
+
+	target, err := s.queries.GetResourceByNameInEnvironment(ctx, genDb.GetResourceByNameInEnvironmentParams{
+		EnvironmentID: targetEnvironment.ID,
+		Name:          sourceResource.Name,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no resource named %q in environment %q", sourceResource.Name, targetEnvironment.Name))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	sourceSpec, err := converter.DeserializeDeploymentSpec(source.Spec, string(sourceResource.Type))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to deserialize deployment spec", "deploymentId", source.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid spec: %w", err))
+	}
+
+	region := r.GetRegion()
+	if region == "" {
+		regions, err := s.queries.ListResourceRegions(ctx, target.ID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		if len(regions) == 0 {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("target resource has no regions"))
+		}
+		// regions are ordered primary first
+		region = regions[0].Region
+	}
+
+	// promote the image the source runs, by digest when it was pinned, not whatever its tag
+	// points to now
+	build := sourceSpec.GetService().GetBuild()
+	if build != nil {
+		build.Image = registry.Pin(build.GetImage(), build.GetImageDigest())
+	}
+
+	// CreateDeployment checks that the caller may deploy the target resource
+	created, err := s.CreateDeployment(ctx, connect.NewRequest(&deploymentv1.CreateDeploymentRequest{
+		ResourceId: target.ID,
+		Region:     region,
+		Spec: &deploymentv1.DeploymentSpec{
+			Spec: &deploymentv1.DeploymentSpec_Service{
+				Service: &deploymentv1.ServiceDeploymentSpec{
+					Build: build,
+					Port:  sourceSpec.GetService().GetPort(),
+				},
+			},
+		},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "promoted deployment",
+		"sourceDeploymentId", source.ID,
+		"targetResourceId", target.ID,
+		"targetEnvironment", targetEnvironment.Name,
+		"deploymentId", created.Msg.GetDeploymentId(),
+	)
+
+	return connect.NewResponse(&deploymentv1.PromoteDeploymentResponse{
+		DeploymentId:     created.Msg.GetDeploymentId(),
+		TargetResourceId: target.ID,
+	}), nil
+}
+
 // GetDeployment retrieves a deployment by ID
 func (s *DeploymentServer) GetDeployment(
 	ctx context.Context,
@@ -294,7 +537,7 @@ func (s *DeploymentServer) GetDeployment(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -318,7 +561,7 @@ func (s *DeploymentServer) ListDeployments(
 ) (*connect.Response[deploymentv1.ListDeploymentsResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -349,11 +592,34 @@ func (s *DeploymentServer) ListDeployments(
 		}
 	}
 
-	deploymentList, err := s.queries.ListDeploymentsForResource(ctx, genDb.ListDeploymentsForResourceParams{
-		ResourceID: r.GetResourceId(),
-		Limit:      pageSize,
-		PageToken:  pageToken,
-	})
+	params := genDb.ListDeploymentsForResourceParams{
+		ResourceID:  r.GetResourceId(),
+		Limit:       pageSize,
+		PageToken:   pageToken,
+		ActiveOnly:  r.GetActiveOnly(),
+		OldestFirst: r.GetSortOrder() == deploymentv1.DeploymentSortOrder_DEPLOYMENT_SORT_ORDER_OLDEST_FIRST,
+	}
+	for _, phase := range r.GetStatuses() {
+		status, err := deploymentPhaseToStatus(phase)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		params.Statuses = append(params.Statuses, status)
+	}
+	if r.CreatedAfter != nil {
+		params.CreatedAfter = pgtype.Timestamptz{Time: r.GetCreatedAfter().AsTime(), Valid: true}
+	}
+	if r.CreatedBefore != nil {
+		params.CreatedBefore = pgtype.Timestamptz{Time: r.GetCreatedBefore().AsTime(), Valid: true}
+	}
+	if params.CreatedAfter.Valid && params.CreatedBefore.Valid && !params.CreatedAfter.Time.Before(params.CreatedBefore.Time) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("created_after must be before created_before"))
+	}
+	if r.CreatedBy != nil {
+		params.CreatedBy = pgtype.Int8{Int64: r.GetCreatedBy(), Valid: true}
+	}
+
+	deploymentList, err := s.queries.ListDeploymentsForResource(ctx, params)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to list deployments", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
@@ -375,6 +641,116 @@ func (s *DeploymentServer) ListDeployments(
 	}), nil
 }
 
+// ListDeploymentHistory lists deployments for a resource, newest first, each paired with the spec
+// changes it introduced relative to the previous deployment in the same region
+func (s *DeploymentServer) ListDeploymentHistory(
+	ctx context.Context,
+	req *connect.Request[deploymentv1.ListDeploymentHistoryRequest],
+) (*connect.Response[deploymentv1.ListDeploymentHistoryResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ListDeployments, r.GetResourceId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	resource, err := s.queries.GetResourceByID(ctx, r.GetResourceId())
+	if err != nil {
+		slog.WarnContext(ctx, "resource not found", "resourceId", r.GetResourceId())
+		return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+	}
+
+	pageSize := normalizePageSize(r.GetPageSize())
+
+	var pageToken pgtype.Text
+	if r.GetPageToken() != "" {
+		cursorID, err := decodeCursor(r.GetPageToken())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token: %w", err))
+		}
+		pageToken = pgtype.Text{
+			String: fmt.Sprintf("%d", cursorID),
+			Valid:  true,
+		}
+	}
+
+	deploymentList, err := s.queries.ListDeploymentsForResource(ctx, genDb.ListDeploymentsForResourceParams{
+		ResourceID: r.GetResourceId(),
+		Limit:      pageSize,
+		PageToken:  pageToken,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list deployments", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	entries := make([]*deploymentv1.DeploymentHistoryEntry, 0, len(deploymentList))
+	for i, d := range deploymentList {
+		entry := &deploymentv1.DeploymentHistoryEntry{
+			Deployment: deploymentToProto(d, string(resource.Type)),
+		}
+
+		// the predecessor is usually later in this page; only hit the database when it isn't
+		var previous *genDb.Deployment
+		for j := i + 1; j < len(deploymentList); j++ {
+			if deploymentList[j].Region == d.Region {
+				previous = &deploymentList[j]
+				break
+			}
+		}
+		if previous == nil {
+			prev, err := s.queries.GetPreviousDeploymentInRegion(ctx, genDb.GetPreviousDeploymentInRegionParams{
+				ResourceID: d.ResourceID,
+				Region:     d.Region,
+				CreatedAt:  d.CreatedAt,
+				ID:         d.ID,
+			})
+			if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+				slog.ErrorContext(ctx, "failed to get previous deployment", "deploymentId", d.ID, "error", err)
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+			}
+			if err == nil {
+				previous = &prev
+			}
+		}
+
+		var previousSpec []byte
+		if previous != nil {
+			entry.PreviousDeploymentId = &previous.ID
+			previousSpec = previous.Spec
+		}
+
+		changes, err := specdiff.Diff(previousSpec, d.Spec)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to diff deployment specs", "deploymentId", d.ID, "error", err)
+		}
+		for _, c := range changes {
+			entry.Changes = append(entry.Changes, &deploymentv1.SpecChange{
+				Path:     c.Path,
+				OldValue: c.Old,
+				NewValue: c.New,
+			})
+		}
+
+		entries = append(entries, entry)
+	}
+
+	var nextPageToken string
+	if len(deploymentList) == int(pageSize) {
+		nextPageToken = encodeCursor(deploymentList[len(deploymentList)-1].ID)
+	}
+
+	return connect.NewResponse(&deploymentv1.ListDeploymentHistoryResponse{
+		Entries:       entries,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
 // DeleteDeployment deletes/inactivates a deployment and cleans up its Application
 func (s *DeploymentServer) DeleteDeployment(
 	ctx context.Context,
@@ -394,7 +770,7 @@ func (s *DeploymentServer) DeleteDeployment(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -443,7 +819,7 @@ func (s *DeploymentServer) WatchDeployment(
 		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -454,28 +830,100 @@ func (s *DeploymentServer) WatchDeployment(
 		return connect.NewError(connect.CodePermissionDenied, err)
 	}
 
+	// status changes arrive through the change feed; subscribe before the first read so none are missed
+	events, unsubscribe := s.feed.Subscribe(func(e changes.Event) bool {
+		return e.Table == changes.TableDeployments && e.ID == r.GetDeploymentId()
+	})
+	defer unsubscribe()
+
 	lastStatus := ""
-	ticker := time.NewTicker(2 * time.Second)
+	ticker := time.NewTicker(watchPollInterval)
 	defer ticker.Stop()
 
 	if err := s.sendDeploymentEvent(ctx, stream, fmt.Sprintf("%d", r.DeploymentId), &lastStatus); err != nil {
 		return err
 	}
 
+	// hook output is streamed alongside status changes; without it the watch still reports status
+	var hookLogs <-chan klogmux.LogEntry
+	var hookLogErrors <-chan error
+	if logStream := s.startHookLogStream(ctx, resource, r.GetDeploymentId()); logStream != nil {
+		defer logStream.Stop()
+		hookLogs = logStream.Entries()
+		hookLogErrors = logStream.Errors()
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
+		case <-events:
 		case <-ticker.C:
-			if err := s.sendDeploymentEvent(ctx, stream, fmt.Sprintf("%d", r.DeploymentId), &lastStatus); err != nil {
+		case entry := <-hookLogs:
+			if err := stream.Send(&deploymentv1.WatchDeploymentResponse{
+				DeploymentId: r.GetDeploymentId(),
+				Status:       parseDeploymentPhase(genDb.DeploymentStatus(lastStatus)),
+				Timestamp:    timestamppb.New(entry.Timestamp),
+				HookLog: &deploymentv1.HookLog{
+					Hook: entry.Container,
+					Line: entry.Message,
+				},
+			}); err != nil {
 				return err
 			}
-
-			if lastStatus == "succeeded" || lastStatus == "failed" {
-				return nil
+			continue
+		case err := <-hookLogErrors:
+			if err != nil {
+				slog.WarnContext(ctx, "hook log stream error", "deployment_id", r.GetDeploymentId(), "error", err)
 			}
+			continue
+		}
+
+		if err := s.sendDeploymentEvent(ctx, stream, fmt.Sprintf("%d", r.DeploymentId), &lastStatus); err != nil {
+			return err
 		}
+
+		if lastStatus == "succeeded" || lastStatus == "failed" {
+			return nil
+		}
+	}
+}
+
+// validateDeploymentAnnotation bounds what a deployer may record about a deployment.
+func validateDeploymentAnnotation(annotation *deploymentv1.DeploymentAnnotation) error {
+	if len(annotation.GetMessage()) > 2000 {
+		return fmt.Errorf("%w: message must be at most 2000 characters", ErrInvalidAnnotation)
+	}
+	if len(annotation.GetAuthor()) > 200 {
+		return fmt.Errorf("%w: author must be at most 200 characters", ErrInvalidAnnotation)
 	}
+	if sha := annotation.GetGitSha(); sha != "" && !gitShaPattern.MatchString(strings.ToLower(sha)) {
+		return fmt.Errorf("%w: git sha must be 7 to 64 hex characters", ErrInvalidAnnotation)
+	}
+	return nil
+}
+
+// startHookLogStream follows the logs of the deployment's hook Jobs, from when the deployment was
+// created. It returns nil when the logs can't be followed.
+func (s *DeploymentServer) startHookLogStream(ctx context.Context, resource genDb.Resource, deploymentID int64) *klogmux.LogStream {
+	deployment, err := s.queries.GetDeploymentByID(ctx, deploymentID)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to get deployment for hook logs", "deployment_id", deploymentID, "error", err)
+		return nil
+	}
+
+	logStream := klogmux.NewBuilder(s.kubeClient.ClientSet).
+		Namespace(computeNamespace(resource.WorkspaceID, resource.ID)).
+		LabelSelector(locoControllerV1.HookLabel).
+		Follow(true).
+		Since(time.Since(deployment.CreatedAt.Time)).
+		Timestamps(true).
+		Build()
+	if err := logStream.Start(ctx); err != nil {
+		slog.WarnContext(ctx, "failed to start hook log stream", "deployment_id", deploymentID, "error", err)
+		return nil
+	}
+	return logStream
 }
 
 func (s *DeploymentServer) sendDeploymentEvent(
@@ -506,6 +954,11 @@ func (s *DeploymentServer) sendDeploymentEvent(
 			Status:       statusPhase,
 			Message:      message,
 			Timestamp:    timestamppb.New(time.Now()),
+			Annotation: &deploymentv1.DeploymentAnnotation{
+				Message: deployment.AnnotationMessage,
+				Author:  deployment.AnnotationAuthor,
+				GitSha:  deployment.AnnotationGitSha,
+			},
 		}
 
 		if err := stream.Send(event); err != nil {
@@ -519,13 +972,53 @@ func (s *DeploymentServer) sendDeploymentEvent(
 	return nil
 }
 
+// inheritedEnv is the env vars a resource takes from its workspace, config groups and environment.
+type inheritedEnv struct {
+	workspace   map[string]string // defaults the resource's own env vars win over
+	groups      map[string]string // config groups, winning over the workspace but not the resource
+	environment map[string]string // overlay that wins over the resource's own env vars
+}
+
+// loadInheritedEnv returns the env vars the resource inherits from its workspace, config groups and
+// environment.
+func loadInheritedEnv(ctx context.Context, queries genDb.Querier, resource genDb.Resource) (inheritedEnv, error) {
+	workspace, err := workspaceEnv(ctx, queries, resource.WorkspaceID)
+	if err != nil {
+		return inheritedEnv{}, err
+	}
+	groups, err := configGroupsEnv(ctx, queries, resource.ID)
+	if err != nil {
+		return inheritedEnv{}, err
+	}
+	environment, err := environmentEnv(ctx, queries, resource)
+	if err != nil {
+		return inheritedEnv{}, err
+	}
+	return inheritedEnv{workspace: workspace, groups: groups, environment: environment}, nil
+}
+
+// apply merges a resource's own env vars with the inherited ones.
+func (e inheritedEnv) apply(env map[string]string) map[string]string {
+	if len(e.workspace) == 0 && len(e.groups) == 0 && len(e.environment) == 0 {
+		return env
+	}
+	merged := make(map[string]string, len(e.workspace)+len(e.groups)+len(env)+len(e.environment))
+	maps.Copy(merged, e.workspace)
+	maps.Copy(merged, e.groups)
+	maps.Copy(merged, env)
+	maps.Copy(merged, e.environment)
+	return merged
+}
+
 // createLocoResource creates a Application in the loco-system namespace
 func createLocoResource(
 	ctx context.Context,
 	kubeClient *kube.Client,
+	queries genDb.Querier,
 	resource genDb.Resource,
 	resourceSpec *resourcev1.ResourceSpec,
-	hostname string,
+	domain genDb.GetDomainByResourceIdRow,
+	inherited inheritedEnv,
 	deploymentSpec *deploymentv1.DeploymentSpec,
 	locoNamespace string,
 	region string,
@@ -534,10 +1027,22 @@ func createLocoResource(
 	crdServiceDeploymentSpec := converter.ProtoToServiceDeploymentSpec(deploymentSpec)
 	slog.InfoContext(ctx, "converted deployment spec", "image", crdServiceDeploymentSpec.Image, "port", crdServiceDeploymentSpec.Port)
 
+	crdServiceDeploymentSpec.Env = inherited.apply(crdServiceDeploymentSpec.Env)
+
+	// the controller holds the app's CPU and memory to its plan's limits
+	plan, err := queries.GetResourcePlanName(ctx, resource.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get resource plan: %w", err)
+	}
+
 	locoResourceSpec := locoControllerV1.ApplicationSpec{
 		ResourceId:  resource.ID,
 		WorkspaceId: resource.WorkspaceID,
 		Region:      region,
+		Plan:        plan,
+		// deploying a suspended resource updates it without bringing it back up
+		Suspended:          resource.Status == genDb.ResourceStatusSuspended,
+		MaintenanceMessage: resource.MaintenanceMessage.String,
 	}
 
 	switch resource.Type {
@@ -554,7 +1059,30 @@ func createLocoResource(
 			Deployment: crdServiceDeploymentSpec,
 			Resources:  resourcesSpec,
 			Obs:        converter.ProtoToObsSpec(resourceSpec.GetService().GetObservability()),
-			Routing:    converter.ProtoToRoutingSpec(resourceSpec.GetService().GetRouting(), hostname),
+			Routing:    converter.ProtoToRoutingSpec(resourceSpec.GetService().GetRouting(), domain.Domain),
+
+			ScaleToZero: converter.ProtoToScaleToZeroSpec(resourceSpec.GetService().GetScaleToZero()),
+			Scheduling:  converter.ProtoToSchedulingSpec(resourceSpec.GetService().GetScheduling()),
+
+			Command:    resourceSpec.GetService().GetCommand(),
+			Args:       resourceSpec.GetService().GetArgs(),
+			WorkingDir: resourceSpec.GetService().GetWorkingDir(),
+		}
+		if routing := locoResourceSpec.ServiceSpec.Routing; routing != nil {
+			routing.PlatformDomain = domain.DomainSource == genDb.DomainSourcePlatformProvided
+			storedAccessControl, err := converter.DeserializeAccessControl(domain.AccessControl)
+			if err != nil {
+				return err
+			}
+			routing.AccessControl = converter.ProtoToAccessControlSpec(storedAccessControl)
+			storedTLS, err := converter.DeserializeTLSPolicy(domain.Tls)
+			if err != nil {
+				return err
+			}
+			routing.TLS = converter.ProtoToTLSSpec(storedTLS)
+			if routing.Redirects, err = domainRedirects(ctx, queries, resource.ID, domain.Domain); err != nil {
+				return err
+			}
 		}
 
 	case genDb.ResourceTypeDatabase:
@@ -594,11 +1122,12 @@ func createLocoResource(
 	slog.InfoContext(ctx, "building Application", "resourceId", resource.ID, "spec", string(specJSON))
 
 	// create or update the Application
-	err := kubeClient.ControllerClient.Get(ctx, client.ObjectKey{
+	err = kubeClient.ControllerClient.Get(ctx, client.ObjectKey{
 		Name:      locoRes.Name,
 		Namespace: locoRes.Namespace,
 	}, locoRes)
 
+	stampRequestID(ctx, locoRes)
 	if err == nil {
 		// resource exists, update it
 		if err := kubeClient.ControllerClient.Update(ctx, locoRes); err != nil {
@@ -681,15 +1210,307 @@ func buildResourcesSpec(
 	// Add scalers if configured
 	if scalers != nil {
 		resourcesSpec.Scalers = locoControllerV1.ScalersSpec{
-			Enabled:      scalers.GetEnabled(),
-			CPUTarget:    scalers.GetCpuTarget(),
-			MemoryTarget: scalers.GetMemoryTarget(),
+			Enabled:         scalers.GetEnabled(),
+			CPUTarget:       scalers.GetCpuTarget(),
+			MemoryTarget:    scalers.GetMemoryTarget(),
+			Triggers:        converter.ProtoToScaleTriggers(scalers.GetTriggers()),
+			Schedules:       converter.ProtoToCronSchedules(scalers.GetSchedules()),
+			PollingInterval: scalers.GetPollingInterval(),
+			CooldownPeriod:  scalers.GetCooldownPeriod(),
+			IdleAtZero:      scalers.GetIdleAtZero(),
 		}
 	}
 
 	return resourcesSpec, nil
 }
 
+// verifyImageExists checks the registry has the image being deployed and records the digest its
+// tag points to, which the controller deploys by, so pushing the tag again later does not change
+// what runs. A digest given in the request is ignored.
+func (s *DeploymentServer) verifyImageExists(ctx context.Context, build *deploymentv1.BuildSource) error {
+	build.ImageDigest = nil
+	if s.resolver == nil {
+		return nil
+	}
+
+	digest, err := s.resolver.Resolve(ctx, build.GetImage())
+	if err != nil {
+		if errors.Is(err, registry.ErrImageNotFound) || errors.Is(err, registry.ErrAccessDenied) {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%w: %s", err, build.GetImage()))
+		}
+		slog.WarnContext(ctx, "failed to resolve image", "image", build.GetImage(), "error", err)
+		return connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to look up image %s: %w", build.GetImage(), err))
+	}
+
+	slog.InfoContext(ctx, "resolved image", "image", build.GetImage(), "digest", digest)
+	build.ImageDigest = &digest
+	return nil
+}
+
+// resolveScaleTriggers validates KEDA triggers and schedules and links queue-backed triggers to their queue
+// resource. Triggers are limited to the types and metadata keys the platform supports. A trigger linked to a
+// queue gets the queue's name as its queueName metadata, and any other queueName is refused.
+func (s *DeploymentServer) resolveScaleTriggers(ctx context.Context, resource genDb.Resource, scalers *deploymentv1.Scalers) error {
+	for i, schedule := range scalers.GetSchedules() {
+		if schedule.GetTimezone() == "" || schedule.GetStart() == "" || schedule.GetEnd() == "" {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("scalers.schedules[%d] needs a timezone, start and end", i))
+		}
+		if _, err := time.LoadLocation(schedule.GetTimezone()); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("scalers.schedules[%d].timezone: %w", i, err))
+		}
+		if schedule.GetDesiredReplicas() <= 0 {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("scalers.schedules[%d].desired_replicas must be positive", i))
+		}
+	}
+	if scalers.GetPollingInterval() < 0 || scalers.GetCooldownPeriod() < 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("scalers polling interval and cooldown period cannot be negative"))
+	}
+	for i, trigger := range scalers.GetTriggers() {
+		spec := locoControllerV1.ScaleTriggerSpec{Type: trigger.GetType(), Metadata: trigger.GetMetadata(), LinkedResourceId: trigger.GetQueueResourceId()}
+		if err := locoControllerV1.ValidateScaleTrigger(spec); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("scalers.triggers[%d]: %w", i, err))
+		}
+		if trigger.QueueResourceId == nil {
+			continue
+		}
+
+		queue, err := s.queries.GetResourceByID(ctx, trigger.GetQueueResourceId())
+		if err != nil {
+			slog.WarnContext(ctx, "scale trigger queue not found", "resourceId", resource.ID, "queueResourceId", trigger.GetQueueResourceId())
+			return connect.NewError(connect.CodeInvalidArgument, ErrInvalidScaleTarget)
+		}
+		if queue.WorkspaceID != resource.WorkspaceID || queue.Type != genDb.ResourceTypeQueue {
+			slog.WarnContext(ctx, "invalid scale trigger queue", "resourceId", resource.ID, "queueResourceId", queue.ID, "type", queue.Type)
+			return connect.NewError(connect.CodeInvalidArgument, ErrInvalidScaleTarget)
+		}
+
+		// the queue's name always wins, or a trigger could be linked to its own queue while reading another's
+		if name, ok := trigger.Metadata[locoControllerV1.QueueNameKey]; ok && name != queue.Name {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("scalers.triggers[%d].metadata.queueName must be left unset or match the linked queue %q", i, queue.Name))
+		}
+		if trigger.Metadata == nil {
+			trigger.Metadata = map[string]string{}
+		}
+		trigger.Metadata[locoControllerV1.QueueNameKey] = queue.Name
+	}
+	return nil
+}
+
+// stampRequestID records the request changing an Application on it, so the controller's logs of
+// the reconcile that follows can be matched with the API's logs of the request.
+func stampRequestID(ctx context.Context, locoRes *locoControllerV1.Application) {
+	id := requestid.FromContext(ctx)
+	if id == "" {
+		return
+	}
+	if locoRes.Annotations == nil {
+		locoRes.Annotations = map[string]string{}
+	}
+	locoRes.Annotations[locoControllerV1.RequestIDAnnotation] = id
+}
+
+// restartLocoResource stamps the Application with a restart time, which the controller copies to the
+// pod template so the pods roll.
+func restartLocoResource(ctx context.Context, kubeClient *kube.Client, resourceID int64, locoNamespace string, at time.Time) error {
+	locoRes := &locoControllerV1.Application{}
+	err := kubeClient.ControllerClient.Get(ctx, client.ObjectKey{
+		Name:      fmt.Sprintf("resource-%d", resourceID),
+		Namespace: locoNamespace,
+	}, locoRes)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get Application", "error", err, "resourceId", resourceID)
+		return err
+	}
+
+	patch := client.MergeFrom(locoRes.DeepCopy())
+	stampRequestID(ctx, locoRes)
+	locoRes.Spec.RestartedAt = at.UTC().Format(time.RFC3339)
+	if err := kubeClient.ControllerClient.Patch(ctx, locoRes, patch); err != nil {
+		slog.ErrorContext(ctx, "failed to patch Application", "error", err, "resourceId", resourceID)
+		return err
+	}
+	return nil
+}
+
+// setLocoResourceSuspended suspends or resumes the Application for a resource.
+// Resources that were never deployed have no Application and are left alone.
+func setLocoResourceSuspended(ctx context.Context, kubeClient *kube.Client, resourceID int64, locoNamespace string, suspended bool, message string) error {
+	locoRes := &locoControllerV1.Application{}
+	err := kubeClient.ControllerClient.Get(ctx, client.ObjectKey{
+		Name:      fmt.Sprintf("resource-%d", resourceID),
+		Namespace: locoNamespace,
+	}, locoRes)
+	if err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			slog.InfoContext(ctx, "no Application to update", "resourceId", resourceID)
+			return nil
+		}
+		slog.ErrorContext(ctx, "failed to get Application", "error", err, "resourceId", resourceID)
+		return err
+	}
+
+	patch := client.MergeFrom(locoRes.DeepCopy())
+	stampRequestID(ctx, locoRes)
+	locoRes.Spec.Suspended = suspended
+	locoRes.Spec.MaintenanceMessage = message
+	if err := kubeClient.ControllerClient.Patch(ctx, locoRes, patch); err != nil {
+		slog.ErrorContext(ctx, "failed to patch Application", "error", err, "resourceId", resourceID)
+		return err
+	}
+
+	slog.InfoContext(ctx, "updated Application suspension", "resourceId", resourceID, "suspended", suspended)
+	return nil
+}
+
+// markResourceAwake records that a resource no longer sleeps once createLocoResource rebuilt its
+// Application, which always runs it. A failure only delays its next idle check.
+func markResourceAwake(ctx context.Context, queries genDb.Querier, resourceID int64) {
+	if _, err := queries.WakeResource(ctx, resourceID); err != nil {
+		slog.ErrorContext(ctx, "failed to mark resource awake", "error", err, "resourceId", resourceID)
+	}
+}
+
+// setLocoResourceAccessControl applies a domain's access control to the resource's Application
+// when that domain is the hostname it routes. Other domains take effect on their next deployment.
+func setLocoResourceAccessControl(ctx context.Context, kubeClient *kube.Client, domain genDb.ResourceDomain, locoNamespace string) error {
+	locoRes := &locoControllerV1.Application{}
+	err := kubeClient.ControllerClient.Get(ctx, client.ObjectKey{
+		Name:      fmt.Sprintf("resource-%d", domain.ResourceID),
+		Namespace: locoNamespace,
+	}, locoRes)
+	if err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			slog.InfoContext(ctx, "no Application to update", "resourceId", domain.ResourceID)
+			return nil
+		}
+		slog.ErrorContext(ctx, "failed to get Application", "error", err, "resourceId", domain.ResourceID)
+		return err
+	}
+	if locoRes.Spec.ServiceSpec == nil || locoRes.Spec.ServiceSpec.Routing == nil || locoRes.Spec.ServiceSpec.Routing.HostName != domain.Domain {
+		return nil
+	}
+
+	accessControl, err := converter.DeserializeAccessControl(domain.AccessControl)
+	if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(locoRes.DeepCopy())
+	stampRequestID(ctx, locoRes)
+	locoRes.Spec.ServiceSpec.Routing.AccessControl = converter.ProtoToAccessControlSpec(accessControl)
+	if err := locoRes.Spec.Validate(); err != nil {
+		return fmt.Errorf("invalid Application spec: %w", err)
+	}
+	if err := kubeClient.ControllerClient.Patch(ctx, locoRes, patch); err != nil {
+		slog.ErrorContext(ctx, "failed to patch Application", "error", err, "resourceId", domain.ResourceID)
+		return err
+	}
+
+	slog.InfoContext(ctx, "updated Application access control", "resourceId", domain.ResourceID, "domain", domain.Domain)
+	return nil
+}
+
+// setLocoResourceTLSPolicy applies a domain's TLS policy to the resource's Application when that
+// domain is the hostname it routes. Other domains take effect on their next deployment.
+func setLocoResourceTLSPolicy(ctx context.Context, kubeClient *kube.Client, domain genDb.ResourceDomain, locoNamespace string) error {
+	locoRes := &locoControllerV1.Application{}
+	err := kubeClient.ControllerClient.Get(ctx, client.ObjectKey{
+		Name:      fmt.Sprintf("resource-%d", domain.ResourceID),
+		Namespace: locoNamespace,
+	}, locoRes)
+	if err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			slog.InfoContext(ctx, "no Application to update", "resourceId", domain.ResourceID)
+			return nil
+		}
+		slog.ErrorContext(ctx, "failed to get Application", "error", err, "resourceId", domain.ResourceID)
+		return err
+	}
+	if locoRes.Spec.ServiceSpec == nil || locoRes.Spec.ServiceSpec.Routing == nil || locoRes.Spec.ServiceSpec.Routing.HostName != domain.Domain {
+		return nil
+	}
+
+	tls, err := converter.DeserializeTLSPolicy(domain.Tls)
+	if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(locoRes.DeepCopy())
+	stampRequestID(ctx, locoRes)
+	locoRes.Spec.ServiceSpec.Routing.TLS = converter.ProtoToTLSSpec(tls)
+	if err := locoRes.Spec.Validate(); err != nil {
+		return fmt.Errorf("invalid Application spec: %w", err)
+	}
+	if err := kubeClient.ControllerClient.Patch(ctx, locoRes, patch); err != nil {
+		slog.ErrorContext(ctx, "failed to patch Application", "error", err, "resourceId", domain.ResourceID)
+		return err
+	}
+
+	slog.InfoContext(ctx, "updated Application TLS policy", "resourceId", domain.ResourceID, "domain", domain.Domain)
+	return nil
+}
+
+// domainRedirects returns the redirects of a resource's domains other than the routed one
+func domainRedirects(ctx context.Context, queries genDb.Querier, resourceID int64, routed string) ([]locoControllerV1.RedirectSpec, error) {
+	domains, err := queries.ListResourceDomains(ctx, resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource domains: %w", err)
+	}
+	var redirects []locoControllerV1.RedirectSpec
+	for _, d := range domains {
+		if d.Domain == routed {
+			continue
+		}
+		redirect, err := converter.DeserializeRedirect(d.Redirect)
+		if err != nil {
+			return nil, err
+		}
+		if redirect != nil {
+			redirects = append(redirects, converter.ProtoToRedirectSpec(d.Domain, redirect))
+		}
+	}
+	return redirects, nil
+}
+
+// setLocoResourceRedirects updates the redirects on a resource's live Application, if there is one
+func setLocoResourceRedirects(ctx context.Context, kubeClient *kube.Client, queries genDb.Querier, resourceID int64, locoNamespace string) error {
+	locoRes := &locoControllerV1.Application{}
+	err := kubeClient.ControllerClient.Get(ctx, client.ObjectKey{
+		Name:      fmt.Sprintf("resource-%d", resourceID),
+		Namespace: locoNamespace,
+	}, locoRes)
+	if err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			slog.InfoContext(ctx, "no Application to update", "resourceId", resourceID)
+			return nil
+		}
+		slog.ErrorContext(ctx, "failed to get Application", "error", err, "resourceId", resourceID)
+		return err
+	}
+	if locoRes.Spec.ServiceSpec == nil || locoRes.Spec.ServiceSpec.Routing == nil {
+		return nil
+	}
+
+	redirects, err := domainRedirects(ctx, queries, resourceID, locoRes.Spec.ServiceSpec.Routing.HostName)
+	if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(locoRes.DeepCopy())
+	stampRequestID(ctx, locoRes)
+	locoRes.Spec.ServiceSpec.Routing.Redirects = redirects
+	if err := locoRes.Spec.Validate(); err != nil {
+		return fmt.Errorf("invalid Application spec: %w", err)
+	}
+	if err := kubeClient.ControllerClient.Patch(ctx, locoRes, patch); err != nil {
+		slog.ErrorContext(ctx, "failed to patch Application", "error", err, "resourceId", resourceID)
+		return err
+	}
+
+	slog.InfoContext(ctx, "updated Application redirects", "resourceId", resourceID, "redirects", len(redirects))
+	return nil
+}
+
 // deleteLocoResource deletes a Application from the loco-system namespace
 func deleteLocoResource(ctx context.Context, kubeClient *kube.Client, resourceID int64, locoNamespace string) error {
 	locoRes := &locoControllerV1.Application{
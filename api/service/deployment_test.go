@@ -0,0 +1,73 @@
+package service
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/testutil"
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+)
+
+func TestResolveScaleTriggers(t *testing.T) {
+	w := newResourceWorld(t)
+	ctx := testutil.Context(t)
+	server := NewDeploymentServer(w.db, w.store, testutil.NewVendingMachine(t, w.store), testutil.NewKubeClient(), "loco-system", nil, nil, nil, nil)
+
+	newResource := func(name string, workspaceID int64, resourceType genDb.ResourceType) genDb.Resource {
+		t.Helper()
+		id, err := w.store.CreateResource(ctx, genDb.CreateResourceParams{WorkspaceID: workspaceID, Name: name, Type: resourceType})
+		if err != nil {
+			t.Fatalf("unexpected error creating resource: %v", err)
+		}
+		resource, err := w.store.GetResourceByID(ctx, id)
+		if err != nil {
+			t.Fatalf("unexpected error getting resource: %v", err)
+		}
+		return resource
+	}
+	service := newResource("api", w.workspaceID, genDb.ResourceTypeService)
+	queue := newResource("jobs", w.workspaceID, genDb.ResourceTypeQueue)
+	otherWorkspaceID, err := w.store.CreateWorkspace(ctx, genDb.CreateWorkspaceParams{OrgID: w.orgID, Name: "other", CreatedBy: w.userID})
+	if err != nil {
+		t.Fatalf("unexpected error creating workspace: %v", err)
+	}
+	otherQueue := newResource("payments", otherWorkspaceID, genDb.ResourceTypeQueue)
+
+	tests := []struct {
+		name          string
+		trigger       *deploymentv1.ScaleTrigger
+		wantErr       bool
+		wantQueueName string
+	}{
+		{"workload", &deploymentv1.ScaleTrigger{Type: "kubernetes-workload", Metadata: map[string]string{"podSelector": "app=worker", "value": "2"}}, false, ""},
+		{"linked queue", &deploymentv1.ScaleTrigger{Type: "rabbitmq", Metadata: map[string]string{"value": "20"}, QueueResourceId: &queue.ID}, false, "jobs"},
+		{"linked queue without metadata", &deploymentv1.ScaleTrigger{Type: "rabbitmq", QueueResourceId: &queue.ID}, false, "jobs"},
+		{"linked queue naming itself", &deploymentv1.ScaleTrigger{Type: "rabbitmq", Metadata: map[string]string{"queueName": "jobs"}, QueueResourceId: &queue.ID}, false, "jobs"},
+		{"linked queue naming another queue", &deploymentv1.ScaleTrigger{Type: "rabbitmq", Metadata: map[string]string{"queueName": "payments"}, QueueResourceId: &queue.ID}, true, ""},
+		{"no type", &deploymentv1.ScaleTrigger{}, true, ""},
+		{"unsupported type", &deploymentv1.ScaleTrigger{Type: "metrics-api", Metadata: map[string]string{"url": "http://10.0.0.1/metrics"}}, true, ""},
+		{"host key", &deploymentv1.ScaleTrigger{Type: "rabbitmq", Metadata: map[string]string{"host": "amqp://10.0.0.1"}, QueueResourceId: &queue.ID}, true, ""},
+		{"from env key", &deploymentv1.ScaleTrigger{Type: "rabbitmq", Metadata: map[string]string{"hostFromEnv": "BROKER"}, QueueResourceId: &queue.ID}, true, ""},
+		{"queue trigger without a queue", &deploymentv1.ScaleTrigger{Type: "rabbitmq", Metadata: map[string]string{"value": "20"}}, true, ""},
+		{"queue of another workspace", &deploymentv1.ScaleTrigger{Type: "rabbitmq", QueueResourceId: &otherQueue.ID}, true, ""},
+		{"linked to a service", &deploymentv1.ScaleTrigger{Type: "rabbitmq", QueueResourceId: &service.ID}, true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := server.resolveScaleTriggers(ctx, service, &deploymentv1.Scalers{Triggers: []*deploymentv1.ScaleTrigger{tt.trigger}})
+			if tt.wantErr {
+				if connect.CodeOf(err) != connect.CodeInvalidArgument {
+					t.Errorf("expected an invalid argument error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := tt.trigger.GetMetadata()["queueName"]; got != tt.wantQueueName {
+				t.Errorf("expected queueName %q, got %q", tt.wantQueueName, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/digest"
+	"github.com/team-loco/loco/api/tvm/actions"
+	workspacev1 "github.com/team-loco/loco/shared/proto/workspace/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrDigestWeekInFuture = errors.New("week_of cannot be in the future")
+
+// GetWorkspaceDigest returns a workspace's digest of the week holding week_of, or its latest
+// compiled digest. Weeks the compiler has not stored yet, including the current one, are
+// summarized on the spot.
+func (s *WorkspaceServer) GetWorkspaceDigest(
+	ctx context.Context,
+	req *connect.Request[workspacev1.GetWorkspaceDigestRequest],
+) (*connect.Response[workspacev1.GetWorkspaceDigestResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetWorkspace, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to get workspace digest", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if _, err := s.queries.GetWorkspaceByIDQuery(ctx, r.GetWorkspaceId()); err != nil {
+		slog.WarnContext(ctx, "workspace not found", "id", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodeNotFound, ErrWorkspaceNotFound)
+	}
+
+	now := time.Now()
+	var row genDb.WorkspaceDigest
+	var err error
+	var weekStart time.Time
+	if r.WeekOf != nil {
+		if r.GetWeekOf().AsTime().After(now) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrDigestWeekInFuture)
+		}
+		weekStart = digest.WeekStart(r.GetWeekOf().AsTime())
+		row, err = s.queries.GetWorkspaceDigest(ctx, genDb.GetWorkspaceDigestParams{
+			WorkspaceID: r.GetWorkspaceId(),
+			WeekStart:   pgtype.Timestamptz{Time: weekStart, Valid: true},
+		})
+	} else {
+		// until the compiler gets to the workspace, the latest is last week's
+		weekStart = digest.WeekStart(now).Add(-digest.Week)
+		row, err = s.queries.GetLatestWorkspaceDigest(ctx, r.GetWorkspaceId())
+	}
+
+	var d digest.Digest
+	switch {
+	case err == nil:
+		d, err = digest.FromRow(row)
+		if err != nil {
+			slog.ErrorContext(ctx, err.Error(), "workspaceId", r.GetWorkspaceId())
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	case errors.Is(err, pgx.ErrNoRows):
+		d, err = digest.Compile(ctx, s.queries, r.GetWorkspaceId(), weekStart)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to compile workspace digest", "workspaceId", r.GetWorkspaceId(), "weekStart", weekStart, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	default:
+		slog.ErrorContext(ctx, "failed to get workspace digest", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&workspacev1.GetWorkspaceDigestResponse{
+		Digest: digestToProto(d, now),
+	}), nil
+}
+
+func digestToProto(d digest.Digest, now time.Time) *workspacev1.WorkspaceDigest {
+	resources := make([]*workspacev1.DigestResource, 0, len(d.TopResources))
+	for _, resource := range d.TopResources {
+		resources = append(resources, &workspacev1.DigestResource{
+			ResourceId:   resource.ResourceID,
+			Name:         resource.Name,
+			ReplicaHours: resource.ReplicaHours,
+			CostMicros:   resource.CostMicros,
+			Currency:     resource.Currency,
+		})
+	}
+	return &workspacev1.WorkspaceDigest{
+		WorkspaceId:       d.WorkspaceID,
+		WeekStart:         timestamppb.New(d.WeekStart),
+		WeekEnd:           timestamppb.New(d.WeekEnd()),
+		Deployments:       d.Deployments,
+		FailedDeployments: d.FailedDeployments,
+		FailureRate:       d.FailureRate(),
+		TopResources:      resources,
+		Partial:           d.WeekEnd().After(now),
+	}
+}
@@ -2,19 +2,33 @@ package service
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
+	"slices"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
 	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/converter"
+	"github.com/team-loco/loco/api/pkg/customdomain"
+	"github.com/team-loco/loco/api/pkg/dnsrecord"
+	"github.com/team-loco/loco/api/pkg/kube"
 	"github.com/team-loco/loco/api/tvm"
 	"github.com/team-loco/loco/api/tvm/actions"
 	domainv1 "github.com/team-loco/loco/shared/proto/domain/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 var (
@@ -22,16 +36,25 @@ var (
 	ErrDomainAlreadyExists    = errors.New("domain already exists")
 	ErrCannotRemovePrimary    = errors.New("cannot remove primary domain")
 	ErrCannotRemoveOnly       = errors.New("cannot remove resource's only domain")
+	ErrInvalidAccessControl   = errors.New("invalid access control")
+	ErrInvalidCustomDomain    = errors.New("invalid custom domain")
+	ErrInvalidRedirect        = errors.New("invalid redirect")
+	ErrInvalidTLSPolicy       = errors.New("invalid TLS policy")
 )
 
+// ingressLookupTimeout bounds resolving a resource's ingress for setup instructions
+const ingressLookupTimeout = 5 * time.Second
+
 type DomainServer struct {
-	db      *pgxpool.Pool
-	queries genDb.Querier
-	machine *tvm.VendingMachine
+	db            apiDb.Beginner
+	queries       genDb.Querier
+	machine       *tvm.VendingMachine
+	kubeClient    *kube.Client
+	locoNamespace string
 }
 
-func NewDomainServer(db *pgxpool.Pool, queries genDb.Querier, machine *tvm.VendingMachine) *DomainServer {
-	return &DomainServer{db: db, queries: queries, machine: machine}
+func NewDomainServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine, kubeClient *kube.Client, locoNamespace string) *DomainServer {
+	return &DomainServer{db: db, queries: queries, machine: machine, kubeClient: kubeClient, locoNamespace: locoNamespace}
 }
 
 // CreatePlatformDomain creates a new platform domain (admin only)
@@ -41,7 +64,7 @@ func (s *DomainServer) CreatePlatformDomain(
 ) (*connect.Response[domainv1.CreatePlatformDomainResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -152,7 +175,7 @@ func (s *DomainServer) UpdatePlatformDomain(
 ) (*connect.Response[domainv1.UpdatePlatformDomainResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -190,7 +213,7 @@ func (s *DomainServer) DeletePlatformDomain(
 ) (*connect.Response[domainv1.DeletePlatformDomainResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -221,7 +244,7 @@ func (s *DomainServer) ListLocoOwnedDomains(
 	ctx context.Context,
 	req *connect.Request[domainv1.ListLocoOwnedDomainsRequest],
 ) (*connect.Response[domainv1.ListLocoOwnedDomainsResponse], error) {
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -261,7 +284,7 @@ func (s *DomainServer) CreateResourceDomain(
 ) (*connect.Response[domainv1.CreateResourceDomainResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -279,6 +302,7 @@ func (s *DomainServer) CreateResourceDomain(
 	var subdomainLabel pgtype.Text
 	platformDomainID := pgtype.Int8{Valid: false}
 	domainSource := genDb.DomainSourceUserProvided
+	status := genDb.DomainStatusPending
 
 	if r.GetDomain().GetDomainSource() == domainv1.DomainType_DOMAIN_TYPE_PLATFORM_PROVIDED {
 		if r.GetDomain().GetSubdomain() == "" {
@@ -297,42 +321,83 @@ func (s *DomainServer) CreateResourceDomain(
 		fullDomain = r.GetDomain().GetSubdomain() + "." + platformDomain.Domain
 		subdomainLabel = pgtype.Text{String: r.GetDomain().GetSubdomain(), Valid: true}
 		domainSource = genDb.DomainSourcePlatformProvided
+		status = genDb.DomainStatusActive
 	} else {
 		if r.GetDomain().GetDomain() == "" {
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("domain required for user-provided domains"))
 		}
-		fullDomain = r.GetDomain().GetDomain()
+		fullDomain = normalizeDomain(r.GetDomain().GetDomain())
+		if err := s.checkCustomDomain(ctx, fullDomain); err != nil {
+			return nil, err
+		}
 	}
 
-	// check domain availability
-	available, err := s.queries.CheckDomainAvailability(ctx, fullDomain)
-	if err != nil {
+	// adding a domain the resource already has is a no-op, so retries are safe
+	existing, err := s.queries.GetResourceDomainByName(ctx, fullDomain)
+	if err == nil {
+		if existing.ResourceID != r.GetResourceId() {
+			return nil, connect.NewError(connect.CodeAlreadyExists, ErrDomainAlreadyExists)
+		}
+		return connect.NewResponse(&domainv1.CreateResourceDomainResponse{
+			DomainId: existing.ID,
+			Domain:   resourceDomainToListProto([]genDb.ResourceDomain{existing})[0],
+		}), nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
-	if !available {
-		return nil, connect.NewError(connect.CodeAlreadyExists, ErrDomainAlreadyExists)
+
+	// the first domain of a resource becomes its primary. The resource row stays locked from the count to
+	// the commit, so a concurrent add waits and then counts this domain instead of also claiming primary.
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
+	defer tx.Rollback(ctx)
+	qtx := apiDb.QueriesFor(tx)
 
-	// check if this is the first domain for the resource
-	count, err := s.queries.GetResourceDomainCount(ctx, r.ResourceId)
+	if err := qtx.LockResourceDomains(ctx, r.GetResourceId()); err != nil {
+		slog.ErrorContext(ctx, "failed to lock resource", "resourceId", r.GetResourceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	count, err := qtx.GetResourceDomainCount(ctx, r.GetResourceId())
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
-	resourceDomain, err := s.queries.CreateResourceDomain(ctx, genDb.CreateResourceDomainParams{
+	resourceDomain, err := qtx.CreateResourceDomain(ctx, genDb.CreateResourceDomainParams{
 		ResourceID:       r.GetResourceId(),
 		Domain:           fullDomain,
 		DomainSource:     domainSource,
 		SubdomainLabel:   subdomainLabel,
 		PlatformDomainID: platformDomainID,
-		IsPrimary:        count == 0, // first domain is primary
+		IsPrimary:        count == 0,
+		Status:           status,
 	})
+	if isPgConstraintViolation(err) {
+		// the same domain was added at the same time
+		return nil, connect.NewError(connect.CodeAlreadyExists, ErrDomainAlreadyExists)
+	}
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit resource domain creation", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	created, err := s.queries.GetResourceDomainByID(ctx, resourceDomain)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read back created resource domain", "id", resourceDomain, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	recordResourceActivity(ctx, s.queries, created.ResourceID, genDb.ResourceActivityKindDomainChanged, "Added domain "+created.Domain)
+
 	return connect.NewResponse(&domainv1.CreateResourceDomainResponse{
 		DomainId: resourceDomain,
+		Domain:   resourceDomainToListProto([]genDb.ResourceDomain{created})[0],
 	}), nil
 }
 
@@ -349,7 +414,7 @@ func (s *DomainServer) UpdateResourceDomain(
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("domain not found"))
 	}
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -360,6 +425,21 @@ func (s *DomainServer) UpdateResourceDomain(
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
+	fields, err := resourceDomainUpdateMask.parse(r.GetUpdateMask())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if fields.has("domain", r.GetDomain() != "") && r.GetDomain() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("domain cannot be empty"))
+	}
+	if r.GetDomain() != "" && domainRow.DomainSource == genDb.DomainSourceUserProvided {
+		normalized := normalizeDomain(r.GetDomain())
+		r.Domain = &normalized
+		if err := s.checkCustomDomain(ctx, normalized); err != nil {
+			return nil, err
+		}
+	}
+
 	// check if new domain is available (unless it's the same domain)
 	if r.GetDomain() != "" && r.GetDomain() != domainRow.Domain {
 		available, err := s.queries.CheckDomainAvailability(ctx, r.GetDomain())
@@ -379,10 +459,19 @@ func (s *DomainServer) UpdateResourceDomain(
 			slog.ErrorContext(ctx, "failed to update resource domain", "id", r.GetDomainId(), "error", err)
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 		}
+		recordResourceActivity(ctx, s.queries, domainRow.ResourceID, genDb.ResourceActivityKindDomainChanged,
+			fmt.Sprintf("Changed domain %s to %s", domainRow.Domain, r.GetDomain()))
+	}
+
+	updated, err := s.queries.GetResourceDomainByID(ctx, r.GetDomainId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read back updated resource domain", "id", r.GetDomainId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
 	return connect.NewResponse(&domainv1.UpdateResourceDomainResponse{
 		DomainId: r.GetDomainId(),
+		Domain:   resourceDomainToListProto([]genDb.ResourceDomain{updated})[0],
 	}), nil
 }
 
@@ -393,7 +482,7 @@ func (s *DomainServer) SetPrimaryResourceDomain(
 ) (*connect.Response[domainv1.SetPrimaryResourceDomainResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -403,14 +492,23 @@ func (s *DomainServer) SetPrimaryResourceDomain(
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
+	// unsetting and setting happen together, so an unknown domain leaves the current primary in place
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+	qtx := apiDb.QueriesFor(tx)
+
 	// unset primary on all other domains
-	err := s.queries.UpdateResourceDomainPrimary(ctx, r.GetResourceId())
+	err = qtx.UpdateResourceDomainPrimary(ctx, r.GetResourceId())
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
 	// set this domain as primary
-	_, err = s.queries.SetResourceDomainPrimary(ctx, genDb.SetResourceDomainPrimaryParams{
+	_, err = qtx.SetResourceDomainPrimary(ctx, genDb.SetResourceDomainPrimaryParams{
 		ID:         r.GetDomainId(),
 		ResourceID: r.GetResourceId(),
 	})
@@ -418,6 +516,16 @@ func (s *DomainServer) SetPrimaryResourceDomain(
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("domain not found or does not belong to resource"))
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit primary domain change", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	summary := "Changed primary domain"
+	if primary, err := s.queries.GetResourceDomainByID(ctx, r.GetDomainId()); err == nil {
+		summary += " to " + primary.Domain
+	}
+	recordResourceActivity(ctx, s.queries, r.GetResourceId(), genDb.ResourceActivityKindDomainChanged, summary)
+
 	return connect.NewResponse(&domainv1.SetPrimaryResourceDomainResponse{
 		ResourceId: r.GetResourceId(),
 		DomainId:   r.GetDomainId(),
@@ -437,7 +545,7 @@ func (s *DomainServer) DeleteResourceDomain(
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("domain not found"))
 	}
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -447,27 +555,443 @@ func (s *DomainServer) DeleteResourceDomain(
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
+	// check the domain can go before asking anyone to approve it
+	if err := s.checkResourceDomainRemovable(ctx, domainRow); err != nil {
+		return nil, err
+	}
+
+	resource, err := s.queries.GetResourceByID(ctx, domainRow.ResourceID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	production, err := isProductionResource(ctx, s.queries, resource)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if production {
+		return nil, requireApproval(ctx, s.queries, genDb.ApprovalActionDeleteResourceDomain, domainRow.ID, resource.WorkspaceID)
+	}
+
+	if err := s.deleteResourceDomain(ctx, domainRow); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&domainv1.DeleteResourceDomainResponse{}), nil
+}
+
+// checkResourceDomainRemovable makes sure removing a domain leaves its resource reachable
+func (s *DomainServer) checkResourceDomainRemovable(ctx context.Context, domainRow genDb.ResourceDomain) error {
 	// cannot remove primary domain
 	if domainRow.IsPrimary {
-		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrCannotRemovePrimary)
+		return connect.NewError(connect.CodeFailedPrecondition, ErrCannotRemovePrimary)
 	}
 
 	// cannot remove if it's the only domain
 	count, err := s.queries.GetResourceDomainCount(ctx, domainRow.ResourceID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 	if count <= 1 {
-		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrCannotRemoveOnly)
+		return connect.NewError(connect.CodeFailedPrecondition, ErrCannotRemoveOnly)
+	}
+	return nil
+}
+
+// deleteResourceDomain deletes a domain once it is still safe to remove
+func (s *DomainServer) deleteResourceDomain(ctx context.Context, domainRow genDb.ResourceDomain) error {
+	if err := s.checkResourceDomainRemovable(ctx, domainRow); err != nil {
+		return err
+	}
+	if err := s.queries.DeleteResourceDomain(ctx, domainRow.ID); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	// a removed domain stops redirecting too
+	if domainRow.Redirect != nil {
+		if err := setLocoResourceRedirects(ctx, s.kubeClient, s.queries, domainRow.ResourceID, s.locoNamespace); err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to remove redirect: %w", err))
+		}
+	}
+	recordResourceActivity(ctx, s.queries, domainRow.ResourceID, genDb.ResourceActivityKindDomainChanged, "Removed domain "+domainRow.Domain)
+	return nil
+}
+
+// SetResourceDomainAccessControl replaces or clears the access control on a resource domain.
+// When the domain is the one routed to the live Application, the change is applied right away.
+func (s *DomainServer) SetResourceDomainAccessControl(
+	ctx context.Context,
+	req *connect.Request[domainv1.SetResourceDomainAccessControlRequest],
+) (*connect.Response[domainv1.SetResourceDomainAccessControlResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.SetDomainAccessControl, r.GetResourceId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
-	// delete the domain
-	err = s.queries.DeleteResourceDomain(ctx, r.GetDomainId())
+	var data []byte
+	if r.AccessControl != nil {
+		if err := validateAccessControl(r.GetAccessControl()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %w", ErrInvalidAccessControl, err))
+		}
+		stored := hashAccessControl(r.GetAccessControl())
+		var err error
+		data, err = protojson.Marshal(stored)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to marshal access control", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to marshal access control: %w", err))
+		}
+	}
+
+	domainRow, err := s.queries.SetResourceDomainAccessControl(ctx, genDb.SetResourceDomainAccessControlParams{
+		ID:            r.GetDomainId(),
+		ResourceID:    r.GetResourceId(),
+		AccessControl: data,
+	})
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("domain not found or does not belong to resource"))
+		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
-	return connect.NewResponse(&domainv1.DeleteResourceDomainResponse{}), nil
+	if err := setLocoResourceAccessControl(ctx, s.kubeClient, domainRow, s.locoNamespace); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to apply access control: %w", err))
+	}
+
+	slog.InfoContext(ctx, "updated domain access control", "resourceId", domainRow.ResourceID, "domainId", domainRow.ID, "enabled", data != nil)
+	recordResourceActivity(ctx, s.queries, domainRow.ResourceID, genDb.ResourceActivityKindDomainChanged, domainSettingSummary("access control", domainRow.Domain, data != nil))
+
+	return connect.NewResponse(&domainv1.SetResourceDomainAccessControlResponse{
+		Domain: resourceDomainToListProto([]genDb.ResourceDomain{domainRow})[0],
+	}), nil
+}
+
+// validateAccessControl checks an AccessControl before its secrets are hashed
+func validateAccessControl(accessControl *domainv1.AccessControl) error {
+	for _, cidr := range accessControl.GetAllowedCidrs() {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("invalid CIDR %q", cidr)
+		}
+	}
+
+	if accessControl.BasicAuth != nil && accessControl.Oidc != nil {
+		return errors.New("basic auth and OIDC cannot be combined")
+	}
+
+	if accessControl.BasicAuth != nil {
+		if len(accessControl.GetBasicAuth().GetUsers()) == 0 {
+			return errors.New("basic auth requires at least one user")
+		}
+		for _, user := range accessControl.GetBasicAuth().GetUsers() {
+			if user.GetUsername() == "" || strings.ContainsAny(user.GetUsername(), ":\n") {
+				return fmt.Errorf("invalid basic auth username %q", user.GetUsername())
+			}
+			if user.GetPassword() == "" {
+				return fmt.Errorf("basic auth user %q has no password", user.GetUsername())
+			}
+		}
+	}
+
+	if oidc := accessControl.GetOidc(); oidc != nil {
+		if !strings.HasPrefix(oidc.GetIssuer(), "https://") {
+			return errors.New("OIDC issuer must be an https URL")
+		}
+		if oidc.GetClientId() == "" || oidc.GetClientSecret() == "" {
+			return errors.New("OIDC requires a client ID and client secret")
+		}
+	}
+
+	if len(accessControl.GetAllowedCidrs()) == 0 && accessControl.BasicAuth == nil && accessControl.Oidc == nil {
+		return errors.New("at least one of allowed CIDRs, basic auth or OIDC is required")
+	}
+
+	return nil
+}
+
+// hashAccessControl returns a copy of accessControl with basic auth passwords replaced by
+// the SHA htpasswd hashes the gateway expects.
+func hashAccessControl(accessControl *domainv1.AccessControl) *domainv1.AccessControl {
+	stored := proto.CloneOf(accessControl)
+	for _, user := range stored.GetBasicAuth().GetUsers() {
+		sum := sha1.Sum([]byte(user.GetPassword()))
+		user.Password = "{SHA}" + base64.StdEncoding.EncodeToString(sum[:])
+	}
+	return stored
+}
+
+// redactAccessControl returns a copy of accessControl without passwords or client secrets
+func redactAccessControl(accessControl *domainv1.AccessControl) *domainv1.AccessControl {
+	redacted := proto.CloneOf(accessControl)
+	for _, user := range redacted.GetBasicAuth().GetUsers() {
+		user.Password = ""
+	}
+	if redacted.GetOidc() != nil {
+		redacted.Oidc.ClientSecret = ""
+	}
+	return redacted
+}
+
+// SetResourceDomainRedirect makes a resource domain redirect to another domain, e.g. www to the
+// apex, or clears its redirect. The primary domain is the one routed to the app, so it can't
+// redirect. The change is applied to the live Application right away.
+func (s *DomainServer) SetResourceDomainRedirect(
+	ctx context.Context,
+	req *connect.Request[domainv1.SetResourceDomainRedirectRequest],
+) (*connect.Response[domainv1.SetResourceDomainRedirectResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.SetDomainRedirect, r.GetResourceId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	existing, err := s.queries.GetResourceDomainByID(ctx, r.GetDomainId())
+	if err != nil || existing.ResourceID != r.GetResourceId() {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("domain not found or does not belong to resource"))
+	}
+
+	var data []byte
+	if r.Redirect != nil {
+		if existing.IsPrimary {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("the primary domain serves the app and cannot redirect"))
+		}
+		redirect := r.GetRedirect()
+		redirect.TargetDomain = normalizeDomain(redirect.GetTargetDomain())
+		if err := validateRedirect(existing.Domain, redirect); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %w", ErrInvalidRedirect, err))
+		}
+		data, err = protojson.Marshal(redirect)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to marshal redirect", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to marshal redirect: %w", err))
+		}
+	}
+
+	domainRow, err := s.queries.SetResourceDomainRedirect(ctx, genDb.SetResourceDomainRedirectParams{
+		ID:         r.GetDomainId(),
+		ResourceID: r.GetResourceId(),
+		Redirect:   data,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("domain not found or does not belong to resource"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := setLocoResourceRedirects(ctx, s.kubeClient, s.queries, domainRow.ResourceID, s.locoNamespace); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to apply redirect: %w", err))
+	}
+
+	slog.InfoContext(ctx, "updated domain redirect", "resourceId", domainRow.ResourceID, "domainId", domainRow.ID, "enabled", data != nil)
+	recordResourceActivity(ctx, s.queries, domainRow.ResourceID, genDb.ResourceActivityKindDomainChanged, domainSettingSummary("redirect", domainRow.Domain, data != nil))
+
+	return connect.NewResponse(&domainv1.SetResourceDomainRedirectResponse{
+		Domain: resourceDomainToListProto([]genDb.ResourceDomain{domainRow})[0],
+	}), nil
+}
+
+// validateRedirect checks a redirect from domain is to another valid hostname with a status
+// code the gateway can send
+func validateRedirect(domain string, redirect *domainv1.DomainRedirect) error {
+	if errs := validation.IsDNS1123Subdomain(redirect.GetTargetDomain()); len(errs) > 0 {
+		return fmt.Errorf("invalid target domain %q: %s", redirect.GetTargetDomain(), strings.Join(errs, "; "))
+	}
+	if redirect.GetTargetDomain() == domain {
+		return fmt.Errorf("%s cannot redirect to itself", domain)
+	}
+	switch redirect.GetStatusCode() {
+	case 0, 301, 302:
+	default:
+		return fmt.Errorf("status code must be 301 or 302, got %d", redirect.GetStatusCode())
+	}
+	return nil
+}
+
+// SetResourceDomainTLSPolicy replaces or clears the TLS policy of a resource domain. When the
+// domain is the one routed to the live Application, the change is applied right away.
+func (s *DomainServer) SetResourceDomainTLSPolicy(
+	ctx context.Context,
+	req *connect.Request[domainv1.SetResourceDomainTLSPolicyRequest],
+) (*connect.Response[domainv1.SetResourceDomainTLSPolicyResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.SetDomainTLSPolicy, r.GetResourceId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	var data []byte
+	if r.Tls != nil {
+		// the controller validates the policy as it will apply it
+		if err := converter.ProtoToTLSSpec(r.GetTls()).Validate(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %w", ErrInvalidTLSPolicy, err))
+		}
+		var err error
+		data, err = protojson.Marshal(r.GetTls())
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to marshal TLS policy", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to marshal TLS policy: %w", err))
+		}
+	}
+
+	domainRow, err := s.queries.SetResourceDomainTLSPolicy(ctx, genDb.SetResourceDomainTLSPolicyParams{
+		ID:         r.GetDomainId(),
+		ResourceID: r.GetResourceId(),
+		Tls:        data,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("domain not found or does not belong to resource"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := setLocoResourceTLSPolicy(ctx, s.kubeClient, domainRow, s.locoNamespace); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to apply TLS policy: %w", err))
+	}
+
+	slog.InfoContext(ctx, "updated domain TLS policy", "resourceId", domainRow.ResourceID, "domainId", domainRow.ID, "enabled", data != nil)
+	recordResourceActivity(ctx, s.queries, domainRow.ResourceID, genDb.ResourceActivityKindDomainChanged, domainSettingSummary("TLS policy", domainRow.Domain, data != nil))
+
+	return connect.NewResponse(&domainv1.SetResourceDomainTLSPolicyResponse{
+		Domain: resourceDomainToListProto([]genDb.ResourceDomain{domainRow})[0],
+	}), nil
+}
+
+// normalizeDomain lowercases a user-provided domain and drops a trailing dot
+func normalizeDomain(domain string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+}
+
+// checkCustomDomain rejects user-provided domains that cannot be pointed at the platform:
+// malformed hostnames, IP addresses, public suffixes such as co.uk that nobody can own, and
+// names under a platform domain, which are handed out as platform-provided domains instead.
+func (s *DomainServer) checkCustomDomain(ctx context.Context, domain string) error {
+	if errs := validation.IsDNS1123Subdomain(domain); len(errs) > 0 {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %q: %s", ErrInvalidCustomDomain, domain, strings.Join(errs, "; ")))
+	}
+	if net.ParseIP(domain) != nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s is an IP address, not a domain", ErrInvalidCustomDomain, domain))
+	}
+	if _, err := customdomain.Zone(domain); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s is a public suffix, not a registrable domain", ErrInvalidCustomDomain, domain))
+	}
+
+	platformDomains, err := s.queries.ListActivePlatformDomains(ctx)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	for _, platformDomain := range platformDomains {
+		if domain == platformDomain.Domain || strings.HasSuffix(domain, "."+platformDomain.Domain) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s is under the platform domain %s; add it as a platform-provided domain", ErrInvalidCustomDomain, domain, platformDomain.Domain))
+		}
+	}
+	return nil
+}
+
+// GetDomainSetupInstructions returns the DNS records a user-provided domain needs to reach its
+// resource, tailored to each DNS provider. Apex domains cannot use a CNAME at most providers, so
+// they get an ALIAS where the provider has one and A records for the ingress's current addresses
+// where it does not.
+func (s *DomainServer) GetDomainSetupInstructions(
+	ctx context.Context,
+	req *connect.Request[domainv1.GetDomainSetupInstructionsRequest],
+) (*connect.Response[domainv1.GetDomainSetupInstructionsResponse], error) {
+	r := req.Msg
+
+	domainRow, err := s.queries.GetResourceDomainByID(ctx, r.GetDomainId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("domain not found"))
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetDomainSetupInstructions, domainRow.ResourceID)); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if domainRow.DomainSource == genDb.DomainSourcePlatformProvided {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("platform-provided domains are set up by the platform"))
+	}
+	providers := customdomain.Providers
+	if r.Provider != nil {
+		if !slices.Contains(customdomain.Providers, r.GetProvider()) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("provider must be one of %s", strings.Join(customdomain.Providers, ", ")))
+		}
+		providers = []string{r.GetProvider()}
+	}
+
+	endpoint, err := s.queries.GetResourceIngressEndpoint(ctx, domainRow.ResourceID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		slog.ErrorContext(ctx, "failed to get ingress endpoint", "resourceId", domainRow.ResourceID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if !endpoint.Valid {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("the resource's region has no ingress to point the domain at yet"))
+	}
+
+	target := dnsrecord.RecordFor(domainRow.Domain, endpoint.String, 0)
+	var addresses []string
+	if target.Type == dnsrecord.TypeCNAME && customdomain.IsApex(domainRow.Domain) {
+		lookupCtx, cancel := context.WithTimeout(ctx, ingressLookupTimeout)
+		addresses, err = net.DefaultResolver.LookupHost(lookupCtx, target.Target)
+		cancel()
+		if err != nil {
+			slog.WarnContext(ctx, "failed to resolve ingress", "ingress", target.Target, "error", err)
+		}
+		slices.Sort(addresses)
+	}
+
+	resp := &domainv1.GetDomainSetupInstructionsResponse{
+		Domain:        domainRow.Domain,
+		Apex:          customdomain.IsApex(domainRow.Domain),
+		Target:        target.Target,
+		Status:        domainStatusToProto(domainRow.Status),
+		StatusMessage: domainRow.StatusMessage,
+	}
+	for _, instructions := range customdomain.Setup(domainRow.Domain, endpoint.String, providers, addresses) {
+		protoInstructions := &domainv1.ProviderSetupInstructions{Provider: instructions.Provider, Note: instructions.Note}
+		for _, record := range instructions.Records {
+			protoInstructions.Records = append(protoInstructions.Records, &domainv1.DnsRecordInstruction{
+				Type:  record.Type,
+				Name:  record.Name,
+				Value: record.Value,
+			})
+		}
+		resp.Providers = append(resp.Providers, protoInstructions)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func domainStatusToProto(status genDb.DomainStatus) domainv1.DomainStatus {
+	switch status {
+	case genDb.DomainStatusPending:
+		return domainv1.DomainStatus_DOMAIN_STATUS_PENDING
+	case genDb.DomainStatusActive:
+		return domainv1.DomainStatus_DOMAIN_STATUS_ACTIVE
+	default:
+		return domainv1.DomainStatus_DOMAIN_STATUS_UNSPECIFIED
+	}
 }
 
 // CheckDomainAvailability checks if a domain is available
@@ -494,3 +1018,11 @@ func (s *DomainServer) CheckDomainAvailability(
 		},
 	}, nil
 }
+
+// domainSettingSummary describes turning a domain setting on or off for the resource's timeline
+func domainSettingSummary(setting, domain string, enabled bool) string {
+	if enabled {
+		return fmt.Sprintf("Set %s on %s", setting, domain)
+	}
+	return fmt.Sprintf("Removed %s from %s", setting, domain)
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/testutil"
+	domainv1 "github.com/team-loco/loco/shared/proto/domain/v1"
+)
+
+func TestCreateResourceDomainPrimary(t *testing.T) {
+	const resourceID = 7
+	store := testutil.NewStore()
+	var domains []genDb.ResourceDomain
+	store.GetResourceDomainByNameFunc = func(ctx context.Context, domain string) (genDb.ResourceDomain, error) {
+		return genDb.ResourceDomain{}, pgx.ErrNoRows
+	}
+	store.ListActivePlatformDomainsFunc = func(ctx context.Context) ([]genDb.PlatformDomain, error) {
+		return nil, nil
+	}
+	store.LockResourceDomainsFunc = func(ctx context.Context, id int64) error {
+		return nil
+	}
+	store.GetResourceDomainCountFunc = func(ctx context.Context, id int64) (int64, error) {
+		return int64(len(domains)), nil
+	}
+	store.CreateResourceDomainFunc = func(ctx context.Context, arg genDb.CreateResourceDomainParams) (int64, error) {
+		for _, domain := range domains {
+			if domain.Domain == arg.Domain {
+				return 0, &pgconn.PgError{Code: "23505"}
+			}
+		}
+		domains = append(domains, genDb.ResourceDomain{ID: int64(len(domains) + 1), ResourceID: arg.ResourceID, Domain: arg.Domain, IsPrimary: arg.IsPrimary})
+		return int64(len(domains)), nil
+	}
+	store.GetResourceDomainByIDFunc = func(ctx context.Context, id int64) (genDb.ResourceDomain, error) {
+		return domains[id-1], nil
+	}
+	store.CreateResourceActivityFunc = func(ctx context.Context, arg genDb.CreateResourceActivityParams) error {
+		return nil
+	}
+
+	server := NewDomainServer(testutil.NewDB(store), store, testutil.NewVendingMachine(t, store), testutil.NewKubeClient(), "loco-system")
+	ctx := testutil.AsUser(testutil.Context(t), 1, testutil.Scopes(genDb.EntityTypeResource, resourceID, genDb.ScopeWrite)...)
+	add := func(domain string) (*domainv1.ResourceDomain, error) {
+		res, err := server.CreateResourceDomain(ctx, connect.NewRequest(&domainv1.CreateResourceDomainRequest{
+			ResourceId: resourceID,
+			Domain:     &domainv1.DomainInput{DomainSource: domainv1.DomainType_DOMAIN_TYPE_USER_PROVIDED, Domain: &domain},
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg.GetDomain(), nil
+	}
+
+	first, err := add("app.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error adding a domain: %v", err)
+	}
+	if !first.GetIsPrimary() {
+		t.Errorf("expected the first domain to be primary")
+	}
+	second, err := add("www.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error adding a domain: %v", err)
+	}
+	if second.GetIsPrimary() {
+		t.Errorf("expected the second domain not to be primary")
+	}
+
+	// the resource is locked before its domains are counted
+	calls := store.Calls()
+	lock, count := slices.Index(calls, "LockResourceDomains"), slices.Index(calls, "GetResourceDomainCount")
+	if lock == -1 || lock > count {
+		t.Errorf("expected LockResourceDomains before GetResourceDomainCount, got %v", calls)
+	}
+
+	// a domain added by a concurrent request between the lookup and the insert
+	if _, err := add("app.example.com"); connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Errorf("expected %v, got %v", connect.CodeAlreadyExists, err)
+	}
+}
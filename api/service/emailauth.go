@@ -0,0 +1,379 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/notify"
+	"github.com/team-loco/loco/api/tvm/providers"
+	oAuth "github.com/team-loco/loco/shared/proto/oauth/v1"
+)
+
+// purposes of emailed tokens
+const (
+	emailTokenVerify = "verify"
+	emailTokenReset  = "reset"
+)
+
+var (
+	VerifyEmailTTL   = 24 * time.Hour
+	PasswordResetTTL = time.Hour
+)
+
+var (
+	ErrEmailAuthDisabled = errors.New("email and password logins are not enabled")
+	ErrInvalidEmail      = errors.New("invalid email address")
+	ErrEmailNotVerified  = errors.New("email address is not verified; follow the link emailed to it")
+	ErrInvalidEmailToken = errors.New("link is invalid or has expired")
+)
+
+// emailAuth is what email and password logins need besides the database.
+type emailAuth struct {
+	sender notify.EmailSender
+	url    string // web app URL the emailed links point to
+}
+
+// SignUpWithEmail creates a user who logs in with an email address and password, then emails them a
+// link to verify the address. They cannot log in until they follow it. An address that already has
+// an account is emailed about it instead, and the response is the same, so signing up cannot be used
+// to find out who has an account.
+func (s *OAuthServer) SignUpWithEmail(
+	ctx context.Context,
+	req *connect.Request[oAuth.SignUpWithEmailRequest],
+) (*connect.Response[oAuth.SignUpWithEmailResponse], error) {
+	if s.email == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, ErrEmailAuthDisabled)
+	}
+	r := req.Msg
+
+	address, err := normalizeEmail(r.GetEmail())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	hash, err := providers.HashPassword(r.GetPassword())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if existing, err := s.queries.GetUserByEmail(ctx, address); err == nil {
+		s.sendAccountExistsEmail(ctx, existing)
+		return connect.NewResponse(&oAuth.SignUpWithEmailResponse{}), nil
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		slog.ErrorContext(ctx, "failed to look up user by email", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+	qtx := apiDb.QueriesFor(tx)
+
+	user, err := qtx.CreateUser(ctx, genDb.CreateUserParams{
+		ExternalID: "email:" + address,
+		Email:      address,
+		Name:       pgtype.Text{String: r.GetName(), Valid: r.GetName() != ""},
+	})
+	if isPgConstraintViolation(err) {
+		// signed up at the same time by another request
+		if existing, err := s.queries.GetUserByEmail(ctx, address); err == nil {
+			s.sendAccountExistsEmail(ctx, existing)
+		}
+		return connect.NewResponse(&oAuth.SignUpWithEmailResponse{}), nil
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create user", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if err := qtx.CreateUserPassword(ctx, genDb.CreateUserPasswordParams{UserID: user.ID, PasswordHash: hash}); err != nil {
+		slog.ErrorContext(ctx, "failed to store password", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.grantSelfRoles(ctx, user.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := s.sendEmailToken(ctx, user, emailTokenVerify); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to send verification email: %w", err))
+	}
+
+	slog.InfoContext(ctx, "created new user with email and password", "userId", user.ID)
+	return connect.NewResponse(&oAuth.SignUpWithEmailResponse{}), nil
+}
+
+// sendAccountExistsEmail tells the owner of an address someone tried to sign up with that it already
+// has an account. An account whose address was never verified gets a new verification link instead,
+// as signing up again is how its owner would retry.
+func (s *OAuthServer) sendAccountExistsEmail(ctx context.Context, user genDb.User) {
+	password, err := s.queries.GetUserPassword(ctx, user.ID)
+	if err == nil && !password.VerifiedAt.Valid {
+		if err := s.sendEmailToken(ctx, user, emailTokenVerify); err != nil {
+			slog.ErrorContext(ctx, "failed to resend verification email", "userId", user.ID, "error", err)
+		}
+		return
+	}
+
+	err = s.email.sender.SendEmail(ctx, notify.Email{
+		To:      user.Email,
+		Subject: "You already have a loco account",
+		Body: fmt.Sprintf("Someone tried to sign up for loco with this email address, which already has an account.\n\n"+
+			"If it was you, log in the way you signed up at %s/login. If you forgot your password, you can reset it there.\n\n"+
+			"If it was not you, you can ignore this email; your account has not changed.\n", s.email.url),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to send account exists email", "userId", user.ID, "error", err)
+	}
+}
+
+// VerifyEmail verifies the address a verification email was sent to and logs its user in.
+func (s *OAuthServer) VerifyEmail(
+	ctx context.Context,
+	req *connect.Request[oAuth.VerifyEmailRequest],
+) (*connect.Response[oAuth.VerifyEmailResponse], error) {
+	if s.email == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, ErrEmailAuthDisabled)
+	}
+
+	userID, err := s.consumeEmailToken(ctx, req.Msg.GetToken(), emailTokenVerify)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.queries.MarkUserPasswordVerified(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "failed to mark email verified", "userId", userID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	user, err := s.queries.GetUserByID(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get verified user", "userId", userID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	l, err := s.exchange(ctx, providers.NewEmailResponse(user.Email, nil))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to exchange verified email", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("exchange token: %w", err))
+	}
+
+	res := connect.NewResponse(&oAuth.VerifyEmailResponse{
+		LocoToken:    l.token,
+		ExpiresIn:    l.expiresIn(),
+		UserId:       l.user.ID,
+		Name:         l.user.Name.String,
+		MfaRequired:  l.mfaRequired,
+		RefreshToken: l.refreshToken,
+	})
+	l.setCookies(res.Header(), s.machine)
+
+	slog.InfoContext(ctx, "verified email", "userId", user.ID)
+	return res, nil
+}
+
+// ResendVerificationEmail sends a new verification link to an unverified address, invalidating the
+// previous one. It succeeds either way so it cannot be used to find out who has an account.
+func (s *OAuthServer) ResendVerificationEmail(
+	ctx context.Context,
+	req *connect.Request[oAuth.ResendVerificationEmailRequest],
+) (*connect.Response[oAuth.ResendVerificationEmailResponse], error) {
+	if s.email == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, ErrEmailAuthDisabled)
+	}
+
+	user, password, ok := s.userWithPassword(ctx, req.Msg.GetEmail())
+	if ok && !password.VerifiedAt.Valid {
+		if err := s.sendEmailToken(ctx, user, emailTokenVerify); err != nil {
+			slog.ErrorContext(ctx, "failed to resend verification email", "userId", user.ID, "error", err)
+		}
+	}
+	return connect.NewResponse(&oAuth.ResendVerificationEmailResponse{}), nil
+}
+
+// LoginWithEmail exchanges a verified email address and its password for a Loco token.
+func (s *OAuthServer) LoginWithEmail(
+	ctx context.Context,
+	req *connect.Request[oAuth.LoginWithEmailRequest],
+) (*connect.Response[oAuth.LoginWithEmailResponse], error) {
+	if s.email == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, ErrEmailAuthDisabled)
+	}
+	r := req.Msg
+
+	user, password, _ := s.userWithPassword(ctx, r.GetEmail())
+	emailResp := providers.Password(user.Email, password.PasswordHash, r.GetPassword())
+	if _, err := emailResp.Address(); err != nil {
+		slog.WarnContext(ctx, "failed email login", "email", r.GetEmail())
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if !password.VerifiedAt.Valid {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrEmailNotVerified)
+	}
+
+	l, err := s.exchange(ctx, emailResp)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to exchange email login", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("exchange token: %w", err))
+	}
+
+	res := connect.NewResponse(&oAuth.LoginWithEmailResponse{
+		LocoToken:    l.token,
+		ExpiresIn:    l.expiresIn(),
+		UserId:       l.user.ID,
+		Name:         l.user.Name.String,
+		MfaRequired:  l.mfaRequired,
+		RefreshToken: l.refreshToken,
+	})
+	l.setCookies(res.Header(), s.machine)
+
+	slog.InfoContext(ctx, "logged in with email and password", "userId", user.ID)
+	return res, nil
+}
+
+// RequestPasswordReset emails a password reset link to an account that has a password. It succeeds
+// either way so it cannot be used to find out who has an account.
+func (s *OAuthServer) RequestPasswordReset(
+	ctx context.Context,
+	req *connect.Request[oAuth.RequestPasswordResetRequest],
+) (*connect.Response[oAuth.RequestPasswordResetResponse], error) {
+	if s.email == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, ErrEmailAuthDisabled)
+	}
+
+	if user, _, ok := s.userWithPassword(ctx, req.Msg.GetEmail()); ok {
+		if err := s.sendEmailToken(ctx, user, emailTokenReset); err != nil {
+			slog.ErrorContext(ctx, "failed to send password reset email", "userId", user.ID, "error", err)
+		}
+	}
+	return connect.NewResponse(&oAuth.RequestPasswordResetResponse{}), nil
+}
+
+// ResetPassword replaces a password with the token from a reset email and logs out every session of
+// the user, in case the old password was compromised.
+func (s *OAuthServer) ResetPassword(
+	ctx context.Context,
+	req *connect.Request[oAuth.ResetPasswordRequest],
+) (*connect.Response[oAuth.ResetPasswordResponse], error) {
+	if s.email == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, ErrEmailAuthDisabled)
+	}
+
+	// checked before the token is spent, so a too short password can be retried with the same link
+	hash, err := providers.HashPassword(req.Msg.GetPassword())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userID, err := s.consumeEmailToken(ctx, req.Msg.GetToken(), emailTokenReset)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.queries.SetUserPasswordHash(ctx, genDb.SetUserPasswordHashParams{UserID: userID, PasswordHash: hash}); err != nil {
+		slog.ErrorContext(ctx, "failed to set password", "userId", userID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if err := s.queries.DeleteEmailTokens(ctx, genDb.DeleteEmailTokensParams{UserID: userID, Purpose: emailTokenReset}); err != nil {
+		slog.WarnContext(ctx, "failed to delete password reset tokens", "userId", userID, "error", err)
+	}
+	if err := s.machine.RevokeAllForEntity(ctx, genDb.Entity{Type: genDb.EntityTypeUser, ID: userID}); err != nil {
+		slog.ErrorContext(ctx, "failed to revoke tokens after password reset", "userId", userID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "reset password", "userId", userID)
+	return connect.NewResponse(&oAuth.ResetPasswordResponse{}), nil
+}
+
+// userWithPassword returns the user with an email address and their password, if they have one.
+func (s *OAuthServer) userWithPassword(ctx context.Context, email string) (genDb.User, genDb.UserPassword, bool) {
+	address, err := normalizeEmail(email)
+	if err != nil {
+		return genDb.User{}, genDb.UserPassword{}, false
+	}
+	user, err := s.queries.GetUserByEmail(ctx, address)
+	if err != nil {
+		return genDb.User{}, genDb.UserPassword{}, false
+	}
+	password, err := s.queries.GetUserPassword(ctx, user.ID)
+	if err != nil {
+		return user, genDb.UserPassword{}, false
+	}
+	return user, password, true
+}
+
+// sendEmailToken emails a user a link with a new token for purpose, invalidating the ones sent before.
+func (s *OAuthServer) sendEmailToken(ctx context.Context, user genDb.User, purpose string) error {
+	if err := s.queries.DeleteEmailTokens(ctx, genDb.DeleteEmailTokensParams{UserID: user.ID, Purpose: purpose}); err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	token, hash, err := providers.NewEmailToken()
+	if err != nil {
+		return err
+	}
+
+	ttl, path, subject, intro := VerifyEmailTTL, "/verify-email", "Verify your loco email address",
+		"Follow this link to verify your email address and finish signing up for loco:"
+	if purpose == emailTokenReset {
+		ttl, path, subject, intro = PasswordResetTTL, "/reset-password", "Reset your loco password",
+			"Someone asked to reset the password of your loco account. If it was you, follow this link to choose a new one:"
+	}
+
+	if err := s.queries.CreateEmailToken(ctx, genDb.CreateEmailTokenParams{
+		TokenHash: hash,
+		UserID:    user.ID,
+		Purpose:   purpose,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(ttl), Valid: true},
+	}); err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+
+	link := s.email.url + path + "?token=" + url.QueryEscape(token)
+	return s.email.sender.SendEmail(ctx, notify.Email{
+		To:      user.Email,
+		Subject: subject,
+		Body:    fmt.Sprintf("%s\n\n%s\n\nThe link expires in %s. If you did not ask for this email, you can ignore it.\n", intro, link, ttl),
+	})
+}
+
+// consumeEmailToken spends an emailed token, returning the user it was sent to.
+func (s *OAuthServer) consumeEmailToken(ctx context.Context, token, purpose string) (int64, error) {
+	if token == "" {
+		return 0, connect.NewError(connect.CodeInvalidArgument, errors.New("token is required"))
+	}
+	userID, err := s.queries.ConsumeEmailToken(ctx, genDb.ConsumeEmailTokenParams{
+		TokenHash: providers.HashEmailToken(token),
+		Purpose:   purpose,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, connect.NewError(connect.CodeInvalidArgument, ErrInvalidEmailToken)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to consume email token", "error", err)
+		return 0, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return userID, nil
+}
+
+// normalizeEmail checks an address is a bare email address and lowercases it.
+func normalizeEmail(email string) (string, error) {
+	addr, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil || addr.Name != "" || addr.Address != strings.TrimSpace(email) {
+		return "", ErrInvalidEmail
+	}
+	return strings.ToLower(addr.Address), nil
+}
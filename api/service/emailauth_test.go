@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/notify"
+	"github.com/team-loco/loco/api/testutil"
+	oAuth "github.com/team-loco/loco/shared/proto/oauth/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// sentEmails records the emails it is asked to send
+type sentEmails struct {
+	mu     sync.Mutex
+	emails []notify.Email
+}
+
+func (s *sentEmails) SendEmail(ctx context.Context, email notify.Email) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.emails = append(s.emails, email)
+	return nil
+}
+
+func TestSignUpWithEmail(t *testing.T) {
+	tests := []struct {
+		name        string
+		existing    bool
+		password    *genDb.UserPassword // of the existing user
+		wantSubject string
+	}{
+		{"new address", false, nil, "Verify your loco email address"},
+		{"verified password account", true, &genDb.UserPassword{VerifiedAt: pgtype.Timestamptz{Valid: true}}, "You already have a loco account"},
+		{"account without a password", true, nil, "You already have a loco account"},
+		{"unverified password account", true, &genDb.UserPassword{}, "Verify your loco email address"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testutil.Context(t)
+			store := testutil.NewStore()
+			var existingID int64
+			if tt.existing {
+				user, err := store.CreateUser(ctx, genDb.CreateUserParams{ExternalID: "github:1", Email: "dev@loco-testing.com"})
+				if err != nil {
+					t.Fatalf("unexpected error creating user: %v", err)
+				}
+				existingID = user.ID
+			}
+			var createdPassword bool
+			store.CreateUserPasswordFunc = func(ctx context.Context, arg genDb.CreateUserPasswordParams) error {
+				createdPassword = true
+				return nil
+			}
+			store.GetUserPasswordFunc = func(ctx context.Context, userID int64) (genDb.UserPassword, error) {
+				if tt.password == nil || userID != existingID {
+					return genDb.UserPassword{}, pgx.ErrNoRows
+				}
+				return *tt.password, nil
+			}
+			store.DeleteEmailTokensFunc = func(ctx context.Context, arg genDb.DeleteEmailTokensParams) error { return nil }
+			store.CreateEmailTokenFunc = func(ctx context.Context, arg genDb.CreateEmailTokenParams) error { return nil }
+
+			sender := &sentEmails{}
+			server, err := NewOAuthServer(testutil.NewDB(store), store, nil, testutil.NewVendingMachine(t, store), sender, OAuthConfig{EmailAuth: true, EmailAuthURL: "https://loco.test"})
+			if err != nil {
+				t.Fatalf("unexpected error creating server: %v", err)
+			}
+
+			res, err := server.SignUpWithEmail(ctx, connect.NewRequest(&oAuth.SignUpWithEmailRequest{Email: "Dev@loco-testing.com", Password: "correct horse battery staple"}))
+			if err != nil {
+				t.Fatalf("expected sign up to succeed, got %v", err)
+			}
+			if !proto.Equal(res.Msg, &oAuth.SignUpWithEmailResponse{}) {
+				t.Errorf("expected the same empty response for every address, got %v", res.Msg)
+			}
+			if createdPassword == tt.existing {
+				t.Errorf("expected a password to be created=%v, got %v", !tt.existing, createdPassword)
+			}
+			if len(sender.emails) != 1 {
+				t.Fatalf("expected 1 email, got %d", len(sender.emails))
+			}
+			email := sender.emails[0]
+			if email.To != "dev@loco-testing.com" || email.Subject != tt.wantSubject {
+				t.Errorf("expected %q to dev@loco-testing.com, got %q to %s", tt.wantSubject, email.Subject, email.To)
+			}
+			if tt.existing && tt.password != nil && tt.password.VerifiedAt.Valid && strings.Contains(email.Body, "token=") {
+				t.Errorf("expected no login link for an existing account, got %q", email.Body)
+			}
+		})
+	}
+}
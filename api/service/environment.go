@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	environmentv1 "github.com/team-loco/loco/shared/proto/environment/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrEnvironmentNotFound  = errors.New("environment not found")
+	ErrEnvironmentNotEmpty  = errors.New("environment still has resources")
+	ErrInvalidEnvironment   = errors.New("environment name must be 1-32 lowercase letters, digits or dashes")
+	ErrEnvironmentWorkspace = errors.New("environment does not belong to workspace")
+)
+
+// environmentNamePattern matches names like "dev", "staging" or "prod-eu"
+var environmentNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,30}[a-z0-9])?$`)
+
+// envVarNamePattern matches the env var names the controller accepts
+var envVarNamePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+type EnvironmentServer struct {
+	db      apiDb.Beginner
+	queries genDb.Querier
+	machine *tvm.VendingMachine
+}
+
+func NewEnvironmentServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine) *EnvironmentServer {
+	return &EnvironmentServer{db: db, queries: queries, machine: machine}
+}
+
+// CreateEnvironment creates an environment in a workspace
+func (s *EnvironmentServer) CreateEnvironment(
+	ctx context.Context,
+	req *connect.Request[environmentv1.CreateEnvironmentRequest],
+) (*connect.Response[environmentv1.CreateEnvironmentResponse], error) {
+	r := req.Msg
+
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.CreateEnvironment, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to create environment", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if !environmentNamePattern.MatchString(r.GetName()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidEnvironment)
+	}
+
+	env, err := marshalEnvironmentEnv(r.GetEnv())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	params := genDb.CreateEnvironmentParams{
+		WorkspaceID: r.GetWorkspaceId(),
+		Name:        r.GetName(),
+		Description: r.GetDescription(),
+		Env:         env,
+	}
+	if entity.Type == genDb.EntityTypeUser {
+		params.CreatedBy = pgtype.Int8{Int64: entity.ID, Valid: true}
+	}
+
+	environment, err := s.queries.CreateEnvironment(ctx, params)
+	if err != nil {
+		if isPgConstraintViolation(err) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("an environment with this name already exists in this workspace"))
+		}
+		slog.ErrorContext(ctx, "failed to create environment", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "created environment", "environmentId", environment.ID, "workspaceId", environment.WorkspaceID, "name", environment.Name)
+
+	return connect.NewResponse(&environmentv1.CreateEnvironmentResponse{
+		Environment: environmentToProto(environment),
+	}), nil
+}
+
+// GetEnvironment retrieves an environment by ID
+func (s *EnvironmentServer) GetEnvironment(
+	ctx context.Context,
+	req *connect.Request[environmentv1.GetEnvironmentRequest],
+) (*connect.Response[environmentv1.GetEnvironmentResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetEnvironment, r.GetEnvironmentId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	environment, err := s.queries.GetEnvironmentByID(ctx, r.GetEnvironmentId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrEnvironmentNotFound)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&environmentv1.GetEnvironmentResponse{
+		Environment: environmentToProto(environment),
+	}), nil
+}
+
+// ListEnvironments lists the environments of a workspace, oldest first
+func (s *EnvironmentServer) ListEnvironments(
+	ctx context.Context,
+	req *connect.Request[environmentv1.ListEnvironmentsRequest],
+) (*connect.Response[environmentv1.ListEnvironmentsResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ListEnvironments, r.GetWorkspaceId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	environments, err := s.queries.ListEnvironmentsForWorkspace(ctx, r.GetWorkspaceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list environments", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	protoEnvironments := make([]*environmentv1.Environment, 0, len(environments))
+	for _, environment := range environments {
+		protoEnvironments = append(protoEnvironments, environmentToProto(environment))
+	}
+
+	return connect.NewResponse(&environmentv1.ListEnvironmentsResponse{
+		Environments: protoEnvironments,
+	}), nil
+}
+
+// UpdateEnvironment updates an environment's description or env var overlay.
+// The overlay takes effect on each resource's next deployment.
+func (s *EnvironmentServer) UpdateEnvironment(
+	ctx context.Context,
+	req *connect.Request[environmentv1.UpdateEnvironmentRequest],
+) (*connect.Response[environmentv1.UpdateEnvironmentResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.UpdateEnvironment, r.GetEnvironmentId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	params := genDb.UpdateEnvironmentParams{ID: r.GetEnvironmentId()}
+	if r.Description != nil {
+		params.Description = pgtype.Text{String: r.GetDescription(), Valid: true}
+	}
+	if r.GetReplaceEnv() {
+		env, err := marshalEnvironmentEnv(r.GetEnv())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		params.Env = env
+	}
+
+	environment, err := s.queries.UpdateEnvironment(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrEnvironmentNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to update environment", "environmentId", r.GetEnvironmentId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&environmentv1.UpdateEnvironmentResponse{
+		Environment: environmentToProto(environment),
+	}), nil
+}
+
+// DeleteEnvironment deletes an empty environment
+func (s *EnvironmentServer) DeleteEnvironment(
+	ctx context.Context,
+	req *connect.Request[environmentv1.DeleteEnvironmentRequest],
+) (*connect.Response[environmentv1.DeleteEnvironmentResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.DeleteEnvironment, r.GetEnvironmentId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	count, err := s.queries.CountEnvironmentResources(ctx, r.GetEnvironmentId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if count > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrEnvironmentNotEmpty)
+	}
+
+	if err := s.queries.DeleteEnvironment(ctx, r.GetEnvironmentId()); err != nil {
+		slog.ErrorContext(ctx, "failed to delete environment", "environmentId", r.GetEnvironmentId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	// scopes granted on the environment are meaningless once it is gone
+	if err := s.queries.RemoveAllScopesForEntity(ctx, genDb.RemoveAllScopesForEntityParams{
+		EntityType: genDb.EntityTypeEnvironment,
+		EntityID:   r.GetEnvironmentId(),
+	}); err != nil {
+		slog.WarnContext(ctx, "failed to remove environment scopes", "environmentId", r.GetEnvironmentId(), "error", err)
+	}
+
+	slog.InfoContext(ctx, "deleted environment", "environmentId", r.GetEnvironmentId())
+
+	return connect.NewResponse(&environmentv1.DeleteEnvironmentResponse{}), nil
+}
+
+// marshalEnvironmentEnv validates and serializes an env var overlay for the environments table
+func marshalEnvironmentEnv(env map[string]string) ([]byte, error) {
+	for name, value := range env {
+		if !envVarNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid environment variable name %q", name)
+		}
+		if err := locoControllerV1.ValidateSecretRef(value); err != nil {
+			return nil, fmt.Errorf("environment variable %q: %w", name, err)
+		}
+	}
+	if env == nil {
+		env = map[string]string{}
+	}
+	return json.Marshal(env)
+}
+
+// environmentEnv returns the env var overlay of the resource's environment, or nil when the
+// resource is not in an environment.
+func environmentEnv(ctx context.Context, queries genDb.Querier, resource genDb.Resource) (map[string]string, error) {
+	if !resource.EnvironmentID.Valid {
+		return nil, nil
+	}
+	environment, err := queries.GetEnvironmentByID(ctx, resource.EnvironmentID.Int64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+	var env map[string]string
+	if err := json.Unmarshal(environment.Env, &env); err != nil {
+		return nil, fmt.Errorf("invalid environment env: %w", err)
+	}
+	return env, nil
+}
+
+// environmentToProto converts a database Environment to the proto Environment
+func environmentToProto(environment genDb.Environment) *environmentv1.Environment {
+	var env map[string]string
+	if err := json.Unmarshal(environment.Env, &env); err != nil {
+		slog.Warn("failed to unmarshal environment env", "environmentId", environment.ID, "error", err)
+	}
+	return &environmentv1.Environment{
+		Id:          environment.ID,
+		WorkspaceId: environment.WorkspaceID,
+		Name:        environment.Name,
+		Description: environment.Description,
+		Env:         env,
+		CreatedBy:   environment.CreatedBy.Int64,
+		CreatedAt:   timestamppb.New(environment.CreatedAt.Time),
+		UpdatedAt:   timestamppb.New(environment.UpdatedAt.Time),
+	}
+}
@@ -6,10 +6,19 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
-var ErrImproperUsage = errors.New("improper usage of the api")
+var (
+	ErrImproperUsage   = errors.New("improper usage of the api")
+	ErrEmptyExternalID = errors.New("external_id cannot be empty")
+)
 
 // isPgConstraintViolation checks if an error is a PostgreSQL unique constraint violation
 func isPgConstraintViolation(err error) bool {
 	var pgErr *pgconn.PgError
 	return errors.As(err, &pgErr) && pgErr.Code == "23505" // unique_violation
 }
+
+// isPgLockTimeout checks if an error is a PostgreSQL lock that could not be taken within lock_timeout
+func isPgLockTimeout(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "55P03" // lock_not_available
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var (
+	ErrInvalidUpdateMask = errors.New("invalid update mask")
+	ErrImmutableField    = errors.New("field is immutable")
+)
+
+// updateFields is the set of fields an Update RPC should change.
+// A nil updateFields means no mask was given, so only fields that are set are changed.
+type updateFields map[string]bool
+
+// updateMaskRules lists the fields of a message that an Update RPC can change and the ones it never can
+type updateMaskRules struct {
+	updatable []string
+	immutable []string
+}
+
+// parse validates mask against the rules
+func (r updateMaskRules) parse(mask *fieldmaskpb.FieldMask) (updateFields, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+	fields := make(updateFields, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		switch {
+		case slices.Contains(r.updatable, path):
+			fields[path] = true
+		case slices.Contains(r.immutable, path):
+			return nil, fmt.Errorf("%w: %q cannot be changed after creation", ErrImmutableField, path)
+		default:
+			return nil, fmt.Errorf("%w: unknown field %q; updatable fields are %v", ErrInvalidUpdateMask, path, r.updatable)
+		}
+	}
+	return fields, nil
+}
+
+// has reports whether field should be changed. set is whether the request sets the field,
+// which decides when no mask was given.
+func (f updateFields) has(field string, set bool) bool {
+	if f == nil {
+		return set
+	}
+	return f[field]
+}
+
+var (
+	resourceUpdateMask = updateMaskRules{
+		updatable: []string{"name", "description", "spec", "labels"},
+		immutable: []string{"id", "resource_id", "workspace_id", "type", "environment_id", "external_id", "domains", "regions", "status", "created_at", "updated_at"},
+	}
+	workspaceUpdateMask = updateMaskRules{
+		updatable: []string{"name", "description", "deployment_history_limit", "labels", "block_critical_vulnerabilities"},
+		immutable: []string{"id", "workspace_id", "org_id", "external_id", "created_by", "created_at", "updated_at"},
+	}
+	resourceDomainUpdateMask = updateMaskRules{
+		updatable: []string{"domain"},
+		immutable: []string{"id", "domain_id", "resource_id", "domain_source", "subdomain_label", "platform_domain_id", "created_at", "updated_at"},
+	}
+)
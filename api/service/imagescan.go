@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/team-loco/loco/api/contextkeys"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/converter"
+	"github.com/team-loco/loco/api/pkg/imagescan"
+	"github.com/team-loco/loco/api/pkg/registry"
+	"github.com/team-loco/loco/api/tvm/actions"
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxListedVulnerabilities is how many critical vulnerabilities a blocked deployment names.
+const maxListedVulnerabilities = 5
+
+// checkImageScan enforces the workspace's vulnerability policy on an image about to be deployed.
+// Workspaces that block critical vulnerabilities wait for the scan and refuse images that have
+// any or could not be scanned; other deployments go ahead while the image is scanned in the
+// background.
+func (s *DeploymentServer) checkImageScan(ctx context.Context, resource genDb.Resource, image string) error {
+	if s.scanner == nil {
+		return nil
+	}
+
+	workspace, err := s.queries.GetWorkspaceByIDQuery(ctx, resource.WorkspaceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get workspace", "workspaceId", resource.WorkspaceID, "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if !workspace.BlockCriticalVulnerabilities {
+		s.scanner.ScanInBackground(image)
+		return nil
+	}
+
+	scan, err := s.scanner.Scan(ctx, image)
+	if err != nil {
+		slog.WarnContext(ctx, "refusing deployment of image that could not be scanned", "image", image, "resourceId", resource.ID, "error", err)
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%w: %v", ErrImageNotScanned, err))
+	}
+	if scan.Critical == 0 {
+		return nil
+	}
+
+	findings, err := imagescan.Findings(scan)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to decode image scan", "image", image, "error", err)
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	var ids []string
+	for _, f := range findings {
+		if f.Severity != "CRITICAL" || len(ids) == maxListedVulnerabilities {
+			break
+		}
+		ids = append(ids, f.ID)
+	}
+	if int(scan.Critical) > len(ids) {
+		ids = append(ids, fmt.Sprintf("and %d more", int(scan.Critical)-len(ids)))
+	}
+	slog.InfoContext(ctx, "refusing deployment of image with critical vulnerabilities", "image", image, "resourceId", resource.ID, "critical", scan.Critical)
+	return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%w (%s); fix them or turn off block_critical_vulnerabilities for the workspace", ErrCriticalVulnerabilities, strings.Join(ids, ", ")))
+}
+
+// GetImageScanReport returns the latest vulnerability scan of a deployment's image
+func (s *DeploymentServer) GetImageScanReport(
+	ctx context.Context,
+	req *connect.Request[deploymentv1.GetImageScanReportRequest],
+) (*connect.Response[deploymentv1.GetImageScanReportResponse], error) {
+	r := req.Msg
+
+	deployment, err := s.queries.GetDeploymentByID(ctx, r.GetDeploymentId())
+	if err != nil {
+		slog.WarnContext(ctx, "deployment not found", "deployment_id", r.GetDeploymentId())
+		return nil, connect.NewError(connect.CodeNotFound, ErrDeploymentNotFound)
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetDeployment, deployment.ResourceID)); err != nil {
+		slog.WarnContext(ctx, "unauthorized to get image scan report", "deploymentId", deployment.ID)
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if s.scanner == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrImageScanDisabled)
+	}
+
+	resource, err := s.queries.GetResourceByID(ctx, deployment.ResourceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get resource", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	spec, err := converter.DeserializeDeploymentSpec(deployment.Spec, string(resource.Type))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to deserialize deployment spec", "deploymentId", deployment.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid spec: %w", err))
+	}
+	image := registry.Pin(spec.GetService().GetBuild().GetImage(), spec.GetService().GetBuild().GetImageDigest())
+	if image == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("deployment has no image"))
+	}
+
+	scan, err := s.scanner.Report(ctx, image)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.scanner.ScanInBackground(image)
+			return nil, connect.NewError(connect.CodeNotFound, ErrImageScanNotReady)
+		}
+		slog.ErrorContext(ctx, "failed to get image scan", "image", image, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if s.scanner.Stale(scan) {
+		s.scanner.ScanInBackground(image)
+	}
+
+	report, err := imageScanToProto(scan)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to decode image scan", "image", image, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&deploymentv1.GetImageScanReportResponse{Report: report}), nil
+}
+
+func imageScanToProto(scan genDb.ImageScan) (*deploymentv1.ImageScanReport, error) {
+	findings, err := imagescan.Findings(scan)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &deploymentv1.ImageScanReport{
+		Image:           scan.Image,
+		ScannedAt:       timestamppb.New(scan.ScannedAt.Time),
+		Critical:        scan.Critical,
+		High:            scan.High,
+		Medium:          scan.Medium,
+		Low:             scan.Low,
+		Vulnerabilities: make([]*deploymentv1.Vulnerability, 0, len(findings)),
+	}
+	for _, f := range findings {
+		report.Vulnerabilities = append(report.Vulnerabilities, &deploymentv1.Vulnerability{
+			Id:               f.ID,
+			Package:          f.Package,
+			InstalledVersion: f.InstalledVersion,
+			FixedVersion:     f.FixedVersion,
+			Severity:         f.Severity,
+			Title:            f.Title,
+		})
+	}
+	if scan.Error.Valid {
+		report.Error = &scan.Error.String
+	}
+	return report, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+)
+
+const (
+	maxLabels          = 64
+	maxLabelValueBytes = 256
+)
+
+var (
+	ErrInvalidLabels = errors.New("invalid labels")
+
+	// label keys follow the same rules as a kubernetes label name
+	labelKeyPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9._-]{0,61}[a-z0-9])?$`)
+)
+
+// marshalLabels validates labels and encodes them for storage
+func marshalLabels(labels map[string]string) ([]byte, error) {
+	if len(labels) > maxLabels {
+		return nil, fmt.Errorf("%w: at most %d labels are allowed", ErrInvalidLabels, maxLabels)
+	}
+	for k, v := range labels {
+		if !labelKeyPattern.MatchString(k) {
+			return nil, fmt.Errorf("%w: key %q must be lowercase alphanumeric, '.', '_' or '-' and at most 63 characters", ErrInvalidLabels, k)
+		}
+		if len(v) > maxLabelValueBytes {
+			return nil, fmt.Errorf("%w: value of %q is longer than %d bytes", ErrInvalidLabels, k, maxLabelValueBytes)
+		}
+	}
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	return json.Marshal(labels)
+}
+
+// unmarshalLabels decodes stored labels, logging and dropping them if they are corrupt
+func unmarshalLabels(data []byte) map[string]string {
+	if len(data) == 0 {
+		return nil
+	}
+	var labels map[string]string
+	if err := json.Unmarshal(data, &labels); err != nil {
+		slog.Error("failed to unmarshal labels", "error", err)
+		return nil
+	}
+	return labels
+}
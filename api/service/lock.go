@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/team-loco/loco/api/contextkeys"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm/actions"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrResourceLocked     = errors.New("resource is locked")
+	ErrWorkspaceHasLocked = errors.New("workspace has locked resources")
+	ErrDeletionProtected  = errors.New("organization has deletion protection enabled")
+)
+
+// Locks and deletion protection are checked here rather than in each handler, so every path that deletes
+// or scales down, including approved operations, goes through the same checks.
+
+// ensureResourceDeletable fails when a resource is locked or its organization has deletion protection
+func ensureResourceDeletable(ctx context.Context, queries genDb.Querier, resourceID int64) error {
+	protection, err := queries.GetResourceProtection(ctx, resourceID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get resource protection", "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if protection.Locked {
+		return connect.NewError(connect.CodeFailedPrecondition, lockedError(protection.LockReason))
+	}
+	if protection.DeletionProtection {
+		return connect.NewError(connect.CodeFailedPrecondition, ErrDeletionProtected)
+	}
+	return nil
+}
+
+// ensureResourceScalable fails when a resource is locked, since a locked resource must keep running
+func ensureResourceScalable(ctx context.Context, queries genDb.Querier, resourceID int64) error {
+	protection, err := queries.GetResourceProtection(ctx, resourceID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get resource protection", "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if protection.Locked {
+		return connect.NewError(connect.CodeFailedPrecondition, lockedError(protection.LockReason))
+	}
+	return nil
+}
+
+// ensureWorkspaceDeletable fails when a workspace holds locked resources or its organization has deletion protection
+func ensureWorkspaceDeletable(ctx context.Context, queries genDb.Querier, workspaceID int64) error {
+	protection, err := queries.GetWorkspaceProtection(ctx, workspaceID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return connect.NewError(connect.CodeNotFound, ErrWorkspaceNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get workspace protection", "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if protection.LockedResources > 0 {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%w: %d must be unlocked first", ErrWorkspaceHasLocked, protection.LockedResources))
+	}
+	if protection.DeletionProtection {
+		return connect.NewError(connect.CodeFailedPrecondition, ErrDeletionProtected)
+	}
+	return nil
+}
+
+// ensureOrgDeletable fails when an organization has deletion protection
+func ensureOrgDeletable(ctx context.Context, queries genDb.Querier, orgID int64) error {
+	org, err := queries.GetOrgByID(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return connect.NewError(connect.CodeNotFound, ErrOrgNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get org", "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if org.DeletionProtection {
+		return connect.NewError(connect.CodeFailedPrecondition, ErrDeletionProtected)
+	}
+	return nil
+}
+
+func lockedError(reason string) error {
+	if reason == "" {
+		return ErrResourceLocked
+	}
+	return fmt.Errorf("%w: %s", ErrResourceLocked, reason)
+}
+
+// LockResource protects a resource from being deleted or scaled to zero
+func (s *ResourceServer) LockResource(
+	ctx context.Context,
+	req *connect.Request[resourcev1.LockResourceRequest],
+) (*connect.Response[resourcev1.LockResourceResponse], error) {
+	r := req.Msg
+
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.LockResource, r.GetResourceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to lock resource", "resourceId", r.GetResourceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	lock, err := s.queries.LockResource(ctx, genDb.LockResourceParams{
+		ResourceID:   r.GetResourceId(),
+		Reason:       r.GetReason(),
+		LockedByType: entity.Type,
+		LockedByID:   entity.ID,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation
+			return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to lock resource", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "locked resource", "resourceId", lock.ResourceID, "reason", lock.Reason)
+	return connect.NewResponse(&resourcev1.LockResourceResponse{Lock: resourceLockToProto(lock)}), nil
+}
+
+// UnlockResource removes a resource's lock
+func (s *ResourceServer) UnlockResource(
+	ctx context.Context,
+	req *connect.Request[resourcev1.UnlockResourceRequest],
+) (*connect.Response[resourcev1.UnlockResourceResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.UnlockResource, r.GetResourceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to unlock resource", "resourceId", r.GetResourceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if err := s.queries.UnlockResource(ctx, r.GetResourceId()); err != nil {
+		slog.ErrorContext(ctx, "failed to unlock resource", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "unlocked resource", "resourceId", r.GetResourceId())
+	return connect.NewResponse(&resourcev1.UnlockResourceResponse{}), nil
+}
+
+func resourceLockToProto(lock genDb.ResourceLock) *resourcev1.ResourceLock {
+	return &resourcev1.ResourceLock{
+		ResourceId:   lock.ResourceID,
+		Reason:       lock.Reason,
+		LockedByType: dbEntityTypeToProto(lock.LockedByType),
+		LockedById:   lock.LockedByID,
+		CreatedAt:    timestamppb.New(lock.CreatedAt.Time),
+	}
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/team-loco/loco/api/contextkeys"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+	userv1 "github.com/team-loco/loco/shared/proto/user/v1"
+)
+
+// GetMFAStatus reports whether the calling user has enrolled in MFA and which organizations require it.
+func (s *UserServer) GetMFAStatus(
+	ctx context.Context,
+	req *connect.Request[userv1.GetMFAStatusRequest],
+) (*connect.Response[userv1.GetMFAStatusResponse], error) {
+	entity, err := s.authorizeAccount(ctx, actions.GetMFAStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.tvm.GetMFAStatus(ctx, entity.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get mfa status", "userId", entity.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	orgs, err := s.queries.ListUserMFAOrganizations(ctx, entity.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list organizations requiring mfa", "userId", entity.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	names := make([]string, len(orgs))
+	for i, org := range orgs {
+		names[i] = org.Name
+	}
+
+	var pending bool
+	if token, ok := contextkeys.Token(ctx); ok {
+		claims, err := s.tvm.GetClaims(ctx, token)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		pending = claims.MFAPending
+	}
+
+	return connect.NewResponse(&userv1.GetMFAStatusResponse{
+		Enabled:                status.Enabled,
+		RequiringOrganizations: names,
+		Pending:                pending,
+	}), nil
+}
+
+// EnrollTOTP starts enrolling the calling user in TOTP MFA.
+func (s *UserServer) EnrollTOTP(
+	ctx context.Context,
+	req *connect.Request[userv1.EnrollTOTPRequest],
+) (*connect.Response[userv1.EnrollTOTPResponse], error) {
+	entity, err := s.authorizeAccount(ctx, actions.EnrollMFA)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.queries.GetUserByID(ctx, entity.ID)
+	if err != nil {
+		slog.WarnContext(ctx, "user not found", "userId", entity.ID)
+		return nil, connect.NewError(connect.CodeNotFound, ErrUserNotFound)
+	}
+
+	secret, uri, err := s.tvm.EnrollTOTP(ctx, user.ID, user.Email)
+	if err != nil {
+		return nil, mfaError(ctx, err)
+	}
+
+	slog.InfoContext(ctx, "user started mfa enrollment", "userId", user.ID)
+	return connect.NewResponse(&userv1.EnrollTOTPResponse{Secret: secret, Uri: uri}), nil
+}
+
+// ConfirmTOTP enables TOTP MFA for the calling user. A login waiting on MFA is completed, and its cookie replaced.
+func (s *UserServer) ConfirmTOTP(
+	ctx context.Context,
+	req *connect.Request[userv1.ConfirmTOTPRequest],
+) (*connect.Response[userv1.ConfirmTOTPResponse], error) {
+	entity, err := s.authorizeAccount(ctx, actions.ConfirmMFA)
+	if err != nil {
+		return nil, err
+	}
+	token, ok := contextkeys.Token(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "token not found in context")
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+
+	locoToken, err := s.tvm.ConfirmTOTP(ctx, token, req.Msg.GetCode())
+	if err != nil {
+		return nil, mfaError(ctx, err)
+	}
+
+	slog.InfoContext(ctx, "user enabled mfa", "userId", entity.ID)
+	if locoToken == "" {
+		return connect.NewResponse(&userv1.ConfirmTOTPResponse{}), nil
+	}
+	refreshToken, err := startSession(ctx, s.tvm, locoToken, true)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to issue refresh token", "userId", entity.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := connect.NewResponse(&userv1.ConfirmTOTPResponse{LocoToken: locoToken, RefreshToken: refreshToken})
+	login{token: locoToken, refreshToken: refreshToken}.setCookies(res.Header(), s.tvm)
+	return res, nil
+}
+
+// VerifyMFA completes the calling login, which is waiting on MFA, and replaces its cookie.
+func (s *UserServer) VerifyMFA(
+	ctx context.Context,
+	req *connect.Request[userv1.VerifyMFARequest],
+) (*connect.Response[userv1.VerifyMFAResponse], error) {
+	entity, err := s.authorizeAccount(ctx, actions.VerifyMFA)
+	if err != nil {
+		return nil, err
+	}
+	token, ok := contextkeys.Token(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "token not found in context")
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+
+	locoToken, err := s.tvm.VerifyMFA(ctx, token, req.Msg.GetCode())
+	if err != nil {
+		return nil, mfaError(ctx, err)
+	}
+
+	refreshToken, err := startSession(ctx, s.tvm, locoToken, true)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to issue refresh token", "userId", entity.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "user passed mfa", "userId", entity.ID)
+	res := connect.NewResponse(&userv1.VerifyMFAResponse{LocoToken: locoToken, RefreshToken: refreshToken})
+	login{token: locoToken, refreshToken: refreshToken}.setCookies(res.Header(), s.tvm)
+	return res, nil
+}
+
+// DisableTOTP removes TOTP MFA from the calling user.
+func (s *UserServer) DisableTOTP(
+	ctx context.Context,
+	req *connect.Request[userv1.DisableTOTPRequest],
+) (*connect.Response[userv1.DisableTOTPResponse], error) {
+	entity, err := s.authorizeAccount(ctx, actions.DisableMFA)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.tvm.DisableTOTP(ctx, entity.ID, req.Msg.GetCode()); err != nil {
+		return nil, mfaError(ctx, err)
+	}
+
+	slog.InfoContext(ctx, "user disabled mfa", "userId", entity.ID)
+	return connect.NewResponse(&userv1.DisableTOTPResponse{}), nil
+}
+
+// mfaError maps an error from the vending machine's MFA methods to a connect error.
+func mfaError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, tvm.ErrInvalidMFACode):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, tvm.ErrMFANotConfigured):
+		return connect.NewError(connect.CodeUnimplemented, err)
+	case errors.Is(err, tvm.ErrMFAAlreadyEnabled),
+		errors.Is(err, tvm.ErrMFANotEnrolled),
+		errors.Is(err, tvm.ErrMFARequiredByOrg),
+		errors.Is(err, tvm.ErrNotMFAPending):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, tvm.ErrTokenNotFound), errors.Is(err, tvm.ErrImproperUsage):
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	default:
+		slog.ErrorContext(ctx, "mfa operation failed", "error", err)
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
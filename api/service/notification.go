@@ -0,0 +1,477 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/url"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+	notificationv1 "github.com/team-loco/loco/shared/proto/notification/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrNotificationsNotAUser      = errors.New("only users have notifications")
+	ErrInvalidNotificationEvent   = errors.New("invalid notification event")
+	ErrDuplicateNotificationEvent = errors.New("notification event given more than once")
+	ErrInvalidWebhookURL          = errors.New("webhook url must be an absolute https URL")
+	ErrNotificationNotFound       = errors.New("notification not found")
+	ErrConflictingReadTarget      = errors.New("give either id or up_to_id, not both")
+)
+
+// NotificationServer implements the NotificationService gRPC server
+type NotificationServer struct {
+	db      apiDb.Beginner
+	queries genDb.Querier
+	machine *tvm.VendingMachine
+}
+
+// NewNotificationServer creates a new NotificationServer instance
+func NewNotificationServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine) *NotificationServer {
+	return &NotificationServer{db: db, queries: queries, machine: machine}
+}
+
+// GetNotificationPreferences lists the caller's notification preferences
+func (s *NotificationServer) GetNotificationPreferences(
+	ctx context.Context,
+	req *connect.Request[notificationv1.GetNotificationPreferencesRequest],
+) (*connect.Response[notificationv1.GetNotificationPreferencesResponse], error) {
+	entity, err := s.authorizeUser(ctx, actions.GetNotificationPreferences)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := s.queries.ListUserNotificationPreferences(ctx, entity.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list notification preferences", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&notificationv1.GetNotificationPreferencesResponse{
+		Preferences: notificationPreferencesToProto(preferences),
+	}), nil
+}
+
+// UpdateNotificationPreferences replaces the caller's notification preferences
+func (s *NotificationServer) UpdateNotificationPreferences(
+	ctx context.Context,
+	req *connect.Request[notificationv1.UpdateNotificationPreferencesRequest],
+) (*connect.Response[notificationv1.UpdateNotificationPreferencesResponse], error) {
+	r := req.Msg
+
+	entity, err := s.authorizeUser(ctx, actions.UpdateNotificationPreferences)
+	if err != nil {
+		return nil, err
+	}
+
+	given := map[genDb.NotificationEvent]bool{}
+	for _, preference := range r.GetPreferences() {
+		event, ok := protoNotificationEventToDb(preference.GetEvent())
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidNotificationEvent)
+		}
+		if given[event] {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s", ErrDuplicateNotificationEvent, event))
+		}
+		given[event] = true
+		if err := validateWebhookURL(preference.GetWebhookUrl()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := apiDb.QueriesFor(tx)
+
+	existing, err := qtx.ListUserNotificationPreferences(ctx, entity.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list notification preferences", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	for _, preference := range existing {
+		if given[preference.Event] {
+			continue
+		}
+		if err := qtx.DeleteUserNotificationPreference(ctx, genDb.DeleteUserNotificationPreferenceParams{
+			UserID: entity.ID,
+			Event:  preference.Event,
+		}); err != nil {
+			slog.ErrorContext(ctx, "failed to delete notification preference", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+	for _, preference := range r.GetPreferences() {
+		event, _ := protoNotificationEventToDb(preference.GetEvent())
+		if err := qtx.UpsertUserNotificationPreference(ctx, genDb.UpsertUserNotificationPreferenceParams{
+			UserID:     entity.ID,
+			Event:      event,
+			Email:      preference.GetEmail(),
+			WebhookUrl: preference.GetWebhookUrl(),
+		}); err != nil {
+			slog.ErrorContext(ctx, "failed to save notification preference", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	preferences, err := qtx.ListUserNotificationPreferences(ctx, entity.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list notification preferences", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit notification preferences", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&notificationv1.UpdateNotificationPreferencesResponse{
+		Preferences: notificationPreferencesToProto(preferences),
+	}), nil
+}
+
+// GetWorkspaceNotificationDefaults lists a workspace's notification defaults
+func (s *NotificationServer) GetWorkspaceNotificationDefaults(
+	ctx context.Context,
+	req *connect.Request[notificationv1.GetWorkspaceNotificationDefaultsRequest],
+) (*connect.Response[notificationv1.GetWorkspaceNotificationDefaultsResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetWorkspaceNotificationDefaults, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to get workspace notification defaults", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	defaults, err := s.queries.ListWorkspaceNotificationDefaults(ctx, r.GetWorkspaceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list workspace notification defaults", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&notificationv1.GetWorkspaceNotificationDefaultsResponse{
+		Defaults: workspaceNotificationDefaultsToProto(defaults),
+	}), nil
+}
+
+// UpdateWorkspaceNotificationDefaults replaces a workspace's notification defaults
+func (s *NotificationServer) UpdateWorkspaceNotificationDefaults(
+	ctx context.Context,
+	req *connect.Request[notificationv1.UpdateWorkspaceNotificationDefaultsRequest],
+) (*connect.Response[notificationv1.UpdateWorkspaceNotificationDefaultsResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.UpdateWorkspaceNotificationDefaults, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to update workspace notification defaults", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	given := map[genDb.NotificationEvent]bool{}
+	for _, d := range r.GetDefaults() {
+		event, ok := protoNotificationEventToDb(d.GetEvent())
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidNotificationEvent)
+		}
+		if given[event] {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s", ErrDuplicateNotificationEvent, event))
+		}
+		given[event] = true
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := apiDb.QueriesFor(tx)
+
+	existing, err := qtx.ListWorkspaceNotificationDefaults(ctx, r.GetWorkspaceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list workspace notification defaults", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	for _, d := range existing {
+		if given[d.Event] {
+			continue
+		}
+		if err := qtx.DeleteWorkspaceNotificationDefault(ctx, genDb.DeleteWorkspaceNotificationDefaultParams{
+			WorkspaceID: r.GetWorkspaceId(),
+			Event:       d.Event,
+		}); err != nil {
+			slog.ErrorContext(ctx, "failed to delete workspace notification default", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+	for _, d := range r.GetDefaults() {
+		event, _ := protoNotificationEventToDb(d.GetEvent())
+		if err := qtx.UpsertWorkspaceNotificationDefault(ctx, genDb.UpsertWorkspaceNotificationDefaultParams{
+			WorkspaceID: r.GetWorkspaceId(),
+			Event:       event,
+			Email:       d.GetEmail(),
+		}); err != nil {
+			slog.ErrorContext(ctx, "failed to save workspace notification default", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	defaults, err := qtx.ListWorkspaceNotificationDefaults(ctx, r.GetWorkspaceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list workspace notification defaults", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit workspace notification defaults", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&notificationv1.UpdateWorkspaceNotificationDefaultsResponse{
+		Defaults: workspaceNotificationDefaultsToProto(defaults),
+	}), nil
+}
+
+// ListNotifications lists the caller's inbox
+func (s *NotificationServer) ListNotifications(
+	ctx context.Context,
+	req *connect.Request[notificationv1.ListNotificationsRequest],
+) (*connect.Response[notificationv1.ListNotificationsResponse], error) {
+	r := req.Msg
+
+	entity, err := s.authorizeUser(ctx, actions.ListNotifications)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := normalizePageSize(r.GetPageSize())
+
+	var pageToken pgtype.Int8
+	if r.GetPageToken() != "" {
+		cursorID, err := decodeCursor(r.GetPageToken())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token: %w", err))
+		}
+		pageToken = pgtype.Int8{Int64: cursorID, Valid: true}
+	}
+
+	dbNotifications, err := s.queries.ListNotifications(ctx, genDb.ListNotificationsParams{
+		UserID:     entity.ID,
+		Limit:      pageSize,
+		UnreadOnly: r.GetUnreadOnly(),
+		PageToken:  pageToken,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list notifications", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	unreadCount, err := s.queries.CountUnreadNotifications(ctx, entity.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count unread notifications", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	notifications := make([]*notificationv1.Notification, 0, len(dbNotifications))
+	for _, n := range dbNotifications {
+		notifications = append(notifications, notificationToProto(n))
+	}
+
+	var nextPageToken string
+	if len(dbNotifications) == int(pageSize) {
+		nextPageToken = encodeCursor(dbNotifications[len(dbNotifications)-1].ID)
+	}
+
+	return connect.NewResponse(&notificationv1.ListNotificationsResponse{
+		Notifications: notifications,
+		NextPageToken: nextPageToken,
+		UnreadCount:   unreadCount,
+	}), nil
+}
+
+// MarkNotificationRead marks one or more of the caller's notifications as read
+func (s *NotificationServer) MarkNotificationRead(
+	ctx context.Context,
+	req *connect.Request[notificationv1.MarkNotificationReadRequest],
+) (*connect.Response[notificationv1.MarkNotificationReadResponse], error) {
+	r := req.Msg
+
+	entity, err := s.authorizeUser(ctx, actions.MarkNotificationRead)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Id != nil && r.UpToId != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrConflictingReadTarget)
+	}
+
+	if r.Id != nil {
+		marked, err := s.queries.MarkNotificationRead(ctx, genDb.MarkNotificationReadParams{
+			ID:     r.GetId(),
+			UserID: entity.ID,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to mark notification read", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		if marked == 0 {
+			return nil, connect.NewError(connect.CodeNotFound, ErrNotificationNotFound)
+		}
+	} else {
+		upToID := int64(math.MaxInt64)
+		if r.UpToId != nil {
+			upToID = r.GetUpToId()
+		}
+		if err := s.queries.MarkAllNotificationsRead(ctx, genDb.MarkAllNotificationsReadParams{
+			UserID: entity.ID,
+			UpToID: upToID,
+		}); err != nil {
+			slog.ErrorContext(ctx, "failed to mark notifications read", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	unreadCount, err := s.queries.CountUnreadNotifications(ctx, entity.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count unread notifications", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&notificationv1.MarkNotificationReadResponse{UnreadCount: unreadCount}), nil
+}
+
+// authorizeUser returns the calling user once they may perform action on their own notifications
+func (s *NotificationServer) authorizeUser(ctx context.Context, action actions.Action) (genDb.Entity, error) {
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return genDb.Entity{}, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+	if entity.Type != genDb.EntityTypeUser {
+		return genDb.Entity{}, connect.NewError(connect.CodeFailedPrecondition, ErrNotificationsNotAUser)
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return genDb.Entity{}, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(action, entity.ID)); err != nil {
+		slog.WarnContext(ctx, "unauthorized to access notifications", "userId", entity.ID)
+		return genDb.Entity{}, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return entity, nil
+}
+
+// validateWebhookURL accepts an empty URL, meaning no webhook, or an absolute https URL
+func validateWebhookURL(raw string) error {
+	if raw == "" {
+		return nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return ErrInvalidWebhookURL
+	}
+	return nil
+}
+
+func notificationToProto(n genDb.Notification) *notificationv1.Notification {
+	notification := &notificationv1.Notification{
+		Id:        n.ID,
+		Event:     notificationEventToProto(n.Event),
+		Subject:   n.Subject,
+		Body:      n.Body,
+		CreatedAt: timestamppb.New(n.CreatedAt.Time),
+	}
+	if n.WorkspaceID.Valid {
+		notification.WorkspaceId = &n.WorkspaceID.Int64
+	}
+	if n.ReadAt.Valid {
+		notification.ReadAt = timestamppb.New(n.ReadAt.Time)
+	}
+	if len(n.Data) > 0 {
+		if err := json.Unmarshal(n.Data, &notification.Data); err != nil {
+			slog.Warn("failed to decode notification data", "notificationId", n.ID, "error", err)
+		}
+	}
+	return notification
+}
+
+func notificationPreferencesToProto(preferences []genDb.UserNotificationPreference) []*notificationv1.NotificationPreference {
+	result := make([]*notificationv1.NotificationPreference, 0, len(preferences))
+	for _, p := range preferences {
+		result = append(result, &notificationv1.NotificationPreference{
+			Event:      notificationEventToProto(p.Event),
+			Email:      p.Email,
+			WebhookUrl: p.WebhookUrl,
+		})
+	}
+	return result
+}
+
+func workspaceNotificationDefaultsToProto(defaults []genDb.WorkspaceNotificationDefault) []*notificationv1.WorkspaceNotificationDefault {
+	result := make([]*notificationv1.WorkspaceNotificationDefault, 0, len(defaults))
+	for _, d := range defaults {
+		result = append(result, &notificationv1.WorkspaceNotificationDefault{
+			Event: notificationEventToProto(d.Event),
+			Email: d.Email,
+		})
+	}
+	return result
+}
+
+func notificationEventToProto(event genDb.NotificationEvent) notificationv1.NotificationEvent {
+	switch event {
+	case genDb.NotificationEventDeploymentFailed:
+		return notificationv1.NotificationEvent_NOTIFICATION_EVENT_DEPLOYMENT_FAILED
+	case genDb.NotificationEventDomainVerification:
+		return notificationv1.NotificationEvent_NOTIFICATION_EVENT_DOMAIN_VERIFICATION
+	case genDb.NotificationEventInvitation:
+		return notificationv1.NotificationEvent_NOTIFICATION_EVENT_INVITATION
+	case genDb.NotificationEventWorkspaceDigest:
+		return notificationv1.NotificationEvent_NOTIFICATION_EVENT_WORKSPACE_DIGEST
+	default:
+		return notificationv1.NotificationEvent_NOTIFICATION_EVENT_UNSPECIFIED
+	}
+}
+
+func protoNotificationEventToDb(event notificationv1.NotificationEvent) (genDb.NotificationEvent, bool) {
+	switch event {
+	case notificationv1.NotificationEvent_NOTIFICATION_EVENT_DEPLOYMENT_FAILED:
+		return genDb.NotificationEventDeploymentFailed, true
+	case notificationv1.NotificationEvent_NOTIFICATION_EVENT_DOMAIN_VERIFICATION:
+		return genDb.NotificationEventDomainVerification, true
+	case notificationv1.NotificationEvent_NOTIFICATION_EVENT_INVITATION:
+		return genDb.NotificationEventInvitation, true
+	case notificationv1.NotificationEvent_NOTIFICATION_EVENT_WORKSPACE_DIGEST:
+		return genDb.NotificationEventWorkspaceDigest, true
+	default:
+		return "", false
+	}
+}
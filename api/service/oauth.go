@@ -10,16 +10,19 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/allegro/bigcache/v3"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
+	apiDb "github.com/team-loco/loco/api/db"
 	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/notify"
 	"github.com/team-loco/loco/api/tvm"
 	"github.com/team-loco/loco/api/tvm/providers"
 	oAuth "github.com/team-loco/loco/shared/proto/oauth/v1"
+	"github.com/team-loco/loco/shared/proto/oauth/v1/oauthv1connect"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/github"
 )
@@ -74,11 +77,51 @@ func (c *OAuthStateCache) Close() error {
 }
 
 type OAuthServer struct {
-	db         *pgxpool.Pool
+	db         apiDb.Beginner
 	queries    genDb.Querier
 	httpClient *http.Client
 	stateCache *OAuthStateCache
 	machine    *tvm.VendingMachine
+	providers  map[oAuth.OAuthProvider]*oauthProvider
+	email      *emailAuth // nil unless email and password logins are enabled
+}
+
+// OAuthConfig configures the identity providers besides GitHub, which is always enabled through OAuthConf.
+// A provider is enabled when its client ID is set.
+type OAuthConfig struct {
+	GitLabURL          string `env:"GITLAB_OAUTH_URL"` // defaults to gitlab.com
+	GitLabClientID     string `env:"GITLAB_OAUTH_CLIENT_ID"`
+	GitLabClientSecret string `env:"GITLAB_OAUTH_CLIENT_SECRET" requiredWith:"GITLAB_OAUTH_CLIENT_ID"`
+	GitLabRedirectURL  string `env:"GITLAB_OAUTH_REDIRECT_URL" requiredWith:"GITLAB_OAUTH_CLIENT_ID"`
+
+	GoogleClientID     string `env:"GOOGLE_OAUTH_CLIENT_ID"`
+	GoogleClientSecret string `env:"GOOGLE_OAUTH_CLIENT_SECRET" requiredWith:"GOOGLE_OAUTH_CLIENT_ID"`
+	GoogleRedirectURL  string `env:"GOOGLE_OAUTH_REDIRECT_URL" requiredWith:"GOOGLE_OAUTH_CLIENT_ID"`
+
+	OIDCIssuer       string               `env:"OIDC_ISSUER"`
+	OIDCClientID     string               `env:"OIDC_CLIENT_ID" requiredWith:"OIDC_ISSUER"`
+	OIDCClientSecret string               `env:"OIDC_CLIENT_SECRET" requiredWith:"OIDC_ISSUER"`
+	OIDCRedirectURL  string               `env:"OIDC_REDIRECT_URL" requiredWith:"OIDC_ISSUER"`
+	OIDCScopes       []string             `env:"OIDC_SCOPES"` // defaults to openid, email and profile
+	OIDCClaims       providers.OIDCClaims // OIDC_*_CLAIM
+
+	EmailAuth    bool   `env:"EMAIL_AUTH_ENABLED"`                               // sign up and log in with an email address and password; needs an email provider
+	EmailAuthURL string `env:"EMAIL_AUTH_URL" requiredWith:"EMAIL_AUTH_ENABLED"` // web app URL the verification and password reset links point to
+}
+
+// oauthProvider is an identity provider users can sign in with
+type oauthProvider struct {
+	// config returns the provider's oauth2 config; OIDC providers discover their endpoints on first use
+	config  func(ctx context.Context) (*oauth2.Config, error)
+	email   func(ctx context.Context, token string) providers.EmailResponse
+	profile func(ctx context.Context, token string) (*oauthProfile, error)
+}
+
+// oauthProfile is what is stored about a user signing up through a provider
+type oauthProfile struct {
+	externalID string
+	name       string
+	avatarURL  string
 }
 
 // GithubUser is the response structure from GitHub's user endpoint
@@ -110,19 +153,139 @@ func generateSecureRandomString(length int) (string, error) {
 	return hex.EncodeToString(bytes), nil
 }
 
-func NewOAuthServer(db *pgxpool.Pool, queries genDb.Querier, httpClient *http.Client, machine *tvm.VendingMachine) (*OAuthServer, error) {
+func NewOAuthServer(db apiDb.Beginner, queries genDb.Querier, httpClient *http.Client, machine *tvm.VendingMachine, emailSender notify.EmailSender, cfg OAuthConfig) (*OAuthServer, error) {
+	if cfg.EmailAuth && emailSender == nil {
+		return nil, errors.New("EMAIL_AUTH_ENABLED needs an email provider to send verification emails (NOTIFY_EMAIL_PROVIDER)")
+	}
+
 	stateCache, err := NewOAuthStateCache(OAuthStateTTL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create oauth state cache: %w", err)
 	}
 
-	return &OAuthServer{
+	s := &OAuthServer{
 		db:         db,
 		queries:    queries,
 		httpClient: httpClient,
 		stateCache: stateCache,
 		machine:    machine,
-	}, nil
+	}
+	s.providers = s.newProviders(cfg)
+	if cfg.EmailAuth {
+		s.email = &emailAuth{sender: emailSender, url: strings.TrimSuffix(cfg.EmailAuthURL, "/")}
+		slog.Info("email and password logins enabled")
+	}
+	return s, nil
+}
+
+// newProviders builds the enabled identity providers
+func (s *OAuthServer) newProviders(cfg OAuthConfig) map[oAuth.OAuthProvider]*oauthProvider {
+	enabled := map[oAuth.OAuthProvider]*oauthProvider{
+		oAuth.OAuthProvider_O_AUTH_PROVIDER_GITHUB: {
+			config: func(context.Context) (*oauth2.Config, error) { return OAuthConf, nil },
+			email:  func(_ context.Context, token string) providers.EmailResponse { return providers.Github(token) },
+			profile: func(_ context.Context, token string) (*oauthProfile, error) {
+				user, err := s.fetchGithubUserData(token)
+				if err != nil {
+					return nil, err
+				}
+				return &oauthProfile{externalID: fmt.Sprintf("%d", user.ID), name: user.Name, avatarURL: user.Avatar}, nil
+			},
+		},
+	}
+
+	if cfg.GitLabClientID != "" {
+		gitlab := providers.NewGitLab(s.httpClient, cfg.GitLabURL)
+		conf := &oauth2.Config{
+			ClientID:     cfg.GitLabClientID,
+			ClientSecret: cfg.GitLabClientSecret,
+			RedirectURL:  cfg.GitLabRedirectURL,
+			Scopes:       []string{"read_user"},
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  gitlab.URL() + "/oauth/authorize",
+				TokenURL: gitlab.URL() + "/oauth/token",
+			},
+		}
+		enabled[oAuth.OAuthProvider_O_AUTH_PROVIDER_GITLAB] = &oauthProvider{
+			config: func(context.Context) (*oauth2.Config, error) { return conf, nil },
+			email:  gitlab.Email,
+			profile: func(ctx context.Context, token string) (*oauthProfile, error) {
+				user, err := gitlab.User(ctx, token)
+				if err != nil {
+					return nil, err
+				}
+				return &oauthProfile{externalID: fmt.Sprintf("gitlab:%d", user.ID), name: user.Name, avatarURL: user.AvatarURL}, nil
+			},
+		}
+	}
+
+	if cfg.GoogleClientID != "" {
+		enabled[oAuth.OAuthProvider_O_AUTH_PROVIDER_GOOGLE] = s.newOIDCProvider(
+			"google",
+			providers.NewOIDC(s.httpClient, providers.GoogleIssuer, providers.OIDCClaims{}),
+			cfg.GoogleClientID, cfg.GoogleClientSecret, cfg.GoogleRedirectURL, nil,
+		)
+	}
+
+	if cfg.OIDCClientID != "" && cfg.OIDCIssuer != "" {
+		enabled[oAuth.OAuthProvider_O_AUTH_PROVIDER_OIDC] = s.newOIDCProvider(
+			"oidc",
+			providers.NewOIDC(s.httpClient, cfg.OIDCIssuer, cfg.OIDCClaims),
+			cfg.OIDCClientID, cfg.OIDCClientSecret, cfg.OIDCRedirectURL, cfg.OIDCScopes,
+		)
+	}
+
+	for provider := range enabled {
+		slog.Info("oauth provider enabled", "provider", provider)
+	}
+	return enabled
+}
+
+// newOIDCProvider builds a provider backed by an OIDC issuer. prefix namespaces the subjects it identifies users by.
+func (s *OAuthServer) newOIDCProvider(prefix string, oidc *providers.OIDC, clientID, clientSecret, redirectURL string, scopes []string) *oauthProvider {
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email", "profile"}
+	}
+	return &oauthProvider{
+		config: func(ctx context.Context) (*oauth2.Config, error) {
+			endpoints, err := oidc.Endpoints(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return &oauth2.Config{
+				ClientID:     clientID,
+				ClientSecret: clientSecret,
+				RedirectURL:  redirectURL,
+				Scopes:       scopes,
+				Endpoint: oauth2.Endpoint{
+					AuthURL:  endpoints.Authorization,
+					TokenURL: endpoints.Token,
+				},
+			}, nil
+		},
+		email: oidc.Email,
+		profile: func(ctx context.Context, token string) (*oauthProfile, error) {
+			user, err := oidc.User(ctx, token)
+			if err != nil {
+				return nil, err
+			}
+			return &oauthProfile{externalID: prefix + ":" + user.Subject, name: user.Name, avatarURL: user.Picture}, nil
+		},
+	}
+}
+
+// provider returns the requested provider and its oauth2 config
+func (s *OAuthServer) provider(ctx context.Context, requested oAuth.OAuthProvider) (*oauthProvider, *oauth2.Config, error) {
+	p, ok := s.providers[requested]
+	if !ok {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unsupported oauth provider"))
+	}
+	conf, err := p.config(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load oauth provider config", "provider", requested, "error", err)
+		return nil, nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("oauth provider unavailable: %w", err))
+	}
+	return p, conf, nil
 }
 
 func (s *OAuthServer) fetchGithubUserData(token string) (*GithubUser, error) {
@@ -160,7 +323,7 @@ func (s *OAuthServer) tempCreateUser(ctx context.Context, externalID string, ema
 	}
 	defer tx.Rollback(ctx)
 
-	qtx := s.queries.(*genDb.Queries).WithTx(tx)
+	qtx := apiDb.QueriesFor(tx)
 
 	avatarURLPgType := pgtype.Text{String: avatarURL, Valid: avatarURL != ""}
 	namePgType := pgtype.Text{String: name, Valid: name != ""}
@@ -181,28 +344,112 @@ func (s *OAuthServer) tempCreateUser(ctx context.Context, externalID string, ema
 		return nil, fmt.Errorf("database error: %w", err)
 	}
 
-	if err := s.machine.UpdateRoles(ctx, user.ID, []genDb.EntityScope{
-		{EntityType: genDb.EntityTypeUser, EntityID: user.ID, Scope: genDb.ScopeRead},
-		{EntityType: genDb.EntityTypeUser, EntityID: user.ID, Scope: genDb.ScopeWrite},
-		{EntityType: genDb.EntityTypeUser, EntityID: user.ID, Scope: genDb.ScopeAdmin},
-	}, []genDb.EntityScope{}); err != nil {
-		slog.ErrorContext(ctx, "failed to update user roles", "error", err, "userId", user.ID)
-		return nil, fmt.Errorf("database error: %w", err)
+	if err := s.grantSelfRoles(ctx, user.ID); err != nil {
+		return nil, err
 	}
 
 	return &user, nil
 }
 
+// grantSelfRoles lets a new user read, change and delete their own account.
+func (s *OAuthServer) grantSelfRoles(ctx context.Context, userID int64) error {
+	if err := s.machine.UpdateRoles(ctx, userID, []genDb.EntityScope{
+		{EntityType: genDb.EntityTypeUser, EntityID: userID, Scope: genDb.ScopeRead},
+		{EntityType: genDb.EntityTypeUser, EntityID: userID, Scope: genDb.ScopeWrite},
+		{EntityType: genDb.EntityTypeUser, EntityID: userID, Scope: genDb.ScopeAdmin},
+	}, []genDb.EntityScope{}); err != nil {
+		slog.ErrorContext(ctx, "failed to update user roles", "error", err, "userId", userID)
+		return fmt.Errorf("database error: %w", err)
+	}
+	return nil
+}
+
+// login is a user logged in by exchange.
+type login struct {
+	user  genDb.User
+	token string
+	// refreshToken starts the session's refresh tokens. It is empty while mfaRequired is set, or when
+	// refresh tokens are disabled.
+	refreshToken string
+	// mfaRequired is set when token only allows passing MFA.
+	mfaRequired bool
+}
+
+// exchange logs a user in with the vending machine and starts their session.
+func (s *OAuthServer) exchange(ctx context.Context, email providers.EmailResponse) (login, error) {
+	user, locoToken, err := s.machine.Exchange(ctx, email)
+	if errors.Is(err, tvm.ErrMFARequired) {
+		slog.InfoContext(ctx, "login waiting on mfa", "userId", user.ID)
+		return login{user: user, token: locoToken, mfaRequired: true}, nil
+	}
+	if err != nil {
+		return login{}, err
+	}
+
+	refreshToken, err := startSession(ctx, s.machine, locoToken, false)
+	if err != nil {
+		return login{}, err
+	}
+	return login{user: user, token: locoToken, refreshToken: refreshToken}, nil
+}
+
+// expiresIn is the lifetime in seconds of the login's token.
+func (l login) expiresIn() int64 {
+	if l.mfaRequired {
+		return int64(tvm.MFAPendingDuration.Seconds())
+	}
+	return int64(OAuthTokenTTL.Seconds())
+}
+
+// setCookies sets the login's cookies for the web app.
+func (l login) setCookies(header http.Header, machine *tvm.VendingMachine) {
+	setLoginCookie(header, l.token)
+	if l.refreshToken != "" {
+		setRefreshCookie(header, l.refreshToken, int(machine.Cfg.RefreshTokenDuration.Seconds()))
+	}
+}
+
+// startSession issues the refresh token of a full login token, or returns "" when refresh tokens are disabled.
+// mfaVerified is set when the login passed a TOTP check.
+func startSession(ctx context.Context, machine *tvm.VendingMachine, locoToken string, mfaVerified bool) (string, error) {
+	refreshToken, err := machine.IssueRefreshToken(ctx, locoToken, mfaVerified)
+	if errors.Is(err, tvm.ErrRefreshDisabled) {
+		return "", nil
+	}
+	return refreshToken, err
+}
+
+// setLoginCookie sets the loco token as an http-only cookie for the web app.
+func setLoginCookie(header http.Header, locoToken string) {
+	header.Set("Set-Cookie", fmt.Sprintf(
+		"loco_token=%s; Path=/; Max-Age=%d; HttpOnly; SameSite=Lax",
+		locoToken,
+		int(OAuthTokenTTL.Seconds()),
+	))
+}
+
+// setRefreshCookie sets the refresh token as an http-only cookie only sent to RefreshToken. It is added after
+// setLoginCookie, which replaces the cookies set before it. An empty token and maxAge clear the cookie.
+func setRefreshCookie(header http.Header, refreshToken string, maxAge int) {
+	header.Add("Set-Cookie", fmt.Sprintf(
+		"%s=%s; Path=%s; Max-Age=%d; HttpOnly; SameSite=Strict",
+		refreshCookie,
+		refreshToken,
+		oauthv1connect.OAuthServiceRefreshTokenProcedure,
+		maxAge,
+	))
+}
+
 func (s *OAuthServer) GetOAuthDetails(
 	ctx context.Context, req *connect.Request[oAuth.GetOAuthDetailsRequest],
 ) (*connect.Response[oAuth.GetOAuthDetailsResponse], error) {
-	// Currently only GitHub is supported
-	if req.Msg.GetProvider() != oAuth.OAuthProvider_O_AUTH_PROVIDER_GITHUB {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unsupported oauth provider"))
+	_, conf, err := s.provider(ctx, req.Msg.GetProvider())
+	if err != nil {
+		return nil, err
 	}
 
 	res := connect.NewResponse(&oAuth.GetOAuthDetailsResponse{
-		ClientId: OAuthConf.ClientID,
+		ClientId: conf.ClientID,
 		TokenTtl: OAuthTokenTTL.Seconds(),
 	})
 	return res, nil
@@ -213,8 +460,8 @@ func (s *OAuthServer) ExchangeOAuthToken(
 	ctx context.Context,
 	req *connect.Request[oAuth.ExchangeOAuthTokenRequest],
 ) (*connect.Response[oAuth.ExchangeOAuthTokenResponse], error) {
-	// Currently only GitHub is supported
-	if req.Msg.GetProvider() != oAuth.OAuthProvider_O_AUTH_PROVIDER_GITHUB {
+	provider, ok := s.providers[req.Msg.GetProvider()]
+	if !ok {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unsupported oauth provider"))
 	}
 
@@ -225,20 +472,22 @@ func (s *OAuthServer) ExchangeOAuthToken(
 	}
 
 	// initiate login
-	user, locoToken, err := s.machine.Exchange(ctx, providers.Github(token))
+	l, err := s.exchange(ctx, provider.email(ctx, token))
 	if err != nil {
 		slog.ErrorContext(ctx, "exchange oauth token", "error", err)
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("exchange token: %w", err))
 	}
 
 	res := connect.NewResponse(&oAuth.ExchangeOAuthTokenResponse{
-		LocoToken: locoToken,
-		ExpiresIn: int64(OAuthTokenTTL.Seconds()),
-		UserId:    user.ID,
-		Name:      user.Name.String,
+		LocoToken:    l.token,
+		ExpiresIn:    l.expiresIn(),
+		UserId:       l.user.ID,
+		Name:         l.user.Name.String,
+		MfaRequired:  l.mfaRequired,
+		RefreshToken: l.refreshToken,
 	})
 
-	slog.InfoContext(ctx, "exchanged oauth token for loco token", "userId", user.ID)
+	slog.InfoContext(ctx, "exchanged oauth token for loco token", "userId", l.user.ID)
 	return res, nil
 }
 
@@ -247,14 +496,13 @@ func (s *OAuthServer) GetOAuthAuthorizationURL(
 	ctx context.Context,
 	req *connect.Request[oAuth.GetOAuthAuthorizationURLRequest],
 ) (*connect.Response[oAuth.GetOAuthAuthorizationURLResponse], error) {
-	// Currently only GitHub is supported
-	if req.Msg.GetProvider() != oAuth.OAuthProvider_O_AUTH_PROVIDER_GITHUB {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unsupported oauth provider"))
+	_, conf, err := s.provider(ctx, req.Msg.GetProvider())
+	if err != nil {
+		return nil, err
 	}
 
 	state := req.Msg.GetState()
 	if state == "" {
-		var err error
 		state, err = generateSecureRandomString(32)
 		if err != nil {
 			slog.ErrorContext(ctx, "failed to generate state", "error", err)
@@ -267,8 +515,7 @@ func (s *OAuthServer) GetOAuthAuthorizationURL(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to store state: %w", err))
 	}
 
-	// build github oauth url
-	authURL := OAuthConf.AuthCodeURL(state, oauth2.AccessTypeOffline)
+	authURL := conf.AuthCodeURL(state, oauth2.AccessTypeOffline)
 
 	res := connect.NewResponse(&oAuth.GetOAuthAuthorizationURLResponse{
 		AuthorizationUrl: authURL,
@@ -284,9 +531,9 @@ func (s *OAuthServer) ExchangeOAuthCode(
 	ctx context.Context,
 	req *connect.Request[oAuth.ExchangeOAuthCodeRequest],
 ) (*connect.Response[oAuth.ExchangeOAuthCodeResponse], error) {
-	// Currently only GitHub is supported
-	if req.Msg.GetProvider() != oAuth.OAuthProvider_O_AUTH_PROVIDER_GITHUB {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unsupported oauth provider"))
+	provider, conf, err := s.provider(ctx, req.Msg.GetProvider())
+	if err != nil {
+		return nil, err
 	}
 
 	code := req.Msg.GetCode()
@@ -310,8 +557,8 @@ func (s *OAuthServer) ExchangeOAuthCode(
 	}
 	slog.InfoContext(ctx, "state verified and deleted", "state", state)
 
-	// exchange authorization code for github access token
-	token, err := OAuthConf.Exchange(ctx, code)
+	// exchange authorization code for the provider's access token
+	token, err := conf.Exchange(ctx, code)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to exchange authorization code", "error", err)
 		return nil, connect.NewError(
@@ -320,55 +567,50 @@ func (s *OAuthServer) ExchangeOAuthCode(
 		)
 	}
 
-	// get github user data
-	emailResp := providers.Github(token.AccessToken)
+	emailResp := provider.email(ctx, token.AccessToken)
 	address, err := emailResp.Address()
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to get email from github token", "error", err)
+		slog.ErrorContext(ctx, "failed to get email from oauth token", "provider", req.Msg.GetProvider(), "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get email: %w", err))
 	}
 
 	// try to exchange token for existing user
-	user, locoToken, err := s.machine.Exchange(ctx, emailResp)
+	l, err := s.exchange(ctx, emailResp)
 	if err == tvm.ErrUserNotFound {
-		// user doesn't exist, fetch github profile and create user
-		githubUser, err := s.fetchGithubUserData(token.AccessToken)
+		// user doesn't exist, fetch their profile and create user
+		profile, err := provider.profile(ctx, token.AccessToken)
 		if err != nil {
-			slog.ErrorContext(ctx, "failed to fetch github user data", "error", err)
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch github user: %w", err))
+			slog.ErrorContext(ctx, "failed to fetch oauth user profile", "provider", req.Msg.GetProvider(), "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch user profile: %w", err))
 		}
 
-		createdUser, err := s.tempCreateUser(ctx, fmt.Sprintf("%d", githubUser.ID), address, githubUser.Name, githubUser.Avatar)
+		createdUser, err := s.tempCreateUser(ctx, profile.externalID, address, profile.name, profile.avatarURL)
 		if err != nil {
 			slog.ErrorContext(ctx, "failed to create user", "error", err)
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create user: %w", err))
 		}
 
 		// exchange again with newly created user
-		user, locoToken, err = s.machine.Exchange(ctx, emailResp)
+		l, err = s.exchange(ctx, emailResp)
 		if err != nil {
-			slog.ErrorContext(ctx, "exchange github token for new user", "error", err)
+			slog.ErrorContext(ctx, "exchange oauth token for new user", "error", err)
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("exchange token: %w", err))
 		}
-		slog.InfoContext(ctx, "created new user from github oauth", "userId", createdUser.ID)
+		slog.InfoContext(ctx, "created new user from oauth", "userId", createdUser.ID, "provider", req.Msg.GetProvider())
 	} else if err != nil {
 		slog.ErrorContext(ctx, "failed to exchange token", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	res := connect.NewResponse(&oAuth.ExchangeOAuthCodeResponse{
-		ExpiresIn: int64(OAuthTokenTTL.Seconds()),
-		UserId:    user.ID,
-		Name:      user.Name.String,
+		ExpiresIn:   l.expiresIn(),
+		UserId:      l.user.ID,
+		Name:        l.user.Name.String,
+		MfaRequired: l.mfaRequired,
 	})
 
-	// set loco token as http-only cookie
-	res.Header().Set("Set-Cookie", fmt.Sprintf(
-		"loco_token=%s; Path=/; Max-Age=%d; HttpOnly; SameSite=Lax",
-		locoToken,
-		int(OAuthTokenTTL.Seconds()),
-	))
+	l.setCookies(res.Header(), s.machine)
 
-	slog.InfoContext(ctx, "exchanged oauth code for loco token", "userId", user.ID, "method", "cookie", "provider", req.Msg.GetProvider())
+	slog.InfoContext(ctx, "exchanged oauth code for loco token", "userId", l.user.ID, "method", "cookie", "provider", req.Msg.GetProvider())
 	return res, nil
 }
@@ -5,16 +5,21 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
 	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/scim"
 	"github.com/team-loco/loco/api/timeutil"
 	"github.com/team-loco/loco/api/tvm"
 	"github.com/team-loco/loco/api/tvm/actions"
 	orgv1 "github.com/team-loco/loco/shared/proto/org/v1"
+	tokenv1 "github.com/team-loco/loco/shared/proto/token/v1"
 )
 
 var (
@@ -23,18 +28,25 @@ var (
 	ErrOrgHasWorkspacesWithResources = errors.New("organization has workspaces with resources")
 	ErrNotOrgMember                  = errors.New("user is not a member of this organization")
 	ErrNotOrgAdmin                   = errors.New("user is not an admin of this organization")
+	ErrScopeOutsideOrg               = errors.New("directory group scopes must be within the organization")
+	ErrDirectoryGroupEscalates       = errors.New("cannot map a directory group to a scope the caller does not hold")
+	ErrOrgDeletionBlocked            = errors.New("organization cannot be deleted")
+	ErrOrgDeletionInProgress         = errors.New("organization is already being deleted")
+	ErrOrgDeletionNotFound           = errors.New("organization deletion not found")
+	ErrOrgRequireMFANotEnrolled      = errors.New("enroll in MFA before requiring it of the organization")
 )
 
 // OrgServer implements the OrgService gRPC server
 type OrgServer struct {
-	db      *pgxpool.Pool
-	queries genDb.Querier
-	machine *tvm.VendingMachine
+	db        apiDb.Beginner
+	queries   genDb.Querier
+	machine   *tvm.VendingMachine
+	directory *scim.Directory
 }
 
 // NewOrgServer creates a new OrgServer instance
-func NewOrgServer(db *pgxpool.Pool, queries genDb.Querier, machine *tvm.VendingMachine) *OrgServer {
-	return &OrgServer{db: db, queries: queries, machine: machine}
+func NewOrgServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine, directory *scim.Directory) *OrgServer {
+	return &OrgServer{db: db, queries: queries, machine: machine, directory: directory}
 }
 
 // CreateOrg creates a new organization
@@ -44,7 +56,7 @@ func (s *OrgServer) CreateOrg(
 ) (*connect.Response[orgv1.CreateOrgResponse], error) {
 	r := req.Msg
 
-	entity, ok := ctx.Value(contextkeys.EntityKey).(genDb.Entity)
+	entity, ok := contextkeys.Entity(ctx)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
 	}
@@ -54,14 +66,14 @@ func (s *OrgServer) CreateOrg(
 		return nil, connect.NewError(connect.CodePermissionDenied, ErrImproperUsage)
 	}
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
 	}
 
 	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.CreateOrg, entity.ID)); err != nil {
-		slog.WarnContext(ctx, "unauthorized to create org", "entityId", entity.ID, "entityType", entity.Type, "entityScopes", ctx.Value(contextkeys.EntityScopesKey))
+		slog.WarnContext(ctx, "unauthorized to create org", "entityId", entity.ID, "entityType", entity.Type, "entityScopes", scopes)
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 	user, err := s.queries.GetUserByID(ctx, entity.ID)
@@ -143,7 +155,7 @@ func (s *OrgServer) GetOrg(
 		return nil, connect.NewError(connect.CodeNotFound, ErrOrgNotFound)
 	}
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -155,13 +167,7 @@ func (s *OrgServer) GetOrg(
 	}
 
 	return connect.NewResponse(&orgv1.GetOrgResponse{
-		Organization: &orgv1.Organization{
-			Id:        org.ID,
-			Name:      org.Name,
-			CreatedBy: org.CreatedBy,
-			CreatedAt: timeutil.ParsePostgresTimestamp(org.CreatedAt.Time),
-			UpdatedAt: timeutil.ParsePostgresTimestamp(org.UpdatedAt.Time),
-		},
+		Organization: orgToProto(org),
 	}), nil
 }
 
@@ -172,7 +178,7 @@ func (s *OrgServer) ListUserOrgs(
 ) (*connect.Response[orgv1.ListUserOrgsResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -209,13 +215,7 @@ func (s *OrgServer) ListUserOrgs(
 
 	var orgResponses []*orgv1.Organization
 	for _, org := range orgs {
-		orgResponses = append(orgResponses, &orgv1.Organization{
-			Id:        org.ID,
-			Name:      org.Name,
-			CreatedBy: org.CreatedBy,
-			CreatedAt: timeutil.ParsePostgresTimestamp(org.CreatedAt.Time),
-			UpdatedAt: timeutil.ParsePostgresTimestamp(org.UpdatedAt.Time),
-		})
+		orgResponses = append(orgResponses, orgToProto(org))
 	}
 
 	var nextPageToken string
@@ -236,7 +236,7 @@ func (s *OrgServer) UpdateOrg(
 ) (*connect.Response[orgv1.UpdateOrgResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -277,14 +277,20 @@ func (s *OrgServer) UpdateOrg(
 	}), nil
 }
 
-// DeleteOrg deletes an organization
+// DeleteOrg deletes an organization. With cascade, the deletion is queued for the org deletion
+// worker, which tears down every resource first; with dry_run, only the report is returned.
 func (s *OrgServer) DeleteOrg(
 	ctx context.Context,
 	req *connect.Request[orgv1.DeleteOrgRequest],
 ) (*connect.Response[orgv1.DeleteOrgResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -295,24 +301,231 @@ func (s *OrgServer) DeleteOrg(
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
-	hasResources, err := s.queries.OrgHasWorkspacesWithResources(ctx, r.GetOrgId())
+	org, err := s.queries.GetOrgByID(ctx, r.GetOrgId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrOrgNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get org", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	report, err := s.orgDeletionReport(ctx, org, r.GetCascade())
+	if err != nil {
+		return nil, err
+	}
+	if r.GetDryRun() {
+		return connect.NewResponse(&orgv1.DeleteOrgResponse{Report: report}), nil
+	}
+
+	if err := ensureOrgDeletable(ctx, s.queries, org.ID); err != nil {
+		return nil, err
+	}
+
+	if !r.GetCascade() {
+		if report.GetResources() > 0 {
+			slog.WarnContext(ctx, "org has workspaces with resources", "orgId", org.ID)
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrOrgHasWorkspacesWithResources)
+		}
+
+		if err := s.queries.DeleteOrg(ctx, org.ID); err != nil {
+			slog.ErrorContext(ctx, "failed to delete org", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		return connect.NewResponse(&orgv1.DeleteOrgResponse{Report: report}), nil
+	}
+
+	if len(report.GetBlockers()) > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%w: %s", ErrOrgDeletionBlocked, strings.Join(report.GetBlockers(), "; ")))
+	}
+
+	deletion, err := s.queries.CreateOrgDeletion(ctx, genDb.CreateOrgDeletionParams{
+		OrgID:           org.ID,
+		OrgName:         org.Name,
+		TotalResources:  report.GetResources(),
+		RequestedByType: entity.Type,
+		RequestedByID:   entity.ID,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique_violation
+			return nil, connect.NewError(connect.CodeAlreadyExists, ErrOrgDeletionInProgress)
+		}
+		slog.ErrorContext(ctx, "failed to create org deletion", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "queued org deletion", "orgId", org.ID, "deletionId", deletion.ID, "resources", deletion.TotalResources)
+	return connect.NewResponse(&orgv1.DeleteOrgResponse{
+		Report:   report,
+		Deletion: orgDeletionToProto(deletion),
+	}), nil
+}
+
+// orgDeletionReport lists what deleting org affects and anything that stops it from being deleted
+func (s *OrgServer) orgDeletionReport(ctx context.Context, org genDb.Organization, cascade bool) (*orgv1.OrgDeletionReport, error) {
+	workspaces, err := s.queries.ListWorkspaceNamesForOrg(ctx, org.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list workspaces for org deletion", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	resources, err := s.queries.ListOrgDeletionResources(ctx, org.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list resources for org deletion", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	report := &orgv1.OrgDeletionReport{}
+	byID := make(map[int64]*orgv1.AffectedWorkspace, len(workspaces))
+	for _, w := range workspaces {
+		workspace := &orgv1.AffectedWorkspace{Id: w.ID, Name: w.Name}
+		byID[w.ID] = workspace
+		report.Workspaces = append(report.Workspaces, workspace)
+	}
+
+	locked := 0
+	for _, r := range resources {
+		workspace, ok := byID[r.WorkspaceID]
+		if !ok {
+			// created after the workspaces were listed
+			continue
+		}
+		workspace.Resources = append(workspace.Resources, &orgv1.AffectedResource{
+			Id:                r.ID,
+			Name:              r.Name,
+			Domains:           r.Domains,
+			Deployments:       int32(r.Deployments),
+			ActiveDeployments: int32(r.ActiveDeployments),
+			Locked:            r.Locked,
+		})
+		report.Resources++
+		report.Domains += int32(len(r.Domains))
+		report.Deployments += int32(r.Deployments)
+		if r.Locked {
+			locked++
+		}
+	}
+
+	if org.DeletionProtection {
+		report.Blockers = append(report.Blockers, ErrDeletionProtected.Error())
+	}
+	if !cascade && report.Resources > 0 {
+		report.Blockers = append(report.Blockers, ErrOrgHasWorkspacesWithResources.Error())
+	}
+	if cascade && locked > 0 {
+		report.Blockers = append(report.Blockers, fmt.Sprintf("%d resource(s) are locked", locked))
+	}
+	return report, nil
+}
+
+// GetOrgDeletion reports the progress of a cascading org deletion
+func (s *OrgServer) GetOrgDeletion(
+	ctx context.Context,
+	req *connect.Request[orgv1.GetOrgDeletionRequest],
+) (*connect.Response[orgv1.GetOrgDeletionResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	deletion, err := s.queries.GetOrgDeletion(ctx, r.GetDeletionId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrOrgDeletionNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get org deletion", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetOrgDeletion, deletion.OrgID)); err != nil {
+		slog.WarnContext(ctx, "unauthorized to get org deletion", "deletionId", deletion.ID)
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	return connect.NewResponse(&orgv1.GetOrgDeletionResponse{Deletion: orgDeletionToProto(deletion)}), nil
+}
+
+// SetOrgDeletionProtection turns deletion protection on or off
+func (s *OrgServer) SetOrgDeletionProtection(
+	ctx context.Context,
+	req *connect.Request[orgv1.SetOrgDeletionProtectionRequest],
+) (*connect.Response[orgv1.SetOrgDeletionProtectionResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.SetOrgDeletionProtection, r.GetOrgId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to set org deletion protection", "orgId", r.GetOrgId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	org, err := s.queries.SetOrgDeletionProtection(ctx, genDb.SetOrgDeletionProtectionParams{
+		ID:                 r.GetOrgId(),
+		DeletionProtection: r.GetEnabled(),
+	})
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to check for resources in workspaces", "error", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrOrgNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to set org deletion protection", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
-	if hasResources {
-		slog.WarnContext(ctx, "org has workspaces with resources", "orgId", r.GetOrgId())
-		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrOrgHasWorkspacesWithResources)
+	slog.InfoContext(ctx, "set org deletion protection", "orgId", org.ID, "enabled", org.DeletionProtection)
+	return connect.NewResponse(&orgv1.SetOrgDeletionProtectionResponse{Organization: orgToProto(org)}), nil
+}
+
+// SetOrgRequireMFA turns the MFA requirement of an organization on or off. Turning it on is refused
+// until the caller has enrolled, so they do not find out about it at their next login.
+func (s *OrgServer) SetOrgRequireMFA(
+	ctx context.Context,
+	req *connect.Request[orgv1.SetOrgRequireMFARequest],
+) (*connect.Response[orgv1.SetOrgRequireMFAResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
 	}
 
-	err = s.queries.DeleteOrg(ctx, r.GetOrgId())
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.SetOrgRequireMFA, r.GetOrgId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to set org mfa requirement", "orgId", r.GetOrgId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if entity, ok := contextkeys.Entity(ctx); ok && r.GetEnabled() && entity.Type == genDb.EntityTypeUser {
+		status, err := s.machine.GetMFAStatus(ctx, entity.ID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to get mfa status", "userId", entity.ID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		if !status.Enabled {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrOrgRequireMFANotEnrolled)
+		}
+	}
+
+	org, err := s.queries.SetOrgRequireMFA(ctx, genDb.SetOrgRequireMFAParams{
+		ID:         r.GetOrgId(),
+		RequireMfa: r.GetEnabled(),
+	})
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to delete org", "error", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrOrgNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to set org mfa requirement", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
-	return connect.NewResponse(&orgv1.DeleteOrgResponse{}), nil
+	slog.InfoContext(ctx, "set org mfa requirement", "orgId", org.ID, "enabled", org.RequireMfa)
+	return connect.NewResponse(&orgv1.SetOrgRequireMFAResponse{Organization: orgToProto(org)}), nil
 }
 
 // ListOrgUsers lists users in an organization
@@ -336,7 +549,7 @@ func (s *OrgServer) ListOrgWorkspaces(
 ) (*connect.Response[orgv1.ListOrgWorkspacesResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -363,9 +576,10 @@ func (s *OrgServer) ListOrgWorkspaces(
 
 	// Get workspaces for org
 	workspaces, err := s.queries.ListWorkspacesInOrg(ctx, genDb.ListWorkspacesInOrgParams{
-		OrgID:     r.GetOrgId(),
-		Limit:     pageSize,
-		PageToken: pageToken,
+		OrgID:           r.GetOrgId(),
+		Limit:           pageSize,
+		PageToken:       pageToken,
+		IncludeArchived: r.GetIncludeArchived(),
 	})
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to list workspaces", "error", err)
@@ -392,3 +606,157 @@ func (s *OrgServer) ListOrgWorkspaces(
 		NextPageToken: nextPageToken,
 	}), nil
 }
+
+// SetDirectoryGroupRoles maps a directory group to scopes and resyncs its members
+func (s *OrgServer) SetDirectoryGroupRoles(
+	ctx context.Context,
+	req *connect.Request[orgv1.SetDirectoryGroupRolesRequest],
+) (*connect.Response[orgv1.SetDirectoryGroupRolesResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.SetDirectoryGroupRoles, r.GetOrgId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to set directory group roles", "orgId", r.GetOrgId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	if r.GetGroupName() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("group_name is required"))
+	}
+
+	groupScopes := make([]genDb.EntityScope, 0, len(r.GetScopes()))
+	for _, scope := range r.GetScopes() {
+		if scope.GetEntityType() == tokenv1.EntityType_ENTITY_TYPE_UNSPECIFIED || scope.GetScope() == tokenv1.Scope_SCOPE_UNSPECIFIED {
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidScopes)
+		}
+		es := genDb.EntityScope{
+			EntityType: protoEntityTypeToDb(scope.GetEntityType()),
+			EntityID:   scope.GetEntityId(),
+			Scope:      protoScopeToDb(scope.GetScope()),
+		}
+
+		// a scope is within the org when the same scope on the org implies it
+		orgScope := genDb.EntityScope{EntityType: genDb.EntityTypeOrganization, EntityID: r.GetOrgId(), Scope: es.Scope}
+		if err := s.machine.VerifyWithGivenEntityScopes(ctx, []genDb.EntityScope{orgScope}, es); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s:%s on %d", ErrScopeOutsideOrg, es.EntityType, es.Scope, es.EntityID))
+		}
+		if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, es); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%w: %s:%s on %d", ErrDirectoryGroupEscalates, es.EntityType, es.Scope, es.EntityID))
+		}
+		groupScopes = append(groupScopes, es)
+	}
+
+	var err error
+	if len(groupScopes) == 0 {
+		err = s.queries.DeleteDirectoryGroupRoles(ctx, genDb.DeleteDirectoryGroupRolesParams{
+			OrgID:     r.GetOrgId(),
+			GroupName: r.GetGroupName(),
+		})
+	} else {
+		err = s.queries.UpsertDirectoryGroupRoles(ctx, genDb.UpsertDirectoryGroupRolesParams{
+			OrgID:     r.GetOrgId(),
+			GroupName: r.GetGroupName(),
+			Scopes:    groupScopes,
+		})
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to store directory group roles", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.directory.SyncGroup(ctx, r.GetOrgId(), r.GetGroupName()); err != nil {
+		slog.ErrorContext(ctx, "failed to sync directory group", "orgId", r.GetOrgId(), "group", r.GetGroupName(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&orgv1.SetDirectoryGroupRolesResponse{}), nil
+}
+
+// ListDirectoryGroupRoles lists an organization's directory group mappings
+func (s *OrgServer) ListDirectoryGroupRoles(
+	ctx context.Context,
+	req *connect.Request[orgv1.ListDirectoryGroupRolesRequest],
+) (*connect.Response[orgv1.ListDirectoryGroupRolesResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ListDirectoryGroupRoles, r.GetOrgId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	roles, err := s.queries.ListDirectoryGroupRoles(ctx, r.GetOrgId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list directory group roles", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	groups := make([]*orgv1.DirectoryGroupRoles, len(roles))
+	for i, role := range roles {
+		groupScopes := make([]*tokenv1.EntityScope, len(role.Scopes))
+		for j, scope := range role.Scopes {
+			groupScopes[j] = &tokenv1.EntityScope{
+				Scope:      dbScopeToProto(scope.Scope),
+				EntityType: dbEntityTypeToProto(scope.EntityType),
+				EntityId:   scope.EntityID,
+			}
+		}
+		groups[i] = &orgv1.DirectoryGroupRoles{GroupName: role.GroupName, Scopes: groupScopes}
+	}
+
+	return connect.NewResponse(&orgv1.ListDirectoryGroupRolesResponse{Groups: groups}), nil
+}
+
+// orgToProto converts a database Organization to the proto Organization
+func orgToProto(org genDb.Organization) *orgv1.Organization {
+	return &orgv1.Organization{
+		Id:                 org.ID,
+		Name:               org.Name,
+		CreatedBy:          org.CreatedBy,
+		CreatedAt:          timeutil.ParsePostgresTimestamp(org.CreatedAt.Time),
+		UpdatedAt:          timeutil.ParsePostgresTimestamp(org.UpdatedAt.Time),
+		DeletionProtection: org.DeletionProtection,
+		RequireMfa:         org.RequireMfa,
+	}
+}
+
+func orgDeletionToProto(deletion genDb.OrgDeletion) *orgv1.OrgDeletion {
+	result := &orgv1.OrgDeletion{
+		Id:               deletion.ID,
+		OrgId:            deletion.OrgID,
+		OrgName:          deletion.OrgName,
+		Status:           orgDeletionStatusToProto(deletion.Status),
+		TotalResources:   deletion.TotalResources,
+		DeletedResources: deletion.DeletedResources,
+		Error:            deletion.Error,
+		CreatedAt:        timeutil.ParsePostgresTimestamp(deletion.CreatedAt.Time),
+		UpdatedAt:        timeutil.ParsePostgresTimestamp(deletion.UpdatedAt.Time),
+	}
+	if deletion.CompletedAt.Valid {
+		result.CompletedAt = timeutil.ParsePostgresTimestamp(deletion.CompletedAt.Time)
+	}
+	return result
+}
+
+func orgDeletionStatusToProto(status genDb.OrgDeletionStatus) orgv1.OrgDeletionStatus {
+	switch status {
+	case genDb.OrgDeletionStatusPending:
+		return orgv1.OrgDeletionStatus_ORG_DELETION_STATUS_PENDING
+	case genDb.OrgDeletionStatusRunning:
+		return orgv1.OrgDeletionStatus_ORG_DELETION_STATUS_RUNNING
+	case genDb.OrgDeletionStatusCompleted:
+		return orgv1.OrgDeletionStatus_ORG_DELETION_STATUS_COMPLETED
+	case genDb.OrgDeletionStatusFailed:
+		return orgv1.OrgDeletionStatus_ORG_DELETION_STATUS_FAILED
+	default:
+		return orgv1.OrgDeletionStatus_ORG_DELETION_STATUS_UNSPECIFIED
+	}
+}
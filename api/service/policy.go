@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/policy"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+	policyv1 "github.com/team-loco/loco/shared/proto/policy/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxPolicyNameLength        = 100
+	maxPolicyDescriptionLength = 1000
+)
+
+var (
+	ErrPolicyNotFound      = errors.New("policy not found")
+	ErrPolicyNameNotUnique = errors.New("policy name already exists in org")
+	ErrInvalidPolicyName   = errors.New("policy name must be between 1 and 100 characters")
+	ErrInvalidPolicyDesc   = errors.New("policy description must be at most 1000 characters")
+	ErrPolicyWorkspace     = errors.New("workspace does not belong to the org")
+	ErrPolicyViolation     = errors.New("request violates policy")
+)
+
+// PolicyServer implements the PolicyService gRPC server
+type PolicyServer struct {
+	db      apiDb.Beginner
+	queries genDb.Querier
+	machine *tvm.VendingMachine
+}
+
+// NewPolicyServer creates a new PolicyServer instance
+func NewPolicyServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine) *PolicyServer {
+	return &PolicyServer{db: db, queries: queries, machine: machine}
+}
+
+// CreatePolicy creates a policy for an org
+func (s *PolicyServer) CreatePolicy(
+	ctx context.Context,
+	req *connect.Request[policyv1.CreatePolicyRequest],
+) (*connect.Response[policyv1.CreatePolicyResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.CreatePolicy, r.GetOrgId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to create policy", "orgId", r.GetOrgId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if err := validatePolicy(r.GetName(), r.GetDescription(), r.GetRule()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	rule, err := protojson.Marshal(r.GetRule())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid rule: %w", err))
+	}
+
+	workspaceID := pgtype.Int8{}
+	if r.WorkspaceId != nil {
+		orgID, err := s.queries.GetWorkspaceOrgID(ctx, r.GetWorkspaceId())
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, connect.NewError(connect.CodeNotFound, ErrWorkspaceNotFound)
+			}
+			slog.ErrorContext(ctx, "failed to get workspace org", "workspaceId", r.GetWorkspaceId(), "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		if orgID != r.GetOrgId() {
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrPolicyWorkspace)
+		}
+		workspaceID = pgtype.Int8{Int64: r.GetWorkspaceId(), Valid: true}
+	}
+
+	createdBy := pgtype.Int8{}
+	if entity.Type == genDb.EntityTypeUser {
+		createdBy = pgtype.Int8{Int64: entity.ID, Valid: true}
+	}
+
+	created, err := s.queries.CreatePolicy(ctx, genDb.CreatePolicyParams{
+		OrgID:       r.GetOrgId(),
+		WorkspaceID: workspaceID,
+		Name:        r.GetName(),
+		Description: r.GetDescription(),
+		Rule:        rule,
+		Enabled:     true,
+		CreatedBy:   createdBy,
+	})
+	if err != nil {
+		if isPgConstraintViolation(err) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, ErrPolicyNameNotUnique)
+		}
+		slog.ErrorContext(ctx, "failed to create policy", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "created policy", "policyId", created.ID, "orgId", created.OrgID, "name", created.Name)
+
+	return connect.NewResponse(&policyv1.CreatePolicyResponse{Policy: policyToProto(created, r.GetRule())}), nil
+}
+
+// ListPolicies lists an org's policies
+func (s *PolicyServer) ListPolicies(
+	ctx context.Context,
+	req *connect.Request[policyv1.ListPoliciesRequest],
+) (*connect.Response[policyv1.ListPoliciesResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ListPolicies, r.GetOrgId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to list policies", "orgId", r.GetOrgId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	policies, err := s.queries.ListPolicies(ctx, r.GetOrgId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list policies", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	protoPolicies := make([]*policyv1.Policy, 0, len(policies))
+	for _, p := range policies {
+		rule, err := policy.ParseRule(p.Rule)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to parse policy rule", "policyId", p.ID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		protoPolicies = append(protoPolicies, policyToProto(p, rule))
+	}
+
+	return connect.NewResponse(&policyv1.ListPoliciesResponse{Policies: protoPolicies}), nil
+}
+
+// UpdatePolicy changes the given fields of a policy
+func (s *PolicyServer) UpdatePolicy(
+	ctx context.Context,
+	req *connect.Request[policyv1.UpdatePolicyRequest],
+) (*connect.Response[policyv1.UpdatePolicyResponse], error) {
+	r := req.Msg
+
+	existing, err := s.authorizePolicy(ctx, r.GetId(), actions.UpdatePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := policy.ParseRule(existing.Rule)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to parse policy rule", "policyId", existing.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	params := genDb.UpdatePolicyParams{
+		ID:          existing.ID,
+		Name:        existing.Name,
+		Description: existing.Description,
+		Rule:        existing.Rule,
+		Enabled:     existing.Enabled,
+	}
+	if r.Name != nil {
+		params.Name = r.GetName()
+	}
+	if r.Description != nil {
+		params.Description = r.GetDescription()
+	}
+	if r.Rule != nil {
+		rule = r.GetRule()
+		if params.Rule, err = protojson.Marshal(rule); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid rule: %w", err))
+		}
+	}
+	if r.Enabled != nil {
+		params.Enabled = r.GetEnabled()
+	}
+	if err := validatePolicy(params.Name, params.Description, rule); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	updated, err := s.queries.UpdatePolicy(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrPolicyNotFound)
+		}
+		if isPgConstraintViolation(err) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, ErrPolicyNameNotUnique)
+		}
+		slog.ErrorContext(ctx, "failed to update policy", "policyId", existing.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "updated policy", "policyId", updated.ID, "orgId", updated.OrgID, "enabled", updated.Enabled)
+
+	return connect.NewResponse(&policyv1.UpdatePolicyResponse{Policy: policyToProto(updated, rule)}), nil
+}
+
+// DeletePolicy deletes a policy
+func (s *PolicyServer) DeletePolicy(
+	ctx context.Context,
+	req *connect.Request[policyv1.DeletePolicyRequest],
+) (*connect.Response[policyv1.DeletePolicyResponse], error) {
+	existing, err := s.authorizePolicy(ctx, req.Msg.GetId(), actions.DeletePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.queries.DeletePolicy(ctx, existing.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to delete policy", "policyId", existing.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, ErrPolicyNotFound)
+	}
+
+	slog.InfoContext(ctx, "deleted policy", "policyId", existing.ID, "orgId", existing.OrgID)
+
+	return connect.NewResponse(&policyv1.DeletePolicyResponse{}), nil
+}
+
+// authorizePolicy loads a policy and checks the caller may perform action on its org.
+func (s *PolicyServer) authorizePolicy(ctx context.Context, policyID int64, action actions.Action) (genDb.Policy, error) {
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return genDb.Policy{}, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	p, err := s.queries.GetPolicy(ctx, policyID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return genDb.Policy{}, connect.NewError(connect.CodeNotFound, ErrPolicyNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get policy", "policyId", policyID, "error", err)
+		return genDb.Policy{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(action, p.OrgID)); err != nil {
+		slog.WarnContext(ctx, "unauthorized to manage policy", "policyId", policyID, "orgId", p.OrgID)
+		return genDb.Policy{}, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return p, nil
+}
+
+// enforcePolicies refuses a resource or deployment in a workspace that breaks enabled policies of
+// the workspace's org, listing every violation in a PolicyViolations error detail.
+func enforcePolicies(ctx context.Context, queries genDb.Querier, workspaceID int64, in policy.Input) error {
+	policies, err := queries.ListWorkspacePolicies(ctx, workspaceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list policies", "workspaceId", workspaceID, "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	violations, err := policy.Evaluate(policies, in)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to evaluate policies", "workspaceId", workspaceID, "error", err)
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return policyViolationError(ctx, workspaceID, violations)
+}
+
+// policyViolationError is the FAILED_PRECONDITION error refusing a request, with the violations as
+// a PolicyViolations detail.
+func policyViolationError(ctx context.Context, workspaceID int64, violations []*policyv1.PolicyViolation) error {
+	messages := make([]string, 0, len(violations))
+	for _, v := range violations {
+		messages = append(messages, fmt.Sprintf("%s: %s", v.GetPolicyName(), v.GetMessage()))
+	}
+	slog.InfoContext(ctx, "refused by policy", "workspaceId", workspaceID, "violations", len(violations))
+
+	cerr := connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%w: %s", ErrPolicyViolation, strings.Join(messages, "; ")))
+	if detail, err := connect.NewErrorDetail(&policyv1.PolicyViolations{Violations: violations}); err == nil {
+		cerr.AddDetail(detail)
+	}
+	return cerr
+}
+
+func validatePolicy(name, description string, rule *policyv1.PolicyRule) error {
+	if name == "" || len(name) > maxPolicyNameLength {
+		return ErrInvalidPolicyName
+	}
+	if len(description) > maxPolicyDescriptionLength {
+		return ErrInvalidPolicyDesc
+	}
+	return policy.ValidateRule(rule)
+}
+
+func policyToProto(p genDb.Policy, rule *policyv1.PolicyRule) *policyv1.Policy {
+	protoPolicy := &policyv1.Policy{
+		Id:          p.ID,
+		OrgId:       p.OrgID,
+		Name:        p.Name,
+		Description: p.Description,
+		Rule:        rule,
+		Enabled:     p.Enabled,
+		CreatedAt:   timestamppb.New(p.CreatedAt.Time),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt.Time),
+	}
+	if p.WorkspaceID.Valid {
+		protoPolicy.WorkspaceId = &p.WorkspaceID.Int64
+	}
+	return protoPolicy
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/registry"
+	"github.com/team-loco/loco/api/tvm/actions"
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// recordProvenanceInBackground reads the OCI source labels of a deployment's image and stores the
+// commit and repository they name. Images are only looked up in registries when digests are pinned.
+func (s *DeploymentServer) recordProvenanceInBackground(deploymentID int64, image string) {
+	if s.resolver == nil || image == "" {
+		return
+	}
+
+	go func() {
+		ctx := context.Background()
+		params := genDb.UpsertDeploymentProvenanceParams{DeploymentID: deploymentID, Image: image}
+		labels, err := s.resolver.Labels(ctx, image)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to read image labels", "image", image, "deployment_id", deploymentID, "error", err)
+			params.Error = pgtype.Text{String: err.Error(), Valid: true}
+		} else {
+			params.CommitSha = strings.ToLower(labels[registry.LabelRevision])
+			params.RepositoryUrl = labels[registry.LabelSource]
+		}
+		if err := s.queries.UpsertDeploymentProvenance(ctx, params); err != nil {
+			slog.ErrorContext(ctx, "failed to record deployment provenance", "deployment_id", deploymentID, "error", err)
+		}
+	}()
+}
+
+// GetDeploymentProvenance returns the commit a deployment was built from
+func (s *DeploymentServer) GetDeploymentProvenance(
+	ctx context.Context,
+	req *connect.Request[deploymentv1.GetDeploymentProvenanceRequest],
+) (*connect.Response[deploymentv1.GetDeploymentProvenanceResponse], error) {
+	r := req.Msg
+
+	deployment, err := s.queries.GetDeploymentByID(ctx, r.GetDeploymentId())
+	if err != nil {
+		slog.WarnContext(ctx, "deployment not found", "deployment_id", r.GetDeploymentId())
+		return nil, connect.NewError(connect.CodeNotFound, ErrDeploymentNotFound)
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetDeployment, deployment.ResourceID)); err != nil {
+		slog.WarnContext(ctx, "unauthorized to get deployment provenance", "deploymentId", deployment.ID)
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	provenance := &deploymentv1.DeploymentProvenance{DeploymentId: deployment.ID}
+
+	stored, err := s.queries.GetDeploymentProvenance(ctx, deployment.ID)
+	switch {
+	case err == nil:
+		provenance.Image = stored.Image
+		provenance.FetchedAt = timestamppb.New(stored.FetchedAt.Time)
+		if stored.Error.Valid {
+			provenance.Error = &stored.Error.String
+		}
+		if stored.CommitSha != "" {
+			provenance.Source = deploymentv1.ProvenanceSource_PROVENANCE_SOURCE_IMAGE_LABELS
+			provenance.CommitSha = stored.CommitSha
+		}
+		provenance.RepositoryUrl = stored.RepositoryUrl
+	case !errors.Is(err, pgx.ErrNoRows):
+		slog.ErrorContext(ctx, "failed to get deployment provenance", "deployment_id", deployment.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	// the image's labels win; what the deployer said it deployed fills in for images without them
+	if provenance.CommitSha == "" && deployment.AnnotationGitSha != "" {
+		provenance.Source = deploymentv1.ProvenanceSource_PROVENANCE_SOURCE_ANNOTATION
+		provenance.CommitSha = deployment.AnnotationGitSha
+	}
+	provenance.CommitUrl = commitURL(provenance.RepositoryUrl, provenance.CommitSha)
+
+	return connect.NewResponse(&deploymentv1.GetDeploymentProvenanceResponse{Provenance: provenance}), nil
+}
+
+// commitURL links to a commit on GitHub or GitLab, or returns "" for other hosts.
+func commitURL(repositoryURL, sha string) string {
+	if repositoryURL == "" || sha == "" {
+		return ""
+	}
+	u, err := url.Parse(strings.TrimSuffix(repositoryURL, ".git"))
+	if err != nil || u.Scheme != "https" {
+		return ""
+	}
+	switch {
+	case u.Host == "github.com":
+		return u.String() + "/commit/" + sha
+	case u.Host == "gitlab.com" || strings.HasPrefix(u.Host, "gitlab."):
+		return u.String() + "/-/commit/" + sha
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/converter"
+	"github.com/team-loco/loco/api/tvm/actions"
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ReapplyDeployment writes the Application for an existing deployment, as the reconciler does
+// when the cluster lost or changed it. env seeds the deployment's env vars, since they are not
+// stored with the deployment; the workspace's and environment's env vars are merged in as usual.
+func (s *DeploymentServer) ReapplyDeployment(ctx context.Context, deploymentID int64, env map[string]string) error {
+	deployment, err := s.queries.GetDeploymentByID(ctx, deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to get deployment: %w", err)
+	}
+	if !deployment.IsActive {
+		return fmt.Errorf("deployment %d is not active", deploymentID)
+	}
+
+	resource, err := s.queries.GetResourceByID(ctx, deployment.ResourceID)
+	if err != nil {
+		return fmt.Errorf("failed to get resource: %w", err)
+	}
+	resourceSpec, err := converter.DeserializeResourceSpec(resource.Spec, resource.Type)
+	if err != nil {
+		return fmt.Errorf("invalid resource spec: %w", err)
+	}
+	deploymentSpec, err := converter.DeserializeDeploymentSpec(deployment.Spec, string(resource.Type))
+	if err != nil {
+		return fmt.Errorf("invalid deployment spec: %w", err)
+	}
+	if service := deploymentSpec.GetService(); service != nil {
+		service.Env = env
+	}
+
+	domain, err := s.queries.GetDomainByResourceId(ctx, resource.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get domain: %w", err)
+	}
+	inherited, err := loadInheritedEnv(ctx, s.queries, resource)
+	if err != nil {
+		return err
+	}
+
+	if err := createLocoResource(ctx, s.kubeClient, s.queries, resource, resourceSpec, domain, inherited, deploymentSpec, s.locoNamespace, deployment.Region); err != nil {
+		return err
+	}
+	markResourceAwake(ctx, s.queries, resource.ID)
+	return nil
+}
+
+// ListClusterDiscrepancies lists what the reconciler last found out of sync, for one workspace or, for system readers, all of them
+func (s *DeploymentServer) ListClusterDiscrepancies(
+	ctx context.Context,
+	req *connect.Request[deploymentv1.ListClusterDiscrepanciesRequest],
+) (*connect.Response[deploymentv1.ListClusterDiscrepanciesResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	action := actions.New(actions.ListAllClusterDiscrepancies, 0)
+	if r.WorkspaceId != nil {
+		action = actions.New(actions.ListClusterDiscrepancies, r.GetWorkspaceId())
+	}
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, action); err != nil {
+		slog.WarnContext(ctx, "unauthorized to list cluster discrepancies", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	workspaceID := pgtype.Int8{}
+	if r.WorkspaceId != nil {
+		workspaceID = pgtype.Int8{Int64: r.GetWorkspaceId(), Valid: true}
+	}
+	discrepancies, err := s.queries.ListClusterDiscrepancies(ctx, workspaceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list cluster discrepancies", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	result := make([]*deploymentv1.ClusterDiscrepancy, 0, len(discrepancies))
+	for _, d := range discrepancies {
+		result = append(result, clusterDiscrepancyToProto(d))
+	}
+
+	return connect.NewResponse(&deploymentv1.ListClusterDiscrepanciesResponse{Discrepancies: result}), nil
+}
+
+func clusterDiscrepancyToProto(d genDb.ClusterDiscrepancy) *deploymentv1.ClusterDiscrepancy {
+	result := &deploymentv1.ClusterDiscrepancy{
+		ResourceId:  d.ResourceID,
+		WorkspaceId: d.WorkspaceID,
+		Region:      d.Region,
+		Kind:        discrepancyKindToProto(d.Kind),
+		Detail:      d.Detail,
+		DetectedAt:  timestamppb.New(d.DetectedAt.Time),
+	}
+	if d.DeploymentID.Valid {
+		result.DeploymentId = &d.DeploymentID.Int64
+	}
+	if d.RepairedAt.Valid {
+		result.RepairedAt = timestamppb.New(d.RepairedAt.Time)
+	}
+	return result
+}
+
+func discrepancyKindToProto(kind genDb.DiscrepancyKind) deploymentv1.DiscrepancyKind {
+	switch kind {
+	case genDb.DiscrepancyKindMissing:
+		return deploymentv1.DiscrepancyKind_DISCREPANCY_KIND_MISSING
+	case genDb.DiscrepancyKindStale:
+		return deploymentv1.DiscrepancyKind_DISCREPANCY_KIND_STALE
+	case genDb.DiscrepancyKindOrphaned:
+		return deploymentv1.DiscrepancyKind_DISCREPANCY_KIND_ORPHANED
+	default:
+		return deploymentv1.DiscrepancyKind_DISCREPANCY_KIND_UNSPECIFIED
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/team-loco/loco/api/tvm"
+	oAuth "github.com/team-loco/loco/shared/proto/oauth/v1"
+)
+
+// refreshCookie is the cookie the web app's refresh token is kept in.
+const refreshCookie = "loco_refresh"
+
+// RefreshToken trades a refresh token for a new Loco token and refresh token. The CLI sends the token in the
+// request; the web app sends the cookie set when it logged in.
+func (s *OAuthServer) RefreshToken(
+	ctx context.Context,
+	req *connect.Request[oAuth.RefreshTokenRequest],
+) (*connect.Response[oAuth.RefreshTokenResponse], error) {
+	refreshToken := req.Msg.GetRefreshToken()
+	if refreshToken == "" {
+		if cookies, err := http.ParseCookie(req.Header().Get("Cookie")); err == nil {
+			for _, cookie := range cookies {
+				if cookie.Name == refreshCookie {
+					refreshToken = cookie.Value
+				}
+			}
+		}
+	}
+	if refreshToken == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("refresh token is required"))
+	}
+
+	locoToken, next, err := s.machine.Refresh(ctx, refreshToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, tvm.ErrRefreshDisabled):
+			return nil, connect.NewError(connect.CodeUnimplemented, err)
+		case errors.Is(err, tvm.ErrRefreshTokenReused):
+			slog.WarnContext(ctx, "refresh token reused")
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, tvm.ErrInvalidRefreshToken), errors.Is(err, tvm.ErrSessionMFARequired):
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		slog.ErrorContext(ctx, "failed to refresh token", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := connect.NewResponse(&oAuth.RefreshTokenResponse{
+		LocoToken:    locoToken,
+		ExpiresIn:    int64(s.machine.Cfg.LoginTokenDuration.Seconds()),
+		RefreshToken: next,
+	})
+	setLoginCookie(res.Header(), locoToken)
+	setRefreshCookie(res.Header(), next, int(s.machine.Cfg.RefreshTokenDuration.Seconds()))
+	return res, nil
+}
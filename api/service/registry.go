@@ -8,9 +8,9 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/team-loco/loco/api/client"
 	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
 	"github.com/team-loco/loco/api/gen/db"
 	"github.com/team-loco/loco/api/tvm"
 	"github.com/team-loco/loco/api/tvm/actions"
@@ -19,7 +19,7 @@ import (
 
 // RegistryServer implements the RegistryService
 type RegistryServer struct {
-	db                *pgxpool.Pool
+	db                apiDb.Beginner
 	queries           db.Querier
 	gitlabURL         string
 	gitlabPAT         string
@@ -32,7 +32,7 @@ type RegistryServer struct {
 
 // NewRegistryServer creates a new RegistryServer instance
 func NewRegistryServer(
-	dbPool *pgxpool.Pool,
+	dbPool apiDb.Beginner,
 	queries db.Querier,
 	gitlabURL string,
 	gitlabPAT string,
@@ -61,13 +61,13 @@ func (s *RegistryServer) GetGitlabToken(
 	ctx context.Context,
 	req *connect.Request[registryv1.GetGitlabTokenRequest],
 ) (*connect.Response[registryv1.GetGitlabTokenResponse], error) {
-	entity, ok := ctx.Value(contextkeys.EntityKey).(db.Entity)
+	entity, ok := contextkeys.Entity(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthorized"))
 	}
 
-	entityScopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]db.EntityScope)
+	entityScopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthorized"))
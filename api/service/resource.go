@@ -2,30 +2,54 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
 	"sort"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
 	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/changes"
 	"github.com/team-loco/loco/api/pkg/converter"
+	"github.com/team-loco/loco/api/pkg/cost"
+	"github.com/team-loco/loco/api/pkg/drift"
 	"github.com/team-loco/loco/api/pkg/klogmux"
 	"github.com/team-loco/loco/api/pkg/kube"
+	"github.com/team-loco/loco/api/pkg/policy"
 	"github.com/team-loco/loco/api/timeutil"
 	"github.com/team-loco/loco/api/tvm"
 	"github.com/team-loco/loco/api/tvm/actions"
+	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
 	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
 	domainv1 "github.com/team-loco/loco/shared/proto/domain/v1"
 	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
 	"github.com/team-loco/loco/shared/version"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	eventWatchMinBackoff = 500 * time.Millisecond
+	eventWatchMaxBackoff = 30 * time.Second
 )
 
 var (
@@ -38,6 +62,14 @@ var (
 	ErrInvalidResourceType   = errors.New("invalid resource type")
 	ErrInvalidCPU            = errors.New("invalid CPU format")
 	ErrInvalidMemory         = errors.New("invalid memory format")
+	ErrInvalidLogFilter      = errors.New("invalid log filter expression")
+	ErrRegionNotFound        = errors.New("region not configured for this resource")
+	ErrRegionNotPromotable   = errors.New("region cannot be promoted")
+	ErrResourceNotSuspended  = errors.New("resource is not suspended")
+	ErrResourceSuspended     = errors.New("resource is suspended")
+	ErrSpecNotPriceable      = errors.New("only service specs can be priced")
+	ErrScaleToZeroTriggers   = errors.New("scale to zero cannot be combined with scaler triggers or schedules")
+	ErrVersionConflict       = errors.New("changed since the given version was read; read it again and retry")
 )
 
 // protoResourceTypeToDb converts a proto ResourceType to a database ResourceType
@@ -67,15 +99,16 @@ func computeNamespace(workspaceID, resourceID int64) string {
 }
 
 type ResourceServer struct {
-	db            *pgxpool.Pool
+	db            apiDb.Beginner
 	queries       genDb.Querier
 	machine       *tvm.VendingMachine
 	kubeClient    *kube.Client
 	locoNamespace string
+	feed          *changes.Feed
 }
 
 // NewResourceServer creates a new ResourceServer instance
-func NewResourceServer(db *pgxpool.Pool, queries genDb.Querier, machine *tvm.VendingMachine, kubeClient *kube.Client, locoNamespace string) *ResourceServer {
+func NewResourceServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine, kubeClient *kube.Client, locoNamespace string, feed *changes.Feed) *ResourceServer {
 	// todo: move this out.
 	return &ResourceServer{
 		db:            db,
@@ -83,6 +116,7 @@ func NewResourceServer(db *pgxpool.Pool, queries genDb.Querier, machine *tvm.Ven
 		machine:       machine,
 		kubeClient:    kubeClient,
 		locoNamespace: locoNamespace,
+		feed:          feed,
 	}
 }
 
@@ -93,7 +127,7 @@ func (s *ResourceServer) CreateResource(
 ) (*connect.Response[resourcev1.CreateResourceResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -104,6 +138,47 @@ func (s *ResourceServer) CreateResource(
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
+	var environmentID pgtype.Int8
+	if r.EnvironmentId != nil {
+		if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.CreateEnvironmentResource, r.GetEnvironmentId())); err != nil {
+			slog.WarnContext(ctx, "unauthorized to create resource in environment", "environmentId", r.GetEnvironmentId())
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		environment, err := s.queries.GetEnvironmentByID(ctx, r.GetEnvironmentId())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, ErrEnvironmentNotFound)
+		}
+		if environment.WorkspaceID != r.GetWorkspaceId() {
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrEnvironmentWorkspace)
+		}
+		environmentID = pgtype.Int8{Int64: environment.ID, Valid: true}
+	}
+
+	var externalID pgtype.Text
+	if r.ExternalId != nil {
+		if r.GetExternalId() == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrEmptyExternalID)
+		}
+		externalID = pgtype.Text{String: r.GetExternalId(), Valid: true}
+
+		// a retried create returns what the first attempt created
+		existing, err := s.queries.GetResourceByExternalID(ctx, genDb.GetResourceByExternalIDParams{
+			WorkspaceID: r.GetWorkspaceId(),
+			ExternalID:  externalID,
+		})
+		if err == nil {
+			protoResource, err := s.resourceToProto(ctx, existing)
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(&resourcev1.CreateResourceResponse{ResourceId: existing.ID, Resource: protoResource}), nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			slog.ErrorContext(ctx, "failed to get resource by external id", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
 	if r.GetSpec() == nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("spec is required"))
 	}
@@ -117,6 +192,24 @@ func (s *ResourceServer) CreateResource(
 	if len(serviceSpec.GetRegions()) == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("at least one region is required in spec"))
 	}
+	if err := validateScaleToZero(serviceSpec); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validateConfigSchema(serviceSpec.GetConfigSchema()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validateScheduling(serviceSpec.GetScheduling()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validateEntrypoint(serviceSpec); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := s.checkScheduling(ctx, serviceSpec); err != nil {
+		return nil, err
+	}
+	if err := enforcePolicies(ctx, s.queries, r.GetWorkspaceId(), policy.Input{Labels: r.GetLabels(), Resource: serviceSpec}); err != nil {
+		return nil, err
+	}
 
 	if r.GetDomain() == nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("domain is required"))
@@ -211,32 +304,63 @@ func (s *ResourceServer) CreateResource(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	labels, err := marshalLabels(r.GetLabels())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	params := genDb.CreateResourceParams{
-		WorkspaceID: r.GetWorkspaceId(),
-		Name:        r.GetName(),
-		Type:        resourceType,
-		Status:      genDb.ResourceStatusUnavailable,
-		Spec:        specJSON,
-		SpecVersion: version.SpecVersionV1,
-		Description: r.GetDescription(),
+		WorkspaceID:   r.GetWorkspaceId(),
+		Name:          r.GetName(),
+		Type:          resourceType,
+		Status:        genDb.ResourceStatusUnavailable,
+		Spec:          specJSON,
+		SpecVersion:   version.SpecVersionV1,
+		Description:   r.GetDescription(),
+		EnvironmentID: environmentID,
+		ExternalID:    externalID,
+		Labels:        labels,
+	}
+	// the resource, its regions and its domain are created together or not at all
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
-	resourceID, err := s.queries.CreateResource(ctx, params)
+	defer tx.Rollback(ctx)
+
+	qtx := apiDb.QueriesFor(tx)
+
+	resourceID, err := qtx.CreateResource(ctx, params)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to create resource", "error", err)
 		if isPgConstraintViolation(err) {
-			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("a resource with this name already exists in this workspace"))
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("a resource with this name already exists in this workspace or environment"))
 		}
 		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create resource"))
 	}
 
-	// Create resource regions (first region is primary)
-	for region, regionConfig := range serviceSpec.GetRegions() {
-		isPrimary := regionConfig.GetPrimary()
-		_, err := s.queries.CreateResourceRegion(ctx, genDb.CreateResourceRegionParams{
-			ResourceID: resourceID,
-			Region:     region,
-			IsPrimary:  isPrimary,
-			Status:     genDb.RegionIntentStatusDesired,
+	// Create resource regions; the primary gets failover priority 0 and standbys follow by name
+	regionNames := slices.Sorted(maps.Keys(serviceSpec.GetRegions()))
+	slices.SortStableFunc(regionNames, func(a, b string) int {
+		aPrimary, bPrimary := serviceSpec.GetRegions()[a].GetPrimary(), serviceSpec.GetRegions()[b].GetPrimary()
+		switch {
+		case aPrimary == bPrimary:
+			return 0
+		case aPrimary:
+			return -1
+		default:
+			return 1
+		}
+	})
+	for priority, region := range regionNames {
+		isPrimary := serviceSpec.GetRegions()[region].GetPrimary()
+		_, err := qtx.CreateResourceRegion(ctx, genDb.CreateResourceRegionParams{
+			ResourceID:       resourceID,
+			Region:           region,
+			IsPrimary:        isPrimary,
+			Status:           genDb.RegionIntentStatusDesired,
+			FailoverPriority: int32(priority),
 		})
 		if err != nil {
 			slog.ErrorContext(ctx, "failed to create resource region", "error", err)
@@ -253,13 +377,28 @@ func (s *ResourceServer) CreateResource(
 		IsPrimary:        true,
 	}
 
-	_, err = s.queries.CreateResourceDomain(ctx, domainParams)
+	_, err = qtx.CreateResourceDomain(ctx, domainParams)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to create resource domain", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
-	return connect.NewResponse(&resourcev1.CreateResourceResponse{ResourceId: resourceID}), nil
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit resource creation", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	created, err := s.queries.GetResourceByID(ctx, resourceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read back created resource", "resourceId", resourceID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	protoResource, err := s.resourceToProto(ctx, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&resourcev1.CreateResourceResponse{ResourceId: resourceID, Resource: protoResource}), nil
 }
 
 // GetResource retrieves a resource by ID
@@ -274,12 +413,28 @@ func (s *ResourceServer) GetResource(
 	case *resourcev1.GetResourceRequest_ResourceId:
 		resourceId = key.ResourceId
 	case *resourcev1.GetResourceRequest_NameKey:
-		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("name-based lookup not yet implemented"))
+		resource, err := s.queries.GetResourceByNameAndWorkspace(ctx, genDb.GetResourceByNameAndWorkspaceParams{
+			WorkspaceID: key.NameKey.GetWorkspaceId(),
+			Name:        key.NameKey.GetName(),
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+		}
+		resourceId = resource.ID
+	case *resourcev1.GetResourceRequest_ExternalKey:
+		resource, err := s.queries.GetResourceByExternalID(ctx, genDb.GetResourceByExternalIDParams{
+			WorkspaceID: key.ExternalKey.GetWorkspaceId(),
+			ExternalID:  pgtype.Text{String: key.ExternalKey.GetExternalId(), Valid: true},
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+		}
+		resourceId = resource.ID
 	default:
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("resource_id or name_key is required"))
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("resource_id, name_key or external_key is required"))
 	}
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -322,16 +477,14 @@ func (s *ResourceServer) ListWorkspaceResources(
 
 	slog.InfoContext(ctx, "received req to list resources", "workspaceId", r.GetWorkspaceId())
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
 	}
 
-	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ListResources, r.GetWorkspaceId())); err != nil {
-		slog.WarnContext(ctx, "unauthorized to list resources", "workspaceId", r.GetWorkspaceId())
-		return nil, connect.NewError(connect.CodePermissionDenied, err)
-	}
+	// callers without workspace:read still see the resources they were granted individually
+	listErr := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ListResources, r.GetWorkspaceId()))
 
 	pageSize := normalizePageSize(r.GetPageSize())
 
@@ -347,29 +500,64 @@ func (s *ResourceServer) ListWorkspaceResources(
 		}
 	}
 
+	var environmentID pgtype.Int8
+	if r.EnvironmentId != nil {
+		environmentID = pgtype.Int8{Int64: r.GetEnvironmentId(), Valid: true}
+	}
+
 	dbResources, err := s.queries.ListResourcesForWorkspace(ctx, genDb.ListResourcesForWorkspaceParams{
-		WorkspaceID: r.GetWorkspaceId(),
-		Limit:       pageSize,
-		PageToken:   pageToken,
+		WorkspaceID:   r.GetWorkspaceId(),
+		Limit:         pageSize,
+		PageToken:     pageToken,
+		EnvironmentID: environmentID,
 	})
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to list resources", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
-	var resources []*resourcev1.Resource
-	for _, dbResource := range dbResources {
-		resourceDomains, err := s.queries.ListResourceDomains(ctx, dbResource.ID)
-		if err != nil {
-			slog.ErrorContext(ctx, "failed to list resource domains", "resourceId", dbResource.ID, "error", err)
-			continue
+	allowed, err := s.allowedResources(ctx, scopes, listErr, dbResources)
+	if err != nil {
+		if errors.Is(err, listErr) {
+			slog.WarnContext(ctx, "unauthorized to list resources", "workspaceId", r.GetWorkspaceId())
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
 		}
-		resourceRegions, err := s.queries.ListResourceRegions(ctx, dbResource.ID)
-		if err != nil {
-			slog.ErrorContext(ctx, "failed to list resource regions", "resourceId", dbResource.ID, "error", err)
+		slog.ErrorContext(ctx, "failed to verify access to resources", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// the page's domains and regions are fetched in one query each rather than per resource
+	resourceIDs := make([]int64, 0, len(dbResources))
+	for i, dbResource := range dbResources {
+		if allowed[i] {
+			resourceIDs = append(resourceIDs, dbResource.ID)
+		}
+	}
+	allDomains, err := s.queries.ListResourceDomainsForResources(ctx, resourceIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list resource domains", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	allRegions, err := s.queries.ListResourceRegionsForResources(ctx, resourceIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list resource regions", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	domainsByResource := make(map[int64][]genDb.ResourceDomain, len(resourceIDs))
+	for _, domain := range allDomains {
+		domainsByResource[domain.ResourceID] = append(domainsByResource[domain.ResourceID], domain)
+	}
+	regionsByResource := make(map[int64][]genDb.ResourceRegion, len(resourceIDs))
+	for _, region := range allRegions {
+		regionsByResource[region.ResourceID] = append(regionsByResource[region.ResourceID], region)
+	}
+
+	var resources []*resourcev1.Resource
+	for i, dbResource := range dbResources {
+		if !allowed[i] {
 			continue
 		}
-		resources = append(resources, dbResourceToProto(dbResource, resourceDomains, resourceRegions))
+		resources = append(resources, dbResourceToProto(dbResource, domainsByResource[dbResource.ID], regionsByResource[dbResource.ID]))
 	}
 
 	var nextPageToken string
@@ -383,6 +571,31 @@ func (s *ResourceServer) ListWorkspaceResources(
 	}), nil
 }
 
+// allowedResources reports which of resources the caller may read. listErr is the result of the workspace level check;
+// when it failed, each resource is checked on its own and listErr is returned if none are readable.
+func (s *ResourceServer) allowedResources(ctx context.Context, scopes []genDb.EntityScope, listErr error, resources []genDb.Resource) ([]bool, error) {
+	if listErr == nil {
+		allowed := make([]bool, len(resources))
+		for i := range allowed {
+			allowed[i] = true
+		}
+		return allowed, nil
+	}
+
+	entityScopes := make([]genDb.EntityScope, len(resources))
+	for i, resource := range resources {
+		entityScopes[i] = actions.New(actions.GetResource, resource.ID)
+	}
+	allowed, err := s.machine.VerifyAccessBatch(ctx, scopes, entityScopes)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(allowed, true) {
+		return nil, listErr
+	}
+	return allowed, nil
+}
+
 // UpdateResource updates a resource
 func (s *ResourceServer) UpdateResource(
 	ctx context.Context,
@@ -390,7 +603,7 @@ func (s *ResourceServer) UpdateResource(
 ) (*connect.Response[resourcev1.UpdateResourceResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -401,21 +614,147 @@ func (s *ResourceServer) UpdateResource(
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
+	fields, err := resourceUpdateMask.parse(r.GetUpdateMask())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	resource, err := s.queries.GetResourceByID(ctx, r.GetResourceId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+	}
+	if r.Version != nil && r.GetVersion() != resource.Version {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("resource %w (now at version %d)", ErrVersionConflict, resource.Version))
+	}
+
 	updateParams := genDb.UpdateResourceParams{
 		ID: r.GetResourceId(),
 	}
+	if r.Version != nil {
+		updateParams.Version = pgtype.Int8{Int64: r.GetVersion(), Valid: true}
+	}
 
-	if r.GetName() != "" {
+	if fields.has("name", r.GetName() != "") {
+		if r.GetName() == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name cannot be empty"))
+		}
 		updateParams.Name = pgtype.Text{String: r.GetName(), Valid: true}
 	}
+	if fields.has("description", r.Description != nil) {
+		updateParams.Description = pgtype.Text{String: r.GetDescription(), Valid: true}
+	}
+	if fields.has("spec", r.Spec != nil) {
+		regions, err := s.queries.ListResourceRegions(ctx, resource.ID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to list resource regions", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		if updateParams.Spec, err = marshalUpdatedSpec(resource, regions, r.GetSpec()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err := s.checkScheduling(ctx, r.GetSpec().GetService()); err != nil {
+			return nil, err
+		}
+	}
+	if fields.has("labels", len(r.GetLabels()) > 0) {
+		if updateParams.Labels, err = marshalLabels(r.GetLabels()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
 
-	_, err := s.queries.UpdateResource(ctx, updateParams)
+	_, err = s.queries.UpdateResource(ctx, updateParams)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// the resource was read above, so no row means another update got in first
+			if updateParams.Version.Valid {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("resource %w", ErrVersionConflict))
+			}
+			return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+		}
 		slog.ErrorContext(ctx, "failed to update resource", "error", err)
+		if isPgConstraintViolation(err) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("a resource with this name already exists in this workspace or environment"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	updated, err := s.queries.GetResourceByID(ctx, r.GetResourceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read back updated resource", "resourceId", r.GetResourceId(), "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
+	protoResource, err := s.resourceToProto(ctx, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&resourcev1.UpdateResourceResponse{ResourceId: r.GetResourceId(), Resource: protoResource}), nil
+}
+
+// marshalUpdatedSpec validates a replacement spec for a resource and encodes it for storage.
+// The spec must keep the resource's type, and a service must keep its regions and primary.
+func marshalUpdatedSpec(resource genDb.Resource, regions []genDb.ResourceRegion, spec *resourcev1.ResourceSpec) ([]byte, error) {
+	serviceSpec := spec.GetService()
+	if serviceSpec == nil || resource.Type != genDb.ResourceTypeService {
+		return nil, fmt.Errorf("%w: spec must match the resource type %q", ErrImmutableField, resource.Type)
+	}
+
+	if len(serviceSpec.GetRegions()) != len(regions) {
+		return nil, fmt.Errorf("%w: spec regions must match the resource's regions", ErrImmutableField)
+	}
+	for _, region := range regions {
+		target, ok := serviceSpec.GetRegions()[region.Region]
+		if !ok {
+			return nil, fmt.Errorf("%w: spec is missing region %s", ErrImmutableField, region.Region)
+		}
+		if target.GetPrimary() != region.IsPrimary {
+			return nil, fmt.Errorf("%w: the primary region is changed with PromoteRegion", ErrImmutableField)
+		}
+	}
+	if err := validateScaleToZero(serviceSpec); err != nil {
+		return nil, err
+	}
+	if err := validateConfigSchema(serviceSpec.GetConfigSchema()); err != nil {
+		return nil, err
+	}
+	if err := validateScheduling(serviceSpec.GetScheduling()); err != nil {
+		return nil, err
+	}
+	if err := validateEntrypoint(serviceSpec); err != nil {
+		return nil, err
+	}
+
+	return protojson.Marshal(serviceSpec)
+}
+
+// validateScaleToZero rejects scaling to zero in a spec whose regions scale on KEDA triggers or
+// schedules, since both would own the replica count.
+func validateScaleToZero(serviceSpec *resourcev1.ServiceSpec) error {
+	scaleToZero := serviceSpec.GetScaleToZero()
+	if scaleToZero == nil {
+		return nil
+	}
+	if scaleToZero.GetScaledownPeriod() < 0 || scaleToZero.GetTargetConcurrency() < 0 {
+		return errors.New("scale to zero settings cannot be negative")
+	}
+	for region, target := range serviceSpec.GetRegions() {
+		if len(target.GetScalers().GetTriggers()) > 0 || len(target.GetScalers().GetSchedules()) > 0 {
+			return fmt.Errorf("%w (region %s)", ErrScaleToZeroTriggers, region)
+		}
+	}
+	return nil
+}
 
-	return connect.NewResponse(&resourcev1.UpdateResourceResponse{ResourceId: r.GetResourceId()}), nil
+// validateEntrypoint rejects a command with nothing to run and a relative working directory, which
+// Kubernetes would only refuse once the pods are created.
+func validateEntrypoint(serviceSpec *resourcev1.ServiceSpec) error {
+	if command := serviceSpec.GetCommand(); len(command) > 0 && command[0] == "" {
+		return errors.New("command must start with the executable to run")
+	}
+	if serviceSpec.WorkingDir != nil && !path.IsAbs(serviceSpec.GetWorkingDir()) {
+		return fmt.Errorf("working dir %q must be an absolute path", serviceSpec.GetWorkingDir())
+	}
+	return nil
 }
 
 // DeleteResource deletes a resource
@@ -425,7 +764,7 @@ func (s *ResourceServer) DeleteResource(
 ) (*connect.Response[resourcev1.DeleteResourceResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -442,20 +781,75 @@ func (s *ResourceServer) DeleteResource(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
-	if err := deleteLocoResource(ctx, s.kubeClient, resource.ID, s.locoNamespace); err != nil {
-		slog.ErrorContext(ctx, "failed to delete Application during resource deletion", "error", err, "resourceId", resource.ID)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to cleanup Application: %w", err))
+	if err := ensureResourceDeletable(ctx, s.queries, resource.ID); err != nil {
+		return nil, err
 	}
 
-	err = s.queries.DeleteResource(ctx, r.GetResourceId())
+	production, err := isProductionResource(ctx, s.queries, resource)
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to delete resource", "error", err)
+		slog.ErrorContext(ctx, "failed to check whether resource is production", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
+	if production {
+		return nil, requireApproval(ctx, s.queries, genDb.ApprovalActionDeleteResource, resource.ID, resource.WorkspaceID)
+	}
+
+	if err := s.deleteResource(ctx, resource); err != nil {
+		return nil, err
+	}
 
 	return connect.NewResponse(&resourcev1.DeleteResourceResponse{}), nil
 }
 
+// deleteResource tears down a resource's Application and deletes the resource
+func (s *ResourceServer) deleteResource(ctx context.Context, resource genDb.Resource) error {
+	// checked again since a lock may have been taken while an approval was pending
+	if err := ensureResourceDeletable(ctx, s.queries, resource.ID); err != nil {
+		return err
+	}
+
+	if err := deleteLocoResource(ctx, s.kubeClient, resource.ID, s.locoNamespace); err != nil {
+		slog.ErrorContext(ctx, "failed to delete Application during resource deletion", "error", err, "resourceId", resource.ID)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to cleanup Application: %w", err))
+	}
+
+	if err := s.queries.DeleteResource(ctx, resource.ID); err != nil {
+		slog.ErrorContext(ctx, "failed to delete resource", "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return nil
+}
+
+// TeardownResource deletes a resource for a cascading organization deletion. A resource that
+// is already gone is not an error, so a deletion that was interrupted can be resumed.
+func (s *ResourceServer) TeardownResource(ctx context.Context, resourceID int64) error {
+	resource, err := s.queries.GetResourceByID(ctx, resourceID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to get resource: %w", err)
+	}
+	return s.deleteResource(ctx, resource)
+}
+
+// ForceDeleteResource deletes a resource for a platform admin, regardless of locks and deletion
+// protection. The resource is deleted even when its Application cannot be removed, which is
+// reported as cleanedUp false so the Application can be cleaned up by hand.
+func (s *ResourceServer) ForceDeleteResource(ctx context.Context, resource genDb.Resource) (cleanedUp bool, err error) {
+	cleanedUp = true
+	if err := deleteLocoResource(ctx, s.kubeClient, resource.ID, s.locoNamespace); err != nil {
+		slog.WarnContext(ctx, "leaving Application behind for force-deleted resource", "error", err, "resourceId", resource.ID)
+		cleanedUp = false
+	}
+
+	if err := s.queries.DeleteResource(ctx, resource.ID); err != nil {
+		slog.ErrorContext(ctx, "failed to delete resource", "error", err)
+		return false, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return cleanedUp, nil
+}
+
 // GetResourceStatus retrieves a resource and its current deployment status
 func (s *ResourceServer) GetResourceStatus(
 	ctx context.Context,
@@ -463,7 +857,7 @@ func (s *ResourceServer) GetResourceStatus(
 ) (*connect.Response[resourcev1.GetResourceStatusResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -474,20 +868,168 @@ func (s *ResourceServer) GetResourceStatus(
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
-	resource, err := s.queries.GetResourceByID(ctx, r.GetResourceId())
+	resource, deploymentStatus, err := s.resourceStatus(ctx, r.GetResourceId())
 	if err != nil {
-		slog.WarnContext(ctx, "resource not found", "resourceId", r.GetResourceId())
-		return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+		return nil, err
+	}
+
+	resp := &resourcev1.GetResourceStatusResponse{
+		Resource:          resource,
+		CurrentDeployment: deploymentStatus,
+	}
+
+	probe, err := s.queries.GetLatestResourceProbe(ctx, r.GetResourceId())
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		slog.ErrorContext(ctx, "failed to get latest health probe", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if err == nil {
+		resp.LatestProbe = &resourcev1.HealthProbe{
+			Url:        probe.Url,
+			Ok:         probe.Ok,
+			StatusCode: probe.StatusCode,
+			LatencyMs:  probe.LatencyMs,
+			Error:      probe.Error,
+			CheckedAt:  timestamppb.New(probe.CheckedAt.Time),
+		}
+	}
+
+	if resp.Domains, err = s.domainRoutingStatus(ctx, r.GetResourceId()); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// domainRoutingStatus reports, for each of a resource's domains, whether its Application routes it
+// and what the controller last saw of its route and DNS. The Application routes only the domain
+// it was deployed with; the rest are picked up by the next deployment.
+func (s *ResourceServer) domainRoutingStatus(ctx context.Context, resourceID int64) ([]*resourcev1.DomainRoutingStatus, error) {
+	domains, err := s.queries.ListResourceDomains(ctx, resourceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list resource domains", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	locoRes := &locoControllerV1.Application{}
+	err = s.kubeClient.ControllerClient.Get(ctx, client.ObjectKey{
+		Name:      fmt.Sprintf("resource-%d", resourceID),
+		Namespace: s.locoNamespace,
+	}, locoRes)
+	if err != nil && !apierrors.IsNotFound(err) {
+		slog.ErrorContext(ctx, "failed to get Application", "error", err, "resourceId", resourceID)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var routedHost string
+	if err == nil && locoRes.Spec.ServiceSpec != nil && locoRes.Spec.ServiceSpec.Routing != nil {
+		routedHost = locoRes.Spec.ServiceSpec.Routing.HostName
+	}
+
+	statuses := make([]*resourcev1.DomainRoutingStatus, 0, len(domains))
+	for _, domain := range domains {
+		status := &resourcev1.DomainRoutingStatus{Domain: domain.Domain}
+		statuses = append(statuses, status)
+		if domain.Domain != routedHost {
+			status.Message = "not routed until the next deployment"
+			if routedHost == "" {
+				status.Message = "no deployment is running"
+			}
+			continue
+		}
+
+		status.Routed = true
+		route := meta.FindStatusCondition(locoRes.Status.Conditions, locoControllerV1.ConditionRouteProgrammed)
+		if route == nil {
+			status.Message = "the route has not been checked yet"
+			continue
+		}
+		status.RouteProgrammed = route.Status == metav1.ConditionTrue
+		if !status.RouteProgrammed {
+			status.Message = route.Message
+		}
+		if dns := meta.FindStatusCondition(locoRes.Status.Conditions, locoControllerV1.ConditionDNSResolved); dns != nil {
+			resolved := dns.Status == metav1.ConditionTrue
+			status.DnsResolved = &resolved
+			if !resolved && status.Message == "" {
+				status.Message = dns.Message
+			}
+		}
+	}
+	return statuses, nil
+}
+
+// WatchResourceStatus streams the resource's status whenever the change feed reports a change to the
+// resource or one of its deployments, so clients do not have to poll GetResourceStatus
+func (s *ResourceServer) WatchResourceStatus(
+	ctx context.Context,
+	req *connect.Request[resourcev1.WatchResourceStatusRequest],
+	stream *connect.ServerStream[resourcev1.WatchResourceStatusResponse],
+) error {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetResourceStatus, r.GetResourceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to watch resource status", "resourceId", r.GetResourceId())
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	// subscribe before the first read so no change falls in between
+	events, unsubscribe := s.feed.Subscribe(func(e changes.Event) bool {
+		return (e.Table == changes.TableResources || e.Table == changes.TableDeployments) && e.ResourceID == r.GetResourceId()
+	})
+	defer unsubscribe()
+
+	// the feed drops events while reconnecting, so re-read now and then regardless
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	var last *resourcev1.WatchResourceStatusResponse
+	for {
+		resource, deploymentStatus, err := s.resourceStatus(ctx, r.GetResourceId())
+		if err != nil {
+			return err
+		}
+		current := &resourcev1.WatchResourceStatusResponse{
+			Resource:          resource,
+			CurrentDeployment: deploymentStatus,
+		}
+		if !proto.Equal(current, last) {
+			if err := stream.Send(current); err != nil {
+				return err
+			}
+			last = current
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-events:
+		case <-ticker.C:
+		}
+	}
+}
+
+// resourceStatus reads a resource and the status of its latest deployment
+func (s *ResourceServer) resourceStatus(ctx context.Context, resourceID int64) (*resourcev1.Resource, *resourcev1.DeploymentStatus, error) {
+	resource, err := s.queries.GetResourceByID(ctx, resourceID)
+	if err != nil {
+		slog.WarnContext(ctx, "resource not found", "resourceId", resourceID)
+		return nil, nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
 	}
 
 	deploymentList, err := s.queries.ListDeploymentsForResource(ctx, genDb.ListDeploymentsForResourceParams{
-		ResourceID: r.ResourceId,
+		ResourceID: resourceID,
 		Limit:      1,
 		PageToken:  pgtype.Text{}, // empty for first page
 	})
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to list deployments", "error", err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
 	var deploymentStatus *resourcev1.DeploymentStatus
@@ -498,25 +1040,26 @@ func (s *ResourceServer) GetResourceStatus(
 			Status:   deploymentStatusToProto(deployment.Status),
 			Replicas: deployment.Replicas,
 			Message:  &deployment.Message,
+			Drift:    driftToProto(ctx, deployment.Drift),
+		}
+		if deployment.DriftDetectedAt.Valid {
+			deploymentStatus.DriftDetectedAt = timestamppb.New(deployment.DriftDetectedAt.Time)
 		}
 	}
 
 	resourceDomains, err := s.queries.ListResourceDomains(ctx, resource.ID)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to list resource domains", "error", err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
 	resourceRegions, err := s.queries.ListResourceRegions(ctx, resource.ID)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to list resource regions", "error", err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
-	return connect.NewResponse(&resourcev1.GetResourceStatusResponse{
-		Resource:          dbResourceToProto(resource, resourceDomains, resourceRegions),
-		CurrentDeployment: deploymentStatus,
-	}), nil
+	return dbResourceToProto(resource, resourceDomains, resourceRegions), deploymentStatus, nil
 }
 
 // ListRegions lists available regions for resource deployment
@@ -556,17 +1099,60 @@ func (s *ResourceServer) ListRegions(
 	}), nil
 }
 
-// WatchLogs streams logs for a resource
-func (s *ResourceServer) WatchLogs(
+// EstimateCost projects the monthly cost of a resource spec
+func (s *ResourceServer) EstimateCost(
 	ctx context.Context,
-	req *connect.Request[resourcev1.WatchLogsRequest],
-	stream *connect.ServerStream[resourcev1.WatchLogsResponse],
-) error {
-	r := req.Msg
+	req *connect.Request[resourcev1.EstimateCostRequest],
+) (*connect.Response[resourcev1.EstimateCostResponse], error) {
+	spec := req.Msg.GetSpec().GetService()
+	if spec == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrSpecNotPriceable)
+	}
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
-	if !ok {
-		slog.ErrorContext(ctx, "entity scopes not found in context")
+	rows, err := s.queries.ListRegionPricing(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list region pricing", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	estimate, err := cost.EstimateService(spec, cost.PricesFromRows(rows))
+	if err != nil {
+		var unpriced *cost.UnpricedRegionError
+		if errors.As(err, &unpriced) || errors.Is(err, cost.ErrMixedCurrencies) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	resp := &resourcev1.EstimateCostResponse{
+		Currency:        estimate.Currency,
+		MinMonthlyCents: estimate.MinMonthly,
+		MaxMonthlyCents: estimate.MaxMonthly,
+	}
+	for _, region := range estimate.Regions {
+		resp.Regions = append(resp.Regions, &resourcev1.RegionCostEstimate{
+			Region:          region.Region,
+			MinReplicas:     region.MinReplicas,
+			MaxReplicas:     region.MaxReplicas,
+			MinMonthlyCents: region.MinMonthly,
+			MaxMonthlyCents: region.MaxMonthly,
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// WatchLogs streams logs for a resource
+func (s *ResourceServer) WatchLogs(
+	ctx context.Context,
+	req *connect.Request[resourcev1.WatchLogsRequest],
+	stream *connect.ServerStream[resourcev1.WatchLogsResponse],
+) error {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
 	}
 
@@ -595,12 +1181,26 @@ func (s *ResourceServer) WatchLogs(
 
 	namespace := computeNamespace(resource.WorkspaceID, resource.ID)
 
-	logStream := klogmux.NewBuilder(s.kubeClient.ClientSet).
+	builder := klogmux.NewBuilder(s.kubeClient.ClientSet).
 		Namespace(namespace).
 		Follow(follow).
 		TailLines(tailLines).
-		Timestamps(true).
-		Build()
+		Timestamps(true)
+
+	// filtering happens in klogmux before lines hit the wire, so chatty apps
+	// don't ship everything to the client just to have it thrown away.
+	if r.GetFilterRegex() {
+		for _, pattern := range []string{r.GetFilter(), r.GetExclude()} {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s", ErrInvalidLogFilter, err.Error()))
+			}
+		}
+		builder = builder.MessageFilter(r.GetFilter()).ExcludePattern(r.GetExclude())
+	} else {
+		builder = builder.MessageContains(r.GetFilter()).ExcludeContains(r.GetExclude())
+	}
+
+	logStream := builder.Build()
 
 	if err := logStream.Start(ctx); err != nil {
 		slog.ErrorContext(ctx, "failed to start log stream", "error", err)
@@ -644,7 +1244,7 @@ func (s *ResourceServer) ListResourceEvents(
 ) (*connect.Response[resourcev1.ListResourceEventsResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -671,21 +1271,14 @@ func (s *ResourceServer) ListResourceEvents(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch events: %w", err))
 	}
 
+	matchesKind := eventKindFilter(r.GetKinds())
+
 	var protoEvents []*resourcev1.Event
 	for _, k8sEvent := range eventList.Items {
-		// filter events to those related to this resource's pods
-		if k8sEvent.InvolvedObject.Kind != "Pod" {
+		if !matchesKind(k8sEvent.InvolvedObject.Kind) {
 			continue
 		}
-
-		protoEvent := &resourcev1.Event{
-			Timestamp: timestamppb.New(k8sEvent.FirstTimestamp.Time),
-			Reason:    k8sEvent.Reason,
-			Message:   k8sEvent.Message,
-			Type:      k8sEvent.Type,
-			PodName:   k8sEvent.InvolvedObject.Name,
-		}
-		protoEvents = append(protoEvents, protoEvent)
+		protoEvents = append(protoEvents, eventToProto(&k8sEvent))
 	}
 
 	// sort by timestamp descending (newest first)
@@ -705,6 +1298,190 @@ func (s *ResourceServer) ListResourceEvents(
 	}), nil
 }
 
+// StreamEvents streams Kubernetes events for a resource as they happen.
+// The underlying watch is re-established transparently when the API server closes it, resuming from the
+// last seen resourceVersion; if that version has expired the stream resumes from the current state.
+func (s *ResourceServer) StreamEvents(
+	ctx context.Context,
+	req *connect.Request[resourcev1.StreamEventsRequest],
+	stream *connect.ServerStream[resourcev1.StreamEventsResponse],
+) error {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetResourceEvents, r.GetResourceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to stream events for resource", "resourceId", r.GetResourceId())
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	resource, err := s.queries.GetResourceByID(ctx, r.GetResourceId())
+	if err != nil {
+		slog.WarnContext(ctx, "resource not found", "resourceId", r.GetResourceId())
+		return connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+	}
+
+	namespace := computeNamespace(resource.WorkspaceID, resource.ID)
+	events := s.kubeClient.ClientSet.CoreV1().Events(namespace)
+	resourceVersion := r.GetResourceVersion()
+	backoff := eventWatchMinBackoff
+	matchesKind := eventKindFilter(r.GetKinds())
+
+	slog.InfoContext(ctx, "streaming events for resource", "resourceId", r.GetResourceId(), "resource_namespace", namespace, "resourceVersion", resourceVersion)
+
+	for {
+		// without a version to resume from, start at "now" so the stream only carries new events;
+		// the backlog is available through ListResourceEvents.
+		if resourceVersion == "" {
+			list, err := events.List(ctx, metav1.ListOptions{Limit: 1})
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to list events from kubernetes", "error", err, "namespace", namespace)
+				return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch events: %w", err))
+			}
+			resourceVersion = list.ResourceVersion
+		}
+
+		watcher, err := events.Watch(ctx, metav1.ListOptions{
+			ResourceVersion:     resourceVersion,
+			AllowWatchBookmarks: true,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+				slog.InfoContext(ctx, "event resourceVersion expired, resuming from current state", "resourceVersion", resourceVersion)
+				resourceVersion = ""
+				continue
+			}
+			slog.WarnContext(ctx, "failed to watch events, retrying", "error", err, "namespace", namespace, "backoff", backoff)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, eventWatchMaxBackoff)
+			continue
+		}
+		backoff = eventWatchMinBackoff
+
+		resourceVersion, err = s.forwardEvents(ctx, watcher, stream, resourceVersion, matchesKind)
+		watcher.Stop()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// forwardEvents relays matching events from a single watch until the watch closes, returning the
+// resourceVersion to resume from. An expired version is returned as "".
+func (s *ResourceServer) forwardEvents(
+	ctx context.Context,
+	watcher watch.Interface,
+	stream *connect.ServerStream[resourcev1.StreamEventsResponse],
+	resourceVersion string,
+	matchesKind func(kind string) bool,
+) (string, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return resourceVersion, ctx.Err()
+		case watchEvent, ok := <-watcher.ResultChan():
+			if !ok {
+				// server closed the watch (timeout); the caller re-establishes it
+				return resourceVersion, nil
+			}
+
+			switch watchEvent.Type {
+			case watch.Error:
+				status := apierrors.FromObject(watchEvent.Object)
+				if apierrors.IsResourceExpired(status) || apierrors.IsGone(status) {
+					return "", nil
+				}
+				slog.WarnContext(ctx, "event watch error", "error", status)
+				return resourceVersion, nil
+			case watch.Bookmark:
+				if obj, err := meta.Accessor(watchEvent.Object); err == nil {
+					resourceVersion = obj.GetResourceVersion()
+				}
+				continue
+			case watch.Deleted:
+				// events expiring from the cluster are not interesting to clients
+				continue
+			}
+
+			k8sEvent, ok := watchEvent.Object.(*corev1.Event)
+			if !ok {
+				continue
+			}
+			resourceVersion = k8sEvent.ResourceVersion
+
+			if !matchesKind(k8sEvent.InvolvedObject.Kind) {
+				continue
+			}
+
+			if err := stream.Send(&resourcev1.StreamEventsResponse{
+				Event:           eventToProto(k8sEvent),
+				ResourceVersion: resourceVersion,
+			}); err != nil {
+				slog.ErrorContext(ctx, "failed to send event to client", "error", err)
+				return resourceVersion, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to send events: %w", err))
+			}
+		}
+	}
+}
+
+// eventToProto converts a Kubernetes event into its API representation
+func eventToProto(k8sEvent *corev1.Event) *resourcev1.Event {
+	timestamp := k8sEvent.FirstTimestamp.Time
+	if timestamp.IsZero() {
+		timestamp = k8sEvent.EventTime.Time
+	}
+
+	lastTimestamp := k8sEvent.LastTimestamp.Time
+	if lastTimestamp.IsZero() {
+		lastTimestamp = timestamp
+	}
+
+	event := &resourcev1.Event{
+		Timestamp: timestamppb.New(timestamp),
+		Reason:    k8sEvent.Reason,
+		Message:   k8sEvent.Message,
+		Type:      k8sEvent.Type,
+		InvolvedObject: &resourcev1.ObjectReference{
+			Kind:      k8sEvent.InvolvedObject.Kind,
+			Name:      k8sEvent.InvolvedObject.Name,
+			Namespace: k8sEvent.InvolvedObject.Namespace,
+		},
+		Count:         k8sEvent.Count,
+		LastTimestamp: timestamppb.New(lastTimestamp),
+	}
+	if k8sEvent.InvolvedObject.Kind == "Pod" {
+		event.PodName = k8sEvent.InvolvedObject.Name
+	}
+	return event
+}
+
+// eventKindFilter returns a predicate matching involved object kinds case-insensitively.
+// An empty kinds list matches everything.
+func eventKindFilter(kinds []string) func(kind string) bool {
+	if len(kinds) == 0 {
+		return func(string) bool { return true }
+	}
+	return func(kind string) bool {
+		for _, k := range kinds {
+			if strings.EqualFold(k, kind) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
 // ScaleResource scales a resource by creating a new deployment with updated resources
 func (s *ResourceServer) ScaleResource(
 	ctx context.Context,
@@ -712,7 +1489,7 @@ func (s *ResourceServer) ScaleResource(
 ) (*connect.Response[resourcev1.ScaleResourceResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -723,6 +1500,11 @@ func (s *ResourceServer) ScaleResource(
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
+	// scaling deploys, which an archived workspace does not take
+	if err := ensureResourceWorkspaceActive(ctx, s.queries, r.GetResourceId()); err != nil {
+		return nil, err
+	}
+
 	if r.Replicas == nil && r.Cpu == nil && r.Memory == nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("at least one of replicas, cpu, or memory must be provided"))
 	}
@@ -794,6 +1576,13 @@ func (s *ResourceServer) ScaleResource(
 	if len(currentDeployment.Spec) == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("previous deployment has no spec"))
 	}
+	var supersedes *genDb.Deployment
+	if r.DeploymentVersion != nil {
+		if r.GetDeploymentVersion() != currentDeployment.Version {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("deployment %w (now deployment %d at version %d)", ErrVersionConflict, currentDeployment.ID, currentDeployment.Version))
+		}
+		supersedes = &currentDeployment
+	}
 
 	deploymentSpec, deserializeErr := converter.DeserializeDeploymentSpec(currentDeployment.Spec, string(resource.Type))
 	if deserializeErr != nil {
@@ -828,6 +1617,7 @@ func (s *ResourceServer) ScaleResource(
 	if !hasChanges {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("scaling values must be different from current deployment"))
 	}
+	scaleSummary := describeScale(serviceDeploymentSpec, currentDeployment.Replicas, r)
 
 	if r.Cpu != nil {
 		serviceDeploymentSpec.Cpu = r.Cpu
@@ -859,7 +1649,7 @@ func (s *ResourceServer) ScaleResource(
 	}
 
 	// Create deployment transactionally, finalizing previous deployments in the same region
-	_, err = createDeploymentWithCleanup(ctx, s.db, s.queries, genDb.CreateDeploymentParams{
+	_, err = createDeploymentWithCleanup(ctx, s.db, s.queries, supersedes, genDb.CreateDeploymentParams{
 		ResourceID:  r.ResourceId,
 		ClusterID:   cluster.ID,
 		Region:      regionToScale,
@@ -871,6 +1661,12 @@ func (s *ResourceServer) ScaleResource(
 		SpecVersion: version.SpecVersionV1,
 	})
 	if err != nil {
+		if errors.Is(err, ErrVersionConflict) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if errors.Is(err, ErrDeploymentInProgress) {
+			return nil, connect.NewError(connect.CodeAborted, err)
+		}
 		slog.ErrorContext(ctx, "failed to create deployment", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
@@ -893,24 +1689,32 @@ func (s *ResourceServer) ScaleResource(
 		},
 	}
 
-	err = createLocoResource(ctx, s.kubeClient, resource, resourceSpec, domain.Domain, updatedDeploymentSpec, s.locoNamespace, regionToScale)
+	inherited, err := loadInheritedEnv(ctx, s.queries, resource)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load inherited env", "error", err, "resourceId", resource.ID)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	err = createLocoResource(ctx, s.kubeClient, s.queries, resource, resourceSpec, domain, inherited, updatedDeploymentSpec, s.locoNamespace, regionToScale)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to update Application", "error", err, "resourceId", resource.ID)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update Application: %w", err))
 	}
 	slog.InfoContext(ctx, "updated Application after scaling", "resourceId", resource.ID, "resource_name", resource.Name, "regions", regionsToScale)
+	markResourceAwake(ctx, s.queries, resource.ID)
+	recordResourceActivity(ctx, s.queries, resource.ID, genDb.ResourceActivityKindScaled, scaleSummary)
 
 	return connect.NewResponse(&resourcev1.ScaleResourceResponse{}), nil
 }
 
-// UpdateResourceEnv updates environment variables for a resource
+// UpdateResourceEnv updates environment variables for a resource, checked against its config schema
 func (s *ResourceServer) UpdateResourceEnv(
 	ctx context.Context,
 	req *connect.Request[resourcev1.UpdateResourceEnvRequest],
 ) (*connect.Response[resourcev1.UpdateResourceEnvResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -921,6 +1725,14 @@ func (s *ResourceServer) UpdateResourceEnv(
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
+	// an env change deploys, which an archived workspace does not take
+	if err := ensureResourceWorkspaceActive(ctx, s.queries, r.GetResourceId()); err != nil {
+		return nil, err
+	}
+	if err := ensureOrgInGoodStanding(ctx, s.queries, r.GetResourceId()); err != nil {
+		return nil, err
+	}
+
 	if len(r.Env) == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("at least one environment variable must be provided"))
 	}
@@ -986,8 +1798,24 @@ func (s *ResourceServer) UpdateResourceEnv(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("only service resources are supported for env updates"))
 	}
 
+	envSummary := describeEnvChange(serviceDeploymentSpec.GetEnv(), r.GetEnv())
 	serviceDeploymentSpec.Env = r.GetEnv()
 
+	resourceSpec, deserializeErr := converter.DeserializeResourceSpecByType(resource.Spec, string(resource.Type))
+	if deserializeErr != nil {
+		slog.ErrorContext(ctx, deserializeErr.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid resource spec: %w", deserializeErr))
+	}
+
+	inherited, err := loadInheritedEnv(ctx, s.queries, resource)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load inherited env", "error", err, "resourceId", resource.ID)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := checkConfig(resourceSpec.GetService().GetConfigSchema(), inherited.apply(r.GetEnv())); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	specJson, err := protojson.Marshal(serviceDeploymentSpec)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to marshal service deployment spec", "error", err)
@@ -1005,7 +1833,7 @@ func (s *ResourceServer) UpdateResourceEnv(
 	}
 
 	// Create deployment transactionally, finalizing previous deployments in the same region
-	deploymentId, err := createDeploymentWithCleanup(ctx, s.db, s.queries, genDb.CreateDeploymentParams{
+	deploymentId, err := createDeploymentWithCleanup(ctx, s.db, s.queries, nil, genDb.CreateDeploymentParams{
 		ResourceID:  r.ResourceId,
 		ClusterID:   cluster.ID,
 		Region:      regionToUpdate,
@@ -1017,6 +1845,9 @@ func (s *ResourceServer) UpdateResourceEnv(
 		SpecVersion: version.SpecVersionV1,
 	})
 	if err != nil {
+		if errors.Is(err, ErrDeploymentInProgress) {
+			return nil, connect.NewError(connect.CodeAborted, err)
+		}
 		slog.ErrorContext(ctx, "failed to create deployment", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
@@ -1027,28 +1858,388 @@ func (s *ResourceServer) UpdateResourceEnv(
 		return nil, connect.NewError(connect.CodeNotFound, ErrDomainNotFound)
 	}
 
-	resourceSpec, deserializeErr := converter.DeserializeResourceSpecByType(resource.Spec, string(resource.Type))
-	if deserializeErr != nil {
-		slog.ErrorContext(ctx, deserializeErr.Error())
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid resource spec: %w", deserializeErr))
-	}
-
 	updatedDeploymentSpec := &deploymentv1.DeploymentSpec{
 		Spec: &deploymentv1.DeploymentSpec_Service{
 			Service: serviceDeploymentSpec,
 		},
 	}
 
-	err = createLocoResource(ctx, s.kubeClient, resource, resourceSpec, domain.Domain, updatedDeploymentSpec, s.locoNamespace, regionToUpdate)
+	err = createLocoResource(ctx, s.kubeClient, s.queries, resource, resourceSpec, domain, inherited, updatedDeploymentSpec, s.locoNamespace, regionToUpdate)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to update Application", "error", err, "resourceId", resource.ID)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update Application: %w", err))
 	}
 	slog.InfoContext(ctx, "updated Application after env update", "resourceId", resource.ID, "resource_name", resource.Name, "regions", regionsToUpdate, "deploymentId", deploymentId)
+	markResourceAwake(ctx, s.queries, resource.ID)
+	recordResourceActivity(ctx, s.queries, resource.ID, genDb.ResourceActivityKindEnvChanged, envSummary)
 
 	return connect.NewResponse(&resourcev1.UpdateResourceEnvResponse{}), nil
 }
 
+// RestartResource rolls a resource's pods in every region it runs in. The restart is recorded as a
+// new deployment with the same spec and replicas in each region, so it shows in the deployment
+// history; the response carries the one of the region deployed most recently.
+func (s *ResourceServer) RestartResource(
+	ctx context.Context,
+	req *connect.Request[resourcev1.RestartResourceRequest],
+) (*connect.Response[resourcev1.RestartResourceResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.RestartResource, r.GetResourceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to restart resource", "resourceId", r.GetResourceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	// restarting deploys, which an archived workspace does not take
+	if err := ensureResourceWorkspaceActive(ctx, s.queries, r.GetResourceId()); err != nil {
+		return nil, err
+	}
+
+	resource, err := s.queries.GetResourceByID(ctx, r.GetResourceId())
+	if err != nil {
+		slog.WarnContext(ctx, "resource not found", "resourceId", r.GetResourceId())
+		return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+	}
+	if resource.Status == genDb.ResourceStatusSuspended {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%w; resume it instead", ErrResourceSuspended))
+	}
+
+	deploymentList, err := s.queries.ListActiveDeploymentsForResource(ctx, r.GetResourceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list active deployments", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if len(deploymentList) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("no active deployment found for resource"))
+	}
+
+	// each region has its own active deployment, and each records the restart, as a deploy to it would
+	var deploymentIDs []int64
+	for _, currentDeployment := range deploymentList {
+		deploymentID, err := createDeploymentWithCleanup(ctx, s.db, s.queries, nil, genDb.CreateDeploymentParams{
+			ResourceID:  resource.ID,
+			ClusterID:   currentDeployment.ClusterID,
+			Region:      currentDeployment.Region,
+			Replicas:    currentDeployment.Replicas,
+			Status:      genDb.DeploymentStatusPending,
+			IsActive:    true,
+			Message:     "Restart requested",
+			Spec:        currentDeployment.Spec,
+			SpecVersion: currentDeployment.SpecVersion,
+		})
+		if err != nil {
+			if errors.Is(err, ErrDeploymentInProgress) {
+				return nil, connect.NewError(connect.CodeAborted, err)
+			}
+			slog.ErrorContext(ctx, "failed to create deployment", "region", currentDeployment.Region, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		deploymentIDs = append(deploymentIDs, deploymentID)
+	}
+
+	if err := restartLocoResource(ctx, s.kubeClient, resource.ID, s.locoNamespace, time.Now()); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to restart Application: %w", err))
+	}
+	slog.InfoContext(ctx, "restarted resource", "resourceId", resource.ID, "deploymentIds", deploymentIDs)
+
+	return connect.NewResponse(&resourcev1.RestartResourceResponse{DeploymentId: deploymentIDs[0]}), nil
+}
+
+// PromoteRegion promotes a standby region to primary. The primary flag, the traffic policy in the
+// resource spec and the failover order are updated in a single transaction. Unless forced, the target
+// region must be active with a healthy cluster; force exists for promoting away from a regional outage.
+func (s *ResourceServer) PromoteRegion(
+	ctx context.Context,
+	req *connect.Request[resourcev1.PromoteRegionRequest],
+) (*connect.Response[resourcev1.PromoteRegionResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.PromoteRegion, r.GetResourceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to promote region", "resourceId", r.GetResourceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if r.GetRegion() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("region is required"))
+	}
+
+	if !r.GetForce() {
+		if _, err := s.queries.GetActiveClusterByRegion(ctx, r.GetRegion()); err != nil {
+			slog.WarnContext(ctx, "no healthy cluster in promotion target", "region", r.GetRegion(), "error", err)
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%w: %s", ErrClusterNotHealthy, r.GetRegion()))
+		}
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := apiDb.QueriesFor(tx)
+
+	resource, err := qtx.GetResourceByID(ctx, r.GetResourceId())
+	if err != nil {
+		slog.WarnContext(ctx, "resource not found", "resourceId", r.GetResourceId())
+		return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+	}
+
+	// lock the region rows so concurrent promotions serialize
+	regions, err := qtx.ListResourceRegionsForUpdate(ctx, r.GetResourceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list resource regions", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	var target *genDb.ResourceRegion
+	for i := range regions {
+		if regions[i].Region == r.GetRegion() {
+			target = &regions[i]
+		}
+	}
+	if target == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("%w: %s", ErrRegionNotFound, r.GetRegion()))
+	}
+
+	if target.IsPrimary {
+		return connect.NewResponse(&resourcev1.PromoteRegionResponse{
+			Regions: regionConfigsToProto(regions),
+		}), nil
+	}
+
+	if !r.GetForce() && target.Status != genDb.RegionIntentStatusActive {
+		slog.WarnContext(ctx, "promotion target is not active", "resourceId", r.GetResourceId(), "region", target.Region, "status", target.Status)
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%w: region %s is %s", ErrRegionNotPromotable, target.Region, target.Status))
+	}
+
+	// new order: target first, then the previous primary, then the remaining standbys in their current order
+	ordered := make([]genDb.ResourceRegion, 0, len(regions))
+	ordered = append(ordered, *target)
+	for _, region := range regions {
+		if region.Region != target.Region {
+			ordered = append(ordered, region)
+		}
+	}
+
+	// the partial unique index allows a single primary, so clear it before assigning the new one
+	if err := qtx.ClearPrimaryResourceRegion(ctx, r.GetResourceId()); err != nil {
+		slog.ErrorContext(ctx, "failed to clear primary region", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	for i := range ordered {
+		ordered[i].IsPrimary = i == 0
+		ordered[i].FailoverPriority = int32(i)
+		if err := qtx.UpdateResourceRegionFailover(ctx, genDb.UpdateResourceRegionFailoverParams{
+			ResourceID:       r.GetResourceId(),
+			Region:           ordered[i].Region,
+			IsPrimary:        ordered[i].IsPrimary,
+			FailoverPriority: ordered[i].FailoverPriority,
+		}); err != nil {
+			slog.ErrorContext(ctx, "failed to update region failover order", "region", ordered[i].Region, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	// re-point the traffic policy stored in the spec at the new primary
+	if resource.Type == genDb.ResourceTypeService && len(resource.Spec) > 0 {
+		serviceSpec := &resourcev1.ServiceSpec{}
+		if err := protojson.Unmarshal(resource.Spec, serviceSpec); err != nil {
+			slog.ErrorContext(ctx, "failed to unmarshal service spec", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid resource spec: %w", err))
+		}
+		for name, regionTarget := range serviceSpec.GetRegions() {
+			regionTarget.Primary = name == target.Region
+		}
+		specJSON, err := protojson.Marshal(serviceSpec)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to marshal service spec", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid resource spec: %w", err))
+		}
+		if err := qtx.UpdateResourceSpec(ctx, genDb.UpdateResourceSpecParams{
+			ID:   resource.ID,
+			Spec: specJSON,
+		}); err != nil {
+			slog.ErrorContext(ctx, "failed to update resource spec", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit region promotion", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "promoted region", "resourceId", r.GetResourceId(), "region", target.Region, "forced", r.GetForce())
+
+	return connect.NewResponse(&resourcev1.PromoteRegionResponse{
+		Regions: regionConfigsToProto(ordered),
+	}), nil
+}
+
+// SuspendResource scales a resource to zero and serves a maintenance page in its place.
+// Data, domains and deployment history are kept. Suspending twice updates the message.
+func (s *ResourceServer) SuspendResource(
+	ctx context.Context,
+	req *connect.Request[resourcev1.SuspendResourceRequest],
+) (*connect.Response[resourcev1.SuspendResourceResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.SuspendResource, r.GetResourceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to suspend resource", "resourceId", r.GetResourceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	// suspending scales to zero, which a lock forbids
+	if err := ensureResourceScalable(ctx, s.queries, r.GetResourceId()); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := apiDb.QueriesFor(tx)
+
+	message := pgtype.Text{}
+	if r.Message != nil {
+		message = pgtype.Text{String: r.GetMessage(), Valid: true}
+	}
+
+	resource, err := qtx.SuspendResource(ctx, genDb.SuspendResourceParams{
+		ID:                 r.GetResourceId(),
+		MaintenanceMessage: message,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "resource not found", "resourceId", r.GetResourceId(), "error", err)
+		return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+	}
+
+	if err := setLocoResourceSuspended(ctx, s.kubeClient, resource.ID, s.locoNamespace, true, r.GetMessage()); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to suspend Application: %w", err))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit resource suspension", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "suspended resource", "resourceId", resource.ID)
+
+	protoResource, err := s.resourceToProto(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&resourcev1.SuspendResourceResponse{Resource: protoResource}), nil
+}
+
+// ResumeResource brings a suspended resource back on its active deployment
+func (s *ResourceServer) ResumeResource(
+	ctx context.Context,
+	req *connect.Request[resourcev1.ResumeResourceRequest],
+) (*connect.Response[resourcev1.ResumeResourceResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ResumeResource, r.GetResourceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to resume resource", "resourceId", r.GetResourceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	// resources in an archived workspace stay suspended until it is unarchived
+	if err := ensureResourceWorkspaceActive(ctx, s.queries, r.GetResourceId()); err != nil {
+		return nil, err
+	}
+	if err := ensureOrgInGoodStanding(ctx, s.queries, r.GetResourceId()); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := apiDb.QueriesFor(tx)
+
+	current, err := qtx.GetResourceByID(ctx, r.GetResourceId())
+	if err != nil {
+		slog.WarnContext(ctx, "resource not found", "resourceId", r.GetResourceId())
+		return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+	}
+	if current.Status != genDb.ResourceStatusSuspended {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrResourceNotSuspended)
+	}
+
+	resource, err := qtx.ResumeResource(ctx, r.GetResourceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to resume resource", "resourceId", r.GetResourceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := setLocoResourceSuspended(ctx, s.kubeClient, resource.ID, s.locoNamespace, false, ""); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to resume Application: %w", err))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit resource resume", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "resumed resource", "resourceId", resource.ID)
+
+	protoResource, err := s.resourceToProto(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&resourcev1.ResumeResourceResponse{Resource: protoResource}), nil
+}
+
+// resourceToProto loads a resource's domains and regions and converts it to proto
+func (s *ResourceServer) resourceToProto(ctx context.Context, resource genDb.Resource) (*resourcev1.Resource, error) {
+	resourceDomains, err := s.queries.ListResourceDomains(ctx, resource.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list resource domains", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resourceRegions, err := s.queries.ListResourceRegions(ctx, resource.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list resource regions", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return dbResourceToProto(resource, resourceDomains, resourceRegions), nil
+}
+
 // resourceStatusToProto converts database resource status to proto enum
 func resourceStatusToProto(status genDb.ResourceStatus) resourcev1.ResourceStatus {
 	switch status {
@@ -1067,6 +2258,66 @@ func resourceStatusToProto(status genDb.ResourceStatus) resourcev1.ResourceStatu
 	}
 }
 
+// driftToProto converts the drift recorded on a deployment to proto fields
+func driftToProto(ctx context.Context, data []byte) []*resourcev1.DriftedField {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var fields []drift.Field
+	if err := json.Unmarshal(data, &fields); err != nil {
+		slog.WarnContext(ctx, "failed to unmarshal deployment drift", "error", err)
+		return nil
+	}
+
+	result := make([]*resourcev1.DriftedField, 0, len(fields))
+	for _, f := range fields {
+		result = append(result, &resourcev1.DriftedField{
+			Field:    f.Field,
+			Expected: f.Expected,
+			Actual:   f.Actual,
+		})
+	}
+	return result
+}
+
+// regionIntentStatusToProto converts database region intent status to proto enum
+func regionIntentStatusToProto(status genDb.RegionIntentStatus) resourcev1.RegionIntentStatus {
+	switch status {
+	case genDb.RegionIntentStatusDesired:
+		return resourcev1.RegionIntentStatus_REGION_INTENT_STATUS_DESIRED
+	case genDb.RegionIntentStatusProvisioning:
+		return resourcev1.RegionIntentStatus_REGION_INTENT_STATUS_PROVISIONING
+	case genDb.RegionIntentStatusActive:
+		return resourcev1.RegionIntentStatus_REGION_INTENT_STATUS_ACTIVE
+	case genDb.RegionIntentStatusDegraded:
+		return resourcev1.RegionIntentStatus_REGION_INTENT_STATUS_DEGRADED
+	case genDb.RegionIntentStatusRemoving:
+		return resourcev1.RegionIntentStatus_REGION_INTENT_STATUS_REMOVING
+	case genDb.RegionIntentStatusFailed:
+		return resourcev1.RegionIntentStatus_REGION_INTENT_STATUS_FAILED
+	default:
+		return resourcev1.RegionIntentStatus_REGION_INTENT_STATUS_UNSPECIFIED
+	}
+}
+
+// regionConfigsToProto converts resource regions to proto RegionConfigs
+func regionConfigsToProto(regions []genDb.ResourceRegion) []*resourcev1.RegionConfig {
+	protoRegions := make([]*resourcev1.RegionConfig, len(regions))
+	for i, r := range regions {
+		protoRegions[i] = &resourcev1.RegionConfig{
+			Region:           r.Region,
+			IsPrimary:        r.IsPrimary,
+			Status:           regionIntentStatusToProto(r.Status),
+			FailoverPriority: r.FailoverPriority,
+		}
+		if r.LastError.Valid {
+			protoRegions[i].LastError = &r.LastError.String
+		}
+	}
+	return protoRegions
+}
+
 // deploymentStatusToProto converts database deployment status to proto enum
 func deploymentStatusToProto(status genDb.DeploymentStatus) deploymentv1.DeploymentPhase {
 	switch status {
@@ -1097,13 +2348,15 @@ func resourceDomainToListProto(domains []genDb.ResourceDomain) []*domainv1.Resou
 		}
 
 		domain := &domainv1.ResourceDomain{
-			Id:           d.ID,
-			ResourceId:   d.ResourceID,
-			Domain:       d.Domain,
-			DomainSource: domainSource,
-			IsPrimary:    d.IsPrimary,
-			CreatedAt:    timestamppb.New(d.CreatedAt.Time),
-			UpdatedAt:    timestamppb.New(d.UpdatedAt.Time),
+			Id:            d.ID,
+			ResourceId:    d.ResourceID,
+			Domain:        d.Domain,
+			DomainSource:  domainSource,
+			IsPrimary:     d.IsPrimary,
+			CreatedAt:     timestamppb.New(d.CreatedAt.Time),
+			UpdatedAt:     timestamppb.New(d.UpdatedAt.Time),
+			Status:        domainStatusToProto(d.Status),
+			StatusMessage: d.StatusMessage,
 		}
 
 		if d.SubdomainLabel.Valid {
@@ -1112,6 +2365,21 @@ func resourceDomainToListProto(domains []genDb.ResourceDomain) []*domainv1.Resou
 		if d.PlatformDomainID.Valid {
 			domain.PlatformDomainId = &d.PlatformDomainID.Int64
 		}
+		if accessControl, err := converter.DeserializeAccessControl(d.AccessControl); err != nil {
+			slog.Error("failed to deserialize access control", "domainId", d.ID, "error", err)
+		} else if accessControl != nil {
+			domain.AccessControl = redactAccessControl(accessControl)
+		}
+		if redirect, err := converter.DeserializeRedirect(d.Redirect); err != nil {
+			slog.Error("failed to deserialize redirect", "domainId", d.ID, "error", err)
+		} else {
+			domain.Redirect = redirect
+		}
+		if tls, err := converter.DeserializeTLSPolicy(d.Tls); err != nil {
+			slog.Error("failed to deserialize TLS policy", "domainId", d.ID, "error", err)
+		} else {
+			domain.Tls = tls
+		}
 
 		protoDomains = append(protoDomains, domain)
 	}
@@ -1142,13 +2410,7 @@ func dbResourceToProto(resource genDb.Resource, domains []genDb.ResourceDomain,
 
 	resourceStatus := resourceStatusToProto(resource.Status)
 
-	protoRegions := make([]*resourcev1.RegionConfig, len(regions))
-	for i, r := range regions {
-		protoRegions[i] = &resourcev1.RegionConfig{
-			Region:    r.Region,
-			IsPrimary: r.IsPrimary,
-		}
-	}
+	protoRegions := regionConfigsToProto(regions)
 
 	// reconstruct oneof spec from stored spec bytes
 	var spec *resourcev1.ResourceSpec
@@ -1168,6 +2430,14 @@ func dbResourceToProto(resource genDb.Resource, domains []genDb.ResourceDomain,
 		UpdatedAt:   timeutil.ParsePostgresTimestamp(resource.UpdatedAt.Time),
 		Status:      resourceStatus,
 		Description: &resource.Description,
+		Labels:      unmarshalLabels(resource.Labels),
+		Version:     resource.Version,
+	}
+	if resource.EnvironmentID.Valid {
+		result.EnvironmentId = &resource.EnvironmentID.Int64
+	}
+	if resource.ExternalID.Valid {
+		result.ExternalId = &resource.ExternalID.String
 	}
 
 	return result
@@ -1232,11 +2502,14 @@ func reconstructResourceSpec(resourceType genDb.ResourceType, specBytes []byte)
 }
 
 // createDeploymentWithCleanup creates a new deployment and finalizes previous active deployments in the same region
-// within a transaction to ensure consistency.
+// within a transaction to ensure consistency. Concurrent calls for a resource are serialized, and one that cannot get
+// its turn within deploymentLockTimeout fails with ErrDeploymentInProgress. When supersedes is given, the call fails
+// with ErrVersionConflict unless that deployment is still the active one in the region, at the same version.
 func createDeploymentWithCleanup(
 	ctx context.Context,
-	pool *pgxpool.Pool,
+	pool apiDb.Beginner,
 	queries genDb.Querier,
+	supersedes *genDb.Deployment,
 	params genDb.CreateDeploymentParams,
 ) (int64, error) {
 	slog.InfoContext(ctx, "starting deployment creation with cleanup",
@@ -1258,6 +2531,11 @@ func createDeploymentWithCleanup(
 	}
 	params.ResourceRegionID = resourceRegion.ID
 
+	// deployments made on a user's request record who made them
+	if entity, ok := contextkeys.Entity(ctx); ok && entity.Type == genDb.EntityTypeUser && !params.CreatedBy.Valid {
+		params.CreatedBy = pgtype.Int8{Int64: entity.ID, Valid: true}
+	}
+
 	tx, err := pool.Begin(ctx)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
@@ -1265,7 +2543,23 @@ func createDeploymentWithCleanup(
 	}
 	defer tx.Rollback(ctx)
 
-	qtx := genDb.New(tx)
+	qtx := apiDb.QueriesFor(tx)
+
+	// Deployments of a resource take turns: a second one waits here until the first commits, so both
+	// cannot finalize the same active deployment and stay active side by side. One that waits too long
+	// is rejected rather than left hanging.
+	if _, err := tx.Exec(ctx, fmt.Sprintf("SET LOCAL lock_timeout = '%dms'", deploymentLockTimeout.Milliseconds())); err != nil {
+		slog.ErrorContext(ctx, "failed to set lock timeout", "error", err)
+		return 0, fmt.Errorf("failed to set lock timeout: %w", err)
+	}
+	if err := qtx.LockResourceDeployments(ctx, params.ResourceID); err != nil {
+		if isPgLockTimeout(err) {
+			slog.WarnContext(ctx, "deployment lock timed out", "resourceId", params.ResourceID)
+			return 0, ErrDeploymentInProgress
+		}
+		slog.ErrorContext(ctx, "failed to lock resource deployments", "resourceId", params.ResourceID, "error", err)
+		return 0, fmt.Errorf("failed to lock resource deployments: %w", err)
+	}
 
 	// Find active deployment in the same region for this resource (should only be one)
 	activeDeployment, err := qtx.GetActiveDeploymentForResourceAndRegion(ctx, genDb.GetActiveDeploymentForResourceAndRegionParams{
@@ -1283,6 +2577,14 @@ func createDeploymentWithCleanup(
 		return 0, fmt.Errorf("failed to get active deployment: %w", err)
 	}
 
+	if supersedes != nil && (err != nil || activeDeployment.ID != supersedes.ID || activeDeployment.Version != supersedes.Version) {
+		slog.InfoContext(ctx, "active deployment changed since it was read",
+			"resourceId", params.ResourceID,
+			"region", params.Region,
+			"expectedDeploymentId", supersedes.ID)
+		return 0, fmt.Errorf("deployment %w", ErrVersionConflict)
+	}
+
 	// Finalize the previous deployment if it exists
 	if err == nil {
 		hadPreviousDeployment = true
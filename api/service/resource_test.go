@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/testutil"
+	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	domainv1 "github.com/team-loco/loco/shared/proto/domain/v1"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// resourceWorld is a user, and an org with one workspace, in a Store
+type resourceWorld struct {
+	store       *testutil.Store
+	db          *testutil.DB
+	server      *ResourceServer
+	userID      int64
+	orgID       int64
+	workspaceID int64
+}
+
+func newResourceWorld(t *testing.T) *resourceWorld {
+	t.Helper()
+	ctx := t.Context()
+	store := testutil.NewStore()
+	store.ListWorkspacePoliciesFunc = func(ctx context.Context, workspaceID int64) ([]genDb.Policy, error) {
+		return nil, nil
+	}
+
+	user, err := store.CreateUser(ctx, genDb.CreateUserParams{ExternalID: "ext-1", Email: "dev@loco-testing.com"})
+	if err != nil {
+		t.Fatalf("unexpected error creating user: %v", err)
+	}
+	org, err := store.CreateOrganization(ctx, genDb.CreateOrganizationParams{Name: "org", CreatedBy: user.ID})
+	if err != nil {
+		t.Fatalf("unexpected error creating org: %v", err)
+	}
+	workspaceID, err := store.CreateWorkspace(ctx, genDb.CreateWorkspaceParams{OrgID: org.ID, Name: "wks", CreatedBy: user.ID})
+	if err != nil {
+		t.Fatalf("unexpected error creating workspace: %v", err)
+	}
+
+	db := testutil.NewDB(store)
+	server := NewResourceServer(db, store, testutil.NewVendingMachine(t, store), testutil.NewKubeClient(), "loco-system", nil)
+	return &resourceWorld{store: store, db: db, server: server, userID: user.ID, orgID: org.ID, workspaceID: workspaceID}
+}
+
+func (w *resourceWorld) createRequest(name, domain string, regions map[string]*resourcev1.RegionTarget) *resourcev1.CreateResourceRequest {
+	return &resourcev1.CreateResourceRequest{
+		WorkspaceId: w.workspaceID,
+		Name:        name,
+		Type:        resourcev1.ResourceType_RESOURCE_TYPE_SERVICE,
+		Domain:      &domainv1.DomainInput{DomainSource: domainv1.DomainType_DOMAIN_TYPE_USER_PROVIDED, Domain: &domain},
+		Spec: &resourcev1.ResourceSpec{Spec: &resourcev1.ResourceSpec_Service{Service: &resourcev1.ServiceSpec{
+			Regions: regions,
+		}}},
+	}
+}
+
+func TestCreateResource(t *testing.T) {
+	w := newResourceWorld(t)
+	member := testutil.Scopes(genDb.EntityTypeWorkspace, w.workspaceID, genDb.ScopeRead, genDb.ScopeWrite)
+	regions := map[string]*resourcev1.RegionTarget{
+		"us-east-1":  {Enabled: true},
+		"eu-west-1":  {Enabled: true, Primary: true},
+		"ap-south-1": {Enabled: true},
+	}
+
+	ctx := testutil.AsUser(testutil.Context(t), w.userID, member...)
+	resp, err := w.server.CreateResource(ctx, connect.NewRequest(w.createRequest("api", "api.example.com", regions)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resource := resp.Msg.GetResource()
+	if resource.GetId() != resp.Msg.GetResourceId() || resource.GetName() != "api" || resource.GetWorkspaceId() != w.workspaceID {
+		t.Errorf("expected the created resource to be returned, got %v", resource)
+	}
+	if w.db.Commits() != 1 || w.db.Rollbacks() != 0 {
+		t.Errorf("expected 1 commit and no rollbacks, got %d and %d", w.db.Commits(), w.db.Rollbacks())
+	}
+
+	var gotRegions []string
+	for _, region := range resource.GetRegions() {
+		gotRegions = append(gotRegions, fmt.Sprintf("%s:%d:%v", region.GetRegion(), region.GetFailoverPriority(), region.GetIsPrimary()))
+	}
+	wantRegions := []string{"eu-west-1:0:true", "ap-south-1:1:false", "us-east-1:2:false"}
+	if fmt.Sprint(gotRegions) != fmt.Sprint(wantRegions) {
+		t.Errorf("expected regions %v, got %v", wantRegions, gotRegions)
+	}
+	if domains := resource.GetDomains(); len(domains) != 1 || domains[0].GetDomain() != "api.example.com" || !domains[0].GetIsPrimary() {
+		t.Errorf("expected api.example.com as the only, primary domain, got %v", domains)
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		req      *resourcev1.CreateResourceRequest
+		wantCode connect.Code
+	}{
+		{
+			"read only member",
+			testutil.AsUser(testutil.Context(t), w.userID, testutil.Scopes(genDb.EntityTypeWorkspace, w.workspaceID, genDb.ScopeRead)...),
+			w.createRequest("web", "web.example.com", regions),
+			connect.CodePermissionDenied,
+		},
+		{
+			"another workspace's member",
+			testutil.AsUser(testutil.Context(t), w.userID, testutil.Scopes(genDb.EntityTypeWorkspace, w.workspaceID+100, genDb.ScopeWrite)...),
+			w.createRequest("web", "web.example.com", regions),
+			connect.CodePermissionDenied,
+		},
+		{"no regions", ctx, w.createRequest("web", "web.example.com", nil), connect.CodeInvalidArgument},
+		{"domain in use", ctx, w.createRequest("web", "api.example.com", regions), connect.CodeAlreadyExists},
+		{"name in use", ctx, w.createRequest("api", "web.example.com", regions), connect.CodeAlreadyExists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := w.server.CreateResource(tt.ctx, connect.NewRequest(tt.req))
+			if connect.CodeOf(err) != tt.wantCode {
+				t.Errorf("expected %s, got %v", tt.wantCode, err)
+			}
+		})
+	}
+	if w.db.Commits() != 1 {
+		t.Errorf("expected failed creates not to commit, got %d commits", w.db.Commits())
+	}
+}
+
+func TestListWorkspaceResources(t *testing.T) {
+	w := newResourceWorld(t)
+	member := testutil.Scopes(genDb.EntityTypeWorkspace, w.workspaceID, genDb.ScopeRead, genDb.ScopeWrite)
+	ctx := testutil.AsUser(testutil.Context(t), w.userID, member...)
+
+	var ids []int64
+	for i := range 5 {
+		name := fmt.Sprintf("svc-%d", i)
+		resp, err := w.server.CreateResource(ctx, connect.NewRequest(w.createRequest(name, name+".example.com", map[string]*resourcev1.RegionTarget{
+			"us-east-1": {Enabled: true, Primary: true},
+		})))
+		if err != nil {
+			t.Fatalf("unexpected error creating %s: %v", name, err)
+		}
+		ids = append(ids, resp.Msg.GetResourceId())
+	}
+
+	t.Run("pages newest first", func(t *testing.T) {
+		var got []int64
+		var token string
+		pages := 0
+		for {
+			resp, err := w.server.ListWorkspaceResources(ctx, connect.NewRequest(&resourcev1.ListWorkspaceResourcesRequest{
+				WorkspaceId: w.workspaceID,
+				PageSize:    2,
+				PageToken:   token,
+			}))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			pages++
+			for _, resource := range resp.Msg.GetResources() {
+				if len(resource.GetDomains()) != 1 || len(resource.GetRegions()) != 1 {
+					t.Errorf("resource %d: expected its domain and region, got %v and %v", resource.GetId(), resource.GetDomains(), resource.GetRegions())
+				}
+				got = append(got, resource.GetId())
+			}
+			if token = resp.Msg.GetNextPageToken(); token == "" {
+				break
+			}
+		}
+		want := []int64{ids[4], ids[3], ids[2], ids[1], ids[0]}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+		if pages != 3 {
+			t.Errorf("expected 3 pages, got %d", pages)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		scopes   []genDb.EntityScope
+		want     []int64
+		wantCode connect.Code
+	}{
+		{"workspace reader", testutil.Scopes(genDb.EntityTypeWorkspace, w.workspaceID, genDb.ScopeRead), []int64{ids[4], ids[3], ids[2], ids[1], ids[0]}, 0},
+		{"org reader", testutil.Scopes(genDb.EntityTypeOrganization, w.orgID, genDb.ScopeRead), []int64{ids[4], ids[3], ids[2], ids[1], ids[0]}, 0},
+		{
+			"reader of two resources",
+			append(testutil.Scopes(genDb.EntityTypeResource, ids[1], genDb.ScopeRead), testutil.Scopes(genDb.EntityTypeResource, ids[3], genDb.ScopeRead)...),
+			[]int64{ids[3], ids[1]},
+			0,
+		},
+		{"no scopes", nil, nil, connect.CodePermissionDenied},
+		{"another workspace's reader", testutil.Scopes(genDb.EntityTypeWorkspace, w.workspaceID+100, genDb.ScopeRead), nil, connect.CodePermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testutil.AsUser(testutil.Context(t), w.userID, tt.scopes...)
+			resp, err := w.server.ListWorkspaceResources(ctx, connect.NewRequest(&resourcev1.ListWorkspaceResourcesRequest{WorkspaceId: w.workspaceID}))
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("expected %s, got %v", tt.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []int64
+			for _, resource := range resp.Msg.GetResources() {
+				got = append(got, resource.GetId())
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRestartResourceMultiRegion(t *testing.T) {
+	w := newResourceWorld(t)
+	ctx := testutil.AsUser(testutil.Context(t), w.userID, testutil.Scopes(genDb.EntityTypeWorkspace, w.workspaceID, genDb.ScopeRead, genDb.ScopeWrite)...)
+
+	created, err := w.server.CreateResource(ctx, connect.NewRequest(w.createRequest("api", "api.example.com", map[string]*resourcev1.RegionTarget{
+		"us-east-1": {Enabled: true, Primary: true},
+		"eu-west-1": {Enabled: true},
+	})))
+	if err != nil {
+		t.Fatalf("unexpected error creating resource: %v", err)
+	}
+	resourceID := created.Msg.GetResourceId()
+	app := &locoControllerV1.Application{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("resource-%d", resourceID), Namespace: w.server.locoNamespace}}
+	if err := w.server.kubeClient.ControllerClient.Create(ctx, app); err != nil {
+		t.Fatalf("unexpected error creating Application: %v", err)
+	}
+
+	// one active deployment per region, the newest first as the query orders them
+	active := map[string]genDb.Deployment{
+		"eu-west-1": {ID: 1001, ResourceID: resourceID, ClusterID: 2, Region: "eu-west-1", Replicas: 2, Status: genDb.DeploymentStatusRunning, IsActive: true, Spec: []byte(`{"eu":true}`)},
+		"us-east-1": {ID: 1000, ResourceID: resourceID, ClusterID: 1, Region: "us-east-1", Replicas: 3, Status: genDb.DeploymentStatusRunning, IsActive: true, Spec: []byte(`{"us":true}`)},
+	}
+	var finalized []genDb.UpdateDeploymentStatusAndActiveParams
+	var recorded []genDb.CreateDeploymentParams
+	w.store.IsResourceWorkspaceArchivedFunc = func(ctx context.Context, id int64) (bool, error) {
+		return false, nil
+	}
+	w.store.ListActiveDeploymentsForResourceFunc = func(ctx context.Context, id int64) ([]genDb.Deployment, error) {
+		return []genDb.Deployment{active["eu-west-1"], active["us-east-1"]}, nil
+	}
+	w.store.GetResourceRegionByResourceAndRegionFunc = func(ctx context.Context, arg genDb.GetResourceRegionByResourceAndRegionParams) (genDb.ResourceRegion, error) {
+		regions, err := w.store.ListResourceRegions(ctx, arg.ResourceID)
+		for _, region := range regions {
+			if region.Region == arg.Region {
+				return region, err
+			}
+		}
+		return genDb.ResourceRegion{}, pgx.ErrNoRows
+	}
+	w.store.LockResourceDeploymentsFunc = func(ctx context.Context, id int64) error {
+		return nil
+	}
+	w.store.GetActiveDeploymentForResourceAndRegionFunc = func(ctx context.Context, arg genDb.GetActiveDeploymentForResourceAndRegionParams) (genDb.Deployment, error) {
+		return active[arg.Region], nil
+	}
+	w.store.UpdateDeploymentStatusAndActiveFunc = func(ctx context.Context, arg genDb.UpdateDeploymentStatusAndActiveParams) error {
+		finalized = append(finalized, arg)
+		return nil
+	}
+	w.store.CreateDeploymentFunc = func(ctx context.Context, arg genDb.CreateDeploymentParams) (int64, error) {
+		recorded = append(recorded, arg)
+		return int64(2000 + len(recorded)), nil
+	}
+
+	resp, err := w.server.RestartResource(ctx, connect.NewRequest(&resourcev1.RestartResourceRequest{ResourceId: resourceID}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Msg.GetDeploymentId() != 2001 {
+		t.Errorf("expected the restart of the newest region's deployment, 2001, got %d", resp.Msg.GetDeploymentId())
+	}
+
+	if len(recorded) != 2 {
+		t.Fatalf("expected a restart recorded in each of the 2 regions, got %d", len(recorded))
+	}
+	for _, params := range recorded {
+		previous := active[params.Region]
+		if params.ClusterID != previous.ClusterID || params.Replicas != previous.Replicas || string(params.Spec) != string(previous.Spec) {
+			t.Errorf("%s: expected the restart to keep the region's cluster, replicas and spec, got %+v", params.Region, params)
+		}
+		if params.Message != "Restart requested" || !params.IsActive || params.CreatedBy.Int64 != w.userID {
+			t.Errorf("%s: expected an active restart by user %d, got %+v", params.Region, w.userID, params)
+		}
+	}
+	if len(finalized) != 2 || finalized[0].ID != 1001 || finalized[1].ID != 1000 {
+		t.Errorf("expected both regions' previous deployments to be finalized, got %+v", finalized)
+	}
+	if w.db.Commits() != 3 {
+		t.Errorf("expected the create and a transaction per region to commit, got %d commits", w.db.Commits())
+	}
+
+	if err := w.server.kubeClient.ControllerClient.Get(ctx, crClient.ObjectKeyFromObject(app), app); err != nil {
+		t.Fatalf("unexpected error getting Application: %v", err)
+	}
+	if app.Spec.RestartedAt == "" {
+		t.Errorf("expected the Application to be restarted once for all regions")
+	}
+}
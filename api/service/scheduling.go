@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	adminv1 "github.com/team-loco/loco/shared/proto/admin/v1"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ErrNoMatchingNodePool is returned for a service whose scheduling no node pool of a region's
+// clusters can satisfy.
+var ErrNoMatchingNodePool = errors.New("no node pool matches the service's scheduling")
+
+// nodeTaintEffects are the taint effects Kubernetes knows.
+var nodeTaintEffects = []string{"NoSchedule", "PreferNoSchedule", "NoExecute"}
+
+// wellKnownTopologyKeys are node labels every cluster sets, so services can spread across them
+// without a node pool declaring them.
+var wellKnownTopologyKeys = []string{"kubernetes.io/hostname", "topology.kubernetes.io/zone", "topology.kubernetes.io/region"}
+
+// nodeTaint is how a node pool's taints are stored.
+type nodeTaint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
+// nodePool is a node pool as read from the database.
+type nodePool struct {
+	name   string
+	labels map[string]string
+	taints []nodeTaint
+}
+
+func decodeNodePool(pool genDb.ClusterNodePool) (nodePool, error) {
+	decoded := nodePool{name: pool.Name}
+	if err := json.Unmarshal(pool.Labels, &decoded.labels); err != nil {
+		return nodePool{}, fmt.Errorf("invalid labels on node pool %s: %w", pool.Name, err)
+	}
+	if err := json.Unmarshal(pool.Taints, &decoded.taints); err != nil {
+		return nodePool{}, fmt.Errorf("invalid taints on node pool %s: %w", pool.Name, err)
+	}
+	return decoded, nil
+}
+
+// validateScheduling rejects scheduling Kubernetes would refuse: invalid label keys and values,
+// unknown taint effects and negative skews.
+func validateScheduling(scheduling *resourcev1.SchedulingConfig) error {
+	for key, value := range scheduling.GetNodeSelector() {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("scheduling: invalid node selector key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("scheduling: invalid node selector value %q: %s", value, strings.Join(errs, "; "))
+		}
+	}
+	for _, toleration := range scheduling.GetTolerations() {
+		if errs := validation.IsQualifiedName(toleration.GetKey()); len(errs) > 0 {
+			return fmt.Errorf("scheduling: invalid toleration key %q: %s", toleration.GetKey(), strings.Join(errs, "; "))
+		}
+		if toleration.GetEffect() != "" && !slices.Contains(nodeTaintEffects, toleration.GetEffect()) {
+			return fmt.Errorf("scheduling: toleration effect must be one of %s", strings.Join(nodeTaintEffects, ", "))
+		}
+	}
+	seen := make(map[string]bool, len(scheduling.GetSpread()))
+	for _, spread := range scheduling.GetSpread() {
+		if errs := validation.IsQualifiedName(spread.GetTopologyKey()); len(errs) > 0 {
+			return fmt.Errorf("scheduling: invalid topology key %q: %s", spread.GetTopologyKey(), strings.Join(errs, "; "))
+		}
+		if seen[spread.GetTopologyKey()] {
+			return fmt.Errorf("scheduling: %s is spread across twice", spread.GetTopologyKey())
+		}
+		seen[spread.GetTopologyKey()] = true
+		if spread.GetMaxSkew() < 0 {
+			return errors.New("scheduling: max skew cannot be negative")
+		}
+	}
+	return nil
+}
+
+// checkScheduling checks a service's scheduling against its regions' node pools, reporting a
+// mismatch as an invalid argument.
+func (s *ResourceServer) checkScheduling(ctx context.Context, serviceSpec *resourcev1.ServiceSpec) error {
+	err := matchNodePools(ctx, s.queries, serviceSpec)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrNoMatchingNodePool):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		slog.ErrorContext(ctx, "failed to check scheduling", "error", err)
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// matchNodePools checks a service's scheduling against the node pools of each of its regions'
+// clusters: some pool must carry every label of the node selector, each toleration must match a
+// taint of such a pool, and each spread key must be a label nodes have.
+func matchNodePools(ctx context.Context, queries genDb.Querier, serviceSpec *resourcev1.ServiceSpec) error {
+	scheduling := serviceSpec.GetScheduling()
+	if len(scheduling.GetNodeSelector()) == 0 && len(scheduling.GetTolerations()) == 0 && len(scheduling.GetSpread()) == 0 {
+		return nil
+	}
+
+	for _, region := range slices.Sorted(maps.Keys(serviceSpec.GetRegions())) {
+		rows, err := queries.ListRegionNodePools(ctx, region)
+		if err != nil {
+			return fmt.Errorf("failed to list node pools: %w", err)
+		}
+
+		var matching []nodePool
+		for _, row := range rows {
+			pool, err := decodeNodePool(row)
+			if err != nil {
+				return err
+			}
+			if poolHasLabels(pool, scheduling.GetNodeSelector()) {
+				matching = append(matching, pool)
+			}
+		}
+		if len(scheduling.GetNodeSelector()) > 0 && len(matching) == 0 {
+			return fmt.Errorf("%w: region %s has no node pool with labels %s", ErrNoMatchingNodePool, region, formatLabels(scheduling.GetNodeSelector()))
+		}
+
+		for _, toleration := range scheduling.GetTolerations() {
+			if !slices.ContainsFunc(matching, func(pool nodePool) bool { return poolHasTaint(pool, toleration) }) {
+				return fmt.Errorf("%w: region %s has no node pool with taint %s", ErrNoMatchingNodePool, region, toleration.GetKey())
+			}
+		}
+
+		for _, spread := range scheduling.GetSpread() {
+			key := spread.GetTopologyKey()
+			if slices.Contains(wellKnownTopologyKeys, key) {
+				continue
+			}
+			if !slices.ContainsFunc(matching, func(pool nodePool) bool { _, ok := pool.labels[key]; return ok }) {
+				return fmt.Errorf("%w: region %s has no node pool labelled %s to spread across", ErrNoMatchingNodePool, region, key)
+			}
+		}
+	}
+	return nil
+}
+
+func poolHasLabels(pool nodePool, labels map[string]string) bool {
+	for key, value := range labels {
+		if pool.labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func poolHasTaint(pool nodePool, toleration *resourcev1.Toleration) bool {
+	return slices.ContainsFunc(pool.taints, func(taint nodeTaint) bool {
+		return taint.Key == toleration.GetKey() &&
+			(toleration.GetValue() == "" || taint.Value == toleration.GetValue()) &&
+			(toleration.GetEffect() == "" || taint.Effect == toleration.GetEffect())
+	})
+}
+
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		pairs = append(pairs, key+"="+labels[key])
+	}
+	return strings.Join(pairs, ",")
+}
+
+// validateNodePools rejects node pools with missing or repeated names, and labels or taints
+// Kubernetes would refuse.
+func validateNodePools(pools []*adminv1.NodePool) error {
+	seen := make(map[string]bool, len(pools))
+	for _, pool := range pools {
+		if pool.GetName() == "" {
+			return errors.New("node pool name is required")
+		}
+		if seen[pool.GetName()] {
+			return fmt.Errorf("node pool %s is listed twice", pool.GetName())
+		}
+		seen[pool.GetName()] = true
+		for key, value := range pool.GetLabels() {
+			if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+				return fmt.Errorf("node pool %s: invalid label key %q: %s", pool.GetName(), key, strings.Join(errs, "; "))
+			}
+			if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+				return fmt.Errorf("node pool %s: invalid label value %q: %s", pool.GetName(), value, strings.Join(errs, "; "))
+			}
+		}
+		for _, taint := range pool.GetTaints() {
+			if errs := validation.IsQualifiedName(taint.GetKey()); len(errs) > 0 {
+				return fmt.Errorf("node pool %s: invalid taint key %q: %s", pool.GetName(), taint.GetKey(), strings.Join(errs, "; "))
+			}
+			if !slices.Contains(nodeTaintEffects, taint.GetEffect()) {
+				return fmt.Errorf("node pool %s: taint effect must be one of %s", pool.GetName(), strings.Join(nodeTaintEffects, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+func nodePoolToProto(row genDb.ClusterNodePool) (*adminv1.NodePool, error) {
+	pool, err := decodeNodePool(row)
+	if err != nil {
+		return nil, err
+	}
+	protoPool := &adminv1.NodePool{Name: pool.name, Labels: pool.labels}
+	for _, taint := range pool.taints {
+		protoPool.Taints = append(protoPool.Taints, &adminv1.NodeTaint{Key: taint.Key, Value: taint.Value, Effect: taint.Effect})
+	}
+	return protoPool, nil
+}
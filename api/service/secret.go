@@ -0,0 +1,203 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/kube"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	secretv1 "github.com/team-loco/loco/shared/proto/secret/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxSecretValueSize keeps a store well below the 1MiB limit of a Secret
+const maxSecretValueSize = 64 << 10
+
+var (
+	ErrSecretNotFound    = errors.New("secret not found")
+	ErrSecretValueTooBig = fmt.Errorf("secret value must be at most %d bytes", maxSecretValueSize)
+)
+
+type SecretServer struct {
+	db            apiDb.Beginner
+	queries       genDb.Querier
+	machine       *tvm.VendingMachine
+	kubeClient    *kube.Client
+	locoNamespace string
+}
+
+func NewSecretServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine, kubeClient *kube.Client, locoNamespace string) *SecretServer {
+	return &SecretServer{db: db, queries: queries, machine: machine, kubeClient: kubeClient, locoNamespace: locoNamespace}
+}
+
+// SetSecret sets an entry of a workspace secret store, creating the store if needed
+func (s *SecretServer) SetSecret(
+	ctx context.Context,
+	req *connect.Request[secretv1.SetSecretRequest],
+) (*connect.Response[secretv1.SetSecretResponse], error) {
+	r := req.Msg
+
+	if err := s.authorize(ctx, actions.SetSecret, r.GetWorkspaceId()); err != nil {
+		return nil, err
+	}
+	if err := validateSecretEntry(r.GetStore(), r.GetKey()); err != nil {
+		return nil, err
+	}
+	if len(r.GetValue()) > maxSecretValueSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrSecretValueTooBig)
+	}
+
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: s.locoNamespace, Name: locoControllerV1.SecretStoreName(r.GetWorkspaceId(), r.GetStore())}
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := s.kubeClient.ControllerClient.Get(ctx, key, secret)
+		if apierrors.IsNotFound(err) {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+					Labels: map[string]string{
+						locoControllerV1.SecretStoreWorkspaceLabel: strconv.FormatInt(r.GetWorkspaceId(), 10),
+						locoControllerV1.SecretStoreLabel:          r.GetStore(),
+					},
+				},
+				Type: corev1.SecretTypeOpaque,
+				Data: map[string][]byte{r.GetKey(): []byte(r.GetValue())},
+			}
+			return s.kubeClient.ControllerClient.Create(ctx, secret)
+		}
+		if err != nil {
+			return err
+		}
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+		secret.Data[r.GetKey()] = []byte(r.GetValue())
+		return s.kubeClient.ControllerClient.Update(ctx, secret)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to set secret", "workspaceId", r.GetWorkspaceId(), "store", r.GetStore(), "key", r.GetKey(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to set secret: %w", err))
+	}
+
+	slog.InfoContext(ctx, "set secret", "workspaceId", r.GetWorkspaceId(), "store", r.GetStore(), "key", r.GetKey())
+
+	return connect.NewResponse(&secretv1.SetSecretResponse{}), nil
+}
+
+// DeleteSecret deletes an entry of a workspace secret store, and the store with its last entry
+func (s *SecretServer) DeleteSecret(
+	ctx context.Context,
+	req *connect.Request[secretv1.DeleteSecretRequest],
+) (*connect.Response[secretv1.DeleteSecretResponse], error) {
+	r := req.Msg
+
+	if err := s.authorize(ctx, actions.DeleteSecret, r.GetWorkspaceId()); err != nil {
+		return nil, err
+	}
+	if err := validateSecretEntry(r.GetStore(), r.GetKey()); err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: s.locoNamespace, Name: locoControllerV1.SecretStoreName(r.GetWorkspaceId(), r.GetStore())}
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := s.kubeClient.ControllerClient.Get(ctx, key, secret); err != nil {
+			return err
+		}
+		if _, ok := secret.Data[r.GetKey()]; !ok {
+			return ErrSecretNotFound
+		}
+		delete(secret.Data, r.GetKey())
+		if len(secret.Data) == 0 {
+			// the resource version guards against an entry set since the Get
+			return s.kubeClient.ControllerClient.Delete(ctx, secret, client.Preconditions{ResourceVersion: &secret.ResourceVersion})
+		}
+		return s.kubeClient.ControllerClient.Update(ctx, secret)
+	})
+	if apierrors.IsNotFound(err) || errors.Is(err, ErrSecretNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, ErrSecretNotFound)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to delete secret", "workspaceId", r.GetWorkspaceId(), "store", r.GetStore(), "key", r.GetKey(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete secret: %w", err))
+	}
+
+	slog.InfoContext(ctx, "deleted secret", "workspaceId", r.GetWorkspaceId(), "store", r.GetStore(), "key", r.GetKey())
+
+	return connect.NewResponse(&secretv1.DeleteSecretResponse{}), nil
+}
+
+// ListSecretStores lists the secret stores of a workspace, by name, with the keys of their entries
+func (s *SecretServer) ListSecretStores(
+	ctx context.Context,
+	req *connect.Request[secretv1.ListSecretStoresRequest],
+) (*connect.Response[secretv1.ListSecretStoresResponse], error) {
+	r := req.Msg
+
+	if err := s.authorize(ctx, actions.ListSecretStores, r.GetWorkspaceId()); err != nil {
+		return nil, err
+	}
+
+	var secrets corev1.SecretList
+	err := s.kubeClient.ControllerClient.List(ctx, &secrets,
+		client.InNamespace(s.locoNamespace),
+		client.MatchingLabels{locoControllerV1.SecretStoreWorkspaceLabel: strconv.FormatInt(r.GetWorkspaceId(), 10)},
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list secret stores", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list secret stores: %w", err))
+	}
+
+	stores := make([]*secretv1.SecretStore, 0, len(secrets.Items))
+	for _, secret := range secrets.Items {
+		keys := make([]string, 0, len(secret.Data))
+		for key := range secret.Data {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		stores = append(stores, &secretv1.SecretStore{Name: secret.Labels[locoControllerV1.SecretStoreLabel], Keys: keys})
+	}
+	slices.SortFunc(stores, func(a, b *secretv1.SecretStore) int {
+		return cmp.Compare(a.GetName(), b.GetName())
+	})
+
+	return connect.NewResponse(&secretv1.ListSecretStoresResponse{Stores: stores}), nil
+}
+
+// authorize checks the caller may perform action on the workspace's secret stores
+func (s *SecretServer) authorize(ctx context.Context, action actions.Action, workspaceID int64) error {
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(action, workspaceID)); err != nil {
+		slog.WarnContext(ctx, "unauthorized to manage secrets", "workspaceId", workspaceID)
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return nil
+}
+
+// validateSecretEntry checks store and key are what a secret://<store>/<key> env var can reference
+func validateSecretEntry(store, key string) error {
+	if err := locoControllerV1.ValidateSecretRef(locoControllerV1.SecretRefPrefix + store + "/" + key); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/testutil"
+	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	secretv1 "github.com/team-loco/loco/shared/proto/secret/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestSecretStores(t *testing.T) {
+	w := newResourceWorld(t)
+	kubeClient := testutil.NewKubeClient()
+	server := NewSecretServer(w.db, w.store, testutil.NewVendingMachine(t, w.store), kubeClient, "loco-system")
+	member := testutil.AsUser(testutil.Context(t), w.userID, testutil.Scopes(genDb.EntityTypeWorkspace, w.workspaceID, genDb.ScopeRead, genDb.ScopeWrite)...)
+	reader := testutil.AsUser(testutil.Context(t), w.userID, testutil.Scopes(genDb.EntityTypeWorkspace, w.workspaceID, genDb.ScopeRead)...)
+
+	set := func(store, key, value string) error {
+		_, err := server.SetSecret(member, connect.NewRequest(&secretv1.SetSecretRequest{WorkspaceId: w.workspaceID, Store: store, Key: key, Value: value}))
+		return err
+	}
+	storeSecret := func(store string) (*corev1.Secret, error) {
+		secret := &corev1.Secret{}
+		key := client.ObjectKey{Namespace: "loco-system", Name: locoControllerV1.SecretStoreName(w.workspaceID, store)}
+		return secret, kubeClient.ControllerClient.Get(t.Context(), key, secret)
+	}
+
+	if err := set("payments", "api-key", "sk_live_1"); err != nil {
+		t.Fatalf("unexpected error setting a secret: %v", err)
+	}
+	if err := set("payments", "webhook.secret", "whsec_1"); err != nil {
+		t.Fatalf("unexpected error setting a secret: %v", err)
+	}
+	if err := set("payments", "api-key", "sk_live_2"); err != nil {
+		t.Fatalf("unexpected error replacing a secret: %v", err)
+	}
+	if err := set("mail", "password", "hunter2"); err != nil {
+		t.Fatalf("unexpected error setting a secret: %v", err)
+	}
+
+	secret, err := storeSecret("payments")
+	if err != nil {
+		t.Fatalf("unexpected error getting the store's secret: %v", err)
+	}
+	if got := string(secret.Data["api-key"]); got != "sk_live_2" {
+		t.Errorf("expected api-key %q, got %q", "sk_live_2", got)
+	}
+	if got := secret.Labels[locoControllerV1.SecretStoreLabel]; got != "payments" {
+		t.Errorf("expected store label %q, got %q", "payments", got)
+	}
+
+	list, err := server.ListSecretStores(reader, connect.NewRequest(&secretv1.ListSecretStoresRequest{WorkspaceId: w.workspaceID}))
+	if err != nil {
+		t.Fatalf("unexpected error listing secret stores: %v", err)
+	}
+	var got []string
+	for _, store := range list.Msg.GetStores() {
+		got = append(got, store.GetName()+":"+strings.Join(store.GetKeys(), ","))
+	}
+	if want := []string{"mail:password", "payments:api-key,webhook.secret"}; !slices.Equal(got, want) {
+		t.Errorf("expected stores %v, got %v", want, got)
+	}
+
+	tests := []struct {
+		name     string
+		call     func() error
+		wantCode connect.Code
+	}{
+		{"bad store", func() error { return set("Payments", "api-key", "x") }, connect.CodeInvalidArgument},
+		{"bad key", func() error { return set("payments", "api/key", "x") }, connect.CodeInvalidArgument},
+		{"value too big", func() error { return set("payments", "big", strings.Repeat("x", maxSecretValueSize+1)) }, connect.CodeInvalidArgument},
+		{"set without write", func() error {
+			_, err := server.SetSecret(reader, connect.NewRequest(&secretv1.SetSecretRequest{WorkspaceId: w.workspaceID, Store: "payments", Key: "api-key", Value: "x"}))
+			return err
+		}, connect.CodePermissionDenied},
+		{"list another workspace", func() error {
+			_, err := server.ListSecretStores(member, connect.NewRequest(&secretv1.ListSecretStoresRequest{WorkspaceId: w.workspaceID + 1}))
+			return err
+		}, connect.CodePermissionDenied},
+		{"delete a missing key", func() error {
+			_, err := server.DeleteSecret(member, connect.NewRequest(&secretv1.DeleteSecretRequest{WorkspaceId: w.workspaceID, Store: "payments", Key: "missing"}))
+			return err
+		}, connect.CodeNotFound},
+		{"delete from a missing store", func() error {
+			_, err := server.DeleteSecret(member, connect.NewRequest(&secretv1.DeleteSecretRequest{WorkspaceId: w.workspaceID, Store: "billing", Key: "api-key"}))
+			return err
+		}, connect.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := connect.CodeOf(tt.call()); code != tt.wantCode {
+				t.Errorf("expected %v, got %v", tt.wantCode, code)
+			}
+		})
+	}
+
+	if _, err := server.DeleteSecret(member, connect.NewRequest(&secretv1.DeleteSecretRequest{WorkspaceId: w.workspaceID, Store: "mail", Key: "password"})); err != nil {
+		t.Fatalf("unexpected error deleting a secret: %v", err)
+	}
+	if _, err := storeSecret("mail"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the store to be deleted with its last entry, got %v", err)
+	}
+	if _, err := server.DeleteSecret(member, connect.NewRequest(&secretv1.DeleteSecretRequest{WorkspaceId: w.workspaceID, Store: "payments", Key: "api-key"})); err != nil {
+		t.Fatalf("unexpected error deleting a secret: %v", err)
+	}
+	secret, err = storeSecret("payments")
+	if err != nil {
+		t.Fatalf("expected the store to be kept while it has entries, got %v", err)
+	}
+	if _, ok := secret.Data["api-key"]; ok || len(secret.Data) != 1 {
+		t.Errorf("expected only webhook.secret to be left, got %v", slices.Collect(maps.Keys(secret.Data)))
+	}
+}
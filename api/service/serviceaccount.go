@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+	serviceaccountv1 "github.com/team-loco/loco/shared/proto/serviceaccount/v1"
+	tokenv1 "github.com/team-loco/loco/shared/proto/token/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrServiceAccountNotFound  = errors.New("service account not found")
+	ErrInvalidServiceAccount   = errors.New("service account name must be 1-63 lowercase letters, digits or dashes")
+	ErrScopeOutsideWorkspace   = errors.New("service account scopes must be within its workspace")
+	ErrServiceAccountEscalates = errors.New("cannot grant a service account a scope the caller does not hold")
+)
+
+// serviceAccountNamePattern matches names like "ci" or "deploy-bot"
+var serviceAccountNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+type ServiceAccountServer struct {
+	db      apiDb.Beginner
+	queries genDb.Querier
+	machine *tvm.VendingMachine
+}
+
+func NewServiceAccountServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine) *ServiceAccountServer {
+	return &ServiceAccountServer{db: db, queries: queries, machine: machine}
+}
+
+// CreateServiceAccount creates a service account in a workspace
+func (s *ServiceAccountServer) CreateServiceAccount(
+	ctx context.Context,
+	req *connect.Request[serviceaccountv1.CreateServiceAccountRequest],
+) (*connect.Response[serviceaccountv1.CreateServiceAccountResponse], error) {
+	r := req.Msg
+
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.CreateServiceAccount, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to create service account", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if !serviceAccountNamePattern.MatchString(r.GetName()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidServiceAccount)
+	}
+
+	accountScopes, err := s.checkScopes(ctx, scopes, r.GetWorkspaceId(), r.GetScopes())
+	if err != nil {
+		return nil, err
+	}
+
+	params := genDb.CreateServiceAccountParams{
+		WorkspaceID: r.GetWorkspaceId(),
+		Name:        r.GetName(),
+		Description: r.GetDescription(),
+	}
+	if entity.Type == genDb.EntityTypeUser {
+		params.CreatedBy = pgtype.Int8{Int64: entity.ID, Valid: true}
+	}
+
+	account, err := s.queries.CreateServiceAccount(ctx, params)
+	if err != nil {
+		if isPgConstraintViolation(err) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("a service account with this name already exists in this workspace"))
+		}
+		slog.ErrorContext(ctx, "failed to create service account", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.machine.SetServiceAccountScopes(ctx, account.ID, accountScopes); err != nil {
+		slog.ErrorContext(ctx, "failed to set service account scopes", "serviceAccountId", account.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "created service account", "serviceAccountId", account.ID, "workspaceId", account.WorkspaceID, "name", account.Name)
+
+	return connect.NewResponse(&serviceaccountv1.CreateServiceAccountResponse{
+		ServiceAccount: serviceAccountToProto(account, accountScopes),
+	}), nil
+}
+
+// GetServiceAccount retrieves a service account by ID
+func (s *ServiceAccountServer) GetServiceAccount(
+	ctx context.Context,
+	req *connect.Request[serviceaccountv1.GetServiceAccountRequest],
+) (*connect.Response[serviceaccountv1.GetServiceAccountResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetServiceAccount, r.GetServiceAccountId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	account, err := s.getServiceAccount(ctx, r.GetServiceAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&serviceaccountv1.GetServiceAccountResponse{
+		ServiceAccount: account,
+	}), nil
+}
+
+// ListServiceAccounts lists the service accounts of a workspace, oldest first
+func (s *ServiceAccountServer) ListServiceAccounts(
+	ctx context.Context,
+	req *connect.Request[serviceaccountv1.ListServiceAccountsRequest],
+) (*connect.Response[serviceaccountv1.ListServiceAccountsResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ListServiceAccounts, r.GetWorkspaceId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	accounts, err := s.queries.ListServiceAccountsForWorkspace(ctx, r.GetWorkspaceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list service accounts", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	protoAccounts := make([]*serviceaccountv1.ServiceAccount, 0, len(accounts))
+	for _, account := range accounts {
+		accountScopes, err := s.queries.GetServiceAccountScopes(ctx, account.ID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to get service account scopes", "serviceAccountId", account.ID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		protoAccounts = append(protoAccounts, serviceAccountToProto(account, accountScopes))
+	}
+
+	return connect.NewResponse(&serviceaccountv1.ListServiceAccountsResponse{
+		ServiceAccounts: protoAccounts,
+	}), nil
+}
+
+// SetServiceAccountScopes replaces a service account's scopes
+func (s *ServiceAccountServer) SetServiceAccountScopes(
+	ctx context.Context,
+	req *connect.Request[serviceaccountv1.SetServiceAccountScopesRequest],
+) (*connect.Response[serviceaccountv1.SetServiceAccountScopesResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.SetServiceAccountScopes, r.GetServiceAccountId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	account, err := s.queries.GetServiceAccountByID(ctx, r.GetServiceAccountId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrServiceAccountNotFound)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	accountScopes, err := s.checkScopes(ctx, scopes, account.WorkspaceID, r.GetScopes())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.machine.SetServiceAccountScopes(ctx, account.ID, accountScopes); err != nil {
+		slog.ErrorContext(ctx, "failed to set service account scopes", "serviceAccountId", account.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "set service account scopes", "serviceAccountId", account.ID, "scopes", len(accountScopes))
+
+	return connect.NewResponse(&serviceaccountv1.SetServiceAccountScopesResponse{
+		ServiceAccount: serviceAccountToProto(account, accountScopes),
+	}), nil
+}
+
+// DeleteServiceAccount deletes a service account and revokes its tokens
+func (s *ServiceAccountServer) DeleteServiceAccount(
+	ctx context.Context,
+	req *connect.Request[serviceaccountv1.DeleteServiceAccountRequest],
+) (*connect.Response[serviceaccountv1.DeleteServiceAccountResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.DeleteServiceAccount, r.GetServiceAccountId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	entity := genDb.Entity{Type: genDb.EntityTypeServiceAccount, ID: r.GetServiceAccountId()}
+
+	// revoke first, so a failed delete never leaves tokens of a half-deleted account
+	if err := s.machine.RevokeAllForEntity(ctx, entity); err != nil {
+		slog.ErrorContext(ctx, "failed to revoke service account tokens", "serviceAccountId", entity.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.queries.DeleteServiceAccount(ctx, entity.ID); err != nil {
+		slog.ErrorContext(ctx, "failed to delete service account", "serviceAccountId", entity.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	// scopes users were granted on the service account are meaningless once it is gone
+	if err := s.queries.RemoveAllScopesForEntity(ctx, genDb.RemoveAllScopesForEntityParams{
+		EntityType: entity.Type,
+		EntityID:   entity.ID,
+	}); err != nil {
+		slog.WarnContext(ctx, "failed to remove service account scopes", "serviceAccountId", entity.ID, "error", err)
+	}
+
+	slog.InfoContext(ctx, "deleted service account", "serviceAccountId", entity.ID)
+
+	return connect.NewResponse(&serviceaccountv1.DeleteServiceAccountResponse{}), nil
+}
+
+// getServiceAccount loads a service account and its scopes
+func (s *ServiceAccountServer) getServiceAccount(ctx context.Context, id int64) (*serviceaccountv1.ServiceAccount, error) {
+	account, err := s.queries.GetServiceAccountByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrServiceAccountNotFound)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	accountScopes, err := s.queries.GetServiceAccountScopes(ctx, id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return serviceAccountToProto(account, accountScopes), nil
+}
+
+// checkScopes converts requested scopes, making sure each one is within the workspace and held by the caller,
+// so a service account can never do more than whoever set it up.
+func (s *ServiceAccountServer) checkScopes(ctx context.Context, callerScopes []genDb.EntityScope, workspaceID int64, requested []*tokenv1.EntityScope) ([]genDb.EntityScope, error) {
+	accountScopes := make([]genDb.EntityScope, 0, len(requested))
+	for _, scope := range requested {
+		if scope.GetEntityType() == tokenv1.EntityType_ENTITY_TYPE_UNSPECIFIED || scope.GetScope() == tokenv1.Scope_SCOPE_UNSPECIFIED {
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidScopes)
+		}
+		es := genDb.EntityScope{
+			EntityType: protoEntityTypeToDb(scope.GetEntityType()),
+			EntityID:   scope.GetEntityId(),
+			Scope:      protoScopeToDb(scope.GetScope()),
+		}
+
+		// a scope is within the workspace when the same scope on the workspace implies it
+		workspaceScope := genDb.EntityScope{EntityType: genDb.EntityTypeWorkspace, EntityID: workspaceID, Scope: es.Scope}
+		if err := s.machine.VerifyWithGivenEntityScopes(ctx, []genDb.EntityScope{workspaceScope}, es); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s:%s on %d", ErrScopeOutsideWorkspace, es.EntityType, es.Scope, es.EntityID))
+		}
+
+		if err := s.machine.VerifyWithGivenEntityScopes(ctx, callerScopes, es); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%w: %s:%s on %d", ErrServiceAccountEscalates, es.EntityType, es.Scope, es.EntityID))
+		}
+		accountScopes = append(accountScopes, es)
+	}
+	return accountScopes, nil
+}
+
+// serviceAccountToProto converts a database ServiceAccount and its scopes to the proto ServiceAccount
+func serviceAccountToProto(account genDb.ServiceAccount, accountScopes []genDb.EntityScope) *serviceaccountv1.ServiceAccount {
+	scopes := make([]*tokenv1.EntityScope, len(accountScopes))
+	for i, scope := range accountScopes {
+		scopes[i] = &tokenv1.EntityScope{
+			Scope:      dbScopeToProto(scope.Scope),
+			EntityType: dbEntityTypeToProto(scope.EntityType),
+			EntityId:   scope.EntityID,
+		}
+	}
+	return &serviceaccountv1.ServiceAccount{
+		Id:          account.ID,
+		WorkspaceId: account.WorkspaceID,
+		Name:        account.Name,
+		Description: account.Description,
+		Scopes:      scopes,
+		CreatedBy:   account.CreatedBy.Int64,
+		CreatedAt:   timestamppb.New(account.CreatedAt.Time),
+		UpdatedAt:   timestamppb.New(account.UpdatedAt.Time),
+	}
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"connectrpc.com/connect"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/policy"
+	"github.com/team-loco/loco/api/pkg/registry"
+	"github.com/team-loco/loco/controller/pkg/imagesig"
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	policyv1 "github.com/team-loco/loco/shared/proto/policy/v1"
+)
+
+// NewSignatureVerifier creates the verifier of the image signatures workspace policies require,
+// reading signatures through the resolver. It returns nil when images are not resolved, since
+// signatures are found by the digest an image resolved to.
+func NewSignatureVerifier(cfg registry.Config, resolver *registry.Resolver) (*imagesig.Verifier, error) {
+	if resolver == nil {
+		return nil, nil
+	}
+
+	var trust *imagesig.TrustRoot
+	if cfg.FulcioRootsFile != "" {
+		fulcio, err := os.ReadFile(cfg.FulcioRootsFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Fulcio roots: %w", err)
+		}
+		rekor, err := os.ReadFile(cfg.RekorKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Rekor key: %w", err)
+		}
+		if trust, err = imagesig.ParseTrustRoot(fulcio, rekor); err != nil {
+			return nil, err
+		}
+	}
+	return imagesig.NewVerifier(signatureFetcher{resolver}, trust), nil
+}
+
+// signatureFetcher reads signatures with the resolver's registry access.
+type signatureFetcher struct {
+	resolver *registry.Resolver
+}
+
+func (f signatureFetcher) Manifest(ctx context.Context, host, repository, reference string) ([]byte, error) {
+	data, err := f.resolver.Manifest(ctx, host, repository, reference)
+	return data, signatureFetchError(err)
+}
+
+func (f signatureFetcher) Blob(ctx context.Context, host, repository, digest string) ([]byte, error) {
+	data, err := f.resolver.Blob(ctx, host, repository, digest)
+	return data, signatureFetchError(err)
+}
+
+func signatureFetchError(err error) error {
+	if errors.Is(err, registry.ErrImageNotFound) {
+		return fmt.Errorf("%w: %v", imagesig.ErrNotFound, err)
+	}
+	return err
+}
+
+// checkSignatures enforces the workspace's signature policies on the image about to be deployed,
+// which must already be resolved to its digest. The policies the image satisfies are recorded on
+// the build so the cluster verifies them again before rolling out.
+func (s *DeploymentServer) checkSignatures(ctx context.Context, resource genDb.Resource, build *deploymentv1.BuildSource) error {
+	build.SignaturePolicies = nil
+
+	policies, err := s.queries.ListWorkspacePolicies(ctx, resource.WorkspaceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list policies", "workspaceId", resource.WorkspaceID, "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	signatures, err := policy.Signatures(policies)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read signature policies", "workspaceId", resource.WorkspaceID, "error", err)
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if len(signatures) == 0 {
+		return nil
+	}
+	if s.verifier == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, ErrSignatureUnverifiable)
+	}
+
+	image := registry.Pin(build.GetImage(), build.GetImageDigest())
+	var violations []*policyv1.PolicyViolation
+	for _, signature := range signatures {
+		err := s.verifier.Verify(ctx, image, policy.SignaturePolicy(signature.Rule))
+		switch {
+		case err == nil:
+			build.SignaturePolicies = append(build.SignaturePolicies, signature.Rule)
+		case errors.Is(err, imagesig.ErrNoSignature), errors.Is(err, imagesig.ErrUnverified), errors.Is(err, imagesig.ErrNoTrustRoot):
+			violations = append(violations, &policyv1.PolicyViolation{
+				PolicyId:   signature.PolicyID,
+				PolicyName: signature.PolicyName,
+				Field:      "build.image",
+				Message:    err.Error(),
+			})
+		default:
+			slog.WarnContext(ctx, "failed to verify image signature", "image", image, "policyId", signature.PolicyID, "error", err)
+			return connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to verify signature of image %s: %w", image, err))
+		}
+	}
+	if len(violations) > 0 {
+		return policyViolationError(ctx, resource.WorkspaceID, violations)
+	}
+
+	slog.InfoContext(ctx, "verified image signatures", "image", image, "policies", len(signatures))
+	return nil
+}
@@ -0,0 +1,606 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/converter"
+	"github.com/team-loco/loco/api/pkg/slack"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+	slackv1 "github.com/team-loco/loco/shared/proto/slack/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrSlackNotInstalled    = errors.New("slack is not installed in this organization")
+	ErrSlackChannelRequired = errors.New("channel_id is required")
+	ErrSlackChannelNotFound = errors.New("slack channel not found; invite the loco app to it first")
+)
+
+// SlackServer implements the SlackService gRPC server, and carries out installs and slash
+// commands for the Slack app.
+type SlackServer struct {
+	db          apiDb.Beginner
+	queries     genDb.Querier
+	machine     *tvm.VendingMachine
+	slack       *slack.Client
+	resources   *ResourceServer
+	deployments *DeploymentServer
+}
+
+// NewSlackServer creates a new SlackServer instance. slackClient is nil when the app is not configured.
+func NewSlackServer(
+	db apiDb.Beginner,
+	queries genDb.Querier,
+	machine *tvm.VendingMachine,
+	slackClient *slack.Client,
+	resources *ResourceServer,
+	deployments *DeploymentServer,
+) *SlackServer {
+	return &SlackServer{
+		db:          db,
+		queries:     queries,
+		machine:     machine,
+		slack:       slackClient,
+		resources:   resources,
+		deployments: deployments,
+	}
+}
+
+// GetSlackInstallURL returns where an org admin goes to install the Slack app
+func (s *SlackServer) GetSlackInstallURL(
+	ctx context.Context,
+	req *connect.Request[slackv1.GetSlackInstallURLRequest],
+) (*connect.Response[slackv1.GetSlackInstallURLResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity not found in context"))
+	}
+	if entity.Type != genDb.EntityTypeUser {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("only users can install slack"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.InstallSlack, r.GetOrgId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to install slack", "orgId", r.GetOrgId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	url, err := s.slack.InstallURL(r.GetOrgId(), entity.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return connect.NewResponse(&slackv1.GetSlackInstallURLResponse{Url: url}), nil
+}
+
+// GetSlackInstallation returns an org's Slack installation
+func (s *SlackServer) GetSlackInstallation(
+	ctx context.Context,
+	req *connect.Request[slackv1.GetSlackInstallationRequest],
+) (*connect.Response[slackv1.GetSlackInstallationResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetSlackInstallation, r.GetOrgId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to get slack installation", "orgId", r.GetOrgId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	installation, err := s.queries.GetSlackInstallation(ctx, r.GetOrgId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return connect.NewResponse(&slackv1.GetSlackInstallationResponse{}), nil
+		}
+		slog.ErrorContext(ctx, "failed to get slack installation", "orgId", r.GetOrgId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &slackv1.SlackInstallation{
+		OrgId:     installation.OrgID,
+		TeamId:    installation.TeamID,
+		TeamName:  installation.TeamName,
+		CreatedAt: timestamppb.New(installation.CreatedAt.Time),
+	}
+	if installation.InstalledBy.Valid {
+		resp.InstalledBy = &installation.InstalledBy.Int64
+	}
+
+	return connect.NewResponse(&slackv1.GetSlackInstallationResponse{Installation: resp}), nil
+}
+
+// DeleteSlackInstallation uninstalls the Slack app from an org
+func (s *SlackServer) DeleteSlackInstallation(
+	ctx context.Context,
+	req *connect.Request[slackv1.DeleteSlackInstallationRequest],
+) (*connect.Response[slackv1.DeleteSlackInstallationResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.DeleteSlackInstallation, r.GetOrgId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to delete slack installation", "orgId", r.GetOrgId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	installation, err := s.queries.GetSlackInstallation(ctx, r.GetOrgId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrSlackNotInstalled)
+		}
+		slog.ErrorContext(ctx, "failed to get slack installation", "orgId", r.GetOrgId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := apiDb.QueriesFor(tx)
+
+	if err := qtx.DeleteSlackChannelsForOrg(ctx, r.GetOrgId()); err != nil {
+		slog.ErrorContext(ctx, "failed to delete slack channels", "orgId", r.GetOrgId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if _, err := qtx.DeleteSlackInstallation(ctx, r.GetOrgId()); err != nil {
+		slog.ErrorContext(ctx, "failed to delete slack installation", "orgId", r.GetOrgId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit slack uninstall", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	// the rows are gone either way; a token left valid can no longer be used by loco
+	if err := s.slack.Revoke(ctx, installation.BotToken); err != nil {
+		slog.WarnContext(ctx, "failed to revoke slack token", "orgId", r.GetOrgId(), "error", err)
+	}
+
+	slog.InfoContext(ctx, "uninstalled slack app", "orgId", r.GetOrgId(), "teamId", installation.TeamID)
+
+	return connect.NewResponse(&slackv1.DeleteSlackInstallationResponse{}), nil
+}
+
+// GetWorkspaceSlackChannel returns the channel a workspace posts to
+func (s *SlackServer) GetWorkspaceSlackChannel(
+	ctx context.Context,
+	req *connect.Request[slackv1.GetWorkspaceSlackChannelRequest],
+) (*connect.Response[slackv1.GetWorkspaceSlackChannelResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetWorkspaceSlackChannel, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to get workspace slack channel", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	channel, err := s.queries.GetWorkspaceSlackChannel(ctx, r.GetWorkspaceId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return connect.NewResponse(&slackv1.GetWorkspaceSlackChannelResponse{}), nil
+		}
+		slog.ErrorContext(ctx, "failed to get workspace slack channel", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&slackv1.GetWorkspaceSlackChannelResponse{
+		Channel: slackChannelToProto(channel),
+	}), nil
+}
+
+// SetWorkspaceSlackChannel sets the channel a workspace posts to
+func (s *SlackServer) SetWorkspaceSlackChannel(
+	ctx context.Context,
+	req *connect.Request[slackv1.SetWorkspaceSlackChannelRequest],
+) (*connect.Response[slackv1.SetWorkspaceSlackChannelResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.SetWorkspaceSlackChannel, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to set workspace slack channel", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	channelID := strings.TrimSpace(r.GetChannelId())
+	if channelID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrSlackChannelRequired)
+	}
+
+	workspace, err := s.queries.GetWorkspaceByIDQuery(ctx, r.GetWorkspaceId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrWorkspaceNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get workspace", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	installation, err := s.queries.GetSlackInstallation(ctx, workspace.OrgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrSlackNotInstalled)
+		}
+		slog.ErrorContext(ctx, "failed to get slack installation", "orgId", workspace.OrgID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	name, err := s.slack.ChannelName(ctx, installation.BotToken, channelID)
+	if err != nil {
+		var apiErr *slack.APIError
+		if errors.As(err, &apiErr) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrSlackChannelNotFound)
+		}
+		if errors.Is(err, slack.ErrNotConfigured) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		slog.ErrorContext(ctx, "failed to look up slack channel", "channelId", channelID, "error", err)
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+
+	deployments, alerts := true, true
+	if r.Deployments != nil {
+		deployments = r.GetDeployments()
+	}
+	if r.Alerts != nil {
+		alerts = r.GetAlerts()
+	}
+
+	channel, err := s.queries.UpsertWorkspaceSlackChannel(ctx, genDb.UpsertWorkspaceSlackChannelParams{
+		WorkspaceID: workspace.ID,
+		ChannelID:   channelID,
+		ChannelName: name,
+		Deployments: deployments,
+		Alerts:      alerts,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save workspace slack channel", "workspaceId", workspace.ID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "set workspace slack channel", "workspaceId", workspace.ID, "channelId", channelID)
+
+	return connect.NewResponse(&slackv1.SetWorkspaceSlackChannelResponse{
+		Channel: slackChannelToProto(channel),
+	}), nil
+}
+
+// DeleteWorkspaceSlackChannel stops a workspace posting to Slack
+func (s *SlackServer) DeleteWorkspaceSlackChannel(
+	ctx context.Context,
+	req *connect.Request[slackv1.DeleteWorkspaceSlackChannelRequest],
+) (*connect.Response[slackv1.DeleteWorkspaceSlackChannelResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.DeleteWorkspaceSlackChannel, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to delete workspace slack channel", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if _, err := s.queries.DeleteWorkspaceSlackChannel(ctx, r.GetWorkspaceId()); err != nil {
+		slog.ErrorContext(ctx, "failed to delete workspace slack channel", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&slackv1.DeleteWorkspaceSlackChannelResponse{}), nil
+}
+
+// Install records an installation of the Slack app. It implements slack.App.
+func (s *SlackServer) Install(ctx context.Context, orgID, userID int64, installation slack.Installation) error {
+	return s.queries.UpsertSlackInstallation(ctx, genDb.UpsertSlackInstallationParams{
+		OrgID:       orgID,
+		TeamID:      installation.TeamID,
+		TeamName:    installation.TeamName,
+		BotToken:    installation.BotToken,
+		InstalledBy: pgtype.Int8{Int64: userID, Valid: userID != 0},
+	})
+}
+
+// Command runs a /loco slash command as the loco user with the email of the Slack user who sent
+// it, unless that user logs in with MFA. It implements slack.App.
+func (s *SlackServer) Command(ctx context.Context, cmd slack.Command) slack.Message {
+	if cmd.Name != "deploy" && cmd.Name != "status" {
+		return slack.Reply("Unknown command `%s`. Try `/loco help`.", cmd.Name)
+	}
+
+	workspaces, err := s.queries.ListSlackChannelWorkspaces(ctx, genDb.ListSlackChannelWorkspacesParams{
+		TeamID:    cmd.TeamID,
+		ChannelID: cmd.ChannelID,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list slack channel workspaces", "error", err)
+		return slack.Reply("Something went wrong, please try again.")
+	}
+	if len(workspaces) == 0 {
+		return slack.Reply("This channel is not connected to a loco workspace.")
+	}
+
+	ctx, reply := s.commandContext(ctx, cmd)
+	if reply != nil {
+		return *reply
+	}
+
+	switch cmd.Name {
+	case "deploy":
+		if len(cmd.Args) != 2 {
+			return slack.Reply("Usage: `/loco deploy <resource> <image>`")
+		}
+		resource, reply := s.commandResource(ctx, workspaces, cmd.Args[0])
+		if reply != nil {
+			return *reply
+		}
+		return s.deploy(ctx, cmd, resource, cmd.Args[1])
+	default:
+		if len(cmd.Args) != 1 {
+			return slack.Reply("Usage: `/loco status <resource>`")
+		}
+		resource, reply := s.commandResource(ctx, workspaces, cmd.Args[0])
+		if reply != nil {
+			return *reply
+		}
+		return s.status(ctx, resource)
+	}
+}
+
+// commandContext finds the loco user who sent cmd and returns a context acting as them. Users
+// who log in with MFA are refused.
+func (s *SlackServer) commandContext(ctx context.Context, cmd slack.Command) (context.Context, *slack.Message) {
+	token, err := s.queries.GetSlackBotToken(ctx, cmd.TeamID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get slack bot token", "teamId", cmd.TeamID, "error", err)
+		reply := slack.Reply("Something went wrong, please try again.")
+		return ctx, &reply
+	}
+	email, err := s.slack.UserEmail(ctx, token, cmd.UserID)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to look up slack user", "slackUserId", cmd.UserID, "error", err)
+		reply := slack.Reply("Could not look up your Slack profile, please try again.")
+		return ctx, &reply
+	}
+	if email == "" {
+		reply := slack.Reply("Your Slack profile has no email address, so your loco account cannot be found.")
+		return ctx, &reply
+	}
+
+	user, err := s.queries.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			reply := slack.Reply("No loco account uses %s.", email)
+			return ctx, &reply
+		}
+		slog.ErrorContext(ctx, "failed to get user by email", "error", err)
+		reply := slack.Reply("Something went wrong, please try again.")
+		return ctx, &reply
+	}
+	// A Slack profile is no second factor, so users who must pass a TOTP check to log in cannot
+	// act through Slack.
+	status, err := s.machine.GetMFAStatus(ctx, user.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get mfa status", "userId", user.ID, "error", err)
+		reply := slack.Reply("Something went wrong, please try again.")
+		return ctx, &reply
+	}
+	if status.Enabled || status.Required {
+		reply := slack.Reply("Your loco account uses MFA, which Slack commands cannot check. Use the loco CLI or dashboard instead.")
+		return ctx, &reply
+	}
+	scopes, err := s.queries.GetUserScopes(ctx, user.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get user scopes", "userId", user.ID, "error", err)
+		reply := slack.Reply("Something went wrong, please try again.")
+		return ctx, &reply
+	}
+
+	ctx = contextkeys.WithAuth(ctx, contextkeys.Auth{
+		Entity: genDb.Entity{Type: genDb.EntityTypeUser, ID: user.ID},
+		Scopes: scopes,
+	})
+	return ctx, nil
+}
+
+// commandResource finds a resource by ID, or by name outside any environment, in the workspaces of
+// the channel.
+func (s *SlackServer) commandResource(ctx context.Context, workspaces []genDb.ListSlackChannelWorkspacesRow, ref string) (genDb.Resource, *slack.Message) {
+	if id, err := strconv.ParseInt(ref, 10, 64); err == nil {
+		resource, err := s.queries.GetResourceByID(ctx, id)
+		if err == nil {
+			for _, w := range workspaces {
+				if w.ID == resource.WorkspaceID {
+					return resource, nil
+				}
+			}
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			slog.ErrorContext(ctx, "failed to get resource", "resourceId", id, "error", err)
+			reply := slack.Reply("Something went wrong, please try again.")
+			return genDb.Resource{}, &reply
+		}
+	}
+
+	var found []genDb.Resource
+	for _, w := range workspaces {
+		resource, err := s.queries.GetResourceByNameAndWorkspace(ctx, genDb.GetResourceByNameAndWorkspaceParams{
+			WorkspaceID: w.ID,
+			Name:        ref,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			slog.ErrorContext(ctx, "failed to get resource by name", "workspaceId", w.ID, "error", err)
+			reply := slack.Reply("Something went wrong, please try again.")
+			return genDb.Resource{}, &reply
+		}
+		found = append(found, resource)
+	}
+
+	switch len(found) {
+	case 0:
+		reply := slack.Reply("No resource named `%s` in the workspaces of this channel.", ref)
+		return genDb.Resource{}, &reply
+	case 1:
+		return found[0], nil
+	default:
+		reply := slack.Reply("More than one workspace of this channel has a resource named `%s`; use its ID instead.", ref)
+		return genDb.Resource{}, &reply
+	}
+}
+
+// deploy redeploys a service's active deployment in its primary region with another image.
+func (s *SlackServer) deploy(ctx context.Context, cmd slack.Command, resource genDb.Resource, image string) slack.Message {
+	if resource.Type != genDb.ResourceTypeService {
+		return slack.Reply("Only services can be deployed from Slack.")
+	}
+
+	regions, err := s.queries.ListResourceRegions(ctx, resource.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list resource regions", "resourceId", resource.ID, "error", err)
+		return slack.Reply("Something went wrong, please try again.")
+	}
+	if len(regions) == 0 {
+		return slack.Reply("`%s` has no regions to deploy to.", resource.Name)
+	}
+	// regions are ordered primary first
+	region := regions[0].Region
+
+	active, err := s.queries.GetActiveDeploymentForResourceAndRegion(ctx, genDb.GetActiveDeploymentForResourceAndRegionParams{
+		ResourceID: resource.ID,
+		Region:     region,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return slack.Reply("`%s` has no active deployment in %s to update; deploy it with the CLI first.", resource.Name, region)
+		}
+		slog.ErrorContext(ctx, "failed to get active deployment", "resourceId", resource.ID, "error", err)
+		return slack.Reply("Something went wrong, please try again.")
+	}
+	activeSpec, err := converter.DeserializeDeploymentSpec(active.Spec, string(resource.Type))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to deserialize deployment spec", "deploymentId", active.ID, "error", err)
+		return slack.Reply("Something went wrong, please try again.")
+	}
+
+	spec := proto.CloneOf(activeSpec.GetService())
+	if spec.GetBuild() == nil {
+		spec.Build = &deploymentv1.BuildSource{Type: "image"}
+	}
+	spec.Build.Image = image
+
+	// CreateDeployment checks that the user may deploy the resource
+	created, err := s.deployments.CreateDeployment(ctx, connect.NewRequest(&deploymentv1.CreateDeploymentRequest{
+		ResourceId: resource.ID,
+		Region:     region,
+		Spec:       &deploymentv1.DeploymentSpec{Spec: &deploymentv1.DeploymentSpec_Service{Service: spec}},
+	}))
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodePermissionDenied {
+			return slack.Reply("You do not have permission to deploy `%s`.", resource.Name)
+		}
+		return slack.Reply("Could not deploy `%s`: %s", resource.Name, connectErrorMessage(err))
+	}
+
+	slog.InfoContext(ctx, "deployed from slack", "resourceId", resource.ID, "deploymentId", created.Msg.GetDeploymentId(), "slackUserId", cmd.UserID)
+
+	return slack.Message{
+		ResponseType: "in_channel",
+		Text:         fmt.Sprintf("<@%s> started deployment %d of `%s` in %s with `%s`.", cmd.UserID, created.Msg.GetDeploymentId(), resource.Name, region, image),
+	}
+}
+
+// status replies with a resource's status, its current deployment and its latest health probe.
+func (s *SlackServer) status(ctx context.Context, resource genDb.Resource) slack.Message {
+	resp, err := s.resources.GetResourceStatus(ctx, connect.NewRequest(&resourcev1.GetResourceStatusRequest{ResourceId: resource.ID}))
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodePermissionDenied {
+			return slack.Reply("You do not have permission to see `%s`.", resource.Name)
+		}
+		return slack.Reply("Could not get the status of `%s`: %s", resource.Name, connectErrorMessage(err))
+	}
+
+	status := strings.ToLower(strings.TrimPrefix(resp.Msg.GetResource().GetStatus().String(), "RESOURCE_STATUS_"))
+	var fields []slack.Field
+	if d := resp.Msg.GetCurrentDeployment(); d != nil && d.GetId() != 0 {
+		phase := strings.ToLower(strings.TrimPrefix(d.GetStatus().String(), "DEPLOYMENT_PHASE_"))
+		fields = append(fields,
+			slack.Field{Label: "Deployment", Value: fmt.Sprintf("%d (%s)", d.GetId(), phase)},
+			slack.Field{Label: "Replicas", Value: fmt.Sprint(d.GetReplicas())},
+		)
+	}
+	if probe := resp.Msg.GetLatestProbe(); probe != nil {
+		health := fmt.Sprintf("ok in %d ms", probe.GetLatencyMs())
+		if !probe.GetOk() {
+			health = "failing: " + probe.GetError()
+		}
+		fields = append(fields, slack.Field{Label: "Health", Value: health})
+	}
+
+	message := slack.StatusMessage(resource.Name, status, fields)
+	message.ResponseType = "ephemeral"
+	return message
+}
+
+// connectErrorMessage is the message of a connect error without its code prefix.
+func connectErrorMessage(err error) string {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Message()
+	}
+	return err.Error()
+}
+
+func slackChannelToProto(channel genDb.WorkspaceSlackChannel) *slackv1.SlackChannel {
+	return &slackv1.SlackChannel{
+		WorkspaceId: channel.WorkspaceID,
+		ChannelId:   channel.ChannelID,
+		ChannelName: channel.ChannelName,
+		Deployments: channel.Deployments,
+		Alerts:      channel.Alerts,
+	}
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/slack"
+	"github.com/team-loco/loco/api/testutil"
+)
+
+// slackProfiles answers users.info with the email of the Slack user asked for
+type slackProfiles map[string]string
+
+func (p slackProfiles) RoundTrip(req *http.Request) (*http.Response, error) {
+	var resp struct {
+		OK   bool `json:"ok"`
+		User struct {
+			Profile struct {
+				Email string `json:"email"`
+			} `json:"profile"`
+		} `json:"user"`
+	}
+	resp.OK = true
+	resp.User.Profile.Email = p[req.URL.Query().Get("user")]
+	rec := httptest.NewRecorder()
+	json.NewEncoder(rec).Encode(resp)
+	return rec.Result(), nil
+}
+
+func TestSlackCommandContext(t *testing.T) {
+	tests := []struct {
+		name        string
+		email       string // of the Slack profile
+		totp        *genDb.UserTotp
+		mfaRequired bool
+		wantReply   string // a substring of the reply, or "" to act as the user
+	}{
+		{"no mfa", "dev@loco-testing.com", nil, false, ""},
+		{"mfa enabled", "dev@loco-testing.com", &genDb.UserTotp{EnabledAt: pgtype.Timestamptz{Valid: true}}, false, "uses MFA"},
+		{"mfa required by an org", "dev@loco-testing.com", nil, true, "uses MFA"},
+		{"mfa enrollment not confirmed", "dev@loco-testing.com", &genDb.UserTotp{}, false, ""},
+		{"no matching account", "someone@loco-testing.com", nil, false, "No loco account uses someone@loco-testing.com"},
+		{"no email", "", nil, false, "no email address"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			store := testutil.NewStore()
+			user, err := store.CreateUser(ctx, genDb.CreateUserParams{ExternalID: "ext-1", Email: "dev@loco-testing.com"})
+			if err != nil {
+				t.Fatalf("unexpected error creating user: %v", err)
+			}
+			store.GetSlackBotTokenFunc = func(ctx context.Context, teamID string) (string, error) {
+				return "xoxb-token", nil
+			}
+			store.GetUserTOTPFunc = func(ctx context.Context, userID int64) (genDb.UserTotp, error) {
+				if tt.totp == nil {
+					return genDb.UserTotp{}, pgx.ErrNoRows
+				}
+				return *tt.totp, nil
+			}
+			store.UserRequiresMFAFunc = func(ctx context.Context, userID int64) (bool, error) {
+				return tt.mfaRequired, nil
+			}
+
+			client := slack.NewClient(slack.Config{ClientID: "id"}, &http.Client{Transport: slackProfiles{"U1": tt.email}})
+			server := NewSlackServer(testutil.NewDB(store), store, testutil.NewVendingMachine(t, store), client, nil, nil)
+
+			got, reply := server.commandContext(ctx, slack.Command{TeamID: "T1", ChannelID: "C1", UserID: "U1", Name: "status"})
+			if tt.wantReply != "" {
+				if reply == nil || !strings.Contains(reply.Text, tt.wantReply) {
+					t.Errorf("expected a reply containing %q, got %+v", tt.wantReply, reply)
+				}
+				if _, ok := contextkeys.Entity(got); ok {
+					t.Errorf("expected the context not to act as anyone")
+				}
+				return
+			}
+			if reply != nil {
+				t.Fatalf("expected no reply, got %q", reply.Text)
+			}
+			entity, ok := contextkeys.Entity(got)
+			if !ok || entity.Type != genDb.EntityTypeUser || entity.ID != user.ID {
+				t.Errorf("expected the context to act as user %d, got %+v", user.ID, entity)
+			}
+		})
+	}
+}
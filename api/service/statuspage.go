@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"regexp"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/statuspage"
+	"github.com/team-loco/loco/api/tvm/actions"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+)
+
+var (
+	ErrInvalidAccentColor = errors.New("accent color must be #rgb or #rrggbb")
+	ErrInvalidLogoURL     = errors.New("logo url must be an absolute https URL")
+	ErrStatusPageTooLong  = errors.New("status page title must be at most 100 characters and description at most 1000")
+)
+
+var accentColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// GetStatusPage returns the settings of a resource's public status page
+func (s *ResourceServer) GetStatusPage(
+	ctx context.Context,
+	req *connect.Request[resourcev1.GetStatusPageRequest],
+) (*connect.Response[resourcev1.GetStatusPageResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetStatusPage, r.GetResourceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to get status page", "resourceId", r.GetResourceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	statusPage, err := s.getStatusPage(ctx, s.queries, r.GetResourceId())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&resourcev1.GetStatusPageResponse{
+		StatusPage: s.statusPageToProto(ctx, statusPage),
+	}), nil
+}
+
+// UpdateStatusPage turns a resource's public status page on or off and customizes it
+func (s *ResourceServer) UpdateStatusPage(
+	ctx context.Context,
+	req *connect.Request[resourcev1.UpdateStatusPageRequest],
+) (*connect.Response[resourcev1.UpdateStatusPageResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.UpdateStatusPage, r.GetResourceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to update status page", "resourceId", r.GetResourceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if len(r.GetTitle()) > 100 || len(r.GetDescription()) > 1000 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrStatusPageTooLong)
+	}
+	if color := r.GetAccentColor(); color != "" && !accentColorPattern.MatchString(color) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidAccentColor)
+	}
+	if logo := r.GetLogoUrl(); logo != "" {
+		u, err := url.Parse(logo)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidLogoURL)
+		}
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := apiDb.QueriesFor(tx)
+
+	current, err := s.getStatusPage(ctx, qtx, r.GetResourceId())
+	if err != nil {
+		return nil, err
+	}
+
+	params := genDb.UpsertStatusPageParams{
+		ResourceID:  r.GetResourceId(),
+		Enabled:     current.Enabled,
+		Title:       current.Title,
+		Description: current.Description,
+		LogoUrl:     current.LogoUrl,
+		AccentColor: current.AccentColor,
+	}
+	if r.Enabled != nil {
+		params.Enabled = r.GetEnabled()
+	}
+	if r.Title != nil {
+		params.Title = r.GetTitle()
+	}
+	if r.Description != nil {
+		params.Description = r.GetDescription()
+	}
+	if r.LogoUrl != nil {
+		params.LogoUrl = r.GetLogoUrl()
+	}
+	if r.AccentColor != nil {
+		params.AccentColor = r.GetAccentColor()
+	}
+
+	statusPage, err := qtx.UpsertStatusPage(ctx, params)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save status page", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit status page", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "updated status page", "resourceId", statusPage.ResourceID, "enabled", statusPage.Enabled)
+	return connect.NewResponse(&resourcev1.UpdateStatusPageResponse{
+		StatusPage: s.statusPageToProto(ctx, statusPage),
+	}), nil
+}
+
+// getStatusPage returns a resource's status page, or the defaults when it has never been set up
+func (s *ResourceServer) getStatusPage(ctx context.Context, queries genDb.Querier, resourceID int64) (genDb.StatusPage, error) {
+	statusPage, err := queries.GetStatusPage(ctx, resourceID)
+	if err == nil {
+		return statusPage, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		slog.ErrorContext(ctx, "failed to get status page", "error", err)
+		return genDb.StatusPage{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if _, err := queries.GetResourceByID(ctx, resourceID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return genDb.StatusPage{}, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to get resource", "error", err)
+		return genDb.StatusPage{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return genDb.StatusPage{ResourceID: resourceID}, nil
+}
+
+func (s *ResourceServer) statusPageToProto(ctx context.Context, statusPage genDb.StatusPage) *resourcev1.StatusPage {
+	result := &resourcev1.StatusPage{
+		ResourceId:  statusPage.ResourceID,
+		Enabled:     statusPage.Enabled,
+		Title:       statusPage.Title,
+		Description: statusPage.Description,
+		LogoUrl:     statusPage.LogoUrl,
+		AccentColor: statusPage.AccentColor,
+	}
+
+	domain, err := s.queries.GetPrimaryDomainForResource(ctx, statusPage.ResourceID)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			slog.WarnContext(ctx, "failed to get primary domain", "resourceId", statusPage.ResourceID, "error", err)
+		}
+		return result
+	}
+	result.Url = "https://" + statuspage.HostPrefix + domain
+	return result
+}
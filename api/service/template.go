@@ -0,0 +1,397 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	domainv1 "github.com/team-loco/loco/shared/proto/domain/v1"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+	templatev1 "github.com/team-loco/loco/shared/proto/template/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrTemplateNotFound     = errors.New("template not found")
+	ErrTemplateParameter    = errors.New("invalid template parameter")
+	ErrTemplateInstantiated = errors.New("template could not be fully instantiated")
+)
+
+// generatedParameterBytes is the entropy of generated secrets; hex encoded they are twice as long
+const generatedParameterBytes = 16
+
+// templateCatalog holds the curated templates, in the order they are listed.
+// every link must refer to a resource declared earlier in the same template.
+var templateCatalog = []*templatev1.Template{
+	{
+		Id:          "postgres-api",
+		Name:        "Postgres + API starter",
+		Description: "An API service connected to its own Postgres database through DATABASE_URL. The database keeps no data across restarts, so use it for prototypes.",
+		Parameters: []*templatev1.TemplateParameter{
+			{Name: "app_name", Description: "Name of the API; the database is named <app_name>-db", Required: true},
+			{Name: "api_image", Description: "Container image of the API, listening on port 8080", Required: true},
+			{Name: "db_name", Description: "Name of the database to create", DefaultValue: "app"},
+			{Name: "db_password", Description: "Password of the postgres user", Generated: true},
+		},
+		Resources: []*templatev1.TemplateResource{
+			{
+				Key:       "db",
+				Name:      "${app_name}-db",
+				Subdomain: "${app_name}-db",
+				Spec:      templateServiceSpec(5432, nil),
+				Deployment: templateDeploymentSpec("postgres:16-alpine", 5432, "250m", "512Mi", 1, 1, map[string]string{
+					"POSTGRES_DB":       "${db_name}",
+					"POSTGRES_PASSWORD": "${db_password}",
+				}),
+			},
+			{
+				Key:       "api",
+				Name:      "${app_name}",
+				Subdomain: "${app_name}",
+				Spec:      templateServiceSpec(8080, nil),
+				Deployment: templateDeploymentSpec("${api_image}", 8080, "250m", "256Mi", 1, 3, map[string]string{
+					"DATABASE_URL": "postgres://postgres:${db_password}@${db.host}:${db.port}/${db_name}?sslmode=disable",
+				}),
+				Links: []string{"db"},
+			},
+		},
+	},
+	{
+		Id:          "static-site",
+		Name:        "Static site",
+		Description: "A static site served by nginx. Build your image FROM nginx:alpine and copy your files to /usr/share/nginx/html.",
+		Parameters: []*templatev1.TemplateParameter{
+			{Name: "app_name", Description: "Name of the site", Required: true},
+			{Name: "image", Description: "Container image serving the site on port 80", DefaultValue: "nginx:alpine"},
+		},
+		Resources: []*templatev1.TemplateResource{
+			{
+				Key:       "site",
+				Name:      "${app_name}",
+				Subdomain: "${app_name}",
+				Spec: templateServiceSpec(80, &deploymentv1.HealthCheckConfig{
+					Path:                "/",
+					InitialDelaySeconds: 5,
+					IntervalSeconds:     10,
+					TimeoutSeconds:      5,
+					FailureThreshold:    3,
+				}),
+				Deployment: templateDeploymentSpec("${image}", 80, "100m", "128Mi", 1, 2, nil),
+			},
+		},
+	},
+	{
+		Id:          "worker",
+		Name:        "Background worker",
+		Description: "A single-replica background worker. Add scale triggers after deploying to scale it on queue depth.",
+		Parameters: []*templatev1.TemplateParameter{
+			{Name: "app_name", Description: "Name of the worker", Required: true},
+			{Name: "image", Description: "Container image of the worker", Required: true},
+		},
+		Resources: []*templatev1.TemplateResource{
+			{
+				Key:        "worker",
+				Name:       "${app_name}",
+				Subdomain:  "${app_name}",
+				Spec:       templateServiceSpec(8080, nil),
+				Deployment: templateDeploymentSpec("${image}", 8080, "250m", "256Mi", 1, 1, nil),
+			},
+		},
+	},
+}
+
+func templateServiceSpec(port int32, healthCheck *deploymentv1.HealthCheckConfig) *resourcev1.ServiceSpec {
+	return &resourcev1.ServiceSpec{
+		Routing: &resourcev1.RoutingConfig{
+			Port:       port,
+			PathPrefix: "/",
+		},
+		Observability: &resourcev1.ObservabilityConfig{
+			Logging: &resourcev1.LoggingConfig{Enabled: true, RetentionPeriod: "7d"},
+			Metrics: &resourcev1.MetricsConfig{},
+			Tracing: &resourcev1.TracingConfig{},
+		},
+		HealthCheck: healthCheck,
+	}
+}
+
+func templateDeploymentSpec(image string, port int32, cpu, memory string, minReplicas, maxReplicas int32, env map[string]string) *deploymentv1.ServiceDeploymentSpec {
+	return &deploymentv1.ServiceDeploymentSpec{
+		Build:       &deploymentv1.BuildSource{Type: "image", Image: image},
+		Port:        port,
+		Cpu:         &cpu,
+		Memory:      &memory,
+		MinReplicas: &minReplicas,
+		MaxReplicas: &maxReplicas,
+		Env:         env,
+	}
+}
+
+func findTemplate(id string) *templatev1.Template {
+	for _, t := range templateCatalog {
+		if t.GetId() == id {
+			return t
+		}
+	}
+	return nil
+}
+
+// TemplateServer implements the TemplateService. Instantiating a template goes through
+// the resource and deployment servers so the caller's permissions are checked the same way.
+type TemplateServer struct {
+	db          apiDb.Beginner
+	queries     genDb.Querier
+	machine     *tvm.VendingMachine
+	resources   *ResourceServer
+	deployments *DeploymentServer
+}
+
+func NewTemplateServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine, resources *ResourceServer, deployments *DeploymentServer) *TemplateServer {
+	return &TemplateServer{
+		db:          db,
+		queries:     queries,
+		machine:     machine,
+		resources:   resources,
+		deployments: deployments,
+	}
+}
+
+// ListTemplates lists the template catalog
+func (s *TemplateServer) ListTemplates(
+	ctx context.Context,
+	req *connect.Request[templatev1.ListTemplatesRequest],
+) (*connect.Response[templatev1.ListTemplatesResponse], error) {
+	return connect.NewResponse(&templatev1.ListTemplatesResponse{Templates: templateCatalog}), nil
+}
+
+// GetTemplate retrieves a template by ID
+func (s *TemplateServer) GetTemplate(
+	ctx context.Context,
+	req *connect.Request[templatev1.GetTemplateRequest],
+) (*connect.Response[templatev1.GetTemplateResponse], error) {
+	template := findTemplate(req.Msg.GetTemplateId())
+	if template == nil {
+		return nil, connect.NewError(connect.CodeNotFound, ErrTemplateNotFound)
+	}
+	return connect.NewResponse(&templatev1.GetTemplateResponse{Template: template}), nil
+}
+
+// InstantiateTemplate creates, links and deploys every resource of a template in a workspace.
+// If a step fails, the resources created so far are deleted again on a best-effort basis.
+func (s *TemplateServer) InstantiateTemplate(
+	ctx context.Context,
+	req *connect.Request[templatev1.InstantiateTemplateRequest],
+) (*connect.Response[templatev1.InstantiateTemplateResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.InstantiateTemplate, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to instantiate template", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	template := findTemplate(r.GetTemplateId())
+	if template == nil {
+		return nil, connect.NewError(connect.CodeNotFound, ErrTemplateNotFound)
+	}
+
+	if r.GetRegion() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("region is required"))
+	}
+
+	platformDomain, err := s.queries.GetPlatformDomain(ctx, r.GetPlatformDomainId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get platform domain", "error", err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("platform domain not found"))
+	}
+
+	values, err := resolveTemplateParameters(template, r.GetParameters())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var created []*templatev1.InstantiatedResource
+	for _, tr := range template.GetResources() {
+		instantiated, err := s.instantiateTemplateResource(ctx, r, tr, values, platformDomain.Domain)
+		if instantiated != nil {
+			created = append(created, instantiated)
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to instantiate template resource", "template", template.GetId(), "key", tr.GetKey(), "error", err)
+			s.rollbackTemplate(ctx, created)
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				return nil, connect.NewError(connectErr.Code(), fmt.Errorf("%w: %s: %s", ErrTemplateInstantiated, tr.GetKey(), connectErr.Message()))
+			}
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s: %w", ErrTemplateInstantiated, tr.GetKey(), err))
+		}
+	}
+
+	slog.InfoContext(ctx, "instantiated template", "template", template.GetId(), "workspaceId", r.GetWorkspaceId(), "resources", len(created))
+
+	return connect.NewResponse(&templatev1.InstantiateTemplateResponse{Resources: created}), nil
+}
+
+// instantiateTemplateResource creates and deploys one resource of a template, then records its
+// in-cluster address in values for the resources linking to it. It returns the resource once it
+// exists, even if deploying it failed, so it can be rolled back.
+func (s *TemplateServer) instantiateTemplateResource(
+	ctx context.Context,
+	r *templatev1.InstantiateTemplateRequest,
+	tr *templatev1.TemplateResource,
+	values map[string]string,
+	platformDomain string,
+) (*templatev1.InstantiatedResource, error) {
+	for _, link := range tr.GetLinks() {
+		if _, ok := values[link+".host"]; !ok {
+			return nil, fmt.Errorf("link to unknown resource %q", link)
+		}
+	}
+
+	name, err := expandTemplateValue(tr.GetName(), values)
+	if err != nil {
+		return nil, err
+	}
+	subdomain, err := expandTemplateValue(tr.GetSubdomain(), values)
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentSpec := proto.CloneOf(tr.GetDeployment())
+	deploymentSpec.Build.Image, err = expandTemplateValue(deploymentSpec.GetBuild().GetImage(), values)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range deploymentSpec.GetEnv() {
+		if deploymentSpec.Env[k], err = expandTemplateValue(v, values); err != nil {
+			return nil, err
+		}
+	}
+
+	serviceSpec := proto.CloneOf(tr.GetSpec())
+	serviceSpec.Regions = map[string]*resourcev1.RegionTarget{
+		r.GetRegion(): {
+			Enabled:     true,
+			Primary:     true,
+			Cpu:         deploymentSpec.GetCpu(),
+			Memory:      deploymentSpec.GetMemory(),
+			MinReplicas: deploymentSpec.GetMinReplicas(),
+			MaxReplicas: deploymentSpec.GetMaxReplicas(),
+		},
+	}
+
+	platformDomainID := r.GetPlatformDomainId()
+	createdResource, err := s.resources.CreateResource(ctx, connect.NewRequest(&resourcev1.CreateResourceRequest{
+		WorkspaceId: r.GetWorkspaceId(),
+		Name:        name,
+		Type:        resourcev1.ResourceType_RESOURCE_TYPE_SERVICE,
+		Domain: &domainv1.DomainInput{
+			DomainSource:     domainv1.DomainType_DOMAIN_TYPE_PLATFORM_PROVIDED,
+			Subdomain:        &subdomain,
+			PlatformDomainId: &platformDomainID,
+		},
+		Spec:          &resourcev1.ResourceSpec{Spec: &resourcev1.ResourceSpec_Service{Service: serviceSpec}},
+		EnvironmentId: r.EnvironmentId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	resourceID := createdResource.Msg.GetResourceId()
+	instantiated := &templatev1.InstantiatedResource{
+		Key:        tr.GetKey(),
+		ResourceId: resourceID,
+		Name:       name,
+		Domain:     subdomain + "." + platformDomain,
+	}
+
+	deployment, err := s.deployments.CreateDeployment(ctx, connect.NewRequest(&deploymentv1.CreateDeploymentRequest{
+		ResourceId: resourceID,
+		Region:     r.GetRegion(),
+		Spec:       &deploymentv1.DeploymentSpec{Spec: &deploymentv1.DeploymentSpec_Service{Service: deploymentSpec}},
+	}))
+	if err != nil {
+		return instantiated, err
+	}
+	instantiated.DeploymentId = deployment.Msg.GetDeploymentId()
+
+	// the controller names the service after the resource, in the resource's own namespace
+	values[tr.GetKey()+".host"] = fmt.Sprintf("resource-%d.%s.svc.cluster.local", resourceID, computeNamespace(r.GetWorkspaceId(), resourceID))
+	values[tr.GetKey()+".port"] = strconv.Itoa(int(deploymentSpec.GetPort()))
+
+	return instantiated, nil
+}
+
+// rollbackTemplate deletes resources created by a failed instantiation, newest first
+func (s *TemplateServer) rollbackTemplate(ctx context.Context, created []*templatev1.InstantiatedResource) {
+	for i := len(created) - 1; i >= 0; i-- {
+		_, err := s.resources.DeleteResource(ctx, connect.NewRequest(&resourcev1.DeleteResourceRequest{
+			ResourceId: created[i].GetResourceId(),
+		}))
+		if err != nil {
+			slog.WarnContext(ctx, "failed to roll back template resource", "resourceId", created[i].GetResourceId(), "error", err)
+		}
+	}
+}
+
+// resolveTemplateParameters merges the given parameters with the template's defaults and
+// generates the secrets that were left out
+func resolveTemplateParameters(template *templatev1.Template, given map[string]string) (map[string]string, error) {
+	values := make(map[string]string, len(template.GetParameters()))
+	for _, p := range template.GetParameters() {
+		value, ok := given[p.GetName()]
+		switch {
+		case ok && value != "":
+		case p.GetGenerated():
+			generated, err := generateSecureRandomString(generatedParameterBytes)
+			if err != nil {
+				return nil, err
+			}
+			value = generated
+		case p.GetRequired():
+			return nil, fmt.Errorf("%w: %s is required", ErrTemplateParameter, p.GetName())
+		default:
+			value = p.GetDefaultValue()
+		}
+		values[p.GetName()] = value
+	}
+
+	for name := range given {
+		if _, ok := values[name]; !ok {
+			return nil, fmt.Errorf("%w: unknown parameter %s", ErrTemplateParameter, name)
+		}
+	}
+
+	return values, nil
+}
+
+// expandTemplateValue replaces ${name} references with parameter values or linked resource addresses
+func expandTemplateValue(s string, values map[string]string) (string, error) {
+	var missing string
+	expanded := os.Expand(s, func(name string) string {
+		value, ok := values[name]
+		if !ok && missing == "" {
+			missing = name
+		}
+		return value
+	})
+	if missing != "" {
+		return "", fmt.Errorf("%w: unknown reference ${%s}", ErrTemplateParameter, missing)
+	}
+	return expanded, nil
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm/actions"
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// timeline entry kinds as ListResourceTimeline returns them; changes use their resource_activity_kind
+const (
+	timelineKindDeployment = "deployment"
+	timelineKindIncident   = "incident"
+)
+
+var timelineKinds = map[resourcev1.TimelineEntryKind]string{
+	resourcev1.TimelineEntryKind_TIMELINE_ENTRY_KIND_DEPLOYMENT:     timelineKindDeployment,
+	resourcev1.TimelineEntryKind_TIMELINE_ENTRY_KIND_SCALED:         string(genDb.ResourceActivityKindScaled),
+	resourcev1.TimelineEntryKind_TIMELINE_ENTRY_KIND_ENV_CHANGED:    string(genDb.ResourceActivityKindEnvChanged),
+	resourcev1.TimelineEntryKind_TIMELINE_ENTRY_KIND_DOMAIN_CHANGED: string(genDb.ResourceActivityKindDomainChanged),
+	resourcev1.TimelineEntryKind_TIMELINE_ENTRY_KIND_INCIDENT:       timelineKindIncident,
+}
+
+// recordResourceActivity adds a change to the resource's timeline, made by the user behind ctx if
+// any. The change itself has already happened, so failing to record it is only logged.
+func recordResourceActivity(ctx context.Context, queries genDb.Querier, resourceID int64, kind genDb.ResourceActivityKind, summary string) {
+	var actorID pgtype.Int8
+	if entity, ok := contextkeys.Entity(ctx); ok && entity.Type == genDb.EntityTypeUser {
+		actorID = pgtype.Int8{Int64: entity.ID, Valid: true}
+	}
+	if err := queries.CreateResourceActivity(ctx, genDb.CreateResourceActivityParams{
+		ResourceID: resourceID,
+		Kind:       kind,
+		Summary:    summary,
+		ActorID:    actorID,
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to record resource activity", "resourceId", resourceID, "kind", kind, "error", err)
+	}
+}
+
+// describeScale summarizes the changes a scale request makes to spec and replicas
+func describeScale(spec *deploymentv1.ServiceDeploymentSpec, replicas int32, req *resourcev1.ScaleResourceRequest) string {
+	var changes []string
+	if req.Replicas != nil && req.GetReplicas() != replicas {
+		changes = append(changes, fmt.Sprintf("replicas %d -> %d", replicas, req.GetReplicas()))
+	}
+	if req.Cpu != nil && req.GetCpu() != spec.GetCpu() {
+		changes = append(changes, fmt.Sprintf("cpu %s -> %s", orDash(spec.GetCpu()), req.GetCpu()))
+	}
+	if req.Memory != nil && req.GetMemory() != spec.GetMemory() {
+		changes = append(changes, fmt.Sprintf("memory %s -> %s", orDash(spec.GetMemory()), req.GetMemory()))
+	}
+	return "Scaled " + strings.Join(changes, ", ")
+}
+
+// describeEnvChange summarizes which variables changed between two envs, naming only keys since
+// values may be secrets
+func describeEnvChange(before, after map[string]string) string {
+	var added, changed, removed []string
+	for _, key := range slices.Sorted(maps.Keys(after)) {
+		old, ok := before[key]
+		switch {
+		case !ok:
+			added = append(added, key)
+		case old != after[key]:
+			changed = append(changed, key)
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(before)) {
+		if _, ok := after[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	var parts []string
+	if len(added) > 0 {
+		parts = append(parts, "added "+strings.Join(added, ", "))
+	}
+	if len(changed) > 0 {
+		parts = append(parts, "changed "+strings.Join(changed, ", "))
+	}
+	if len(removed) > 0 {
+		parts = append(parts, "removed "+strings.Join(removed, ", "))
+	}
+	if len(parts) == 0 {
+		return "Environment redeployed without changes"
+	}
+	return "Environment " + strings.Join(parts, "; ")
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// GetResourceTimeline lists a resource's deployments, scaling, env and domain changes, and
+// incidents, newest first.
+func (s *ResourceServer) GetResourceTimeline(
+	ctx context.Context,
+	req *connect.Request[resourcev1.GetResourceTimelineRequest],
+) (*connect.Response[resourcev1.GetResourceTimelineResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetResource, r.GetResourceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to get resource timeline", "resourceId", r.GetResourceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if _, err := s.queries.GetResourceByID(ctx, r.GetResourceId()); err != nil {
+		slog.WarnContext(ctx, "resource not found", "resourceId", r.GetResourceId())
+		return nil, connect.NewError(connect.CodeNotFound, ErrResourceNotFound)
+	}
+
+	params := genDb.ListResourceTimelineParams{
+		ResourceID: r.GetResourceId(),
+		Kinds:      []string{},
+		PageSize:   normalizePageSize(r.GetPageSize()),
+	}
+	for _, kind := range r.GetKinds() {
+		dbKind, ok := timelineKinds[kind]
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown timeline entry kind %s", kind))
+		}
+		params.Kinds = append(params.Kinds, dbKind)
+	}
+	if r.GetPageToken() != "" {
+		at, kind, id, err := decodeTimelineCursor(r.GetPageToken())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token: %w", err))
+		}
+		params.AfterOccurredAt = pgtype.Timestamptz{Time: at, Valid: true}
+		params.AfterKind = pgtype.Text{String: kind, Valid: true}
+		params.AfterID = pgtype.Int8{Int64: id, Valid: true}
+	}
+
+	rows, err := s.queries.ListResourceTimeline(ctx, params)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list resource timeline", "resourceId", r.GetResourceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	entries := make([]*resourcev1.TimelineEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, timelineEntryToProto(row))
+	}
+
+	var nextPageToken string
+	if len(rows) == int(params.PageSize) {
+		last := rows[len(rows)-1]
+		nextPageToken = encodeTimelineCursor(last.OccurredAt.Time, last.Kind, last.ID)
+	}
+
+	return connect.NewResponse(&resourcev1.GetResourceTimelineResponse{
+		Entries:       entries,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func timelineEntryToProto(row genDb.ListResourceTimelineRow) *resourcev1.TimelineEntry {
+	entry := &resourcev1.TimelineEntry{
+		OccurredAt: timestamppb.New(row.OccurredAt.Time),
+		Summary:    row.Summary,
+	}
+	if row.ActorID.Valid {
+		entry.ActorId = &row.ActorID.Int64
+	}
+
+	switch row.Kind {
+	case timelineKindDeployment:
+		entry.Kind = resourcev1.TimelineEntryKind_TIMELINE_ENTRY_KIND_DEPLOYMENT
+		entry.DeploymentId = &row.ID
+		entry.DeploymentPhase = deploymentStatusToProto(genDb.DeploymentStatus(row.Status))
+		summary := fmt.Sprintf("Deployment %d %s", row.ID, row.Status)
+		if row.Region != "" {
+			summary += " in " + row.Region
+		}
+		// the summary column holds the deployment's annotation
+		if row.Summary != "" {
+			summary += ": " + row.Summary
+		}
+		entry.Summary = summary
+	case timelineKindIncident:
+		entry.Kind = resourcev1.TimelineEntryKind_TIMELINE_ENTRY_KIND_INCIDENT
+		entry.IncidentStatus = resourceStatusToProto(genDb.ResourceStatus(row.Status))
+		entry.Summary = "Resource " + row.Status
+		if row.EndedAt.Valid {
+			entry.EndedAt = timestamppb.New(row.EndedAt.Time)
+			entry.Summary += " for " + row.EndedAt.Time.Sub(row.OccurredAt.Time).Round(time.Second).String()
+		}
+	default:
+		for kind, dbKind := range timelineKinds {
+			if dbKind == row.Kind {
+				entry.Kind = kind
+			}
+		}
+	}
+	return entry
+}
+
+// encodeTimelineCursor encodes the position of a timeline entry as a base64 cursor token
+func encodeTimelineCursor(at time.Time, kind string, id int64) string {
+	return base64.URLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%s:%d", at.UnixMicro(), kind, id)))
+}
+
+// decodeTimelineCursor decodes a cursor token from encodeTimelineCursor
+func decodeTimelineCursor(token string) (time.Time, string, int64, error) {
+	decoded, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, "", 0, fmt.Errorf("invalid cursor token: %w", err)
+	}
+	parts := strings.Split(string(decoded), ":")
+	if len(parts) != 3 {
+		return time.Time{}, "", 0, errors.New("invalid cursor value")
+	}
+	micros, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, "", 0, fmt.Errorf("invalid cursor value: %w", err)
+	}
+	id, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return time.Time{}, "", 0, fmt.Errorf("invalid cursor value: %w", err)
+	}
+	return time.UnixMicro(micros), parts[1], id, nil
+}
@@ -8,8 +8,8 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
 	genDb "github.com/team-loco/loco/api/gen/db"
 	"github.com/team-loco/loco/api/timeutil"
 	"github.com/team-loco/loco/api/tvm"
@@ -26,13 +26,13 @@ var (
 
 // TokenServer implements the TokenService gRPC server
 type TokenServer struct {
-	db      *pgxpool.Pool
+	db      apiDb.Beginner
 	queries genDb.Querier
 	tvm     *tvm.VendingMachine
 }
 
 // NewTokenServer creates a new TokenServer instance
-func NewTokenServer(db *pgxpool.Pool, queries genDb.Querier, tvm *tvm.VendingMachine) *TokenServer {
+func NewTokenServer(db apiDb.Beginner, queries genDb.Querier, tvm *tvm.VendingMachine) *TokenServer {
 	return &TokenServer{db: db, queries: queries, tvm: tvm}
 }
 
@@ -63,13 +63,13 @@ func (s *TokenServer) CreateToken(
 		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidScopes)
 	}
 
-	entity, ok := ctx.Value(contextkeys.EntityKey).(genDb.Entity)
+	entity, ok := contextkeys.Entity(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrTokenUnauthorized)
 	}
 
-	entityScopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	entityScopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrTokenUnauthorized)
@@ -99,7 +99,14 @@ func (s *TokenServer) CreateToken(
 	}
 
 	duration := time.Duration(r.GetExpiresInSec()) * time.Second
-	token, err := s.tvm.Issue(ctx, r.GetName(), entity.ID, targetEntity, dbScopes, duration)
+	var token string
+	var err error
+	if targetEntity.Type == genDb.EntityTypeServiceAccount {
+		// service accounts hold their own scopes, so the token is checked against those rather than the caller's
+		token, err = s.tvm.IssueForServiceAccount(ctx, r.GetName(), targetEntity.ID, dbScopes, duration)
+	} else {
+		token, err = s.tvm.Issue(ctx, r.GetName(), entity.ID, targetEntity, dbScopes, duration)
+	}
 	if err != nil {
 		if errors.Is(err, tvm.ErrInsufficentPermissions) {
 			slog.WarnContext(ctx, "user lacks permissions for requested scopes", "user_id", entity.ID)
@@ -141,7 +148,7 @@ func (s *TokenServer) ListTokens(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("entity_type is required"))
 	}
 
-	entityScopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	entityScopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrTokenUnauthorized)
@@ -194,7 +201,7 @@ func (s *TokenServer) GetToken(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("entity_type is required"))
 	}
 
-	entityScopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	entityScopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrTokenUnauthorized)
@@ -246,13 +253,13 @@ func (s *TokenServer) RevokeToken(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("entity_type is required"))
 	}
 
-	entity, ok := ctx.Value(contextkeys.EntityKey).(genDb.Entity)
+	entity, ok := contextkeys.Entity(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrTokenUnauthorized)
 	}
 
-	entityScopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	entityScopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrTokenUnauthorized)
@@ -276,11 +283,7 @@ func (s *TokenServer) RevokeToken(
 		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("insufficient permissions to revoke token"))
 	}
 
-	err := s.queries.DeleteTokenByNameAndEntity(ctx, genDb.DeleteTokenByNameAndEntityParams{
-		Name:       r.GetName(),
-		EntityType: targetEntity.Type,
-		EntityID:   targetEntity.ID,
-	})
+	err := s.tvm.RevokeByName(ctx, r.GetName(), targetEntity)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to delete token", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to revoke token: %w", err))
@@ -291,6 +294,83 @@ func (s *TokenServer) RevokeToken(
 	return connect.NewResponse(&tokenv1.RevokeTokenResponse{}), nil
 }
 
+// MintToken mints a child of the calling token, restricted to a subset of its scopes and a shorter lifetime
+func (s *TokenServer) MintToken(
+	ctx context.Context,
+	req *connect.Request[tokenv1.MintTokenRequest],
+) (*connect.Response[tokenv1.MintTokenResponse], error) {
+	r := req.Msg
+
+	if r.GetName() == "" {
+		slog.ErrorContext(ctx, "invalid request: name is required")
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+
+	if r.GetExpiresInSec() <= 0 {
+		slog.ErrorContext(ctx, "invalid token duration", "expires_in_sec", r.GetExpiresInSec())
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidTokenDuration)
+	}
+
+	if len(r.GetScopes()) == 0 {
+		slog.ErrorContext(ctx, "invalid request: at least one scope is required")
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidScopes)
+	}
+
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrTokenUnauthorized)
+	}
+
+	parentToken, ok := contextkeys.Token(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "token not found in context")
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrTokenUnauthorized)
+	}
+
+	dbScopes := make([]genDb.EntityScope, len(r.GetScopes()))
+	for i, scope := range r.GetScopes() {
+		dbScopes[i] = genDb.EntityScope{
+			EntityType: protoEntityTypeToDb(scope.GetEntityType()),
+			EntityID:   scope.GetEntityId(),
+			Scope:      protoScopeToDb(scope.GetScope()),
+		}
+	}
+
+	duration := time.Duration(r.GetExpiresInSec()) * time.Second
+	token, err := s.tvm.Mint(ctx, r.GetName(), parentToken, dbScopes, duration)
+	if err != nil {
+		switch {
+		case errors.Is(err, tvm.ErrInsufficentPermissions):
+			slog.WarnContext(ctx, "token lacks permissions for requested scopes", "entityType", entity.Type, "entityId", entity.ID)
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		case errors.Is(err, tvm.ErrDurationExceedsMaxAllowed), errors.Is(err, tvm.ErrDurationExceedsParent):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, tvm.ErrTokenNotFound), errors.Is(err, tvm.ErrTokenExpired):
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		slog.ErrorContext(ctx, "failed to mint token", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to mint token: %w", err))
+	}
+
+	tokenData, err := s.queries.GetTokenByName(ctx, genDb.GetTokenByNameParams{
+		Name:       r.GetName(),
+		EntityType: entity.Type,
+		EntityID:   entity.ID,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch minted token metadata", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch token metadata: %w", err))
+	}
+
+	slog.InfoContext(ctx, "minted token", "name", r.GetName(), "entityType", entity.Type, "entityId", entity.ID)
+
+	return connect.NewResponse(&tokenv1.MintTokenResponse{
+		Token:         token,
+		TokenMetadata: dbTokenGetRowToProto(tokenData),
+	}), nil
+}
+
 // Helper functions
 
 func dbTokenListRowToProto(token genDb.ListTokensForEntityRow) *tokenv1.Token {
@@ -332,6 +412,10 @@ func protoEntityTypeToDb(et tokenv1.EntityType) genDb.EntityType {
 		return genDb.EntityTypeResource
 	case tokenv1.EntityType_ENTITY_TYPE_USER:
 		return genDb.EntityTypeUser
+	case tokenv1.EntityType_ENTITY_TYPE_ENVIRONMENT:
+		return genDb.EntityTypeEnvironment
+	case tokenv1.EntityType_ENTITY_TYPE_SERVICE_ACCOUNT:
+		return genDb.EntityTypeServiceAccount
 	default:
 		return genDb.EntityTypeUser // default fallback
 	}
@@ -349,6 +433,10 @@ func dbEntityTypeToProto(et genDb.EntityType) tokenv1.EntityType {
 		return tokenv1.EntityType_ENTITY_TYPE_RESOURCE
 	case genDb.EntityTypeUser:
 		return tokenv1.EntityType_ENTITY_TYPE_USER
+	case genDb.EntityTypeEnvironment:
+		return tokenv1.EntityType_ENTITY_TYPE_ENVIRONMENT
+	case genDb.EntityTypeServiceAccount:
+		return tokenv1.EntityType_ENTITY_TYPE_SERVICE_ACCOUNT
 	default:
 		return tokenv1.EntityType_ENTITY_TYPE_UNSPECIFIED
 	}
@@ -362,6 +450,14 @@ func protoScopeToDb(s tokenv1.Scope) genDb.Scope {
 		return genDb.ScopeWrite
 	case tokenv1.Scope_SCOPE_ADMIN:
 		return genDb.ScopeAdmin
+	case tokenv1.Scope_SCOPE_LOGS_READ:
+		return genDb.ScopeLogsRead
+	case tokenv1.Scope_SCOPE_ENV_WRITE:
+		return genDb.ScopeEnvWrite
+	case tokenv1.Scope_SCOPE_DEPLOY_CREATE:
+		return genDb.ScopeDeployCreate
+	case tokenv1.Scope_SCOPE_DOMAIN_MANAGE:
+		return genDb.ScopeDomainManage
 	default:
 		return genDb.ScopeRead // default fallback
 	}
@@ -375,6 +471,14 @@ func dbScopeToProto(s genDb.Scope) tokenv1.Scope {
 		return tokenv1.Scope_SCOPE_WRITE
 	case genDb.ScopeAdmin:
 		return tokenv1.Scope_SCOPE_ADMIN
+	case genDb.ScopeLogsRead:
+		return tokenv1.Scope_SCOPE_LOGS_READ
+	case genDb.ScopeEnvWrite:
+		return tokenv1.Scope_SCOPE_ENV_WRITE
+	case genDb.ScopeDeployCreate:
+		return tokenv1.Scope_SCOPE_DEPLOY_CREATE
+	case genDb.ScopeDomainManage:
+		return tokenv1.Scope_SCOPE_DOMAIN_MANAGE
 	default:
 		return tokenv1.Scope_SCOPE_UNSPECIFIED
 	}
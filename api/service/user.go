@@ -8,8 +8,8 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
 	genDb "github.com/team-loco/loco/api/gen/db"
 	"github.com/team-loco/loco/api/timeutil"
 	"github.com/team-loco/loco/api/tvm"
@@ -29,13 +29,13 @@ var (
 
 // UserServer implements the UserService gRPC server
 type UserServer struct {
-	db      *pgxpool.Pool
+	db      apiDb.Beginner
 	queries genDb.Querier
 	tvm     *tvm.VendingMachine
 }
 
 // NewUserServer creates a new UserServer instance
-func NewUserServer(db *pgxpool.Pool, queries genDb.Querier, tvm *tvm.VendingMachine) *UserServer {
+func NewUserServer(db apiDb.Beginner, queries genDb.Querier, tvm *tvm.VendingMachine) *UserServer {
 	return &UserServer{db: db, queries: queries, tvm: tvm}
 }
 
@@ -137,7 +137,7 @@ func (s *UserServer) GetUser(
 		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidRequest)
 	}
 
-	entityScopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	entityScopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
@@ -161,13 +161,13 @@ func (s *UserServer) WhoAmI(
 	ctx context.Context,
 	req *connect.Request[userv1.WhoAmIRequest],
 ) (*connect.Response[userv1.WhoAmIResponse], error) {
-	entity, ok := ctx.Value(contextkeys.EntityKey).(genDb.Entity)
+	entity, ok := contextkeys.Entity(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
 	}
 
-	entityScopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	entityScopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
@@ -185,7 +185,11 @@ func (s *UserServer) WhoAmI(
 	}
 	slog.InfoContext(ctx, "returning user")
 
-	return connect.NewResponse(&userv1.WhoAmIResponse{User: user}), nil
+	resp := &userv1.WhoAmIResponse{User: user}
+	if impersonator, ok := contextkeys.Impersonator(ctx); ok {
+		resp.ImpersonatorId = &impersonator
+	}
+	return connect.NewResponse(resp), nil
 }
 
 // UpdateUser updates user information
@@ -195,13 +199,13 @@ func (s *UserServer) UpdateUser(
 ) (*connect.Response[userv1.UpdateUserResponse], error) {
 	r := req.Msg
 
-	entity, ok := ctx.Value(contextkeys.EntityKey).(genDb.Entity)
+	entity, ok := contextkeys.Entity(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
 	}
 
-	entityScopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	entityScopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
@@ -233,7 +237,7 @@ func (s *UserServer) ListUsers(
 ) (*connect.Response[userv1.ListUsersResponse], error) {
 	r := req.Msg
 
-	entityScopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	entityScopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
@@ -290,7 +294,7 @@ func (s *UserServer) DeleteUser(
 ) (*connect.Response[userv1.DeleteUserResponse], error) {
 	r := req.Msg
 
-	entityScopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	entityScopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
@@ -338,20 +342,23 @@ func (s *UserServer) DeleteUser(
 	return connect.NewResponse(&userv1.DeleteUserResponse{}), nil
 }
 
-// Logout logs out the user by clearing the session cookie
+// Logout logs out the user by clearing the session cookies and ending the session of their token
 func (s *UserServer) Logout(
 	ctx context.Context,
 	req *connect.Request[userv1.LogoutRequest],
 ) (*connect.Response[userv1.LogoutResponse], error) {
 	res := connect.NewResponse(&userv1.LogoutResponse{})
 	res.Header().Set("Set-Cookie", "loco_token=; Path=/; Max-Age=0; HttpOnly; SameSite=Lax")
+	setRefreshCookie(res.Header(), "", 0)
 
-	token, ok := ctx.Value(contextkeys.TokenKey).(string)
+	token, ok := contextkeys.Token(ctx)
 	if !ok {
 		slog.WarnContext(ctx, "token not found in context")
 		return res, nil
 	}
-	s.tvm.Revoke(ctx, token)
+	if err := s.tvm.EndSession(ctx, token); err != nil {
+		slog.ErrorContext(ctx, "failed to end session", "error", err)
+	}
 
 	slog.InfoContext(ctx, "user logged out")
 	return res, nil
@@ -2,20 +2,34 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"regexp"
+	"slices"
+	"strconv"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/team-loco/loco/api/contextkeys"
+	apiDb "github.com/team-loco/loco/api/db"
 	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/bundle"
+	"github.com/team-loco/loco/api/pkg/kube"
+	"github.com/team-loco/loco/api/pkg/notify"
 	"github.com/team-loco/loco/api/timeutil"
 	"github.com/team-loco/loco/api/tvm"
 	"github.com/team-loco/loco/api/tvm/actions"
+	locoControllerV1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
 	workspacev1 "github.com/team-loco/loco/shared/proto/workspace/v1"
+	"github.com/team-loco/loco/shared/version"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var (
@@ -26,20 +40,28 @@ var (
 	ErrNotWorkspaceAdmin      = errors.New("user is not an admin of this workspace")
 	ErrWorkspaceHasResources  = errors.New("workspace has resources - must confirm deletion")
 	ErrInvalidRole            = errors.New("invalid role - must be admin, deploy, or read")
+	ErrRoleAboveCaller        = errors.New("cannot grant a role above your own")
+	ErrInvalidHistoryLimit    = errors.New("deployment history limit must be between 0 and 1000")
 )
 
 var workspaceNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
 
+// maxDeploymentHistoryLimit bounds how many inactive deployments a workspace may retain per resource
+const maxDeploymentHistoryLimit = 1000
+
 // WorkspaceServer implements the WorkspaceService gRPC server
 type WorkspaceServer struct {
-	db      *pgxpool.Pool
-	queries genDb.Querier
-	machine *tvm.VendingMachine
+	db            apiDb.Beginner
+	queries       genDb.Querier
+	machine       *tvm.VendingMachine
+	kubeClient    *kube.Client
+	locoNamespace string
+	notifier      *notify.Notifier
 }
 
 // NewWorkspaceServer creates a new WorkspaceServer instance
-func NewWorkspaceServer(db *pgxpool.Pool, queries genDb.Querier, machine *tvm.VendingMachine) *WorkspaceServer {
-	return &WorkspaceServer{db: db, queries: queries, machine: machine}
+func NewWorkspaceServer(db apiDb.Beginner, queries genDb.Querier, machine *tvm.VendingMachine, kubeClient *kube.Client, locoNamespace string, notifier *notify.Notifier) *WorkspaceServer {
+	return &WorkspaceServer{db: db, queries: queries, machine: machine, kubeClient: kubeClient, locoNamespace: locoNamespace, notifier: notifier}
 }
 
 // CreateWorkspace creates a new workspace
@@ -49,7 +71,7 @@ func (s *WorkspaceServer) CreateWorkspace(
 ) (*connect.Response[workspacev1.CreateWorkspaceResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -65,6 +87,35 @@ func (s *WorkspaceServer) CreateWorkspace(
 		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidWorkspaceName)
 	}
 
+	var externalID pgtype.Text
+	if r.ExternalId != nil {
+		if r.GetExternalId() == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrEmptyExternalID)
+		}
+		externalID = pgtype.Text{String: r.GetExternalId(), Valid: true}
+
+		// a retried create returns what the first attempt created
+		existing, err := s.queries.GetWorkspaceByExternalID(ctx, genDb.GetWorkspaceByExternalIDParams{
+			OrgID:      r.GetOrgId(),
+			ExternalID: externalID,
+		})
+		if err == nil {
+			return connect.NewResponse(&workspacev1.CreateWorkspaceResponse{
+				WorkspaceId: existing.ID,
+				Workspace:   dbWorkspaceToProto(existing),
+			}), nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			slog.ErrorContext(ctx, "failed to get workspace by external id", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	labels, err := marshalLabels(r.GetLabels())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	isUnique, err := s.queries.IsWorkspaceNameUniqueInOrg(ctx, genDb.IsWorkspaceNameUniqueInOrgParams{
 		OrgID: r.GetOrgId(),
 		Name:  r.GetName(),
@@ -81,7 +132,7 @@ func (s *WorkspaceServer) CreateWorkspace(
 
 	description := pgtype.Text{String: r.GetDescription(), Valid: r.GetDescription() != ""}
 
-	entity, ok := ctx.Value(contextkeys.EntityKey).(genDb.Entity)
+	entity, ok := contextkeys.Entity(ctx)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
 	}
@@ -95,6 +146,8 @@ func (s *WorkspaceServer) CreateWorkspace(
 		Name:        r.GetName(),
 		Description: description,
 		CreatedBy:   entity.ID,
+		ExternalID:  externalID,
+		Labels:      labels,
 	})
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to create workspace", "error", err)
@@ -111,8 +164,15 @@ func (s *WorkspaceServer) CreateWorkspace(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
+	ws, err := s.queries.GetWorkspaceByIDQuery(ctx, wsID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read back created workspace", "workspaceId", wsID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
 	return connect.NewResponse(&workspacev1.CreateWorkspaceResponse{
 		WorkspaceId: wsID,
+		Workspace:   dbWorkspaceToProto(ws),
 	}), nil
 }
 
@@ -123,7 +183,7 @@ func (s *WorkspaceServer) GetWorkspace(
 ) (*connect.Response[workspacev1.GetWorkspaceResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -141,15 +201,7 @@ func (s *WorkspaceServer) GetWorkspace(
 	}
 
 	return connect.NewResponse(&workspacev1.GetWorkspaceResponse{
-		Workspace: &workspacev1.Workspace{
-			Id:          ws.ID,
-			OrgId:       ws.OrgID,
-			Name:        ws.Name,
-			Description: ws.Description.String,
-			CreatedBy:   ws.CreatedBy,
-			CreatedAt:   timeutil.ParsePostgresTimestamp(ws.CreatedAt.Time),
-			UpdatedAt:   timeutil.ParsePostgresTimestamp(ws.UpdatedAt.Time),
-		},
+		Workspace: dbWorkspaceToProto(ws),
 	}), nil
 }
 
@@ -159,7 +211,7 @@ func (s *WorkspaceServer) ListUserWorkspaces(
 	req *connect.Request[workspacev1.ListUserWorkspacesRequest],
 ) (*connect.Response[workspacev1.ListUserWorkspacesResponse], error) {
 	r := req.Msg
-	entity, ok := ctx.Value(contextkeys.EntityKey).(genDb.Entity)
+	entity, ok := contextkeys.Entity(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity not found in context")
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
@@ -169,7 +221,7 @@ func (s *WorkspaceServer) ListUserWorkspaces(
 		return nil, connect.NewError(connect.CodeUnauthenticated, ErrImproperUsage)
 	}
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -195,9 +247,10 @@ func (s *WorkspaceServer) ListUserWorkspaces(
 	}
 
 	workspaceList, err := s.queries.ListWorkspacesForUser(ctx, genDb.ListWorkspacesForUserParams{
-		UserID:    entity.ID,
-		Limit:     pageSize,
-		PageToken: pageToken,
+		UserID:          entity.ID,
+		Limit:           pageSize,
+		PageToken:       pageToken,
+		IncludeArchived: r.GetIncludeArchived(),
 	})
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to list workspaces for user", "error", err)
@@ -206,15 +259,7 @@ func (s *WorkspaceServer) ListUserWorkspaces(
 
 	var workspaces []*workspacev1.Workspace
 	for _, ws := range workspaceList {
-		workspaces = append(workspaces, &workspacev1.Workspace{
-			Id:          ws.ID,
-			OrgId:       ws.OrgID,
-			Name:        ws.Name,
-			Description: ws.Description.String,
-			CreatedBy:   ws.CreatedBy,
-			CreatedAt:   timeutil.ParsePostgresTimestamp(ws.CreatedAt.Time),
-			UpdatedAt:   timeutil.ParsePostgresTimestamp(ws.UpdatedAt.Time),
-		})
+		workspaces = append(workspaces, dbWorkspaceToProto(ws))
 	}
 
 	var nextPageToken string
@@ -236,7 +281,7 @@ func (s *WorkspaceServer) ListOrgWorkspaces(
 	r := req.Msg
 	slog.InfoContext(ctx, "list workspaces req for org", "orgId", r.GetOrgId())
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -268,9 +313,10 @@ func (s *WorkspaceServer) ListOrgWorkspaces(
 	}
 
 	workspaceList, err := s.queries.ListWorkspacesInOrg(ctx, genDb.ListWorkspacesInOrgParams{
-		OrgID:     r.GetOrgId(),
-		Limit:     pageSize,
-		PageToken: pageToken,
+		OrgID:           r.GetOrgId(),
+		Limit:           pageSize,
+		PageToken:       pageToken,
+		IncludeArchived: r.GetIncludeArchived(),
 	})
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to list workspaces", "error", err)
@@ -279,15 +325,7 @@ func (s *WorkspaceServer) ListOrgWorkspaces(
 
 	var workspaces []*workspacev1.Workspace
 	for _, ws := range workspaceList {
-		workspaces = append(workspaces, &workspacev1.Workspace{
-			Id:          ws.ID,
-			OrgId:       ws.OrgID,
-			Name:        ws.Name,
-			Description: ws.Description.String,
-			CreatedBy:   ws.CreatedBy,
-			CreatedAt:   timeutil.ParsePostgresTimestamp(ws.CreatedAt.Time),
-			UpdatedAt:   timeutil.ParsePostgresTimestamp(ws.UpdatedAt.Time),
-		})
+		workspaces = append(workspaces, dbWorkspaceToProto(ws))
 	}
 
 	var nextPageToken string
@@ -308,7 +346,7 @@ func (s *WorkspaceServer) UpdateWorkspace(
 ) (*connect.Response[workspacev1.UpdateWorkspaceResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -319,7 +357,13 @@ func (s *WorkspaceServer) UpdateWorkspace(
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
-	if r.GetName() != "" {
+	fields, err := workspaceUpdateMask.parse(r.GetUpdateMask())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	updateName := fields.has("name", r.GetName() != "")
+
+	if updateName {
 		if !workspaceNamePattern.MatchString(r.GetName()) {
 			slog.WarnContext(ctx, "invalid workspace name", "name", r.GetName())
 			return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidWorkspaceName)
@@ -346,24 +390,72 @@ func (s *WorkspaceServer) UpdateWorkspace(
 		}
 	}
 
-	name := pgtype.Text{String: r.GetName(), Valid: r.GetName() != ""}
-	description := pgtype.Text{String: r.GetDescription(), Valid: r.GetDescription() != ""}
+	updateHistoryLimit := fields.has("deployment_history_limit", r.DeploymentHistoryLimit != nil)
+	if updateHistoryLimit && (r.GetDeploymentHistoryLimit() < 0 || r.GetDeploymentHistoryLimit() > maxDeploymentHistoryLimit) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidHistoryLimit)
+	}
 
-	_, err := s.queries.UpdateWorkspace(ctx, genDb.UpdateWorkspaceParams{
-		ID:          r.GetWorkspaceId(),
-		Name:        name,
-		Description: description,
+	var labels []byte
+	if fields.has("labels", len(r.GetLabels()) > 0) {
+		if labels, err = marshalLabels(r.GetLabels()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	name := pgtype.Text{String: r.GetName(), Valid: updateName}
+	description := pgtype.Text{String: r.GetDescription(), Valid: fields.has("description", r.GetDescription() != "")}
+	historyLimit := pgtype.Int4{Int32: r.GetDeploymentHistoryLimit(), Valid: updateHistoryLimit}
+	blockCritical := pgtype.Bool{Bool: r.GetBlockCriticalVulnerabilities(), Valid: fields.has("block_critical_vulnerabilities", r.BlockCriticalVulnerabilities != nil)}
+
+	_, err = s.queries.UpdateWorkspace(ctx, genDb.UpdateWorkspaceParams{
+		ID:                           r.GetWorkspaceId(),
+		Name:                         name,
+		Description:                  description,
+		DeploymentHistoryLimit:       historyLimit,
+		Labels:                       labels,
+		BlockCriticalVulnerabilities: blockCritical,
 	})
 	if err != nil {
 		slog.WarnContext(ctx, "workspace not found", "id", r.GetWorkspaceId())
 		return nil, connect.NewError(connect.CodeNotFound, ErrWorkspaceNotFound)
 	}
 
+	ws, err := s.queries.GetWorkspaceByIDQuery(ctx, r.GetWorkspaceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read back updated workspace", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
 	return connect.NewResponse(&workspacev1.UpdateWorkspaceResponse{
 		WorkspaceId: r.GetWorkspaceId(),
+		Workspace:   dbWorkspaceToProto(ws),
 	}), nil
 }
 
+// dbWorkspaceToProto converts a database Workspace to the proto Workspace
+func dbWorkspaceToProto(ws genDb.Workspace) *workspacev1.Workspace {
+	workspace := &workspacev1.Workspace{
+		Id:          ws.ID,
+		OrgId:       ws.OrgID,
+		Name:        ws.Name,
+		Description: ws.Description.String,
+		CreatedBy:   ws.CreatedBy,
+		CreatedAt:   timeutil.ParsePostgresTimestamp(ws.CreatedAt.Time),
+		UpdatedAt:   timeutil.ParsePostgresTimestamp(ws.UpdatedAt.Time),
+
+		DeploymentHistoryLimit:       ws.DeploymentHistoryLimit,
+		Labels:                       unmarshalLabels(ws.Labels),
+		BlockCriticalVulnerabilities: ws.BlockCriticalVulnerabilities,
+	}
+	if ws.ExternalID.Valid {
+		workspace.ExternalId = &ws.ExternalID.String
+	}
+	if ws.ArchivedAt.Valid {
+		workspace.ArchivedAt = timeutil.ParsePostgresTimestamp(ws.ArchivedAt.Time)
+	}
+	return workspace
+}
+
 // DeleteWorkspace deletes a workspace
 func (s *WorkspaceServer) DeleteWorkspace(
 	ctx context.Context,
@@ -371,7 +463,7 @@ func (s *WorkspaceServer) DeleteWorkspace(
 ) (*connect.Response[workspacev1.DeleteWorkspaceResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -382,21 +474,115 @@ func (s *WorkspaceServer) DeleteWorkspace(
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
-	err := s.queries.RemoveWorkspace(ctx, r.GetWorkspaceId())
+	if err := ensureWorkspaceDeletable(ctx, s.queries, r.GetWorkspaceId()); err != nil {
+		return nil, err
+	}
+
+	production, err := isProductionWorkspace(ctx, s.queries, r.GetWorkspaceId())
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to delete workspace", "error", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrWorkspaceNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to check whether workspace is production", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
+	if production {
+		return nil, requireApproval(ctx, s.queries, genDb.ApprovalActionDeleteWorkspace, r.GetWorkspaceId(), r.GetWorkspaceId())
+	}
+
+	if err := s.deleteWorkspace(ctx, r.GetWorkspaceId()); err != nil {
+		return nil, err
+	}
 
 	return connect.NewResponse(&workspacev1.DeleteWorkspaceResponse{}), nil
 }
 
+// deleteWorkspace deletes a workspace
+func (s *WorkspaceServer) deleteWorkspace(ctx context.Context, workspaceID int64) error {
+	// checked again since a lock may have been taken while an approval was pending
+	if err := ensureWorkspaceDeletable(ctx, s.queries, workspaceID); err != nil {
+		return err
+	}
+	if err := s.queries.RemoveWorkspace(ctx, workspaceID); err != nil {
+		slog.ErrorContext(ctx, "failed to delete workspace", "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return nil
+}
+
 // CreateMember adds a member to a workspace
 func (s *WorkspaceServer) CreateMember(
 	ctx context.Context,
 	req *connect.Request[workspacev1.CreateMemberRequest],
 ) (*connect.Response[workspacev1.CreateMemberResponse], error) {
 	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.AddWorkspaceMember, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to add workspace member", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	role := genDb.WorkspaceRole(r.GetRole())
+	var scope genDb.Scope
+	switch role {
+	case genDb.WorkspaceRoleAdmin:
+		scope = genDb.ScopeAdmin
+	case genDb.WorkspaceRoleDeploy:
+		scope = genDb.ScopeWrite
+	case genDb.WorkspaceRoleRead:
+		scope = genDb.ScopeRead
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidRole)
+	}
+
+	memberScope := genDb.EntityScope{
+		EntityType: genDb.EntityTypeWorkspace,
+		EntityID:   r.GetWorkspaceId(),
+		Scope:      scope,
+	}
+	// members can only be granted what the caller holds themselves
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, memberScope); err != nil {
+		slog.WarnContext(ctx, "cannot grant a role above the caller's own", "workspaceId", r.GetWorkspaceId(), "role", role)
+		return nil, connect.NewError(connect.CodePermissionDenied, ErrRoleAboveCaller)
+	}
+
+	if _, err := s.queries.UpsertWorkspaceMember(ctx, genDb.UpsertWorkspaceMemberParams{
+		WorkspaceID: r.GetWorkspaceId(),
+		UserID:      r.GetUserId(),
+		Role:        role,
+	}); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation
+			return nil, connect.NewError(connect.CodeNotFound, ErrUserNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to add workspace member", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.machine.UpdateRoles(ctx, r.GetUserId(), []genDb.EntityScope{memberScope}, nil); err != nil {
+		slog.ErrorContext(ctx, "failed to grant workspace member scope", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to grant workspace scope: %w", err))
+	}
+
+	if err := s.notifier.Notify(ctx, notify.Event{
+		Kind:        genDb.NotificationEventInvitation,
+		Key:         fmt.Sprintf("%d:%d:%s", r.GetWorkspaceId(), r.GetUserId(), role),
+		WorkspaceID: r.GetWorkspaceId(),
+		UserID:      r.GetUserId(),
+		Subject:     "You've been added to a workspace",
+		Body:        fmt.Sprintf("You now have %s access to workspace %d.", role, r.GetWorkspaceId()),
+		Data:        map[string]any{"workspace_id": r.GetWorkspaceId(), "role": string(role)},
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to queue invitation notification", "error", err)
+	}
+
+	slog.InfoContext(ctx, "added workspace member", "workspaceId", r.GetWorkspaceId(), "userId", r.GetUserId(), "role", role)
 	return connect.NewResponse(&workspacev1.CreateMemberResponse{
 		WorkspaceId: r.GetWorkspaceId(),
 		UserId:      r.GetUserId(),
@@ -419,7 +605,7 @@ func (s *WorkspaceServer) ListWorkspaceMembers(
 ) (*connect.Response[workspacev1.ListWorkspaceMembersResponse], error) {
 	r := req.Msg
 
-	scopes, ok := ctx.Value(contextkeys.EntityScopesKey).([]genDb.EntityScope)
+	scopes, ok := contextkeys.Scopes(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "entity scopes not found in context")
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
@@ -477,3 +663,468 @@ func (s *WorkspaceServer) ListWorkspaceMembers(
 		NextPageToken: nextPageToken,
 	}), nil
 }
+
+// ExportWorkspace exports a workspace's environments and resources as a YAML bundle. Env var values
+// are replaced by secret references and scale trigger links refer to resources by name.
+func (s *WorkspaceServer) ExportWorkspace(
+	ctx context.Context,
+	req *connect.Request[workspacev1.ExportWorkspaceRequest],
+) (*connect.Response[workspacev1.ExportWorkspaceResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ExportWorkspace, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to export workspace", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	workspace, err := s.queries.GetWorkspaceByIDQuery(ctx, r.GetWorkspaceId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, ErrWorkspaceNotFound)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	b := &bundle.Bundle{
+		Workspace: bundle.Workspace{Name: workspace.Name, Description: workspace.Description.String},
+	}
+
+	environments, err := s.queries.ListEnvironmentsForWorkspace(ctx, workspace.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	environmentNames := make(map[int64]string, len(environments))
+	for _, environment := range environments {
+		environmentNames[environment.ID] = environment.Name
+		var env map[string]string
+		if err := json.Unmarshal(environment.Env, &env); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid environment env: %w", err))
+		}
+		b.Environments = append(b.Environments, bundle.Environment{
+			Name:        environment.Name,
+			Description: environment.Description,
+			Env:         secretRefs(slices.Collect(maps.Keys(env)), func(key string) string { return bundle.EnvironmentSecret(environment.Name, key) }),
+		})
+	}
+
+	resources, err := s.listAllWorkspaceResources(ctx, workspace.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	resourcesByID := make(map[int64]genDb.Resource, len(resources))
+	for _, resource := range resources {
+		resourcesByID[resource.ID] = resource
+	}
+
+	for _, resource := range resources {
+		exported, err := s.exportResource(ctx, resource, environmentNames, resourcesByID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to export resource", "resourceId", resource.ID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to export resource %s: %w", resource.Name, err))
+		}
+		b.Resources = append(b.Resources, exported)
+	}
+
+	data, err := bundle.Marshal(b)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to render bundle: %w", err))
+	}
+
+	slog.InfoContext(ctx, "exported workspace", "workspaceId", workspace.ID, "environments", len(b.Environments), "resources", len(b.Resources))
+
+	return connect.NewResponse(&workspacev1.ExportWorkspaceResponse{Bundle: string(data)}), nil
+}
+
+// listAllWorkspaceResources pages through every resource of a workspace, oldest first so that an
+// import creates them in the same order.
+func (s *WorkspaceServer) listAllWorkspaceResources(ctx context.Context, workspaceID int64) ([]genDb.Resource, error) {
+	const pageSize = 200
+	var all []genDb.Resource
+	var pageToken pgtype.Text
+	for {
+		page, err := s.queries.ListResourcesForWorkspace(ctx, genDb.ListResourcesForWorkspaceParams{
+			WorkspaceID: workspaceID,
+			Limit:       pageSize,
+			PageToken:   pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		if len(page) < pageSize {
+			break
+		}
+		pageToken = pgtype.Text{String: strconv.FormatInt(page[len(page)-1].ID, 10), Valid: true}
+	}
+	slices.Reverse(all)
+	return all, nil
+}
+
+func (s *WorkspaceServer) exportResource(
+	ctx context.Context,
+	resource genDb.Resource,
+	environmentNames map[int64]string,
+	resourcesByID map[int64]genDb.Resource,
+) (bundle.Resource, error) {
+	exported := bundle.Resource{
+		Name:        resource.Name,
+		Type:        string(resource.Type),
+		Description: resource.Description,
+		Spec:        resource.Spec,
+	}
+	if resource.EnvironmentID.Valid {
+		exported.Environment = environmentNames[resource.EnvironmentID.Int64]
+	}
+
+	// scale triggers point at queue resources by ID, which means nothing on another control plane
+	if resource.Type == genDb.ResourceTypeService {
+		var spec resourcev1.ServiceSpec
+		if err := protojson.Unmarshal(resource.Spec, &spec); err != nil {
+			return bundle.Resource{}, fmt.Errorf("invalid resource spec: %w", err)
+		}
+		for _, region := range slices.Sorted(maps.Keys(spec.GetRegions())) {
+			for i, trigger := range spec.GetRegions()[region].GetScalers().GetTriggers() {
+				if trigger.QueueResourceId == nil {
+					continue
+				}
+				linked, ok := resourcesByID[trigger.GetQueueResourceId()]
+				if !ok {
+					return bundle.Resource{}, fmt.Errorf("scale trigger links to unknown resource %d", trigger.GetQueueResourceId())
+				}
+				link := bundle.Link{Region: region, Trigger: i, Resource: linked.Name}
+				if linked.EnvironmentID.Valid {
+					link.Environment = environmentNames[linked.EnvironmentID.Int64]
+				}
+				exported.Links = append(exported.Links, link)
+				trigger.QueueResourceId = nil
+			}
+		}
+		specJSON, err := protojson.Marshal(&spec)
+		if err != nil {
+			return bundle.Resource{}, err
+		}
+		exported.Spec = specJSON
+	}
+
+	domains, err := s.queries.ListResourceDomains(ctx, resource.ID)
+	if err != nil {
+		return bundle.Resource{}, err
+	}
+	for _, domain := range domains {
+		exportedDomain := bundle.Domain{Domain: domain.Domain, Primary: domain.IsPrimary}
+		if domain.DomainSource == genDb.DomainSourcePlatformProvided && domain.PlatformDomainID.Valid {
+			platformDomain, err := s.queries.GetPlatformDomain(ctx, domain.PlatformDomainID.Int64)
+			if err != nil {
+				return bundle.Resource{}, err
+			}
+			exportedDomain.PlatformDomain = platformDomain.Domain
+			exportedDomain.Subdomain = domain.SubdomainLabel.String
+		}
+		exported.Domains = append(exported.Domains, exportedDomain)
+	}
+
+	// env vars are only kept on the Application, never in the database
+	app := &locoControllerV1.Application{}
+	err = s.kubeClient.ControllerClient.Get(ctx, client.ObjectKey{Name: fmt.Sprintf("resource-%d", resource.ID), Namespace: s.locoNamespace}, app)
+	if client.IgnoreNotFound(err) != nil {
+		return bundle.Resource{}, err
+	}
+	if err == nil && app.Spec.ServiceSpec != nil && app.Spec.ServiceSpec.Deployment != nil {
+		exported.Env = secretRefs(slices.Collect(maps.Keys(app.Spec.ServiceSpec.Deployment.Env)), func(key string) string {
+			return bundle.ResourceSecret(exported.Environment, resource.Name, key)
+		})
+	}
+
+	return exported, nil
+}
+
+func secretRefs(keys []string, name func(key string) string) map[string]bundle.SecretRef {
+	if len(keys) == 0 {
+		return nil
+	}
+	refs := make(map[string]bundle.SecretRef, len(keys))
+	for _, key := range keys {
+		refs[key] = bundle.SecretRef{SecretRef: name(key)}
+	}
+	return refs
+}
+
+// ImportWorkspace creates the environments and resources of a bundle in a workspace, in one
+// transaction. Environments that already exist are reused; resources must not exist yet.
+func (s *WorkspaceServer) ImportWorkspace(
+	ctx context.Context,
+	req *connect.Request[workspacev1.ImportWorkspaceRequest],
+) (*connect.Response[workspacev1.ImportWorkspaceResponse], error) {
+	r := req.Msg
+
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.ImportWorkspace, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to import workspace", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	b, err := bundle.Unmarshal([]byte(r.GetBundle()))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := apiDb.QueriesFor(tx)
+	resp := &workspacev1.ImportWorkspaceResponse{}
+
+	var createdBy pgtype.Int8
+	if entity.Type == genDb.EntityTypeUser {
+		createdBy = pgtype.Int8{Int64: entity.ID, Valid: true}
+	}
+
+	environmentIDs := make(map[string]int64, len(b.Environments))
+	for _, environment := range b.Environments {
+		existing, err := qtx.GetEnvironmentByName(ctx, genDb.GetEnvironmentByNameParams{WorkspaceID: r.GetWorkspaceId(), Name: environment.Name})
+		if err == nil {
+			environmentIDs[environment.Name] = existing.ID
+			continue
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		if !environmentNamePattern.MatchString(environment.Name) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("environment %q: %w", environment.Name, ErrInvalidEnvironment))
+		}
+
+		env := make(map[string]string, len(environment.Env))
+		for key, ref := range environment.Env {
+			value, ok := r.GetSecrets()[ref.SecretRef]
+			if !ok {
+				resp.UnresolvedSecrets = append(resp.UnresolvedSecrets, ref.SecretRef)
+				continue
+			}
+			env[key] = value
+		}
+		envJSON, err := marshalEnvironmentEnv(env)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("environment %q: %w", environment.Name, err))
+		}
+
+		created, err := qtx.CreateEnvironment(ctx, genDb.CreateEnvironmentParams{
+			WorkspaceID: r.GetWorkspaceId(),
+			Name:        environment.Name,
+			Description: environment.Description,
+			Env:         envJSON,
+			CreatedBy:   createdBy,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to create environment", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		environmentIDs[environment.Name] = created.ID
+		resp.Environments = append(resp.Environments, environment.Name)
+	}
+
+	type resourceKey struct{ environment, name string }
+	resourceIDs := make(map[resourceKey]int64, len(b.Resources))
+	specs := make(map[resourceKey]*resourcev1.ServiceSpec, len(b.Resources))
+
+	for _, resource := range b.Resources {
+		key := resourceKey{resource.Environment, resource.Name}
+
+		resourceID, spec, err := importResource(ctx, qtx, r.GetWorkspaceId(), resource, environmentIDs)
+		if err != nil {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				return nil, err
+			}
+			slog.ErrorContext(ctx, "failed to import resource", "name", resource.Name, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to import resource %s: %w", resource.Name, err))
+		}
+		resourceIDs[key] = resourceID
+		specs[key] = spec
+
+		imported := &workspacev1.ImportedResource{Name: resource.Name, ResourceId: resourceID}
+		if resource.Environment != "" {
+			imported.Environment = &resource.Environment
+		}
+		resp.Resources = append(resp.Resources, imported)
+
+		for _, ref := range resource.Env {
+			resp.UnresolvedSecrets = append(resp.UnresolvedSecrets, ref.SecretRef)
+		}
+	}
+
+	// links are resolved once every resource exists, since they may point forward
+	for _, resource := range b.Resources {
+		if len(resource.Links) == 0 {
+			continue
+		}
+		key := resourceKey{resource.Environment, resource.Name}
+		spec := specs[key]
+		for _, link := range resource.Links {
+			linkedID, ok := resourceIDs[resourceKey{link.Environment, link.Resource}]
+			if !ok {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("resource %s links to unknown resource %s", resource.Name, link.Resource))
+			}
+			triggers := spec.GetRegions()[link.Region].GetScalers().GetTriggers()
+			if link.Trigger < 0 || link.Trigger >= len(triggers) {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("resource %s links scale trigger %d in region %s, which does not exist", resource.Name, link.Trigger, link.Region))
+			}
+			triggers[link.Trigger].QueueResourceId = &linkedID
+		}
+		specJSON, err := protojson.Marshal(spec)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if err := qtx.UpdateResourceSpec(ctx, genDb.UpdateResourceSpecParams{ID: resourceIDs[key], Spec: specJSON}); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	slices.Sort(resp.UnresolvedSecrets)
+
+	if r.GetDryRun() {
+		for _, imported := range resp.Resources {
+			imported.ResourceId = 0
+		}
+		return connect.NewResponse(resp), nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit import", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.InfoContext(ctx, "imported workspace bundle", "workspaceId", r.GetWorkspaceId(), "environments", len(resp.Environments), "resources", len(resp.Resources))
+
+	return connect.NewResponse(resp), nil
+}
+
+// importResource creates a bundled resource with its regions and domains. The returned spec
+// still has to have its links resolved.
+func importResource(
+	ctx context.Context,
+	qtx genDb.Querier,
+	workspaceID int64,
+	resource bundle.Resource,
+	environmentIDs map[string]int64,
+) (int64, *resourcev1.ServiceSpec, error) {
+	if genDb.ResourceType(resource.Type) != genDb.ResourceTypeService {
+		return 0, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("resource %s: only service resources can be imported", resource.Name))
+	}
+
+	var spec resourcev1.ServiceSpec
+	if err := protojson.Unmarshal(resource.Spec, &spec); err != nil {
+		return 0, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("resource %s: invalid spec: %w", resource.Name, err))
+	}
+	if len(spec.GetRegions()) == 0 {
+		return 0, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("resource %s: at least one region is required in spec", resource.Name))
+	}
+	if len(resource.Domains) == 0 {
+		return 0, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("resource %s: domain is required", resource.Name))
+	}
+
+	var environmentID pgtype.Int8
+	if resource.Environment != "" {
+		id, ok := environmentIDs[resource.Environment]
+		if !ok {
+			return 0, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("resource %s: unknown environment %s", resource.Name, resource.Environment))
+		}
+		environmentID = pgtype.Int8{Int64: id, Valid: true}
+	}
+
+	resourceID, err := qtx.CreateResource(ctx, genDb.CreateResourceParams{
+		WorkspaceID:   workspaceID,
+		Name:          resource.Name,
+		Type:          genDb.ResourceTypeService,
+		Status:        genDb.ResourceStatusUnavailable,
+		Spec:          resource.Spec,
+		SpecVersion:   version.SpecVersionV1,
+		Description:   resource.Description,
+		EnvironmentID: environmentID,
+	})
+	if err != nil {
+		if isPgConstraintViolation(err) {
+			return 0, nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("resource %s already exists in this workspace or environment", resource.Name))
+		}
+		return 0, nil, err
+	}
+
+	for _, region := range slices.Sorted(maps.Keys(spec.GetRegions())) {
+		if spec.GetRegions()[region].GetPrimary() {
+			if _, err := qtx.CreateResourceRegion(ctx, genDb.CreateResourceRegionParams{
+				ResourceID: resourceID,
+				Region:     region,
+				IsPrimary:  true,
+				Status:     genDb.RegionIntentStatusDesired,
+			}); err != nil {
+				return 0, nil, err
+			}
+		}
+	}
+	priority := int32(1)
+	for _, region := range slices.Sorted(maps.Keys(spec.GetRegions())) {
+		if !spec.GetRegions()[region].GetPrimary() {
+			if _, err := qtx.CreateResourceRegion(ctx, genDb.CreateResourceRegionParams{
+				ResourceID:       resourceID,
+				Region:           region,
+				Status:           genDb.RegionIntentStatusDesired,
+				FailoverPriority: priority,
+			}); err != nil {
+				return 0, nil, err
+			}
+			priority++
+		}
+	}
+
+	for _, domain := range resource.Domains {
+		params := genDb.CreateResourceDomainParams{
+			ResourceID:   resourceID,
+			Domain:       domain.Domain,
+			DomainSource: genDb.DomainSourceUserProvided,
+			IsPrimary:    domain.Primary,
+		}
+		if domain.PlatformDomain != "" {
+			platformDomain, err := qtx.GetPlatformDomainByName(ctx, domain.PlatformDomain)
+			if err != nil {
+				return 0, nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("resource %s: platform domain %s not found", resource.Name, domain.PlatformDomain))
+			}
+			params.DomainSource = genDb.DomainSourcePlatformProvided
+			params.Domain = domain.Subdomain + "." + platformDomain.Domain
+			params.SubdomainLabel = pgtype.Text{String: domain.Subdomain, Valid: true}
+			params.PlatformDomainID = pgtype.Int8{Int64: platformDomain.ID, Valid: true}
+		}
+
+		available, err := qtx.CheckDomainAvailability(ctx, params.Domain)
+		if err != nil {
+			return 0, nil, err
+		}
+		if !available {
+			return 0, nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("resource %s: domain %s already in use", resource.Name, params.Domain))
+		}
+		if _, err := qtx.CreateResourceDomain(ctx, params); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	return resourceID, &spec, nil
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/team-loco/loco/api/contextkeys"
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/notify"
+	"github.com/team-loco/loco/api/tvm/actions"
+	workspacev1 "github.com/team-loco/loco/shared/proto/workspace/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetWorkspaceEnv returns the env vars shared by every resource in a workspace, and the resources
+// still running with older values.
+func (s *WorkspaceServer) GetWorkspaceEnv(
+	ctx context.Context,
+	req *connect.Request[workspacev1.GetWorkspaceEnvRequest],
+) (*connect.Response[workspacev1.GetWorkspaceEnvResponse], error) {
+	r := req.Msg
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.GetWorkspaceEnv, r.GetWorkspaceId())); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	workspaceEnv, err := s.queries.GetWorkspaceEnv(ctx, r.GetWorkspaceId())
+	if errors.Is(err, pgx.ErrNoRows) {
+		// never set, so nothing can be stale either
+		return connect.NewResponse(&workspacev1.GetWorkspaceEnvResponse{}), nil
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get workspace env", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	env, err := unmarshalWorkspaceEnv(workspaceEnv)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error(), "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	stale, err := s.queries.ListWorkspaceEnvStaleResources(ctx, r.GetWorkspaceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list resources with stale workspace env", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&workspacev1.GetWorkspaceEnvResponse{
+		Env:            env,
+		UpdatedAt:      timestamppb.New(workspaceEnv.UpdatedAt.Time),
+		StaleResources: staleResourcesToProto(stale),
+	}), nil
+}
+
+// UpdateWorkspaceEnv replaces the env vars shared by every resource in a workspace. Resources pick the
+// change up on their next deployment, so the ones running with the old values are listed in the
+// response, and workspace members are notified to redeploy them.
+func (s *WorkspaceServer) UpdateWorkspaceEnv(
+	ctx context.Context,
+	req *connect.Request[workspacev1.UpdateWorkspaceEnvRequest],
+) (*connect.Response[workspacev1.UpdateWorkspaceEnvResponse], error) {
+	r := req.Msg
+
+	entity, ok := contextkeys.Entity(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity not found in context")
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthorized)
+	}
+
+	scopes, ok := contextkeys.Scopes(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "entity scopes not found in context")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("entity scopes not found in context"))
+	}
+
+	if err := s.machine.VerifyWithGivenEntityScopes(ctx, scopes, actions.New(actions.UpdateWorkspaceEnv, r.GetWorkspaceId())); err != nil {
+		slog.WarnContext(ctx, "unauthorized to update workspace env", "workspaceId", r.GetWorkspaceId())
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	encoded, err := marshalEnvironmentEnv(r.GetEnv())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	current, err := workspaceEnv(ctx, s.queries, r.GetWorkspaceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load workspace env", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// rewriting the same values would mark every resource stale for nothing
+	changed := !maps.Equal(current, r.GetEnv())
+	if changed {
+		params := genDb.UpsertWorkspaceEnvParams{WorkspaceID: r.GetWorkspaceId(), Env: encoded}
+		if entity.Type == genDb.EntityTypeUser {
+			params.UpdatedBy = pgtype.Int8{Int64: entity.ID, Valid: true}
+		}
+		if _, err := s.queries.UpsertWorkspaceEnv(ctx, params); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation
+				return nil, connect.NewError(connect.CodeNotFound, ErrWorkspaceNotFound)
+			}
+			slog.ErrorContext(ctx, "failed to update workspace env", "workspaceId", r.GetWorkspaceId(), "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	stale, err := s.queries.ListWorkspaceEnvStaleResources(ctx, r.GetWorkspaceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list resources with stale workspace env", "workspaceId", r.GetWorkspaceId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if changed {
+		slog.InfoContext(ctx, "updated workspace env", "workspaceId", r.GetWorkspaceId(), "vars", len(r.GetEnv()), "staleResources", len(stale))
+		s.notifyWorkspaceEnvChanged(ctx, r.GetWorkspaceId(), stale)
+	}
+
+	return connect.NewResponse(&workspacev1.UpdateWorkspaceEnvResponse{
+		Env:            r.GetEnv(),
+		Changed:        changed,
+		StaleResources: staleResourcesToProto(stale),
+	}), nil
+}
+
+// notifyWorkspaceEnvChanged prompts workspace members to redeploy the resources still running with
+// the workspace's old env vars.
+func (s *WorkspaceServer) notifyWorkspaceEnvChanged(ctx context.Context, workspaceID int64, stale []genDb.ListWorkspaceEnvStaleResourcesRow) {
+	if len(stale) == 0 {
+		return
+	}
+	names := make([]string, len(stale))
+	ids := make([]int64, len(stale))
+	for i, resource := range stale {
+		names[i] = resource.Name
+		ids[i] = resource.ID
+	}
+	workspaceEnv, err := s.queries.GetWorkspaceEnv(ctx, workspaceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get workspace env", "workspaceId", workspaceID, "error", err)
+		return
+	}
+
+	if err := s.notifier.Notify(ctx, notify.Event{
+		Kind:        genDb.NotificationEventDeployment,
+		Key:         fmt.Sprintf("workspace-env:%d:%d", workspaceID, workspaceEnv.UpdatedAt.Time.UnixNano()),
+		WorkspaceID: workspaceID,
+		Subject:     "Shared env vars changed",
+		Body:        fmt.Sprintf("Redeploy %s to pick up the workspace's new env vars.", strings.Join(names, ", ")),
+		Data:        map[string]any{"workspace_id": workspaceID, "resource_ids": ids},
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to queue workspace env notification", "error", err)
+	}
+}
+
+// workspaceEnv returns the env vars shared by every resource in a workspace, or nil when none were set.
+func workspaceEnv(ctx context.Context, queries genDb.Querier, workspaceID int64) (map[string]string, error) {
+	workspaceEnv, err := queries.GetWorkspaceEnv(ctx, workspaceID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace env: %w", err)
+	}
+	return unmarshalWorkspaceEnv(workspaceEnv)
+}
+
+func unmarshalWorkspaceEnv(workspaceEnv genDb.WorkspaceEnv) (map[string]string, error) {
+	var env map[string]string
+	if err := json.Unmarshal(workspaceEnv.Env, &env); err != nil {
+		return nil, fmt.Errorf("invalid workspace env: %w", err)
+	}
+	return env, nil
+}
+
+func staleResourcesToProto(stale []genDb.ListWorkspaceEnvStaleResourcesRow) []*workspacev1.StaleResource {
+	resources := make([]*workspacev1.StaleResource, 0, len(stale))
+	for _, resource := range stale {
+		resources = append(resources, &workspacev1.StaleResource{ResourceId: resource.ID, Name: resource.Name})
+	}
+	return resources
+}
@@ -0,0 +1,55 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/team-loco/loco/api/contextkeys"
+	"github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+var requestIDs atomic.Int64
+
+// Context returns a context carrying what the HTTP middleware sets on a request: a request ID,
+// method, path and source IP. It is cancelled when t ends. Pass it to AsUser or
+// AsServiceAccount to act as someone.
+func Context(t testing.TB) context.Context {
+	ctx := t.Context()
+	ctx = context.WithValue(ctx, contextkeys.RequestIDKey, fmt.Sprintf("test-%d", requestIDs.Add(1)))
+	ctx = context.WithValue(ctx, contextkeys.MethodKey, "POST")
+	ctx = context.WithValue(ctx, contextkeys.PathKey, "/"+t.Name())
+	ctx = context.WithValue(ctx, contextkeys.SourceIPKey, "127.0.0.1:0")
+	return db.WithReadYourWrites(ctx)
+}
+
+// AsUser returns a copy of ctx acting as the user userID with scopes, as the auth interceptor
+// sets it after checking the user's token.
+func AsUser(ctx context.Context, userID int64, scopes ...genDb.EntityScope) context.Context {
+	return contextkeys.WithAuth(ctx, contextkeys.Auth{
+		Entity: genDb.Entity{Type: genDb.EntityTypeUser, ID: userID},
+		Scopes: scopes,
+		Token:  "test-token",
+	})
+}
+
+// AsServiceAccount returns a copy of ctx acting as the service account id with scopes.
+func AsServiceAccount(ctx context.Context, id int64, scopes ...genDb.EntityScope) context.Context {
+	return contextkeys.WithAuth(ctx, contextkeys.Auth{
+		Entity: genDb.Entity{Type: genDb.EntityTypeServiceAccount, ID: id},
+		Scopes: scopes,
+		Token:  "test-token",
+	})
+}
+
+// Scopes returns the given scopes on one entity, e.g. Scopes(genDb.EntityTypeWorkspace, wksID,
+// genDb.ScopeRead, genDb.ScopeWrite) for a workspace member.
+func Scopes(entityType genDb.EntityType, id int64, scopes ...genDb.Scope) []genDb.EntityScope {
+	entityScopes := make([]genDb.EntityScope, 0, len(scopes))
+	for _, scope := range scopes {
+		entityScopes = append(entityScopes, genDb.EntityScope{EntityType: entityType, EntityID: id, Scope: scope})
+	}
+	return entityScopes
+}
@@ -0,0 +1,169 @@
+package testutil
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/team-loco/loco/api/db"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// errNoSQL is returned by the Tx methods that would run SQL of their own
+var errNoSQL = errors.New("testutil: Tx only runs generated queries, through db.QueriesFor")
+
+var (
+	_ db.Beginner = (*DB)(nil)
+	_ pgx.Tx      = (*Tx)(nil)
+)
+
+// DB is a db.Beginner for handlers under test. Queries run inside its transactions go to Queries,
+// usually the same Querier or Store the handler was given, and nothing is rolled back: a test
+// checks Commits and Rollbacks instead.
+type DB struct {
+	Queries genDb.Querier
+	// BeginErr, when set, is returned by Begin, as when the database is down.
+	BeginErr error
+	// CommitErr, when set, is returned by Commit, as on a serialization failure.
+	CommitErr error
+
+	mu        sync.Mutex
+	commits   int
+	rollbacks int
+	execs     []string
+}
+
+// NewDB returns a DB whose transactions run on q.
+func NewDB(q genDb.Querier) *DB {
+	return &DB{Queries: q}
+}
+
+// Begin starts a transaction.
+func (d *DB) Begin(ctx context.Context) (pgx.Tx, error) {
+	if d.BeginErr != nil {
+		return nil, d.BeginErr
+	}
+	return &Tx{db: d}, nil
+}
+
+// Commits returns how many transactions were committed.
+func (d *DB) Commits() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.commits
+}
+
+// Rollbacks returns how many transactions were rolled back before being committed. The deferred
+// Rollback after a Commit is not counted.
+func (d *DB) Rollbacks() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.rollbacks
+}
+
+// Execs returns the statements run with Tx.Exec, such as SET LOCAL, in order.
+func (d *DB) Execs() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string(nil), d.execs...)
+}
+
+// Tx is a transaction of a DB. Its generated queries run on the DB's Queries; Exec only records
+// the statement, and the other ways of running SQL fail.
+type Tx struct {
+	db     *DB
+	closed bool
+}
+
+// Querier returns the queries db.QueriesFor runs inside tx.
+func (tx *Tx) Querier() genDb.Querier {
+	return tx.db.Queries
+}
+
+// Begin starts a nested transaction, which commits and rolls back on its own.
+func (tx *Tx) Begin(ctx context.Context) (pgx.Tx, error) {
+	if tx.closed {
+		return nil, pgx.ErrTxClosed
+	}
+	return tx.db.Begin(ctx)
+}
+
+func (tx *Tx) Commit(ctx context.Context) error {
+	if tx.closed {
+		return pgx.ErrTxClosed
+	}
+	tx.closed = true
+	if tx.db.CommitErr != nil {
+		tx.db.mu.Lock()
+		tx.db.rollbacks++
+		tx.db.mu.Unlock()
+		return tx.db.CommitErr
+	}
+	tx.db.mu.Lock()
+	tx.db.commits++
+	tx.db.mu.Unlock()
+	return nil
+}
+
+func (tx *Tx) Rollback(ctx context.Context) error {
+	if tx.closed {
+		return pgx.ErrTxClosed
+	}
+	tx.closed = true
+	tx.db.mu.Lock()
+	tx.db.rollbacks++
+	tx.db.mu.Unlock()
+	return nil
+}
+
+func (tx *Tx) Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error) {
+	if tx.closed {
+		return pgconn.CommandTag{}, pgx.ErrTxClosed
+	}
+	tx.db.mu.Lock()
+	tx.db.execs = append(tx.db.execs, sql)
+	tx.db.mu.Unlock()
+	return pgconn.CommandTag{}, nil
+}
+
+func (tx *Tx) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return 0, errNoSQL
+}
+
+func (tx *Tx) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	return errBatch{}
+}
+
+func (tx *Tx) LargeObjects() pgx.LargeObjects {
+	return pgx.LargeObjects{}
+}
+
+func (tx *Tx) Prepare(ctx context.Context, name, sql string) (*pgconn.StatementDescription, error) {
+	return nil, errNoSQL
+}
+
+func (tx *Tx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return nil, errNoSQL
+}
+
+func (tx *Tx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return errRow{}
+}
+
+// Conn returns nil: there is no connection behind a Tx.
+func (tx *Tx) Conn() *pgx.Conn {
+	return nil
+}
+
+type errRow struct{}
+
+func (errRow) Scan(dest ...any) error { return errNoSQL }
+
+type errBatch struct{}
+
+func (errBatch) Exec() (pgconn.CommandTag, error) { return pgconn.CommandTag{}, errNoSQL }
+func (errBatch) Query() (pgx.Rows, error)         { return nil, errNoSQL }
+func (errBatch) QueryRow() pgx.Row                { return errRow{} }
+func (errBatch) Close() error                     { return nil }
@@ -0,0 +1,182 @@
+// genquerier writes querier_gen.go: a testutil.Querier method, and a func field to stub it, for
+// every method of the sqlc generated Querier interface. Run it with go generate after sqlc.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+const (
+	src = "../gen/db/querier.go"
+	out = "querier_gen.go"
+)
+
+type method struct {
+	name    string
+	params  []string // "name type"
+	args    []string // names, to pass the params on
+	results []string // types; the last is always error
+}
+
+func main() {
+	methods, err := parseQuerier(src)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by genquerier. DO NOT EDIT.\n\n")
+	b.WriteString("package testutil\n\n")
+	b.WriteString("import (\n\t\"context\"\n\n\t\"github.com/jackc/pgx/v5/pgtype\"\n\tgenDb \"github.com/team-loco/loco/api/gen/db\"\n)\n\n")
+
+	b.WriteString("// Querier is a genDb.Querier whose methods call the func field of the same name, so a test\n")
+	b.WriteString("// stubs just the queries the code under test runs. A query whose func is nil fails with\n")
+	b.WriteString("// ErrNotStubbed. Every call is recorded, see Calls.\n")
+	b.WriteString("type Querier struct {\n\tcalls callLog\n\n")
+	for _, m := range methods {
+		fmt.Fprintf(&b, "\t%sFunc func(%s) %s\n", m.name, strings.Join(m.params, ", "), resultList(m.results))
+	}
+	b.WriteString("}\n")
+
+	for _, m := range methods {
+		fmt.Fprintf(&b, "\nfunc (q *Querier) %s(%s) %s {\n", m.name, strings.Join(m.params, ", "), resultList(m.results))
+		fmt.Fprintf(&b, "\tq.calls.record(%q)\n", m.name)
+		fmt.Fprintf(&b, "\tif q.%sFunc == nil {\n", m.name)
+		if len(m.results) == 1 {
+			fmt.Fprintf(&b, "\t\treturn notStubbed(%q)\n", m.name)
+		} else {
+			fmt.Fprintf(&b, "\t\tvar zero %s\n\t\treturn zero, notStubbed(%q)\n", m.results[0], m.name)
+		}
+		fmt.Fprintf(&b, "\t}\n\treturn q.%sFunc(%s)\n}\n", m.name, strings.Join(m.args, ", "))
+	}
+
+	formatted, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatalf("formatting %s: %v", out, err)
+	}
+	if err := os.WriteFile(out, formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseQuerier reads the methods of the Querier interface declared in path
+func parseQuerier(path string) ([]method, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := file.Scope.Lookup("Querier")
+	if obj == nil {
+		return nil, fmt.Errorf("%s declares no Querier", path)
+	}
+	iface, ok := obj.Decl.(*ast.TypeSpec).Type.(*ast.InterfaceType)
+	if !ok {
+		return nil, fmt.Errorf("%s: Querier is not an interface", path)
+	}
+
+	var methods []method
+	for _, field := range iface.Methods.List {
+		fn, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) != 1 {
+			return nil, fmt.Errorf("%s: Querier embeds an interface, which genquerier does not handle", path)
+		}
+		m := method{name: field.Names[0].Name}
+
+		for i, param := range fn.Params.List {
+			typ, err := typeString(param.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", m.name, err)
+			}
+			names := param.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+			}
+			for _, name := range names {
+				m.params = append(m.params, name.Name+" "+typ)
+				arg := name.Name
+				if _, variadic := param.Type.(*ast.Ellipsis); variadic {
+					arg += "..."
+				}
+				m.args = append(m.args, arg)
+			}
+		}
+
+		if fn.Results != nil {
+			for _, result := range fn.Results.List {
+				typ, err := typeString(result.Type)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", m.name, err)
+				}
+				m.results = append(m.results, typ)
+			}
+		}
+		if n := len(m.results); n == 0 || n > 2 || m.results[n-1] != "error" {
+			return nil, fmt.Errorf("%s: expected (error) or (T, error) results, got (%s)", m.name, strings.Join(m.results, ", "))
+		}
+
+		methods = append(methods, m)
+	}
+	return methods, nil
+}
+
+// typeString prints a type from the db package as seen from testutil, qualifying the
+// package's own types with genDb.
+func typeString(expr ast.Expr) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return "genDb." + t.Name, nil
+		}
+		return t.Name, nil
+	case *ast.SelectorExpr:
+		pkg, ok := t.X.(*ast.Ident)
+		if !ok {
+			return "", fmt.Errorf("unexpected type %T", t.X)
+		}
+		if pkg.Name != "context" && pkg.Name != "pgtype" {
+			return "", fmt.Errorf("type from unexpected package %s", pkg.Name)
+		}
+		return pkg.Name + "." + t.Sel.Name, nil
+	case *ast.StarExpr:
+		elem, err := typeString(t.X)
+		return "*" + elem, err
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return "", fmt.Errorf("unexpected array type")
+		}
+		elem, err := typeString(t.Elt)
+		return "[]" + elem, err
+	case *ast.Ellipsis:
+		elem, err := typeString(t.Elt)
+		return "..." + elem, err
+	case *ast.MapType:
+		key, err := typeString(t.Key)
+		if err != nil {
+			return "", err
+		}
+		value, err := typeString(t.Value)
+		return "map[" + key + "]" + value, err
+	case *ast.InterfaceType:
+		if len(t.Methods.List) > 0 {
+			return "", fmt.Errorf("unexpected non-empty interface type")
+		}
+		return "interface{}", nil
+	}
+	return "", fmt.Errorf("unexpected type %T", expr)
+}
+
+func resultList(results []string) string {
+	if len(results) == 1 {
+		return results[0]
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
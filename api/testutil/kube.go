@@ -0,0 +1,43 @@
+package testutil
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	crClient "sigs.k8s.io/controller-runtime/pkg/client"
+	crFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/team-loco/loco/api/pkg/kube"
+	locov1alpha1 "github.com/team-loco/loco/controller/api/v1alpha1"
+)
+
+// Scheme knows the built-in kinds and loco's own, like the API's kube clients.
+func Scheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(locov1alpha1.AddToScheme(scheme))
+	return scheme
+}
+
+// NewKubeClient returns a kube.Client backed by in-memory fakes instead of a cluster, holding
+// objs. The ClientSet and the ControllerClient keep separate stores: built-in objects are put in
+// both, Applications only in the ControllerClient, and a write through one is not seen by the
+// other. Manager, Cache and Config are nil.
+func NewKubeClient(objs ...crClient.Object) *kube.Client {
+	var builtin []runtime.Object
+	for _, obj := range objs {
+		if _, ok := obj.(*locov1alpha1.Application); !ok {
+			builtin = append(builtin, obj)
+		}
+	}
+
+	return &kube.Client{
+		ClientSet: kubefake.NewClientset(builtin...),
+		ControllerClient: crFake.NewClientBuilder().
+			WithScheme(Scheme()).
+			WithObjects(objs...).
+			WithStatusSubresource(&locov1alpha1.Application{}).
+			Build(),
+	}
+}
@@ -0,0 +1,58 @@
+// Package testutil lets service handlers be tested without a database or a cluster: a Querier
+// to stub queries on, a Store holding the core entities in memory, a DB whose transactions run on
+// either, a fake kube client, and contexts carrying what the middleware and auth interceptor set.
+package testutil
+
+//go:generate go run ./internal/genquerier
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// ErrNotStubbed is returned by a Querier query whose func field is nil.
+var ErrNotStubbed = errors.New("query not stubbed")
+
+var _ genDb.Querier = (*Querier)(nil)
+
+func notStubbed(query string) error {
+	return fmt.Errorf("testutil: %s: %w", query, ErrNotStubbed)
+}
+
+// Calls returns the queries run on q so far, in order.
+func (q *Querier) Calls() []string {
+	return q.calls.list()
+}
+
+// Called reports how many times query was run on q.
+func (q *Querier) Called(query string) int {
+	n := 0
+	for _, call := range q.calls.list() {
+		if call == query {
+			n++
+		}
+	}
+	return n
+}
+
+// callLog records queries; handlers may run them from several goroutines
+type callLog struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (l *callLog) record(query string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls = append(l.calls, query)
+}
+
+func (l *callLog) list() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return slices.Clone(l.calls)
+}
@@ -0,0 +1,3789 @@
+// Code generated by genquerier. DO NOT EDIT.
+
+package testutil
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// Querier is a genDb.Querier whose methods call the func field of the same name, so a test
+// stubs just the queries the code under test runs. A query whose func is nil fails with
+// ErrNotStubbed. Every call is recorded, see Calls.
+type Querier struct {
+	calls callLog
+
+	AddOrgMemberFunc                             func(ctx context.Context, arg genDb.AddOrgMemberParams) error
+	AddOrganizationMemberFunc                    func(ctx context.Context, arg genDb.AddOrganizationMemberParams) (genDb.AddOrganizationMemberRow, error)
+	AddScimGroupMemberFunc                       func(ctx context.Context, arg genDb.AddScimGroupMemberParams) error
+	AddServiceAccountScopeFunc                   func(ctx context.Context, arg genDb.AddServiceAccountScopeParams) error
+	AddUserScopeFunc                             func(ctx context.Context, arg genDb.AddUserScopeParams) error
+	AddWorkspaceMemberFunc                       func(ctx context.Context, arg genDb.AddWorkspaceMemberParams) (genDb.AddWorkspaceMemberRow, error)
+	ArchiveWorkspaceFunc                         func(ctx context.Context, id int64) (genDb.Workspace, error)
+	AttachConfigGroupFunc                        func(ctx context.Context, arg genDb.AttachConfigGroupParams) error
+	CancelApprovalRequestFunc                    func(ctx context.Context, id int64) (genDb.ApprovalRequest, error)
+	CheckDomainAvailabilityFunc                  func(ctx context.Context, domain string) (bool, error)
+	CheckUserHasOrganizationsFunc                func(ctx context.Context, createdBy int64) (bool, error)
+	CheckUserHasWorkspacesFunc                   func(ctx context.Context, userID int64) (bool, error)
+	ClaimAlertRulesFunc                          func(ctx context.Context, arg genDb.ClaimAlertRulesParams) ([]genDb.AlertRule, error)
+	ClaimConfigRolloutFunc                       func(ctx context.Context, staleBefore pgtype.Timestamptz) (genDb.ConfigRollout, error)
+	ClaimNotificationDeliveriesFunc              func(ctx context.Context, arg genDb.ClaimNotificationDeliveriesParams) ([]genDb.NotificationOutbox, error)
+	ClaimOrgDeletionFunc                         func(ctx context.Context, staleBefore pgtype.Timestamptz) (genDb.OrgDeletion, error)
+	ClaimProbeTargetsFunc                        func(ctx context.Context, arg genDb.ClaimProbeTargetsParams) ([]genDb.ClaimProbeTargetsRow, error)
+	ClearDeploymentDriftFunc                     func(ctx context.Context, id int64) error
+	ClearPrimaryResourceRegionFunc               func(ctx context.Context, resourceID int64) error
+	ClearResourceProbeDegradedFunc               func(ctx context.Context, id int64) (int64, error)
+	CompleteConfigRolloutFunc                    func(ctx context.Context, id int64) error
+	CompleteOrgDeletionFunc                      func(ctx context.Context, id int64) error
+	ConsumeEmailTokenFunc                        func(ctx context.Context, arg genDb.ConsumeEmailTokenParams) (int64, error)
+	CountEnvironmentResourcesFunc                func(ctx context.Context, environmentID int64) (int64, error)
+	CountScimGroupsFunc                          func(ctx context.Context, arg genDb.CountScimGroupsParams) (int64, error)
+	CountScimUsersFunc                           func(ctx context.Context, arg genDb.CountScimUsersParams) (int64, error)
+	CountUnreadAnnouncementsFunc                 func(ctx context.Context, afterID int64) (int64, error)
+	CountUnreadNotificationsFunc                 func(ctx context.Context, userID int64) (int64, error)
+	CreateAlertRuleFunc                          func(ctx context.Context, arg genDb.CreateAlertRuleParams) (genDb.AlertRule, error)
+	CreateAlertTargetFunc                        func(ctx context.Context, arg genDb.CreateAlertTargetParams) error
+	CreateAnnouncementFunc                       func(ctx context.Context, arg genDb.CreateAnnouncementParams) (int64, error)
+	CreateApprovalRequestFunc                    func(ctx context.Context, arg genDb.CreateApprovalRequestParams) (genDb.ApprovalRequest, error)
+	CreateClusterFunc                            func(ctx context.Context, arg genDb.CreateClusterParams) (genDb.Cluster, error)
+	CreateClusterNodePoolFunc                    func(ctx context.Context, arg genDb.CreateClusterNodePoolParams) (genDb.ClusterNodePool, error)
+	CreateConfigGroupFunc                        func(ctx context.Context, arg genDb.CreateConfigGroupParams) (genDb.ConfigGroup, error)
+	CreateConfigRolloutFunc                      func(ctx context.Context, arg genDb.CreateConfigRolloutParams) (genDb.ConfigRollout, error)
+	CreateDeploymentFunc                         func(ctx context.Context, arg genDb.CreateDeploymentParams) (int64, error)
+	CreateEmailTokenFunc                         func(ctx context.Context, arg genDb.CreateEmailTokenParams) error
+	CreateEnvironmentFunc                        func(ctx context.Context, arg genDb.CreateEnvironmentParams) (genDb.Environment, error)
+	CreateInboxNotificationFunc                  func(ctx context.Context, arg genDb.CreateInboxNotificationParams) error
+	CreateOrgFunc                                func(ctx context.Context, arg genDb.CreateOrgParams) (genDb.Organization, error)
+	CreateOrgBillingFunc                         func(ctx context.Context, arg genDb.CreateOrgBillingParams) (genDb.OrgBilling, error)
+	CreateOrgDeletionFunc                        func(ctx context.Context, arg genDb.CreateOrgDeletionParams) (genDb.OrgDeletion, error)
+	CreateOrganizationFunc                       func(ctx context.Context, arg genDb.CreateOrganizationParams) (genDb.CreateOrganizationRow, error)
+	CreatePlatformDomainFunc                     func(ctx context.Context, arg genDb.CreatePlatformDomainParams) (int64, error)
+	CreatePolicyFunc                             func(ctx context.Context, arg genDb.CreatePolicyParams) (genDb.Policy, error)
+	CreateRefreshTokenFunc                       func(ctx context.Context, arg genDb.CreateRefreshTokenParams) error
+	CreateResourceFunc                           func(ctx context.Context, arg genDb.CreateResourceParams) (int64, error)
+	CreateResourceActivityFunc                   func(ctx context.Context, arg genDb.CreateResourceActivityParams) error
+	CreateResourceDomainFunc                     func(ctx context.Context, arg genDb.CreateResourceDomainParams) (int64, error)
+	CreateResourceRegionFunc                     func(ctx context.Context, arg genDb.CreateResourceRegionParams) (genDb.ResourceRegion, error)
+	CreateScimGroupFunc                          func(ctx context.Context, arg genDb.CreateScimGroupParams) (genDb.ScimGroup, error)
+	CreateScimUserFunc                           func(ctx context.Context, arg genDb.CreateScimUserParams) (genDb.ScimUser, error)
+	CreateServiceAccountFunc                     func(ctx context.Context, arg genDb.CreateServiceAccountParams) (genDb.ServiceAccount, error)
+	CreateUserFunc                               func(ctx context.Context, arg genDb.CreateUserParams) (genDb.User, error)
+	CreateUserPasswordFunc                       func(ctx context.Context, arg genDb.CreateUserPasswordParams) error
+	CreateWorkspaceFunc                          func(ctx context.Context, arg genDb.CreateWorkspaceParams) (int64, error)
+	CreateWorkspaceDigestFunc                    func(ctx context.Context, arg genDb.CreateWorkspaceDigestParams) (int64, error)
+	DeactivatePlatformDomainFunc                 func(ctx context.Context, id int64) (int64, error)
+	DeleteAlertRuleFunc                          func(ctx context.Context, id int64) (int64, error)
+	DeleteAlertTargetsFunc                       func(ctx context.Context, ruleID int64) error
+	DeleteAnnouncementFunc                       func(ctx context.Context, id int64) (int64, error)
+	DeleteClusterNodePoolsFunc                   func(ctx context.Context, clusterID int64) error
+	DeleteConfigGroupFunc                        func(ctx context.Context, id int64) error
+	DeleteDNSRecordFunc                          func(ctx context.Context, id int64) error
+	DeleteDirectoryGroupRolesFunc                func(ctx context.Context, arg genDb.DeleteDirectoryGroupRolesParams) error
+	DeleteEmailTokensFunc                        func(ctx context.Context, arg genDb.DeleteEmailTokensParams) error
+	DeleteEmptyWorkspacesForOrgFunc              func(ctx context.Context, orgID int64) error
+	DeleteEnvironmentFunc                        func(ctx context.Context, id int64) error
+	DeleteExpiredRefreshTokensFunc               func(ctx context.Context) error
+	DeleteExpiredTokensFunc                      func(ctx context.Context) error
+	DeleteOrgFunc                                func(ctx context.Context, id int64) error
+	DeleteOrganizationFunc                       func(ctx context.Context, id int64) error
+	DeletePolicyFunc                             func(ctx context.Context, id int64) (int64, error)
+	DeleteRefreshTokenFamilyFunc                 func(ctx context.Context, familyID string) ([]pgtype.Text, error)
+	DeleteRefreshTokenFamilyByAccessTokenFunc    func(ctx context.Context, accessToken pgtype.Text) ([]pgtype.Text, error)
+	DeleteRefreshTokensForUserFunc               func(ctx context.Context, userID int64) error
+	DeleteResolvedClusterDiscrepanciesFunc       func(ctx context.Context, foundResourceIds []int64) (int64, error)
+	DeleteResourceFunc                           func(ctx context.Context, id int64) error
+	DeleteResourceDomainFunc                     func(ctx context.Context, id int64) error
+	DeleteResourceProbesBeforeFunc               func(ctx context.Context, before pgtype.Timestamptz) (int64, error)
+	DeleteScimGroupFunc                          func(ctx context.Context, id int64) error
+	DeleteScimUserFunc                           func(ctx context.Context, id int64) error
+	DeleteServiceAccountFunc                     func(ctx context.Context, id int64) error
+	DeleteSlackChannelsForOrgFunc                func(ctx context.Context, orgID int64) error
+	DeleteSlackInstallationFunc                  func(ctx context.Context, orgID int64) (int64, error)
+	DeleteTokenFunc                              func(ctx context.Context, name string) error
+	DeleteTokenByNameAndEntityFunc               func(ctx context.Context, arg genDb.DeleteTokenByNameAndEntityParams) error
+	DeleteTokensForEntityFunc                    func(ctx context.Context, arg genDb.DeleteTokensForEntityParams) error
+	DeleteUserFunc                               func(ctx context.Context, id int64) error
+	DeleteUserNotificationPreferenceFunc         func(ctx context.Context, arg genDb.DeleteUserNotificationPreferenceParams) error
+	DeleteUserTOTPFunc                           func(ctx context.Context, userID int64) error
+	DeleteWorkspaceFunc                          func(ctx context.Context, id int64) error
+	DeleteWorkspaceMemberFunc                    func(ctx context.Context, arg genDb.DeleteWorkspaceMemberParams) error
+	DeleteWorkspaceNotificationDefaultFunc       func(ctx context.Context, arg genDb.DeleteWorkspaceNotificationDefaultParams) error
+	DeleteWorkspaceSlackChannelFunc              func(ctx context.Context, workspaceID int64) (int64, error)
+	DetachConfigGroupFunc                        func(ctx context.Context, arg genDb.DetachConfigGroupParams) (int64, error)
+	EndOrgSubscriptionFunc                       func(ctx context.Context, arg genDb.EndOrgSubscriptionParams) (int64, error)
+	EnqueueNotificationFunc                      func(ctx context.Context, arg genDb.EnqueueNotificationParams) error
+	FailConfigRolloutFunc                        func(ctx context.Context, arg genDb.FailConfigRolloutParams) error
+	FailOrgDeletionFunc                          func(ctx context.Context, arg genDb.FailOrgDeletionParams) error
+	FailStuckDeploymentFunc                      func(ctx context.Context, arg genDb.FailStuckDeploymentParams) (int64, error)
+	GetActiveClusterByRegionFunc                 func(ctx context.Context, region string) (genDb.Cluster, error)
+	GetActiveConfigRolloutFunc                   func(ctx context.Context, configGroupID int64) (genDb.ConfigRollout, error)
+	GetActiveDeploymentForResourceAndRegionFunc  func(ctx context.Context, arg genDb.GetActiveDeploymentForResourceAndRegionParams) (genDb.Deployment, error)
+	GetAlertRuleFunc                             func(ctx context.Context, id int64) (genDb.AlertRule, error)
+	GetAlertStateFunc                            func(ctx context.Context, arg genDb.GetAlertStateParams) (genDb.AlertState, error)
+	GetAnnouncementReadMarkerFunc                func(ctx context.Context, userID int64) (int64, error)
+	GetApprovalRequestFunc                       func(ctx context.Context, id int64) (genDb.ApprovalRequest, error)
+	GetBillingPlanFunc                           func(ctx context.Context, id int64) (genDb.BillingPlan, error)
+	GetClusterFunc                               func(ctx context.Context, id int64) (genDb.Cluster, error)
+	GetClusterDetailsFunc                        func(ctx context.Context, id int64) (genDb.GetClusterDetailsRow, error)
+	GetConfigGroupFunc                           func(ctx context.Context, id int64) (genDb.ConfigGroup, error)
+	GetConfigRolloutFunc                         func(ctx context.Context, id int64) (genDb.ConfigRollout, error)
+	GetDeploymentByIDFunc                        func(ctx context.Context, id int64) (genDb.Deployment, error)
+	GetDeploymentNotificationContextFunc         func(ctx context.Context, id int64) (genDb.GetDeploymentNotificationContextRow, error)
+	GetDeploymentProvenanceFunc                  func(ctx context.Context, deploymentID int64) (genDb.DeploymentProvenance, error)
+	GetDeploymentResourceIDFunc                  func(ctx context.Context, id int64) (int64, error)
+	GetDomainByResourceIdFunc                    func(ctx context.Context, resourceID int64) (genDb.GetDomainByResourceIdRow, error)
+	GetEnvironmentByIDFunc                       func(ctx context.Context, id int64) (genDb.Environment, error)
+	GetEnvironmentByNameFunc                     func(ctx context.Context, arg genDb.GetEnvironmentByNameParams) (genDb.Environment, error)
+	GetEnvironmentWorkspaceOrganizationIDFunc    func(ctx context.Context, id int64) (genDb.GetEnvironmentWorkspaceOrganizationIDRow, error)
+	GetFirstActiveClusterFunc                    func(ctx context.Context) (genDb.Cluster, error)
+	GetGitOpsApplicationFunc                     func(ctx context.Context, resourceID int64) (genDb.GitopsApplication, error)
+	GetImageScanFunc                             func(ctx context.Context, image string) (genDb.ImageScan, error)
+	GetLatestAnnouncementIDFunc                  func(ctx context.Context) (int64, error)
+	GetLatestDeploymentIDFunc                    func(ctx context.Context, resourceID int64) (int64, error)
+	GetLatestResourceProbeFunc                   func(ctx context.Context, resourceID int64) (genDb.ResourceProbe, error)
+	GetLatestWorkspaceDigestFunc                 func(ctx context.Context, workspaceID int64) (genDb.WorkspaceDigest, error)
+	GetOrgBillingFunc                            func(ctx context.Context, orgID int64) (genDb.OrgBilling, error)
+	GetOrgByIDFunc                               func(ctx context.Context, id int64) (genDb.Organization, error)
+	GetOrgByNameFunc                             func(ctx context.Context, name string) (genDb.Organization, error)
+	GetOrgDeletionFunc                           func(ctx context.Context, id int64) (genDb.OrgDeletion, error)
+	GetOrganizationByIDFunc                      func(ctx context.Context, id int64) (genDb.GetOrganizationByIDRow, error)
+	GetOrganizationByNameFunc                    func(ctx context.Context, name string) (genDb.GetOrganizationByNameRow, error)
+	GetOrganizationIDByWorkspaceIDFunc           func(ctx context.Context, id int64) (int64, error)
+	GetOrganizationMemberFunc                    func(ctx context.Context, arg genDb.GetOrganizationMemberParams) (genDb.GetOrganizationMemberRow, error)
+	GetPendingApprovalRequestFunc                func(ctx context.Context, arg genDb.GetPendingApprovalRequestParams) (genDb.ApprovalRequest, error)
+	GetPlatformDomainFunc                        func(ctx context.Context, id int64) (genDb.PlatformDomain, error)
+	GetPlatformDomainByNameFunc                  func(ctx context.Context, domain string) (genDb.PlatformDomain, error)
+	GetPolicyFunc                                func(ctx context.Context, id int64) (genDb.Policy, error)
+	GetPreviousDeploymentInRegionFunc            func(ctx context.Context, arg genDb.GetPreviousDeploymentInRegionParams) (genDb.Deployment, error)
+	GetPrimaryDomainForResourceFunc              func(ctx context.Context, resourceID int64) (string, error)
+	GetResourceByExternalIDFunc                  func(ctx context.Context, arg genDb.GetResourceByExternalIDParams) (genDb.Resource, error)
+	GetResourceByIDFunc                          func(ctx context.Context, id int64) (genDb.Resource, error)
+	GetResourceByNameAndWorkspaceFunc            func(ctx context.Context, arg genDb.GetResourceByNameAndWorkspaceParams) (genDb.Resource, error)
+	GetResourceByNameInEnvironmentFunc           func(ctx context.Context, arg genDb.GetResourceByNameInEnvironmentParams) (genDb.Resource, error)
+	GetResourceDomainByIDFunc                    func(ctx context.Context, id int64) (genDb.ResourceDomain, error)
+	GetResourceDomainByNameFunc                  func(ctx context.Context, domain string) (genDb.ResourceDomain, error)
+	GetResourceDomainCountFunc                   func(ctx context.Context, resourceID int64) (int64, error)
+	GetResourceIngressEndpointFunc               func(ctx context.Context, resourceID int64) (pgtype.Text, error)
+	GetResourceLockFunc                          func(ctx context.Context, resourceID int64) (genDb.ResourceLock, error)
+	GetResourcePlanNameFunc                      func(ctx context.Context, id int64) (string, error)
+	GetResourceProbeLatencyP95Func               func(ctx context.Context, arg genDb.GetResourceProbeLatencyP95Params) (genDb.GetResourceProbeLatencyP95Row, error)
+	GetResourceProbeStatsFunc                    func(ctx context.Context, arg genDb.GetResourceProbeStatsParams) (genDb.GetResourceProbeStatsRow, error)
+	GetResourceProtectionFunc                    func(ctx context.Context, id int64) (genDb.GetResourceProtectionRow, error)
+	GetResourceRegionByResourceAndRegionFunc     func(ctx context.Context, arg genDb.GetResourceRegionByResourceAndRegionParams) (genDb.ResourceRegion, error)
+	GetResourceSleepByDomainFunc                 func(ctx context.Context, domain string) (genDb.GetResourceSleepByDomainRow, error)
+	GetResourceWorkspaceIDFunc                   func(ctx context.Context, id int64) (int64, error)
+	GetScimGroupFunc                             func(ctx context.Context, arg genDb.GetScimGroupParams) (genDb.ScimGroup, error)
+	GetScimUserFunc                              func(ctx context.Context, arg genDb.GetScimUserParams) (genDb.ScimUser, error)
+	GetServiceAccountByIDFunc                    func(ctx context.Context, id int64) (genDb.ServiceAccount, error)
+	GetServiceAccountScopesFunc                  func(ctx context.Context, serviceAccountID int64) ([]genDb.EntityScope, error)
+	GetServiceAccountWorkspaceOrganizationIDFunc func(ctx context.Context, id int64) (genDb.GetServiceAccountWorkspaceOrganizationIDRow, error)
+	GetSlackBotTokenFunc                         func(ctx context.Context, teamID string) (string, error)
+	GetSlackInstallationFunc                     func(ctx context.Context, orgID int64) (genDb.SlackInstallation, error)
+	GetStatusPageFunc                            func(ctx context.Context, resourceID int64) (genDb.StatusPage, error)
+	GetStatusPageByDomainFunc                    func(ctx context.Context, domain string) (genDb.GetStatusPageByDomainRow, error)
+	GetTokenFunc                                 func(ctx context.Context, token string) (genDb.Token, error)
+	GetTokenByNameFunc                           func(ctx context.Context, arg genDb.GetTokenByNameParams) (genDb.GetTokenByNameRow, error)
+	GetUsedRefreshTokenFamilyFunc                func(ctx context.Context, tokenHash string) (string, error)
+	GetUserByEmailFunc                           func(ctx context.Context, email string) (genDb.User, error)
+	GetUserByExternalIDFunc                      func(ctx context.Context, externalID string) (genDb.User, error)
+	GetUserByIDFunc                              func(ctx context.Context, id int64) (genDb.User, error)
+	GetUserPasswordFunc                          func(ctx context.Context, userID int64) (genDb.UserPassword, error)
+	GetUserScopesFunc                            func(ctx context.Context, userID int64) ([]genDb.EntityScope, error)
+	GetUserScopesOnEntityFunc                    func(ctx context.Context, arg genDb.GetUserScopesOnEntityParams) ([]genDb.EntityScope, error)
+	GetUserScopesOnOrganizationFunc              func(ctx context.Context, arg genDb.GetUserScopesOnOrganizationParams) ([]genDb.EntityScope, error)
+	GetUserScopesOnWorkspaceFunc                 func(ctx context.Context, arg genDb.GetUserScopesOnWorkspaceParams) ([]genDb.EntityScope, error)
+	GetUserTOTPFunc                              func(ctx context.Context, userID int64) (genDb.UserTotp, error)
+	GetUserWithScopesByEmailFunc                 func(ctx context.Context, email string) (genDb.UserWithScopesView, error)
+	GetUsersWithScopeOnEntityFunc                func(ctx context.Context, arg genDb.GetUsersWithScopeOnEntityParams) ([]int64, error)
+	GetWorkspaceByExternalIDFunc                 func(ctx context.Context, arg genDb.GetWorkspaceByExternalIDParams) (genDb.Workspace, error)
+	GetWorkspaceByIDQueryFunc                    func(ctx context.Context, id int64) (genDb.Workspace, error)
+	GetWorkspaceDeploymentCountsFunc             func(ctx context.Context, arg genDb.GetWorkspaceDeploymentCountsParams) (genDb.GetWorkspaceDeploymentCountsRow, error)
+	GetWorkspaceDigestFunc                       func(ctx context.Context, arg genDb.GetWorkspaceDigestParams) (genDb.WorkspaceDigest, error)
+	GetWorkspaceEnvFunc                          func(ctx context.Context, workspaceID int64) (genDb.WorkspaceEnv, error)
+	GetWorkspaceMemberFunc                       func(ctx context.Context, arg genDb.GetWorkspaceMemberParams) (genDb.GetWorkspaceMemberRow, error)
+	GetWorkspaceMemberRoleFunc                   func(ctx context.Context, arg genDb.GetWorkspaceMemberRoleParams) (genDb.WorkspaceRole, error)
+	GetWorkspaceMembersFunc                      func(ctx context.Context, workspaceID int64) ([]genDb.WorkspaceMember, error)
+	GetWorkspaceOrgIDFunc                        func(ctx context.Context, id int64) (int64, error)
+	GetWorkspaceOrganizationIDByResourceIDFunc   func(ctx context.Context, id int64) (genDb.GetWorkspaceOrganizationIDByResourceIDRow, error)
+	GetWorkspaceProtectionFunc                   func(ctx context.Context, id int64) (genDb.GetWorkspaceProtectionRow, error)
+	GetWorkspaceSlackChannelFunc                 func(ctx context.Context, workspaceID int64) (genDb.WorkspaceSlackChannel, error)
+	GetWorkspaceSlackTargetFunc                  func(ctx context.Context, workspaceID int64) (genDb.GetWorkspaceSlackTargetRow, error)
+	InsertAdminAuditEntryFunc                    func(ctx context.Context, arg genDb.InsertAdminAuditEntryParams) (genDb.AdminAuditLog, error)
+	IsOrgMemberFunc                              func(ctx context.Context, arg genDb.IsOrgMemberParams) (bool, error)
+	IsOrgNameUniqueFunc                          func(ctx context.Context, name string) (bool, error)
+	IsOrganizationNameUniqueFunc                 func(ctx context.Context, name string) (bool, error)
+	IsResourceOrgDelinquentFunc                  func(ctx context.Context, id int64) (bool, error)
+	IsResourceWorkspaceArchivedFunc              func(ctx context.Context, id int64) (bool, error)
+	IsWorkspaceMemberFunc                        func(ctx context.Context, arg genDb.IsWorkspaceMemberParams) (bool, error)
+	IsWorkspaceNameUniqueInOrgFunc               func(ctx context.Context, arg genDb.IsWorkspaceNameUniqueInOrgParams) (bool, error)
+	ListActiveDeploymentsFunc                    func(ctx context.Context) ([]int64, error)
+	ListActiveDeploymentsByResourceIDFunc        func(ctx context.Context, resourceID int64) ([]genDb.DeploymentStatus, error)
+	ListActiveDeploymentsForDriftFunc            func(ctx context.Context) ([]genDb.ListActiveDeploymentsForDriftRow, error)
+	ListActiveDeploymentsForReconcileFunc        func(ctx context.Context) ([]genDb.ListActiveDeploymentsForReconcileRow, error)
+	ListActiveDeploymentsForResourceFunc         func(ctx context.Context, resourceID int64) ([]genDb.Deployment, error)
+	ListActivePlatformDomainsFunc                func(ctx context.Context) ([]genDb.PlatformDomain, error)
+	ListAdminAuditEntriesFunc                    func(ctx context.Context, arg genDb.ListAdminAuditEntriesParams) ([]genDb.AdminAuditLog, error)
+	ListAlertRuleResourcesFunc                   func(ctx context.Context, arg genDb.ListAlertRuleResourcesParams) ([]genDb.ListAlertRuleResourcesRow, error)
+	ListAlertRulesFunc                           func(ctx context.Context, workspaceID int64) ([]genDb.AlertRule, error)
+	ListAlertTargetsFunc                         func(ctx context.Context, ruleIds []int64) ([]genDb.AlertTarget, error)
+	ListAllLocoOwnedDomainsFunc                  func(ctx context.Context) ([]genDb.ListAllLocoOwnedDomainsRow, error)
+	ListAllOrgsFunc                              func(ctx context.Context, arg genDb.ListAllOrgsParams) ([]genDb.Organization, error)
+	ListAllUserNotificationsFunc                 func(ctx context.Context, userID int64) ([]genDb.Notification, error)
+	ListAnnouncementsFunc                        func(ctx context.Context, arg genDb.ListAnnouncementsParams) ([]genDb.Announcement, error)
+	ListApprovalRequestsForWorkspaceFunc         func(ctx context.Context, arg genDb.ListApprovalRequestsForWorkspaceParams) ([]genDb.ApprovalRequest, error)
+	ListBillingPlansFunc                         func(ctx context.Context) ([]genDb.BillingPlan, error)
+	ListClusterDiscrepanciesFunc                 func(ctx context.Context, workspaceID pgtype.Int8) ([]genDb.ClusterDiscrepancy, error)
+	ListClusterNodePoolsFunc                     func(ctx context.Context, clusterID int64) ([]genDb.ClusterNodePool, error)
+	ListClustersFunc                             func(ctx context.Context) ([]genDb.Cluster, error)
+	ListClustersActiveFunc                       func(ctx context.Context) ([]genDb.Cluster, error)
+	ListConfigGroupResourceIDsFunc               func(ctx context.Context, configGroupID int64) ([]int64, error)
+	ListConfigGroupsForWorkspaceFunc             func(ctx context.Context, workspaceID int64) ([]genDb.ConfigGroup, error)
+	ListDNSRecordsFunc                           func(ctx context.Context) ([]genDb.DnsRecord, error)
+	ListDeploymentsForResourceFunc               func(ctx context.Context, arg genDb.ListDeploymentsForResourceParams) ([]genDb.Deployment, error)
+	ListDeprecatedUsageFunc                      func(ctx context.Context) ([]genDb.DeprecatedUsage, error)
+	ListDirectoryGroupRolesFunc                  func(ctx context.Context, orgID int64) ([]genDb.DirectoryGroupRole, error)
+	ListEntityParentsFunc                        func(ctx context.Context, arg genDb.ListEntityParentsParams) ([]genDb.ListEntityParentsRow, error)
+	ListEnvironmentsForWorkspaceFunc             func(ctx context.Context, workspaceID int64) ([]genDb.Environment, error)
+	ListFailedDeploymentsAfterFunc               func(ctx context.Context, arg genDb.ListFailedDeploymentsAfterParams) ([]genDb.ListFailedDeploymentsAfterRow, error)
+	ListFailedDeploymentsSinceFunc               func(ctx context.Context, arg genDb.ListFailedDeploymentsSinceParams) ([]genDb.ListFailedDeploymentsSinceRow, error)
+	ListFiringAlertStatesFunc                    func(ctx context.Context, ruleIds []int64) ([]genDb.AlertState, error)
+	ListIdleSleepCandidatesFunc                  func(ctx context.Context, arg genDb.ListIdleSleepCandidatesParams) ([]genDb.ListIdleSleepCandidatesRow, error)
+	ListMeteredDeploymentsFunc                   func(ctx context.Context) ([]genDb.ListMeteredDeploymentsRow, error)
+	ListMultiRegionResourcesFunc                 func(ctx context.Context) ([]genDb.ListMultiRegionResourcesRow, error)
+	ListNotificationRecipientsFunc               func(ctx context.Context, arg genDb.ListNotificationRecipientsParams) ([]genDb.ListNotificationRecipientsRow, error)
+	ListNotificationsFunc                        func(ctx context.Context, arg genDb.ListNotificationsParams) ([]genDb.Notification, error)
+	ListOrgDeletionResourcesFunc                 func(ctx context.Context, orgID int64) ([]genDb.ListOrgDeletionResourcesRow, error)
+	ListOrgUsageFunc                             func(ctx context.Context, arg genDb.ListOrgUsageParams) ([]genDb.ListOrgUsageRow, error)
+	ListOrganizationMembersFunc                  func(ctx context.Context, organizationID int64) ([]genDb.ListOrganizationMembersRow, error)
+	ListOrgsForUserFunc                          func(ctx context.Context, arg genDb.ListOrgsForUserParams) ([]genDb.Organization, error)
+	ListPendingCustomDomainsFunc                 func(ctx context.Context) ([]genDb.ListPendingCustomDomainsRow, error)
+	ListPlatformAdminsFunc                       func(ctx context.Context) ([]genDb.User, error)
+	ListPlatformDomainTargetsFunc                func(ctx context.Context) ([]genDb.ListPlatformDomainTargetsRow, error)
+	ListPlatformDomainsFunc                      func(ctx context.Context, activeOnly pgtype.Bool) ([]genDb.PlatformDomain, error)
+	ListPoliciesFunc                             func(ctx context.Context, orgID int64) ([]genDb.Policy, error)
+	ListRegionNodePoolsFunc                      func(ctx context.Context, region string) ([]genDb.ClusterNodePool, error)
+	ListRegionPricingFunc                        func(ctx context.Context) ([]genDb.ListRegionPricingRow, error)
+	ListResourceConfigGroupsFunc                 func(ctx context.Context, resourceID int64) ([]genDb.ConfigGroup, error)
+	ListResourceDomainsFunc                      func(ctx context.Context, resourceID int64) ([]genDb.ResourceDomain, error)
+	ListResourceDomainsForResourcesFunc          func(ctx context.Context, resourceIds []int64) ([]genDb.ResourceDomain, error)
+	ListResourceIDsForOrgFunc                    func(ctx context.Context, orgID int64) ([]int64, error)
+	ListResourceRegionOriginsFunc                func(ctx context.Context, resourceID int64) ([]genDb.ListResourceRegionOriginsRow, error)
+	ListResourceRegionsFunc                      func(ctx context.Context, resourceID int64) ([]genDb.ResourceRegion, error)
+	ListResourceRegionsForResourcesFunc          func(ctx context.Context, resourceIds []int64) ([]genDb.ResourceRegion, error)
+	ListResourceRegionsForUpdateFunc             func(ctx context.Context, resourceID int64) ([]genDb.ResourceRegion, error)
+	ListResourceStatusHistoryFunc                func(ctx context.Context, arg genDb.ListResourceStatusHistoryParams) ([]genDb.ResourceStatusHistory, error)
+	ListResourceTimelineFunc                     func(ctx context.Context, arg genDb.ListResourceTimelineParams) ([]genDb.ListResourceTimelineRow, error)
+	ListResourcesForWorkspaceFunc                func(ctx context.Context, arg genDb.ListResourcesForWorkspaceParams) ([]genDb.Resource, error)
+	ListScimGroupMembersFunc                     func(ctx context.Context, groupID int64) ([]genDb.ListScimGroupMembersRow, error)
+	ListScimGroupMembersByNameFunc               func(ctx context.Context, arg genDb.ListScimGroupMembersByNameParams) ([]int64, error)
+	ListScimGroupsFunc                           func(ctx context.Context, arg genDb.ListScimGroupsParams) ([]genDb.ScimGroup, error)
+	ListScimUserGroupScopesFunc                  func(ctx context.Context, scimUserID int64) ([][]genDb.EntityScope, error)
+	ListScimUsersFunc                            func(ctx context.Context, arg genDb.ListScimUsersParams) ([]genDb.ScimUser, error)
+	ListServiceAccountsForWorkspaceFunc          func(ctx context.Context, workspaceID int64) ([]genDb.ServiceAccount, error)
+	ListSlackChannelWorkspacesFunc               func(ctx context.Context, arg genDb.ListSlackChannelWorkspacesParams) ([]genDb.ListSlackChannelWorkspacesRow, error)
+	ListSolelyOwnedOrganizationsFunc             func(ctx context.Context, userID int64) ([]genDb.Organization, error)
+	ListStuckDeploymentsFunc                     func(ctx context.Context, cutoff pgtype.Timestamptz) ([]genDb.ListStuckDeploymentsRow, error)
+	ListTokensForEntityFunc                      func(ctx context.Context, arg genDb.ListTokensForEntityParams) ([]genDb.ListTokensForEntityRow, error)
+	ListUnreportedUsageFunc                      func(ctx context.Context, limit int32) ([]genDb.ListUnreportedUsageRow, error)
+	ListUserMFAOrganizationsFunc                 func(ctx context.Context, userID int64) ([]genDb.ListUserMFAOrganizationsRow, error)
+	ListUserNotificationPreferencesFunc          func(ctx context.Context, userID int64) ([]genDb.UserNotificationPreference, error)
+	ListUserOrganizationsFunc                    func(ctx context.Context, userID int64) ([]genDb.ListUserOrganizationsRow, error)
+	ListUserWorkspacesFunc                       func(ctx context.Context, userID int64) ([]genDb.ListUserWorkspacesRow, error)
+	ListUsersFunc                                func(ctx context.Context, arg genDb.ListUsersParams) ([]genDb.User, error)
+	ListWorkspaceEnvStaleResourcesFunc           func(ctx context.Context, workspaceID int64) ([]genDb.ListWorkspaceEnvStaleResourcesRow, error)
+	ListWorkspaceMembersFunc                     func(ctx context.Context, workspaceID int64) ([]genDb.ListWorkspaceMembersRow, error)
+	ListWorkspaceMembersWithUserDetailsFunc      func(ctx context.Context, arg genDb.ListWorkspaceMembersWithUserDetailsParams) ([]genDb.ListWorkspaceMembersWithUserDetailsRow, error)
+	ListWorkspaceNamesForOrgFunc                 func(ctx context.Context, orgID int64) ([]genDb.ListWorkspaceNamesForOrgRow, error)
+	ListWorkspaceNotificationDefaultsFunc        func(ctx context.Context, workspaceID int64) ([]genDb.WorkspaceNotificationDefault, error)
+	ListWorkspacePoliciesFunc                    func(ctx context.Context, id int64) ([]genDb.Policy, error)
+	ListWorkspaceTopResourcesFunc                func(ctx context.Context, arg genDb.ListWorkspaceTopResourcesParams) ([]genDb.ListWorkspaceTopResourcesRow, error)
+	ListWorkspacesForOrgFunc                     func(ctx context.Context, arg genDb.ListWorkspacesForOrgParams) ([]genDb.ListWorkspacesForOrgRow, error)
+	ListWorkspacesForUserFunc                    func(ctx context.Context, arg genDb.ListWorkspacesForUserParams) ([]genDb.Workspace, error)
+	ListWorkspacesInOrgFunc                      func(ctx context.Context, arg genDb.ListWorkspacesInOrgParams) ([]genDb.Workspace, error)
+	ListWorkspacesWithoutDigestFunc              func(ctx context.Context, arg genDb.ListWorkspacesWithoutDigestParams) ([]genDb.ListWorkspacesWithoutDigestRow, error)
+	LockResourceFunc                             func(ctx context.Context, arg genDb.LockResourceParams) (genDb.ResourceLock, error)
+	LockResourceDeploymentsFunc                  func(ctx context.Context, resourceID int64) error
+	LockResourceDomainsFunc                      func(ctx context.Context, id int64) error
+	MarkAllNotificationsReadFunc                 func(ctx context.Context, arg genDb.MarkAllNotificationsReadParams) error
+	MarkApprovalRequestExecutedFunc              func(ctx context.Context, arg genDb.MarkApprovalRequestExecutedParams) (genDb.ApprovalRequest, error)
+	MarkDeploymentNotActiveFunc                  func(ctx context.Context, id int64) error
+	MarkNotificationFailedFunc                   func(ctx context.Context, arg genDb.MarkNotificationFailedParams) error
+	MarkNotificationReadFunc                     func(ctx context.Context, arg genDb.MarkNotificationReadParams) (int64, error)
+	MarkNotificationSentFunc                     func(ctx context.Context, id int64) error
+	MarkPreviousDeploymentsNotActiveFunc         func(ctx context.Context, resourceID int64) error
+	MarkResourceProbeDegradedFunc                func(ctx context.Context, id int64) (int64, error)
+	MarkSessionMFAVerifiedFunc                   func(ctx context.Context, accessToken pgtype.Text) error
+	MarkUsageReportedFunc                        func(ctx context.Context, id int64) error
+	MarkUserPasswordVerifiedFunc                 func(ctx context.Context, userID int64) error
+	OrgHasWorkspacesWithResourcesFunc            func(ctx context.Context, orgID int64) (bool, error)
+	PruneDeploymentHistoryFunc                   func(ctx context.Context) (int64, error)
+	RecordConfigRolloutDeploymentFunc            func(ctx context.Context, arg genDb.RecordConfigRolloutDeploymentParams) error
+	RecordConfigRolloutProgressFunc              func(ctx context.Context, id int64) error
+	RecordDeprecatedUsageFunc                    func(ctx context.Context, arg genDb.RecordDeprecatedUsageParams) error
+	RecordOrgDeletionProgressFunc                func(ctx context.Context, id int64) error
+	RecordResourceProbeFunc                      func(ctx context.Context, arg genDb.RecordResourceProbeParams) (int32, error)
+	RecordUsageFunc                              func(ctx context.Context, arg genDb.RecordUsageParams) (int64, error)
+	RemoveAllScimGroupMembersFunc                func(ctx context.Context, groupID int64) error
+	RemoveAllScopesForEntityFunc                 func(ctx context.Context, arg genDb.RemoveAllScopesForEntityParams) error
+	RemoveAllScopesForUserOnEntityFunc           func(ctx context.Context, arg genDb.RemoveAllScopesForUserOnEntityParams) error
+	RemoveAllServiceAccountScopesFunc            func(ctx context.Context, serviceAccountID int64) error
+	RemoveOrganizationMemberFunc                 func(ctx context.Context, arg genDb.RemoveOrganizationMemberParams) error
+	RemoveScimGroupMemberFunc                    func(ctx context.Context, arg genDb.RemoveScimGroupMemberParams) error
+	RemoveUserScopeFunc                          func(ctx context.Context, arg genDb.RemoveUserScopeParams) error
+	RemoveWorkspaceFunc                          func(ctx context.Context, id int64) error
+	RemoveWorkspaceMemberFunc                    func(ctx context.Context, arg genDb.RemoveWorkspaceMemberParams) error
+	ReopenApprovalRequestFunc                    func(ctx context.Context, id int64) error
+	ResumeResourceFunc                           func(ctx context.Context, id int64) (genDb.Resource, error)
+	ResumeWorkspaceResourcesFromArchiveFunc      func(ctx context.Context, workspaceID int64) ([]genDb.Resource, error)
+	SetDNSRecordErrorFunc                        func(ctx context.Context, arg genDb.SetDNSRecordErrorParams) error
+	SetDeploymentDriftFunc                       func(ctx context.Context, arg genDb.SetDeploymentDriftParams) error
+	SetOrgDeletionProtectionFunc                 func(ctx context.Context, arg genDb.SetOrgDeletionProtectionParams) (genDb.Organization, error)
+	SetOrgDelinquentFunc                         func(ctx context.Context, arg genDb.SetOrgDelinquentParams) (int64, error)
+	SetOrgRequireMFAFunc                         func(ctx context.Context, arg genDb.SetOrgRequireMFAParams) (genDb.Organization, error)
+	SetOrgSubscriptionFunc                       func(ctx context.Context, arg genDb.SetOrgSubscriptionParams) (genDb.OrgBilling, error)
+	SetPlatformDomainActiveFunc                  func(ctx context.Context, arg genDb.SetPlatformDomainActiveParams) (genDb.PlatformDomain, error)
+	SetResourceDomainAccessControlFunc           func(ctx context.Context, arg genDb.SetResourceDomainAccessControlParams) (genDb.ResourceDomain, error)
+	SetResourceDomainPrimaryFunc                 func(ctx context.Context, arg genDb.SetResourceDomainPrimaryParams) (int64, error)
+	SetResourceDomainRedirectFunc                func(ctx context.Context, arg genDb.SetResourceDomainRedirectParams) (genDb.ResourceDomain, error)
+	SetResourceDomainStatusFunc                  func(ctx context.Context, arg genDb.SetResourceDomainStatusParams) error
+	SetResourceDomainTLSPolicyFunc               func(ctx context.Context, arg genDb.SetResourceDomainTLSPolicyParams) (genDb.ResourceDomain, error)
+	SetScimUserGrantedScopesFunc                 func(ctx context.Context, arg genDb.SetScimUserGrantedScopesParams) error
+	SetUserPasswordHashFunc                      func(ctx context.Context, arg genDb.SetUserPasswordHashParams) error
+	SleepResourceFunc                            func(ctx context.Context, resourceID int64) (int64, error)
+	StoreTokenFunc                               func(ctx context.Context, arg genDb.StoreTokenParams) error
+	SuspendResourceFunc                          func(ctx context.Context, arg genDb.SuspendResourceParams) (genDb.Resource, error)
+	SuspendWorkspaceResourcesForArchiveFunc      func(ctx context.Context, arg genDb.SuspendWorkspaceResourcesForArchiveParams) ([]genDb.Resource, error)
+	SyncOrgSubscriptionFunc                      func(ctx context.Context, arg genDb.SyncOrgSubscriptionParams) (int64, error)
+	SyncProbeTargetsFunc                         func(ctx context.Context) error
+	TransferCreatedOrganizationsFunc             func(ctx context.Context, userID int64) error
+	TransferCreatedWorkspacesFunc                func(ctx context.Context, createdBy int64) error
+	UnarchiveWorkspaceFunc                       func(ctx context.Context, id int64) (genDb.Workspace, error)
+	UnlockResourceFunc                           func(ctx context.Context, resourceID int64) error
+	UpdateActiveDeploymentStatusFunc             func(ctx context.Context, arg genDb.UpdateActiveDeploymentStatusParams) error
+	UpdateAlertRuleFunc                          func(ctx context.Context, arg genDb.UpdateAlertRuleParams) (genDb.AlertRule, error)
+	UpdateClusterFunc                            func(ctx context.Context, arg genDb.UpdateClusterParams) (genDb.Cluster, error)
+	UpdateConfigGroupFunc                        func(ctx context.Context, arg genDb.UpdateConfigGroupParams) (genDb.ConfigGroup, error)
+	UpdateDeploymentStatusFunc                   func(ctx context.Context, arg genDb.UpdateDeploymentStatusParams) error
+	UpdateDeploymentStatusAndActiveFunc          func(ctx context.Context, arg genDb.UpdateDeploymentStatusAndActiveParams) error
+	UpdateDeploymentStatusWithMessageFunc        func(ctx context.Context, arg genDb.UpdateDeploymentStatusWithMessageParams) error
+	UpdateEnvironmentFunc                        func(ctx context.Context, arg genDb.UpdateEnvironmentParams) (genDb.Environment, error)
+	UpdateOrgNameFunc                            func(ctx context.Context, arg genDb.UpdateOrgNameParams) (genDb.Organization, error)
+	UpdatePolicyFunc                             func(ctx context.Context, arg genDb.UpdatePolicyParams) (genDb.Policy, error)
+	UpdateResourceFunc                           func(ctx context.Context, arg genDb.UpdateResourceParams) (int64, error)
+	UpdateResourceDomainFunc                     func(ctx context.Context, arg genDb.UpdateResourceDomainParams) (int64, error)
+	UpdateResourceDomainPrimaryFunc              func(ctx context.Context, resourceID int64) error
+	UpdateResourceRegionFailoverFunc             func(ctx context.Context, arg genDb.UpdateResourceRegionFailoverParams) error
+	UpdateResourceSpecFunc                       func(ctx context.Context, arg genDb.UpdateResourceSpecParams) error
+	UpdateResourceStatusFunc                     func(ctx context.Context, arg genDb.UpdateResourceStatusParams) error
+	UpdateScimGroupFunc                          func(ctx context.Context, arg genDb.UpdateScimGroupParams) (genDb.ScimGroup, error)
+	UpdateScimUserFunc                           func(ctx context.Context, arg genDb.UpdateScimUserParams) (genDb.ScimUser, error)
+	UpdateUserAvatarURLFunc                      func(ctx context.Context, arg genDb.UpdateUserAvatarURLParams) (genDb.User, error)
+	UpdateWorkspaceFunc                          func(ctx context.Context, arg genDb.UpdateWorkspaceParams) (int64, error)
+	UpsertAlertStateFunc                         func(ctx context.Context, arg genDb.UpsertAlertStateParams) error
+	UpsertAnnouncementReadMarkerFunc             func(ctx context.Context, arg genDb.UpsertAnnouncementReadMarkerParams) error
+	UpsertClusterDiscrepancyFunc                 func(ctx context.Context, arg genDb.UpsertClusterDiscrepancyParams) error
+	UpsertDNSRecordFunc                          func(ctx context.Context, arg genDb.UpsertDNSRecordParams) error
+	UpsertDeploymentProvenanceFunc               func(ctx context.Context, arg genDb.UpsertDeploymentProvenanceParams) error
+	UpsertDirectoryGroupRolesFunc                func(ctx context.Context, arg genDb.UpsertDirectoryGroupRolesParams) error
+	UpsertGitOpsApplicationFunc                  func(ctx context.Context, arg genDb.UpsertGitOpsApplicationParams) error
+	UpsertImageScanFunc                          func(ctx context.Context, arg genDb.UpsertImageScanParams) (genDb.ImageScan, error)
+	UpsertSlackInstallationFunc                  func(ctx context.Context, arg genDb.UpsertSlackInstallationParams) error
+	UpsertStatusPageFunc                         func(ctx context.Context, arg genDb.UpsertStatusPageParams) (genDb.StatusPage, error)
+	UpsertUserNotificationPreferenceFunc         func(ctx context.Context, arg genDb.UpsertUserNotificationPreferenceParams) error
+	UpsertUserTOTPFunc                           func(ctx context.Context, arg genDb.UpsertUserTOTPParams) error
+	UpsertWorkspaceEnvFunc                       func(ctx context.Context, arg genDb.UpsertWorkspaceEnvParams) (genDb.WorkspaceEnv, error)
+	UpsertWorkspaceMemberFunc                    func(ctx context.Context, arg genDb.UpsertWorkspaceMemberParams) (int64, error)
+	UpsertWorkspaceNotificationDefaultFunc       func(ctx context.Context, arg genDb.UpsertWorkspaceNotificationDefaultParams) error
+	UpsertWorkspaceSlackChannelFunc              func(ctx context.Context, arg genDb.UpsertWorkspaceSlackChannelParams) (genDb.WorkspaceSlackChannel, error)
+	UseRefreshTokenFunc                          func(ctx context.Context, tokenHash string) (genDb.UseRefreshTokenRow, error)
+	UseUserTOTPStepFunc                          func(ctx context.Context, arg genDb.UseUserTOTPStepParams) (int64, error)
+	UserRequiresMFAFunc                          func(ctx context.Context, userID int64) (bool, error)
+	WakeResourceFunc                             func(ctx context.Context, resourceID int64) (int64, error)
+	WorkspaceHasResourcesWithLabelsFunc          func(ctx context.Context, arg genDb.WorkspaceHasResourcesWithLabelsParams) (bool, error)
+}
+
+func (q *Querier) AddOrgMember(ctx context.Context, arg genDb.AddOrgMemberParams) error {
+	q.calls.record("AddOrgMember")
+	if q.AddOrgMemberFunc == nil {
+		return notStubbed("AddOrgMember")
+	}
+	return q.AddOrgMemberFunc(ctx, arg)
+}
+
+func (q *Querier) AddOrganizationMember(ctx context.Context, arg genDb.AddOrganizationMemberParams) (genDb.AddOrganizationMemberRow, error) {
+	q.calls.record("AddOrganizationMember")
+	if q.AddOrganizationMemberFunc == nil {
+		var zero genDb.AddOrganizationMemberRow
+		return zero, notStubbed("AddOrganizationMember")
+	}
+	return q.AddOrganizationMemberFunc(ctx, arg)
+}
+
+func (q *Querier) AddScimGroupMember(ctx context.Context, arg genDb.AddScimGroupMemberParams) error {
+	q.calls.record("AddScimGroupMember")
+	if q.AddScimGroupMemberFunc == nil {
+		return notStubbed("AddScimGroupMember")
+	}
+	return q.AddScimGroupMemberFunc(ctx, arg)
+}
+
+func (q *Querier) AddServiceAccountScope(ctx context.Context, arg genDb.AddServiceAccountScopeParams) error {
+	q.calls.record("AddServiceAccountScope")
+	if q.AddServiceAccountScopeFunc == nil {
+		return notStubbed("AddServiceAccountScope")
+	}
+	return q.AddServiceAccountScopeFunc(ctx, arg)
+}
+
+func (q *Querier) AddUserScope(ctx context.Context, arg genDb.AddUserScopeParams) error {
+	q.calls.record("AddUserScope")
+	if q.AddUserScopeFunc == nil {
+		return notStubbed("AddUserScope")
+	}
+	return q.AddUserScopeFunc(ctx, arg)
+}
+
+func (q *Querier) AddWorkspaceMember(ctx context.Context, arg genDb.AddWorkspaceMemberParams) (genDb.AddWorkspaceMemberRow, error) {
+	q.calls.record("AddWorkspaceMember")
+	if q.AddWorkspaceMemberFunc == nil {
+		var zero genDb.AddWorkspaceMemberRow
+		return zero, notStubbed("AddWorkspaceMember")
+	}
+	return q.AddWorkspaceMemberFunc(ctx, arg)
+}
+
+func (q *Querier) ArchiveWorkspace(ctx context.Context, id int64) (genDb.Workspace, error) {
+	q.calls.record("ArchiveWorkspace")
+	if q.ArchiveWorkspaceFunc == nil {
+		var zero genDb.Workspace
+		return zero, notStubbed("ArchiveWorkspace")
+	}
+	return q.ArchiveWorkspaceFunc(ctx, id)
+}
+
+func (q *Querier) AttachConfigGroup(ctx context.Context, arg genDb.AttachConfigGroupParams) error {
+	q.calls.record("AttachConfigGroup")
+	if q.AttachConfigGroupFunc == nil {
+		return notStubbed("AttachConfigGroup")
+	}
+	return q.AttachConfigGroupFunc(ctx, arg)
+}
+
+func (q *Querier) CancelApprovalRequest(ctx context.Context, id int64) (genDb.ApprovalRequest, error) {
+	q.calls.record("CancelApprovalRequest")
+	if q.CancelApprovalRequestFunc == nil {
+		var zero genDb.ApprovalRequest
+		return zero, notStubbed("CancelApprovalRequest")
+	}
+	return q.CancelApprovalRequestFunc(ctx, id)
+}
+
+func (q *Querier) CheckDomainAvailability(ctx context.Context, domain string) (bool, error) {
+	q.calls.record("CheckDomainAvailability")
+	if q.CheckDomainAvailabilityFunc == nil {
+		var zero bool
+		return zero, notStubbed("CheckDomainAvailability")
+	}
+	return q.CheckDomainAvailabilityFunc(ctx, domain)
+}
+
+func (q *Querier) CheckUserHasOrganizations(ctx context.Context, createdBy int64) (bool, error) {
+	q.calls.record("CheckUserHasOrganizations")
+	if q.CheckUserHasOrganizationsFunc == nil {
+		var zero bool
+		return zero, notStubbed("CheckUserHasOrganizations")
+	}
+	return q.CheckUserHasOrganizationsFunc(ctx, createdBy)
+}
+
+func (q *Querier) CheckUserHasWorkspaces(ctx context.Context, userID int64) (bool, error) {
+	q.calls.record("CheckUserHasWorkspaces")
+	if q.CheckUserHasWorkspacesFunc == nil {
+		var zero bool
+		return zero, notStubbed("CheckUserHasWorkspaces")
+	}
+	return q.CheckUserHasWorkspacesFunc(ctx, userID)
+}
+
+func (q *Querier) ClaimAlertRules(ctx context.Context, arg genDb.ClaimAlertRulesParams) ([]genDb.AlertRule, error) {
+	q.calls.record("ClaimAlertRules")
+	if q.ClaimAlertRulesFunc == nil {
+		var zero []genDb.AlertRule
+		return zero, notStubbed("ClaimAlertRules")
+	}
+	return q.ClaimAlertRulesFunc(ctx, arg)
+}
+
+func (q *Querier) ClaimConfigRollout(ctx context.Context, staleBefore pgtype.Timestamptz) (genDb.ConfigRollout, error) {
+	q.calls.record("ClaimConfigRollout")
+	if q.ClaimConfigRolloutFunc == nil {
+		var zero genDb.ConfigRollout
+		return zero, notStubbed("ClaimConfigRollout")
+	}
+	return q.ClaimConfigRolloutFunc(ctx, staleBefore)
+}
+
+func (q *Querier) ClaimNotificationDeliveries(ctx context.Context, arg genDb.ClaimNotificationDeliveriesParams) ([]genDb.NotificationOutbox, error) {
+	q.calls.record("ClaimNotificationDeliveries")
+	if q.ClaimNotificationDeliveriesFunc == nil {
+		var zero []genDb.NotificationOutbox
+		return zero, notStubbed("ClaimNotificationDeliveries")
+	}
+	return q.ClaimNotificationDeliveriesFunc(ctx, arg)
+}
+
+func (q *Querier) ClaimOrgDeletion(ctx context.Context, staleBefore pgtype.Timestamptz) (genDb.OrgDeletion, error) {
+	q.calls.record("ClaimOrgDeletion")
+	if q.ClaimOrgDeletionFunc == nil {
+		var zero genDb.OrgDeletion
+		return zero, notStubbed("ClaimOrgDeletion")
+	}
+	return q.ClaimOrgDeletionFunc(ctx, staleBefore)
+}
+
+func (q *Querier) ClaimProbeTargets(ctx context.Context, arg genDb.ClaimProbeTargetsParams) ([]genDb.ClaimProbeTargetsRow, error) {
+	q.calls.record("ClaimProbeTargets")
+	if q.ClaimProbeTargetsFunc == nil {
+		var zero []genDb.ClaimProbeTargetsRow
+		return zero, notStubbed("ClaimProbeTargets")
+	}
+	return q.ClaimProbeTargetsFunc(ctx, arg)
+}
+
+func (q *Querier) ClearDeploymentDrift(ctx context.Context, id int64) error {
+	q.calls.record("ClearDeploymentDrift")
+	if q.ClearDeploymentDriftFunc == nil {
+		return notStubbed("ClearDeploymentDrift")
+	}
+	return q.ClearDeploymentDriftFunc(ctx, id)
+}
+
+func (q *Querier) ClearPrimaryResourceRegion(ctx context.Context, resourceID int64) error {
+	q.calls.record("ClearPrimaryResourceRegion")
+	if q.ClearPrimaryResourceRegionFunc == nil {
+		return notStubbed("ClearPrimaryResourceRegion")
+	}
+	return q.ClearPrimaryResourceRegionFunc(ctx, resourceID)
+}
+
+func (q *Querier) ClearResourceProbeDegraded(ctx context.Context, id int64) (int64, error) {
+	q.calls.record("ClearResourceProbeDegraded")
+	if q.ClearResourceProbeDegradedFunc == nil {
+		var zero int64
+		return zero, notStubbed("ClearResourceProbeDegraded")
+	}
+	return q.ClearResourceProbeDegradedFunc(ctx, id)
+}
+
+func (q *Querier) CompleteConfigRollout(ctx context.Context, id int64) error {
+	q.calls.record("CompleteConfigRollout")
+	if q.CompleteConfigRolloutFunc == nil {
+		return notStubbed("CompleteConfigRollout")
+	}
+	return q.CompleteConfigRolloutFunc(ctx, id)
+}
+
+func (q *Querier) CompleteOrgDeletion(ctx context.Context, id int64) error {
+	q.calls.record("CompleteOrgDeletion")
+	if q.CompleteOrgDeletionFunc == nil {
+		return notStubbed("CompleteOrgDeletion")
+	}
+	return q.CompleteOrgDeletionFunc(ctx, id)
+}
+
+func (q *Querier) ConsumeEmailToken(ctx context.Context, arg genDb.ConsumeEmailTokenParams) (int64, error) {
+	q.calls.record("ConsumeEmailToken")
+	if q.ConsumeEmailTokenFunc == nil {
+		var zero int64
+		return zero, notStubbed("ConsumeEmailToken")
+	}
+	return q.ConsumeEmailTokenFunc(ctx, arg)
+}
+
+func (q *Querier) CountEnvironmentResources(ctx context.Context, environmentID int64) (int64, error) {
+	q.calls.record("CountEnvironmentResources")
+	if q.CountEnvironmentResourcesFunc == nil {
+		var zero int64
+		return zero, notStubbed("CountEnvironmentResources")
+	}
+	return q.CountEnvironmentResourcesFunc(ctx, environmentID)
+}
+
+func (q *Querier) CountScimGroups(ctx context.Context, arg genDb.CountScimGroupsParams) (int64, error) {
+	q.calls.record("CountScimGroups")
+	if q.CountScimGroupsFunc == nil {
+		var zero int64
+		return zero, notStubbed("CountScimGroups")
+	}
+	return q.CountScimGroupsFunc(ctx, arg)
+}
+
+func (q *Querier) CountScimUsers(ctx context.Context, arg genDb.CountScimUsersParams) (int64, error) {
+	q.calls.record("CountScimUsers")
+	if q.CountScimUsersFunc == nil {
+		var zero int64
+		return zero, notStubbed("CountScimUsers")
+	}
+	return q.CountScimUsersFunc(ctx, arg)
+}
+
+func (q *Querier) CountUnreadAnnouncements(ctx context.Context, afterID int64) (int64, error) {
+	q.calls.record("CountUnreadAnnouncements")
+	if q.CountUnreadAnnouncementsFunc == nil {
+		var zero int64
+		return zero, notStubbed("CountUnreadAnnouncements")
+	}
+	return q.CountUnreadAnnouncementsFunc(ctx, afterID)
+}
+
+func (q *Querier) CountUnreadNotifications(ctx context.Context, userID int64) (int64, error) {
+	q.calls.record("CountUnreadNotifications")
+	if q.CountUnreadNotificationsFunc == nil {
+		var zero int64
+		return zero, notStubbed("CountUnreadNotifications")
+	}
+	return q.CountUnreadNotificationsFunc(ctx, userID)
+}
+
+func (q *Querier) CreateAlertRule(ctx context.Context, arg genDb.CreateAlertRuleParams) (genDb.AlertRule, error) {
+	q.calls.record("CreateAlertRule")
+	if q.CreateAlertRuleFunc == nil {
+		var zero genDb.AlertRule
+		return zero, notStubbed("CreateAlertRule")
+	}
+	return q.CreateAlertRuleFunc(ctx, arg)
+}
+
+func (q *Querier) CreateAlertTarget(ctx context.Context, arg genDb.CreateAlertTargetParams) error {
+	q.calls.record("CreateAlertTarget")
+	if q.CreateAlertTargetFunc == nil {
+		return notStubbed("CreateAlertTarget")
+	}
+	return q.CreateAlertTargetFunc(ctx, arg)
+}
+
+func (q *Querier) CreateAnnouncement(ctx context.Context, arg genDb.CreateAnnouncementParams) (int64, error) {
+	q.calls.record("CreateAnnouncement")
+	if q.CreateAnnouncementFunc == nil {
+		var zero int64
+		return zero, notStubbed("CreateAnnouncement")
+	}
+	return q.CreateAnnouncementFunc(ctx, arg)
+}
+
+func (q *Querier) CreateApprovalRequest(ctx context.Context, arg genDb.CreateApprovalRequestParams) (genDb.ApprovalRequest, error) {
+	q.calls.record("CreateApprovalRequest")
+	if q.CreateApprovalRequestFunc == nil {
+		var zero genDb.ApprovalRequest
+		return zero, notStubbed("CreateApprovalRequest")
+	}
+	return q.CreateApprovalRequestFunc(ctx, arg)
+}
+
+func (q *Querier) CreateCluster(ctx context.Context, arg genDb.CreateClusterParams) (genDb.Cluster, error) {
+	q.calls.record("CreateCluster")
+	if q.CreateClusterFunc == nil {
+		var zero genDb.Cluster
+		return zero, notStubbed("CreateCluster")
+	}
+	return q.CreateClusterFunc(ctx, arg)
+}
+
+func (q *Querier) CreateClusterNodePool(ctx context.Context, arg genDb.CreateClusterNodePoolParams) (genDb.ClusterNodePool, error) {
+	q.calls.record("CreateClusterNodePool")
+	if q.CreateClusterNodePoolFunc == nil {
+		var zero genDb.ClusterNodePool
+		return zero, notStubbed("CreateClusterNodePool")
+	}
+	return q.CreateClusterNodePoolFunc(ctx, arg)
+}
+
+func (q *Querier) CreateConfigGroup(ctx context.Context, arg genDb.CreateConfigGroupParams) (genDb.ConfigGroup, error) {
+	q.calls.record("CreateConfigGroup")
+	if q.CreateConfigGroupFunc == nil {
+		var zero genDb.ConfigGroup
+		return zero, notStubbed("CreateConfigGroup")
+	}
+	return q.CreateConfigGroupFunc(ctx, arg)
+}
+
+func (q *Querier) CreateConfigRollout(ctx context.Context, arg genDb.CreateConfigRolloutParams) (genDb.ConfigRollout, error) {
+	q.calls.record("CreateConfigRollout")
+	if q.CreateConfigRolloutFunc == nil {
+		var zero genDb.ConfigRollout
+		return zero, notStubbed("CreateConfigRollout")
+	}
+	return q.CreateConfigRolloutFunc(ctx, arg)
+}
+
+func (q *Querier) CreateDeployment(ctx context.Context, arg genDb.CreateDeploymentParams) (int64, error) {
+	q.calls.record("CreateDeployment")
+	if q.CreateDeploymentFunc == nil {
+		var zero int64
+		return zero, notStubbed("CreateDeployment")
+	}
+	return q.CreateDeploymentFunc(ctx, arg)
+}
+
+func (q *Querier) CreateEmailToken(ctx context.Context, arg genDb.CreateEmailTokenParams) error {
+	q.calls.record("CreateEmailToken")
+	if q.CreateEmailTokenFunc == nil {
+		return notStubbed("CreateEmailToken")
+	}
+	return q.CreateEmailTokenFunc(ctx, arg)
+}
+
+func (q *Querier) CreateEnvironment(ctx context.Context, arg genDb.CreateEnvironmentParams) (genDb.Environment, error) {
+	q.calls.record("CreateEnvironment")
+	if q.CreateEnvironmentFunc == nil {
+		var zero genDb.Environment
+		return zero, notStubbed("CreateEnvironment")
+	}
+	return q.CreateEnvironmentFunc(ctx, arg)
+}
+
+func (q *Querier) CreateInboxNotification(ctx context.Context, arg genDb.CreateInboxNotificationParams) error {
+	q.calls.record("CreateInboxNotification")
+	if q.CreateInboxNotificationFunc == nil {
+		return notStubbed("CreateInboxNotification")
+	}
+	return q.CreateInboxNotificationFunc(ctx, arg)
+}
+
+func (q *Querier) CreateOrg(ctx context.Context, arg genDb.CreateOrgParams) (genDb.Organization, error) {
+	q.calls.record("CreateOrg")
+	if q.CreateOrgFunc == nil {
+		var zero genDb.Organization
+		return zero, notStubbed("CreateOrg")
+	}
+	return q.CreateOrgFunc(ctx, arg)
+}
+
+func (q *Querier) CreateOrgBilling(ctx context.Context, arg genDb.CreateOrgBillingParams) (genDb.OrgBilling, error) {
+	q.calls.record("CreateOrgBilling")
+	if q.CreateOrgBillingFunc == nil {
+		var zero genDb.OrgBilling
+		return zero, notStubbed("CreateOrgBilling")
+	}
+	return q.CreateOrgBillingFunc(ctx, arg)
+}
+
+func (q *Querier) CreateOrgDeletion(ctx context.Context, arg genDb.CreateOrgDeletionParams) (genDb.OrgDeletion, error) {
+	q.calls.record("CreateOrgDeletion")
+	if q.CreateOrgDeletionFunc == nil {
+		var zero genDb.OrgDeletion
+		return zero, notStubbed("CreateOrgDeletion")
+	}
+	return q.CreateOrgDeletionFunc(ctx, arg)
+}
+
+func (q *Querier) CreateOrganization(ctx context.Context, arg genDb.CreateOrganizationParams) (genDb.CreateOrganizationRow, error) {
+	q.calls.record("CreateOrganization")
+	if q.CreateOrganizationFunc == nil {
+		var zero genDb.CreateOrganizationRow
+		return zero, notStubbed("CreateOrganization")
+	}
+	return q.CreateOrganizationFunc(ctx, arg)
+}
+
+func (q *Querier) CreatePlatformDomain(ctx context.Context, arg genDb.CreatePlatformDomainParams) (int64, error) {
+	q.calls.record("CreatePlatformDomain")
+	if q.CreatePlatformDomainFunc == nil {
+		var zero int64
+		return zero, notStubbed("CreatePlatformDomain")
+	}
+	return q.CreatePlatformDomainFunc(ctx, arg)
+}
+
+func (q *Querier) CreatePolicy(ctx context.Context, arg genDb.CreatePolicyParams) (genDb.Policy, error) {
+	q.calls.record("CreatePolicy")
+	if q.CreatePolicyFunc == nil {
+		var zero genDb.Policy
+		return zero, notStubbed("CreatePolicy")
+	}
+	return q.CreatePolicyFunc(ctx, arg)
+}
+
+func (q *Querier) CreateRefreshToken(ctx context.Context, arg genDb.CreateRefreshTokenParams) error {
+	q.calls.record("CreateRefreshToken")
+	if q.CreateRefreshTokenFunc == nil {
+		return notStubbed("CreateRefreshToken")
+	}
+	return q.CreateRefreshTokenFunc(ctx, arg)
+}
+
+func (q *Querier) CreateResource(ctx context.Context, arg genDb.CreateResourceParams) (int64, error) {
+	q.calls.record("CreateResource")
+	if q.CreateResourceFunc == nil {
+		var zero int64
+		return zero, notStubbed("CreateResource")
+	}
+	return q.CreateResourceFunc(ctx, arg)
+}
+
+func (q *Querier) CreateResourceActivity(ctx context.Context, arg genDb.CreateResourceActivityParams) error {
+	q.calls.record("CreateResourceActivity")
+	if q.CreateResourceActivityFunc == nil {
+		return notStubbed("CreateResourceActivity")
+	}
+	return q.CreateResourceActivityFunc(ctx, arg)
+}
+
+func (q *Querier) CreateResourceDomain(ctx context.Context, arg genDb.CreateResourceDomainParams) (int64, error) {
+	q.calls.record("CreateResourceDomain")
+	if q.CreateResourceDomainFunc == nil {
+		var zero int64
+		return zero, notStubbed("CreateResourceDomain")
+	}
+	return q.CreateResourceDomainFunc(ctx, arg)
+}
+
+func (q *Querier) CreateResourceRegion(ctx context.Context, arg genDb.CreateResourceRegionParams) (genDb.ResourceRegion, error) {
+	q.calls.record("CreateResourceRegion")
+	if q.CreateResourceRegionFunc == nil {
+		var zero genDb.ResourceRegion
+		return zero, notStubbed("CreateResourceRegion")
+	}
+	return q.CreateResourceRegionFunc(ctx, arg)
+}
+
+func (q *Querier) CreateScimGroup(ctx context.Context, arg genDb.CreateScimGroupParams) (genDb.ScimGroup, error) {
+	q.calls.record("CreateScimGroup")
+	if q.CreateScimGroupFunc == nil {
+		var zero genDb.ScimGroup
+		return zero, notStubbed("CreateScimGroup")
+	}
+	return q.CreateScimGroupFunc(ctx, arg)
+}
+
+func (q *Querier) CreateScimUser(ctx context.Context, arg genDb.CreateScimUserParams) (genDb.ScimUser, error) {
+	q.calls.record("CreateScimUser")
+	if q.CreateScimUserFunc == nil {
+		var zero genDb.ScimUser
+		return zero, notStubbed("CreateScimUser")
+	}
+	return q.CreateScimUserFunc(ctx, arg)
+}
+
+func (q *Querier) CreateServiceAccount(ctx context.Context, arg genDb.CreateServiceAccountParams) (genDb.ServiceAccount, error) {
+	q.calls.record("CreateServiceAccount")
+	if q.CreateServiceAccountFunc == nil {
+		var zero genDb.ServiceAccount
+		return zero, notStubbed("CreateServiceAccount")
+	}
+	return q.CreateServiceAccountFunc(ctx, arg)
+}
+
+func (q *Querier) CreateUser(ctx context.Context, arg genDb.CreateUserParams) (genDb.User, error) {
+	q.calls.record("CreateUser")
+	if q.CreateUserFunc == nil {
+		var zero genDb.User
+		return zero, notStubbed("CreateUser")
+	}
+	return q.CreateUserFunc(ctx, arg)
+}
+
+func (q *Querier) CreateUserPassword(ctx context.Context, arg genDb.CreateUserPasswordParams) error {
+	q.calls.record("CreateUserPassword")
+	if q.CreateUserPasswordFunc == nil {
+		return notStubbed("CreateUserPassword")
+	}
+	return q.CreateUserPasswordFunc(ctx, arg)
+}
+
+func (q *Querier) CreateWorkspace(ctx context.Context, arg genDb.CreateWorkspaceParams) (int64, error) {
+	q.calls.record("CreateWorkspace")
+	if q.CreateWorkspaceFunc == nil {
+		var zero int64
+		return zero, notStubbed("CreateWorkspace")
+	}
+	return q.CreateWorkspaceFunc(ctx, arg)
+}
+
+func (q *Querier) CreateWorkspaceDigest(ctx context.Context, arg genDb.CreateWorkspaceDigestParams) (int64, error) {
+	q.calls.record("CreateWorkspaceDigest")
+	if q.CreateWorkspaceDigestFunc == nil {
+		var zero int64
+		return zero, notStubbed("CreateWorkspaceDigest")
+	}
+	return q.CreateWorkspaceDigestFunc(ctx, arg)
+}
+
+func (q *Querier) DeactivatePlatformDomain(ctx context.Context, id int64) (int64, error) {
+	q.calls.record("DeactivatePlatformDomain")
+	if q.DeactivatePlatformDomainFunc == nil {
+		var zero int64
+		return zero, notStubbed("DeactivatePlatformDomain")
+	}
+	return q.DeactivatePlatformDomainFunc(ctx, id)
+}
+
+func (q *Querier) DeleteAlertRule(ctx context.Context, id int64) (int64, error) {
+	q.calls.record("DeleteAlertRule")
+	if q.DeleteAlertRuleFunc == nil {
+		var zero int64
+		return zero, notStubbed("DeleteAlertRule")
+	}
+	return q.DeleteAlertRuleFunc(ctx, id)
+}
+
+func (q *Querier) DeleteAlertTargets(ctx context.Context, ruleID int64) error {
+	q.calls.record("DeleteAlertTargets")
+	if q.DeleteAlertTargetsFunc == nil {
+		return notStubbed("DeleteAlertTargets")
+	}
+	return q.DeleteAlertTargetsFunc(ctx, ruleID)
+}
+
+func (q *Querier) DeleteAnnouncement(ctx context.Context, id int64) (int64, error) {
+	q.calls.record("DeleteAnnouncement")
+	if q.DeleteAnnouncementFunc == nil {
+		var zero int64
+		return zero, notStubbed("DeleteAnnouncement")
+	}
+	return q.DeleteAnnouncementFunc(ctx, id)
+}
+
+func (q *Querier) DeleteClusterNodePools(ctx context.Context, clusterID int64) error {
+	q.calls.record("DeleteClusterNodePools")
+	if q.DeleteClusterNodePoolsFunc == nil {
+		return notStubbed("DeleteClusterNodePools")
+	}
+	return q.DeleteClusterNodePoolsFunc(ctx, clusterID)
+}
+
+func (q *Querier) DeleteConfigGroup(ctx context.Context, id int64) error {
+	q.calls.record("DeleteConfigGroup")
+	if q.DeleteConfigGroupFunc == nil {
+		return notStubbed("DeleteConfigGroup")
+	}
+	return q.DeleteConfigGroupFunc(ctx, id)
+}
+
+func (q *Querier) DeleteDNSRecord(ctx context.Context, id int64) error {
+	q.calls.record("DeleteDNSRecord")
+	if q.DeleteDNSRecordFunc == nil {
+		return notStubbed("DeleteDNSRecord")
+	}
+	return q.DeleteDNSRecordFunc(ctx, id)
+}
+
+func (q *Querier) DeleteDirectoryGroupRoles(ctx context.Context, arg genDb.DeleteDirectoryGroupRolesParams) error {
+	q.calls.record("DeleteDirectoryGroupRoles")
+	if q.DeleteDirectoryGroupRolesFunc == nil {
+		return notStubbed("DeleteDirectoryGroupRoles")
+	}
+	return q.DeleteDirectoryGroupRolesFunc(ctx, arg)
+}
+
+func (q *Querier) DeleteEmailTokens(ctx context.Context, arg genDb.DeleteEmailTokensParams) error {
+	q.calls.record("DeleteEmailTokens")
+	if q.DeleteEmailTokensFunc == nil {
+		return notStubbed("DeleteEmailTokens")
+	}
+	return q.DeleteEmailTokensFunc(ctx, arg)
+}
+
+func (q *Querier) DeleteEmptyWorkspacesForOrg(ctx context.Context, orgID int64) error {
+	q.calls.record("DeleteEmptyWorkspacesForOrg")
+	if q.DeleteEmptyWorkspacesForOrgFunc == nil {
+		return notStubbed("DeleteEmptyWorkspacesForOrg")
+	}
+	return q.DeleteEmptyWorkspacesForOrgFunc(ctx, orgID)
+}
+
+func (q *Querier) DeleteEnvironment(ctx context.Context, id int64) error {
+	q.calls.record("DeleteEnvironment")
+	if q.DeleteEnvironmentFunc == nil {
+		return notStubbed("DeleteEnvironment")
+	}
+	return q.DeleteEnvironmentFunc(ctx, id)
+}
+
+func (q *Querier) DeleteExpiredRefreshTokens(ctx context.Context) error {
+	q.calls.record("DeleteExpiredRefreshTokens")
+	if q.DeleteExpiredRefreshTokensFunc == nil {
+		return notStubbed("DeleteExpiredRefreshTokens")
+	}
+	return q.DeleteExpiredRefreshTokensFunc(ctx)
+}
+
+func (q *Querier) DeleteExpiredTokens(ctx context.Context) error {
+	q.calls.record("DeleteExpiredTokens")
+	if q.DeleteExpiredTokensFunc == nil {
+		return notStubbed("DeleteExpiredTokens")
+	}
+	return q.DeleteExpiredTokensFunc(ctx)
+}
+
+func (q *Querier) DeleteOrg(ctx context.Context, id int64) error {
+	q.calls.record("DeleteOrg")
+	if q.DeleteOrgFunc == nil {
+		return notStubbed("DeleteOrg")
+	}
+	return q.DeleteOrgFunc(ctx, id)
+}
+
+func (q *Querier) DeleteOrganization(ctx context.Context, id int64) error {
+	q.calls.record("DeleteOrganization")
+	if q.DeleteOrganizationFunc == nil {
+		return notStubbed("DeleteOrganization")
+	}
+	return q.DeleteOrganizationFunc(ctx, id)
+}
+
+func (q *Querier) DeletePolicy(ctx context.Context, id int64) (int64, error) {
+	q.calls.record("DeletePolicy")
+	if q.DeletePolicyFunc == nil {
+		var zero int64
+		return zero, notStubbed("DeletePolicy")
+	}
+	return q.DeletePolicyFunc(ctx, id)
+}
+
+func (q *Querier) DeleteRefreshTokenFamily(ctx context.Context, familyID string) ([]pgtype.Text, error) {
+	q.calls.record("DeleteRefreshTokenFamily")
+	if q.DeleteRefreshTokenFamilyFunc == nil {
+		var zero []pgtype.Text
+		return zero, notStubbed("DeleteRefreshTokenFamily")
+	}
+	return q.DeleteRefreshTokenFamilyFunc(ctx, familyID)
+}
+
+func (q *Querier) DeleteRefreshTokenFamilyByAccessToken(ctx context.Context, accessToken pgtype.Text) ([]pgtype.Text, error) {
+	q.calls.record("DeleteRefreshTokenFamilyByAccessToken")
+	if q.DeleteRefreshTokenFamilyByAccessTokenFunc == nil {
+		var zero []pgtype.Text
+		return zero, notStubbed("DeleteRefreshTokenFamilyByAccessToken")
+	}
+	return q.DeleteRefreshTokenFamilyByAccessTokenFunc(ctx, accessToken)
+}
+
+func (q *Querier) DeleteRefreshTokensForUser(ctx context.Context, userID int64) error {
+	q.calls.record("DeleteRefreshTokensForUser")
+	if q.DeleteRefreshTokensForUserFunc == nil {
+		return notStubbed("DeleteRefreshTokensForUser")
+	}
+	return q.DeleteRefreshTokensForUserFunc(ctx, userID)
+}
+
+func (q *Querier) DeleteResolvedClusterDiscrepancies(ctx context.Context, foundResourceIds []int64) (int64, error) {
+	q.calls.record("DeleteResolvedClusterDiscrepancies")
+	if q.DeleteResolvedClusterDiscrepanciesFunc == nil {
+		var zero int64
+		return zero, notStubbed("DeleteResolvedClusterDiscrepancies")
+	}
+	return q.DeleteResolvedClusterDiscrepanciesFunc(ctx, foundResourceIds)
+}
+
+func (q *Querier) DeleteResource(ctx context.Context, id int64) error {
+	q.calls.record("DeleteResource")
+	if q.DeleteResourceFunc == nil {
+		return notStubbed("DeleteResource")
+	}
+	return q.DeleteResourceFunc(ctx, id)
+}
+
+func (q *Querier) DeleteResourceDomain(ctx context.Context, id int64) error {
+	q.calls.record("DeleteResourceDomain")
+	if q.DeleteResourceDomainFunc == nil {
+		return notStubbed("DeleteResourceDomain")
+	}
+	return q.DeleteResourceDomainFunc(ctx, id)
+}
+
+func (q *Querier) DeleteResourceProbesBefore(ctx context.Context, before pgtype.Timestamptz) (int64, error) {
+	q.calls.record("DeleteResourceProbesBefore")
+	if q.DeleteResourceProbesBeforeFunc == nil {
+		var zero int64
+		return zero, notStubbed("DeleteResourceProbesBefore")
+	}
+	return q.DeleteResourceProbesBeforeFunc(ctx, before)
+}
+
+func (q *Querier) DeleteScimGroup(ctx context.Context, id int64) error {
+	q.calls.record("DeleteScimGroup")
+	if q.DeleteScimGroupFunc == nil {
+		return notStubbed("DeleteScimGroup")
+	}
+	return q.DeleteScimGroupFunc(ctx, id)
+}
+
+func (q *Querier) DeleteScimUser(ctx context.Context, id int64) error {
+	q.calls.record("DeleteScimUser")
+	if q.DeleteScimUserFunc == nil {
+		return notStubbed("DeleteScimUser")
+	}
+	return q.DeleteScimUserFunc(ctx, id)
+}
+
+func (q *Querier) DeleteServiceAccount(ctx context.Context, id int64) error {
+	q.calls.record("DeleteServiceAccount")
+	if q.DeleteServiceAccountFunc == nil {
+		return notStubbed("DeleteServiceAccount")
+	}
+	return q.DeleteServiceAccountFunc(ctx, id)
+}
+
+func (q *Querier) DeleteSlackChannelsForOrg(ctx context.Context, orgID int64) error {
+	q.calls.record("DeleteSlackChannelsForOrg")
+	if q.DeleteSlackChannelsForOrgFunc == nil {
+		return notStubbed("DeleteSlackChannelsForOrg")
+	}
+	return q.DeleteSlackChannelsForOrgFunc(ctx, orgID)
+}
+
+func (q *Querier) DeleteSlackInstallation(ctx context.Context, orgID int64) (int64, error) {
+	q.calls.record("DeleteSlackInstallation")
+	if q.DeleteSlackInstallationFunc == nil {
+		var zero int64
+		return zero, notStubbed("DeleteSlackInstallation")
+	}
+	return q.DeleteSlackInstallationFunc(ctx, orgID)
+}
+
+func (q *Querier) DeleteToken(ctx context.Context, name string) error {
+	q.calls.record("DeleteToken")
+	if q.DeleteTokenFunc == nil {
+		return notStubbed("DeleteToken")
+	}
+	return q.DeleteTokenFunc(ctx, name)
+}
+
+func (q *Querier) DeleteTokenByNameAndEntity(ctx context.Context, arg genDb.DeleteTokenByNameAndEntityParams) error {
+	q.calls.record("DeleteTokenByNameAndEntity")
+	if q.DeleteTokenByNameAndEntityFunc == nil {
+		return notStubbed("DeleteTokenByNameAndEntity")
+	}
+	return q.DeleteTokenByNameAndEntityFunc(ctx, arg)
+}
+
+func (q *Querier) DeleteTokensForEntity(ctx context.Context, arg genDb.DeleteTokensForEntityParams) error {
+	q.calls.record("DeleteTokensForEntity")
+	if q.DeleteTokensForEntityFunc == nil {
+		return notStubbed("DeleteTokensForEntity")
+	}
+	return q.DeleteTokensForEntityFunc(ctx, arg)
+}
+
+func (q *Querier) DeleteUser(ctx context.Context, id int64) error {
+	q.calls.record("DeleteUser")
+	if q.DeleteUserFunc == nil {
+		return notStubbed("DeleteUser")
+	}
+	return q.DeleteUserFunc(ctx, id)
+}
+
+func (q *Querier) DeleteUserNotificationPreference(ctx context.Context, arg genDb.DeleteUserNotificationPreferenceParams) error {
+	q.calls.record("DeleteUserNotificationPreference")
+	if q.DeleteUserNotificationPreferenceFunc == nil {
+		return notStubbed("DeleteUserNotificationPreference")
+	}
+	return q.DeleteUserNotificationPreferenceFunc(ctx, arg)
+}
+
+func (q *Querier) DeleteUserTOTP(ctx context.Context, userID int64) error {
+	q.calls.record("DeleteUserTOTP")
+	if q.DeleteUserTOTPFunc == nil {
+		return notStubbed("DeleteUserTOTP")
+	}
+	return q.DeleteUserTOTPFunc(ctx, userID)
+}
+
+func (q *Querier) DeleteWorkspace(ctx context.Context, id int64) error {
+	q.calls.record("DeleteWorkspace")
+	if q.DeleteWorkspaceFunc == nil {
+		return notStubbed("DeleteWorkspace")
+	}
+	return q.DeleteWorkspaceFunc(ctx, id)
+}
+
+func (q *Querier) DeleteWorkspaceMember(ctx context.Context, arg genDb.DeleteWorkspaceMemberParams) error {
+	q.calls.record("DeleteWorkspaceMember")
+	if q.DeleteWorkspaceMemberFunc == nil {
+		return notStubbed("DeleteWorkspaceMember")
+	}
+	return q.DeleteWorkspaceMemberFunc(ctx, arg)
+}
+
+func (q *Querier) DeleteWorkspaceNotificationDefault(ctx context.Context, arg genDb.DeleteWorkspaceNotificationDefaultParams) error {
+	q.calls.record("DeleteWorkspaceNotificationDefault")
+	if q.DeleteWorkspaceNotificationDefaultFunc == nil {
+		return notStubbed("DeleteWorkspaceNotificationDefault")
+	}
+	return q.DeleteWorkspaceNotificationDefaultFunc(ctx, arg)
+}
+
+func (q *Querier) DeleteWorkspaceSlackChannel(ctx context.Context, workspaceID int64) (int64, error) {
+	q.calls.record("DeleteWorkspaceSlackChannel")
+	if q.DeleteWorkspaceSlackChannelFunc == nil {
+		var zero int64
+		return zero, notStubbed("DeleteWorkspaceSlackChannel")
+	}
+	return q.DeleteWorkspaceSlackChannelFunc(ctx, workspaceID)
+}
+
+func (q *Querier) DetachConfigGroup(ctx context.Context, arg genDb.DetachConfigGroupParams) (int64, error) {
+	q.calls.record("DetachConfigGroup")
+	if q.DetachConfigGroupFunc == nil {
+		var zero int64
+		return zero, notStubbed("DetachConfigGroup")
+	}
+	return q.DetachConfigGroupFunc(ctx, arg)
+}
+
+func (q *Querier) EndOrgSubscription(ctx context.Context, arg genDb.EndOrgSubscriptionParams) (int64, error) {
+	q.calls.record("EndOrgSubscription")
+	if q.EndOrgSubscriptionFunc == nil {
+		var zero int64
+		return zero, notStubbed("EndOrgSubscription")
+	}
+	return q.EndOrgSubscriptionFunc(ctx, arg)
+}
+
+func (q *Querier) EnqueueNotification(ctx context.Context, arg genDb.EnqueueNotificationParams) error {
+	q.calls.record("EnqueueNotification")
+	if q.EnqueueNotificationFunc == nil {
+		return notStubbed("EnqueueNotification")
+	}
+	return q.EnqueueNotificationFunc(ctx, arg)
+}
+
+func (q *Querier) FailConfigRollout(ctx context.Context, arg genDb.FailConfigRolloutParams) error {
+	q.calls.record("FailConfigRollout")
+	if q.FailConfigRolloutFunc == nil {
+		return notStubbed("FailConfigRollout")
+	}
+	return q.FailConfigRolloutFunc(ctx, arg)
+}
+
+func (q *Querier) FailOrgDeletion(ctx context.Context, arg genDb.FailOrgDeletionParams) error {
+	q.calls.record("FailOrgDeletion")
+	if q.FailOrgDeletionFunc == nil {
+		return notStubbed("FailOrgDeletion")
+	}
+	return q.FailOrgDeletionFunc(ctx, arg)
+}
+
+func (q *Querier) FailStuckDeployment(ctx context.Context, arg genDb.FailStuckDeploymentParams) (int64, error) {
+	q.calls.record("FailStuckDeployment")
+	if q.FailStuckDeploymentFunc == nil {
+		var zero int64
+		return zero, notStubbed("FailStuckDeployment")
+	}
+	return q.FailStuckDeploymentFunc(ctx, arg)
+}
+
+func (q *Querier) GetActiveClusterByRegion(ctx context.Context, region string) (genDb.Cluster, error) {
+	q.calls.record("GetActiveClusterByRegion")
+	if q.GetActiveClusterByRegionFunc == nil {
+		var zero genDb.Cluster
+		return zero, notStubbed("GetActiveClusterByRegion")
+	}
+	return q.GetActiveClusterByRegionFunc(ctx, region)
+}
+
+func (q *Querier) GetActiveConfigRollout(ctx context.Context, configGroupID int64) (genDb.ConfigRollout, error) {
+	q.calls.record("GetActiveConfigRollout")
+	if q.GetActiveConfigRolloutFunc == nil {
+		var zero genDb.ConfigRollout
+		return zero, notStubbed("GetActiveConfigRollout")
+	}
+	return q.GetActiveConfigRolloutFunc(ctx, configGroupID)
+}
+
+func (q *Querier) GetActiveDeploymentForResourceAndRegion(ctx context.Context, arg genDb.GetActiveDeploymentForResourceAndRegionParams) (genDb.Deployment, error) {
+	q.calls.record("GetActiveDeploymentForResourceAndRegion")
+	if q.GetActiveDeploymentForResourceAndRegionFunc == nil {
+		var zero genDb.Deployment
+		return zero, notStubbed("GetActiveDeploymentForResourceAndRegion")
+	}
+	return q.GetActiveDeploymentForResourceAndRegionFunc(ctx, arg)
+}
+
+func (q *Querier) GetAlertRule(ctx context.Context, id int64) (genDb.AlertRule, error) {
+	q.calls.record("GetAlertRule")
+	if q.GetAlertRuleFunc == nil {
+		var zero genDb.AlertRule
+		return zero, notStubbed("GetAlertRule")
+	}
+	return q.GetAlertRuleFunc(ctx, id)
+}
+
+func (q *Querier) GetAlertState(ctx context.Context, arg genDb.GetAlertStateParams) (genDb.AlertState, error) {
+	q.calls.record("GetAlertState")
+	if q.GetAlertStateFunc == nil {
+		var zero genDb.AlertState
+		return zero, notStubbed("GetAlertState")
+	}
+	return q.GetAlertStateFunc(ctx, arg)
+}
+
+func (q *Querier) GetAnnouncementReadMarker(ctx context.Context, userID int64) (int64, error) {
+	q.calls.record("GetAnnouncementReadMarker")
+	if q.GetAnnouncementReadMarkerFunc == nil {
+		var zero int64
+		return zero, notStubbed("GetAnnouncementReadMarker")
+	}
+	return q.GetAnnouncementReadMarkerFunc(ctx, userID)
+}
+
+func (q *Querier) GetApprovalRequest(ctx context.Context, id int64) (genDb.ApprovalRequest, error) {
+	q.calls.record("GetApprovalRequest")
+	if q.GetApprovalRequestFunc == nil {
+		var zero genDb.ApprovalRequest
+		return zero, notStubbed("GetApprovalRequest")
+	}
+	return q.GetApprovalRequestFunc(ctx, id)
+}
+
+func (q *Querier) GetBillingPlan(ctx context.Context, id int64) (genDb.BillingPlan, error) {
+	q.calls.record("GetBillingPlan")
+	if q.GetBillingPlanFunc == nil {
+		var zero genDb.BillingPlan
+		return zero, notStubbed("GetBillingPlan")
+	}
+	return q.GetBillingPlanFunc(ctx, id)
+}
+
+func (q *Querier) GetCluster(ctx context.Context, id int64) (genDb.Cluster, error) {
+	q.calls.record("GetCluster")
+	if q.GetClusterFunc == nil {
+		var zero genDb.Cluster
+		return zero, notStubbed("GetCluster")
+	}
+	return q.GetClusterFunc(ctx, id)
+}
+
+func (q *Querier) GetClusterDetails(ctx context.Context, id int64) (genDb.GetClusterDetailsRow, error) {
+	q.calls.record("GetClusterDetails")
+	if q.GetClusterDetailsFunc == nil {
+		var zero genDb.GetClusterDetailsRow
+		return zero, notStubbed("GetClusterDetails")
+	}
+	return q.GetClusterDetailsFunc(ctx, id)
+}
+
+func (q *Querier) GetConfigGroup(ctx context.Context, id int64) (genDb.ConfigGroup, error) {
+	q.calls.record("GetConfigGroup")
+	if q.GetConfigGroupFunc == nil {
+		var zero genDb.ConfigGroup
+		return zero, notStubbed("GetConfigGroup")
+	}
+	return q.GetConfigGroupFunc(ctx, id)
+}
+
+func (q *Querier) GetConfigRollout(ctx context.Context, id int64) (genDb.ConfigRollout, error) {
+	q.calls.record("GetConfigRollout")
+	if q.GetConfigRolloutFunc == nil {
+		var zero genDb.ConfigRollout
+		return zero, notStubbed("GetConfigRollout")
+	}
+	return q.GetConfigRolloutFunc(ctx, id)
+}
+
+func (q *Querier) GetDeploymentByID(ctx context.Context, id int64) (genDb.Deployment, error) {
+	q.calls.record("GetDeploymentByID")
+	if q.GetDeploymentByIDFunc == nil {
+		var zero genDb.Deployment
+		return zero, notStubbed("GetDeploymentByID")
+	}
+	return q.GetDeploymentByIDFunc(ctx, id)
+}
+
+func (q *Querier) GetDeploymentNotificationContext(ctx context.Context, id int64) (genDb.GetDeploymentNotificationContextRow, error) {
+	q.calls.record("GetDeploymentNotificationContext")
+	if q.GetDeploymentNotificationContextFunc == nil {
+		var zero genDb.GetDeploymentNotificationContextRow
+		return zero, notStubbed("GetDeploymentNotificationContext")
+	}
+	return q.GetDeploymentNotificationContextFunc(ctx, id)
+}
+
+func (q *Querier) GetDeploymentProvenance(ctx context.Context, deploymentID int64) (genDb.DeploymentProvenance, error) {
+	q.calls.record("GetDeploymentProvenance")
+	if q.GetDeploymentProvenanceFunc == nil {
+		var zero genDb.DeploymentProvenance
+		return zero, notStubbed("GetDeploymentProvenance")
+	}
+	return q.GetDeploymentProvenanceFunc(ctx, deploymentID)
+}
+
+func (q *Querier) GetDeploymentResourceID(ctx context.Context, id int64) (int64, error) {
+	q.calls.record("GetDeploymentResourceID")
+	if q.GetDeploymentResourceIDFunc == nil {
+		var zero int64
+		return zero, notStubbed("GetDeploymentResourceID")
+	}
+	return q.GetDeploymentResourceIDFunc(ctx, id)
+}
+
+func (q *Querier) GetDomainByResourceId(ctx context.Context, resourceID int64) (genDb.GetDomainByResourceIdRow, error) {
+	q.calls.record("GetDomainByResourceId")
+	if q.GetDomainByResourceIdFunc == nil {
+		var zero genDb.GetDomainByResourceIdRow
+		return zero, notStubbed("GetDomainByResourceId")
+	}
+	return q.GetDomainByResourceIdFunc(ctx, resourceID)
+}
+
+func (q *Querier) GetEnvironmentByID(ctx context.Context, id int64) (genDb.Environment, error) {
+	q.calls.record("GetEnvironmentByID")
+	if q.GetEnvironmentByIDFunc == nil {
+		var zero genDb.Environment
+		return zero, notStubbed("GetEnvironmentByID")
+	}
+	return q.GetEnvironmentByIDFunc(ctx, id)
+}
+
+func (q *Querier) GetEnvironmentByName(ctx context.Context, arg genDb.GetEnvironmentByNameParams) (genDb.Environment, error) {
+	q.calls.record("GetEnvironmentByName")
+	if q.GetEnvironmentByNameFunc == nil {
+		var zero genDb.Environment
+		return zero, notStubbed("GetEnvironmentByName")
+	}
+	return q.GetEnvironmentByNameFunc(ctx, arg)
+}
+
+func (q *Querier) GetEnvironmentWorkspaceOrganizationID(ctx context.Context, id int64) (genDb.GetEnvironmentWorkspaceOrganizationIDRow, error) {
+	q.calls.record("GetEnvironmentWorkspaceOrganizationID")
+	if q.GetEnvironmentWorkspaceOrganizationIDFunc == nil {
+		var zero genDb.GetEnvironmentWorkspaceOrganizationIDRow
+		return zero, notStubbed("GetEnvironmentWorkspaceOrganizationID")
+	}
+	return q.GetEnvironmentWorkspaceOrganizationIDFunc(ctx, id)
+}
+
+func (q *Querier) GetFirstActiveCluster(ctx context.Context) (genDb.Cluster, error) {
+	q.calls.record("GetFirstActiveCluster")
+	if q.GetFirstActiveClusterFunc == nil {
+		var zero genDb.Cluster
+		return zero, notStubbed("GetFirstActiveCluster")
+	}
+	return q.GetFirstActiveClusterFunc(ctx)
+}
+
+func (q *Querier) GetGitOpsApplication(ctx context.Context, resourceID int64) (genDb.GitopsApplication, error) {
+	q.calls.record("GetGitOpsApplication")
+	if q.GetGitOpsApplicationFunc == nil {
+		var zero genDb.GitopsApplication
+		return zero, notStubbed("GetGitOpsApplication")
+	}
+	return q.GetGitOpsApplicationFunc(ctx, resourceID)
+}
+
+func (q *Querier) GetImageScan(ctx context.Context, image string) (genDb.ImageScan, error) {
+	q.calls.record("GetImageScan")
+	if q.GetImageScanFunc == nil {
+		var zero genDb.ImageScan
+		return zero, notStubbed("GetImageScan")
+	}
+	return q.GetImageScanFunc(ctx, image)
+}
+
+func (q *Querier) GetLatestAnnouncementID(ctx context.Context) (int64, error) {
+	q.calls.record("GetLatestAnnouncementID")
+	if q.GetLatestAnnouncementIDFunc == nil {
+		var zero int64
+		return zero, notStubbed("GetLatestAnnouncementID")
+	}
+	return q.GetLatestAnnouncementIDFunc(ctx)
+}
+
+func (q *Querier) GetLatestDeploymentID(ctx context.Context, resourceID int64) (int64, error) {
+	q.calls.record("GetLatestDeploymentID")
+	if q.GetLatestDeploymentIDFunc == nil {
+		var zero int64
+		return zero, notStubbed("GetLatestDeploymentID")
+	}
+	return q.GetLatestDeploymentIDFunc(ctx, resourceID)
+}
+
+func (q *Querier) GetLatestResourceProbe(ctx context.Context, resourceID int64) (genDb.ResourceProbe, error) {
+	q.calls.record("GetLatestResourceProbe")
+	if q.GetLatestResourceProbeFunc == nil {
+		var zero genDb.ResourceProbe
+		return zero, notStubbed("GetLatestResourceProbe")
+	}
+	return q.GetLatestResourceProbeFunc(ctx, resourceID)
+}
+
+func (q *Querier) GetLatestWorkspaceDigest(ctx context.Context, workspaceID int64) (genDb.WorkspaceDigest, error) {
+	q.calls.record("GetLatestWorkspaceDigest")
+	if q.GetLatestWorkspaceDigestFunc == nil {
+		var zero genDb.WorkspaceDigest
+		return zero, notStubbed("GetLatestWorkspaceDigest")
+	}
+	return q.GetLatestWorkspaceDigestFunc(ctx, workspaceID)
+}
+
+func (q *Querier) GetOrgBilling(ctx context.Context, orgID int64) (genDb.OrgBilling, error) {
+	q.calls.record("GetOrgBilling")
+	if q.GetOrgBillingFunc == nil {
+		var zero genDb.OrgBilling
+		return zero, notStubbed("GetOrgBilling")
+	}
+	return q.GetOrgBillingFunc(ctx, orgID)
+}
+
+func (q *Querier) GetOrgByID(ctx context.Context, id int64) (genDb.Organization, error) {
+	q.calls.record("GetOrgByID")
+	if q.GetOrgByIDFunc == nil {
+		var zero genDb.Organization
+		return zero, notStubbed("GetOrgByID")
+	}
+	return q.GetOrgByIDFunc(ctx, id)
+}
+
+func (q *Querier) GetOrgByName(ctx context.Context, name string) (genDb.Organization, error) {
+	q.calls.record("GetOrgByName")
+	if q.GetOrgByNameFunc == nil {
+		var zero genDb.Organization
+		return zero, notStubbed("GetOrgByName")
+	}
+	return q.GetOrgByNameFunc(ctx, name)
+}
+
+func (q *Querier) GetOrgDeletion(ctx context.Context, id int64) (genDb.OrgDeletion, error) {
+	q.calls.record("GetOrgDeletion")
+	if q.GetOrgDeletionFunc == nil {
+		var zero genDb.OrgDeletion
+		return zero, notStubbed("GetOrgDeletion")
+	}
+	return q.GetOrgDeletionFunc(ctx, id)
+}
+
+func (q *Querier) GetOrganizationByID(ctx context.Context, id int64) (genDb.GetOrganizationByIDRow, error) {
+	q.calls.record("GetOrganizationByID")
+	if q.GetOrganizationByIDFunc == nil {
+		var zero genDb.GetOrganizationByIDRow
+		return zero, notStubbed("GetOrganizationByID")
+	}
+	return q.GetOrganizationByIDFunc(ctx, id)
+}
+
+func (q *Querier) GetOrganizationByName(ctx context.Context, name string) (genDb.GetOrganizationByNameRow, error) {
+	q.calls.record("GetOrganizationByName")
+	if q.GetOrganizationByNameFunc == nil {
+		var zero genDb.GetOrganizationByNameRow
+		return zero, notStubbed("GetOrganizationByName")
+	}
+	return q.GetOrganizationByNameFunc(ctx, name)
+}
+
+func (q *Querier) GetOrganizationIDByWorkspaceID(ctx context.Context, id int64) (int64, error) {
+	q.calls.record("GetOrganizationIDByWorkspaceID")
+	if q.GetOrganizationIDByWorkspaceIDFunc == nil {
+		var zero int64
+		return zero, notStubbed("GetOrganizationIDByWorkspaceID")
+	}
+	return q.GetOrganizationIDByWorkspaceIDFunc(ctx, id)
+}
+
+func (q *Querier) GetOrganizationMember(ctx context.Context, arg genDb.GetOrganizationMemberParams) (genDb.GetOrganizationMemberRow, error) {
+	q.calls.record("GetOrganizationMember")
+	if q.GetOrganizationMemberFunc == nil {
+		var zero genDb.GetOrganizationMemberRow
+		return zero, notStubbed("GetOrganizationMember")
+	}
+	return q.GetOrganizationMemberFunc(ctx, arg)
+}
+
+func (q *Querier) GetPendingApprovalRequest(ctx context.Context, arg genDb.GetPendingApprovalRequestParams) (genDb.ApprovalRequest, error) {
+	q.calls.record("GetPendingApprovalRequest")
+	if q.GetPendingApprovalRequestFunc == nil {
+		var zero genDb.ApprovalRequest
+		return zero, notStubbed("GetPendingApprovalRequest")
+	}
+	return q.GetPendingApprovalRequestFunc(ctx, arg)
+}
+
+func (q *Querier) GetPlatformDomain(ctx context.Context, id int64) (genDb.PlatformDomain, error) {
+	q.calls.record("GetPlatformDomain")
+	if q.GetPlatformDomainFunc == nil {
+		var zero genDb.PlatformDomain
+		return zero, notStubbed("GetPlatformDomain")
+	}
+	return q.GetPlatformDomainFunc(ctx, id)
+}
+
+func (q *Querier) GetPlatformDomainByName(ctx context.Context, domain string) (genDb.PlatformDomain, error) {
+	q.calls.record("GetPlatformDomainByName")
+	if q.GetPlatformDomainByNameFunc == nil {
+		var zero genDb.PlatformDomain
+		return zero, notStubbed("GetPlatformDomainByName")
+	}
+	return q.GetPlatformDomainByNameFunc(ctx, domain)
+}
+
+func (q *Querier) GetPolicy(ctx context.Context, id int64) (genDb.Policy, error) {
+	q.calls.record("GetPolicy")
+	if q.GetPolicyFunc == nil {
+		var zero genDb.Policy
+		return zero, notStubbed("GetPolicy")
+	}
+	return q.GetPolicyFunc(ctx, id)
+}
+
+func (q *Querier) GetPreviousDeploymentInRegion(ctx context.Context, arg genDb.GetPreviousDeploymentInRegionParams) (genDb.Deployment, error) {
+	q.calls.record("GetPreviousDeploymentInRegion")
+	if q.GetPreviousDeploymentInRegionFunc == nil {
+		var zero genDb.Deployment
+		return zero, notStubbed("GetPreviousDeploymentInRegion")
+	}
+	return q.GetPreviousDeploymentInRegionFunc(ctx, arg)
+}
+
+func (q *Querier) GetPrimaryDomainForResource(ctx context.Context, resourceID int64) (string, error) {
+	q.calls.record("GetPrimaryDomainForResource")
+	if q.GetPrimaryDomainForResourceFunc == nil {
+		var zero string
+		return zero, notStubbed("GetPrimaryDomainForResource")
+	}
+	return q.GetPrimaryDomainForResourceFunc(ctx, resourceID)
+}
+
+func (q *Querier) GetResourceByExternalID(ctx context.Context, arg genDb.GetResourceByExternalIDParams) (genDb.Resource, error) {
+	q.calls.record("GetResourceByExternalID")
+	if q.GetResourceByExternalIDFunc == nil {
+		var zero genDb.Resource
+		return zero, notStubbed("GetResourceByExternalID")
+	}
+	return q.GetResourceByExternalIDFunc(ctx, arg)
+}
+
+func (q *Querier) GetResourceByID(ctx context.Context, id int64) (genDb.Resource, error) {
+	q.calls.record("GetResourceByID")
+	if q.GetResourceByIDFunc == nil {
+		var zero genDb.Resource
+		return zero, notStubbed("GetResourceByID")
+	}
+	return q.GetResourceByIDFunc(ctx, id)
+}
+
+func (q *Querier) GetResourceByNameAndWorkspace(ctx context.Context, arg genDb.GetResourceByNameAndWorkspaceParams) (genDb.Resource, error) {
+	q.calls.record("GetResourceByNameAndWorkspace")
+	if q.GetResourceByNameAndWorkspaceFunc == nil {
+		var zero genDb.Resource
+		return zero, notStubbed("GetResourceByNameAndWorkspace")
+	}
+	return q.GetResourceByNameAndWorkspaceFunc(ctx, arg)
+}
+
+func (q *Querier) GetResourceByNameInEnvironment(ctx context.Context, arg genDb.GetResourceByNameInEnvironmentParams) (genDb.Resource, error) {
+	q.calls.record("GetResourceByNameInEnvironment")
+	if q.GetResourceByNameInEnvironmentFunc == nil {
+		var zero genDb.Resource
+		return zero, notStubbed("GetResourceByNameInEnvironment")
+	}
+	return q.GetResourceByNameInEnvironmentFunc(ctx, arg)
+}
+
+func (q *Querier) GetResourceDomainByID(ctx context.Context, id int64) (genDb.ResourceDomain, error) {
+	q.calls.record("GetResourceDomainByID")
+	if q.GetResourceDomainByIDFunc == nil {
+		var zero genDb.ResourceDomain
+		return zero, notStubbed("GetResourceDomainByID")
+	}
+	return q.GetResourceDomainByIDFunc(ctx, id)
+}
+
+func (q *Querier) GetResourceDomainByName(ctx context.Context, domain string) (genDb.ResourceDomain, error) {
+	q.calls.record("GetResourceDomainByName")
+	if q.GetResourceDomainByNameFunc == nil {
+		var zero genDb.ResourceDomain
+		return zero, notStubbed("GetResourceDomainByName")
+	}
+	return q.GetResourceDomainByNameFunc(ctx, domain)
+}
+
+func (q *Querier) GetResourceDomainCount(ctx context.Context, resourceID int64) (int64, error) {
+	q.calls.record("GetResourceDomainCount")
+	if q.GetResourceDomainCountFunc == nil {
+		var zero int64
+		return zero, notStubbed("GetResourceDomainCount")
+	}
+	return q.GetResourceDomainCountFunc(ctx, resourceID)
+}
+
+func (q *Querier) GetResourceIngressEndpoint(ctx context.Context, resourceID int64) (pgtype.Text, error) {
+	q.calls.record("GetResourceIngressEndpoint")
+	if q.GetResourceIngressEndpointFunc == nil {
+		var zero pgtype.Text
+		return zero, notStubbed("GetResourceIngressEndpoint")
+	}
+	return q.GetResourceIngressEndpointFunc(ctx, resourceID)
+}
+
+func (q *Querier) GetResourceLock(ctx context.Context, resourceID int64) (genDb.ResourceLock, error) {
+	q.calls.record("GetResourceLock")
+	if q.GetResourceLockFunc == nil {
+		var zero genDb.ResourceLock
+		return zero, notStubbed("GetResourceLock")
+	}
+	return q.GetResourceLockFunc(ctx, resourceID)
+}
+
+func (q *Querier) GetResourcePlanName(ctx context.Context, id int64) (string, error) {
+	q.calls.record("GetResourcePlanName")
+	if q.GetResourcePlanNameFunc == nil {
+		var zero string
+		return zero, notStubbed("GetResourcePlanName")
+	}
+	return q.GetResourcePlanNameFunc(ctx, id)
+}
+
+func (q *Querier) GetResourceProbeLatencyP95(ctx context.Context, arg genDb.GetResourceProbeLatencyP95Params) (genDb.GetResourceProbeLatencyP95Row, error) {
+	q.calls.record("GetResourceProbeLatencyP95")
+	if q.GetResourceProbeLatencyP95Func == nil {
+		var zero genDb.GetResourceProbeLatencyP95Row
+		return zero, notStubbed("GetResourceProbeLatencyP95")
+	}
+	return q.GetResourceProbeLatencyP95Func(ctx, arg)
+}
+
+func (q *Querier) GetResourceProbeStats(ctx context.Context, arg genDb.GetResourceProbeStatsParams) (genDb.GetResourceProbeStatsRow, error) {
+	q.calls.record("GetResourceProbeStats")
+	if q.GetResourceProbeStatsFunc == nil {
+		var zero genDb.GetResourceProbeStatsRow
+		return zero, notStubbed("GetResourceProbeStats")
+	}
+	return q.GetResourceProbeStatsFunc(ctx, arg)
+}
+
+func (q *Querier) GetResourceProtection(ctx context.Context, id int64) (genDb.GetResourceProtectionRow, error) {
+	q.calls.record("GetResourceProtection")
+	if q.GetResourceProtectionFunc == nil {
+		var zero genDb.GetResourceProtectionRow
+		return zero, notStubbed("GetResourceProtection")
+	}
+	return q.GetResourceProtectionFunc(ctx, id)
+}
+
+func (q *Querier) GetResourceRegionByResourceAndRegion(ctx context.Context, arg genDb.GetResourceRegionByResourceAndRegionParams) (genDb.ResourceRegion, error) {
+	q.calls.record("GetResourceRegionByResourceAndRegion")
+	if q.GetResourceRegionByResourceAndRegionFunc == nil {
+		var zero genDb.ResourceRegion
+		return zero, notStubbed("GetResourceRegionByResourceAndRegion")
+	}
+	return q.GetResourceRegionByResourceAndRegionFunc(ctx, arg)
+}
+
+func (q *Querier) GetResourceSleepByDomain(ctx context.Context, domain string) (genDb.GetResourceSleepByDomainRow, error) {
+	q.calls.record("GetResourceSleepByDomain")
+	if q.GetResourceSleepByDomainFunc == nil {
+		var zero genDb.GetResourceSleepByDomainRow
+		return zero, notStubbed("GetResourceSleepByDomain")
+	}
+	return q.GetResourceSleepByDomainFunc(ctx, domain)
+}
+
+func (q *Querier) GetResourceWorkspaceID(ctx context.Context, id int64) (int64, error) {
+	q.calls.record("GetResourceWorkspaceID")
+	if q.GetResourceWorkspaceIDFunc == nil {
+		var zero int64
+		return zero, notStubbed("GetResourceWorkspaceID")
+	}
+	return q.GetResourceWorkspaceIDFunc(ctx, id)
+}
+
+func (q *Querier) GetScimGroup(ctx context.Context, arg genDb.GetScimGroupParams) (genDb.ScimGroup, error) {
+	q.calls.record("GetScimGroup")
+	if q.GetScimGroupFunc == nil {
+		var zero genDb.ScimGroup
+		return zero, notStubbed("GetScimGroup")
+	}
+	return q.GetScimGroupFunc(ctx, arg)
+}
+
+func (q *Querier) GetScimUser(ctx context.Context, arg genDb.GetScimUserParams) (genDb.ScimUser, error) {
+	q.calls.record("GetScimUser")
+	if q.GetScimUserFunc == nil {
+		var zero genDb.ScimUser
+		return zero, notStubbed("GetScimUser")
+	}
+	return q.GetScimUserFunc(ctx, arg)
+}
+
+func (q *Querier) GetServiceAccountByID(ctx context.Context, id int64) (genDb.ServiceAccount, error) {
+	q.calls.record("GetServiceAccountByID")
+	if q.GetServiceAccountByIDFunc == nil {
+		var zero genDb.ServiceAccount
+		return zero, notStubbed("GetServiceAccountByID")
+	}
+	return q.GetServiceAccountByIDFunc(ctx, id)
+}
+
+func (q *Querier) GetServiceAccountScopes(ctx context.Context, serviceAccountID int64) ([]genDb.EntityScope, error) {
+	q.calls.record("GetServiceAccountScopes")
+	if q.GetServiceAccountScopesFunc == nil {
+		var zero []genDb.EntityScope
+		return zero, notStubbed("GetServiceAccountScopes")
+	}
+	return q.GetServiceAccountScopesFunc(ctx, serviceAccountID)
+}
+
+func (q *Querier) GetServiceAccountWorkspaceOrganizationID(ctx context.Context, id int64) (genDb.GetServiceAccountWorkspaceOrganizationIDRow, error) {
+	q.calls.record("GetServiceAccountWorkspaceOrganizationID")
+	if q.GetServiceAccountWorkspaceOrganizationIDFunc == nil {
+		var zero genDb.GetServiceAccountWorkspaceOrganizationIDRow
+		return zero, notStubbed("GetServiceAccountWorkspaceOrganizationID")
+	}
+	return q.GetServiceAccountWorkspaceOrganizationIDFunc(ctx, id)
+}
+
+func (q *Querier) GetSlackBotToken(ctx context.Context, teamID string) (string, error) {
+	q.calls.record("GetSlackBotToken")
+	if q.GetSlackBotTokenFunc == nil {
+		var zero string
+		return zero, notStubbed("GetSlackBotToken")
+	}
+	return q.GetSlackBotTokenFunc(ctx, teamID)
+}
+
+func (q *Querier) GetSlackInstallation(ctx context.Context, orgID int64) (genDb.SlackInstallation, error) {
+	q.calls.record("GetSlackInstallation")
+	if q.GetSlackInstallationFunc == nil {
+		var zero genDb.SlackInstallation
+		return zero, notStubbed("GetSlackInstallation")
+	}
+	return q.GetSlackInstallationFunc(ctx, orgID)
+}
+
+func (q *Querier) GetStatusPage(ctx context.Context, resourceID int64) (genDb.StatusPage, error) {
+	q.calls.record("GetStatusPage")
+	if q.GetStatusPageFunc == nil {
+		var zero genDb.StatusPage
+		return zero, notStubbed("GetStatusPage")
+	}
+	return q.GetStatusPageFunc(ctx, resourceID)
+}
+
+func (q *Querier) GetStatusPageByDomain(ctx context.Context, domain string) (genDb.GetStatusPageByDomainRow, error) {
+	q.calls.record("GetStatusPageByDomain")
+	if q.GetStatusPageByDomainFunc == nil {
+		var zero genDb.GetStatusPageByDomainRow
+		return zero, notStubbed("GetStatusPageByDomain")
+	}
+	return q.GetStatusPageByDomainFunc(ctx, domain)
+}
+
+func (q *Querier) GetToken(ctx context.Context, token string) (genDb.Token, error) {
+	q.calls.record("GetToken")
+	if q.GetTokenFunc == nil {
+		var zero genDb.Token
+		return zero, notStubbed("GetToken")
+	}
+	return q.GetTokenFunc(ctx, token)
+}
+
+func (q *Querier) GetTokenByName(ctx context.Context, arg genDb.GetTokenByNameParams) (genDb.GetTokenByNameRow, error) {
+	q.calls.record("GetTokenByName")
+	if q.GetTokenByNameFunc == nil {
+		var zero genDb.GetTokenByNameRow
+		return zero, notStubbed("GetTokenByName")
+	}
+	return q.GetTokenByNameFunc(ctx, arg)
+}
+
+func (q *Querier) GetUsedRefreshTokenFamily(ctx context.Context, tokenHash string) (string, error) {
+	q.calls.record("GetUsedRefreshTokenFamily")
+	if q.GetUsedRefreshTokenFamilyFunc == nil {
+		var zero string
+		return zero, notStubbed("GetUsedRefreshTokenFamily")
+	}
+	return q.GetUsedRefreshTokenFamilyFunc(ctx, tokenHash)
+}
+
+func (q *Querier) GetUserByEmail(ctx context.Context, email string) (genDb.User, error) {
+	q.calls.record("GetUserByEmail")
+	if q.GetUserByEmailFunc == nil {
+		var zero genDb.User
+		return zero, notStubbed("GetUserByEmail")
+	}
+	return q.GetUserByEmailFunc(ctx, email)
+}
+
+func (q *Querier) GetUserByExternalID(ctx context.Context, externalID string) (genDb.User, error) {
+	q.calls.record("GetUserByExternalID")
+	if q.GetUserByExternalIDFunc == nil {
+		var zero genDb.User
+		return zero, notStubbed("GetUserByExternalID")
+	}
+	return q.GetUserByExternalIDFunc(ctx, externalID)
+}
+
+func (q *Querier) GetUserByID(ctx context.Context, id int64) (genDb.User, error) {
+	q.calls.record("GetUserByID")
+	if q.GetUserByIDFunc == nil {
+		var zero genDb.User
+		return zero, notStubbed("GetUserByID")
+	}
+	return q.GetUserByIDFunc(ctx, id)
+}
+
+func (q *Querier) GetUserPassword(ctx context.Context, userID int64) (genDb.UserPassword, error) {
+	q.calls.record("GetUserPassword")
+	if q.GetUserPasswordFunc == nil {
+		var zero genDb.UserPassword
+		return zero, notStubbed("GetUserPassword")
+	}
+	return q.GetUserPasswordFunc(ctx, userID)
+}
+
+func (q *Querier) GetUserScopes(ctx context.Context, userID int64) ([]genDb.EntityScope, error) {
+	q.calls.record("GetUserScopes")
+	if q.GetUserScopesFunc == nil {
+		var zero []genDb.EntityScope
+		return zero, notStubbed("GetUserScopes")
+	}
+	return q.GetUserScopesFunc(ctx, userID)
+}
+
+func (q *Querier) GetUserScopesOnEntity(ctx context.Context, arg genDb.GetUserScopesOnEntityParams) ([]genDb.EntityScope, error) {
+	q.calls.record("GetUserScopesOnEntity")
+	if q.GetUserScopesOnEntityFunc == nil {
+		var zero []genDb.EntityScope
+		return zero, notStubbed("GetUserScopesOnEntity")
+	}
+	return q.GetUserScopesOnEntityFunc(ctx, arg)
+}
+
+func (q *Querier) GetUserScopesOnOrganization(ctx context.Context, arg genDb.GetUserScopesOnOrganizationParams) ([]genDb.EntityScope, error) {
+	q.calls.record("GetUserScopesOnOrganization")
+	if q.GetUserScopesOnOrganizationFunc == nil {
+		var zero []genDb.EntityScope
+		return zero, notStubbed("GetUserScopesOnOrganization")
+	}
+	return q.GetUserScopesOnOrganizationFunc(ctx, arg)
+}
+
+func (q *Querier) GetUserScopesOnWorkspace(ctx context.Context, arg genDb.GetUserScopesOnWorkspaceParams) ([]genDb.EntityScope, error) {
+	q.calls.record("GetUserScopesOnWorkspace")
+	if q.GetUserScopesOnWorkspaceFunc == nil {
+		var zero []genDb.EntityScope
+		return zero, notStubbed("GetUserScopesOnWorkspace")
+	}
+	return q.GetUserScopesOnWorkspaceFunc(ctx, arg)
+}
+
+func (q *Querier) GetUserTOTP(ctx context.Context, userID int64) (genDb.UserTotp, error) {
+	q.calls.record("GetUserTOTP")
+	if q.GetUserTOTPFunc == nil {
+		var zero genDb.UserTotp
+		return zero, notStubbed("GetUserTOTP")
+	}
+	return q.GetUserTOTPFunc(ctx, userID)
+}
+
+func (q *Querier) GetUserWithScopesByEmail(ctx context.Context, email string) (genDb.UserWithScopesView, error) {
+	q.calls.record("GetUserWithScopesByEmail")
+	if q.GetUserWithScopesByEmailFunc == nil {
+		var zero genDb.UserWithScopesView
+		return zero, notStubbed("GetUserWithScopesByEmail")
+	}
+	return q.GetUserWithScopesByEmailFunc(ctx, email)
+}
+
+func (q *Querier) GetUsersWithScopeOnEntity(ctx context.Context, arg genDb.GetUsersWithScopeOnEntityParams) ([]int64, error) {
+	q.calls.record("GetUsersWithScopeOnEntity")
+	if q.GetUsersWithScopeOnEntityFunc == nil {
+		var zero []int64
+		return zero, notStubbed("GetUsersWithScopeOnEntity")
+	}
+	return q.GetUsersWithScopeOnEntityFunc(ctx, arg)
+}
+
+func (q *Querier) GetWorkspaceByExternalID(ctx context.Context, arg genDb.GetWorkspaceByExternalIDParams) (genDb.Workspace, error) {
+	q.calls.record("GetWorkspaceByExternalID")
+	if q.GetWorkspaceByExternalIDFunc == nil {
+		var zero genDb.Workspace
+		return zero, notStubbed("GetWorkspaceByExternalID")
+	}
+	return q.GetWorkspaceByExternalIDFunc(ctx, arg)
+}
+
+func (q *Querier) GetWorkspaceByIDQuery(ctx context.Context, id int64) (genDb.Workspace, error) {
+	q.calls.record("GetWorkspaceByIDQuery")
+	if q.GetWorkspaceByIDQueryFunc == nil {
+		var zero genDb.Workspace
+		return zero, notStubbed("GetWorkspaceByIDQuery")
+	}
+	return q.GetWorkspaceByIDQueryFunc(ctx, id)
+}
+
+func (q *Querier) GetWorkspaceDeploymentCounts(ctx context.Context, arg genDb.GetWorkspaceDeploymentCountsParams) (genDb.GetWorkspaceDeploymentCountsRow, error) {
+	q.calls.record("GetWorkspaceDeploymentCounts")
+	if q.GetWorkspaceDeploymentCountsFunc == nil {
+		var zero genDb.GetWorkspaceDeploymentCountsRow
+		return zero, notStubbed("GetWorkspaceDeploymentCounts")
+	}
+	return q.GetWorkspaceDeploymentCountsFunc(ctx, arg)
+}
+
+func (q *Querier) GetWorkspaceDigest(ctx context.Context, arg genDb.GetWorkspaceDigestParams) (genDb.WorkspaceDigest, error) {
+	q.calls.record("GetWorkspaceDigest")
+	if q.GetWorkspaceDigestFunc == nil {
+		var zero genDb.WorkspaceDigest
+		return zero, notStubbed("GetWorkspaceDigest")
+	}
+	return q.GetWorkspaceDigestFunc(ctx, arg)
+}
+
+func (q *Querier) GetWorkspaceEnv(ctx context.Context, workspaceID int64) (genDb.WorkspaceEnv, error) {
+	q.calls.record("GetWorkspaceEnv")
+	if q.GetWorkspaceEnvFunc == nil {
+		var zero genDb.WorkspaceEnv
+		return zero, notStubbed("GetWorkspaceEnv")
+	}
+	return q.GetWorkspaceEnvFunc(ctx, workspaceID)
+}
+
+func (q *Querier) GetWorkspaceMember(ctx context.Context, arg genDb.GetWorkspaceMemberParams) (genDb.GetWorkspaceMemberRow, error) {
+	q.calls.record("GetWorkspaceMember")
+	if q.GetWorkspaceMemberFunc == nil {
+		var zero genDb.GetWorkspaceMemberRow
+		return zero, notStubbed("GetWorkspaceMember")
+	}
+	return q.GetWorkspaceMemberFunc(ctx, arg)
+}
+
+func (q *Querier) GetWorkspaceMemberRole(ctx context.Context, arg genDb.GetWorkspaceMemberRoleParams) (genDb.WorkspaceRole, error) {
+	q.calls.record("GetWorkspaceMemberRole")
+	if q.GetWorkspaceMemberRoleFunc == nil {
+		var zero genDb.WorkspaceRole
+		return zero, notStubbed("GetWorkspaceMemberRole")
+	}
+	return q.GetWorkspaceMemberRoleFunc(ctx, arg)
+}
+
+func (q *Querier) GetWorkspaceMembers(ctx context.Context, workspaceID int64) ([]genDb.WorkspaceMember, error) {
+	q.calls.record("GetWorkspaceMembers")
+	if q.GetWorkspaceMembersFunc == nil {
+		var zero []genDb.WorkspaceMember
+		return zero, notStubbed("GetWorkspaceMembers")
+	}
+	return q.GetWorkspaceMembersFunc(ctx, workspaceID)
+}
+
+func (q *Querier) GetWorkspaceOrgID(ctx context.Context, id int64) (int64, error) {
+	q.calls.record("GetWorkspaceOrgID")
+	if q.GetWorkspaceOrgIDFunc == nil {
+		var zero int64
+		return zero, notStubbed("GetWorkspaceOrgID")
+	}
+	return q.GetWorkspaceOrgIDFunc(ctx, id)
+}
+
+func (q *Querier) GetWorkspaceOrganizationIDByResourceID(ctx context.Context, id int64) (genDb.GetWorkspaceOrganizationIDByResourceIDRow, error) {
+	q.calls.record("GetWorkspaceOrganizationIDByResourceID")
+	if q.GetWorkspaceOrganizationIDByResourceIDFunc == nil {
+		var zero genDb.GetWorkspaceOrganizationIDByResourceIDRow
+		return zero, notStubbed("GetWorkspaceOrganizationIDByResourceID")
+	}
+	return q.GetWorkspaceOrganizationIDByResourceIDFunc(ctx, id)
+}
+
+func (q *Querier) GetWorkspaceProtection(ctx context.Context, id int64) (genDb.GetWorkspaceProtectionRow, error) {
+	q.calls.record("GetWorkspaceProtection")
+	if q.GetWorkspaceProtectionFunc == nil {
+		var zero genDb.GetWorkspaceProtectionRow
+		return zero, notStubbed("GetWorkspaceProtection")
+	}
+	return q.GetWorkspaceProtectionFunc(ctx, id)
+}
+
+func (q *Querier) GetWorkspaceSlackChannel(ctx context.Context, workspaceID int64) (genDb.WorkspaceSlackChannel, error) {
+	q.calls.record("GetWorkspaceSlackChannel")
+	if q.GetWorkspaceSlackChannelFunc == nil {
+		var zero genDb.WorkspaceSlackChannel
+		return zero, notStubbed("GetWorkspaceSlackChannel")
+	}
+	return q.GetWorkspaceSlackChannelFunc(ctx, workspaceID)
+}
+
+func (q *Querier) GetWorkspaceSlackTarget(ctx context.Context, workspaceID int64) (genDb.GetWorkspaceSlackTargetRow, error) {
+	q.calls.record("GetWorkspaceSlackTarget")
+	if q.GetWorkspaceSlackTargetFunc == nil {
+		var zero genDb.GetWorkspaceSlackTargetRow
+		return zero, notStubbed("GetWorkspaceSlackTarget")
+	}
+	return q.GetWorkspaceSlackTargetFunc(ctx, workspaceID)
+}
+
+func (q *Querier) InsertAdminAuditEntry(ctx context.Context, arg genDb.InsertAdminAuditEntryParams) (genDb.AdminAuditLog, error) {
+	q.calls.record("InsertAdminAuditEntry")
+	if q.InsertAdminAuditEntryFunc == nil {
+		var zero genDb.AdminAuditLog
+		return zero, notStubbed("InsertAdminAuditEntry")
+	}
+	return q.InsertAdminAuditEntryFunc(ctx, arg)
+}
+
+func (q *Querier) IsOrgMember(ctx context.Context, arg genDb.IsOrgMemberParams) (bool, error) {
+	q.calls.record("IsOrgMember")
+	if q.IsOrgMemberFunc == nil {
+		var zero bool
+		return zero, notStubbed("IsOrgMember")
+	}
+	return q.IsOrgMemberFunc(ctx, arg)
+}
+
+func (q *Querier) IsOrgNameUnique(ctx context.Context, name string) (bool, error) {
+	q.calls.record("IsOrgNameUnique")
+	if q.IsOrgNameUniqueFunc == nil {
+		var zero bool
+		return zero, notStubbed("IsOrgNameUnique")
+	}
+	return q.IsOrgNameUniqueFunc(ctx, name)
+}
+
+func (q *Querier) IsOrganizationNameUnique(ctx context.Context, name string) (bool, error) {
+	q.calls.record("IsOrganizationNameUnique")
+	if q.IsOrganizationNameUniqueFunc == nil {
+		var zero bool
+		return zero, notStubbed("IsOrganizationNameUnique")
+	}
+	return q.IsOrganizationNameUniqueFunc(ctx, name)
+}
+
+func (q *Querier) IsResourceOrgDelinquent(ctx context.Context, id int64) (bool, error) {
+	q.calls.record("IsResourceOrgDelinquent")
+	if q.IsResourceOrgDelinquentFunc == nil {
+		var zero bool
+		return zero, notStubbed("IsResourceOrgDelinquent")
+	}
+	return q.IsResourceOrgDelinquentFunc(ctx, id)
+}
+
+func (q *Querier) IsResourceWorkspaceArchived(ctx context.Context, id int64) (bool, error) {
+	q.calls.record("IsResourceWorkspaceArchived")
+	if q.IsResourceWorkspaceArchivedFunc == nil {
+		var zero bool
+		return zero, notStubbed("IsResourceWorkspaceArchived")
+	}
+	return q.IsResourceWorkspaceArchivedFunc(ctx, id)
+}
+
+func (q *Querier) IsWorkspaceMember(ctx context.Context, arg genDb.IsWorkspaceMemberParams) (bool, error) {
+	q.calls.record("IsWorkspaceMember")
+	if q.IsWorkspaceMemberFunc == nil {
+		var zero bool
+		return zero, notStubbed("IsWorkspaceMember")
+	}
+	return q.IsWorkspaceMemberFunc(ctx, arg)
+}
+
+func (q *Querier) IsWorkspaceNameUniqueInOrg(ctx context.Context, arg genDb.IsWorkspaceNameUniqueInOrgParams) (bool, error) {
+	q.calls.record("IsWorkspaceNameUniqueInOrg")
+	if q.IsWorkspaceNameUniqueInOrgFunc == nil {
+		var zero bool
+		return zero, notStubbed("IsWorkspaceNameUniqueInOrg")
+	}
+	return q.IsWorkspaceNameUniqueInOrgFunc(ctx, arg)
+}
+
+func (q *Querier) ListActiveDeployments(ctx context.Context) ([]int64, error) {
+	q.calls.record("ListActiveDeployments")
+	if q.ListActiveDeploymentsFunc == nil {
+		var zero []int64
+		return zero, notStubbed("ListActiveDeployments")
+	}
+	return q.ListActiveDeploymentsFunc(ctx)
+}
+
+func (q *Querier) ListActiveDeploymentsByResourceID(ctx context.Context, resourceID int64) ([]genDb.DeploymentStatus, error) {
+	q.calls.record("ListActiveDeploymentsByResourceID")
+	if q.ListActiveDeploymentsByResourceIDFunc == nil {
+		var zero []genDb.DeploymentStatus
+		return zero, notStubbed("ListActiveDeploymentsByResourceID")
+	}
+	return q.ListActiveDeploymentsByResourceIDFunc(ctx, resourceID)
+}
+
+func (q *Querier) ListActiveDeploymentsForDrift(ctx context.Context) ([]genDb.ListActiveDeploymentsForDriftRow, error) {
+	q.calls.record("ListActiveDeploymentsForDrift")
+	if q.ListActiveDeploymentsForDriftFunc == nil {
+		var zero []genDb.ListActiveDeploymentsForDriftRow
+		return zero, notStubbed("ListActiveDeploymentsForDrift")
+	}
+	return q.ListActiveDeploymentsForDriftFunc(ctx)
+}
+
+func (q *Querier) ListActiveDeploymentsForReconcile(ctx context.Context) ([]genDb.ListActiveDeploymentsForReconcileRow, error) {
+	q.calls.record("ListActiveDeploymentsForReconcile")
+	if q.ListActiveDeploymentsForReconcileFunc == nil {
+		var zero []genDb.ListActiveDeploymentsForReconcileRow
+		return zero, notStubbed("ListActiveDeploymentsForReconcile")
+	}
+	return q.ListActiveDeploymentsForReconcileFunc(ctx)
+}
+
+func (q *Querier) ListActiveDeploymentsForResource(ctx context.Context, resourceID int64) ([]genDb.Deployment, error) {
+	q.calls.record("ListActiveDeploymentsForResource")
+	if q.ListActiveDeploymentsForResourceFunc == nil {
+		var zero []genDb.Deployment
+		return zero, notStubbed("ListActiveDeploymentsForResource")
+	}
+	return q.ListActiveDeploymentsForResourceFunc(ctx, resourceID)
+}
+
+func (q *Querier) ListActivePlatformDomains(ctx context.Context) ([]genDb.PlatformDomain, error) {
+	q.calls.record("ListActivePlatformDomains")
+	if q.ListActivePlatformDomainsFunc == nil {
+		var zero []genDb.PlatformDomain
+		return zero, notStubbed("ListActivePlatformDomains")
+	}
+	return q.ListActivePlatformDomainsFunc(ctx)
+}
+
+func (q *Querier) ListAdminAuditEntries(ctx context.Context, arg genDb.ListAdminAuditEntriesParams) ([]genDb.AdminAuditLog, error) {
+	q.calls.record("ListAdminAuditEntries")
+	if q.ListAdminAuditEntriesFunc == nil {
+		var zero []genDb.AdminAuditLog
+		return zero, notStubbed("ListAdminAuditEntries")
+	}
+	return q.ListAdminAuditEntriesFunc(ctx, arg)
+}
+
+func (q *Querier) ListAlertRuleResources(ctx context.Context, arg genDb.ListAlertRuleResourcesParams) ([]genDb.ListAlertRuleResourcesRow, error) {
+	q.calls.record("ListAlertRuleResources")
+	if q.ListAlertRuleResourcesFunc == nil {
+		var zero []genDb.ListAlertRuleResourcesRow
+		return zero, notStubbed("ListAlertRuleResources")
+	}
+	return q.ListAlertRuleResourcesFunc(ctx, arg)
+}
+
+func (q *Querier) ListAlertRules(ctx context.Context, workspaceID int64) ([]genDb.AlertRule, error) {
+	q.calls.record("ListAlertRules")
+	if q.ListAlertRulesFunc == nil {
+		var zero []genDb.AlertRule
+		return zero, notStubbed("ListAlertRules")
+	}
+	return q.ListAlertRulesFunc(ctx, workspaceID)
+}
+
+func (q *Querier) ListAlertTargets(ctx context.Context, ruleIds []int64) ([]genDb.AlertTarget, error) {
+	q.calls.record("ListAlertTargets")
+	if q.ListAlertTargetsFunc == nil {
+		var zero []genDb.AlertTarget
+		return zero, notStubbed("ListAlertTargets")
+	}
+	return q.ListAlertTargetsFunc(ctx, ruleIds)
+}
+
+func (q *Querier) ListAllLocoOwnedDomains(ctx context.Context) ([]genDb.ListAllLocoOwnedDomainsRow, error) {
+	q.calls.record("ListAllLocoOwnedDomains")
+	if q.ListAllLocoOwnedDomainsFunc == nil {
+		var zero []genDb.ListAllLocoOwnedDomainsRow
+		return zero, notStubbed("ListAllLocoOwnedDomains")
+	}
+	return q.ListAllLocoOwnedDomainsFunc(ctx)
+}
+
+func (q *Querier) ListAllOrgs(ctx context.Context, arg genDb.ListAllOrgsParams) ([]genDb.Organization, error) {
+	q.calls.record("ListAllOrgs")
+	if q.ListAllOrgsFunc == nil {
+		var zero []genDb.Organization
+		return zero, notStubbed("ListAllOrgs")
+	}
+	return q.ListAllOrgsFunc(ctx, arg)
+}
+
+func (q *Querier) ListAllUserNotifications(ctx context.Context, userID int64) ([]genDb.Notification, error) {
+	q.calls.record("ListAllUserNotifications")
+	if q.ListAllUserNotificationsFunc == nil {
+		var zero []genDb.Notification
+		return zero, notStubbed("ListAllUserNotifications")
+	}
+	return q.ListAllUserNotificationsFunc(ctx, userID)
+}
+
+func (q *Querier) ListAnnouncements(ctx context.Context, arg genDb.ListAnnouncementsParams) ([]genDb.Announcement, error) {
+	q.calls.record("ListAnnouncements")
+	if q.ListAnnouncementsFunc == nil {
+		var zero []genDb.Announcement
+		return zero, notStubbed("ListAnnouncements")
+	}
+	return q.ListAnnouncementsFunc(ctx, arg)
+}
+
+func (q *Querier) ListApprovalRequestsForWorkspace(ctx context.Context, arg genDb.ListApprovalRequestsForWorkspaceParams) ([]genDb.ApprovalRequest, error) {
+	q.calls.record("ListApprovalRequestsForWorkspace")
+	if q.ListApprovalRequestsForWorkspaceFunc == nil {
+		var zero []genDb.ApprovalRequest
+		return zero, notStubbed("ListApprovalRequestsForWorkspace")
+	}
+	return q.ListApprovalRequestsForWorkspaceFunc(ctx, arg)
+}
+
+func (q *Querier) ListBillingPlans(ctx context.Context) ([]genDb.BillingPlan, error) {
+	q.calls.record("ListBillingPlans")
+	if q.ListBillingPlansFunc == nil {
+		var zero []genDb.BillingPlan
+		return zero, notStubbed("ListBillingPlans")
+	}
+	return q.ListBillingPlansFunc(ctx)
+}
+
+func (q *Querier) ListClusterDiscrepancies(ctx context.Context, workspaceID pgtype.Int8) ([]genDb.ClusterDiscrepancy, error) {
+	q.calls.record("ListClusterDiscrepancies")
+	if q.ListClusterDiscrepanciesFunc == nil {
+		var zero []genDb.ClusterDiscrepancy
+		return zero, notStubbed("ListClusterDiscrepancies")
+	}
+	return q.ListClusterDiscrepanciesFunc(ctx, workspaceID)
+}
+
+func (q *Querier) ListClusterNodePools(ctx context.Context, clusterID int64) ([]genDb.ClusterNodePool, error) {
+	q.calls.record("ListClusterNodePools")
+	if q.ListClusterNodePoolsFunc == nil {
+		var zero []genDb.ClusterNodePool
+		return zero, notStubbed("ListClusterNodePools")
+	}
+	return q.ListClusterNodePoolsFunc(ctx, clusterID)
+}
+
+func (q *Querier) ListClusters(ctx context.Context) ([]genDb.Cluster, error) {
+	q.calls.record("ListClusters")
+	if q.ListClustersFunc == nil {
+		var zero []genDb.Cluster
+		return zero, notStubbed("ListClusters")
+	}
+	return q.ListClustersFunc(ctx)
+}
+
+func (q *Querier) ListClustersActive(ctx context.Context) ([]genDb.Cluster, error) {
+	q.calls.record("ListClustersActive")
+	if q.ListClustersActiveFunc == nil {
+		var zero []genDb.Cluster
+		return zero, notStubbed("ListClustersActive")
+	}
+	return q.ListClustersActiveFunc(ctx)
+}
+
+func (q *Querier) ListConfigGroupResourceIDs(ctx context.Context, configGroupID int64) ([]int64, error) {
+	q.calls.record("ListConfigGroupResourceIDs")
+	if q.ListConfigGroupResourceIDsFunc == nil {
+		var zero []int64
+		return zero, notStubbed("ListConfigGroupResourceIDs")
+	}
+	return q.ListConfigGroupResourceIDsFunc(ctx, configGroupID)
+}
+
+func (q *Querier) ListConfigGroupsForWorkspace(ctx context.Context, workspaceID int64) ([]genDb.ConfigGroup, error) {
+	q.calls.record("ListConfigGroupsForWorkspace")
+	if q.ListConfigGroupsForWorkspaceFunc == nil {
+		var zero []genDb.ConfigGroup
+		return zero, notStubbed("ListConfigGroupsForWorkspace")
+	}
+	return q.ListConfigGroupsForWorkspaceFunc(ctx, workspaceID)
+}
+
+func (q *Querier) ListDNSRecords(ctx context.Context) ([]genDb.DnsRecord, error) {
+	q.calls.record("ListDNSRecords")
+	if q.ListDNSRecordsFunc == nil {
+		var zero []genDb.DnsRecord
+		return zero, notStubbed("ListDNSRecords")
+	}
+	return q.ListDNSRecordsFunc(ctx)
+}
+
+func (q *Querier) ListDeploymentsForResource(ctx context.Context, arg genDb.ListDeploymentsForResourceParams) ([]genDb.Deployment, error) {
+	q.calls.record("ListDeploymentsForResource")
+	if q.ListDeploymentsForResourceFunc == nil {
+		var zero []genDb.Deployment
+		return zero, notStubbed("ListDeploymentsForResource")
+	}
+	return q.ListDeploymentsForResourceFunc(ctx, arg)
+}
+
+func (q *Querier) ListDeprecatedUsage(ctx context.Context) ([]genDb.DeprecatedUsage, error) {
+	q.calls.record("ListDeprecatedUsage")
+	if q.ListDeprecatedUsageFunc == nil {
+		var zero []genDb.DeprecatedUsage
+		return zero, notStubbed("ListDeprecatedUsage")
+	}
+	return q.ListDeprecatedUsageFunc(ctx)
+}
+
+func (q *Querier) ListDirectoryGroupRoles(ctx context.Context, orgID int64) ([]genDb.DirectoryGroupRole, error) {
+	q.calls.record("ListDirectoryGroupRoles")
+	if q.ListDirectoryGroupRolesFunc == nil {
+		var zero []genDb.DirectoryGroupRole
+		return zero, notStubbed("ListDirectoryGroupRoles")
+	}
+	return q.ListDirectoryGroupRolesFunc(ctx, orgID)
+}
+
+func (q *Querier) ListEntityParents(ctx context.Context, arg genDb.ListEntityParentsParams) ([]genDb.ListEntityParentsRow, error) {
+	q.calls.record("ListEntityParents")
+	if q.ListEntityParentsFunc == nil {
+		var zero []genDb.ListEntityParentsRow
+		return zero, notStubbed("ListEntityParents")
+	}
+	return q.ListEntityParentsFunc(ctx, arg)
+}
+
+func (q *Querier) ListEnvironmentsForWorkspace(ctx context.Context, workspaceID int64) ([]genDb.Environment, error) {
+	q.calls.record("ListEnvironmentsForWorkspace")
+	if q.ListEnvironmentsForWorkspaceFunc == nil {
+		var zero []genDb.Environment
+		return zero, notStubbed("ListEnvironmentsForWorkspace")
+	}
+	return q.ListEnvironmentsForWorkspaceFunc(ctx, workspaceID)
+}
+
+func (q *Querier) ListFailedDeploymentsAfter(ctx context.Context, arg genDb.ListFailedDeploymentsAfterParams) ([]genDb.ListFailedDeploymentsAfterRow, error) {
+	q.calls.record("ListFailedDeploymentsAfter")
+	if q.ListFailedDeploymentsAfterFunc == nil {
+		var zero []genDb.ListFailedDeploymentsAfterRow
+		return zero, notStubbed("ListFailedDeploymentsAfter")
+	}
+	return q.ListFailedDeploymentsAfterFunc(ctx, arg)
+}
+
+func (q *Querier) ListFailedDeploymentsSince(ctx context.Context, arg genDb.ListFailedDeploymentsSinceParams) ([]genDb.ListFailedDeploymentsSinceRow, error) {
+	q.calls.record("ListFailedDeploymentsSince")
+	if q.ListFailedDeploymentsSinceFunc == nil {
+		var zero []genDb.ListFailedDeploymentsSinceRow
+		return zero, notStubbed("ListFailedDeploymentsSince")
+	}
+	return q.ListFailedDeploymentsSinceFunc(ctx, arg)
+}
+
+func (q *Querier) ListFiringAlertStates(ctx context.Context, ruleIds []int64) ([]genDb.AlertState, error) {
+	q.calls.record("ListFiringAlertStates")
+	if q.ListFiringAlertStatesFunc == nil {
+		var zero []genDb.AlertState
+		return zero, notStubbed("ListFiringAlertStates")
+	}
+	return q.ListFiringAlertStatesFunc(ctx, ruleIds)
+}
+
+func (q *Querier) ListIdleSleepCandidates(ctx context.Context, arg genDb.ListIdleSleepCandidatesParams) ([]genDb.ListIdleSleepCandidatesRow, error) {
+	q.calls.record("ListIdleSleepCandidates")
+	if q.ListIdleSleepCandidatesFunc == nil {
+		var zero []genDb.ListIdleSleepCandidatesRow
+		return zero, notStubbed("ListIdleSleepCandidates")
+	}
+	return q.ListIdleSleepCandidatesFunc(ctx, arg)
+}
+
+func (q *Querier) ListMeteredDeployments(ctx context.Context) ([]genDb.ListMeteredDeploymentsRow, error) {
+	q.calls.record("ListMeteredDeployments")
+	if q.ListMeteredDeploymentsFunc == nil {
+		var zero []genDb.ListMeteredDeploymentsRow
+		return zero, notStubbed("ListMeteredDeployments")
+	}
+	return q.ListMeteredDeploymentsFunc(ctx)
+}
+
+func (q *Querier) ListMultiRegionResources(ctx context.Context) ([]genDb.ListMultiRegionResourcesRow, error) {
+	q.calls.record("ListMultiRegionResources")
+	if q.ListMultiRegionResourcesFunc == nil {
+		var zero []genDb.ListMultiRegionResourcesRow
+		return zero, notStubbed("ListMultiRegionResources")
+	}
+	return q.ListMultiRegionResourcesFunc(ctx)
+}
+
+func (q *Querier) ListNotificationRecipients(ctx context.Context, arg genDb.ListNotificationRecipientsParams) ([]genDb.ListNotificationRecipientsRow, error) {
+	q.calls.record("ListNotificationRecipients")
+	if q.ListNotificationRecipientsFunc == nil {
+		var zero []genDb.ListNotificationRecipientsRow
+		return zero, notStubbed("ListNotificationRecipients")
+	}
+	return q.ListNotificationRecipientsFunc(ctx, arg)
+}
+
+func (q *Querier) ListNotifications(ctx context.Context, arg genDb.ListNotificationsParams) ([]genDb.Notification, error) {
+	q.calls.record("ListNotifications")
+	if q.ListNotificationsFunc == nil {
+		var zero []genDb.Notification
+		return zero, notStubbed("ListNotifications")
+	}
+	return q.ListNotificationsFunc(ctx, arg)
+}
+
+func (q *Querier) ListOrgDeletionResources(ctx context.Context, orgID int64) ([]genDb.ListOrgDeletionResourcesRow, error) {
+	q.calls.record("ListOrgDeletionResources")
+	if q.ListOrgDeletionResourcesFunc == nil {
+		var zero []genDb.ListOrgDeletionResourcesRow
+		return zero, notStubbed("ListOrgDeletionResources")
+	}
+	return q.ListOrgDeletionResourcesFunc(ctx, orgID)
+}
+
+func (q *Querier) ListOrgUsage(ctx context.Context, arg genDb.ListOrgUsageParams) ([]genDb.ListOrgUsageRow, error) {
+	q.calls.record("ListOrgUsage")
+	if q.ListOrgUsageFunc == nil {
+		var zero []genDb.ListOrgUsageRow
+		return zero, notStubbed("ListOrgUsage")
+	}
+	return q.ListOrgUsageFunc(ctx, arg)
+}
+
+func (q *Querier) ListOrganizationMembers(ctx context.Context, organizationID int64) ([]genDb.ListOrganizationMembersRow, error) {
+	q.calls.record("ListOrganizationMembers")
+	if q.ListOrganizationMembersFunc == nil {
+		var zero []genDb.ListOrganizationMembersRow
+		return zero, notStubbed("ListOrganizationMembers")
+	}
+	return q.ListOrganizationMembersFunc(ctx, organizationID)
+}
+
+func (q *Querier) ListOrgsForUser(ctx context.Context, arg genDb.ListOrgsForUserParams) ([]genDb.Organization, error) {
+	q.calls.record("ListOrgsForUser")
+	if q.ListOrgsForUserFunc == nil {
+		var zero []genDb.Organization
+		return zero, notStubbed("ListOrgsForUser")
+	}
+	return q.ListOrgsForUserFunc(ctx, arg)
+}
+
+func (q *Querier) ListPendingCustomDomains(ctx context.Context) ([]genDb.ListPendingCustomDomainsRow, error) {
+	q.calls.record("ListPendingCustomDomains")
+	if q.ListPendingCustomDomainsFunc == nil {
+		var zero []genDb.ListPendingCustomDomainsRow
+		return zero, notStubbed("ListPendingCustomDomains")
+	}
+	return q.ListPendingCustomDomainsFunc(ctx)
+}
+
+func (q *Querier) ListPlatformAdmins(ctx context.Context) ([]genDb.User, error) {
+	q.calls.record("ListPlatformAdmins")
+	if q.ListPlatformAdminsFunc == nil {
+		var zero []genDb.User
+		return zero, notStubbed("ListPlatformAdmins")
+	}
+	return q.ListPlatformAdminsFunc(ctx)
+}
+
+func (q *Querier) ListPlatformDomainTargets(ctx context.Context) ([]genDb.ListPlatformDomainTargetsRow, error) {
+	q.calls.record("ListPlatformDomainTargets")
+	if q.ListPlatformDomainTargetsFunc == nil {
+		var zero []genDb.ListPlatformDomainTargetsRow
+		return zero, notStubbed("ListPlatformDomainTargets")
+	}
+	return q.ListPlatformDomainTargetsFunc(ctx)
+}
+
+func (q *Querier) ListPlatformDomains(ctx context.Context, activeOnly pgtype.Bool) ([]genDb.PlatformDomain, error) {
+	q.calls.record("ListPlatformDomains")
+	if q.ListPlatformDomainsFunc == nil {
+		var zero []genDb.PlatformDomain
+		return zero, notStubbed("ListPlatformDomains")
+	}
+	return q.ListPlatformDomainsFunc(ctx, activeOnly)
+}
+
+func (q *Querier) ListPolicies(ctx context.Context, orgID int64) ([]genDb.Policy, error) {
+	q.calls.record("ListPolicies")
+	if q.ListPoliciesFunc == nil {
+		var zero []genDb.Policy
+		return zero, notStubbed("ListPolicies")
+	}
+	return q.ListPoliciesFunc(ctx, orgID)
+}
+
+func (q *Querier) ListRegionNodePools(ctx context.Context, region string) ([]genDb.ClusterNodePool, error) {
+	q.calls.record("ListRegionNodePools")
+	if q.ListRegionNodePoolsFunc == nil {
+		var zero []genDb.ClusterNodePool
+		return zero, notStubbed("ListRegionNodePools")
+	}
+	return q.ListRegionNodePoolsFunc(ctx, region)
+}
+
+func (q *Querier) ListRegionPricing(ctx context.Context) ([]genDb.ListRegionPricingRow, error) {
+	q.calls.record("ListRegionPricing")
+	if q.ListRegionPricingFunc == nil {
+		var zero []genDb.ListRegionPricingRow
+		return zero, notStubbed("ListRegionPricing")
+	}
+	return q.ListRegionPricingFunc(ctx)
+}
+
+func (q *Querier) ListResourceConfigGroups(ctx context.Context, resourceID int64) ([]genDb.ConfigGroup, error) {
+	q.calls.record("ListResourceConfigGroups")
+	if q.ListResourceConfigGroupsFunc == nil {
+		var zero []genDb.ConfigGroup
+		return zero, notStubbed("ListResourceConfigGroups")
+	}
+	return q.ListResourceConfigGroupsFunc(ctx, resourceID)
+}
+
+func (q *Querier) ListResourceDomains(ctx context.Context, resourceID int64) ([]genDb.ResourceDomain, error) {
+	q.calls.record("ListResourceDomains")
+	if q.ListResourceDomainsFunc == nil {
+		var zero []genDb.ResourceDomain
+		return zero, notStubbed("ListResourceDomains")
+	}
+	return q.ListResourceDomainsFunc(ctx, resourceID)
+}
+
+func (q *Querier) ListResourceDomainsForResources(ctx context.Context, resourceIds []int64) ([]genDb.ResourceDomain, error) {
+	q.calls.record("ListResourceDomainsForResources")
+	if q.ListResourceDomainsForResourcesFunc == nil {
+		var zero []genDb.ResourceDomain
+		return zero, notStubbed("ListResourceDomainsForResources")
+	}
+	return q.ListResourceDomainsForResourcesFunc(ctx, resourceIds)
+}
+
+func (q *Querier) ListResourceIDsForOrg(ctx context.Context, orgID int64) ([]int64, error) {
+	q.calls.record("ListResourceIDsForOrg")
+	if q.ListResourceIDsForOrgFunc == nil {
+		var zero []int64
+		return zero, notStubbed("ListResourceIDsForOrg")
+	}
+	return q.ListResourceIDsForOrgFunc(ctx, orgID)
+}
+
+func (q *Querier) ListResourceRegionOrigins(ctx context.Context, resourceID int64) ([]genDb.ListResourceRegionOriginsRow, error) {
+	q.calls.record("ListResourceRegionOrigins")
+	if q.ListResourceRegionOriginsFunc == nil {
+		var zero []genDb.ListResourceRegionOriginsRow
+		return zero, notStubbed("ListResourceRegionOrigins")
+	}
+	return q.ListResourceRegionOriginsFunc(ctx, resourceID)
+}
+
+func (q *Querier) ListResourceRegions(ctx context.Context, resourceID int64) ([]genDb.ResourceRegion, error) {
+	q.calls.record("ListResourceRegions")
+	if q.ListResourceRegionsFunc == nil {
+		var zero []genDb.ResourceRegion
+		return zero, notStubbed("ListResourceRegions")
+	}
+	return q.ListResourceRegionsFunc(ctx, resourceID)
+}
+
+func (q *Querier) ListResourceRegionsForResources(ctx context.Context, resourceIds []int64) ([]genDb.ResourceRegion, error) {
+	q.calls.record("ListResourceRegionsForResources")
+	if q.ListResourceRegionsForResourcesFunc == nil {
+		var zero []genDb.ResourceRegion
+		return zero, notStubbed("ListResourceRegionsForResources")
+	}
+	return q.ListResourceRegionsForResourcesFunc(ctx, resourceIds)
+}
+
+func (q *Querier) ListResourceRegionsForUpdate(ctx context.Context, resourceID int64) ([]genDb.ResourceRegion, error) {
+	q.calls.record("ListResourceRegionsForUpdate")
+	if q.ListResourceRegionsForUpdateFunc == nil {
+		var zero []genDb.ResourceRegion
+		return zero, notStubbed("ListResourceRegionsForUpdate")
+	}
+	return q.ListResourceRegionsForUpdateFunc(ctx, resourceID)
+}
+
+func (q *Querier) ListResourceStatusHistory(ctx context.Context, arg genDb.ListResourceStatusHistoryParams) ([]genDb.ResourceStatusHistory, error) {
+	q.calls.record("ListResourceStatusHistory")
+	if q.ListResourceStatusHistoryFunc == nil {
+		var zero []genDb.ResourceStatusHistory
+		return zero, notStubbed("ListResourceStatusHistory")
+	}
+	return q.ListResourceStatusHistoryFunc(ctx, arg)
+}
+
+func (q *Querier) ListResourceTimeline(ctx context.Context, arg genDb.ListResourceTimelineParams) ([]genDb.ListResourceTimelineRow, error) {
+	q.calls.record("ListResourceTimeline")
+	if q.ListResourceTimelineFunc == nil {
+		var zero []genDb.ListResourceTimelineRow
+		return zero, notStubbed("ListResourceTimeline")
+	}
+	return q.ListResourceTimelineFunc(ctx, arg)
+}
+
+func (q *Querier) ListResourcesForWorkspace(ctx context.Context, arg genDb.ListResourcesForWorkspaceParams) ([]genDb.Resource, error) {
+	q.calls.record("ListResourcesForWorkspace")
+	if q.ListResourcesForWorkspaceFunc == nil {
+		var zero []genDb.Resource
+		return zero, notStubbed("ListResourcesForWorkspace")
+	}
+	return q.ListResourcesForWorkspaceFunc(ctx, arg)
+}
+
+func (q *Querier) ListScimGroupMembers(ctx context.Context, groupID int64) ([]genDb.ListScimGroupMembersRow, error) {
+	q.calls.record("ListScimGroupMembers")
+	if q.ListScimGroupMembersFunc == nil {
+		var zero []genDb.ListScimGroupMembersRow
+		return zero, notStubbed("ListScimGroupMembers")
+	}
+	return q.ListScimGroupMembersFunc(ctx, groupID)
+}
+
+func (q *Querier) ListScimGroupMembersByName(ctx context.Context, arg genDb.ListScimGroupMembersByNameParams) ([]int64, error) {
+	q.calls.record("ListScimGroupMembersByName")
+	if q.ListScimGroupMembersByNameFunc == nil {
+		var zero []int64
+		return zero, notStubbed("ListScimGroupMembersByName")
+	}
+	return q.ListScimGroupMembersByNameFunc(ctx, arg)
+}
+
+func (q *Querier) ListScimGroups(ctx context.Context, arg genDb.ListScimGroupsParams) ([]genDb.ScimGroup, error) {
+	q.calls.record("ListScimGroups")
+	if q.ListScimGroupsFunc == nil {
+		var zero []genDb.ScimGroup
+		return zero, notStubbed("ListScimGroups")
+	}
+	return q.ListScimGroupsFunc(ctx, arg)
+}
+
+func (q *Querier) ListScimUserGroupScopes(ctx context.Context, scimUserID int64) ([][]genDb.EntityScope, error) {
+	q.calls.record("ListScimUserGroupScopes")
+	if q.ListScimUserGroupScopesFunc == nil {
+		var zero [][]genDb.EntityScope
+		return zero, notStubbed("ListScimUserGroupScopes")
+	}
+	return q.ListScimUserGroupScopesFunc(ctx, scimUserID)
+}
+
+func (q *Querier) ListScimUsers(ctx context.Context, arg genDb.ListScimUsersParams) ([]genDb.ScimUser, error) {
+	q.calls.record("ListScimUsers")
+	if q.ListScimUsersFunc == nil {
+		var zero []genDb.ScimUser
+		return zero, notStubbed("ListScimUsers")
+	}
+	return q.ListScimUsersFunc(ctx, arg)
+}
+
+func (q *Querier) ListServiceAccountsForWorkspace(ctx context.Context, workspaceID int64) ([]genDb.ServiceAccount, error) {
+	q.calls.record("ListServiceAccountsForWorkspace")
+	if q.ListServiceAccountsForWorkspaceFunc == nil {
+		var zero []genDb.ServiceAccount
+		return zero, notStubbed("ListServiceAccountsForWorkspace")
+	}
+	return q.ListServiceAccountsForWorkspaceFunc(ctx, workspaceID)
+}
+
+func (q *Querier) ListSlackChannelWorkspaces(ctx context.Context, arg genDb.ListSlackChannelWorkspacesParams) ([]genDb.ListSlackChannelWorkspacesRow, error) {
+	q.calls.record("ListSlackChannelWorkspaces")
+	if q.ListSlackChannelWorkspacesFunc == nil {
+		var zero []genDb.ListSlackChannelWorkspacesRow
+		return zero, notStubbed("ListSlackChannelWorkspaces")
+	}
+	return q.ListSlackChannelWorkspacesFunc(ctx, arg)
+}
+
+func (q *Querier) ListSolelyOwnedOrganizations(ctx context.Context, userID int64) ([]genDb.Organization, error) {
+	q.calls.record("ListSolelyOwnedOrganizations")
+	if q.ListSolelyOwnedOrganizationsFunc == nil {
+		var zero []genDb.Organization
+		return zero, notStubbed("ListSolelyOwnedOrganizations")
+	}
+	return q.ListSolelyOwnedOrganizationsFunc(ctx, userID)
+}
+
+func (q *Querier) ListStuckDeployments(ctx context.Context, cutoff pgtype.Timestamptz) ([]genDb.ListStuckDeploymentsRow, error) {
+	q.calls.record("ListStuckDeployments")
+	if q.ListStuckDeploymentsFunc == nil {
+		var zero []genDb.ListStuckDeploymentsRow
+		return zero, notStubbed("ListStuckDeployments")
+	}
+	return q.ListStuckDeploymentsFunc(ctx, cutoff)
+}
+
+func (q *Querier) ListTokensForEntity(ctx context.Context, arg genDb.ListTokensForEntityParams) ([]genDb.ListTokensForEntityRow, error) {
+	q.calls.record("ListTokensForEntity")
+	if q.ListTokensForEntityFunc == nil {
+		var zero []genDb.ListTokensForEntityRow
+		return zero, notStubbed("ListTokensForEntity")
+	}
+	return q.ListTokensForEntityFunc(ctx, arg)
+}
+
+func (q *Querier) ListUnreportedUsage(ctx context.Context, limit int32) ([]genDb.ListUnreportedUsageRow, error) {
+	q.calls.record("ListUnreportedUsage")
+	if q.ListUnreportedUsageFunc == nil {
+		var zero []genDb.ListUnreportedUsageRow
+		return zero, notStubbed("ListUnreportedUsage")
+	}
+	return q.ListUnreportedUsageFunc(ctx, limit)
+}
+
+func (q *Querier) ListUserMFAOrganizations(ctx context.Context, userID int64) ([]genDb.ListUserMFAOrganizationsRow, error) {
+	q.calls.record("ListUserMFAOrganizations")
+	if q.ListUserMFAOrganizationsFunc == nil {
+		var zero []genDb.ListUserMFAOrganizationsRow
+		return zero, notStubbed("ListUserMFAOrganizations")
+	}
+	return q.ListUserMFAOrganizationsFunc(ctx, userID)
+}
+
+func (q *Querier) ListUserNotificationPreferences(ctx context.Context, userID int64) ([]genDb.UserNotificationPreference, error) {
+	q.calls.record("ListUserNotificationPreferences")
+	if q.ListUserNotificationPreferencesFunc == nil {
+		var zero []genDb.UserNotificationPreference
+		return zero, notStubbed("ListUserNotificationPreferences")
+	}
+	return q.ListUserNotificationPreferencesFunc(ctx, userID)
+}
+
+func (q *Querier) ListUserOrganizations(ctx context.Context, userID int64) ([]genDb.ListUserOrganizationsRow, error) {
+	q.calls.record("ListUserOrganizations")
+	if q.ListUserOrganizationsFunc == nil {
+		var zero []genDb.ListUserOrganizationsRow
+		return zero, notStubbed("ListUserOrganizations")
+	}
+	return q.ListUserOrganizationsFunc(ctx, userID)
+}
+
+func (q *Querier) ListUserWorkspaces(ctx context.Context, userID int64) ([]genDb.ListUserWorkspacesRow, error) {
+	q.calls.record("ListUserWorkspaces")
+	if q.ListUserWorkspacesFunc == nil {
+		var zero []genDb.ListUserWorkspacesRow
+		return zero, notStubbed("ListUserWorkspaces")
+	}
+	return q.ListUserWorkspacesFunc(ctx, userID)
+}
+
+func (q *Querier) ListUsers(ctx context.Context, arg genDb.ListUsersParams) ([]genDb.User, error) {
+	q.calls.record("ListUsers")
+	if q.ListUsersFunc == nil {
+		var zero []genDb.User
+		return zero, notStubbed("ListUsers")
+	}
+	return q.ListUsersFunc(ctx, arg)
+}
+
+func (q *Querier) ListWorkspaceEnvStaleResources(ctx context.Context, workspaceID int64) ([]genDb.ListWorkspaceEnvStaleResourcesRow, error) {
+	q.calls.record("ListWorkspaceEnvStaleResources")
+	if q.ListWorkspaceEnvStaleResourcesFunc == nil {
+		var zero []genDb.ListWorkspaceEnvStaleResourcesRow
+		return zero, notStubbed("ListWorkspaceEnvStaleResources")
+	}
+	return q.ListWorkspaceEnvStaleResourcesFunc(ctx, workspaceID)
+}
+
+func (q *Querier) ListWorkspaceMembers(ctx context.Context, workspaceID int64) ([]genDb.ListWorkspaceMembersRow, error) {
+	q.calls.record("ListWorkspaceMembers")
+	if q.ListWorkspaceMembersFunc == nil {
+		var zero []genDb.ListWorkspaceMembersRow
+		return zero, notStubbed("ListWorkspaceMembers")
+	}
+	return q.ListWorkspaceMembersFunc(ctx, workspaceID)
+}
+
+func (q *Querier) ListWorkspaceMembersWithUserDetails(ctx context.Context, arg genDb.ListWorkspaceMembersWithUserDetailsParams) ([]genDb.ListWorkspaceMembersWithUserDetailsRow, error) {
+	q.calls.record("ListWorkspaceMembersWithUserDetails")
+	if q.ListWorkspaceMembersWithUserDetailsFunc == nil {
+		var zero []genDb.ListWorkspaceMembersWithUserDetailsRow
+		return zero, notStubbed("ListWorkspaceMembersWithUserDetails")
+	}
+	return q.ListWorkspaceMembersWithUserDetailsFunc(ctx, arg)
+}
+
+func (q *Querier) ListWorkspaceNamesForOrg(ctx context.Context, orgID int64) ([]genDb.ListWorkspaceNamesForOrgRow, error) {
+	q.calls.record("ListWorkspaceNamesForOrg")
+	if q.ListWorkspaceNamesForOrgFunc == nil {
+		var zero []genDb.ListWorkspaceNamesForOrgRow
+		return zero, notStubbed("ListWorkspaceNamesForOrg")
+	}
+	return q.ListWorkspaceNamesForOrgFunc(ctx, orgID)
+}
+
+func (q *Querier) ListWorkspaceNotificationDefaults(ctx context.Context, workspaceID int64) ([]genDb.WorkspaceNotificationDefault, error) {
+	q.calls.record("ListWorkspaceNotificationDefaults")
+	if q.ListWorkspaceNotificationDefaultsFunc == nil {
+		var zero []genDb.WorkspaceNotificationDefault
+		return zero, notStubbed("ListWorkspaceNotificationDefaults")
+	}
+	return q.ListWorkspaceNotificationDefaultsFunc(ctx, workspaceID)
+}
+
+func (q *Querier) ListWorkspacePolicies(ctx context.Context, id int64) ([]genDb.Policy, error) {
+	q.calls.record("ListWorkspacePolicies")
+	if q.ListWorkspacePoliciesFunc == nil {
+		var zero []genDb.Policy
+		return zero, notStubbed("ListWorkspacePolicies")
+	}
+	return q.ListWorkspacePoliciesFunc(ctx, id)
+}
+
+func (q *Querier) ListWorkspaceTopResources(ctx context.Context, arg genDb.ListWorkspaceTopResourcesParams) ([]genDb.ListWorkspaceTopResourcesRow, error) {
+	q.calls.record("ListWorkspaceTopResources")
+	if q.ListWorkspaceTopResourcesFunc == nil {
+		var zero []genDb.ListWorkspaceTopResourcesRow
+		return zero, notStubbed("ListWorkspaceTopResources")
+	}
+	return q.ListWorkspaceTopResourcesFunc(ctx, arg)
+}
+
+func (q *Querier) ListWorkspacesForOrg(ctx context.Context, arg genDb.ListWorkspacesForOrgParams) ([]genDb.ListWorkspacesForOrgRow, error) {
+	q.calls.record("ListWorkspacesForOrg")
+	if q.ListWorkspacesForOrgFunc == nil {
+		var zero []genDb.ListWorkspacesForOrgRow
+		return zero, notStubbed("ListWorkspacesForOrg")
+	}
+	return q.ListWorkspacesForOrgFunc(ctx, arg)
+}
+
+func (q *Querier) ListWorkspacesForUser(ctx context.Context, arg genDb.ListWorkspacesForUserParams) ([]genDb.Workspace, error) {
+	q.calls.record("ListWorkspacesForUser")
+	if q.ListWorkspacesForUserFunc == nil {
+		var zero []genDb.Workspace
+		return zero, notStubbed("ListWorkspacesForUser")
+	}
+	return q.ListWorkspacesForUserFunc(ctx, arg)
+}
+
+func (q *Querier) ListWorkspacesInOrg(ctx context.Context, arg genDb.ListWorkspacesInOrgParams) ([]genDb.Workspace, error) {
+	q.calls.record("ListWorkspacesInOrg")
+	if q.ListWorkspacesInOrgFunc == nil {
+		var zero []genDb.Workspace
+		return zero, notStubbed("ListWorkspacesInOrg")
+	}
+	return q.ListWorkspacesInOrgFunc(ctx, arg)
+}
+
+func (q *Querier) ListWorkspacesWithoutDigest(ctx context.Context, arg genDb.ListWorkspacesWithoutDigestParams) ([]genDb.ListWorkspacesWithoutDigestRow, error) {
+	q.calls.record("ListWorkspacesWithoutDigest")
+	if q.ListWorkspacesWithoutDigestFunc == nil {
+		var zero []genDb.ListWorkspacesWithoutDigestRow
+		return zero, notStubbed("ListWorkspacesWithoutDigest")
+	}
+	return q.ListWorkspacesWithoutDigestFunc(ctx, arg)
+}
+
+func (q *Querier) LockResource(ctx context.Context, arg genDb.LockResourceParams) (genDb.ResourceLock, error) {
+	q.calls.record("LockResource")
+	if q.LockResourceFunc == nil {
+		var zero genDb.ResourceLock
+		return zero, notStubbed("LockResource")
+	}
+	return q.LockResourceFunc(ctx, arg)
+}
+
+func (q *Querier) LockResourceDeployments(ctx context.Context, resourceID int64) error {
+	q.calls.record("LockResourceDeployments")
+	if q.LockResourceDeploymentsFunc == nil {
+		return notStubbed("LockResourceDeployments")
+	}
+	return q.LockResourceDeploymentsFunc(ctx, resourceID)
+}
+
+func (q *Querier) LockResourceDomains(ctx context.Context, id int64) error {
+	q.calls.record("LockResourceDomains")
+	if q.LockResourceDomainsFunc == nil {
+		return notStubbed("LockResourceDomains")
+	}
+	return q.LockResourceDomainsFunc(ctx, id)
+}
+
+func (q *Querier) MarkAllNotificationsRead(ctx context.Context, arg genDb.MarkAllNotificationsReadParams) error {
+	q.calls.record("MarkAllNotificationsRead")
+	if q.MarkAllNotificationsReadFunc == nil {
+		return notStubbed("MarkAllNotificationsRead")
+	}
+	return q.MarkAllNotificationsReadFunc(ctx, arg)
+}
+
+func (q *Querier) MarkApprovalRequestExecuted(ctx context.Context, arg genDb.MarkApprovalRequestExecutedParams) (genDb.ApprovalRequest, error) {
+	q.calls.record("MarkApprovalRequestExecuted")
+	if q.MarkApprovalRequestExecutedFunc == nil {
+		var zero genDb.ApprovalRequest
+		return zero, notStubbed("MarkApprovalRequestExecuted")
+	}
+	return q.MarkApprovalRequestExecutedFunc(ctx, arg)
+}
+
+func (q *Querier) MarkDeploymentNotActive(ctx context.Context, id int64) error {
+	q.calls.record("MarkDeploymentNotActive")
+	if q.MarkDeploymentNotActiveFunc == nil {
+		return notStubbed("MarkDeploymentNotActive")
+	}
+	return q.MarkDeploymentNotActiveFunc(ctx, id)
+}
+
+func (q *Querier) MarkNotificationFailed(ctx context.Context, arg genDb.MarkNotificationFailedParams) error {
+	q.calls.record("MarkNotificationFailed")
+	if q.MarkNotificationFailedFunc == nil {
+		return notStubbed("MarkNotificationFailed")
+	}
+	return q.MarkNotificationFailedFunc(ctx, arg)
+}
+
+func (q *Querier) MarkNotificationRead(ctx context.Context, arg genDb.MarkNotificationReadParams) (int64, error) {
+	q.calls.record("MarkNotificationRead")
+	if q.MarkNotificationReadFunc == nil {
+		var zero int64
+		return zero, notStubbed("MarkNotificationRead")
+	}
+	return q.MarkNotificationReadFunc(ctx, arg)
+}
+
+func (q *Querier) MarkNotificationSent(ctx context.Context, id int64) error {
+	q.calls.record("MarkNotificationSent")
+	if q.MarkNotificationSentFunc == nil {
+		return notStubbed("MarkNotificationSent")
+	}
+	return q.MarkNotificationSentFunc(ctx, id)
+}
+
+func (q *Querier) MarkPreviousDeploymentsNotActive(ctx context.Context, resourceID int64) error {
+	q.calls.record("MarkPreviousDeploymentsNotActive")
+	if q.MarkPreviousDeploymentsNotActiveFunc == nil {
+		return notStubbed("MarkPreviousDeploymentsNotActive")
+	}
+	return q.MarkPreviousDeploymentsNotActiveFunc(ctx, resourceID)
+}
+
+func (q *Querier) MarkResourceProbeDegraded(ctx context.Context, id int64) (int64, error) {
+	q.calls.record("MarkResourceProbeDegraded")
+	if q.MarkResourceProbeDegradedFunc == nil {
+		var zero int64
+		return zero, notStubbed("MarkResourceProbeDegraded")
+	}
+	return q.MarkResourceProbeDegradedFunc(ctx, id)
+}
+
+func (q *Querier) MarkSessionMFAVerified(ctx context.Context, accessToken pgtype.Text) error {
+	q.calls.record("MarkSessionMFAVerified")
+	if q.MarkSessionMFAVerifiedFunc == nil {
+		return notStubbed("MarkSessionMFAVerified")
+	}
+	return q.MarkSessionMFAVerifiedFunc(ctx, accessToken)
+}
+
+func (q *Querier) MarkUsageReported(ctx context.Context, id int64) error {
+	q.calls.record("MarkUsageReported")
+	if q.MarkUsageReportedFunc == nil {
+		return notStubbed("MarkUsageReported")
+	}
+	return q.MarkUsageReportedFunc(ctx, id)
+}
+
+func (q *Querier) MarkUserPasswordVerified(ctx context.Context, userID int64) error {
+	q.calls.record("MarkUserPasswordVerified")
+	if q.MarkUserPasswordVerifiedFunc == nil {
+		return notStubbed("MarkUserPasswordVerified")
+	}
+	return q.MarkUserPasswordVerifiedFunc(ctx, userID)
+}
+
+func (q *Querier) OrgHasWorkspacesWithResources(ctx context.Context, orgID int64) (bool, error) {
+	q.calls.record("OrgHasWorkspacesWithResources")
+	if q.OrgHasWorkspacesWithResourcesFunc == nil {
+		var zero bool
+		return zero, notStubbed("OrgHasWorkspacesWithResources")
+	}
+	return q.OrgHasWorkspacesWithResourcesFunc(ctx, orgID)
+}
+
+func (q *Querier) PruneDeploymentHistory(ctx context.Context) (int64, error) {
+	q.calls.record("PruneDeploymentHistory")
+	if q.PruneDeploymentHistoryFunc == nil {
+		var zero int64
+		return zero, notStubbed("PruneDeploymentHistory")
+	}
+	return q.PruneDeploymentHistoryFunc(ctx)
+}
+
+func (q *Querier) RecordConfigRolloutDeployment(ctx context.Context, arg genDb.RecordConfigRolloutDeploymentParams) error {
+	q.calls.record("RecordConfigRolloutDeployment")
+	if q.RecordConfigRolloutDeploymentFunc == nil {
+		return notStubbed("RecordConfigRolloutDeployment")
+	}
+	return q.RecordConfigRolloutDeploymentFunc(ctx, arg)
+}
+
+func (q *Querier) RecordConfigRolloutProgress(ctx context.Context, id int64) error {
+	q.calls.record("RecordConfigRolloutProgress")
+	if q.RecordConfigRolloutProgressFunc == nil {
+		return notStubbed("RecordConfigRolloutProgress")
+	}
+	return q.RecordConfigRolloutProgressFunc(ctx, id)
+}
+
+func (q *Querier) RecordDeprecatedUsage(ctx context.Context, arg genDb.RecordDeprecatedUsageParams) error {
+	q.calls.record("RecordDeprecatedUsage")
+	if q.RecordDeprecatedUsageFunc == nil {
+		return notStubbed("RecordDeprecatedUsage")
+	}
+	return q.RecordDeprecatedUsageFunc(ctx, arg)
+}
+
+func (q *Querier) RecordOrgDeletionProgress(ctx context.Context, id int64) error {
+	q.calls.record("RecordOrgDeletionProgress")
+	if q.RecordOrgDeletionProgressFunc == nil {
+		return notStubbed("RecordOrgDeletionProgress")
+	}
+	return q.RecordOrgDeletionProgressFunc(ctx, id)
+}
+
+func (q *Querier) RecordResourceProbe(ctx context.Context, arg genDb.RecordResourceProbeParams) (int32, error) {
+	q.calls.record("RecordResourceProbe")
+	if q.RecordResourceProbeFunc == nil {
+		var zero int32
+		return zero, notStubbed("RecordResourceProbe")
+	}
+	return q.RecordResourceProbeFunc(ctx, arg)
+}
+
+func (q *Querier) RecordUsage(ctx context.Context, arg genDb.RecordUsageParams) (int64, error) {
+	q.calls.record("RecordUsage")
+	if q.RecordUsageFunc == nil {
+		var zero int64
+		return zero, notStubbed("RecordUsage")
+	}
+	return q.RecordUsageFunc(ctx, arg)
+}
+
+func (q *Querier) RemoveAllScimGroupMembers(ctx context.Context, groupID int64) error {
+	q.calls.record("RemoveAllScimGroupMembers")
+	if q.RemoveAllScimGroupMembersFunc == nil {
+		return notStubbed("RemoveAllScimGroupMembers")
+	}
+	return q.RemoveAllScimGroupMembersFunc(ctx, groupID)
+}
+
+func (q *Querier) RemoveAllScopesForEntity(ctx context.Context, arg genDb.RemoveAllScopesForEntityParams) error {
+	q.calls.record("RemoveAllScopesForEntity")
+	if q.RemoveAllScopesForEntityFunc == nil {
+		return notStubbed("RemoveAllScopesForEntity")
+	}
+	return q.RemoveAllScopesForEntityFunc(ctx, arg)
+}
+
+func (q *Querier) RemoveAllScopesForUserOnEntity(ctx context.Context, arg genDb.RemoveAllScopesForUserOnEntityParams) error {
+	q.calls.record("RemoveAllScopesForUserOnEntity")
+	if q.RemoveAllScopesForUserOnEntityFunc == nil {
+		return notStubbed("RemoveAllScopesForUserOnEntity")
+	}
+	return q.RemoveAllScopesForUserOnEntityFunc(ctx, arg)
+}
+
+func (q *Querier) RemoveAllServiceAccountScopes(ctx context.Context, serviceAccountID int64) error {
+	q.calls.record("RemoveAllServiceAccountScopes")
+	if q.RemoveAllServiceAccountScopesFunc == nil {
+		return notStubbed("RemoveAllServiceAccountScopes")
+	}
+	return q.RemoveAllServiceAccountScopesFunc(ctx, serviceAccountID)
+}
+
+func (q *Querier) RemoveOrganizationMember(ctx context.Context, arg genDb.RemoveOrganizationMemberParams) error {
+	q.calls.record("RemoveOrganizationMember")
+	if q.RemoveOrganizationMemberFunc == nil {
+		return notStubbed("RemoveOrganizationMember")
+	}
+	return q.RemoveOrganizationMemberFunc(ctx, arg)
+}
+
+func (q *Querier) RemoveScimGroupMember(ctx context.Context, arg genDb.RemoveScimGroupMemberParams) error {
+	q.calls.record("RemoveScimGroupMember")
+	if q.RemoveScimGroupMemberFunc == nil {
+		return notStubbed("RemoveScimGroupMember")
+	}
+	return q.RemoveScimGroupMemberFunc(ctx, arg)
+}
+
+func (q *Querier) RemoveUserScope(ctx context.Context, arg genDb.RemoveUserScopeParams) error {
+	q.calls.record("RemoveUserScope")
+	if q.RemoveUserScopeFunc == nil {
+		return notStubbed("RemoveUserScope")
+	}
+	return q.RemoveUserScopeFunc(ctx, arg)
+}
+
+func (q *Querier) RemoveWorkspace(ctx context.Context, id int64) error {
+	q.calls.record("RemoveWorkspace")
+	if q.RemoveWorkspaceFunc == nil {
+		return notStubbed("RemoveWorkspace")
+	}
+	return q.RemoveWorkspaceFunc(ctx, id)
+}
+
+func (q *Querier) RemoveWorkspaceMember(ctx context.Context, arg genDb.RemoveWorkspaceMemberParams) error {
+	q.calls.record("RemoveWorkspaceMember")
+	if q.RemoveWorkspaceMemberFunc == nil {
+		return notStubbed("RemoveWorkspaceMember")
+	}
+	return q.RemoveWorkspaceMemberFunc(ctx, arg)
+}
+
+func (q *Querier) ReopenApprovalRequest(ctx context.Context, id int64) error {
+	q.calls.record("ReopenApprovalRequest")
+	if q.ReopenApprovalRequestFunc == nil {
+		return notStubbed("ReopenApprovalRequest")
+	}
+	return q.ReopenApprovalRequestFunc(ctx, id)
+}
+
+func (q *Querier) ResumeResource(ctx context.Context, id int64) (genDb.Resource, error) {
+	q.calls.record("ResumeResource")
+	if q.ResumeResourceFunc == nil {
+		var zero genDb.Resource
+		return zero, notStubbed("ResumeResource")
+	}
+	return q.ResumeResourceFunc(ctx, id)
+}
+
+func (q *Querier) ResumeWorkspaceResourcesFromArchive(ctx context.Context, workspaceID int64) ([]genDb.Resource, error) {
+	q.calls.record("ResumeWorkspaceResourcesFromArchive")
+	if q.ResumeWorkspaceResourcesFromArchiveFunc == nil {
+		var zero []genDb.Resource
+		return zero, notStubbed("ResumeWorkspaceResourcesFromArchive")
+	}
+	return q.ResumeWorkspaceResourcesFromArchiveFunc(ctx, workspaceID)
+}
+
+func (q *Querier) SetDNSRecordError(ctx context.Context, arg genDb.SetDNSRecordErrorParams) error {
+	q.calls.record("SetDNSRecordError")
+	if q.SetDNSRecordErrorFunc == nil {
+		return notStubbed("SetDNSRecordError")
+	}
+	return q.SetDNSRecordErrorFunc(ctx, arg)
+}
+
+func (q *Querier) SetDeploymentDrift(ctx context.Context, arg genDb.SetDeploymentDriftParams) error {
+	q.calls.record("SetDeploymentDrift")
+	if q.SetDeploymentDriftFunc == nil {
+		return notStubbed("SetDeploymentDrift")
+	}
+	return q.SetDeploymentDriftFunc(ctx, arg)
+}
+
+func (q *Querier) SetOrgDeletionProtection(ctx context.Context, arg genDb.SetOrgDeletionProtectionParams) (genDb.Organization, error) {
+	q.calls.record("SetOrgDeletionProtection")
+	if q.SetOrgDeletionProtectionFunc == nil {
+		var zero genDb.Organization
+		return zero, notStubbed("SetOrgDeletionProtection")
+	}
+	return q.SetOrgDeletionProtectionFunc(ctx, arg)
+}
+
+func (q *Querier) SetOrgDelinquent(ctx context.Context, arg genDb.SetOrgDelinquentParams) (int64, error) {
+	q.calls.record("SetOrgDelinquent")
+	if q.SetOrgDelinquentFunc == nil {
+		var zero int64
+		return zero, notStubbed("SetOrgDelinquent")
+	}
+	return q.SetOrgDelinquentFunc(ctx, arg)
+}
+
+func (q *Querier) SetOrgRequireMFA(ctx context.Context, arg genDb.SetOrgRequireMFAParams) (genDb.Organization, error) {
+	q.calls.record("SetOrgRequireMFA")
+	if q.SetOrgRequireMFAFunc == nil {
+		var zero genDb.Organization
+		return zero, notStubbed("SetOrgRequireMFA")
+	}
+	return q.SetOrgRequireMFAFunc(ctx, arg)
+}
+
+func (q *Querier) SetOrgSubscription(ctx context.Context, arg genDb.SetOrgSubscriptionParams) (genDb.OrgBilling, error) {
+	q.calls.record("SetOrgSubscription")
+	if q.SetOrgSubscriptionFunc == nil {
+		var zero genDb.OrgBilling
+		return zero, notStubbed("SetOrgSubscription")
+	}
+	return q.SetOrgSubscriptionFunc(ctx, arg)
+}
+
+func (q *Querier) SetPlatformDomainActive(ctx context.Context, arg genDb.SetPlatformDomainActiveParams) (genDb.PlatformDomain, error) {
+	q.calls.record("SetPlatformDomainActive")
+	if q.SetPlatformDomainActiveFunc == nil {
+		var zero genDb.PlatformDomain
+		return zero, notStubbed("SetPlatformDomainActive")
+	}
+	return q.SetPlatformDomainActiveFunc(ctx, arg)
+}
+
+func (q *Querier) SetResourceDomainAccessControl(ctx context.Context, arg genDb.SetResourceDomainAccessControlParams) (genDb.ResourceDomain, error) {
+	q.calls.record("SetResourceDomainAccessControl")
+	if q.SetResourceDomainAccessControlFunc == nil {
+		var zero genDb.ResourceDomain
+		return zero, notStubbed("SetResourceDomainAccessControl")
+	}
+	return q.SetResourceDomainAccessControlFunc(ctx, arg)
+}
+
+func (q *Querier) SetResourceDomainPrimary(ctx context.Context, arg genDb.SetResourceDomainPrimaryParams) (int64, error) {
+	q.calls.record("SetResourceDomainPrimary")
+	if q.SetResourceDomainPrimaryFunc == nil {
+		var zero int64
+		return zero, notStubbed("SetResourceDomainPrimary")
+	}
+	return q.SetResourceDomainPrimaryFunc(ctx, arg)
+}
+
+func (q *Querier) SetResourceDomainRedirect(ctx context.Context, arg genDb.SetResourceDomainRedirectParams) (genDb.ResourceDomain, error) {
+	q.calls.record("SetResourceDomainRedirect")
+	if q.SetResourceDomainRedirectFunc == nil {
+		var zero genDb.ResourceDomain
+		return zero, notStubbed("SetResourceDomainRedirect")
+	}
+	return q.SetResourceDomainRedirectFunc(ctx, arg)
+}
+
+func (q *Querier) SetResourceDomainStatus(ctx context.Context, arg genDb.SetResourceDomainStatusParams) error {
+	q.calls.record("SetResourceDomainStatus")
+	if q.SetResourceDomainStatusFunc == nil {
+		return notStubbed("SetResourceDomainStatus")
+	}
+	return q.SetResourceDomainStatusFunc(ctx, arg)
+}
+
+func (q *Querier) SetResourceDomainTLSPolicy(ctx context.Context, arg genDb.SetResourceDomainTLSPolicyParams) (genDb.ResourceDomain, error) {
+	q.calls.record("SetResourceDomainTLSPolicy")
+	if q.SetResourceDomainTLSPolicyFunc == nil {
+		var zero genDb.ResourceDomain
+		return zero, notStubbed("SetResourceDomainTLSPolicy")
+	}
+	return q.SetResourceDomainTLSPolicyFunc(ctx, arg)
+}
+
+func (q *Querier) SetScimUserGrantedScopes(ctx context.Context, arg genDb.SetScimUserGrantedScopesParams) error {
+	q.calls.record("SetScimUserGrantedScopes")
+	if q.SetScimUserGrantedScopesFunc == nil {
+		return notStubbed("SetScimUserGrantedScopes")
+	}
+	return q.SetScimUserGrantedScopesFunc(ctx, arg)
+}
+
+func (q *Querier) SetUserPasswordHash(ctx context.Context, arg genDb.SetUserPasswordHashParams) error {
+	q.calls.record("SetUserPasswordHash")
+	if q.SetUserPasswordHashFunc == nil {
+		return notStubbed("SetUserPasswordHash")
+	}
+	return q.SetUserPasswordHashFunc(ctx, arg)
+}
+
+func (q *Querier) SleepResource(ctx context.Context, resourceID int64) (int64, error) {
+	q.calls.record("SleepResource")
+	if q.SleepResourceFunc == nil {
+		var zero int64
+		return zero, notStubbed("SleepResource")
+	}
+	return q.SleepResourceFunc(ctx, resourceID)
+}
+
+func (q *Querier) StoreToken(ctx context.Context, arg genDb.StoreTokenParams) error {
+	q.calls.record("StoreToken")
+	if q.StoreTokenFunc == nil {
+		return notStubbed("StoreToken")
+	}
+	return q.StoreTokenFunc(ctx, arg)
+}
+
+func (q *Querier) SuspendResource(ctx context.Context, arg genDb.SuspendResourceParams) (genDb.Resource, error) {
+	q.calls.record("SuspendResource")
+	if q.SuspendResourceFunc == nil {
+		var zero genDb.Resource
+		return zero, notStubbed("SuspendResource")
+	}
+	return q.SuspendResourceFunc(ctx, arg)
+}
+
+func (q *Querier) SuspendWorkspaceResourcesForArchive(ctx context.Context, arg genDb.SuspendWorkspaceResourcesForArchiveParams) ([]genDb.Resource, error) {
+	q.calls.record("SuspendWorkspaceResourcesForArchive")
+	if q.SuspendWorkspaceResourcesForArchiveFunc == nil {
+		var zero []genDb.Resource
+		return zero, notStubbed("SuspendWorkspaceResourcesForArchive")
+	}
+	return q.SuspendWorkspaceResourcesForArchiveFunc(ctx, arg)
+}
+
+func (q *Querier) SyncOrgSubscription(ctx context.Context, arg genDb.SyncOrgSubscriptionParams) (int64, error) {
+	q.calls.record("SyncOrgSubscription")
+	if q.SyncOrgSubscriptionFunc == nil {
+		var zero int64
+		return zero, notStubbed("SyncOrgSubscription")
+	}
+	return q.SyncOrgSubscriptionFunc(ctx, arg)
+}
+
+func (q *Querier) SyncProbeTargets(ctx context.Context) error {
+	q.calls.record("SyncProbeTargets")
+	if q.SyncProbeTargetsFunc == nil {
+		return notStubbed("SyncProbeTargets")
+	}
+	return q.SyncProbeTargetsFunc(ctx)
+}
+
+func (q *Querier) TransferCreatedOrganizations(ctx context.Context, userID int64) error {
+	q.calls.record("TransferCreatedOrganizations")
+	if q.TransferCreatedOrganizationsFunc == nil {
+		return notStubbed("TransferCreatedOrganizations")
+	}
+	return q.TransferCreatedOrganizationsFunc(ctx, userID)
+}
+
+func (q *Querier) TransferCreatedWorkspaces(ctx context.Context, createdBy int64) error {
+	q.calls.record("TransferCreatedWorkspaces")
+	if q.TransferCreatedWorkspacesFunc == nil {
+		return notStubbed("TransferCreatedWorkspaces")
+	}
+	return q.TransferCreatedWorkspacesFunc(ctx, createdBy)
+}
+
+func (q *Querier) UnarchiveWorkspace(ctx context.Context, id int64) (genDb.Workspace, error) {
+	q.calls.record("UnarchiveWorkspace")
+	if q.UnarchiveWorkspaceFunc == nil {
+		var zero genDb.Workspace
+		return zero, notStubbed("UnarchiveWorkspace")
+	}
+	return q.UnarchiveWorkspaceFunc(ctx, id)
+}
+
+func (q *Querier) UnlockResource(ctx context.Context, resourceID int64) error {
+	q.calls.record("UnlockResource")
+	if q.UnlockResourceFunc == nil {
+		return notStubbed("UnlockResource")
+	}
+	return q.UnlockResourceFunc(ctx, resourceID)
+}
+
+func (q *Querier) UpdateActiveDeploymentStatus(ctx context.Context, arg genDb.UpdateActiveDeploymentStatusParams) error {
+	q.calls.record("UpdateActiveDeploymentStatus")
+	if q.UpdateActiveDeploymentStatusFunc == nil {
+		return notStubbed("UpdateActiveDeploymentStatus")
+	}
+	return q.UpdateActiveDeploymentStatusFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateAlertRule(ctx context.Context, arg genDb.UpdateAlertRuleParams) (genDb.AlertRule, error) {
+	q.calls.record("UpdateAlertRule")
+	if q.UpdateAlertRuleFunc == nil {
+		var zero genDb.AlertRule
+		return zero, notStubbed("UpdateAlertRule")
+	}
+	return q.UpdateAlertRuleFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateCluster(ctx context.Context, arg genDb.UpdateClusterParams) (genDb.Cluster, error) {
+	q.calls.record("UpdateCluster")
+	if q.UpdateClusterFunc == nil {
+		var zero genDb.Cluster
+		return zero, notStubbed("UpdateCluster")
+	}
+	return q.UpdateClusterFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateConfigGroup(ctx context.Context, arg genDb.UpdateConfigGroupParams) (genDb.ConfigGroup, error) {
+	q.calls.record("UpdateConfigGroup")
+	if q.UpdateConfigGroupFunc == nil {
+		var zero genDb.ConfigGroup
+		return zero, notStubbed("UpdateConfigGroup")
+	}
+	return q.UpdateConfigGroupFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateDeploymentStatus(ctx context.Context, arg genDb.UpdateDeploymentStatusParams) error {
+	q.calls.record("UpdateDeploymentStatus")
+	if q.UpdateDeploymentStatusFunc == nil {
+		return notStubbed("UpdateDeploymentStatus")
+	}
+	return q.UpdateDeploymentStatusFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateDeploymentStatusAndActive(ctx context.Context, arg genDb.UpdateDeploymentStatusAndActiveParams) error {
+	q.calls.record("UpdateDeploymentStatusAndActive")
+	if q.UpdateDeploymentStatusAndActiveFunc == nil {
+		return notStubbed("UpdateDeploymentStatusAndActive")
+	}
+	return q.UpdateDeploymentStatusAndActiveFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateDeploymentStatusWithMessage(ctx context.Context, arg genDb.UpdateDeploymentStatusWithMessageParams) error {
+	q.calls.record("UpdateDeploymentStatusWithMessage")
+	if q.UpdateDeploymentStatusWithMessageFunc == nil {
+		return notStubbed("UpdateDeploymentStatusWithMessage")
+	}
+	return q.UpdateDeploymentStatusWithMessageFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateEnvironment(ctx context.Context, arg genDb.UpdateEnvironmentParams) (genDb.Environment, error) {
+	q.calls.record("UpdateEnvironment")
+	if q.UpdateEnvironmentFunc == nil {
+		var zero genDb.Environment
+		return zero, notStubbed("UpdateEnvironment")
+	}
+	return q.UpdateEnvironmentFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateOrgName(ctx context.Context, arg genDb.UpdateOrgNameParams) (genDb.Organization, error) {
+	q.calls.record("UpdateOrgName")
+	if q.UpdateOrgNameFunc == nil {
+		var zero genDb.Organization
+		return zero, notStubbed("UpdateOrgName")
+	}
+	return q.UpdateOrgNameFunc(ctx, arg)
+}
+
+func (q *Querier) UpdatePolicy(ctx context.Context, arg genDb.UpdatePolicyParams) (genDb.Policy, error) {
+	q.calls.record("UpdatePolicy")
+	if q.UpdatePolicyFunc == nil {
+		var zero genDb.Policy
+		return zero, notStubbed("UpdatePolicy")
+	}
+	return q.UpdatePolicyFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateResource(ctx context.Context, arg genDb.UpdateResourceParams) (int64, error) {
+	q.calls.record("UpdateResource")
+	if q.UpdateResourceFunc == nil {
+		var zero int64
+		return zero, notStubbed("UpdateResource")
+	}
+	return q.UpdateResourceFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateResourceDomain(ctx context.Context, arg genDb.UpdateResourceDomainParams) (int64, error) {
+	q.calls.record("UpdateResourceDomain")
+	if q.UpdateResourceDomainFunc == nil {
+		var zero int64
+		return zero, notStubbed("UpdateResourceDomain")
+	}
+	return q.UpdateResourceDomainFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateResourceDomainPrimary(ctx context.Context, resourceID int64) error {
+	q.calls.record("UpdateResourceDomainPrimary")
+	if q.UpdateResourceDomainPrimaryFunc == nil {
+		return notStubbed("UpdateResourceDomainPrimary")
+	}
+	return q.UpdateResourceDomainPrimaryFunc(ctx, resourceID)
+}
+
+func (q *Querier) UpdateResourceRegionFailover(ctx context.Context, arg genDb.UpdateResourceRegionFailoverParams) error {
+	q.calls.record("UpdateResourceRegionFailover")
+	if q.UpdateResourceRegionFailoverFunc == nil {
+		return notStubbed("UpdateResourceRegionFailover")
+	}
+	return q.UpdateResourceRegionFailoverFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateResourceSpec(ctx context.Context, arg genDb.UpdateResourceSpecParams) error {
+	q.calls.record("UpdateResourceSpec")
+	if q.UpdateResourceSpecFunc == nil {
+		return notStubbed("UpdateResourceSpec")
+	}
+	return q.UpdateResourceSpecFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateResourceStatus(ctx context.Context, arg genDb.UpdateResourceStatusParams) error {
+	q.calls.record("UpdateResourceStatus")
+	if q.UpdateResourceStatusFunc == nil {
+		return notStubbed("UpdateResourceStatus")
+	}
+	return q.UpdateResourceStatusFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateScimGroup(ctx context.Context, arg genDb.UpdateScimGroupParams) (genDb.ScimGroup, error) {
+	q.calls.record("UpdateScimGroup")
+	if q.UpdateScimGroupFunc == nil {
+		var zero genDb.ScimGroup
+		return zero, notStubbed("UpdateScimGroup")
+	}
+	return q.UpdateScimGroupFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateScimUser(ctx context.Context, arg genDb.UpdateScimUserParams) (genDb.ScimUser, error) {
+	q.calls.record("UpdateScimUser")
+	if q.UpdateScimUserFunc == nil {
+		var zero genDb.ScimUser
+		return zero, notStubbed("UpdateScimUser")
+	}
+	return q.UpdateScimUserFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateUserAvatarURL(ctx context.Context, arg genDb.UpdateUserAvatarURLParams) (genDb.User, error) {
+	q.calls.record("UpdateUserAvatarURL")
+	if q.UpdateUserAvatarURLFunc == nil {
+		var zero genDb.User
+		return zero, notStubbed("UpdateUserAvatarURL")
+	}
+	return q.UpdateUserAvatarURLFunc(ctx, arg)
+}
+
+func (q *Querier) UpdateWorkspace(ctx context.Context, arg genDb.UpdateWorkspaceParams) (int64, error) {
+	q.calls.record("UpdateWorkspace")
+	if q.UpdateWorkspaceFunc == nil {
+		var zero int64
+		return zero, notStubbed("UpdateWorkspace")
+	}
+	return q.UpdateWorkspaceFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertAlertState(ctx context.Context, arg genDb.UpsertAlertStateParams) error {
+	q.calls.record("UpsertAlertState")
+	if q.UpsertAlertStateFunc == nil {
+		return notStubbed("UpsertAlertState")
+	}
+	return q.UpsertAlertStateFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertAnnouncementReadMarker(ctx context.Context, arg genDb.UpsertAnnouncementReadMarkerParams) error {
+	q.calls.record("UpsertAnnouncementReadMarker")
+	if q.UpsertAnnouncementReadMarkerFunc == nil {
+		return notStubbed("UpsertAnnouncementReadMarker")
+	}
+	return q.UpsertAnnouncementReadMarkerFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertClusterDiscrepancy(ctx context.Context, arg genDb.UpsertClusterDiscrepancyParams) error {
+	q.calls.record("UpsertClusterDiscrepancy")
+	if q.UpsertClusterDiscrepancyFunc == nil {
+		return notStubbed("UpsertClusterDiscrepancy")
+	}
+	return q.UpsertClusterDiscrepancyFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertDNSRecord(ctx context.Context, arg genDb.UpsertDNSRecordParams) error {
+	q.calls.record("UpsertDNSRecord")
+	if q.UpsertDNSRecordFunc == nil {
+		return notStubbed("UpsertDNSRecord")
+	}
+	return q.UpsertDNSRecordFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertDeploymentProvenance(ctx context.Context, arg genDb.UpsertDeploymentProvenanceParams) error {
+	q.calls.record("UpsertDeploymentProvenance")
+	if q.UpsertDeploymentProvenanceFunc == nil {
+		return notStubbed("UpsertDeploymentProvenance")
+	}
+	return q.UpsertDeploymentProvenanceFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertDirectoryGroupRoles(ctx context.Context, arg genDb.UpsertDirectoryGroupRolesParams) error {
+	q.calls.record("UpsertDirectoryGroupRoles")
+	if q.UpsertDirectoryGroupRolesFunc == nil {
+		return notStubbed("UpsertDirectoryGroupRoles")
+	}
+	return q.UpsertDirectoryGroupRolesFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertGitOpsApplication(ctx context.Context, arg genDb.UpsertGitOpsApplicationParams) error {
+	q.calls.record("UpsertGitOpsApplication")
+	if q.UpsertGitOpsApplicationFunc == nil {
+		return notStubbed("UpsertGitOpsApplication")
+	}
+	return q.UpsertGitOpsApplicationFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertImageScan(ctx context.Context, arg genDb.UpsertImageScanParams) (genDb.ImageScan, error) {
+	q.calls.record("UpsertImageScan")
+	if q.UpsertImageScanFunc == nil {
+		var zero genDb.ImageScan
+		return zero, notStubbed("UpsertImageScan")
+	}
+	return q.UpsertImageScanFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertSlackInstallation(ctx context.Context, arg genDb.UpsertSlackInstallationParams) error {
+	q.calls.record("UpsertSlackInstallation")
+	if q.UpsertSlackInstallationFunc == nil {
+		return notStubbed("UpsertSlackInstallation")
+	}
+	return q.UpsertSlackInstallationFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertStatusPage(ctx context.Context, arg genDb.UpsertStatusPageParams) (genDb.StatusPage, error) {
+	q.calls.record("UpsertStatusPage")
+	if q.UpsertStatusPageFunc == nil {
+		var zero genDb.StatusPage
+		return zero, notStubbed("UpsertStatusPage")
+	}
+	return q.UpsertStatusPageFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertUserNotificationPreference(ctx context.Context, arg genDb.UpsertUserNotificationPreferenceParams) error {
+	q.calls.record("UpsertUserNotificationPreference")
+	if q.UpsertUserNotificationPreferenceFunc == nil {
+		return notStubbed("UpsertUserNotificationPreference")
+	}
+	return q.UpsertUserNotificationPreferenceFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertUserTOTP(ctx context.Context, arg genDb.UpsertUserTOTPParams) error {
+	q.calls.record("UpsertUserTOTP")
+	if q.UpsertUserTOTPFunc == nil {
+		return notStubbed("UpsertUserTOTP")
+	}
+	return q.UpsertUserTOTPFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertWorkspaceEnv(ctx context.Context, arg genDb.UpsertWorkspaceEnvParams) (genDb.WorkspaceEnv, error) {
+	q.calls.record("UpsertWorkspaceEnv")
+	if q.UpsertWorkspaceEnvFunc == nil {
+		var zero genDb.WorkspaceEnv
+		return zero, notStubbed("UpsertWorkspaceEnv")
+	}
+	return q.UpsertWorkspaceEnvFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertWorkspaceMember(ctx context.Context, arg genDb.UpsertWorkspaceMemberParams) (int64, error) {
+	q.calls.record("UpsertWorkspaceMember")
+	if q.UpsertWorkspaceMemberFunc == nil {
+		var zero int64
+		return zero, notStubbed("UpsertWorkspaceMember")
+	}
+	return q.UpsertWorkspaceMemberFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertWorkspaceNotificationDefault(ctx context.Context, arg genDb.UpsertWorkspaceNotificationDefaultParams) error {
+	q.calls.record("UpsertWorkspaceNotificationDefault")
+	if q.UpsertWorkspaceNotificationDefaultFunc == nil {
+		return notStubbed("UpsertWorkspaceNotificationDefault")
+	}
+	return q.UpsertWorkspaceNotificationDefaultFunc(ctx, arg)
+}
+
+func (q *Querier) UpsertWorkspaceSlackChannel(ctx context.Context, arg genDb.UpsertWorkspaceSlackChannelParams) (genDb.WorkspaceSlackChannel, error) {
+	q.calls.record("UpsertWorkspaceSlackChannel")
+	if q.UpsertWorkspaceSlackChannelFunc == nil {
+		var zero genDb.WorkspaceSlackChannel
+		return zero, notStubbed("UpsertWorkspaceSlackChannel")
+	}
+	return q.UpsertWorkspaceSlackChannelFunc(ctx, arg)
+}
+
+func (q *Querier) UseRefreshToken(ctx context.Context, tokenHash string) (genDb.UseRefreshTokenRow, error) {
+	q.calls.record("UseRefreshToken")
+	if q.UseRefreshTokenFunc == nil {
+		var zero genDb.UseRefreshTokenRow
+		return zero, notStubbed("UseRefreshToken")
+	}
+	return q.UseRefreshTokenFunc(ctx, tokenHash)
+}
+
+func (q *Querier) UseUserTOTPStep(ctx context.Context, arg genDb.UseUserTOTPStepParams) (int64, error) {
+	q.calls.record("UseUserTOTPStep")
+	if q.UseUserTOTPStepFunc == nil {
+		var zero int64
+		return zero, notStubbed("UseUserTOTPStep")
+	}
+	return q.UseUserTOTPStepFunc(ctx, arg)
+}
+
+func (q *Querier) UserRequiresMFA(ctx context.Context, userID int64) (bool, error) {
+	q.calls.record("UserRequiresMFA")
+	if q.UserRequiresMFAFunc == nil {
+		var zero bool
+		return zero, notStubbed("UserRequiresMFA")
+	}
+	return q.UserRequiresMFAFunc(ctx, userID)
+}
+
+func (q *Querier) WakeResource(ctx context.Context, resourceID int64) (int64, error) {
+	q.calls.record("WakeResource")
+	if q.WakeResourceFunc == nil {
+		var zero int64
+		return zero, notStubbed("WakeResource")
+	}
+	return q.WakeResourceFunc(ctx, resourceID)
+}
+
+func (q *Querier) WorkspaceHasResourcesWithLabels(ctx context.Context, arg genDb.WorkspaceHasResourcesWithLabelsParams) (bool, error) {
+	q.calls.record("WorkspaceHasResourcesWithLabels")
+	if q.WorkspaceHasResourcesWithLabelsFunc == nil {
+		var zero bool
+		return zero, notStubbed("WorkspaceHasResourcesWithLabels")
+	}
+	return q.WorkspaceHasResourcesWithLabelsFunc(ctx, arg)
+}
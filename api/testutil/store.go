@@ -0,0 +1,515 @@
+package testutil
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	genDb "github.com/team-loco/loco/api/gen/db"
+)
+
+// Store is a Querier holding users, organizations, workspaces, environments, resources with their
+// regions and domains, and user scopes in memory. It answers the queries that create and look
+// these up, including the parent lookups the token vending machine makes for implied scopes, so a
+// handler can be run against a small seeded world. Other queries are stubbed on the embedded
+// Querier as usual, and setting a func field overrides the in-memory version of a query too.
+//
+// Lookups of missing rows fail with pgx.ErrNoRows and duplicate user emails or resource names
+// with a unique_violation, as they would in Postgres.
+type Store struct {
+	*Querier
+
+	mu           sync.Mutex
+	lastID       int64
+	users        map[int64]genDb.User
+	orgs         map[int64]genDb.Organization
+	workspaces   map[int64]genDb.Workspace
+	environments map[int64]genDb.Environment
+	resources    map[int64]genDb.Resource
+	regions      map[int64]genDb.ResourceRegion
+	domains      map[int64]genDb.ResourceDomain
+	userScopes   map[int64][]genDb.EntityScope
+}
+
+// NewStore returns an empty Store.
+func NewStore() *Store {
+	s := &Store{
+		Querier:      &Querier{},
+		users:        map[int64]genDb.User{},
+		orgs:         map[int64]genDb.Organization{},
+		workspaces:   map[int64]genDb.Workspace{},
+		environments: map[int64]genDb.Environment{},
+		resources:    map[int64]genDb.Resource{},
+		regions:      map[int64]genDb.ResourceRegion{},
+		domains:      map[int64]genDb.ResourceDomain{},
+		userScopes:   map[int64][]genDb.EntityScope{},
+	}
+
+	q := s.Querier
+	q.CreateUserFunc = s.createUser
+	q.GetUserByIDFunc = s.getUserByID
+	q.GetUserByEmailFunc = s.getUserByEmail
+	q.CreateOrganizationFunc = s.createOrganization
+	q.GetOrganizationByIDFunc = s.getOrganizationByID
+	q.CreateWorkspaceFunc = s.createWorkspace
+	q.GetWorkspaceByIDQueryFunc = s.getWorkspaceByID
+	q.GetOrganizationIDByWorkspaceIDFunc = s.getWorkspaceOrgID
+	q.GetWorkspaceOrgIDFunc = s.getWorkspaceOrgID
+	q.CreateEnvironmentFunc = s.createEnvironment
+	q.GetEnvironmentByIDFunc = s.getEnvironmentByID
+	q.GetEnvironmentWorkspaceOrganizationIDFunc = s.getEnvironmentParents
+	q.CreateResourceFunc = s.createResource
+	q.GetResourceByIDFunc = s.getResourceByID
+	q.GetResourceByNameAndWorkspaceFunc = s.getResourceByName
+	q.GetWorkspaceOrganizationIDByResourceIDFunc = s.getResourceParents
+	q.ListResourcesForWorkspaceFunc = s.listResourcesForWorkspace
+	q.CreateResourceRegionFunc = s.createResourceRegion
+	q.ListResourceRegionsFunc = s.listResourceRegions
+	q.ListResourceRegionsForResourcesFunc = s.listResourceRegionsForResources
+	q.CreateResourceDomainFunc = s.createResourceDomain
+	q.ListResourceDomainsFunc = s.listResourceDomains
+	q.ListResourceDomainsForResourcesFunc = s.listResourceDomainsForResources
+	q.CheckDomainAvailabilityFunc = s.checkDomainAvailability
+	q.ListEntityParentsFunc = s.listEntityParents
+	q.AddUserScopeFunc = s.addUserScope
+	q.GetUserScopesFunc = s.getUserScopes
+	return s
+}
+
+// nextID hands out ids from one sequence for every table, so an id is never valid for two
+// entities by accident. The caller holds s.mu.
+func (s *Store) nextID() int64 {
+	s.lastID++
+	return s.lastID
+}
+
+func now() pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: time.Now(), Valid: true}
+}
+
+func uniqueViolation(constraint string) error {
+	return &pgconn.PgError{Code: "23505", ConstraintName: constraint, Message: fmt.Sprintf("duplicate key value violates unique constraint %q", constraint)}
+}
+
+func (s *Store) createUser(_ context.Context, arg genDb.CreateUserParams) (genDb.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, user := range s.users {
+		if user.Email == arg.Email {
+			return genDb.User{}, uniqueViolation("users_email_key")
+		}
+	}
+	user := genDb.User{
+		ID:         s.nextID(),
+		ExternalID: arg.ExternalID,
+		Email:      arg.Email,
+		Name:       arg.Name,
+		AvatarUrl:  arg.AvatarUrl,
+		CreatedAt:  now(),
+		UpdatedAt:  now(),
+	}
+	s.users[user.ID] = user
+	return user, nil
+}
+
+func (s *Store) getUserByID(_ context.Context, id int64) (genDb.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	user, ok := s.users[id]
+	if !ok {
+		return genDb.User{}, pgx.ErrNoRows
+	}
+	return user, nil
+}
+
+func (s *Store) getUserByEmail(_ context.Context, email string) (genDb.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, user := range s.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return genDb.User{}, pgx.ErrNoRows
+}
+
+func (s *Store) createOrganization(_ context.Context, arg genDb.CreateOrganizationParams) (genDb.CreateOrganizationRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	org := genDb.Organization{
+		ID:        s.nextID(),
+		Name:      arg.Name,
+		CreatedBy: arg.CreatedBy,
+		CreatedAt: now(),
+		UpdatedAt: now(),
+	}
+	s.orgs[org.ID] = org
+	return genDb.CreateOrganizationRow{ID: org.ID, Name: org.Name, CreatedBy: org.CreatedBy, CreatedAt: org.CreatedAt, UpdatedAt: org.UpdatedAt}, nil
+}
+
+func (s *Store) getOrganizationByID(_ context.Context, id int64) (genDb.GetOrganizationByIDRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	org, ok := s.orgs[id]
+	if !ok {
+		return genDb.GetOrganizationByIDRow{}, pgx.ErrNoRows
+	}
+	return genDb.GetOrganizationByIDRow{ID: org.ID, Name: org.Name, CreatedBy: org.CreatedBy, CreatedAt: org.CreatedAt, UpdatedAt: org.UpdatedAt}, nil
+}
+
+func (s *Store) createWorkspace(_ context.Context, arg genDb.CreateWorkspaceParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.orgs[arg.OrgID]; !ok {
+		return 0, fmt.Errorf("testutil: workspace in unknown org %d", arg.OrgID)
+	}
+	wks := genDb.Workspace{
+		ID:          s.nextID(),
+		OrgID:       arg.OrgID,
+		Name:        arg.Name,
+		Description: arg.Description,
+		CreatedBy:   arg.CreatedBy,
+		CreatedAt:   now(),
+		UpdatedAt:   now(),
+		ExternalID:  arg.ExternalID,
+		Labels:      arg.Labels,
+	}
+	s.workspaces[wks.ID] = wks
+	return wks.ID, nil
+}
+
+func (s *Store) getWorkspaceByID(_ context.Context, id int64) (genDb.Workspace, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	wks, ok := s.workspaces[id]
+	if !ok {
+		return genDb.Workspace{}, pgx.ErrNoRows
+	}
+	return wks, nil
+}
+
+func (s *Store) getWorkspaceOrgID(_ context.Context, id int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	wks, ok := s.workspaces[id]
+	if !ok {
+		return 0, pgx.ErrNoRows
+	}
+	return wks.OrgID, nil
+}
+
+func (s *Store) createEnvironment(_ context.Context, arg genDb.CreateEnvironmentParams) (genDb.Environment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.workspaces[arg.WorkspaceID]; !ok {
+		return genDb.Environment{}, fmt.Errorf("testutil: environment in unknown workspace %d", arg.WorkspaceID)
+	}
+	env := genDb.Environment{
+		ID:          s.nextID(),
+		WorkspaceID: arg.WorkspaceID,
+		Name:        arg.Name,
+		Description: arg.Description,
+		Env:         arg.Env,
+		CreatedBy:   arg.CreatedBy,
+		CreatedAt:   now(),
+		UpdatedAt:   now(),
+	}
+	s.environments[env.ID] = env
+	return env, nil
+}
+
+func (s *Store) getEnvironmentByID(_ context.Context, id int64) (genDb.Environment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	env, ok := s.environments[id]
+	if !ok {
+		return genDb.Environment{}, pgx.ErrNoRows
+	}
+	return env, nil
+}
+
+func (s *Store) getEnvironmentParents(_ context.Context, id int64) (genDb.GetEnvironmentWorkspaceOrganizationIDRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	env, ok := s.environments[id]
+	if !ok {
+		return genDb.GetEnvironmentWorkspaceOrganizationIDRow{}, pgx.ErrNoRows
+	}
+	return genDb.GetEnvironmentWorkspaceOrganizationIDRow{WorkspaceID: env.WorkspaceID, OrgID: s.workspaces[env.WorkspaceID].OrgID}, nil
+}
+
+func (s *Store) createResource(_ context.Context, arg genDb.CreateResourceParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.workspaces[arg.WorkspaceID]; !ok {
+		return 0, fmt.Errorf("testutil: resource in unknown workspace %d", arg.WorkspaceID)
+	}
+	for _, resource := range s.resources {
+		if resource.WorkspaceID == arg.WorkspaceID && resource.EnvironmentID == arg.EnvironmentID && resource.Name == arg.Name {
+			return 0, uniqueViolation("uniq_resources_workspace_environment_name")
+		}
+	}
+	resource := genDb.Resource{
+		ID:            s.nextID(),
+		WorkspaceID:   arg.WorkspaceID,
+		Name:          arg.Name,
+		Type:          arg.Type,
+		Description:   arg.Description,
+		Status:        arg.Status,
+		Spec:          arg.Spec,
+		SpecVersion:   arg.SpecVersion,
+		CreatedAt:     now(),
+		UpdatedAt:     now(),
+		EnvironmentID: arg.EnvironmentID,
+		ExternalID:    arg.ExternalID,
+		Labels:        arg.Labels,
+		Version:       1,
+	}
+	s.resources[resource.ID] = resource
+	return resource.ID, nil
+}
+
+func (s *Store) getResourceByID(_ context.Context, id int64) (genDb.Resource, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resource, ok := s.resources[id]
+	if !ok {
+		return genDb.Resource{}, pgx.ErrNoRows
+	}
+	return resource, nil
+}
+
+func (s *Store) getResourceByName(_ context.Context, arg genDb.GetResourceByNameAndWorkspaceParams) (genDb.Resource, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, resource := range s.resources {
+		if resource.WorkspaceID == arg.WorkspaceID && resource.EnvironmentID == arg.EnvironmentID && resource.Name == arg.Name {
+			return resource, nil
+		}
+	}
+	return genDb.Resource{}, pgx.ErrNoRows
+}
+
+func (s *Store) getResourceParents(_ context.Context, id int64) (genDb.GetWorkspaceOrganizationIDByResourceIDRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resource, ok := s.resources[id]
+	if !ok {
+		return genDb.GetWorkspaceOrganizationIDByResourceIDRow{}, pgx.ErrNoRows
+	}
+	return genDb.GetWorkspaceOrganizationIDByResourceIDRow{
+		WorkspaceID:   resource.WorkspaceID,
+		OrgID:         s.workspaces[resource.WorkspaceID].OrgID,
+		EnvironmentID: resource.EnvironmentID,
+	}, nil
+}
+
+// listResourcesForWorkspace pages newest first; ids grow with creation time, so the page token,
+// the id of the last resource of the previous page, is compared with ids alone
+func (s *Store) listResourcesForWorkspace(_ context.Context, arg genDb.ListResourcesForWorkspaceParams) ([]genDb.Resource, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var after int64
+	if arg.PageToken.Valid {
+		var err error
+		if after, err = strconv.ParseInt(arg.PageToken.String, 10, 64); err != nil {
+			return nil, fmt.Errorf("testutil: page token %q: %w", arg.PageToken.String, err)
+		}
+	}
+
+	var resources []genDb.Resource
+	for _, resource := range s.resources {
+		if resource.WorkspaceID != arg.WorkspaceID || (arg.EnvironmentID.Valid && resource.EnvironmentID != arg.EnvironmentID) {
+			continue
+		}
+		if arg.PageToken.Valid && resource.ID >= after {
+			continue
+		}
+		resources = append(resources, resource)
+	}
+	slices.SortFunc(resources, func(a, b genDb.Resource) int { return cmp.Compare(b.ID, a.ID) })
+	return resources[:min(len(resources), int(arg.Limit))], nil
+}
+
+func (s *Store) createResourceRegion(_ context.Context, arg genDb.CreateResourceRegionParams) (genDb.ResourceRegion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.resources[arg.ResourceID]; !ok {
+		return genDb.ResourceRegion{}, fmt.Errorf("testutil: region of unknown resource %d", arg.ResourceID)
+	}
+	for _, region := range s.regions {
+		if region.ResourceID == arg.ResourceID && region.Region == arg.Region {
+			return genDb.ResourceRegion{}, uniqueViolation("resource_regions_resource_id_region_key")
+		}
+	}
+	region := genDb.ResourceRegion{
+		ID:               s.nextID(),
+		ResourceID:       arg.ResourceID,
+		Region:           arg.Region,
+		IsPrimary:        arg.IsPrimary,
+		Status:           arg.Status,
+		CreatedAt:        now(),
+		UpdatedAt:        now(),
+		FailoverPriority: arg.FailoverPriority,
+	}
+	s.regions[region.ID] = region
+	return region, nil
+}
+
+func (s *Store) listResourceRegions(ctx context.Context, resourceID int64) ([]genDb.ResourceRegion, error) {
+	return s.listResourceRegionsForResources(ctx, []int64{resourceID})
+}
+
+// listResourceRegionsForResources orders each resource's regions primary first, then by failover
+// priority and name
+func (s *Store) listResourceRegionsForResources(_ context.Context, resourceIDs []int64) ([]genDb.ResourceRegion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var regions []genDb.ResourceRegion
+	for _, region := range s.regions {
+		if slices.Contains(resourceIDs, region.ResourceID) {
+			regions = append(regions, region)
+		}
+	}
+	slices.SortFunc(regions, func(a, b genDb.ResourceRegion) int {
+		if c := cmp.Compare(a.ResourceID, b.ResourceID); c != 0 {
+			return c
+		}
+		if a.IsPrimary != b.IsPrimary {
+			if a.IsPrimary {
+				return -1
+			}
+			return 1
+		}
+		if c := cmp.Compare(a.FailoverPriority, b.FailoverPriority); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Region, b.Region)
+	})
+	return regions, nil
+}
+
+func (s *Store) createResourceDomain(_ context.Context, arg genDb.CreateResourceDomainParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.resources[arg.ResourceID]; !ok {
+		return 0, fmt.Errorf("testutil: domain of unknown resource %d", arg.ResourceID)
+	}
+	for _, domain := range s.domains {
+		if domain.Domain == arg.Domain {
+			return 0, uniqueViolation("resource_domains_domain_key")
+		}
+	}
+	domain := genDb.ResourceDomain{
+		ID:               s.nextID(),
+		ResourceID:       arg.ResourceID,
+		Domain:           arg.Domain,
+		DomainSource:     arg.DomainSource,
+		SubdomainLabel:   arg.SubdomainLabel,
+		PlatformDomainID: arg.PlatformDomainID,
+		IsPrimary:        arg.IsPrimary,
+		CreatedAt:        now(),
+		UpdatedAt:        now(),
+		Status:           arg.Status,
+	}
+	s.domains[domain.ID] = domain
+	return domain.ID, nil
+}
+
+func (s *Store) listResourceDomains(ctx context.Context, resourceID int64) ([]genDb.ResourceDomain, error) {
+	return s.listResourceDomainsForResources(ctx, []int64{resourceID})
+}
+
+// listResourceDomainsForResources orders each resource's domains primary first, then oldest first
+func (s *Store) listResourceDomainsForResources(_ context.Context, resourceIDs []int64) ([]genDb.ResourceDomain, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var domains []genDb.ResourceDomain
+	for _, domain := range s.domains {
+		if slices.Contains(resourceIDs, domain.ResourceID) {
+			domains = append(domains, domain)
+		}
+	}
+	slices.SortFunc(domains, func(a, b genDb.ResourceDomain) int {
+		if c := cmp.Compare(a.ResourceID, b.ResourceID); c != 0 {
+			return c
+		}
+		if a.IsPrimary != b.IsPrimary {
+			if a.IsPrimary {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return domains, nil
+}
+
+func (s *Store) checkDomainAvailability(_ context.Context, name string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, domain := range s.domains {
+		if domain.Domain == name {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// listEntityParents skips service accounts, which the Store does not hold, and unknown ids, as
+// the query's joins would
+func (s *Store) listEntityParents(_ context.Context, arg genDb.ListEntityParentsParams) ([]genDb.ListEntityParentsRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var rows []genDb.ListEntityParentsRow
+	for _, id := range arg.WorkspaceIds {
+		if wks, ok := s.workspaces[id]; ok {
+			rows = append(rows, genDb.ListEntityParentsRow{EntityType: genDb.EntityTypeWorkspace, EntityID: id, OrgID: wks.OrgID})
+		}
+	}
+	for _, id := range arg.EnvironmentIds {
+		if env, ok := s.environments[id]; ok {
+			rows = append(rows, genDb.ListEntityParentsRow{
+				EntityType:  genDb.EntityTypeEnvironment,
+				EntityID:    id,
+				OrgID:       s.workspaces[env.WorkspaceID].OrgID,
+				WorkspaceID: pgtype.Int8{Int64: env.WorkspaceID, Valid: true},
+			})
+		}
+	}
+	for _, id := range arg.ResourceIds {
+		if resource, ok := s.resources[id]; ok {
+			rows = append(rows, genDb.ListEntityParentsRow{
+				EntityType:    genDb.EntityTypeResource,
+				EntityID:      id,
+				OrgID:         s.workspaces[resource.WorkspaceID].OrgID,
+				WorkspaceID:   pgtype.Int8{Int64: resource.WorkspaceID, Valid: true},
+				EnvironmentID: resource.EnvironmentID,
+			})
+		}
+	}
+	return rows, nil
+}
+
+func (s *Store) addUserScope(_ context.Context, arg genDb.AddUserScopeParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	scope := genDb.EntityScope{EntityType: arg.EntityType, EntityID: arg.EntityID, Scope: arg.Scope}
+	if !slices.Contains(s.userScopes[arg.UserID], scope) {
+		s.userScopes[arg.UserID] = append(s.userScopes[arg.UserID], scope)
+	}
+	return nil
+}
+
+func (s *Store) getUserScopes(_ context.Context, userID int64) ([]genDb.EntityScope, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.userScopes[userID]), nil
+}
@@ -0,0 +1,20 @@
+package testutil
+
+import (
+	"testing"
+	"time"
+
+	genDb "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/tvm"
+)
+
+// NewVendingMachine returns a token vending machine checking scopes against q, closed when t
+// ends. Caching is off, so entities a test adds to a Store part way through are seen.
+func NewVendingMachine(t testing.TB, q genDb.Querier) *tvm.VendingMachine {
+	machine := tvm.NewVendingMachine(NewDB(q), q, tvm.Config{
+		MaxTokenDuration:   24 * time.Hour,
+		LoginTokenDuration: time.Hour,
+	})
+	t.Cleanup(machine.Close)
+	return machine
+}
@@ -36,46 +36,80 @@ var (
 		entityType: db.EntityTypeResource,
 		scope:      db.ScopeRead,
 	}
-	// StreamResourceLogs requires resource:read.
+	// StreamResourceLogs requires resource:logs:read, which resource:read implies.
 	StreamResourceLogs = Action{
 		entityType: db.EntityTypeResource,
-		scope:      db.ScopeRead,
+		scope:      db.ScopeLogsRead,
 	}
 	// GetResourceEvents requires resource:read.
 	GetResourceEvents = Action{
 		entityType: db.EntityTypeResource,
 		scope:      db.ScopeRead,
 	}
+	// AddDomain requires resource:domain:manage, which resource:write implies.
 	AddDomain = Action{
 		entityType: db.EntityTypeResource,
-		scope:      db.ScopeWrite,
+		scope:      db.ScopeDomainManage,
 	}
+	// UpdateDomain requires resource:domain:manage, which resource:write implies.
 	UpdateDomain = Action{
 		entityType: db.EntityTypeResource,
-		scope:      db.ScopeWrite,
+		scope:      db.ScopeDomainManage,
 	}
+	// RemoveDomain requires resource:domain:manage, which resource:write implies.
 	RemoveDomain = Action{
 		entityType: db.EntityTypeResource,
-		scope:      db.ScopeWrite,
+		scope:      db.ScopeDomainManage,
 	}
+	// SetPrimaryDomain requires resource:domain:manage, which resource:write implies.
 	SetPrimaryDomain = Action{
 		entityType: db.EntityTypeResource,
-		scope:      db.ScopeWrite,
+		scope:      db.ScopeDomainManage,
+	}
+	// SetDomainAccessControl requires resource:domain:manage, which resource:write implies.
+	SetDomainAccessControl = Action{
+		entityType: db.EntityTypeResource,
+		scope:      db.ScopeDomainManage,
+	}
+	// SetDomainRedirect requires resource:domain:manage, which resource:write implies.
+	SetDomainRedirect = Action{
+		entityType: db.EntityTypeResource,
+		scope:      db.ScopeDomainManage,
+	}
+	// SetDomainTLSPolicy requires resource:domain:manage, which resource:write implies.
+	SetDomainTLSPolicy = Action{
+		entityType: db.EntityTypeResource,
+		scope:      db.ScopeDomainManage,
+	}
+	// GetDomainSetupInstructions requires resource:read.
+	GetDomainSetupInstructions = Action{
+		entityType: db.EntityTypeResource,
+		scope:      db.ScopeRead,
 	}
 	// UpdateResource requires resource:write.
 	UpdateResource = Action{
 		entityType: db.EntityTypeResource,
 		scope:      db.ScopeWrite,
 	}
-	// UpdateResourceEnv requires resource:write.
+	// AttachConfigGroup requires resource:env:write, which resource:write implies.
+	AttachConfigGroup = Action{
+		entityType: db.EntityTypeResource,
+		scope:      db.ScopeEnvWrite,
+	}
+	// DetachConfigGroup requires resource:env:write, which resource:write implies.
+	DetachConfigGroup = Action{
+		entityType: db.EntityTypeResource,
+		scope:      db.ScopeEnvWrite,
+	}
+	// UpdateResourceEnv requires resource:env:write, which resource:write implies.
 	UpdateResourceEnv = Action{
 		entityType: db.EntityTypeResource,
-		scope:      db.ScopeWrite,
+		scope:      db.ScopeEnvWrite,
 	}
-	// DeployResource requires resource:write.
+	// DeployResource requires resource:deploy:create, which resource:write implies.
 	DeployResource = Action{
 		entityType: db.EntityTypeResource,
-		scope:      db.ScopeWrite,
+		scope:      db.ScopeDeployCreate,
 	}
 	// ScaleResource requires resource:write.
 	ScaleResource = Action{
@@ -92,6 +126,21 @@ var (
 		entityType: db.EntityTypeResource,
 		scope:      db.ScopeWrite,
 	}
+	// SuspendResource requires resource:write.
+	SuspendResource = Action{
+		entityType: db.EntityTypeResource,
+		scope:      db.ScopeWrite,
+	}
+	// ResumeResource requires resource:write.
+	ResumeResource = Action{
+		entityType: db.EntityTypeResource,
+		scope:      db.ScopeWrite,
+	}
+	// PromoteRegion requires resource:write.
+	PromoteRegion = Action{
+		entityType: db.EntityTypeResource,
+		scope:      db.ScopeWrite,
+	}
 	// StopResource requires resource:write.
 	StopResource = Action{
 		entityType: db.EntityTypeResource,
@@ -102,6 +151,26 @@ var (
 		entityType: db.EntityTypeResource,
 		scope:      db.ScopeAdmin,
 	}
+	// LockResource requires resource:admin.
+	LockResource = Action{
+		entityType: db.EntityTypeResource,
+		scope:      db.ScopeAdmin,
+	}
+	// UnlockResource requires resource:admin.
+	UnlockResource = Action{
+		entityType: db.EntityTypeResource,
+		scope:      db.ScopeAdmin,
+	}
+	// GetStatusPage requires resource:read.
+	GetStatusPage = Action{
+		entityType: db.EntityTypeResource,
+		scope:      db.ScopeRead,
+	}
+	// UpdateStatusPage requires resource:write.
+	UpdateStatusPage = Action{
+		entityType: db.EntityTypeResource,
+		scope:      db.ScopeWrite,
+	}
 
 	// deployments
 
@@ -120,16 +189,32 @@ var (
 		entityType: db.EntityTypeResource,
 		scope:      db.ScopeRead,
 	}
-	// CreateDeployment requires resource:write.
+	// PromoteDeployment requires resource:read on the source resource; the target
+	// resource is checked with CreateDeployment.
+	PromoteDeployment = Action{
+		entityType: db.EntityTypeResource,
+		scope:      db.ScopeRead,
+	}
+	// CreateDeployment requires resource:deploy:create, which resource:write implies.
 	CreateDeployment = Action{
 		entityType: db.EntityTypeResource,
-		scope:      db.ScopeWrite,
+		scope:      db.ScopeDeployCreate,
 	}
 	// DeleteDeployment requires resource:write
 	DeleteDeployment = Action{
 		entityType: db.EntityTypeResource,
 		scope:      db.ScopeWrite,
 	}
+	// ListClusterDiscrepancies requires workspace:read.
+	ListClusterDiscrepancies = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeRead,
+	}
+	// ListAllClusterDiscrepancies requires system:read.
+	ListAllClusterDiscrepancies = Action{
+		entityType: db.EntityTypeSystem,
+		scope:      db.ScopeRead,
+	}
 
 	// orgs
 	// ListOrgs requires org:read.
@@ -157,6 +242,11 @@ var (
 		entityType: db.EntityTypeOrganization,
 		scope:      db.ScopeAdmin,
 	}
+	// GetOrgDeletion requires organization:admin.
+	GetOrgDeletion = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeAdmin,
+	}
 	// AddOrgMember requires organization:write.
 	AddOrgMember = Action{
 		entityType: db.EntityTypeOrganization,
@@ -172,6 +262,16 @@ var (
 		entityType: db.EntityTypeOrganization,
 		scope:      db.ScopeRead,
 	}
+	// SetOrgDeletionProtection requires organization:admin.
+	SetOrgDeletionProtection = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeAdmin,
+	}
+	// SetOrgRequireMFA requires organization:admin.
+	SetOrgRequireMFA = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeAdmin,
+	}
 
 	// users
 
@@ -200,6 +300,26 @@ var (
 		entityType: db.EntityTypeUser,
 		scope:      db.ScopeWrite,
 	}
+	// GetNotificationPreferences requires user:read.
+	GetNotificationPreferences = Action{
+		entityType: db.EntityTypeUser,
+		scope:      db.ScopeRead,
+	}
+	// UpdateNotificationPreferences requires user:write.
+	UpdateNotificationPreferences = Action{
+		entityType: db.EntityTypeUser,
+		scope:      db.ScopeWrite,
+	}
+	// ListNotifications requires user:read.
+	ListNotifications = Action{
+		entityType: db.EntityTypeUser,
+		scope:      db.ScopeRead,
+	}
+	// MarkNotificationRead requires user:write.
+	MarkNotificationRead = Action{
+		entityType: db.EntityTypeUser,
+		scope:      db.ScopeWrite,
+	}
 	// ListUsers requires system:read
 	ListUsers = Action{
 		entityType: db.EntityTypeSystem,
@@ -210,6 +330,41 @@ var (
 		entityType: db.EntityTypeUser,
 		scope:      db.ScopeAdmin,
 	}
+	// DeleteMyAccount requires user:admin.
+	DeleteMyAccount = Action{
+		entityType: db.EntityTypeUser,
+		scope:      db.ScopeAdmin,
+	}
+	// ExportMyData requires user:read.
+	ExportMyData = Action{
+		entityType: db.EntityTypeUser,
+		scope:      db.ScopeRead,
+	}
+	// GetMFAStatus requires user:read.
+	GetMFAStatus = Action{
+		entityType: db.EntityTypeUser,
+		scope:      db.ScopeRead,
+	}
+	// EnrollMFA requires user:write.
+	EnrollMFA = Action{
+		entityType: db.EntityTypeUser,
+		scope:      db.ScopeWrite,
+	}
+	// ConfirmMFA requires user:write.
+	ConfirmMFA = Action{
+		entityType: db.EntityTypeUser,
+		scope:      db.ScopeWrite,
+	}
+	// VerifyMFA requires user:write.
+	VerifyMFA = Action{
+		entityType: db.EntityTypeUser,
+		scope:      db.ScopeWrite,
+	}
+	// DisableMFA requires user:admin.
+	DisableMFA = Action{
+		entityType: db.EntityTypeUser,
+		scope:      db.ScopeAdmin,
+	}
 
 	// workspace
 	// ListWorkspaces requires organization:read (assuming workspaces are scoped to orgs).
@@ -237,6 +392,81 @@ var (
 		entityType: db.EntityTypeWorkspace,
 		scope:      db.ScopeAdmin,
 	}
+	// GetWorkspaceNotificationDefaults requires workspace:read.
+	GetWorkspaceNotificationDefaults = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeRead,
+	}
+	// UpdateWorkspaceNotificationDefaults requires workspace:admin.
+	UpdateWorkspaceNotificationDefaults = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeAdmin,
+	}
+	// GetWorkspaceEnv requires workspace:read.
+	GetWorkspaceEnv = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeRead,
+	}
+	// UpdateWorkspaceEnv requires workspace:env:write, which workspace:write implies.
+	UpdateWorkspaceEnv = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeEnvWrite,
+	}
+	// CreateConfigGroup requires workspace:env:write, which workspace:write implies.
+	CreateConfigGroup = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeEnvWrite,
+	}
+	// GetConfigGroup requires workspace:read.
+	GetConfigGroup = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeRead,
+	}
+	// ListConfigGroups requires workspace:read.
+	ListConfigGroups = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeRead,
+	}
+	// UpdateConfigGroup requires workspace:env:write, which workspace:write implies.
+	UpdateConfigGroup = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeEnvWrite,
+	}
+	// DeleteConfigGroup requires workspace:env:write, which workspace:write implies.
+	DeleteConfigGroup = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeEnvWrite,
+	}
+	// GetConfigRollout requires workspace:read.
+	GetConfigRollout = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeRead,
+	}
+	// SetSecret requires workspace:env:write, which workspace:write implies.
+	SetSecret = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeEnvWrite,
+	}
+	// DeleteSecret requires workspace:env:write, which workspace:write implies.
+	DeleteSecret = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeEnvWrite,
+	}
+	// ListSecretStores requires workspace:read.
+	ListSecretStores = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeRead,
+	}
+	// ArchiveWorkspace requires workspace:admin.
+	ArchiveWorkspace = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeAdmin,
+	}
+	// UnarchiveWorkspace requires workspace:admin.
+	UnarchiveWorkspace = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeAdmin,
+	}
 	// AddWorkspaceMember requires workspace:write.
 	AddWorkspaceMember = Action{
 		entityType: db.EntityTypeWorkspace,
@@ -252,6 +482,234 @@ var (
 		entityType: db.EntityTypeWorkspace,
 		scope:      db.ScopeRead,
 	}
+	// ExportWorkspace requires workspace:read.
+	ExportWorkspace = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeRead,
+	}
+	// ImportWorkspace requires workspace:write.
+	ImportWorkspace = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeWrite,
+	}
+
+	// alerts
+
+	// CreateAlertRule requires workspace:admin.
+	CreateAlertRule = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeAdmin,
+	}
+	// ListAlertRules requires workspace:read.
+	ListAlertRules = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeRead,
+	}
+	// UpdateAlertRule requires workspace:admin.
+	UpdateAlertRule = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeAdmin,
+	}
+	// DeleteAlertRule requires workspace:admin.
+	DeleteAlertRule = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeAdmin,
+	}
+
+	// environments
+
+	// CreateEnvironment requires workspace:write.
+	CreateEnvironment = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeWrite,
+	}
+	// ListEnvironments requires workspace:read.
+	ListEnvironments = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeRead,
+	}
+	// GetEnvironment requires environment:read.
+	GetEnvironment = Action{
+		entityType: db.EntityTypeEnvironment,
+		scope:      db.ScopeRead,
+	}
+	// UpdateEnvironment requires environment:write.
+	UpdateEnvironment = Action{
+		entityType: db.EntityTypeEnvironment,
+		scope:      db.ScopeWrite,
+	}
+	// DeleteEnvironment requires environment:admin.
+	DeleteEnvironment = Action{
+		entityType: db.EntityTypeEnvironment,
+		scope:      db.ScopeAdmin,
+	}
+	// CreateEnvironmentResource requires environment:write, on top of CreateResource.
+	CreateEnvironmentResource = Action{
+		entityType: db.EntityTypeEnvironment,
+		scope:      db.ScopeWrite,
+	}
+
+	// service accounts
+
+	// CreateServiceAccount requires workspace:admin.
+	CreateServiceAccount = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeAdmin,
+	}
+	// ListServiceAccounts requires workspace:read.
+	ListServiceAccounts = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeRead,
+	}
+	// GetServiceAccount requires service_account:read.
+	GetServiceAccount = Action{
+		entityType: db.EntityTypeServiceAccount,
+		scope:      db.ScopeRead,
+	}
+	// SetServiceAccountScopes requires service_account:admin.
+	SetServiceAccountScopes = Action{
+		entityType: db.EntityTypeServiceAccount,
+		scope:      db.ScopeAdmin,
+	}
+	// DeleteServiceAccount requires service_account:admin.
+	DeleteServiceAccount = Action{
+		entityType: db.EntityTypeServiceAccount,
+		scope:      db.ScopeAdmin,
+	}
+
+	// approvals
+
+	// ListApprovalRequests requires workspace:read.
+	ListApprovalRequests = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeRead,
+	}
+	// CancelApprovalRequest requires workspace:admin, unless the caller made the request.
+	// ApproveAction has no action of its own: the approver needs whatever the approved operation needs.
+	CancelApprovalRequest = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeAdmin,
+	}
+
+	// directory sync
+
+	// ManageDirectory requires organization:admin. It guards the SCIM endpoint of an org.
+	ManageDirectory = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeAdmin,
+	}
+	// SetDirectoryGroupRoles requires organization:admin.
+	SetDirectoryGroupRoles = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeAdmin,
+	}
+	// ListDirectoryGroupRoles requires organization:read.
+	ListDirectoryGroupRoles = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeRead,
+	}
+
+	// slack
+
+	// InstallSlack requires organization:admin.
+	InstallSlack = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeAdmin,
+	}
+	// GetSlackInstallation requires organization:read.
+	GetSlackInstallation = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeRead,
+	}
+	// DeleteSlackInstallation requires organization:admin.
+	DeleteSlackInstallation = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeAdmin,
+	}
+	// GetWorkspaceSlackChannel requires workspace:read.
+	GetWorkspaceSlackChannel = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeRead,
+	}
+	// SetWorkspaceSlackChannel requires workspace:admin.
+	SetWorkspaceSlackChannel = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeAdmin,
+	}
+	// DeleteWorkspaceSlackChannel requires workspace:admin.
+	DeleteWorkspaceSlackChannel = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeAdmin,
+	}
+
+	// billing
+
+	// GetBilling requires organization:read. It covers the billing account and usage of an org.
+	GetBilling = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeRead,
+	}
+	// ManageBilling requires organization:admin. It covers plans, subscriptions and payment methods.
+	ManageBilling = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeAdmin,
+	}
+
+	// policies
+
+	// CreatePolicy requires organization:admin.
+	CreatePolicy = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeAdmin,
+	}
+	// ListPolicies requires organization:read.
+	ListPolicies = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeRead,
+	}
+	// UpdatePolicy requires organization:admin.
+	UpdatePolicy = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeAdmin,
+	}
+	// DeletePolicy requires organization:admin.
+	DeletePolicy = Action{
+		entityType: db.EntityTypeOrganization,
+		scope:      db.ScopeAdmin,
+	}
+
+	// platform admin, see tvm.PlatformAdmin
+
+	// ListAllOrgs requires system:admin.
+	ListAllOrgs = Action{
+		entityType: db.EntityTypeSystem,
+		scope:      db.ScopeAdmin,
+	}
+	// ImpersonateUser requires system:admin.
+	ImpersonateUser = Action{
+		entityType: db.EntityTypeSystem,
+		scope:      db.ScopeAdmin,
+	}
+	// ForceDeleteResource requires system:admin.
+	ForceDeleteResource = Action{
+		entityType: db.EntityTypeSystem,
+		scope:      db.ScopeAdmin,
+	}
+	// ManageClusters requires system:admin.
+	ManageClusters = Action{
+		entityType: db.EntityTypeSystem,
+		scope:      db.ScopeAdmin,
+	}
+	// ManagePlatformAdmins requires system:admin.
+	ManagePlatformAdmins = Action{
+		entityType: db.EntityTypeSystem,
+		scope:      db.ScopeAdmin,
+	}
+	// ListAdminAuditLog requires system:admin.
+	ListAdminAuditLog = Action{
+		entityType: db.EntityTypeSystem,
+		scope:      db.ScopeAdmin,
+	}
 
 	// domains
 
@@ -292,6 +750,38 @@ var (
 		scope:      db.ScopeRead,
 	}
 
+	// templates
+	// ListTemplates and GetTemplate only require a valid token, the catalog is the same for everyone.
+
+	// InstantiateTemplate requires workspace:write; each resource it creates is checked with CreateResource.
+	InstantiateTemplate = Action{
+		entityType: db.EntityTypeWorkspace,
+		scope:      db.ScopeWrite,
+	}
+
+	// announcements
+
+	// CreateAnnouncement requires system:admin.
+	CreateAnnouncement = Action{
+		entityType: db.EntityTypeSystem,
+		scope:      db.ScopeAdmin,
+	}
+	// DeleteAnnouncement requires system:admin.
+	DeleteAnnouncement = Action{
+		entityType: db.EntityTypeSystem,
+		scope:      db.ScopeAdmin,
+	}
+	// ListAnnouncements requires user:read (to see the caller's read marker).
+	ListAnnouncements = Action{
+		entityType: db.EntityTypeUser,
+		scope:      db.ScopeRead,
+	}
+	// MarkAnnouncementsRead requires user:write.
+	MarkAnnouncementsRead = Action{
+		entityType: db.EntityTypeUser,
+		scope:      db.ScopeWrite,
+	}
+
 	// Token management actions are dynamically defined.
 )
 
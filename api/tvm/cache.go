@@ -0,0 +1,154 @@
+package tvm
+
+import (
+	"sync"
+	"time"
+
+	queries "github.com/team-loco/loco/api/gen/db"
+)
+
+// cache keeps tokens and the parents of entities in memory for a short time, so verifying a
+// request does not cost a token lookup and a hierarchy lookup every time. Each API replica has
+// its own cache: a token revoked through another replica is dropped here when its change
+// notification arrives, and stays usable for at most the TTL if it never does.
+// A zero TTL disables caching.
+type cache struct {
+	ttl time.Duration
+
+	mu      sync.RWMutex
+	tokens  map[string]cachedToken
+	parents map[queries.Entity]cachedParents
+}
+
+type cachedToken struct {
+	token   queries.Token
+	expires time.Time
+}
+
+type cachedParents struct {
+	parents []queries.Entity
+	expires time.Time
+}
+
+func newCache(ttl time.Duration) *cache {
+	return &cache{
+		ttl:     ttl,
+		tokens:  map[string]cachedToken{},
+		parents: map[queries.Entity]cachedParents{},
+	}
+}
+
+func (c *cache) enabled() bool {
+	return c.ttl > 0
+}
+
+func (c *cache) getToken(token string) (queries.Token, bool) {
+	if !c.enabled() {
+		return queries.Token{}, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.tokens[token]
+	if !ok || time.Now().After(entry.expires) {
+		return queries.Token{}, false
+	}
+	return entry.token, true
+}
+
+func (c *cache) putToken(token string, data queries.Token) {
+	if !c.enabled() {
+		return
+	}
+	// never serve a token past its own expiry
+	expires := time.Now().Add(c.ttl)
+	if data.ExpiresAt.Before(expires) {
+		expires = data.ExpiresAt
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokens[token] = cachedToken{token: data, expires: expires}
+}
+
+func (c *cache) getParents(entity queries.Entity) ([]queries.Entity, bool) {
+	if !c.enabled() {
+		return nil, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.parents[entity]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.parents, true
+}
+
+func (c *cache) putParents(entity queries.Entity, parents []queries.Entity) {
+	if !c.enabled() {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.parents[entity] = cachedParents{parents: parents, expires: time.Now().Add(c.ttl)}
+}
+
+// invalidateToken drops a token and every token minted from it
+func (c *cache) invalidateToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dropTokens([]string{token})
+}
+
+// invalidateEntityTokens drops every token issued to an entity, optionally only those with the given name, and every token minted from them
+func (c *cache) invalidateEntityTokens(entity queries.Entity, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var revoked []string
+	for token, entry := range c.tokens {
+		if entry.token.EntityType != entity.Type || entry.token.EntityID != entity.ID {
+			continue
+		}
+		if name != "" && entry.token.Name != name {
+			continue
+		}
+		revoked = append(revoked, token)
+	}
+	c.dropTokens(revoked)
+}
+
+// flush drops every cached token, e.g. when revocations may have been missed
+func (c *cache) flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.tokens)
+}
+
+// dropTokens drops tokens and their descendants. c.mu must be held.
+func (c *cache) dropTokens(revoked []string) {
+	for len(revoked) > 0 {
+		parent := revoked[0]
+		revoked = revoked[1:]
+		delete(c.tokens, parent)
+		for child, entry := range c.tokens {
+			if entry.token.ParentToken.Valid && entry.token.ParentToken.String == parent {
+				revoked = append(revoked, child)
+			}
+		}
+	}
+}
+
+// sweep drops expired entries
+func (c *cache) sweep() {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for token, entry := range c.tokens {
+		if now.After(entry.expires) {
+			delete(c.tokens, token)
+		}
+	}
+	for entity, entry := range c.parents {
+		if now.After(entry.expires) {
+			delete(c.parents, entity)
+		}
+	}
+}
@@ -6,9 +6,11 @@ import (
 
 var (
 	ErrDurationExceedsMaxAllowed = errors.New("token duration exceeds maximum allowed")
+	ErrDurationExceedsParent     = errors.New("token duration exceeds the lifetime of its parent token")
 	ErrInsufficentPermissions    = errors.New("insufficient permissions")
 	ErrStoreToken                = errors.New("unable to store issued token")
 	ErrImproperUsage             = errors.New("improper usage of token vending machine")
+	ErrImpersonationToken        = errors.New("impersonation tokens cannot issue tokens")
 
 	ErrTokenExpired        = errors.New("token has expired")
 	ErrTokenNotFound       = errors.New("token not found")
@@ -20,4 +22,18 @@ var (
 	ErrEntityNotFound = errors.New("entity not found or invalid entity")
 
 	ErrIssueToken = errors.New("unable to issue token")
+
+	ErrMFARequired       = errors.New("a TOTP code is required to finish logging in")
+	ErrMFAPendingToken   = errors.New("logins waiting on MFA cannot issue tokens")
+	ErrNotMFAPending     = errors.New("token is not a login waiting on MFA")
+	ErrMFANotConfigured  = errors.New("MFA is not configured on this server")
+	ErrMFAAlreadyEnabled = errors.New("MFA is already enabled")
+	ErrMFANotEnrolled    = errors.New("MFA is not enrolled")
+	ErrInvalidMFACode    = errors.New("invalid or already used TOTP code")
+	ErrMFARequiredByOrg  = errors.New("an organization of the user requires MFA")
+
+	ErrRefreshDisabled     = errors.New("refresh tokens are not enabled")
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token was already used; the session has been revoked")
+	ErrSessionMFARequired  = errors.New("the session did not pass MFA, which the user now needs; log in again")
 )
@@ -11,7 +11,8 @@ import (
 )
 
 // Exchange returns a token for the user with the given email. It is expected that the email has been
-// provided by a provider in a trusted manner (e.g., after successful OAuth).
+// provided by a provider in a trusted manner (e.g., after successful OAuth). When the user must pass a TOTP
+// check first, the token is returned along with [ErrMFARequired] and only allows enrolling in and passing MFA.
 func (tvm *VendingMachine) Exchange(ctx context.Context, email providers.EmailResponse) (queries.User, string, error) {
 	address, err := email.Address()
 	if err != nil {
@@ -36,11 +37,30 @@ func (tvm *VendingMachine) Exchange(ctx context.Context, email providers.EmailRe
 		UpdatedAt: userWithScopes.UpdatedAt,
 	}
 
-	// issue the token
-	token, err := tvm.issueNoCheck(ctx, fmt.Sprintf("login token for user %d created at %s", user.ID, time.Now().Format(time.RFC1123)), queries.Entity{
-		Type: queries.EntityTypeUser,
-		ID:   user.ID,
-	}, userWithScopes.Scopes, tvm.Cfg.LoginTokenDuration)
+	scopes := userWithScopes.Scopes
+	if tvm.isBootstrapPlatformAdmin(address) && !IsPlatformAdmin(scopes) {
+		if err := tvm.SetPlatformAdmin(ctx, user.ID, true); err != nil {
+			slog.ErrorContext(ctx, err.Error())
+			return queries.User{}, "", fmt.Errorf("grant platform admin: %w", err)
+		}
+		slog.InfoContext(ctx, "granted platform admin from configuration", "userId", user.ID)
+		scopes = append(scopes, PlatformAdmin)
+	}
+
+	mfa, err := tvm.mfaNeeded(ctx, user.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return queries.User{}, "", fmt.Errorf("check mfa: %w", err)
+	}
+	if mfa {
+		token, err := tvm.issueMFAPending(ctx, user.ID)
+		if err != nil {
+			return queries.User{}, "", fmt.Errorf("issue login token: %w", err)
+		}
+		return user, token, ErrMFARequired
+	}
+
+	token, err := tvm.issueLogin(ctx, user.ID, scopes)
 	if err != nil {
 		slog.ErrorContext(ctx, err.Error())
 		return queries.User{}, "", fmt.Errorf("issue login token: %w", err)
@@ -48,3 +68,11 @@ func (tvm *VendingMachine) Exchange(ctx context.Context, email providers.EmailRe
 
 	return user, token, nil
 }
+
+// issueLogin issues a login token for a user with the given scopes.
+func (tvm *VendingMachine) issueLogin(ctx context.Context, userID int64, scopes []queries.EntityScope) (string, error) {
+	return tvm.issueNoCheck(ctx, fmt.Sprintf("login token for user %d created at %s", userID, time.Now().Format(time.RFC1123)), queries.Entity{
+		Type: queries.EntityTypeUser,
+		ID:   userID,
+	}, scopes, tvm.Cfg.LoginTokenDuration)
+}
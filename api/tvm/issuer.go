@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	queries "github.com/team-loco/loco/api/gen/db"
 )
 
@@ -42,7 +43,7 @@ func (tvm *VendingMachine) Issue(ctx context.Context, name string, userID int64,
 // Unlike [Issue], this function uses a token to authenticate the user, rather than taking a userID directly.
 func (tvm *VendingMachine) IssueWithLoginToken(ctx context.Context, name string, token string, entity queries.Entity, entityScopes []queries.EntityScope, duration time.Duration) (string, error) {
 	// this is meant to issue a token from a user's login token, although a user token could also be used
-	tokenData, err := tvm.queries.GetToken(ctx, token)
+	tokenData, err := tvm.lookupToken(ctx, token)
 	if err != nil {
 		slog.ErrorContext(ctx, err.Error())
 		return "", ErrTokenNotFound
@@ -53,24 +54,76 @@ func (tvm *VendingMachine) IssueWithLoginToken(ctx context.Context, name string,
 	if tokenData.EntityType != queries.EntityTypeUser {
 		return "", ErrImproperUsage
 	}
+	// the issued token would act as the user without saying an admin is behind it
+	if tokenData.ImpersonatorID.Valid {
+		return "", ErrImpersonationToken
+	}
+	// Issue checks the user's scopes, not the few a login waiting on MFA holds
+	if tokenData.MfaPending {
+		return "", ErrMFAPendingToken
+	}
 	userID := tokenData.EntityID
 
 	return tvm.Issue(ctx, name, userID, entity, entityScopes, duration)
 }
 
+// Mint issues a child of the given token, restricted to a subset of its scopes and a shorter lifetime, for example to hand a
+// script or a support engineer "deploy resource 5 only, for 1 hour". The child is issued to the same entity as its parent, may
+// not outlive it, and is revoked along with it. Every requested scope must be implied by the parent's scopes, or an error
+// [ErrInsufficentPermissions] is returned.
+func (tvm *VendingMachine) Mint(ctx context.Context, name string, parentToken string, entityScopes []queries.EntityScope, duration time.Duration) (string, error) {
+	parent, err := tvm.lookupToken(ctx, parentToken)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return "", ErrTokenNotFound
+	}
+	if time.Now().After(parent.ExpiresAt) {
+		return "", ErrTokenExpired
+	}
+	if parent.MfaPending {
+		return "", ErrMFAPendingToken
+	}
+	if duration > tvm.Cfg.MaxTokenDuration {
+		return "", ErrDurationExceedsMaxAllowed
+	}
+	if time.Now().Add(duration).After(parent.ExpiresAt) {
+		return "", ErrDurationExceedsParent
+	}
+
+	// the child can only narrow what the parent can do
+	for _, entityScope := range entityScopes {
+		if err := tvm.VerifyWithGivenEntityScopes(ctx, parent.Scopes, entityScope); err != nil {
+			slog.ErrorContext(ctx, err.Error())
+			return "", err
+		}
+	}
+
+	// children of an impersonation token are impersonations too
+	entity := queries.Entity{Type: parent.EntityType, ID: parent.EntityID}
+	return tvm.store(ctx, name, entity, entityScopes, duration, pgtype.Text{String: parentToken, Valid: true}, parent.ImpersonatorID, false)
+}
+
 // issueNoCheck issues a token without checking permissions.
 func (tvm *VendingMachine) issueNoCheck(ctx context.Context, name string, entity queries.Entity, entityScopes []queries.EntityScope, duration time.Duration) (string, error) {
+	return tvm.store(ctx, name, entity, entityScopes, duration, pgtype.Text{}, pgtype.Int8{}, false)
+}
+
+// store generates and stores a new token.
+func (tvm *VendingMachine) store(ctx context.Context, name string, entity queries.Entity, entityScopes []queries.EntityScope, duration time.Duration, parentToken pgtype.Text, impersonatorID pgtype.Int8, mfaPending bool) (string, error) {
 	tk := uuid.Must(uuid.NewV7())
 	tks := tk.String()
 
 	// issue the token
 	err := tvm.queries.StoreToken(ctx, queries.StoreTokenParams{
-		Name:       name,
-		Token:      tks,
-		EntityType: queries.EntityType(entity.Type),
-		EntityID:   entity.ID,
-		Scopes:     entityScopes,
-		ExpiresAt:  time.Now().Add(duration),
+		Name:           name,
+		Token:          tks,
+		EntityType:     queries.EntityType(entity.Type),
+		EntityID:       entity.ID,
+		Scopes:         entityScopes,
+		ExpiresAt:      time.Now().Add(duration),
+		ParentToken:    parentToken,
+		ImpersonatorID: impersonatorID,
+		MfaPending:     mfaPending,
 	})
 	if err != nil {
 		slog.ErrorContext(ctx, err.Error())
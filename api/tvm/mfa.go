@@ -0,0 +1,220 @@
+package tvm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	queries "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/totp"
+)
+
+// MFAPendingDuration is how long a login waiting on a TOTP check has to pass it.
+const MFAPendingDuration = 10 * time.Minute
+
+// mfaIssuer is the name authenticator apps show next to the code.
+const mfaIssuer = "loco"
+
+// mfaPendingScopes are the scopes of a login waiting on a TOTP check: read and change its own user, to enroll
+// in MFA when an org requires it and to pass the check. They would satisfy other actions on the user too, so
+// the auth interceptor only lets such a login call the MFA procedures.
+func mfaPendingScopes(userID int64) []queries.EntityScope {
+	return []queries.EntityScope{
+		{EntityType: queries.EntityTypeUser, EntityID: userID, Scope: queries.ScopeRead},
+		{EntityType: queries.EntityTypeUser, EntityID: userID, Scope: queries.ScopeWrite},
+	}
+}
+
+// MFAStatus is whether a user has enrolled in MFA and whether they must.
+type MFAStatus struct {
+	Enabled bool
+	// Required is set when an organization of the user requires MFA.
+	Required bool
+}
+
+// GetMFAStatus returns the MFA status of a user.
+func (tvm *VendingMachine) GetMFAStatus(ctx context.Context, userID int64) (MFAStatus, error) {
+	var status MFAStatus
+	secret, err := tvm.queries.GetUserTOTP(ctx, userID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return MFAStatus{}, err
+	}
+	status.Enabled = err == nil && secret.EnabledAt.Valid
+
+	status.Required, err = tvm.queries.UserRequiresMFA(ctx, userID)
+	if err != nil {
+		return MFAStatus{}, err
+	}
+	return status, nil
+}
+
+// mfaNeeded reports whether a user must pass a TOTP check to log in: they enrolled, or an org of theirs requires it.
+func (tvm *VendingMachine) mfaNeeded(ctx context.Context, userID int64) (bool, error) {
+	status, err := tvm.GetMFAStatus(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return status.Enabled || status.Required, nil
+}
+
+// issueMFAPending issues the login token of a user who has yet to pass a TOTP check.
+func (tvm *VendingMachine) issueMFAPending(ctx context.Context, userID int64) (string, error) {
+	name := fmt.Sprintf("mfa pending login for user %d created at %s", userID, time.Now().Format(time.RFC1123))
+	entity := queries.Entity{Type: queries.EntityTypeUser, ID: userID}
+	return tvm.store(ctx, name, entity, mfaPendingScopes(userID), MFAPendingDuration, pgtype.Text{}, pgtype.Int8{}, true)
+}
+
+// EnrollTOTP starts enrolling a user in MFA, replacing an enrollment that was never confirmed. It returns the
+// secret and the otpauth:// URI to add it to an authenticator app with; [ConfirmTOTP] finishes the enrollment.
+func (tvm *VendingMachine) EnrollTOTP(ctx context.Context, userID int64, account string) (string, string, error) {
+	if tvm.Cfg.MFASealer == nil {
+		return "", "", ErrMFANotConfigured
+	}
+	status, err := tvm.GetMFAStatus(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+	if status.Enabled {
+		return "", "", ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return "", "", err
+	}
+	sealed, err := tvm.Cfg.MFASealer.Seal(secret)
+	if err != nil {
+		return "", "", err
+	}
+	if err := tvm.queries.UpsertUserTOTP(ctx, queries.UpsertUserTOTPParams{UserID: userID, Secret: sealed}); err != nil {
+		return "", "", err
+	}
+	return secret, totp.URI(mfaIssuer, account, secret), nil
+}
+
+// ConfirmTOTP finishes enrolling the token's user in MFA with a code from their authenticator app. When the token
+// is a login waiting on MFA, the login is complete and a full login token is returned in place of it; otherwise the
+// session the token was issued in is marked as having passed MFA.
+func (tvm *VendingMachine) ConfirmTOTP(ctx context.Context, token string, code string) (string, error) {
+	tokenData, err := tvm.lookupToken(ctx, token)
+	if err != nil {
+		return "", ErrTokenNotFound
+	}
+	if tokenData.EntityType != queries.EntityTypeUser {
+		return "", ErrImproperUsage
+	}
+	userID := tokenData.EntityID
+
+	secret, err := tvm.queries.GetUserTOTP(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrMFANotEnrolled
+	}
+	if err != nil {
+		return "", err
+	}
+	if secret.EnabledAt.Valid {
+		return "", ErrMFAAlreadyEnabled
+	}
+	if err := tvm.checkTOTP(ctx, secret, code); err != nil {
+		return "", err
+	}
+
+	if !tokenData.MfaPending {
+		if err := tvm.queries.MarkSessionMFAVerified(ctx, pgtype.Text{String: token, Valid: true}); err != nil {
+			return "", fmt.Errorf("mark session mfa verified: %w", err)
+		}
+		return "", nil
+	}
+	return tvm.completeMFALogin(ctx, token, userID)
+}
+
+// VerifyMFA passes the TOTP check of a login waiting on it, returning a full login token in place of it.
+func (tvm *VendingMachine) VerifyMFA(ctx context.Context, token string, code string) (string, error) {
+	tokenData, err := tvm.lookupToken(ctx, token)
+	if err != nil {
+		return "", ErrTokenNotFound
+	}
+	if !tokenData.MfaPending || tokenData.EntityType != queries.EntityTypeUser {
+		return "", ErrNotMFAPending
+	}
+	userID := tokenData.EntityID
+
+	secret, err := tvm.queries.GetUserTOTP(ctx, userID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", err
+	}
+	if err != nil || !secret.EnabledAt.Valid {
+		return "", ErrMFANotEnrolled
+	}
+	if err := tvm.checkTOTP(ctx, secret, code); err != nil {
+		return "", err
+	}
+	return tvm.completeMFALogin(ctx, token, userID)
+}
+
+// DisableTOTP removes a user's second factor after checking a code from it. It is refused while an organization
+// of the user requires MFA.
+func (tvm *VendingMachine) DisableTOTP(ctx context.Context, userID int64, code string) error {
+	secret, err := tvm.queries.GetUserTOTP(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrMFANotEnrolled
+	}
+	if err != nil {
+		return err
+	}
+	required, err := tvm.queries.UserRequiresMFA(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if required && secret.EnabledAt.Valid {
+		return ErrMFARequiredByOrg
+	}
+	if secret.EnabledAt.Valid {
+		if err := tvm.checkTOTP(ctx, secret, code); err != nil {
+			return err
+		}
+	}
+	return tvm.queries.DeleteUserTOTP(ctx, userID)
+}
+
+// checkTOTP checks a code against a user's secret and records its time step, so it cannot be used again.
+func (tvm *VendingMachine) checkTOTP(ctx context.Context, secret queries.UserTotp, code string) error {
+	if tvm.Cfg.MFASealer == nil {
+		return ErrMFANotConfigured
+	}
+	plain, err := tvm.Cfg.MFASealer.Open(secret.Secret)
+	if err != nil {
+		return fmt.Errorf("open totp secret: %w", err)
+	}
+	step, err := totp.Validate(plain, code, time.Now())
+	if err != nil {
+		return ErrInvalidMFACode
+	}
+	used, err := tvm.queries.UseUserTOTPStep(ctx, queries.UseUserTOTPStepParams{UserID: secret.UserID, LastStep: step})
+	if err != nil {
+		return err
+	}
+	if used == 0 {
+		return ErrInvalidMFACode
+	}
+	return nil
+}
+
+// completeMFALogin swaps a login waiting on MFA for a full login token.
+func (tvm *VendingMachine) completeMFALogin(ctx context.Context, pendingToken string, userID int64) (string, error) {
+	scopes, err := tvm.queries.GetUserScopes(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("get user scopes: %w", err)
+	}
+	token, err := tvm.issueLogin(ctx, userID, scopes)
+	if err != nil {
+		return "", err
+	}
+	if err := tvm.Revoke(ctx, pendingToken); err != nil {
+		return "", fmt.Errorf("revoke mfa pending token: %w", err)
+	}
+	return token, nil
+}
@@ -0,0 +1,68 @@
+package providers
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Bounds on passwords. bcrypt only reads the first 72 bytes, so longer passwords are refused
+// rather than silently truncated.
+const (
+	MinPasswordLength = 10
+	MaxPasswordLength = 72
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrInvalidPassword    = fmt.Errorf("password must be between %d and %d bytes", MinPasswordLength, MaxPasswordLength)
+)
+
+// dummyHash is compared against when a user has no password, so failed logins take as long
+// whether or not the account exists.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("loco-dummy-password"), bcrypt.DefaultCost)
+
+// HashPassword checks a password's length and hashes it with bcrypt for storage.
+func HashPassword(password string) (string, error) {
+	if len(password) < MinPasswordLength || len(password) > MaxPasswordLength {
+		return "", ErrInvalidPassword
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hash), nil
+}
+
+// Password identifies a user by the password they signed up with. hash is the stored hash of the
+// user with the given address, or empty when they have none.
+func Password(address, hash, password string) EmailResponse {
+	if hash == "" {
+		bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return NewEmailResponse("", ErrInvalidCredentials)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+		return NewEmailResponse("", ErrInvalidCredentials)
+	}
+	return NewEmailResponse(address, nil)
+}
+
+// NewEmailToken returns a random single-use token to send in an email and the hash to store of it.
+func NewEmailToken() (token string, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("failed to generate email token: %w", err)
+	}
+	token = hex.EncodeToString(b)
+	return token, HashEmailToken(token), nil
+}
+
+// HashEmailToken returns the hash an emailed token is stored and looked up by.
+func HashEmailToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
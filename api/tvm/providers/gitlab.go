@@ -0,0 +1,92 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// DefaultGitLabURL is used when no self-managed GitLab instance is configured.
+const DefaultGitLabURL = "https://gitlab.com"
+
+var ErrGitLabExchange = errors.New("an issue occured while exchanging the gitlab token")
+
+// GitLabUser is the part of GitLab's user endpoint loco cares about.
+type GitLabUser struct {
+	ID          int64  `json:"id"`
+	Username    string `json:"username"`
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	AvatarURL   string `json:"avatar_url"`
+	ConfirmedAt string `json:"confirmed_at"`
+}
+
+// GitLab identifies users through gitlab.com or a self-managed GitLab instance.
+type GitLab struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// NewGitLab creates a GitLab provider for the instance at baseURL.
+func NewGitLab(httpClient *http.Client, baseURL string) *GitLab {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	if baseURL == "" {
+		baseURL = DefaultGitLabURL
+	}
+	return &GitLab{
+		httpClient: httpClient,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// URL returns the instance's base URL.
+func (g *GitLab) URL() string {
+	return g.baseURL
+}
+
+// User fetches the user owning an OAuth token (read_user scope).
+func (g *GitLab) User(ctx context.Context, token string) (*GitLabUser, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", g.baseURL+"/api/v4/user", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("gitlab user api returned status %d", resp.StatusCode)
+	}
+
+	var user GitLabUser
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// Email fetches the user's primary email using the provided OAuth token.
+func (g *GitLab) Email(ctx context.Context, token string) EmailResponse {
+	user, err := g.User(ctx, token)
+	if err != nil {
+		slog.Error("failed to fetch gitlab user", "err", err)
+		return NewEmailResponse("", ErrGitLabExchange)
+	}
+	// the primary email of a gitlab user is always confirmed unless the instance skips confirmation
+	if user.Email == "" || user.ConfirmedAt == "" {
+		slog.Error("gitlab user has no confirmed primary email address")
+		return NewEmailResponse("", ErrGitLabExchange)
+	}
+	return NewEmailResponse(user.Email, nil)
+}
@@ -0,0 +1,200 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// GoogleIssuer is the OIDC issuer of Google accounts, which sign in through [OIDC].
+const GoogleIssuer = "https://accounts.google.com"
+
+var ErrOIDCExchange = errors.New("an issue occured while exchanging the oidc token")
+
+// OIDCClaims maps the claims of an identity provider's userinfo response onto loco's user fields.
+// Empty fields use the standard OIDC claim names.
+type OIDCClaims struct {
+	Subject string `env:"OIDC_SUBJECT_CLAIM"` // defaults to "sub"
+	Email   string `env:"OIDC_EMAIL_CLAIM"`   // defaults to "email"
+	// EmailVerified must be true for the email to be trusted. Defaults to "email_verified"; set it to "-" for
+	// providers that only hand out verified addresses and do not send the claim.
+	EmailVerified string `env:"OIDC_EMAIL_VERIFIED_CLAIM"`
+	Name          string `env:"OIDC_NAME_CLAIM"`    // defaults to "name"
+	Picture       string `env:"OIDC_PICTURE_CLAIM"` // defaults to "picture"
+}
+
+func (c OIDCClaims) withDefaults() OIDCClaims {
+	if c.Subject == "" {
+		c.Subject = "sub"
+	}
+	if c.Email == "" {
+		c.Email = "email"
+	}
+	if c.EmailVerified == "" {
+		c.EmailVerified = "email_verified"
+	}
+	if c.Name == "" {
+		c.Name = "name"
+	}
+	if c.Picture == "" {
+		c.Picture = "picture"
+	}
+	return c
+}
+
+// OIDCEndpoints are the endpoints of an OIDC provider, from its discovery document.
+type OIDCEndpoints struct {
+	Issuer        string `json:"issuer"`
+	Authorization string `json:"authorization_endpoint"`
+	Token         string `json:"token_endpoint"`
+	UserInfo      string `json:"userinfo_endpoint"`
+}
+
+// OIDCUser is a user as described by the userinfo endpoint, after claims mapping.
+type OIDCUser struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+}
+
+// OIDC identifies users through any OpenID Connect provider (Okta, Entra ID, Keycloak, Google, ...). Endpoints are
+// discovered from the issuer on first use. Users are read from the userinfo endpoint with the access token, so no ID
+// token signatures need to be checked.
+type OIDC struct {
+	httpClient *http.Client
+	issuer     string
+	claims     OIDCClaims
+
+	mu        sync.Mutex
+	endpoints *OIDCEndpoints
+}
+
+// NewOIDC creates an OIDC provider for issuer.
+func NewOIDC(httpClient *http.Client, issuer string, claims OIDCClaims) *OIDC {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &OIDC{
+		httpClient: httpClient,
+		issuer:     strings.TrimSuffix(issuer, "/"),
+		claims:     claims.withDefaults(),
+	}
+}
+
+// Issuer returns the issuer URL.
+func (o *OIDC) Issuer() string {
+	return o.issuer
+}
+
+// Endpoints returns the provider's endpoints, fetching the discovery document if it has not been fetched yet.
+func (o *OIDC) Endpoints(ctx context.Context) (*OIDCEndpoints, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.endpoints != nil {
+		return o.endpoints, nil
+	}
+
+	var endpoints OIDCEndpoints
+	if err := o.get(ctx, o.issuer+"/.well-known/openid-configuration", "", &endpoints); err != nil {
+		return nil, fmt.Errorf("discover %s: %w", o.issuer, err)
+	}
+	// the discovery document must be for the issuer it was fetched from
+	if strings.TrimSuffix(endpoints.Issuer, "/") != o.issuer {
+		return nil, fmt.Errorf("discover %s: document is for issuer %q", o.issuer, endpoints.Issuer)
+	}
+	if endpoints.Authorization == "" || endpoints.Token == "" || endpoints.UserInfo == "" {
+		return nil, fmt.Errorf("discover %s: authorization, token and userinfo endpoints are required", o.issuer)
+	}
+	o.endpoints = &endpoints
+	return o.endpoints, nil
+}
+
+// User fetches the user owning an access token from the userinfo endpoint.
+func (o *OIDC) User(ctx context.Context, token string) (*OIDCUser, error) {
+	endpoints, err := o.Endpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims map[string]any
+	if err := o.get(ctx, endpoints.UserInfo, token, &claims); err != nil {
+		return nil, fmt.Errorf("userinfo: %w", err)
+	}
+
+	user := &OIDCUser{
+		Subject: stringClaim(claims, o.claims.Subject),
+		Email:   stringClaim(claims, o.claims.Email),
+		Name:    stringClaim(claims, o.claims.Name),
+		Picture: stringClaim(claims, o.claims.Picture),
+	}
+	if o.claims.EmailVerified == "-" {
+		user.EmailVerified = true
+	} else {
+		// some providers send the claim as a string
+		switch v := claims[o.claims.EmailVerified].(type) {
+		case bool:
+			user.EmailVerified = v
+		case string:
+			user.EmailVerified = v == "true"
+		}
+	}
+	if user.Subject == "" {
+		return nil, fmt.Errorf("userinfo: missing %q claim", o.claims.Subject)
+	}
+	return user, nil
+}
+
+// Email fetches the user's verified email using the provided access token.
+func (o *OIDC) Email(ctx context.Context, token string) EmailResponse {
+	user, err := o.User(ctx, token)
+	if err != nil {
+		slog.Error("failed to fetch oidc user", "issuer", o.issuer, "err", err)
+		return NewEmailResponse("", ErrOIDCExchange)
+	}
+	if user.Email == "" || !user.EmailVerified {
+		slog.Error("oidc user has no verified email address", "issuer", o.issuer, "subject", user.Subject)
+		return NewEmailResponse("", ErrOIDCExchange)
+	}
+	return NewEmailResponse(user.Email, nil)
+}
+
+func (o *OIDC) get(ctx context.Context, url, token string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Accept", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func stringClaim(claims map[string]any, name string) string {
+	switch v := claims[name].(type) {
+	case string:
+		return v
+	case float64:
+		// numeric subjects, e.g. from providers using database ids
+		return fmt.Sprintf("%.0f", v)
+	default:
+		return ""
+	}
+}
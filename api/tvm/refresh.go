@@ -0,0 +1,166 @@
+package tvm
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	queries "github.com/team-loco/loco/api/gen/db"
+)
+
+// IssueRefreshToken starts a session for a login token: the returned refresh token trades for a new login token
+// and refresh token with [Refresh] until it goes unused for [Config.RefreshTokenDuration]. mfaVerified is set when
+// the login passed a TOTP check.
+func (tvm *VendingMachine) IssueRefreshToken(ctx context.Context, loginToken string, mfaVerified bool) (string, error) {
+	if tvm.Cfg.RefreshTokenDuration <= 0 {
+		return "", ErrRefreshDisabled
+	}
+	tokenData, err := tvm.lookupToken(ctx, loginToken)
+	if err != nil {
+		return "", ErrTokenNotFound
+	}
+	if tokenData.EntityType != queries.EntityTypeUser {
+		return "", ErrImproperUsage
+	}
+	if tokenData.ImpersonatorID.Valid {
+		return "", ErrImpersonationToken
+	}
+	if tokenData.MfaPending {
+		return "", ErrMFAPendingToken
+	}
+
+	return tvm.storeRefreshToken(ctx, uuid.Must(uuid.NewV7()).String(), tokenData.EntityID, loginToken, mfaVerified)
+}
+
+// Refresh trades a refresh token for a new login token and a new refresh token, which expires
+// [Config.RefreshTokenDuration] from now. Every refresh token can be used once: presenting one again means it
+// leaked, so the whole session is revoked and [ErrRefreshTokenReused] returned. A session that never passed a TOTP
+// check is revoked too once its user needs MFA, returning [ErrSessionMFARequired].
+func (tvm *VendingMachine) Refresh(ctx context.Context, refreshToken string) (string, string, error) {
+	if tvm.Cfg.RefreshTokenDuration <= 0 {
+		return "", "", ErrRefreshDisabled
+	}
+	hash := hashRefreshToken(refreshToken)
+
+	session, err := tvm.queries.UseRefreshToken(ctx, hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", "", tvm.refreshFailed(ctx, hash)
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("use refresh token: %w", err)
+	}
+
+	mfa, err := tvm.mfaNeeded(ctx, session.UserID)
+	if err != nil {
+		return "", "", fmt.Errorf("check mfa: %w", err)
+	}
+	if mfa && !session.MfaVerified {
+		slog.WarnContext(ctx, "session did not pass mfa, revoking it", "userId", session.UserID, "family", session.FamilyID)
+		tokens, err := tvm.queries.DeleteRefreshTokenFamily(ctx, session.FamilyID)
+		if err != nil {
+			return "", "", fmt.Errorf("delete refresh tokens: %w", err)
+		}
+		if err := tvm.revokeSessionTokens(ctx, tokens); err != nil {
+			return "", "", err
+		}
+		return "", "", ErrSessionMFARequired
+	}
+
+	scopes, err := tvm.queries.GetUserScopes(ctx, session.UserID)
+	if err != nil {
+		return "", "", fmt.Errorf("get user scopes: %w", err)
+	}
+	token, err := tvm.issueLogin(ctx, session.UserID, scopes)
+	if err != nil {
+		return "", "", err
+	}
+	next, err := tvm.storeRefreshToken(ctx, session.FamilyID, session.UserID, token, session.MfaVerified)
+	if err != nil {
+		return "", "", err
+	}
+	return token, next, nil
+}
+
+// EndSession revokes a login token along with the session it was issued in: its refresh tokens and the other login
+// tokens refreshed from it.
+func (tvm *VendingMachine) EndSession(ctx context.Context, loginToken string) error {
+	tokens, err := tvm.queries.DeleteRefreshTokenFamilyByAccessToken(ctx, pgtype.Text{String: loginToken, Valid: true})
+	if err != nil {
+		return fmt.Errorf("delete refresh tokens: %w", err)
+	}
+	if err := tvm.revokeSessionTokens(ctx, tokens); err != nil {
+		return err
+	}
+	return tvm.Revoke(ctx, loginToken)
+}
+
+// refreshFailed works out why a refresh token was refused. A token that was already used revokes its session.
+func (tvm *VendingMachine) refreshFailed(ctx context.Context, hash string) error {
+	family, err := tvm.queries.GetUsedRefreshTokenFamily(ctx, hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return fmt.Errorf("get refresh token: %w", err)
+	}
+
+	slog.WarnContext(ctx, "refresh token reused, revoking session", "family", family)
+	tokens, err := tvm.queries.DeleteRefreshTokenFamily(ctx, family)
+	if err != nil {
+		return fmt.Errorf("delete refresh tokens: %w", err)
+	}
+	if err := tvm.revokeSessionTokens(ctx, tokens); err != nil {
+		return err
+	}
+	return ErrRefreshTokenReused
+}
+
+// revokeSessionTokens revokes the login tokens issued in a session.
+func (tvm *VendingMachine) revokeSessionTokens(ctx context.Context, tokens []pgtype.Text) error {
+	for _, token := range tokens {
+		if !token.Valid {
+			continue
+		}
+		if err := tvm.Revoke(ctx, token.String); err != nil {
+			return fmt.Errorf("revoke session token: %w", err)
+		}
+	}
+	return nil
+}
+
+// storeRefreshToken generates and stores a refresh token of a session, issued alongside the given login token.
+func (tvm *VendingMachine) storeRefreshToken(ctx context.Context, family string, userID int64, loginToken string, mfaVerified bool) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate refresh token: %w", err)
+	}
+	token := hex.EncodeToString(b)
+
+	err := tvm.queries.CreateRefreshToken(ctx, queries.CreateRefreshTokenParams{
+		TokenHash:   hashRefreshToken(token),
+		FamilyID:    family,
+		UserID:      userID,
+		AccessToken: pgtype.Text{String: loginToken, Valid: true},
+		ExpiresAt:   pgtype.Timestamptz{Time: time.Now().Add(tvm.Cfg.RefreshTokenDuration), Valid: true},
+		MfaVerified: mfaVerified,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return "", ErrStoreToken
+	}
+	return token, nil
+}
+
+// hashRefreshToken returns the hash a refresh token is stored and looked up by.
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
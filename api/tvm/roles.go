@@ -7,13 +7,14 @@ import (
 	"slices"
 	"time"
 
+	"github.com/team-loco/loco/api/db"
 	queries "github.com/team-loco/loco/api/gen/db"
 )
 
 // GetRoles returns all roles for the given user associated with the given token. The token must have user:read for the user the token is associated with.
 func (tvm *VendingMachine) GetRoles(ctx context.Context, token string) ([]queries.EntityScope, error) {
 	// get the token data
-	tokenData, err := tvm.queries.GetToken(ctx, token)
+	tokenData, err := tvm.lookupToken(ctx, token)
 	if err != nil {
 		return nil, fmt.Errorf("get user scopes: %w", err)
 	}
@@ -140,7 +141,7 @@ func (tvm *VendingMachine) UpdateRoles(ctx context.Context, userID int64, addSco
 	}
 	defer tx.Rollback(ctx)
 
-	qtx := tvm.queries.(*queries.Queries).WithTx(tx)
+	qtx := db.QueriesFor(tx)
 
 	for _, es := range addScopes {
 		if err := qtx.AddUserScope(ctx, queries.AddUserScopeParams{
@@ -167,5 +168,8 @@ func (tvm *VendingMachine) UpdateRoles(ctx context.Context, userID int64, addSco
 		slog.ErrorContext(ctx, err.Error())
 		return fmt.Errorf("commit tx: %w", err)
 	}
+
+	// drop the user's cached tokens so nothing cached predates the role change
+	tvm.cache.invalidateEntityTokens(queries.Entity{Type: queries.EntityTypeUser, ID: userID}, "")
 	return nil
 }
@@ -0,0 +1,67 @@
+package tvm
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/team-loco/loco/api/db"
+	queries "github.com/team-loco/loco/api/gen/db"
+)
+
+// IssueForServiceAccount issues a token to the given service account for the given duration. The service account must hold all of the
+// requested scopes, either explicitly or implicitly, or an error [ErrInsufficentPermissions] is returned.
+// This function does NOT check the permissions of the caller. It is expected that the caller has already verified that the caller may
+// issue tokens for the service account.
+func (tvm *VendingMachine) IssueForServiceAccount(ctx context.Context, name string, serviceAccountID int64, entityScopes []queries.EntityScope, duration time.Duration) (string, error) {
+	if duration > tvm.Cfg.MaxTokenDuration {
+		return "", ErrDurationExceedsMaxAllowed
+	}
+
+	accountScopes, err := tvm.queries.GetServiceAccountScopes(ctx, serviceAccountID)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return "", err
+	}
+
+	for _, entityScope := range entityScopes {
+		if err := tvm.VerifyWithGivenEntityScopes(ctx, accountScopes, entityScope); err != nil {
+			slog.ErrorContext(ctx, err.Error())
+			return "", err
+		}
+	}
+
+	return tvm.issueNoCheck(ctx, name, queries.Entity{Type: queries.EntityTypeServiceAccount, ID: serviceAccountID}, entityScopes, duration)
+}
+
+// SetServiceAccountScopes replaces the scopes of the given service account. Tokens already issued to it keep the scopes they were
+// issued with until they expire or are revoked. This function does not check the permissions of the caller.
+func (tvm *VendingMachine) SetServiceAccountScopes(ctx context.Context, serviceAccountID int64, entityScopes []queries.EntityScope) error {
+	tx, err := tvm.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := db.QueriesFor(tx)
+
+	if err := qtx.RemoveAllServiceAccountScopes(ctx, serviceAccountID); err != nil {
+		return fmt.Errorf("remove service account scopes: %w", err)
+	}
+	for _, es := range entityScopes {
+		if err := qtx.AddServiceAccountScope(ctx, queries.AddServiceAccountScopeParams{
+			ServiceAccountID: serviceAccountID,
+			EntityType:       es.EntityType,
+			EntityID:         es.EntityID,
+			Scope:            es.Scope,
+		}); err != nil {
+			return fmt.Errorf("add service account scope: %w", err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
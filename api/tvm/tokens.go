@@ -10,26 +10,114 @@ import (
 )
 
 func (tvm *VendingMachine) GetToken(ctx context.Context, token string) (queries.Entity, []queries.EntityScope, error) {
-	tokenData, err := tvm.queries.GetToken(ctx, token)
+	claims, err := tvm.GetClaims(ctx, token)
+	if err != nil {
+		return queries.Entity{}, nil, err
+	}
+	return claims.Entity, claims.Scopes, nil
+}
+
+// Claims is what a token grants and to whom.
+type Claims struct {
+	Entity queries.Entity
+	Scopes []queries.EntityScope
+	// ImpersonatorID is the platform admin acting as Entity through an impersonation token, or 0.
+	ImpersonatorID int64
+	// MFAPending is set on login tokens waiting on a TOTP check; see [VendingMachine.VerifyMFA].
+	MFAPending bool
+}
+
+// GetClaims returns the claims of the given token.
+func (tvm *VendingMachine) GetClaims(ctx context.Context, token string) (Claims, error) {
+	tokenData, err := tvm.lookupToken(ctx, token)
 	if err != nil {
 		slog.ErrorContext(ctx, err.Error())
 		if errors.Is(err, pgx.ErrNoRows) {
 			// we don't know why its not in the cache, so return broad error
-			return queries.Entity{}, nil, ErrInvalidExpiredToken
+			return Claims{}, ErrInvalidExpiredToken
 		}
 
-		return queries.Entity{}, nil, err
+		return Claims{}, err
 	}
 
-	return queries.Entity{
-		Type: tokenData.EntityType,
-		ID:   tokenData.EntityID,
-	}, tokenData.Scopes, nil
+	return Claims{
+		Entity: queries.Entity{
+			Type: tokenData.EntityType,
+			ID:   tokenData.EntityID,
+		},
+		Scopes:         tokenData.Scopes,
+		ImpersonatorID: tokenData.ImpersonatorID.Int64,
+		MFAPending:     tokenData.MfaPending,
+	}, nil
+}
+
+// lookupToken returns a token's data, from the cache when possible.
+func (tvm *VendingMachine) lookupToken(ctx context.Context, token string) (queries.Token, error) {
+	if tokenData, ok := tvm.cache.getToken(token); ok {
+		return tokenData, nil
+	}
+	tokenData, err := tvm.queries.GetToken(ctx, token)
+	if err != nil {
+		return queries.Token{}, err
+	}
+	tvm.cache.putToken(token, tokenData)
+	return tokenData, nil
 }
 
 // Revoke deletes the given token, effectively immediately revoking it.
+//
+// The token is dropped from the cache only once it is deleted: invalidating first would let a
+// concurrent verify cache it again from the row that is still there. The delete's change
+// notification drops it from the cache again, on this replica as on the others.
 func (tvm *VendingMachine) Revoke(ctx context.Context, token string) error {
-	return tvm.queries.DeleteToken(ctx, token)
+	if err := tvm.queries.DeleteToken(ctx, token); err != nil {
+		return err
+	}
+	tvm.cache.invalidateToken(token)
+	return nil
+}
+
+// RevokeByName deletes the token with the given name issued to the given entity. This function does not check the permissions of the caller.
+// Like Revoke, the cache is invalidated after the delete.
+func (tvm *VendingMachine) RevokeByName(ctx context.Context, name string, entity queries.Entity) error {
+	if err := tvm.queries.DeleteTokenByNameAndEntity(ctx, queries.DeleteTokenByNameAndEntityParams{
+		Name:       name,
+		EntityType: entity.Type,
+		EntityID:   entity.ID,
+	}); err != nil {
+		return err
+	}
+	tvm.cache.invalidateEntityTokens(entity, name)
+	return nil
+}
+
+// RevokeAllForEntity deletes every token issued to the given entity, and the refresh tokens of a user. This function does not
+// check the permissions of the caller. Like Revoke, the cache is invalidated after the delete.
+func (tvm *VendingMachine) RevokeAllForEntity(ctx context.Context, entity queries.Entity) error {
+	if entity.Type == queries.EntityTypeUser {
+		if err := tvm.queries.DeleteRefreshTokensForUser(ctx, entity.ID); err != nil {
+			return err
+		}
+	}
+	if err := tvm.queries.DeleteTokensForEntity(ctx, queries.DeleteTokensForEntityParams{
+		EntityType: entity.Type,
+		EntityID:   entity.ID,
+	}); err != nil {
+		return err
+	}
+	tvm.cache.invalidateEntityTokens(entity, "")
+	return nil
+}
+
+// InvalidateEntityTokens drops the cached tokens issued to the given entity, optionally only those with the given name,
+// e.g. when another replica revoked them. The tokens themselves are left alone.
+func (tvm *VendingMachine) InvalidateEntityTokens(entity queries.Entity, name string) {
+	tvm.cache.invalidateEntityTokens(entity, name)
+}
+
+// FlushTokenCache drops every cached token, e.g. when this replica may have missed revocations made through another.
+func (tvm *VendingMachine) FlushTokenCache() {
+	tvm.cache.flush()
 }
 
 // ListTokensForEntity lists all tokens associated with the given entity. This function does not check the permissions of the caller.
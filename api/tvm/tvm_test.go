@@ -5,8 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	queries "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/totp"
 	"github.com/team-loco/loco/api/tvm"
+	"github.com/team-loco/loco/api/tvm/actions"
 	"github.com/team-loco/loco/api/tvm/providers"
 )
 
@@ -19,8 +23,16 @@ type TestingQueries struct {
 	// user 3 user3@loco-testing.com: r, w of org 1
 	// user 4 user4@loco-testing.com: r or ws 1
 	// user 5 user5@loco-testing.com: r, w, a of wks 3
+	// environment 1 in ws 1, resource 4 in ws 1 and environment 1
+	// user 6 user6@loco-testing.com: r, w of environment 1
+	// service account 1 in ws 1: r of ws 1
 	queries.Querier
 	tokens map[string]queries.Token
+	// second factors, and the users belonging to an org requiring MFA
+	totp       map[int64]queries.UserTotp
+	requireMFA map[int64]bool
+	// refresh tokens by hash
+	refresh map[string]queries.RefreshToken
 }
 
 func (*TestingQueries) GetUserByEmail(ctx context.Context, email string) (queries.User, error) {
@@ -35,6 +47,8 @@ func (*TestingQueries) GetUserByEmail(ctx context.Context, email string) (querie
 		return queries.User{ID: 4, Email: email}, nil
 	case "user5@loco-testing.com":
 		return queries.User{ID: 5, Email: email}, nil
+	case "user6@loco-testing.com":
+		return queries.User{ID: 6, Email: email}, nil
 	default:
 		return queries.User{}, tvm.ErrUserNotFound
 	}
@@ -81,6 +95,14 @@ func (*TestingQueries) GetUserScopes(ctx context.Context, userID int64) ([]queri
 			{Scope: queries.ScopeWrite, EntityType: queries.EntityTypeWorkspace, EntityID: 3},
 			{Scope: queries.ScopeAdmin, EntityType: queries.EntityTypeWorkspace, EntityID: 3},
 		}, nil
+	case 6:
+		return []queries.EntityScope{
+			{Scope: queries.ScopeRead, EntityType: queries.EntityTypeUser, EntityID: userID},
+			{Scope: queries.ScopeWrite, EntityType: queries.EntityTypeUser, EntityID: userID},
+			{Scope: queries.ScopeAdmin, EntityType: queries.EntityTypeUser, EntityID: userID},
+			{Scope: queries.ScopeRead, EntityType: queries.EntityTypeEnvironment, EntityID: 1},
+			{Scope: queries.ScopeWrite, EntityType: queries.EntityTypeEnvironment, EntityID: 1},
+		}, nil
 	default:
 		return nil, tvm.ErrUserNotFound
 	}
@@ -98,6 +120,8 @@ func (tq *TestingQueries) GetUserScopesByEmail(ctx context.Context, email string
 		return tq.GetUserScopes(ctx, 4)
 	case "user5@loco-testing.com":
 		return tq.GetUserScopes(ctx, 5)
+	case "user6@loco-testing.com":
+		return tq.GetUserScopes(ctx, 6)
 	default:
 		return nil, tvm.ErrUserNotFound
 	}
@@ -151,17 +175,100 @@ func (*TestingQueries) GetWorkspaceOrganizationIDByResourceID(ctx context.Contex
 			WorkspaceID: 3,
 			OrgID:       2,
 		}, nil
+	case 4:
+		return queries.GetWorkspaceOrganizationIDByResourceIDRow{
+			WorkspaceID:   1,
+			OrgID:         1,
+			EnvironmentID: pgtype.Int8{Int64: 1, Valid: true},
+		}, nil
 	default:
 		return queries.GetWorkspaceOrganizationIDByResourceIDRow{}, tvm.ErrEntityNotFound
 	}
 }
 
+func (*TestingQueries) GetEnvironmentWorkspaceOrganizationID(ctx context.Context, id int64) (queries.GetEnvironmentWorkspaceOrganizationIDRow, error) {
+	switch id {
+	case 1:
+		return queries.GetEnvironmentWorkspaceOrganizationIDRow{
+			WorkspaceID: 1,
+			OrgID:       1,
+		}, nil
+	default:
+		return queries.GetEnvironmentWorkspaceOrganizationIDRow{}, tvm.ErrEntityNotFound
+	}
+}
+
+func (*TestingQueries) GetServiceAccountScopes(ctx context.Context, serviceAccountID int64) ([]queries.EntityScope, error) {
+	switch serviceAccountID {
+	case 1:
+		return []queries.EntityScope{
+			{EntityType: queries.EntityTypeWorkspace, EntityID: 1, Scope: queries.ScopeRead},
+		}, nil
+	default:
+		return []queries.EntityScope{}, nil
+	}
+}
+
+func (*TestingQueries) GetServiceAccountWorkspaceOrganizationID(ctx context.Context, id int64) (queries.GetServiceAccountWorkspaceOrganizationIDRow, error) {
+	switch id {
+	case 1:
+		return queries.GetServiceAccountWorkspaceOrganizationIDRow{
+			WorkspaceID: 1,
+			OrgID:       1,
+		}, nil
+	default:
+		return queries.GetServiceAccountWorkspaceOrganizationIDRow{}, tvm.ErrEntityNotFound
+	}
+}
+
+func (tq *TestingQueries) ListEntityParents(ctx context.Context, params queries.ListEntityParentsParams) ([]queries.ListEntityParentsRow, error) {
+	var rows []queries.ListEntityParentsRow
+	for _, id := range params.WorkspaceIds {
+		orgID, err := tq.GetOrganizationIDByWorkspaceID(ctx, id)
+		if err != nil {
+			continue
+		}
+		rows = append(rows, queries.ListEntityParentsRow{EntityType: queries.EntityTypeWorkspace, EntityID: id, OrgID: orgID})
+	}
+	for _, id := range params.EnvironmentIds {
+		row, err := tq.GetEnvironmentWorkspaceOrganizationID(ctx, id)
+		if err != nil {
+			continue
+		}
+		rows = append(rows, queries.ListEntityParentsRow{
+			EntityType:  queries.EntityTypeEnvironment,
+			EntityID:    id,
+			OrgID:       row.OrgID,
+			WorkspaceID: pgtype.Int8{Int64: row.WorkspaceID, Valid: true},
+		})
+	}
+	for _, id := range params.ResourceIds {
+		row, err := tq.GetWorkspaceOrganizationIDByResourceID(ctx, id)
+		if err != nil {
+			continue
+		}
+		rows = append(rows, queries.ListEntityParentsRow{
+			EntityType:    queries.EntityTypeResource,
+			EntityID:      id,
+			OrgID:         row.OrgID,
+			WorkspaceID:   pgtype.Int8{Int64: row.WorkspaceID, Valid: true},
+			EnvironmentID: row.EnvironmentID,
+		})
+	}
+	return rows, nil
+}
+
 func (tq *TestingQueries) StoreToken(ctx context.Context, params queries.StoreTokenParams) error {
 	tq.tokens[params.Token] = queries.Token{
-		Scopes:     params.Scopes,
-		EntityID:   params.EntityID,
-		EntityType: params.EntityType,
-		ExpiresAt:  params.ExpiresAt,
+		Name:           params.Name,
+		Token:          params.Token,
+		Scopes:         params.Scopes,
+		EntityID:       params.EntityID,
+		EntityType:     params.EntityType,
+		ExpiresAt:      params.ExpiresAt,
+		ParentToken:    params.ParentToken,
+		ImpersonatorID: params.ImpersonatorID,
+		MfaPending:     params.MfaPending,
 	}
 	return nil
 }
@@ -176,6 +283,126 @@ func (tq *TestingQueries) GetToken(ctx context.Context, token string) (queries.T
 
 func (tq *TestingQueries) DeleteToken(ctx context.Context, token string) error {
 	delete(tq.tokens, token)
+	// parent_token cascades on delete
+	for child, tk := range tq.tokens {
+		if tk.ParentToken.Valid && tk.ParentToken.String == token {
+			_ = tq.DeleteToken(ctx, child)
+		}
+	}
+	return nil
+}
+
+func (tq *TestingQueries) GetUserTOTP(ctx context.Context, userID int64) (queries.UserTotp, error) {
+	secret, ok := tq.totp[userID]
+	if !ok {
+		return queries.UserTotp{}, pgx.ErrNoRows
+	}
+	return secret, nil
+}
+
+func (tq *TestingQueries) UpsertUserTOTP(ctx context.Context, params queries.UpsertUserTOTPParams) error {
+	if secret, ok := tq.totp[params.UserID]; ok && secret.EnabledAt.Valid {
+		return nil
+	}
+	tq.totp[params.UserID] = queries.UserTotp{UserID: params.UserID, Secret: params.Secret}
+	return nil
+}
+
+func (tq *TestingQueries) UseUserTOTPStep(ctx context.Context, params queries.UseUserTOTPStepParams) (int64, error) {
+	secret, ok := tq.totp[params.UserID]
+	if !ok || secret.LastStep >= params.LastStep {
+		return 0, nil
+	}
+	secret.LastStep = params.LastStep
+	secret.EnabledAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	tq.totp[params.UserID] = secret
+	return 1, nil
+}
+
+func (tq *TestingQueries) DeleteUserTOTP(ctx context.Context, userID int64) error {
+	delete(tq.totp, userID)
+	return nil
+}
+
+func (tq *TestingQueries) UserRequiresMFA(ctx context.Context, userID int64) (bool, error) {
+	return tq.requireMFA[userID], nil
+}
+
+func (tq *TestingQueries) CreateRefreshToken(ctx context.Context, params queries.CreateRefreshTokenParams) error {
+	tq.refresh[params.TokenHash] = queries.RefreshToken{
+		TokenHash:   params.TokenHash,
+		FamilyID:    params.FamilyID,
+		UserID:      params.UserID,
+		AccessToken: params.AccessToken,
+		ExpiresAt:   params.ExpiresAt,
+		MfaVerified: params.MfaVerified,
+	}
+	return nil
+}
+
+func (tq *TestingQueries) UseRefreshToken(ctx context.Context, tokenHash string) (queries.UseRefreshTokenRow, error) {
+	rt, ok := tq.refresh[tokenHash]
+	if !ok || rt.UsedAt.Valid || rt.ExpiresAt.Time.Before(time.Now()) {
+		return queries.UseRefreshTokenRow{}, pgx.ErrNoRows
+	}
+	rt.UsedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	tq.refresh[tokenHash] = rt
+	return queries.UseRefreshTokenRow{FamilyID: rt.FamilyID, UserID: rt.UserID, MfaVerified: rt.MfaVerified}, nil
+}
+
+func (tq *TestingQueries) MarkSessionMFAVerified(ctx context.Context, accessToken pgtype.Text) error {
+	for _, rt := range tq.refresh {
+		if rt.AccessToken != accessToken {
+			continue
+		}
+		for hash, member := range tq.refresh {
+			if member.FamilyID == rt.FamilyID {
+				member.MfaVerified = true
+				tq.refresh[hash] = member
+			}
+		}
+	}
+	return nil
+}
+
+func (tq *TestingQueries) GetUsedRefreshTokenFamily(ctx context.Context, tokenHash string) (string, error) {
+	rt, ok := tq.refresh[tokenHash]
+	if !ok || !rt.UsedAt.Valid {
+		return "", pgx.ErrNoRows
+	}
+	return rt.FamilyID, nil
+}
+
+func (tq *TestingQueries) DeleteRefreshTokenFamily(ctx context.Context, familyID string) ([]pgtype.Text, error) {
+	var tokens []pgtype.Text
+	for hash, rt := range tq.refresh {
+		if rt.FamilyID == familyID {
+			tokens = append(tokens, rt.AccessToken)
+			delete(tq.refresh, hash)
+		}
+	}
+	return tokens, nil
+}
+
+func (tq *TestingQueries) DeleteRefreshTokenFamilyByAccessToken(ctx context.Context, accessToken pgtype.Text) ([]pgtype.Text, error) {
+	for _, rt := range tq.refresh {
+		if rt.AccessToken == accessToken {
+			return tq.DeleteRefreshTokenFamily(ctx, rt.FamilyID)
+		}
+	}
+	return nil, nil
+}
+
+func (tq *TestingQueries) DeleteRefreshTokensForUser(ctx context.Context, userID int64) error {
+	for hash, rt := range tq.refresh {
+		if rt.UserID == userID {
+			delete(tq.refresh, hash)
+		}
+	}
+	return nil
+}
+
+func (tq *TestingQueries) DeleteExpiredRefreshTokens(ctx context.Context) error {
 	return nil
 }
 
@@ -201,6 +428,8 @@ func TestingGithubProvider(ctx context.Context, token string) providers.EmailRes
 		return providers.NewEmailResponse("user4@loco-testing.com", nil)
 	case "github-token-user5":
 		return providers.NewEmailResponse("user5@loco-testing.com", nil)
+	case "github-token-user6":
+		return providers.NewEmailResponse("user6@loco-testing.com", nil)
 	}
 	return providers.NewEmailResponse("", tvm.ErrUserNotFound)
 }
@@ -704,3 +933,627 @@ func TestUser5Permissions(t *testing.T) {
 		}
 	})
 }
+
+// user 6 has read/write on environment 1 only
+func TestUser6Permissions(t *testing.T) {
+	machine := tvm.NewVendingMachine(nil, &TestingQueries{tokens: make(map[string]queries.Token)}, tvm.Config{
+		MaxTokenDuration:   24 * time.Hour,
+		LoginTokenDuration: 15 * time.Minute,
+	})
+	_, token, err := machine.Exchange(t.Context(), TestingGithubProvider(t.Context(), "github-token-user6"))
+	if err != nil {
+		t.Fatalf("unexpected error during exchange: %v", err)
+	}
+
+	t.Run("granted environment 1 write", func(t *testing.T) {
+		err := machine.Verify(context.Background(), token, queries.EntityScope{
+			EntityType: queries.EntityTypeEnvironment,
+			EntityID:   1,
+			Scope:      queries.ScopeWrite,
+		})
+		if err != nil {
+			t.Errorf("expected no error for environment 1 write, got: %v", err)
+		}
+	})
+
+	t.Run("denied environment 1 admin", func(t *testing.T) {
+		err := machine.Verify(context.Background(), token, queries.EntityScope{
+			EntityType: queries.EntityTypeEnvironment,
+			EntityID:   1,
+			Scope:      queries.ScopeAdmin,
+		})
+		if err != tvm.ErrInsufficentPermissions {
+			t.Errorf("expected insufficient permissions error, got: %v", err)
+		}
+	})
+
+	t.Run("granted resource 4 write via environment 1", func(t *testing.T) {
+		err := machine.Verify(context.Background(), token, queries.EntityScope{
+			EntityType: queries.EntityTypeResource,
+			EntityID:   4,
+			Scope:      queries.ScopeWrite,
+		})
+		if err != nil {
+			t.Errorf("expected no error for resource 4 write via environment 1, got: %v", err)
+		}
+	})
+
+	t.Run("denied resource 1 read outside environment 1", func(t *testing.T) {
+		err := machine.Verify(context.Background(), token, queries.EntityScope{
+			EntityType: queries.EntityTypeResource,
+			EntityID:   1,
+			Scope:      queries.ScopeRead,
+		})
+		if err != tvm.ErrInsufficentPermissions {
+			t.Errorf("expected insufficient permissions error, got: %v", err)
+		}
+	})
+
+	t.Run("denied workspace 1 read", func(t *testing.T) {
+		err := machine.Verify(context.Background(), token, queries.EntityScope{
+			EntityType: queries.EntityTypeWorkspace,
+			EntityID:   1,
+			Scope:      queries.ScopeRead,
+		})
+		if err != tvm.ErrInsufficentPermissions {
+			t.Errorf("expected insufficient permissions error, got: %v", err)
+		}
+	})
+
+	t.Run("denied environment 2 read", func(t *testing.T) {
+		err := machine.Verify(context.Background(), token, queries.EntityScope{
+			EntityType: queries.EntityTypeEnvironment,
+			EntityID:   2,
+			Scope:      queries.ScopeRead,
+		})
+		if err != tvm.ErrEntityNotFound {
+			t.Errorf("expected entity not found error, got: %v", err)
+		}
+	})
+}
+
+// cached tokens keep working until they are revoked through the machine
+func TestTokenCache(t *testing.T) {
+	tq := &TestingQueries{tokens: make(map[string]queries.Token)}
+	machine := tvm.NewVendingMachine(nil, tq, tvm.Config{
+		MaxTokenDuration:   24 * time.Hour,
+		LoginTokenDuration: 15 * time.Minute,
+		CacheTTL:           time.Minute,
+	})
+	_, token, err := machine.Exchange(t.Context(), TestingGithubProvider(t.Context(), "github-token-user2"))
+	if err != nil {
+		t.Fatalf("unexpected error during exchange: %v", err)
+	}
+
+	resourceRead := queries.EntityScope{
+		EntityType: queries.EntityTypeResource,
+		EntityID:   1,
+		Scope:      queries.ScopeRead,
+	}
+
+	if err := machine.Verify(context.Background(), token, resourceRead); err != nil {
+		t.Fatalf("expected no error for resource 1 read, got: %v", err)
+	}
+
+	t.Run("served from cache", func(t *testing.T) {
+		stored := tq.tokens[token]
+		delete(tq.tokens, token)
+		defer func() { tq.tokens[token] = stored }()
+
+		if err := machine.Verify(context.Background(), token, resourceRead); err != nil {
+			t.Errorf("expected cached token to verify, got: %v", err)
+		}
+	})
+
+	t.Run("flush drops cached tokens", func(t *testing.T) {
+		stored := tq.tokens[token]
+		delete(tq.tokens, token)
+		defer func() { tq.tokens[token] = stored }()
+
+		machine.FlushTokenCache()
+		if err := machine.Verify(context.Background(), token, resourceRead); err != tvm.ErrTokenNotFound {
+			t.Errorf("expected token not found error after a flush, got: %v", err)
+		}
+	})
+
+	t.Run("revoke invalidates", func(t *testing.T) {
+		if err := machine.Revoke(context.Background(), token); err != nil {
+			t.Fatalf("unexpected error during revoke: %v", err)
+		}
+		if err := machine.Verify(context.Background(), token, resourceRead); err != tvm.ErrTokenNotFound {
+			t.Errorf("expected token not found error, got: %v", err)
+		}
+	})
+}
+
+// user 6 has environment 1 r, w
+func TestVerifyAccessBatch(t *testing.T) {
+	machine := tvm.NewVendingMachine(nil, &TestingQueries{tokens: make(map[string]queries.Token)}, tvm.Config{
+		MaxTokenDuration:   24 * time.Hour,
+		LoginTokenDuration: 15 * time.Minute,
+	})
+	_, token, err := machine.Exchange(t.Context(), TestingGithubProvider(t.Context(), "github-token-user6"))
+	if err != nil {
+		t.Fatalf("unexpected error during exchange: %v", err)
+	}
+	_, scopes, err := machine.GetToken(t.Context(), token)
+	if err != nil {
+		t.Fatalf("unexpected error getting token: %v", err)
+	}
+
+	entityScopes := []queries.EntityScope{
+		{EntityType: queries.EntityTypeEnvironment, EntityID: 1, Scope: queries.ScopeRead}, // direct
+		{EntityType: queries.EntityTypeResource, EntityID: 4, Scope: queries.ScopeWrite},   // via environment 1
+		{EntityType: queries.EntityTypeResource, EntityID: 1, Scope: queries.ScopeRead},    // ws 1, not in environment 1
+		{EntityType: queries.EntityTypeResource, EntityID: 4, Scope: queries.ScopeAdmin},   // scope not held
+		{EntityType: queries.EntityTypeResource, EntityID: 99, Scope: queries.ScopeRead},   // does not exist
+		{EntityType: queries.EntityTypeOrganization, EntityID: 1, Scope: queries.ScopeRead},
+	}
+	want := []bool{true, true, false, false, false, false}
+
+	allowed, err := machine.VerifyAccessBatch(t.Context(), scopes, entityScopes)
+	if err != nil {
+		t.Fatalf("unexpected error during batch verify: %v", err)
+	}
+	if len(allowed) != len(want) {
+		t.Fatalf("expected %d results, got %d", len(want), len(allowed))
+	}
+	for i := range want {
+		if allowed[i] != want[i] {
+			t.Errorf("entity scope %v: expected allowed=%v, got %v", entityScopes[i], want[i], allowed[i])
+		}
+	}
+}
+
+func TestActionScopes(t *testing.T) {
+	machine := tvm.NewVendingMachine(nil, &TestingQueries{tokens: make(map[string]queries.Token)}, tvm.Config{
+		MaxTokenDuration:   24 * time.Hour,
+		LoginTokenDuration: 15 * time.Minute,
+	})
+
+	logsOnly := []queries.EntityScope{{EntityType: queries.EntityTypeResource, EntityID: 1, Scope: queries.ScopeLogsRead}}
+	deployOnly := []queries.EntityScope{{EntityType: queries.EntityTypeWorkspace, EntityID: 1, Scope: queries.ScopeDeployCreate}}
+	workspaceRead := []queries.EntityScope{{EntityType: queries.EntityTypeWorkspace, EntityID: 1, Scope: queries.ScopeRead}}
+	workspaceWrite := []queries.EntityScope{{EntityType: queries.EntityTypeWorkspace, EntityID: 1, Scope: queries.ScopeWrite}}
+
+	tests := []struct {
+		name    string
+		scopes  []queries.EntityScope
+		action  queries.EntityScope
+		allowed bool
+	}{
+		{"logs:read streams logs", logsOnly, actions.New(actions.StreamResourceLogs, 1), true},
+		{"logs:read does not read the resource", logsOnly, actions.New(actions.GetResource, 1), false},
+		{"logs:read does not change env", logsOnly, actions.New(actions.UpdateResourceEnv, 1), false},
+		{"deploy:create on the workspace deploys", deployOnly, actions.New(actions.CreateDeployment, 1), true},
+		{"deploy:create does not change env", deployOnly, actions.New(actions.UpdateResourceEnv, 1), false},
+		{"deploy:create does not manage domains", deployOnly, actions.New(actions.AddDomain, 1), false},
+		{"read implies logs:read", workspaceRead, actions.New(actions.StreamResourceLogs, 1), true},
+		{"read does not deploy", workspaceRead, actions.New(actions.CreateDeployment, 1), false},
+		{"write implies deploy:create", workspaceWrite, actions.New(actions.CreateDeployment, 1), true},
+		{"write implies env:write", workspaceWrite, actions.New(actions.UpdateResourceEnv, 1), true},
+		{"write implies domain:manage", workspaceWrite, actions.New(actions.AddDomain, 1), true},
+		{"write does not reach another workspace", workspaceWrite, actions.New(actions.CreateDeployment, 2), false},
+	}
+	for _, tt := range tests {
+		err := machine.VerifyWithGivenEntityScopes(t.Context(), tt.scopes, tt.action)
+		if (err == nil) != tt.allowed {
+			t.Errorf("%s: expected allowed=%v, got error %v", tt.name, tt.allowed, err)
+		}
+	}
+}
+
+// user 4 has ws 1 r
+func TestMint(t *testing.T) {
+	machine := tvm.NewVendingMachine(nil, &TestingQueries{tokens: make(map[string]queries.Token)}, tvm.Config{
+		MaxTokenDuration:   24 * time.Hour,
+		LoginTokenDuration: 15 * time.Minute,
+		CacheTTL:           time.Minute,
+	})
+	_, token, err := machine.Exchange(t.Context(), TestingGithubProvider(t.Context(), "github-token-user4"))
+	if err != nil {
+		t.Fatalf("unexpected error during exchange: %v", err)
+	}
+
+	resource1Read := queries.EntityScope{
+		EntityType: queries.EntityTypeResource,
+		EntityID:   1,
+		Scope:      queries.ScopeRead,
+	}
+
+	t.Run("denied scope the parent lacks", func(t *testing.T) {
+		_, err := machine.Mint(context.Background(), "child", token, []queries.EntityScope{{
+			EntityType: queries.EntityTypeResource,
+			EntityID:   1,
+			Scope:      queries.ScopeWrite,
+		}}, time.Minute)
+		if err != tvm.ErrInsufficentPermissions {
+			t.Errorf("expected insufficient permissions error, got: %v", err)
+		}
+	})
+
+	t.Run("denied outliving the parent", func(t *testing.T) {
+		_, err := machine.Mint(context.Background(), "child", token, []queries.EntityScope{resource1Read}, time.Hour)
+		if err != tvm.ErrDurationExceedsParent {
+			t.Errorf("expected duration exceeds parent error, got: %v", err)
+		}
+	})
+
+	child, err := machine.Mint(context.Background(), "child", token, []queries.EntityScope{resource1Read}, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error during mint: %v", err)
+	}
+
+	t.Run("granted minted scope", func(t *testing.T) {
+		if err := machine.Verify(context.Background(), child, resource1Read); err != nil {
+			t.Errorf("expected no error for resource 1 read, got: %v", err)
+		}
+	})
+
+	t.Run("denied parent scope not minted", func(t *testing.T) {
+		err := machine.Verify(context.Background(), child, queries.EntityScope{
+			EntityType: queries.EntityTypeResource,
+			EntityID:   2,
+			Scope:      queries.ScopeRead,
+		})
+		if err != tvm.ErrInsufficentPermissions {
+			t.Errorf("expected insufficient permissions error, got: %v", err)
+		}
+	})
+
+	t.Run("revoking parent revokes child", func(t *testing.T) {
+		if err := machine.Revoke(context.Background(), token); err != nil {
+			t.Fatalf("unexpected error during revoke: %v", err)
+		}
+		if err := machine.Verify(context.Background(), child, resource1Read); err != tvm.ErrTokenNotFound {
+			t.Errorf("expected token not found error, got: %v", err)
+		}
+	})
+}
+
+// user 1 impersonates user 4, who has ws 1 r
+func TestImpersonate(t *testing.T) {
+	machine := tvm.NewVendingMachine(nil, &TestingQueries{tokens: make(map[string]queries.Token)}, tvm.Config{
+		MaxTokenDuration:   24 * time.Hour,
+		LoginTokenDuration: 15 * time.Minute,
+		CacheTTL:           time.Minute,
+	})
+
+	token, expiresAt, err := machine.Impersonate(t.Context(), 1, 4, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error during impersonate: %v", err)
+	}
+	if time.Until(expiresAt) > 15*time.Minute {
+		t.Errorf("expected token to last at most the login token duration, expires at %v", expiresAt)
+	}
+
+	workspace1Read := queries.EntityScope{
+		EntityType: queries.EntityTypeWorkspace,
+		EntityID:   1,
+		Scope:      queries.ScopeRead,
+	}
+
+	t.Run("claims name the impersonator", func(t *testing.T) {
+		claims, err := machine.GetClaims(t.Context(), token)
+		if err != nil {
+			t.Fatalf("unexpected error getting claims: %v", err)
+		}
+		if claims.Entity != (queries.Entity{Type: queries.EntityTypeUser, ID: 4}) {
+			t.Errorf("expected user 4, got: %v", claims.Entity)
+		}
+		if claims.ImpersonatorID != 1 {
+			t.Errorf("expected impersonator 1, got: %d", claims.ImpersonatorID)
+		}
+	})
+
+	t.Run("granted the user's scopes", func(t *testing.T) {
+		if err := machine.Verify(t.Context(), token, workspace1Read); err != nil {
+			t.Errorf("expected no error for workspace 1 read, got: %v", err)
+		}
+	})
+
+	t.Run("minted children are impersonations", func(t *testing.T) {
+		child, err := machine.Mint(t.Context(), "child", token, []queries.EntityScope{workspace1Read}, time.Minute)
+		if err != nil {
+			t.Fatalf("unexpected error during mint: %v", err)
+		}
+		claims, err := machine.GetClaims(t.Context(), child)
+		if err != nil {
+			t.Fatalf("unexpected error getting claims: %v", err)
+		}
+		if claims.ImpersonatorID != 1 {
+			t.Errorf("expected impersonator 1, got: %d", claims.ImpersonatorID)
+		}
+	})
+
+	t.Run("denied issuing tokens", func(t *testing.T) {
+		_, err := machine.IssueWithLoginToken(t.Context(), "issued", token, queries.Entity{Type: queries.EntityTypeUser, ID: 4}, []queries.EntityScope{workspace1Read}, time.Minute)
+		if err != tvm.ErrImpersonationToken {
+			t.Errorf("expected impersonation token error, got: %v", err)
+		}
+	})
+}
+
+// service account 1 has ws 1 r
+func TestServiceAccountTokens(t *testing.T) {
+	machine := tvm.NewVendingMachine(nil, &TestingQueries{tokens: make(map[string]queries.Token)}, tvm.Config{
+		MaxTokenDuration:   24 * time.Hour,
+		LoginTokenDuration: 15 * time.Minute,
+	})
+
+	resource1Read := queries.EntityScope{
+		EntityType: queries.EntityTypeResource,
+		EntityID:   1,
+		Scope:      queries.ScopeRead,
+	}
+
+	t.Run("denied scope the account lacks", func(t *testing.T) {
+		_, err := machine.IssueForServiceAccount(context.Background(), "ci", 1, []queries.EntityScope{{
+			EntityType: queries.EntityTypeResource,
+			EntityID:   3,
+			Scope:      queries.ScopeRead,
+		}}, time.Hour)
+		if err != tvm.ErrInsufficentPermissions {
+			t.Errorf("expected insufficient permissions error, got: %v", err)
+		}
+	})
+
+	token, err := machine.IssueForServiceAccount(context.Background(), "ci", 1, []queries.EntityScope{resource1Read}, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error during issue: %v", err)
+	}
+
+	t.Run("token is for the service account", func(t *testing.T) {
+		entity, err := machine.VerifyWithEntity(context.Background(), token, resource1Read)
+		if err != nil {
+			t.Fatalf("expected no error for resource 1 read, got: %v", err)
+		}
+		if entity.Type != queries.EntityTypeServiceAccount || entity.ID != 1 {
+			t.Errorf("expected service account 1, got: %v", entity)
+		}
+	})
+
+	t.Run("granted account read via ws 1", func(t *testing.T) {
+		err := machine.VerifyWithGivenEntityScopes(context.Background(), []queries.EntityScope{
+			{EntityType: queries.EntityTypeWorkspace, EntityID: 1, Scope: queries.ScopeRead},
+		}, queries.EntityScope{
+			EntityType: queries.EntityTypeServiceAccount,
+			EntityID:   1,
+			Scope:      queries.ScopeRead,
+		})
+		if err != nil {
+			t.Errorf("expected no error for service account 1 read via ws 1, got: %v", err)
+		}
+	})
+}
+
+// user 3 has org 1 r, w, and org 1 requires MFA
+func TestMFA(t *testing.T) {
+	sealer, err := totp.NewSealer("MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=")
+	if err != nil {
+		t.Fatalf("unexpected error creating sealer: %v", err)
+	}
+	tq := &TestingQueries{
+		tokens:     make(map[string]queries.Token),
+		totp:       make(map[int64]queries.UserTotp),
+		requireMFA: map[int64]bool{3: true},
+	}
+	machine := tvm.NewVendingMachine(nil, tq, tvm.Config{
+		MaxTokenDuration:   24 * time.Hour,
+		LoginTokenDuration: 15 * time.Minute,
+		MFASealer:          sealer,
+	})
+
+	org1Read := queries.EntityScope{
+		EntityType: queries.EntityTypeOrganization,
+		EntityID:   1,
+		Scope:      queries.ScopeRead,
+	}
+
+	_, pending, err := machine.Exchange(t.Context(), TestingGithubProvider(t.Context(), "github-token-user3"))
+	if err != tvm.ErrMFARequired {
+		t.Fatalf("expected mfa required error, got: %v", err)
+	}
+
+	t.Run("pending login is limited to the user", func(t *testing.T) {
+		claims, err := machine.GetClaims(t.Context(), pending)
+		if err != nil {
+			t.Fatalf("unexpected error getting claims: %v", err)
+		}
+		if !claims.MFAPending {
+			t.Errorf("expected the token to be waiting on mfa")
+		}
+		if err := machine.Verify(t.Context(), pending, org1Read); err != tvm.ErrInsufficentPermissions {
+			t.Errorf("expected insufficient permissions error, got: %v", err)
+		}
+	})
+
+	t.Run("pending login cannot issue tokens", func(t *testing.T) {
+		_, err := machine.IssueWithLoginToken(t.Context(), "issued", pending, queries.Entity{Type: queries.EntityTypeUser, ID: 3}, []queries.EntityScope{org1Read}, time.Minute)
+		if err != tvm.ErrMFAPendingToken {
+			t.Errorf("expected mfa pending token error, got: %v", err)
+		}
+	})
+
+	t.Run("verify needs enrollment", func(t *testing.T) {
+		if _, err := machine.VerifyMFA(t.Context(), pending, "000000"); err != tvm.ErrMFANotEnrolled {
+			t.Errorf("expected mfa not enrolled error, got: %v", err)
+		}
+	})
+
+	secret, _, err := machine.EnrollTOTP(t.Context(), 3, "user3@loco-testing.com")
+	if err != nil {
+		t.Fatalf("unexpected error enrolling: %v", err)
+	}
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatalf("unexpected error generating code: %v", err)
+	}
+
+	t.Run("confirming enrollment completes the login", func(t *testing.T) {
+		token, err := machine.ConfirmTOTP(t.Context(), pending, code)
+		if err != nil {
+			t.Fatalf("unexpected error confirming: %v", err)
+		}
+		if err := machine.Verify(t.Context(), token, org1Read); err != nil {
+			t.Errorf("expected no error for org 1 read, got: %v", err)
+		}
+		if _, err := machine.GetClaims(t.Context(), pending); err == nil {
+			t.Errorf("expected the pending token to be revoked")
+		}
+	})
+
+	t.Run("codes cannot be reused", func(t *testing.T) {
+		_, next, err := machine.Exchange(t.Context(), TestingGithubProvider(t.Context(), "github-token-user3"))
+		if err != tvm.ErrMFARequired {
+			t.Fatalf("expected mfa required error, got: %v", err)
+		}
+		if _, err := machine.VerifyMFA(t.Context(), next, code); err != tvm.ErrInvalidMFACode {
+			t.Errorf("expected invalid code error, got: %v", err)
+		}
+	})
+
+	t.Run("denied disabling while required", func(t *testing.T) {
+		if err := machine.DisableTOTP(t.Context(), 3, code); err != tvm.ErrMFARequiredByOrg {
+			t.Errorf("expected mfa required by org error, got: %v", err)
+		}
+	})
+
+	t.Run("users without mfa log in directly", func(t *testing.T) {
+		_, token, err := machine.Exchange(t.Context(), TestingGithubProvider(t.Context(), "github-token-user1"))
+		if err != nil {
+			t.Fatalf("unexpected error during exchange: %v", err)
+		}
+		claims, err := machine.GetClaims(t.Context(), token)
+		if err != nil {
+			t.Fatalf("unexpected error getting claims: %v", err)
+		}
+		if claims.MFAPending {
+			t.Errorf("expected a full login token")
+		}
+	})
+}
+
+func TestRefresh(t *testing.T) {
+	tq := &TestingQueries{
+		tokens:     make(map[string]queries.Token),
+		requireMFA: make(map[int64]bool),
+		refresh:    make(map[string]queries.RefreshToken),
+	}
+	machine := tvm.NewVendingMachine(nil, tq, tvm.Config{
+		MaxTokenDuration:     24 * time.Hour,
+		LoginTokenDuration:   15 * time.Minute,
+		RefreshTokenDuration: 24 * time.Hour,
+	})
+
+	org1Read := queries.EntityScope{
+		EntityType: queries.EntityTypeOrganization,
+		EntityID:   1,
+		Scope:      queries.ScopeRead,
+	}
+
+	login := func(t *testing.T) (string, string) {
+		_, token, err := machine.Exchange(t.Context(), TestingGithubProvider(t.Context(), "github-token-user2"))
+		if err != nil {
+			t.Fatalf("unexpected error during exchange: %v", err)
+		}
+		refresh, err := machine.IssueRefreshToken(t.Context(), token, false)
+		if err != nil {
+			t.Fatalf("unexpected error issuing refresh token: %v", err)
+		}
+		return token, refresh
+	}
+
+	t.Run("refresh rotates the refresh token", func(t *testing.T) {
+		_, refresh := login(t)
+		token, next, err := machine.Refresh(t.Context(), refresh)
+		if err != nil {
+			t.Fatalf("unexpected error refreshing: %v", err)
+		}
+		if next == refresh {
+			t.Errorf("expected a new refresh token")
+		}
+		if err := machine.Verify(t.Context(), token, org1Read); err != nil {
+			t.Errorf("expected no error for org 1 read, got: %v", err)
+		}
+		if _, _, err := machine.Refresh(t.Context(), next); err != nil {
+			t.Errorf("unexpected error refreshing again: %v", err)
+		}
+	})
+
+	t.Run("reuse revokes the session", func(t *testing.T) {
+		first, refresh := login(t)
+		second, next, err := machine.Refresh(t.Context(), refresh)
+		if err != nil {
+			t.Fatalf("unexpected error refreshing: %v", err)
+		}
+		if _, _, err := machine.Refresh(t.Context(), refresh); err != tvm.ErrRefreshTokenReused {
+			t.Fatalf("expected refresh token reused error, got: %v", err)
+		}
+		for _, token := range []string{first, second} {
+			if _, err := machine.GetClaims(t.Context(), token); err == nil {
+				t.Errorf("expected the session's login tokens to be revoked")
+			}
+		}
+		if _, _, err := machine.Refresh(t.Context(), next); err != tvm.ErrInvalidRefreshToken {
+			t.Errorf("expected invalid refresh token error, got: %v", err)
+		}
+	})
+
+	t.Run("ending the session revokes its refresh tokens", func(t *testing.T) {
+		token, refresh := login(t)
+		if err := machine.EndSession(t.Context(), token); err != nil {
+			t.Fatalf("unexpected error ending session: %v", err)
+		}
+		if _, _, err := machine.Refresh(t.Context(), refresh); err != tvm.ErrInvalidRefreshToken {
+			t.Errorf("expected invalid refresh token error, got: %v", err)
+		}
+	})
+
+	t.Run("a session that never passed mfa is revoked once it is required", func(t *testing.T) {
+		first, refresh := login(t)
+		second, refresh, err := machine.Refresh(t.Context(), refresh)
+		if err != nil {
+			t.Fatalf("unexpected error refreshing: %v", err)
+		}
+
+		tq.requireMFA[2] = true
+		t.Cleanup(func() { delete(tq.requireMFA, 2) })
+		if _, _, err := machine.Refresh(t.Context(), refresh); err != tvm.ErrSessionMFARequired {
+			t.Fatalf("expected session mfa required error, got: %v", err)
+		}
+		for _, token := range []string{first, second} {
+			if _, err := machine.GetClaims(t.Context(), token); err == nil {
+				t.Errorf("expected the session's login tokens to be revoked")
+			}
+		}
+		if _, _, err := machine.Refresh(t.Context(), refresh); err != tvm.ErrInvalidRefreshToken {
+			t.Errorf("expected invalid refresh token error, got: %v", err)
+		}
+	})
+
+	t.Run("a session that passed mfa keeps refreshing", func(t *testing.T) {
+		_, token, err := machine.Exchange(t.Context(), TestingGithubProvider(t.Context(), "github-token-user2"))
+		if err != nil {
+			t.Fatalf("unexpected error during exchange: %v", err)
+		}
+		refresh, err := machine.IssueRefreshToken(t.Context(), token, true)
+		if err != nil {
+			t.Fatalf("unexpected error issuing refresh token: %v", err)
+		}
+
+		tq.requireMFA[2] = true
+		t.Cleanup(func() { delete(tq.requireMFA, 2) })
+		for range 2 {
+			if _, refresh, err = machine.Refresh(t.Context(), refresh); err != nil {
+				t.Fatalf("unexpected error refreshing: %v", err)
+			}
+		}
+	})
+
+	t.Run("unknown refresh token", func(t *testing.T) {
+		if _, _, err := machine.Refresh(t.Context(), "not-a-refresh-token"); err != tvm.ErrInvalidRefreshToken {
+			t.Errorf("expected invalid refresh token error, got: %v", err)
+		}
+	})
+}
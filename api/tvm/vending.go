@@ -5,27 +5,40 @@ import (
 	"log/slog"
 	"time"
 
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/team-loco/loco/api/db"
 	queries "github.com/team-loco/loco/api/gen/db"
+	"github.com/team-loco/loco/api/pkg/totp"
 )
 
 type VendingMachine struct {
-	pool       *pgxpool.Pool
+	pool       db.Beginner
 	queries    queries.Querier
 	Cfg        Config
+	cache      *cache
 	cancelFunc context.CancelFunc
 }
 
 type Config struct {
 	MaxTokenDuration   time.Duration
 	LoginTokenDuration time.Duration
+	// RefreshTokenDuration is how long a session lasts without being refreshed; every refresh extends it by as
+	// much again. Zero disables refresh tokens.
+	RefreshTokenDuration time.Duration
+	// CacheTTL is how long tokens and entity hierarchy lookups are kept in memory. Zero disables caching.
+	CacheTTL time.Duration
+	// PlatformAdminEmails are made platform admins when they log in, so a new installation has someone who can
+	// grant it to others.
+	PlatformAdminEmails []string
+	// MFASealer encrypts TOTP secrets. MFA cannot be enrolled in without it.
+	MFASealer *totp.Sealer
 }
 
 // NewVendingMachine creates a new VendingMachine with the given database pool, queries, and configuration.
 // starts a background goroutine to periodically clean up expired tokens (in-process cron)
 // Close() to stop the background cleanup goroutine and release resources.
-func NewVendingMachine(pool *pgxpool.Pool, q queries.Querier, cfg Config) *VendingMachine {
+func NewVendingMachine(pool db.Beginner, q queries.Querier, cfg Config) *VendingMachine {
 	ctx, cancel := context.WithCancel(context.Background())
+	c := newCache(cfg.CacheTTL)
 
 	// low cost pg cron
 	go func() {
@@ -40,6 +53,10 @@ func NewVendingMachine(pool *pgxpool.Pool, q queries.Querier, cfg Config) *Vendi
 				if err := q.DeleteExpiredTokens(ctx); err != nil {
 					slog.ErrorContext(ctx, err.Error())
 				}
+				if err := q.DeleteExpiredRefreshTokens(ctx); err != nil {
+					slog.ErrorContext(ctx, err.Error())
+				}
+				c.sweep()
 			}
 		}
 	}()
@@ -48,6 +65,7 @@ func NewVendingMachine(pool *pgxpool.Pool, q queries.Querier, cfg Config) *Vendi
 		pool:       pool,
 		queries:    q,
 		Cfg:        cfg,
+		cache:      c,
 		cancelFunc: cancel,
 	}
 }
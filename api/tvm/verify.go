@@ -13,77 +13,206 @@ import (
 // occurs, if the entity does not exist [ErrEntityNotFound], or if the token does not have sufficient permissions [ErrInsufficentPermissions].
 func (tvm *VendingMachine) VerifyWithGivenEntityScopes(ctx context.Context, givenEntityScopes []queries.EntityScope, entityScope queries.EntityScope) error {
 	// hot path: check if token has the entityScope required or has sys:scope
-	for _, scope := range givenEntityScopes {
-		if scope == entityScope { // the token directly has the scope needed
+	if hasScope(givenEntityScopes, entityScope) {
+		return nil
+	}
+
+	// not so hot path: if the token has an entityScope that is *implied*
+	parents, err := tvm.parents(ctx, queries.Entity{Type: entityScope.EntityType, ID: entityScope.EntityID})
+	if err != nil {
+		return err
+	}
+	otherEntityScopes := make([]queries.EntityScope, 0, len(parents))
+	for _, parent := range parents {
+		otherEntityScopes = append(otherEntityScopes, queries.EntityScope{
+			EntityType: parent.Type,
+			EntityID:   parent.ID,
+			Scope:      entityScope.Scope,
+		})
+	}
+
+	// check otherentityscopes. note: someone see if this can be optimized
+	for _, oes := range otherEntityScopes {
+		// if token has any of the implied scopes, allow
+		if slices.ContainsFunc(givenEntityScopes, func(given queries.EntityScope) bool { return grants(given, oes) }) {
 			return nil
 		}
+	}
+
+	return ErrInsufficentPermissions
+}
+
+// VerifyAccessBatch checks many entityScopes against givenEntityScopes at once, for example to filter a list to what the caller
+// can see. allowed[i] reports whether entityScopes[i] is granted. Parents that are not cached are resolved in a single query, and
+// entities that do not exist are denied rather than failing the batch.
+func (tvm *VendingMachine) VerifyAccessBatch(ctx context.Context, givenEntityScopes []queries.EntityScope, entityScopes []queries.EntityScope) ([]bool, error) {
+	allowed := make([]bool, len(entityScopes))
+
+	// hot path first, then collect the entities whose parents still have to be looked up
+	var params queries.ListEntityParentsParams
+	parents := map[queries.Entity][]queries.Entity{}
+	for i, entityScope := range entityScopes {
+		if hasScope(givenEntityScopes, entityScope) {
+			allowed[i] = true
+			continue
+		}
+		entity := queries.Entity{Type: entityScope.EntityType, ID: entityScope.EntityID}
+		if _, seen := parents[entity]; seen {
+			continue
+		}
+		if cached, ok := tvm.cache.getParents(entity); ok {
+			parents[entity] = cached
+			continue
+		}
+		parents[entity] = nil
+		switch entity.Type {
+		case queries.EntityTypeWorkspace:
+			params.WorkspaceIds = append(params.WorkspaceIds, entity.ID)
+		case queries.EntityTypeEnvironment:
+			params.EnvironmentIds = append(params.EnvironmentIds, entity.ID)
+		case queries.EntityTypeResource:
+			params.ResourceIds = append(params.ResourceIds, entity.ID)
+		case queries.EntityTypeServiceAccount:
+			params.ServiceAccountIds = append(params.ServiceAccountIds, entity.ID)
+		}
+	}
+
+	if len(params.WorkspaceIds)+len(params.EnvironmentIds)+len(params.ResourceIds)+len(params.ServiceAccountIds) > 0 {
+		rows, err := tvm.queries.ListEntityParents(ctx, params)
+		if err != nil {
+			slog.ErrorContext(ctx, err.Error())
+			return nil, err
+		}
+		for _, row := range rows {
+			entity := queries.Entity{Type: row.EntityType, ID: row.EntityID}
+			entityParents := []queries.Entity{{Type: queries.EntityTypeOrganization, ID: row.OrgID}}
+			if row.WorkspaceID.Valid {
+				entityParents = append(entityParents, queries.Entity{Type: queries.EntityTypeWorkspace, ID: row.WorkspaceID.Int64})
+			}
+			if row.EnvironmentID.Valid {
+				entityParents = append(entityParents, queries.Entity{Type: queries.EntityTypeEnvironment, ID: row.EnvironmentID.Int64})
+			}
+			parents[entity] = entityParents
+			tvm.cache.putParents(entity, entityParents)
+		}
+	}
+
+	for i, entityScope := range entityScopes {
+		if allowed[i] {
+			continue
+		}
+		for _, parent := range parents[queries.Entity{Type: entityScope.EntityType, ID: entityScope.EntityID}] {
+			implied := queries.EntityScope{EntityType: parent.Type, EntityID: parent.ID, Scope: entityScope.Scope}
+			if slices.ContainsFunc(givenEntityScopes, func(given queries.EntityScope) bool { return grants(given, implied) }) {
+				allowed[i] = true
+				break
+			}
+		}
+	}
+
+	return allowed, nil
+}
+
+// hasScope reports whether givenEntityScopes grant entityScope directly or through a sys scope
+func hasScope(givenEntityScopes []queries.EntityScope, entityScope queries.EntityScope) bool {
+	for _, scope := range givenEntityScopes {
+		if grants(scope, entityScope) { // the token directly has the scope needed
+			return true
+		}
 		// for example: if operation requires workspace:write and user has sys:write
 		// it should allow the operation. this function still does not allow access
 		// for someone with something like sys:read to workspace:write.
-		if scope.EntityType == queries.EntityTypeSystem && scope.Scope == entityScope.Scope {
-			return nil
+		if scope.EntityType == queries.EntityTypeSystem && grantsScope(scope.Scope, entityScope.Scope) {
+			return true
 		}
 	}
+	return false
+}
 
-	// not so hot path: if the token has an entityScope that is *implied*
-	var otherEntityScopes []queries.EntityScope
-	switch entityScope.EntityType {
+// grants reports whether given grants required on the same entity, either exactly or, for an action-level
+// scope such as logs:read, through the coarse scope implying it.
+func grants(given queries.EntityScope, required queries.EntityScope) bool {
+	return given.EntityType == required.EntityType && given.EntityID == required.EntityID && grantsScope(given.Scope, required.Scope)
+}
+
+func grantsScope(given queries.Scope, required queries.Scope) bool {
+	return given == required || given == queries.CoarseScope(required)
+}
+
+// parents returns the entities above the given entity whose scopes imply scopes on it, e.g. the workspace and org of a resource.
+// Lookups are cached, as an entity never moves to another parent.
+func (tvm *VendingMachine) parents(ctx context.Context, entity queries.Entity) ([]queries.Entity, error) {
+	if parents, ok := tvm.cache.getParents(entity); ok {
+		return parents, nil
+	}
+
+	var parents []queries.Entity
+	switch entity.Type {
 	case queries.EntityTypeOrganization, queries.EntityTypeUser:
-		return ErrInsufficentPermissions // there is nothing higher to check.
+		return nil, ErrInsufficentPermissions // there is nothing higher to check.
 	case queries.EntityTypeWorkspace:
 		// lookup the org id for the workspace
-		org_id, err := tvm.queries.GetOrganizationIDByWorkspaceID(ctx, entityScope.EntityID)
+		org_id, err := tvm.queries.GetOrganizationIDByWorkspaceID(ctx, entity.ID)
 		if err != nil {
 			slog.ErrorContext(ctx, err.Error())
 			// note: this could be another error
-			return ErrEntityNotFound
+			return nil, ErrEntityNotFound
 		}
 
 		// check for org:scope
-		otherEntityScopes = []queries.EntityScope{
-			{
-				EntityType: queries.EntityTypeOrganization,
-				EntityID:   org_id,
-				Scope:      entityScope.Scope,
-			},
+		parents = []queries.Entity{
+			{Type: queries.EntityTypeOrganization, ID: org_id},
+		}
+	case queries.EntityTypeEnvironment:
+		// lookup the workspace and org id for the environment
+		ids, err := tvm.queries.GetEnvironmentWorkspaceOrganizationID(ctx, entity.ID)
+		if err != nil {
+			slog.ErrorContext(ctx, err.Error())
+			return nil, ErrEntityNotFound
+		}
+
+		// check for org:scope and workspace:scope
+		parents = []queries.Entity{
+			{Type: queries.EntityTypeOrganization, ID: ids.OrgID},
+			{Type: queries.EntityTypeWorkspace, ID: ids.WorkspaceID},
 		}
 	case queries.EntityTypeResource:
 		// lookup the workspace and org id for the resource
-		ids, err := tvm.queries.GetWorkspaceOrganizationIDByResourceID(ctx, entityScope.EntityID)
+		ids, err := tvm.queries.GetWorkspaceOrganizationIDByResourceID(ctx, entity.ID)
 		if err != nil {
 			slog.ErrorContext(ctx, err.Error())
 			// note: again this could be another eror
-			return ErrEntityNotFound
+			return nil, ErrEntityNotFound
 		}
-		wks_id := ids.WorkspaceID
-		org_id := ids.OrgID
 
 		// check for org:scope and workspace:scope
-		otherEntityScopes = []queries.EntityScope{
-			{
-				EntityType: queries.EntityTypeOrganization,
-				EntityID:   org_id,
-				Scope:      entityScope.Scope,
-			},
-			{
-				EntityType: queries.EntityTypeWorkspace,
-				EntityID:   wks_id,
-				Scope:      entityScope.Scope,
-			},
+		parents = []queries.Entity{
+			{Type: queries.EntityTypeOrganization, ID: ids.OrgID},
+			{Type: queries.EntityTypeWorkspace, ID: ids.WorkspaceID},
+		}
+		// and environment:scope if the resource belongs to one
+		if ids.EnvironmentID.Valid {
+			parents = append(parents, queries.Entity{Type: queries.EntityTypeEnvironment, ID: ids.EnvironmentID.Int64})
+		}
+	case queries.EntityTypeServiceAccount:
+		// lookup the owning workspace and its org
+		ids, err := tvm.queries.GetServiceAccountWorkspaceOrganizationID(ctx, entity.ID)
+		if err != nil {
+			slog.ErrorContext(ctx, err.Error())
+			return nil, ErrEntityNotFound
 		}
-	default:
-		return ErrEntityNotFound // unknown entity type
-	}
 
-	// check otherentityscopes. note: someone see if this can be optimized
-	for _, oes := range otherEntityScopes {
-		// if token has any of the implied scopes, allow
-		if slices.Contains(givenEntityScopes, oes) {
-			return nil
+		// check for org:scope and workspace:scope
+		parents = []queries.Entity{
+			{Type: queries.EntityTypeOrganization, ID: ids.OrgID},
+			{Type: queries.EntityTypeWorkspace, ID: ids.WorkspaceID},
 		}
+	default:
+		return nil, ErrEntityNotFound // unknown entity type
 	}
 
-	return ErrInsufficentPermissions
+	tvm.cache.putParents(entity, parents)
+	return parents, nil
 }
 
 // Verify verifies that the given token has the entityScope required, either explicitly or implicitly. It returns an error if an error
@@ -91,7 +220,7 @@ func (tvm *VendingMachine) VerifyWithGivenEntityScopes(ctx context.Context, give
 // It also returns the entity associated with the token, for example, log in tokens will return the user entity, and tokens issues for an
 // Resource will return the resource entity.
 func (tvm *VendingMachine) VerifyWithEntity(ctx context.Context, token string, entityScope queries.EntityScope) (queries.Entity, error) {
-	tokenData, err := tvm.queries.GetToken(ctx, token)
+	tokenData, err := tvm.lookupToken(ctx, token)
 	if err != nil {
 		return queries.Entity{}, ErrTokenNotFound
 	}
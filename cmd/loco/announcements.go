@@ -0,0 +1,85 @@
+package loco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+	"github.com/team-loco/loco/internal/client"
+	"github.com/team-loco/loco/internal/ui"
+	announcementv1 "github.com/team-loco/loco/shared/proto/announcement/v1"
+)
+
+const (
+	maxAnnouncementsShown = 3
+	announcementsTimeout  = 2 * time.Second
+)
+
+// showUnreadAnnouncements prints unread platform announcements after a command and
+// marks them read. It is best effort: failures are logged and never surface to the user.
+func showUnreadAnnouncements(cmd *cobra.Command) {
+	// only commands that talk to the API have a host flag
+	if cmd.Flags().Lookup("host") == nil {
+		return
+	}
+	if os.Getenv("LOCO__NO_ANNOUNCEMENTS") != "" || !isatty.IsTerminal(os.Stderr.Fd()) {
+		return
+	}
+
+	host, err := getHost(cmd)
+	if err != nil {
+		return
+	}
+	locoToken, err := getLocoToken(cmd)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), announcementsTimeout)
+	defer cancel()
+
+	apiClient := client.NewClient(host, locoToken.Token)
+	announcements, err := apiClient.ListUnreadAnnouncements(ctx, maxAnnouncementsShown)
+	if err != nil || len(announcements) == 0 {
+		return
+	}
+
+	fmt.Fprintln(os.Stderr)
+	for _, a := range announcements {
+		fmt.Fprintln(os.Stderr, renderAnnouncement(a))
+	}
+
+	// announcements are listed newest first
+	if err := apiClient.MarkAnnouncementsRead(ctx, announcements[0].GetId()); err != nil {
+		slog.Debug("failed to mark announcements read", "error", err)
+	}
+}
+
+func renderAnnouncement(a *announcementv1.Announcement) string {
+	label, color := "NEW", ui.LocoCyan
+	switch a.GetKind() {
+	case announcementv1.AnnouncementKind_ANNOUNCEMENT_KIND_DEPRECATION:
+		label, color = "DEPRECATION", ui.LocoOrange
+	case announcementv1.AnnouncementKind_ANNOUNCEMENT_KIND_MAINTENANCE:
+		label, color = "MAINTENANCE", ui.LocoOrange
+	}
+
+	header := lipgloss.NewStyle().Bold(true).Foreground(color).Render(label) + " " +
+		lipgloss.NewStyle().Bold(true).Render(a.GetTitle())
+
+	lines := []string{header, a.GetBody()}
+	if a.GetLink() != "" {
+		lines = append(lines, lipgloss.NewStyle().Foreground(ui.LocoMuted).Render(a.GetLink()))
+	}
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(color).
+		Padding(0, 1).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
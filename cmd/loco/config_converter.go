@@ -30,6 +30,31 @@ func configToResourceSpecV1(cfg *config.LocoConfig) (*resourcev1.ResourceSpec, e
 		Port:        cfg.Routing.Port,
 		PathPrefix:  cfg.Routing.PathPrefix,
 		IdleTimeout: cfg.Routing.IdleTimeout,
+		Protocol:    cfg.Routing.Protocol,
+
+		RequestTimeout: cfg.Routing.RequestTimeout,
+		MaxBodySize:    cfg.Routing.MaxBodySize,
+	}
+	for _, port := range cfg.Routing.Ports {
+		routing.Ports = append(routing.Ports, &resourcev1.PortConfig{
+			Name:       port.Name,
+			Port:       port.Port,
+			PathPrefix: port.PathPrefix,
+		})
+	}
+	if ep := cfg.Routing.ErrorPage; ep != nil {
+		routing.ErrorPage = &resourcev1.ErrorPageConfig{
+			Disabled: ep.Disabled,
+		}
+		if ep.Title != "" {
+			routing.ErrorPage.Title = &ep.Title
+		}
+		if ep.Message != "" {
+			routing.ErrorPage.Message = &ep.Message
+		}
+		if ep.HTML != "" {
+			routing.ErrorPage.Html = &ep.HTML
+		}
 	}
 
 	// build observability config
@@ -70,6 +95,7 @@ func configToResourceSpecV1(cfg *config.LocoConfig) (*resourcev1.ResourceSpec, e
 				Enabled:      true,
 				CpuTarget:    &resourceCfg.CPUTarget,
 				MemoryTarget: &resourceCfg.ScalersMemTarget,
+				Triggers:     scaleTriggersToProto(resourceCfg.Triggers),
 			}
 			target.Scalers = scalers
 		}
@@ -93,3 +119,68 @@ func configToResourceSpecV1(cfg *config.LocoConfig) (*resourcev1.ResourceSpec, e
 
 	return spec, nil
 }
+
+// scaleTriggersToProto converts configured KEDA triggers to their proto form
+func scaleTriggersToProto(triggers []config.ScaleTrigger) []*deploymentv1.ScaleTrigger {
+	if len(triggers) == 0 {
+		return nil
+	}
+
+	protoTriggers := make([]*deploymentv1.ScaleTrigger, 0, len(triggers))
+	for _, t := range triggers {
+		trigger := &deploymentv1.ScaleTrigger{
+			Type:     t.Type,
+			Metadata: t.Metadata,
+		}
+		if t.QueueResourceID != 0 {
+			trigger.QueueResourceId = &t.QueueResourceID
+		}
+		protoTriggers = append(protoTriggers, trigger)
+	}
+	return protoTriggers
+}
+
+func hooksToProto(hooks []config.Hook) []*deploymentv1.DeploymentHook {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	protoHooks := make([]*deploymentv1.DeploymentHook, 0, len(hooks))
+	for _, h := range hooks {
+		hook := &deploymentv1.DeploymentHook{
+			Name:           h.Name,
+			Phase:          deploymentv1.HookPhase_HOOK_PHASE_PRE_DEPLOY,
+			Command:        h.Command,
+			TimeoutSeconds: h.Timeout,
+		}
+		if h.Phase == "post-deploy" {
+			hook.Phase = deploymentv1.HookPhase_HOOK_PHASE_POST_DEPLOY
+		}
+		if h.Image != "" {
+			hook.Image = &h.Image
+		}
+		protoHooks = append(protoHooks, hook)
+	}
+	return protoHooks
+}
+
+func containersToProto(containers []config.Container) []*deploymentv1.Container {
+	if len(containers) == 0 {
+		return nil
+	}
+
+	protoContainers := make([]*deploymentv1.Container, 0, len(containers))
+	for _, c := range containers {
+		protoContainers = append(protoContainers, &deploymentv1.Container{
+			Name:       c.Name,
+			Image:      c.Image,
+			Command:    c.Command,
+			Args:       c.Args,
+			Env:        c.Env,
+			InheritEnv: c.InheritEnv,
+			Cpu:        c.CPU,
+			Memory:     c.Memory,
+		})
+	}
+	return protoContainers
+}
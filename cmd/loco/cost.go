@@ -0,0 +1,104 @@
+package loco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"github.com/team-loco/loco/internal/client"
+	"github.com/team-loco/loco/internal/ui"
+	"github.com/team-loco/loco/shared/config"
+)
+
+var costCmd = &cobra.Command{
+	Use:   "cost",
+	Short: "Estimate the monthly cost of a loco.toml",
+	Long: `Estimate what the application in loco.toml will cost per month, from the CPU,
+memory and replicas of each region and the pricing of the cluster serving it.
+Autoscaled regions show a range from their minimum to their maximum replicas.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return costCmdFunc(cmd)
+	},
+}
+
+func init() {
+	costCmd.Flags().StringP("config", "c", "", "path to loco.toml config file (defaults to ./loco.toml)")
+	costCmd.Flags().String("host", "", "Set the host URL")
+}
+
+func costCmdFunc(cmd *cobra.Command) error {
+	ctx := context.Background()
+
+	host, err := getHost(cmd)
+	if err != nil {
+		return err
+	}
+
+	configPath, err := parseLocoTomlPath(cmd)
+	if err != nil {
+		return err
+	}
+
+	locoToken, err := getLocoToken(cmd)
+	if err != nil {
+		return ErrLoginRequired
+	}
+
+	loadedCfg, err := config.Load(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := config.Validate(loadedCfg.Config); err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	config.FillSensibleDefaults(loadedCfg.Config)
+
+	spec, err := configToResourceSpec(loadedCfg.Config, "v1")
+	if err != nil {
+		return fmt.Errorf("failed to build resource spec: %w", err)
+	}
+
+	apiClient := client.NewClient(host, locoToken.Token)
+
+	slog.Debug("estimating cost", "app_name", loadedCfg.Config.Metadata.Name)
+
+	estimate, err := apiClient.EstimateCost(ctx, spec)
+	if err != nil {
+		return fmt.Errorf("failed to estimate cost: %w", err)
+	}
+
+	titleStyle := lipgloss.NewStyle().Foreground(ui.LocoCyan).Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(ui.LocoDimGrey).Width(18)
+	valueStyle := lipgloss.NewStyle().Foreground(ui.LocoWhite).Bold(true)
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("\nEstimated monthly cost of %s", loadedCfg.Config.Metadata.Name)))
+	for _, r := range estimate.GetRegions() {
+		replicas := fmt.Sprintf("%d replicas", r.GetMinReplicas())
+		if r.GetMaxReplicas() != r.GetMinReplicas() {
+			replicas = fmt.Sprintf("%d-%d replicas", r.GetMinReplicas(), r.GetMaxReplicas())
+		}
+		fmt.Printf("  %s %s  %s\n",
+			labelStyle.Render(r.GetRegion()+":"),
+			valueStyle.Render(costRange(estimate.GetCurrency(), r.GetMinMonthlyCents(), r.GetMaxMonthlyCents())),
+			replicas)
+	}
+	fmt.Printf("  %s %s\n\n",
+		labelStyle.Render("Total:"),
+		valueStyle.Render(costRange(estimate.GetCurrency(), estimate.GetMinMonthlyCents(), estimate.GetMaxMonthlyCents())))
+
+	return nil
+}
+
+// costRange formats a monthly cost, or a range of them, e.g. "12.40 USD" or "12.40-49.60 USD"
+func costRange(currency string, minCents, maxCents int64) string {
+	if minCents == maxCents {
+		return fmt.Sprintf("%s %s", formatCents(minCents), currency)
+	}
+	return fmt.Sprintf("%s-%s %s", formatCents(minCents), formatCents(maxCents), currency)
+}
+
+func formatCents(cents int64) string {
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100)
+}
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"maps"
 	"os"
+	"os/exec"
 	"strings"
 
 	"connectrpc.com/connect"
@@ -44,6 +45,9 @@ func init() {
 	deployCmd.Flags().StringP("image", "i", "", "image tag to use for deployment")
 	deployCmd.Flags().String("host", "", "Set the host URL")
 	deployCmd.Flags().BoolP("wait", "", false, "Wait for the rollout to complete")
+	deployCmd.Flags().StringP("message", "m", "", "release notes describing what this deploy contains")
+	deployCmd.Flags().String("author", "", "who is deploying (defaults to git user.name)")
+	deployCmd.Flags().String("git-sha", "", "commit being deployed (defaults to the project's git HEAD)")
 }
 
 func deployCmdFunc(cmd *cobra.Command) error {
@@ -79,7 +83,7 @@ func deployCmdFunc(cmd *cobra.Command) error {
 		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
 	}
 
-	locoToken, err := getLocoToken()
+	locoToken, err := getLocoToken(cmd)
 	if err != nil {
 		return ErrLoginRequired
 	}
@@ -89,6 +93,11 @@ func deployCmdFunc(cmd *cobra.Command) error {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
 
+	annotation, err := parseDeployAnnotation(cmd, loadedCfg.ProjectPath)
+	if err != nil {
+		return err
+	}
+
 	if validateErr := config.Validate(loadedCfg.Config); validateErr != nil {
 		return fmt.Errorf("%w: %w", ErrValidation, validateErr)
 	}
@@ -370,7 +379,7 @@ func deployCmdFunc(cmd *cobra.Command) error {
 	steps = append(steps, ui.Step{
 		Title: "Create revision and deployment",
 		Run: func(logf func(string)) error {
-			return deployApp(ctx, apiClient, resourceID, dockerClient.ImageName, loadedCfg.Config, locoToken.Token, logf, wait)
+			return deployApp(ctx, apiClient, resourceID, dockerClient.ImageName, loadedCfg.Config, annotation, locoToken.Token, logf, wait)
 		},
 	})
 
@@ -403,6 +412,7 @@ func deployApp(ctx context.Context,
 	resourceID int64,
 	imageName string,
 	cfg *config.LocoConfig,
+	annotation *deploymentv1.DeploymentAnnotation,
 	token string,
 	logf func(string),
 	wait bool,
@@ -430,6 +440,7 @@ func deployApp(ctx context.Context,
 			Enabled:      true,
 			CpuTarget:    &primaryRegion.CPUTarget,
 			MemoryTarget: &primaryRegion.ScalersMemTarget,
+			Triggers:     scaleTriggersToProto(primaryRegion.Triggers),
 		}
 	}
 
@@ -453,15 +464,18 @@ func deployApp(ctx context.Context,
 	}
 
 	serviceDeploymentSpec := &deploymentv1.ServiceDeploymentSpec{
-		Build:       buildSource,
-		HealthCheck: healthCheck,
-		Port:        cfg.Routing.Port,
-		Cpu:         &primaryRegion.CPU,
-		Memory:      &primaryRegion.Memory,
-		MinReplicas: &primaryRegion.ReplicasMin,
-		MaxReplicas: &primaryRegion.ReplicasMax,
-		Scalers:     scalers,
-		Env:         env,
+		Build:          buildSource,
+		HealthCheck:    healthCheck,
+		Port:           cfg.Routing.Port,
+		Cpu:            &primaryRegion.CPU,
+		Memory:         &primaryRegion.Memory,
+		MinReplicas:    &primaryRegion.ReplicasMin,
+		MaxReplicas:    &primaryRegion.ReplicasMax,
+		Scalers:        scalers,
+		Env:            env,
+		Hooks:          hooksToProto(cfg.Hooks),
+		InitContainers: containersToProto(cfg.InitContainers),
+		Sidecars:       containersToProto(cfg.Sidecars),
 	}
 
 	deploymentSpec := &deploymentv1.DeploymentSpec{
@@ -473,6 +487,7 @@ func deployApp(ctx context.Context,
 	createDeploymentReq := connect.NewRequest(&deploymentv1.CreateDeploymentRequest{
 		ResourceId: resourceID,
 		Spec:       deploymentSpec,
+		Annotation: annotation,
 	})
 	createDeploymentReq.Header().Set("Authorization", fmt.Sprintf("Bearer %s", token))
 
@@ -484,10 +499,17 @@ func deployApp(ctx context.Context,
 
 	deploymentID := deploymentResp.Msg.DeploymentId
 	logf(fmt.Sprintf("Created deployment with version: %d", deploymentID))
+	if release := formatDeployAnnotation(annotation); release != "" {
+		logf("Release: " + release)
+	}
 
 	if wait {
 		logf("Waiting for deployment to complete...")
 		if err := apiClient.StreamDeployment(ctx, fmt.Sprintf("%d", deploymentID), func(event *deploymentv1.WatchDeploymentResponse) error {
+			if hookLog := event.GetHookLog(); hookLog != nil {
+				logf(fmt.Sprintf("[hook %s] %s", hookLog.GetHook(), hookLog.GetLine()))
+				return nil
+			}
 			logf(fmt.Sprintf("[%s] %s", event.Status, event.Message))
 			if event.Status == deploymentv1.DeploymentPhase_DEPLOYMENT_PHASE_FAILED && event.Message != "" {
 				logf(fmt.Sprintf("ERROR: %s", event.Message))
@@ -501,3 +523,61 @@ func deployApp(ctx context.Context,
 
 	return nil
 }
+
+// parseDeployAnnotation reads the release notes for a deploy from flags, filling in the author and
+// commit from the project's git checkout when they are not given.
+func parseDeployAnnotation(cmd *cobra.Command, projectPath string) (*deploymentv1.DeploymentAnnotation, error) {
+	message, err := cmd.Flags().GetString("message")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	author, err := cmd.Flags().GetString("author")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	gitSha, err := cmd.Flags().GetString("git-sha")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	if author == "" {
+		author = gitOutput(projectPath, "config", "user.name")
+	}
+	if gitSha == "" {
+		gitSha = gitOutput(projectPath, "rev-parse", "HEAD")
+	}
+
+	return &deploymentv1.DeploymentAnnotation{
+		Message: message,
+		Author:  author,
+		GitSha:  gitSha,
+	}, nil
+}
+
+// gitOutput runs git in dir and returns its trimmed output, or "" when git is missing or fails,
+// as outside a repository.
+func gitOutput(dir string, args ...string) string {
+	gitCmd := exec.Command("git", args...)
+	gitCmd.Dir = dir
+	out, err := gitCmd.Output()
+	if err != nil {
+		slog.Debug("git metadata unavailable", "args", args, "error", err)
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// formatDeployAnnotation renders an annotation on one line, e.g. "1a2b3c4 fix checkout (by ada)".
+func formatDeployAnnotation(annotation *deploymentv1.DeploymentAnnotation) string {
+	var parts []string
+	if sha := annotation.GetGitSha(); sha != "" {
+		parts = append(parts, sha[:min(len(sha), 7)])
+	}
+	if message := annotation.GetMessage(); message != "" {
+		parts = append(parts, message)
+	}
+	if author := annotation.GetAuthor(); author != "" {
+		parts = append(parts, fmt.Sprintf("(by %s)", author))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,151 @@
+package loco
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"github.com/team-loco/loco/internal/ui"
+	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
+)
+
+func init() {
+	deploymentsCmd.Flags().StringP("app", "a", "", "Application name")
+	deploymentsCmd.Flags().String("org", "", "organization ID")
+	deploymentsCmd.Flags().String("workspace", "", "workspace ID")
+	deploymentsCmd.Flags().String("output", "table", "Output format (table, json). Defaults to table.")
+	deploymentsCmd.Flags().Int32("limit", 20, "Maximum number of deployments to display")
+	deploymentsCmd.Flags().BoolP("watch", "w", false, "Keep watching the deployments and redraw them as they change")
+	deploymentsCmd.Flags().String("host", "", "Set the host URL")
+}
+
+var deploymentsCmd = &cobra.Command{
+	Use:   "deployments",
+	Short: "List application deployments",
+	Long:  "List an application's most recent deployments, newest first, marking the active one with *. With --watch, keep the list on screen and redraw it as deployments progress.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return deploymentsCmdFunc(cmd)
+	},
+}
+
+func deploymentsCmdFunc(cmd *cobra.Command) error {
+	ctx := context.Background()
+
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	limit, err := cmd.Flags().GetInt32("limit")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	if limit < 1 || limit > 200 {
+		return fmt.Errorf("--limit must be between 1 and 200")
+	}
+
+	watch, err := cmd.Flags().GetBool("watch")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	if watch && output == "json" {
+		return fmt.Errorf("--watch only supports table output")
+	}
+
+	apiClient, app, err := getAppForCmd(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	if watch {
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+		defer stop()
+
+		return watchApp(ctx, apiClient, app.GetId(), func(ctx context.Context) (string, error) {
+			deployments, err := apiClient.ListDeployments(ctx, app.GetId(), limit)
+			if err != nil {
+				return "", err
+			}
+			return deploymentsTableView(deployments), nil
+		})
+	}
+
+	deployments, err := apiClient.ListDeployments(ctx, app.GetId(), limit)
+	if err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	if output == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(deployments)
+	}
+	fmt.Println(deploymentsTableView(deployments))
+	return nil
+}
+
+func deploymentsTableView(deployments []*deploymentv1.Deployment) string {
+	if len(deployments) == 0 {
+		return "No deployments found."
+	}
+
+	columns := []table.Column{
+		{Title: "ID", Width: 8},
+		{Title: "STATUS", Width: 11},
+		{Title: "REGION", Width: 12},
+		{Title: "REPLICAS", Width: 8},
+		{Title: "CREATED", Width: 20},
+		{Title: "MESSAGE", Width: 60},
+	}
+
+	var rows []table.Row
+	for _, d := range deployments {
+		status := deploymentPhaseName(d.GetStatus())
+		if d.GetIsActive() {
+			status += "*"
+		}
+		message := d.GetMessage()
+		if note := d.GetAnnotation().GetMessage(); note != "" {
+			message = note
+		}
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", d.GetId()),
+			status,
+			d.GetRegion(),
+			fmt.Sprintf("%d", d.GetReplicas()),
+			d.GetCreatedAt().AsTime().Local().Format(time.RFC3339),
+			message,
+		})
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithHeight(len(rows)),
+	)
+
+	s := table.Styles{
+		Header: lipgloss.NewStyle().
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderForeground(ui.LocoMuted).
+			BorderBottom(true).
+			Bold(false),
+		Cell: lipgloss.NewStyle().Padding(0, 1),
+	}
+	t.SetStyles(s)
+
+	tableStyle := lipgloss.NewStyle().Margin(1, 2)
+	return tableStyle.Render(t.View())
+}
+
+// deploymentPhaseName turns DEPLOYMENT_PHASE_RUNNING into running
+func deploymentPhaseName(phase deploymentv1.DeploymentPhase) string {
+	return strings.ToLower(strings.TrimPrefix(phase.String(), "DEPLOYMENT_PHASE_"))
+}
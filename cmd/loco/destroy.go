@@ -56,7 +56,7 @@ func destroyCmdFunc(cmd *cobra.Command) error {
 		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
 	}
 
-	locoToken, err := getLocoToken()
+	locoToken, err := getLocoToken(cmd)
 	if err != nil {
 		return ErrLoginRequired
 	}
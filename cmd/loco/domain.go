@@ -0,0 +1,234 @@
+package loco
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"github.com/team-loco/loco/internal/ui"
+	domainv1 "github.com/team-loco/loco/shared/proto/domain/v1"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+)
+
+var domainCmd = &cobra.Command{
+	Use:   "domain",
+	Short: "Set up an application's custom domains",
+	Long: `Set up an application's custom domains without leaving the terminal.
+
+A custom domain stays pending until its DNS records point at loco. Use 'loco domain dns'
+to print the records to create, then 'loco domain verify' to wait for them to be picked up.`,
+}
+
+var domainDNSCmd = &cobra.Command{
+	Use:   "dns [domain]",
+	Short: "Print DNS setup instructions for custom domains",
+	Long: `Print the DNS records a custom domain needs, per DNS provider.
+
+Without a domain, prints instructions for each of the application's pending custom domains.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return domainDNSCmdFunc(cmd, args)
+	},
+}
+
+var domainVerifyCmd = &cobra.Command{
+	Use:   "verify <domain>",
+	Short: "Wait for a custom domain to be verified",
+	Long: `Poll a custom domain's verification status until its DNS records are picked up and it is
+active, or until --timeout. Exits non-zero if the domain is still pending at the timeout.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return domainVerifyCmdFunc(cmd, args[0])
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{domainDNSCmd, domainVerifyCmd} {
+		cmd.Flags().StringP("app", "a", "", "Application name")
+		cmd.Flags().String("org", "", "organization ID")
+		cmd.Flags().String("workspace", "", "workspace ID")
+		cmd.Flags().String("host", "", "Set the host URL")
+	}
+	domainDNSCmd.Flags().String("provider", "", "Only show instructions for this DNS provider (e.g. cloudflare, route53)")
+	domainDNSCmd.Flags().String("output", "text", "Output format (text, json). Defaults to text.")
+	domainVerifyCmd.Flags().Duration("timeout", 10*time.Minute, "How long to wait for the domain to be verified")
+	domainVerifyCmd.Flags().Duration("interval", 10*time.Second, "How often to check the domain's status")
+
+	domainCmd.AddCommand(domainDNSCmd, domainVerifyCmd)
+}
+
+func domainDNSCmdFunc(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	provider, err := cmd.Flags().GetString("provider")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	apiClient, app, err := getAppForCmd(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	var domains []*domainv1.ResourceDomain
+	if len(args) == 1 {
+		domain, err := findCustomDomain(app, args[0])
+		if err != nil {
+			return err
+		}
+		domains = append(domains, domain)
+	} else {
+		for _, domain := range app.GetDomains() {
+			if domain.GetDomainSource() == domainv1.DomainType_DOMAIN_TYPE_USER_PROVIDED &&
+				domain.GetStatus() == domainv1.DomainStatus_DOMAIN_STATUS_PENDING {
+				domains = append(domains, domain)
+			}
+		}
+	}
+
+	var instructions []*domainv1.GetDomainSetupInstructionsResponse
+	for _, domain := range domains {
+		slog.Debug("fetching domain setup instructions", "domain_id", domain.GetId(), "domain", domain.GetDomain())
+
+		resp, err := apiClient.GetDomainSetupInstructions(ctx, domain.GetId(), provider)
+		if err != nil {
+			return fmt.Errorf("failed to get setup instructions for %s: %w", domain.GetDomain(), err)
+		}
+		instructions = append(instructions, resp)
+	}
+
+	if output == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(instructions)
+	}
+
+	if len(instructions) == 0 {
+		fmt.Println("No pending custom domains. Pass a domain to see its records anyway.")
+		return nil
+	}
+	for _, resp := range instructions {
+		printDomainInstructions(resp)
+	}
+	fmt.Printf("Once the records are created, run 'loco domain verify --app %s <domain>' to wait for them.\n", app.GetName())
+	return nil
+}
+
+func printDomainInstructions(resp *domainv1.GetDomainSetupInstructionsResponse) {
+	titleStyle := lipgloss.NewStyle().Foreground(ui.LocoCyan).Bold(true)
+	mutedStyle := lipgloss.NewStyle().Foreground(ui.LocoDimGrey)
+
+	status := "active"
+	if resp.GetStatus() == domainv1.DomainStatus_DOMAIN_STATUS_PENDING {
+		status = "pending"
+		if resp.GetStatusMessage() != "" {
+			status += ": " + resp.GetStatusMessage()
+		}
+	}
+	fmt.Printf("\n%s %s\n", titleStyle.Render(resp.GetDomain()), mutedStyle.Render("("+status+")"))
+	if resp.GetApex() {
+		fmt.Println(mutedStyle.Render("  This is an apex domain: most providers can't use a CNAME record for it."))
+	}
+
+	for _, provider := range resp.GetProviders() {
+		fmt.Printf("\n  %s\n", provider.GetProvider())
+		for _, record := range provider.GetRecords() {
+			fmt.Printf("    %-6s %-24s %s\n", record.GetType(), record.GetName(), record.GetValue())
+		}
+		if provider.GetNote() != "" {
+			fmt.Println(mutedStyle.Render("    " + provider.GetNote()))
+		}
+	}
+	fmt.Println()
+}
+
+func domainVerifyCmdFunc(cmd *cobra.Command, name string) error {
+	ctx := context.Background()
+
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	interval, err := cmd.Flags().GetDuration("interval")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	if interval <= 0 {
+		return fmt.Errorf("--interval must be greater than 0")
+	}
+
+	apiClient, app, err := getAppForCmd(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	domain, err := findCustomDomain(app, name)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	style := lipgloss.NewStyle().Foreground(ui.LocoLightGreen).Bold(true)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	stillPending := fmt.Errorf("%s is still pending after %s. Run 'loco domain dns --app %s %s' to check its records", domain.GetDomain(), timeout, app.GetName(), domain.GetDomain())
+
+	fmt.Printf("Waiting for %s to be verified (up to %s)...\n", domain.GetDomain(), timeout)
+
+	lastMessage := ""
+	for {
+		resp, err := apiClient.GetDomainSetupInstructions(ctx, domain.GetId(), "")
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return stillPending
+			}
+			return fmt.Errorf("failed to check %s: %w", domain.GetDomain(), err)
+		}
+
+		if resp.GetStatus() == domainv1.DomainStatus_DOMAIN_STATUS_ACTIVE {
+			fmt.Printf("%s %s is active!\n", style.Render("✓"), domain.GetDomain())
+			return nil
+		}
+		if message := resp.GetStatusMessage(); message != "" && message != lastMessage {
+			fmt.Printf("  %s\n", message)
+			lastMessage = message
+		}
+
+		select {
+		case <-ctx.Done():
+			return stillPending
+		case <-ticker.C:
+		}
+	}
+}
+
+// findCustomDomain returns the app's user-provided domain named name
+func findCustomDomain(app *resourcev1.Resource, name string) (*domainv1.ResourceDomain, error) {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	for _, domain := range app.GetDomains() {
+		if domain.GetDomain() != name {
+			continue
+		}
+		if domain.GetDomainSource() != domainv1.DomainType_DOMAIN_TYPE_USER_PROVIDED {
+			return nil, fmt.Errorf("%s is a platform domain, which loco sets up itself", name)
+		}
+		return domain, nil
+	}
+	return nil, fmt.Errorf("app '%s' has no domain %s", app.GetName(), name)
+}
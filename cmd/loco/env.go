@@ -22,7 +22,16 @@ import (
 var envCmd = &cobra.Command{
 	Use:   "env",
 	Short: "Sync environment variables for an application",
-	Long:  "Sync environment variables for an application without redeploying.",
+	Long: `Sync environment variables for an application without redeploying.
+
+With --shared, the variables are set for every application in the workspace
+instead. An application's own variables win over shared ones, which take effect
+on each application's next deployment.
+
+A value of the form secret://<store>/<key> references an entry of one of the
+workspace's secret stores instead of holding the value, e.g.
+--set STRIPE_KEY=secret://payments/api-key. The value itself is never sent;
+set entries with 'loco secret set'.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return envCmdFunc(cmd)
 	},
@@ -34,6 +43,7 @@ func init() {
 	envCmd.Flags().String("workspace", "", "workspace ID")
 	envCmd.Flags().String("env-file", "", "path to .env file")
 	envCmd.Flags().StringSlice("set", []string{}, "set environment variables (e.g. --set KEY1=VALUE1 --set KEY2=VALUE2)")
+	envCmd.Flags().Bool("shared", false, "set variables shared by every application in the workspace")
 	envCmd.Flags().String("host", "", "Set the host URL")
 }
 
@@ -50,12 +60,17 @@ func envCmdFunc(cmd *cobra.Command) error {
 		return err
 	}
 
+	sharedEnv, err := cmd.Flags().GetBool("shared")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
 	appName, err := cmd.Flags().GetString("app")
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
 	}
-	if appName == "" {
-		return fmt.Errorf("app name is required. Use --app flag")
+	if appName == "" && !sharedEnv {
+		return fmt.Errorf("app name is required. Use --app flag, or --shared to set variables for the whole workspace")
 	}
 
 	envFile, err := cmd.Flags().GetString("env-file")
@@ -95,11 +110,15 @@ func envCmdFunc(cmd *cobra.Command) error {
 		return fmt.Errorf("no environment variables to sync. Use --env-file or --set")
 	}
 
-	locoToken, err := getLocoToken()
+	locoToken, err := getLocoToken(cmd)
 	if err != nil {
 		return ErrLoginRequired
 	}
 
+	if sharedEnv {
+		return syncWorkspaceEnv(ctx, client.NewClient(host, locoToken.Token), workspaceID, envVars)
+	}
+
 	resourceClient := resourcev1connect.NewResourceServiceClient(shared.NewHTTPClient(), host)
 
 	slog.Debug("fetching app by name", "workspaceId", workspaceID, "app_name", appName)
@@ -141,3 +160,40 @@ func envCmdFunc(cmd *cobra.Command) error {
 
 	return nil
 }
+
+// syncWorkspaceEnv adds envVars to the variables shared by every app in the workspace, then lists
+// the apps that need a redeploy to pick them up.
+func syncWorkspaceEnv(ctx context.Context, apiClient *client.Client, workspaceID int64, envVars map[string]string) error {
+	env, err := apiClient.GetWorkspaceEnv(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to get shared environment variables: %w", err)
+	}
+	if env == nil {
+		env = make(map[string]string, len(envVars))
+	}
+	maps.Copy(env, envVars)
+
+	resp, err := apiClient.UpdateWorkspaceEnv(ctx, workspaceID, env)
+	if err != nil {
+		return fmt.Errorf("failed to update shared environment variables: %w", err)
+	}
+	if !resp.GetChanged() {
+		fmt.Println("Shared environment variables are already up to date.")
+		return nil
+	}
+
+	s := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(ui.LocoLightGreen).
+		Render("\n🎉 Shared environment variables synced for the workspace")
+	fmt.Println(s)
+
+	if stale := resp.GetStaleResources(); len(stale) > 0 {
+		fmt.Println("\nThese applications run with the old values until redeployed:")
+		for _, resource := range stale {
+			fmt.Printf("  %s\n", resource.GetName())
+		}
+		fmt.Println("Redeploy each with `loco deploy` to pick up the change.")
+	}
+	return nil
+}
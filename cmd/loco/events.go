@@ -3,9 +3,11 @@ package loco
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"os/signal"
 	"strings"
 	"time"
 
@@ -23,6 +25,8 @@ func init() {
 	eventsCmd.Flags().String("workspace", "", "workspace ID")
 	eventsCmd.Flags().String("output", "table", "Output format (table, json). Defaults to table.")
 	eventsCmd.Flags().Int32("limit", 0, "Maximum number of events to display (0 = all)")
+	eventsCmd.Flags().BoolP("watch", "w", false, "Keep streaming new events as they happen")
+	eventsCmd.Flags().StringSlice("kind", nil, "Only show events for these object kinds (e.g. Pod,Deployment,HTTPRoute)")
 	eventsCmd.Flags().String("host", "", "Set the host URL")
 }
 
@@ -66,7 +70,17 @@ func eventsCmdFunc(cmd *cobra.Command) error {
 		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
 	}
 
-	locoToken, err := getLocoToken()
+	watch, err := cmd.Flags().GetBool("watch")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	kinds, err := cmd.Flags().GetStringSlice("kind")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	locoToken, err := getLocoToken(cmd)
 	if err != nil {
 		return ErrLoginRequired
 	}
@@ -91,17 +105,38 @@ func eventsCmdFunc(cmd *cobra.Command) error {
 		limitPtr = &limit
 	}
 
-	events, err := apiClient.GetEvents(ctx, appID, limitPtr)
+	events, err := apiClient.GetEvents(ctx, appID, limitPtr, kinds)
 	if err != nil {
 		slog.Error("failed to fetch events", "error", err)
 		return fmt.Errorf("failed to fetch events: %w", err)
 	}
 
 	if output == "json" {
-		return printEventsJSON(events)
+		if err := printEventsJSON(events); err != nil {
+			return err
+		}
+	} else {
+		printEventsTable(events)
 	}
 
-	printEventsTable(events)
+	if !watch {
+		return nil
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	err = apiClient.StreamEvents(ctx, appID, kinds, func(event *resourcev1.Event) error {
+		if output == "json" {
+			return printEventsJSON([]*resourcev1.Event{event})
+		}
+		fmt.Printf("  %-20s %-30s %-20s %s\n", event.Timestamp.AsTime().Format(time.RFC3339), eventObject(event), event.Reason, simplifyMessage(event.Message))
+		return nil
+	})
+	if err != nil && !errors.Is(err, context.Canceled) {
+		slog.Error("failed to stream events", "error", err)
+		return fmt.Errorf("failed to stream events: %w", err)
+	}
 	return nil
 }
 
@@ -113,6 +148,7 @@ func printEventsTable(events []*resourcev1.Event) {
 
 	columns := []table.Column{
 		{Title: "TIME", Width: 20},
+		{Title: "OBJECT", Width: 30},
 		{Title: "REASON", Width: 20},
 		{Title: "MESSAGE", Width: 80},
 	}
@@ -121,6 +157,7 @@ func printEventsTable(events []*resourcev1.Event) {
 	for _, event := range events {
 		rows = append(rows, table.Row{
 			event.Timestamp.AsTime().Format(time.RFC3339),
+			eventObject(event),
 			event.Reason,
 			simplifyMessage(event.Message),
 		})
@@ -146,6 +183,15 @@ func printEventsTable(events []*resourcev1.Event) {
 	fmt.Println(tableStyle.Render(t.View()))
 }
 
+// eventObject renders the involved object as kind/name
+func eventObject(event *resourcev1.Event) string {
+	ref := event.GetInvolvedObject()
+	if ref == nil {
+		return event.GetPodName()
+	}
+	return strings.ToLower(ref.GetKind()) + "/" + ref.GetName()
+}
+
 func simplifyMessage(message string) string {
 	if strings.Contains(message, "ImagePullBackOff") {
 		return "Error: ImagePullBackOff"
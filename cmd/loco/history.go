@@ -0,0 +1,172 @@
+package loco
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"github.com/team-loco/loco/internal/client"
+	"github.com/team-loco/loco/internal/ui"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+)
+
+// historyKinds maps the names accepted by --kind to timeline entry kinds
+var historyKinds = map[string]resourcev1.TimelineEntryKind{
+	"deployment": resourcev1.TimelineEntryKind_TIMELINE_ENTRY_KIND_DEPLOYMENT,
+	"scaled":     resourcev1.TimelineEntryKind_TIMELINE_ENTRY_KIND_SCALED,
+	"env":        resourcev1.TimelineEntryKind_TIMELINE_ENTRY_KIND_ENV_CHANGED,
+	"domain":     resourcev1.TimelineEntryKind_TIMELINE_ENTRY_KIND_DOMAIN_CHANGED,
+	"incident":   resourcev1.TimelineEntryKind_TIMELINE_ENTRY_KIND_INCIDENT,
+}
+
+func init() {
+	historyCmd.Flags().StringP("app", "a", "", "Application name")
+	historyCmd.Flags().String("org", "", "organization ID")
+	historyCmd.Flags().String("workspace", "", "workspace ID")
+	historyCmd.Flags().String("output", "table", "Output format (table, json). Defaults to table.")
+	historyCmd.Flags().Int32("limit", 50, "Maximum number of entries to display (0 = all)")
+	historyCmd.Flags().StringSlice("kind", nil, "Only show these kinds of entries (deployment, scaled, env, domain, incident)")
+	historyCmd.Flags().String("host", "", "Set the host URL")
+}
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show application history",
+	Long:  "Display an application's deployments, scaling, environment and domain changes, and incidents, newest first.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return historyCmdFunc(cmd)
+	},
+}
+
+func historyCmdFunc(cmd *cobra.Command) error {
+	ctx := context.Background()
+
+	host, err := getHost(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspaceID, err := getWorkspaceId(cmd)
+	if err != nil {
+		return err
+	}
+
+	appName, err := cmd.Flags().GetString("app")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	if appName == "" {
+		return fmt.Errorf("app name is required. Use --app flag")
+	}
+
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	limit, err := cmd.Flags().GetInt32("limit")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	kindNames, err := cmd.Flags().GetStringSlice("kind")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	var kinds []resourcev1.TimelineEntryKind
+	for _, name := range kindNames {
+		kind, ok := historyKinds[strings.ToLower(name)]
+		if !ok {
+			return fmt.Errorf("unknown kind %q: must be one of deployment, scaled, env, domain, incident", name)
+		}
+		kinds = append(kinds, kind)
+	}
+
+	locoToken, err := getLocoToken(cmd)
+	if err != nil {
+		return ErrLoginRequired
+	}
+
+	apiClient := client.NewClient(host, locoToken.Token)
+
+	slog.Debug("fetching app by name", "workspaceId", workspaceID, "app_name", appName)
+
+	app, err := apiClient.GetAppByName(ctx, workspaceID, appName)
+	if err != nil {
+		slog.Debug("failed to get app by name", "error", err)
+		return fmt.Errorf("failed to get app '%s': %w", appName, err)
+	}
+
+	slog.Debug("fetching timeline for app", "app_id", app.Id, "app_name", appName)
+
+	entries, err := apiClient.GetTimeline(ctx, app.Id, limit, kinds)
+	if err != nil {
+		slog.Error("failed to fetch timeline", "error", err)
+		return fmt.Errorf("failed to fetch history: %w", err)
+	}
+
+	if output == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(entries)
+	}
+	printHistoryTable(entries)
+	return nil
+}
+
+func printHistoryTable(entries []*resourcev1.TimelineEntry) {
+	if len(entries) == 0 {
+		fmt.Println("No history found.")
+		return
+	}
+
+	columns := []table.Column{
+		{Title: "TIME", Width: 20},
+		{Title: "KIND", Width: 12},
+		{Title: "SUMMARY", Width: 90},
+	}
+
+	var rows []table.Row
+	for _, entry := range entries {
+		rows = append(rows, table.Row{
+			entry.GetOccurredAt().AsTime().Local().Format(time.RFC3339),
+			historyKindName(entry.GetKind()),
+			entry.GetSummary(),
+		})
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithHeight(len(rows)),
+	)
+
+	s := table.Styles{
+		Header: lipgloss.NewStyle().
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderForeground(ui.LocoMuted).
+			BorderBottom(true).
+			Bold(false),
+		Cell: lipgloss.NewStyle().Padding(0, 1),
+	}
+	t.SetStyles(s)
+
+	tableStyle := lipgloss.NewStyle().Margin(1, 2)
+	fmt.Println(tableStyle.Render(t.View()))
+}
+
+func historyKindName(kind resourcev1.TimelineEntryKind) string {
+	for name, k := range historyKinds {
+		if k == kind {
+			return name
+		}
+	}
+	return "unknown"
+}
@@ -0,0 +1,136 @@
+package loco
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"github.com/team-loco/loco/internal/client"
+	"github.com/team-loco/loco/internal/ui"
+	notificationv1 "github.com/team-loco/loco/shared/proto/notification/v1"
+)
+
+func init() {
+	inboxCmd.Flags().Bool("all", false, "Show read notifications too")
+	inboxCmd.Flags().Int32("limit", 20, "Maximum number of notifications to display")
+	inboxCmd.Flags().Bool("keep-unread", false, "Don't mark the shown notifications as read")
+	inboxCmd.Flags().String("output", "text", "Output format (text, json). Defaults to text.")
+	inboxCmd.Flags().String("host", "", "Set the host URL")
+}
+
+var inboxCmd = &cobra.Command{
+	Use:   "inbox",
+	Short: "Show your notifications",
+	Long:  "Display deployment failures, workspace invitations and other notifications since you last looked, then mark them read.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return inboxCmdFunc(cmd)
+	},
+}
+
+func inboxCmdFunc(cmd *cobra.Command) error {
+	ctx := context.Background()
+
+	host, err := getHost(cmd)
+	if err != nil {
+		return err
+	}
+
+	all, err := cmd.Flags().GetBool("all")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	limit, err := cmd.Flags().GetInt32("limit")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	keepUnread, err := cmd.Flags().GetBool("keep-unread")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	locoToken, err := getLocoToken(cmd)
+	if err != nil {
+		return ErrLoginRequired
+	}
+
+	apiClient := client.NewClient(host, locoToken.Token)
+
+	notifications, unreadCount, err := apiClient.ListNotifications(ctx, limit, !all)
+	if err != nil {
+		return fmt.Errorf("failed to list notifications: %w", err)
+	}
+
+	if output == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(notifications); err != nil {
+			return err
+		}
+	} else {
+		printInbox(notifications, unreadCount)
+	}
+
+	if keepUnread {
+		return nil
+	}
+
+	// only what was shown, so unread notifications past the limit stay unread
+	for _, n := range notifications {
+		if n.GetReadAt() != nil {
+			continue
+		}
+		if err := apiClient.MarkNotificationRead(ctx, n.GetId()); err != nil {
+			slog.Debug("failed to mark notification read", "id", n.GetId(), "error", err)
+		}
+	}
+	return nil
+}
+
+func printInbox(notifications []*notificationv1.Notification, unreadCount int64) {
+	if len(notifications) == 0 {
+		fmt.Println("You're all caught up.")
+		return
+	}
+
+	unreadStyle := lipgloss.NewStyle().Bold(true).Foreground(ui.LocoCyan)
+	mutedStyle := lipgloss.NewStyle().Foreground(ui.LocoMuted)
+
+	for _, n := range notifications {
+		marker := "  "
+		if n.GetReadAt() == nil {
+			marker = unreadStyle.Render("● ")
+		}
+		fmt.Printf("%s%s %s\n", marker, lipgloss.NewStyle().Bold(true).Render(n.GetSubject()),
+			mutedStyle.Render(n.GetCreatedAt().AsTime().Local().Format(time.DateTime)))
+		if n.GetBody() != "" {
+			fmt.Println(lipgloss.NewStyle().MarginLeft(2).Render(n.GetBody()))
+		}
+		fmt.Println()
+	}
+
+	if shown := int64(countUnread(notifications)); unreadCount > shown {
+		fmt.Println(mutedStyle.Render(fmt.Sprintf("%d more unread; use --limit to see them.", unreadCount-shown)))
+	}
+}
+
+func countUnread(notifications []*notificationv1.Notification) int {
+	unread := 0
+	for _, n := range notifications {
+		if n.GetReadAt() == nil {
+			unread++
+		}
+	}
+	return unread
+}
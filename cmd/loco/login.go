@@ -187,11 +187,7 @@ var loginCmd = &cobra.Command{
 		if existingCfg != nil {
 			scope, scopeErr := existingCfg.GetScope()
 			if scopeErr == nil {
-				keychain.SetLocoToken(user.Name, keychain.UserToken{
-					Token: locoResp.Msg.LocoToken,
-					// sub 10 mins
-					ExpiresAt: time.Now().Add(time.Duration(locoResp.Msg.ExpiresIn)*time.Second - (10 * time.Minute)),
-				})
+				storeLoginToken(user.Name, locoResp.Msg.LocoToken, locoResp.Msg.RefreshToken, locoResp.Msg.ExpiresIn)
 
 				checkmark := lipgloss.NewStyle().Foreground(ui.LocoGreen).Render("✔")
 				title := lipgloss.NewStyle().Bold(true).Foreground(ui.LocoOrange).Render("Logged in!")
@@ -306,11 +302,7 @@ var loginCmd = &cobra.Command{
 				return err
 			}
 
-			keychain.SetLocoToken(user.Name, keychain.UserToken{
-				Token: locoResp.Msg.LocoToken,
-				// sub 10 mins
-				ExpiresAt: time.Now().Add(time.Duration(locoResp.Msg.ExpiresIn)*time.Second - (10 * time.Minute)),
-			})
+			storeLoginToken(user.Name, locoResp.Msg.LocoToken, locoResp.Msg.RefreshToken, locoResp.Msg.ExpiresIn)
 
 			checkmark := lipgloss.NewStyle().Foreground(ui.LocoGreen).Render("✔")
 			title := lipgloss.NewStyle().Bold(true).Foreground(ui.LocoOrange).Render("Authentication successful!")
@@ -374,11 +366,7 @@ var loginCmd = &cobra.Command{
 			return err
 		}
 
-		keychain.SetLocoToken(user.Name, keychain.UserToken{
-			Token: locoResp.Msg.LocoToken,
-			// sub 10 mins
-			ExpiresAt: time.Now().Add(time.Duration(locoResp.Msg.ExpiresIn)*time.Second - (10 * time.Minute)),
-		})
+		storeLoginToken(user.Name, locoResp.Msg.LocoToken, locoResp.Msg.RefreshToken, locoResp.Msg.ExpiresIn)
 
 		checkmark := lipgloss.NewStyle().Foreground(ui.LocoGreen).Render("✔")
 		title := lipgloss.NewStyle().Bold(true).Foreground(ui.LocoOrange).Render("Authentication successful!")
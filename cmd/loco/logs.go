@@ -71,7 +71,12 @@ func streamLogsAsJson(cmd *cobra.Command) error {
 		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
 	}
 
-	locoToken, err := getLocoToken()
+	filter, err := getLogFilter(cmd)
+	if err != nil {
+		return err
+	}
+
+	locoToken, err := getLocoToken(cmd)
 	if err != nil {
 		return ErrLoginRequired
 	}
@@ -101,7 +106,7 @@ func streamLogsAsJson(cmd *cobra.Command) error {
 		followPtr = &follow
 	}
 
-	err = apiClient.StreamLogs(ctx, appID, linesPtr, followPtr, func(logEntry *resourcev1.WatchLogsResponse) error {
+	err = apiClient.StreamLogs(ctx, appID, linesPtr, followPtr, filter, func(logEntry *resourcev1.WatchLogsResponse) error {
 		jsonLog, marshalErr := json.Marshal(logEntry)
 		if marshalErr != nil {
 			slog.Debug("failed to marshal log entry to json", "error", marshalErr)
@@ -150,7 +155,12 @@ func streamLogsInteractive(cmd *cobra.Command) error {
 		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
 	}
 
-	locoToken, err := getLocoToken()
+	filter, err := getLogFilter(cmd)
+	if err != nil {
+		return err
+	}
+
+	locoToken, err := getLocoToken(cmd)
 	if err != nil {
 		return ErrLoginRequired
 	}
@@ -207,7 +217,7 @@ func streamLogsInteractive(cmd *cobra.Command) error {
 	}
 
 	go func() {
-		err := apiClient.StreamLogs(ctx, appID, linesPtr, followPtr, func(logEntry *resourcev1.WatchLogsResponse) error {
+		err := apiClient.StreamLogs(ctx, appID, linesPtr, followPtr, filter, func(logEntry *resourcev1.WatchLogsResponse) error {
 			logsChan <- logEntry
 			return nil
 		})
@@ -238,6 +248,23 @@ func streamLogsInteractive(cmd *cobra.Command) error {
 	return nil
 }
 
+// getLogFilter reads the server-side filter flags shared by both output modes
+func getLogFilter(cmd *cobra.Command) (client.LogFilter, error) {
+	match, err := cmd.Flags().GetString("filter")
+	if err != nil {
+		return client.LogFilter{}, fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	exclude, err := cmd.Flags().GetString("exclude")
+	if err != nil {
+		return client.LogFilter{}, fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	regex, err := cmd.Flags().GetBool("regex")
+	if err != nil {
+		return client.LogFilter{}, fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	return client.LogFilter{Match: match, Exclude: exclude, Regex: regex}, nil
+}
+
 type logMsg struct {
 	Time    string
 	PodName string
@@ -327,5 +354,8 @@ func init() {
 	logsCmd.Flags().BoolP("follow", "f", false, "Follow log output (tail -f style)")
 	logsCmd.Flags().Int32P("lines", "n", 0, "Number of lines to show (0 = all)")
 	logsCmd.Flags().StringP("output", "o", "", "Output format (json, table). Defaults to table.")
+	logsCmd.Flags().String("filter", "", "Only show lines containing this text (evaluated server-side)")
+	logsCmd.Flags().String("exclude", "", "Hide lines containing this text (evaluated server-side)")
+	logsCmd.Flags().Bool("regex", false, "Treat --filter and --exclude as regular expressions")
 	logsCmd.Flags().String("host", "", "Set the host URL")
 }
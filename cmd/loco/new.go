@@ -0,0 +1,312 @@
+package loco
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"github.com/team-loco/loco/internal/client"
+	"github.com/team-loco/loco/internal/ui"
+	"github.com/team-loco/loco/shared"
+	domainv1 "github.com/team-loco/loco/shared/proto/domain/v1"
+	"github.com/team-loco/loco/shared/proto/domain/v1/domainv1connect"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+	templatev1 "github.com/team-loco/loco/shared/proto/template/v1"
+)
+
+var newCmd = &cobra.Command{
+	Use:   "new [template]",
+	Short: "Deploy a new application from a template",
+	Long: `Create and deploy a new application from the template catalog, such as a
+Postgres + API starter, a static site or a background worker. Resources, links
+between them and their domains are set up in one step.
+
+Run without a template to pick one from the catalog.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return newCmdFunc(cmd, args)
+	},
+}
+
+func init() {
+	newCmd.Flags().StringP("name", "n", "", "Application name (sets the app_name parameter)")
+	newCmd.Flags().StringSlice("param", []string{}, "set template parameters (e.g. --param KEY1=VALUE1 --param KEY2=VALUE2)")
+	newCmd.Flags().String("region", "", "Region to deploy to")
+	newCmd.Flags().String("domain", "", "Platform domain to create subdomains under, e.g. deploy-app.com")
+	newCmd.Flags().Bool("list", false, "List the available templates and exit")
+	newCmd.Flags().String("org", "", "organization ID")
+	newCmd.Flags().String("workspace", "", "workspace ID")
+	newCmd.Flags().String("host", "", "Set the host URL")
+}
+
+func newCmdFunc(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	host, err := getHost(cmd)
+	if err != nil {
+		return err
+	}
+
+	list, err := cmd.Flags().GetBool("list")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	locoToken, err := getLocoToken(cmd)
+	if err != nil {
+		return ErrLoginRequired
+	}
+
+	apiClient := client.NewClient(host, locoToken.Token)
+
+	templates, err := apiClient.ListTemplates(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list templates: %w", err)
+	}
+
+	if list {
+		for _, t := range templates {
+			fmt.Printf("%-16s %s\n", t.GetId(), t.GetName())
+			fmt.Printf("%-16s %s\n", "", t.GetDescription())
+		}
+		return nil
+	}
+
+	workspaceID, err := getWorkspaceId(cmd)
+	if err != nil {
+		return err
+	}
+
+	template, err := selectTemplate(templates, args)
+	if err != nil {
+		return err
+	}
+
+	params, err := templateParamsFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// prompt for required parameters that were not given; generated ones are left to the server
+	for _, p := range template.GetParameters() {
+		if params[p.GetName()] != "" || !p.GetRequired() {
+			continue
+		}
+		value, askErr := ui.AskForString(fmt.Sprintf("%s (%s): ", p.GetName(), p.GetDescription()))
+		if askErr != nil {
+			return fmt.Errorf("failed to read parameter %s: %w", p.GetName(), askErr)
+		}
+		if value == "" {
+			return fmt.Errorf("parameter %s is required. Use --param %s=VALUE", p.GetName(), p.GetName())
+		}
+		params[p.GetName()] = value
+	}
+
+	region, err := selectTemplateRegion(ctx, cmd, apiClient, locoToken.Token)
+	if err != nil {
+		return err
+	}
+
+	platformDomainID, err := selectTemplatePlatformDomain(ctx, cmd, host, locoToken.Token)
+	if err != nil {
+		return err
+	}
+
+	slog.Debug("instantiating template", "template", template.GetId(), "workspace_id", workspaceID, "region", region)
+
+	created, err := apiClient.InstantiateTemplate(ctx, &templatev1.InstantiateTemplateRequest{
+		WorkspaceId:      workspaceID,
+		TemplateId:       template.GetId(),
+		Parameters:       params,
+		PlatformDomainId: platformDomainID,
+		Region:           region,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to deploy template '%s': %w", template.GetId(), err)
+	}
+
+	s := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(ui.LocoLightGreen).
+		Render(fmt.Sprintf("\n🎉 Deployed %s", template.GetName()))
+	fmt.Println(s)
+
+	for _, r := range created {
+		fmt.Printf("  %s: https://%s (deployment %d)\n", r.GetName(), r.GetDomain(), r.GetDeploymentId())
+	}
+
+	return nil
+}
+
+// selectTemplate returns the template named in args, or lets the user pick one
+func selectTemplate(templates []*templatev1.Template, args []string) (*templatev1.Template, error) {
+	if len(args) == 1 {
+		for _, t := range templates {
+			if t.GetId() == args[0] {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown template '%s'. Run `loco new --list` to see the catalog", args[0])
+	}
+
+	if len(templates) == 0 {
+		return nil, errors.New("no templates available")
+	}
+
+	options := make([]ui.SelectOption, len(templates))
+	for i, t := range templates {
+		options[i] = ui.SelectOption{
+			Label:       t.GetName(),
+			Description: t.GetDescription(),
+			Value:       t,
+		}
+	}
+
+	selected, err := ui.SelectFromList("Select a template", options)
+	if err != nil {
+		return nil, fmt.Errorf("template selection cancelled: %w", err)
+	}
+
+	template, ok := selected.(*templatev1.Template)
+	if !ok {
+		return nil, fmt.Errorf("invalid template type: expected template, got %T", selected)
+	}
+	return template, nil
+}
+
+func templateParamsFromFlags(cmd *cobra.Command) (map[string]string, error) {
+	setParams, err := cmd.Flags().GetStringSlice("param")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	params := make(map[string]string)
+	for _, setParam := range setParams {
+		parts := strings.SplitN(setParam, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid --param format: %s, expected KEY=VALUE", setParam)
+		}
+		params[parts[0]] = parts[1]
+	}
+
+	name, err := cmd.Flags().GetString("name")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	if name != "" {
+		params["app_name"] = name
+	}
+
+	return params, nil
+}
+
+func selectTemplateRegion(ctx context.Context, cmd *cobra.Command, apiClient *client.Client, token string) (string, error) {
+	region, err := cmd.Flags().GetString("region")
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	if region != "" {
+		return region, nil
+	}
+
+	req := connect.NewRequest(&resourcev1.ListRegionsRequest{})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := apiClient.Resource.ListRegions(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "list regions")
+		return "", fmt.Errorf("failed to fetch regions: %w", err)
+	}
+
+	if len(resp.Msg.Regions) == 0 {
+		return "", errors.New("no available regions found")
+	}
+
+	options := make([]ui.SelectOption, len(resp.Msg.Regions))
+	for i, r := range resp.Msg.Regions {
+		label := r.Region
+		if r.IsDefault {
+			label += " (default)"
+		}
+		options[i] = ui.SelectOption{
+			Label:       label,
+			Description: fmt.Sprintf("Health: %s", r.HealthStatus),
+			Value:       r.Region,
+		}
+	}
+
+	selected, err := ui.SelectFromList("Select a region for your app", options)
+	if err != nil {
+		return "", fmt.Errorf("region selection cancelled: %w", err)
+	}
+
+	regionStr, ok := selected.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid region type: expected string, got %T", selected)
+	}
+	return regionStr, nil
+}
+
+func selectTemplatePlatformDomain(ctx context.Context, cmd *cobra.Command, host, token string) (int64, error) {
+	domain, err := cmd.Flags().GetString("domain")
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	domainClient := domainv1connect.NewDomainServiceClient(shared.NewHTTPClient(), host)
+
+	activeOnly := true
+	req := connect.NewRequest(&domainv1.ListPlatformDomainsRequest{
+		ActiveOnly: &activeOnly,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := domainClient.ListPlatformDomains(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "list platform domains")
+		return 0, fmt.Errorf("failed to fetch platform domains: %w", err)
+	}
+
+	platformDomains := resp.Msg.PlatformDomains
+	if len(platformDomains) == 0 {
+		return 0, errors.New("no available platform domains found")
+	}
+
+	if domain != "" {
+		for _, pd := range platformDomains {
+			if pd.Domain == domain {
+				return pd.Id, nil
+			}
+		}
+		return 0, fmt.Errorf("platform domain '%s' not found", domain)
+	}
+
+	if len(platformDomains) == 1 {
+		return platformDomains[0].Id, nil
+	}
+
+	options := make([]ui.SelectOption, len(platformDomains))
+	for i, pd := range platformDomains {
+		options[i] = ui.SelectOption{
+			Label:       pd.Domain,
+			Description: fmt.Sprintf("ID: %d", pd.Id),
+			Value:       pd.Id,
+		}
+	}
+
+	selected, err := ui.SelectFromList("Select platform domain for your app", options)
+	if err != nil {
+		return 0, fmt.Errorf("domain selection cancelled: %w", err)
+	}
+
+	domainID, ok := selected.(int64)
+	if !ok {
+		return 0, fmt.Errorf("invalid domain ID type: expected int64, got %T", selected)
+	}
+	return domainID, nil
+}
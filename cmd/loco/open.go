@@ -0,0 +1,122 @@
+package loco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+	"github.com/team-loco/loco/internal/client"
+	domainv1 "github.com/team-loco/loco/shared/proto/domain/v1"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+)
+
+var openCmd = &cobra.Command{
+	Use:   "open",
+	Short: "Open an application in your browser",
+	Long: `Open an application's primary domain in your browser.
+
+Use --print to print the URL instead, e.g. on a machine without a browser.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return openCmdFunc(cmd)
+	},
+}
+
+func init() {
+	openCmd.Flags().StringP("app", "a", "", "Application name")
+	openCmd.Flags().String("org", "", "organization ID")
+	openCmd.Flags().String("workspace", "", "workspace ID")
+	openCmd.Flags().Bool("print", false, "Print the URL instead of opening it")
+	openCmd.Flags().String("host", "", "Set the host URL")
+}
+
+func openCmdFunc(cmd *cobra.Command) error {
+	ctx := context.Background()
+
+	printOnly, err := cmd.Flags().GetBool("print")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	_, app, err := getAppForCmd(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	domain := primaryDomain(app)
+	if domain == nil {
+		return fmt.Errorf("app '%s' has no domains yet. Deploy it first with 'loco deploy'", app.GetName())
+	}
+
+	url := "https://" + domain.GetDomain()
+	if printOnly {
+		fmt.Println(url)
+		return nil
+	}
+
+	if domain.GetStatus() == domainv1.DomainStatus_DOMAIN_STATUS_PENDING {
+		fmt.Printf("%s is not active yet and may not load. Run 'loco domain dns --app %s' to finish its setup.\n", domain.GetDomain(), app.GetName())
+	}
+
+	slog.Debug("opening app in browser", "url", url, "app_id", app.GetId())
+
+	if err := openBrowser(url); err != nil {
+		return fmt.Errorf("failed to open browser: %w", err)
+	}
+
+	fmt.Printf("Opening %s in your browser...\n", url)
+	return nil
+}
+
+// primaryDomain returns the domain an app is reached at: its primary domain, or else its first
+// active one
+func primaryDomain(app *resourcev1.Resource) *domainv1.ResourceDomain {
+	var fallback *domainv1.ResourceDomain
+	for _, domain := range app.GetDomains() {
+		if domain.GetIsPrimary() {
+			return domain
+		}
+		if fallback == nil && domain.GetStatus() != domainv1.DomainStatus_DOMAIN_STATUS_PENDING && domain.GetRedirect() == nil {
+			fallback = domain
+		}
+	}
+	return fallback
+}
+
+// getAppForCmd looks up the app named by the command's --app flag in the current workspace
+func getAppForCmd(ctx context.Context, cmd *cobra.Command) (*client.Client, *resourcev1.Resource, error) {
+	host, err := getHost(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workspaceID, err := getWorkspaceId(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	appName, err := cmd.Flags().GetString("app")
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	if appName == "" {
+		return nil, nil, fmt.Errorf("app name is required. Use --app flag")
+	}
+
+	locoToken, err := getLocoToken(cmd)
+	if err != nil {
+		return nil, nil, ErrLoginRequired
+	}
+
+	apiClient := client.NewClient(host, locoToken.Token)
+
+	slog.Debug("fetching app by name", "workspaceId", workspaceID, "app_name", appName)
+
+	app, err := apiClient.GetAppByName(ctx, workspaceID, appName)
+	if err != nil {
+		slog.Debug("failed to get app by name", "error", err)
+		return nil, nil, fmt.Errorf("failed to get app '%s': %w", appName, err)
+	}
+
+	return apiClient, app, nil
+}
@@ -0,0 +1,100 @@
+package loco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"github.com/team-loco/loco/internal/client"
+	"github.com/team-loco/loco/internal/ui"
+)
+
+var promoteCmd = &cobra.Command{
+	Use:   "promote",
+	Short: "Promote a standby region to primary",
+	Long: `Promote a standby region of a multi-region application to primary.
+Traffic and failover order move to the promoted region. Use --force during a
+regional outage to skip health checks on the target region.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return promoteCmdFunc(cmd)
+	},
+}
+
+func init() {
+	promoteCmd.Flags().StringP("app", "a", "", "Application name")
+	promoteCmd.Flags().String("org", "", "organization ID")
+	promoteCmd.Flags().String("workspace", "", "workspace ID")
+	promoteCmd.Flags().String("region", "", "Region to promote to primary")
+	promoteCmd.Flags().Bool("force", false, "Promote even if the target region is not healthy")
+	promoteCmd.Flags().String("host", "", "Set the host URL")
+}
+
+func promoteCmdFunc(cmd *cobra.Command) error {
+	ctx := context.Background()
+
+	host, err := getHost(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspaceID, err := getWorkspaceId(cmd)
+	if err != nil {
+		return err
+	}
+
+	appName, err := cmd.Flags().GetString("app")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	if appName == "" {
+		return fmt.Errorf("app name is required. Use --app flag")
+	}
+
+	region, err := cmd.Flags().GetString("region")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	if region == "" {
+		return fmt.Errorf("region is required. Use --region flag")
+	}
+
+	force, err := cmd.Flags().GetBool("force")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+
+	locoToken, err := getLocoToken(cmd)
+	if err != nil {
+		return ErrLoginRequired
+	}
+
+	apiClient := client.NewClient(host, locoToken.Token)
+
+	app, err := apiClient.GetAppByName(ctx, workspaceID, appName)
+	if err != nil {
+		slog.Debug("failed to get app by name", "error", err)
+		return fmt.Errorf("failed to get app '%s': %w", appName, err)
+	}
+
+	slog.Debug("promoting region", "app_id", app.Id, "region", region, "force", force)
+
+	regions, err := apiClient.PromoteRegion(ctx, app.Id, region, force)
+	if err != nil {
+		return fmt.Errorf("failed to promote region '%s' for app '%s': %w", region, appName, err)
+	}
+
+	s := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(ui.LocoLightGreen).
+		Render(fmt.Sprintf("\n🎉 %s is now the primary region for %s", region, appName))
+	fmt.Println(s)
+
+	fmt.Println("  Failover order:")
+	for i, r := range regions {
+		fmt.Printf("    %d. %s\n", i+1, r.GetRegion())
+	}
+
+	return nil
+}
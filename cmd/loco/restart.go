@@ -0,0 +1,53 @@
+package loco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"github.com/team-loco/loco/internal/ui"
+)
+
+var restartCmd = &cobra.Command{
+	Use:   "restart",
+	Short: "Restart an application's pods",
+	Long: `Restart an application. Its pods are replaced one by one on the current
+deployment, without changing its image, resources or environment. The restart
+shows in the deployment history.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return restartCmdFunc(cmd)
+	},
+}
+
+func init() {
+	restartCmd.Flags().StringP("app", "a", "", "Application name")
+	restartCmd.Flags().String("org", "", "organization ID")
+	restartCmd.Flags().String("workspace", "", "workspace ID")
+	restartCmd.Flags().String("host", "", "Set the host URL")
+}
+
+func restartCmdFunc(cmd *cobra.Command) error {
+	ctx := context.Background()
+
+	apiClient, appID, appName, err := resolveAppForCmd(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	slog.Debug("restarting app", "app_id", appID)
+
+	deploymentID, err := apiClient.RestartApp(ctx, appID)
+	if err != nil {
+		return fmt.Errorf("failed to restart app '%s': %w", appName, err)
+	}
+
+	s := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(ui.LocoLightGreen).
+		Render(fmt.Sprintf("\n🔄 %s is restarting (deployment %d). Run `loco status --app %s` to follow the rollout.", appName, deploymentID, appName))
+	fmt.Println(s)
+
+	return nil
+}
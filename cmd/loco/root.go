@@ -32,6 +32,7 @@ var RootCmd = &cobra.Command{
 			"command", cmd.Name(),
 			"duration", time.Since(startTime),
 		)
+		showUnreadAnnouncements(cmd)
 	},
 }
 
@@ -65,5 +66,5 @@ func initLogger(cmd *cobra.Command) error {
 }
 
 func init() {
-	RootCmd.AddCommand(loginCmd, useCmd, buildWhoAmICmd(), initCmd, validateCmd, deployCmd, destroyCmd, scaleCmd, envCmd, statusCmd, logsCmd, eventsCmd, webCmd)
+	RootCmd.AddCommand(loginCmd, useCmd, buildWhoAmICmd(), initCmd, newCmd, validateCmd, costCmd, deployCmd, destroyCmd, scaleCmd, promoteCmd, suspendCmd, resumeCmd, restartCmd, envCmd, secretCmd, statusCmd, deploymentsCmd, logsCmd, eventsCmd, historyCmd, inboxCmd, openCmd, domainCmd, webCmd)
 }
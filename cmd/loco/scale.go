@@ -78,7 +78,7 @@ func scaleCmdFunc(cmd *cobra.Command) error {
 		return fmt.Errorf("replicas must be >= 1")
 	}
 
-	locoToken, err := getLocoToken()
+	locoToken, err := getLocoToken(cmd)
 	if err != nil {
 		return ErrLoginRequired
 	}
@@ -0,0 +1,179 @@
+package loco
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"github.com/team-loco/loco/internal/client"
+	"github.com/team-loco/loco/internal/ui"
+)
+
+var secretCmd = &cobra.Command{
+	Use:   "secret",
+	Short: "Manage a workspace's secret stores",
+	Long: `Manage the secret stores of a workspace.
+
+An entry is set once and referenced from environment variables as
+secret://<store>/<key>, e.g. 'loco env --set STRIPE_KEY=secret://payments/api-key'.
+Values are write-only: they are never shown again, and applications pick up a
+changed value on their next deployment.`,
+}
+
+var secretSetCmd = &cobra.Command{
+	Use:   "set <store>/<key>",
+	Short: "Set a secret store entry",
+	Long: `Set a secret store entry, creating the store if needed.
+
+The value is read from standard input, so it stays out of your shell history, e.g.
+  loco secret set payments/api-key < api-key.txt`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return secretSetCmdFunc(cmd, args[0])
+	},
+}
+
+var secretDeleteCmd = &cobra.Command{
+	Use:   "delete <store>/<key>",
+	Short: "Delete a secret store entry",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return secretDeleteCmdFunc(cmd, args[0])
+	},
+}
+
+var secretListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List secret stores and the keys of their entries",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return secretListCmdFunc(cmd)
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{secretSetCmd, secretDeleteCmd, secretListCmd} {
+		cmd.Flags().String("org", "", "organization ID")
+		cmd.Flags().String("workspace", "", "workspace ID")
+		cmd.Flags().String("host", "", "Set the host URL")
+	}
+
+	secretCmd.AddCommand(secretSetCmd, secretDeleteCmd, secretListCmd)
+}
+
+// secretClient returns an API client and the workspace the command acts on
+func secretClient(cmd *cobra.Command) (*client.Client, int64, error) {
+	host, err := getHost(cmd)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	workspaceID, err := getWorkspaceId(cmd)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	locoToken, err := getLocoToken(cmd)
+	if err != nil {
+		return nil, 0, ErrLoginRequired
+	}
+
+	return client.NewClient(host, locoToken.Token), workspaceID, nil
+}
+
+// parseSecretEntry splits a <store>/<key> argument
+func parseSecretEntry(arg string) (string, string, error) {
+	store, key, ok := strings.Cut(arg, "/")
+	if !ok || store == "" || key == "" {
+		return "", "", fmt.Errorf("invalid secret %q, expected <store>/<key>", arg)
+	}
+	return store, key, nil
+}
+
+func secretSetCmdFunc(cmd *cobra.Command, arg string) error {
+	ctx := context.Background()
+
+	store, key, err := parseSecretEntry(arg)
+	if err != nil {
+		return err
+	}
+
+	value, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return fmt.Errorf("failed to read the secret value: %w", err)
+	}
+	// a value piped from echo or a file usually ends with a newline that isn't part of it
+	value = []byte(strings.TrimSuffix(strings.TrimSuffix(string(value), "\n"), "\r"))
+	if len(value) == 0 {
+		return fmt.Errorf("no secret value given. Pipe it on standard input")
+	}
+
+	apiClient, workspaceID, err := secretClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := apiClient.SetSecret(ctx, workspaceID, store, key, string(value)); err != nil {
+		return fmt.Errorf("failed to set secret: %w", err)
+	}
+
+	s := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(ui.LocoLightGreen).
+		Render(fmt.Sprintf("\n🎉 Secret set. Reference it as secret://%s/%s", store, key))
+	fmt.Println(s)
+	return nil
+}
+
+func secretDeleteCmdFunc(cmd *cobra.Command, arg string) error {
+	ctx := context.Background()
+
+	store, key, err := parseSecretEntry(arg)
+	if err != nil {
+		return err
+	}
+
+	apiClient, workspaceID, err := secretClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := apiClient.DeleteSecret(ctx, workspaceID, store, key); err != nil {
+		return fmt.Errorf("failed to delete secret: %w", err)
+	}
+
+	fmt.Printf("Deleted secret://%s/%s. Applications referencing it fail to deploy until it is set again.\n", store, key)
+	return nil
+}
+
+func secretListCmdFunc(cmd *cobra.Command) error {
+	ctx := context.Background()
+
+	apiClient, workspaceID, err := secretClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	stores, err := apiClient.ListSecretStores(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to list secret stores: %w", err)
+	}
+
+	if len(stores) == 0 {
+		fmt.Println("No secret stores. Create one with 'loco secret set <store>/<key>'.")
+		return nil
+	}
+
+	titleStyle := lipgloss.NewStyle().Foreground(ui.LocoCyan).Bold(true)
+	for _, store := range stores {
+		fmt.Println(titleStyle.Render(store.GetName()))
+		for _, key := range store.GetKeys() {
+			fmt.Printf("  secret://%s/%s\n", store.GetName(), key)
+		}
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"os/signal"
 
 	"connectrpc.com/connect"
 	"github.com/charmbracelet/lipgloss"
@@ -20,6 +21,7 @@ import (
 var statusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Show application status",
+	Long:  "Show an application's status. With --watch, keep it on screen and redraw it as it changes.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return statusCmdFunc(cmd)
 	},
@@ -51,7 +53,15 @@ func statusCmdFunc(cmd *cobra.Command) error {
 		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
 	}
 
-	locoToken, err := getLocoToken()
+	watch, err := cmd.Flags().GetBool("watch")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	if watch && output == "json" {
+		return fmt.Errorf("--watch only supports table output")
+	}
+
+	locoToken, err := getLocoToken(cmd)
 	if err != nil {
 		return ErrLoginRequired
 	}
@@ -81,6 +91,19 @@ func statusCmdFunc(cmd *cobra.Command) error {
 
 	apiClient := client.NewClient(host, locoToken.Token)
 
+	if watch {
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+		defer stop()
+
+		return watchApp(ctx, apiClient, appID, func(ctx context.Context) (string, error) {
+			statusResp, err := apiClient.GetAppStatus(ctx, appID)
+			if err != nil {
+				return "", err
+			}
+			return newStatusModel(appName, statusResp).View(), nil
+		})
+	}
+
 	slog.Debug("retrieving app status", "app_id", appID, "app_name", appName)
 
 	statusResp, err := apiClient.GetAppStatus(ctx, appID)
@@ -105,6 +128,7 @@ func init() {
 	statusCmd.Flags().String("org", "", "organization ID")
 	statusCmd.Flags().String("workspace", "", "workspace ID")
 	statusCmd.Flags().StringP("output", "", "table", "Output format: table | json")
+	statusCmd.Flags().BoolP("watch", "w", false, "Keep watching the status and redraw it as it changes")
 	statusCmd.Flags().String("host", "", "Set the host URL")
 }
 
@@ -152,5 +176,21 @@ func (m statusModel) View() string {
 		labelStyle.Render("URL:"), valueStyle.Render(url),
 	)
 
+	if probe := m.response.GetLatestProbe(); probe != nil {
+		health := fmt.Sprintf("ok, %d ms", probe.GetLatencyMs())
+		if !probe.GetOk() {
+			health = "failing: " + probe.GetError()
+		}
+		content += fmt.Sprintf("\n%s %s", labelStyle.Render("Health:"), valueStyle.Render(health))
+	}
+
+	for _, domain := range m.response.GetDomains() {
+		routing := "serving"
+		if domain.GetMessage() != "" {
+			routing = domain.GetMessage()
+		}
+		content += fmt.Sprintf("\n%s %s", labelStyle.Render("Domain:"), valueStyle.Render(fmt.Sprintf("%s (%s)", domain.GetDomain(), routing)))
+	}
+
 	return titleStyle.Render("Application Status") + "\n" + blockStyle.Render(content)
 }
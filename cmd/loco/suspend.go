@@ -0,0 +1,134 @@
+package loco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"github.com/team-loco/loco/internal/client"
+	"github.com/team-loco/loco/internal/ui"
+)
+
+var suspendCmd = &cobra.Command{
+	Use:   "suspend",
+	Short: "Suspend an application and serve a maintenance page",
+	Long: `Suspend an application. It is scaled to zero and visitors see a maintenance
+page until it is resumed. Domains, environment and deployment history are kept.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return suspendCmdFunc(cmd)
+	},
+}
+
+var resumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resume a suspended application",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return resumeCmdFunc(cmd)
+	},
+}
+
+func init() {
+	suspendCmd.Flags().StringP("app", "a", "", "Application name")
+	suspendCmd.Flags().String("org", "", "organization ID")
+	suspendCmd.Flags().String("workspace", "", "workspace ID")
+	suspendCmd.Flags().StringP("message", "m", "", "Message shown on the maintenance page")
+	suspendCmd.Flags().String("host", "", "Set the host URL")
+
+	resumeCmd.Flags().StringP("app", "a", "", "Application name")
+	resumeCmd.Flags().String("org", "", "organization ID")
+	resumeCmd.Flags().String("workspace", "", "workspace ID")
+	resumeCmd.Flags().String("host", "", "Set the host URL")
+}
+
+func suspendCmdFunc(cmd *cobra.Command) error {
+	ctx := context.Background()
+
+	message, err := cmd.Flags().GetString("message")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	var messagePtr *string
+	if cmd.Flags().Changed("message") {
+		messagePtr = &message
+	}
+
+	apiClient, appID, appName, err := resolveAppForCmd(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	slog.Debug("suspending app", "app_id", appID)
+
+	if err := apiClient.SuspendApp(ctx, appID, messagePtr); err != nil {
+		return fmt.Errorf("failed to suspend app '%s': %w", appName, err)
+	}
+
+	s := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(ui.LocoLightGreen).
+		Render(fmt.Sprintf("\n⏸  %s is suspended. Run `loco resume --app %s` to bring it back.", appName, appName))
+	fmt.Println(s)
+
+	return nil
+}
+
+func resumeCmdFunc(cmd *cobra.Command) error {
+	ctx := context.Background()
+
+	apiClient, appID, appName, err := resolveAppForCmd(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	slog.Debug("resuming app", "app_id", appID)
+
+	if err := apiClient.ResumeApp(ctx, appID); err != nil {
+		return fmt.Errorf("failed to resume app '%s': %w", appName, err)
+	}
+
+	s := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(ui.LocoLightGreen).
+		Render(fmt.Sprintf("\n▶  %s is resuming. Run `loco status --app %s` to follow the rollout.", appName, appName))
+	fmt.Println(s)
+
+	return nil
+}
+
+// resolveAppForCmd reads the host, workspace and app flags and looks the app up by name.
+func resolveAppForCmd(ctx context.Context, cmd *cobra.Command) (*client.Client, int64, string, error) {
+	host, err := getHost(cmd)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	workspaceID, err := getWorkspaceId(cmd)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	appName, err := cmd.Flags().GetString("app")
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("%w: %w", ErrFlagParsing, err)
+	}
+	if appName == "" {
+		return nil, 0, "", fmt.Errorf("app name is required. Use --app flag")
+	}
+
+	locoToken, err := getLocoToken(cmd)
+	if err != nil {
+		return nil, 0, "", ErrLoginRequired
+	}
+
+	apiClient := client.NewClient(host, locoToken.Token)
+
+	app, err := apiClient.GetAppByName(ctx, workspaceID, appName)
+	if err != nil {
+		slog.Debug("failed to get app by name", "error", err)
+		return nil, 0, "", fmt.Errorf("failed to get app '%s': %w", appName, err)
+	}
+
+	return apiClient, app.Id, appName, nil
+}
@@ -14,6 +14,9 @@ import (
 	"github.com/team-loco/loco/internal/client"
 	"github.com/team-loco/loco/internal/config"
 	"github.com/team-loco/loco/internal/keychain"
+	"github.com/team-loco/loco/shared"
+	oAuth "github.com/team-loco/loco/shared/proto/oauth/v1"
+	"github.com/team-loco/loco/shared/proto/oauth/v1/oauthv1connect"
 )
 
 const locoProdHost = "https://loco.deploy-app.com"
@@ -38,7 +41,7 @@ func getHost(cmd *cobra.Command) (string, error) {
 	return locoProdHost, nil
 }
 
-func getLocoToken() (*keychain.UserToken, error) {
+func getLocoToken(cmd *cobra.Command) (*keychain.UserToken, error) {
 	usr, err := user.Current()
 	if err != nil {
 		slog.Debug("failed to get current user", "error", err)
@@ -52,12 +55,49 @@ func getLocoToken() (*keychain.UserToken, error) {
 
 	if locoToken.ExpiresAt.Before(time.Now().Add(5 * time.Minute)) {
 		slog.Debug("token is expired or will expire soon", "expires_at", locoToken.ExpiresAt)
+		if locoToken.RefreshToken != "" {
+			refreshed, err := refreshLocoToken(cmd, usr.Name, locoToken.RefreshToken)
+			if err == nil {
+				return refreshed, nil
+			}
+			slog.Debug("failed to refresh loco token", "error", err)
+		}
 		return nil, fmt.Errorf("token is expired or will expire soon. Please re-login via `loco login`")
 	}
 
 	return locoToken, err
 }
 
+// refreshLocoToken trades a refresh token for a new loco token and stores it in the keychain.
+func refreshLocoToken(cmd *cobra.Command, username string, refreshToken string) (*keychain.UserToken, error) {
+	host, err := getHost(cmd)
+	if err != nil {
+		return nil, err
+	}
+	oAuthClient := oauthv1connect.NewOAuthServiceClient(shared.NewHTTPClient(), host)
+	resp, err := oAuthClient.RefreshToken(cmd.Context(), connect.NewRequest(&oAuth.RefreshTokenRequest{
+		RefreshToken: refreshToken,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("refreshed loco token")
+	return storeLoginToken(username, resp.Msg.LocoToken, resp.Msg.RefreshToken, resp.Msg.ExpiresIn), nil
+}
+
+// storeLoginToken saves a loco token and its refresh token in the keychain, expiring it 10 minutes early.
+func storeLoginToken(username string, token string, refreshToken string, expiresIn int64) *keychain.UserToken {
+	t := keychain.UserToken{
+		Token:        token,
+		ExpiresAt:    time.Now().Add(time.Duration(expiresIn)*time.Second - (10 * time.Minute)),
+		RefreshToken: refreshToken,
+	}
+	if err := keychain.SetLocoToken(username, t); err != nil {
+		slog.Debug("failed to store loco token", "error", err)
+	}
+	return &t
+}
+
 func parseLocoTomlPath(cmd *cobra.Command) (string, error) {
 	configPath, err := cmd.Flags().GetString("config")
 	if err != nil {
@@ -163,7 +203,7 @@ func getOrgId(cmd *cobra.Command) (int64, error) {
 		return 0, err
 	}
 
-	locoToken, err := getLocoToken()
+	locoToken, err := getLocoToken(cmd)
 	if err != nil {
 		return 0, err
 	}
@@ -219,7 +259,7 @@ func getWorkspaceId(cmd *cobra.Command) (int64, error) {
 		return 0, err
 	}
 
-	locoToken, err := getLocoToken()
+	locoToken, err := getLocoToken(cmd)
 	if err != nil {
 		return 0, err
 	}
@@ -3,6 +3,7 @@ package loco
 import (
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
@@ -11,22 +12,39 @@ import (
 )
 
 var validateCmd = &cobra.Command{
-	Use:   "validate",
+	Use:   "validate [path]",
 	Short: "Validate a loco.toml configuration file",
 	Long: `Validate a loco.toml file and catch most configuration errors before deployment.
 
+Validation is entirely offline, so it needs no login and can gate pull requests in CI:
+it exits non-zero when the file is invalid. It checks that:
+  - every key is part of the schema, catching typos
+  - the file converts to the resource spec a deploy sends
+  - CPU and memory are valid quantities within the platform's limits (100m-2000m, 32Mi-4Gi)
+  - ports are in range and don't collide
+  - the hostname is a valid, fully qualified domain name
+
 Note: CPU and memory limits are validated against the Kubernetes resource format.
 See https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/ for details.`,
+	Example: `  loco validate
+  loco validate services/api/loco.toml`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return validateCmdFunc(cmd)
+		return validateCmdFunc(cmd, args)
 	},
 }
 
-func validateCmdFunc(cmd *cobra.Command) error {
+func validateCmdFunc(cmd *cobra.Command, args []string) error {
 	configPath, err := parseLocoTomlPath(cmd)
 	if err != nil {
 		return fmt.Errorf("error reading config flag: %w", err)
 	}
+	if len(args) == 1 {
+		if cmd.Flags().Changed("config") {
+			return fmt.Errorf("%w: pass the path as an argument or with --config, not both", ErrConfigPath)
+		}
+		configPath = args[0]
+	}
 
 	loadedCfg, err := config.Load(configPath)
 	if err != nil {
@@ -34,13 +52,24 @@ func validateCmdFunc(cmd *cobra.Command) error {
 		return fmt.Errorf("failed to load loco.toml: %w", err)
 	}
 
+	if len(loadedCfg.UnknownKeys) > 0 {
+		return fmt.Errorf("invalid configuration: unknown keys %s", strings.Join(loadedCfg.UnknownKeys, ", "))
+	}
+
 	if err := config.Validate(loadedCfg.Config); err != nil {
 		slog.Debug("invalid configuration", "error", err)
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	// convert as deploy does, so a file that validates is one deploy can send
+	config.FillSensibleDefaults(loadedCfg.Config)
+	if _, err := configToResourceSpec(loadedCfg.Config, "v1"); err != nil {
+		slog.Debug("failed to convert config to resource spec", "error", err)
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+
 	style := lipgloss.NewStyle().Foreground(ui.LocoLightGreen).Bold(true)
-	fmt.Printf("\n%s loco.toml is valid!\n\n", style.Render("✓"))
+	fmt.Printf("\n%s %s is valid!\n\n", style.Render("✓"), configPath)
 
 	fmt.Printf("Configuration loaded from: %s\n", loadedCfg.ProjectPath)
 	fmt.Printf("Application name: %s\n", loadedCfg.Config.Metadata.Name)
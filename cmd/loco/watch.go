@@ -0,0 +1,90 @@
+package loco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/team-loco/loco/internal/client"
+	"github.com/team-loco/loco/internal/ui"
+	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
+)
+
+// the bounds of the polling interval when the API can't stream an app's status: polling slows down
+// while nothing changes and speeds back up once something does
+const (
+	watchMinPollInterval = 2 * time.Second
+	watchMaxPollInterval = 30 * time.Second
+)
+
+// clearScreen moves the cursor home and clears the terminal, so each render replaces the last
+const clearScreen = "\033[H\033[2J"
+
+// watchApp redraws the view render returns whenever an app changes, until ctx is done. Changes
+// come from the app's status stream; if the API can't stream, the view is polled instead.
+func watchApp(ctx context.Context, apiClient *client.Client, appID int64, render func(context.Context) (string, error)) error {
+	footerStyle := lipgloss.NewStyle().Foreground(ui.LocoDimGrey).MarginLeft(2)
+
+	last := ""
+	draw := func() (bool, error) {
+		view, err := render(ctx)
+		if err != nil {
+			return false, err
+		}
+		if view == last {
+			return false, nil
+		}
+		last = view
+		fmt.Print(clearScreen)
+		fmt.Println(view)
+		fmt.Println(footerStyle.Render(fmt.Sprintf("Updated %s. Watching for changes, press Ctrl+C to stop.", time.Now().Format(time.TimeOnly))))
+		return true, nil
+	}
+
+	// the stream sends the current status first, so the view is drawn right away
+	err := apiClient.WatchAppStatus(ctx, appID, func(*resourcev1.WatchResourceStatusResponse) error {
+		_, err := draw()
+		return err
+	})
+	if ctx.Err() != nil {
+		return nil
+	}
+	if err != nil && !isWatchUnavailable(err) {
+		return err
+	}
+	slog.Debug("status stream unavailable, polling instead", "app_id", appID, "error", err)
+
+	interval := watchMinPollInterval
+	for {
+		changed, err := draw()
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case err != nil && !isWatchUnavailable(err):
+			return err
+		case changed:
+			interval = watchMinPollInterval
+		default:
+			interval = min(interval*2, watchMaxPollInterval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// isWatchUnavailable reports whether err means the API can't stream right now, or at all, rather
+// than that the watch itself is wrong
+func isWatchUnavailable(err error) bool {
+	switch connect.CodeOf(err) {
+	case connect.CodeUnimplemented, connect.CodeUnavailable, connect.CodeUnknown, connect.CodeDeadlineExceeded:
+		return true
+	}
+	return false
+}
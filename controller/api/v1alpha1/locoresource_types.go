@@ -17,6 +17,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -41,6 +44,35 @@ type ScalersSpec struct {
 	Enabled      bool  `json:"enabled,omitempty"`
 	CPUTarget    int32 `json:"cpuTarget,omitempty"`
 	MemoryTarget int32 `json:"memoryTarget,omitempty"`
+
+	// Triggers are external metric scalers handed to KEDA. When set, the
+	// controller manages a ScaledObject instead of a fixed replica count.
+	Triggers []ScaleTriggerSpec `json:"triggers,omitempty"`
+	// Schedules set replica counts by time of day, through KEDA's cron scaler
+	Schedules []CronScheduleSpec `json:"schedules,omitempty"`
+
+	PollingInterval int32 `json:"pollingInterval,omitempty"` // seconds between trigger checks
+	CooldownPeriod  int32 `json:"cooldownPeriod,omitempty"`  // seconds after the last active trigger before scaling to min
+	// IdleAtZero runs no replicas while every trigger is inactive, e.g. for a consumer of an empty queue
+	IdleAtZero bool `json:"idleAtZero,omitempty"`
+}
+
+// CronScheduleSpec keeps DesiredReplicas running between Start and End
+type CronScheduleSpec struct {
+	Timezone        string `json:"timezone"` // IANA name, e.g. Europe/Berlin
+	Start           string `json:"start"`    // cron expression, e.g. "0 8 * * 1-5"
+	End             string `json:"end"`      // cron expression
+	DesiredReplicas int32  `json:"desiredReplicas"`
+}
+
+// ScaleTriggerSpec describes a single KEDA trigger (e.g. queue depth). Only the types and metadata
+// keys of ValidateScaleTrigger are accepted.
+type ScaleTriggerSpec struct {
+	Type     string            `json:"type"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// LinkedResourceId is the loco QUEUE resource this trigger reads from, if any
+	LinkedResourceId int64 `json:"linkedResourceId,omitempty"`
 }
 
 // HealthCheckSpec describes readiness/liveness checks
@@ -80,9 +112,95 @@ type TracingSpec struct {
 
 // RoutingSpec contains subdomain, path prefix, port, idle timeout
 type RoutingSpec struct {
-	HostName    string `json:"hostName,omitempty"`
-	PathPrefix  string `json:"pathPrefix,omitempty"`
-	IdleTimeout int32  `json:"idleTimeout,omitempty"` // seconds
+	HostName    string         `json:"hostName,omitempty"`
+	PathPrefix  string         `json:"pathPrefix,omitempty"`
+	IdleTimeout int32          `json:"idleTimeout,omitempty"` // seconds
+	ErrorPage   *ErrorPageSpec `json:"errorPage,omitempty"`
+	Protocol    string         `json:"protocol,omitempty"` // http (default), h2c, grpc, websocket
+	// RequestTimeout bounds a whole request/response exchange at the gateway, in seconds
+	RequestTimeout int32 `json:"requestTimeout,omitempty"`
+	// MaxBodySize caps request bodies accepted by the gateway, as a quantity (e.g. 10Mi)
+	MaxBodySize string `json:"maxBodySize,omitempty"`
+	// PlatformDomain is set when HostName is a subdomain the platform serves DNS for, so the app is
+	// not ready until it resolves
+	PlatformDomain bool `json:"platformDomain,omitempty"`
+	// AccessControl keeps the service private; nil makes it public
+	AccessControl *AccessControlSpec `json:"accessControl,omitempty"`
+	// Ports are further ports the service listens on besides its main one, e.g. metrics or an admin API
+	Ports []PortSpec `json:"ports,omitempty"`
+	// Redirects send requests for the resource's other domains elsewhere, e.g. www to the apex
+	Redirects []RedirectSpec `json:"redirects,omitempty"`
+	// TLS tightens the TLS policy for HostName; nil keeps the gateway's defaults
+	TLS *TLSSpec `json:"tls,omitempty"`
+}
+
+// TLSSpec is the TLS policy for the service's hostname. A minimum version or client certificate
+// gives the hostname its own gateway listener, since the gateway applies both per listener.
+type TLSSpec struct {
+	MinVersion string `json:"minVersion,omitempty"` // "1.2" or "1.3"; empty keeps the gateway's minimum
+	HSTSMaxAge int32  `json:"hstsMaxAge,omitempty"` // seconds; 0 sends no Strict-Transport-Security header
+	// ClientCACertificate is a PEM CA bundle; when set, clients must present a certificate it signed
+	ClientCACertificate string `json:"clientCaCertificate,omitempty"`
+}
+
+// PortSpec is a named port of the service. Ports with a path prefix are routed from the service's
+// hostname like the main port; the rest are only reachable inside the cluster.
+type PortSpec struct {
+	Name       string `json:"name"`
+	Port       int32  `json:"port"`
+	PathPrefix string `json:"pathPrefix,omitempty"` // empty keeps the port internal-only
+}
+
+// RedirectSpec answers every request for HostName with a redirect to TargetHostName over https
+type RedirectSpec struct {
+	HostName       string `json:"hostName"`
+	TargetHostName string `json:"targetHostName"`
+	StatusCode     int32  `json:"statusCode,omitempty"`   // 301 (default) or 302
+	PreservePath   bool   `json:"preservePath,omitempty"` // keep the request's path rather than redirecting to /
+}
+
+// AccessControlSpec restricts who can reach the service through the gateway
+type AccessControlSpec struct {
+	AllowedCIDRs []string       `json:"allowedCidrs,omitempty"`
+	BasicAuth    *BasicAuthSpec `json:"basicAuth,omitempty"`
+	OIDC         *OIDCSpec      `json:"oidc,omitempty"`
+}
+
+// BasicAuthSpec lists the users accepted by HTTP basic auth
+type BasicAuthSpec struct {
+	Users []string `json:"users"` // htpasswd lines, "username:{SHA}hash"
+}
+
+// OIDCSpec signs visitors in with an OpenID Connect provider
+type OIDCSpec struct {
+	Issuer       string `json:"issuer"`
+	ClientID     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"`
+	RedirectURL  string `json:"redirectUrl,omitempty"`
+}
+
+// Status conditions reporting whether traffic for the app's hostname reaches it
+const (
+	// ConditionRouteProgrammed is true once the gateway accepted the app's HTTPRoute and is serving it
+	ConditionRouteProgrammed = "RouteProgrammed"
+	// ConditionDNSResolved is true while the app's hostname resolves
+	ConditionDNSResolved = "DNSResolved"
+)
+
+// Backend protocols the gateway can speak to a service
+const (
+	ProtocolHTTP      = "http"
+	ProtocolH2C       = "h2c"
+	ProtocolGRPC      = "grpc"
+	ProtocolWebSocket = "websocket"
+)
+
+// ErrorPageSpec customizes the page served by the gateway when the service is unavailable
+type ErrorPageSpec struct {
+	Disabled bool   `json:"disabled,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Message  string `json:"message,omitempty"`
+	HTML     string `json:"html,omitempty"` // replaces the default page entirely
 }
 
 // ApplicationSpec defines the desired state of Application
@@ -99,6 +217,18 @@ type ApplicationSpec struct {
 	ResourceId  int64  `json:"resourceId,omitempty"` // optional
 	WorkspaceId int64  `json:"workspaceId,omitempty"`
 	Region      string `json:"region,omitempty"`
+	// Plan is the billing plan of the resource's organization; it picks which platform resource
+	// limits apply
+	Plan string `json:"plan,omitempty"`
+
+	// Suspended scales the workload to zero and serves a maintenance page in its place
+	Suspended bool `json:"suspended,omitempty"`
+	// MaintenanceMessage is shown on the maintenance page while suspended
+	MaintenanceMessage string `json:"maintenanceMessage,omitempty"`
+	// Sleeping scales an idle service to zero and routes its requests to the API, which wakes it
+	Sleeping bool `json:"sleeping,omitempty"`
+	// RestartedAt restarts the workload's pods whenever it changes, as `kubectl rollout restart` does
+	RestartedAt string `json:"restartedAt,omitempty"`
 
 	// Type-specific specs (only one populated based on Type)
 	ServiceSpec  *ServiceSpec  `json:"serviceSpec,omitempty"`
@@ -121,12 +251,57 @@ type ServiceSpec struct {
 
 	// Observability configuration (logging, metrics, tracing)
 	Obs *ObsSpec `json:"obs,omitempty"`
+
+	// ScaleToZero runs the service only while it receives requests; nil keeps it always running
+	ScaleToZero *ScaleToZeroSpec `json:"scaleToZero,omitempty"`
+
+	// Scheduling places pods on dedicated node pools and spreads them across nodes; nil runs on any node
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+
+	// Command replaces the image's entrypoint, so one image can run web and worker variants
+	Command []string `json:"command,omitempty"`
+	// Args replaces the image's default arguments
+	Args []string `json:"args,omitempty"`
+	// WorkingDir is the directory the service runs in; empty uses the image's
+	WorkingDir string `json:"workingDir,omitempty"`
+}
+
+// SchedulingSpec places an app's pods, and its hook jobs, on particular nodes
+type SchedulingSpec struct {
+	// NodeSelector is the node labels pods must run on, e.g. kubernetes.io/arch=arm64
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations let pods run on node pools tainted for dedicated use
+	Tolerations []TolerationSpec `json:"tolerations,omitempty"`
+	// Spread spreads the service's pods across the values of node labels, e.g. zones
+	Spread []SpreadConstraintSpec `json:"spread,omitempty"`
+}
+
+// TolerationSpec tolerates a node taint
+type TolerationSpec struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`  // empty tolerates any value
+	Effect string `json:"effect,omitempty"` // empty tolerates every effect
+}
+
+// SpreadConstraintSpec spreads pods across the values of a node label
+type SpreadConstraintSpec struct {
+	TopologyKey string `json:"topologyKey"`
+	MaxSkew     int32  `json:"maxSkew,omitempty"`  // defaults to 1
+	Required    bool   `json:"required,omitempty"` // leave pods pending rather than skew further
+}
+
+// ScaleToZeroSpec hands the replica count to the KEDA HTTP add-on, whose interceptor holds the
+// first request after an idle period until a replica is ready
+type ScaleToZeroSpec struct {
+	ScaledownPeriod   int32 `json:"scaledownPeriod,omitempty"`   // seconds without requests before scaling to zero
+	TargetConcurrency int32 `json:"targetConcurrency,omitempty"` // in-flight requests per replica before scaling out
 }
 
 // ServiceDeploymentSpec contains service deployment-specific configuration
 // Includes deployment-time resource overrides that take precedence over ResourcesSpec
 type ServiceDeploymentSpec struct {
 	Image          string `json:"image,omitempty"`
+	ImageDigest    string `json:"imageDigest,omitempty"` // digest Image resolved to when deployed; pods run the image by digest when set
 	Port           int32  `json:"port,omitempty"`
 	DockerfilePath string `json:"dockerfilePath,omitempty"`
 	BuildType      string `json:"buildType,omitempty"` // docker, buildpack, etc
@@ -140,8 +315,105 @@ type ServiceDeploymentSpec struct {
 
 	HealthCheck *HealthCheckSpec  `json:"healthCheck,omitempty"`
 	Env         map[string]string `json:"env,omitempty"`
+
+	// Hooks run as Jobs before and after the rollout, in order within each phase
+	Hooks []HookSpec `json:"hooks,omitempty"`
+
+	// InitContainers run in order to completion in each pod before the service starts
+	InitContainers []ContainerSpec `json:"initContainers,omitempty"`
+	// Sidecars run alongside the service in each pod, started before it and its init containers
+	Sidecars []ContainerSpec `json:"sidecars,omitempty"`
+
+	// SignaturePolicies the image must satisfy, each with a cosign signature it accepts, before pods roll out
+	SignaturePolicies []SignaturePolicySpec `json:"signaturePolicies,omitempty"`
+}
+
+// SignaturePolicySpec accepts cosign signatures made with one of its keys or by one of its identities
+type SignaturePolicySpec struct {
+	PublicKeys []string             `json:"publicKeys,omitempty"` // PEM-encoded
+	Identities []SignerIdentitySpec `json:"identities,omitempty"` // keyless signers, checked against the Fulcio and Rekor trust root
 }
 
+// SignerIdentitySpec is a keyless signer: the OIDC issuer that vouched for it and its email or URI
+type SignerIdentitySpec struct {
+	Issuer        string `json:"issuer"`
+	Subject       string `json:"subject"`
+	SubjectRegexp bool   `json:"subjectRegexp,omitempty"` // Subject is a regular expression matched against the whole subject
+}
+
+const (
+	HookPhasePreDeploy  = "PreDeploy"
+	HookPhasePostDeploy = "PostDeploy"
+
+	// HookLabel is set on hook Jobs and their pods to the hook's name
+	HookLabel = "loco.dev/hook"
+)
+
+// RequestIDAnnotation is set by the API to the ID of the request that last changed an Application,
+// so reconciles can be traced back to it
+const RequestIDAnnotation = "loco.dev/request-id"
+
+// HookSpec is a command run to completion as a Job around a rollout, with the deployment's env
+type HookSpec struct {
+	Name           string   `json:"name"`
+	Phase          string   `json:"phase"` // PreDeploy | PostDeploy
+	Command        []string `json:"command"`
+	Image          string   `json:"image,omitempty"`          // defaults to the deployment's image
+	TimeoutSeconds int32    `json:"timeoutSeconds,omitempty"` // defaults to 600
+}
+
+// ContainerSpec is an extra container in the service's pods, e.g. a migration or a proxy
+type ContainerSpec struct {
+	Name       string            `json:"name"`
+	Image      string            `json:"image,omitempty"` // defaults to the deployment's image
+	Command    []string          `json:"command,omitempty"`
+	Args       []string          `json:"args,omitempty"`
+	Env        map[string]string `json:"env,omitempty"`
+	InheritEnv bool              `json:"inheritEnv,omitempty"` // also gets the deployment's env, under its own
+	CPU        string            `json:"cpu,omitempty"`        // defaults to 100m
+	Memory     string            `json:"memory,omitempty"`     // defaults to 64Mi
+}
+
+// PinnedImage returns the image pods run: by ImageDigest when set, dropping Image's tag, else Image
+func (s *ServiceDeploymentSpec) PinnedImage() string {
+	if s.ImageDigest == "" {
+		return s.Image
+	}
+	name, _, _ := strings.Cut(s.Image, "@")
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+	return name + "@" + s.ImageDigest
+}
+
+// SecretRefPrefix marks an env var value that references an entry of a workspace secret store rather
+// than holding the value itself, as in secret://payments/api-key. The controller hands such values
+// to pods through a secretKeyRef, so the plaintext never appears in the spec.
+const SecretRefPrefix = "secret://"
+
+// ParseSecretRef splits an env var value of the form secret://<store>/<key>. ok is false for plain
+// values; malformed references are reported by Validate.
+func ParseSecretRef(value string) (store, key string, ok bool) {
+	ref, found := strings.CutPrefix(value, SecretRefPrefix)
+	if !found {
+		return "", "", false
+	}
+	store, key, _ = strings.Cut(ref, "/")
+	return store, key, true
+}
+
+// SecretStoreName is the name of the Secret in the loco namespace holding the entries of a workspace's
+// secret store.
+func SecretStoreName(workspaceID int64, store string) string {
+	return fmt.Sprintf("secrets-%d-%s", workspaceID, store)
+}
+
+// Labels of a secret store's Secret, which the API sets so a workspace's stores can be listed.
+const (
+	SecretStoreWorkspaceLabel = "loco.dev/workspace"
+	SecretStoreLabel          = "loco.dev/secret-store"
+)
+
 // DatabaseSpec is a placeholder for future DATABASE type resources
 type DatabaseSpec struct {
 	// TODO: Add when implementing database support
@@ -1,16 +1,34 @@
 package v1alpha1
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"net"
 	"regexp"
+	"slices"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
+// maxErrorPageBytes bounds custom error pages, which are stored inline in the gateway config.
+const maxErrorPageBytes = 32 * 1024
+
+// maxClientCABytes bounds client CA bundles, which are stored in a ConfigMap for the gateway.
+const maxClientCABytes = 64 * 1024
+
+// maxBodySizeBytes is the largest request body the gateway can be asked to buffer.
+const maxBodySizeBytes = 1024 * 1024 * 1024
+
 var (
 	dockerImagePattern = regexp.MustCompile(`^([a-z0-9\-._]+(/[a-z0-9\-._]+)*)(:[a-z0-9\-._]+|@sha256:[a-f0-9]{64})?$`)
 	envVarNamePattern  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	imageDigestPattern = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+	hookNamePattern    = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,30}[a-z0-9])?$`)
+	secretStorePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,30}[a-z0-9])?$`)
+	secretKeyPattern   = regexp.MustCompile(`^[-._a-zA-Z0-9]{1,253}$`)
 )
 
 // ValidateApplicationSpec validates the entire ApplicationSpec
@@ -80,6 +98,15 @@ func validateServiceSpec(spec *ServiceSpec) error {
 		if err := validateRoutingSpec(spec.Routing); err != nil {
 			return fmt.Errorf("invalid routing: %w", err)
 		}
+		if err := validatePortSpecs(spec.Routing, spec.Deployment.Port, spec.ScaleToZero != nil); err != nil {
+			return fmt.Errorf("invalid routing: %w", err)
+		}
+	}
+
+	if spec.ScaleToZero != nil {
+		if err := validateScaleToZeroSpec(spec.ScaleToZero, spec.Resources); err != nil {
+			return fmt.Errorf("invalid scaleToZero: %w", err)
+		}
 	}
 
 	return nil
@@ -101,6 +128,9 @@ func validateServiceDeploymentSpec(spec *ServiceDeploymentSpec) error {
 	if !strings.Contains(spec.Image, ":") && !strings.Contains(spec.Image, "@") {
 		return fmt.Errorf("image %q must include a tag (e.g., :v1.0) or digest (e.g., @sha256:...)", spec.Image)
 	}
+	if spec.ImageDigest != "" && !imageDigestPattern.MatchString(spec.ImageDigest) {
+		return fmt.Errorf("image digest invalid: %q (must be sha256:<64 hex digits>)", spec.ImageDigest)
+	}
 
 	// Port validation (required)
 	if spec.Port < 1024 || spec.Port > 65535 {
@@ -125,8 +155,135 @@ func validateServiceDeploymentSpec(spec *ServiceDeploymentSpec) error {
 		if value == "" {
 			return fmt.Errorf("environment variable %q has empty value", name)
 		}
+		if err := ValidateSecretRef(value); err != nil {
+			return fmt.Errorf("environment variable %q: %w", name, err)
+		}
+	}
+
+	if err := validateHookSpecs(spec.Hooks, spec.Image); err != nil {
+		return err
+	}
+
+	if err := ValidateContainerSpecs(spec.InitContainers, spec.Sidecars); err != nil {
+		return err
+	}
+
+	if err := validateSignaturePolicies(spec.SignaturePolicies); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSignaturePolicies validates the image signature policies (optional)
+func validateSignaturePolicies(policies []SignaturePolicySpec) error {
+	for i, policy := range policies {
+		if len(policy.PublicKeys) == 0 && len(policy.Identities) == 0 {
+			return fmt.Errorf("signature policy %d must have at least one public key or identity", i)
+		}
+		for _, key := range policy.PublicKeys {
+			if !strings.Contains(key, "-----BEGIN PUBLIC KEY-----") {
+				return fmt.Errorf("signature policy %d has a public key that is not PEM-encoded", i)
+			}
+		}
+		for _, identity := range policy.Identities {
+			if identity.Issuer == "" || identity.Subject == "" {
+				return fmt.Errorf("signature policy %d has an identity without issuer or subject", i)
+			}
+		}
+	}
+	return nil
+}
+
+// validateHookSpecs validates the deployment hooks (optional)
+func validateHookSpecs(hooks []HookSpec, image string) error {
+	if len(hooks) > 10 {
+		return fmt.Errorf("too many hooks: %d (max 10)", len(hooks))
+	}
+	seen := make(map[string]bool, len(hooks))
+	for _, hook := range hooks {
+		if !hookNamePattern.MatchString(hook.Name) {
+			return fmt.Errorf("invalid hook name %q (must be 1-32 lowercase letters, digits or dashes)", hook.Name)
+		}
+		if seen[hook.Name] {
+			return fmt.Errorf("hook %q is declared twice", hook.Name)
+		}
+		seen[hook.Name] = true
+		if hook.Phase != HookPhasePreDeploy && hook.Phase != HookPhasePostDeploy {
+			return fmt.Errorf("hook %q has invalid phase %q (must be %s or %s)", hook.Name, hook.Phase, HookPhasePreDeploy, HookPhasePostDeploy)
+		}
+		if len(hook.Command) == 0 {
+			return fmt.Errorf("hook %q must have a command", hook.Name)
+		}
+		if hook.Image == "" && image == "" {
+			return fmt.Errorf("hook %q needs an image when the deployment has none", hook.Name)
+		}
+		if hook.Image != "" && !dockerImagePattern.MatchString(hook.Image) {
+			return fmt.Errorf("hook %q has invalid image format: %s", hook.Name, hook.Image)
+		}
+		if hook.TimeoutSeconds < 0 || hook.TimeoutSeconds > 3600 {
+			return fmt.Errorf("hook %q timeout must be between 0 and 3600 seconds, got %d", hook.Name, hook.TimeoutSeconds)
+		}
+	}
+	return nil
+}
+
+// ValidateContainerSpecs validates the init containers and sidecars (optional)
+func ValidateContainerSpecs(initContainers, sidecars []ContainerSpec) error {
+	if len(initContainers) > 5 {
+		return fmt.Errorf("too many init containers: %d (max 5)", len(initContainers))
+	}
+	if len(sidecars) > 5 {
+		return fmt.Errorf("too many sidecars: %d (max 5)", len(sidecars))
+	}
+	seen := make(map[string]bool, len(initContainers)+len(sidecars))
+	for _, container := range append(slices.Clone(initContainers), sidecars...) {
+		// the service's own container is named resource-<id>
+		if !hookNamePattern.MatchString(container.Name) || strings.HasPrefix(container.Name, "resource-") {
+			return fmt.Errorf("invalid container name %q (must be 1-32 lowercase letters, digits or dashes, not starting with resource-)", container.Name)
+		}
+		if seen[container.Name] {
+			return fmt.Errorf("container %q is declared twice", container.Name)
+		}
+		seen[container.Name] = true
+		if container.Image != "" && !dockerImagePattern.MatchString(container.Image) {
+			return fmt.Errorf("container %q has invalid image format: %s", container.Name, container.Image)
+		}
+		if len(container.Env) > 100 {
+			return fmt.Errorf("container %q has too many environment variables: %d (max 100)", container.Name, len(container.Env))
+		}
+		for name, value := range container.Env {
+			if !envVarNamePattern.MatchString(name) {
+				return fmt.Errorf("container %q has invalid environment variable name %q", container.Name, name)
+			}
+			if err := ValidateSecretRef(value); err != nil {
+				return fmt.Errorf("container %q environment variable %q: %w", container.Name, name, err)
+			}
+		}
+		if container.CPU != "" {
+			if err := validateCPUQuantity(container.CPU); err != nil {
+				return fmt.Errorf("container %q: %w", container.Name, err)
+			}
+		}
+		if container.Memory != "" {
+			if err := validateMemoryQuantity(container.Memory); err != nil {
+				return fmt.Errorf("container %q: %w", container.Name, err)
+			}
+		}
 	}
+	return nil
+}
 
+// ValidateSecretRef checks an env var value that references a secret store entry is well-formed.
+// Plain values are always valid.
+func ValidateSecretRef(value string) error {
+	store, key, ok := ParseSecretRef(value)
+	if !ok {
+		return nil
+	}
+	if !secretStorePattern.MatchString(store) || !secretKeyPattern.MatchString(key) {
+		return fmt.Errorf("invalid secret reference %q (must be %s<store>/<key>)", value, SecretRefPrefix)
+	}
 	return nil
 }
 
@@ -270,6 +427,88 @@ func validateScalersSpec(spec *ScalersSpec) error {
 		return fmt.Errorf("memoryTarget must be between 1 and 100, got %d", spec.MemoryTarget)
 	}
 
+	for i, trigger := range spec.Triggers {
+		if err := ValidateScaleTrigger(trigger); err != nil {
+			return fmt.Errorf("triggers[%d]: %w", i, err)
+		}
+	}
+
+	for i, schedule := range spec.Schedules {
+		if schedule.Timezone == "" || schedule.Start == "" || schedule.End == "" {
+			return fmt.Errorf("schedules[%d] needs a timezone, start and end", i)
+		}
+		if len(strings.Fields(schedule.Start)) != 5 || len(strings.Fields(schedule.End)) != 5 {
+			return fmt.Errorf("schedules[%d].start and end must be cron expressions with five fields", i)
+		}
+		if schedule.DesiredReplicas < 1 || schedule.DesiredReplicas > 10 {
+			return fmt.Errorf("schedules[%d].desiredReplicas must be between 1 and 10, got %d", i, schedule.DesiredReplicas)
+		}
+	}
+
+	if spec.IdleAtZero && (spec.CPUTarget > 0 || spec.MemoryTarget > 0) {
+		return fmt.Errorf("idleAtZero cannot be combined with cpu or memory targets, which are never idle")
+	}
+
+	if spec.PollingInterval < 0 || spec.CooldownPeriod < 0 {
+		return fmt.Errorf("pollingInterval and cooldownPeriod cannot be negative")
+	}
+
+	return nil
+}
+
+// scaleTriggerType is a KEDA scaler tenants may use.
+type scaleTriggerType struct {
+	// keys are the metadata keys a tenant may set. KEDA's operator connects to what a trigger names
+	// from inside the cluster, so keys naming hosts, URLs, credentials or the environment of the
+	// workload are never among them.
+	keys []string
+	// queue triggers read a linked QUEUE resource: loco sets their queueName, and KEDA reaches the
+	// broker through QueueTriggerAuthentication.
+	queue bool
+}
+
+// scaleTriggerTypes are the KEDA scalers the platform supports.
+var scaleTriggerTypes = map[string]scaleTriggerType{
+	"kubernetes-workload": {keys: []string{"podSelector", "value", "activationValue"}},
+	"rabbitmq":            {keys: []string{"mode", "value", "activationValue"}, queue: true},
+}
+
+// QueueTriggerAuthentication is the KEDA ClusterTriggerAuthentication, installed with the platform,
+// holding the address and credentials of the broker behind QUEUE resources.
+const QueueTriggerAuthentication = "loco-queue-broker"
+
+// QueueNameKey is the metadata key loco sets to the name of a queue trigger's linked queue.
+const QueueNameKey = "queueName"
+
+// IsQueueTrigger reports whether a trigger of the given type reads a linked QUEUE resource.
+func IsQueueTrigger(triggerType string) bool {
+	return scaleTriggerTypes[triggerType].queue
+}
+
+// ValidateScaleTrigger checks that a trigger is of a supported type and sets only the metadata that
+// type allows. A queue trigger must be linked to a queue, and only it may carry a queueName.
+func ValidateScaleTrigger(trigger ScaleTriggerSpec) error {
+	if trigger.Type == "" {
+		return fmt.Errorf("type is required")
+	}
+	triggerType, ok := scaleTriggerTypes[trigger.Type]
+	if !ok {
+		return fmt.Errorf("unsupported trigger type %q", trigger.Type)
+	}
+	if triggerType.queue && trigger.LinkedResourceId == 0 {
+		return fmt.Errorf("%s triggers must be linked to a queue resource", trigger.Type)
+	}
+	if !triggerType.queue && trigger.LinkedResourceId != 0 {
+		return fmt.Errorf("%s triggers cannot be linked to a queue resource", trigger.Type)
+	}
+	for key := range trigger.Metadata {
+		if triggerType.queue && key == QueueNameKey {
+			continue
+		}
+		if !slices.Contains(triggerType.keys, key) {
+			return fmt.Errorf("metadata key %q is not allowed for %s triggers", key, trigger.Type)
+		}
+	}
 	return nil
 }
 
@@ -302,6 +541,21 @@ func validateObsSpec(spec *ObsSpec) error {
 	return nil
 }
 
+// validateScaleToZeroSpec validates the ScaleToZeroSpec. KEDA triggers and schedules scale on
+// their own, so they cannot be combined with scaling on requests.
+func validateScaleToZeroSpec(spec *ScaleToZeroSpec, resources *ResourcesSpec) error {
+	if spec.ScaledownPeriod < 0 {
+		return fmt.Errorf("scaleToZero.scaledownPeriod cannot be negative")
+	}
+	if spec.TargetConcurrency < 0 {
+		return fmt.Errorf("scaleToZero.targetConcurrency cannot be negative")
+	}
+	if resources != nil && resources.Scalers.Enabled && (len(resources.Scalers.Triggers) > 0 || len(resources.Scalers.Schedules) > 0) {
+		return fmt.Errorf("scaleToZero cannot be combined with scaler triggers or schedules")
+	}
+	return nil
+}
+
 // validateRoutingSpec validates the RoutingSpec
 func validateRoutingSpec(spec *RoutingSpec) error {
 	if spec == nil {
@@ -320,5 +574,205 @@ func validateRoutingSpec(spec *RoutingSpec) error {
 		return fmt.Errorf("routing.idleTimeout cannot be negative")
 	}
 
+	if spec.RequestTimeout < 0 {
+		return fmt.Errorf("routing.requestTimeout cannot be negative")
+	}
+
+	if spec.MaxBodySize != "" {
+		qty, err := resource.ParseQuantity(spec.MaxBodySize)
+		if err != nil {
+			return fmt.Errorf("invalid routing.maxBodySize format: %s", spec.MaxBodySize)
+		}
+		maxBody := resource.NewQuantity(maxBodySizeBytes, resource.BinarySI)
+		if qty.Sign() <= 0 || qty.Cmp(*maxBody) > 0 {
+			return fmt.Errorf("routing.maxBodySize must be between 1 and %s", maxBody.String())
+		}
+	}
+
+	if err := validateAccessControlSpec(spec.AccessControl); err != nil {
+		return err
+	}
+
+	switch spec.Protocol {
+	case "", ProtocolHTTP, ProtocolH2C, ProtocolGRPC, ProtocolWebSocket:
+	default:
+		return fmt.Errorf("routing.protocol must be one of http, h2c, grpc, websocket")
+	}
+
+	if spec.ErrorPage != nil && len(spec.ErrorPage.HTML) > maxErrorPageBytes {
+		return fmt.Errorf("routing.errorPage.html must be at most %d bytes", maxErrorPageBytes)
+	}
+
+	if err := validateRedirectSpecs(spec); err != nil {
+		return err
+	}
+
+	if spec.TLS != nil {
+		if err := spec.TLS.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the TLS policy of the service's hostname
+func (spec *TLSSpec) Validate() error {
+	switch spec.MinVersion {
+	case "", "1.2", "1.3":
+	default:
+		return fmt.Errorf("routing.tls.minVersion must be 1.2 or 1.3, got %q", spec.MinVersion)
+	}
+	if spec.HSTSMaxAge < 0 {
+		return fmt.Errorf("routing.tls.hstsMaxAge cannot be negative")
+	}
+	if spec.ClientCACertificate == "" {
+		return nil
+	}
+	if len(spec.ClientCACertificate) > maxClientCABytes {
+		return fmt.Errorf("routing.tls.clientCaCertificate must be at most %d bytes", maxClientCABytes)
+	}
+	rest := []byte(spec.ClientCACertificate)
+	certs := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("routing.tls.clientCaCertificate must only hold certificates, found %s", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("invalid routing.tls.clientCaCertificate: %w", err)
+		}
+		certs++
+	}
+	if certs == 0 {
+		return fmt.Errorf("routing.tls.clientCaCertificate must be a PEM encoded certificate")
+	}
+	return nil
+}
+
+// validateRedirectSpecs validates the redirects of the resource's other domains. The routed
+// hostname cannot redirect, or the service would be unreachable.
+func validateRedirectSpecs(spec *RoutingSpec) error {
+	if len(spec.Redirects) > 20 {
+		return fmt.Errorf("too many routing.redirects: %d (max 20)", len(spec.Redirects))
+	}
+	hostNames := make(map[string]bool, len(spec.Redirects))
+	for _, redirect := range spec.Redirects {
+		if redirect.HostName == "" || redirect.TargetHostName == "" {
+			return fmt.Errorf("routing.redirects entries must set hostName and targetHostName")
+		}
+		if redirect.HostName == spec.HostName {
+			return fmt.Errorf("routing.redirects %s: the routed hostname cannot redirect", redirect.HostName)
+		}
+		if redirect.TargetHostName == redirect.HostName {
+			return fmt.Errorf("routing.redirects %s: cannot redirect to itself", redirect.HostName)
+		}
+		if hostNames[redirect.HostName] {
+			return fmt.Errorf("routing.redirects %s: redirected more than once", redirect.HostName)
+		}
+		hostNames[redirect.HostName] = true
+		switch redirect.StatusCode {
+		case 0, 301, 302:
+		default:
+			return fmt.Errorf("routing.redirects %s: statusCode must be 301 or 302", redirect.HostName)
+		}
+	}
+	return nil
+}
+
+// validatePortSpecs validates the service's further ports against each other and its main port.
+// The KEDA interceptor only forwards to the main port, so further ports cannot be routed while the
+// service scales to zero.
+func validatePortSpecs(spec *RoutingSpec, mainPort int32, scaleToZero bool) error {
+	if len(spec.Ports) > 10 {
+		return fmt.Errorf("too many routing.ports: %d (max 10)", len(spec.Ports))
+	}
+	mainPath := spec.PathPrefix
+	if mainPath == "" {
+		mainPath = "/"
+	}
+	names := make(map[string]bool, len(spec.Ports))
+	ports := map[int32]bool{mainPort: true}
+	paths := map[string]bool{mainPath: true}
+	for _, port := range spec.Ports {
+		if errs := validation.IsValidPortName(port.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid routing.ports name %q: %s", port.Name, strings.Join(errs, "; "))
+		}
+		// the main port is named after its protocol
+		if slices.Contains([]string{"http", "http2", "grpc"}, port.Name) {
+			return fmt.Errorf("routing.ports name %q is reserved for the main port", port.Name)
+		}
+		if names[port.Name] {
+			return fmt.Errorf("routing.ports name %q is used more than once", port.Name)
+		}
+		names[port.Name] = true
+		if port.Port < 1 || port.Port > 65535 {
+			return fmt.Errorf("routing.ports %s: port must be between 1 and 65535, got %d", port.Name, port.Port)
+		}
+		if ports[port.Port] {
+			return fmt.Errorf("routing.ports %s: port %d is already in use", port.Name, port.Port)
+		}
+		ports[port.Port] = true
+		if port.PathPrefix == "" {
+			continue
+		}
+		if !strings.HasPrefix(port.PathPrefix, "/") {
+			return fmt.Errorf("routing.ports %s: pathPrefix must start with '/'", port.Name)
+		}
+		if paths[port.PathPrefix] {
+			return fmt.Errorf("routing.ports %s: pathPrefix %s is already routed", port.Name, port.PathPrefix)
+		}
+		paths[port.PathPrefix] = true
+		if scaleToZero {
+			return fmt.Errorf("routing.ports %s: ports other than the main one cannot be routed with scaleToZero", port.Name)
+		}
+	}
+	return nil
+}
+
+// validateAccessControlSpec validates the AccessControlSpec (optional)
+func validateAccessControlSpec(spec *AccessControlSpec) error {
+	if spec == nil {
+		return nil
+	}
+
+	for _, cidr := range spec.AllowedCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("invalid routing.accessControl.allowedCidrs entry: %s", cidr)
+		}
+	}
+
+	if spec.BasicAuth != nil && spec.OIDC != nil {
+		return fmt.Errorf("routing.accessControl cannot use both basicAuth and oidc")
+	}
+
+	if spec.BasicAuth != nil {
+		if len(spec.BasicAuth.Users) == 0 {
+			return fmt.Errorf("routing.accessControl.basicAuth must have at least one user")
+		}
+		for _, user := range spec.BasicAuth.Users {
+			if !strings.Contains(user, ":{SHA}") {
+				return fmt.Errorf("routing.accessControl.basicAuth users must be SHA htpasswd entries")
+			}
+		}
+	}
+
+	if spec.OIDC != nil {
+		if !strings.HasPrefix(spec.OIDC.Issuer, "https://") {
+			return fmt.Errorf("routing.accessControl.oidc.issuer must be an https URL")
+		}
+		if spec.OIDC.ClientID == "" || spec.OIDC.ClientSecret == "" {
+			return fmt.Errorf("routing.accessControl.oidc requires clientId and clientSecret")
+		}
+	}
+
+	if len(spec.AllowedCIDRs) == 0 && spec.BasicAuth == nil && spec.OIDC == nil {
+		return fmt.Errorf("routing.accessControl must set allowedCidrs, basicAuth or oidc")
+	}
+
 	return nil
 }
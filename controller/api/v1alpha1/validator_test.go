@@ -0,0 +1,91 @@
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateScaleTrigger(t *testing.T) {
+	tests := []struct {
+		name    string
+		trigger ScaleTriggerSpec
+		wantErr bool
+	}{
+		{"workload", ScaleTriggerSpec{Type: "kubernetes-workload", Metadata: map[string]string{"podSelector": "app=worker", "value": "2"}}, false},
+		{"linked queue", ScaleTriggerSpec{Type: "rabbitmq", Metadata: map[string]string{"queueName": "jobs", "mode": "QueueLength", "value": "20"}, LinkedResourceId: 7}, false},
+		{"no type", ScaleTriggerSpec{Metadata: map[string]string{"value": "2"}}, true},
+		{"unsupported type", ScaleTriggerSpec{Type: "prometheus", Metadata: map[string]string{"serverAddress": "http://10.0.0.1:9090", "query": "up"}}, true},
+		{"postgresql", ScaleTriggerSpec{Type: "postgresql", Metadata: map[string]string{"host": "db.internal"}}, true},
+		{"host", ScaleTriggerSpec{Type: "rabbitmq", Metadata: map[string]string{"host": "amqp://10.0.0.1"}, LinkedResourceId: 7}, true},
+		{"from env", ScaleTriggerSpec{Type: "rabbitmq", Metadata: map[string]string{"hostFromEnv": "BROKER_URL"}, LinkedResourceId: 7}, true},
+		{"authentication ref", ScaleTriggerSpec{Type: "rabbitmq", Metadata: map[string]string{"authenticationRef": "other"}, LinkedResourceId: 7}, true},
+		{"key of another type", ScaleTriggerSpec{Type: "kubernetes-workload", Metadata: map[string]string{"mode": "QueueLength"}}, true},
+		{"queue without a queue", ScaleTriggerSpec{Type: "rabbitmq", Metadata: map[string]string{"value": "20"}}, true},
+		{"queue name off a queue", ScaleTriggerSpec{Type: "kubernetes-workload", Metadata: map[string]string{"queueName": "jobs"}}, true},
+		{"workload linked to a queue", ScaleTriggerSpec{Type: "kubernetes-workload", Metadata: map[string]string{"podSelector": "app=worker"}, LinkedResourceId: 7}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateScaleTrigger(tt.trigger)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseSecretRef(t *testing.T) {
+	tests := []struct {
+		value     string
+		wantStore string
+		wantKey   string
+		wantOK    bool
+	}{
+		{"secret://payments/api-key", "payments", "api-key", true},
+		{"secret://payments/webhook.secret", "payments", "webhook.secret", true},
+		{"secret://payments", "payments", "", true},
+		{"secret://", "", "", true},
+		{"secret://payments/nested/key", "payments", "nested/key", true},
+		{"plain value", "", "", false},
+		{"https://payments/api-key", "", "", false},
+		{"", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			store, key, ok := ParseSecretRef(tt.value)
+			if store != tt.wantStore || key != tt.wantKey || ok != tt.wantOK {
+				t.Errorf("expected (%q, %q, %v), got (%q, %q, %v)", tt.wantStore, tt.wantKey, tt.wantOK, store, key, ok)
+			}
+		})
+	}
+}
+
+func TestValidateSecretRef(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"secret://payments/api-key", false},
+		{"secret://payments/WEBHOOK_secret.v2", false},
+		{"secret://a/b", false},
+		{"plain value", false},
+		{"", false},
+		{"secret://payments", true},
+		{"secret://payments/", true},
+		{"secret:///api-key", true},
+		{"secret://Payments/api-key", true},
+		{"secret://-payments/api-key", true},
+		{"secret://payments-/api-key", true},
+		{"secret://" + strings.Repeat("a", 33) + "/api-key", true},
+		{"secret://payments/nested/key", true},
+		{"secret://payments/" + strings.Repeat("a", 254), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			err := ValidateSecretRef(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -19,20 +19,26 @@ package main
 import (
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"golang.org/x/time/rate"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -60,6 +66,16 @@ func main() {
 	var metricsCertPath, metricsCertName, metricsCertKey string
 	var webhookCertPath, webhookCertName, webhookCertKey string
 	var enableLeaderElection bool
+	var leaderElectionNamespace string
+	var leaseDuration, renewDeadline, retryPeriod time.Duration
+	var releaseOnCancel bool
+	var maxConcurrentReconciles int
+	var resyncPeriod time.Duration
+	var rateLimiterBaseDelay, rateLimiterMaxDelay time.Duration
+	var rateLimiterQPS float64
+	var rateLimiterBurst int
+	var kubeAPIQPS float64
+	var kubeAPIBurst int
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
@@ -67,9 +83,37 @@ func main() {
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
-	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
+	flag.BoolVar(&enableLeaderElection, "leader-elect", envBool("LEADER_ELECT", false),
 		"Enable leader election for controller manager. "+
-			"Enabling this will ensure there is only one active controller manager.")
+			"Enabling this will ensure there is only one active controller manager. Env: LEADER_ELECT.")
+	flag.StringVar(&leaderElectionNamespace, "leader-elect-namespace", os.Getenv("LEADER_ELECT_NAMESPACE"),
+		"The namespace of the leader election lease. Defaults to the namespace the manager runs in. Env: LEADER_ELECT_NAMESPACE.")
+	flag.DurationVar(&leaseDuration, "leader-elect-lease-duration", envDuration("LEADER_ELECT_LEASE_DURATION", 15*time.Second),
+		"How long standby replicas wait before taking over a lease that was not renewed. Env: LEADER_ELECT_LEASE_DURATION.")
+	flag.DurationVar(&renewDeadline, "leader-elect-renew-deadline", envDuration("LEADER_ELECT_RENEW_DEADLINE", 10*time.Second),
+		"How long the leader keeps retrying to renew its lease before giving up leadership. Env: LEADER_ELECT_RENEW_DEADLINE.")
+	flag.DurationVar(&retryPeriod, "leader-elect-retry-period", envDuration("LEADER_ELECT_RETRY_PERIOD", 2*time.Second),
+		"How often replicas try to acquire or renew the lease. Env: LEADER_ELECT_RETRY_PERIOD.")
+	flag.BoolVar(&releaseOnCancel, "leader-elect-release-on-cancel", envBool("LEADER_ELECT_RELEASE_ON_CANCEL", true),
+		"If set, the leader releases its lease when it shuts down, so a standby takes over without waiting "+
+			"for the lease to expire. Env: LEADER_ELECT_RELEASE_ON_CANCEL.")
+	flag.IntVar(&maxConcurrentReconciles, "max-concurrent-reconciles", envInt("MAX_CONCURRENT_RECONCILES", 1),
+		"How many Applications are reconciled at once. Env: MAX_CONCURRENT_RECONCILES.")
+	flag.DurationVar(&resyncPeriod, "resync-period", envDuration("RESYNC_PERIOD", 30*time.Minute),
+		"How often Applications are reconciled without a change, to repair anything a missed event left behind; "+
+			"0 disables. Env: RESYNC_PERIOD.")
+	flag.DurationVar(&rateLimiterBaseDelay, "rate-limiter-base-delay", envDuration("RATE_LIMITER_BASE_DELAY", 5*time.Millisecond),
+		"The delay before an Application that failed to reconcile is retried, doubled on every failure. Env: RATE_LIMITER_BASE_DELAY.")
+	flag.DurationVar(&rateLimiterMaxDelay, "rate-limiter-max-delay", envDuration("RATE_LIMITER_MAX_DELAY", 1000*time.Second),
+		"The longest delay before a failing Application is retried. Env: RATE_LIMITER_MAX_DELAY.")
+	flag.Float64Var(&rateLimiterQPS, "rate-limiter-qps", envFloat("RATE_LIMITER_QPS", 10),
+		"How many Applications per second may be requeued overall. Env: RATE_LIMITER_QPS.")
+	flag.IntVar(&rateLimiterBurst, "rate-limiter-burst", envInt("RATE_LIMITER_BURST", 100),
+		"How many Applications may be requeued at once above rate-limiter-qps. Env: RATE_LIMITER_BURST.")
+	flag.Float64Var(&kubeAPIQPS, "kube-api-qps", envFloat("KUBE_API_QPS", 20),
+		"Queries per second the manager may send the Kubernetes API. Env: KUBE_API_QPS.")
+	flag.IntVar(&kubeAPIBurst, "kube-api-burst", envInt("KUBE_API_BURST", 30),
+		"Queries the manager may send the Kubernetes API at once above kube-api-qps. Env: KUBE_API_BURST.")
 	flag.BoolVar(&secureMetrics, "metrics-secure", true,
 		"If set, the metrics endpoint is served securely via HTTPS. Use --metrics-secure=false to use HTTP instead.")
 	flag.StringVar(&webhookCertPath, "webhook-cert-path", "", "The directory that contains the webhook certificate.")
@@ -156,33 +200,45 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
-		Scheme:                 scheme,
-		Metrics:                metricsServerOptions,
-		WebhookServer:          webhookServer,
-		HealthProbeBindAddress: probeAddr,
-		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "be6ed5b1.loco.io",
+	restConfig := ctrl.GetConfigOrDie()
+	restConfig.QPS = float32(kubeAPIQPS)
+	restConfig.Burst = kubeAPIBurst
+
+	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
+		Scheme:                  scheme,
+		Metrics:                 metricsServerOptions,
+		WebhookServer:           webhookServer,
+		HealthProbeBindAddress:  probeAddr,
+		LeaderElection:          enableLeaderElection,
+		LeaderElectionID:        "be6ed5b1.loco.io",
+		LeaderElectionNamespace: leaderElectionNamespace,
+		LeaseDuration:           &leaseDuration,
+		RenewDeadline:           &renewDeadline,
+		RetryPeriod:             &retryPeriod,
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
 		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
 		// speeds up voluntary leader transitions as the new leader don't have to wait
-		// LeaseDuration time first.
-		//
-		// In the default scaffold provided, the program ends immediately after
-		// the manager stops, so would be fine to enable this option. However,
-		// if you are doing or is intended to do any operation such as perform cleanups
-		// after the manager stops then its usage might be unsafe.
-		// LeaderElectionReleaseOnCancel: true,
+		// LeaseDuration time first. The program ends as soon as the manager stops.
+		LeaderElectionReleaseOnCancel: releaseOnCancel,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
+	setupLog.Info("configuring controller", "maxConcurrentReconciles", maxConcurrentReconciles,
+		"resyncPeriod", resyncPeriod, "leaderElection", enableLeaderElection, "leaseDuration", leaseDuration)
 	if err := (&controller.LocoResourceReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		MaxConcurrentReconciles: maxConcurrentReconciles,
+		ResyncPeriod:            resyncPeriod,
+		// retries back off per Application, and all of them together are held to an overall rate
+		RateLimiter: workqueue.NewTypedMaxOfRateLimiter(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](rateLimiterBaseDelay, rateLimiterMaxDelay),
+			&workqueue.TypedBucketRateLimiter[reconcile.Request]{Limiter: rate.NewLimiter(rate.Limit(rateLimiterQPS), rateLimiterBurst)},
+		),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Application")
 		os.Exit(1)
@@ -204,3 +260,34 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// envBool, envInt, envFloat and envDuration read a flag's default from the environment, so every
+// setting can be given either way; a flag passed on the command line wins.
+func envBool(name string, def bool) bool {
+	return envValue(name, def, strconv.ParseBool)
+}
+
+func envInt(name string, def int) int {
+	return envValue(name, def, strconv.Atoi)
+}
+
+func envFloat(name string, def float64) float64 {
+	return envValue(name, def, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) })
+}
+
+func envDuration(name string, def time.Duration) time.Duration {
+	return envValue(name, def, time.ParseDuration)
+}
+
+func envValue[T any](name string, def T, parse func(string) (T, error)) T {
+	value, ok := os.LookupEnv(name)
+	if !ok || value == "" {
+		return def
+	}
+	parsed, err := parse(value)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid %s %q: %v\n", name, value, err)
+		os.Exit(1)
+	}
+	return parsed
+}
@@ -21,39 +21,154 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"html/template"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	v1Gateway "sigs.k8s.io/gateway-api/apis/v1"
 
 	locov1alpha1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	"github.com/team-loco/loco/controller/pkg/imagesig"
 )
 
 // todo: finalize on the domain we wanna use inside kubernetes.
 const (
 	finalizerSecretRefresher = "loco.dev/secret-refresher"
+	// restartedAtAnnotation on the pod template rolls the pods when spec.restartedAt changes
+	restartedAtAnnotation = "loco.dev/restartedAt"
+	// deployingRequeue is a safety net while a rollout is in progress; changes to the app's
+	// Deployment, Service and hook Jobs requeue it as soon as they happen
+	deployingRequeue = 30 * time.Second
 )
 
+// scaledObjectGVK is KEDA's ScaledObject kind. KEDA is an optional cluster add-on,
+// so it is handled as unstructured rather than importing its API types.
+var scaledObjectGVK = schema.GroupVersionKind{
+	Group:   "keda.sh",
+	Version: "v1alpha1",
+	Kind:    "ScaledObject",
+}
+
+// httpScaledObjectGVK is the KEDA HTTP add-on's HTTPScaledObject kind, used to scale services
+// to zero between requests.
+var httpScaledObjectGVK = schema.GroupVersionKind{
+	Group:   "http.keda.sh",
+	Version: "v1alpha1",
+	Kind:    "HTTPScaledObject",
+}
+
+// httpRouteFilterGVK is Envoy Gateway's HTTPRouteFilter kind, used to answer requests
+// directly at the gateway while a resource is suspended.
+var httpRouteFilterGVK = schema.GroupVersionKind{
+	Group:   "gateway.envoyproxy.io",
+	Version: "v1alpha1",
+	Kind:    "HTTPRouteFilter",
+}
+
+// backendGVK is Envoy Gateway's Backend kind, used to route requests to services outside the
+// resource's namespace: the API, which wakes sleeping services, and the KEDA HTTP interceptor,
+// which starts services scaled to zero. Envoy Gateway needs extensionApis.enableBackend for it.
+var backendGVK = schema.GroupVersionKind{
+	Group:   "gateway.envoyproxy.io",
+	Version: "v1alpha1",
+	Kind:    "Backend",
+}
+
+// backendTrafficPolicyGVK is Envoy Gateway's BackendTrafficPolicy kind, used for the
+// resource's error page and per-route traffic limits.
+var backendTrafficPolicyGVK = schema.GroupVersionKind{
+	Group:   "gateway.envoyproxy.io",
+	Version: "v1alpha1",
+	Kind:    "BackendTrafficPolicy",
+}
+
+// securityPolicyGVK is Envoy Gateway's SecurityPolicy kind, used for the resource's access control.
+var securityPolicyGVK = schema.GroupVersionKind{
+	Group:   "gateway.envoyproxy.io",
+	Version: "v1alpha1",
+	Kind:    "SecurityPolicy",
+}
+
+// clientTrafficPolicyGVK is Envoy Gateway's ClientTrafficPolicy kind, used for the TLS policy
+// of an app's own gateway listener.
+var clientTrafficPolicyGVK = schema.GroupVersionKind{
+	Group:   "gateway.envoyproxy.io",
+	Version: "v1alpha1",
+	Kind:    "ClientTrafficPolicy",
+}
+
+const (
+	defaultScaledownPeriod    = 300 // seconds
+	defaultTargetConcurrency  = 100
+	defaultMaintenanceMessage = "This service is temporarily down for maintenance. Please check back soon."
+	defaultErrorPageTitle     = "Service unavailable"
+	defaultErrorPageMessage   = "This service is having trouble responding right now. Please try again in a few minutes."
+)
+
+// statusPageTemplate renders the branded maintenance and error pages served by the gateway
+var statusPageTemplate = template.Must(template.New("status").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head><meta charset="utf-8"><title>{{.Title}}</title>
+<style>body{font-family:system-ui,sans-serif;background:#1b1b1b;color:#fafafa;display:flex;align-items:center;justify-content:center;height:100vh;margin:0}main{max-width:32rem;padding:2rem;text-align:center}h1{color:#f57900}</style>
+</head>
+<body><main><h1>{{.Title}}</h1><p>{{.Message}}</p></main></body>
+</html>
+`))
+
+// renderStatusPage renders statusPageTemplate with the given title and message
+func renderStatusPage(title, message string) (string, error) {
+	var page bytes.Buffer
+	err := statusPageTemplate.Execute(&page, struct{ Title, Message string }{title, message})
+	return page.String(), err
+}
+
 // LocoResourceReconciler reconciles a Application object
 type LocoResourceReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
 
+	// how many Applications reconcile at once, and how quickly failed ones are retried;
+	// zero values keep controller-runtime's defaults
+	MaxConcurrentReconciles int
+	RateLimiter             workqueue.TypedRateLimiter[reconcile.Request]
+	// how often Applications are reconciled without a change, jittered so they spread out;
+	// zero reconciles only on changes
+	ResyncPeriod time.Duration
+
 	// needed for refreshing container image token.
 	gitlabURL         string
 	gitlabPAT         string
@@ -64,24 +179,50 @@ type LocoResourceReconciler struct {
 
 	// reconcile can be called concurrently, so protect map access.
 	secretRefreshersMux sync.Mutex
+
+	// where sleeping services' requests are sent to be woken
+	apiHost string
+	apiPort int64
+	// where requests of services that scale to zero are sent to be held until a replica is ready
+	interceptorHost string
+	interceptorPort int64
+
+	// what keyless image signatures are checked against; nil when only keys are accepted
+	signatureTrust *imagesig.TrustRoot
+	// images whose signatures passed their policies, by signatureCacheKey
+	verifiedSignatures    map[string]bool
+	verifiedSignaturesMux sync.Mutex
 }
 
 // +kubebuilder:rbac:groups=infra.loco.io,resources=applications,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infra.loco.io,resources=applications/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=infra.loco.io,resources=applications/finalizers,verbs=update
 // +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;create;list;watch;delete
-// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;create;list;watch;patch;update
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;create;list;watch;patch;update;delete
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;create;list;watch;patch;update;delete
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;create;list;watch
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings,verbs=get;create;list;watch;patch;update
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;create;list;watch;patch;update
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;create;list;watch;delete
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;create;list;watch;patch;update
-// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;create;list;watch;patch;update
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;create;list;watch;patch;update;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups=keda.sh,resources=scaledobjects,verbs=get;create;list;watch;patch;update;delete
+// +kubebuilder:rbac:groups=http.keda.sh,resources=httpscaledobjects,verbs=get;create;list;watch;patch;update;delete
+// +kubebuilder:rbac:groups=gateway.envoyproxy.io,resources=httproutefilters;backendtrafficpolicies;securitypolicies;clienttrafficpolicies;backends,verbs=get;create;list;watch;patch;update;delete
 
 // todo: abuse of power. we should delete based on owner refs, not delete namespace access;
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 func (r *LocoResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	result, err := r.reconcile(ctx, req)
+	observeReconcile(time.Since(start), result, err)
+	return result, err
+}
+
+func (r *LocoResourceReconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	slog.InfoContext(ctx, "reconciling application", "namespace", req.Namespace, "name", req.Name)
 
 	// fetch the Application
@@ -90,10 +231,14 @@ func (r *LocoResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		slog.ErrorContext(ctx, "unable to fetch Application", "error", err)
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	if requestID := locoRes.Annotations[locov1alpha1.RequestIDAnnotation]; requestID != "" {
+		slog.InfoContext(ctx, "application last changed by API request", "requestId", requestID, "generation", locoRes.Generation)
+	}
 
 	// validate spec early to prevent nil panics
 	if err := r.validateLocoResource(&locoRes); err != nil {
 		slog.ErrorContext(ctx, "invalid Application spec", "error", err)
+		stepFailed("validation")
 		if statusErr := r.updatePhase(ctx, &locoRes, "Failed", fmt.Sprintf("validation failed: %v", err)); statusErr != nil {
 			slog.ErrorContext(ctx, "failed to update status after validation error", "error", statusErr)
 		}
@@ -110,6 +255,7 @@ func (r *LocoResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		controllerutil.AddFinalizer(&locoRes, finalizerSecretRefresher)
 		if err := r.Update(ctx, &locoRes); err != nil {
 			slog.ErrorContext(ctx, "failed to add finalizer", "error", err)
+			stepFailed("finalizer")
 			return ctrl.Result{}, err
 		}
 		slog.InfoContext(ctx, "added finalizer", "finalizer", finalizerSecretRefresher)
@@ -130,6 +276,7 @@ func (r *LocoResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	// begin reconcile steps - these functions allocate and ensure Kubernetes resources
 	if err := ensureNamespace(ctx, r.Client, &locoRes); err != nil {
 		slog.ErrorContext(ctx, "failed to ensure namespace", "error", err)
+		stepFailed("namespace")
 		currentPhase = "Failed"
 		currentMessage = fmt.Sprintf("failed to ensure namespace: %v", err)
 		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
@@ -140,6 +287,7 @@ func (r *LocoResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 	if err := ensureEnvSecret(ctx, r.Client, &locoRes); err != nil {
 		slog.ErrorContext(ctx, "failed to ensure secrets", "error", err)
+		stepFailed("env_secret")
 		currentPhase = "Failed"
 		currentMessage = fmt.Sprintf("failed to ensure secrets: %v", err)
 		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
@@ -148,8 +296,20 @@ func (r *LocoResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{}, err
 	}
 
+	if err := r.ensureSecretRefs(ctx, &locoRes); err != nil {
+		slog.ErrorContext(ctx, "failed to resolve secret references", "error", err)
+		stepFailed("secret_refs")
+		currentPhase = "Failed"
+		currentMessage = fmt.Sprintf("failed to resolve secret references: %v", err)
+		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
+			slog.ErrorContext(ctx, "failed to update status after secret references error", "error", statusErr)
+		}
+		return ctrl.Result{}, err
+	}
+
 	if err := r.ensureImagePullSecret(ctx, &locoRes); err != nil {
 		slog.ErrorContext(ctx, "failed to ensure image pull secret", "error", err)
+		stepFailed("image_pull_secret")
 		currentPhase = "Failed"
 		currentMessage = fmt.Sprintf("failed to ensure image pull secret: %v", err)
 		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
@@ -160,6 +320,7 @@ func (r *LocoResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 	if err := r.ensureServiceAccount(ctx, &locoRes); err != nil {
 		slog.ErrorContext(ctx, "failed to ensure service account", "error", err)
+		stepFailed("service_account")
 		currentPhase = "Failed"
 		currentMessage = fmt.Sprintf("failed to ensure service account: %v", err)
 		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
@@ -170,6 +331,7 @@ func (r *LocoResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 	if err := r.ensureRoleAndBinding(ctx, &locoRes); err != nil {
 		slog.ErrorContext(ctx, "failed to ensure role & binding", "error", err)
+		stepFailed("role_binding")
 		currentPhase = "Failed"
 		currentMessage = fmt.Sprintf("failed to ensure role & binding: %v", err)
 		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
@@ -178,9 +340,64 @@ func (r *LocoResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{}, err
 	}
 
+	if err := r.applyResourceLimits(ctx, &locoRes); err != nil {
+		slog.ErrorContext(ctx, "failed to apply resource limits", "error", err)
+		stepFailed("resource_limits")
+		currentPhase = "Failed"
+		currentMessage = err.Error()
+		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
+			slog.ErrorContext(ctx, "failed to update status after resource limits error", "error", statusErr)
+		}
+		if errors.Is(err, errResourcesRejected) {
+			// nothing to retry until the spec changes
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := r.verifyImageSignatures(ctx, &locoRes); err != nil {
+		slog.ErrorContext(ctx, "failed to verify image signatures", "error", err)
+		stepFailed("image_signature")
+		currentPhase = "Failed"
+		currentMessage = err.Error()
+		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
+			slog.ErrorContext(ctx, "failed to update status after image signature error", "error", statusErr)
+		}
+		if errors.Is(err, errSignatureRejected) {
+			// nothing to retry until the spec changes
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	// pre-deploy hooks gate the rollout: the deployment keeps its current version until they pass
+	running, err := r.runHooks(ctx, &locoRes, locov1alpha1.HookPhasePreDeploy)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to run pre-deploy hooks", "error", err)
+		stepFailed("pre_deploy_hooks")
+		currentPhase = "Failed"
+		currentMessage = err.Error()
+		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
+			slog.ErrorContext(ctx, "failed to update status after pre-deploy hook error", "error", statusErr)
+		}
+		if errors.Is(err, errHookFailed) {
+			// nothing to retry until the spec changes
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if running != "" {
+		if statusErr := r.updatePhase(ctx, &locoRes, "Deploying", running); statusErr != nil {
+			slog.ErrorContext(ctx, "failed to update status while running pre-deploy hooks", "error", statusErr)
+		}
+		// the hook Job finishing requeues the Application
+		return ctrl.Result{RequeueAfter: deployingRequeue}, nil
+	}
+
 	dep, err := r.ensureDeployment(ctx, &locoRes)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to ensure deployment", "error", err)
+		stepFailed("deployment")
 		currentPhase = "Failed"
 		currentMessage = fmt.Sprintf("failed to ensure deployment: %v", err)
 		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
@@ -191,6 +408,7 @@ func (r *LocoResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 	if err := r.ensureService(ctx, &locoRes); err != nil {
 		slog.ErrorContext(ctx, "failed to ensure service", "error", err)
+		stepFailed("service")
 		currentPhase = "Failed"
 		currentMessage = fmt.Sprintf("failed to ensure service: %v", err)
 		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
@@ -199,8 +417,42 @@ func (r *LocoResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{}, err
 	}
 
+	if err := r.ensureMaintenanceFilter(ctx, &locoRes); err != nil {
+		slog.ErrorContext(ctx, "failed to ensure maintenance filter", "error", err)
+		stepFailed("maintenance_filter")
+		currentPhase = "Failed"
+		currentMessage = fmt.Sprintf("failed to ensure maintenance filter: %v", err)
+		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
+			slog.ErrorContext(ctx, "failed to update status after maintenance filter error", "error", statusErr)
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := r.ensureWakeBackend(ctx, &locoRes); err != nil {
+		slog.ErrorContext(ctx, "failed to ensure wake backend", "error", err)
+		stepFailed("wake_backend")
+		currentPhase = "Failed"
+		currentMessage = fmt.Sprintf("failed to ensure wake backend: %v", err)
+		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
+			slog.ErrorContext(ctx, "failed to update status after wake backend error", "error", statusErr)
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := r.ensureInterceptorBackend(ctx, &locoRes); err != nil {
+		slog.ErrorContext(ctx, "failed to ensure interceptor backend", "error", err)
+		stepFailed("interceptor_backend")
+		currentPhase = "Failed"
+		currentMessage = fmt.Sprintf("failed to ensure interceptor backend: %v", err)
+		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
+			slog.ErrorContext(ctx, "failed to update status after interceptor backend error", "error", statusErr)
+		}
+		return ctrl.Result{}, err
+	}
+
 	if err := r.ensureHTTPRoute(ctx, &locoRes); err != nil {
 		slog.ErrorContext(ctx, "failed to ensure HTTP route", "error", err)
+		stepFailed("route")
 		currentPhase = "Failed"
 		currentMessage = fmt.Sprintf("failed to ensure HTTP route: %v", err)
 		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
@@ -209,8 +461,84 @@ func (r *LocoResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{}, err
 	}
 
+	if err := r.ensureRedirectRoutes(ctx, &locoRes); err != nil {
+		slog.ErrorContext(ctx, "failed to ensure redirect routes", "error", err)
+		stepFailed("redirect_routes")
+		currentPhase = "Failed"
+		currentMessage = fmt.Sprintf("failed to ensure redirect routes: %v", err)
+		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
+			slog.ErrorContext(ctx, "failed to update status after redirect routes error", "error", statusErr)
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := r.ensureTLSPolicy(ctx, &locoRes); err != nil {
+		slog.ErrorContext(ctx, "failed to ensure TLS policy", "error", err)
+		stepFailed("tls_policy")
+		currentPhase = "Failed"
+		currentMessage = fmt.Sprintf("failed to ensure TLS policy: %v", err)
+		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
+			slog.ErrorContext(ctx, "failed to update status after TLS policy error", "error", statusErr)
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := r.ensureBackendTrafficPolicy(ctx, &locoRes); err != nil {
+		slog.ErrorContext(ctx, "failed to ensure backend traffic policy", "error", err)
+		stepFailed("backend_traffic_policy")
+		currentPhase = "Failed"
+		currentMessage = fmt.Sprintf("failed to ensure backend traffic policy: %v", err)
+		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
+			slog.ErrorContext(ctx, "failed to update status after backend traffic policy error", "error", statusErr)
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := r.ensureSecurityPolicy(ctx, &locoRes); err != nil {
+		slog.ErrorContext(ctx, "failed to ensure security policy", "error", err)
+		stepFailed("security_policy")
+		currentPhase = "Failed"
+		currentMessage = fmt.Sprintf("failed to ensure security policy: %v", err)
+		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
+			slog.ErrorContext(ctx, "failed to update status after security policy error", "error", statusErr)
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := r.ensureScaledObject(ctx, &locoRes); err != nil {
+		slog.ErrorContext(ctx, "failed to ensure scaled object", "error", err)
+		stepFailed("scaled_object")
+		currentPhase = "Failed"
+		currentMessage = fmt.Sprintf("failed to ensure scaled object: %v", err)
+		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
+			slog.ErrorContext(ctx, "failed to update status after scaled object error", "error", statusErr)
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := r.ensureHTTPScaledObject(ctx, &locoRes); err != nil {
+		slog.ErrorContext(ctx, "failed to ensure HTTP scaled object", "error", err)
+		stepFailed("http_scaled_object")
+		currentPhase = "Failed"
+		currentMessage = fmt.Sprintf("failed to ensure HTTP scaled object: %v", err)
+		if statusErr := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); statusErr != nil {
+			slog.ErrorContext(ctx, "failed to update status after HTTP scaled object error", "error", statusErr)
+		}
+		return ctrl.Result{}, err
+	}
+
 	// aggregate deployment status into our status
-	if dep != nil {
+	if locoRes.Spec.Suspended {
+		currentPhase = "Suspended"
+		currentMessage = "Suspended for maintenance"
+	} else if locoRes.Spec.Sleeping {
+		currentPhase = "Sleeping"
+		currentMessage = "Asleep after a period without traffic; the next request wakes it"
+	} else if dep != nil && dep.Spec.Replicas != nil && *dep.Spec.Replicas == 0 && (usesScaleToZero(&locoRes) || usesExternalScaling(&locoRes)) {
+		// KEDA scaled it to zero: idle at zero replicas, or waiting for the next request
+		currentPhase = "Ready"
+		currentMessage = "Scaled to zero until there is work for it"
+	} else if dep != nil {
 		replicas := int32(1)
 		if dep.Status.ReadyReplicas < replicas {
 			currentPhase = "Deploying"
@@ -218,12 +546,40 @@ func (r *LocoResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		} else {
 			currentPhase = "Ready"
 			currentMessage = "Deployment ready"
+			// post-deploy hooks run once the new version is up; it is not ready until they pass
+			running, err := r.runHooks(ctx, &locoRes, locov1alpha1.HookPhasePostDeploy)
+			switch {
+			case err != nil:
+				slog.ErrorContext(ctx, "failed to run post-deploy hooks", "error", err)
+				stepFailed("post_deploy_hooks")
+				currentPhase = "Failed"
+				currentMessage = err.Error()
+			case running != "":
+				currentPhase = "Deploying"
+				currentMessage = running
+			}
+		}
+	}
+
+	// it is only ready once traffic for its hostname reaches it
+	if currentPhase == "Ready" {
+		waiting, err := r.checkRouting(ctx, &locoRes)
+		switch {
+		case err != nil:
+			slog.ErrorContext(ctx, "failed to check routing", "error", err)
+			stepFailed("routing_status")
+			currentPhase = "Deploying"
+			currentMessage = fmt.Sprintf("failed to check routing: %v", err)
+		case waiting != "":
+			currentPhase = "Deploying"
+			currentMessage = waiting
 		}
 	}
 
 	// Single status update at the end
 	if err := r.updatePhase(ctx, &locoRes, currentPhase, currentMessage); err != nil {
 		slog.ErrorContext(ctx, "failed to update status", "error", err)
+		stepFailed("status")
 		// Don't return error - reconciliation work succeeded, status update can retry next reconcile
 	}
 
@@ -231,10 +587,12 @@ func (r *LocoResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 	slog.InfoContext(ctx, "reconcile complete", "phase", locoRes.Status.Phase, "resource", locoRes.Name)
 	if locoRes.Status.Phase == "Deploying" {
-		// requeue faster while deployment is rolling out
-		return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+		return ctrl.Result{RequeueAfter: deployingRequeue}, nil
+	}
+	// Otherwise, rely on watch events, and resync now and then in case one was missed
+	if r.ResyncPeriod > 0 {
+		return ctrl.Result{RequeueAfter: wait.Jitter(r.ResyncPeriod, 0.2)}, nil
 	}
-	// Otherwise, rely on watch events
 	return ctrl.Result{}, nil
 }
 
@@ -250,7 +608,7 @@ func (r *LocoResourceReconciler) updatePhase(ctx context.Context, locoRes *locov
 	return r.updateLRStatus(ctx, locoRes, &locoRes.Status)
 }
 
-// handleDeletion cancels the secret refresher goroutine, deletes the namespace, and removes the finalizer
+// handleDeletion cancels the secret refresher goroutine, deletes the namespace and TLS policy, and removes the finalizer
 func (r *LocoResourceReconciler) handleDeletion(ctx context.Context, locoRes *locov1alpha1.Application) (ctrl.Result, error) {
 	namespace := getNamespace(locoRes)
 	resourceKey := fmt.Sprintf("%s/%s", namespace, getName(locoRes))
@@ -273,6 +631,12 @@ func (r *LocoResourceReconciler) handleDeletion(ctx context.Context, locoRes *lo
 		slog.InfoContext(ctx, "namespace deleted", "namespace", namespace)
 	}
 
+	// the TLS policy lives in the loco namespace, so it goes with the Application rather than the namespace
+	if err := r.deleteTLSPolicy(ctx, locoRes); err != nil {
+		slog.ErrorContext(ctx, "failed to delete TLS policy", "error", err)
+		return ctrl.Result{}, err
+	}
+
 	if controllerutil.ContainsFinalizer(locoRes, finalizerSecretRefresher) {
 		controllerutil.RemoveFinalizer(locoRes, finalizerSecretRefresher)
 		if err := r.Update(ctx, locoRes); err != nil {
@@ -295,10 +659,48 @@ func getNamespace(locoRes *locov1alpha1.Application) string {
 	return fmt.Sprintf("wks-%d-res-%d", locoRes.Spec.WorkspaceId, locoRes.Spec.ResourceId)
 }
 
+// applicationForObject maps an object in an app's namespace to the Application the app is
+// reconciled from, the inverse of getNamespace and getName. Objects elsewhere map to nothing.
+func (r *LocoResourceReconciler) applicationForObject(_ context.Context, obj client.Object) []reconcile.Request {
+	var workspaceID, resourceID int64
+	if _, err := fmt.Sscanf(obj.GetNamespace(), "wks-%d-res-%d", &workspaceID, &resourceID); err != nil {
+		return nil
+	}
+	if obj.GetNamespace() != fmt.Sprintf("wks-%d-res-%d", workspaceID, resourceID) {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: r.locoNamespace,
+		Name:      fmt.Sprintf("resource-%d", resourceID),
+	}}}
+}
+
+func getMaintenanceFilterName(locoRes *locov1alpha1.Application) string {
+	return fmt.Sprintf("%s-maintenance", getName(locoRes))
+}
+
+func getWakeBackendName(locoRes *locov1alpha1.Application) string {
+	return fmt.Sprintf("%s-wake", getName(locoRes))
+}
+
+func getInterceptorBackendName(locoRes *locov1alpha1.Application) string {
+	return fmt.Sprintf("%s-interceptor", getName(locoRes))
+}
+
 func getImageSecretName(locoRes *locov1alpha1.Application) string {
 	return fmt.Sprintf("%s-image-pull", getName(locoRes))
 }
 
+// getSecretRefsName is the secret holding the secret store entries the env references
+func getSecretRefsName(locoRes *locov1alpha1.Application) string {
+	return fmt.Sprintf("%s-secret-refs", getName(locoRes))
+}
+
+// secretRefKey is the key a secret store entry is copied to in the app's secret refs secret
+func secretRefKey(store, key string) string {
+	return store + "." + key
+}
+
 // getContainerPort extracts the container port from routing or deployment config
 // Prefers routing port, falls back to deployment port, defaults to 8000
 func getContainerPort(locoRes *locov1alpha1.Application) int32 {
@@ -353,6 +755,9 @@ func ensureEnvSecret(ctx context.Context, kubeClient client.Client, locoRes *loc
 		// create env secret from deployment env map
 		secretData := make(map[string][]byte)
 		for k, v := range locoRes.Spec.ServiceSpec.Deployment.Env {
+			if _, _, ok := locov1alpha1.ParseSecretRef(v); ok {
+				continue
+			}
 			secretData[k] = []byte(v)
 		}
 
@@ -379,6 +784,51 @@ func ensureEnvSecret(ctx context.Context, kubeClient client.Client, locoRes *loc
 	return nil
 }
 
+// ensureSecretRefs copies the secret store entries referenced by the env into the app namespace,
+// where pods can read them through a secretKeyRef. The secret is removed when nothing is referenced.
+func (r *LocoResourceReconciler) ensureSecretRefs(ctx context.Context, locoRes *locov1alpha1.Application) error {
+	var data map[string][]byte
+	stores := make(map[string]*corev1.Secret)
+	// init containers and sidecars may reference secrets too
+	deployment := locoRes.Spec.ServiceSpec.Deployment
+	envs := []map[string]string{deployment.Env}
+	for _, container := range append(slices.Clone(deployment.InitContainers), deployment.Sidecars...) {
+		envs = append(envs, container.Env)
+	}
+	for _, env := range envs {
+		for name, value := range env {
+			store, key, ok := locov1alpha1.ParseSecretRef(value)
+			if !ok {
+				continue
+			}
+
+			source, ok := stores[store]
+			if !ok {
+				source = &corev1.Secret{}
+				sourceName := locov1alpha1.SecretStoreName(locoRes.Spec.WorkspaceId, store)
+				if err := r.Get(ctx, client.ObjectKey{Namespace: r.locoNamespace, Name: sourceName}, source); err != nil {
+					if apierrors.IsNotFound(err) {
+						return fmt.Errorf("environment variable %s references secret store %q, which does not exist", name, store)
+					}
+					return err
+				}
+				stores[store] = source
+			}
+
+			entry, ok := source.Data[key]
+			if !ok {
+				return fmt.Errorf("environment variable %s references key %q, which secret store %q does not have", name, key, store)
+			}
+			if data == nil {
+				data = make(map[string][]byte)
+			}
+			data[secretRefKey(store, key)] = entry
+		}
+	}
+
+	return r.ensureOptionalSecret(ctx, locoRes, getSecretRefsName(locoRes), data)
+}
+
 // ensureImagePullSecret creates or updates the image pull secret for GitLab registry
 func (r *LocoResourceReconciler) ensureImagePullSecret(ctx context.Context, locoRes *locov1alpha1.Application) error {
 	name := getName(locoRes)
@@ -580,14 +1030,25 @@ func (r *LocoResourceReconciler) ensureService(ctx context.Context, locoRes *loc
 		svc.Spec.Selector = map[string]string{
 			"app": name,
 		}
+		portName, appProtocol := servicePortProtocol(locoRes)
 		svc.Spec.Ports = []corev1.ServicePort{
 			{
-				Name:       "http",
-				Protocol:   corev1.ProtocolTCP,
-				Port:       80,
-				TargetPort: intstr.FromInt32(containerPort),
+				Name:        portName,
+				Protocol:    corev1.ProtocolTCP,
+				AppProtocol: appProtocol,
+				Port:        80,
+				TargetPort:  intstr.FromInt32(containerPort),
 			},
 		}
+		// further ports keep their own number, so the cluster reaches them as the app listens on them
+		for _, port := range getExtraPorts(locoRes) {
+			svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+				Name:       port.Name,
+				Protocol:   corev1.ProtocolTCP,
+				Port:       port.Port,
+				TargetPort: intstr.FromInt32(port.Port),
+			})
+		}
 		return nil
 	})
 	if err != nil {
@@ -599,6 +1060,37 @@ func (r *LocoResourceReconciler) ensureService(ctx context.Context, locoRes *loc
 	return nil
 }
 
+// getRoutingProtocol returns the backend protocol hint, defaulting to plain HTTP
+func getRoutingProtocol(locoRes *locov1alpha1.Application) string {
+	if locoRes.Spec.ServiceSpec.Routing == nil || locoRes.Spec.ServiceSpec.Routing.Protocol == "" {
+		return locov1alpha1.ProtocolHTTP
+	}
+	return locoRes.Spec.ServiceSpec.Routing.Protocol
+}
+
+// servicePortProtocol returns the Service port name and appProtocol the gateway uses to pick
+// the upstream protocol; kubernetes.io/h2c and kubernetes.io/ws are understood by Envoy Gateway
+func servicePortProtocol(locoRes *locov1alpha1.Application) (string, *string) {
+	switch getRoutingProtocol(locoRes) {
+	case locov1alpha1.ProtocolGRPC:
+		return "grpc", ptrToString("kubernetes.io/h2c")
+	case locov1alpha1.ProtocolH2C:
+		return "http2", ptrToString("kubernetes.io/h2c")
+	case locov1alpha1.ProtocolWebSocket:
+		return "http", ptrToString("kubernetes.io/ws")
+	default:
+		return "http", nil
+	}
+}
+
+// getExtraPorts returns the ports the service listens on besides its main one
+func getExtraPorts(locoRes *locov1alpha1.Application) []locov1alpha1.PortSpec {
+	if locoRes.Spec.ServiceSpec.Routing == nil {
+		return nil
+	}
+	return locoRes.Spec.ServiceSpec.Routing.Ports
+}
+
 // ensureDeployment ensures the Kubernetes deployment exists and is configured with the spec
 // Returns the deployment if it exists or was created, or nil if skipped
 func (r *LocoResourceReconciler) ensureDeployment(ctx context.Context, locoRes *locov1alpha1.Application) (*appsv1.Deployment, error) {
@@ -610,18 +1102,9 @@ func (r *LocoResourceReconciler) ensureDeployment(ctx context.Context, locoRes *
 	var livenessProbe *corev1.Probe
 	var readinessProbe *corev1.Probe
 	var containerPort int32 = 8080
-	cpuRequest := "100m"
-	cpuLimit := "500m"
-	memoryRequest := "128Mi"
-	memoryLimit := "512Mi"
 
-	image = locoRes.Spec.ServiceSpec.Deployment.Image
-	for k, v := range locoRes.Spec.ServiceSpec.Deployment.Env {
-		envVars = append(envVars, corev1.EnvVar{
-			Name:  k,
-			Value: v,
-		})
-	}
+	image = locoRes.Spec.ServiceSpec.Deployment.PinnedImage()
+	envVars = getContainerEnv(locoRes)
 
 	if locoRes.Spec.ServiceSpec.Deployment.Port > 0 {
 		containerPort = locoRes.Spec.ServiceSpec.Deployment.Port
@@ -646,11 +1129,15 @@ func (r *LocoResourceReconciler) ensureDeployment(ctx context.Context, locoRes *
 		readinessProbe = probe
 	}
 
-	cpuRequest = locoRes.Spec.ServiceSpec.Resources.CPU
-	cpuLimit = locoRes.Spec.ServiceSpec.Resources.CPU
-	memoryRequest = locoRes.Spec.ServiceSpec.Resources.Memory
-	memoryLimit = locoRes.Spec.ServiceSpec.Resources.Memory
+	// applyResourceLimits has filled in the platform defaults and held these to the plan's maximums
+	cpuRequest := locoRes.Spec.ServiceSpec.Resources.CPU
+	cpuLimit := locoRes.Spec.ServiceSpec.Resources.CPU
+	memoryRequest := locoRes.Spec.ServiceSpec.Resources.Memory
+	memoryLimit := locoRes.Spec.ServiceSpec.Resources.Memory
 	replicas = locoRes.Spec.ServiceSpec.Resources.Replicas.Min
+	if locoRes.Spec.Suspended || locoRes.Spec.Sleeping {
+		replicas = 0
+	}
 
 	slog.InfoContext(ctx, "ensuring deployment", "namespace", namespace, "name", name, "replicas", replicas, "image", image)
 
@@ -667,9 +1154,12 @@ func (r *LocoResourceReconciler) ensureDeployment(ctx context.Context, locoRes *
 		}
 
 		container := corev1.Container{
-			Name:  name,
-			Image: image,
-			Env:   envVars,
+			Name:       name,
+			Image:      image,
+			Command:    locoRes.Spec.ServiceSpec.Command,
+			Args:       locoRes.Spec.ServiceSpec.Args,
+			WorkingDir: locoRes.Spec.ServiceSpec.WorkingDir,
+			Env:        envVars,
 			Ports: []corev1.ContainerPort{
 				{
 					Name:          "http",
@@ -689,12 +1179,23 @@ func (r *LocoResourceReconciler) ensureDeployment(ctx context.Context, locoRes *
 			},
 		}
 
+		for _, port := range getExtraPorts(locoRes) {
+			container.Ports = append(container.Ports, corev1.ContainerPort{
+				Name:          port.Name,
+				ContainerPort: port.Port,
+				Protocol:      corev1.ProtocolTCP,
+			})
+		}
+
 		if livenessProbe != nil {
 			container.LivenessProbe = livenessProbe
 			container.ReadinessProbe = readinessProbe
 		}
 
-		dep.Spec.Replicas = &replicas
+		// when KEDA owns the replica count, only seed it on create
+		if !(usesExternalScaling(locoRes) || usesScaleToZero(locoRes)) || dep.Spec.Replicas == nil {
+			dep.Spec.Replicas = &replicas
+		}
 		dep.Spec.Selector = &metav1.LabelSelector{
 			MatchLabels: map[string]string{
 				"app": name,
@@ -707,15 +1208,24 @@ func (r *LocoResourceReconciler) ensureDeployment(ctx context.Context, locoRes *
 				MaxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "25%"},
 			},
 		}
+		var podAnnotations map[string]string
+		if locoRes.Spec.RestartedAt != "" {
+			podAnnotations = map[string]string{restartedAtAnnotation: locoRes.Spec.RestartedAt}
+		}
 		dep.Spec.Template = corev1.PodTemplateSpec{
 			ObjectMeta: metav1.ObjectMeta{
 				Labels: map[string]string{
 					"app": name,
 				},
+				Annotations: podAnnotations,
 			},
 			Spec: corev1.PodSpec{
-				ServiceAccountName: name,
-				RestartPolicy:      corev1.RestartPolicyAlways,
+				ServiceAccountName:        name,
+				RestartPolicy:             corev1.RestartPolicyAlways,
+				NodeSelector:              getNodeSelector(locoRes),
+				Tolerations:               getTolerations(locoRes),
+				TopologySpreadConstraints: getTopologySpreadConstraints(locoRes, map[string]string{"app": name}),
+				InitContainers:            getInitContainers(locoRes),
 				Containers: []corev1.Container{
 					container,
 				},
@@ -733,6 +1243,93 @@ func (r *LocoResourceReconciler) ensureDeployment(ctx context.Context, locoRes *
 	return dep, nil
 }
 
+// getContainerEnv returns the env vars the app's containers run with, resolving secret references
+// from the secret-refs secret
+func getContainerEnv(locoRes *locov1alpha1.Application) []corev1.EnvVar {
+	return containerEnvVars(locoRes, locoRes.Spec.ServiceSpec.Deployment.Env)
+}
+
+// containerEnvVars turns env into a container's env vars, resolving secret references from the
+// secret-refs secret
+func containerEnvVars(locoRes *locov1alpha1.Application, env map[string]string) []corev1.EnvVar {
+	var envVars []corev1.EnvVar
+	for k, v := range env {
+		if store, key, ok := locov1alpha1.ParseSecretRef(v); ok {
+			envVars = append(envVars, corev1.EnvVar{
+				Name: k,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: getSecretRefsName(locoRes)},
+						Key:                  secretRefKey(store, key),
+					},
+				},
+			})
+			continue
+		}
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  k,
+			Value: v,
+		})
+	}
+	return envVars
+}
+
+// getNodeSelector returns the node labels the app's pods must run on
+func getNodeSelector(locoRes *locov1alpha1.Application) map[string]string {
+	if scheduling := locoRes.Spec.ServiceSpec.Scheduling; scheduling != nil {
+		return scheduling.NodeSelector
+	}
+	return nil
+}
+
+// getTolerations returns the node taints the app's pods tolerate
+func getTolerations(locoRes *locov1alpha1.Application) []corev1.Toleration {
+	scheduling := locoRes.Spec.ServiceSpec.Scheduling
+	if scheduling == nil {
+		return nil
+	}
+	var tolerations []corev1.Toleration
+	for _, t := range scheduling.Tolerations {
+		toleration := corev1.Toleration{
+			Key:      t.Key,
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffect(t.Effect),
+		}
+		if t.Value != "" {
+			toleration.Operator = corev1.TolerationOpEqual
+			toleration.Value = t.Value
+		}
+		tolerations = append(tolerations, toleration)
+	}
+	return tolerations
+}
+
+// getTopologySpreadConstraints spreads the pods matching podLabels across the app's spread keys
+func getTopologySpreadConstraints(locoRes *locov1alpha1.Application, podLabels map[string]string) []corev1.TopologySpreadConstraint {
+	scheduling := locoRes.Spec.ServiceSpec.Scheduling
+	if scheduling == nil {
+		return nil
+	}
+	var constraints []corev1.TopologySpreadConstraint
+	for _, spread := range scheduling.Spread {
+		maxSkew := spread.MaxSkew
+		if maxSkew <= 0 {
+			maxSkew = 1
+		}
+		whenUnsatisfiable := corev1.ScheduleAnyway
+		if spread.Required {
+			whenUnsatisfiable = corev1.DoNotSchedule
+		}
+		constraints = append(constraints, corev1.TopologySpreadConstraint{
+			MaxSkew:           maxSkew,
+			TopologyKey:       spread.TopologyKey,
+			WhenUnsatisfiable: whenUnsatisfiable,
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: podLabels},
+		})
+	}
+	return constraints
+}
+
 // ensureHTTPRoute ensures the HTTPRoute exists for traffic ingress (Envoy Gateway)
 func (r *LocoResourceReconciler) ensureHTTPRoute(ctx context.Context, locoRes *locov1alpha1.Application) error {
 	name := getName(locoRes)
@@ -765,6 +1362,80 @@ func (r *LocoResourceReconciler) ensureHTTPRoute(ctx context.Context, locoRes *l
 		},
 	}
 
+	backendRefs := []v1Gateway.HTTPBackendRef{
+		{
+			BackendRef: v1Gateway.BackendRef{
+				BackendObjectReference: v1Gateway.BackendObjectReference{
+					Name: v1Gateway.ObjectName(name),
+					Port: ptrToPortNumber(80),
+					Kind: ptrToKind("Service"),
+				},
+			},
+		},
+	}
+	// gRPC streams and websockets are long-lived, so the gateway must not cut them off
+	// unless a request timeout is set explicitly
+	var timeouts *v1Gateway.HTTPRouteTimeouts
+	if routing := locoRes.Spec.ServiceSpec.Routing; routing != nil && routing.RequestTimeout > 0 {
+		requestTimeout := v1Gateway.Duration(fmt.Sprintf("%ds", routing.RequestTimeout))
+		timeouts = &v1Gateway.HTTPRouteTimeouts{Request: &requestTimeout}
+	} else {
+		switch getRoutingProtocol(locoRes) {
+		case locov1alpha1.ProtocolGRPC, locov1alpha1.ProtocolWebSocket:
+			noTimeout := v1Gateway.Duration("0s")
+			timeouts = &v1Gateway.HTTPRouteTimeouts{Request: &noTimeout}
+		}
+	}
+
+	var filters []v1Gateway.HTTPRouteFilter
+	// routed further ports go straight to their Service port, unless the whole service is
+	// answered by something else below
+	servedDirectly := !locoRes.Spec.Suspended && !locoRes.Spec.Sleeping && !usesScaleToZero(locoRes)
+	// while suspended the gateway answers with the maintenance page instead of the service
+	if locoRes.Spec.Suspended {
+		backendRefs = nil
+		filters = []v1Gateway.HTTPRouteFilter{
+			{
+				Type: v1Gateway.HTTPRouteFilterExtensionRef,
+				ExtensionRef: &v1Gateway.LocalObjectReference{
+					Group: v1Gateway.Group(httpRouteFilterGVK.Group),
+					Kind:  v1Gateway.Kind(httpRouteFilterGVK.Kind),
+					Name:  v1Gateway.ObjectName(getMaintenanceFilterName(locoRes)),
+				},
+			},
+		}
+	} else if locoRes.Spec.Sleeping {
+		// while sleeping the API answers, waking the service on the first request
+		backendRefs = []v1Gateway.HTTPBackendRef{
+			{
+				BackendRef: v1Gateway.BackendRef{
+					BackendObjectReference: v1Gateway.BackendObjectReference{
+						Group: ptrToGroup(backendGVK.Group),
+						Kind:  ptrToKind(backendGVK.Kind),
+						Name:  v1Gateway.ObjectName(getWakeBackendName(locoRes)),
+					},
+				},
+			},
+		}
+	} else if usesScaleToZero(locoRes) {
+		// the interceptor holds requests while the service scales up from zero
+		backendRefs = []v1Gateway.HTTPBackendRef{
+			{
+				BackendRef: v1Gateway.BackendRef{
+					BackendObjectReference: v1Gateway.BackendObjectReference{
+						Group: ptrToGroup(backendGVK.Group),
+						Kind:  ptrToKind(backendGVK.Kind),
+						Name:  v1Gateway.ObjectName(getInterceptorBackendName(locoRes)),
+					},
+				},
+			},
+		}
+	}
+
+	if hsts := hstsFilter(locoRes); hsts != nil {
+		filters = append(filters, *hsts)
+	}
+
 	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, route, func() error {
 		route.Labels = map[string]string{
 			"app": name,
@@ -775,7 +1446,7 @@ func (r *LocoResourceReconciler) ensureHTTPRoute(ctx context.Context, locoRes *l
 		route.Spec.ParentRefs = []v1Gateway.ParentReference{
 			// todo: remove the hardooded gateway name and namespace.
 			{
-				Name:      v1Gateway.ObjectName("eg"),
+				Name:      v1Gateway.ObjectName(gatewayName),
 				Namespace: (*v1Gateway.Namespace)(&r.locoNamespace),
 			},
 		}
@@ -789,18 +1460,42 @@ func (r *LocoResourceReconciler) ensureHTTPRoute(ctx context.Context, locoRes *l
 						},
 					},
 				},
-				BackendRefs: []v1Gateway.HTTPBackendRef{
+				Filters:     filters,
+				BackendRefs: backendRefs,
+				Timeouts:    timeouts,
+			},
+		}
+		for _, port := range getExtraPorts(locoRes) {
+			if port.PathPrefix == "" {
+				continue
+			}
+			portBackendRefs := backendRefs
+			if servedDirectly {
+				portBackendRefs = []v1Gateway.HTTPBackendRef{
 					{
 						BackendRef: v1Gateway.BackendRef{
 							BackendObjectReference: v1Gateway.BackendObjectReference{
 								Name: v1Gateway.ObjectName(name),
-								Port: ptrToPortNumber(80),
+								Port: ptrToPortNumber(int(port.Port)),
 								Kind: ptrToKind("Service"),
 							},
 						},
 					},
+				}
+			}
+			route.Spec.Rules = append(route.Spec.Rules, v1Gateway.HTTPRouteRule{
+				Matches: []v1Gateway.HTTPRouteMatch{
+					{
+						Path: &v1Gateway.HTTPPathMatch{
+							Type:  &pathType,
+							Value: ptrToString(port.PathPrefix),
+						},
+					},
 				},
-			},
+				Filters:     filters,
+				BackendRefs: portBackendRefs,
+				Timeouts:    timeouts,
+			})
 		}
 		return nil
 	})
@@ -813,15 +1508,597 @@ func (r *LocoResourceReconciler) ensureHTTPRoute(ctx context.Context, locoRes *l
 	return nil
 }
 
-// updateLRStatus writes the observed status back to the Application status subresource
+// usesExternalScaling reports whether autoscaling is driven by KEDA triggers or schedules
+func usesExternalScaling(locoRes *locov1alpha1.Application) bool {
+	resources := locoRes.Spec.ServiceSpec.Resources
+	return !locoRes.Spec.Suspended && !locoRes.Spec.Sleeping && resources != nil && resources.Scalers.Enabled &&
+		(len(resources.Scalers.Triggers) > 0 || len(resources.Scalers.Schedules) > 0)
+}
+
+// usesScaleToZero reports whether the KEDA HTTP add-on scales the service on its requests
+func usesScaleToZero(locoRes *locov1alpha1.Application) bool {
+	return !locoRes.Spec.Suspended && !locoRes.Spec.Sleeping && locoRes.Spec.ServiceSpec.ScaleToZero != nil
+}
+
+// ensureMaintenanceFilter ensures the HTTPRouteFilter serving the maintenance page exists while
+// the Application is suspended, and removes it otherwise
+func (r *LocoResourceReconciler) ensureMaintenanceFilter(ctx context.Context, locoRes *locov1alpha1.Application) error {
+	name := getMaintenanceFilterName(locoRes)
+	namespace := getNamespace(locoRes)
+
+	filter := &unstructured.Unstructured{}
+	filter.SetGroupVersionKind(httpRouteFilterGVK)
+	filter.SetName(name)
+	filter.SetNamespace(namespace)
+
+	if !locoRes.Spec.Suspended {
+		if err := r.Delete(ctx, filter); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			slog.ErrorContext(ctx, "failed to delete maintenance filter", "name", name, "namespace", namespace, "error", err)
+			return err
+		}
+		return nil
+	}
+
+	message := locoRes.Spec.MaintenanceMessage
+	if message == "" {
+		message = defaultMaintenanceMessage
+	}
+	page, err := renderStatusPage("Down for maintenance", message)
+	if err != nil {
+		return fmt.Errorf("failed to render maintenance page: %w", err)
+	}
+
+	slog.InfoContext(ctx, "ensuring maintenance filter", "namespace", namespace, "name", name)
+
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, filter, func() error {
+		filter.SetLabels(map[string]string{
+			"app": getName(locoRes),
+		})
+		filter.Object["spec"] = map[string]any{
+			"directResponse": map[string]any{
+				"contentType": "text/html",
+				"statusCode":  int64(http.StatusServiceUnavailable),
+				"body": map[string]any{
+					"type":   "Inline",
+					"inline": page,
+				},
+			},
+		}
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to ensure maintenance filter", "name", name, "namespace", namespace, "error", err)
+		return err
+	}
+
+	slog.InfoContext(ctx, "maintenance filter ensured", "name", name, "namespace", namespace, "op", op)
+	return nil
+}
+
+// ensureWakeBackend ensures the Backend pointing at the API exists while the Application is
+// sleeping, and removes it otherwise
+func (r *LocoResourceReconciler) ensureWakeBackend(ctx context.Context, locoRes *locov1alpha1.Application) error {
+	wanted := locoRes.Spec.Sleeping && !locoRes.Spec.Suspended
+	return r.ensureFQDNBackend(ctx, locoRes, getWakeBackendName(locoRes), r.apiHost, r.apiPort, wanted)
+}
+
+// ensureInterceptorBackend ensures the Backend pointing at the KEDA HTTP interceptor exists while
+// the service scales to zero, and removes it otherwise
+func (r *LocoResourceReconciler) ensureInterceptorBackend(ctx context.Context, locoRes *locov1alpha1.Application) error {
+	return r.ensureFQDNBackend(ctx, locoRes, getInterceptorBackendName(locoRes), r.interceptorHost, r.interceptorPort, usesScaleToZero(locoRes))
+}
+
+// ensureFQDNBackend ensures a Backend resolving to host:port exists when wanted, and removes it otherwise
+func (r *LocoResourceReconciler) ensureFQDNBackend(ctx context.Context, locoRes *locov1alpha1.Application, name, host string, port int64, wanted bool) error {
+	namespace := getNamespace(locoRes)
+
+	backend := &unstructured.Unstructured{}
+	backend.SetGroupVersionKind(backendGVK)
+	backend.SetName(name)
+	backend.SetNamespace(namespace)
+
+	if !wanted {
+		if err := r.Delete(ctx, backend); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			slog.ErrorContext(ctx, "failed to delete backend", "name", name, "namespace", namespace, "error", err)
+			return err
+		}
+		return nil
+	}
+
+	slog.InfoContext(ctx, "ensuring backend", "namespace", namespace, "name", name, "host", host)
+
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, backend, func() error {
+		backend.SetLabels(map[string]string{
+			"app": getName(locoRes),
+		})
+		backend.Object["spec"] = map[string]any{
+			"endpoints": []any{
+				map[string]any{
+					"fqdn": map[string]any{
+						"hostname": host,
+						"port":     port,
+					},
+				},
+			},
+		}
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to ensure backend", "name", name, "namespace", namespace, "error", err)
+		return err
+	}
+
+	slog.InfoContext(ctx, "backend ensured", "name", name, "namespace", namespace, "op", op)
+	return nil
+}
+
+// ensureBackendTrafficPolicy ensures the BackendTrafficPolicy attached to the HTTPRoute, which carries
+// the error page, idle timeout and body size limit. Envoy Gateway only honours one policy per route,
+// so every route-level setting lives here; the policy is removed when none apply.
+func (r *LocoResourceReconciler) ensureBackendTrafficPolicy(ctx context.Context, locoRes *locov1alpha1.Application) error {
+	name := fmt.Sprintf("%s-traffic", getName(locoRes))
+	namespace := getNamespace(locoRes)
+
+	policy := &unstructured.Unstructured{}
+	policy.SetGroupVersionKind(backendTrafficPolicyGVK)
+	policy.SetName(name)
+	policy.SetNamespace(namespace)
+
+	routing := locoRes.Spec.ServiceSpec.Routing
+	if routing == nil {
+		routing = &locov1alpha1.RoutingSpec{}
+	}
+
+	spec := map[string]any{
+		"targetRefs": []any{
+			map[string]any{
+				"group": v1Gateway.GroupName,
+				"kind":  "HTTPRoute",
+				"name":  fmt.Sprintf("%s-route", getName(locoRes)),
+			},
+		},
+	}
+
+	// the API's waking page is a 503 as well, so it is not replaced while sleeping
+	if !locoRes.Spec.Sleeping && (routing.ErrorPage == nil || !routing.ErrorPage.Disabled) {
+		page, err := renderErrorPage(routing.ErrorPage)
+		if err != nil {
+			return fmt.Errorf("failed to render error page: %w", err)
+		}
+		spec["responseOverride"] = []any{
+			map[string]any{
+				"match": map[string]any{
+					"statusCodes": []any{
+						map[string]any{
+							"type": "Range",
+							"range": map[string]any{
+								"start": int64(http.StatusBadGateway),
+								"end":   int64(http.StatusGatewayTimeout),
+							},
+						},
+					},
+				},
+				"response": map[string]any{
+					"contentType": "text/html",
+					"body": map[string]any{
+						"type":   "Inline",
+						"inline": page,
+					},
+				},
+			},
+		}
+	}
+	if routing.IdleTimeout > 0 {
+		spec["timeout"] = map[string]any{
+			"http": map[string]any{
+				"connectionIdleTimeout": fmt.Sprintf("%ds", routing.IdleTimeout),
+			},
+		}
+	}
+	if routing.MaxBodySize != "" {
+		spec["requestBuffer"] = map[string]any{
+			"limit": routing.MaxBodySize,
+		}
+	}
+
+	// only the targetRefs are left, so there is nothing for the policy to do
+	if len(spec) == 1 {
+		if err := r.Delete(ctx, policy); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			slog.ErrorContext(ctx, "failed to delete backend traffic policy", "name", name, "namespace", namespace, "error", err)
+			return err
+		}
+		return nil
+	}
+
+	slog.InfoContext(ctx, "ensuring backend traffic policy", "namespace", namespace, "name", name)
+
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, policy, func() error {
+		policy.SetLabels(map[string]string{
+			"app": getName(locoRes),
+		})
+		policy.Object["spec"] = spec
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to ensure backend traffic policy", "name", name, "namespace", namespace, "error", err)
+		return err
+	}
+
+	slog.InfoContext(ctx, "backend traffic policy ensured", "name", name, "namespace", namespace, "op", op)
+	return nil
+}
+
+// ensureSecurityPolicy ensures the SecurityPolicy and credential secrets enforcing the resource's
+// access control on its HTTPRoute, and removes them when the resource is public
+func (r *LocoResourceReconciler) ensureSecurityPolicy(ctx context.Context, locoRes *locov1alpha1.Application) error {
+	name := fmt.Sprintf("%s-access", getName(locoRes))
+	namespace := getNamespace(locoRes)
+	basicAuthSecretName := fmt.Sprintf("%s-basic-auth", getName(locoRes))
+	oidcSecretName := fmt.Sprintf("%s-oidc", getName(locoRes))
+
+	policy := &unstructured.Unstructured{}
+	policy.SetGroupVersionKind(securityPolicyGVK)
+	policy.SetName(name)
+	policy.SetNamespace(namespace)
+
+	var accessControl *locov1alpha1.AccessControlSpec
+	if locoRes.Spec.ServiceSpec.Routing != nil {
+		accessControl = locoRes.Spec.ServiceSpec.Routing.AccessControl
+	}
+	if accessControl == nil {
+		accessControl = &locov1alpha1.AccessControlSpec{}
+	}
+
+	spec := map[string]any{
+		"targetRefs": []any{
+			map[string]any{
+				"group": v1Gateway.GroupName,
+				"kind":  "HTTPRoute",
+				"name":  fmt.Sprintf("%s-route", getName(locoRes)),
+			},
+		},
+	}
+
+	if len(accessControl.AllowedCIDRs) > 0 {
+		cidrs := make([]any, 0, len(accessControl.AllowedCIDRs))
+		for _, cidr := range accessControl.AllowedCIDRs {
+			cidrs = append(cidrs, cidr)
+		}
+		spec["authorization"] = map[string]any{
+			"defaultAction": "Deny",
+			"rules": []any{
+				map[string]any{
+					"action":    "Allow",
+					"principal": map[string]any{"clientCIDRs": cidrs},
+				},
+			},
+		}
+	}
+
+	basicAuthData := map[string][]byte(nil)
+	if accessControl.BasicAuth != nil {
+		basicAuthData = map[string][]byte{
+			".htpasswd": []byte(strings.Join(accessControl.BasicAuth.Users, "\n") + "\n"),
+		}
+		spec["basicAuth"] = map[string]any{
+			"users": map[string]any{"name": basicAuthSecretName},
+		}
+	}
+	if err := r.ensureOptionalSecret(ctx, locoRes, basicAuthSecretName, basicAuthData); err != nil {
+		return err
+	}
+
+	oidcData := map[string][]byte(nil)
+	if oidc := accessControl.OIDC; oidc != nil {
+		oidcData = map[string][]byte{
+			"client-secret": []byte(oidc.ClientSecret),
+		}
+		oidcSpec := map[string]any{
+			"provider":     map[string]any{"issuer": oidc.Issuer},
+			"clientID":     oidc.ClientID,
+			"clientSecret": map[string]any{"name": oidcSecretName},
+		}
+		if oidc.RedirectURL != "" {
+			oidcSpec["redirectURL"] = oidc.RedirectURL
+		}
+		spec["oidc"] = oidcSpec
+	}
+	if err := r.ensureOptionalSecret(ctx, locoRes, oidcSecretName, oidcData); err != nil {
+		return err
+	}
+
+	// only the targetRefs are left, so the resource is public
+	if len(spec) == 1 {
+		if err := r.Delete(ctx, policy); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			slog.ErrorContext(ctx, "failed to delete security policy", "name", name, "namespace", namespace, "error", err)
+			return err
+		}
+		return nil
+	}
+
+	slog.InfoContext(ctx, "ensuring security policy", "namespace", namespace, "name", name)
+
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, policy, func() error {
+		policy.SetLabels(map[string]string{
+			"app": getName(locoRes),
+		})
+		policy.Object["spec"] = spec
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to ensure security policy", "name", name, "namespace", namespace, "error", err)
+		return err
+	}
+
+	slog.InfoContext(ctx, "security policy ensured", "name", name, "namespace", namespace, "op", op)
+	return nil
+}
+
+// ensureOptionalSecret writes data to the named secret, or deletes the secret when data is nil
+func (r *LocoResourceReconciler) ensureOptionalSecret(ctx context.Context, locoRes *locov1alpha1.Application, name string, data map[string][]byte) error {
+	namespace := getNamespace(locoRes)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+
+	if data == nil {
+		if err := r.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+			slog.ErrorContext(ctx, "failed to delete secret", "name", name, "namespace", namespace, "error", err)
+			return err
+		}
+		return nil
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Labels = map[string]string{
+			"app": getName(locoRes),
+		}
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = data
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to ensure secret", "name", name, "namespace", namespace, "error", err)
+		return err
+	}
+	return nil
+}
+
+// renderErrorPage returns the custom HTML of errorPage, or the branded page built from its title and message
+func renderErrorPage(errorPage *locov1alpha1.ErrorPageSpec) (string, error) {
+	if errorPage == nil {
+		errorPage = &locov1alpha1.ErrorPageSpec{}
+	}
+	if errorPage.HTML != "" {
+		return errorPage.HTML, nil
+	}
+	title := errorPage.Title
+	if title == "" {
+		title = defaultErrorPageTitle
+	}
+	message := errorPage.Message
+	if message == "" {
+		message = defaultErrorPageMessage
+	}
+	return renderStatusPage(title, message)
+}
+
+// ensureScaledObject ensures a KEDA ScaledObject exists when external scale triggers or schedules
+// are configured, and removes a stale one when they are not. CPU and memory targets become KEDA
+// triggers too, since KEDA replaces any other autoscaler of the Deployment.
+func (r *LocoResourceReconciler) ensureScaledObject(ctx context.Context, locoRes *locov1alpha1.Application) error {
+	name := getName(locoRes)
+	namespace := getNamespace(locoRes)
+
+	so := &unstructured.Unstructured{}
+	so.SetGroupVersionKind(scaledObjectGVK)
+	so.SetName(name)
+	so.SetNamespace(namespace)
+
+	if !usesExternalScaling(locoRes) {
+		if err := r.Delete(ctx, so); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			slog.ErrorContext(ctx, "failed to delete ScaledObject", "name", name, "namespace", namespace, "error", err)
+			return err
+		}
+		return nil
+	}
+
+	resources := locoRes.Spec.ServiceSpec.Resources
+	minReplicas := resources.Replicas.Min
+	maxReplicas := resources.Replicas.Max
+	if maxReplicas < minReplicas {
+		maxReplicas = minReplicas
+	}
+
+	scalers := resources.Scalers
+	triggers := make([]any, 0, len(scalers.Triggers)+len(scalers.Schedules)+2)
+	for i, t := range scalers.Triggers {
+		// the API checks triggers too, but an Application may have been written around it
+		if err := locov1alpha1.ValidateScaleTrigger(t); err != nil {
+			slog.ErrorContext(ctx, "refusing scale trigger", "name", name, "namespace", namespace, "index", i, "error", err)
+			return fmt.Errorf("scale trigger %d: %w", i, err)
+		}
+		metadata := make(map[string]any, len(t.Metadata))
+		for k, v := range t.Metadata {
+			metadata[k] = v
+		}
+		trigger := map[string]any{
+			"type":     t.Type,
+			"metadata": metadata,
+		}
+		if locov1alpha1.IsQueueTrigger(t.Type) {
+			trigger["authenticationRef"] = map[string]any{
+				"name": locov1alpha1.QueueTriggerAuthentication,
+				"kind": "ClusterTriggerAuthentication",
+			}
+		}
+		triggers = append(triggers, trigger)
+	}
+	for _, schedule := range scalers.Schedules {
+		triggers = append(triggers, map[string]any{
+			"type": "cron",
+			"metadata": map[string]any{
+				"timezone":        schedule.Timezone,
+				"start":           schedule.Start,
+				"end":             schedule.End,
+				"desiredReplicas": strconv.Itoa(int(schedule.DesiredReplicas)),
+			},
+		})
+	}
+	if scalers.CPUTarget > 0 {
+		triggers = append(triggers, map[string]any{
+			"type":       "cpu",
+			"metricType": "Utilization",
+			"metadata":   map[string]any{"value": strconv.Itoa(int(scalers.CPUTarget))},
+		})
+	}
+	if scalers.MemoryTarget > 0 {
+		triggers = append(triggers, map[string]any{
+			"type":       "memory",
+			"metricType": "Utilization",
+			"metadata":   map[string]any{"value": strconv.Itoa(int(scalers.MemoryTarget))},
+		})
+	}
+
+	spec := map[string]any{
+		"scaleTargetRef": map[string]any{
+			"name": name,
+		},
+		"minReplicaCount": int64(minReplicas),
+		"maxReplicaCount": int64(maxReplicas),
+		"triggers":        triggers,
+	}
+	if scalers.PollingInterval > 0 {
+		spec["pollingInterval"] = int64(scalers.PollingInterval)
+	}
+	if scalers.CooldownPeriod > 0 {
+		spec["cooldownPeriod"] = int64(scalers.CooldownPeriod)
+	}
+	// KEDA only accepts an idle count below the minimum, and cpu and memory triggers never go idle
+	if scalers.IdleAtZero && scalers.CPUTarget == 0 && scalers.MemoryTarget == 0 {
+		spec["idleReplicaCount"] = int64(0)
+	}
+
+	slog.InfoContext(ctx, "ensuring ScaledObject", "namespace", namespace, "name", name, "triggers", len(triggers))
+
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, so, func() error {
+		so.SetLabels(map[string]string{
+			"app": name,
+		})
+		so.Object["spec"] = spec
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to ensure ScaledObject", "name", name, "namespace", namespace, "error", err)
+		return err
+	}
+
+	slog.InfoContext(ctx, "ScaledObject ensured", "name", name, "namespace", namespace, "op", op)
+	return nil
+}
+
+// ensureHTTPScaledObject ensures the HTTPScaledObject exists while the service scales to zero, and
+// removes it otherwise. The add-on creates a ScaledObject of the same name, so it is named apart
+// from the one ensureScaledObject manages.
+func (r *LocoResourceReconciler) ensureHTTPScaledObject(ctx context.Context, locoRes *locov1alpha1.Application) error {
+	name := getName(locoRes)
+	hsoName := fmt.Sprintf("%s-http", name)
+	namespace := getNamespace(locoRes)
+
+	hso := &unstructured.Unstructured{}
+	hso.SetGroupVersionKind(httpScaledObjectGVK)
+	hso.SetName(hsoName)
+	hso.SetNamespace(namespace)
+
+	if !usesScaleToZero(locoRes) {
+		if err := r.Delete(ctx, hso); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			slog.ErrorContext(ctx, "failed to delete HTTPScaledObject", "name", hsoName, "namespace", namespace, "error", err)
+			return err
+		}
+		return nil
+	}
+
+	scaleToZero := locoRes.Spec.ServiceSpec.ScaleToZero
+	scaledownPeriod := scaleToZero.ScaledownPeriod
+	if scaledownPeriod == 0 {
+		scaledownPeriod = defaultScaledownPeriod
+	}
+	targetConcurrency := scaleToZero.TargetConcurrency
+	if targetConcurrency == 0 {
+		targetConcurrency = defaultTargetConcurrency
+	}
+	maxReplicas := int32(1)
+	if resources := locoRes.Spec.ServiceSpec.Resources; resources != nil {
+		maxReplicas = max(resources.Replicas.Max, resources.Replicas.Min, 1)
+	}
+
+	spec := map[string]any{
+		"hosts": []any{locoRes.Spec.ServiceSpec.Routing.HostName},
+		"scaleTargetRef": map[string]any{
+			"name":       name,
+			"kind":       "Deployment",
+			"apiVersion": "apps/v1",
+			"service":    name,
+			"port":       int64(80),
+		},
+		"replicas": map[string]any{
+			"min": int64(0),
+			"max": int64(maxReplicas),
+		},
+		"scaledownPeriod": int64(scaledownPeriod),
+		"scalingMetric": map[string]any{
+			"concurrency": map[string]any{
+				"targetValue": int64(targetConcurrency),
+			},
+		},
+	}
+	if prefix := locoRes.Spec.ServiceSpec.Routing.PathPrefix; prefix != "" && prefix != "/" {
+		spec["pathPrefixes"] = []any{prefix}
+	}
+
+	slog.InfoContext(ctx, "ensuring HTTPScaledObject", "namespace", namespace, "name", hsoName)
+
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, hso, func() error {
+		hso.SetLabels(map[string]string{
+			"app": name,
+		})
+		hso.Object["spec"] = spec
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to ensure HTTPScaledObject", "name", hsoName, "namespace", namespace, "error", err)
+		return err
+	}
+
+	slog.InfoContext(ctx, "HTTPScaledObject ensured", "name", hsoName, "namespace", namespace, "op", op)
+	return nil
+}
+
+// updateLRStatus writes the observed status back to the Application status subresource.
+// The API updates Applications while they reconcile, so a write that conflicts with a newer
+// version is retried on top of it: the status replaces the newer one, its spec is kept.
 func (r *LocoResourceReconciler) updateLRStatus(
 	ctx context.Context,
 	locoRes *locov1alpha1.Application,
 	status *locov1alpha1.ApplicationStatus,
 ) error {
-	locoRes.Status = *status
-	if err := r.Status().Update(ctx, locoRes); err != nil {
-		slog.ErrorContext(ctx, "failed to update Application status", "error", err)
+	desired := *status
+	attempt := 0
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if attempt > 0 {
+			if err := r.Get(ctx, client.ObjectKeyFromObject(locoRes), locoRes); err != nil {
+				return err
+			}
+		}
+		attempt++
+		locoRes.Status = desired
+		return r.Status().Update(ctx, locoRes)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to update Application status", "attempts", attempt, "error", err)
 		return err
 	}
 	return nil
@@ -838,6 +2115,12 @@ func ptrToString(s string) *string {
 	return &s
 }
 
+// ptrToGroup returns a pointer to a Group
+func ptrToGroup(g string) *v1Gateway.Group {
+	t := v1Gateway.Group(g)
+	return &t
+}
+
 // ptrToKind returns a pointer to a Kind
 func ptrToKind(k string) *v1Gateway.Kind {
 	t := v1Gateway.Kind(k)
@@ -891,18 +2174,21 @@ func (r *LocoResourceReconciler) refreshImagePullSecret(ctx context.Context, loc
 	secret := &corev1.Secret{}
 	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: secretName}, secret); err != nil {
 		slog.ErrorContext(ctx, "failed to fetch image pull secret for refresh", "namespace", namespace, "name", secretName, "error", err)
+		secretRefreshes.WithLabelValues("failure").Inc()
 		return
 	}
 
 	expiryStr, ok := secret.Annotations["tokenExpiry"]
 	if !ok {
 		slog.WarnContext(ctx, "image pull secret missing tokenExpiry annotation", "namespace", namespace, "name", secretName)
+		secretRefreshes.WithLabelValues("failure").Inc()
 		return
 	}
 
 	expiryTime, err := time.Parse(time.RFC3339, expiryStr)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to parse tokenExpiry annotation", "namespace", namespace, "name", secretName, "error", err)
+		secretRefreshes.WithLabelValues("failure").Inc()
 		return
 	}
 
@@ -917,12 +2203,14 @@ func (r *LocoResourceReconciler) refreshImagePullSecret(ctx context.Context, loc
 	token, err := r.getGitlabRegistryToken(ctx)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to get new gitlab deploy token", "namespace", namespace, "name", secretName, "error", err)
+		secretRefreshes.WithLabelValues("failure").Inc()
 		return
 	}
 
 	dockerConfig, err := buildDockerConfig(r.gitlabRegistryURL, token.Username, token.Token)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to build docker config", "namespace", namespace, "name", secretName, "error", err)
+		secretRefreshes.WithLabelValues("failure").Inc()
 		return
 	}
 	newExpiryTime := time.Now().Add(55 * time.Minute).UTC().Format(time.RFC3339)
@@ -933,9 +2221,11 @@ func (r *LocoResourceReconciler) refreshImagePullSecret(ctx context.Context, loc
 	err = r.Update(ctx, secret)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to update image pull secret", "namespace", namespace, "name", secretName, "error", err)
+		secretRefreshes.WithLabelValues("failure").Inc()
 		return
 	}
 
+	secretRefreshes.WithLabelValues("success").Inc()
 	slog.InfoContext(ctx, "image pull secret token refreshed successfully", "namespace", namespace, "name", secretName)
 }
 
@@ -1048,13 +2338,62 @@ func (r *LocoResourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.gitlabRegistryURL = os.Getenv("GITLAB_REGISTRY_URL")
 	r.locoNamespace = os.Getenv("LOCO_NAMESPACE")
 
+	apiAddress := os.Getenv("LOCO_API_ADDRESS")
+	if apiAddress == "" {
+		apiAddress = fmt.Sprintf("loco-api.%s.svc.cluster.local:80", r.locoNamespace)
+	}
+	apiHost, apiPort, err := net.SplitHostPort(apiAddress)
+	if err != nil {
+		return fmt.Errorf("invalid LOCO_API_ADDRESS %q: %w", apiAddress, err)
+	}
+	r.apiHost = apiHost
+	if r.apiPort, err = strconv.ParseInt(apiPort, 10, 32); err != nil {
+		return fmt.Errorf("invalid LOCO_API_ADDRESS %q: %w", apiAddress, err)
+	}
+
+	interceptorAddress := os.Getenv("KEDA_HTTP_INTERCEPTOR_ADDRESS")
+	if interceptorAddress == "" {
+		interceptorAddress = "keda-add-ons-http-interceptor-proxy.keda.svc.cluster.local:8080"
+	}
+	interceptorHost, interceptorPort, err := net.SplitHostPort(interceptorAddress)
+	if err != nil {
+		return fmt.Errorf("invalid KEDA_HTTP_INTERCEPTOR_ADDRESS %q: %w", interceptorAddress, err)
+	}
+	r.interceptorHost = interceptorHost
+	if r.interceptorPort, err = strconv.ParseInt(interceptorPort, 10, 32); err != nil {
+		return fmt.Errorf("invalid KEDA_HTTP_INTERCEPTOR_ADDRESS %q: %w", interceptorAddress, err)
+	}
+
 	if r.gitlabURL == "" || r.gitlabPAT == "" || r.gitlabProjectID == "" || r.gitlabRegistryURL == "" {
 		slog.Error("missing required gitlab environment variables")
 		return fmt.Errorf("missing required gitlab environment variables")
 	}
 
+	r.verifiedSignatures = make(map[string]bool)
+	if r.signatureTrust, err = loadSignatureTrustRoot(); err != nil {
+		return err
+	}
+
+	// the Application's own status writes are not changes to reconcile
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&locov1alpha1.Application{}).
+		For(&locov1alpha1.Application{}, builder.WithPredicates(predicate.Or(
+			predicate.GenerationChangedPredicate{},
+			predicate.AnnotationChangedPredicate{},
+			predicate.LabelChangedPredicate{},
+		))).
+		// an app's objects live in its own namespace, so they cannot carry owner references to the
+		// Application; changes to them are mapped back to it by namespace. Edits and deletions are
+		// repaired right away, as reconciling writes the objects back as the spec wants them.
+		Watches(&appsv1.Deployment{}, handler.EnqueueRequestsFromMapFunc(r.applicationForObject)).
+		Watches(&corev1.Service{}, handler.EnqueueRequestsFromMapFunc(r.applicationForObject)).
+		Watches(&batchv1.Job{}, handler.EnqueueRequestsFromMapFunc(r.applicationForObject)).
+		// the gateway writes route status on its own; only changes to the route itself count
+		Watches(&v1Gateway.HTTPRoute{}, handler.EnqueueRequestsFromMapFunc(r.applicationForObject),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Named("application").
+		WithOptions(crcontroller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+			RateLimiter:             r.RateLimiter,
+		}).
 		Complete(r)
 }
@@ -21,8 +21,10 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -82,3 +84,75 @@ var _ = Describe("Application Controller", func() {
 		})
 	})
 })
+
+var _ = Describe("Secret refs", func() {
+	const locoNamespace = "loco-system"
+
+	ctx := context.Background()
+
+	application := &locov1alpha1.Application{
+		Spec: locov1alpha1.ApplicationSpec{
+			WorkspaceId: 7,
+			ResourceId:  42,
+			ServiceSpec: &locov1alpha1.ServiceSpec{
+				Deployment: &locov1alpha1.ServiceDeploymentSpec{
+					Env: map[string]string{
+						"STRIPE_KEY": "secret://payments/api-key",
+						"LOG_LEVEL":  "debug",
+					},
+				},
+			},
+		},
+	}
+	refsKey := types.NamespacedName{Namespace: getNamespace(application), Name: getSecretRefsName(application)}
+	store := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      locov1alpha1.SecretStoreName(7, "payments"),
+			Namespace: locoNamespace,
+		},
+		Data: map[string][]byte{
+			"api-key":        []byte("sk_live_1"),
+			"webhook.secret": []byte("whsec_1"),
+		},
+	}
+
+	BeforeEach(func() {
+		for _, name := range []string{locoNamespace, refsKey.Namespace} {
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			Expect(client.IgnoreAlreadyExists(k8sClient.Create(ctx, namespace))).To(Succeed())
+		}
+		Expect(k8sClient.Create(ctx, store.DeepCopy())).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, store.DeepCopy()))).To(Succeed())
+		refs := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: refsKey.Name, Namespace: refsKey.Namespace}}
+		Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, refs))).To(Succeed())
+	})
+
+	It("copies only the referenced store entries into the app namespace", func() {
+		reconciler := &LocoResourceReconciler{Client: k8sClient, Scheme: k8sClient.Scheme(), locoNamespace: locoNamespace}
+		Expect(reconciler.ensureSecretRefs(ctx, application)).To(Succeed())
+
+		refs := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, refsKey, refs)).To(Succeed())
+		Expect(refs.Data).To(Equal(map[string][]byte{secretRefKey("payments", "api-key"): []byte("sk_live_1")}))
+	})
+
+	It("fails when a referenced entry does not exist", func() {
+		missing := application.DeepCopy()
+		missing.Spec.ServiceSpec.Deployment.Env["SENDGRID_KEY"] = "secret://payments/sendgrid"
+		reconciler := &LocoResourceReconciler{Client: k8sClient, Scheme: k8sClient.Scheme(), locoNamespace: locoNamespace}
+		Expect(reconciler.ensureSecretRefs(ctx, missing)).To(MatchError(ContainSubstring(`references key "sendgrid"`)))
+	})
+
+	It("removes the copy once nothing is referenced", func() {
+		reconciler := &LocoResourceReconciler{Client: k8sClient, Scheme: k8sClient.Scheme(), locoNamespace: locoNamespace}
+		Expect(reconciler.ensureSecretRefs(ctx, application)).To(Succeed())
+
+		plain := application.DeepCopy()
+		delete(plain.Spec.ServiceSpec.Deployment.Env, "STRIPE_KEY")
+		Expect(reconciler.ensureSecretRefs(ctx, plain)).To(Succeed())
+		Expect(errors.IsNotFound(k8sClient.Get(ctx, refsKey, &corev1.Secret{}))).To(BeTrue())
+	})
+})
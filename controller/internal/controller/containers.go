@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	locov1alpha1 "github.com/team-loco/loco/controller/api/v1alpha1"
+)
+
+const (
+	defaultContainerCPU    = "100m"
+	defaultContainerMemory = "64Mi"
+)
+
+// getInitContainers renders the app's sidecars and init containers as the pod's init containers.
+// Sidecars are init containers that keep running, so they come first: they are up before the init
+// containers run, e.g. a database proxy a migration connects through, and before the service starts.
+func getInitContainers(locoRes *locov1alpha1.Application) []corev1.Container {
+	deployment := locoRes.Spec.ServiceSpec.Deployment
+	var containers []corev1.Container
+	always := corev1.ContainerRestartPolicyAlways
+	for _, spec := range deployment.Sidecars {
+		container := extraContainer(locoRes, spec)
+		container.RestartPolicy = &always
+		containers = append(containers, container)
+	}
+	for _, spec := range deployment.InitContainers {
+		containers = append(containers, extraContainer(locoRes, spec))
+	}
+	return containers
+}
+
+// extraContainer renders an init container or sidecar with its own image, env and resources
+func extraContainer(locoRes *locov1alpha1.Application, spec locov1alpha1.ContainerSpec) corev1.Container {
+	deployment := locoRes.Spec.ServiceSpec.Deployment
+	image := spec.Image
+	if image == "" {
+		image = deployment.PinnedImage()
+	}
+
+	env := spec.Env
+	if spec.InheritEnv {
+		env = maps.Clone(deployment.Env)
+		if env == nil {
+			env = make(map[string]string, len(spec.Env))
+		}
+		maps.Copy(env, spec.Env)
+	}
+
+	cpu := resource.MustParse(defaultContainerCPU)
+	if spec.CPU != "" {
+		cpu = resource.MustParse(spec.CPU)
+	}
+	memory := resource.MustParse(defaultContainerMemory)
+	if spec.Memory != "" {
+		memory = resource.MustParse(spec.Memory)
+	}
+
+	return corev1.Container{
+		Name:    spec.Name,
+		Image:   image,
+		Command: spec.Command,
+		Args:    spec.Args,
+		Env:     containerEnvVars(locoRes, env),
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    cpu,
+				corev1.ResourceMemory: memory,
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    cpu,
+				corev1.ResourceMemory: memory,
+			},
+		},
+	}
+}
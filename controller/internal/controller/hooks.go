@@ -0,0 +1,208 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	locov1alpha1 "github.com/team-loco/loco/controller/api/v1alpha1"
+)
+
+const (
+	// hookRevisionLabel ties a hook Job to the revision of the deployment it ran for
+	hookRevisionLabel = "loco.dev/hook-revision"
+	// defaultHookTimeout is how long a hook may run when its spec sets no timeout, in seconds
+	defaultHookTimeout = 600
+)
+
+// errHookFailed is returned for a hook that ran and failed; retrying the same revision won't help
+var errHookFailed = errors.New("hook failed")
+
+// hookRevision identifies what a rollout ships: the image, env and hooks. Hooks run once per
+// revision, so scaling, sleeping or restarting the app does not run them again.
+func hookRevision(deployment *locov1alpha1.ServiceDeploymentSpec) string {
+	data, _ := json.Marshal(struct {
+		Image string                  `json:"image"`
+		Env   map[string]string       `json:"env"`
+		Hooks []locov1alpha1.HookSpec `json:"hooks"`
+	}{deployment.PinnedImage(), deployment.Env, deployment.Hooks})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:10]
+}
+
+// runHooks runs the hooks of a phase for the current revision in order, one Job at a time.
+// It returns a status message while a hook is still running and "" once all of them succeeded.
+// A hook that failed or timed out is returned as an error wrapping errHookFailed.
+func (r *LocoResourceReconciler) runHooks(ctx context.Context, locoRes *locov1alpha1.Application, phase string) (string, error) {
+	deployment := locoRes.Spec.ServiceSpec.Deployment
+	revision := hookRevision(deployment)
+
+	if phase == locov1alpha1.HookPhasePreDeploy {
+		if err := r.cleanupHookJobs(ctx, locoRes, revision); err != nil {
+			return "", fmt.Errorf("failed to clean up old hook jobs: %w", err)
+		}
+	}
+
+	for _, hook := range deployment.Hooks {
+		if hook.Phase != phase {
+			continue
+		}
+		job, err := r.ensureHookJob(ctx, locoRes, hook, revision)
+		if err != nil {
+			return "", fmt.Errorf("failed to ensure %s hook %s: %w", hookPhaseName(phase), hook.Name, err)
+		}
+		if cond := jobCondition(job, batchv1.JobFailed); cond != nil {
+			return "", fmt.Errorf("%w: %s hook %s: %s", errHookFailed, hookPhaseName(phase), hook.Name, cond.Message)
+		}
+		if jobCondition(job, batchv1.JobComplete) == nil {
+			return fmt.Sprintf("Running %s hook %s", hookPhaseName(phase), hook.Name), nil
+		}
+	}
+	return "", nil
+}
+
+// ensureHookJob returns the hook's Job for the revision, creating it on first sight.
+// Jobs are immutable once created, so an existing one is left as is.
+func (r *LocoResourceReconciler) ensureHookJob(ctx context.Context, locoRes *locov1alpha1.Application, hook locov1alpha1.HookSpec, revision string) (*batchv1.Job, error) {
+	name := getName(locoRes)
+	namespace := getNamespace(locoRes)
+	jobName := fmt.Sprintf("hook-%s-%s", hook.Name, revision)
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, client.ObjectKey{Name: jobName, Namespace: namespace}, job)
+	if err == nil {
+		return job, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	image := hook.Image
+	if image == "" {
+		image = locoRes.Spec.ServiceSpec.Deployment.PinnedImage()
+	}
+	timeout := int64(defaultHookTimeout)
+	if hook.TimeoutSeconds > 0 {
+		timeout = int64(hook.TimeoutSeconds)
+	}
+	backoffLimit := int32(0)
+	cpu := resource.MustParse(locoRes.Spec.ServiceSpec.Resources.CPU)
+	memory := resource.MustParse(locoRes.Spec.ServiceSpec.Resources.Memory)
+
+	// no "app" label: the service selects on it and must not route traffic to hook pods
+	hookLabels := map[string]string{
+		locov1alpha1.HookLabel: hook.Name,
+		hookRevisionLabel:      revision,
+	}
+
+	job = &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: namespace,
+			Labels:    hookLabels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          &backoffLimit,
+			ActiveDeadlineSeconds: &timeout,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: hookLabels,
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: name,
+					RestartPolicy:      corev1.RestartPolicyNever,
+					// hooks run the app's image, so they need the same kind of node
+					NodeSelector: getNodeSelector(locoRes),
+					Tolerations:  getTolerations(locoRes),
+					Containers: []corev1.Container{
+						{
+							// named after the hook so log streams can tell hooks apart
+							Name:    hook.Name,
+							Image:   image,
+							Command: hook.Command,
+							Env:     getContainerEnv(locoRes),
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    cpu,
+									corev1.ResourceMemory: memory,
+								},
+								Limits: corev1.ResourceList{
+									corev1.ResourceCPU:    cpu,
+									corev1.ResourceMemory: memory,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	slog.InfoContext(ctx, "creating hook job", "namespace", namespace, "name", jobName, "phase", hook.Phase, "image", image)
+	if err := r.Create(ctx, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// cleanupHookJobs deletes hook Jobs, and their pods, left from earlier revisions
+func (r *LocoResourceReconciler) cleanupHookJobs(ctx context.Context, locoRes *locov1alpha1.Application, revision string) error {
+	namespace := getNamespace(locoRes)
+
+	hasHook, err := labels.NewRequirement(locov1alpha1.HookLabel, selection.Exists, nil)
+	if err != nil {
+		return err
+	}
+	otherRevision, err := labels.NewRequirement(hookRevisionLabel, selection.NotEquals, []string{revision})
+	if err != nil {
+		return err
+	}
+
+	var jobs batchv1.JobList
+	if err := r.List(ctx, &jobs,
+		client.InNamespace(namespace),
+		client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(*hasHook, *otherRevision)},
+	); err != nil {
+		return err
+	}
+
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		slog.InfoContext(ctx, "deleting old hook job", "namespace", namespace, "name", job.Name)
+		if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// jobCondition returns the Job's condition of the given type when it is true
+func jobCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) *batchv1.JobCondition {
+	for i := range job.Status.Conditions {
+		cond := &job.Status.Conditions[i]
+		if cond.Type == conditionType && cond.Status == corev1.ConditionTrue {
+			return cond
+		}
+	}
+	return nil
+}
+
+func hookPhaseName(phase string) string {
+	if phase == locov1alpha1.HookPhasePreDeploy {
+		return "pre-deploy"
+	}
+	return "post-deploy"
+}
@@ -0,0 +1,180 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	locov1alpha1 "github.com/team-loco/loco/controller/api/v1alpha1"
+)
+
+// errResourcesRejected is returned for an app asking for more CPU or memory than its plan allows
+// when limits are enforced by rejecting; retrying the same spec won't help
+var errResourcesRejected = errors.New("requested resources exceed plan limits")
+
+// conditionResourceLimits reports whether the app's CPU and memory were within its plan's limits
+const conditionResourceLimits = "ResourceLimits"
+
+// defaultResourceLimitsConfigMap is read from the loco namespace unless RESOURCE_LIMITS_CONFIGMAP
+// names another. Its keys are:
+//
+//	defaultCpu, defaultMemory       used when an app leaves CPU or memory unset
+//	maxCpu, maxMemory               the most any app may ask for
+//	<plan>.maxCpu, <plan>.maxMemory the most an app on that plan may ask for
+//	enforcement                     clamp (the default) or reject apps asking for more
+//
+// Changes are picked up the next time each app is reconciled.
+const defaultResourceLimitsConfigMap = "loco-resource-limits"
+
+// resourceLimits holds the defaults and maximums one app is held to. A nil maximum is no maximum.
+type resourceLimits struct {
+	defaultCPU    resource.Quantity
+	defaultMemory resource.Quantity
+	maxCPU        *resource.Quantity
+	maxMemory     *resource.Quantity
+	reject        bool
+}
+
+// loadResourceLimits reads the limits for plan from the platform's ConfigMap, falling back to
+// built-in defaults and no maximums when there isn't one
+func (r *LocoResourceReconciler) loadResourceLimits(ctx context.Context, plan string) (resourceLimits, error) {
+	limits := resourceLimits{
+		defaultCPU:    resource.MustParse("100m"),
+		defaultMemory: resource.MustParse("128Mi"),
+	}
+
+	name := os.Getenv("RESOURCE_LIMITS_CONFIGMAP")
+	if name == "" {
+		name = defaultResourceLimitsConfigMap
+	}
+	cm := &corev1.ConfigMap{}
+	err := r.Get(ctx, client.ObjectKey{Namespace: r.locoNamespace, Name: name}, cm)
+	if apierrors.IsNotFound(err) {
+		return limits, nil
+	}
+	if err != nil {
+		return limits, fmt.Errorf("failed to get resource limits config: %w", err)
+	}
+
+	parse := func(key string) (*resource.Quantity, error) {
+		value, ok := cm.Data[key]
+		if !ok {
+			return nil, nil
+		}
+		q, err := resource.ParseQuantity(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s in %s: %w", key, name, err)
+		}
+		return &q, nil
+	}
+	for key, dst := range map[string]*resource.Quantity{"defaultCpu": &limits.defaultCPU, "defaultMemory": &limits.defaultMemory} {
+		q, err := parse(key)
+		if err != nil {
+			return limits, err
+		}
+		if q != nil {
+			*dst = *q
+		}
+	}
+	for key, dst := range map[string]**resource.Quantity{"maxCpu": &limits.maxCPU, "maxMemory": &limits.maxMemory} {
+		q, err := parse(key)
+		if err != nil {
+			return limits, err
+		}
+		if plan != "" {
+			planQ, err := parse(plan + "." + key)
+			if err != nil {
+				return limits, err
+			}
+			if planQ != nil {
+				q = planQ
+			}
+		}
+		*dst = q
+	}
+
+	switch enforcement := strings.TrimSpace(cm.Data["enforcement"]); enforcement {
+	case "", "clamp":
+	case "reject":
+		limits.reject = true
+	default:
+		return limits, fmt.Errorf("invalid enforcement %q in %s: must be clamp or reject", enforcement, name)
+	}
+	return limits, nil
+}
+
+// applyResourceLimits fills in the app's CPU and memory from the platform defaults where unset and
+// holds them to its plan's maximums, recording the outcome in the ResourceLimits condition. Values
+// over a maximum are lowered to it, or rejected with errResourcesRejected when enforcement is reject.
+// Only the in-memory spec is changed; the stored Application keeps what was asked for.
+func (r *LocoResourceReconciler) applyResourceLimits(ctx context.Context, locoRes *locov1alpha1.Application) error {
+	limits, err := r.loadResourceLimits(ctx, locoRes.Spec.Plan)
+	if err != nil {
+		return err
+	}
+
+	resources := locoRes.Spec.ServiceSpec.Resources
+	if resources == nil {
+		resources = &locov1alpha1.ResourcesSpec{}
+		locoRes.Spec.ServiceSpec.Resources = resources
+	}
+	var over []string
+	hold := func(field, value string, def resource.Quantity, max *resource.Quantity) (string, error) {
+		if value == "" {
+			value = def.String()
+		}
+		q, err := resource.ParseQuantity(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s %q: %w", field, value, err)
+		}
+		if max != nil && q.Cmp(*max) > 0 {
+			over = append(over, fmt.Sprintf("%s %s exceeds the maximum of %s", field, q.String(), max.String()))
+			return max.String(), nil
+		}
+		return value, nil
+	}
+	cpu, err := hold("cpu", resources.CPU, limits.defaultCPU, limits.maxCPU)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errResourcesRejected, err)
+	}
+	memory, err := hold("memory", resources.Memory, limits.defaultMemory, limits.maxMemory)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errResourcesRejected, err)
+	}
+
+	condition := metav1.Condition{
+		Type:               conditionResourceLimits,
+		Status:             metav1.ConditionTrue,
+		Reason:             "WithinLimits",
+		Message:            "CPU and memory are within the plan's limits",
+		ObservedGeneration: locoRes.Generation,
+	}
+	if len(over) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Message = strings.Join(over, "; ")
+		if locoRes.Spec.Plan != "" {
+			condition.Message += fmt.Sprintf(" for plan %s", locoRes.Spec.Plan)
+		}
+		if limits.reject {
+			condition.Reason = "Rejected"
+			meta.SetStatusCondition(&locoRes.Status.Conditions, condition)
+			return fmt.Errorf("%w: %s", errResourcesRejected, condition.Message)
+		}
+		condition.Reason = "Clamped"
+		condition.Message += "; lowered to the maximum"
+	}
+	meta.SetStatusCondition(&locoRes.Status.Conditions, condition)
+
+	resources.CPU = cpu
+	resources.Memory = memory
+	return nil
+}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Metrics served on the manager's metrics endpoint alongside controller-runtime's own, so operators
+// can alert on reconciles failing, and on which step they fail at.
+var (
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "loco_controller_reconcile_duration_seconds",
+		Help:    "How long reconciling an Application took, by result (success, requeue or error).",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"result"})
+
+	reconcileStepErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "loco_controller_reconcile_step_errors_total",
+		Help: "Reconciles that failed, by the step that failed, e.g. namespace, deployment or route.",
+	}, []string{"step"})
+
+	secretRefreshes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "loco_controller_secret_refreshes_total",
+		Help: "Image pull secret token refreshes, by result (success or failure).",
+	}, []string{"result"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(reconcileDuration, reconcileStepErrors, secretRefreshes)
+}
+
+// observeReconcile records how long a reconcile took and how it ended
+func observeReconcile(duration time.Duration, result ctrl.Result, err error) {
+	outcome := "success"
+	switch {
+	case err != nil:
+		outcome = "error"
+	case result.RequeueAfter > 0:
+		outcome = "requeue"
+	}
+	reconcileDuration.WithLabelValues(outcome).Observe(duration.Seconds())
+}
+
+// stepFailed counts a reconcile that failed at step
+func stepFailed(step string) {
+	reconcileStepErrors.WithLabelValues(step).Inc()
+}
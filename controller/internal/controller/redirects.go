@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	v1Gateway "sigs.k8s.io/gateway-api/apis/v1"
+
+	locov1alpha1 "github.com/team-loco/loco/controller/api/v1alpha1"
+)
+
+// redirectRouteLabel marks the HTTPRoutes redirecting an app's other domains, so those no longer
+// wanted can be found and removed
+const redirectRouteLabel = "loco.dev/redirect"
+
+// getRedirectRouteName names the HTTPRoute redirecting hostName
+func getRedirectRouteName(locoRes *locov1alpha1.Application, hostName string) string {
+	return fmt.Sprintf("%s-redirect-%s", getName(locoRes), strings.ReplaceAll(hostName, ".", "-"))
+}
+
+// ensureRedirectRoutes ensures an HTTPRoute answering each redirected domain with a redirect to
+// its target, and removes the routes of domains that no longer redirect. Each domain gets its own
+// route since a route's rules apply to all of its hostnames.
+func (r *LocoResourceReconciler) ensureRedirectRoutes(ctx context.Context, locoRes *locov1alpha1.Application) error {
+	name := getName(locoRes)
+	namespace := getNamespace(locoRes)
+
+	var redirects []locov1alpha1.RedirectSpec
+	if locoRes.Spec.ServiceSpec.Routing != nil {
+		redirects = locoRes.Spec.ServiceSpec.Routing.Redirects
+	}
+
+	wanted := make(map[string]bool, len(redirects))
+	for _, redirect := range redirects {
+		route := &v1Gateway.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      getRedirectRouteName(locoRes, redirect.HostName),
+				Namespace: namespace,
+			},
+		}
+		wanted[route.Name] = true
+
+		statusCode := int(redirect.StatusCode)
+		if statusCode == 0 {
+			statusCode = 301
+		}
+		filter := &v1Gateway.HTTPRequestRedirectFilter{
+			Scheme:     ptrToString("https"),
+			Hostname:   (*v1Gateway.PreciseHostname)(ptrToString(redirect.TargetHostName)),
+			StatusCode: &statusCode,
+		}
+		if !redirect.PreservePath {
+			filter.Path = &v1Gateway.HTTPPathModifier{
+				Type:            v1Gateway.FullPathHTTPPathModifier,
+				ReplaceFullPath: ptrToString("/"),
+			}
+		}
+
+		op, err := controllerutil.CreateOrUpdate(ctx, r.Client, route, func() error {
+			route.Labels = map[string]string{
+				"app":              name,
+				redirectRouteLabel: "true",
+			}
+			route.Spec.Hostnames = []v1Gateway.Hostname{v1Gateway.Hostname(redirect.HostName)}
+			route.Spec.ParentRefs = []v1Gateway.ParentReference{
+				{
+					Name:      v1Gateway.ObjectName(gatewayName),
+					Namespace: (*v1Gateway.Namespace)(&r.locoNamespace),
+				},
+			}
+			route.Spec.Rules = []v1Gateway.HTTPRouteRule{
+				{
+					Filters: []v1Gateway.HTTPRouteFilter{
+						{
+							Type:            v1Gateway.HTTPRouteFilterRequestRedirect,
+							RequestRedirect: filter,
+						},
+					},
+				},
+			}
+			return nil
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to ensure redirect HTTPRoute", "name", route.Name, "namespace", namespace, "error", err)
+			return err
+		}
+		slog.InfoContext(ctx, "redirect HTTPRoute ensured", "name", route.Name, "from", redirect.HostName, "to", redirect.TargetHostName, "op", op)
+	}
+
+	var routes v1Gateway.HTTPRouteList
+	if err := r.List(ctx, &routes,
+		client.InNamespace(namespace),
+		client.MatchingLabels{"app": name, redirectRouteLabel: "true"},
+	); err != nil {
+		return fmt.Errorf("failed to list redirect HTTPRoutes: %w", err)
+	}
+	for i := range routes.Items {
+		route := &routes.Items[i]
+		if wanted[route.Name] {
+			continue
+		}
+		slog.InfoContext(ctx, "deleting redirect HTTPRoute", "name", route.Name, "namespace", namespace)
+		if err := r.Delete(ctx, route); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1Gateway "sigs.k8s.io/gateway-api/apis/v1"
+
+	locov1alpha1 "github.com/team-loco/loco/controller/api/v1alpha1"
+)
+
+// gatewayName is the Gateway every app's HTTPRoute attaches to, in the loco namespace
+const gatewayName = "eg"
+
+// dnsLookupTimeout bounds resolving an app's hostname while checking its routing
+const dnsLookupTimeout = 5 * time.Second
+
+// checkRouting records whether traffic for the app's hostname reaches it in the RouteProgrammed
+// and DNSResolved conditions, and returns what it is still waiting for, or "" once the gateway
+// has accepted and programmed its route and, for platform subdomains, the hostname resolves.
+// A running deployment behind a route the gateway never took is the "deployed but 404" case, so
+// the app is not ready until this passes.
+func (r *LocoResourceReconciler) checkRouting(ctx context.Context, locoRes *locov1alpha1.Application) (string, error) {
+	routing := locoRes.Spec.ServiceSpec.Routing
+	if routing == nil || routing.HostName == "" {
+		return "", nil
+	}
+
+	programmed, reason, message, err := r.routeProgrammed(ctx, locoRes)
+	if err != nil {
+		return "", err
+	}
+	condition := metav1.Condition{
+		Type:               locov1alpha1.ConditionRouteProgrammed,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Programmed",
+		Message:            "The gateway is serving the route",
+		ObservedGeneration: locoRes.Generation,
+	}
+	if !programmed {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reason
+		condition.Message = message
+	}
+	meta.SetStatusCondition(&locoRes.Status.Conditions, condition)
+
+	lookupCtx, cancel := context.WithTimeout(ctx, dnsLookupTimeout)
+	defer cancel()
+	_, lookupErr := net.DefaultResolver.LookupHost(lookupCtx, routing.HostName)
+	condition = metav1.Condition{
+		Type:               locov1alpha1.ConditionDNSResolved,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Resolved",
+		Message:            fmt.Sprintf("%s resolves", routing.HostName),
+		ObservedGeneration: locoRes.Generation,
+	}
+	if lookupErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "NotResolved"
+		condition.Message = fmt.Sprintf("%s does not resolve: %v", routing.HostName, lookupErr)
+	}
+	meta.SetStatusCondition(&locoRes.Status.Conditions, condition)
+
+	switch {
+	case !programmed:
+		return "Waiting for the gateway to program the route: " + message, nil
+	case lookupErr != nil && routing.PlatformDomain:
+		return fmt.Sprintf("Waiting for %s to resolve", routing.HostName), nil
+	}
+	return "", nil
+}
+
+// routeProgrammed reports whether the gateway accepted the app's HTTPRoute at its current
+// generation with all its backends resolved, and the gateway itself is programmed. When not, it
+// returns the reason and message of the condition holding it back.
+func (r *LocoResourceReconciler) routeProgrammed(ctx context.Context, locoRes *locov1alpha1.Application) (bool, string, string, error) {
+	route := &v1Gateway.HTTPRoute{}
+	err := r.Get(ctx, client.ObjectKey{Name: fmt.Sprintf("%s-route", getName(locoRes)), Namespace: getNamespace(locoRes)}, route)
+	if apierrors.IsNotFound(err) {
+		return false, "RouteNotFound", "the route does not exist yet", nil
+	}
+	if err != nil {
+		return false, "", "", fmt.Errorf("failed to get HTTPRoute: %w", err)
+	}
+
+	var parent *v1Gateway.RouteParentStatus
+	for i := range route.Status.Parents {
+		if string(route.Status.Parents[i].ParentRef.Name) == gatewayName {
+			parent = &route.Status.Parents[i]
+			break
+		}
+	}
+	if parent == nil {
+		return false, "Pending", "the gateway has not picked up the route", nil
+	}
+	for _, conditionType := range []v1Gateway.RouteConditionType{v1Gateway.RouteConditionAccepted, v1Gateway.RouteConditionResolvedRefs} {
+		condition := meta.FindStatusCondition(parent.Conditions, string(conditionType))
+		if condition == nil || condition.ObservedGeneration < route.Generation {
+			return false, "Pending", "the gateway has not picked up the latest route", nil
+		}
+		if condition.Status != metav1.ConditionTrue {
+			return false, condition.Reason, condition.Message, nil
+		}
+	}
+
+	gateway := &v1Gateway.Gateway{}
+	if err := r.Get(ctx, client.ObjectKey{Name: gatewayName, Namespace: r.locoNamespace}, gateway); err != nil {
+		return false, "", "", fmt.Errorf("failed to get Gateway: %w", err)
+	}
+	condition := meta.FindStatusCondition(gateway.Status.Conditions, string(v1Gateway.GatewayConditionProgrammed))
+	if condition == nil || condition.Status != metav1.ConditionTrue {
+		return false, "GatewayNotProgrammed", "the gateway is not programmed", nil
+	}
+	return true, "", "", nil
+}
@@ -0,0 +1,149 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	locov1alpha1 "github.com/team-loco/loco/controller/api/v1alpha1"
+	"github.com/team-loco/loco/controller/pkg/imagesig"
+)
+
+// errSignatureRejected is returned for an image whose signatures its policies do not accept;
+// retrying the same image won't help
+var errSignatureRejected = errors.New("image signature rejected")
+
+// loadSignatureTrustRoot reads the Fulcio roots and Rekor key keyless signatures are checked
+// against. Without COSIGN_FULCIO_ROOTS_FILE only signatures made with a key are accepted.
+func loadSignatureTrustRoot() (*imagesig.TrustRoot, error) {
+	fulcioFile := os.Getenv("COSIGN_FULCIO_ROOTS_FILE")
+	rekorFile := os.Getenv("COSIGN_REKOR_KEY_FILE")
+	if fulcioFile == "" && rekorFile == "" {
+		return nil, nil
+	}
+	if fulcioFile == "" || rekorFile == "" {
+		return nil, fmt.Errorf("COSIGN_FULCIO_ROOTS_FILE and COSIGN_REKOR_KEY_FILE must be set together")
+	}
+	fulcio, err := os.ReadFile(fulcioFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Fulcio roots: %w", err)
+	}
+	rekor, err := os.ReadFile(rekorFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Rekor key: %w", err)
+	}
+	return imagesig.ParseTrustRoot(fulcio, rekor)
+}
+
+// verifyImageSignatures checks the image pods are about to run carries a signature accepted by
+// each of the deployment's signature policies. The API checked them when the deployment was
+// created; checking again here keeps an image from rolling out when its signatures were never
+// checked or have since been removed. An image and set of policies that passed are not checked
+// again until either changes.
+func (r *LocoResourceReconciler) verifyImageSignatures(ctx context.Context, locoRes *locov1alpha1.Application) error {
+	deployment := locoRes.Spec.ServiceSpec.Deployment
+	if len(deployment.SignaturePolicies) == 0 {
+		return nil
+	}
+	if deployment.ImageDigest == "" {
+		return fmt.Errorf("%w: signed images must be pinned to a digest", errSignatureRejected)
+	}
+
+	image := deployment.PinnedImage()
+	key := signatureCacheKey(image, deployment.SignaturePolicies)
+	r.verifiedSignaturesMux.Lock()
+	verified := r.verifiedSignatures[key]
+	r.verifiedSignaturesMux.Unlock()
+	if verified {
+		return nil
+	}
+
+	fetcher := imagesig.NewRegistryFetcher(&http.Client{Timeout: 10 * time.Second}, r.registryCredentials(locoRes))
+	verifier := imagesig.NewVerifier(fetcher, r.signatureTrust)
+	for i, policy := range deployment.SignaturePolicies {
+		err := verifier.Verify(ctx, image, signaturePolicy(policy))
+		switch {
+		case err == nil:
+		case errors.Is(err, imagesig.ErrNoSignature), errors.Is(err, imagesig.ErrUnverified),
+			errors.Is(err, imagesig.ErrNoTrustRoot), errors.Is(err, imagesig.ErrInvalidKey), errors.Is(err, imagesig.ErrInvalidImage):
+			return fmt.Errorf("%w: signature policy %d: %v", errSignatureRejected, i, err)
+		default:
+			return fmt.Errorf("failed to verify image signature: %w", err)
+		}
+	}
+
+	slog.InfoContext(ctx, "verified image signatures", "image", image, "policies", len(deployment.SignaturePolicies))
+	r.verifiedSignaturesMux.Lock()
+	r.verifiedSignatures[key] = true
+	r.verifiedSignaturesMux.Unlock()
+	return nil
+}
+
+// registryCredentials returns the GitLab registry's credentials from the app's image pull secret,
+// and no credentials for any other registry.
+func (r *LocoResourceReconciler) registryCredentials(locoRes *locov1alpha1.Application) imagesig.Credentials {
+	return func(ctx context.Context, host string) (string, string, error) {
+		registryHost := r.gitlabRegistryURL
+		if u, err := url.Parse(registryHost); err == nil && u.Host != "" {
+			registryHost = u.Host
+		}
+		if host != registryHost {
+			return "", "", nil
+		}
+
+		secret := &corev1.Secret{}
+		err := r.Get(ctx, client.ObjectKey{Name: getImageSecretName(locoRes), Namespace: getNamespace(locoRes)}, secret)
+		if apierrors.IsNotFound(err) {
+			return "", "", nil
+		}
+		if err != nil {
+			return "", "", err
+		}
+		var config struct {
+			Auths map[string]struct {
+				Auth string `json:"auth"`
+			} `json:"auths"`
+		}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &config); err != nil {
+			return "", "", fmt.Errorf("invalid image pull secret: %w", err)
+		}
+		auth, err := base64.StdEncoding.DecodeString(config.Auths[r.gitlabRegistryURL].Auth)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid image pull secret: %w", err)
+		}
+		username, password, _ := strings.Cut(string(auth), ":")
+		return username, password, nil
+	}
+}
+
+func signaturePolicy(spec locov1alpha1.SignaturePolicySpec) imagesig.Policy {
+	policy := imagesig.Policy{PublicKeys: spec.PublicKeys}
+	for _, identity := range spec.Identities {
+		policy.Identities = append(policy.Identities, imagesig.Identity{
+			Issuer:        identity.Issuer,
+			Subject:       identity.Subject,
+			SubjectRegexp: identity.SubjectRegexp,
+		})
+	}
+	return policy
+}
+
+func signatureCacheKey(image string, policies []locov1alpha1.SignaturePolicySpec) string {
+	data, _ := json.Marshal(policies)
+	sum := sha256.Sum256(append([]byte(image+"\n"), data...))
+	return hex.EncodeToString(sum[:])
+}
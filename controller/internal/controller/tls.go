@@ -0,0 +1,236 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	v1Gateway "sigs.k8s.io/gateway-api/apis/v1"
+
+	locov1alpha1 "github.com/team-loco/loco/controller/api/v1alpha1"
+)
+
+// gatewayHTTPSListener is the gateway's shared HTTPS listener, whose certificates an app's own
+// listener serves too
+const gatewayHTTPSListener = "https"
+
+// clientCAKey is the ConfigMap key Envoy Gateway reads a client CA bundle from
+const clientCAKey = "ca.crt"
+
+// getTLSListenerName names the gateway listener of an app with its own TLS policy
+func getTLSListenerName(locoRes *locov1alpha1.Application) string {
+	return getName(locoRes)
+}
+
+// getTLSPolicyName names the ClientTrafficPolicy holding an app's TLS policy, and the ConfigMap
+// with its client CA bundle
+func getTLSPolicyName(locoRes *locov1alpha1.Application) string {
+	return fmt.Sprintf("%s-tls", getName(locoRes))
+}
+
+// hstsFilter returns the filter adding the Strict-Transport-Security header to the app's
+// responses, or nil when the app sends none
+func hstsFilter(locoRes *locov1alpha1.Application) *v1Gateway.HTTPRouteFilter {
+	routing := locoRes.Spec.ServiceSpec.Routing
+	if routing == nil || routing.TLS == nil || routing.TLS.HSTSMaxAge <= 0 {
+		return nil
+	}
+	return &v1Gateway.HTTPRouteFilter{
+		Type: v1Gateway.HTTPRouteFilterResponseHeaderModifier,
+		ResponseHeaderModifier: &v1Gateway.HTTPHeaderFilter{
+			Set: []v1Gateway.HTTPHeader{
+				{Name: "Strict-Transport-Security", Value: fmt.Sprintf("max-age=%d", routing.TLS.HSTSMaxAge)},
+			},
+		},
+	}
+}
+
+// ensureTLSPolicy gives an app with a minimum TLS version or client certificate requirement its
+// own HTTPS listener on the gateway, with a ClientTrafficPolicy applying them, since the gateway
+// only applies TLS settings per listener. Apps without either share the gateway's HTTPS listener,
+// and anything left over from an earlier policy is removed.
+func (r *LocoResourceReconciler) ensureTLSPolicy(ctx context.Context, locoRes *locov1alpha1.Application) error {
+	routing := locoRes.Spec.ServiceSpec.Routing
+	if routing == nil || routing.HostName == "" || routing.TLS == nil ||
+		(routing.TLS.MinVersion == "" && routing.TLS.ClientCACertificate == "") {
+		return r.deleteTLSPolicy(ctx, locoRes)
+	}
+	tls := routing.TLS
+	name := getTLSPolicyName(locoRes)
+
+	if err := r.ensureTLSListener(ctx, locoRes, routing.HostName); err != nil {
+		return err
+	}
+
+	clientCA := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: r.locoNamespace},
+	}
+	if tls.ClientCACertificate == "" {
+		if err := r.Delete(ctx, clientCA); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete client CA: %w", err)
+		}
+	} else {
+		_, err := controllerutil.CreateOrUpdate(ctx, r.Client, clientCA, func() error {
+			clientCA.Labels = map[string]string{"app": getName(locoRes)}
+			clientCA.Data = map[string]string{clientCAKey: tls.ClientCACertificate}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to ensure client CA: %w", err)
+		}
+	}
+
+	tlsSpec := map[string]any{}
+	if tls.MinVersion != "" {
+		tlsSpec["minVersion"] = tls.MinVersion
+	}
+	if tls.ClientCACertificate != "" {
+		tlsSpec["clientValidation"] = map[string]any{
+			"caCertificateRefs": []any{
+				map[string]any{"kind": "ConfigMap", "group": "", "name": name},
+			},
+		}
+	}
+
+	policy := &unstructured.Unstructured{}
+	policy.SetGroupVersionKind(clientTrafficPolicyGVK)
+	policy.SetName(name)
+	policy.SetNamespace(r.locoNamespace)
+
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, policy, func() error {
+		policy.SetLabels(map[string]string{
+			"app": getName(locoRes),
+		})
+		policy.Object["spec"] = map[string]any{
+			"targetRefs": []any{
+				map[string]any{
+					"group":       v1Gateway.GroupName,
+					"kind":        "Gateway",
+					"name":        gatewayName,
+					"sectionName": getTLSListenerName(locoRes),
+				},
+			},
+			"tls": tlsSpec,
+		}
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to ensure client traffic policy", "name", name, "namespace", r.locoNamespace, "error", err)
+		return err
+	}
+
+	slog.InfoContext(ctx, "client traffic policy ensured", "name", name, "namespace", r.locoNamespace, "op", op)
+	return nil
+}
+
+// ensureTLSListener ensures the gateway has an HTTPS listener for hostName alone, serving the
+// certificates of its shared HTTPS listener and only taking routes from the app's namespace
+func (r *LocoResourceReconciler) ensureTLSListener(ctx context.Context, locoRes *locov1alpha1.Application, hostName string) error {
+	listenerName := v1Gateway.SectionName(getTLSListenerName(locoRes))
+	hostname := v1Gateway.Hostname(hostName)
+	from := v1Gateway.NamespacesFromSelector
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		gateway := &v1Gateway.Gateway{}
+		if err := r.Get(ctx, client.ObjectKey{Name: gatewayName, Namespace: r.locoNamespace}, gateway); err != nil {
+			return fmt.Errorf("failed to get Gateway: %w", err)
+		}
+
+		var shared *v1Gateway.Listener
+		existing := -1
+		for i := range gateway.Spec.Listeners {
+			switch gateway.Spec.Listeners[i].Name {
+			case gatewayHTTPSListener:
+				shared = &gateway.Spec.Listeners[i]
+			case listenerName:
+				existing = i
+			}
+		}
+		if shared == nil || shared.TLS == nil {
+			return fmt.Errorf("gateway %s has no %s listener to take certificates from", gatewayName, gatewayHTTPSListener)
+		}
+
+		listener := v1Gateway.Listener{
+			Name:     listenerName,
+			Hostname: &hostname,
+			Port:     shared.Port,
+			Protocol: v1Gateway.HTTPSProtocolType,
+			TLS:      shared.TLS.DeepCopy(),
+			AllowedRoutes: &v1Gateway.AllowedRoutes{
+				Namespaces: &v1Gateway.RouteNamespaces{
+					From: &from,
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{corev1.LabelMetadataName: getNamespace(locoRes)},
+					},
+				},
+			},
+		}
+		if existing >= 0 {
+			if equality.Semantic.DeepEqual(gateway.Spec.Listeners[existing], listener) {
+				return nil
+			}
+			gateway.Spec.Listeners[existing] = listener
+		} else {
+			gateway.Spec.Listeners = append(gateway.Spec.Listeners, listener)
+		}
+
+		slog.InfoContext(ctx, "updating gateway listener", "gateway", gatewayName, "listener", listenerName, "hostname", hostName)
+		return r.Update(ctx, gateway)
+	})
+}
+
+// deleteTLSPolicy removes an app's ClientTrafficPolicy, client CA and gateway listener, if it has them
+func (r *LocoResourceReconciler) deleteTLSPolicy(ctx context.Context, locoRes *locov1alpha1.Application) error {
+	name := getTLSPolicyName(locoRes)
+
+	policy := &unstructured.Unstructured{}
+	policy.SetGroupVersionKind(clientTrafficPolicyGVK)
+	policy.SetName(name)
+	policy.SetNamespace(r.locoNamespace)
+	if err := r.Delete(ctx, policy); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		slog.ErrorContext(ctx, "failed to delete client traffic policy", "name", name, "namespace", r.locoNamespace, "error", err)
+		return err
+	}
+
+	clientCA := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: r.locoNamespace},
+	}
+	if err := r.Delete(ctx, clientCA); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete client CA: %w", err)
+	}
+
+	listenerName := v1Gateway.SectionName(getTLSListenerName(locoRes))
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		gateway := &v1Gateway.Gateway{}
+		err := r.Get(ctx, client.ObjectKey{Name: gatewayName, Namespace: r.locoNamespace}, gateway)
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get Gateway: %w", err)
+		}
+
+		listeners := make([]v1Gateway.Listener, 0, len(gateway.Spec.Listeners))
+		for _, listener := range gateway.Spec.Listeners {
+			if listener.Name != listenerName {
+				listeners = append(listeners, listener)
+			}
+		}
+		if len(listeners) == len(gateway.Spec.Listeners) {
+			return nil
+		}
+		gateway.Spec.Listeners = listeners
+
+		slog.InfoContext(ctx, "removing gateway listener", "gateway", gatewayName, "listener", listenerName)
+		return r.Update(ctx, gateway)
+	})
+}
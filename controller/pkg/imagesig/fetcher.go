@@ -0,0 +1,184 @@
+package imagesig
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrNotFound is returned, wrapped, by fetchers for manifests and blobs the registry does not have.
+var ErrNotFound = errors.New("not found in registry")
+
+// Fetcher reads manifests and blobs from registries.
+type Fetcher interface {
+	Manifest(ctx context.Context, host, repository, reference string) ([]byte, error)
+	Blob(ctx context.Context, host, repository, digest string) ([]byte, error)
+}
+
+// Credentials returns the username and password for a registry host, or empty strings to pull
+// anonymously.
+type Credentials func(ctx context.Context, host string) (username, password string, err error)
+
+// maxResponseBytes bounds what is read of a manifest or blob; signature payloads are small.
+const maxResponseBytes = 4 << 20
+
+// RegistryFetcher is a Fetcher talking to registries over their HTTP API.
+type RegistryFetcher struct {
+	httpClient  *http.Client
+	credentials Credentials
+}
+
+// NewRegistryFetcher creates a RegistryFetcher. credentials may be nil to always pull anonymously.
+func NewRegistryFetcher(httpClient *http.Client, credentials Credentials) *RegistryFetcher {
+	return &RegistryFetcher{httpClient: httpClient, credentials: credentials}
+}
+
+func (f *RegistryFetcher) Manifest(ctx context.Context, host, repository, reference string) ([]byte, error) {
+	return f.get(ctx, host, repository, fmt.Sprintf("https://%s/v2/%s/manifests/%s", host, repository, reference))
+}
+
+func (f *RegistryFetcher) Blob(ctx context.Context, host, repository, digest string) ([]byte, error) {
+	return f.get(ctx, host, repository, fmt.Sprintf("https://%s/v2/%s/blobs/%s", host, repository, digest))
+}
+
+// get fetches endpoint, answering the registry's auth challenge when it sends one.
+func (f *RegistryFetcher) get(ctx context.Context, host, repository, endpoint string) ([]byte, error) {
+	resp, err := f.do(ctx, endpoint, host, "")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		authorization, err := f.authorize(ctx, host, repository, challenge)
+		if err != nil {
+			return nil, err
+		}
+		if resp, err = f.do(ctx, endpoint, host, authorization); err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%s: %w", endpoint, ErrNotFound)
+	default:
+		return nil, fmt.Errorf("registry %s returned status %d", host, resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", endpoint, err)
+	}
+	return body, nil
+}
+
+func (f *RegistryFetcher) do(ctx context.Context, endpoint, host, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.oci.image.manifest.v1+json, application/vnd.docker.distribution.manifest.v2+json")
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("registry %s unreachable: %w", host, err)
+	}
+	return resp, nil
+}
+
+// authorize answers a registry's challenge with the host's credentials, or a bearer token for
+// pulling the repository.
+func (f *RegistryFetcher) authorize(ctx context.Context, host, repository, challenge string) (string, error) {
+	var username, password string
+	if f.credentials != nil {
+		var err error
+		if username, password, err = f.credentials(ctx, host); err != nil {
+			return "", fmt.Errorf("failed to get credentials for registry %s: %w", host, err)
+		}
+	}
+
+	scheme, params, _ := strings.Cut(challenge, " ")
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if username == "" {
+			return "", fmt.Errorf("registry %s denied access", host)
+		}
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password)), nil
+
+	case "bearer":
+		fields := parseChallenge(params)
+		realm, err := url.Parse(fields["realm"])
+		if err != nil || realm.Scheme == "" {
+			return "", fmt.Errorf("registry %s sent an invalid auth challenge", host)
+		}
+		query := realm.Query()
+		if service := fields["service"]; service != "" {
+			query.Set("service", service)
+		}
+		query.Set("scope", fmt.Sprintf("repository:%s:pull", repository))
+		realm.RawQuery = query.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+		if err != nil {
+			return "", err
+		}
+		if username != "" {
+			req.SetBasicAuth(username, password)
+		}
+		resp, err := f.httpClient.Do(req)
+		if err != nil {
+			return "", fmt.Errorf("registry %s unreachable: %w", host, err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("registry %s token endpoint returned status %d", host, resp.StatusCode)
+		}
+		var token struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+			return "", fmt.Errorf("failed to decode registry token: %w", err)
+		}
+		if token.Token == "" {
+			token.Token = token.AccessToken
+		}
+		return "Bearer " + token.Token, nil
+
+	default:
+		return "", fmt.Errorf("registry %s denied access", host)
+	}
+}
+
+// parseChallenge parses the comma-separated key="value" parameters of a WWW-Authenticate header.
+func parseChallenge(params string) map[string]string {
+	fields := map[string]string{}
+	for params != "" {
+		key, rest, ok := strings.Cut(strings.TrimLeft(params, " ,"), "=")
+		if !ok {
+			break
+		}
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				break
+			}
+			value, params = rest[1:end+1], rest[end+2:]
+		} else {
+			value, params, _ = strings.Cut(rest, ",")
+		}
+		fields[strings.ToLower(strings.TrimSpace(key))] = value
+	}
+	return fields
+}
@@ -0,0 +1,425 @@
+// Package imagesig verifies cosign signatures of container images: signatures made with a key, and
+// keyless signatures made with a Fulcio certificate and recorded in Rekor. Signatures are read from
+// the registry where cosign stores them, the sha256-<digest>.sig tag next to the image.
+package imagesig
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNoSignature  = errors.New("image is not signed")
+	ErrUnverified   = errors.New("no signature of the image matches the policy")
+	ErrNoTrustRoot  = errors.New("keyless signatures cannot be verified: no Fulcio and Rekor trust root configured")
+	ErrInvalidKey   = errors.New("invalid public key")
+	ErrInvalidImage = errors.New("invalid image")
+)
+
+// Annotations cosign sets on the layers of a signature manifest.
+const (
+	annotationSignature   = "dev.cosignproject.cosign/signature"
+	annotationCertificate = "dev.sigstore.cosign/certificate"
+	annotationChain       = "dev.sigstore.cosign/chain"
+	annotationBundle      = "dev.sigstore.cosign/bundle"
+)
+
+// Fulcio certificate extensions naming the OIDC issuer: the original raw string, and its DER
+// encoded successor.
+var (
+	oidIssuerV1 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// Identity is a keyless signer: the issuer that vouched for it and its email or URI.
+type Identity struct {
+	Issuer        string
+	Subject       string
+	SubjectRegexp bool // Subject is a regular expression matched against the whole subject
+}
+
+// Policy is what a signature must have been made with: one of the keys or one of the identities.
+type Policy struct {
+	PublicKeys []string // PEM-encoded
+	Identities []Identity
+}
+
+// TrustRoot is what keyless signatures are checked against: the Fulcio certificates signer
+// certificates chain up to, and the Rekor key that signs the time a signature was logged.
+type TrustRoot struct {
+	FulcioRoots *x509.CertPool
+	RekorKey    crypto.PublicKey
+}
+
+// ParseTrustRoot reads a trust root from PEM-encoded Fulcio root certificates and Rekor public key.
+func ParseTrustRoot(fulcioPEM, rekorPEM []byte) (*TrustRoot, error) {
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(fulcioPEM) {
+		return nil, errors.New("no Fulcio root certificates found")
+	}
+	rekorKey, err := ParsePublicKey(string(rekorPEM))
+	if err != nil {
+		return nil, fmt.Errorf("rekor key: %w", err)
+	}
+	return &TrustRoot{FulcioRoots: roots, RekorKey: rekorKey}, nil
+}
+
+// ParsePublicKey parses a PEM-encoded ECDSA, RSA or Ed25519 public key.
+func ParsePublicKey(data string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, fmt.Errorf("%w: not PEM-encoded", ErrInvalidKey)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: unsupported key type %T", ErrInvalidKey, key)
+}
+
+// ValidatePolicy checks a policy can be verified against: it names a key or identity, its keys
+// parse and its subject patterns compile.
+func ValidatePolicy(policy Policy) error {
+	if len(policy.PublicKeys) == 0 && len(policy.Identities) == 0 {
+		return errors.New("at least one public key or identity is required")
+	}
+	for _, key := range policy.PublicKeys {
+		if _, err := ParsePublicKey(key); err != nil {
+			return err
+		}
+	}
+	for _, identity := range policy.Identities {
+		if identity.Issuer == "" || identity.Subject == "" {
+			return errors.New("identities need an issuer and a subject")
+		}
+		if identity.SubjectRegexp {
+			if _, err := regexp.Compile(identity.Subject); err != nil {
+				return fmt.Errorf("invalid subject pattern %q: %w", identity.Subject, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Verifier checks images carry signatures a policy accepts.
+type Verifier struct {
+	fetcher Fetcher
+	trust   *TrustRoot // nil when keyless signatures are not accepted
+}
+
+// NewVerifier creates a Verifier reading signatures through fetcher. trust may be nil, in which
+// case policies naming identities cannot be satisfied.
+func NewVerifier(fetcher Fetcher, trust *TrustRoot) *Verifier {
+	return &Verifier{fetcher: fetcher, trust: trust}
+}
+
+// layer is a layer of a cosign signature manifest; each holds one signature.
+type layer struct {
+	Digest      string            `json:"digest"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+// payload is the simple signing payload a cosign signature signs.
+type payload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// Verify checks an image pinned by digest, e.g. ghcr.io/acme/app@sha256:..., carries a signature
+// the policy accepts.
+func (v *Verifier) Verify(ctx context.Context, image string, policy Policy) error {
+	host, repository, digest, err := splitImage(image)
+	if err != nil {
+		return err
+	}
+	hexDigest := strings.TrimPrefix(digest, "sha256:")
+	if len(policy.Identities) > 0 && len(policy.PublicKeys) == 0 && v.trust == nil {
+		return ErrNoTrustRoot
+	}
+
+	keys := make([]crypto.PublicKey, 0, len(policy.PublicKeys))
+	for _, data := range policy.PublicKeys {
+		key, err := ParsePublicKey(data)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+
+	data, err := v.fetcher.Manifest(ctx, host, repository, "sha256-"+hexDigest+".sig")
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return ErrNoSignature
+		}
+		return fmt.Errorf("failed to fetch signatures: %w", err)
+	}
+	var manifest struct {
+		Layers []layer `json:"layers"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return fmt.Errorf("invalid signature manifest: %w", err)
+	}
+	if len(manifest.Layers) == 0 {
+		return ErrNoSignature
+	}
+
+	var reasons []string
+	for _, l := range manifest.Layers {
+		err := v.verifyLayer(ctx, host, repository, digest, l, keys, policy.Identities)
+		if err == nil {
+			return nil
+		}
+		reasons = append(reasons, err.Error())
+	}
+	return fmt.Errorf("%w: %s", ErrUnverified, strings.Join(reasons, "; "))
+}
+
+// verifyLayer checks one signature: that it signs the image and was made with one of the keys or
+// by one of the identities.
+func (v *Verifier) verifyLayer(ctx context.Context, host, repository, digest string, l layer, keys []crypto.PublicKey, identities []Identity) error {
+	signature, err := base64.StdEncoding.DecodeString(l.Annotations[annotationSignature])
+	if err != nil || len(signature) == 0 {
+		return errors.New("signature missing")
+	}
+
+	body, err := v.fetcher.Blob(ctx, host, repository, l.Digest)
+	if err != nil {
+		return fmt.Errorf("failed to fetch signed payload: %w", err)
+	}
+	sum := sha256.Sum256(body)
+	if l.Digest != "sha256:"+hex.EncodeToString(sum[:]) {
+		return errors.New("signed payload does not match its digest")
+	}
+	var p payload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return fmt.Errorf("invalid signed payload: %w", err)
+	}
+	if p.Critical.Image.DockerManifestDigest != digest {
+		return errors.New("signature is for another image")
+	}
+
+	for _, key := range keys {
+		if verifySignature(key, body, signature) {
+			return nil
+		}
+	}
+
+	if len(identities) == 0 || l.Annotations[annotationCertificate] == "" {
+		return errors.New("not signed with an accepted key")
+	}
+	if v.trust == nil {
+		return ErrNoTrustRoot
+	}
+	return v.verifyKeyless(l, body, signature, identities)
+}
+
+// verifyKeyless checks a signature made with a Fulcio certificate: the certificate chains up to the
+// trusted roots and was valid when Rekor logged the signature, it made the signature, and it was
+// issued to one of the identities.
+func (v *Verifier) verifyKeyless(l layer, body, signature []byte, identities []Identity) error {
+	cert, err := parseCertificate(l.Annotations[annotationCertificate])
+	if err != nil {
+		return err
+	}
+	intermediates := x509.NewCertPool()
+	intermediates.AppendCertsFromPEM([]byte(l.Annotations[annotationChain]))
+
+	loggedAt, err := v.verifyBundle(l.Annotations[annotationBundle], body, signature)
+	if err != nil {
+		return err
+	}
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:         v.trust.FulcioRoots,
+		Intermediates: intermediates,
+		CurrentTime:   loggedAt,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return fmt.Errorf("untrusted certificate: %w", err)
+	}
+	if !verifySignature(cert.PublicKey, body, signature) {
+		return errors.New("signature does not match its certificate")
+	}
+
+	issuer := certificateIssuer(cert)
+	subjects := append([]string{}, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		subjects = append(subjects, uri.String())
+	}
+	for _, identity := range identities {
+		if identity.Issuer == issuer && matchesSubject(identity, subjects) {
+			return nil
+		}
+	}
+	return fmt.Errorf("signed by %s (issuer %s), which the policy does not accept", strings.Join(subjects, ", "), issuer)
+}
+
+// verifyBundle checks Rekor's signed entry timestamp for the signature and returns when it was
+// logged. The logged entry must be for this signature and payload.
+func (v *Verifier) verifyBundle(data string, body, signature []byte) (time.Time, error) {
+	if data == "" {
+		return time.Time{}, errors.New("keyless signature has no transparency log bundle")
+	}
+	var bundle struct {
+		SignedEntryTimestamp string `json:"SignedEntryTimestamp"`
+		Payload              struct {
+			Body           string `json:"body"`
+			IntegratedTime int64  `json:"integratedTime"`
+			LogIndex       int64  `json:"logIndex"`
+			LogID          string `json:"logID"`
+		} `json:"Payload"`
+	}
+	if err := json.Unmarshal([]byte(data), &bundle); err != nil {
+		return time.Time{}, fmt.Errorf("invalid transparency log bundle: %w", err)
+	}
+
+	// the timestamp signs the canonical JSON of the payload: keys sorted, no whitespace
+	var canonical bytes.Buffer
+	encoder := json.NewEncoder(&canonical)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(struct {
+		Body           string `json:"body"`
+		IntegratedTime int64  `json:"integratedTime"`
+		LogID          string `json:"logID"`
+		LogIndex       int64  `json:"logIndex"`
+	}{bundle.Payload.Body, bundle.Payload.IntegratedTime, bundle.Payload.LogID, bundle.Payload.LogIndex}); err != nil {
+		return time.Time{}, err
+	}
+	set, err := base64.StdEncoding.DecodeString(bundle.SignedEntryTimestamp)
+	if err != nil || !verifySignature(v.trust.RekorKey, bytes.TrimSuffix(canonical.Bytes(), []byte("\n")), set) {
+		return time.Time{}, errors.New("transparency log bundle is not signed by the trusted Rekor key")
+	}
+
+	entryJSON, err := base64.StdEncoding.DecodeString(bundle.Payload.Body)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid transparency log entry: %w", err)
+	}
+	var entry struct {
+		Spec struct {
+			Signature struct {
+				Content string `json:"content"`
+			} `json:"signature"`
+			Data struct {
+				Hash struct {
+					Algorithm string `json:"algorithm"`
+					Value     string `json:"value"`
+				} `json:"hash"`
+			} `json:"data"`
+		} `json:"spec"`
+	}
+	if err := json.Unmarshal(entryJSON, &entry); err != nil {
+		return time.Time{}, fmt.Errorf("invalid transparency log entry: %w", err)
+	}
+	sum := sha256.Sum256(body)
+	if entry.Spec.Signature.Content != base64.StdEncoding.EncodeToString(signature) ||
+		entry.Spec.Data.Hash.Algorithm != "sha256" || entry.Spec.Data.Hash.Value != hex.EncodeToString(sum[:]) {
+		return time.Time{}, errors.New("transparency log entry is for another signature")
+	}
+	return time.Unix(bundle.Payload.IntegratedTime, 0), nil
+}
+
+func parseCertificate(data string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("invalid signing certificate: not PEM-encoded")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing certificate: %w", err)
+	}
+	return cert, nil
+}
+
+// certificateIssuer returns the OIDC issuer Fulcio recorded in a signing certificate.
+func certificateIssuer(cert *x509.Certificate) string {
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidIssuerV2):
+			var issuer string
+			if _, err := asn1.UnmarshalWithParams(ext.Value, &issuer, "utf8"); err == nil {
+				return issuer
+			}
+		case ext.Id.Equal(oidIssuerV1):
+			return string(ext.Value)
+		}
+	}
+	return ""
+}
+
+func matchesSubject(identity Identity, subjects []string) bool {
+	for _, subject := range subjects {
+		if !identity.SubjectRegexp {
+			if subject == identity.Subject {
+				return true
+			}
+			continue
+		}
+		if pattern, err := regexp.Compile("^(?:" + identity.Subject + ")$"); err == nil && pattern.MatchString(subject) {
+			return true
+		}
+	}
+	return false
+}
+
+// verifySignature checks a signature over the SHA-256 of data, as cosign makes them.
+func verifySignature(key crypto.PublicKey, data, signature []byte) bool {
+	digest := sha256.Sum256(data)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, digest[:], signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, data, signature)
+	}
+	return false
+}
+
+// splitImage splits an image pinned by digest into the registry host, repository and digest the
+// registry API addresses. Images without a host are on Docker Hub.
+func splitImage(image string) (host, repository, digest string, err error) {
+	name, digest, found := strings.Cut(image, "@")
+	hexDigest, ok := strings.CutPrefix(digest, "sha256:")
+	if !found || !ok || len(hexDigest) != 64 {
+		return "", "", "", fmt.Errorf("%w: %q is not pinned by digest", ErrInvalidImage, image)
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+
+	first, rest, found := strings.Cut(name, "/")
+	switch {
+	case found && (strings.ContainsAny(first, ".:") || first == "localhost"):
+		host, repository = first, rest
+	case found:
+		host, repository = "docker.io", name
+	default:
+		host, repository = "docker.io", "library/"+name
+	}
+	if host == "docker.io" {
+		host = "registry-1.docker.io"
+	}
+	return host, repository, digest, nil
+}
@@ -9,17 +9,28 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
+	"time"
 
 	json "github.com/goccy/go-json"
 
 	"connectrpc.com/connect"
 	"github.com/team-loco/loco/shared"
+	announcementv1 "github.com/team-loco/loco/shared/proto/announcement/v1"
+	"github.com/team-loco/loco/shared/proto/announcement/v1/announcementv1connect"
 	deploymentv1 "github.com/team-loco/loco/shared/proto/deployment/v1"
 	"github.com/team-loco/loco/shared/proto/deployment/v1/deploymentv1connect"
+	domainv1 "github.com/team-loco/loco/shared/proto/domain/v1"
+	"github.com/team-loco/loco/shared/proto/domain/v1/domainv1connect"
+	notificationv1 "github.com/team-loco/loco/shared/proto/notification/v1"
+	"github.com/team-loco/loco/shared/proto/notification/v1/notificationv1connect"
 	orgv1 "github.com/team-loco/loco/shared/proto/org/v1"
 	"github.com/team-loco/loco/shared/proto/org/v1/orgv1connect"
 	resourcev1 "github.com/team-loco/loco/shared/proto/resource/v1"
 	"github.com/team-loco/loco/shared/proto/resource/v1/resourcev1connect"
+	secretv1 "github.com/team-loco/loco/shared/proto/secret/v1"
+	"github.com/team-loco/loco/shared/proto/secret/v1/secretv1connect"
+	templatev1 "github.com/team-loco/loco/shared/proto/template/v1"
+	"github.com/team-loco/loco/shared/proto/template/v1/templatev1connect"
 	userv1 "github.com/team-loco/loco/shared/proto/user/v1"
 	"github.com/team-loco/loco/shared/proto/user/v1/userv1connect"
 	workspacev1 "github.com/team-loco/loco/shared/proto/workspace/v1"
@@ -32,11 +43,16 @@ import (
 type Client struct {
 	httpClient *http.Client
 
-	User       userv1connect.UserServiceClient
-	Org        orgv1connect.OrgServiceClient
-	Workspace  workspacev1connect.WorkspaceServiceClient
-	Resource   resourcev1connect.ResourceServiceClient
-	Deployment deploymentv1connect.DeploymentServiceClient
+	User         userv1connect.UserServiceClient
+	Org          orgv1connect.OrgServiceClient
+	Workspace    workspacev1connect.WorkspaceServiceClient
+	Resource     resourcev1connect.ResourceServiceClient
+	Deployment   deploymentv1connect.DeploymentServiceClient
+	Domain       domainv1connect.DomainServiceClient
+	Announcement announcementv1connect.AnnouncementServiceClient
+	Template     templatev1connect.TemplateServiceClient
+	Notification notificationv1connect.NotificationServiceClient
+	Secret       secretv1connect.SecretServiceClient
 
 	host  string
 	token string
@@ -46,14 +62,19 @@ func NewClient(host, token string) *Client {
 	httpClient := shared.NewHTTPClient()
 
 	return &Client{
-		host:       host,
-		token:      token,
-		httpClient: httpClient,
-		User:       userv1connect.NewUserServiceClient(httpClient, host),
-		Org:        orgv1connect.NewOrgServiceClient(httpClient, host),
-		Workspace:  workspacev1connect.NewWorkspaceServiceClient(httpClient, host),
-		Resource:   resourcev1connect.NewResourceServiceClient(httpClient, host),
-		Deployment: deploymentv1connect.NewDeploymentServiceClient(httpClient, host),
+		host:         host,
+		token:        token,
+		httpClient:   httpClient,
+		User:         userv1connect.NewUserServiceClient(httpClient, host),
+		Org:          orgv1connect.NewOrgServiceClient(httpClient, host),
+		Workspace:    workspacev1connect.NewWorkspaceServiceClient(httpClient, host),
+		Resource:     resourcev1connect.NewResourceServiceClient(httpClient, host),
+		Deployment:   deploymentv1connect.NewDeploymentServiceClient(httpClient, host),
+		Domain:       domainv1connect.NewDomainServiceClient(httpClient, host),
+		Announcement: announcementv1connect.NewAnnouncementServiceClient(httpClient, host),
+		Template:     templatev1connect.NewTemplateServiceClient(httpClient, host),
+		Notification: notificationv1connect.NewNotificationServiceClient(httpClient, host),
+		Secret:       secretv1connect.NewSecretServiceClient(httpClient, host),
 	}
 }
 
@@ -289,6 +310,69 @@ func (c *Client) ScaleApp(ctx context.Context, appID int64, replicas *int32, cpu
 	return nil
 }
 
+func (c *Client) PromoteRegion(ctx context.Context, appID int64, region string, force bool) ([]*resourcev1.RegionConfig, error) {
+	req := connect.NewRequest(&resourcev1.PromoteRegionRequest{
+		ResourceId: appID,
+		Region:     region,
+		Force:      force,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.Resource.PromoteRegion(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to promote region")
+		return nil, err
+	}
+
+	return resp.Msg.Regions, nil
+}
+
+func (c *Client) SuspendApp(ctx context.Context, appID int64, message *string) error {
+	req := connect.NewRequest(&resourcev1.SuspendResourceRequest{
+		ResourceId: appID,
+		Message:    message,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	_, err := c.Resource.SuspendResource(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to suspend resource")
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) ResumeApp(ctx context.Context, appID int64) error {
+	req := connect.NewRequest(&resourcev1.ResumeResourceRequest{
+		ResourceId: appID,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	_, err := c.Resource.ResumeResource(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to resume resource")
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) RestartApp(ctx context.Context, appID int64) (int64, error) {
+	req := connect.NewRequest(&resourcev1.RestartResourceRequest{
+		ResourceId: appID,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.Resource.RestartResource(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to restart resource")
+		return 0, err
+	}
+
+	return resp.Msg.DeploymentId, nil
+}
+
 func (c *Client) UpdateAppEnv(ctx context.Context, appID int64, env map[string]string) error {
 	req := connect.NewRequest(&resourcev1.UpdateResourceEnvRequest{
 		ResourceId: appID,
@@ -305,6 +389,90 @@ func (c *Client) UpdateAppEnv(ctx context.Context, appID int64, env map[string]s
 	return nil
 }
 
+// GetWorkspaceEnv returns the env vars shared by every app in a workspace.
+func (c *Client) GetWorkspaceEnv(ctx context.Context, workspaceID int64) (map[string]string, error) {
+	req := connect.NewRequest(&workspacev1.GetWorkspaceEnvRequest{
+		WorkspaceId: workspaceID,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.Workspace.GetWorkspaceEnv(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to get workspace env")
+		return nil, err
+	}
+
+	return resp.Msg.GetEnv(), nil
+}
+
+// UpdateWorkspaceEnv replaces the env vars shared by every app in a workspace.
+func (c *Client) UpdateWorkspaceEnv(ctx context.Context, workspaceID int64, env map[string]string) (*workspacev1.UpdateWorkspaceEnvResponse, error) {
+	req := connect.NewRequest(&workspacev1.UpdateWorkspaceEnvRequest{
+		WorkspaceId: workspaceID,
+		Env:         env,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.Workspace.UpdateWorkspaceEnv(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to update workspace env")
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// SetSecret sets an entry of a workspace secret store.
+func (c *Client) SetSecret(ctx context.Context, workspaceID int64, store, key, value string) error {
+	req := connect.NewRequest(&secretv1.SetSecretRequest{
+		WorkspaceId: workspaceID,
+		Store:       store,
+		Key:         key,
+		Value:       value,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	if _, err := c.Secret.SetSecret(ctx, req); err != nil {
+		logRequestID(ctx, err, "failed to set secret")
+		return err
+	}
+
+	return nil
+}
+
+// DeleteSecret deletes an entry of a workspace secret store.
+func (c *Client) DeleteSecret(ctx context.Context, workspaceID int64, store, key string) error {
+	req := connect.NewRequest(&secretv1.DeleteSecretRequest{
+		WorkspaceId: workspaceID,
+		Store:       store,
+		Key:         key,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	if _, err := c.Secret.DeleteSecret(ctx, req); err != nil {
+		logRequestID(ctx, err, "failed to delete secret")
+		return err
+	}
+
+	return nil
+}
+
+// ListSecretStores returns a workspace's secret stores with the keys of their entries.
+func (c *Client) ListSecretStores(ctx context.Context, workspaceID int64) ([]*secretv1.SecretStore, error) {
+	req := connect.NewRequest(&secretv1.ListSecretStoresRequest{
+		WorkspaceId: workspaceID,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.Secret.ListSecretStores(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to list secret stores")
+		return nil, err
+	}
+
+	return resp.Msg.GetStores(), nil
+}
+
 func (c *Client) GetAppStatus(ctx context.Context, appID int64) (*resourcev1.GetResourceStatusResponse, error) {
 	req := connect.NewRequest(&resourcev1.GetResourceStatusRequest{
 		ResourceId: appID,
@@ -320,12 +488,43 @@ func (c *Client) GetAppStatus(ctx context.Context, appID int64) (*resourcev1.Get
 	return resp.Msg, nil
 }
 
-func (c *Client) StreamLogs(ctx context.Context, appID int64, limit *int32, follow *bool, logHandler func(*resourcev1.WatchLogsResponse) error) error {
+func (c *Client) EstimateCost(ctx context.Context, spec *resourcev1.ResourceSpec) (*resourcev1.EstimateCostResponse, error) {
+	req := connect.NewRequest(&resourcev1.EstimateCostRequest{
+		Spec: spec,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.Resource.EstimateCost(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to estimate cost")
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// LogFilter narrows a log stream on the server before lines are sent.
+type LogFilter struct {
+	Match   string // only lines matching this are sent
+	Exclude string // lines matching this are dropped
+	Regex   bool   // treat Match and Exclude as regular expressions
+}
+
+func (c *Client) StreamLogs(ctx context.Context, appID int64, limit *int32, follow *bool, filter LogFilter, logHandler func(*resourcev1.WatchLogsResponse) error) error {
 	req := connect.NewRequest(&resourcev1.WatchLogsRequest{
 		ResourceId: appID,
 		Limit:      limit,
 		Follow:     follow,
 	})
+	if filter.Match != "" {
+		req.Msg.Filter = &filter.Match
+	}
+	if filter.Exclude != "" {
+		req.Msg.Exclude = &filter.Exclude
+	}
+	if filter.Regex {
+		req.Msg.FilterRegex = &filter.Regex
+	}
 	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
 
 	stream, err := c.Resource.WatchLogs(ctx, req)
@@ -349,10 +548,11 @@ func (c *Client) StreamLogs(ctx context.Context, appID int64, limit *int32, foll
 	return nil
 }
 
-func (c *Client) GetEvents(ctx context.Context, appID int64, limit *int32) ([]*resourcev1.Event, error) {
+func (c *Client) GetEvents(ctx context.Context, appID int64, limit *int32, kinds []string) ([]*resourcev1.Event, error) {
 	req := connect.NewRequest(&resourcev1.ListResourceEventsRequest{
 		ResourceId: appID,
 		Limit:      limit,
+		Kinds:      kinds,
 	})
 	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
 
@@ -364,6 +564,135 @@ func (c *Client) GetEvents(ctx context.Context, appID int64, limit *int32) ([]*r
 	return resp.Msg.Events, nil
 }
 
+// GetTimeline returns up to limit of an app's timeline entries of the given kinds, newest first,
+// following pages as needed. A limit of 0 returns the whole timeline.
+func (c *Client) GetTimeline(ctx context.Context, appID int64, limit int32, kinds []resourcev1.TimelineEntryKind) ([]*resourcev1.TimelineEntry, error) {
+	var entries []*resourcev1.TimelineEntry
+	var pageToken string
+
+	for {
+		pageSize := int32(100)
+		if limit > 0 {
+			pageSize = min(pageSize, limit-int32(len(entries)))
+		}
+		req := connect.NewRequest(&resourcev1.GetResourceTimelineRequest{
+			ResourceId: appID,
+			PageSize:   pageSize,
+			PageToken:  pageToken,
+			Kinds:      kinds,
+		})
+		req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+		resp, err := c.Resource.GetResourceTimeline(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, resp.Msg.GetEntries()...)
+		pageToken = resp.Msg.GetNextPageToken()
+		if pageToken == "" || (limit > 0 && int32(len(entries)) >= limit) {
+			return entries, nil
+		}
+	}
+}
+
+// WatchAppStatus calls handler with the app's status each time it changes, starting right away,
+// until ctx is done, the stream fails or handler returns an error.
+func (c *Client) WatchAppStatus(ctx context.Context, appID int64, handler func(*resourcev1.WatchResourceStatusResponse) error) error {
+	req := connect.NewRequest(&resourcev1.WatchResourceStatusRequest{
+		ResourceId: appID,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	stream, err := c.Resource.WatchResourceStatus(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to watch app status")
+		return err
+	}
+	defer stream.Close()
+
+	for stream.Receive() {
+		if err := handler(stream.Msg()); err != nil {
+			return err
+		}
+	}
+	return stream.Err()
+}
+
+// ListDeployments returns an app's most recent deployments, newest first.
+func (c *Client) ListDeployments(ctx context.Context, appID int64, limit int32) ([]*deploymentv1.Deployment, error) {
+	req := connect.NewRequest(&deploymentv1.ListDeploymentsRequest{
+		ResourceId: appID,
+		PageSize:   limit,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.Deployment.ListDeployments(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to list deployments")
+		return nil, err
+	}
+
+	return resp.Msg.Deployments, nil
+}
+
+// maxEventStreamReconnects bounds consecutive reconnect attempts when an event stream drops.
+const maxEventStreamReconnects = 5
+
+// StreamEvents streams events for an app until ctx is canceled. Dropped streams are
+// reconnected and resumed from the last event received.
+func (c *Client) StreamEvents(ctx context.Context, appID int64, kinds []string, eventHandler func(*resourcev1.Event) error) error {
+	var resourceVersion string
+	attempts := 0
+
+	for {
+		req := connect.NewRequest(&resourcev1.StreamEventsRequest{
+			ResourceId: appID,
+			Kinds:      kinds,
+		})
+		if resourceVersion != "" {
+			req.Msg.ResourceVersion = &resourceVersion
+		}
+		req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+		stream, err := c.Resource.StreamEvents(ctx, req)
+		if err == nil {
+			for stream.Receive() {
+				attempts = 0
+				msg := stream.Msg()
+				resourceVersion = msg.GetResourceVersion()
+				if err := eventHandler(msg.GetEvent()); err != nil {
+					stream.Close()
+					return err
+				}
+			}
+			err = stream.Err()
+			stream.Close()
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil && connect.CodeOf(err) != connect.CodeUnavailable && connect.CodeOf(err) != connect.CodeUnknown {
+			logRequestID(ctx, err, "failed to stream events")
+			return err
+		}
+
+		attempts++
+		if attempts > maxEventStreamReconnects {
+			logRequestID(ctx, err, "giving up on event stream")
+			return fmt.Errorf("event stream disconnected: %w", err)
+		}
+
+		slog.DebugContext(ctx, "event stream disconnected, reconnecting", "attempt", attempts, "error", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempts) * time.Second):
+		}
+	}
+}
+
 // APIError represents an HTTP API error
 type APIError struct {
 	Body       string
@@ -421,3 +750,117 @@ func (c *Client) Post(path string, payload any, headers map[string]string) ([]by
 
 	return body, nil
 }
+
+// ListUnreadAnnouncements returns up to limit unread announcements, newest first.
+func (c *Client) ListUnreadAnnouncements(ctx context.Context, limit int32) ([]*announcementv1.Announcement, error) {
+	req := connect.NewRequest(&announcementv1.ListAnnouncementsRequest{
+		PageSize:   limit,
+		UnreadOnly: true,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.Announcement.ListAnnouncements(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to list announcements")
+		return nil, err
+	}
+
+	return resp.Msg.Announcements, nil
+}
+
+// MarkAnnouncementsRead marks every announcement up to upToID as read.
+func (c *Client) MarkAnnouncementsRead(ctx context.Context, upToID int64) error {
+	req := connect.NewRequest(&announcementv1.MarkAnnouncementsReadRequest{
+		UpToId: &upToID,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	_, err := c.Announcement.MarkAnnouncementsRead(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to mark announcements read")
+		return err
+	}
+
+	return nil
+}
+
+// ListNotifications returns up to limit of the caller's notifications, newest first, along with
+// how many are unread.
+func (c *Client) ListNotifications(ctx context.Context, limit int32, unreadOnly bool) ([]*notificationv1.Notification, int64, error) {
+	req := connect.NewRequest(&notificationv1.ListNotificationsRequest{
+		PageSize:   limit,
+		UnreadOnly: unreadOnly,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.Notification.ListNotifications(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to list notifications")
+		return nil, 0, err
+	}
+
+	return resp.Msg.Notifications, resp.Msg.UnreadCount, nil
+}
+
+// MarkNotificationRead marks a notification as read.
+func (c *Client) MarkNotificationRead(ctx context.Context, id int64) error {
+	req := connect.NewRequest(&notificationv1.MarkNotificationReadRequest{
+		Id: &id,
+	})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	_, err := c.Notification.MarkNotificationRead(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to mark notification read")
+		return err
+	}
+
+	return nil
+}
+
+// GetDomainSetupInstructions returns the DNS records a custom domain needs and whether they are in
+// place yet. An empty provider returns instructions for every known DNS provider.
+func (c *Client) GetDomainSetupInstructions(ctx context.Context, domainID int64, provider string) (*domainv1.GetDomainSetupInstructionsResponse, error) {
+	msg := &domainv1.GetDomainSetupInstructionsRequest{DomainId: domainID}
+	if provider != "" {
+		msg.Provider = &provider
+	}
+	req := connect.NewRequest(msg)
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.Domain.GetDomainSetupInstructions(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to get domain setup instructions")
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// ListTemplates returns the template catalog.
+func (c *Client) ListTemplates(ctx context.Context) ([]*templatev1.Template, error) {
+	req := connect.NewRequest(&templatev1.ListTemplatesRequest{})
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.Template.ListTemplates(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to list templates")
+		return nil, err
+	}
+
+	return resp.Msg.Templates, nil
+}
+
+// InstantiateTemplate creates and deploys a template's resources, returning them in creation order.
+func (c *Client) InstantiateTemplate(ctx context.Context, msg *templatev1.InstantiateTemplateRequest) ([]*templatev1.InstantiatedResource, error) {
+	req := connect.NewRequest(msg)
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.Template.InstantiateTemplate(ctx, req)
+	if err != nil {
+		logRequestID(ctx, err, "failed to instantiate template")
+		return nil, err
+	}
+
+	return resp.Msg.Resources, nil
+}
@@ -12,6 +12,8 @@ const Service = "loco"
 type UserToken struct {
 	ExpiresAt time.Time
 	Token     string
+	// RefreshToken trades for a new token once this one expires. Empty for tokens stored before refresh tokens.
+	RefreshToken string `json:",omitempty"`
 }
 
 func SetLocoToken(user string, t UserToken) error {
@@ -0,0 +1,245 @@
+// Package client is the Go SDK for the Loco API. It wraps the generated Connect clients of every
+// public service with what each caller would otherwise hand-roll: bearer token authentication,
+// retries with backoff for transient failures, iterators over paginated lists and server streams,
+// and access to the request ID of a failed call.
+//
+//	c := client.New("https://api.loco.dev", client.WithToken(os.Getenv("LOCO_TOKEN")))
+//	resp, err := c.Workspaces.GetWorkspace(ctx, connect.NewRequest(&workspacev1.GetWorkspaceRequest{WorkspaceId: 1}))
+//	if err != nil {
+//		log.Fatalf("get workspace (request %s): %v", client.RequestID(err), err)
+//	}
+//
+// See [All] for paginated lists and [Receive] for streams.
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/team-loco/loco/shared"
+	"github.com/team-loco/loco/shared/proto/alert/v1/alertv1connect"
+	"github.com/team-loco/loco/shared/proto/announcement/v1/announcementv1connect"
+	"github.com/team-loco/loco/shared/proto/approval/v1/approvalv1connect"
+	"github.com/team-loco/loco/shared/proto/billing/v1/billingv1connect"
+	"github.com/team-loco/loco/shared/proto/configgroup/v1/configgroupv1connect"
+	"github.com/team-loco/loco/shared/proto/deployment/v1/deploymentv1connect"
+	"github.com/team-loco/loco/shared/proto/domain/v1/domainv1connect"
+	"github.com/team-loco/loco/shared/proto/environment/v1/environmentv1connect"
+	"github.com/team-loco/loco/shared/proto/notification/v1/notificationv1connect"
+	"github.com/team-loco/loco/shared/proto/oauth/v1/oauthv1connect"
+	"github.com/team-loco/loco/shared/proto/org/v1/orgv1connect"
+	"github.com/team-loco/loco/shared/proto/policy/v1/policyv1connect"
+	"github.com/team-loco/loco/shared/proto/registry/v1/registryv1connect"
+	"github.com/team-loco/loco/shared/proto/resource/v1/resourcev1connect"
+	"github.com/team-loco/loco/shared/proto/secret/v1/secretv1connect"
+	"github.com/team-loco/loco/shared/proto/serviceaccount/v1/serviceaccountv1connect"
+	"github.com/team-loco/loco/shared/proto/slack/v1/slackv1connect"
+	"github.com/team-loco/loco/shared/proto/template/v1/templatev1connect"
+	"github.com/team-loco/loco/shared/proto/token/v1/tokenv1connect"
+	"github.com/team-loco/loco/shared/proto/user/v1/userv1connect"
+	"github.com/team-loco/loco/shared/proto/workspace/v1/workspacev1connect"
+)
+
+// RequestIDHeader is the header the API returns the ID of each request in, on errors too.
+const RequestIDHeader = "X-Loco-Request-Id"
+
+// Client holds a client for each public service of the API. Every call is authenticated and,
+// when unary, retried according to the client's options.
+type Client struct {
+	OAuth           oauthv1connect.OAuthServiceClient
+	Users           userv1connect.UserServiceClient
+	Orgs            orgv1connect.OrgServiceClient
+	Workspaces      workspacev1connect.WorkspaceServiceClient
+	Resources       resourcev1connect.ResourceServiceClient
+	Deployments     deploymentv1connect.DeploymentServiceClient
+	Domains         domainv1connect.DomainServiceClient
+	Tokens          tokenv1connect.TokenServiceClient
+	Registry        registryv1connect.RegistryServiceClient
+	Announcements   announcementv1connect.AnnouncementServiceClient
+	Environments    environmentv1connect.EnvironmentServiceClient
+	ConfigGroups    configgroupv1connect.ConfigGroupServiceClient
+	Secrets         secretv1connect.SecretServiceClient
+	ServiceAccounts serviceaccountv1connect.ServiceAccountServiceClient
+	Templates       templatev1connect.TemplateServiceClient
+	Approvals       approvalv1connect.ApprovalServiceClient
+	Notifications   notificationv1connect.NotificationServiceClient
+	Alerts          alertv1connect.AlertServiceClient
+	Policies        policyv1connect.PolicyServiceClient
+	Slack           slackv1connect.SlackServiceClient
+	Billing         billingv1connect.BillingServiceClient
+}
+
+// TokenSource returns the token each call is made with, such as a loco token read from a
+// keychain or refreshed before it expires.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc adapts a function to a TokenSource.
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token calls f.
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+type options struct {
+	httpClient   *http.Client
+	tokens       TokenSource
+	retry        RetryPolicy
+	userAgent    string
+	interceptors []connect.Interceptor
+	clientOpts   []connect.ClientOption
+}
+
+// Option configures a Client.
+type Option func(*options)
+
+// WithToken authenticates every call with a loco token.
+func WithToken(token string) Option {
+	return WithTokenSource(TokenSourceFunc(func(context.Context) (string, error) { return token, nil }))
+}
+
+// WithTokenSource authenticates every call with the token tokens returns for it.
+func WithTokenSource(tokens TokenSource) Option {
+	return func(o *options) { o.tokens = tokens }
+}
+
+// WithHTTPClient makes calls with httpClient instead of an HTTP/2 capable default.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *options) { o.httpClient = httpClient }
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy. A policy with MaxAttempts of 1 turns retries off.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) { o.retry = policy }
+}
+
+// WithUserAgent sends userAgent with every call, so the API's logs can tell integrations apart.
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) { o.userAgent = userAgent }
+}
+
+// WithInterceptors adds interceptors, which run inside authentication and retries: they see each
+// attempt of a retried call.
+func WithInterceptors(interceptors ...connect.Interceptor) Option {
+	return func(o *options) { o.interceptors = append(o.interceptors, interceptors...) }
+}
+
+// WithClientOptions passes options, such as connect.WithGRPC(), on to every Connect client.
+func WithClientOptions(opts ...connect.ClientOption) Option {
+	return func(o *options) { o.clientOpts = append(o.clientOpts, opts...) }
+}
+
+// New creates a Client for the API at baseURL, such as https://api.loco.dev.
+func New(baseURL string, opts ...Option) *Client {
+	o := options{retry: DefaultRetryPolicy}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.httpClient == nil {
+		o.httpClient = shared.NewHTTPClient()
+	}
+
+	// the first interceptor is outermost: a retried call is authenticated once per attempt
+	interceptors := append([]connect.Interceptor{
+		&retryInterceptor{policy: o.retry},
+		&authInterceptor{tokens: o.tokens, userAgent: o.userAgent},
+	}, o.interceptors...)
+	clientOpts := append([]connect.ClientOption{connect.WithInterceptors(interceptors...)}, o.clientOpts...)
+
+	h, url := o.httpClient, baseURL
+	return &Client{
+		OAuth:           oauthv1connect.NewOAuthServiceClient(h, url, clientOpts...),
+		Users:           userv1connect.NewUserServiceClient(h, url, clientOpts...),
+		Orgs:            orgv1connect.NewOrgServiceClient(h, url, clientOpts...),
+		Workspaces:      workspacev1connect.NewWorkspaceServiceClient(h, url, clientOpts...),
+		Resources:       resourcev1connect.NewResourceServiceClient(h, url, clientOpts...),
+		Deployments:     deploymentv1connect.NewDeploymentServiceClient(h, url, clientOpts...),
+		Domains:         domainv1connect.NewDomainServiceClient(h, url, clientOpts...),
+		Tokens:          tokenv1connect.NewTokenServiceClient(h, url, clientOpts...),
+		Registry:        registryv1connect.NewRegistryServiceClient(h, url, clientOpts...),
+		Announcements:   announcementv1connect.NewAnnouncementServiceClient(h, url, clientOpts...),
+		Environments:    environmentv1connect.NewEnvironmentServiceClient(h, url, clientOpts...),
+		ConfigGroups:    configgroupv1connect.NewConfigGroupServiceClient(h, url, clientOpts...),
+		Secrets:         secretv1connect.NewSecretServiceClient(h, url, clientOpts...),
+		ServiceAccounts: serviceaccountv1connect.NewServiceAccountServiceClient(h, url, clientOpts...),
+		Templates:       templatev1connect.NewTemplateServiceClient(h, url, clientOpts...),
+		Approvals:       approvalv1connect.NewApprovalServiceClient(h, url, clientOpts...),
+		Notifications:   notificationv1connect.NewNotificationServiceClient(h, url, clientOpts...),
+		Alerts:          alertv1connect.NewAlertServiceClient(h, url, clientOpts...),
+		Policies:        policyv1connect.NewPolicyServiceClient(h, url, clientOpts...),
+		Slack:           slackv1connect.NewSlackServiceClient(h, url, clientOpts...),
+		Billing:         billingv1connect.NewBillingServiceClient(h, url, clientOpts...),
+	}
+}
+
+// RequestID returns the ID the API gave the failed call behind err, for reporting it, or "" when
+// err did not come from the API.
+func RequestID(err error) string {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Meta().Get(RequestIDHeader)
+	}
+	return ""
+}
+
+// authInterceptor sets the Authorization and User-Agent headers of every call
+type authInterceptor struct {
+	tokens    TokenSource
+	userAgent string
+}
+
+func (a *authInterceptor) setHeaders(ctx context.Context, header http.Header) error {
+	if a.userAgent != "" {
+		header.Set("User-Agent", a.userAgent)
+	}
+	if a.tokens == nil || header.Get("Authorization") != "" {
+		return nil
+	}
+	token, err := a.tokens.Token(ctx)
+	if err != nil {
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+	return nil
+}
+
+func (a *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := a.setHeaders(ctx, req.Header()); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (a *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		if err := a.setHeaders(ctx, conn.RequestHeader()); err != nil {
+			return &failedConn{StreamingClientConn: conn, err: err}
+		}
+		return conn
+	}
+}
+
+func (a *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// failedConn fails a stream that could not be authenticated without sending it
+type failedConn struct {
+	connect.StreamingClientConn
+	err error
+}
+
+func (c *failedConn) Send(any) error    { return c.err }
+func (c *failedConn) Receive(any) error { return c.err }
+func (c *failedConn) CloseRequest() error {
+	return c.err
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// PageRequest is a request for one page of a list, such as *workspacev1.ListUserWorkspacesRequest.
+type PageRequest[T any] interface {
+	*T
+	proto.Message
+	GetPageToken() string
+}
+
+// PageResponse is one page of a list.
+type PageResponse[T any] interface {
+	*T
+	GetNextPageToken() string
+}
+
+// All calls a List method page by page, yielding each item that items picks out of a page until
+// the last page, an error, or the loop stops. req is not modified, and its page size and any
+// filters apply to every page. An error ends the iteration after it is yielded.
+//
+//	for ws, err := range client.All(ctx, c.Workspaces.ListUserWorkspaces,
+//		&workspacev1.ListUserWorkspacesRequest{UserId: userID},
+//		(*workspacev1.ListUserWorkspacesResponse).GetWorkspaces) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(ws.GetName())
+//	}
+func All[Req, Resp, T any, PReq PageRequest[Req], PResp PageResponse[Resp]](
+	ctx context.Context,
+	call func(context.Context, *connect.Request[Req]) (*connect.Response[Resp], error),
+	req PReq,
+	items func(PResp) []T,
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		page := proto.Clone(req).(PReq)
+		tokenField := page.ProtoReflect().Descriptor().Fields().ByName("page_token")
+		if tokenField == nil || tokenField.Kind() != protoreflect.StringKind {
+			yield(zero, fmt.Errorf("%s has no page_token field", page.ProtoReflect().Descriptor().FullName()))
+			return
+		}
+
+		for {
+			resp, err := call(ctx, connect.NewRequest((*Req)(page)))
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items(PResp(resp.Msg)) {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			next := PResp(resp.Msg).GetNextPageToken()
+			if next == "" || next == page.GetPageToken() {
+				return
+			}
+			page.ProtoReflect().Set(tokenField, protoreflect.ValueOfString(next))
+		}
+	}
+}
+
+// Receive yields each message of a server stream until it ends, fails, or the loop stops, and
+// closes it. An error ends the iteration after it is yielded; a stream ending normally yields none.
+//
+//	stream, err := c.Resources.StreamEvents(ctx, connect.NewRequest(&resourcev1.StreamEventsRequest{ResourceId: id}))
+//	if err != nil {
+//		return err
+//	}
+//	for event, err := range client.Receive(stream) {
+//		...
+//	}
+func Receive[Res any](stream *connect.ServerStreamForClient[Res]) iter.Seq2[*Res, error] {
+	return func(yield func(*Res, error) bool) {
+		defer stream.Close()
+		for stream.Receive() {
+			if !yield(stream.Msg(), nil) {
+				return
+			}
+		}
+		if err := stream.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"connectrpc.com/connect"
+	workspacev1 "github.com/team-loco/loco/shared/proto/workspace/v1"
+)
+
+// pagedWorkspaces serves total workspaces, pageSize at a time, using the index of the next one as
+// the page token. It fails a request for the page starting at failAt, when set.
+type pagedWorkspaces struct {
+	total    int
+	failAt   int
+	requests []*workspacev1.ListUserWorkspacesRequest
+}
+
+func (p *pagedWorkspaces) list(ctx context.Context, req *connect.Request[workspacev1.ListUserWorkspacesRequest]) (*connect.Response[workspacev1.ListUserWorkspacesResponse], error) {
+	p.requests = append(p.requests, req.Msg)
+	start := 0
+	if token := req.Msg.GetPageToken(); token != "" {
+		var err error
+		if start, err = strconv.Atoi(token); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	if p.failAt != 0 && start == p.failAt {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("page failed"))
+	}
+
+	resp := &workspacev1.ListUserWorkspacesResponse{}
+	end := min(start+int(req.Msg.GetPageSize()), p.total)
+	for i := start; i < end; i++ {
+		resp.Workspaces = append(resp.Workspaces, &workspacev1.Workspace{Id: int64(i), Name: fmt.Sprintf("ws-%d", i)})
+	}
+	if end < p.total {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func TestAll(t *testing.T) {
+	tests := []struct {
+		name      string
+		total     int
+		failAt    int
+		stopAfter int // stop the loop after this many items; 0 to read them all
+		wantItems int
+		wantErr   bool
+		wantPages int
+	}{
+		{"empty list", 0, 0, 0, 0, false, 1},
+		{"one partial page", 2, 0, 0, 2, false, 1},
+		{"exact pages", 6, 0, 0, 6, false, 2},
+		{"several pages", 7, 0, 0, 7, false, 3},
+		{"error on a later page", 7, 3, 0, 3, true, 2},
+		{"stopping early fetches no more pages", 7, 0, 2, 2, false, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &pagedWorkspaces{total: tt.total, failAt: tt.failAt}
+			req := &workspacev1.ListUserWorkspacesRequest{UserId: 7, PageSize: 3}
+
+			var items []*workspacev1.Workspace
+			var gotErr error
+			for ws, err := range All(t.Context(), server.list, req, (*workspacev1.ListUserWorkspacesResponse).GetWorkspaces) {
+				if err != nil {
+					gotErr = err
+					continue
+				}
+				items = append(items, ws)
+				if len(items) == tt.stopAfter {
+					break
+				}
+			}
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, gotErr)
+			}
+			if len(items) != tt.wantItems {
+				t.Errorf("expected %d items, got %d", tt.wantItems, len(items))
+			}
+			for i, ws := range items {
+				if ws.GetId() != int64(i) {
+					t.Errorf("item %d: expected workspace %d, got %d", i, i, ws.GetId())
+				}
+			}
+			if len(server.requests) != tt.wantPages {
+				t.Errorf("expected %d pages to be requested, got %d", tt.wantPages, len(server.requests))
+			}
+			for _, sent := range server.requests {
+				if sent.GetUserId() != 7 || sent.GetPageSize() != 3 {
+					t.Errorf("expected every page to keep the request's filters, got %v", sent)
+				}
+			}
+			if req.GetPageToken() != "" {
+				t.Errorf("expected req to be left unmodified, got page token %q", req.GetPageToken())
+			}
+		})
+	}
+}
+
+func TestAllRepeatedToken(t *testing.T) {
+	calls := 0
+	stuck := func(ctx context.Context, req *connect.Request[workspacev1.ListUserWorkspacesRequest]) (*connect.Response[workspacev1.ListUserWorkspacesResponse], error) {
+		calls++
+		return connect.NewResponse(&workspacev1.ListUserWorkspacesResponse{
+			Workspaces:    []*workspacev1.Workspace{{Id: int64(calls)}},
+			NextPageToken: "same",
+		}), nil
+	}
+
+	n := 0
+	for _, err := range All(t.Context(), stuck, &workspacev1.ListUserWorkspacesRequest{}, (*workspacev1.ListUserWorkspacesResponse).GetWorkspaces) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		n++
+	}
+	if calls != 2 || n != 2 {
+		t.Errorf("expected a repeated page token to end the list after 2 pages, got %d pages and %d items", calls, n)
+	}
+}
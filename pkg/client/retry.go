@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// RetryPolicy decides how unary calls that fail with a transient error are retried, waiting
+// exponentially longer between attempts, with jitter, or as long as the API's Retry-After asks.
+// A handler may fail after it has already charged a card or started a deployment, so only calls
+// that are safe to repeat are retried: those to methods declaring an idempotency_level of
+// NO_SIDE_EFFECTS or IDEMPOTENT, and those the API turned away with a Retry-After header. Streams
+// are never retried.
+type RetryPolicy struct {
+	MaxAttempts    int           // including the first; 1 or less turns retries off
+	InitialBackoff time.Duration // wait before the second attempt
+	MaxBackoff     time.Duration // longest wait between attempts
+}
+
+// DefaultRetryPolicy is the policy of a Client created without WithRetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 250 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+}
+
+// retryable reports whether a call to the method of spec that failed with err should be made
+// again. Unavailable does not mean a handler never ran, so a method with side effects is only
+// retried when the API asked for it with Retry-After.
+func retryable(spec connect.Spec, err error) bool {
+	switch connect.CodeOf(err) {
+	case connect.CodeUnavailable, connect.CodeResourceExhausted:
+	default:
+		return false
+	}
+	if _, ok := retryAfter(err); ok {
+		return true
+	}
+	switch spec.IdempotencyLevel {
+	case connect.IdempotencyNoSideEffects, connect.IdempotencyIdempotent:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the wait the API asked for with err's Retry-After header, if any
+func retryAfter(err error) (time.Duration, bool) {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return 0, false
+	}
+	seconds, parseErr := strconv.Atoi(connectErr.Meta().Get("Retry-After"))
+	if parseErr != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// backoff returns how long to wait after attempt failed with err, at most MaxBackoff
+func (p RetryPolicy) backoff(attempt int, err error) time.Duration {
+	if wait, ok := retryAfter(err); ok {
+		return min(wait, p.MaxBackoff)
+	}
+	wait := p.InitialBackoff
+	for i := 1; i < attempt && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	wait = min(wait, p.MaxBackoff)
+	// full jitter keeps clients that failed together from retrying together
+	return time.Duration(rand.Int64N(int64(wait) + 1))
+}
+
+type retryInterceptor struct {
+	policy RetryPolicy
+}
+
+func (r *retryInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		for attempt := 1; ; attempt++ {
+			resp, err := next(ctx, req)
+			if err == nil || attempt >= r.policy.MaxAttempts || !retryable(req.Spec(), err) {
+				return resp, err
+			}
+
+			timer := time.NewTimer(r.policy.backoff(attempt, err))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, err
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+func (r *retryInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (r *retryInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	workspacev1 "github.com/team-loco/loco/shared/proto/workspace/v1"
+	"github.com/team-loco/loco/shared/proto/workspace/v1/workspacev1connect"
+)
+
+func errWithRetryAfter(code connect.Code, retryAfter string) error {
+	err := connect.NewError(code, errors.New("try again"))
+	if retryAfter != "" {
+		err.Meta().Set("Retry-After", retryAfter)
+	}
+	return err
+}
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	unavailable := errWithRetryAfter(connect.CodeUnavailable, "")
+
+	t.Run("doubles up to the max, with jitter", func(t *testing.T) {
+		bounds := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}
+		for i, bound := range bounds {
+			attempt := i + 1
+			for range 50 {
+				if wait := policy.backoff(attempt, unavailable); wait < 0 || wait > bound {
+					t.Fatalf("attempt %d: expected a wait in [0, %s], got %s", attempt, bound, wait)
+				}
+			}
+		}
+	})
+
+	tests := []struct {
+		name       string
+		retryAfter string
+		want       time.Duration
+	}{
+		{"retry-after is honoured", "0", 0},
+		{"retry-after is capped at the max", "30", time.Second},
+	}
+	for _, tt := range tests {
+		if wait := policy.backoff(1, errWithRetryAfter(connect.CodeUnavailable, tt.retryAfter)); wait != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.name, tt.want, wait)
+		}
+	}
+
+	for _, retryAfter := range []string{"soon", "-1", "Wed, 21 Oct 2015 07:28:00 GMT"} {
+		if wait := policy.backoff(1, errWithRetryAfter(connect.CodeUnavailable, retryAfter)); wait > policy.InitialBackoff {
+			t.Errorf("retry-after %q: expected it to be ignored, got a wait of %s", retryAfter, wait)
+		}
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		name  string
+		level connect.IdempotencyLevel
+		err   error
+		want  bool
+	}{
+		{"unavailable, no side effects", connect.IdempotencyNoSideEffects, errWithRetryAfter(connect.CodeUnavailable, ""), true},
+		{"unavailable, idempotent", connect.IdempotencyIdempotent, errWithRetryAfter(connect.CodeUnavailable, ""), true},
+		{"rate limited, no side effects", connect.IdempotencyNoSideEffects, errWithRetryAfter(connect.CodeResourceExhausted, ""), true},
+		{"unavailable, side effects", connect.IdempotencyUnknown, errWithRetryAfter(connect.CodeUnavailable, ""), false},
+		{"rate limited, side effects", connect.IdempotencyUnknown, errWithRetryAfter(connect.CodeResourceExhausted, ""), false},
+		{"unavailable, side effects, retry-after", connect.IdempotencyUnknown, errWithRetryAfter(connect.CodeUnavailable, "1"), true},
+		{"invalid retry-after", connect.IdempotencyUnknown, errWithRetryAfter(connect.CodeUnavailable, "later"), false},
+		{"internal, no side effects", connect.IdempotencyNoSideEffects, errWithRetryAfter(connect.CodeInternal, ""), false},
+		{"internal, retry-after", connect.IdempotencyUnknown, errWithRetryAfter(connect.CodeInternal, "1"), false},
+		{"not a connect error", connect.IdempotencyNoSideEffects, errors.New("boom"), false},
+	}
+	for _, tt := range tests {
+		if got := retryable(connect.Spec{IdempotencyLevel: tt.level}, tt.err); got != tt.want {
+			t.Errorf("%s: expected retryable=%v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+// flakyWorkspaces fails GetWorkspace with err until it has been called failures times
+type flakyWorkspaces struct {
+	workspacev1connect.UnimplementedWorkspaceServiceHandler
+	failures int32
+	err      func() error
+	calls    atomic.Int32
+}
+
+func (f *flakyWorkspaces) GetWorkspace(ctx context.Context, req *connect.Request[workspacev1.GetWorkspaceRequest]) (*connect.Response[workspacev1.GetWorkspaceResponse], error) {
+	if f.calls.Add(1) <= f.failures {
+		return nil, f.err()
+	}
+	return connect.NewResponse(&workspacev1.GetWorkspaceResponse{Workspace: &workspacev1.Workspace{Id: req.Msg.GetWorkspaceId()}}), nil
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+	tests := []struct {
+		name       string
+		failures   int32
+		err        func() error
+		idempotent bool
+		wantCalls  int32
+		wantCode   connect.Code // 0 for success
+	}{
+		{"side effects are not retried", 1, func() error { return errWithRetryAfter(connect.CodeUnavailable, "") }, false, 1, connect.CodeUnavailable},
+		{"retry-after is retried", 2, func() error { return errWithRetryAfter(connect.CodeUnavailable, "0") }, false, 3, 0},
+		{"no side effects are retried", 2, func() error { return errWithRetryAfter(connect.CodeUnavailable, "") }, true, 3, 0},
+		{"attempts run out", 5, func() error { return errWithRetryAfter(connect.CodeUnavailable, "") }, true, 3, connect.CodeUnavailable},
+		{"other errors are not retried", 1, func() error { return errWithRetryAfter(connect.CodeNotFound, "") }, true, 1, connect.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := &flakyWorkspaces{failures: tt.failures, err: tt.err}
+			mux := http.NewServeMux()
+			mux.Handle(workspacev1connect.NewWorkspaceServiceHandler(handler))
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			opts := []Option{WithHTTPClient(server.Client()), WithRetryPolicy(policy)}
+			if tt.idempotent {
+				opts = append(opts, WithClientOptions(connect.WithIdempotency(connect.IdempotencyNoSideEffects)))
+			}
+			c := New(server.URL, opts...)
+
+			_, err := c.Workspaces.GetWorkspace(t.Context(), connect.NewRequest(&workspacev1.GetWorkspaceRequest{WorkspaceId: 1}))
+			if tt.wantCode == 0 && err != nil {
+				t.Errorf("expected success, got %v", err)
+			}
+			if tt.wantCode != 0 && connect.CodeOf(err) != tt.wantCode {
+				t.Errorf("expected %s, got %v", tt.wantCode, err)
+			}
+			if calls := handler.calls.Load(); calls != tt.wantCalls {
+				t.Errorf("expected %d calls, got %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
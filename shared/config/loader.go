@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"github.com/BurntSushi/toml"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // AllowedSchemaVersions defines supported config versions
@@ -18,6 +20,25 @@ var AllowedSchemaVersions = []string{
 	"0.1",
 }
 
+// maxErrorPageBytes matches the controller's limit on inline error pages
+const maxErrorPageBytes = 32 * 1024
+
+// the controller's bounds on resources and request bodies, checked here so a deploy fails before
+// building an image rather than after
+var (
+	minCPU      = resource.MustParse("100m")
+	maxCPU      = resource.MustParse("2000m")
+	minMemory   = resource.MustParse("32Mi")
+	maxMemory   = resource.MustParse("4Gi")
+	maxBodySize = resource.MustParse("1Gi")
+)
+
+// maxRoutedPorts matches the controller's limit on further ports
+const maxRoutedPorts = 10
+
+// reservedPortNames are the names the controller gives the main port
+var reservedPortNames = []string{"http", "http2", "grpc"}
+
 // BannedSubdomains are reserved subdomains that cannot be used
 // todo: user-hosted domain is different from loco's real domain.
 // this avoids conflict instead of a hardcoded list.
@@ -142,6 +163,10 @@ func Validate(cfg *LocoConfig) error {
 		cfg.DomainConfig.Type = "platform"
 	}
 
+	if err := validateHostname(cfg.DomainConfig.Hostname); err != nil {
+		return fmt.Errorf("domainConfig.hostname: %w", err)
+	}
+
 	if cfg.Routing.Port <= 1023 || cfg.Routing.Port > 65535 {
 		return fmt.Errorf("routing.port must be between 1024 and 65535, got %d", cfg.Routing.Port)
 	}
@@ -156,6 +181,59 @@ func Validate(cfg *LocoConfig) error {
 		return fmt.Errorf("routing.idleTimeout cannot be negative")
 	}
 
+	if cfg.Routing.RequestTimeout < 0 {
+		return fmt.Errorf("routing.requestTimeout cannot be negative")
+	}
+
+	if !slices.Contains([]string{"", "http", "h2c", "grpc", "websocket"}, cfg.Routing.Protocol) {
+		return fmt.Errorf("routing.protocol must be one of http, h2c, grpc, websocket")
+	}
+
+	if cfg.Routing.ErrorPage != nil && len(cfg.Routing.ErrorPage.HTML) > maxErrorPageBytes {
+		return fmt.Errorf("routing.errorPage.html must be at most %d bytes", maxErrorPageBytes)
+	}
+
+	if cfg.Routing.MaxBodySize != "" {
+		size, err := resource.ParseQuantity(cfg.Routing.MaxBodySize)
+		if err != nil {
+			return fmt.Errorf("routing.maxBodySize %q is not a valid quantity (e.g. '10Mi')", cfg.Routing.MaxBodySize)
+		}
+		if size.Sign() <= 0 || size.Cmp(maxBodySize) > 0 {
+			return fmt.Errorf("routing.maxBodySize must be between 1 and %s", maxBodySize.String())
+		}
+	}
+
+	if len(cfg.Routing.Ports) > maxRoutedPorts {
+		return fmt.Errorf("routing.ports can have at most %d ports, got %d", maxRoutedPorts, len(cfg.Routing.Ports))
+	}
+	seenPorts := map[int32]bool{cfg.Routing.Port: true}
+	seenPortNames := make(map[string]bool, len(cfg.Routing.Ports))
+	for i, port := range cfg.Routing.Ports {
+		if port.Name == "" {
+			return fmt.Errorf("routing.ports[%d].name must be provided", i)
+		}
+		if errs := validation.IsValidPortName(port.Name); len(errs) > 0 {
+			return fmt.Errorf("routing.ports[%d].name %q is invalid: %s", i, port.Name, strings.Join(errs, "; "))
+		}
+		if slices.Contains(reservedPortNames, port.Name) {
+			return fmt.Errorf("routing.ports[%d].name %q is reserved for the main port", i, port.Name)
+		}
+		if seenPortNames[port.Name] {
+			return fmt.Errorf("routing.ports[%d].name %q is used by another port", i, port.Name)
+		}
+		seenPortNames[port.Name] = true
+		if port.Port < 1 || port.Port > 65535 {
+			return fmt.Errorf("routing.ports[%d].port must be between 1 and 65535, got %d", i, port.Port)
+		}
+		if seenPorts[port.Port] {
+			return fmt.Errorf("routing.ports[%d].port %d is already in use", i, port.Port)
+		}
+		seenPorts[port.Port] = true
+		if port.PathPrefix != "" && !strings.HasPrefix(port.PathPrefix, "/") {
+			return fmt.Errorf("routing.ports[%d].pathPrefix must start with '/'", i)
+		}
+	}
+
 	if cfg.Build.DockerfilePath == "" {
 		cfg.Build.DockerfilePath = "Dockerfile"
 	}
@@ -186,6 +264,12 @@ func Validate(cfg *LocoConfig) error {
 		if resources.Memory == "" {
 			return fmt.Errorf("regionConfig.%s.memory must be set (e.g. '512Mi')", region)
 		}
+		if err := validateQuantity(resources.CPU, minCPU, maxCPU); err != nil {
+			return fmt.Errorf("regionConfig.%s.cpu: %w", region, err)
+		}
+		if err := validateQuantity(resources.Memory, minMemory, maxMemory); err != nil {
+			return fmt.Errorf("regionConfig.%s.memory: %w", region, err)
+		}
 
 		if resources.ReplicasMin <= 0 {
 			return fmt.Errorf("regionConfig.%s.replicas_min must be greater than 0", region)
@@ -200,9 +284,18 @@ func Validate(cfg *LocoConfig) error {
 			return fmt.Errorf("regionConfig.%s.replicas_max cannot exceed 3 replicas", region)
 		}
 
+		if len(resources.Triggers) > 0 && !resources.EnableAutoScaling {
+			return fmt.Errorf("regionConfig.%s: scalers_triggers require scalers_enabled=true", region)
+		}
+		for i, trigger := range resources.Triggers {
+			if trigger.Type == "" {
+				return fmt.Errorf("regionConfig.%s.scalers_triggers[%d].type must be provided", region, i)
+			}
+		}
+
 		if resources.EnableAutoScaling {
-			if resources.CPUTarget == 0 && resources.ScalersMemTarget == 0 {
-				return fmt.Errorf("regionConfig.%s: when scalers_enabled=true, either scalers_cpu_target or scalers_memory_target must be provided (non-zero)", region)
+			if resources.CPUTarget == 0 && resources.ScalersMemTarget == 0 && len(resources.Triggers) == 0 {
+				return fmt.Errorf("regionConfig.%s: when scalers_enabled=true, either scalers_cpu_target, scalers_memory_target or scalers_triggers must be provided", region)
 			}
 			if resources.CPUTarget != 0 && resources.ScalersMemTarget != 0 {
 				return fmt.Errorf("regionConfig.%s: only one of scalers_cpu_target or scalers_memory_target should be provided", region)
@@ -239,6 +332,53 @@ func Validate(cfg *LocoConfig) error {
 		return fmt.Errorf("health.failThreshold cannot be negative")
 	}
 
+	seenHooks := make(map[string]bool, len(cfg.Hooks))
+	for i, hook := range cfg.Hooks {
+		if hook.Name == "" {
+			return fmt.Errorf("hooks[%d].name must be provided", i)
+		}
+		if seenHooks[hook.Name] {
+			return fmt.Errorf("hooks[%d].name %q is used by another hook", i, hook.Name)
+		}
+		seenHooks[hook.Name] = true
+		if hook.Phase != "pre-deploy" && hook.Phase != "post-deploy" {
+			return fmt.Errorf("hooks[%d].phase must be pre-deploy or post-deploy", i)
+		}
+		if len(hook.Command) == 0 {
+			return fmt.Errorf("hooks[%d].command must be provided", i)
+		}
+		if hook.Timeout < 0 || hook.Timeout > 3600 {
+			return fmt.Errorf("hooks[%d].timeout must be between 0 and 3600 seconds", i)
+		}
+	}
+
+	seenContainers := make(map[string]bool, len(cfg.InitContainers)+len(cfg.Sidecars))
+	for _, group := range []struct {
+		field      string
+		containers []Container
+	}{{"initContainers", cfg.InitContainers}, {"sidecars", cfg.Sidecars}} {
+		field := group.field
+		for i, container := range group.containers {
+			if container.Name == "" {
+				return fmt.Errorf("%s[%d].name must be provided", field, i)
+			}
+			if seenContainers[container.Name] {
+				return fmt.Errorf("%s[%d].name %q is used by another container", field, i, container.Name)
+			}
+			seenContainers[container.Name] = true
+			if container.CPU != "" {
+				if err := validateQuantity(container.CPU, minCPU, maxCPU); err != nil {
+					return fmt.Errorf("%s[%d].cpu: %w", field, i, err)
+				}
+			}
+			if container.Memory != "" {
+				if err := validateQuantity(container.Memory, minMemory, maxMemory); err != nil {
+					return fmt.Errorf("%s[%d].memory: %w", field, i, err)
+				}
+			}
+		}
+	}
+
 	if cfg.Obs.Logging.Enabled {
 		if cfg.Obs.Logging.RetentionPeriod == "" {
 			cfg.Obs.Logging.RetentionPeriod = "7d"
@@ -262,6 +402,9 @@ func Validate(cfg *LocoConfig) error {
 		if cfg.Obs.Metrics.Port <= 1023 || cfg.Obs.Metrics.Port > 65535 {
 			return fmt.Errorf("obs.metrics.port must be between 1024 and 65535")
 		}
+		if cfg.Obs.Metrics.Port == cfg.Routing.Port {
+			return fmt.Errorf("obs.metrics.port %d is already routing.port", cfg.Obs.Metrics.Port)
+		}
 	}
 
 	if cfg.Obs.Tracing.Enabled {
@@ -273,6 +416,32 @@ func Validate(cfg *LocoConfig) error {
 	return nil
 }
 
+// validateQuantity checks a Kubernetes resource quantity, like "100m" or "512Mi", is within bounds
+func validateQuantity(value string, minimum, maximum resource.Quantity) error {
+	qty, err := resource.ParseQuantity(value)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid quantity", value)
+	}
+	if qty.Cmp(minimum) < 0 {
+		return fmt.Errorf("%s is below the minimum of %s", value, minimum.String())
+	}
+	if qty.Cmp(maximum) > 0 {
+		return fmt.Errorf("%s exceeds the maximum of %s", value, maximum.String())
+	}
+	return nil
+}
+
+// validateHostname checks a hostname is a fully qualified DNS name, e.g. "myapp.deploy-app.com"
+func validateHostname(hostname string) error {
+	if errs := validation.IsDNS1123Subdomain(hostname); len(errs) > 0 {
+		return fmt.Errorf("%q is not a valid hostname: %s", hostname, strings.Join(errs, "; "))
+	}
+	if !strings.Contains(hostname, ".") {
+		return fmt.Errorf("%q must be a fully qualified hostname (e.g. 'myapp.deploy-app.com')", hostname)
+	}
+	return nil
+}
+
 // parseRetention parses retention period strings like "7d" or "24h"
 func parseRetention(value string) (time.Duration, error) {
 	if strings.HasSuffix(value, "d") {
@@ -346,6 +515,9 @@ func ResolveConfigPaths(cfg *LocoConfig, cfgPath string) error {
 type LoadedConfig struct {
 	Config      *LocoConfig
 	ProjectPath string
+
+	// UnknownKeys are keys in the file the schema doesn't have, usually typos, e.g. "Routing.Prot"
+	UnknownKeys []string
 }
 
 // Load reads and parses a loco.toml file from the given path
@@ -367,7 +539,8 @@ func Load(cfgPath string) (*LoadedConfig, error) {
 
 	var cfg LocoConfig
 	decoder := toml.NewDecoder(file)
-	if _, err := decoder.Decode(&cfg); err != nil {
+	meta, err := decoder.Decode(&cfg)
+	if err != nil {
 		return nil, fmt.Errorf("failed to parse loco.toml: %w", err)
 	}
 
@@ -375,9 +548,15 @@ func Load(cfgPath string) (*LoadedConfig, error) {
 		return nil, err
 	}
 
+	var unknownKeys []string
+	for _, key := range meta.Undecoded() {
+		unknownKeys = append(unknownKeys, key.String())
+	}
+
 	return &LoadedConfig{
 		Config:      &cfg,
 		ProjectPath: filepath.Dir(cfgPathAbs),
+		UnknownKeys: unknownKeys,
 	}, nil
 }
 
@@ -2,14 +2,17 @@ package config
 
 // LocoConfig represents the full configuration from loco.toml
 type LocoConfig struct {
-	Metadata     Metadata             `json:"metadata" toml:"Metadata"`
-	Build        Build                `json:"build" toml:"Build"`
-	Routing      Routing              `json:"routing" toml:"Routing"`
-	DomainConfig DomainConfig         `json:"domainConfig" toml:"DomainConfig"`
-	RegionConfig map[string]Resources `json:"regionConfig" toml:"RegionConfig"`
-	Health       Health               `json:"health" toml:"Health"`
-	Env          Env                  `json:"env,omitzero" toml:"Env"`
-	Obs          Obs                  `json:"obs,omitzero" toml:"Obs"`
+	Metadata       Metadata             `json:"metadata" toml:"Metadata"`
+	Build          Build                `json:"build" toml:"Build"`
+	Routing        Routing              `json:"routing" toml:"Routing"`
+	DomainConfig   DomainConfig         `json:"domainConfig" toml:"DomainConfig"`
+	RegionConfig   map[string]Resources `json:"regionConfig" toml:"RegionConfig"`
+	Health         Health               `json:"health" toml:"Health"`
+	Env            Env                  `json:"env,omitzero" toml:"Env"`
+	Obs            Obs                  `json:"obs,omitzero" toml:"Obs"`
+	Hooks          []Hook               `json:"hooks,omitempty" toml:"Hooks"`
+	InitContainers []Container          `json:"initContainers,omitempty" toml:"InitContainers"`
+	Sidecars       []Container          `json:"sidecars,omitempty" toml:"Sidecars"`
 }
 
 type Metadata struct {
@@ -28,6 +31,15 @@ type Resources struct {
 	EnableAutoScaling bool   `json:"scalers_enabled,omitempty" toml:"EnableAutoScaling"`
 	CPUTarget         int32  `json:"scalers_cpu_target,omitempty" toml:"CPUTarget"`
 	ScalersMemTarget  int32  `json:"scalers_mem_target,omitempty" toml:"MemoryTarget"`
+
+	// Triggers scale on external metrics (e.g. queue depth) through KEDA
+	Triggers []ScaleTrigger `json:"scalers_triggers,omitempty" toml:"Triggers"`
+}
+
+type ScaleTrigger struct {
+	Type            string            `json:"type" toml:"Type"` // KEDA scaler type, e.g. "rabbitmq"
+	Metadata        map[string]string `json:"metadata,omitempty" toml:"Metadata"`
+	QueueResourceID int64             `json:"queueResourceId,omitempty" toml:"QueueResourceID"` // linked loco queue resource
 }
 
 type Build struct {
@@ -36,9 +48,32 @@ type Build struct {
 }
 
 type Routing struct {
-	Port        int32  `json:"port" toml:"Port"`
-	PathPrefix  string `json:"pathPrefix,omitempty" toml:"PathPrefix"`
-	IdleTimeout int32  `json:"idleTimeout,omitempty" toml:"IdleTimeout"`
+	Port        int32      `json:"port" toml:"Port"`
+	PathPrefix  string     `json:"pathPrefix,omitempty" toml:"PathPrefix"`
+	IdleTimeout int32      `json:"idleTimeout,omitempty" toml:"IdleTimeout"`
+	ErrorPage   *ErrorPage `json:"errorPage,omitempty" toml:"ErrorPage"`
+	Protocol    string     `json:"protocol,omitempty" toml:"Protocol"` // "http" (default), "h2c", "grpc", or "websocket"
+
+	RequestTimeout int32  `json:"requestTimeout,omitempty" toml:"RequestTimeout"` // seconds
+	MaxBodySize    string `json:"maxBodySize,omitempty" toml:"MaxBodySize"`       // e.g. "10Mi"
+
+	// Ports are further ports the app listens on, e.g. metrics or an admin API
+	Ports []Port `json:"ports,omitempty" toml:"Ports"`
+}
+
+// Port is a further named port of the app, routed under PathPrefix or kept internal-only without one.
+type Port struct {
+	Name       string `json:"name" toml:"Name"`
+	Port       int32  `json:"port" toml:"Port"`
+	PathPrefix string `json:"pathPrefix,omitempty" toml:"PathPrefix"`
+}
+
+// ErrorPage customizes the page shown while the app is unavailable.
+type ErrorPage struct {
+	Disabled bool   `json:"disabled,omitempty" toml:"Disabled"`
+	Title    string `json:"title,omitempty" toml:"Title"`
+	Message  string `json:"message,omitempty" toml:"Message"`
+	HTML     string `json:"html,omitempty" toml:"HTML"` // replaces the default page entirely
 }
 
 type DomainConfig struct {
@@ -59,6 +94,28 @@ type Env struct {
 	Variables map[string]string `json:"variables,omitempty" toml:"Variables"`
 }
 
+// Hook is a command run as a one-off job around each deploy, e.g. a migration or a smoke test.
+type Hook struct {
+	Name    string   `json:"name" toml:"Name"`
+	Phase   string   `json:"phase" toml:"Phase"` // "pre-deploy" or "post-deploy"
+	Command []string `json:"command" toml:"Command"`
+	Image   string   `json:"image,omitempty" toml:"Image"`     // defaults to the app's image
+	Timeout int32    `json:"timeout,omitempty" toml:"Timeout"` // seconds, defaults to 600
+}
+
+// Container is an extra container in the app's pods: an init container run to completion before
+// the app starts, e.g. a migration, or a sidecar running next to it, e.g. a proxy.
+type Container struct {
+	Name       string            `json:"name" toml:"Name"`
+	Image      string            `json:"image,omitempty" toml:"Image"` // defaults to the app's image
+	Command    []string          `json:"command,omitempty" toml:"Command"`
+	Args       []string          `json:"args,omitempty" toml:"Args"`
+	Env        map[string]string `json:"env,omitempty" toml:"Env"`
+	InheritEnv bool              `json:"inheritEnv,omitempty" toml:"InheritEnv"` // also gets the app's env
+	CPU        string            `json:"cpu,omitempty" toml:"CPU"`               // defaults to 100m
+	Memory     string            `json:"memory,omitempty" toml:"Memory"`         // defaults to 64Mi
+}
+
 type Obs struct {
 	Logging Logging `json:"logging,omitzero" toml:"Logging"`
 	Metrics Metrics `json:"metrics,omitzero" toml:"Metrics"`
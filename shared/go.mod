@@ -7,6 +7,16 @@ require (
 	github.com/BurntSushi/toml v1.5.0
 	golang.org/x/net v0.48.0
 	google.golang.org/protobuf v1.36.10
+	k8s.io/apimachinery v0.35.0
 )
 
-require golang.org/x/text v0.32.0 // indirect
+require (
+	github.com/fxamacker/cbor/v2 v2.9.0 // indirect
+	github.com/go-logr/logr v1.4.3 // indirect
+	github.com/x448/float16 v0.8.4 // indirect
+	golang.org/x/text v0.32.0 // indirect
+	gopkg.in/inf.v0 v0.9.1 // indirect
+	k8s.io/klog/v2 v2.130.1 // indirect
+	k8s.io/utils v0.0.0-20251002143259-bc988d571ff4 // indirect
+	sigs.k8s.io/json v0.0.0-20250730193827-2d320260d730 // indirect
+)
@@ -0,0 +1,451 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: admin/v1/admin.proto
+
+package adminv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/team-loco/loco/shared/proto/admin/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "admin.v1.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServiceListOrgsProcedure is the fully-qualified name of the AdminService's ListOrgs RPC.
+	AdminServiceListOrgsProcedure = "/admin.v1.AdminService/ListOrgs"
+	// AdminServiceImpersonateUserProcedure is the fully-qualified name of the AdminService's
+	// ImpersonateUser RPC.
+	AdminServiceImpersonateUserProcedure = "/admin.v1.AdminService/ImpersonateUser"
+	// AdminServiceForceDeleteResourceProcedure is the fully-qualified name of the AdminService's
+	// ForceDeleteResource RPC.
+	AdminServiceForceDeleteResourceProcedure = "/admin.v1.AdminService/ForceDeleteResource"
+	// AdminServiceSetPlatformDomainActiveProcedure is the fully-qualified name of the AdminService's
+	// SetPlatformDomainActive RPC.
+	AdminServiceSetPlatformDomainActiveProcedure = "/admin.v1.AdminService/SetPlatformDomainActive"
+	// AdminServiceListClustersProcedure is the fully-qualified name of the AdminService's ListClusters
+	// RPC.
+	AdminServiceListClustersProcedure = "/admin.v1.AdminService/ListClusters"
+	// AdminServiceCreateClusterProcedure is the fully-qualified name of the AdminService's
+	// CreateCluster RPC.
+	AdminServiceCreateClusterProcedure = "/admin.v1.AdminService/CreateCluster"
+	// AdminServiceUpdateClusterProcedure is the fully-qualified name of the AdminService's
+	// UpdateCluster RPC.
+	AdminServiceUpdateClusterProcedure = "/admin.v1.AdminService/UpdateCluster"
+	// AdminServiceListClusterNodePoolsProcedure is the fully-qualified name of the AdminService's
+	// ListClusterNodePools RPC.
+	AdminServiceListClusterNodePoolsProcedure = "/admin.v1.AdminService/ListClusterNodePools"
+	// AdminServiceSetClusterNodePoolsProcedure is the fully-qualified name of the AdminService's
+	// SetClusterNodePools RPC.
+	AdminServiceSetClusterNodePoolsProcedure = "/admin.v1.AdminService/SetClusterNodePools"
+	// AdminServiceListPlatformAdminsProcedure is the fully-qualified name of the AdminService's
+	// ListPlatformAdmins RPC.
+	AdminServiceListPlatformAdminsProcedure = "/admin.v1.AdminService/ListPlatformAdmins"
+	// AdminServiceSetPlatformAdminProcedure is the fully-qualified name of the AdminService's
+	// SetPlatformAdmin RPC.
+	AdminServiceSetPlatformAdminProcedure = "/admin.v1.AdminService/SetPlatformAdmin"
+	// AdminServiceListAuditLogProcedure is the fully-qualified name of the AdminService's ListAuditLog
+	// RPC.
+	AdminServiceListAuditLogProcedure = "/admin.v1.AdminService/ListAuditLog"
+)
+
+// AdminServiceClient is a client for the admin.v1.AdminService service.
+type AdminServiceClient interface {
+	// ListOrgs lists every org on the platform.
+	ListOrgs(context.Context, *connect.Request[v1.ListOrgsRequest]) (*connect.Response[v1.ListOrgsResponse], error)
+	// ImpersonateUser issues a short-lived token acting as a user, to reproduce what they see.
+	ImpersonateUser(context.Context, *connect.Request[v1.ImpersonateUserRequest]) (*connect.Response[v1.ImpersonateUserResponse], error)
+	// ForceDeleteResource deletes a resource regardless of locks, deletion protection and approvals.
+	ForceDeleteResource(context.Context, *connect.Request[v1.ForceDeleteResourceRequest]) (*connect.Response[v1.ForceDeleteResourceResponse], error)
+	// SetPlatformDomainActive activates or deactivates a platform domain.
+	SetPlatformDomainActive(context.Context, *connect.Request[v1.SetPlatformDomainActiveRequest]) (*connect.Response[v1.SetPlatformDomainActiveResponse], error)
+	// ListClusters lists every cluster, active or not.
+	ListClusters(context.Context, *connect.Request[v1.ListClustersRequest]) (*connect.Response[v1.ListClustersResponse], error)
+	// CreateCluster registers a cluster.
+	CreateCluster(context.Context, *connect.Request[v1.CreateClusterRequest]) (*connect.Response[v1.CreateClusterResponse], error)
+	// UpdateCluster changes the given fields of a cluster.
+	UpdateCluster(context.Context, *connect.Request[v1.UpdateClusterRequest]) (*connect.Response[v1.UpdateClusterResponse], error)
+	// ListClusterNodePools lists the node pools of a cluster.
+	ListClusterNodePools(context.Context, *connect.Request[v1.ListClusterNodePoolsRequest]) (*connect.Response[v1.ListClusterNodePoolsResponse], error)
+	// SetClusterNodePools replaces the node pools of a cluster that services' scheduling is checked against.
+	SetClusterNodePools(context.Context, *connect.Request[v1.SetClusterNodePoolsRequest]) (*connect.Response[v1.SetClusterNodePoolsResponse], error)
+	// ListPlatformAdmins lists the users with the platform admin role.
+	ListPlatformAdmins(context.Context, *connect.Request[v1.ListPlatformAdminsRequest]) (*connect.Response[v1.ListPlatformAdminsResponse], error)
+	// SetPlatformAdmin grants or revokes the platform admin role.
+	SetPlatformAdmin(context.Context, *connect.Request[v1.SetPlatformAdminRequest]) (*connect.Response[v1.SetPlatformAdminResponse], error)
+	// ListAuditLog lists what platform admins did, newest first.
+	ListAuditLog(context.Context, *connect.Request[v1.ListAuditLogRequest]) (*connect.Response[v1.ListAuditLogResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the admin.v1.AdminService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	adminServiceMethods := v1.File_admin_v1_admin_proto.Services().ByName("AdminService").Methods()
+	return &adminServiceClient{
+		listOrgs: connect.NewClient[v1.ListOrgsRequest, v1.ListOrgsResponse](
+			httpClient,
+			baseURL+AdminServiceListOrgsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListOrgs")),
+			connect.WithClientOptions(opts...),
+		),
+		impersonateUser: connect.NewClient[v1.ImpersonateUserRequest, v1.ImpersonateUserResponse](
+			httpClient,
+			baseURL+AdminServiceImpersonateUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ImpersonateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		forceDeleteResource: connect.NewClient[v1.ForceDeleteResourceRequest, v1.ForceDeleteResourceResponse](
+			httpClient,
+			baseURL+AdminServiceForceDeleteResourceProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ForceDeleteResource")),
+			connect.WithClientOptions(opts...),
+		),
+		setPlatformDomainActive: connect.NewClient[v1.SetPlatformDomainActiveRequest, v1.SetPlatformDomainActiveResponse](
+			httpClient,
+			baseURL+AdminServiceSetPlatformDomainActiveProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetPlatformDomainActive")),
+			connect.WithClientOptions(opts...),
+		),
+		listClusters: connect.NewClient[v1.ListClustersRequest, v1.ListClustersResponse](
+			httpClient,
+			baseURL+AdminServiceListClustersProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListClusters")),
+			connect.WithClientOptions(opts...),
+		),
+		createCluster: connect.NewClient[v1.CreateClusterRequest, v1.CreateClusterResponse](
+			httpClient,
+			baseURL+AdminServiceCreateClusterProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("CreateCluster")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCluster: connect.NewClient[v1.UpdateClusterRequest, v1.UpdateClusterResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateClusterProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateCluster")),
+			connect.WithClientOptions(opts...),
+		),
+		listClusterNodePools: connect.NewClient[v1.ListClusterNodePoolsRequest, v1.ListClusterNodePoolsResponse](
+			httpClient,
+			baseURL+AdminServiceListClusterNodePoolsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListClusterNodePools")),
+			connect.WithClientOptions(opts...),
+		),
+		setClusterNodePools: connect.NewClient[v1.SetClusterNodePoolsRequest, v1.SetClusterNodePoolsResponse](
+			httpClient,
+			baseURL+AdminServiceSetClusterNodePoolsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetClusterNodePools")),
+			connect.WithClientOptions(opts...),
+		),
+		listPlatformAdmins: connect.NewClient[v1.ListPlatformAdminsRequest, v1.ListPlatformAdminsResponse](
+			httpClient,
+			baseURL+AdminServiceListPlatformAdminsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListPlatformAdmins")),
+			connect.WithClientOptions(opts...),
+		),
+		setPlatformAdmin: connect.NewClient[v1.SetPlatformAdminRequest, v1.SetPlatformAdminResponse](
+			httpClient,
+			baseURL+AdminServiceSetPlatformAdminProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetPlatformAdmin")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditLog: connect.NewClient[v1.ListAuditLogRequest, v1.ListAuditLogResponse](
+			httpClient,
+			baseURL+AdminServiceListAuditLogProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListAuditLog")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	listOrgs                *connect.Client[v1.ListOrgsRequest, v1.ListOrgsResponse]
+	impersonateUser         *connect.Client[v1.ImpersonateUserRequest, v1.ImpersonateUserResponse]
+	forceDeleteResource     *connect.Client[v1.ForceDeleteResourceRequest, v1.ForceDeleteResourceResponse]
+	setPlatformDomainActive *connect.Client[v1.SetPlatformDomainActiveRequest, v1.SetPlatformDomainActiveResponse]
+	listClusters            *connect.Client[v1.ListClustersRequest, v1.ListClustersResponse]
+	createCluster           *connect.Client[v1.CreateClusterRequest, v1.CreateClusterResponse]
+	updateCluster           *connect.Client[v1.UpdateClusterRequest, v1.UpdateClusterResponse]
+	listClusterNodePools    *connect.Client[v1.ListClusterNodePoolsRequest, v1.ListClusterNodePoolsResponse]
+	setClusterNodePools     *connect.Client[v1.SetClusterNodePoolsRequest, v1.SetClusterNodePoolsResponse]
+	listPlatformAdmins      *connect.Client[v1.ListPlatformAdminsRequest, v1.ListPlatformAdminsResponse]
+	setPlatformAdmin        *connect.Client[v1.SetPlatformAdminRequest, v1.SetPlatformAdminResponse]
+	listAuditLog            *connect.Client[v1.ListAuditLogRequest, v1.ListAuditLogResponse]
+}
+
+// ListOrgs calls admin.v1.AdminService.ListOrgs.
+func (c *adminServiceClient) ListOrgs(ctx context.Context, req *connect.Request[v1.ListOrgsRequest]) (*connect.Response[v1.ListOrgsResponse], error) {
+	return c.listOrgs.CallUnary(ctx, req)
+}
+
+// ImpersonateUser calls admin.v1.AdminService.ImpersonateUser.
+func (c *adminServiceClient) ImpersonateUser(ctx context.Context, req *connect.Request[v1.ImpersonateUserRequest]) (*connect.Response[v1.ImpersonateUserResponse], error) {
+	return c.impersonateUser.CallUnary(ctx, req)
+}
+
+// ForceDeleteResource calls admin.v1.AdminService.ForceDeleteResource.
+func (c *adminServiceClient) ForceDeleteResource(ctx context.Context, req *connect.Request[v1.ForceDeleteResourceRequest]) (*connect.Response[v1.ForceDeleteResourceResponse], error) {
+	return c.forceDeleteResource.CallUnary(ctx, req)
+}
+
+// SetPlatformDomainActive calls admin.v1.AdminService.SetPlatformDomainActive.
+func (c *adminServiceClient) SetPlatformDomainActive(ctx context.Context, req *connect.Request[v1.SetPlatformDomainActiveRequest]) (*connect.Response[v1.SetPlatformDomainActiveResponse], error) {
+	return c.setPlatformDomainActive.CallUnary(ctx, req)
+}
+
+// ListClusters calls admin.v1.AdminService.ListClusters.
+func (c *adminServiceClient) ListClusters(ctx context.Context, req *connect.Request[v1.ListClustersRequest]) (*connect.Response[v1.ListClustersResponse], error) {
+	return c.listClusters.CallUnary(ctx, req)
+}
+
+// CreateCluster calls admin.v1.AdminService.CreateCluster.
+func (c *adminServiceClient) CreateCluster(ctx context.Context, req *connect.Request[v1.CreateClusterRequest]) (*connect.Response[v1.CreateClusterResponse], error) {
+	return c.createCluster.CallUnary(ctx, req)
+}
+
+// UpdateCluster calls admin.v1.AdminService.UpdateCluster.
+func (c *adminServiceClient) UpdateCluster(ctx context.Context, req *connect.Request[v1.UpdateClusterRequest]) (*connect.Response[v1.UpdateClusterResponse], error) {
+	return c.updateCluster.CallUnary(ctx, req)
+}
+
+// ListClusterNodePools calls admin.v1.AdminService.ListClusterNodePools.
+func (c *adminServiceClient) ListClusterNodePools(ctx context.Context, req *connect.Request[v1.ListClusterNodePoolsRequest]) (*connect.Response[v1.ListClusterNodePoolsResponse], error) {
+	return c.listClusterNodePools.CallUnary(ctx, req)
+}
+
+// SetClusterNodePools calls admin.v1.AdminService.SetClusterNodePools.
+func (c *adminServiceClient) SetClusterNodePools(ctx context.Context, req *connect.Request[v1.SetClusterNodePoolsRequest]) (*connect.Response[v1.SetClusterNodePoolsResponse], error) {
+	return c.setClusterNodePools.CallUnary(ctx, req)
+}
+
+// ListPlatformAdmins calls admin.v1.AdminService.ListPlatformAdmins.
+func (c *adminServiceClient) ListPlatformAdmins(ctx context.Context, req *connect.Request[v1.ListPlatformAdminsRequest]) (*connect.Response[v1.ListPlatformAdminsResponse], error) {
+	return c.listPlatformAdmins.CallUnary(ctx, req)
+}
+
+// SetPlatformAdmin calls admin.v1.AdminService.SetPlatformAdmin.
+func (c *adminServiceClient) SetPlatformAdmin(ctx context.Context, req *connect.Request[v1.SetPlatformAdminRequest]) (*connect.Response[v1.SetPlatformAdminResponse], error) {
+	return c.setPlatformAdmin.CallUnary(ctx, req)
+}
+
+// ListAuditLog calls admin.v1.AdminService.ListAuditLog.
+func (c *adminServiceClient) ListAuditLog(ctx context.Context, req *connect.Request[v1.ListAuditLogRequest]) (*connect.Response[v1.ListAuditLogResponse], error) {
+	return c.listAuditLog.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the admin.v1.AdminService service.
+type AdminServiceHandler interface {
+	// ListOrgs lists every org on the platform.
+	ListOrgs(context.Context, *connect.Request[v1.ListOrgsRequest]) (*connect.Response[v1.ListOrgsResponse], error)
+	// ImpersonateUser issues a short-lived token acting as a user, to reproduce what they see.
+	ImpersonateUser(context.Context, *connect.Request[v1.ImpersonateUserRequest]) (*connect.Response[v1.ImpersonateUserResponse], error)
+	// ForceDeleteResource deletes a resource regardless of locks, deletion protection and approvals.
+	ForceDeleteResource(context.Context, *connect.Request[v1.ForceDeleteResourceRequest]) (*connect.Response[v1.ForceDeleteResourceResponse], error)
+	// SetPlatformDomainActive activates or deactivates a platform domain.
+	SetPlatformDomainActive(context.Context, *connect.Request[v1.SetPlatformDomainActiveRequest]) (*connect.Response[v1.SetPlatformDomainActiveResponse], error)
+	// ListClusters lists every cluster, active or not.
+	ListClusters(context.Context, *connect.Request[v1.ListClustersRequest]) (*connect.Response[v1.ListClustersResponse], error)
+	// CreateCluster registers a cluster.
+	CreateCluster(context.Context, *connect.Request[v1.CreateClusterRequest]) (*connect.Response[v1.CreateClusterResponse], error)
+	// UpdateCluster changes the given fields of a cluster.
+	UpdateCluster(context.Context, *connect.Request[v1.UpdateClusterRequest]) (*connect.Response[v1.UpdateClusterResponse], error)
+	// ListClusterNodePools lists the node pools of a cluster.
+	ListClusterNodePools(context.Context, *connect.Request[v1.ListClusterNodePoolsRequest]) (*connect.Response[v1.ListClusterNodePoolsResponse], error)
+	// SetClusterNodePools replaces the node pools of a cluster that services' scheduling is checked against.
+	SetClusterNodePools(context.Context, *connect.Request[v1.SetClusterNodePoolsRequest]) (*connect.Response[v1.SetClusterNodePoolsResponse], error)
+	// ListPlatformAdmins lists the users with the platform admin role.
+	ListPlatformAdmins(context.Context, *connect.Request[v1.ListPlatformAdminsRequest]) (*connect.Response[v1.ListPlatformAdminsResponse], error)
+	// SetPlatformAdmin grants or revokes the platform admin role.
+	SetPlatformAdmin(context.Context, *connect.Request[v1.SetPlatformAdminRequest]) (*connect.Response[v1.SetPlatformAdminResponse], error)
+	// ListAuditLog lists what platform admins did, newest first.
+	ListAuditLog(context.Context, *connect.Request[v1.ListAuditLogRequest]) (*connect.Response[v1.ListAuditLogResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceMethods := v1.File_admin_v1_admin_proto.Services().ByName("AdminService").Methods()
+	adminServiceListOrgsHandler := connect.NewUnaryHandler(
+		AdminServiceListOrgsProcedure,
+		svc.ListOrgs,
+		connect.WithSchema(adminServiceMethods.ByName("ListOrgs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceImpersonateUserHandler := connect.NewUnaryHandler(
+		AdminServiceImpersonateUserProcedure,
+		svc.ImpersonateUser,
+		connect.WithSchema(adminServiceMethods.ByName("ImpersonateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceForceDeleteResourceHandler := connect.NewUnaryHandler(
+		AdminServiceForceDeleteResourceProcedure,
+		svc.ForceDeleteResource,
+		connect.WithSchema(adminServiceMethods.ByName("ForceDeleteResource")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetPlatformDomainActiveHandler := connect.NewUnaryHandler(
+		AdminServiceSetPlatformDomainActiveProcedure,
+		svc.SetPlatformDomainActive,
+		connect.WithSchema(adminServiceMethods.ByName("SetPlatformDomainActive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListClustersHandler := connect.NewUnaryHandler(
+		AdminServiceListClustersProcedure,
+		svc.ListClusters,
+		connect.WithSchema(adminServiceMethods.ByName("ListClusters")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCreateClusterHandler := connect.NewUnaryHandler(
+		AdminServiceCreateClusterProcedure,
+		svc.CreateCluster,
+		connect.WithSchema(adminServiceMethods.ByName("CreateCluster")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateClusterHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateClusterProcedure,
+		svc.UpdateCluster,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateCluster")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListClusterNodePoolsHandler := connect.NewUnaryHandler(
+		AdminServiceListClusterNodePoolsProcedure,
+		svc.ListClusterNodePools,
+		connect.WithSchema(adminServiceMethods.ByName("ListClusterNodePools")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetClusterNodePoolsHandler := connect.NewUnaryHandler(
+		AdminServiceSetClusterNodePoolsProcedure,
+		svc.SetClusterNodePools,
+		connect.WithSchema(adminServiceMethods.ByName("SetClusterNodePools")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListPlatformAdminsHandler := connect.NewUnaryHandler(
+		AdminServiceListPlatformAdminsProcedure,
+		svc.ListPlatformAdmins,
+		connect.WithSchema(adminServiceMethods.ByName("ListPlatformAdmins")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetPlatformAdminHandler := connect.NewUnaryHandler(
+		AdminServiceSetPlatformAdminProcedure,
+		svc.SetPlatformAdmin,
+		connect.WithSchema(adminServiceMethods.ByName("SetPlatformAdmin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListAuditLogHandler := connect.NewUnaryHandler(
+		AdminServiceListAuditLogProcedure,
+		svc.ListAuditLog,
+		connect.WithSchema(adminServiceMethods.ByName("ListAuditLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/admin.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceListOrgsProcedure:
+			adminServiceListOrgsHandler.ServeHTTP(w, r)
+		case AdminServiceImpersonateUserProcedure:
+			adminServiceImpersonateUserHandler.ServeHTTP(w, r)
+		case AdminServiceForceDeleteResourceProcedure:
+			adminServiceForceDeleteResourceHandler.ServeHTTP(w, r)
+		case AdminServiceSetPlatformDomainActiveProcedure:
+			adminServiceSetPlatformDomainActiveHandler.ServeHTTP(w, r)
+		case AdminServiceListClustersProcedure:
+			adminServiceListClustersHandler.ServeHTTP(w, r)
+		case AdminServiceCreateClusterProcedure:
+			adminServiceCreateClusterHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateClusterProcedure:
+			adminServiceUpdateClusterHandler.ServeHTTP(w, r)
+		case AdminServiceListClusterNodePoolsProcedure:
+			adminServiceListClusterNodePoolsHandler.ServeHTTP(w, r)
+		case AdminServiceSetClusterNodePoolsProcedure:
+			adminServiceSetClusterNodePoolsHandler.ServeHTTP(w, r)
+		case AdminServiceListPlatformAdminsProcedure:
+			adminServiceListPlatformAdminsHandler.ServeHTTP(w, r)
+		case AdminServiceSetPlatformAdminProcedure:
+			adminServiceSetPlatformAdminHandler.ServeHTTP(w, r)
+		case AdminServiceListAuditLogProcedure:
+			adminServiceListAuditLogHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) ListOrgs(context.Context, *connect.Request[v1.ListOrgsRequest]) (*connect.Response[v1.ListOrgsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ListOrgs is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ImpersonateUser(context.Context, *connect.Request[v1.ImpersonateUserRequest]) (*connect.Response[v1.ImpersonateUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ImpersonateUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ForceDeleteResource(context.Context, *connect.Request[v1.ForceDeleteResourceRequest]) (*connect.Response[v1.ForceDeleteResourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ForceDeleteResource is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetPlatformDomainActive(context.Context, *connect.Request[v1.SetPlatformDomainActiveRequest]) (*connect.Response[v1.SetPlatformDomainActiveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.SetPlatformDomainActive is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListClusters(context.Context, *connect.Request[v1.ListClustersRequest]) (*connect.Response[v1.ListClustersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ListClusters is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CreateCluster(context.Context, *connect.Request[v1.CreateClusterRequest]) (*connect.Response[v1.CreateClusterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.CreateCluster is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateCluster(context.Context, *connect.Request[v1.UpdateClusterRequest]) (*connect.Response[v1.UpdateClusterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.UpdateCluster is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListClusterNodePools(context.Context, *connect.Request[v1.ListClusterNodePoolsRequest]) (*connect.Response[v1.ListClusterNodePoolsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ListClusterNodePools is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetClusterNodePools(context.Context, *connect.Request[v1.SetClusterNodePoolsRequest]) (*connect.Response[v1.SetClusterNodePoolsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.SetClusterNodePools is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListPlatformAdmins(context.Context, *connect.Request[v1.ListPlatformAdminsRequest]) (*connect.Response[v1.ListPlatformAdminsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ListPlatformAdmins is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetPlatformAdmin(context.Context, *connect.Request[v1.SetPlatformAdminRequest]) (*connect.Response[v1.SetPlatformAdminResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.SetPlatformAdmin is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListAuditLog(context.Context, *connect.Request[v1.ListAuditLogRequest]) (*connect.Response[v1.ListAuditLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ListAuditLog is not implemented"))
+}
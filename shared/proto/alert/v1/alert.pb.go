@@ -0,0 +1,916 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: alert/v1/alert.proto
+
+package alertv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AlertCondition is what an alert rule watches for.
+type AlertCondition int32
+
+const (
+	AlertCondition_ALERT_CONDITION_UNSPECIFIED       AlertCondition = 0
+	AlertCondition_ALERT_CONDITION_DEPLOYMENT_FAILED AlertCondition = 1 // alerts once for every failed deployment; threshold is unused
+	AlertCondition_ALERT_CONDITION_RESTARTS          AlertCondition = 2 // container restarts within the window exceed threshold
+	AlertCondition_ALERT_CONDITION_LATENCY_P95       AlertCondition = 3 // p95 health probe latency within the window exceeds threshold milliseconds
+)
+
+// Enum value maps for AlertCondition.
+var (
+	AlertCondition_name = map[int32]string{
+		0: "ALERT_CONDITION_UNSPECIFIED",
+		1: "ALERT_CONDITION_DEPLOYMENT_FAILED",
+		2: "ALERT_CONDITION_RESTARTS",
+		3: "ALERT_CONDITION_LATENCY_P95",
+	}
+	AlertCondition_value = map[string]int32{
+		"ALERT_CONDITION_UNSPECIFIED":       0,
+		"ALERT_CONDITION_DEPLOYMENT_FAILED": 1,
+		"ALERT_CONDITION_RESTARTS":          2,
+		"ALERT_CONDITION_LATENCY_P95":       3,
+	}
+)
+
+func (x AlertCondition) Enum() *AlertCondition {
+	p := new(AlertCondition)
+	*p = x
+	return p
+}
+
+func (x AlertCondition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertCondition) Descriptor() protoreflect.EnumDescriptor {
+	return file_alert_v1_alert_proto_enumTypes[0].Descriptor()
+}
+
+func (AlertCondition) Type() protoreflect.EnumType {
+	return &file_alert_v1_alert_proto_enumTypes[0]
+}
+
+func (x AlertCondition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertCondition.Descriptor instead.
+func (AlertCondition) EnumDescriptor() ([]byte, []int) {
+	return file_alert_v1_alert_proto_rawDescGZIP(), []int{0}
+}
+
+// AlertChannel is how an alert reaches a target.
+type AlertChannel int32
+
+const (
+	AlertChannel_ALERT_CHANNEL_UNSPECIFIED AlertChannel = 0
+	AlertChannel_ALERT_CHANNEL_EMAIL       AlertChannel = 1
+	AlertChannel_ALERT_CHANNEL_WEBHOOK     AlertChannel = 2 // posted as JSON, like notification webhooks
+	AlertChannel_ALERT_CHANNEL_SLACK       AlertChannel = 3 // posted to a Slack incoming webhook
+)
+
+// Enum value maps for AlertChannel.
+var (
+	AlertChannel_name = map[int32]string{
+		0: "ALERT_CHANNEL_UNSPECIFIED",
+		1: "ALERT_CHANNEL_EMAIL",
+		2: "ALERT_CHANNEL_WEBHOOK",
+		3: "ALERT_CHANNEL_SLACK",
+	}
+	AlertChannel_value = map[string]int32{
+		"ALERT_CHANNEL_UNSPECIFIED": 0,
+		"ALERT_CHANNEL_EMAIL":       1,
+		"ALERT_CHANNEL_WEBHOOK":     2,
+		"ALERT_CHANNEL_SLACK":       3,
+	}
+)
+
+func (x AlertChannel) Enum() *AlertChannel {
+	p := new(AlertChannel)
+	*p = x
+	return p
+}
+
+func (x AlertChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_alert_v1_alert_proto_enumTypes[1].Descriptor()
+}
+
+func (AlertChannel) Type() protoreflect.EnumType {
+	return &file_alert_v1_alert_proto_enumTypes[1]
+}
+
+func (x AlertChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertChannel.Descriptor instead.
+func (AlertChannel) EnumDescriptor() ([]byte, []int) {
+	return file_alert_v1_alert_proto_rawDescGZIP(), []int{1}
+}
+
+// AlertTarget is where a rule's alerts are sent.
+type AlertTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       AlertChannel           `protobuf:"varint,1,opt,name=channel,proto3,enum=alert.v1.AlertChannel" json:"channel,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"` // email address, or https URL for webhook and Slack
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertTarget) Reset() {
+	*x = AlertTarget{}
+	mi := &file_alert_v1_alert_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertTarget) ProtoMessage() {}
+
+func (x *AlertTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_v1_alert_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertTarget.ProtoReflect.Descriptor instead.
+func (*AlertTarget) Descriptor() ([]byte, []int) {
+	return file_alert_v1_alert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AlertTarget) GetChannel() AlertChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return AlertChannel_ALERT_CHANNEL_UNSPECIFIED
+}
+
+func (x *AlertTarget) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// AlertRule is a condition on a workspace's services and who to tell when it is met. Rules are
+// evaluated every 30 seconds; an alert is sent when a rule starts firing and again when it resolves.
+type AlertRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId   int64                  `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	ResourceId    *int64                 `protobuf:"varint,3,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"` // unset for every service in the workspace
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Condition     AlertCondition         `protobuf:"varint,5,opt,name=condition,proto3,enum=alert.v1.AlertCondition" json:"condition,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	WindowSeconds int32                  `protobuf:"varint,7,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	Enabled       bool                   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Targets       []*AlertTarget         `protobuf:"bytes,9,rep,name=targets,proto3" json:"targets,omitempty"`
+	Firing        []int64                `protobuf:"varint,10,rep,packed,name=firing,proto3" json:"firing,omitempty"` // the resources the rule is firing for
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_alert_v1_alert_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_v1_alert_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_alert_v1_alert_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AlertRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AlertRule) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *AlertRule) GetResourceId() int64 {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return 0
+}
+
+func (x *AlertRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlertRule) GetCondition() AlertCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return AlertCondition_ALERT_CONDITION_UNSPECIFIED
+}
+
+func (x *AlertRule) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AlertRule) GetWindowSeconds() int32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *AlertRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AlertRule) GetTargets() []*AlertTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *AlertRule) GetFiring() []int64 {
+	if x != nil {
+		return x.Firing
+	}
+	return nil
+}
+
+func (x *AlertRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AlertRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateAlertRuleRequest is the request to create a rule.
+type CreateAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	ResourceId    *int64                 `protobuf:"varint,2,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"` // unset for every service in the workspace
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Condition     AlertCondition         `protobuf:"varint,4,opt,name=condition,proto3,enum=alert.v1.AlertCondition" json:"condition,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	WindowSeconds int32                  `protobuf:"varint,6,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"` // defaults to 600
+	Targets       []*AlertTarget         `protobuf:"bytes,7,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleRequest) Reset() {
+	*x = CreateAlertRuleRequest{}
+	mi := &file_alert_v1_alert_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleRequest) ProtoMessage() {}
+
+func (x *CreateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_v1_alert_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_alert_v1_alert_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAlertRuleRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *CreateAlertRuleRequest) GetResourceId() int64 {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return 0
+}
+
+func (x *CreateAlertRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAlertRuleRequest) GetCondition() AlertCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return AlertCondition_ALERT_CONDITION_UNSPECIFIED
+}
+
+func (x *CreateAlertRuleRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CreateAlertRuleRequest) GetWindowSeconds() int32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *CreateAlertRuleRequest) GetTargets() []*AlertTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+// CreateAlertRuleResponse contains the created rule.
+type CreateAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleResponse) Reset() {
+	*x = CreateAlertRuleResponse{}
+	mi := &file_alert_v1_alert_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleResponse) ProtoMessage() {}
+
+func (x *CreateAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_v1_alert_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_alert_v1_alert_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateAlertRuleResponse) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// ListAlertRulesRequest is the request to list a workspace's rules.
+type ListAlertRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesRequest) Reset() {
+	*x = ListAlertRulesRequest{}
+	mi := &file_alert_v1_alert_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesRequest) ProtoMessage() {}
+
+func (x *ListAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_v1_alert_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_alert_v1_alert_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAlertRulesRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+// ListAlertRulesResponse contains a workspace's rules.
+type ListAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*AlertRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesResponse) Reset() {
+	*x = ListAlertRulesResponse{}
+	mi := &file_alert_v1_alert_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesResponse) ProtoMessage() {}
+
+func (x *ListAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_v1_alert_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_alert_v1_alert_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAlertRulesResponse) GetRules() []*AlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// UpdateAlertRuleRequest is the request to change a rule. Unset fields are left as they are.
+type UpdateAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Threshold     *float64               `protobuf:"fixed64,3,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
+	WindowSeconds *int32                 `protobuf:"varint,4,opt,name=window_seconds,json=windowSeconds,proto3,oneof" json:"window_seconds,omitempty"`
+	Enabled       *bool                  `protobuf:"varint,5,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Targets       []*AlertTarget         `protobuf:"bytes,6,rep,name=targets,proto3" json:"targets,omitempty"` // replaces the rule's targets when not empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertRuleRequest) Reset() {
+	*x = UpdateAlertRuleRequest{}
+	mi := &file_alert_v1_alert_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertRuleRequest) ProtoMessage() {}
+
+func (x *UpdateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_v1_alert_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_alert_v1_alert_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateAlertRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAlertRuleRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAlertRuleRequest) GetThreshold() float64 {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return 0
+}
+
+func (x *UpdateAlertRuleRequest) GetWindowSeconds() int32 {
+	if x != nil && x.WindowSeconds != nil {
+		return *x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *UpdateAlertRuleRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateAlertRuleRequest) GetTargets() []*AlertTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+// UpdateAlertRuleResponse contains the rule as stored.
+type UpdateAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertRuleResponse) Reset() {
+	*x = UpdateAlertRuleResponse{}
+	mi := &file_alert_v1_alert_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertRuleResponse) ProtoMessage() {}
+
+func (x *UpdateAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_v1_alert_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_alert_v1_alert_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateAlertRuleResponse) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// DeleteAlertRuleRequest is the request to delete a rule.
+type DeleteAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleRequest) Reset() {
+	*x = DeleteAlertRuleRequest{}
+	mi := &file_alert_v1_alert_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleRequest) ProtoMessage() {}
+
+func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_v1_alert_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_alert_v1_alert_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteAlertRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeleteAlertRuleResponse is empty.
+type DeleteAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleResponse) Reset() {
+	*x = DeleteAlertRuleResponse{}
+	mi := &file_alert_v1_alert_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleResponse) ProtoMessage() {}
+
+func (x *DeleteAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_v1_alert_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_alert_v1_alert_proto_rawDescGZIP(), []int{9}
+}
+
+var File_alert_v1_alert_proto protoreflect.FileDescriptor
+
+const file_alert_v1_alert_proto_rawDesc = "" +
+	"\n" +
+	"\x14alert/v1/alert.proto\x12\balert.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"Y\n" +
+	"\vAlertTarget\x120\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x16.alert.v1.AlertChannelR\achannel\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"\xde\x03\n" +
+	"\tAlertRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\x03R\vworkspaceId\x12$\n" +
+	"\vresource_id\x18\x03 \x01(\x03H\x00R\n" +
+	"resourceId\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x126\n" +
+	"\tcondition\x18\x05 \x01(\x0e2\x18.alert.v1.AlertConditionR\tcondition\x12\x1c\n" +
+	"\tthreshold\x18\x06 \x01(\x01R\tthreshold\x12%\n" +
+	"\x0ewindow_seconds\x18\a \x01(\x05R\rwindowSeconds\x12\x18\n" +
+	"\aenabled\x18\b \x01(\bR\aenabled\x12/\n" +
+	"\atargets\x18\t \x03(\v2\x15.alert.v1.AlertTargetR\atargets\x12\x16\n" +
+	"\x06firing\x18\n" +
+	" \x03(\x03R\x06firing\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_resource_id\"\xb3\x02\n" +
+	"\x16CreateAlertRuleRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12$\n" +
+	"\vresource_id\x18\x02 \x01(\x03H\x00R\n" +
+	"resourceId\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x126\n" +
+	"\tcondition\x18\x04 \x01(\x0e2\x18.alert.v1.AlertConditionR\tcondition\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x01R\tthreshold\x12%\n" +
+	"\x0ewindow_seconds\x18\x06 \x01(\x05R\rwindowSeconds\x12/\n" +
+	"\atargets\x18\a \x03(\v2\x15.alert.v1.AlertTargetR\atargetsB\x0e\n" +
+	"\f_resource_id\"B\n" +
+	"\x17CreateAlertRuleResponse\x12'\n" +
+	"\x04rule\x18\x01 \x01(\v2\x13.alert.v1.AlertRuleR\x04rule\":\n" +
+	"\x15ListAlertRulesRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"C\n" +
+	"\x16ListAlertRulesResponse\x12)\n" +
+	"\x05rules\x18\x01 \x03(\v2\x13.alert.v1.AlertRuleR\x05rules\"\x96\x02\n" +
+	"\x16UpdateAlertRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12!\n" +
+	"\tthreshold\x18\x03 \x01(\x01H\x01R\tthreshold\x88\x01\x01\x12*\n" +
+	"\x0ewindow_seconds\x18\x04 \x01(\x05H\x02R\rwindowSeconds\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x05 \x01(\bH\x03R\aenabled\x88\x01\x01\x12/\n" +
+	"\atargets\x18\x06 \x03(\v2\x15.alert.v1.AlertTargetR\atargetsB\a\n" +
+	"\x05_nameB\f\n" +
+	"\n" +
+	"_thresholdB\x11\n" +
+	"\x0f_window_secondsB\n" +
+	"\n" +
+	"\b_enabled\"B\n" +
+	"\x17UpdateAlertRuleResponse\x12'\n" +
+	"\x04rule\x18\x01 \x01(\v2\x13.alert.v1.AlertRuleR\x04rule\"(\n" +
+	"\x16DeleteAlertRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x19\n" +
+	"\x17DeleteAlertRuleResponse*\x97\x01\n" +
+	"\x0eAlertCondition\x12\x1f\n" +
+	"\x1bALERT_CONDITION_UNSPECIFIED\x10\x00\x12%\n" +
+	"!ALERT_CONDITION_DEPLOYMENT_FAILED\x10\x01\x12\x1c\n" +
+	"\x18ALERT_CONDITION_RESTARTS\x10\x02\x12\x1f\n" +
+	"\x1bALERT_CONDITION_LATENCY_P95\x10\x03*z\n" +
+	"\fAlertChannel\x12\x1d\n" +
+	"\x19ALERT_CHANNEL_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13ALERT_CHANNEL_EMAIL\x10\x01\x12\x19\n" +
+	"\x15ALERT_CHANNEL_WEBHOOK\x10\x02\x12\x17\n" +
+	"\x13ALERT_CHANNEL_SLACK\x10\x032\xeb\x02\n" +
+	"\fAlertService\x12V\n" +
+	"\x0fCreateAlertRule\x12 .alert.v1.CreateAlertRuleRequest\x1a!.alert.v1.CreateAlertRuleResponse\x12S\n" +
+	"\x0eListAlertRules\x12\x1f.alert.v1.ListAlertRulesRequest\x1a .alert.v1.ListAlertRulesResponse\x12V\n" +
+	"\x0fUpdateAlertRule\x12 .alert.v1.UpdateAlertRuleRequest\x1a!.alert.v1.UpdateAlertRuleResponse\x12V\n" +
+	"\x0fDeleteAlertRule\x12 .alert.v1.DeleteAlertRuleRequest\x1a!.alert.v1.DeleteAlertRuleResponseB9Z7github.com/team-loco/loco/shared/proto/alert/v1;alertv1b\x06proto3"
+
+var (
+	file_alert_v1_alert_proto_rawDescOnce sync.Once
+	file_alert_v1_alert_proto_rawDescData []byte
+)
+
+func file_alert_v1_alert_proto_rawDescGZIP() []byte {
+	file_alert_v1_alert_proto_rawDescOnce.Do(func() {
+		file_alert_v1_alert_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_alert_v1_alert_proto_rawDesc), len(file_alert_v1_alert_proto_rawDesc)))
+	})
+	return file_alert_v1_alert_proto_rawDescData
+}
+
+var file_alert_v1_alert_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_alert_v1_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_alert_v1_alert_proto_goTypes = []any{
+	(AlertCondition)(0),             // 0: alert.v1.AlertCondition
+	(AlertChannel)(0),               // 1: alert.v1.AlertChannel
+	(*AlertTarget)(nil),             // 2: alert.v1.AlertTarget
+	(*AlertRule)(nil),               // 3: alert.v1.AlertRule
+	(*CreateAlertRuleRequest)(nil),  // 4: alert.v1.CreateAlertRuleRequest
+	(*CreateAlertRuleResponse)(nil), // 5: alert.v1.CreateAlertRuleResponse
+	(*ListAlertRulesRequest)(nil),   // 6: alert.v1.ListAlertRulesRequest
+	(*ListAlertRulesResponse)(nil),  // 7: alert.v1.ListAlertRulesResponse
+	(*UpdateAlertRuleRequest)(nil),  // 8: alert.v1.UpdateAlertRuleRequest
+	(*UpdateAlertRuleResponse)(nil), // 9: alert.v1.UpdateAlertRuleResponse
+	(*DeleteAlertRuleRequest)(nil),  // 10: alert.v1.DeleteAlertRuleRequest
+	(*DeleteAlertRuleResponse)(nil), // 11: alert.v1.DeleteAlertRuleResponse
+	(*timestamppb.Timestamp)(nil),   // 12: google.protobuf.Timestamp
+}
+var file_alert_v1_alert_proto_depIdxs = []int32{
+	1,  // 0: alert.v1.AlertTarget.channel:type_name -> alert.v1.AlertChannel
+	0,  // 1: alert.v1.AlertRule.condition:type_name -> alert.v1.AlertCondition
+	2,  // 2: alert.v1.AlertRule.targets:type_name -> alert.v1.AlertTarget
+	12, // 3: alert.v1.AlertRule.created_at:type_name -> google.protobuf.Timestamp
+	12, // 4: alert.v1.AlertRule.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: alert.v1.CreateAlertRuleRequest.condition:type_name -> alert.v1.AlertCondition
+	2,  // 6: alert.v1.CreateAlertRuleRequest.targets:type_name -> alert.v1.AlertTarget
+	3,  // 7: alert.v1.CreateAlertRuleResponse.rule:type_name -> alert.v1.AlertRule
+	3,  // 8: alert.v1.ListAlertRulesResponse.rules:type_name -> alert.v1.AlertRule
+	2,  // 9: alert.v1.UpdateAlertRuleRequest.targets:type_name -> alert.v1.AlertTarget
+	3,  // 10: alert.v1.UpdateAlertRuleResponse.rule:type_name -> alert.v1.AlertRule
+	4,  // 11: alert.v1.AlertService.CreateAlertRule:input_type -> alert.v1.CreateAlertRuleRequest
+	6,  // 12: alert.v1.AlertService.ListAlertRules:input_type -> alert.v1.ListAlertRulesRequest
+	8,  // 13: alert.v1.AlertService.UpdateAlertRule:input_type -> alert.v1.UpdateAlertRuleRequest
+	10, // 14: alert.v1.AlertService.DeleteAlertRule:input_type -> alert.v1.DeleteAlertRuleRequest
+	5,  // 15: alert.v1.AlertService.CreateAlertRule:output_type -> alert.v1.CreateAlertRuleResponse
+	7,  // 16: alert.v1.AlertService.ListAlertRules:output_type -> alert.v1.ListAlertRulesResponse
+	9,  // 17: alert.v1.AlertService.UpdateAlertRule:output_type -> alert.v1.UpdateAlertRuleResponse
+	11, // 18: alert.v1.AlertService.DeleteAlertRule:output_type -> alert.v1.DeleteAlertRuleResponse
+	15, // [15:19] is the sub-list for method output_type
+	11, // [11:15] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_alert_v1_alert_proto_init() }
+func file_alert_v1_alert_proto_init() {
+	if File_alert_v1_alert_proto != nil {
+		return
+	}
+	file_alert_v1_alert_proto_msgTypes[1].OneofWrappers = []any{}
+	file_alert_v1_alert_proto_msgTypes[2].OneofWrappers = []any{}
+	file_alert_v1_alert_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_alert_v1_alert_proto_rawDesc), len(file_alert_v1_alert_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_alert_v1_alert_proto_goTypes,
+		DependencyIndexes: file_alert_v1_alert_proto_depIdxs,
+		EnumInfos:         file_alert_v1_alert_proto_enumTypes,
+		MessageInfos:      file_alert_v1_alert_proto_msgTypes,
+	}.Build()
+	File_alert_v1_alert_proto = out.File
+	file_alert_v1_alert_proto_goTypes = nil
+	file_alert_v1_alert_proto_depIdxs = nil
+}
@@ -0,0 +1,719 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: announcement/v1/announcement.proto
+
+package announcementv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AnnouncementKind categorizes an announcement.
+type AnnouncementKind int32
+
+const (
+	AnnouncementKind_ANNOUNCEMENT_KIND_UNSPECIFIED  AnnouncementKind = 0
+	AnnouncementKind_ANNOUNCEMENT_KIND_RELEASE_NOTE AnnouncementKind = 1
+	AnnouncementKind_ANNOUNCEMENT_KIND_DEPRECATION  AnnouncementKind = 2
+	AnnouncementKind_ANNOUNCEMENT_KIND_MAINTENANCE  AnnouncementKind = 3
+)
+
+// Enum value maps for AnnouncementKind.
+var (
+	AnnouncementKind_name = map[int32]string{
+		0: "ANNOUNCEMENT_KIND_UNSPECIFIED",
+		1: "ANNOUNCEMENT_KIND_RELEASE_NOTE",
+		2: "ANNOUNCEMENT_KIND_DEPRECATION",
+		3: "ANNOUNCEMENT_KIND_MAINTENANCE",
+	}
+	AnnouncementKind_value = map[string]int32{
+		"ANNOUNCEMENT_KIND_UNSPECIFIED":  0,
+		"ANNOUNCEMENT_KIND_RELEASE_NOTE": 1,
+		"ANNOUNCEMENT_KIND_DEPRECATION":  2,
+		"ANNOUNCEMENT_KIND_MAINTENANCE":  3,
+	}
+)
+
+func (x AnnouncementKind) Enum() *AnnouncementKind {
+	p := new(AnnouncementKind)
+	*p = x
+	return p
+}
+
+func (x AnnouncementKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnnouncementKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_announcement_v1_announcement_proto_enumTypes[0].Descriptor()
+}
+
+func (AnnouncementKind) Type() protoreflect.EnumType {
+	return &file_announcement_v1_announcement_proto_enumTypes[0]
+}
+
+func (x AnnouncementKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnnouncementKind.Descriptor instead.
+func (AnnouncementKind) EnumDescriptor() ([]byte, []int) {
+	return file_announcement_v1_announcement_proto_rawDescGZIP(), []int{0}
+}
+
+// Announcement is a platform-wide notice such as release notes or a deprecation.
+type Announcement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          AnnouncementKind       `protobuf:"varint,2,opt,name=kind,proto3,enum=announcement.v1.AnnouncementKind" json:"kind,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`       // markdown
+	Link          *string                `protobuf:"bytes,5,opt,name=link,proto3,oneof" json:"link,omitempty"` // where to read more
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	Read          bool                   `protobuf:"varint,8,opt,name=read,proto3" json:"read,omitempty"` // whether the caller has read it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_announcement_v1_announcement_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_v1_announcement_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_announcement_v1_announcement_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Announcement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Announcement) GetKind() AnnouncementKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AnnouncementKind_ANNOUNCEMENT_KIND_UNSPECIFIED
+}
+
+func (x *Announcement) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Announcement) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Announcement) GetLink() string {
+	if x != nil && x.Link != nil {
+		return *x.Link
+	}
+	return ""
+}
+
+func (x *Announcement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+// CreateAnnouncementRequest is the request to publish an announcement.
+type CreateAnnouncementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          AnnouncementKind       `protobuf:"varint,1,opt,name=kind,proto3,enum=announcement.v1.AnnouncementKind" json:"kind,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Link          *string                `protobuf:"bytes,4,opt,name=link,proto3,oneof" json:"link,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAnnouncementRequest) Reset() {
+	*x = CreateAnnouncementRequest{}
+	mi := &file_announcement_v1_announcement_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAnnouncementRequest) ProtoMessage() {}
+
+func (x *CreateAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_v1_announcement_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*CreateAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_announcement_v1_announcement_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAnnouncementRequest) GetKind() AnnouncementKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AnnouncementKind_ANNOUNCEMENT_KIND_UNSPECIFIED
+}
+
+func (x *CreateAnnouncementRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateAnnouncementRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateAnnouncementRequest) GetLink() string {
+	if x != nil && x.Link != nil {
+		return *x.Link
+	}
+	return ""
+}
+
+func (x *CreateAnnouncementRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// CreateAnnouncementResponse is the response containing the created announcement ID.
+type CreateAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAnnouncementResponse) Reset() {
+	*x = CreateAnnouncementResponse{}
+	mi := &file_announcement_v1_announcement_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAnnouncementResponse) ProtoMessage() {}
+
+func (x *CreateAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_v1_announcement_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*CreateAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_announcement_v1_announcement_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAnnouncementResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeleteAnnouncementRequest is the request to delete an announcement.
+type DeleteAnnouncementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAnnouncementRequest) Reset() {
+	*x = DeleteAnnouncementRequest{}
+	mi := &file_announcement_v1_announcement_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnouncementRequest) ProtoMessage() {}
+
+func (x *DeleteAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_v1_announcement_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_announcement_v1_announcement_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteAnnouncementRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeleteAnnouncementResponse is the response after deleting an announcement.
+type DeleteAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAnnouncementResponse) Reset() {
+	*x = DeleteAnnouncementResponse{}
+	mi := &file_announcement_v1_announcement_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnouncementResponse) ProtoMessage() {}
+
+func (x *DeleteAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_v1_announcement_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_announcement_v1_announcement_proto_rawDescGZIP(), []int{4}
+}
+
+// ListAnnouncementsRequest is the request to list announcements.
+type ListAnnouncementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,3,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnnouncementsRequest) Reset() {
+	*x = ListAnnouncementsRequest{}
+	mi := &file_announcement_v1_announcement_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsRequest) ProtoMessage() {}
+
+func (x *ListAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_v1_announcement_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_announcement_v1_announcement_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAnnouncementsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAnnouncementsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAnnouncementsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+// ListAnnouncementsResponse contains a page of announcements.
+type ListAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	UnreadCount   int64                  `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnnouncementsResponse) Reset() {
+	*x = ListAnnouncementsResponse{}
+	mi := &file_announcement_v1_announcement_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsResponse) ProtoMessage() {}
+
+func (x *ListAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_v1_announcement_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_announcement_v1_announcement_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListAnnouncementsResponse) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+func (x *ListAnnouncementsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListAnnouncementsResponse) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+// MarkAnnouncementsReadRequest is the request to mark announcements as read.
+type MarkAnnouncementsReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpToId        *int64                 `protobuf:"varint,1,opt,name=up_to_id,json=upToId,proto3,oneof" json:"up_to_id,omitempty"` // defaults to the latest announcement
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAnnouncementsReadRequest) Reset() {
+	*x = MarkAnnouncementsReadRequest{}
+	mi := &file_announcement_v1_announcement_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAnnouncementsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAnnouncementsReadRequest) ProtoMessage() {}
+
+func (x *MarkAnnouncementsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_v1_announcement_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAnnouncementsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAnnouncementsReadRequest) Descriptor() ([]byte, []int) {
+	return file_announcement_v1_announcement_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MarkAnnouncementsReadRequest) GetUpToId() int64 {
+	if x != nil && x.UpToId != nil {
+		return *x.UpToId
+	}
+	return 0
+}
+
+// MarkAnnouncementsReadResponse is the response after marking announcements as read.
+type MarkAnnouncementsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAnnouncementsReadResponse) Reset() {
+	*x = MarkAnnouncementsReadResponse{}
+	mi := &file_announcement_v1_announcement_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAnnouncementsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAnnouncementsReadResponse) ProtoMessage() {}
+
+func (x *MarkAnnouncementsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_v1_announcement_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAnnouncementsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAnnouncementsReadResponse) Descriptor() ([]byte, []int) {
+	return file_announcement_v1_announcement_proto_rawDescGZIP(), []int{8}
+}
+
+var File_announcement_v1_announcement_proto protoreflect.FileDescriptor
+
+const file_announcement_v1_announcement_proto_rawDesc = "" +
+	"\n" +
+	"\"announcement/v1/announcement.proto\x12\x0fannouncement.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbf\x02\n" +
+	"\fAnnouncement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x125\n" +
+	"\x04kind\x18\x02 \x01(\x0e2!.announcement.v1.AnnouncementKindR\x04kind\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x17\n" +
+	"\x04link\x18\x05 \x01(\tH\x00R\x04link\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12>\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\texpiresAt\x88\x01\x01\x12\x12\n" +
+	"\x04read\x18\b \x01(\bR\x04readB\a\n" +
+	"\x05_linkB\r\n" +
+	"\v_expires_at\"\xed\x01\n" +
+	"\x19CreateAnnouncementRequest\x125\n" +
+	"\x04kind\x18\x01 \x01(\x0e2!.announcement.v1.AnnouncementKindR\x04kind\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x17\n" +
+	"\x04link\x18\x04 \x01(\tH\x00R\x04link\x88\x01\x01\x12>\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\texpiresAt\x88\x01\x01B\a\n" +
+	"\x05_linkB\r\n" +
+	"\v_expires_at\",\n" +
+	"\x1aCreateAnnouncementResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"+\n" +
+	"\x19DeleteAnnouncementRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x1c\n" +
+	"\x1aDeleteAnnouncementResponse\"w\n" +
+	"\x18ListAnnouncementsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\vunread_only\x18\x03 \x01(\bR\n" +
+	"unreadOnly\"\xab\x01\n" +
+	"\x19ListAnnouncementsResponse\x12C\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x1d.announcement.v1.AnnouncementR\rannouncements\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12!\n" +
+	"\funread_count\x18\x03 \x01(\x03R\vunreadCount\"J\n" +
+	"\x1cMarkAnnouncementsReadRequest\x12\x1d\n" +
+	"\bup_to_id\x18\x01 \x01(\x03H\x00R\x06upToId\x88\x01\x01B\v\n" +
+	"\t_up_to_id\"\x1f\n" +
+	"\x1dMarkAnnouncementsReadResponse*\x9f\x01\n" +
+	"\x10AnnouncementKind\x12!\n" +
+	"\x1dANNOUNCEMENT_KIND_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eANNOUNCEMENT_KIND_RELEASE_NOTE\x10\x01\x12!\n" +
+	"\x1dANNOUNCEMENT_KIND_DEPRECATION\x10\x02\x12!\n" +
+	"\x1dANNOUNCEMENT_KIND_MAINTENANCE\x10\x032\xd7\x03\n" +
+	"\x13AnnouncementService\x12m\n" +
+	"\x12CreateAnnouncement\x12*.announcement.v1.CreateAnnouncementRequest\x1a+.announcement.v1.CreateAnnouncementResponse\x12m\n" +
+	"\x12DeleteAnnouncement\x12*.announcement.v1.DeleteAnnouncementRequest\x1a+.announcement.v1.DeleteAnnouncementResponse\x12j\n" +
+	"\x11ListAnnouncements\x12).announcement.v1.ListAnnouncementsRequest\x1a*.announcement.v1.ListAnnouncementsResponse\x12v\n" +
+	"\x15MarkAnnouncementsRead\x12-.announcement.v1.MarkAnnouncementsReadRequest\x1a..announcement.v1.MarkAnnouncementsReadResponseBGZEgithub.com/team-loco/loco/shared/proto/announcement/v1;announcementv1b\x06proto3"
+
+var (
+	file_announcement_v1_announcement_proto_rawDescOnce sync.Once
+	file_announcement_v1_announcement_proto_rawDescData []byte
+)
+
+func file_announcement_v1_announcement_proto_rawDescGZIP() []byte {
+	file_announcement_v1_announcement_proto_rawDescOnce.Do(func() {
+		file_announcement_v1_announcement_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_announcement_v1_announcement_proto_rawDesc), len(file_announcement_v1_announcement_proto_rawDesc)))
+	})
+	return file_announcement_v1_announcement_proto_rawDescData
+}
+
+var file_announcement_v1_announcement_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_announcement_v1_announcement_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_announcement_v1_announcement_proto_goTypes = []any{
+	(AnnouncementKind)(0),                 // 0: announcement.v1.AnnouncementKind
+	(*Announcement)(nil),                  // 1: announcement.v1.Announcement
+	(*CreateAnnouncementRequest)(nil),     // 2: announcement.v1.CreateAnnouncementRequest
+	(*CreateAnnouncementResponse)(nil),    // 3: announcement.v1.CreateAnnouncementResponse
+	(*DeleteAnnouncementRequest)(nil),     // 4: announcement.v1.DeleteAnnouncementRequest
+	(*DeleteAnnouncementResponse)(nil),    // 5: announcement.v1.DeleteAnnouncementResponse
+	(*ListAnnouncementsRequest)(nil),      // 6: announcement.v1.ListAnnouncementsRequest
+	(*ListAnnouncementsResponse)(nil),     // 7: announcement.v1.ListAnnouncementsResponse
+	(*MarkAnnouncementsReadRequest)(nil),  // 8: announcement.v1.MarkAnnouncementsReadRequest
+	(*MarkAnnouncementsReadResponse)(nil), // 9: announcement.v1.MarkAnnouncementsReadResponse
+	(*timestamppb.Timestamp)(nil),         // 10: google.protobuf.Timestamp
+}
+var file_announcement_v1_announcement_proto_depIdxs = []int32{
+	0,  // 0: announcement.v1.Announcement.kind:type_name -> announcement.v1.AnnouncementKind
+	10, // 1: announcement.v1.Announcement.created_at:type_name -> google.protobuf.Timestamp
+	10, // 2: announcement.v1.Announcement.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: announcement.v1.CreateAnnouncementRequest.kind:type_name -> announcement.v1.AnnouncementKind
+	10, // 4: announcement.v1.CreateAnnouncementRequest.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: announcement.v1.ListAnnouncementsResponse.announcements:type_name -> announcement.v1.Announcement
+	2,  // 6: announcement.v1.AnnouncementService.CreateAnnouncement:input_type -> announcement.v1.CreateAnnouncementRequest
+	4,  // 7: announcement.v1.AnnouncementService.DeleteAnnouncement:input_type -> announcement.v1.DeleteAnnouncementRequest
+	6,  // 8: announcement.v1.AnnouncementService.ListAnnouncements:input_type -> announcement.v1.ListAnnouncementsRequest
+	8,  // 9: announcement.v1.AnnouncementService.MarkAnnouncementsRead:input_type -> announcement.v1.MarkAnnouncementsReadRequest
+	3,  // 10: announcement.v1.AnnouncementService.CreateAnnouncement:output_type -> announcement.v1.CreateAnnouncementResponse
+	5,  // 11: announcement.v1.AnnouncementService.DeleteAnnouncement:output_type -> announcement.v1.DeleteAnnouncementResponse
+	7,  // 12: announcement.v1.AnnouncementService.ListAnnouncements:output_type -> announcement.v1.ListAnnouncementsResponse
+	9,  // 13: announcement.v1.AnnouncementService.MarkAnnouncementsRead:output_type -> announcement.v1.MarkAnnouncementsReadResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_announcement_v1_announcement_proto_init() }
+func file_announcement_v1_announcement_proto_init() {
+	if File_announcement_v1_announcement_proto != nil {
+		return
+	}
+	file_announcement_v1_announcement_proto_msgTypes[0].OneofWrappers = []any{}
+	file_announcement_v1_announcement_proto_msgTypes[1].OneofWrappers = []any{}
+	file_announcement_v1_announcement_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_announcement_v1_announcement_proto_rawDesc), len(file_announcement_v1_announcement_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_announcement_v1_announcement_proto_goTypes,
+		DependencyIndexes: file_announcement_v1_announcement_proto_depIdxs,
+		EnumInfos:         file_announcement_v1_announcement_proto_enumTypes,
+		MessageInfos:      file_announcement_v1_announcement_proto_msgTypes,
+	}.Build()
+	File_announcement_v1_announcement_proto = out.File
+	file_announcement_v1_announcement_proto_goTypes = nil
+	file_announcement_v1_announcement_proto_depIdxs = nil
+}
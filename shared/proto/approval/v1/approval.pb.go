@@ -0,0 +1,676 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: approval/v1/approval.proto
+
+package approvalv1
+
+import (
+	v1 "github.com/team-loco/loco/shared/proto/token/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ApprovalAction is a destructive operation that waits for a second admin when its target is production-tagged.
+type ApprovalAction int32
+
+const (
+	ApprovalAction_APPROVAL_ACTION_UNSPECIFIED            ApprovalAction = 0
+	ApprovalAction_APPROVAL_ACTION_DELETE_RESOURCE        ApprovalAction = 1 // ResourceService.DeleteResource
+	ApprovalAction_APPROVAL_ACTION_DELETE_WORKSPACE       ApprovalAction = 2 // WorkspaceService.DeleteWorkspace
+	ApprovalAction_APPROVAL_ACTION_DELETE_RESOURCE_DOMAIN ApprovalAction = 3 // DomainService.DeleteResourceDomain
+)
+
+// Enum value maps for ApprovalAction.
+var (
+	ApprovalAction_name = map[int32]string{
+		0: "APPROVAL_ACTION_UNSPECIFIED",
+		1: "APPROVAL_ACTION_DELETE_RESOURCE",
+		2: "APPROVAL_ACTION_DELETE_WORKSPACE",
+		3: "APPROVAL_ACTION_DELETE_RESOURCE_DOMAIN",
+	}
+	ApprovalAction_value = map[string]int32{
+		"APPROVAL_ACTION_UNSPECIFIED":            0,
+		"APPROVAL_ACTION_DELETE_RESOURCE":        1,
+		"APPROVAL_ACTION_DELETE_WORKSPACE":       2,
+		"APPROVAL_ACTION_DELETE_RESOURCE_DOMAIN": 3,
+	}
+)
+
+func (x ApprovalAction) Enum() *ApprovalAction {
+	p := new(ApprovalAction)
+	*p = x
+	return p
+}
+
+func (x ApprovalAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_approval_v1_approval_proto_enumTypes[0].Descriptor()
+}
+
+func (ApprovalAction) Type() protoreflect.EnumType {
+	return &file_approval_v1_approval_proto_enumTypes[0]
+}
+
+func (x ApprovalAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalAction.Descriptor instead.
+func (ApprovalAction) EnumDescriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{0}
+}
+
+type ApprovalStatus int32
+
+const (
+	ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED ApprovalStatus = 0
+	ApprovalStatus_APPROVAL_STATUS_PENDING     ApprovalStatus = 1
+	ApprovalStatus_APPROVAL_STATUS_EXECUTED    ApprovalStatus = 2 // approved and carried out
+	ApprovalStatus_APPROVAL_STATUS_CANCELLED   ApprovalStatus = 3
+	ApprovalStatus_APPROVAL_STATUS_EXPIRED     ApprovalStatus = 4 // nobody approved it in time
+)
+
+// Enum value maps for ApprovalStatus.
+var (
+	ApprovalStatus_name = map[int32]string{
+		0: "APPROVAL_STATUS_UNSPECIFIED",
+		1: "APPROVAL_STATUS_PENDING",
+		2: "APPROVAL_STATUS_EXECUTED",
+		3: "APPROVAL_STATUS_CANCELLED",
+		4: "APPROVAL_STATUS_EXPIRED",
+	}
+	ApprovalStatus_value = map[string]int32{
+		"APPROVAL_STATUS_UNSPECIFIED": 0,
+		"APPROVAL_STATUS_PENDING":     1,
+		"APPROVAL_STATUS_EXECUTED":    2,
+		"APPROVAL_STATUS_CANCELLED":   3,
+		"APPROVAL_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x ApprovalStatus) Enum() *ApprovalStatus {
+	p := new(ApprovalStatus)
+	*p = x
+	return p
+}
+
+func (x ApprovalStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_approval_v1_approval_proto_enumTypes[1].Descriptor()
+}
+
+func (ApprovalStatus) Type() protoreflect.EnumType {
+	return &file_approval_v1_approval_proto_enumTypes[1]
+}
+
+func (x ApprovalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalStatus.Descriptor instead.
+func (ApprovalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{1}
+}
+
+// ApprovalRequest is a destructive operation waiting for a second admin.
+//
+// A workspace or resource is production-tagged when its labels contain env=production; a workspace also counts
+// when any of its resources is. Deleting such a target fails with FAILED_PRECONDITION and an ApprovalRequest
+// error detail until another admin calls ApproveAction, which carries the operation out.
+type ApprovalRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action          ApprovalAction         `protobuf:"varint,2,opt,name=action,proto3,enum=approval.v1.ApprovalAction" json:"action,omitempty"`
+	TargetId        int64                  `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // the resource, workspace or resource domain
+	WorkspaceId     int64                  `protobuf:"varint,4,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	RequestedByType v1.EntityType          `protobuf:"varint,5,opt,name=requested_by_type,json=requestedByType,proto3,enum=token.v1.EntityType" json:"requested_by_type,omitempty"`
+	RequestedById   int64                  `protobuf:"varint,6,opt,name=requested_by_id,json=requestedById,proto3" json:"requested_by_id,omitempty"`
+	ApprovedBy      *int64                 `protobuf:"varint,7,opt,name=approved_by,json=approvedBy,proto3,oneof" json:"approved_by,omitempty"` // the user who approved it
+	Status          ApprovalStatus         `protobuf:"varint,8,opt,name=status,proto3,enum=approval.v1.ApprovalStatus" json:"status,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DecidedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApprovalRequest) Reset() {
+	*x = ApprovalRequest{}
+	mi := &file_approval_v1_approval_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalRequest) ProtoMessage() {}
+
+func (x *ApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalRequest.ProtoReflect.Descriptor instead.
+func (*ApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApprovalRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApprovalRequest) GetAction() ApprovalAction {
+	if x != nil {
+		return x.Action
+	}
+	return ApprovalAction_APPROVAL_ACTION_UNSPECIFIED
+}
+
+func (x *ApprovalRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *ApprovalRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *ApprovalRequest) GetRequestedByType() v1.EntityType {
+	if x != nil {
+		return x.RequestedByType
+	}
+	return v1.EntityType(0)
+}
+
+func (x *ApprovalRequest) GetRequestedById() int64 {
+	if x != nil {
+		return x.RequestedById
+	}
+	return 0
+}
+
+func (x *ApprovalRequest) GetApprovedBy() int64 {
+	if x != nil && x.ApprovedBy != nil {
+		return *x.ApprovedBy
+	}
+	return 0
+}
+
+func (x *ApprovalRequest) GetStatus() ApprovalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED
+}
+
+func (x *ApprovalRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ApprovalRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApprovalRequest) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+// ListApprovalRequestsRequest is the request to list a workspace's approval requests.
+type ListApprovalRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	PendingOnly   bool                   `protobuf:"varint,2,opt,name=pending_only,json=pendingOnly,proto3" json:"pending_only,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // default: 50, max: 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalRequestsRequest) Reset() {
+	*x = ListApprovalRequestsRequest{}
+	mi := &file_approval_v1_approval_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalRequestsRequest) ProtoMessage() {}
+
+func (x *ListApprovalRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListApprovalRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListApprovalRequestsRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *ListApprovalRequestsRequest) GetPendingOnly() bool {
+	if x != nil {
+		return x.PendingOnly
+	}
+	return false
+}
+
+func (x *ListApprovalRequestsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListApprovalRequestsResponse is the response containing the approval requests.
+type ListApprovalRequestsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalRequests []*ApprovalRequest     `protobuf:"bytes,1,rep,name=approval_requests,json=approvalRequests,proto3" json:"approval_requests,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListApprovalRequestsResponse) Reset() {
+	*x = ListApprovalRequestsResponse{}
+	mi := &file_approval_v1_approval_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalRequestsResponse) ProtoMessage() {}
+
+func (x *ListApprovalRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListApprovalRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListApprovalRequestsResponse) GetApprovalRequests() []*ApprovalRequest {
+	if x != nil {
+		return x.ApprovalRequests
+	}
+	return nil
+}
+
+// ApproveActionRequest is the request to approve a pending request.
+type ApproveActionRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalRequestId int64                  `protobuf:"varint,1,opt,name=approval_request_id,json=approvalRequestId,proto3" json:"approval_request_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ApproveActionRequest) Reset() {
+	*x = ApproveActionRequest{}
+	mi := &file_approval_v1_approval_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveActionRequest) ProtoMessage() {}
+
+func (x *ApproveActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveActionRequest.ProtoReflect.Descriptor instead.
+func (*ApproveActionRequest) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ApproveActionRequest) GetApprovalRequestId() int64 {
+	if x != nil {
+		return x.ApprovalRequestId
+	}
+	return 0
+}
+
+// ApproveActionResponse is the response containing the executed request.
+type ApproveActionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalRequest *ApprovalRequest       `protobuf:"bytes,1,opt,name=approval_request,json=approvalRequest,proto3" json:"approval_request,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApproveActionResponse) Reset() {
+	*x = ApproveActionResponse{}
+	mi := &file_approval_v1_approval_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveActionResponse) ProtoMessage() {}
+
+func (x *ApproveActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveActionResponse.ProtoReflect.Descriptor instead.
+func (*ApproveActionResponse) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApproveActionResponse) GetApprovalRequest() *ApprovalRequest {
+	if x != nil {
+		return x.ApprovalRequest
+	}
+	return nil
+}
+
+// CancelApprovalRequestRequest is the request to cancel a pending request.
+type CancelApprovalRequestRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalRequestId int64                  `protobuf:"varint,1,opt,name=approval_request_id,json=approvalRequestId,proto3" json:"approval_request_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CancelApprovalRequestRequest) Reset() {
+	*x = CancelApprovalRequestRequest{}
+	mi := &file_approval_v1_approval_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelApprovalRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelApprovalRequestRequest) ProtoMessage() {}
+
+func (x *CancelApprovalRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelApprovalRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelApprovalRequestRequest) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelApprovalRequestRequest) GetApprovalRequestId() int64 {
+	if x != nil {
+		return x.ApprovalRequestId
+	}
+	return 0
+}
+
+// CancelApprovalRequestResponse is the response containing the cancelled request.
+type CancelApprovalRequestResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalRequest *ApprovalRequest       `protobuf:"bytes,1,opt,name=approval_request,json=approvalRequest,proto3" json:"approval_request,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CancelApprovalRequestResponse) Reset() {
+	*x = CancelApprovalRequestResponse{}
+	mi := &file_approval_v1_approval_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelApprovalRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelApprovalRequestResponse) ProtoMessage() {}
+
+func (x *CancelApprovalRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelApprovalRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelApprovalRequestResponse) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CancelApprovalRequestResponse) GetApprovalRequest() *ApprovalRequest {
+	if x != nil {
+		return x.ApprovalRequest
+	}
+	return nil
+}
+
+var File_approval_v1_approval_proto protoreflect.FileDescriptor
+
+const file_approval_v1_approval_proto_rawDesc = "" +
+	"\n" +
+	"\x1aapproval/v1/approval.proto\x12\vapproval.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14token/v1/token.proto\"\x9c\x04\n" +
+	"\x0fApprovalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x123\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x1b.approval.v1.ApprovalActionR\x06action\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\x03R\btargetId\x12!\n" +
+	"\fworkspace_id\x18\x04 \x01(\x03R\vworkspaceId\x12@\n" +
+	"\x11requested_by_type\x18\x05 \x01(\x0e2\x14.token.v1.EntityTypeR\x0frequestedByType\x12&\n" +
+	"\x0frequested_by_id\x18\x06 \x01(\x03R\rrequestedById\x12$\n" +
+	"\vapproved_by\x18\a \x01(\x03H\x00R\n" +
+	"approvedBy\x88\x01\x01\x123\n" +
+	"\x06status\x18\b \x01(\x0e2\x1b.approval.v1.ApprovalStatusR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"decided_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAtB\x0e\n" +
+	"\f_approved_by\"\x80\x01\n" +
+	"\x1bListApprovalRequestsRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12!\n" +
+	"\fpending_only\x18\x02 \x01(\bR\vpendingOnly\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"i\n" +
+	"\x1cListApprovalRequestsResponse\x12I\n" +
+	"\x11approval_requests\x18\x01 \x03(\v2\x1c.approval.v1.ApprovalRequestR\x10approvalRequests\"F\n" +
+	"\x14ApproveActionRequest\x12.\n" +
+	"\x13approval_request_id\x18\x01 \x01(\x03R\x11approvalRequestId\"`\n" +
+	"\x15ApproveActionResponse\x12G\n" +
+	"\x10approval_request\x18\x01 \x01(\v2\x1c.approval.v1.ApprovalRequestR\x0fapprovalRequest\"N\n" +
+	"\x1cCancelApprovalRequestRequest\x12.\n" +
+	"\x13approval_request_id\x18\x01 \x01(\x03R\x11approvalRequestId\"h\n" +
+	"\x1dCancelApprovalRequestResponse\x12G\n" +
+	"\x10approval_request\x18\x01 \x01(\v2\x1c.approval.v1.ApprovalRequestR\x0fapprovalRequest*\xa8\x01\n" +
+	"\x0eApprovalAction\x12\x1f\n" +
+	"\x1bAPPROVAL_ACTION_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fAPPROVAL_ACTION_DELETE_RESOURCE\x10\x01\x12$\n" +
+	" APPROVAL_ACTION_DELETE_WORKSPACE\x10\x02\x12*\n" +
+	"&APPROVAL_ACTION_DELETE_RESOURCE_DOMAIN\x10\x03*\xa8\x01\n" +
+	"\x0eApprovalStatus\x12\x1f\n" +
+	"\x1bAPPROVAL_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17APPROVAL_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18APPROVAL_STATUS_EXECUTED\x10\x02\x12\x1d\n" +
+	"\x19APPROVAL_STATUS_CANCELLED\x10\x03\x12\x1b\n" +
+	"\x17APPROVAL_STATUS_EXPIRED\x10\x042\xc6\x02\n" +
+	"\x0fApprovalService\x12k\n" +
+	"\x14ListApprovalRequests\x12(.approval.v1.ListApprovalRequestsRequest\x1a).approval.v1.ListApprovalRequestsResponse\x12V\n" +
+	"\rApproveAction\x12!.approval.v1.ApproveActionRequest\x1a\".approval.v1.ApproveActionResponse\x12n\n" +
+	"\x15CancelApprovalRequest\x12).approval.v1.CancelApprovalRequestRequest\x1a*.approval.v1.CancelApprovalRequestResponseB?Z=github.com/team-loco/loco/shared/proto/approval/v1;approvalv1b\x06proto3"
+
+var (
+	file_approval_v1_approval_proto_rawDescOnce sync.Once
+	file_approval_v1_approval_proto_rawDescData []byte
+)
+
+func file_approval_v1_approval_proto_rawDescGZIP() []byte {
+	file_approval_v1_approval_proto_rawDescOnce.Do(func() {
+		file_approval_v1_approval_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_approval_v1_approval_proto_rawDesc), len(file_approval_v1_approval_proto_rawDesc)))
+	})
+	return file_approval_v1_approval_proto_rawDescData
+}
+
+var file_approval_v1_approval_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_approval_v1_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_approval_v1_approval_proto_goTypes = []any{
+	(ApprovalAction)(0),                   // 0: approval.v1.ApprovalAction
+	(ApprovalStatus)(0),                   // 1: approval.v1.ApprovalStatus
+	(*ApprovalRequest)(nil),               // 2: approval.v1.ApprovalRequest
+	(*ListApprovalRequestsRequest)(nil),   // 3: approval.v1.ListApprovalRequestsRequest
+	(*ListApprovalRequestsResponse)(nil),  // 4: approval.v1.ListApprovalRequestsResponse
+	(*ApproveActionRequest)(nil),          // 5: approval.v1.ApproveActionRequest
+	(*ApproveActionResponse)(nil),         // 6: approval.v1.ApproveActionResponse
+	(*CancelApprovalRequestRequest)(nil),  // 7: approval.v1.CancelApprovalRequestRequest
+	(*CancelApprovalRequestResponse)(nil), // 8: approval.v1.CancelApprovalRequestResponse
+	(v1.EntityType)(0),                    // 9: token.v1.EntityType
+	(*timestamppb.Timestamp)(nil),         // 10: google.protobuf.Timestamp
+}
+var file_approval_v1_approval_proto_depIdxs = []int32{
+	0,  // 0: approval.v1.ApprovalRequest.action:type_name -> approval.v1.ApprovalAction
+	9,  // 1: approval.v1.ApprovalRequest.requested_by_type:type_name -> token.v1.EntityType
+	1,  // 2: approval.v1.ApprovalRequest.status:type_name -> approval.v1.ApprovalStatus
+	10, // 3: approval.v1.ApprovalRequest.expires_at:type_name -> google.protobuf.Timestamp
+	10, // 4: approval.v1.ApprovalRequest.created_at:type_name -> google.protobuf.Timestamp
+	10, // 5: approval.v1.ApprovalRequest.decided_at:type_name -> google.protobuf.Timestamp
+	2,  // 6: approval.v1.ListApprovalRequestsResponse.approval_requests:type_name -> approval.v1.ApprovalRequest
+	2,  // 7: approval.v1.ApproveActionResponse.approval_request:type_name -> approval.v1.ApprovalRequest
+	2,  // 8: approval.v1.CancelApprovalRequestResponse.approval_request:type_name -> approval.v1.ApprovalRequest
+	3,  // 9: approval.v1.ApprovalService.ListApprovalRequests:input_type -> approval.v1.ListApprovalRequestsRequest
+	5,  // 10: approval.v1.ApprovalService.ApproveAction:input_type -> approval.v1.ApproveActionRequest
+	7,  // 11: approval.v1.ApprovalService.CancelApprovalRequest:input_type -> approval.v1.CancelApprovalRequestRequest
+	4,  // 12: approval.v1.ApprovalService.ListApprovalRequests:output_type -> approval.v1.ListApprovalRequestsResponse
+	6,  // 13: approval.v1.ApprovalService.ApproveAction:output_type -> approval.v1.ApproveActionResponse
+	8,  // 14: approval.v1.ApprovalService.CancelApprovalRequest:output_type -> approval.v1.CancelApprovalRequestResponse
+	12, // [12:15] is the sub-list for method output_type
+	9,  // [9:12] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_approval_v1_approval_proto_init() }
+func file_approval_v1_approval_proto_init() {
+	if File_approval_v1_approval_proto != nil {
+		return
+	}
+	file_approval_v1_approval_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_approval_v1_approval_proto_rawDesc), len(file_approval_v1_approval_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_approval_v1_approval_proto_goTypes,
+		DependencyIndexes: file_approval_v1_approval_proto_depIdxs,
+		EnumInfos:         file_approval_v1_approval_proto_enumTypes,
+		MessageInfos:      file_approval_v1_approval_proto_msgTypes,
+	}.Build()
+	File_approval_v1_approval_proto = out.File
+	file_approval_v1_approval_proto_goTypes = nil
+	file_approval_v1_approval_proto_depIdxs = nil
+}
@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: approval/v1/approval.proto
+
+package approvalv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/team-loco/loco/shared/proto/approval/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ApprovalServiceName is the fully-qualified name of the ApprovalService service.
+	ApprovalServiceName = "approval.v1.ApprovalService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ApprovalServiceListApprovalRequestsProcedure is the fully-qualified name of the ApprovalService's
+	// ListApprovalRequests RPC.
+	ApprovalServiceListApprovalRequestsProcedure = "/approval.v1.ApprovalService/ListApprovalRequests"
+	// ApprovalServiceApproveActionProcedure is the fully-qualified name of the ApprovalService's
+	// ApproveAction RPC.
+	ApprovalServiceApproveActionProcedure = "/approval.v1.ApprovalService/ApproveAction"
+	// ApprovalServiceCancelApprovalRequestProcedure is the fully-qualified name of the
+	// ApprovalService's CancelApprovalRequest RPC.
+	ApprovalServiceCancelApprovalRequestProcedure = "/approval.v1.ApprovalService/CancelApprovalRequest"
+)
+
+// ApprovalServiceClient is a client for the approval.v1.ApprovalService service.
+type ApprovalServiceClient interface {
+	// ListApprovalRequests lists the approval requests of a workspace, newest first.
+	ListApprovalRequests(context.Context, *connect.Request[v1.ListApprovalRequestsRequest]) (*connect.Response[v1.ListApprovalRequestsResponse], error)
+	// ApproveAction approves a pending request and carries out its operation. The approver must be a user other
+	// than the requester, with the permissions the operation itself needs.
+	ApproveAction(context.Context, *connect.Request[v1.ApproveActionRequest]) (*connect.Response[v1.ApproveActionResponse], error)
+	// CancelApprovalRequest withdraws a pending request. The requester or a workspace admin can cancel it.
+	CancelApprovalRequest(context.Context, *connect.Request[v1.CancelApprovalRequestRequest]) (*connect.Response[v1.CancelApprovalRequestResponse], error)
+}
+
+// NewApprovalServiceClient constructs a client for the approval.v1.ApprovalService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewApprovalServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ApprovalServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	approvalServiceMethods := v1.File_approval_v1_approval_proto.Services().ByName("ApprovalService").Methods()
+	return &approvalServiceClient{
+		listApprovalRequests: connect.NewClient[v1.ListApprovalRequestsRequest, v1.ListApprovalRequestsResponse](
+			httpClient,
+			baseURL+ApprovalServiceListApprovalRequestsProcedure,
+			connect.WithSchema(approvalServiceMethods.ByName("ListApprovalRequests")),
+			connect.WithClientOptions(opts...),
+		),
+		approveAction: connect.NewClient[v1.ApproveActionRequest, v1.ApproveActionResponse](
+			httpClient,
+			baseURL+ApprovalServiceApproveActionProcedure,
+			connect.WithSchema(approvalServiceMethods.ByName("ApproveAction")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelApprovalRequest: connect.NewClient[v1.CancelApprovalRequestRequest, v1.CancelApprovalRequestResponse](
+			httpClient,
+			baseURL+ApprovalServiceCancelApprovalRequestProcedure,
+			connect.WithSchema(approvalServiceMethods.ByName("CancelApprovalRequest")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// approvalServiceClient implements ApprovalServiceClient.
+type approvalServiceClient struct {
+	listApprovalRequests  *connect.Client[v1.ListApprovalRequestsRequest, v1.ListApprovalRequestsResponse]
+	approveAction         *connect.Client[v1.ApproveActionRequest, v1.ApproveActionResponse]
+	cancelApprovalRequest *connect.Client[v1.CancelApprovalRequestRequest, v1.CancelApprovalRequestResponse]
+}
+
+// ListApprovalRequests calls approval.v1.ApprovalService.ListApprovalRequests.
+func (c *approvalServiceClient) ListApprovalRequests(ctx context.Context, req *connect.Request[v1.ListApprovalRequestsRequest]) (*connect.Response[v1.ListApprovalRequestsResponse], error) {
+	return c.listApprovalRequests.CallUnary(ctx, req)
+}
+
+// ApproveAction calls approval.v1.ApprovalService.ApproveAction.
+func (c *approvalServiceClient) ApproveAction(ctx context.Context, req *connect.Request[v1.ApproveActionRequest]) (*connect.Response[v1.ApproveActionResponse], error) {
+	return c.approveAction.CallUnary(ctx, req)
+}
+
+// CancelApprovalRequest calls approval.v1.ApprovalService.CancelApprovalRequest.
+func (c *approvalServiceClient) CancelApprovalRequest(ctx context.Context, req *connect.Request[v1.CancelApprovalRequestRequest]) (*connect.Response[v1.CancelApprovalRequestResponse], error) {
+	return c.cancelApprovalRequest.CallUnary(ctx, req)
+}
+
+// ApprovalServiceHandler is an implementation of the approval.v1.ApprovalService service.
+type ApprovalServiceHandler interface {
+	// ListApprovalRequests lists the approval requests of a workspace, newest first.
+	ListApprovalRequests(context.Context, *connect.Request[v1.ListApprovalRequestsRequest]) (*connect.Response[v1.ListApprovalRequestsResponse], error)
+	// ApproveAction approves a pending request and carries out its operation. The approver must be a user other
+	// than the requester, with the permissions the operation itself needs.
+	ApproveAction(context.Context, *connect.Request[v1.ApproveActionRequest]) (*connect.Response[v1.ApproveActionResponse], error)
+	// CancelApprovalRequest withdraws a pending request. The requester or a workspace admin can cancel it.
+	CancelApprovalRequest(context.Context, *connect.Request[v1.CancelApprovalRequestRequest]) (*connect.Response[v1.CancelApprovalRequestResponse], error)
+}
+
+// NewApprovalServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewApprovalServiceHandler(svc ApprovalServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	approvalServiceMethods := v1.File_approval_v1_approval_proto.Services().ByName("ApprovalService").Methods()
+	approvalServiceListApprovalRequestsHandler := connect.NewUnaryHandler(
+		ApprovalServiceListApprovalRequestsProcedure,
+		svc.ListApprovalRequests,
+		connect.WithSchema(approvalServiceMethods.ByName("ListApprovalRequests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	approvalServiceApproveActionHandler := connect.NewUnaryHandler(
+		ApprovalServiceApproveActionProcedure,
+		svc.ApproveAction,
+		connect.WithSchema(approvalServiceMethods.ByName("ApproveAction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	approvalServiceCancelApprovalRequestHandler := connect.NewUnaryHandler(
+		ApprovalServiceCancelApprovalRequestProcedure,
+		svc.CancelApprovalRequest,
+		connect.WithSchema(approvalServiceMethods.ByName("CancelApprovalRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/approval.v1.ApprovalService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ApprovalServiceListApprovalRequestsProcedure:
+			approvalServiceListApprovalRequestsHandler.ServeHTTP(w, r)
+		case ApprovalServiceApproveActionProcedure:
+			approvalServiceApproveActionHandler.ServeHTTP(w, r)
+		case ApprovalServiceCancelApprovalRequestProcedure:
+			approvalServiceCancelApprovalRequestHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedApprovalServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedApprovalServiceHandler struct{}
+
+func (UnimplementedApprovalServiceHandler) ListApprovalRequests(context.Context, *connect.Request[v1.ListApprovalRequestsRequest]) (*connect.Response[v1.ListApprovalRequestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("approval.v1.ApprovalService.ListApprovalRequests is not implemented"))
+}
+
+func (UnimplementedApprovalServiceHandler) ApproveAction(context.Context, *connect.Request[v1.ApproveActionRequest]) (*connect.Response[v1.ApproveActionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("approval.v1.ApprovalService.ApproveAction is not implemented"))
+}
+
+func (UnimplementedApprovalServiceHandler) CancelApprovalRequest(context.Context, *connect.Request[v1.CancelApprovalRequestRequest]) (*connect.Response[v1.CancelApprovalRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("approval.v1.ApprovalService.CancelApprovalRequest is not implemented"))
+}
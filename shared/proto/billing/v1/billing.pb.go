@@ -0,0 +1,1194 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: billing/v1/billing.proto
+
+package billingv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SubscriptionStatus is where an org's subscription stands with Stripe.
+type SubscriptionStatus int32
+
+const (
+	SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED SubscriptionStatus = 0
+	SubscriptionStatus_SUBSCRIPTION_STATUS_NONE        SubscriptionStatus = 1 // the org has no subscription
+	SubscriptionStatus_SUBSCRIPTION_STATUS_INCOMPLETE  SubscriptionStatus = 2 // waiting for the first invoice to be paid
+	SubscriptionStatus_SUBSCRIPTION_STATUS_TRIALING    SubscriptionStatus = 3
+	SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE      SubscriptionStatus = 4
+	SubscriptionStatus_SUBSCRIPTION_STATUS_PAST_DUE    SubscriptionStatus = 5 // an invoice failed to be paid and is being retried
+	SubscriptionStatus_SUBSCRIPTION_STATUS_UNPAID      SubscriptionStatus = 6 // retries ran out
+	SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELED    SubscriptionStatus = 7
+)
+
+// Enum value maps for SubscriptionStatus.
+var (
+	SubscriptionStatus_name = map[int32]string{
+		0: "SUBSCRIPTION_STATUS_UNSPECIFIED",
+		1: "SUBSCRIPTION_STATUS_NONE",
+		2: "SUBSCRIPTION_STATUS_INCOMPLETE",
+		3: "SUBSCRIPTION_STATUS_TRIALING",
+		4: "SUBSCRIPTION_STATUS_ACTIVE",
+		5: "SUBSCRIPTION_STATUS_PAST_DUE",
+		6: "SUBSCRIPTION_STATUS_UNPAID",
+		7: "SUBSCRIPTION_STATUS_CANCELED",
+	}
+	SubscriptionStatus_value = map[string]int32{
+		"SUBSCRIPTION_STATUS_UNSPECIFIED": 0,
+		"SUBSCRIPTION_STATUS_NONE":        1,
+		"SUBSCRIPTION_STATUS_INCOMPLETE":  2,
+		"SUBSCRIPTION_STATUS_TRIALING":    3,
+		"SUBSCRIPTION_STATUS_ACTIVE":      4,
+		"SUBSCRIPTION_STATUS_PAST_DUE":    5,
+		"SUBSCRIPTION_STATUS_UNPAID":      6,
+		"SUBSCRIPTION_STATUS_CANCELED":    7,
+	}
+)
+
+func (x SubscriptionStatus) Enum() *SubscriptionStatus {
+	p := new(SubscriptionStatus)
+	*p = x
+	return p
+}
+
+func (x SubscriptionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubscriptionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_billing_v1_billing_proto_enumTypes[0].Descriptor()
+}
+
+func (SubscriptionStatus) Type() protoreflect.EnumType {
+	return &file_billing_v1_billing_proto_enumTypes[0]
+}
+
+func (x SubscriptionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubscriptionStatus.Descriptor instead.
+func (SubscriptionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{0}
+}
+
+// Plan is a plan orgs can subscribe to. Usage is charged on top of the monthly price. Services of
+// orgs without a plan sleep when idle.
+type Plan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	MonthlyCents  int64                  `protobuf:"varint,4,opt,name=monthly_cents,json=monthlyCents,proto3" json:"monthly_cents,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	IdleSleep     bool                   `protobuf:"varint,6,opt,name=idle_sleep,json=idleSleep,proto3" json:"idle_sleep,omitempty"` // services sleep after a period without traffic, as on the free tier
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Plan) Reset() {
+	*x = Plan{}
+	mi := &file_billing_v1_billing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan) ProtoMessage() {}
+
+func (x *Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Plan) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Plan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Plan) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Plan) GetMonthlyCents() int64 {
+	if x != nil {
+		return x.MonthlyCents
+	}
+	return 0
+}
+
+func (x *Plan) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Plan) GetIdleSleep() bool {
+	if x != nil {
+		return x.IdleSleep
+	}
+	return false
+}
+
+// PaymentMethod is a way an org pays, managed in the billing portal.
+type PaymentMethod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                          // e.g. card
+	Brand         string                 `protobuf:"bytes,3,opt,name=brand,proto3" json:"brand,omitempty"`                        // cards only, e.g. visa
+	Last4         string                 `protobuf:"bytes,4,opt,name=last4,proto3" json:"last4,omitempty"`                        // cards only
+	ExpMonth      int32                  `protobuf:"varint,5,opt,name=exp_month,json=expMonth,proto3" json:"exp_month,omitempty"` // cards only
+	ExpYear       int32                  `protobuf:"varint,6,opt,name=exp_year,json=expYear,proto3" json:"exp_year,omitempty"`    // cards only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentMethod) Reset() {
+	*x = PaymentMethod{}
+	mi := &file_billing_v1_billing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethod) ProtoMessage() {}
+
+func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethod.ProtoReflect.Descriptor instead.
+func (*PaymentMethod) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PaymentMethod) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetLast4() string {
+	if x != nil {
+		return x.Last4
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetExpMonth() int32 {
+	if x != nil {
+		return x.ExpMonth
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetExpYear() int32 {
+	if x != nil {
+		return x.ExpYear
+	}
+	return 0
+}
+
+// BillingAccount is an org's plan, subscription and payment methods.
+type BillingAccount struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrgId            int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Plan             *Plan                  `protobuf:"bytes,2,opt,name=plan,proto3,oneof" json:"plan,omitempty"` // unset without a subscription
+	Status           SubscriptionStatus     `protobuf:"varint,3,opt,name=status,proto3,enum=billing.v1.SubscriptionStatus" json:"status,omitempty"`
+	Delinquent       bool                   `protobuf:"varint,4,opt,name=delinquent,proto3" json:"delinquent,omitempty"` // an invoice is unpaid; new deployments are blocked
+	CurrentPeriodEnd *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=current_period_end,json=currentPeriodEnd,proto3,oneof" json:"current_period_end,omitempty"`
+	PaymentMethods   []*PaymentMethod       `protobuf:"bytes,6,rep,name=payment_methods,json=paymentMethods,proto3" json:"payment_methods,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BillingAccount) Reset() {
+	*x = BillingAccount{}
+	mi := &file_billing_v1_billing_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BillingAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillingAccount) ProtoMessage() {}
+
+func (x *BillingAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillingAccount.ProtoReflect.Descriptor instead.
+func (*BillingAccount) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BillingAccount) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *BillingAccount) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *BillingAccount) GetStatus() SubscriptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED
+}
+
+func (x *BillingAccount) GetDelinquent() bool {
+	if x != nil {
+		return x.Delinquent
+	}
+	return false
+}
+
+func (x *BillingAccount) GetCurrentPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CurrentPeriodEnd
+	}
+	return nil
+}
+
+func (x *BillingAccount) GetPaymentMethods() []*PaymentMethod {
+	if x != nil {
+		return x.PaymentMethods
+	}
+	return nil
+}
+
+// UsageItem is the usage of one resource in one region.
+type UsageItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    *int64                 `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"` // unset when the resource was deleted
+	ResourceName  string                 `protobuf:"bytes,2,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	ReplicaHours  int64                  `protobuf:"varint,5,opt,name=replica_hours,json=replicaHours,proto3" json:"replica_hours,omitempty"`
+	CostMicros    int64                  `protobuf:"varint,6,opt,name=cost_micros,json=costMicros,proto3" json:"cost_micros,omitempty"` // millionths of currency
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageItem) Reset() {
+	*x = UsageItem{}
+	mi := &file_billing_v1_billing_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageItem) ProtoMessage() {}
+
+func (x *UsageItem) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageItem.ProtoReflect.Descriptor instead.
+func (*UsageItem) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UsageItem) GetResourceId() int64 {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return 0
+}
+
+func (x *UsageItem) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *UsageItem) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *UsageItem) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UsageItem) GetReplicaHours() int64 {
+	if x != nil {
+		return x.ReplicaHours
+	}
+	return 0
+}
+
+func (x *UsageItem) GetCostMicros() int64 {
+	if x != nil {
+		return x.CostMicros
+	}
+	return 0
+}
+
+// ListPlansRequest is the request for the available plans.
+type ListPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlansRequest) Reset() {
+	*x = ListPlansRequest{}
+	mi := &file_billing_v1_billing_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlansRequest) ProtoMessage() {}
+
+func (x *ListPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListPlansRequest) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{4}
+}
+
+// ListPlansResponse contains the plans, cheapest first.
+type ListPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*Plan                `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlansResponse) Reset() {
+	*x = ListPlansResponse{}
+	mi := &file_billing_v1_billing_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlansResponse) ProtoMessage() {}
+
+func (x *ListPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListPlansResponse) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPlansResponse) GetPlans() []*Plan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// GetBillingAccountRequest is the request for an org's billing account.
+type GetBillingAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBillingAccountRequest) Reset() {
+	*x = GetBillingAccountRequest{}
+	mi := &file_billing_v1_billing_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBillingAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBillingAccountRequest) ProtoMessage() {}
+
+func (x *GetBillingAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBillingAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetBillingAccountRequest) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetBillingAccountRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+// GetBillingAccountResponse contains the org's billing account.
+type GetBillingAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *BillingAccount        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBillingAccountResponse) Reset() {
+	*x = GetBillingAccountResponse{}
+	mi := &file_billing_v1_billing_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBillingAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBillingAccountResponse) ProtoMessage() {}
+
+func (x *GetBillingAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBillingAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetBillingAccountResponse) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBillingAccountResponse) GetAccount() *BillingAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// SetPlanRequest is the request to subscribe an org to a plan.
+type SetPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	PlanId        int64                  `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPlanRequest) Reset() {
+	*x = SetPlanRequest{}
+	mi := &file_billing_v1_billing_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlanRequest) ProtoMessage() {}
+
+func (x *SetPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlanRequest.ProtoReflect.Descriptor instead.
+func (*SetPlanRequest) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetPlanRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *SetPlanRequest) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+// SetPlanResponse contains the billing account after the change. A new subscription is incomplete
+// until its first invoice is paid through the billing portal.
+type SetPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *BillingAccount        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPlanResponse) Reset() {
+	*x = SetPlanResponse{}
+	mi := &file_billing_v1_billing_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlanResponse) ProtoMessage() {}
+
+func (x *SetPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlanResponse.ProtoReflect.Descriptor instead.
+func (*SetPlanResponse) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetPlanResponse) GetAccount() *BillingAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// CancelSubscriptionRequest is the request to end an org's subscription.
+type CancelSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSubscriptionRequest) Reset() {
+	*x = CancelSubscriptionRequest{}
+	mi := &file_billing_v1_billing_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionRequest) ProtoMessage() {}
+
+func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelSubscriptionRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+// CancelSubscriptionResponse contains the billing account after the change.
+type CancelSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *BillingAccount        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSubscriptionResponse) Reset() {
+	*x = CancelSubscriptionResponse{}
+	mi := &file_billing_v1_billing_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionResponse) ProtoMessage() {}
+
+func (x *CancelSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelSubscriptionResponse) GetAccount() *BillingAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// CreateBillingPortalSessionRequest is the request for a billing portal link.
+type CreateBillingPortalSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	ReturnUrl     string                 `protobuf:"bytes,2,opt,name=return_url,json=returnUrl,proto3" json:"return_url,omitempty"` // where the portal links back to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBillingPortalSessionRequest) Reset() {
+	*x = CreateBillingPortalSessionRequest{}
+	mi := &file_billing_v1_billing_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBillingPortalSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBillingPortalSessionRequest) ProtoMessage() {}
+
+func (x *CreateBillingPortalSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBillingPortalSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateBillingPortalSessionRequest) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateBillingPortalSessionRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *CreateBillingPortalSessionRequest) GetReturnUrl() string {
+	if x != nil {
+		return x.ReturnUrl
+	}
+	return ""
+}
+
+// CreateBillingPortalSessionResponse contains the portal link, valid for a few minutes.
+type CreateBillingPortalSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBillingPortalSessionResponse) Reset() {
+	*x = CreateBillingPortalSessionResponse{}
+	mi := &file_billing_v1_billing_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBillingPortalSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBillingPortalSessionResponse) ProtoMessage() {}
+
+func (x *CreateBillingPortalSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBillingPortalSessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateBillingPortalSessionResponse) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateBillingPortalSessionResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// ListUsageRequest is the request for an org's usage over a time range.
+type ListUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3,oneof" json:"since,omitempty"` // defaults to the start of the current month
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3,oneof" json:"until,omitempty"` // defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageRequest) Reset() {
+	*x = ListUsageRequest{}
+	mi := &file_billing_v1_billing_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageRequest) ProtoMessage() {}
+
+func (x *ListUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageRequest.ProtoReflect.Descriptor instead.
+func (*ListUsageRequest) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListUsageRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *ListUsageRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListUsageRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+// ListUsageResponse contains the usage, most expensive first.
+type ListUsageResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Items           []*UsageItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Currency        string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`                                         // of the most expensive item
+	TotalCostMicros int64                  `protobuf:"varint,3,opt,name=total_cost_micros,json=totalCostMicros,proto3" json:"total_cost_micros,omitempty"` // of the items in currency
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListUsageResponse) Reset() {
+	*x = ListUsageResponse{}
+	mi := &file_billing_v1_billing_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageResponse) ProtoMessage() {}
+
+func (x *ListUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_v1_billing_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageResponse.ProtoReflect.Descriptor instead.
+func (*ListUsageResponse) Descriptor() ([]byte, []int) {
+	return file_billing_v1_billing_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListUsageResponse) GetItems() []*UsageItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListUsageResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListUsageResponse) GetTotalCostMicros() int64 {
+	if x != nil {
+		return x.TotalCostMicros
+	}
+	return 0
+}
+
+var File_billing_v1_billing_proto protoreflect.FileDescriptor
+
+const file_billing_v1_billing_proto_rawDesc = "" +
+	"\n" +
+	"\x18billing/v1/billing.proto\x12\n" +
+	"billing.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xac\x01\n" +
+	"\x04Plan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rmonthly_cents\x18\x04 \x01(\x03R\fmonthlyCents\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"idle_sleep\x18\x06 \x01(\bR\tidleSleep\"\x97\x01\n" +
+	"\rPaymentMethod\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05brand\x18\x03 \x01(\tR\x05brand\x12\x14\n" +
+	"\x05last4\x18\x04 \x01(\tR\x05last4\x12\x1b\n" +
+	"\texp_month\x18\x05 \x01(\x05R\bexpMonth\x12\x19\n" +
+	"\bexp_year\x18\x06 \x01(\x05R\aexpYear\"\xdd\x02\n" +
+	"\x0eBillingAccount\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12)\n" +
+	"\x04plan\x18\x02 \x01(\v2\x10.billing.v1.PlanH\x00R\x04plan\x88\x01\x01\x126\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1e.billing.v1.SubscriptionStatusR\x06status\x12\x1e\n" +
+	"\n" +
+	"delinquent\x18\x04 \x01(\bR\n" +
+	"delinquent\x12M\n" +
+	"\x12current_period_end\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x10currentPeriodEnd\x88\x01\x01\x12B\n" +
+	"\x0fpayment_methods\x18\x06 \x03(\v2\x19.billing.v1.PaymentMethodR\x0epaymentMethodsB\a\n" +
+	"\x05_planB\x15\n" +
+	"\x13_current_period_end\"\xe0\x01\n" +
+	"\tUsageItem\x12$\n" +
+	"\vresource_id\x18\x01 \x01(\x03H\x00R\n" +
+	"resourceId\x88\x01\x01\x12#\n" +
+	"\rresource_name\x18\x02 \x01(\tR\fresourceName\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12#\n" +
+	"\rreplica_hours\x18\x05 \x01(\x03R\freplicaHours\x12\x1f\n" +
+	"\vcost_micros\x18\x06 \x01(\x03R\n" +
+	"costMicrosB\x0e\n" +
+	"\f_resource_id\"\x12\n" +
+	"\x10ListPlansRequest\";\n" +
+	"\x11ListPlansResponse\x12&\n" +
+	"\x05plans\x18\x01 \x03(\v2\x10.billing.v1.PlanR\x05plans\"1\n" +
+	"\x18GetBillingAccountRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\"Q\n" +
+	"\x19GetBillingAccountResponse\x124\n" +
+	"\aaccount\x18\x01 \x01(\v2\x1a.billing.v1.BillingAccountR\aaccount\"@\n" +
+	"\x0eSetPlanRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x17\n" +
+	"\aplan_id\x18\x02 \x01(\x03R\x06planId\"G\n" +
+	"\x0fSetPlanResponse\x124\n" +
+	"\aaccount\x18\x01 \x01(\v2\x1a.billing.v1.BillingAccountR\aaccount\"2\n" +
+	"\x19CancelSubscriptionRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\"R\n" +
+	"\x1aCancelSubscriptionResponse\x124\n" +
+	"\aaccount\x18\x01 \x01(\v2\x1a.billing.v1.BillingAccountR\aaccount\"Y\n" +
+	"!CreateBillingPortalSessionRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x1d\n" +
+	"\n" +
+	"return_url\x18\x02 \x01(\tR\treturnUrl\"6\n" +
+	"\"CreateBillingPortalSessionResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xab\x01\n" +
+	"\x10ListUsageRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x125\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x05since\x88\x01\x01\x125\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x05until\x88\x01\x01B\b\n" +
+	"\x06_sinceB\b\n" +
+	"\x06_until\"\x88\x01\n" +
+	"\x11ListUsageResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.billing.v1.UsageItemR\x05items\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12*\n" +
+	"\x11total_cost_micros\x18\x03 \x01(\x03R\x0ftotalCostMicros*\xa1\x02\n" +
+	"\x12SubscriptionStatus\x12#\n" +
+	"\x1fSUBSCRIPTION_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18SUBSCRIPTION_STATUS_NONE\x10\x01\x12\"\n" +
+	"\x1eSUBSCRIPTION_STATUS_INCOMPLETE\x10\x02\x12 \n" +
+	"\x1cSUBSCRIPTION_STATUS_TRIALING\x10\x03\x12\x1e\n" +
+	"\x1aSUBSCRIPTION_STATUS_ACTIVE\x10\x04\x12 \n" +
+	"\x1cSUBSCRIPTION_STATUS_PAST_DUE\x10\x05\x12\x1e\n" +
+	"\x1aSUBSCRIPTION_STATUS_UNPAID\x10\x06\x12 \n" +
+	"\x1cSUBSCRIPTION_STATUS_CANCELED\x10\a2\xac\x04\n" +
+	"\x0eBillingService\x12H\n" +
+	"\tListPlans\x12\x1c.billing.v1.ListPlansRequest\x1a\x1d.billing.v1.ListPlansResponse\x12`\n" +
+	"\x11GetBillingAccount\x12$.billing.v1.GetBillingAccountRequest\x1a%.billing.v1.GetBillingAccountResponse\x12B\n" +
+	"\aSetPlan\x12\x1a.billing.v1.SetPlanRequest\x1a\x1b.billing.v1.SetPlanResponse\x12c\n" +
+	"\x12CancelSubscription\x12%.billing.v1.CancelSubscriptionRequest\x1a&.billing.v1.CancelSubscriptionResponse\x12{\n" +
+	"\x1aCreateBillingPortalSession\x12-.billing.v1.CreateBillingPortalSessionRequest\x1a..billing.v1.CreateBillingPortalSessionResponse\x12H\n" +
+	"\tListUsage\x12\x1c.billing.v1.ListUsageRequest\x1a\x1d.billing.v1.ListUsageResponseB=Z;github.com/team-loco/loco/shared/proto/billing/v1;billingv1b\x06proto3"
+
+var (
+	file_billing_v1_billing_proto_rawDescOnce sync.Once
+	file_billing_v1_billing_proto_rawDescData []byte
+)
+
+func file_billing_v1_billing_proto_rawDescGZIP() []byte {
+	file_billing_v1_billing_proto_rawDescOnce.Do(func() {
+		file_billing_v1_billing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_billing_v1_billing_proto_rawDesc), len(file_billing_v1_billing_proto_rawDesc)))
+	})
+	return file_billing_v1_billing_proto_rawDescData
+}
+
+var file_billing_v1_billing_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_billing_v1_billing_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_billing_v1_billing_proto_goTypes = []any{
+	(SubscriptionStatus)(0),                    // 0: billing.v1.SubscriptionStatus
+	(*Plan)(nil),                               // 1: billing.v1.Plan
+	(*PaymentMethod)(nil),                      // 2: billing.v1.PaymentMethod
+	(*BillingAccount)(nil),                     // 3: billing.v1.BillingAccount
+	(*UsageItem)(nil),                          // 4: billing.v1.UsageItem
+	(*ListPlansRequest)(nil),                   // 5: billing.v1.ListPlansRequest
+	(*ListPlansResponse)(nil),                  // 6: billing.v1.ListPlansResponse
+	(*GetBillingAccountRequest)(nil),           // 7: billing.v1.GetBillingAccountRequest
+	(*GetBillingAccountResponse)(nil),          // 8: billing.v1.GetBillingAccountResponse
+	(*SetPlanRequest)(nil),                     // 9: billing.v1.SetPlanRequest
+	(*SetPlanResponse)(nil),                    // 10: billing.v1.SetPlanResponse
+	(*CancelSubscriptionRequest)(nil),          // 11: billing.v1.CancelSubscriptionRequest
+	(*CancelSubscriptionResponse)(nil),         // 12: billing.v1.CancelSubscriptionResponse
+	(*CreateBillingPortalSessionRequest)(nil),  // 13: billing.v1.CreateBillingPortalSessionRequest
+	(*CreateBillingPortalSessionResponse)(nil), // 14: billing.v1.CreateBillingPortalSessionResponse
+	(*ListUsageRequest)(nil),                   // 15: billing.v1.ListUsageRequest
+	(*ListUsageResponse)(nil),                  // 16: billing.v1.ListUsageResponse
+	(*timestamppb.Timestamp)(nil),              // 17: google.protobuf.Timestamp
+}
+var file_billing_v1_billing_proto_depIdxs = []int32{
+	1,  // 0: billing.v1.BillingAccount.plan:type_name -> billing.v1.Plan
+	0,  // 1: billing.v1.BillingAccount.status:type_name -> billing.v1.SubscriptionStatus
+	17, // 2: billing.v1.BillingAccount.current_period_end:type_name -> google.protobuf.Timestamp
+	2,  // 3: billing.v1.BillingAccount.payment_methods:type_name -> billing.v1.PaymentMethod
+	1,  // 4: billing.v1.ListPlansResponse.plans:type_name -> billing.v1.Plan
+	3,  // 5: billing.v1.GetBillingAccountResponse.account:type_name -> billing.v1.BillingAccount
+	3,  // 6: billing.v1.SetPlanResponse.account:type_name -> billing.v1.BillingAccount
+	3,  // 7: billing.v1.CancelSubscriptionResponse.account:type_name -> billing.v1.BillingAccount
+	17, // 8: billing.v1.ListUsageRequest.since:type_name -> google.protobuf.Timestamp
+	17, // 9: billing.v1.ListUsageRequest.until:type_name -> google.protobuf.Timestamp
+	4,  // 10: billing.v1.ListUsageResponse.items:type_name -> billing.v1.UsageItem
+	5,  // 11: billing.v1.BillingService.ListPlans:input_type -> billing.v1.ListPlansRequest
+	7,  // 12: billing.v1.BillingService.GetBillingAccount:input_type -> billing.v1.GetBillingAccountRequest
+	9,  // 13: billing.v1.BillingService.SetPlan:input_type -> billing.v1.SetPlanRequest
+	11, // 14: billing.v1.BillingService.CancelSubscription:input_type -> billing.v1.CancelSubscriptionRequest
+	13, // 15: billing.v1.BillingService.CreateBillingPortalSession:input_type -> billing.v1.CreateBillingPortalSessionRequest
+	15, // 16: billing.v1.BillingService.ListUsage:input_type -> billing.v1.ListUsageRequest
+	6,  // 17: billing.v1.BillingService.ListPlans:output_type -> billing.v1.ListPlansResponse
+	8,  // 18: billing.v1.BillingService.GetBillingAccount:output_type -> billing.v1.GetBillingAccountResponse
+	10, // 19: billing.v1.BillingService.SetPlan:output_type -> billing.v1.SetPlanResponse
+	12, // 20: billing.v1.BillingService.CancelSubscription:output_type -> billing.v1.CancelSubscriptionResponse
+	14, // 21: billing.v1.BillingService.CreateBillingPortalSession:output_type -> billing.v1.CreateBillingPortalSessionResponse
+	16, // 22: billing.v1.BillingService.ListUsage:output_type -> billing.v1.ListUsageResponse
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_billing_v1_billing_proto_init() }
+func file_billing_v1_billing_proto_init() {
+	if File_billing_v1_billing_proto != nil {
+		return
+	}
+	file_billing_v1_billing_proto_msgTypes[2].OneofWrappers = []any{}
+	file_billing_v1_billing_proto_msgTypes[3].OneofWrappers = []any{}
+	file_billing_v1_billing_proto_msgTypes[14].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_billing_v1_billing_proto_rawDesc), len(file_billing_v1_billing_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_billing_v1_billing_proto_goTypes,
+		DependencyIndexes: file_billing_v1_billing_proto_depIdxs,
+		EnumInfos:         file_billing_v1_billing_proto_enumTypes,
+		MessageInfos:      file_billing_v1_billing_proto_msgTypes,
+	}.Build()
+	File_billing_v1_billing_proto = out.File
+	file_billing_v1_billing_proto_goTypes = nil
+	file_billing_v1_billing_proto_depIdxs = nil
+}
@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: billing/v1/billing.proto
+
+package billingv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/team-loco/loco/shared/proto/billing/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// BillingServiceName is the fully-qualified name of the BillingService service.
+	BillingServiceName = "billing.v1.BillingService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BillingServiceListPlansProcedure is the fully-qualified name of the BillingService's ListPlans
+	// RPC.
+	BillingServiceListPlansProcedure = "/billing.v1.BillingService/ListPlans"
+	// BillingServiceGetBillingAccountProcedure is the fully-qualified name of the BillingService's
+	// GetBillingAccount RPC.
+	BillingServiceGetBillingAccountProcedure = "/billing.v1.BillingService/GetBillingAccount"
+	// BillingServiceSetPlanProcedure is the fully-qualified name of the BillingService's SetPlan RPC.
+	BillingServiceSetPlanProcedure = "/billing.v1.BillingService/SetPlan"
+	// BillingServiceCancelSubscriptionProcedure is the fully-qualified name of the BillingService's
+	// CancelSubscription RPC.
+	BillingServiceCancelSubscriptionProcedure = "/billing.v1.BillingService/CancelSubscription"
+	// BillingServiceCreateBillingPortalSessionProcedure is the fully-qualified name of the
+	// BillingService's CreateBillingPortalSession RPC.
+	BillingServiceCreateBillingPortalSessionProcedure = "/billing.v1.BillingService/CreateBillingPortalSession"
+	// BillingServiceListUsageProcedure is the fully-qualified name of the BillingService's ListUsage
+	// RPC.
+	BillingServiceListUsageProcedure = "/billing.v1.BillingService/ListUsage"
+)
+
+// BillingServiceClient is a client for the billing.v1.BillingService service.
+type BillingServiceClient interface {
+	// ListPlans returns the plans orgs can subscribe to.
+	ListPlans(context.Context, *connect.Request[v1.ListPlansRequest]) (*connect.Response[v1.ListPlansResponse], error)
+	// GetBillingAccount returns an org's subscription and payment methods.
+	GetBillingAccount(context.Context, *connect.Request[v1.GetBillingAccountRequest]) (*connect.Response[v1.GetBillingAccountResponse], error)
+	// SetPlan subscribes the org to a plan, or moves its subscription to it. Requires admin on the org.
+	SetPlan(context.Context, *connect.Request[v1.SetPlanRequest]) (*connect.Response[v1.SetPlanResponse], error)
+	// CancelSubscription ends the org's subscription now, invoicing usage so far. Requires admin on the org.
+	CancelSubscription(context.Context, *connect.Request[v1.CancelSubscriptionRequest]) (*connect.Response[v1.CancelSubscriptionResponse], error)
+	// CreateBillingPortalSession returns a link to Stripe's billing portal, where payment methods are
+	// added and removed and open invoices paid. Requires admin on the org.
+	CreateBillingPortalSession(context.Context, *connect.Request[v1.CreateBillingPortalSessionRequest]) (*connect.Response[v1.CreateBillingPortalSessionResponse], error)
+	// ListUsage returns an org's metered usage per resource and region.
+	ListUsage(context.Context, *connect.Request[v1.ListUsageRequest]) (*connect.Response[v1.ListUsageResponse], error)
+}
+
+// NewBillingServiceClient constructs a client for the billing.v1.BillingService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBillingServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BillingServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	billingServiceMethods := v1.File_billing_v1_billing_proto.Services().ByName("BillingService").Methods()
+	return &billingServiceClient{
+		listPlans: connect.NewClient[v1.ListPlansRequest, v1.ListPlansResponse](
+			httpClient,
+			baseURL+BillingServiceListPlansProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("ListPlans")),
+			connect.WithClientOptions(opts...),
+		),
+		getBillingAccount: connect.NewClient[v1.GetBillingAccountRequest, v1.GetBillingAccountResponse](
+			httpClient,
+			baseURL+BillingServiceGetBillingAccountProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("GetBillingAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		setPlan: connect.NewClient[v1.SetPlanRequest, v1.SetPlanResponse](
+			httpClient,
+			baseURL+BillingServiceSetPlanProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("SetPlan")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelSubscription: connect.NewClient[v1.CancelSubscriptionRequest, v1.CancelSubscriptionResponse](
+			httpClient,
+			baseURL+BillingServiceCancelSubscriptionProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("CancelSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		createBillingPortalSession: connect.NewClient[v1.CreateBillingPortalSessionRequest, v1.CreateBillingPortalSessionResponse](
+			httpClient,
+			baseURL+BillingServiceCreateBillingPortalSessionProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("CreateBillingPortalSession")),
+			connect.WithClientOptions(opts...),
+		),
+		listUsage: connect.NewClient[v1.ListUsageRequest, v1.ListUsageResponse](
+			httpClient,
+			baseURL+BillingServiceListUsageProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("ListUsage")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// billingServiceClient implements BillingServiceClient.
+type billingServiceClient struct {
+	listPlans                  *connect.Client[v1.ListPlansRequest, v1.ListPlansResponse]
+	getBillingAccount          *connect.Client[v1.GetBillingAccountRequest, v1.GetBillingAccountResponse]
+	setPlan                    *connect.Client[v1.SetPlanRequest, v1.SetPlanResponse]
+	cancelSubscription         *connect.Client[v1.CancelSubscriptionRequest, v1.CancelSubscriptionResponse]
+	createBillingPortalSession *connect.Client[v1.CreateBillingPortalSessionRequest, v1.CreateBillingPortalSessionResponse]
+	listUsage                  *connect.Client[v1.ListUsageRequest, v1.ListUsageResponse]
+}
+
+// ListPlans calls billing.v1.BillingService.ListPlans.
+func (c *billingServiceClient) ListPlans(ctx context.Context, req *connect.Request[v1.ListPlansRequest]) (*connect.Response[v1.ListPlansResponse], error) {
+	return c.listPlans.CallUnary(ctx, req)
+}
+
+// GetBillingAccount calls billing.v1.BillingService.GetBillingAccount.
+func (c *billingServiceClient) GetBillingAccount(ctx context.Context, req *connect.Request[v1.GetBillingAccountRequest]) (*connect.Response[v1.GetBillingAccountResponse], error) {
+	return c.getBillingAccount.CallUnary(ctx, req)
+}
+
+// SetPlan calls billing.v1.BillingService.SetPlan.
+func (c *billingServiceClient) SetPlan(ctx context.Context, req *connect.Request[v1.SetPlanRequest]) (*connect.Response[v1.SetPlanResponse], error) {
+	return c.setPlan.CallUnary(ctx, req)
+}
+
+// CancelSubscription calls billing.v1.BillingService.CancelSubscription.
+func (c *billingServiceClient) CancelSubscription(ctx context.Context, req *connect.Request[v1.CancelSubscriptionRequest]) (*connect.Response[v1.CancelSubscriptionResponse], error) {
+	return c.cancelSubscription.CallUnary(ctx, req)
+}
+
+// CreateBillingPortalSession calls billing.v1.BillingService.CreateBillingPortalSession.
+func (c *billingServiceClient) CreateBillingPortalSession(ctx context.Context, req *connect.Request[v1.CreateBillingPortalSessionRequest]) (*connect.Response[v1.CreateBillingPortalSessionResponse], error) {
+	return c.createBillingPortalSession.CallUnary(ctx, req)
+}
+
+// ListUsage calls billing.v1.BillingService.ListUsage.
+func (c *billingServiceClient) ListUsage(ctx context.Context, req *connect.Request[v1.ListUsageRequest]) (*connect.Response[v1.ListUsageResponse], error) {
+	return c.listUsage.CallUnary(ctx, req)
+}
+
+// BillingServiceHandler is an implementation of the billing.v1.BillingService service.
+type BillingServiceHandler interface {
+	// ListPlans returns the plans orgs can subscribe to.
+	ListPlans(context.Context, *connect.Request[v1.ListPlansRequest]) (*connect.Response[v1.ListPlansResponse], error)
+	// GetBillingAccount returns an org's subscription and payment methods.
+	GetBillingAccount(context.Context, *connect.Request[v1.GetBillingAccountRequest]) (*connect.Response[v1.GetBillingAccountResponse], error)
+	// SetPlan subscribes the org to a plan, or moves its subscription to it. Requires admin on the org.
+	SetPlan(context.Context, *connect.Request[v1.SetPlanRequest]) (*connect.Response[v1.SetPlanResponse], error)
+	// CancelSubscription ends the org's subscription now, invoicing usage so far. Requires admin on the org.
+	CancelSubscription(context.Context, *connect.Request[v1.CancelSubscriptionRequest]) (*connect.Response[v1.CancelSubscriptionResponse], error)
+	// CreateBillingPortalSession returns a link to Stripe's billing portal, where payment methods are
+	// added and removed and open invoices paid. Requires admin on the org.
+	CreateBillingPortalSession(context.Context, *connect.Request[v1.CreateBillingPortalSessionRequest]) (*connect.Response[v1.CreateBillingPortalSessionResponse], error)
+	// ListUsage returns an org's metered usage per resource and region.
+	ListUsage(context.Context, *connect.Request[v1.ListUsageRequest]) (*connect.Response[v1.ListUsageResponse], error)
+}
+
+// NewBillingServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBillingServiceHandler(svc BillingServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	billingServiceMethods := v1.File_billing_v1_billing_proto.Services().ByName("BillingService").Methods()
+	billingServiceListPlansHandler := connect.NewUnaryHandler(
+		BillingServiceListPlansProcedure,
+		svc.ListPlans,
+		connect.WithSchema(billingServiceMethods.ByName("ListPlans")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceGetBillingAccountHandler := connect.NewUnaryHandler(
+		BillingServiceGetBillingAccountProcedure,
+		svc.GetBillingAccount,
+		connect.WithSchema(billingServiceMethods.ByName("GetBillingAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceSetPlanHandler := connect.NewUnaryHandler(
+		BillingServiceSetPlanProcedure,
+		svc.SetPlan,
+		connect.WithSchema(billingServiceMethods.ByName("SetPlan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceCancelSubscriptionHandler := connect.NewUnaryHandler(
+		BillingServiceCancelSubscriptionProcedure,
+		svc.CancelSubscription,
+		connect.WithSchema(billingServiceMethods.ByName("CancelSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceCreateBillingPortalSessionHandler := connect.NewUnaryHandler(
+		BillingServiceCreateBillingPortalSessionProcedure,
+		svc.CreateBillingPortalSession,
+		connect.WithSchema(billingServiceMethods.ByName("CreateBillingPortalSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceListUsageHandler := connect.NewUnaryHandler(
+		BillingServiceListUsageProcedure,
+		svc.ListUsage,
+		connect.WithSchema(billingServiceMethods.ByName("ListUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/billing.v1.BillingService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BillingServiceListPlansProcedure:
+			billingServiceListPlansHandler.ServeHTTP(w, r)
+		case BillingServiceGetBillingAccountProcedure:
+			billingServiceGetBillingAccountHandler.ServeHTTP(w, r)
+		case BillingServiceSetPlanProcedure:
+			billingServiceSetPlanHandler.ServeHTTP(w, r)
+		case BillingServiceCancelSubscriptionProcedure:
+			billingServiceCancelSubscriptionHandler.ServeHTTP(w, r)
+		case BillingServiceCreateBillingPortalSessionProcedure:
+			billingServiceCreateBillingPortalSessionHandler.ServeHTTP(w, r)
+		case BillingServiceListUsageProcedure:
+			billingServiceListUsageHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBillingServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBillingServiceHandler struct{}
+
+func (UnimplementedBillingServiceHandler) ListPlans(context.Context, *connect.Request[v1.ListPlansRequest]) (*connect.Response[v1.ListPlansResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("billing.v1.BillingService.ListPlans is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) GetBillingAccount(context.Context, *connect.Request[v1.GetBillingAccountRequest]) (*connect.Response[v1.GetBillingAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("billing.v1.BillingService.GetBillingAccount is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) SetPlan(context.Context, *connect.Request[v1.SetPlanRequest]) (*connect.Response[v1.SetPlanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("billing.v1.BillingService.SetPlan is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) CancelSubscription(context.Context, *connect.Request[v1.CancelSubscriptionRequest]) (*connect.Response[v1.CancelSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("billing.v1.BillingService.CancelSubscription is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) CreateBillingPortalSession(context.Context, *connect.Request[v1.CreateBillingPortalSessionRequest]) (*connect.Response[v1.CreateBillingPortalSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("billing.v1.BillingService.CreateBillingPortalSession is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) ListUsage(context.Context, *connect.Request[v1.ListUsageRequest]) (*connect.Response[v1.ListUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("billing.v1.BillingService.ListUsage is not implemented"))
+}
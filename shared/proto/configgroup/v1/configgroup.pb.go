@@ -0,0 +1,1299 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: configgroup/v1/configgroup.proto
+
+package configgroupv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConfigRolloutStatus is the state of a config rollout.
+type ConfigRolloutStatus int32
+
+const (
+	ConfigRolloutStatus_CONFIG_ROLLOUT_STATUS_UNSPECIFIED ConfigRolloutStatus = 0
+	ConfigRolloutStatus_CONFIG_ROLLOUT_STATUS_PENDING     ConfigRolloutStatus = 1
+	ConfigRolloutStatus_CONFIG_ROLLOUT_STATUS_RUNNING     ConfigRolloutStatus = 2
+	ConfigRolloutStatus_CONFIG_ROLLOUT_STATUS_COMPLETED   ConfigRolloutStatus = 3
+	ConfigRolloutStatus_CONFIG_ROLLOUT_STATUS_FAILED      ConfigRolloutStatus = 4
+)
+
+// Enum value maps for ConfigRolloutStatus.
+var (
+	ConfigRolloutStatus_name = map[int32]string{
+		0: "CONFIG_ROLLOUT_STATUS_UNSPECIFIED",
+		1: "CONFIG_ROLLOUT_STATUS_PENDING",
+		2: "CONFIG_ROLLOUT_STATUS_RUNNING",
+		3: "CONFIG_ROLLOUT_STATUS_COMPLETED",
+		4: "CONFIG_ROLLOUT_STATUS_FAILED",
+	}
+	ConfigRolloutStatus_value = map[string]int32{
+		"CONFIG_ROLLOUT_STATUS_UNSPECIFIED": 0,
+		"CONFIG_ROLLOUT_STATUS_PENDING":     1,
+		"CONFIG_ROLLOUT_STATUS_RUNNING":     2,
+		"CONFIG_ROLLOUT_STATUS_COMPLETED":   3,
+		"CONFIG_ROLLOUT_STATUS_FAILED":      4,
+	}
+)
+
+func (x ConfigRolloutStatus) Enum() *ConfigRolloutStatus {
+	p := new(ConfigRolloutStatus)
+	*p = x
+	return p
+}
+
+func (x ConfigRolloutStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigRolloutStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_configgroup_v1_configgroup_proto_enumTypes[0].Descriptor()
+}
+
+func (ConfigRolloutStatus) Type() protoreflect.EnumType {
+	return &file_configgroup_v1_configgroup_proto_enumTypes[0]
+}
+
+func (x ConfigRolloutStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigRolloutStatus.Descriptor instead.
+func (ConfigRolloutStatus) EnumDescriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{0}
+}
+
+// ConfigGroup is a named bundle of env vars shared by the resources it is attached to. A resource's own
+// env vars win over its groups'; when a resource has several groups, the one attached last wins.
+type ConfigGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId   int64                  `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Env           map[string]string      `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ResourceIds   []int64                `protobuf:"varint,6,rep,packed,name=resource_ids,json=resourceIds,proto3" json:"resource_ids,omitempty"` // resources the group is attached to
+	CreatedBy     int64                  `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigGroup) Reset() {
+	*x = ConfigGroup{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigGroup) ProtoMessage() {}
+
+func (x *ConfigGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigGroup.ProtoReflect.Descriptor instead.
+func (*ConfigGroup) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfigGroup) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConfigGroup) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *ConfigGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigGroup) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ConfigGroup) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *ConfigGroup) GetResourceIds() []int64 {
+	if x != nil {
+		return x.ResourceIds
+	}
+	return nil
+}
+
+func (x *ConfigGroup) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *ConfigGroup) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ConfigGroup) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ConfigRollout redeploys the resources of a config group, one at a time, after its env vars changed.
+// It stops at the first resource whose deployment fails, leaving the rest on their current deployment.
+type ConfigRollout struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConfigGroupId      int64                  `protobuf:"varint,2,opt,name=config_group_id,json=configGroupId,proto3" json:"config_group_id,omitempty"`
+	Status             ConfigRolloutStatus    `protobuf:"varint,3,opt,name=status,proto3,enum=configgroup.v1.ConfigRolloutStatus" json:"status,omitempty"`
+	ResourceIds        []int64                `protobuf:"varint,4,rep,packed,name=resource_ids,json=resourceIds,proto3" json:"resource_ids,omitempty"` // in rollout order
+	CompletedResources int32                  `protobuf:"varint,5,opt,name=completed_resources,json=completedResources,proto3" json:"completed_resources,omitempty"`
+	CurrentResourceId  *int64                 `protobuf:"varint,6,opt,name=current_resource_id,json=currentResourceId,proto3,oneof" json:"current_resource_id,omitempty"` // set while a resource is being redeployed
+	Error              string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`                                                           // why the rollout failed
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ConfigRollout) Reset() {
+	*x = ConfigRollout{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigRollout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigRollout) ProtoMessage() {}
+
+func (x *ConfigRollout) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigRollout.ProtoReflect.Descriptor instead.
+func (*ConfigRollout) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfigRollout) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConfigRollout) GetConfigGroupId() int64 {
+	if x != nil {
+		return x.ConfigGroupId
+	}
+	return 0
+}
+
+func (x *ConfigRollout) GetStatus() ConfigRolloutStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ConfigRolloutStatus_CONFIG_ROLLOUT_STATUS_UNSPECIFIED
+}
+
+func (x *ConfigRollout) GetResourceIds() []int64 {
+	if x != nil {
+		return x.ResourceIds
+	}
+	return nil
+}
+
+func (x *ConfigRollout) GetCompletedResources() int32 {
+	if x != nil {
+		return x.CompletedResources
+	}
+	return 0
+}
+
+func (x *ConfigRollout) GetCurrentResourceId() int64 {
+	if x != nil && x.CurrentResourceId != nil {
+		return *x.CurrentResourceId
+	}
+	return 0
+}
+
+func (x *ConfigRollout) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ConfigRollout) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ConfigRollout) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ConfigRollout) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// CreateConfigGroupRequest is the request to create a config group.
+type CreateConfigGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Env           map[string]string      `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateConfigGroupRequest) Reset() {
+	*x = CreateConfigGroupRequest{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConfigGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConfigGroupRequest) ProtoMessage() {}
+
+func (x *CreateConfigGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConfigGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateConfigGroupRequest) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateConfigGroupRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *CreateConfigGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateConfigGroupRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateConfigGroupRequest) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+// CreateConfigGroupResponse is the response containing the created config group.
+type CreateConfigGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigGroup   *ConfigGroup           `protobuf:"bytes,1,opt,name=config_group,json=configGroup,proto3" json:"config_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateConfigGroupResponse) Reset() {
+	*x = CreateConfigGroupResponse{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConfigGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConfigGroupResponse) ProtoMessage() {}
+
+func (x *CreateConfigGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConfigGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateConfigGroupResponse) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateConfigGroupResponse) GetConfigGroup() *ConfigGroup {
+	if x != nil {
+		return x.ConfigGroup
+	}
+	return nil
+}
+
+// GetConfigGroupRequest is the request to retrieve a config group.
+type GetConfigGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigGroupId int64                  `protobuf:"varint,1,opt,name=config_group_id,json=configGroupId,proto3" json:"config_group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigGroupRequest) Reset() {
+	*x = GetConfigGroupRequest{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigGroupRequest) ProtoMessage() {}
+
+func (x *GetConfigGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigGroupRequest) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetConfigGroupRequest) GetConfigGroupId() int64 {
+	if x != nil {
+		return x.ConfigGroupId
+	}
+	return 0
+}
+
+// GetConfigGroupResponse is the response containing the config group.
+type GetConfigGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigGroup   *ConfigGroup           `protobuf:"bytes,1,opt,name=config_group,json=configGroup,proto3" json:"config_group,omitempty"`
+	Rollout       *ConfigRollout         `protobuf:"bytes,2,opt,name=rollout,proto3,oneof" json:"rollout,omitempty"` // set while a rollout is in progress
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigGroupResponse) Reset() {
+	*x = GetConfigGroupResponse{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigGroupResponse) ProtoMessage() {}
+
+func (x *GetConfigGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigGroupResponse) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetConfigGroupResponse) GetConfigGroup() *ConfigGroup {
+	if x != nil {
+		return x.ConfigGroup
+	}
+	return nil
+}
+
+func (x *GetConfigGroupResponse) GetRollout() *ConfigRollout {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+// ListConfigGroupsRequest is the request to list a workspace's config groups.
+type ListConfigGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigGroupsRequest) Reset() {
+	*x = ListConfigGroupsRequest{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigGroupsRequest) ProtoMessage() {}
+
+func (x *ListConfigGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListConfigGroupsRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+// ListConfigGroupsResponse is the response containing the config groups, by name.
+type ListConfigGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigGroups  []*ConfigGroup         `protobuf:"bytes,1,rep,name=config_groups,json=configGroups,proto3" json:"config_groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigGroupsResponse) Reset() {
+	*x = ListConfigGroupsResponse{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigGroupsResponse) ProtoMessage() {}
+
+func (x *ListConfigGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListConfigGroupsResponse) GetConfigGroups() []*ConfigGroup {
+	if x != nil {
+		return x.ConfigGroups
+	}
+	return nil
+}
+
+// UpdateConfigGroupRequest is the request to update a config group.
+type UpdateConfigGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigGroupId int64                  `protobuf:"varint,1,opt,name=config_group_id,json=configGroupId,proto3" json:"config_group_id,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Env           map[string]string      `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // replaces the env vars when replace_env is set
+	ReplaceEnv    bool                   `protobuf:"varint,4,opt,name=replace_env,json=replaceEnv,proto3" json:"replace_env,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateConfigGroupRequest) Reset() {
+	*x = UpdateConfigGroupRequest{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConfigGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConfigGroupRequest) ProtoMessage() {}
+
+func (x *UpdateConfigGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConfigGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateConfigGroupRequest) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateConfigGroupRequest) GetConfigGroupId() int64 {
+	if x != nil {
+		return x.ConfigGroupId
+	}
+	return 0
+}
+
+func (x *UpdateConfigGroupRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateConfigGroupRequest) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *UpdateConfigGroupRequest) GetReplaceEnv() bool {
+	if x != nil {
+		return x.ReplaceEnv
+	}
+	return false
+}
+
+// UpdateConfigGroupResponse is the response containing the updated config group.
+type UpdateConfigGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigGroup   *ConfigGroup           `protobuf:"bytes,1,opt,name=config_group,json=configGroup,proto3" json:"config_group,omitempty"`
+	Rollout       *ConfigRollout         `protobuf:"bytes,2,opt,name=rollout,proto3,oneof" json:"rollout,omitempty"` // set when the env vars changed and resources are attached
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateConfigGroupResponse) Reset() {
+	*x = UpdateConfigGroupResponse{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConfigGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConfigGroupResponse) ProtoMessage() {}
+
+func (x *UpdateConfigGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConfigGroupResponse.ProtoReflect.Descriptor instead.
+func (*UpdateConfigGroupResponse) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateConfigGroupResponse) GetConfigGroup() *ConfigGroup {
+	if x != nil {
+		return x.ConfigGroup
+	}
+	return nil
+}
+
+func (x *UpdateConfigGroupResponse) GetRollout() *ConfigRollout {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+// DeleteConfigGroupRequest is the request to delete a config group.
+type DeleteConfigGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigGroupId int64                  `protobuf:"varint,1,opt,name=config_group_id,json=configGroupId,proto3" json:"config_group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConfigGroupRequest) Reset() {
+	*x = DeleteConfigGroupRequest{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConfigGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConfigGroupRequest) ProtoMessage() {}
+
+func (x *DeleteConfigGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConfigGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteConfigGroupRequest) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteConfigGroupRequest) GetConfigGroupId() int64 {
+	if x != nil {
+		return x.ConfigGroupId
+	}
+	return 0
+}
+
+// DeleteConfigGroupResponse is the response to deleting a config group.
+type DeleteConfigGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConfigGroupResponse) Reset() {
+	*x = DeleteConfigGroupResponse{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConfigGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConfigGroupResponse) ProtoMessage() {}
+
+func (x *DeleteConfigGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConfigGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteConfigGroupResponse) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{11}
+}
+
+// AttachConfigGroupRequest is the request to attach a config group to a resource.
+type AttachConfigGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigGroupId int64                  `protobuf:"varint,1,opt,name=config_group_id,json=configGroupId,proto3" json:"config_group_id,omitempty"`
+	ResourceId    int64                  `protobuf:"varint,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachConfigGroupRequest) Reset() {
+	*x = AttachConfigGroupRequest{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachConfigGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachConfigGroupRequest) ProtoMessage() {}
+
+func (x *AttachConfigGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachConfigGroupRequest.ProtoReflect.Descriptor instead.
+func (*AttachConfigGroupRequest) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AttachConfigGroupRequest) GetConfigGroupId() int64 {
+	if x != nil {
+		return x.ConfigGroupId
+	}
+	return 0
+}
+
+func (x *AttachConfigGroupRequest) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+// AttachConfigGroupResponse is the response to attaching a config group.
+type AttachConfigGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachConfigGroupResponse) Reset() {
+	*x = AttachConfigGroupResponse{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachConfigGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachConfigGroupResponse) ProtoMessage() {}
+
+func (x *AttachConfigGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachConfigGroupResponse.ProtoReflect.Descriptor instead.
+func (*AttachConfigGroupResponse) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{13}
+}
+
+// DetachConfigGroupRequest is the request to detach a config group from a resource.
+type DetachConfigGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigGroupId int64                  `protobuf:"varint,1,opt,name=config_group_id,json=configGroupId,proto3" json:"config_group_id,omitempty"`
+	ResourceId    int64                  `protobuf:"varint,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachConfigGroupRequest) Reset() {
+	*x = DetachConfigGroupRequest{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachConfigGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachConfigGroupRequest) ProtoMessage() {}
+
+func (x *DetachConfigGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachConfigGroupRequest.ProtoReflect.Descriptor instead.
+func (*DetachConfigGroupRequest) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DetachConfigGroupRequest) GetConfigGroupId() int64 {
+	if x != nil {
+		return x.ConfigGroupId
+	}
+	return 0
+}
+
+func (x *DetachConfigGroupRequest) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+// DetachConfigGroupResponse is the response to detaching a config group.
+type DetachConfigGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachConfigGroupResponse) Reset() {
+	*x = DetachConfigGroupResponse{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachConfigGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachConfigGroupResponse) ProtoMessage() {}
+
+func (x *DetachConfigGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachConfigGroupResponse.ProtoReflect.Descriptor instead.
+func (*DetachConfigGroupResponse) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{15}
+}
+
+// GetConfigRolloutRequest is the request to get a config rollout's progress.
+type GetConfigRolloutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RolloutId     int64                  `protobuf:"varint,1,opt,name=rollout_id,json=rolloutId,proto3" json:"rollout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigRolloutRequest) Reset() {
+	*x = GetConfigRolloutRequest{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigRolloutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRolloutRequest) ProtoMessage() {}
+
+func (x *GetConfigRolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRolloutRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRolloutRequest) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetConfigRolloutRequest) GetRolloutId() int64 {
+	if x != nil {
+		return x.RolloutId
+	}
+	return 0
+}
+
+// GetConfigRolloutResponse is the response containing the rollout.
+type GetConfigRolloutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rollout       *ConfigRollout         `protobuf:"bytes,1,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigRolloutResponse) Reset() {
+	*x = GetConfigRolloutResponse{}
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigRolloutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRolloutResponse) ProtoMessage() {}
+
+func (x *GetConfigRolloutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configgroup_v1_configgroup_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRolloutResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigRolloutResponse) Descriptor() ([]byte, []int) {
+	return file_configgroup_v1_configgroup_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetConfigRolloutResponse) GetRollout() *ConfigRollout {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+var File_configgroup_v1_configgroup_proto protoreflect.FileDescriptor
+
+const file_configgroup_v1_configgroup_proto_rawDesc = "" +
+	"\n" +
+	" configgroup/v1/configgroup.proto\x12\x0econfiggroup.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9e\x03\n" +
+	"\vConfigGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\x03R\vworkspaceId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x126\n" +
+	"\x03env\x18\x05 \x03(\v2$.configgroup.v1.ConfigGroup.EnvEntryR\x03env\x12!\n" +
+	"\fresource_ids\x18\x06 \x03(\x03R\vresourceIds\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\x03R\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf0\x03\n" +
+	"\rConfigRollout\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x0fconfig_group_id\x18\x02 \x01(\x03R\rconfigGroupId\x12;\n" +
+	"\x06status\x18\x03 \x01(\x0e2#.configgroup.v1.ConfigRolloutStatusR\x06status\x12!\n" +
+	"\fresource_ids\x18\x04 \x03(\x03R\vresourceIds\x12/\n" +
+	"\x13completed_resources\x18\x05 \x01(\x05R\x12completedResources\x123\n" +
+	"\x13current_resource_id\x18\x06 \x01(\x03H\x00R\x11currentResourceId\x88\x01\x01\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAtB\x16\n" +
+	"\x14_current_resource_id\"\x85\x02\n" +
+	"\x18CreateConfigGroupRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12C\n" +
+	"\x03env\x18\x04 \x03(\v21.configgroup.v1.CreateConfigGroupRequest.EnvEntryR\x03env\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_description\"[\n" +
+	"\x19CreateConfigGroupResponse\x12>\n" +
+	"\fconfig_group\x18\x01 \x01(\v2\x1b.configgroup.v1.ConfigGroupR\vconfigGroup\"?\n" +
+	"\x15GetConfigGroupRequest\x12&\n" +
+	"\x0fconfig_group_id\x18\x01 \x01(\x03R\rconfigGroupId\"\xa2\x01\n" +
+	"\x16GetConfigGroupResponse\x12>\n" +
+	"\fconfig_group\x18\x01 \x01(\v2\x1b.configgroup.v1.ConfigGroupR\vconfigGroup\x12<\n" +
+	"\arollout\x18\x02 \x01(\v2\x1d.configgroup.v1.ConfigRolloutH\x00R\arollout\x88\x01\x01B\n" +
+	"\n" +
+	"\b_rollout\"<\n" +
+	"\x17ListConfigGroupsRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"\\\n" +
+	"\x18ListConfigGroupsResponse\x12@\n" +
+	"\rconfig_groups\x18\x01 \x03(\v2\x1b.configgroup.v1.ConfigGroupR\fconfigGroups\"\x97\x02\n" +
+	"\x18UpdateConfigGroupRequest\x12&\n" +
+	"\x0fconfig_group_id\x18\x01 \x01(\x03R\rconfigGroupId\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12C\n" +
+	"\x03env\x18\x03 \x03(\v21.configgroup.v1.UpdateConfigGroupRequest.EnvEntryR\x03env\x12\x1f\n" +
+	"\vreplace_env\x18\x04 \x01(\bR\n" +
+	"replaceEnv\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_description\"\xa5\x01\n" +
+	"\x19UpdateConfigGroupResponse\x12>\n" +
+	"\fconfig_group\x18\x01 \x01(\v2\x1b.configgroup.v1.ConfigGroupR\vconfigGroup\x12<\n" +
+	"\arollout\x18\x02 \x01(\v2\x1d.configgroup.v1.ConfigRolloutH\x00R\arollout\x88\x01\x01B\n" +
+	"\n" +
+	"\b_rollout\"B\n" +
+	"\x18DeleteConfigGroupRequest\x12&\n" +
+	"\x0fconfig_group_id\x18\x01 \x01(\x03R\rconfigGroupId\"\x1b\n" +
+	"\x19DeleteConfigGroupResponse\"c\n" +
+	"\x18AttachConfigGroupRequest\x12&\n" +
+	"\x0fconfig_group_id\x18\x01 \x01(\x03R\rconfigGroupId\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\x03R\n" +
+	"resourceId\"\x1b\n" +
+	"\x19AttachConfigGroupResponse\"c\n" +
+	"\x18DetachConfigGroupRequest\x12&\n" +
+	"\x0fconfig_group_id\x18\x01 \x01(\x03R\rconfigGroupId\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\x03R\n" +
+	"resourceId\"\x1b\n" +
+	"\x19DetachConfigGroupResponse\"8\n" +
+	"\x17GetConfigRolloutRequest\x12\x1d\n" +
+	"\n" +
+	"rollout_id\x18\x01 \x01(\x03R\trolloutId\"S\n" +
+	"\x18GetConfigRolloutResponse\x127\n" +
+	"\arollout\x18\x01 \x01(\v2\x1d.configgroup.v1.ConfigRolloutR\arollout*\xc9\x01\n" +
+	"\x13ConfigRolloutStatus\x12%\n" +
+	"!CONFIG_ROLLOUT_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dCONFIG_ROLLOUT_STATUS_PENDING\x10\x01\x12!\n" +
+	"\x1dCONFIG_ROLLOUT_STATUS_RUNNING\x10\x02\x12#\n" +
+	"\x1fCONFIG_ROLLOUT_STATUS_COMPLETED\x10\x03\x12 \n" +
+	"\x1cCONFIG_ROLLOUT_STATUS_FAILED\x10\x042\xd5\x06\n" +
+	"\x12ConfigGroupService\x12h\n" +
+	"\x11CreateConfigGroup\x12(.configgroup.v1.CreateConfigGroupRequest\x1a).configgroup.v1.CreateConfigGroupResponse\x12_\n" +
+	"\x0eGetConfigGroup\x12%.configgroup.v1.GetConfigGroupRequest\x1a&.configgroup.v1.GetConfigGroupResponse\x12e\n" +
+	"\x10ListConfigGroups\x12'.configgroup.v1.ListConfigGroupsRequest\x1a(.configgroup.v1.ListConfigGroupsResponse\x12h\n" +
+	"\x11UpdateConfigGroup\x12(.configgroup.v1.UpdateConfigGroupRequest\x1a).configgroup.v1.UpdateConfigGroupResponse\x12h\n" +
+	"\x11DeleteConfigGroup\x12(.configgroup.v1.DeleteConfigGroupRequest\x1a).configgroup.v1.DeleteConfigGroupResponse\x12h\n" +
+	"\x11AttachConfigGroup\x12(.configgroup.v1.AttachConfigGroupRequest\x1a).configgroup.v1.AttachConfigGroupResponse\x12h\n" +
+	"\x11DetachConfigGroup\x12(.configgroup.v1.DetachConfigGroupRequest\x1a).configgroup.v1.DetachConfigGroupResponse\x12e\n" +
+	"\x10GetConfigRollout\x12'.configgroup.v1.GetConfigRolloutRequest\x1a(.configgroup.v1.GetConfigRolloutResponseBEZCgithub.com/team-loco/loco/shared/proto/configgroup/v1;configgroupv1b\x06proto3"
+
+var (
+	file_configgroup_v1_configgroup_proto_rawDescOnce sync.Once
+	file_configgroup_v1_configgroup_proto_rawDescData []byte
+)
+
+func file_configgroup_v1_configgroup_proto_rawDescGZIP() []byte {
+	file_configgroup_v1_configgroup_proto_rawDescOnce.Do(func() {
+		file_configgroup_v1_configgroup_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_configgroup_v1_configgroup_proto_rawDesc), len(file_configgroup_v1_configgroup_proto_rawDesc)))
+	})
+	return file_configgroup_v1_configgroup_proto_rawDescData
+}
+
+var file_configgroup_v1_configgroup_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_configgroup_v1_configgroup_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_configgroup_v1_configgroup_proto_goTypes = []any{
+	(ConfigRolloutStatus)(0),          // 0: configgroup.v1.ConfigRolloutStatus
+	(*ConfigGroup)(nil),               // 1: configgroup.v1.ConfigGroup
+	(*ConfigRollout)(nil),             // 2: configgroup.v1.ConfigRollout
+	(*CreateConfigGroupRequest)(nil),  // 3: configgroup.v1.CreateConfigGroupRequest
+	(*CreateConfigGroupResponse)(nil), // 4: configgroup.v1.CreateConfigGroupResponse
+	(*GetConfigGroupRequest)(nil),     // 5: configgroup.v1.GetConfigGroupRequest
+	(*GetConfigGroupResponse)(nil),    // 6: configgroup.v1.GetConfigGroupResponse
+	(*ListConfigGroupsRequest)(nil),   // 7: configgroup.v1.ListConfigGroupsRequest
+	(*ListConfigGroupsResponse)(nil),  // 8: configgroup.v1.ListConfigGroupsResponse
+	(*UpdateConfigGroupRequest)(nil),  // 9: configgroup.v1.UpdateConfigGroupRequest
+	(*UpdateConfigGroupResponse)(nil), // 10: configgroup.v1.UpdateConfigGroupResponse
+	(*DeleteConfigGroupRequest)(nil),  // 11: configgroup.v1.DeleteConfigGroupRequest
+	(*DeleteConfigGroupResponse)(nil), // 12: configgroup.v1.DeleteConfigGroupResponse
+	(*AttachConfigGroupRequest)(nil),  // 13: configgroup.v1.AttachConfigGroupRequest
+	(*AttachConfigGroupResponse)(nil), // 14: configgroup.v1.AttachConfigGroupResponse
+	(*DetachConfigGroupRequest)(nil),  // 15: configgroup.v1.DetachConfigGroupRequest
+	(*DetachConfigGroupResponse)(nil), // 16: configgroup.v1.DetachConfigGroupResponse
+	(*GetConfigRolloutRequest)(nil),   // 17: configgroup.v1.GetConfigRolloutRequest
+	(*GetConfigRolloutResponse)(nil),  // 18: configgroup.v1.GetConfigRolloutResponse
+	nil,                               // 19: configgroup.v1.ConfigGroup.EnvEntry
+	nil,                               // 20: configgroup.v1.CreateConfigGroupRequest.EnvEntry
+	nil,                               // 21: configgroup.v1.UpdateConfigGroupRequest.EnvEntry
+	(*timestamppb.Timestamp)(nil),     // 22: google.protobuf.Timestamp
+}
+var file_configgroup_v1_configgroup_proto_depIdxs = []int32{
+	19, // 0: configgroup.v1.ConfigGroup.env:type_name -> configgroup.v1.ConfigGroup.EnvEntry
+	22, // 1: configgroup.v1.ConfigGroup.created_at:type_name -> google.protobuf.Timestamp
+	22, // 2: configgroup.v1.ConfigGroup.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: configgroup.v1.ConfigRollout.status:type_name -> configgroup.v1.ConfigRolloutStatus
+	22, // 4: configgroup.v1.ConfigRollout.created_at:type_name -> google.protobuf.Timestamp
+	22, // 5: configgroup.v1.ConfigRollout.updated_at:type_name -> google.protobuf.Timestamp
+	22, // 6: configgroup.v1.ConfigRollout.completed_at:type_name -> google.protobuf.Timestamp
+	20, // 7: configgroup.v1.CreateConfigGroupRequest.env:type_name -> configgroup.v1.CreateConfigGroupRequest.EnvEntry
+	1,  // 8: configgroup.v1.CreateConfigGroupResponse.config_group:type_name -> configgroup.v1.ConfigGroup
+	1,  // 9: configgroup.v1.GetConfigGroupResponse.config_group:type_name -> configgroup.v1.ConfigGroup
+	2,  // 10: configgroup.v1.GetConfigGroupResponse.rollout:type_name -> configgroup.v1.ConfigRollout
+	1,  // 11: configgroup.v1.ListConfigGroupsResponse.config_groups:type_name -> configgroup.v1.ConfigGroup
+	21, // 12: configgroup.v1.UpdateConfigGroupRequest.env:type_name -> configgroup.v1.UpdateConfigGroupRequest.EnvEntry
+	1,  // 13: configgroup.v1.UpdateConfigGroupResponse.config_group:type_name -> configgroup.v1.ConfigGroup
+	2,  // 14: configgroup.v1.UpdateConfigGroupResponse.rollout:type_name -> configgroup.v1.ConfigRollout
+	2,  // 15: configgroup.v1.GetConfigRolloutResponse.rollout:type_name -> configgroup.v1.ConfigRollout
+	3,  // 16: configgroup.v1.ConfigGroupService.CreateConfigGroup:input_type -> configgroup.v1.CreateConfigGroupRequest
+	5,  // 17: configgroup.v1.ConfigGroupService.GetConfigGroup:input_type -> configgroup.v1.GetConfigGroupRequest
+	7,  // 18: configgroup.v1.ConfigGroupService.ListConfigGroups:input_type -> configgroup.v1.ListConfigGroupsRequest
+	9,  // 19: configgroup.v1.ConfigGroupService.UpdateConfigGroup:input_type -> configgroup.v1.UpdateConfigGroupRequest
+	11, // 20: configgroup.v1.ConfigGroupService.DeleteConfigGroup:input_type -> configgroup.v1.DeleteConfigGroupRequest
+	13, // 21: configgroup.v1.ConfigGroupService.AttachConfigGroup:input_type -> configgroup.v1.AttachConfigGroupRequest
+	15, // 22: configgroup.v1.ConfigGroupService.DetachConfigGroup:input_type -> configgroup.v1.DetachConfigGroupRequest
+	17, // 23: configgroup.v1.ConfigGroupService.GetConfigRollout:input_type -> configgroup.v1.GetConfigRolloutRequest
+	4,  // 24: configgroup.v1.ConfigGroupService.CreateConfigGroup:output_type -> configgroup.v1.CreateConfigGroupResponse
+	6,  // 25: configgroup.v1.ConfigGroupService.GetConfigGroup:output_type -> configgroup.v1.GetConfigGroupResponse
+	8,  // 26: configgroup.v1.ConfigGroupService.ListConfigGroups:output_type -> configgroup.v1.ListConfigGroupsResponse
+	10, // 27: configgroup.v1.ConfigGroupService.UpdateConfigGroup:output_type -> configgroup.v1.UpdateConfigGroupResponse
+	12, // 28: configgroup.v1.ConfigGroupService.DeleteConfigGroup:output_type -> configgroup.v1.DeleteConfigGroupResponse
+	14, // 29: configgroup.v1.ConfigGroupService.AttachConfigGroup:output_type -> configgroup.v1.AttachConfigGroupResponse
+	16, // 30: configgroup.v1.ConfigGroupService.DetachConfigGroup:output_type -> configgroup.v1.DetachConfigGroupResponse
+	18, // 31: configgroup.v1.ConfigGroupService.GetConfigRollout:output_type -> configgroup.v1.GetConfigRolloutResponse
+	24, // [24:32] is the sub-list for method output_type
+	16, // [16:24] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_configgroup_v1_configgroup_proto_init() }
+func file_configgroup_v1_configgroup_proto_init() {
+	if File_configgroup_v1_configgroup_proto != nil {
+		return
+	}
+	file_configgroup_v1_configgroup_proto_msgTypes[1].OneofWrappers = []any{}
+	file_configgroup_v1_configgroup_proto_msgTypes[2].OneofWrappers = []any{}
+	file_configgroup_v1_configgroup_proto_msgTypes[5].OneofWrappers = []any{}
+	file_configgroup_v1_configgroup_proto_msgTypes[8].OneofWrappers = []any{}
+	file_configgroup_v1_configgroup_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_configgroup_v1_configgroup_proto_rawDesc), len(file_configgroup_v1_configgroup_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_configgroup_v1_configgroup_proto_goTypes,
+		DependencyIndexes: file_configgroup_v1_configgroup_proto_depIdxs,
+		EnumInfos:         file_configgroup_v1_configgroup_proto_enumTypes,
+		MessageInfos:      file_configgroup_v1_configgroup_proto_msgTypes,
+	}.Build()
+	File_configgroup_v1_configgroup_proto = out.File
+	file_configgroup_v1_configgroup_proto_goTypes = nil
+	file_configgroup_v1_configgroup_proto_depIdxs = nil
+}
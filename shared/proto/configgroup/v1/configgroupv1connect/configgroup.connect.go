@@ -0,0 +1,332 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: configgroup/v1/configgroup.proto
+
+package configgroupv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/team-loco/loco/shared/proto/configgroup/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ConfigGroupServiceName is the fully-qualified name of the ConfigGroupService service.
+	ConfigGroupServiceName = "configgroup.v1.ConfigGroupService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ConfigGroupServiceCreateConfigGroupProcedure is the fully-qualified name of the
+	// ConfigGroupService's CreateConfigGroup RPC.
+	ConfigGroupServiceCreateConfigGroupProcedure = "/configgroup.v1.ConfigGroupService/CreateConfigGroup"
+	// ConfigGroupServiceGetConfigGroupProcedure is the fully-qualified name of the ConfigGroupService's
+	// GetConfigGroup RPC.
+	ConfigGroupServiceGetConfigGroupProcedure = "/configgroup.v1.ConfigGroupService/GetConfigGroup"
+	// ConfigGroupServiceListConfigGroupsProcedure is the fully-qualified name of the
+	// ConfigGroupService's ListConfigGroups RPC.
+	ConfigGroupServiceListConfigGroupsProcedure = "/configgroup.v1.ConfigGroupService/ListConfigGroups"
+	// ConfigGroupServiceUpdateConfigGroupProcedure is the fully-qualified name of the
+	// ConfigGroupService's UpdateConfigGroup RPC.
+	ConfigGroupServiceUpdateConfigGroupProcedure = "/configgroup.v1.ConfigGroupService/UpdateConfigGroup"
+	// ConfigGroupServiceDeleteConfigGroupProcedure is the fully-qualified name of the
+	// ConfigGroupService's DeleteConfigGroup RPC.
+	ConfigGroupServiceDeleteConfigGroupProcedure = "/configgroup.v1.ConfigGroupService/DeleteConfigGroup"
+	// ConfigGroupServiceAttachConfigGroupProcedure is the fully-qualified name of the
+	// ConfigGroupService's AttachConfigGroup RPC.
+	ConfigGroupServiceAttachConfigGroupProcedure = "/configgroup.v1.ConfigGroupService/AttachConfigGroup"
+	// ConfigGroupServiceDetachConfigGroupProcedure is the fully-qualified name of the
+	// ConfigGroupService's DetachConfigGroup RPC.
+	ConfigGroupServiceDetachConfigGroupProcedure = "/configgroup.v1.ConfigGroupService/DetachConfigGroup"
+	// ConfigGroupServiceGetConfigRolloutProcedure is the fully-qualified name of the
+	// ConfigGroupService's GetConfigRollout RPC.
+	ConfigGroupServiceGetConfigRolloutProcedure = "/configgroup.v1.ConfigGroupService/GetConfigRollout"
+)
+
+// ConfigGroupServiceClient is a client for the configgroup.v1.ConfigGroupService service.
+type ConfigGroupServiceClient interface {
+	// CreateConfigGroup creates a config group in a workspace.
+	CreateConfigGroup(context.Context, *connect.Request[v1.CreateConfigGroupRequest]) (*connect.Response[v1.CreateConfigGroupResponse], error)
+	// GetConfigGroup retrieves a config group by ID, with its rollout in progress if any.
+	GetConfigGroup(context.Context, *connect.Request[v1.GetConfigGroupRequest]) (*connect.Response[v1.GetConfigGroupResponse], error)
+	// ListConfigGroups lists the config groups of a workspace.
+	ListConfigGroups(context.Context, *connect.Request[v1.ListConfigGroupsRequest]) (*connect.Response[v1.ListConfigGroupsResponse], error)
+	// UpdateConfigGroup updates a config group's description or env vars. Changing the env vars starts a
+	// rollout that redeploys every attached resource; it is refused while another rollout is in progress.
+	UpdateConfigGroup(context.Context, *connect.Request[v1.UpdateConfigGroupRequest]) (*connect.Response[v1.UpdateConfigGroupResponse], error)
+	// DeleteConfigGroup deletes a config group, detaching it from its resources.
+	DeleteConfigGroup(context.Context, *connect.Request[v1.DeleteConfigGroupRequest]) (*connect.Response[v1.DeleteConfigGroupResponse], error)
+	// AttachConfigGroup attaches a config group to a resource in the same workspace. Its env vars take
+	// effect on the resource's next deployment.
+	AttachConfigGroup(context.Context, *connect.Request[v1.AttachConfigGroupRequest]) (*connect.Response[v1.AttachConfigGroupResponse], error)
+	// DetachConfigGroup detaches a config group from a resource.
+	DetachConfigGroup(context.Context, *connect.Request[v1.DetachConfigGroupRequest]) (*connect.Response[v1.DetachConfigGroupResponse], error)
+	// GetConfigRollout reports the progress of a config rollout.
+	GetConfigRollout(context.Context, *connect.Request[v1.GetConfigRolloutRequest]) (*connect.Response[v1.GetConfigRolloutResponse], error)
+}
+
+// NewConfigGroupServiceClient constructs a client for the configgroup.v1.ConfigGroupService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewConfigGroupServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ConfigGroupServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	configGroupServiceMethods := v1.File_configgroup_v1_configgroup_proto.Services().ByName("ConfigGroupService").Methods()
+	return &configGroupServiceClient{
+		createConfigGroup: connect.NewClient[v1.CreateConfigGroupRequest, v1.CreateConfigGroupResponse](
+			httpClient,
+			baseURL+ConfigGroupServiceCreateConfigGroupProcedure,
+			connect.WithSchema(configGroupServiceMethods.ByName("CreateConfigGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		getConfigGroup: connect.NewClient[v1.GetConfigGroupRequest, v1.GetConfigGroupResponse](
+			httpClient,
+			baseURL+ConfigGroupServiceGetConfigGroupProcedure,
+			connect.WithSchema(configGroupServiceMethods.ByName("GetConfigGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		listConfigGroups: connect.NewClient[v1.ListConfigGroupsRequest, v1.ListConfigGroupsResponse](
+			httpClient,
+			baseURL+ConfigGroupServiceListConfigGroupsProcedure,
+			connect.WithSchema(configGroupServiceMethods.ByName("ListConfigGroups")),
+			connect.WithClientOptions(opts...),
+		),
+		updateConfigGroup: connect.NewClient[v1.UpdateConfigGroupRequest, v1.UpdateConfigGroupResponse](
+			httpClient,
+			baseURL+ConfigGroupServiceUpdateConfigGroupProcedure,
+			connect.WithSchema(configGroupServiceMethods.ByName("UpdateConfigGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteConfigGroup: connect.NewClient[v1.DeleteConfigGroupRequest, v1.DeleteConfigGroupResponse](
+			httpClient,
+			baseURL+ConfigGroupServiceDeleteConfigGroupProcedure,
+			connect.WithSchema(configGroupServiceMethods.ByName("DeleteConfigGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		attachConfigGroup: connect.NewClient[v1.AttachConfigGroupRequest, v1.AttachConfigGroupResponse](
+			httpClient,
+			baseURL+ConfigGroupServiceAttachConfigGroupProcedure,
+			connect.WithSchema(configGroupServiceMethods.ByName("AttachConfigGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		detachConfigGroup: connect.NewClient[v1.DetachConfigGroupRequest, v1.DetachConfigGroupResponse](
+			httpClient,
+			baseURL+ConfigGroupServiceDetachConfigGroupProcedure,
+			connect.WithSchema(configGroupServiceMethods.ByName("DetachConfigGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		getConfigRollout: connect.NewClient[v1.GetConfigRolloutRequest, v1.GetConfigRolloutResponse](
+			httpClient,
+			baseURL+ConfigGroupServiceGetConfigRolloutProcedure,
+			connect.WithSchema(configGroupServiceMethods.ByName("GetConfigRollout")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// configGroupServiceClient implements ConfigGroupServiceClient.
+type configGroupServiceClient struct {
+	createConfigGroup *connect.Client[v1.CreateConfigGroupRequest, v1.CreateConfigGroupResponse]
+	getConfigGroup    *connect.Client[v1.GetConfigGroupRequest, v1.GetConfigGroupResponse]
+	listConfigGroups  *connect.Client[v1.ListConfigGroupsRequest, v1.ListConfigGroupsResponse]
+	updateConfigGroup *connect.Client[v1.UpdateConfigGroupRequest, v1.UpdateConfigGroupResponse]
+	deleteConfigGroup *connect.Client[v1.DeleteConfigGroupRequest, v1.DeleteConfigGroupResponse]
+	attachConfigGroup *connect.Client[v1.AttachConfigGroupRequest, v1.AttachConfigGroupResponse]
+	detachConfigGroup *connect.Client[v1.DetachConfigGroupRequest, v1.DetachConfigGroupResponse]
+	getConfigRollout  *connect.Client[v1.GetConfigRolloutRequest, v1.GetConfigRolloutResponse]
+}
+
+// CreateConfigGroup calls configgroup.v1.ConfigGroupService.CreateConfigGroup.
+func (c *configGroupServiceClient) CreateConfigGroup(ctx context.Context, req *connect.Request[v1.CreateConfigGroupRequest]) (*connect.Response[v1.CreateConfigGroupResponse], error) {
+	return c.createConfigGroup.CallUnary(ctx, req)
+}
+
+// GetConfigGroup calls configgroup.v1.ConfigGroupService.GetConfigGroup.
+func (c *configGroupServiceClient) GetConfigGroup(ctx context.Context, req *connect.Request[v1.GetConfigGroupRequest]) (*connect.Response[v1.GetConfigGroupResponse], error) {
+	return c.getConfigGroup.CallUnary(ctx, req)
+}
+
+// ListConfigGroups calls configgroup.v1.ConfigGroupService.ListConfigGroups.
+func (c *configGroupServiceClient) ListConfigGroups(ctx context.Context, req *connect.Request[v1.ListConfigGroupsRequest]) (*connect.Response[v1.ListConfigGroupsResponse], error) {
+	return c.listConfigGroups.CallUnary(ctx, req)
+}
+
+// UpdateConfigGroup calls configgroup.v1.ConfigGroupService.UpdateConfigGroup.
+func (c *configGroupServiceClient) UpdateConfigGroup(ctx context.Context, req *connect.Request[v1.UpdateConfigGroupRequest]) (*connect.Response[v1.UpdateConfigGroupResponse], error) {
+	return c.updateConfigGroup.CallUnary(ctx, req)
+}
+
+// DeleteConfigGroup calls configgroup.v1.ConfigGroupService.DeleteConfigGroup.
+func (c *configGroupServiceClient) DeleteConfigGroup(ctx context.Context, req *connect.Request[v1.DeleteConfigGroupRequest]) (*connect.Response[v1.DeleteConfigGroupResponse], error) {
+	return c.deleteConfigGroup.CallUnary(ctx, req)
+}
+
+// AttachConfigGroup calls configgroup.v1.ConfigGroupService.AttachConfigGroup.
+func (c *configGroupServiceClient) AttachConfigGroup(ctx context.Context, req *connect.Request[v1.AttachConfigGroupRequest]) (*connect.Response[v1.AttachConfigGroupResponse], error) {
+	return c.attachConfigGroup.CallUnary(ctx, req)
+}
+
+// DetachConfigGroup calls configgroup.v1.ConfigGroupService.DetachConfigGroup.
+func (c *configGroupServiceClient) DetachConfigGroup(ctx context.Context, req *connect.Request[v1.DetachConfigGroupRequest]) (*connect.Response[v1.DetachConfigGroupResponse], error) {
+	return c.detachConfigGroup.CallUnary(ctx, req)
+}
+
+// GetConfigRollout calls configgroup.v1.ConfigGroupService.GetConfigRollout.
+func (c *configGroupServiceClient) GetConfigRollout(ctx context.Context, req *connect.Request[v1.GetConfigRolloutRequest]) (*connect.Response[v1.GetConfigRolloutResponse], error) {
+	return c.getConfigRollout.CallUnary(ctx, req)
+}
+
+// ConfigGroupServiceHandler is an implementation of the configgroup.v1.ConfigGroupService service.
+type ConfigGroupServiceHandler interface {
+	// CreateConfigGroup creates a config group in a workspace.
+	CreateConfigGroup(context.Context, *connect.Request[v1.CreateConfigGroupRequest]) (*connect.Response[v1.CreateConfigGroupResponse], error)
+	// GetConfigGroup retrieves a config group by ID, with its rollout in progress if any.
+	GetConfigGroup(context.Context, *connect.Request[v1.GetConfigGroupRequest]) (*connect.Response[v1.GetConfigGroupResponse], error)
+	// ListConfigGroups lists the config groups of a workspace.
+	ListConfigGroups(context.Context, *connect.Request[v1.ListConfigGroupsRequest]) (*connect.Response[v1.ListConfigGroupsResponse], error)
+	// UpdateConfigGroup updates a config group's description or env vars. Changing the env vars starts a
+	// rollout that redeploys every attached resource; it is refused while another rollout is in progress.
+	UpdateConfigGroup(context.Context, *connect.Request[v1.UpdateConfigGroupRequest]) (*connect.Response[v1.UpdateConfigGroupResponse], error)
+	// DeleteConfigGroup deletes a config group, detaching it from its resources.
+	DeleteConfigGroup(context.Context, *connect.Request[v1.DeleteConfigGroupRequest]) (*connect.Response[v1.DeleteConfigGroupResponse], error)
+	// AttachConfigGroup attaches a config group to a resource in the same workspace. Its env vars take
+	// effect on the resource's next deployment.
+	AttachConfigGroup(context.Context, *connect.Request[v1.AttachConfigGroupRequest]) (*connect.Response[v1.AttachConfigGroupResponse], error)
+	// DetachConfigGroup detaches a config group from a resource.
+	DetachConfigGroup(context.Context, *connect.Request[v1.DetachConfigGroupRequest]) (*connect.Response[v1.DetachConfigGroupResponse], error)
+	// GetConfigRollout reports the progress of a config rollout.
+	GetConfigRollout(context.Context, *connect.Request[v1.GetConfigRolloutRequest]) (*connect.Response[v1.GetConfigRolloutResponse], error)
+}
+
+// NewConfigGroupServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewConfigGroupServiceHandler(svc ConfigGroupServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	configGroupServiceMethods := v1.File_configgroup_v1_configgroup_proto.Services().ByName("ConfigGroupService").Methods()
+	configGroupServiceCreateConfigGroupHandler := connect.NewUnaryHandler(
+		ConfigGroupServiceCreateConfigGroupProcedure,
+		svc.CreateConfigGroup,
+		connect.WithSchema(configGroupServiceMethods.ByName("CreateConfigGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	configGroupServiceGetConfigGroupHandler := connect.NewUnaryHandler(
+		ConfigGroupServiceGetConfigGroupProcedure,
+		svc.GetConfigGroup,
+		connect.WithSchema(configGroupServiceMethods.ByName("GetConfigGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	configGroupServiceListConfigGroupsHandler := connect.NewUnaryHandler(
+		ConfigGroupServiceListConfigGroupsProcedure,
+		svc.ListConfigGroups,
+		connect.WithSchema(configGroupServiceMethods.ByName("ListConfigGroups")),
+		connect.WithHandlerOptions(opts...),
+	)
+	configGroupServiceUpdateConfigGroupHandler := connect.NewUnaryHandler(
+		ConfigGroupServiceUpdateConfigGroupProcedure,
+		svc.UpdateConfigGroup,
+		connect.WithSchema(configGroupServiceMethods.ByName("UpdateConfigGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	configGroupServiceDeleteConfigGroupHandler := connect.NewUnaryHandler(
+		ConfigGroupServiceDeleteConfigGroupProcedure,
+		svc.DeleteConfigGroup,
+		connect.WithSchema(configGroupServiceMethods.ByName("DeleteConfigGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	configGroupServiceAttachConfigGroupHandler := connect.NewUnaryHandler(
+		ConfigGroupServiceAttachConfigGroupProcedure,
+		svc.AttachConfigGroup,
+		connect.WithSchema(configGroupServiceMethods.ByName("AttachConfigGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	configGroupServiceDetachConfigGroupHandler := connect.NewUnaryHandler(
+		ConfigGroupServiceDetachConfigGroupProcedure,
+		svc.DetachConfigGroup,
+		connect.WithSchema(configGroupServiceMethods.ByName("DetachConfigGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	configGroupServiceGetConfigRolloutHandler := connect.NewUnaryHandler(
+		ConfigGroupServiceGetConfigRolloutProcedure,
+		svc.GetConfigRollout,
+		connect.WithSchema(configGroupServiceMethods.ByName("GetConfigRollout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/configgroup.v1.ConfigGroupService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ConfigGroupServiceCreateConfigGroupProcedure:
+			configGroupServiceCreateConfigGroupHandler.ServeHTTP(w, r)
+		case ConfigGroupServiceGetConfigGroupProcedure:
+			configGroupServiceGetConfigGroupHandler.ServeHTTP(w, r)
+		case ConfigGroupServiceListConfigGroupsProcedure:
+			configGroupServiceListConfigGroupsHandler.ServeHTTP(w, r)
+		case ConfigGroupServiceUpdateConfigGroupProcedure:
+			configGroupServiceUpdateConfigGroupHandler.ServeHTTP(w, r)
+		case ConfigGroupServiceDeleteConfigGroupProcedure:
+			configGroupServiceDeleteConfigGroupHandler.ServeHTTP(w, r)
+		case ConfigGroupServiceAttachConfigGroupProcedure:
+			configGroupServiceAttachConfigGroupHandler.ServeHTTP(w, r)
+		case ConfigGroupServiceDetachConfigGroupProcedure:
+			configGroupServiceDetachConfigGroupHandler.ServeHTTP(w, r)
+		case ConfigGroupServiceGetConfigRolloutProcedure:
+			configGroupServiceGetConfigRolloutHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedConfigGroupServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedConfigGroupServiceHandler struct{}
+
+func (UnimplementedConfigGroupServiceHandler) CreateConfigGroup(context.Context, *connect.Request[v1.CreateConfigGroupRequest]) (*connect.Response[v1.CreateConfigGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("configgroup.v1.ConfigGroupService.CreateConfigGroup is not implemented"))
+}
+
+func (UnimplementedConfigGroupServiceHandler) GetConfigGroup(context.Context, *connect.Request[v1.GetConfigGroupRequest]) (*connect.Response[v1.GetConfigGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("configgroup.v1.ConfigGroupService.GetConfigGroup is not implemented"))
+}
+
+func (UnimplementedConfigGroupServiceHandler) ListConfigGroups(context.Context, *connect.Request[v1.ListConfigGroupsRequest]) (*connect.Response[v1.ListConfigGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("configgroup.v1.ConfigGroupService.ListConfigGroups is not implemented"))
+}
+
+func (UnimplementedConfigGroupServiceHandler) UpdateConfigGroup(context.Context, *connect.Request[v1.UpdateConfigGroupRequest]) (*connect.Response[v1.UpdateConfigGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("configgroup.v1.ConfigGroupService.UpdateConfigGroup is not implemented"))
+}
+
+func (UnimplementedConfigGroupServiceHandler) DeleteConfigGroup(context.Context, *connect.Request[v1.DeleteConfigGroupRequest]) (*connect.Response[v1.DeleteConfigGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("configgroup.v1.ConfigGroupService.DeleteConfigGroup is not implemented"))
+}
+
+func (UnimplementedConfigGroupServiceHandler) AttachConfigGroup(context.Context, *connect.Request[v1.AttachConfigGroupRequest]) (*connect.Response[v1.AttachConfigGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("configgroup.v1.ConfigGroupService.AttachConfigGroup is not implemented"))
+}
+
+func (UnimplementedConfigGroupServiceHandler) DetachConfigGroup(context.Context, *connect.Request[v1.DetachConfigGroupRequest]) (*connect.Response[v1.DetachConfigGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("configgroup.v1.ConfigGroupService.DetachConfigGroup is not implemented"))
+}
+
+func (UnimplementedConfigGroupServiceHandler) GetConfigRollout(context.Context, *connect.Request[v1.GetConfigRolloutRequest]) (*connect.Response[v1.GetConfigRolloutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("configgroup.v1.ConfigGroupService.GetConfigRollout is not implemented"))
+}
@@ -7,6 +7,7 @@
 package deploymentv1
 
 import (
+	v1 "github.com/team-loco/loco/shared/proto/policy/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -90,6 +91,212 @@ func (DeploymentPhase) EnumDescriptor() ([]byte, []int) {
 	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{0}
 }
 
+// DeploymentSortOrder is the order deployments are listed in.
+type DeploymentSortOrder int32
+
+const (
+	DeploymentSortOrder_DEPLOYMENT_SORT_ORDER_UNSPECIFIED  DeploymentSortOrder = 0 // newest first
+	DeploymentSortOrder_DEPLOYMENT_SORT_ORDER_NEWEST_FIRST DeploymentSortOrder = 1
+	DeploymentSortOrder_DEPLOYMENT_SORT_ORDER_OLDEST_FIRST DeploymentSortOrder = 2
+)
+
+// Enum value maps for DeploymentSortOrder.
+var (
+	DeploymentSortOrder_name = map[int32]string{
+		0: "DEPLOYMENT_SORT_ORDER_UNSPECIFIED",
+		1: "DEPLOYMENT_SORT_ORDER_NEWEST_FIRST",
+		2: "DEPLOYMENT_SORT_ORDER_OLDEST_FIRST",
+	}
+	DeploymentSortOrder_value = map[string]int32{
+		"DEPLOYMENT_SORT_ORDER_UNSPECIFIED":  0,
+		"DEPLOYMENT_SORT_ORDER_NEWEST_FIRST": 1,
+		"DEPLOYMENT_SORT_ORDER_OLDEST_FIRST": 2,
+	}
+)
+
+func (x DeploymentSortOrder) Enum() *DeploymentSortOrder {
+	p := new(DeploymentSortOrder)
+	*p = x
+	return p
+}
+
+func (x DeploymentSortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeploymentSortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_deployment_v1_deployment_proto_enumTypes[1].Descriptor()
+}
+
+func (DeploymentSortOrder) Type() protoreflect.EnumType {
+	return &file_deployment_v1_deployment_proto_enumTypes[1]
+}
+
+func (x DeploymentSortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeploymentSortOrder.Descriptor instead.
+func (DeploymentSortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{1}
+}
+
+// DiscrepancyKind is how a resource's Application differs from its active deployment.
+type DiscrepancyKind int32
+
+const (
+	DiscrepancyKind_DISCREPANCY_KIND_UNSPECIFIED DiscrepancyKind = 0
+	// the active deployment has no Application
+	DiscrepancyKind_DISCREPANCY_KIND_MISSING DiscrepancyKind = 1
+	// the Application runs another image or region than the active deployment
+	DiscrepancyKind_DISCREPANCY_KIND_STALE DiscrepancyKind = 2
+	// the Application has no active deployment; it is reported but never deleted
+	DiscrepancyKind_DISCREPANCY_KIND_ORPHANED DiscrepancyKind = 3
+)
+
+// Enum value maps for DiscrepancyKind.
+var (
+	DiscrepancyKind_name = map[int32]string{
+		0: "DISCREPANCY_KIND_UNSPECIFIED",
+		1: "DISCREPANCY_KIND_MISSING",
+		2: "DISCREPANCY_KIND_STALE",
+		3: "DISCREPANCY_KIND_ORPHANED",
+	}
+	DiscrepancyKind_value = map[string]int32{
+		"DISCREPANCY_KIND_UNSPECIFIED": 0,
+		"DISCREPANCY_KIND_MISSING":     1,
+		"DISCREPANCY_KIND_STALE":       2,
+		"DISCREPANCY_KIND_ORPHANED":    3,
+	}
+)
+
+func (x DiscrepancyKind) Enum() *DiscrepancyKind {
+	p := new(DiscrepancyKind)
+	*p = x
+	return p
+}
+
+func (x DiscrepancyKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscrepancyKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_deployment_v1_deployment_proto_enumTypes[2].Descriptor()
+}
+
+func (DiscrepancyKind) Type() protoreflect.EnumType {
+	return &file_deployment_v1_deployment_proto_enumTypes[2]
+}
+
+func (x DiscrepancyKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscrepancyKind.Descriptor instead.
+func (DiscrepancyKind) EnumDescriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{2}
+}
+
+// HookPhase is when a deployment hook runs relative to the rollout.
+type HookPhase int32
+
+const (
+	HookPhase_HOOK_PHASE_UNSPECIFIED HookPhase = 0
+	HookPhase_HOOK_PHASE_PRE_DEPLOY  HookPhase = 1 // before the new version rolls out, e.g. a database migration
+	HookPhase_HOOK_PHASE_POST_DEPLOY HookPhase = 2 // once the new version is ready, e.g. a smoke test
+)
+
+// Enum value maps for HookPhase.
+var (
+	HookPhase_name = map[int32]string{
+		0: "HOOK_PHASE_UNSPECIFIED",
+		1: "HOOK_PHASE_PRE_DEPLOY",
+		2: "HOOK_PHASE_POST_DEPLOY",
+	}
+	HookPhase_value = map[string]int32{
+		"HOOK_PHASE_UNSPECIFIED": 0,
+		"HOOK_PHASE_PRE_DEPLOY":  1,
+		"HOOK_PHASE_POST_DEPLOY": 2,
+	}
+)
+
+func (x HookPhase) Enum() *HookPhase {
+	p := new(HookPhase)
+	*p = x
+	return p
+}
+
+func (x HookPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HookPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_deployment_v1_deployment_proto_enumTypes[3].Descriptor()
+}
+
+func (HookPhase) Type() protoreflect.EnumType {
+	return &file_deployment_v1_deployment_proto_enumTypes[3]
+}
+
+func (x HookPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HookPhase.Descriptor instead.
+func (HookPhase) EnumDescriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{3}
+}
+
+// ProvenanceSource is where a deployment's commit was learned from.
+type ProvenanceSource int32
+
+const (
+	ProvenanceSource_PROVENANCE_SOURCE_UNSPECIFIED  ProvenanceSource = 0 // not known (yet); the image labels are read after the deployment is created
+	ProvenanceSource_PROVENANCE_SOURCE_IMAGE_LABELS ProvenanceSource = 1
+	ProvenanceSource_PROVENANCE_SOURCE_ANNOTATION   ProvenanceSource = 2
+)
+
+// Enum value maps for ProvenanceSource.
+var (
+	ProvenanceSource_name = map[int32]string{
+		0: "PROVENANCE_SOURCE_UNSPECIFIED",
+		1: "PROVENANCE_SOURCE_IMAGE_LABELS",
+		2: "PROVENANCE_SOURCE_ANNOTATION",
+	}
+	ProvenanceSource_value = map[string]int32{
+		"PROVENANCE_SOURCE_UNSPECIFIED":  0,
+		"PROVENANCE_SOURCE_IMAGE_LABELS": 1,
+		"PROVENANCE_SOURCE_ANNOTATION":   2,
+	}
+)
+
+func (x ProvenanceSource) Enum() *ProvenanceSource {
+	p := new(ProvenanceSource)
+	*p = x
+	return p
+}
+
+func (x ProvenanceSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProvenanceSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_deployment_v1_deployment_proto_enumTypes[4].Descriptor()
+}
+
+func (ProvenanceSource) Type() protoreflect.EnumType {
+	return &file_deployment_v1_deployment_proto_enumTypes[4]
+}
+
+func (x ProvenanceSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProvenanceSource.Descriptor instead.
+func (ProvenanceSource) EnumDescriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{4}
+}
+
 // Port defines a network port configuration.
 type Port struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -275,12 +482,17 @@ func (x *HealthCheckConfig) GetFailureThreshold() int32 {
 
 // Scalers defines autoscaling configuration.
 type Scalers struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                     // enable autoscaling
-	CpuTarget     *int32                 `protobuf:"varint,2,opt,name=cpu_target,json=cpuTarget,proto3,oneof" json:"cpu_target,omitempty"`          // target CPU percentage (0-100)
-	MemoryTarget  *int32                 `protobuf:"varint,3,opt,name=memory_target,json=memoryTarget,proto3,oneof" json:"memory_target,omitempty"` // target memory percentage (0-100)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Enabled         bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                              // enable autoscaling
+	CpuTarget       *int32                 `protobuf:"varint,2,opt,name=cpu_target,json=cpuTarget,proto3,oneof" json:"cpu_target,omitempty"`                   // target CPU percentage (0-100)
+	MemoryTarget    *int32                 `protobuf:"varint,3,opt,name=memory_target,json=memoryTarget,proto3,oneof" json:"memory_target,omitempty"`          // target memory percentage (0-100)
+	Triggers        []*ScaleTrigger        `protobuf:"bytes,4,rep,name=triggers,proto3" json:"triggers,omitempty"`                                             // external metric triggers (KEDA)
+	Schedules       []*CronSchedule        `protobuf:"bytes,5,rep,name=schedules,proto3" json:"schedules,omitempty"`                                           // replica counts by time of day (KEDA cron)
+	PollingInterval *int32                 `protobuf:"varint,6,opt,name=polling_interval,json=pollingInterval,proto3,oneof" json:"polling_interval,omitempty"` // seconds between trigger checks; KEDA defaults to 30
+	CooldownPeriod  *int32                 `protobuf:"varint,7,opt,name=cooldown_period,json=cooldownPeriod,proto3,oneof" json:"cooldown_period,omitempty"`    // seconds after the last active trigger before scaling to min; KEDA defaults to 300
+	IdleAtZero      bool                   `protobuf:"varint,8,opt,name=idle_at_zero,json=idleAtZero,proto3" json:"idle_at_zero,omitempty"`                    // run no replicas while every trigger is inactive, e.g. an empty queue
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Scalers) Reset() {
@@ -334,19 +546,187 @@ func (x *Scalers) GetMemoryTarget() int32 {
 	return 0
 }
 
+func (x *Scalers) GetTriggers() []*ScaleTrigger {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+func (x *Scalers) GetSchedules() []*CronSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *Scalers) GetPollingInterval() int32 {
+	if x != nil && x.PollingInterval != nil {
+		return *x.PollingInterval
+	}
+	return 0
+}
+
+func (x *Scalers) GetCooldownPeriod() int32 {
+	if x != nil && x.CooldownPeriod != nil {
+		return *x.CooldownPeriod
+	}
+	return 0
+}
+
+func (x *Scalers) GetIdleAtZero() bool {
+	if x != nil {
+		return x.IdleAtZero
+	}
+	return false
+}
+
+// CronSchedule keeps a number of replicas running between two points of a cron schedule, e.g.
+// office hours, on top of whatever the other triggers ask for.
+type CronSchedule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Timezone        string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA name, e.g. "Europe/Berlin"
+	Start           string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`       // cron expression, e.g. "0 8 * * 1-5"
+	End             string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`           // cron expression, e.g. "0 18 * * 1-5"
+	DesiredReplicas int32                  `protobuf:"varint,4,opt,name=desired_replicas,json=desiredReplicas,proto3" json:"desired_replicas,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CronSchedule) Reset() {
+	*x = CronSchedule{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CronSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CronSchedule) ProtoMessage() {}
+
+func (x *CronSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CronSchedule.ProtoReflect.Descriptor instead.
+func (*CronSchedule) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CronSchedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CronSchedule) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *CronSchedule) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *CronSchedule) GetDesiredReplicas() int32 {
+	if x != nil {
+		return x.DesiredReplicas
+	}
+	return 0
+}
+
+// ScaleTrigger defines an external metric that drives autoscaling through KEDA.
+type ScaleTrigger struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Type            string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                                                                                   // KEDA scaler type the platform supports: "rabbitmq" or "kubernetes-workload"
+	Metadata        map[string]string      `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // scaler-specific metadata passed to KEDA, limited per type to keys naming no address or credential
+	QueueResourceId *int64                 `protobuf:"varint,3,opt,name=queue_resource_id,json=queueResourceId,proto3,oneof" json:"queue_resource_id,omitempty"`                             // linked QUEUE resource in the same workspace
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScaleTrigger) Reset() {
+	*x = ScaleTrigger{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleTrigger) ProtoMessage() {}
+
+func (x *ScaleTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleTrigger.ProtoReflect.Descriptor instead.
+func (*ScaleTrigger) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ScaleTrigger) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ScaleTrigger) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ScaleTrigger) GetQueueResourceId() int64 {
+	if x != nil && x.QueueResourceId != nil {
+		return *x.QueueResourceId
+	}
+	return 0
+}
+
 // BuildSource defines where the code comes from.
 type BuildSource struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Type           string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`   // "dockerfile", "buildpack", "image"
-	Image          string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"` // final image or pre-built
-	DockerfilePath *string                `protobuf:"bytes,3,opt,name=dockerfile_path,json=dockerfilePath,proto3,oneof" json:"dockerfile_path,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Type              string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`   // "dockerfile", "buildpack", "image"
+	Image             string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"` // final image or pre-built
+	DockerfilePath    *string                `protobuf:"bytes,3,opt,name=dockerfile_path,json=dockerfilePath,proto3,oneof" json:"dockerfile_path,omitempty"`
+	ImageDigest       *string                `protobuf:"bytes,4,opt,name=image_digest,json=imageDigest,proto3,oneof" json:"image_digest,omitempty"`             // digest image resolved to when deployed, which pods run; set by loco, ignored in requests
+	SignaturePolicies []*v1.RequireSignature `protobuf:"bytes,5,rep,name=signature_policies,json=signaturePolicies,proto3" json:"signature_policies,omitempty"` // signatures the image must carry, from the workspace's policies; set by loco, ignored in requests
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *BuildSource) Reset() {
 	*x = BuildSource{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[4]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -358,7 +738,7 @@ func (x *BuildSource) String() string {
 func (*BuildSource) ProtoMessage() {}
 
 func (x *BuildSource) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[4]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +751,7 @@ func (x *BuildSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildSource.ProtoReflect.Descriptor instead.
 func (*BuildSource) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{4}
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BuildSource) GetType() string {
@@ -395,25 +775,42 @@ func (x *BuildSource) GetDockerfilePath() string {
 	return ""
 }
 
+func (x *BuildSource) GetImageDigest() string {
+	if x != nil && x.ImageDigest != nil {
+		return *x.ImageDigest
+	}
+	return ""
+}
+
+func (x *BuildSource) GetSignaturePolicies() []*v1.RequireSignature {
+	if x != nil {
+		return x.SignaturePolicies
+	}
+	return nil
+}
+
 // ServiceDeploymentSpec is the deployment specification for SERVICE type resources.
 type ServiceDeploymentSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Build         *BuildSource           `protobuf:"bytes,1,opt,name=build,proto3" json:"build,omitempty"`
-	HealthCheck   *HealthCheckConfig     `protobuf:"bytes,2,opt,name=health_check,json=healthCheck,proto3,oneof" json:"health_check,omitempty"`
-	Cpu           *string                `protobuf:"bytes,3,opt,name=cpu,proto3,oneof" json:"cpu,omitempty"`                                     // e.g., "100m" (defaults from resource if omitted)
-	Memory        *string                `protobuf:"bytes,4,opt,name=memory,proto3,oneof" json:"memory,omitempty"`                               // e.g., "256Mi" (defaults from resource if omitted)
-	MinReplicas   *int32                 `protobuf:"varint,5,opt,name=min_replicas,json=minReplicas,proto3,oneof" json:"min_replicas,omitempty"` // defaults from resource if omitted
-	MaxReplicas   *int32                 `protobuf:"varint,6,opt,name=max_replicas,json=maxReplicas,proto3,oneof" json:"max_replicas,omitempty"` // defaults from resource if omitted
-	Scalers       *Scalers               `protobuf:"bytes,7,opt,name=scalers,proto3,oneof" json:"scalers,omitempty"`                             // autoscaling config (defaults from resource if omitted)
-	Env           map[string]string      `protobuf:"bytes,8,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Port          int32                  `protobuf:"varint,9,opt,name=port,proto3" json:"port,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Build          *BuildSource           `protobuf:"bytes,1,opt,name=build,proto3" json:"build,omitempty"`
+	HealthCheck    *HealthCheckConfig     `protobuf:"bytes,2,opt,name=health_check,json=healthCheck,proto3,oneof" json:"health_check,omitempty"`
+	Cpu            *string                `protobuf:"bytes,3,opt,name=cpu,proto3,oneof" json:"cpu,omitempty"`                                     // e.g., "100m" (defaults from resource if omitted)
+	Memory         *string                `protobuf:"bytes,4,opt,name=memory,proto3,oneof" json:"memory,omitempty"`                               // e.g., "256Mi" (defaults from resource if omitted)
+	MinReplicas    *int32                 `protobuf:"varint,5,opt,name=min_replicas,json=minReplicas,proto3,oneof" json:"min_replicas,omitempty"` // defaults from resource if omitted
+	MaxReplicas    *int32                 `protobuf:"varint,6,opt,name=max_replicas,json=maxReplicas,proto3,oneof" json:"max_replicas,omitempty"` // defaults from resource if omitted
+	Scalers        *Scalers               `protobuf:"bytes,7,opt,name=scalers,proto3,oneof" json:"scalers,omitempty"`                             // autoscaling config (defaults from resource if omitted)
+	Env            map[string]string      `protobuf:"bytes,8,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Port           int32                  `protobuf:"varint,9,opt,name=port,proto3" json:"port,omitempty"`
+	Hooks          []*DeploymentHook      `protobuf:"bytes,10,rep,name=hooks,proto3" json:"hooks,omitempty"`                                         // run in order within each phase
+	InitContainers []*Container           `protobuf:"bytes,11,rep,name=init_containers,json=initContainers,proto3" json:"init_containers,omitempty"` // run in order to completion before the service starts
+	Sidecars       []*Container           `protobuf:"bytes,12,rep,name=sidecars,proto3" json:"sidecars,omitempty"`                                   // run alongside the service, started before it and its init containers
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ServiceDeploymentSpec) Reset() {
 	*x = ServiceDeploymentSpec{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[5]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -425,7 +822,7 @@ func (x *ServiceDeploymentSpec) String() string {
 func (*ServiceDeploymentSpec) ProtoMessage() {}
 
 func (x *ServiceDeploymentSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[5]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,7 +835,7 @@ func (x *ServiceDeploymentSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceDeploymentSpec.ProtoReflect.Descriptor instead.
 func (*ServiceDeploymentSpec) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{5}
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ServiceDeploymentSpec) GetBuild() *BuildSource {
@@ -504,17 +901,219 @@ func (x *ServiceDeploymentSpec) GetPort() int32 {
 	return 0
 }
 
-// DatabaseDeploymentSpec is a placeholder for DATABASE type deployments (future implementation).
-type DatabaseDeploymentSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ServiceDeploymentSpec) GetHooks() []*DeploymentHook {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
 }
 
-func (x *DatabaseDeploymentSpec) Reset() {
-	*x = DatabaseDeploymentSpec{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+func (x *ServiceDeploymentSpec) GetInitContainers() []*Container {
+	if x != nil {
+		return x.InitContainers
+	}
+	return nil
+}
+
+func (x *ServiceDeploymentSpec) GetSidecars() []*Container {
+	if x != nil {
+		return x.Sidecars
+	}
+	return nil
+}
+
+// Container is an extra container in the service's pods, e.g. a migration run before the service
+// starts or a proxy running next to it.
+type Container struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`       // lowercase letters, digits and dashes
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`     // defaults to the deployment's image
+	Command       []string               `protobuf:"bytes,3,rep,name=command,proto3" json:"command,omitempty"` // defaults to the image's entrypoint
+	Args          []string               `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	Env           map[string]string      `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // stored with the deployment, so reference secrets as secret://<store>/<key>
+	InheritEnv    bool                   `protobuf:"varint,6,opt,name=inherit_env,json=inheritEnv,proto3" json:"inherit_env,omitempty"`                                          // also gets the deployment's env vars, under its own
+	Cpu           string                 `protobuf:"bytes,7,opt,name=cpu,proto3" json:"cpu,omitempty"`                                                                           // defaults to 100m
+	Memory        string                 `protobuf:"bytes,8,opt,name=memory,proto3" json:"memory,omitempty"`                                                                     // defaults to 64Mi
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Container) Reset() {
+	*x = Container{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Container) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Container) ProtoMessage() {}
+
+func (x *Container) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Container.ProtoReflect.Descriptor instead.
+func (*Container) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Container) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Container) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Container) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *Container) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Container) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *Container) GetInheritEnv() bool {
+	if x != nil {
+		return x.InheritEnv
+	}
+	return false
+}
+
+func (x *Container) GetCpu() string {
+	if x != nil {
+		return x.Cpu
+	}
+	return ""
+}
+
+func (x *Container) GetMemory() string {
+	if x != nil {
+		return x.Memory
+	}
+	return ""
+}
+
+// DeploymentHook is a command run to completion as a Kubernetes Job around a rollout, with the
+// deployment's env vars. A failing pre-deploy hook stops the rollout; a failing post-deploy hook
+// fails the deployment.
+type DeploymentHook struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // lowercase letters, digits and dashes
+	Phase          HookPhase              `protobuf:"varint,2,opt,name=phase,proto3,enum=deployment.v1.HookPhase" json:"phase,omitempty"`
+	Command        []string               `protobuf:"bytes,3,rep,name=command,proto3" json:"command,omitempty"`
+	Image          *string                `protobuf:"bytes,4,opt,name=image,proto3,oneof" json:"image,omitempty"`                                    // defaults to the deployment's image
+	TimeoutSeconds int32                  `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // defaults to 600
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeploymentHook) Reset() {
+	*x = DeploymentHook{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeploymentHook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentHook) ProtoMessage() {}
+
+func (x *DeploymentHook) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentHook.ProtoReflect.Descriptor instead.
+func (*DeploymentHook) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeploymentHook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeploymentHook) GetPhase() HookPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return HookPhase_HOOK_PHASE_UNSPECIFIED
+}
+
+func (x *DeploymentHook) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *DeploymentHook) GetImage() string {
+	if x != nil && x.Image != nil {
+		return *x.Image
+	}
+	return ""
+}
+
+func (x *DeploymentHook) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+// DatabaseDeploymentSpec is a placeholder for DATABASE type deployments (future implementation).
+type DatabaseDeploymentSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseDeploymentSpec) Reset() {
+	*x = DatabaseDeploymentSpec{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
@@ -525,7 +1124,7 @@ func (x *DatabaseDeploymentSpec) String() string {
 func (*DatabaseDeploymentSpec) ProtoMessage() {}
 
 func (x *DatabaseDeploymentSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[6]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,7 +1137,7 @@ func (x *DatabaseDeploymentSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatabaseDeploymentSpec.ProtoReflect.Descriptor instead.
 func (*DatabaseDeploymentSpec) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{6}
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{10}
 }
 
 // CacheDeploymentSpec is a placeholder for CACHE type deployments (future implementation).
@@ -550,7 +1149,7 @@ type CacheDeploymentSpec struct {
 
 func (x *CacheDeploymentSpec) Reset() {
 	*x = CacheDeploymentSpec{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[7]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -562,7 +1161,7 @@ func (x *CacheDeploymentSpec) String() string {
 func (*CacheDeploymentSpec) ProtoMessage() {}
 
 func (x *CacheDeploymentSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[7]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,7 +1174,7 @@ func (x *CacheDeploymentSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CacheDeploymentSpec.ProtoReflect.Descriptor instead.
 func (*CacheDeploymentSpec) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{7}
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{11}
 }
 
 // QueueDeploymentSpec is a placeholder for QUEUE type deployments (future implementation).
@@ -587,7 +1186,7 @@ type QueueDeploymentSpec struct {
 
 func (x *QueueDeploymentSpec) Reset() {
 	*x = QueueDeploymentSpec{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[8]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -599,7 +1198,7 @@ func (x *QueueDeploymentSpec) String() string {
 func (*QueueDeploymentSpec) ProtoMessage() {}
 
 func (x *QueueDeploymentSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[8]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,7 +1211,7 @@ func (x *QueueDeploymentSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueDeploymentSpec.ProtoReflect.Descriptor instead.
 func (*QueueDeploymentSpec) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{8}
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{12}
 }
 
 // DeploymentSpec is the immutable runtime snapshot for a deployment.
@@ -632,7 +1231,7 @@ type DeploymentSpec struct {
 
 func (x *DeploymentSpec) Reset() {
 	*x = DeploymentSpec{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[9]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -644,7 +1243,7 @@ func (x *DeploymentSpec) String() string {
 func (*DeploymentSpec) ProtoMessage() {}
 
 func (x *DeploymentSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[9]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -657,7 +1256,7 @@ func (x *DeploymentSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeploymentSpec.ProtoReflect.Descriptor instead.
 func (*DeploymentSpec) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{9}
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeploymentSpec) GetSpec() isDeploymentSpec_Spec {
@@ -748,13 +1347,16 @@ type Deployment struct {
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	SpecVersion   int32                  `protobuf:"varint,13,opt,name=spec_version,json=specVersion,proto3" json:"spec_version,omitempty"`
 	Spec          *DeploymentSpec        `protobuf:"bytes,14,opt,name=spec,proto3" json:"spec,omitempty"`
+	Version       int64                  `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"` // goes up when it is scaled, respecified or superseded
+	Annotation    *DeploymentAnnotation  `protobuf:"bytes,16,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	CreatedBy     *int64                 `protobuf:"varint,17,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"` // user who deployed; unset for the platform's own deployments
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Deployment) Reset() {
 	*x = Deployment{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[10]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -766,7 +1368,7 @@ func (x *Deployment) String() string {
 func (*Deployment) ProtoMessage() {}
 
 func (x *Deployment) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[10]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,7 +1381,7 @@ func (x *Deployment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Deployment.ProtoReflect.Descriptor instead.
 func (*Deployment) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{10}
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Deployment) GetId() int64 {
@@ -880,6 +1482,88 @@ func (x *Deployment) GetSpec() *DeploymentSpec {
 	return nil
 }
 
+func (x *Deployment) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Deployment) GetAnnotation() *DeploymentAnnotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
+func (x *Deployment) GetCreatedBy() int64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+// DeploymentAnnotation records what a deployment shipped and who shipped it. All fields are optional.
+type DeploymentAnnotation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // release notes, e.g. "fix checkout rounding"
+	Author        string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	GitSha        string                 `protobuf:"bytes,3,opt,name=git_sha,json=gitSha,proto3" json:"git_sha,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeploymentAnnotation) Reset() {
+	*x = DeploymentAnnotation{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeploymentAnnotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentAnnotation) ProtoMessage() {}
+
+func (x *DeploymentAnnotation) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentAnnotation.ProtoReflect.Descriptor instead.
+func (*DeploymentAnnotation) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeploymentAnnotation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeploymentAnnotation) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *DeploymentAnnotation) GetGitSha() string {
+	if x != nil {
+		return x.GitSha
+	}
+	return ""
+}
+
 // CreateDeploymentRequest is the request to create a new deployment.
 type CreateDeploymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -887,13 +1571,14 @@ type CreateDeploymentRequest struct {
 	ClusterId     int64                  `protobuf:"varint,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
 	Spec          *DeploymentSpec        `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	Annotation    *DeploymentAnnotation  `protobuf:"bytes,5,opt,name=annotation,proto3" json:"annotation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateDeploymentRequest) Reset() {
 	*x = CreateDeploymentRequest{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[11]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -905,7 +1590,7 @@ func (x *CreateDeploymentRequest) String() string {
 func (*CreateDeploymentRequest) ProtoMessage() {}
 
 func (x *CreateDeploymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[11]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,7 +1603,7 @@ func (x *CreateDeploymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDeploymentRequest.ProtoReflect.Descriptor instead.
 func (*CreateDeploymentRequest) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{11}
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateDeploymentRequest) GetResourceId() int64 {
@@ -949,6 +1634,13 @@ func (x *CreateDeploymentRequest) GetSpec() *DeploymentSpec {
 	return nil
 }
 
+func (x *CreateDeploymentRequest) GetAnnotation() *DeploymentAnnotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
 // CreateDeploymentResponse is the response containing the created deployment ID.
 type CreateDeploymentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -959,7 +1651,7 @@ type CreateDeploymentResponse struct {
 
 func (x *CreateDeploymentResponse) Reset() {
 	*x = CreateDeploymentResponse{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[12]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -971,7 +1663,7 @@ func (x *CreateDeploymentResponse) String() string {
 func (*CreateDeploymentResponse) ProtoMessage() {}
 
 func (x *CreateDeploymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[12]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,7 +1676,7 @@ func (x *CreateDeploymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDeploymentResponse.ProtoReflect.Descriptor instead.
 func (*CreateDeploymentResponse) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{12}
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CreateDeploymentResponse) GetDeploymentId() int64 {
@@ -1004,7 +1696,7 @@ type GetDeploymentRequest struct {
 
 func (x *GetDeploymentRequest) Reset() {
 	*x = GetDeploymentRequest{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[13]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1016,7 +1708,7 @@ func (x *GetDeploymentRequest) String() string {
 func (*GetDeploymentRequest) ProtoMessage() {}
 
 func (x *GetDeploymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[13]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,7 +1721,7 @@ func (x *GetDeploymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeploymentRequest.ProtoReflect.Descriptor instead.
 func (*GetDeploymentRequest) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{13}
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetDeploymentRequest) GetDeploymentId() int64 {
@@ -1049,7 +1741,7 @@ type GetDeploymentResponse struct {
 
 func (x *GetDeploymentResponse) Reset() {
 	*x = GetDeploymentResponse{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[14]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1061,7 +1753,7 @@ func (x *GetDeploymentResponse) String() string {
 func (*GetDeploymentResponse) ProtoMessage() {}
 
 func (x *GetDeploymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[14]
+	mi := &file_deployment_v1_deployment_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1074,41 +1766,1012 @@ func (x *GetDeploymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeploymentResponse.ProtoReflect.Descriptor instead.
 func (*GetDeploymentResponse) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{14}
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetDeploymentResponse) GetDeployment() *Deployment {
+	if x != nil {
+		return x.Deployment
+	}
+	return nil
+}
+
+// ListDeploymentsRequest is the request to list deployments.
+type ListDeploymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                           // default: 50, max: 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                         // cursor from previous page (base64-encoded timestamp+id)
+	Statuses      []DeploymentPhase      `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=deployment.v1.DeploymentPhase" json:"statuses,omitempty"` // empty lists every status
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`          // inclusive
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"`       // exclusive
+	CreatedBy     *int64                 `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                  // user id
+	ActiveOnly    bool                   `protobuf:"varint,8,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	SortOrder     DeploymentSortOrder    `protobuf:"varint,9,opt,name=sort_order,json=sortOrder,proto3,enum=deployment.v1.DeploymentSortOrder" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeploymentsRequest) Reset() {
+	*x = ListDeploymentsRequest{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeploymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentsRequest) ProtoMessage() {}
+
+func (x *ListDeploymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeploymentsRequest) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListDeploymentsRequest) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *ListDeploymentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeploymentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDeploymentsRequest) GetStatuses() []DeploymentPhase {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListDeploymentsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListDeploymentsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListDeploymentsRequest) GetCreatedBy() int64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *ListDeploymentsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListDeploymentsRequest) GetSortOrder() DeploymentSortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return DeploymentSortOrder_DEPLOYMENT_SORT_ORDER_UNSPECIFIED
+}
+
+// PromoteDeploymentRequest is the request to promote a deployment, e.g. from staging to prod.
+type PromoteDeploymentRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	DeploymentId        int64                  `protobuf:"varint,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	TargetEnvironmentId int64                  `protobuf:"varint,2,opt,name=target_environment_id,json=targetEnvironmentId,proto3" json:"target_environment_id,omitempty"`
+	Region              *string                `protobuf:"bytes,3,opt,name=region,proto3,oneof" json:"region,omitempty"` // defaults to the target resource's primary region
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PromoteDeploymentRequest) Reset() {
+	*x = PromoteDeploymentRequest{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteDeploymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteDeploymentRequest) ProtoMessage() {}
+
+func (x *PromoteDeploymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*PromoteDeploymentRequest) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PromoteDeploymentRequest) GetDeploymentId() int64 {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return 0
+}
+
+func (x *PromoteDeploymentRequest) GetTargetEnvironmentId() int64 {
+	if x != nil {
+		return x.TargetEnvironmentId
+	}
+	return 0
+}
+
+func (x *PromoteDeploymentRequest) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return ""
+}
+
+// PromoteDeploymentResponse is the response containing the deployment created in the target environment.
+type PromoteDeploymentResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DeploymentId     int64                  `protobuf:"varint,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	TargetResourceId int64                  `protobuf:"varint,2,opt,name=target_resource_id,json=targetResourceId,proto3" json:"target_resource_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PromoteDeploymentResponse) Reset() {
+	*x = PromoteDeploymentResponse{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteDeploymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteDeploymentResponse) ProtoMessage() {}
+
+func (x *PromoteDeploymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteDeploymentResponse.ProtoReflect.Descriptor instead.
+func (*PromoteDeploymentResponse) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PromoteDeploymentResponse) GetDeploymentId() int64 {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return 0
+}
+
+func (x *PromoteDeploymentResponse) GetTargetResourceId() int64 {
+	if x != nil {
+		return x.TargetResourceId
+	}
+	return 0
+}
+
+// ListDeploymentsResponse is the response containing deployment list.
+type ListDeploymentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deployments   []*Deployment          `protobuf:"bytes,1,rep,name=deployments,proto3" json:"deployments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty if no more pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeploymentsResponse) Reset() {
+	*x = ListDeploymentsResponse{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeploymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentsResponse) ProtoMessage() {}
+
+func (x *ListDeploymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeploymentsResponse) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListDeploymentsResponse) GetDeployments() []*Deployment {
+	if x != nil {
+		return x.Deployments
+	}
+	return nil
+}
+
+func (x *ListDeploymentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// SpecChange is a single field that differs between two consecutive deployment specs.
+type SpecChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`                               // dotted field path, e.g. "scalers.cpuTarget"
+	OldValue      *string                `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3,oneof" json:"old_value,omitempty"` // JSON-encoded; unset when the field was added
+	NewValue      *string                `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3,oneof" json:"new_value,omitempty"` // JSON-encoded; unset when the field was removed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpecChange) Reset() {
+	*x = SpecChange{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpecChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpecChange) ProtoMessage() {}
+
+func (x *SpecChange) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpecChange.ProtoReflect.Descriptor instead.
+func (*SpecChange) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SpecChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SpecChange) GetOldValue() string {
+	if x != nil && x.OldValue != nil {
+		return *x.OldValue
+	}
+	return ""
+}
+
+func (x *SpecChange) GetNewValue() string {
+	if x != nil && x.NewValue != nil {
+		return *x.NewValue
+	}
+	return ""
+}
+
+// DeploymentHistoryEntry is a deployment together with how its spec differs from the previous deployment in the same region.
+type DeploymentHistoryEntry struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Deployment           *Deployment            `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	PreviousDeploymentId *int64                 `protobuf:"varint,2,opt,name=previous_deployment_id,json=previousDeploymentId,proto3,oneof" json:"previous_deployment_id,omitempty"` // unset for the first deployment in a region
+	Changes              []*SpecChange          `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DeploymentHistoryEntry) Reset() {
+	*x = DeploymentHistoryEntry{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeploymentHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentHistoryEntry) ProtoMessage() {}
+
+func (x *DeploymentHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentHistoryEntry.ProtoReflect.Descriptor instead.
+func (*DeploymentHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeploymentHistoryEntry) GetDeployment() *Deployment {
+	if x != nil {
+		return x.Deployment
+	}
+	return nil
+}
+
+func (x *DeploymentHistoryEntry) GetPreviousDeploymentId() int64 {
+	if x != nil && x.PreviousDeploymentId != nil {
+		return *x.PreviousDeploymentId
+	}
+	return 0
+}
+
+func (x *DeploymentHistoryEntry) GetChanges() []*SpecChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// ListDeploymentHistoryRequest is the request to list deployment history.
+type ListDeploymentHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // default: 50, max: 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // cursor from previous page (base64-encoded timestamp+id)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeploymentHistoryRequest) Reset() {
+	*x = ListDeploymentHistoryRequest{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeploymentHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentHistoryRequest) ProtoMessage() {}
+
+func (x *ListDeploymentHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListDeploymentHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListDeploymentHistoryRequest) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *ListDeploymentHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeploymentHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListDeploymentHistoryResponse is the response containing deployment history, newest first.
+type ListDeploymentHistoryResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Entries       []*DeploymentHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty if no more pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeploymentHistoryResponse) Reset() {
+	*x = ListDeploymentHistoryResponse{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeploymentHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentHistoryResponse) ProtoMessage() {}
+
+func (x *ListDeploymentHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListDeploymentHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListDeploymentHistoryResponse) GetEntries() []*DeploymentHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListDeploymentHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// WatchDeploymentRequest is the request to stream deployment events.
+type WatchDeploymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeploymentId  int64                  `protobuf:"varint,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchDeploymentRequest) Reset() {
+	*x = WatchDeploymentRequest{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDeploymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDeploymentRequest) ProtoMessage() {}
+
+func (x *WatchDeploymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*WatchDeploymentRequest) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WatchDeploymentRequest) GetDeploymentId() int64 {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return 0
+}
+
+// WatchDeploymentResponse represents a deployment event stream response.
+type WatchDeploymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeploymentId  int64                  `protobuf:"varint,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Status        DeploymentPhase        `protobuf:"varint,2,opt,name=status,proto3,enum=deployment.v1.DeploymentPhase" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	HookLog       *HookLog               `protobuf:"bytes,5,opt,name=hook_log,json=hookLog,proto3,oneof" json:"hook_log,omitempty"` // set on events carrying a line of hook output
+	Annotation    *DeploymentAnnotation  `protobuf:"bytes,6,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchDeploymentResponse) Reset() {
+	*x = WatchDeploymentResponse{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDeploymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDeploymentResponse) ProtoMessage() {}
+
+func (x *WatchDeploymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDeploymentResponse.ProtoReflect.Descriptor instead.
+func (*WatchDeploymentResponse) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WatchDeploymentResponse) GetDeploymentId() int64 {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return 0
+}
+
+func (x *WatchDeploymentResponse) GetStatus() DeploymentPhase {
+	if x != nil {
+		return x.Status
+	}
+	return DeploymentPhase_DEPLOYMENT_PHASE_UNSPECIFIED
+}
+
+func (x *WatchDeploymentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WatchDeploymentResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *WatchDeploymentResponse) GetHookLog() *HookLog {
+	if x != nil {
+		return x.HookLog
+	}
+	return nil
+}
+
+func (x *WatchDeploymentResponse) GetAnnotation() *DeploymentAnnotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
+// HookLog is a line written by a deployment hook while it runs.
+type HookLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hook          string                 `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook,omitempty"`
+	Line          string                 `protobuf:"bytes,2,opt,name=line,proto3" json:"line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HookLog) Reset() {
+	*x = HookLog{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HookLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookLog) ProtoMessage() {}
+
+func (x *HookLog) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookLog.ProtoReflect.Descriptor instead.
+func (*HookLog) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *HookLog) GetHook() string {
+	if x != nil {
+		return x.Hook
+	}
+	return ""
+}
+
+func (x *HookLog) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+// DeleteDeploymentRequest is the request to delete/inactivate a deployment.
+type DeleteDeploymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeploymentId  int64                  `protobuf:"varint,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDeploymentRequest) Reset() {
+	*x = DeleteDeploymentRequest{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDeploymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeploymentRequest) ProtoMessage() {}
+
+func (x *DeleteDeploymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDeploymentRequest) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteDeploymentRequest) GetDeploymentId() int64 {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return 0
+}
+
+// DeleteDeploymentResponse is the response after deleting/inactivating a deployment.
+type DeleteDeploymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDeploymentResponse) Reset() {
+	*x = DeleteDeploymentResponse{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDeploymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeploymentResponse) ProtoMessage() {}
+
+func (x *DeleteDeploymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeploymentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDeploymentResponse) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{32}
+}
+
+// ClusterDiscrepancy is a resource whose Application was out of sync on the reconciler's last run.
+type ClusterDiscrepancy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	WorkspaceId   int64                  `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	DeploymentId  *int64                 `protobuf:"varint,3,opt,name=deployment_id,json=deploymentId,proto3,oneof" json:"deployment_id,omitempty"` // unset for orphaned Applications
+	Region        string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Kind          DiscrepancyKind        `protobuf:"varint,5,opt,name=kind,proto3,enum=deployment.v1.DiscrepancyKind" json:"kind,omitempty"`
+	Detail        string                 `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`
+	DetectedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	RepairedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=repaired_at,json=repairedAt,proto3,oneof" json:"repaired_at,omitempty"` // set when the reconciler re-applied the deployment
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterDiscrepancy) Reset() {
+	*x = ClusterDiscrepancy{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterDiscrepancy) ProtoMessage() {}
+
+func (x *ClusterDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterDiscrepancy.ProtoReflect.Descriptor instead.
+func (*ClusterDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ClusterDiscrepancy) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *ClusterDiscrepancy) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *ClusterDiscrepancy) GetDeploymentId() int64 {
+	if x != nil && x.DeploymentId != nil {
+		return *x.DeploymentId
+	}
+	return 0
+}
+
+func (x *ClusterDiscrepancy) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ClusterDiscrepancy) GetKind() DiscrepancyKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DiscrepancyKind_DISCREPANCY_KIND_UNSPECIFIED
+}
+
+func (x *ClusterDiscrepancy) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *ClusterDiscrepancy) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+func (x *ClusterDiscrepancy) GetRepairedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RepairedAt
+	}
+	return nil
+}
+
+// ListClusterDiscrepanciesRequest is the request to list cluster discrepancies.
+type ListClusterDiscrepanciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   *int64                 `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3,oneof" json:"workspace_id,omitempty"` // all workspaces when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClusterDiscrepanciesRequest) Reset() {
+	*x = ListClusterDiscrepanciesRequest{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClusterDiscrepanciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClusterDiscrepanciesRequest) ProtoMessage() {}
+
+func (x *ListClusterDiscrepanciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClusterDiscrepanciesRequest.ProtoReflect.Descriptor instead.
+func (*ListClusterDiscrepanciesRequest) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListClusterDiscrepanciesRequest) GetWorkspaceId() int64 {
+	if x != nil && x.WorkspaceId != nil {
+		return *x.WorkspaceId
+	}
+	return 0
+}
+
+// ListClusterDiscrepanciesResponse is the response containing cluster discrepancies, newest first.
+type ListClusterDiscrepanciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discrepancies []*ClusterDiscrepancy  `protobuf:"bytes,1,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDeploymentResponse) GetDeployment() *Deployment {
+func (x *ListClusterDiscrepanciesResponse) Reset() {
+	*x = ListClusterDiscrepanciesResponse{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClusterDiscrepanciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClusterDiscrepanciesResponse) ProtoMessage() {}
+
+func (x *ListClusterDiscrepanciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[35]
 	if x != nil {
-		return x.Deployment
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClusterDiscrepanciesResponse.ProtoReflect.Descriptor instead.
+func (*ListClusterDiscrepanciesResponse) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListClusterDiscrepanciesResponse) GetDiscrepancies() []*ClusterDiscrepancy {
+	if x != nil {
+		return x.Discrepancies
 	}
 	return nil
 }
 
-// ListDeploymentsRequest is the request to list deployments.
-type ListDeploymentsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // default: 50, max: 200
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // cursor from previous page (base64-encoded timestamp+id)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Vulnerability is a known vulnerability in a package of an image.
+type Vulnerability struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // e.g. CVE-2024-3094
+	Package          string                 `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+	InstalledVersion string                 `protobuf:"bytes,3,opt,name=installed_version,json=installedVersion,proto3" json:"installed_version,omitempty"`
+	FixedVersion     string                 `protobuf:"bytes,4,opt,name=fixed_version,json=fixedVersion,proto3" json:"fixed_version,omitempty"` // empty when there is no fix yet
+	Severity         string                 `protobuf:"bytes,5,opt,name=severity,proto3" json:"severity,omitempty"`                             // CRITICAL, HIGH, MEDIUM, LOW or UNKNOWN
+	Title            string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListDeploymentsRequest) Reset() {
-	*x = ListDeploymentsRequest{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[15]
+func (x *Vulnerability) Reset() {
+	*x = Vulnerability{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDeploymentsRequest) String() string {
+func (x *Vulnerability) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDeploymentsRequest) ProtoMessage() {}
+func (*Vulnerability) ProtoMessage() {}
 
-func (x *ListDeploymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[15]
+func (x *Vulnerability) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,56 +2782,83 @@ func (x *ListDeploymentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDeploymentsRequest.ProtoReflect.Descriptor instead.
-func (*ListDeploymentsRequest) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use Vulnerability.ProtoReflect.Descriptor instead.
+func (*Vulnerability) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ListDeploymentsRequest) GetResourceId() int64 {
+func (x *Vulnerability) GetId() string {
 	if x != nil {
-		return x.ResourceId
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ListDeploymentsRequest) GetPageSize() int32 {
+func (x *Vulnerability) GetPackage() string {
 	if x != nil {
-		return x.PageSize
+		return x.Package
 	}
-	return 0
+	return ""
 }
 
-func (x *ListDeploymentsRequest) GetPageToken() string {
+func (x *Vulnerability) GetInstalledVersion() string {
 	if x != nil {
-		return x.PageToken
+		return x.InstalledVersion
 	}
 	return ""
 }
 
-// ListDeploymentsResponse is the response containing deployment list.
-type ListDeploymentsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deployments   []*Deployment          `protobuf:"bytes,1,rep,name=deployments,proto3" json:"deployments,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty if no more pages
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Vulnerability) GetFixedVersion() string {
+	if x != nil {
+		return x.FixedVersion
+	}
+	return ""
 }
 
-func (x *ListDeploymentsResponse) Reset() {
-	*x = ListDeploymentsResponse{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[16]
+func (x *Vulnerability) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Vulnerability) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// ImageScanReport is the result of scanning an image for vulnerabilities.
+type ImageScanReport struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Image           string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	ScannedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+	Critical        int32                  `protobuf:"varint,3,opt,name=critical,proto3" json:"critical,omitempty"`
+	High            int32                  `protobuf:"varint,4,opt,name=high,proto3" json:"high,omitempty"`
+	Medium          int32                  `protobuf:"varint,5,opt,name=medium,proto3" json:"medium,omitempty"`
+	Low             int32                  `protobuf:"varint,6,opt,name=low,proto3" json:"low,omitempty"`
+	Vulnerabilities []*Vulnerability       `protobuf:"bytes,7,rep,name=vulnerabilities,proto3" json:"vulnerabilities,omitempty"` // most severe first
+	Error           *string                `protobuf:"bytes,8,opt,name=error,proto3,oneof" json:"error,omitempty"`               // set when the scan failed; there are no findings then
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImageScanReport) Reset() {
+	*x = ImageScanReport{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDeploymentsResponse) String() string {
+func (x *ImageScanReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDeploymentsResponse) ProtoMessage() {}
+func (*ImageScanReport) ProtoMessage() {}
 
-func (x *ListDeploymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[16]
+func (x *ImageScanReport) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,48 +2869,90 @@ func (x *ListDeploymentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDeploymentsResponse.ProtoReflect.Descriptor instead.
-func (*ListDeploymentsResponse) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ImageScanReport.ProtoReflect.Descriptor instead.
+func (*ImageScanReport) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ListDeploymentsResponse) GetDeployments() []*Deployment {
+func (x *ImageScanReport) GetImage() string {
 	if x != nil {
-		return x.Deployments
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ImageScanReport) GetScannedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScannedAt
 	}
 	return nil
 }
 
-func (x *ListDeploymentsResponse) GetNextPageToken() string {
+func (x *ImageScanReport) GetCritical() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.Critical
+	}
+	return 0
+}
+
+func (x *ImageScanReport) GetHigh() int32 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *ImageScanReport) GetMedium() int32 {
+	if x != nil {
+		return x.Medium
+	}
+	return 0
+}
+
+func (x *ImageScanReport) GetLow() int32 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *ImageScanReport) GetVulnerabilities() []*Vulnerability {
+	if x != nil {
+		return x.Vulnerabilities
+	}
+	return nil
+}
+
+func (x *ImageScanReport) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
 	return ""
 }
 
-// WatchDeploymentRequest is the request to stream deployment events.
-type WatchDeploymentRequest struct {
+// GetImageScanReportRequest is the request for the scan of a deployment's image.
+type GetImageScanReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DeploymentId  int64                  `protobuf:"varint,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WatchDeploymentRequest) Reset() {
-	*x = WatchDeploymentRequest{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[17]
+func (x *GetImageScanReportRequest) Reset() {
+	*x = GetImageScanReportRequest{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WatchDeploymentRequest) String() string {
+func (x *GetImageScanReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchDeploymentRequest) ProtoMessage() {}
+func (*GetImageScanReportRequest) ProtoMessage() {}
 
-func (x *WatchDeploymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[17]
+func (x *GetImageScanReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,44 +2963,93 @@ func (x *WatchDeploymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchDeploymentRequest.ProtoReflect.Descriptor instead.
-func (*WatchDeploymentRequest) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetImageScanReportRequest.ProtoReflect.Descriptor instead.
+func (*GetImageScanReportRequest) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *WatchDeploymentRequest) GetDeploymentId() int64 {
+func (x *GetImageScanReportRequest) GetDeploymentId() int64 {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return 0
 }
 
-// WatchDeploymentResponse represents a deployment event stream response.
-type WatchDeploymentResponse struct {
+// GetImageScanReportResponse contains the scan report.
+type GetImageScanReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *ImageScanReport       `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImageScanReportResponse) Reset() {
+	*x = GetImageScanReportResponse{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImageScanReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImageScanReportResponse) ProtoMessage() {}
+
+func (x *GetImageScanReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImageScanReportResponse.ProtoReflect.Descriptor instead.
+func (*GetImageScanReportResponse) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetImageScanReportResponse) GetReport() *ImageScanReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// DeploymentProvenance links a deployment to the commit its image was built from.
+type DeploymentProvenance struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DeploymentId  int64                  `protobuf:"varint,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Status        DeploymentPhase        `protobuf:"varint,2,opt,name=status,proto3,enum=deployment.v1.DeploymentPhase" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"` // by digest when it was pinned
+	Source        ProvenanceSource       `protobuf:"varint,3,opt,name=source,proto3,enum=deployment.v1.ProvenanceSource" json:"source,omitempty"`
+	CommitSha     string                 `protobuf:"bytes,4,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	RepositoryUrl string                 `protobuf:"bytes,5,opt,name=repository_url,json=repositoryUrl,proto3" json:"repository_url,omitempty"`
+	CommitUrl     string                 `protobuf:"bytes,6,opt,name=commit_url,json=commitUrl,proto3" json:"commit_url,omitempty"` // link to the commit, for GitHub and GitLab repositories
+	Error         *string                `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`                    // set when the image labels could not be read
+	FetchedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=fetched_at,json=fetchedAt,proto3,oneof" json:"fetched_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WatchDeploymentResponse) Reset() {
-	*x = WatchDeploymentResponse{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[18]
+func (x *DeploymentProvenance) Reset() {
+	*x = DeploymentProvenance{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WatchDeploymentResponse) String() string {
+func (x *DeploymentProvenance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchDeploymentResponse) ProtoMessage() {}
+func (*DeploymentProvenance) ProtoMessage() {}
 
-func (x *WatchDeploymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[18]
+func (x *DeploymentProvenance) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1279,62 +3060,90 @@ func (x *WatchDeploymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchDeploymentResponse.ProtoReflect.Descriptor instead.
-func (*WatchDeploymentResponse) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DeploymentProvenance.ProtoReflect.Descriptor instead.
+func (*DeploymentProvenance) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *WatchDeploymentResponse) GetDeploymentId() int64 {
+func (x *DeploymentProvenance) GetDeploymentId() int64 {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return 0
 }
 
-func (x *WatchDeploymentResponse) GetStatus() DeploymentPhase {
+func (x *DeploymentProvenance) GetImage() string {
 	if x != nil {
-		return x.Status
+		return x.Image
 	}
-	return DeploymentPhase_DEPLOYMENT_PHASE_UNSPECIFIED
+	return ""
 }
 
-func (x *WatchDeploymentResponse) GetMessage() string {
+func (x *DeploymentProvenance) GetSource() ProvenanceSource {
 	if x != nil {
-		return x.Message
+		return x.Source
+	}
+	return ProvenanceSource_PROVENANCE_SOURCE_UNSPECIFIED
+}
+
+func (x *DeploymentProvenance) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
 	}
 	return ""
 }
 
-func (x *WatchDeploymentResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *DeploymentProvenance) GetRepositoryUrl() string {
 	if x != nil {
-		return x.Timestamp
+		return x.RepositoryUrl
+	}
+	return ""
+}
+
+func (x *DeploymentProvenance) GetCommitUrl() string {
+	if x != nil {
+		return x.CommitUrl
+	}
+	return ""
+}
+
+func (x *DeploymentProvenance) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *DeploymentProvenance) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
 	}
 	return nil
 }
 
-// DeleteDeploymentRequest is the request to delete/inactivate a deployment.
-type DeleteDeploymentRequest struct {
+// GetDeploymentProvenanceRequest is the request for a deployment's provenance.
+type GetDeploymentProvenanceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DeploymentId  int64                  `protobuf:"varint,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDeploymentRequest) Reset() {
-	*x = DeleteDeploymentRequest{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[19]
+func (x *GetDeploymentProvenanceRequest) Reset() {
+	*x = GetDeploymentProvenanceRequest{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDeploymentRequest) String() string {
+func (x *GetDeploymentProvenanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDeploymentRequest) ProtoMessage() {}
+func (*GetDeploymentProvenanceRequest) ProtoMessage() {}
 
-func (x *DeleteDeploymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[19]
+func (x *GetDeploymentProvenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,40 +3154,41 @@ func (x *DeleteDeploymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDeploymentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteDeploymentRequest) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetDeploymentProvenanceRequest.ProtoReflect.Descriptor instead.
+func (*GetDeploymentProvenanceRequest) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DeleteDeploymentRequest) GetDeploymentId() int64 {
+func (x *GetDeploymentProvenanceRequest) GetDeploymentId() int64 {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return 0
 }
 
-// DeleteDeploymentResponse is the response after deleting/inactivating a deployment.
-type DeleteDeploymentResponse struct {
+// GetDeploymentProvenanceResponse contains the provenance.
+type GetDeploymentProvenanceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provenance    *DeploymentProvenance  `protobuf:"bytes,1,opt,name=provenance,proto3" json:"provenance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDeploymentResponse) Reset() {
-	*x = DeleteDeploymentResponse{}
-	mi := &file_deployment_v1_deployment_proto_msgTypes[20]
+func (x *GetDeploymentProvenanceResponse) Reset() {
+	*x = GetDeploymentProvenanceResponse{}
+	mi := &file_deployment_v1_deployment_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDeploymentResponse) String() string {
+func (x *GetDeploymentProvenanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDeploymentResponse) ProtoMessage() {}
+func (*GetDeploymentProvenanceResponse) ProtoMessage() {}
 
-func (x *DeleteDeploymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deployment_v1_deployment_proto_msgTypes[20]
+func (x *GetDeploymentProvenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployment_v1_deployment_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1389,16 +3199,23 @@ func (x *DeleteDeploymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDeploymentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteDeploymentResponse) Descriptor() ([]byte, []int) {
-	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetDeploymentProvenanceResponse.ProtoReflect.Descriptor instead.
+func (*GetDeploymentProvenanceResponse) Descriptor() ([]byte, []int) {
+	return file_deployment_v1_deployment_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetDeploymentProvenanceResponse) GetProvenance() *DeploymentProvenance {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
 }
 
 var File_deployment_v1_deployment_proto protoreflect.FileDescriptor
 
 const file_deployment_v1_deployment_proto_rawDesc = "" +
 	"\n" +
-	"\x1edeployment/v1/deployment.proto\x12\rdeployment.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"6\n" +
+	"\x1edeployment/v1/deployment.proto\x12\rdeployment.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16policy/v1/policy.proto\"6\n" +
 	"\x04Port\x12\x12\n" +
 	"\x04port\x18\x01 \x01(\x05R\x04port\x12\x1a\n" +
 	"\bprotocol\x18\x02 \x01(\tR\bprotocol\"U\n" +
@@ -1412,19 +3229,43 @@ const file_deployment_v1_deployment_proto_rawDesc = "" +
 	"\x15initial_delay_seconds\x18\x02 \x01(\x05R\x13initialDelaySeconds\x12)\n" +
 	"\x10interval_seconds\x18\x03 \x01(\x05R\x0fintervalSeconds\x12'\n" +
 	"\x0ftimeout_seconds\x18\x04 \x01(\x05R\x0etimeoutSeconds\x12+\n" +
-	"\x11failure_threshold\x18\x05 \x01(\x05R\x10failureThreshold\"\x92\x01\n" +
+	"\x11failure_threshold\x18\x05 \x01(\x05R\x10failureThreshold\"\xaf\x03\n" +
 	"\aScalers\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\"\n" +
 	"\n" +
 	"cpu_target\x18\x02 \x01(\x05H\x00R\tcpuTarget\x88\x01\x01\x12(\n" +
-	"\rmemory_target\x18\x03 \x01(\x05H\x01R\fmemoryTarget\x88\x01\x01B\r\n" +
+	"\rmemory_target\x18\x03 \x01(\x05H\x01R\fmemoryTarget\x88\x01\x01\x127\n" +
+	"\btriggers\x18\x04 \x03(\v2\x1b.deployment.v1.ScaleTriggerR\btriggers\x129\n" +
+	"\tschedules\x18\x05 \x03(\v2\x1b.deployment.v1.CronScheduleR\tschedules\x12.\n" +
+	"\x10polling_interval\x18\x06 \x01(\x05H\x02R\x0fpollingInterval\x88\x01\x01\x12,\n" +
+	"\x0fcooldown_period\x18\a \x01(\x05H\x03R\x0ecooldownPeriod\x88\x01\x01\x12 \n" +
+	"\fidle_at_zero\x18\b \x01(\bR\n" +
+	"idleAtZeroB\r\n" +
 	"\v_cpu_targetB\x10\n" +
-	"\x0e_memory_target\"y\n" +
+	"\x0e_memory_targetB\x13\n" +
+	"\x11_polling_intervalB\x12\n" +
+	"\x10_cooldown_period\"}\n" +
+	"\fCronSchedule\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12)\n" +
+	"\x10desired_replicas\x18\x04 \x01(\x05R\x0fdesiredReplicas\"\xed\x01\n" +
+	"\fScaleTrigger\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12E\n" +
+	"\bmetadata\x18\x02 \x03(\v2).deployment.v1.ScaleTrigger.MetadataEntryR\bmetadata\x12/\n" +
+	"\x11queue_resource_id\x18\x03 \x01(\x03H\x00R\x0fqueueResourceId\x88\x01\x01\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x14\n" +
+	"\x12_queue_resource_id\"\xfe\x01\n" +
 	"\vBuildSource\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
 	"\x05image\x18\x02 \x01(\tR\x05image\x12,\n" +
-	"\x0fdockerfile_path\x18\x03 \x01(\tH\x00R\x0edockerfilePath\x88\x01\x01B\x12\n" +
-	"\x10_dockerfile_path\"\xad\x04\n" +
+	"\x0fdockerfile_path\x18\x03 \x01(\tH\x00R\x0edockerfilePath\x88\x01\x01\x12&\n" +
+	"\fimage_digest\x18\x04 \x01(\tH\x01R\vimageDigest\x88\x01\x01\x12J\n" +
+	"\x12signature_policies\x18\x05 \x03(\v2\x1b.policy.v1.RequireSignatureR\x11signaturePoliciesB\x12\n" +
+	"\x10_dockerfile_pathB\x0f\n" +
+	"\r_image_digest\"\xdb\x05\n" +
 	"\x15ServiceDeploymentSpec\x120\n" +
 	"\x05build\x18\x01 \x01(\v2\x1a.deployment.v1.BuildSourceR\x05build\x12H\n" +
 	"\fhealth_check\x18\x02 \x01(\v2 .deployment.v1.HealthCheckConfigH\x00R\vhealthCheck\x88\x01\x01\x12\x15\n" +
@@ -1434,7 +3275,11 @@ const file_deployment_v1_deployment_proto_rawDesc = "" +
 	"\fmax_replicas\x18\x06 \x01(\x05H\x04R\vmaxReplicas\x88\x01\x01\x125\n" +
 	"\ascalers\x18\a \x01(\v2\x16.deployment.v1.ScalersH\x05R\ascalers\x88\x01\x01\x12?\n" +
 	"\x03env\x18\b \x03(\v2-.deployment.v1.ServiceDeploymentSpec.EnvEntryR\x03env\x12\x12\n" +
-	"\x04port\x18\t \x01(\x05R\x04port\x1a6\n" +
+	"\x04port\x18\t \x01(\x05R\x04port\x123\n" +
+	"\x05hooks\x18\n" +
+	" \x03(\v2\x1d.deployment.v1.DeploymentHookR\x05hooks\x12A\n" +
+	"\x0finit_containers\x18\v \x03(\v2\x18.deployment.v1.ContainerR\x0einitContainers\x124\n" +
+	"\bsidecars\x18\f \x03(\v2\x18.deployment.v1.ContainerR\bsidecars\x1a6\n" +
 	"\bEnvEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0f\n" +
@@ -1444,7 +3289,27 @@ const file_deployment_v1_deployment_proto_rawDesc = "" +
 	"\r_min_replicasB\x0f\n" +
 	"\r_max_replicasB\n" +
 	"\n" +
-	"\b_scalers\"\x18\n" +
+	"\b_scalers\"\x9b\x02\n" +
+	"\tContainer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x12\x18\n" +
+	"\acommand\x18\x03 \x03(\tR\acommand\x12\x12\n" +
+	"\x04args\x18\x04 \x03(\tR\x04args\x123\n" +
+	"\x03env\x18\x05 \x03(\v2!.deployment.v1.Container.EnvEntryR\x03env\x12\x1f\n" +
+	"\vinherit_env\x18\x06 \x01(\bR\n" +
+	"inheritEnv\x12\x10\n" +
+	"\x03cpu\x18\a \x01(\tR\x03cpu\x12\x16\n" +
+	"\x06memory\x18\b \x01(\tR\x06memory\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbc\x01\n" +
+	"\x0eDeploymentHook\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12.\n" +
+	"\x05phase\x18\x02 \x01(\x0e2\x18.deployment.v1.HookPhaseR\x05phase\x12\x18\n" +
+	"\acommand\x18\x03 \x03(\tR\acommand\x12\x19\n" +
+	"\x05image\x18\x04 \x01(\tH\x00R\x05image\x88\x01\x01\x12'\n" +
+	"\x0ftimeout_seconds\x18\x05 \x01(\x05R\x0etimeoutSecondsB\b\n" +
+	"\x06_image\"\x18\n" +
 	"\x16DatabaseDeploymentSpec\"\x15\n" +
 	"\x13CacheDeploymentSpec\"\x15\n" +
 	"\x13QueueDeploymentSpec\"\x97\x02\n" +
@@ -1453,7 +3318,7 @@ const file_deployment_v1_deployment_proto_rawDesc = "" +
 	"\bdatabase\x18\x02 \x01(\v2%.deployment.v1.DatabaseDeploymentSpecH\x00R\bdatabase\x12:\n" +
 	"\x05cache\x18\x03 \x01(\v2\".deployment.v1.CacheDeploymentSpecH\x00R\x05cache\x12:\n" +
 	"\x05queue\x18\x04 \x01(\v2\".deployment.v1.QueueDeploymentSpecH\x00R\x05queueB\x06\n" +
-	"\x04spec\"\xef\x04\n" +
+	"\x04spec\"\x81\x06\n" +
 	"\n" +
 	"Deployment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
@@ -1475,16 +3340,30 @@ const file_deployment_v1_deployment_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n" +
 	"\fspec_version\x18\r \x01(\x05R\vspecVersion\x121\n" +
-	"\x04spec\x18\x0e \x01(\v2\x1d.deployment.v1.DeploymentSpecR\x04specB\r\n" +
+	"\x04spec\x18\x0e \x01(\v2\x1d.deployment.v1.DeploymentSpecR\x04spec\x12\x18\n" +
+	"\aversion\x18\x0f \x01(\x03R\aversion\x12C\n" +
+	"\n" +
+	"annotation\x18\x10 \x01(\v2#.deployment.v1.DeploymentAnnotationR\n" +
+	"annotation\x12\"\n" +
+	"\n" +
+	"created_by\x18\x11 \x01(\x03H\x02R\tcreatedBy\x88\x01\x01B\r\n" +
 	"\v_started_atB\x0f\n" +
-	"\r_completed_at\"\xa4\x01\n" +
+	"\r_completed_atB\r\n" +
+	"\v_created_by\"a\n" +
+	"\x14DeploymentAnnotation\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x16\n" +
+	"\x06author\x18\x02 \x01(\tR\x06author\x12\x17\n" +
+	"\agit_sha\x18\x03 \x01(\tR\x06gitSha\"\xe9\x01\n" +
 	"\x17CreateDeploymentRequest\x12\x1f\n" +
 	"\vresource_id\x18\x01 \x01(\x03R\n" +
 	"resourceId\x12\x1d\n" +
 	"\n" +
 	"cluster_id\x18\x02 \x01(\x03R\tclusterId\x12\x16\n" +
 	"\x06region\x18\x03 \x01(\tR\x06region\x121\n" +
-	"\x04spec\x18\x04 \x01(\v2\x1d.deployment.v1.DeploymentSpecR\x04spec\"?\n" +
+	"\x04spec\x18\x04 \x01(\v2\x1d.deployment.v1.DeploymentSpecR\x04spec\x12C\n" +
+	"\n" +
+	"annotation\x18\x05 \x01(\v2#.deployment.v1.DeploymentAnnotationR\n" +
+	"annotation\"?\n" +
 	"\x18CreateDeploymentResponse\x12#\n" +
 	"\rdeployment_id\x18\x01 \x01(\x03R\fdeploymentId\";\n" +
 	"\x14GetDeploymentRequest\x12#\n" +
@@ -1492,26 +3371,140 @@ const file_deployment_v1_deployment_proto_rawDesc = "" +
 	"\x15GetDeploymentResponse\x129\n" +
 	"\n" +
 	"deployment\x18\x01 \x01(\v2\x19.deployment.v1.DeploymentR\n" +
-	"deployment\"u\n" +
+	"deployment\"\xfb\x03\n" +
 	"\x16ListDeploymentsRequest\x12\x1f\n" +
 	"\vresource_id\x18\x01 \x01(\x03R\n" +
 	"resourceId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"~\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12:\n" +
+	"\bstatuses\x18\x04 \x03(\x0e2\x1e.deployment.v1.DeploymentPhaseR\bstatuses\x12D\n" +
+	"\rcreated_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\fcreatedAfter\x88\x01\x01\x12F\n" +
+	"\x0ecreated_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\rcreatedBefore\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\a \x01(\x03H\x02R\tcreatedBy\x88\x01\x01\x12\x1f\n" +
+	"\vactive_only\x18\b \x01(\bR\n" +
+	"activeOnly\x12A\n" +
+	"\n" +
+	"sort_order\x18\t \x01(\x0e2\".deployment.v1.DeploymentSortOrderR\tsortOrderB\x10\n" +
+	"\x0e_created_afterB\x11\n" +
+	"\x0f_created_beforeB\r\n" +
+	"\v_created_by\"\x9b\x01\n" +
+	"\x18PromoteDeploymentRequest\x12#\n" +
+	"\rdeployment_id\x18\x01 \x01(\x03R\fdeploymentId\x122\n" +
+	"\x15target_environment_id\x18\x02 \x01(\x03R\x13targetEnvironmentId\x12\x1b\n" +
+	"\x06region\x18\x03 \x01(\tH\x00R\x06region\x88\x01\x01B\t\n" +
+	"\a_region\"n\n" +
+	"\x19PromoteDeploymentResponse\x12#\n" +
+	"\rdeployment_id\x18\x01 \x01(\x03R\fdeploymentId\x12,\n" +
+	"\x12target_resource_id\x18\x02 \x01(\x03R\x10targetResourceId\"~\n" +
 	"\x17ListDeploymentsResponse\x12;\n" +
 	"\vdeployments\x18\x01 \x03(\v2\x19.deployment.v1.DeploymentR\vdeployments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x80\x01\n" +
+	"\n" +
+	"SpecChange\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12 \n" +
+	"\told_value\x18\x02 \x01(\tH\x00R\boldValue\x88\x01\x01\x12 \n" +
+	"\tnew_value\x18\x03 \x01(\tH\x01R\bnewValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_old_valueB\f\n" +
+	"\n" +
+	"_new_value\"\xde\x01\n" +
+	"\x16DeploymentHistoryEntry\x129\n" +
+	"\n" +
+	"deployment\x18\x01 \x01(\v2\x19.deployment.v1.DeploymentR\n" +
+	"deployment\x129\n" +
+	"\x16previous_deployment_id\x18\x02 \x01(\x03H\x00R\x14previousDeploymentId\x88\x01\x01\x123\n" +
+	"\achanges\x18\x03 \x03(\v2\x19.deployment.v1.SpecChangeR\achangesB\x19\n" +
+	"\x17_previous_deployment_id\"{\n" +
+	"\x1cListDeploymentHistoryRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x88\x01\n" +
+	"\x1dListDeploymentHistoryResponse\x12?\n" +
+	"\aentries\x18\x01 \x03(\v2%.deployment.v1.DeploymentHistoryEntryR\aentries\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"=\n" +
 	"\x16WatchDeploymentRequest\x12#\n" +
-	"\rdeployment_id\x18\x01 \x01(\x03R\fdeploymentId\"\xca\x01\n" +
+	"\rdeployment_id\x18\x01 \x01(\x03R\fdeploymentId\"\xd4\x02\n" +
 	"\x17WatchDeploymentResponse\x12#\n" +
 	"\rdeployment_id\x18\x01 \x01(\x03R\fdeploymentId\x126\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1e.deployment.v1.DeploymentPhaseR\x06status\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\">\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x126\n" +
+	"\bhook_log\x18\x05 \x01(\v2\x16.deployment.v1.HookLogH\x00R\ahookLog\x88\x01\x01\x12C\n" +
+	"\n" +
+	"annotation\x18\x06 \x01(\v2#.deployment.v1.DeploymentAnnotationR\n" +
+	"annotationB\v\n" +
+	"\t_hook_log\"1\n" +
+	"\aHookLog\x12\x12\n" +
+	"\x04hook\x18\x01 \x01(\tR\x04hook\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\tR\x04line\">\n" +
 	"\x17DeleteDeploymentRequest\x12#\n" +
 	"\rdeployment_id\x18\x01 \x01(\x03R\fdeploymentId\"\x1a\n" +
-	"\x18DeleteDeploymentResponse*\xeb\x01\n" +
+	"\x18DeleteDeploymentResponse\"\x87\x03\n" +
+	"\x12ClusterDiscrepancy\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\x03R\vworkspaceId\x12(\n" +
+	"\rdeployment_id\x18\x03 \x01(\x03H\x00R\fdeploymentId\x88\x01\x01\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x122\n" +
+	"\x04kind\x18\x05 \x01(\x0e2\x1e.deployment.v1.DiscrepancyKindR\x04kind\x12\x16\n" +
+	"\x06detail\x18\x06 \x01(\tR\x06detail\x12;\n" +
+	"\vdetected_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectedAt\x12@\n" +
+	"\vrepaired_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"repairedAt\x88\x01\x01B\x10\n" +
+	"\x0e_deployment_idB\x0e\n" +
+	"\f_repaired_at\"Z\n" +
+	"\x1fListClusterDiscrepanciesRequest\x12&\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03H\x00R\vworkspaceId\x88\x01\x01B\x0f\n" +
+	"\r_workspace_id\"k\n" +
+	" ListClusterDiscrepanciesResponse\x12G\n" +
+	"\rdiscrepancies\x18\x01 \x03(\v2!.deployment.v1.ClusterDiscrepancyR\rdiscrepancies\"\xbd\x01\n" +
+	"\rVulnerability\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\apackage\x18\x02 \x01(\tR\apackage\x12+\n" +
+	"\x11installed_version\x18\x03 \x01(\tR\x10installedVersion\x12#\n" +
+	"\rfixed_version\x18\x04 \x01(\tR\ffixedVersion\x12\x1a\n" +
+	"\bseverity\x18\x05 \x01(\tR\bseverity\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\"\xa9\x02\n" +
+	"\x0fImageScanReport\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\tR\x05image\x129\n" +
+	"\n" +
+	"scanned_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tscannedAt\x12\x1a\n" +
+	"\bcritical\x18\x03 \x01(\x05R\bcritical\x12\x12\n" +
+	"\x04high\x18\x04 \x01(\x05R\x04high\x12\x16\n" +
+	"\x06medium\x18\x05 \x01(\x05R\x06medium\x12\x10\n" +
+	"\x03low\x18\x06 \x01(\x05R\x03low\x12F\n" +
+	"\x0fvulnerabilities\x18\a \x03(\v2\x1c.deployment.v1.VulnerabilityR\x0fvulnerabilities\x12\x19\n" +
+	"\x05error\x18\b \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"@\n" +
+	"\x19GetImageScanReportRequest\x12#\n" +
+	"\rdeployment_id\x18\x01 \x01(\x03R\fdeploymentId\"T\n" +
+	"\x1aGetImageScanReportResponse\x126\n" +
+	"\x06report\x18\x01 \x01(\v2\x1e.deployment.v1.ImageScanReportR\x06report\"\xe3\x02\n" +
+	"\x14DeploymentProvenance\x12#\n" +
+	"\rdeployment_id\x18\x01 \x01(\x03R\fdeploymentId\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x127\n" +
+	"\x06source\x18\x03 \x01(\x0e2\x1f.deployment.v1.ProvenanceSourceR\x06source\x12\x1d\n" +
+	"\n" +
+	"commit_sha\x18\x04 \x01(\tR\tcommitSha\x12%\n" +
+	"\x0erepository_url\x18\x05 \x01(\tR\rrepositoryUrl\x12\x1d\n" +
+	"\n" +
+	"commit_url\x18\x06 \x01(\tR\tcommitUrl\x12\x19\n" +
+	"\x05error\x18\a \x01(\tH\x00R\x05error\x88\x01\x01\x12>\n" +
+	"\n" +
+	"fetched_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tfetchedAt\x88\x01\x01B\b\n" +
+	"\x06_errorB\r\n" +
+	"\v_fetched_at\"E\n" +
+	"\x1eGetDeploymentProvenanceRequest\x12#\n" +
+	"\rdeployment_id\x18\x01 \x01(\x03R\fdeploymentId\"f\n" +
+	"\x1fGetDeploymentProvenanceResponse\x12C\n" +
+	"\n" +
+	"provenance\x18\x01 \x01(\v2#.deployment.v1.DeploymentProvenanceR\n" +
+	"provenance*\xeb\x01\n" +
 	"\x0fDeploymentPhase\x12 \n" +
 	"\x1cDEPLOYMENT_PHASE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18DEPLOYMENT_PHASE_PENDING\x10\x01\x12\x1e\n" +
@@ -1519,13 +3512,35 @@ const file_deployment_v1_deployment_proto_rawDesc = "" +
 	"\x18DEPLOYMENT_PHASE_RUNNING\x10\x03\x12\x1e\n" +
 	"\x1aDEPLOYMENT_PHASE_SUCCEEDED\x10\x04\x12\x1b\n" +
 	"\x17DEPLOYMENT_PHASE_FAILED\x10\x05\x12\x1d\n" +
-	"\x19DEPLOYMENT_PHASE_CANCELED\x10\x062\xff\x03\n" +
+	"\x19DEPLOYMENT_PHASE_CANCELED\x10\x06*\x8c\x01\n" +
+	"\x13DeploymentSortOrder\x12%\n" +
+	"!DEPLOYMENT_SORT_ORDER_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"DEPLOYMENT_SORT_ORDER_NEWEST_FIRST\x10\x01\x12&\n" +
+	"\"DEPLOYMENT_SORT_ORDER_OLDEST_FIRST\x10\x02*\x8c\x01\n" +
+	"\x0fDiscrepancyKind\x12 \n" +
+	"\x1cDISCREPANCY_KIND_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DISCREPANCY_KIND_MISSING\x10\x01\x12\x1a\n" +
+	"\x16DISCREPANCY_KIND_STALE\x10\x02\x12\x1d\n" +
+	"\x19DISCREPANCY_KIND_ORPHANED\x10\x03*^\n" +
+	"\tHookPhase\x12\x1a\n" +
+	"\x16HOOK_PHASE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15HOOK_PHASE_PRE_DEPLOY\x10\x01\x12\x1a\n" +
+	"\x16HOOK_PHASE_POST_DEPLOY\x10\x02*{\n" +
+	"\x10ProvenanceSource\x12!\n" +
+	"\x1dPROVENANCE_SOURCE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1ePROVENANCE_SOURCE_IMAGE_LABELS\x10\x01\x12 \n" +
+	"\x1cPROVENANCE_SOURCE_ANNOTATION\x10\x022\xbd\b\n" +
 	"\x11DeploymentService\x12c\n" +
 	"\x10CreateDeployment\x12&.deployment.v1.CreateDeploymentRequest\x1a'.deployment.v1.CreateDeploymentResponse\x12Z\n" +
 	"\rGetDeployment\x12#.deployment.v1.GetDeploymentRequest\x1a$.deployment.v1.GetDeploymentResponse\x12`\n" +
 	"\x0fListDeployments\x12%.deployment.v1.ListDeploymentsRequest\x1a&.deployment.v1.ListDeploymentsResponse\x12b\n" +
 	"\x0fWatchDeployment\x12%.deployment.v1.WatchDeploymentRequest\x1a&.deployment.v1.WatchDeploymentResponse0\x01\x12c\n" +
-	"\x10DeleteDeployment\x12&.deployment.v1.DeleteDeploymentRequest\x1a'.deployment.v1.DeleteDeploymentResponseBCZAgithub.com/team-loco/loco/shared/proto/deployment/v1;deploymentv1b\x06proto3"
+	"\x10DeleteDeployment\x12&.deployment.v1.DeleteDeploymentRequest\x1a'.deployment.v1.DeleteDeploymentResponse\x12r\n" +
+	"\x15ListDeploymentHistory\x12+.deployment.v1.ListDeploymentHistoryRequest\x1a,.deployment.v1.ListDeploymentHistoryResponse\x12f\n" +
+	"\x11PromoteDeployment\x12'.deployment.v1.PromoteDeploymentRequest\x1a(.deployment.v1.PromoteDeploymentResponse\x12{\n" +
+	"\x18ListClusterDiscrepancies\x12..deployment.v1.ListClusterDiscrepanciesRequest\x1a/.deployment.v1.ListClusterDiscrepanciesResponse\x12i\n" +
+	"\x12GetImageScanReport\x12(.deployment.v1.GetImageScanReportRequest\x1a).deployment.v1.GetImageScanReportResponse\x12x\n" +
+	"\x17GetDeploymentProvenance\x12-.deployment.v1.GetDeploymentProvenanceRequest\x1a..deployment.v1.GetDeploymentProvenanceResponseBCZAgithub.com/team-loco/loco/shared/proto/deployment/v1;deploymentv1b\x06proto3"
 
 var (
 	file_deployment_v1_deployment_proto_rawDescOnce sync.Once
@@ -1539,69 +3554,138 @@ func file_deployment_v1_deployment_proto_rawDescGZIP() []byte {
 	return file_deployment_v1_deployment_proto_rawDescData
 }
 
-var file_deployment_v1_deployment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_deployment_v1_deployment_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_deployment_v1_deployment_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_deployment_v1_deployment_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_deployment_v1_deployment_proto_goTypes = []any{
-	(DeploymentPhase)(0),             // 0: deployment.v1.DeploymentPhase
-	(*Port)(nil),                     // 1: deployment.v1.Port
-	(*ResourceSpec)(nil),             // 2: deployment.v1.ResourceSpec
-	(*HealthCheckConfig)(nil),        // 3: deployment.v1.HealthCheckConfig
-	(*Scalers)(nil),                  // 4: deployment.v1.Scalers
-	(*BuildSource)(nil),              // 5: deployment.v1.BuildSource
-	(*ServiceDeploymentSpec)(nil),    // 6: deployment.v1.ServiceDeploymentSpec
-	(*DatabaseDeploymentSpec)(nil),   // 7: deployment.v1.DatabaseDeploymentSpec
-	(*CacheDeploymentSpec)(nil),      // 8: deployment.v1.CacheDeploymentSpec
-	(*QueueDeploymentSpec)(nil),      // 9: deployment.v1.QueueDeploymentSpec
-	(*DeploymentSpec)(nil),           // 10: deployment.v1.DeploymentSpec
-	(*Deployment)(nil),               // 11: deployment.v1.Deployment
-	(*CreateDeploymentRequest)(nil),  // 12: deployment.v1.CreateDeploymentRequest
-	(*CreateDeploymentResponse)(nil), // 13: deployment.v1.CreateDeploymentResponse
-	(*GetDeploymentRequest)(nil),     // 14: deployment.v1.GetDeploymentRequest
-	(*GetDeploymentResponse)(nil),    // 15: deployment.v1.GetDeploymentResponse
-	(*ListDeploymentsRequest)(nil),   // 16: deployment.v1.ListDeploymentsRequest
-	(*ListDeploymentsResponse)(nil),  // 17: deployment.v1.ListDeploymentsResponse
-	(*WatchDeploymentRequest)(nil),   // 18: deployment.v1.WatchDeploymentRequest
-	(*WatchDeploymentResponse)(nil),  // 19: deployment.v1.WatchDeploymentResponse
-	(*DeleteDeploymentRequest)(nil),  // 20: deployment.v1.DeleteDeploymentRequest
-	(*DeleteDeploymentResponse)(nil), // 21: deployment.v1.DeleteDeploymentResponse
-	nil,                              // 22: deployment.v1.ServiceDeploymentSpec.EnvEntry
-	(*timestamppb.Timestamp)(nil),    // 23: google.protobuf.Timestamp
+	(DeploymentPhase)(0),                     // 0: deployment.v1.DeploymentPhase
+	(DeploymentSortOrder)(0),                 // 1: deployment.v1.DeploymentSortOrder
+	(DiscrepancyKind)(0),                     // 2: deployment.v1.DiscrepancyKind
+	(HookPhase)(0),                           // 3: deployment.v1.HookPhase
+	(ProvenanceSource)(0),                    // 4: deployment.v1.ProvenanceSource
+	(*Port)(nil),                             // 5: deployment.v1.Port
+	(*ResourceSpec)(nil),                     // 6: deployment.v1.ResourceSpec
+	(*HealthCheckConfig)(nil),                // 7: deployment.v1.HealthCheckConfig
+	(*Scalers)(nil),                          // 8: deployment.v1.Scalers
+	(*CronSchedule)(nil),                     // 9: deployment.v1.CronSchedule
+	(*ScaleTrigger)(nil),                     // 10: deployment.v1.ScaleTrigger
+	(*BuildSource)(nil),                      // 11: deployment.v1.BuildSource
+	(*ServiceDeploymentSpec)(nil),            // 12: deployment.v1.ServiceDeploymentSpec
+	(*Container)(nil),                        // 13: deployment.v1.Container
+	(*DeploymentHook)(nil),                   // 14: deployment.v1.DeploymentHook
+	(*DatabaseDeploymentSpec)(nil),           // 15: deployment.v1.DatabaseDeploymentSpec
+	(*CacheDeploymentSpec)(nil),              // 16: deployment.v1.CacheDeploymentSpec
+	(*QueueDeploymentSpec)(nil),              // 17: deployment.v1.QueueDeploymentSpec
+	(*DeploymentSpec)(nil),                   // 18: deployment.v1.DeploymentSpec
+	(*Deployment)(nil),                       // 19: deployment.v1.Deployment
+	(*DeploymentAnnotation)(nil),             // 20: deployment.v1.DeploymentAnnotation
+	(*CreateDeploymentRequest)(nil),          // 21: deployment.v1.CreateDeploymentRequest
+	(*CreateDeploymentResponse)(nil),         // 22: deployment.v1.CreateDeploymentResponse
+	(*GetDeploymentRequest)(nil),             // 23: deployment.v1.GetDeploymentRequest
+	(*GetDeploymentResponse)(nil),            // 24: deployment.v1.GetDeploymentResponse
+	(*ListDeploymentsRequest)(nil),           // 25: deployment.v1.ListDeploymentsRequest
+	(*PromoteDeploymentRequest)(nil),         // 26: deployment.v1.PromoteDeploymentRequest
+	(*PromoteDeploymentResponse)(nil),        // 27: deployment.v1.PromoteDeploymentResponse
+	(*ListDeploymentsResponse)(nil),          // 28: deployment.v1.ListDeploymentsResponse
+	(*SpecChange)(nil),                       // 29: deployment.v1.SpecChange
+	(*DeploymentHistoryEntry)(nil),           // 30: deployment.v1.DeploymentHistoryEntry
+	(*ListDeploymentHistoryRequest)(nil),     // 31: deployment.v1.ListDeploymentHistoryRequest
+	(*ListDeploymentHistoryResponse)(nil),    // 32: deployment.v1.ListDeploymentHistoryResponse
+	(*WatchDeploymentRequest)(nil),           // 33: deployment.v1.WatchDeploymentRequest
+	(*WatchDeploymentResponse)(nil),          // 34: deployment.v1.WatchDeploymentResponse
+	(*HookLog)(nil),                          // 35: deployment.v1.HookLog
+	(*DeleteDeploymentRequest)(nil),          // 36: deployment.v1.DeleteDeploymentRequest
+	(*DeleteDeploymentResponse)(nil),         // 37: deployment.v1.DeleteDeploymentResponse
+	(*ClusterDiscrepancy)(nil),               // 38: deployment.v1.ClusterDiscrepancy
+	(*ListClusterDiscrepanciesRequest)(nil),  // 39: deployment.v1.ListClusterDiscrepanciesRequest
+	(*ListClusterDiscrepanciesResponse)(nil), // 40: deployment.v1.ListClusterDiscrepanciesResponse
+	(*Vulnerability)(nil),                    // 41: deployment.v1.Vulnerability
+	(*ImageScanReport)(nil),                  // 42: deployment.v1.ImageScanReport
+	(*GetImageScanReportRequest)(nil),        // 43: deployment.v1.GetImageScanReportRequest
+	(*GetImageScanReportResponse)(nil),       // 44: deployment.v1.GetImageScanReportResponse
+	(*DeploymentProvenance)(nil),             // 45: deployment.v1.DeploymentProvenance
+	(*GetDeploymentProvenanceRequest)(nil),   // 46: deployment.v1.GetDeploymentProvenanceRequest
+	(*GetDeploymentProvenanceResponse)(nil),  // 47: deployment.v1.GetDeploymentProvenanceResponse
+	nil,                                      // 48: deployment.v1.ScaleTrigger.MetadataEntry
+	nil,                                      // 49: deployment.v1.ServiceDeploymentSpec.EnvEntry
+	nil,                                      // 50: deployment.v1.Container.EnvEntry
+	(*v1.RequireSignature)(nil),              // 51: policy.v1.RequireSignature
+	(*timestamppb.Timestamp)(nil),            // 52: google.protobuf.Timestamp
 }
 var file_deployment_v1_deployment_proto_depIdxs = []int32{
-	5,  // 0: deployment.v1.ServiceDeploymentSpec.build:type_name -> deployment.v1.BuildSource
-	3,  // 1: deployment.v1.ServiceDeploymentSpec.health_check:type_name -> deployment.v1.HealthCheckConfig
-	4,  // 2: deployment.v1.ServiceDeploymentSpec.scalers:type_name -> deployment.v1.Scalers
-	22, // 3: deployment.v1.ServiceDeploymentSpec.env:type_name -> deployment.v1.ServiceDeploymentSpec.EnvEntry
-	6,  // 4: deployment.v1.DeploymentSpec.service:type_name -> deployment.v1.ServiceDeploymentSpec
-	7,  // 5: deployment.v1.DeploymentSpec.database:type_name -> deployment.v1.DatabaseDeploymentSpec
-	8,  // 6: deployment.v1.DeploymentSpec.cache:type_name -> deployment.v1.CacheDeploymentSpec
-	9,  // 7: deployment.v1.DeploymentSpec.queue:type_name -> deployment.v1.QueueDeploymentSpec
-	0,  // 8: deployment.v1.Deployment.status:type_name -> deployment.v1.DeploymentPhase
-	23, // 9: deployment.v1.Deployment.created_at:type_name -> google.protobuf.Timestamp
-	23, // 10: deployment.v1.Deployment.started_at:type_name -> google.protobuf.Timestamp
-	23, // 11: deployment.v1.Deployment.completed_at:type_name -> google.protobuf.Timestamp
-	23, // 12: deployment.v1.Deployment.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 13: deployment.v1.Deployment.spec:type_name -> deployment.v1.DeploymentSpec
-	10, // 14: deployment.v1.CreateDeploymentRequest.spec:type_name -> deployment.v1.DeploymentSpec
-	11, // 15: deployment.v1.GetDeploymentResponse.deployment:type_name -> deployment.v1.Deployment
-	11, // 16: deployment.v1.ListDeploymentsResponse.deployments:type_name -> deployment.v1.Deployment
-	0,  // 17: deployment.v1.WatchDeploymentResponse.status:type_name -> deployment.v1.DeploymentPhase
-	23, // 18: deployment.v1.WatchDeploymentResponse.timestamp:type_name -> google.protobuf.Timestamp
-	12, // 19: deployment.v1.DeploymentService.CreateDeployment:input_type -> deployment.v1.CreateDeploymentRequest
-	14, // 20: deployment.v1.DeploymentService.GetDeployment:input_type -> deployment.v1.GetDeploymentRequest
-	16, // 21: deployment.v1.DeploymentService.ListDeployments:input_type -> deployment.v1.ListDeploymentsRequest
-	18, // 22: deployment.v1.DeploymentService.WatchDeployment:input_type -> deployment.v1.WatchDeploymentRequest
-	20, // 23: deployment.v1.DeploymentService.DeleteDeployment:input_type -> deployment.v1.DeleteDeploymentRequest
-	13, // 24: deployment.v1.DeploymentService.CreateDeployment:output_type -> deployment.v1.CreateDeploymentResponse
-	15, // 25: deployment.v1.DeploymentService.GetDeployment:output_type -> deployment.v1.GetDeploymentResponse
-	17, // 26: deployment.v1.DeploymentService.ListDeployments:output_type -> deployment.v1.ListDeploymentsResponse
-	19, // 27: deployment.v1.DeploymentService.WatchDeployment:output_type -> deployment.v1.WatchDeploymentResponse
-	21, // 28: deployment.v1.DeploymentService.DeleteDeployment:output_type -> deployment.v1.DeleteDeploymentResponse
-	24, // [24:29] is the sub-list for method output_type
-	19, // [19:24] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	10, // 0: deployment.v1.Scalers.triggers:type_name -> deployment.v1.ScaleTrigger
+	9,  // 1: deployment.v1.Scalers.schedules:type_name -> deployment.v1.CronSchedule
+	48, // 2: deployment.v1.ScaleTrigger.metadata:type_name -> deployment.v1.ScaleTrigger.MetadataEntry
+	51, // 3: deployment.v1.BuildSource.signature_policies:type_name -> policy.v1.RequireSignature
+	11, // 4: deployment.v1.ServiceDeploymentSpec.build:type_name -> deployment.v1.BuildSource
+	7,  // 5: deployment.v1.ServiceDeploymentSpec.health_check:type_name -> deployment.v1.HealthCheckConfig
+	8,  // 6: deployment.v1.ServiceDeploymentSpec.scalers:type_name -> deployment.v1.Scalers
+	49, // 7: deployment.v1.ServiceDeploymentSpec.env:type_name -> deployment.v1.ServiceDeploymentSpec.EnvEntry
+	14, // 8: deployment.v1.ServiceDeploymentSpec.hooks:type_name -> deployment.v1.DeploymentHook
+	13, // 9: deployment.v1.ServiceDeploymentSpec.init_containers:type_name -> deployment.v1.Container
+	13, // 10: deployment.v1.ServiceDeploymentSpec.sidecars:type_name -> deployment.v1.Container
+	50, // 11: deployment.v1.Container.env:type_name -> deployment.v1.Container.EnvEntry
+	3,  // 12: deployment.v1.DeploymentHook.phase:type_name -> deployment.v1.HookPhase
+	12, // 13: deployment.v1.DeploymentSpec.service:type_name -> deployment.v1.ServiceDeploymentSpec
+	15, // 14: deployment.v1.DeploymentSpec.database:type_name -> deployment.v1.DatabaseDeploymentSpec
+	16, // 15: deployment.v1.DeploymentSpec.cache:type_name -> deployment.v1.CacheDeploymentSpec
+	17, // 16: deployment.v1.DeploymentSpec.queue:type_name -> deployment.v1.QueueDeploymentSpec
+	0,  // 17: deployment.v1.Deployment.status:type_name -> deployment.v1.DeploymentPhase
+	52, // 18: deployment.v1.Deployment.created_at:type_name -> google.protobuf.Timestamp
+	52, // 19: deployment.v1.Deployment.started_at:type_name -> google.protobuf.Timestamp
+	52, // 20: deployment.v1.Deployment.completed_at:type_name -> google.protobuf.Timestamp
+	52, // 21: deployment.v1.Deployment.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 22: deployment.v1.Deployment.spec:type_name -> deployment.v1.DeploymentSpec
+	20, // 23: deployment.v1.Deployment.annotation:type_name -> deployment.v1.DeploymentAnnotation
+	18, // 24: deployment.v1.CreateDeploymentRequest.spec:type_name -> deployment.v1.DeploymentSpec
+	20, // 25: deployment.v1.CreateDeploymentRequest.annotation:type_name -> deployment.v1.DeploymentAnnotation
+	19, // 26: deployment.v1.GetDeploymentResponse.deployment:type_name -> deployment.v1.Deployment
+	0,  // 27: deployment.v1.ListDeploymentsRequest.statuses:type_name -> deployment.v1.DeploymentPhase
+	52, // 28: deployment.v1.ListDeploymentsRequest.created_after:type_name -> google.protobuf.Timestamp
+	52, // 29: deployment.v1.ListDeploymentsRequest.created_before:type_name -> google.protobuf.Timestamp
+	1,  // 30: deployment.v1.ListDeploymentsRequest.sort_order:type_name -> deployment.v1.DeploymentSortOrder
+	19, // 31: deployment.v1.ListDeploymentsResponse.deployments:type_name -> deployment.v1.Deployment
+	19, // 32: deployment.v1.DeploymentHistoryEntry.deployment:type_name -> deployment.v1.Deployment
+	29, // 33: deployment.v1.DeploymentHistoryEntry.changes:type_name -> deployment.v1.SpecChange
+	30, // 34: deployment.v1.ListDeploymentHistoryResponse.entries:type_name -> deployment.v1.DeploymentHistoryEntry
+	0,  // 35: deployment.v1.WatchDeploymentResponse.status:type_name -> deployment.v1.DeploymentPhase
+	52, // 36: deployment.v1.WatchDeploymentResponse.timestamp:type_name -> google.protobuf.Timestamp
+	35, // 37: deployment.v1.WatchDeploymentResponse.hook_log:type_name -> deployment.v1.HookLog
+	20, // 38: deployment.v1.WatchDeploymentResponse.annotation:type_name -> deployment.v1.DeploymentAnnotation
+	2,  // 39: deployment.v1.ClusterDiscrepancy.kind:type_name -> deployment.v1.DiscrepancyKind
+	52, // 40: deployment.v1.ClusterDiscrepancy.detected_at:type_name -> google.protobuf.Timestamp
+	52, // 41: deployment.v1.ClusterDiscrepancy.repaired_at:type_name -> google.protobuf.Timestamp
+	38, // 42: deployment.v1.ListClusterDiscrepanciesResponse.discrepancies:type_name -> deployment.v1.ClusterDiscrepancy
+	52, // 43: deployment.v1.ImageScanReport.scanned_at:type_name -> google.protobuf.Timestamp
+	41, // 44: deployment.v1.ImageScanReport.vulnerabilities:type_name -> deployment.v1.Vulnerability
+	42, // 45: deployment.v1.GetImageScanReportResponse.report:type_name -> deployment.v1.ImageScanReport
+	4,  // 46: deployment.v1.DeploymentProvenance.source:type_name -> deployment.v1.ProvenanceSource
+	52, // 47: deployment.v1.DeploymentProvenance.fetched_at:type_name -> google.protobuf.Timestamp
+	45, // 48: deployment.v1.GetDeploymentProvenanceResponse.provenance:type_name -> deployment.v1.DeploymentProvenance
+	21, // 49: deployment.v1.DeploymentService.CreateDeployment:input_type -> deployment.v1.CreateDeploymentRequest
+	23, // 50: deployment.v1.DeploymentService.GetDeployment:input_type -> deployment.v1.GetDeploymentRequest
+	25, // 51: deployment.v1.DeploymentService.ListDeployments:input_type -> deployment.v1.ListDeploymentsRequest
+	33, // 52: deployment.v1.DeploymentService.WatchDeployment:input_type -> deployment.v1.WatchDeploymentRequest
+	36, // 53: deployment.v1.DeploymentService.DeleteDeployment:input_type -> deployment.v1.DeleteDeploymentRequest
+	31, // 54: deployment.v1.DeploymentService.ListDeploymentHistory:input_type -> deployment.v1.ListDeploymentHistoryRequest
+	26, // 55: deployment.v1.DeploymentService.PromoteDeployment:input_type -> deployment.v1.PromoteDeploymentRequest
+	39, // 56: deployment.v1.DeploymentService.ListClusterDiscrepancies:input_type -> deployment.v1.ListClusterDiscrepanciesRequest
+	43, // 57: deployment.v1.DeploymentService.GetImageScanReport:input_type -> deployment.v1.GetImageScanReportRequest
+	46, // 58: deployment.v1.DeploymentService.GetDeploymentProvenance:input_type -> deployment.v1.GetDeploymentProvenanceRequest
+	22, // 59: deployment.v1.DeploymentService.CreateDeployment:output_type -> deployment.v1.CreateDeploymentResponse
+	24, // 60: deployment.v1.DeploymentService.GetDeployment:output_type -> deployment.v1.GetDeploymentResponse
+	28, // 61: deployment.v1.DeploymentService.ListDeployments:output_type -> deployment.v1.ListDeploymentsResponse
+	34, // 62: deployment.v1.DeploymentService.WatchDeployment:output_type -> deployment.v1.WatchDeploymentResponse
+	37, // 63: deployment.v1.DeploymentService.DeleteDeployment:output_type -> deployment.v1.DeleteDeploymentResponse
+	32, // 64: deployment.v1.DeploymentService.ListDeploymentHistory:output_type -> deployment.v1.ListDeploymentHistoryResponse
+	27, // 65: deployment.v1.DeploymentService.PromoteDeployment:output_type -> deployment.v1.PromoteDeploymentResponse
+	40, // 66: deployment.v1.DeploymentService.ListClusterDiscrepancies:output_type -> deployment.v1.ListClusterDiscrepanciesResponse
+	44, // 67: deployment.v1.DeploymentService.GetImageScanReport:output_type -> deployment.v1.GetImageScanReportResponse
+	47, // 68: deployment.v1.DeploymentService.GetDeploymentProvenance:output_type -> deployment.v1.GetDeploymentProvenanceResponse
+	59, // [59:69] is the sub-list for method output_type
+	49, // [49:59] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_deployment_v1_deployment_proto_init() }
@@ -1611,22 +3695,33 @@ func file_deployment_v1_deployment_proto_init() {
 	}
 	file_deployment_v1_deployment_proto_msgTypes[1].OneofWrappers = []any{}
 	file_deployment_v1_deployment_proto_msgTypes[3].OneofWrappers = []any{}
-	file_deployment_v1_deployment_proto_msgTypes[4].OneofWrappers = []any{}
 	file_deployment_v1_deployment_proto_msgTypes[5].OneofWrappers = []any{}
-	file_deployment_v1_deployment_proto_msgTypes[9].OneofWrappers = []any{
+	file_deployment_v1_deployment_proto_msgTypes[6].OneofWrappers = []any{}
+	file_deployment_v1_deployment_proto_msgTypes[7].OneofWrappers = []any{}
+	file_deployment_v1_deployment_proto_msgTypes[9].OneofWrappers = []any{}
+	file_deployment_v1_deployment_proto_msgTypes[13].OneofWrappers = []any{
 		(*DeploymentSpec_Service)(nil),
 		(*DeploymentSpec_Database)(nil),
 		(*DeploymentSpec_Cache)(nil),
 		(*DeploymentSpec_Queue)(nil),
 	}
-	file_deployment_v1_deployment_proto_msgTypes[10].OneofWrappers = []any{}
+	file_deployment_v1_deployment_proto_msgTypes[14].OneofWrappers = []any{}
+	file_deployment_v1_deployment_proto_msgTypes[20].OneofWrappers = []any{}
+	file_deployment_v1_deployment_proto_msgTypes[21].OneofWrappers = []any{}
+	file_deployment_v1_deployment_proto_msgTypes[24].OneofWrappers = []any{}
+	file_deployment_v1_deployment_proto_msgTypes[25].OneofWrappers = []any{}
+	file_deployment_v1_deployment_proto_msgTypes[29].OneofWrappers = []any{}
+	file_deployment_v1_deployment_proto_msgTypes[33].OneofWrappers = []any{}
+	file_deployment_v1_deployment_proto_msgTypes[34].OneofWrappers = []any{}
+	file_deployment_v1_deployment_proto_msgTypes[37].OneofWrappers = []any{}
+	file_deployment_v1_deployment_proto_msgTypes[40].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_deployment_v1_deployment_proto_rawDesc), len(file_deployment_v1_deployment_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   22,
+			NumEnums:      5,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -48,6 +48,21 @@ const (
 	// DeploymentServiceDeleteDeploymentProcedure is the fully-qualified name of the DeploymentService's
 	// DeleteDeployment RPC.
 	DeploymentServiceDeleteDeploymentProcedure = "/deployment.v1.DeploymentService/DeleteDeployment"
+	// DeploymentServiceListDeploymentHistoryProcedure is the fully-qualified name of the
+	// DeploymentService's ListDeploymentHistory RPC.
+	DeploymentServiceListDeploymentHistoryProcedure = "/deployment.v1.DeploymentService/ListDeploymentHistory"
+	// DeploymentServicePromoteDeploymentProcedure is the fully-qualified name of the
+	// DeploymentService's PromoteDeployment RPC.
+	DeploymentServicePromoteDeploymentProcedure = "/deployment.v1.DeploymentService/PromoteDeployment"
+	// DeploymentServiceListClusterDiscrepanciesProcedure is the fully-qualified name of the
+	// DeploymentService's ListClusterDiscrepancies RPC.
+	DeploymentServiceListClusterDiscrepanciesProcedure = "/deployment.v1.DeploymentService/ListClusterDiscrepancies"
+	// DeploymentServiceGetImageScanReportProcedure is the fully-qualified name of the
+	// DeploymentService's GetImageScanReport RPC.
+	DeploymentServiceGetImageScanReportProcedure = "/deployment.v1.DeploymentService/GetImageScanReport"
+	// DeploymentServiceGetDeploymentProvenanceProcedure is the fully-qualified name of the
+	// DeploymentService's GetDeploymentProvenance RPC.
+	DeploymentServiceGetDeploymentProvenanceProcedure = "/deployment.v1.DeploymentService/GetDeploymentProvenance"
 )
 
 // DeploymentServiceClient is a client for the deployment.v1.DeploymentService service.
@@ -62,6 +77,18 @@ type DeploymentServiceClient interface {
 	WatchDeployment(context.Context, *connect.Request[v1.WatchDeploymentRequest]) (*connect.ServerStreamForClient[v1.WatchDeploymentResponse], error)
 	// DeleteDeployment deletes/inactivates a deployment.
 	DeleteDeployment(context.Context, *connect.Request[v1.DeleteDeploymentRequest]) (*connect.Response[v1.DeleteDeploymentResponse], error)
+	// ListDeploymentHistory lists deployments for a resource with the spec changes each one introduced.
+	ListDeploymentHistory(context.Context, *connect.Request[v1.ListDeploymentHistoryRequest]) (*connect.Response[v1.ListDeploymentHistoryResponse], error)
+	// PromoteDeployment deploys a deployment's image to the resource of the same name in another environment.
+	PromoteDeployment(context.Context, *connect.Request[v1.PromoteDeploymentRequest]) (*connect.Response[v1.PromoteDeploymentResponse], error)
+	// ListClusterDiscrepancies lists where the cluster's Applications last differed from the active deployments.
+	ListClusterDiscrepancies(context.Context, *connect.Request[v1.ListClusterDiscrepanciesRequest]) (*connect.Response[v1.ListClusterDiscrepanciesResponse], error)
+	// GetImageScanReport returns the latest vulnerability scan of a deployment's image. An image not
+	// scanned yet is scanned in the background and NOT_FOUND returned until the report is ready.
+	GetImageScanReport(context.Context, *connect.Request[v1.GetImageScanReportRequest]) (*connect.Response[v1.GetImageScanReportResponse], error)
+	// GetDeploymentProvenance returns the commit a deployment was built from, read from its image's
+	// OCI source labels, or the git sha it was annotated with when the image has none.
+	GetDeploymentProvenance(context.Context, *connect.Request[v1.GetDeploymentProvenanceRequest]) (*connect.Response[v1.GetDeploymentProvenanceResponse], error)
 }
 
 // NewDeploymentServiceClient constructs a client for the deployment.v1.DeploymentService service.
@@ -105,16 +132,51 @@ func NewDeploymentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(deploymentServiceMethods.ByName("DeleteDeployment")),
 			connect.WithClientOptions(opts...),
 		),
+		listDeploymentHistory: connect.NewClient[v1.ListDeploymentHistoryRequest, v1.ListDeploymentHistoryResponse](
+			httpClient,
+			baseURL+DeploymentServiceListDeploymentHistoryProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ListDeploymentHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		promoteDeployment: connect.NewClient[v1.PromoteDeploymentRequest, v1.PromoteDeploymentResponse](
+			httpClient,
+			baseURL+DeploymentServicePromoteDeploymentProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("PromoteDeployment")),
+			connect.WithClientOptions(opts...),
+		),
+		listClusterDiscrepancies: connect.NewClient[v1.ListClusterDiscrepanciesRequest, v1.ListClusterDiscrepanciesResponse](
+			httpClient,
+			baseURL+DeploymentServiceListClusterDiscrepanciesProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ListClusterDiscrepancies")),
+			connect.WithClientOptions(opts...),
+		),
+		getImageScanReport: connect.NewClient[v1.GetImageScanReportRequest, v1.GetImageScanReportResponse](
+			httpClient,
+			baseURL+DeploymentServiceGetImageScanReportProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("GetImageScanReport")),
+			connect.WithClientOptions(opts...),
+		),
+		getDeploymentProvenance: connect.NewClient[v1.GetDeploymentProvenanceRequest, v1.GetDeploymentProvenanceResponse](
+			httpClient,
+			baseURL+DeploymentServiceGetDeploymentProvenanceProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("GetDeploymentProvenance")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // deploymentServiceClient implements DeploymentServiceClient.
 type deploymentServiceClient struct {
-	createDeployment *connect.Client[v1.CreateDeploymentRequest, v1.CreateDeploymentResponse]
-	getDeployment    *connect.Client[v1.GetDeploymentRequest, v1.GetDeploymentResponse]
-	listDeployments  *connect.Client[v1.ListDeploymentsRequest, v1.ListDeploymentsResponse]
-	watchDeployment  *connect.Client[v1.WatchDeploymentRequest, v1.WatchDeploymentResponse]
-	deleteDeployment *connect.Client[v1.DeleteDeploymentRequest, v1.DeleteDeploymentResponse]
+	createDeployment         *connect.Client[v1.CreateDeploymentRequest, v1.CreateDeploymentResponse]
+	getDeployment            *connect.Client[v1.GetDeploymentRequest, v1.GetDeploymentResponse]
+	listDeployments          *connect.Client[v1.ListDeploymentsRequest, v1.ListDeploymentsResponse]
+	watchDeployment          *connect.Client[v1.WatchDeploymentRequest, v1.WatchDeploymentResponse]
+	deleteDeployment         *connect.Client[v1.DeleteDeploymentRequest, v1.DeleteDeploymentResponse]
+	listDeploymentHistory    *connect.Client[v1.ListDeploymentHistoryRequest, v1.ListDeploymentHistoryResponse]
+	promoteDeployment        *connect.Client[v1.PromoteDeploymentRequest, v1.PromoteDeploymentResponse]
+	listClusterDiscrepancies *connect.Client[v1.ListClusterDiscrepanciesRequest, v1.ListClusterDiscrepanciesResponse]
+	getImageScanReport       *connect.Client[v1.GetImageScanReportRequest, v1.GetImageScanReportResponse]
+	getDeploymentProvenance  *connect.Client[v1.GetDeploymentProvenanceRequest, v1.GetDeploymentProvenanceResponse]
 }
 
 // CreateDeployment calls deployment.v1.DeploymentService.CreateDeployment.
@@ -142,6 +204,31 @@ func (c *deploymentServiceClient) DeleteDeployment(ctx context.Context, req *con
 	return c.deleteDeployment.CallUnary(ctx, req)
 }
 
+// ListDeploymentHistory calls deployment.v1.DeploymentService.ListDeploymentHistory.
+func (c *deploymentServiceClient) ListDeploymentHistory(ctx context.Context, req *connect.Request[v1.ListDeploymentHistoryRequest]) (*connect.Response[v1.ListDeploymentHistoryResponse], error) {
+	return c.listDeploymentHistory.CallUnary(ctx, req)
+}
+
+// PromoteDeployment calls deployment.v1.DeploymentService.PromoteDeployment.
+func (c *deploymentServiceClient) PromoteDeployment(ctx context.Context, req *connect.Request[v1.PromoteDeploymentRequest]) (*connect.Response[v1.PromoteDeploymentResponse], error) {
+	return c.promoteDeployment.CallUnary(ctx, req)
+}
+
+// ListClusterDiscrepancies calls deployment.v1.DeploymentService.ListClusterDiscrepancies.
+func (c *deploymentServiceClient) ListClusterDiscrepancies(ctx context.Context, req *connect.Request[v1.ListClusterDiscrepanciesRequest]) (*connect.Response[v1.ListClusterDiscrepanciesResponse], error) {
+	return c.listClusterDiscrepancies.CallUnary(ctx, req)
+}
+
+// GetImageScanReport calls deployment.v1.DeploymentService.GetImageScanReport.
+func (c *deploymentServiceClient) GetImageScanReport(ctx context.Context, req *connect.Request[v1.GetImageScanReportRequest]) (*connect.Response[v1.GetImageScanReportResponse], error) {
+	return c.getImageScanReport.CallUnary(ctx, req)
+}
+
+// GetDeploymentProvenance calls deployment.v1.DeploymentService.GetDeploymentProvenance.
+func (c *deploymentServiceClient) GetDeploymentProvenance(ctx context.Context, req *connect.Request[v1.GetDeploymentProvenanceRequest]) (*connect.Response[v1.GetDeploymentProvenanceResponse], error) {
+	return c.getDeploymentProvenance.CallUnary(ctx, req)
+}
+
 // DeploymentServiceHandler is an implementation of the deployment.v1.DeploymentService service.
 type DeploymentServiceHandler interface {
 	// CreateDeployment creates a new deployment for a resource.
@@ -154,6 +241,18 @@ type DeploymentServiceHandler interface {
 	WatchDeployment(context.Context, *connect.Request[v1.WatchDeploymentRequest], *connect.ServerStream[v1.WatchDeploymentResponse]) error
 	// DeleteDeployment deletes/inactivates a deployment.
 	DeleteDeployment(context.Context, *connect.Request[v1.DeleteDeploymentRequest]) (*connect.Response[v1.DeleteDeploymentResponse], error)
+	// ListDeploymentHistory lists deployments for a resource with the spec changes each one introduced.
+	ListDeploymentHistory(context.Context, *connect.Request[v1.ListDeploymentHistoryRequest]) (*connect.Response[v1.ListDeploymentHistoryResponse], error)
+	// PromoteDeployment deploys a deployment's image to the resource of the same name in another environment.
+	PromoteDeployment(context.Context, *connect.Request[v1.PromoteDeploymentRequest]) (*connect.Response[v1.PromoteDeploymentResponse], error)
+	// ListClusterDiscrepancies lists where the cluster's Applications last differed from the active deployments.
+	ListClusterDiscrepancies(context.Context, *connect.Request[v1.ListClusterDiscrepanciesRequest]) (*connect.Response[v1.ListClusterDiscrepanciesResponse], error)
+	// GetImageScanReport returns the latest vulnerability scan of a deployment's image. An image not
+	// scanned yet is scanned in the background and NOT_FOUND returned until the report is ready.
+	GetImageScanReport(context.Context, *connect.Request[v1.GetImageScanReportRequest]) (*connect.Response[v1.GetImageScanReportResponse], error)
+	// GetDeploymentProvenance returns the commit a deployment was built from, read from its image's
+	// OCI source labels, or the git sha it was annotated with when the image has none.
+	GetDeploymentProvenance(context.Context, *connect.Request[v1.GetDeploymentProvenanceRequest]) (*connect.Response[v1.GetDeploymentProvenanceResponse], error)
 }
 
 // NewDeploymentServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -193,6 +292,36 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 		connect.WithSchema(deploymentServiceMethods.ByName("DeleteDeployment")),
 		connect.WithHandlerOptions(opts...),
 	)
+	deploymentServiceListDeploymentHistoryHandler := connect.NewUnaryHandler(
+		DeploymentServiceListDeploymentHistoryProcedure,
+		svc.ListDeploymentHistory,
+		connect.WithSchema(deploymentServiceMethods.ByName("ListDeploymentHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServicePromoteDeploymentHandler := connect.NewUnaryHandler(
+		DeploymentServicePromoteDeploymentProcedure,
+		svc.PromoteDeployment,
+		connect.WithSchema(deploymentServiceMethods.ByName("PromoteDeployment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceListClusterDiscrepanciesHandler := connect.NewUnaryHandler(
+		DeploymentServiceListClusterDiscrepanciesProcedure,
+		svc.ListClusterDiscrepancies,
+		connect.WithSchema(deploymentServiceMethods.ByName("ListClusterDiscrepancies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceGetImageScanReportHandler := connect.NewUnaryHandler(
+		DeploymentServiceGetImageScanReportProcedure,
+		svc.GetImageScanReport,
+		connect.WithSchema(deploymentServiceMethods.ByName("GetImageScanReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceGetDeploymentProvenanceHandler := connect.NewUnaryHandler(
+		DeploymentServiceGetDeploymentProvenanceProcedure,
+		svc.GetDeploymentProvenance,
+		connect.WithSchema(deploymentServiceMethods.ByName("GetDeploymentProvenance")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/deployment.v1.DeploymentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DeploymentServiceCreateDeploymentProcedure:
@@ -205,6 +334,16 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 			deploymentServiceWatchDeploymentHandler.ServeHTTP(w, r)
 		case DeploymentServiceDeleteDeploymentProcedure:
 			deploymentServiceDeleteDeploymentHandler.ServeHTTP(w, r)
+		case DeploymentServiceListDeploymentHistoryProcedure:
+			deploymentServiceListDeploymentHistoryHandler.ServeHTTP(w, r)
+		case DeploymentServicePromoteDeploymentProcedure:
+			deploymentServicePromoteDeploymentHandler.ServeHTTP(w, r)
+		case DeploymentServiceListClusterDiscrepanciesProcedure:
+			deploymentServiceListClusterDiscrepanciesHandler.ServeHTTP(w, r)
+		case DeploymentServiceGetImageScanReportProcedure:
+			deploymentServiceGetImageScanReportHandler.ServeHTTP(w, r)
+		case DeploymentServiceGetDeploymentProvenanceProcedure:
+			deploymentServiceGetDeploymentProvenanceHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -233,3 +372,23 @@ func (UnimplementedDeploymentServiceHandler) WatchDeployment(context.Context, *c
 func (UnimplementedDeploymentServiceHandler) DeleteDeployment(context.Context, *connect.Request[v1.DeleteDeploymentRequest]) (*connect.Response[v1.DeleteDeploymentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deployment.v1.DeploymentService.DeleteDeployment is not implemented"))
 }
+
+func (UnimplementedDeploymentServiceHandler) ListDeploymentHistory(context.Context, *connect.Request[v1.ListDeploymentHistoryRequest]) (*connect.Response[v1.ListDeploymentHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deployment.v1.DeploymentService.ListDeploymentHistory is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) PromoteDeployment(context.Context, *connect.Request[v1.PromoteDeploymentRequest]) (*connect.Response[v1.PromoteDeploymentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deployment.v1.DeploymentService.PromoteDeployment is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) ListClusterDiscrepancies(context.Context, *connect.Request[v1.ListClusterDiscrepanciesRequest]) (*connect.Response[v1.ListClusterDiscrepanciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deployment.v1.DeploymentService.ListClusterDiscrepancies is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) GetImageScanReport(context.Context, *connect.Request[v1.GetImageScanReportRequest]) (*connect.Response[v1.GetImageScanReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deployment.v1.DeploymentService.GetImageScanReport is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) GetDeploymentProvenance(context.Context, *connect.Request[v1.GetDeploymentProvenanceRequest]) (*connect.Response[v1.GetDeploymentProvenanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deployment.v1.DeploymentService.GetDeploymentProvenance is not implemented"))
+}
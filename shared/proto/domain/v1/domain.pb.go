@@ -73,6 +73,106 @@ func (DomainType) EnumDescriptor() ([]byte, []int) {
 	return file_domain_v1_domain_proto_rawDescGZIP(), []int{0}
 }
 
+// DomainStatus indicates whether a domain's DNS records point at the platform yet.
+type DomainStatus int32
+
+const (
+	DomainStatus_DOMAIN_STATUS_UNSPECIFIED DomainStatus = 0
+	DomainStatus_DOMAIN_STATUS_PENDING     DomainStatus = 1 // a user-provided domain whose records have not propagated
+	DomainStatus_DOMAIN_STATUS_ACTIVE      DomainStatus = 2
+)
+
+// Enum value maps for DomainStatus.
+var (
+	DomainStatus_name = map[int32]string{
+		0: "DOMAIN_STATUS_UNSPECIFIED",
+		1: "DOMAIN_STATUS_PENDING",
+		2: "DOMAIN_STATUS_ACTIVE",
+	}
+	DomainStatus_value = map[string]int32{
+		"DOMAIN_STATUS_UNSPECIFIED": 0,
+		"DOMAIN_STATUS_PENDING":     1,
+		"DOMAIN_STATUS_ACTIVE":      2,
+	}
+)
+
+func (x DomainStatus) Enum() *DomainStatus {
+	p := new(DomainStatus)
+	*p = x
+	return p
+}
+
+func (x DomainStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DomainStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_domain_v1_domain_proto_enumTypes[1].Descriptor()
+}
+
+func (DomainStatus) Type() protoreflect.EnumType {
+	return &file_domain_v1_domain_proto_enumTypes[1]
+}
+
+func (x DomainStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DomainStatus.Descriptor instead.
+func (DomainStatus) EnumDescriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{1}
+}
+
+// TLSVersion is a minimum TLS version clients must speak.
+type TLSVersion int32
+
+const (
+	TLSVersion_TLS_VERSION_UNSPECIFIED TLSVersion = 0 // the platform's minimum
+	TLSVersion_TLS_VERSION_1_2         TLSVersion = 1
+	TLSVersion_TLS_VERSION_1_3         TLSVersion = 2
+)
+
+// Enum value maps for TLSVersion.
+var (
+	TLSVersion_name = map[int32]string{
+		0: "TLS_VERSION_UNSPECIFIED",
+		1: "TLS_VERSION_1_2",
+		2: "TLS_VERSION_1_3",
+	}
+	TLSVersion_value = map[string]int32{
+		"TLS_VERSION_UNSPECIFIED": 0,
+		"TLS_VERSION_1_2":         1,
+		"TLS_VERSION_1_3":         2,
+	}
+)
+
+func (x TLSVersion) Enum() *TLSVersion {
+	p := new(TLSVersion)
+	*p = x
+	return p
+}
+
+func (x TLSVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TLSVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_domain_v1_domain_proto_enumTypes[2].Descriptor()
+}
+
+func (TLSVersion) Type() protoreflect.EnumType {
+	return &file_domain_v1_domain_proto_enumTypes[2]
+}
+
+func (x TLSVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TLSVersion.Descriptor instead.
+func (TLSVersion) EnumDescriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{2}
+}
+
 // PlatformDomain represents a platform-provided domain.
 type PlatformDomain struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -231,6 +331,11 @@ type ResourceDomain struct {
 	IsPrimary        bool                   `protobuf:"varint,7,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
 	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AccessControl    *AccessControl         `protobuf:"bytes,10,opt,name=access_control,json=accessControl,proto3,oneof" json:"access_control,omitempty"` // unset when the domain is public; secrets are never returned
+	Status           DomainStatus           `protobuf:"varint,11,opt,name=status,proto3,enum=domain.v1.DomainStatus" json:"status,omitempty"`
+	StatusMessage    string                 `protobuf:"bytes,12,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"` // why a pending domain is not active yet
+	Redirect         *DomainRedirect        `protobuf:"bytes,13,opt,name=redirect,proto3,oneof" json:"redirect,omitempty"`                          // unset when the domain serves the resource
+	Tls              *TLSPolicy             `protobuf:"bytes,14,opt,name=tls,proto3,oneof" json:"tls,omitempty"`                                    // unset keeps the platform's TLS defaults
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -328,29 +433,66 @@ func (x *ResourceDomain) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// CreatePlatformDomainRequest is the request to create a platform domain.
-type CreatePlatformDomainRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ResourceDomain) GetAccessControl() *AccessControl {
+	if x != nil {
+		return x.AccessControl
+	}
+	return nil
 }
 
-func (x *CreatePlatformDomainRequest) Reset() {
-	*x = CreatePlatformDomainRequest{}
+func (x *ResourceDomain) GetStatus() DomainStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DomainStatus_DOMAIN_STATUS_UNSPECIFIED
+}
+
+func (x *ResourceDomain) GetStatusMessage() string {
+	if x != nil {
+		return x.StatusMessage
+	}
+	return ""
+}
+
+func (x *ResourceDomain) GetRedirect() *DomainRedirect {
+	if x != nil {
+		return x.Redirect
+	}
+	return nil
+}
+
+func (x *ResourceDomain) GetTls() *TLSPolicy {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+// TLSPolicy tightens TLS for a domain. It takes effect while the domain is the one serving the
+// resource.
+type TLSPolicy struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MinVersion          TLSVersion             `protobuf:"varint,1,opt,name=min_version,json=minVersion,proto3,enum=domain.v1.TLSVersion" json:"min_version,omitempty"`
+	HstsMaxAge          int32                  `protobuf:"varint,2,opt,name=hsts_max_age,json=hstsMaxAge,proto3" json:"hsts_max_age,omitempty"`                           // seconds; 0 sends no Strict-Transport-Security header
+	ClientCaCertificate string                 `protobuf:"bytes,3,opt,name=client_ca_certificate,json=clientCaCertificate,proto3" json:"client_ca_certificate,omitempty"` // PEM CA bundle; when set, clients must present a certificate it signed
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TLSPolicy) Reset() {
+	*x = TLSPolicy{}
 	mi := &file_domain_v1_domain_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePlatformDomainRequest) String() string {
+func (x *TLSPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePlatformDomainRequest) ProtoMessage() {}
+func (*TLSPolicy) ProtoMessage() {}
 
-func (x *CreatePlatformDomainRequest) ProtoReflect() protoreflect.Message {
+func (x *TLSPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_v1_domain_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -362,47 +504,57 @@ func (x *CreatePlatformDomainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePlatformDomainRequest.ProtoReflect.Descriptor instead.
-func (*CreatePlatformDomainRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TLSPolicy.ProtoReflect.Descriptor instead.
+func (*TLSPolicy) Descriptor() ([]byte, []int) {
 	return file_domain_v1_domain_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreatePlatformDomainRequest) GetDomain() string {
+func (x *TLSPolicy) GetMinVersion() TLSVersion {
 	if x != nil {
-		return x.Domain
+		return x.MinVersion
 	}
-	return ""
+	return TLSVersion_TLS_VERSION_UNSPECIFIED
 }
 
-func (x *CreatePlatformDomainRequest) GetIsActive() bool {
+func (x *TLSPolicy) GetHstsMaxAge() int32 {
 	if x != nil {
-		return x.IsActive
+		return x.HstsMaxAge
 	}
-	return false
+	return 0
 }
 
-// CreatePlatformDomainResponse is the response containing the created platform domain ID.
-type CreatePlatformDomainResponse struct {
+func (x *TLSPolicy) GetClientCaCertificate() string {
+	if x != nil {
+		return x.ClientCaCertificate
+	}
+	return ""
+}
+
+// DomainRedirect answers every request for a domain with a redirect, e.g. www to the apex or an
+// old domain to a new one.
+type DomainRedirect struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetDomain  string                 `protobuf:"bytes,1,opt,name=target_domain,json=targetDomain,proto3" json:"target_domain,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`       // 301 (default) or 302
+	PreservePath  bool                   `protobuf:"varint,3,opt,name=preserve_path,json=preservePath,proto3" json:"preserve_path,omitempty"` // keep the request's path rather than redirecting to /
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePlatformDomainResponse) Reset() {
-	*x = CreatePlatformDomainResponse{}
+func (x *DomainRedirect) Reset() {
+	*x = DomainRedirect{}
 	mi := &file_domain_v1_domain_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePlatformDomainResponse) String() string {
+func (x *DomainRedirect) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePlatformDomainResponse) ProtoMessage() {}
+func (*DomainRedirect) ProtoMessage() {}
 
-func (x *CreatePlatformDomainResponse) ProtoReflect() protoreflect.Message {
+func (x *DomainRedirect) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_v1_domain_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -414,44 +566,57 @@ func (x *CreatePlatformDomainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePlatformDomainResponse.ProtoReflect.Descriptor instead.
-func (*CreatePlatformDomainResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DomainRedirect.ProtoReflect.Descriptor instead.
+func (*DomainRedirect) Descriptor() ([]byte, []int) {
 	return file_domain_v1_domain_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreatePlatformDomainResponse) GetId() int64 {
+func (x *DomainRedirect) GetTargetDomain() string {
 	if x != nil {
-		return x.Id
+		return x.TargetDomain
+	}
+	return ""
+}
+
+func (x *DomainRedirect) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
 	}
 	return 0
 }
 
-// GetPlatformDomainRequest is the request to retrieve a platform domain.
-type GetPlatformDomainRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Key:
-	//
-	//	*GetPlatformDomainRequest_Id
-	//	*GetPlatformDomainRequest_Domain
-	Key           isGetPlatformDomainRequest_Key `protobuf_oneof:"key"`
+func (x *DomainRedirect) GetPreservePath() bool {
+	if x != nil {
+		return x.PreservePath
+	}
+	return false
+}
+
+// AccessControl restricts who can reach a resource domain, e.g. for staging environments.
+// An allowlist can be combined with either basic auth or OIDC, but not both.
+type AccessControl struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllowedCidrs  []string               `protobuf:"bytes,1,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"` // e.g., "203.0.113.0/24"; empty allows every address
+	BasicAuth     *BasicAuth             `protobuf:"bytes,2,opt,name=basic_auth,json=basicAuth,proto3,oneof" json:"basic_auth,omitempty"`
+	Oidc          *OIDCAuth              `protobuf:"bytes,3,opt,name=oidc,proto3,oneof" json:"oidc,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPlatformDomainRequest) Reset() {
-	*x = GetPlatformDomainRequest{}
+func (x *AccessControl) Reset() {
+	*x = AccessControl{}
 	mi := &file_domain_v1_domain_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlatformDomainRequest) String() string {
+func (x *AccessControl) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlatformDomainRequest) ProtoMessage() {}
+func (*AccessControl) ProtoMessage() {}
 
-func (x *GetPlatformDomainRequest) ProtoReflect() protoreflect.Message {
+func (x *AccessControl) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_v1_domain_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -463,74 +628,54 @@ func (x *GetPlatformDomainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlatformDomainRequest.ProtoReflect.Descriptor instead.
-func (*GetPlatformDomainRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccessControl.ProtoReflect.Descriptor instead.
+func (*AccessControl) Descriptor() ([]byte, []int) {
 	return file_domain_v1_domain_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetPlatformDomainRequest) GetKey() isGetPlatformDomainRequest_Key {
+func (x *AccessControl) GetAllowedCidrs() []string {
 	if x != nil {
-		return x.Key
+		return x.AllowedCidrs
 	}
 	return nil
 }
 
-func (x *GetPlatformDomainRequest) GetId() int64 {
+func (x *AccessControl) GetBasicAuth() *BasicAuth {
 	if x != nil {
-		if x, ok := x.Key.(*GetPlatformDomainRequest_Id); ok {
-			return x.Id
-		}
+		return x.BasicAuth
 	}
-	return 0
+	return nil
 }
 
-func (x *GetPlatformDomainRequest) GetDomain() string {
+func (x *AccessControl) GetOidc() *OIDCAuth {
 	if x != nil {
-		if x, ok := x.Key.(*GetPlatformDomainRequest_Domain); ok {
-			return x.Domain
-		}
+		return x.Oidc
 	}
-	return ""
-}
-
-type isGetPlatformDomainRequest_Key interface {
-	isGetPlatformDomainRequest_Key()
-}
-
-type GetPlatformDomainRequest_Id struct {
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3,oneof"`
-}
-
-type GetPlatformDomainRequest_Domain struct {
-	Domain string `protobuf:"bytes,2,opt,name=domain,proto3,oneof"`
+	return nil
 }
 
-func (*GetPlatformDomainRequest_Id) isGetPlatformDomainRequest_Key() {}
-
-func (*GetPlatformDomainRequest_Domain) isGetPlatformDomainRequest_Key() {}
-
-// GetPlatformDomainResponse is the response containing the platform domain.
-type GetPlatformDomainResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	PlatformDomain *PlatformDomain        `protobuf:"bytes,1,opt,name=platform_domain,json=platformDomain,proto3" json:"platform_domain,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// BasicAuth protects a domain with HTTP basic auth.
+type BasicAuth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*BasicAuthUser       `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPlatformDomainResponse) Reset() {
-	*x = GetPlatformDomainResponse{}
+func (x *BasicAuth) Reset() {
+	*x = BasicAuth{}
 	mi := &file_domain_v1_domain_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlatformDomainResponse) String() string {
+func (x *BasicAuth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlatformDomainResponse) ProtoMessage() {}
+func (*BasicAuth) ProtoMessage() {}
 
-func (x *GetPlatformDomainResponse) ProtoReflect() protoreflect.Message {
+func (x *BasicAuth) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_v1_domain_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,40 +687,41 @@ func (x *GetPlatformDomainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlatformDomainResponse.ProtoReflect.Descriptor instead.
-func (*GetPlatformDomainResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BasicAuth.ProtoReflect.Descriptor instead.
+func (*BasicAuth) Descriptor() ([]byte, []int) {
 	return file_domain_v1_domain_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetPlatformDomainResponse) GetPlatformDomain() *PlatformDomain {
+func (x *BasicAuth) GetUsers() []*BasicAuthUser {
 	if x != nil {
-		return x.PlatformDomain
+		return x.Users
 	}
 	return nil
 }
 
-// ListPlatformDomainsRequest is the request to list platform domains.
-type ListPlatformDomainsRequest struct {
+// BasicAuthUser is a username and password accepted by basic auth.
+type BasicAuthUser struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActiveOnly    *bool                  `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3,oneof" json:"active_only,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // write-only; stored hashed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPlatformDomainsRequest) Reset() {
-	*x = ListPlatformDomainsRequest{}
+func (x *BasicAuthUser) Reset() {
+	*x = BasicAuthUser{}
 	mi := &file_domain_v1_domain_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPlatformDomainsRequest) String() string {
+func (x *BasicAuthUser) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPlatformDomainsRequest) ProtoMessage() {}
+func (*BasicAuthUser) ProtoMessage() {}
 
-func (x *ListPlatformDomainsRequest) ProtoReflect() protoreflect.Message {
+func (x *BasicAuthUser) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_v1_domain_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -587,40 +733,50 @@ func (x *ListPlatformDomainsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPlatformDomainsRequest.ProtoReflect.Descriptor instead.
-func (*ListPlatformDomainsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BasicAuthUser.ProtoReflect.Descriptor instead.
+func (*BasicAuthUser) Descriptor() ([]byte, []int) {
 	return file_domain_v1_domain_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListPlatformDomainsRequest) GetActiveOnly() bool {
-	if x != nil && x.ActiveOnly != nil {
-		return *x.ActiveOnly
+func (x *BasicAuthUser) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
-	return false
+	return ""
 }
 
-// ListPlatformDomainsResponse contains the list of platform domains.
-type ListPlatformDomainsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	PlatformDomains []*PlatformDomain      `protobuf:"bytes,1,rep,name=platform_domains,json=platformDomains,proto3" json:"platform_domains,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *BasicAuthUser) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
 }
 
-func (x *ListPlatformDomainsResponse) Reset() {
-	*x = ListPlatformDomainsResponse{}
+// OIDCAuth signs visitors in with an OpenID Connect provider at the gateway.
+type OIDCAuth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issuer        string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"` // e.g., "https://accounts.google.com"
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`    // write-only
+	RedirectUrl   *string                `protobuf:"bytes,4,opt,name=redirect_url,json=redirectUrl,proto3,oneof" json:"redirect_url,omitempty"` // defaults to https://<domain>/oauth2/callback
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDCAuth) Reset() {
+	*x = OIDCAuth{}
 	mi := &file_domain_v1_domain_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPlatformDomainsResponse) String() string {
+func (x *OIDCAuth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPlatformDomainsResponse) ProtoMessage() {}
+func (*OIDCAuth) ProtoMessage() {}
 
-func (x *ListPlatformDomainsResponse) ProtoReflect() protoreflect.Message {
+func (x *OIDCAuth) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_v1_domain_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -632,43 +788,62 @@ func (x *ListPlatformDomainsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPlatformDomainsResponse.ProtoReflect.Descriptor instead.
-func (*ListPlatformDomainsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OIDCAuth.ProtoReflect.Descriptor instead.
+func (*OIDCAuth) Descriptor() ([]byte, []int) {
 	return file_domain_v1_domain_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListPlatformDomainsResponse) GetPlatformDomains() []*PlatformDomain {
+func (x *OIDCAuth) GetIssuer() string {
 	if x != nil {
-		return x.PlatformDomains
+		return x.Issuer
 	}
-	return nil
+	return ""
 }
 
-// UpdatePlatformDomainRequest is the request to update a platform domain.
-type UpdatePlatformDomainRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	Domain        *string                `protobuf:"bytes,3,opt,name=domain,proto3,oneof" json:"domain,omitempty"`
-	IsActive      *bool                  `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OIDCAuth) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
 }
 
-func (x *UpdatePlatformDomainRequest) Reset() {
-	*x = UpdatePlatformDomainRequest{}
-	mi := &file_domain_v1_domain_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OIDCAuth) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
 }
 
-func (x *UpdatePlatformDomainRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OIDCAuth) GetRedirectUrl() string {
+	if x != nil && x.RedirectUrl != nil {
+		return *x.RedirectUrl
+	}
+	return ""
 }
 
-func (*UpdatePlatformDomainRequest) ProtoMessage() {}
-
-func (x *UpdatePlatformDomainRequest) ProtoReflect() protoreflect.Message {
+// CreatePlatformDomainRequest is the request to create a platform domain.
+type CreatePlatformDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlatformDomainRequest) Reset() {
+	*x = CreatePlatformDomainRequest{}
+	mi := &file_domain_v1_domain_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlatformDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlatformDomainRequest) ProtoMessage() {}
+
+func (x *CreatePlatformDomainRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_v1_domain_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -680,62 +855,836 @@ func (x *UpdatePlatformDomainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePlatformDomainRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePlatformDomainRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CreatePlatformDomainRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlatformDomainRequest) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreatePlatformDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CreatePlatformDomainRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+// CreatePlatformDomainResponse is the response containing the created platform domain ID.
+type CreatePlatformDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlatformDomainResponse) Reset() {
+	*x = CreatePlatformDomainResponse{}
+	mi := &file_domain_v1_domain_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlatformDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlatformDomainResponse) ProtoMessage() {}
+
+func (x *CreatePlatformDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlatformDomainResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlatformDomainResponse) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreatePlatformDomainResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// GetPlatformDomainRequest is the request to retrieve a platform domain.
+type GetPlatformDomainRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Key:
+	//
+	//	*GetPlatformDomainRequest_Id
+	//	*GetPlatformDomainRequest_Domain
+	Key           isGetPlatformDomainRequest_Key `protobuf_oneof:"key"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlatformDomainRequest) Reset() {
+	*x = GetPlatformDomainRequest{}
+	mi := &file_domain_v1_domain_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlatformDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformDomainRequest) ProtoMessage() {}
+
+func (x *GetPlatformDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformDomainRequest.ProtoReflect.Descriptor instead.
+func (*GetPlatformDomainRequest) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetPlatformDomainRequest) GetKey() isGetPlatformDomainRequest_Key {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *GetPlatformDomainRequest) GetId() int64 {
+	if x != nil {
+		if x, ok := x.Key.(*GetPlatformDomainRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return 0
+}
+
+func (x *GetPlatformDomainRequest) GetDomain() string {
+	if x != nil {
+		if x, ok := x.Key.(*GetPlatformDomainRequest_Domain); ok {
+			return x.Domain
+		}
+	}
+	return ""
+}
+
+type isGetPlatformDomainRequest_Key interface {
+	isGetPlatformDomainRequest_Key()
+}
+
+type GetPlatformDomainRequest_Id struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3,oneof"`
+}
+
+type GetPlatformDomainRequest_Domain struct {
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3,oneof"`
+}
+
+func (*GetPlatformDomainRequest_Id) isGetPlatformDomainRequest_Key() {}
+
+func (*GetPlatformDomainRequest_Domain) isGetPlatformDomainRequest_Key() {}
+
+// GetPlatformDomainResponse is the response containing the platform domain.
+type GetPlatformDomainResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PlatformDomain *PlatformDomain        `protobuf:"bytes,1,opt,name=platform_domain,json=platformDomain,proto3" json:"platform_domain,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetPlatformDomainResponse) Reset() {
+	*x = GetPlatformDomainResponse{}
+	mi := &file_domain_v1_domain_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlatformDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformDomainResponse) ProtoMessage() {}
+
+func (x *GetPlatformDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformDomainResponse.ProtoReflect.Descriptor instead.
+func (*GetPlatformDomainResponse) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPlatformDomainResponse) GetPlatformDomain() *PlatformDomain {
+	if x != nil {
+		return x.PlatformDomain
+	}
+	return nil
+}
+
+// ListPlatformDomainsRequest is the request to list platform domains.
+type ListPlatformDomainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveOnly    *bool                  `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3,oneof" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlatformDomainsRequest) Reset() {
+	*x = ListPlatformDomainsRequest{}
+	mi := &file_domain_v1_domain_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlatformDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlatformDomainsRequest) ProtoMessage() {}
+
+func (x *ListPlatformDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlatformDomainsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlatformDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListPlatformDomainsRequest) GetActiveOnly() bool {
+	if x != nil && x.ActiveOnly != nil {
+		return *x.ActiveOnly
+	}
+	return false
+}
+
+// ListPlatformDomainsResponse contains the list of platform domains.
+type ListPlatformDomainsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PlatformDomains []*PlatformDomain      `protobuf:"bytes,1,rep,name=platform_domains,json=platformDomains,proto3" json:"platform_domains,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPlatformDomainsResponse) Reset() {
+	*x = ListPlatformDomainsResponse{}
+	mi := &file_domain_v1_domain_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlatformDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlatformDomainsResponse) ProtoMessage() {}
+
+func (x *ListPlatformDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlatformDomainsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlatformDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListPlatformDomainsResponse) GetPlatformDomains() []*PlatformDomain {
+	if x != nil {
+		return x.PlatformDomains
+	}
+	return nil
+}
+
+// UpdatePlatformDomainRequest is the request to update a platform domain.
+type UpdatePlatformDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Domain        *string                `protobuf:"bytes,3,opt,name=domain,proto3,oneof" json:"domain,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlatformDomainRequest) Reset() {
+	*x = UpdatePlatformDomainRequest{}
+	mi := &file_domain_v1_domain_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlatformDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlatformDomainRequest) ProtoMessage() {}
+
+func (x *UpdatePlatformDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlatformDomainRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlatformDomainRequest) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdatePlatformDomainRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdatePlatformDomainRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdatePlatformDomainRequest) GetDomain() string {
+	if x != nil && x.Domain != nil {
+		return *x.Domain
+	}
+	return ""
+}
+
+func (x *UpdatePlatformDomainRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+// UpdatePlatformDomainResponse is the response containing the updated platform domain ID.
+type UpdatePlatformDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlatformDomainResponse) Reset() {
+	*x = UpdatePlatformDomainResponse{}
+	mi := &file_domain_v1_domain_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlatformDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlatformDomainResponse) ProtoMessage() {}
+
+func (x *UpdatePlatformDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlatformDomainResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePlatformDomainResponse) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdatePlatformDomainResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeletePlatformDomainRequest is the request to delete a platform domain.
+type DeletePlatformDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlatformDomainRequest) Reset() {
+	*x = DeletePlatformDomainRequest{}
+	mi := &file_domain_v1_domain_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlatformDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlatformDomainRequest) ProtoMessage() {}
+
+func (x *DeletePlatformDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlatformDomainRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlatformDomainRequest) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeletePlatformDomainRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeletePlatformDomainResponse is the response after deleting a platform domain.
+type DeletePlatformDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlatformDomainResponse) Reset() {
+	*x = DeletePlatformDomainResponse{}
+	mi := &file_domain_v1_domain_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlatformDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlatformDomainResponse) ProtoMessage() {}
+
+func (x *DeletePlatformDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlatformDomainResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlatformDomainResponse) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{18}
+}
+
+// LocoOwnedDomain represents a platform-managed domain paired with a resource deployment.
+type LocoOwnedDomain struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Domain         string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	ResourceName   string                 `protobuf:"bytes,3,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	ResourceId     int64                  `protobuf:"varint,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	PlatformDomain string                 `protobuf:"bytes,5,opt,name=platform_domain,json=platformDomain,proto3" json:"platform_domain,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LocoOwnedDomain) Reset() {
+	*x = LocoOwnedDomain{}
+	mi := &file_domain_v1_domain_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocoOwnedDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocoOwnedDomain) ProtoMessage() {}
+
+func (x *LocoOwnedDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocoOwnedDomain.ProtoReflect.Descriptor instead.
+func (*LocoOwnedDomain) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LocoOwnedDomain) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LocoOwnedDomain) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *LocoOwnedDomain) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *LocoOwnedDomain) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *LocoOwnedDomain) GetPlatformDomain() string {
+	if x != nil {
+		return x.PlatformDomain
+	}
+	return ""
+}
+
+// ListLocoOwnedDomainsRequest is the request to list all Loco-owned domains.
+type ListLocoOwnedDomainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLocoOwnedDomainsRequest) Reset() {
+	*x = ListLocoOwnedDomainsRequest{}
+	mi := &file_domain_v1_domain_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLocoOwnedDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocoOwnedDomainsRequest) ProtoMessage() {}
+
+func (x *ListLocoOwnedDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocoOwnedDomainsRequest.ProtoReflect.Descriptor instead.
+func (*ListLocoOwnedDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{20}
+}
+
+// ListLocoOwnedDomainsResponse contains the list of Loco-owned domains.
+type ListLocoOwnedDomainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domains       []*LocoOwnedDomain     `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLocoOwnedDomainsResponse) Reset() {
+	*x = ListLocoOwnedDomainsResponse{}
+	mi := &file_domain_v1_domain_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLocoOwnedDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocoOwnedDomainsResponse) ProtoMessage() {}
+
+func (x *ListLocoOwnedDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocoOwnedDomainsResponse.ProtoReflect.Descriptor instead.
+func (*ListLocoOwnedDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListLocoOwnedDomainsResponse) GetDomains() []*LocoOwnedDomain {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+// CreateResourceDomainRequest is the request to add a domain to a resource.
+type CreateResourceDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Domain        *DomainInput           `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResourceDomainRequest) Reset() {
+	*x = CreateResourceDomainRequest{}
+	mi := &file_domain_v1_domain_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResourceDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResourceDomainRequest) ProtoMessage() {}
+
+func (x *CreateResourceDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResourceDomainRequest.ProtoReflect.Descriptor instead.
+func (*CreateResourceDomainRequest) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateResourceDomainRequest) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *CreateResourceDomainRequest) GetDomain() *DomainInput {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+// CreateResourceDomainResponse is the response containing the created resource domain.
+// Adding a domain the resource already has returns the existing domain.
+type CreateResourceDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      int64                  `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Domain        *ResourceDomain        `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"` // as stored, so clients need no follow-up read
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResourceDomainResponse) Reset() {
+	*x = CreateResourceDomainResponse{}
+	mi := &file_domain_v1_domain_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResourceDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResourceDomainResponse) ProtoMessage() {}
+
+func (x *CreateResourceDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResourceDomainResponse.ProtoReflect.Descriptor instead.
+func (*CreateResourceDomainResponse) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateResourceDomainResponse) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *CreateResourceDomainResponse) GetDomain() *ResourceDomain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+// UpdateResourceDomainRequest is the request to update a resource's domain.
+// update_mask lists the fields to change (only "domain"); when empty, set fields are changed.
+type UpdateResourceDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      int64                  `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Domain        *string                `protobuf:"bytes,3,opt,name=domain,proto3,oneof" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateResourceDomainRequest) Reset() {
+	*x = UpdateResourceDomainRequest{}
+	mi := &file_domain_v1_domain_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateResourceDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResourceDomainRequest) ProtoMessage() {}
+
+func (x *UpdateResourceDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResourceDomainRequest.ProtoReflect.Descriptor instead.
+func (*UpdateResourceDomainRequest) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UpdatePlatformDomainRequest) GetId() int64 {
+func (x *UpdateResourceDomainRequest) GetDomainId() int64 {
 	if x != nil {
-		return x.Id
+		return x.DomainId
 	}
 	return 0
 }
 
-func (x *UpdatePlatformDomainRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *UpdateResourceDomainRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.UpdateMask
 	}
 	return nil
 }
 
-func (x *UpdatePlatformDomainRequest) GetDomain() string {
+func (x *UpdateResourceDomainRequest) GetDomain() string {
 	if x != nil && x.Domain != nil {
 		return *x.Domain
 	}
 	return ""
 }
 
-func (x *UpdatePlatformDomainRequest) GetIsActive() bool {
-	if x != nil && x.IsActive != nil {
-		return *x.IsActive
-	}
-	return false
-}
-
-// UpdatePlatformDomainResponse is the response containing the updated platform domain ID.
-type UpdatePlatformDomainResponse struct {
+// UpdateResourceDomainResponse is the response containing the updated resource domain.
+type UpdateResourceDomainResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DomainId      int64                  `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Domain        *ResourceDomain        `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePlatformDomainResponse) Reset() {
-	*x = UpdatePlatformDomainResponse{}
-	mi := &file_domain_v1_domain_proto_msgTypes[10]
+func (x *UpdateResourceDomainResponse) Reset() {
+	*x = UpdateResourceDomainResponse{}
+	mi := &file_domain_v1_domain_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePlatformDomainResponse) String() string {
+func (x *UpdateResourceDomainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePlatformDomainResponse) ProtoMessage() {}
+func (*UpdateResourceDomainResponse) ProtoMessage() {}
 
-func (x *UpdatePlatformDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[10]
+func (x *UpdateResourceDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -746,41 +1695,49 @@ func (x *UpdatePlatformDomainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePlatformDomainResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePlatformDomainResponse) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpdateResourceDomainResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResourceDomainResponse) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UpdatePlatformDomainResponse) GetId() int64 {
+func (x *UpdateResourceDomainResponse) GetDomainId() int64 {
 	if x != nil {
-		return x.Id
+		return x.DomainId
 	}
 	return 0
 }
 
-// DeletePlatformDomainRequest is the request to delete a platform domain.
-type DeletePlatformDomainRequest struct {
+func (x *UpdateResourceDomainResponse) GetDomain() *ResourceDomain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+// SetPrimaryResourceDomainRequest is the request to set the primary domain for a resource.
+type SetPrimaryResourceDomainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	DomainId      int64                  `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePlatformDomainRequest) Reset() {
-	*x = DeletePlatformDomainRequest{}
-	mi := &file_domain_v1_domain_proto_msgTypes[11]
+func (x *SetPrimaryResourceDomainRequest) Reset() {
+	*x = SetPrimaryResourceDomainRequest{}
+	mi := &file_domain_v1_domain_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePlatformDomainRequest) String() string {
+func (x *SetPrimaryResourceDomainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePlatformDomainRequest) ProtoMessage() {}
+func (*SetPrimaryResourceDomainRequest) ProtoMessage() {}
 
-func (x *DeletePlatformDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[11]
+func (x *SetPrimaryResourceDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -791,40 +1748,49 @@ func (x *DeletePlatformDomainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePlatformDomainRequest.ProtoReflect.Descriptor instead.
-func (*DeletePlatformDomainRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SetPrimaryResourceDomainRequest.ProtoReflect.Descriptor instead.
+func (*SetPrimaryResourceDomainRequest) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DeletePlatformDomainRequest) GetId() int64 {
+func (x *SetPrimaryResourceDomainRequest) GetResourceId() int64 {
 	if x != nil {
-		return x.Id
+		return x.ResourceId
 	}
 	return 0
 }
 
-// DeletePlatformDomainResponse is the response after deleting a platform domain.
-type DeletePlatformDomainResponse struct {
+func (x *SetPrimaryResourceDomainRequest) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+// SetPrimaryResourceDomainResponse is the response containing the resource and domain IDs.
+type SetPrimaryResourceDomainResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	DomainId      int64                  `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePlatformDomainResponse) Reset() {
-	*x = DeletePlatformDomainResponse{}
-	mi := &file_domain_v1_domain_proto_msgTypes[12]
+func (x *SetPrimaryResourceDomainResponse) Reset() {
+	*x = SetPrimaryResourceDomainResponse{}
+	mi := &file_domain_v1_domain_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePlatformDomainResponse) String() string {
+func (x *SetPrimaryResourceDomainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePlatformDomainResponse) ProtoMessage() {}
+func (*SetPrimaryResourceDomainResponse) ProtoMessage() {}
 
-func (x *DeletePlatformDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[12]
+func (x *SetPrimaryResourceDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -835,38 +1801,48 @@ func (x *DeletePlatformDomainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePlatformDomainResponse.ProtoReflect.Descriptor instead.
-func (*DeletePlatformDomainResponse) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SetPrimaryResourceDomainResponse.ProtoReflect.Descriptor instead.
+func (*SetPrimaryResourceDomainResponse) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{27}
 }
 
-// LocoOwnedDomain represents a platform-managed domain paired with a resource deployment.
-type LocoOwnedDomain struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Domain         string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
-	ResourceName   string                 `protobuf:"bytes,3,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
-	ResourceId     int64                  `protobuf:"varint,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	PlatformDomain string                 `protobuf:"bytes,5,opt,name=platform_domain,json=platformDomain,proto3" json:"platform_domain,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *SetPrimaryResourceDomainResponse) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
 }
 
-func (x *LocoOwnedDomain) Reset() {
-	*x = LocoOwnedDomain{}
-	mi := &file_domain_v1_domain_proto_msgTypes[13]
+func (x *SetPrimaryResourceDomainResponse) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+// DeleteResourceDomainRequest is the request to remove a domain from a resource.
+type DeleteResourceDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      int64                  `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResourceDomainRequest) Reset() {
+	*x = DeleteResourceDomainRequest{}
+	mi := &file_domain_v1_domain_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LocoOwnedDomain) String() string {
+func (x *DeleteResourceDomainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LocoOwnedDomain) ProtoMessage() {}
+func (*DeleteResourceDomainRequest) ProtoMessage() {}
 
-func (x *LocoOwnedDomain) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[13]
+func (x *DeleteResourceDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,106 +1853,139 @@ func (x *LocoOwnedDomain) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LocoOwnedDomain.ProtoReflect.Descriptor instead.
-func (*LocoOwnedDomain) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DeleteResourceDomainRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResourceDomainRequest) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *LocoOwnedDomain) GetId() int64 {
+func (x *DeleteResourceDomainRequest) GetDomainId() int64 {
 	if x != nil {
-		return x.Id
+		return x.DomainId
 	}
 	return 0
 }
 
-func (x *LocoOwnedDomain) GetDomain() string {
-	if x != nil {
-		return x.Domain
-	}
-	return ""
+// DeleteResourceDomainResponse is the response after removing a domain from a resource.
+type DeleteResourceDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LocoOwnedDomain) GetResourceName() string {
-	if x != nil {
-		return x.ResourceName
-	}
-	return ""
+func (x *DeleteResourceDomainResponse) Reset() {
+	*x = DeleteResourceDomainResponse{}
+	mi := &file_domain_v1_domain_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *LocoOwnedDomain) GetResourceId() int64 {
-	if x != nil {
-		return x.ResourceId
-	}
-	return 0
+func (x *DeleteResourceDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *LocoOwnedDomain) GetPlatformDomain() string {
+func (*DeleteResourceDomainResponse) ProtoMessage() {}
+
+func (x *DeleteResourceDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[29]
 	if x != nil {
-		return x.PlatformDomain
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-// ListLocoOwnedDomainsRequest is the request to list all Loco-owned domains.
-type ListLocoOwnedDomainsRequest struct {
+// Deprecated: Use DeleteResourceDomainResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResourceDomainResponse) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{29}
+}
+
+// SetResourceDomainAccessControlRequest is the request to protect a resource domain.
+type SetResourceDomainAccessControlRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	DomainId      int64                  `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	AccessControl *AccessControl         `protobuf:"bytes,3,opt,name=access_control,json=accessControl,proto3,oneof" json:"access_control,omitempty"` // unset makes the domain public again
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLocoOwnedDomainsRequest) Reset() {
-	*x = ListLocoOwnedDomainsRequest{}
-	mi := &file_domain_v1_domain_proto_msgTypes[14]
+func (x *SetResourceDomainAccessControlRequest) Reset() {
+	*x = SetResourceDomainAccessControlRequest{}
+	mi := &file_domain_v1_domain_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLocoOwnedDomainsRequest) String() string {
+func (x *SetResourceDomainAccessControlRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLocoOwnedDomainsRequest) ProtoMessage() {}
+func (*SetResourceDomainAccessControlRequest) ProtoMessage() {}
+
+func (x *SetResourceDomainAccessControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResourceDomainAccessControlRequest.ProtoReflect.Descriptor instead.
+func (*SetResourceDomainAccessControlRequest) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetResourceDomainAccessControlRequest) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
 
-func (x *ListLocoOwnedDomainsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[14]
+func (x *SetResourceDomainAccessControlRequest) GetDomainId() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DomainId
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListLocoOwnedDomainsRequest.ProtoReflect.Descriptor instead.
-func (*ListLocoOwnedDomainsRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{14}
+func (x *SetResourceDomainAccessControlRequest) GetAccessControl() *AccessControl {
+	if x != nil {
+		return x.AccessControl
+	}
+	return nil
 }
 
-// ListLocoOwnedDomainsResponse contains the list of Loco-owned domains.
-type ListLocoOwnedDomainsResponse struct {
+// SetResourceDomainAccessControlResponse is the response containing the updated resource domain.
+type SetResourceDomainAccessControlResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domains       []*LocoOwnedDomain     `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	Domain        *ResourceDomain        `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLocoOwnedDomainsResponse) Reset() {
-	*x = ListLocoOwnedDomainsResponse{}
-	mi := &file_domain_v1_domain_proto_msgTypes[15]
+func (x *SetResourceDomainAccessControlResponse) Reset() {
+	*x = SetResourceDomainAccessControlResponse{}
+	mi := &file_domain_v1_domain_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLocoOwnedDomainsResponse) String() string {
+func (x *SetResourceDomainAccessControlResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLocoOwnedDomainsResponse) ProtoMessage() {}
+func (*SetResourceDomainAccessControlResponse) ProtoMessage() {}
 
-func (x *ListLocoOwnedDomainsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[15]
+func (x *SetResourceDomainAccessControlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -987,42 +1996,43 @@ func (x *ListLocoOwnedDomainsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLocoOwnedDomainsResponse.ProtoReflect.Descriptor instead.
-func (*ListLocoOwnedDomainsResponse) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SetResourceDomainAccessControlResponse.ProtoReflect.Descriptor instead.
+func (*SetResourceDomainAccessControlResponse) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListLocoOwnedDomainsResponse) GetDomains() []*LocoOwnedDomain {
+func (x *SetResourceDomainAccessControlResponse) GetDomain() *ResourceDomain {
 	if x != nil {
-		return x.Domains
+		return x.Domain
 	}
 	return nil
 }
 
-// CreateResourceDomainRequest is the request to add a domain to a resource.
-type CreateResourceDomainRequest struct {
+// SetResourceDomainRedirectRequest is the request to redirect a resource domain.
+type SetResourceDomainRedirectRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	Domain        *DomainInput           `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	DomainId      int64                  `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Redirect      *DomainRedirect        `protobuf:"bytes,3,opt,name=redirect,proto3,oneof" json:"redirect,omitempty"` // unset serves the resource on the domain again
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateResourceDomainRequest) Reset() {
-	*x = CreateResourceDomainRequest{}
-	mi := &file_domain_v1_domain_proto_msgTypes[16]
+func (x *SetResourceDomainRedirectRequest) Reset() {
+	*x = SetResourceDomainRedirectRequest{}
+	mi := &file_domain_v1_domain_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateResourceDomainRequest) String() string {
+func (x *SetResourceDomainRedirectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateResourceDomainRequest) ProtoMessage() {}
+func (*SetResourceDomainRedirectRequest) ProtoMessage() {}
 
-func (x *CreateResourceDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[16]
+func (x *SetResourceDomainRedirectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1033,48 +2043,55 @@ func (x *CreateResourceDomainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateResourceDomainRequest.ProtoReflect.Descriptor instead.
-func (*CreateResourceDomainRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SetResourceDomainRedirectRequest.ProtoReflect.Descriptor instead.
+func (*SetResourceDomainRedirectRequest) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CreateResourceDomainRequest) GetResourceId() int64 {
+func (x *SetResourceDomainRedirectRequest) GetResourceId() int64 {
 	if x != nil {
 		return x.ResourceId
 	}
 	return 0
 }
 
-func (x *CreateResourceDomainRequest) GetDomain() *DomainInput {
+func (x *SetResourceDomainRedirectRequest) GetDomainId() int64 {
 	if x != nil {
-		return x.Domain
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *SetResourceDomainRedirectRequest) GetRedirect() *DomainRedirect {
+	if x != nil {
+		return x.Redirect
 	}
 	return nil
 }
 
-// CreateResourceDomainResponse is the response containing the created resource domain ID.
-type CreateResourceDomainResponse struct {
+// SetResourceDomainRedirectResponse is the response containing the updated resource domain.
+type SetResourceDomainRedirectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DomainId      int64                  `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Domain        *ResourceDomain        `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateResourceDomainResponse) Reset() {
-	*x = CreateResourceDomainResponse{}
-	mi := &file_domain_v1_domain_proto_msgTypes[17]
+func (x *SetResourceDomainRedirectResponse) Reset() {
+	*x = SetResourceDomainRedirectResponse{}
+	mi := &file_domain_v1_domain_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateResourceDomainResponse) String() string {
+func (x *SetResourceDomainRedirectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateResourceDomainResponse) ProtoMessage() {}
+func (*SetResourceDomainRedirectResponse) ProtoMessage() {}
 
-func (x *CreateResourceDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[17]
+func (x *SetResourceDomainRedirectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1085,43 +2102,43 @@ func (x *CreateResourceDomainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateResourceDomainResponse.ProtoReflect.Descriptor instead.
-func (*CreateResourceDomainResponse) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SetResourceDomainRedirectResponse.ProtoReflect.Descriptor instead.
+func (*SetResourceDomainRedirectResponse) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *CreateResourceDomainResponse) GetDomainId() int64 {
+func (x *SetResourceDomainRedirectResponse) GetDomain() *ResourceDomain {
 	if x != nil {
-		return x.DomainId
+		return x.Domain
 	}
-	return 0
+	return nil
 }
 
-// UpdateResourceDomainRequest is the request to update a resource's domain.
-type UpdateResourceDomainRequest struct {
+// SetResourceDomainTLSPolicyRequest is the request to set the TLS policy of a resource domain.
+type SetResourceDomainTLSPolicyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DomainId      int64                  `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	Domain        *string                `protobuf:"bytes,3,opt,name=domain,proto3,oneof" json:"domain,omitempty"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	DomainId      int64                  `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Tls           *TLSPolicy             `protobuf:"bytes,3,opt,name=tls,proto3,oneof" json:"tls,omitempty"` // unset restores the platform's TLS defaults
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateResourceDomainRequest) Reset() {
-	*x = UpdateResourceDomainRequest{}
-	mi := &file_domain_v1_domain_proto_msgTypes[18]
+func (x *SetResourceDomainTLSPolicyRequest) Reset() {
+	*x = SetResourceDomainTLSPolicyRequest{}
+	mi := &file_domain_v1_domain_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateResourceDomainRequest) String() string {
+func (x *SetResourceDomainTLSPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateResourceDomainRequest) ProtoMessage() {}
+func (*SetResourceDomainTLSPolicyRequest) ProtoMessage() {}
 
-func (x *UpdateResourceDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[18]
+func (x *SetResourceDomainTLSPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1132,55 +2149,55 @@ func (x *UpdateResourceDomainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateResourceDomainRequest.ProtoReflect.Descriptor instead.
-func (*UpdateResourceDomainRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SetResourceDomainTLSPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetResourceDomainTLSPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UpdateResourceDomainRequest) GetDomainId() int64 {
+func (x *SetResourceDomainTLSPolicyRequest) GetResourceId() int64 {
 	if x != nil {
-		return x.DomainId
+		return x.ResourceId
 	}
 	return 0
 }
 
-func (x *UpdateResourceDomainRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *SetResourceDomainTLSPolicyRequest) GetDomainId() int64 {
 	if x != nil {
-		return x.UpdateMask
+		return x.DomainId
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateResourceDomainRequest) GetDomain() string {
-	if x != nil && x.Domain != nil {
-		return *x.Domain
+func (x *SetResourceDomainTLSPolicyRequest) GetTls() *TLSPolicy {
+	if x != nil {
+		return x.Tls
 	}
-	return ""
+	return nil
 }
 
-// UpdateResourceDomainResponse is the response containing the updated resource domain ID.
-type UpdateResourceDomainResponse struct {
+// SetResourceDomainTLSPolicyResponse is the response containing the updated resource domain.
+type SetResourceDomainTLSPolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DomainId      int64                  `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Domain        *ResourceDomain        `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateResourceDomainResponse) Reset() {
-	*x = UpdateResourceDomainResponse{}
-	mi := &file_domain_v1_domain_proto_msgTypes[19]
+func (x *SetResourceDomainTLSPolicyResponse) Reset() {
+	*x = SetResourceDomainTLSPolicyResponse{}
+	mi := &file_domain_v1_domain_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateResourceDomainResponse) String() string {
+func (x *SetResourceDomainTLSPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateResourceDomainResponse) ProtoMessage() {}
+func (*SetResourceDomainTLSPolicyResponse) ProtoMessage() {}
 
-func (x *UpdateResourceDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[19]
+func (x *SetResourceDomainTLSPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1191,42 +2208,42 @@ func (x *UpdateResourceDomainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateResourceDomainResponse.ProtoReflect.Descriptor instead.
-func (*UpdateResourceDomainResponse) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SetResourceDomainTLSPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetResourceDomainTLSPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *UpdateResourceDomainResponse) GetDomainId() int64 {
+func (x *SetResourceDomainTLSPolicyResponse) GetDomain() *ResourceDomain {
 	if x != nil {
-		return x.DomainId
+		return x.Domain
 	}
-	return 0
+	return nil
 }
 
-// SetPrimaryResourceDomainRequest is the request to set the primary domain for a resource.
-type SetPrimaryResourceDomainRequest struct {
+// GetDomainSetupInstructionsRequest is the request for the DNS records a domain needs.
+type GetDomainSetupInstructionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	DomainId      int64                  `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	DomainId      int64                  `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Provider      *string                `protobuf:"bytes,2,opt,name=provider,proto3,oneof" json:"provider,omitempty"` // e.g., "cloudflare"; unset returns instructions for every known provider
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetPrimaryResourceDomainRequest) Reset() {
-	*x = SetPrimaryResourceDomainRequest{}
-	mi := &file_domain_v1_domain_proto_msgTypes[20]
+func (x *GetDomainSetupInstructionsRequest) Reset() {
+	*x = GetDomainSetupInstructionsRequest{}
+	mi := &file_domain_v1_domain_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetPrimaryResourceDomainRequest) String() string {
+func (x *GetDomainSetupInstructionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPrimaryResourceDomainRequest) ProtoMessage() {}
+func (*GetDomainSetupInstructionsRequest) ProtoMessage() {}
 
-func (x *SetPrimaryResourceDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[20]
+func (x *GetDomainSetupInstructionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,49 +2254,50 @@ func (x *SetPrimaryResourceDomainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPrimaryResourceDomainRequest.ProtoReflect.Descriptor instead.
-func (*SetPrimaryResourceDomainRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetDomainSetupInstructionsRequest.ProtoReflect.Descriptor instead.
+func (*GetDomainSetupInstructionsRequest) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SetPrimaryResourceDomainRequest) GetResourceId() int64 {
+func (x *GetDomainSetupInstructionsRequest) GetDomainId() int64 {
 	if x != nil {
-		return x.ResourceId
+		return x.DomainId
 	}
 	return 0
 }
 
-func (x *SetPrimaryResourceDomainRequest) GetDomainId() int64 {
-	if x != nil {
-		return x.DomainId
+func (x *GetDomainSetupInstructionsRequest) GetProvider() string {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
 	}
-	return 0
+	return ""
 }
 
-// SetPrimaryResourceDomainResponse is the response containing the resource and domain IDs.
-type SetPrimaryResourceDomainResponse struct {
+// DnsRecordInstruction is a record to create at the domain's DNS provider.
+type DnsRecordInstruction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	DomainId      int64                  `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // e.g., "CNAME", "ALIAS", "A"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // relative to the zone, "@" for the apex
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetPrimaryResourceDomainResponse) Reset() {
-	*x = SetPrimaryResourceDomainResponse{}
-	mi := &file_domain_v1_domain_proto_msgTypes[21]
+func (x *DnsRecordInstruction) Reset() {
+	*x = DnsRecordInstruction{}
+	mi := &file_domain_v1_domain_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetPrimaryResourceDomainResponse) String() string {
+func (x *DnsRecordInstruction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPrimaryResourceDomainResponse) ProtoMessage() {}
+func (*DnsRecordInstruction) ProtoMessage() {}
 
-func (x *SetPrimaryResourceDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[21]
+func (x *DnsRecordInstruction) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1290,48 +2308,57 @@ func (x *SetPrimaryResourceDomainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPrimaryResourceDomainResponse.ProtoReflect.Descriptor instead.
-func (*SetPrimaryResourceDomainResponse) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DnsRecordInstruction.ProtoReflect.Descriptor instead.
+func (*DnsRecordInstruction) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SetPrimaryResourceDomainResponse) GetResourceId() int64 {
+func (x *DnsRecordInstruction) GetType() string {
 	if x != nil {
-		return x.ResourceId
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *SetPrimaryResourceDomainResponse) GetDomainId() int64 {
+func (x *DnsRecordInstruction) GetName() string {
 	if x != nil {
-		return x.DomainId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-// DeleteResourceDomainRequest is the request to remove a domain from a resource.
-type DeleteResourceDomainRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DomainId      int64                  `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+func (x *DnsRecordInstruction) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// ProviderSetupInstructions are the records to create with one DNS provider.
+type ProviderSetupInstructions struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Provider      string                  `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // e.g., "cloudflare", "route53", "other"
+	Records       []*DnsRecordInstruction `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+	Note          string                  `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteResourceDomainRequest) Reset() {
-	*x = DeleteResourceDomainRequest{}
-	mi := &file_domain_v1_domain_proto_msgTypes[22]
+func (x *ProviderSetupInstructions) Reset() {
+	*x = ProviderSetupInstructions{}
+	mi := &file_domain_v1_domain_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResourceDomainRequest) String() string {
+func (x *ProviderSetupInstructions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResourceDomainRequest) ProtoMessage() {}
+func (*ProviderSetupInstructions) ProtoMessage() {}
 
-func (x *DeleteResourceDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[22]
+func (x *ProviderSetupInstructions) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1342,40 +2369,60 @@ func (x *DeleteResourceDomainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResourceDomainRequest.ProtoReflect.Descriptor instead.
-func (*DeleteResourceDomainRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ProviderSetupInstructions.ProtoReflect.Descriptor instead.
+func (*ProviderSetupInstructions) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *DeleteResourceDomainRequest) GetDomainId() int64 {
+func (x *ProviderSetupInstructions) GetProvider() string {
 	if x != nil {
-		return x.DomainId
+		return x.Provider
 	}
-	return 0
+	return ""
 }
 
-// DeleteResourceDomainResponse is the response after removing a domain from a resource.
-type DeleteResourceDomainResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *ProviderSetupInstructions) GetRecords() []*DnsRecordInstruction {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *ProviderSetupInstructions) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// GetDomainSetupInstructionsResponse contains the records the domain needs.
+type GetDomainSetupInstructionsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Domain        string                       `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Apex          bool                         `protobuf:"varint,2,opt,name=apex,proto3" json:"apex,omitempty"`    // apex domains cannot use a CNAME at most providers
+	Target        string                       `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"` // ingress the records point at
+	Providers     []*ProviderSetupInstructions `protobuf:"bytes,4,rep,name=providers,proto3" json:"providers,omitempty"`
+	Status        DomainStatus                 `protobuf:"varint,5,opt,name=status,proto3,enum=domain.v1.DomainStatus" json:"status,omitempty"`
+	StatusMessage string                       `protobuf:"bytes,6,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteResourceDomainResponse) Reset() {
-	*x = DeleteResourceDomainResponse{}
-	mi := &file_domain_v1_domain_proto_msgTypes[23]
+func (x *GetDomainSetupInstructionsResponse) Reset() {
+	*x = GetDomainSetupInstructionsResponse{}
+	mi := &file_domain_v1_domain_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResourceDomainResponse) String() string {
+func (x *GetDomainSetupInstructionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResourceDomainResponse) ProtoMessage() {}
+func (*GetDomainSetupInstructionsResponse) ProtoMessage() {}
 
-func (x *DeleteResourceDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[23]
+func (x *GetDomainSetupInstructionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_domain_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,9 +2433,51 @@ func (x *DeleteResourceDomainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResourceDomainResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResourceDomainResponse) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetDomainSetupInstructionsResponse.ProtoReflect.Descriptor instead.
+func (*GetDomainSetupInstructionsResponse) Descriptor() ([]byte, []int) {
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetDomainSetupInstructionsResponse) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *GetDomainSetupInstructionsResponse) GetApex() bool {
+	if x != nil {
+		return x.Apex
+	}
+	return false
+}
+
+func (x *GetDomainSetupInstructionsResponse) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *GetDomainSetupInstructionsResponse) GetProviders() []*ProviderSetupInstructions {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *GetDomainSetupInstructionsResponse) GetStatus() DomainStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DomainStatus_DOMAIN_STATUS_UNSPECIFIED
+}
+
+func (x *GetDomainSetupInstructionsResponse) GetStatusMessage() string {
+	if x != nil {
+		return x.StatusMessage
+	}
+	return ""
 }
 
 // CheckDomainAvailabilityRequest is the request to check if a domain is available.
@@ -1401,7 +2490,7 @@ type CheckDomainAvailabilityRequest struct {
 
 func (x *CheckDomainAvailabilityRequest) Reset() {
 	*x = CheckDomainAvailabilityRequest{}
-	mi := &file_domain_v1_domain_proto_msgTypes[24]
+	mi := &file_domain_v1_domain_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1413,7 +2502,7 @@ func (x *CheckDomainAvailabilityRequest) String() string {
 func (*CheckDomainAvailabilityRequest) ProtoMessage() {}
 
 func (x *CheckDomainAvailabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[24]
+	mi := &file_domain_v1_domain_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1426,7 +2515,7 @@ func (x *CheckDomainAvailabilityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckDomainAvailabilityRequest.ProtoReflect.Descriptor instead.
 func (*CheckDomainAvailabilityRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{24}
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *CheckDomainAvailabilityRequest) GetDomain() string {
@@ -1446,7 +2535,7 @@ type CheckDomainAvailabilityResponse struct {
 
 func (x *CheckDomainAvailabilityResponse) Reset() {
 	*x = CheckDomainAvailabilityResponse{}
-	mi := &file_domain_v1_domain_proto_msgTypes[25]
+	mi := &file_domain_v1_domain_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1458,7 +2547,7 @@ func (x *CheckDomainAvailabilityResponse) String() string {
 func (*CheckDomainAvailabilityResponse) ProtoMessage() {}
 
 func (x *CheckDomainAvailabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_domain_proto_msgTypes[25]
+	mi := &file_domain_v1_domain_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1471,7 +2560,7 @@ func (x *CheckDomainAvailabilityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckDomainAvailabilityResponse.ProtoReflect.Descriptor instead.
 func (*CheckDomainAvailabilityResponse) Descriptor() ([]byte, []int) {
-	return file_domain_v1_domain_proto_rawDescGZIP(), []int{25}
+	return file_domain_v1_domain_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CheckDomainAvailabilityResponse) GetIsAvailable() bool {
@@ -1502,7 +2591,7 @@ const file_domain_v1_domain_proto_rawDesc = "" +
 	"\n" +
 	"_subdomainB\x15\n" +
 	"\x13_platform_domain_idB\t\n" +
-	"\a_domain\"\xb6\x03\n" +
+	"\a_domain\"\xe5\x05\n" +
 	"\x0eResourceDomain\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vresource_id\x18\x02 \x01(\x03R\n" +
@@ -1516,9 +2605,47 @@ const file_domain_v1_domain_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x12\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12D\n" +
+	"\x0eaccess_control\x18\n" +
+	" \x01(\v2\x18.domain.v1.AccessControlH\x02R\raccessControl\x88\x01\x01\x12/\n" +
+	"\x06status\x18\v \x01(\x0e2\x17.domain.v1.DomainStatusR\x06status\x12%\n" +
+	"\x0estatus_message\x18\f \x01(\tR\rstatusMessage\x12:\n" +
+	"\bredirect\x18\r \x01(\v2\x19.domain.v1.DomainRedirectH\x03R\bredirect\x88\x01\x01\x12+\n" +
+	"\x03tls\x18\x0e \x01(\v2\x14.domain.v1.TLSPolicyH\x04R\x03tls\x88\x01\x01B\x12\n" +
 	"\x10_subdomain_labelB\x15\n" +
-	"\x13_platform_domain_id\"R\n" +
+	"\x13_platform_domain_idB\x11\n" +
+	"\x0f_access_controlB\v\n" +
+	"\t_redirectB\x06\n" +
+	"\x04_tls\"\x99\x01\n" +
+	"\tTLSPolicy\x126\n" +
+	"\vmin_version\x18\x01 \x01(\x0e2\x15.domain.v1.TLSVersionR\n" +
+	"minVersion\x12 \n" +
+	"\fhsts_max_age\x18\x02 \x01(\x05R\n" +
+	"hstsMaxAge\x122\n" +
+	"\x15client_ca_certificate\x18\x03 \x01(\tR\x13clientCaCertificate\"{\n" +
+	"\x0eDomainRedirect\x12#\n" +
+	"\rtarget_domain\x18\x01 \x01(\tR\ftargetDomain\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x12#\n" +
+	"\rpreserve_path\x18\x03 \x01(\bR\fpreservePath\"\xb4\x01\n" +
+	"\rAccessControl\x12#\n" +
+	"\rallowed_cidrs\x18\x01 \x03(\tR\fallowedCidrs\x128\n" +
+	"\n" +
+	"basic_auth\x18\x02 \x01(\v2\x14.domain.v1.BasicAuthH\x00R\tbasicAuth\x88\x01\x01\x12,\n" +
+	"\x04oidc\x18\x03 \x01(\v2\x13.domain.v1.OIDCAuthH\x01R\x04oidc\x88\x01\x01B\r\n" +
+	"\v_basic_authB\a\n" +
+	"\x05_oidc\";\n" +
+	"\tBasicAuth\x12.\n" +
+	"\x05users\x18\x01 \x03(\v2\x18.domain.v1.BasicAuthUserR\x05users\"G\n" +
+	"\rBasicAuthUser\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x9d\x01\n" +
+	"\bOIDCAuth\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\x12&\n" +
+	"\fredirect_url\x18\x04 \x01(\tH\x00R\vredirectUrl\x88\x01\x01B\x0f\n" +
+	"\r_redirect_url\"R\n" +
 	"\x1bCreatePlatformDomainRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1b\n" +
 	"\tis_active\x18\x02 \x01(\bR\bisActive\".\n" +
@@ -1563,17 +2690,19 @@ const file_domain_v1_domain_proto_rawDesc = "" +
 	"\x1bCreateResourceDomainRequest\x12\x1f\n" +
 	"\vresource_id\x18\x01 \x01(\x03R\n" +
 	"resourceId\x12.\n" +
-	"\x06domain\x18\x02 \x01(\v2\x16.domain.v1.DomainInputR\x06domain\";\n" +
+	"\x06domain\x18\x02 \x01(\v2\x16.domain.v1.DomainInputR\x06domain\"n\n" +
 	"\x1cCreateResourceDomainResponse\x12\x1b\n" +
-	"\tdomain_id\x18\x01 \x01(\x03R\bdomainId\"\x9f\x01\n" +
+	"\tdomain_id\x18\x01 \x01(\x03R\bdomainId\x121\n" +
+	"\x06domain\x18\x02 \x01(\v2\x19.domain.v1.ResourceDomainR\x06domain\"\x9f\x01\n" +
 	"\x1bUpdateResourceDomainRequest\x12\x1b\n" +
 	"\tdomain_id\x18\x01 \x01(\x03R\bdomainId\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\x12\x1b\n" +
 	"\x06domain\x18\x03 \x01(\tH\x00R\x06domain\x88\x01\x01B\t\n" +
-	"\a_domain\";\n" +
+	"\a_domain\"n\n" +
 	"\x1cUpdateResourceDomainResponse\x12\x1b\n" +
-	"\tdomain_id\x18\x01 \x01(\x03R\bdomainId\"_\n" +
+	"\tdomain_id\x18\x01 \x01(\x03R\bdomainId\x121\n" +
+	"\x06domain\x18\x02 \x01(\v2\x19.domain.v1.ResourceDomainR\x06domain\"_\n" +
 	"\x1fSetPrimaryResourceDomainRequest\x12\x1f\n" +
 	"\vresource_id\x18\x01 \x01(\x03R\n" +
 	"resourceId\x12\x1b\n" +
@@ -1584,7 +2713,50 @@ const file_domain_v1_domain_proto_rawDesc = "" +
 	"\tdomain_id\x18\x02 \x01(\x03R\bdomainId\":\n" +
 	"\x1bDeleteResourceDomainRequest\x12\x1b\n" +
 	"\tdomain_id\x18\x01 \x01(\x03R\bdomainId\"\x1e\n" +
-	"\x1cDeleteResourceDomainResponse\"8\n" +
+	"\x1cDeleteResourceDomainResponse\"\xbe\x01\n" +
+	"%SetResourceDomainAccessControlRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x1b\n" +
+	"\tdomain_id\x18\x02 \x01(\x03R\bdomainId\x12D\n" +
+	"\x0eaccess_control\x18\x03 \x01(\v2\x18.domain.v1.AccessControlH\x00R\raccessControl\x88\x01\x01B\x11\n" +
+	"\x0f_access_control\"[\n" +
+	"&SetResourceDomainAccessControlResponse\x121\n" +
+	"\x06domain\x18\x01 \x01(\v2\x19.domain.v1.ResourceDomainR\x06domain\"\xa9\x01\n" +
+	" SetResourceDomainRedirectRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x1b\n" +
+	"\tdomain_id\x18\x02 \x01(\x03R\bdomainId\x12:\n" +
+	"\bredirect\x18\x03 \x01(\v2\x19.domain.v1.DomainRedirectH\x00R\bredirect\x88\x01\x01B\v\n" +
+	"\t_redirect\"V\n" +
+	"!SetResourceDomainRedirectResponse\x121\n" +
+	"\x06domain\x18\x01 \x01(\v2\x19.domain.v1.ResourceDomainR\x06domain\"\x96\x01\n" +
+	"!SetResourceDomainTLSPolicyRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x1b\n" +
+	"\tdomain_id\x18\x02 \x01(\x03R\bdomainId\x12+\n" +
+	"\x03tls\x18\x03 \x01(\v2\x14.domain.v1.TLSPolicyH\x00R\x03tls\x88\x01\x01B\x06\n" +
+	"\x04_tls\"W\n" +
+	"\"SetResourceDomainTLSPolicyResponse\x121\n" +
+	"\x06domain\x18\x01 \x01(\v2\x19.domain.v1.ResourceDomainR\x06domain\"n\n" +
+	"!GetDomainSetupInstructionsRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x03R\bdomainId\x12\x1f\n" +
+	"\bprovider\x18\x02 \x01(\tH\x00R\bprovider\x88\x01\x01B\v\n" +
+	"\t_provider\"T\n" +
+	"\x14DnsRecordInstruction\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\x86\x01\n" +
+	"\x19ProviderSetupInstructions\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x129\n" +
+	"\arecords\x18\x02 \x03(\v2\x1f.domain.v1.DnsRecordInstructionR\arecords\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\x84\x02\n" +
+	"\"GetDomainSetupInstructionsResponse\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x12\n" +
+	"\x04apex\x18\x02 \x01(\bR\x04apex\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12B\n" +
+	"\tproviders\x18\x04 \x03(\v2$.domain.v1.ProviderSetupInstructionsR\tproviders\x12/\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x17.domain.v1.DomainStatusR\x06status\x12%\n" +
+	"\x0estatus_message\x18\x06 \x01(\tR\rstatusMessage\"8\n" +
 	"\x1eCheckDomainAvailabilityRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\"D\n" +
 	"\x1fCheckDomainAvailabilityResponse\x12!\n" +
@@ -1593,7 +2765,16 @@ const file_domain_v1_domain_proto_rawDesc = "" +
 	"DomainType\x12\x1b\n" +
 	"\x17DOMAIN_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
 	"\x1dDOMAIN_TYPE_PLATFORM_PROVIDED\x10\x01\x12\x1d\n" +
-	"\x19DOMAIN_TYPE_USER_PROVIDED\x10\x022\x9b\t\n" +
+	"\x19DOMAIN_TYPE_USER_PROVIDED\x10\x02*b\n" +
+	"\fDomainStatus\x12\x1d\n" +
+	"\x19DOMAIN_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15DOMAIN_STATUS_PENDING\x10\x01\x12\x18\n" +
+	"\x14DOMAIN_STATUS_ACTIVE\x10\x02*S\n" +
+	"\n" +
+	"TLSVersion\x12\x1b\n" +
+	"\x17TLS_VERSION_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fTLS_VERSION_1_2\x10\x01\x12\x13\n" +
+	"\x0fTLS_VERSION_1_3\x10\x022\x91\r\n" +
 	"\rDomainService\x12g\n" +
 	"\x14CreatePlatformDomain\x12&.domain.v1.CreatePlatformDomainRequest\x1a'.domain.v1.CreatePlatformDomainResponse\x12^\n" +
 	"\x11GetPlatformDomain\x12#.domain.v1.GetPlatformDomainRequest\x1a$.domain.v1.GetPlatformDomainResponse\x12d\n" +
@@ -1603,7 +2784,11 @@ const file_domain_v1_domain_proto_rawDesc = "" +
 	"\x14CreateResourceDomain\x12&.domain.v1.CreateResourceDomainRequest\x1a'.domain.v1.CreateResourceDomainResponse\x12g\n" +
 	"\x14UpdateResourceDomain\x12&.domain.v1.UpdateResourceDomainRequest\x1a'.domain.v1.UpdateResourceDomainResponse\x12s\n" +
 	"\x18SetPrimaryResourceDomain\x12*.domain.v1.SetPrimaryResourceDomainRequest\x1a+.domain.v1.SetPrimaryResourceDomainResponse\x12g\n" +
-	"\x14DeleteResourceDomain\x12&.domain.v1.DeleteResourceDomainRequest\x1a'.domain.v1.DeleteResourceDomainResponse\x12g\n" +
+	"\x14DeleteResourceDomain\x12&.domain.v1.DeleteResourceDomainRequest\x1a'.domain.v1.DeleteResourceDomainResponse\x12\x85\x01\n" +
+	"\x1eSetResourceDomainAccessControl\x120.domain.v1.SetResourceDomainAccessControlRequest\x1a1.domain.v1.SetResourceDomainAccessControlResponse\x12v\n" +
+	"\x19SetResourceDomainRedirect\x12+.domain.v1.SetResourceDomainRedirectRequest\x1a,.domain.v1.SetResourceDomainRedirectResponse\x12y\n" +
+	"\x1aSetResourceDomainTLSPolicy\x12,.domain.v1.SetResourceDomainTLSPolicyRequest\x1a-.domain.v1.SetResourceDomainTLSPolicyResponse\x12y\n" +
+	"\x1aGetDomainSetupInstructions\x12,.domain.v1.GetDomainSetupInstructionsRequest\x1a-.domain.v1.GetDomainSetupInstructionsResponse\x12g\n" +
 	"\x14ListLocoOwnedDomains\x12&.domain.v1.ListLocoOwnedDomainsRequest\x1a'.domain.v1.ListLocoOwnedDomainsResponse\x12p\n" +
 	"\x17CheckDomainAvailability\x12).domain.v1.CheckDomainAvailabilityRequest\x1a*.domain.v1.CheckDomainAvailabilityResponseB;Z9github.com/team-loco/loco/shared/proto/domain/v1;domainv1b\x06proto3"
 
@@ -1619,79 +2804,124 @@ func file_domain_v1_domain_proto_rawDescGZIP() []byte {
 	return file_domain_v1_domain_proto_rawDescData
 }
 
-var file_domain_v1_domain_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_domain_v1_domain_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_domain_v1_domain_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_domain_v1_domain_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_domain_v1_domain_proto_goTypes = []any{
-	(DomainType)(0),                          // 0: domain.v1.DomainType
-	(*PlatformDomain)(nil),                   // 1: domain.v1.PlatformDomain
-	(*DomainInput)(nil),                      // 2: domain.v1.DomainInput
-	(*ResourceDomain)(nil),                   // 3: domain.v1.ResourceDomain
-	(*CreatePlatformDomainRequest)(nil),      // 4: domain.v1.CreatePlatformDomainRequest
-	(*CreatePlatformDomainResponse)(nil),     // 5: domain.v1.CreatePlatformDomainResponse
-	(*GetPlatformDomainRequest)(nil),         // 6: domain.v1.GetPlatformDomainRequest
-	(*GetPlatformDomainResponse)(nil),        // 7: domain.v1.GetPlatformDomainResponse
-	(*ListPlatformDomainsRequest)(nil),       // 8: domain.v1.ListPlatformDomainsRequest
-	(*ListPlatformDomainsResponse)(nil),      // 9: domain.v1.ListPlatformDomainsResponse
-	(*UpdatePlatformDomainRequest)(nil),      // 10: domain.v1.UpdatePlatformDomainRequest
-	(*UpdatePlatformDomainResponse)(nil),     // 11: domain.v1.UpdatePlatformDomainResponse
-	(*DeletePlatformDomainRequest)(nil),      // 12: domain.v1.DeletePlatformDomainRequest
-	(*DeletePlatformDomainResponse)(nil),     // 13: domain.v1.DeletePlatformDomainResponse
-	(*LocoOwnedDomain)(nil),                  // 14: domain.v1.LocoOwnedDomain
-	(*ListLocoOwnedDomainsRequest)(nil),      // 15: domain.v1.ListLocoOwnedDomainsRequest
-	(*ListLocoOwnedDomainsResponse)(nil),     // 16: domain.v1.ListLocoOwnedDomainsResponse
-	(*CreateResourceDomainRequest)(nil),      // 17: domain.v1.CreateResourceDomainRequest
-	(*CreateResourceDomainResponse)(nil),     // 18: domain.v1.CreateResourceDomainResponse
-	(*UpdateResourceDomainRequest)(nil),      // 19: domain.v1.UpdateResourceDomainRequest
-	(*UpdateResourceDomainResponse)(nil),     // 20: domain.v1.UpdateResourceDomainResponse
-	(*SetPrimaryResourceDomainRequest)(nil),  // 21: domain.v1.SetPrimaryResourceDomainRequest
-	(*SetPrimaryResourceDomainResponse)(nil), // 22: domain.v1.SetPrimaryResourceDomainResponse
-	(*DeleteResourceDomainRequest)(nil),      // 23: domain.v1.DeleteResourceDomainRequest
-	(*DeleteResourceDomainResponse)(nil),     // 24: domain.v1.DeleteResourceDomainResponse
-	(*CheckDomainAvailabilityRequest)(nil),   // 25: domain.v1.CheckDomainAvailabilityRequest
-	(*CheckDomainAvailabilityResponse)(nil),  // 26: domain.v1.CheckDomainAvailabilityResponse
-	(*timestamppb.Timestamp)(nil),            // 27: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),            // 28: google.protobuf.FieldMask
+	(DomainType)(0),                                // 0: domain.v1.DomainType
+	(DomainStatus)(0),                              // 1: domain.v1.DomainStatus
+	(TLSVersion)(0),                                // 2: domain.v1.TLSVersion
+	(*PlatformDomain)(nil),                         // 3: domain.v1.PlatformDomain
+	(*DomainInput)(nil),                            // 4: domain.v1.DomainInput
+	(*ResourceDomain)(nil),                         // 5: domain.v1.ResourceDomain
+	(*TLSPolicy)(nil),                              // 6: domain.v1.TLSPolicy
+	(*DomainRedirect)(nil),                         // 7: domain.v1.DomainRedirect
+	(*AccessControl)(nil),                          // 8: domain.v1.AccessControl
+	(*BasicAuth)(nil),                              // 9: domain.v1.BasicAuth
+	(*BasicAuthUser)(nil),                          // 10: domain.v1.BasicAuthUser
+	(*OIDCAuth)(nil),                               // 11: domain.v1.OIDCAuth
+	(*CreatePlatformDomainRequest)(nil),            // 12: domain.v1.CreatePlatformDomainRequest
+	(*CreatePlatformDomainResponse)(nil),           // 13: domain.v1.CreatePlatformDomainResponse
+	(*GetPlatformDomainRequest)(nil),               // 14: domain.v1.GetPlatformDomainRequest
+	(*GetPlatformDomainResponse)(nil),              // 15: domain.v1.GetPlatformDomainResponse
+	(*ListPlatformDomainsRequest)(nil),             // 16: domain.v1.ListPlatformDomainsRequest
+	(*ListPlatformDomainsResponse)(nil),            // 17: domain.v1.ListPlatformDomainsResponse
+	(*UpdatePlatformDomainRequest)(nil),            // 18: domain.v1.UpdatePlatformDomainRequest
+	(*UpdatePlatformDomainResponse)(nil),           // 19: domain.v1.UpdatePlatformDomainResponse
+	(*DeletePlatformDomainRequest)(nil),            // 20: domain.v1.DeletePlatformDomainRequest
+	(*DeletePlatformDomainResponse)(nil),           // 21: domain.v1.DeletePlatformDomainResponse
+	(*LocoOwnedDomain)(nil),                        // 22: domain.v1.LocoOwnedDomain
+	(*ListLocoOwnedDomainsRequest)(nil),            // 23: domain.v1.ListLocoOwnedDomainsRequest
+	(*ListLocoOwnedDomainsResponse)(nil),           // 24: domain.v1.ListLocoOwnedDomainsResponse
+	(*CreateResourceDomainRequest)(nil),            // 25: domain.v1.CreateResourceDomainRequest
+	(*CreateResourceDomainResponse)(nil),           // 26: domain.v1.CreateResourceDomainResponse
+	(*UpdateResourceDomainRequest)(nil),            // 27: domain.v1.UpdateResourceDomainRequest
+	(*UpdateResourceDomainResponse)(nil),           // 28: domain.v1.UpdateResourceDomainResponse
+	(*SetPrimaryResourceDomainRequest)(nil),        // 29: domain.v1.SetPrimaryResourceDomainRequest
+	(*SetPrimaryResourceDomainResponse)(nil),       // 30: domain.v1.SetPrimaryResourceDomainResponse
+	(*DeleteResourceDomainRequest)(nil),            // 31: domain.v1.DeleteResourceDomainRequest
+	(*DeleteResourceDomainResponse)(nil),           // 32: domain.v1.DeleteResourceDomainResponse
+	(*SetResourceDomainAccessControlRequest)(nil),  // 33: domain.v1.SetResourceDomainAccessControlRequest
+	(*SetResourceDomainAccessControlResponse)(nil), // 34: domain.v1.SetResourceDomainAccessControlResponse
+	(*SetResourceDomainRedirectRequest)(nil),       // 35: domain.v1.SetResourceDomainRedirectRequest
+	(*SetResourceDomainRedirectResponse)(nil),      // 36: domain.v1.SetResourceDomainRedirectResponse
+	(*SetResourceDomainTLSPolicyRequest)(nil),      // 37: domain.v1.SetResourceDomainTLSPolicyRequest
+	(*SetResourceDomainTLSPolicyResponse)(nil),     // 38: domain.v1.SetResourceDomainTLSPolicyResponse
+	(*GetDomainSetupInstructionsRequest)(nil),      // 39: domain.v1.GetDomainSetupInstructionsRequest
+	(*DnsRecordInstruction)(nil),                   // 40: domain.v1.DnsRecordInstruction
+	(*ProviderSetupInstructions)(nil),              // 41: domain.v1.ProviderSetupInstructions
+	(*GetDomainSetupInstructionsResponse)(nil),     // 42: domain.v1.GetDomainSetupInstructionsResponse
+	(*CheckDomainAvailabilityRequest)(nil),         // 43: domain.v1.CheckDomainAvailabilityRequest
+	(*CheckDomainAvailabilityResponse)(nil),        // 44: domain.v1.CheckDomainAvailabilityResponse
+	(*timestamppb.Timestamp)(nil),                  // 45: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                  // 46: google.protobuf.FieldMask
 }
 var file_domain_v1_domain_proto_depIdxs = []int32{
-	27, // 0: domain.v1.PlatformDomain.created_at:type_name -> google.protobuf.Timestamp
-	27, // 1: domain.v1.PlatformDomain.updated_at:type_name -> google.protobuf.Timestamp
+	45, // 0: domain.v1.PlatformDomain.created_at:type_name -> google.protobuf.Timestamp
+	45, // 1: domain.v1.PlatformDomain.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: domain.v1.DomainInput.domain_source:type_name -> domain.v1.DomainType
 	0,  // 3: domain.v1.ResourceDomain.domain_source:type_name -> domain.v1.DomainType
-	27, // 4: domain.v1.ResourceDomain.created_at:type_name -> google.protobuf.Timestamp
-	27, // 5: domain.v1.ResourceDomain.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 6: domain.v1.GetPlatformDomainResponse.platform_domain:type_name -> domain.v1.PlatformDomain
-	1,  // 7: domain.v1.ListPlatformDomainsResponse.platform_domains:type_name -> domain.v1.PlatformDomain
-	28, // 8: domain.v1.UpdatePlatformDomainRequest.update_mask:type_name -> google.protobuf.FieldMask
-	14, // 9: domain.v1.ListLocoOwnedDomainsResponse.domains:type_name -> domain.v1.LocoOwnedDomain
-	2,  // 10: domain.v1.CreateResourceDomainRequest.domain:type_name -> domain.v1.DomainInput
-	28, // 11: domain.v1.UpdateResourceDomainRequest.update_mask:type_name -> google.protobuf.FieldMask
-	4,  // 12: domain.v1.DomainService.CreatePlatformDomain:input_type -> domain.v1.CreatePlatformDomainRequest
-	6,  // 13: domain.v1.DomainService.GetPlatformDomain:input_type -> domain.v1.GetPlatformDomainRequest
-	8,  // 14: domain.v1.DomainService.ListPlatformDomains:input_type -> domain.v1.ListPlatformDomainsRequest
-	10, // 15: domain.v1.DomainService.UpdatePlatformDomain:input_type -> domain.v1.UpdatePlatformDomainRequest
-	12, // 16: domain.v1.DomainService.DeletePlatformDomain:input_type -> domain.v1.DeletePlatformDomainRequest
-	17, // 17: domain.v1.DomainService.CreateResourceDomain:input_type -> domain.v1.CreateResourceDomainRequest
-	19, // 18: domain.v1.DomainService.UpdateResourceDomain:input_type -> domain.v1.UpdateResourceDomainRequest
-	21, // 19: domain.v1.DomainService.SetPrimaryResourceDomain:input_type -> domain.v1.SetPrimaryResourceDomainRequest
-	23, // 20: domain.v1.DomainService.DeleteResourceDomain:input_type -> domain.v1.DeleteResourceDomainRequest
-	15, // 21: domain.v1.DomainService.ListLocoOwnedDomains:input_type -> domain.v1.ListLocoOwnedDomainsRequest
-	25, // 22: domain.v1.DomainService.CheckDomainAvailability:input_type -> domain.v1.CheckDomainAvailabilityRequest
-	5,  // 23: domain.v1.DomainService.CreatePlatformDomain:output_type -> domain.v1.CreatePlatformDomainResponse
-	7,  // 24: domain.v1.DomainService.GetPlatformDomain:output_type -> domain.v1.GetPlatformDomainResponse
-	9,  // 25: domain.v1.DomainService.ListPlatformDomains:output_type -> domain.v1.ListPlatformDomainsResponse
-	11, // 26: domain.v1.DomainService.UpdatePlatformDomain:output_type -> domain.v1.UpdatePlatformDomainResponse
-	13, // 27: domain.v1.DomainService.DeletePlatformDomain:output_type -> domain.v1.DeletePlatformDomainResponse
-	18, // 28: domain.v1.DomainService.CreateResourceDomain:output_type -> domain.v1.CreateResourceDomainResponse
-	20, // 29: domain.v1.DomainService.UpdateResourceDomain:output_type -> domain.v1.UpdateResourceDomainResponse
-	22, // 30: domain.v1.DomainService.SetPrimaryResourceDomain:output_type -> domain.v1.SetPrimaryResourceDomainResponse
-	24, // 31: domain.v1.DomainService.DeleteResourceDomain:output_type -> domain.v1.DeleteResourceDomainResponse
-	16, // 32: domain.v1.DomainService.ListLocoOwnedDomains:output_type -> domain.v1.ListLocoOwnedDomainsResponse
-	26, // 33: domain.v1.DomainService.CheckDomainAvailability:output_type -> domain.v1.CheckDomainAvailabilityResponse
-	23, // [23:34] is the sub-list for method output_type
-	12, // [12:23] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	45, // 4: domain.v1.ResourceDomain.created_at:type_name -> google.protobuf.Timestamp
+	45, // 5: domain.v1.ResourceDomain.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 6: domain.v1.ResourceDomain.access_control:type_name -> domain.v1.AccessControl
+	1,  // 7: domain.v1.ResourceDomain.status:type_name -> domain.v1.DomainStatus
+	7,  // 8: domain.v1.ResourceDomain.redirect:type_name -> domain.v1.DomainRedirect
+	6,  // 9: domain.v1.ResourceDomain.tls:type_name -> domain.v1.TLSPolicy
+	2,  // 10: domain.v1.TLSPolicy.min_version:type_name -> domain.v1.TLSVersion
+	9,  // 11: domain.v1.AccessControl.basic_auth:type_name -> domain.v1.BasicAuth
+	11, // 12: domain.v1.AccessControl.oidc:type_name -> domain.v1.OIDCAuth
+	10, // 13: domain.v1.BasicAuth.users:type_name -> domain.v1.BasicAuthUser
+	3,  // 14: domain.v1.GetPlatformDomainResponse.platform_domain:type_name -> domain.v1.PlatformDomain
+	3,  // 15: domain.v1.ListPlatformDomainsResponse.platform_domains:type_name -> domain.v1.PlatformDomain
+	46, // 16: domain.v1.UpdatePlatformDomainRequest.update_mask:type_name -> google.protobuf.FieldMask
+	22, // 17: domain.v1.ListLocoOwnedDomainsResponse.domains:type_name -> domain.v1.LocoOwnedDomain
+	4,  // 18: domain.v1.CreateResourceDomainRequest.domain:type_name -> domain.v1.DomainInput
+	5,  // 19: domain.v1.CreateResourceDomainResponse.domain:type_name -> domain.v1.ResourceDomain
+	46, // 20: domain.v1.UpdateResourceDomainRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 21: domain.v1.UpdateResourceDomainResponse.domain:type_name -> domain.v1.ResourceDomain
+	8,  // 22: domain.v1.SetResourceDomainAccessControlRequest.access_control:type_name -> domain.v1.AccessControl
+	5,  // 23: domain.v1.SetResourceDomainAccessControlResponse.domain:type_name -> domain.v1.ResourceDomain
+	7,  // 24: domain.v1.SetResourceDomainRedirectRequest.redirect:type_name -> domain.v1.DomainRedirect
+	5,  // 25: domain.v1.SetResourceDomainRedirectResponse.domain:type_name -> domain.v1.ResourceDomain
+	6,  // 26: domain.v1.SetResourceDomainTLSPolicyRequest.tls:type_name -> domain.v1.TLSPolicy
+	5,  // 27: domain.v1.SetResourceDomainTLSPolicyResponse.domain:type_name -> domain.v1.ResourceDomain
+	40, // 28: domain.v1.ProviderSetupInstructions.records:type_name -> domain.v1.DnsRecordInstruction
+	41, // 29: domain.v1.GetDomainSetupInstructionsResponse.providers:type_name -> domain.v1.ProviderSetupInstructions
+	1,  // 30: domain.v1.GetDomainSetupInstructionsResponse.status:type_name -> domain.v1.DomainStatus
+	12, // 31: domain.v1.DomainService.CreatePlatformDomain:input_type -> domain.v1.CreatePlatformDomainRequest
+	14, // 32: domain.v1.DomainService.GetPlatformDomain:input_type -> domain.v1.GetPlatformDomainRequest
+	16, // 33: domain.v1.DomainService.ListPlatformDomains:input_type -> domain.v1.ListPlatformDomainsRequest
+	18, // 34: domain.v1.DomainService.UpdatePlatformDomain:input_type -> domain.v1.UpdatePlatformDomainRequest
+	20, // 35: domain.v1.DomainService.DeletePlatformDomain:input_type -> domain.v1.DeletePlatformDomainRequest
+	25, // 36: domain.v1.DomainService.CreateResourceDomain:input_type -> domain.v1.CreateResourceDomainRequest
+	27, // 37: domain.v1.DomainService.UpdateResourceDomain:input_type -> domain.v1.UpdateResourceDomainRequest
+	29, // 38: domain.v1.DomainService.SetPrimaryResourceDomain:input_type -> domain.v1.SetPrimaryResourceDomainRequest
+	31, // 39: domain.v1.DomainService.DeleteResourceDomain:input_type -> domain.v1.DeleteResourceDomainRequest
+	33, // 40: domain.v1.DomainService.SetResourceDomainAccessControl:input_type -> domain.v1.SetResourceDomainAccessControlRequest
+	35, // 41: domain.v1.DomainService.SetResourceDomainRedirect:input_type -> domain.v1.SetResourceDomainRedirectRequest
+	37, // 42: domain.v1.DomainService.SetResourceDomainTLSPolicy:input_type -> domain.v1.SetResourceDomainTLSPolicyRequest
+	39, // 43: domain.v1.DomainService.GetDomainSetupInstructions:input_type -> domain.v1.GetDomainSetupInstructionsRequest
+	23, // 44: domain.v1.DomainService.ListLocoOwnedDomains:input_type -> domain.v1.ListLocoOwnedDomainsRequest
+	43, // 45: domain.v1.DomainService.CheckDomainAvailability:input_type -> domain.v1.CheckDomainAvailabilityRequest
+	13, // 46: domain.v1.DomainService.CreatePlatformDomain:output_type -> domain.v1.CreatePlatformDomainResponse
+	15, // 47: domain.v1.DomainService.GetPlatformDomain:output_type -> domain.v1.GetPlatformDomainResponse
+	17, // 48: domain.v1.DomainService.ListPlatformDomains:output_type -> domain.v1.ListPlatformDomainsResponse
+	19, // 49: domain.v1.DomainService.UpdatePlatformDomain:output_type -> domain.v1.UpdatePlatformDomainResponse
+	21, // 50: domain.v1.DomainService.DeletePlatformDomain:output_type -> domain.v1.DeletePlatformDomainResponse
+	26, // 51: domain.v1.DomainService.CreateResourceDomain:output_type -> domain.v1.CreateResourceDomainResponse
+	28, // 52: domain.v1.DomainService.UpdateResourceDomain:output_type -> domain.v1.UpdateResourceDomainResponse
+	30, // 53: domain.v1.DomainService.SetPrimaryResourceDomain:output_type -> domain.v1.SetPrimaryResourceDomainResponse
+	32, // 54: domain.v1.DomainService.DeleteResourceDomain:output_type -> domain.v1.DeleteResourceDomainResponse
+	34, // 55: domain.v1.DomainService.SetResourceDomainAccessControl:output_type -> domain.v1.SetResourceDomainAccessControlResponse
+	36, // 56: domain.v1.DomainService.SetResourceDomainRedirect:output_type -> domain.v1.SetResourceDomainRedirectResponse
+	38, // 57: domain.v1.DomainService.SetResourceDomainTLSPolicy:output_type -> domain.v1.SetResourceDomainTLSPolicyResponse
+	42, // 58: domain.v1.DomainService.GetDomainSetupInstructions:output_type -> domain.v1.GetDomainSetupInstructionsResponse
+	24, // 59: domain.v1.DomainService.ListLocoOwnedDomains:output_type -> domain.v1.ListLocoOwnedDomainsResponse
+	44, // 60: domain.v1.DomainService.CheckDomainAvailability:output_type -> domain.v1.CheckDomainAvailabilityResponse
+	46, // [46:61] is the sub-list for method output_type
+	31, // [31:46] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_domain_v1_domain_proto_init() }
@@ -1701,20 +2931,26 @@ func file_domain_v1_domain_proto_init() {
 	}
 	file_domain_v1_domain_proto_msgTypes[1].OneofWrappers = []any{}
 	file_domain_v1_domain_proto_msgTypes[2].OneofWrappers = []any{}
-	file_domain_v1_domain_proto_msgTypes[5].OneofWrappers = []any{
+	file_domain_v1_domain_proto_msgTypes[5].OneofWrappers = []any{}
+	file_domain_v1_domain_proto_msgTypes[8].OneofWrappers = []any{}
+	file_domain_v1_domain_proto_msgTypes[11].OneofWrappers = []any{
 		(*GetPlatformDomainRequest_Id)(nil),
 		(*GetPlatformDomainRequest_Domain)(nil),
 	}
-	file_domain_v1_domain_proto_msgTypes[7].OneofWrappers = []any{}
-	file_domain_v1_domain_proto_msgTypes[9].OneofWrappers = []any{}
-	file_domain_v1_domain_proto_msgTypes[18].OneofWrappers = []any{}
+	file_domain_v1_domain_proto_msgTypes[13].OneofWrappers = []any{}
+	file_domain_v1_domain_proto_msgTypes[15].OneofWrappers = []any{}
+	file_domain_v1_domain_proto_msgTypes[24].OneofWrappers = []any{}
+	file_domain_v1_domain_proto_msgTypes[30].OneofWrappers = []any{}
+	file_domain_v1_domain_proto_msgTypes[32].OneofWrappers = []any{}
+	file_domain_v1_domain_proto_msgTypes[34].OneofWrappers = []any{}
+	file_domain_v1_domain_proto_msgTypes[36].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_domain_v1_domain_proto_rawDesc), len(file_domain_v1_domain_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   26,
+			NumEnums:      3,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -60,6 +60,18 @@ const (
 	// DomainServiceDeleteResourceDomainProcedure is the fully-qualified name of the DomainService's
 	// DeleteResourceDomain RPC.
 	DomainServiceDeleteResourceDomainProcedure = "/domain.v1.DomainService/DeleteResourceDomain"
+	// DomainServiceSetResourceDomainAccessControlProcedure is the fully-qualified name of the
+	// DomainService's SetResourceDomainAccessControl RPC.
+	DomainServiceSetResourceDomainAccessControlProcedure = "/domain.v1.DomainService/SetResourceDomainAccessControl"
+	// DomainServiceSetResourceDomainRedirectProcedure is the fully-qualified name of the
+	// DomainService's SetResourceDomainRedirect RPC.
+	DomainServiceSetResourceDomainRedirectProcedure = "/domain.v1.DomainService/SetResourceDomainRedirect"
+	// DomainServiceSetResourceDomainTLSPolicyProcedure is the fully-qualified name of the
+	// DomainService's SetResourceDomainTLSPolicy RPC.
+	DomainServiceSetResourceDomainTLSPolicyProcedure = "/domain.v1.DomainService/SetResourceDomainTLSPolicy"
+	// DomainServiceGetDomainSetupInstructionsProcedure is the fully-qualified name of the
+	// DomainService's GetDomainSetupInstructions RPC.
+	DomainServiceGetDomainSetupInstructionsProcedure = "/domain.v1.DomainService/GetDomainSetupInstructions"
 	// DomainServiceListLocoOwnedDomainsProcedure is the fully-qualified name of the DomainService's
 	// ListLocoOwnedDomains RPC.
 	DomainServiceListLocoOwnedDomainsProcedure = "/domain.v1.DomainService/ListLocoOwnedDomains"
@@ -90,6 +102,14 @@ type DomainServiceClient interface {
 	SetPrimaryResourceDomain(context.Context, *connect.Request[v1.SetPrimaryResourceDomainRequest]) (*connect.Response[v1.SetPrimaryResourceDomainResponse], error)
 	// DeleteResourceDomain removes a domain from a resource.
 	DeleteResourceDomain(context.Context, *connect.Request[v1.DeleteResourceDomainRequest]) (*connect.Response[v1.DeleteResourceDomainResponse], error)
+	// SetResourceDomainAccessControl replaces or clears the access control on a resource domain.
+	SetResourceDomainAccessControl(context.Context, *connect.Request[v1.SetResourceDomainAccessControlRequest]) (*connect.Response[v1.SetResourceDomainAccessControlResponse], error)
+	// SetResourceDomainRedirect makes a resource domain redirect elsewhere, or serve the resource again.
+	SetResourceDomainRedirect(context.Context, *connect.Request[v1.SetResourceDomainRedirectRequest]) (*connect.Response[v1.SetResourceDomainRedirectResponse], error)
+	// SetResourceDomainTLSPolicy replaces or clears the TLS policy of a resource domain.
+	SetResourceDomainTLSPolicy(context.Context, *connect.Request[v1.SetResourceDomainTLSPolicyRequest]) (*connect.Response[v1.SetResourceDomainTLSPolicyResponse], error)
+	// GetDomainSetupInstructions returns the DNS records a user-provided domain needs, per DNS provider.
+	GetDomainSetupInstructions(context.Context, *connect.Request[v1.GetDomainSetupInstructionsRequest]) (*connect.Response[v1.GetDomainSetupInstructionsResponse], error)
 	// Queries
 	// ListLocoOwnedDomains lists all domains owned by Loco with resources.
 	ListLocoOwnedDomains(context.Context, *connect.Request[v1.ListLocoOwnedDomainsRequest]) (*connect.Response[v1.ListLocoOwnedDomainsResponse], error)
@@ -162,6 +182,30 @@ func NewDomainServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(domainServiceMethods.ByName("DeleteResourceDomain")),
 			connect.WithClientOptions(opts...),
 		),
+		setResourceDomainAccessControl: connect.NewClient[v1.SetResourceDomainAccessControlRequest, v1.SetResourceDomainAccessControlResponse](
+			httpClient,
+			baseURL+DomainServiceSetResourceDomainAccessControlProcedure,
+			connect.WithSchema(domainServiceMethods.ByName("SetResourceDomainAccessControl")),
+			connect.WithClientOptions(opts...),
+		),
+		setResourceDomainRedirect: connect.NewClient[v1.SetResourceDomainRedirectRequest, v1.SetResourceDomainRedirectResponse](
+			httpClient,
+			baseURL+DomainServiceSetResourceDomainRedirectProcedure,
+			connect.WithSchema(domainServiceMethods.ByName("SetResourceDomainRedirect")),
+			connect.WithClientOptions(opts...),
+		),
+		setResourceDomainTLSPolicy: connect.NewClient[v1.SetResourceDomainTLSPolicyRequest, v1.SetResourceDomainTLSPolicyResponse](
+			httpClient,
+			baseURL+DomainServiceSetResourceDomainTLSPolicyProcedure,
+			connect.WithSchema(domainServiceMethods.ByName("SetResourceDomainTLSPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		getDomainSetupInstructions: connect.NewClient[v1.GetDomainSetupInstructionsRequest, v1.GetDomainSetupInstructionsResponse](
+			httpClient,
+			baseURL+DomainServiceGetDomainSetupInstructionsProcedure,
+			connect.WithSchema(domainServiceMethods.ByName("GetDomainSetupInstructions")),
+			connect.WithClientOptions(opts...),
+		),
 		listLocoOwnedDomains: connect.NewClient[v1.ListLocoOwnedDomainsRequest, v1.ListLocoOwnedDomainsResponse](
 			httpClient,
 			baseURL+DomainServiceListLocoOwnedDomainsProcedure,
@@ -179,17 +223,21 @@ func NewDomainServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 
 // domainServiceClient implements DomainServiceClient.
 type domainServiceClient struct {
-	createPlatformDomain     *connect.Client[v1.CreatePlatformDomainRequest, v1.CreatePlatformDomainResponse]
-	getPlatformDomain        *connect.Client[v1.GetPlatformDomainRequest, v1.GetPlatformDomainResponse]
-	listPlatformDomains      *connect.Client[v1.ListPlatformDomainsRequest, v1.ListPlatformDomainsResponse]
-	updatePlatformDomain     *connect.Client[v1.UpdatePlatformDomainRequest, v1.UpdatePlatformDomainResponse]
-	deletePlatformDomain     *connect.Client[v1.DeletePlatformDomainRequest, v1.DeletePlatformDomainResponse]
-	createResourceDomain     *connect.Client[v1.CreateResourceDomainRequest, v1.CreateResourceDomainResponse]
-	updateResourceDomain     *connect.Client[v1.UpdateResourceDomainRequest, v1.UpdateResourceDomainResponse]
-	setPrimaryResourceDomain *connect.Client[v1.SetPrimaryResourceDomainRequest, v1.SetPrimaryResourceDomainResponse]
-	deleteResourceDomain     *connect.Client[v1.DeleteResourceDomainRequest, v1.DeleteResourceDomainResponse]
-	listLocoOwnedDomains     *connect.Client[v1.ListLocoOwnedDomainsRequest, v1.ListLocoOwnedDomainsResponse]
-	checkDomainAvailability  *connect.Client[v1.CheckDomainAvailabilityRequest, v1.CheckDomainAvailabilityResponse]
+	createPlatformDomain           *connect.Client[v1.CreatePlatformDomainRequest, v1.CreatePlatformDomainResponse]
+	getPlatformDomain              *connect.Client[v1.GetPlatformDomainRequest, v1.GetPlatformDomainResponse]
+	listPlatformDomains            *connect.Client[v1.ListPlatformDomainsRequest, v1.ListPlatformDomainsResponse]
+	updatePlatformDomain           *connect.Client[v1.UpdatePlatformDomainRequest, v1.UpdatePlatformDomainResponse]
+	deletePlatformDomain           *connect.Client[v1.DeletePlatformDomainRequest, v1.DeletePlatformDomainResponse]
+	createResourceDomain           *connect.Client[v1.CreateResourceDomainRequest, v1.CreateResourceDomainResponse]
+	updateResourceDomain           *connect.Client[v1.UpdateResourceDomainRequest, v1.UpdateResourceDomainResponse]
+	setPrimaryResourceDomain       *connect.Client[v1.SetPrimaryResourceDomainRequest, v1.SetPrimaryResourceDomainResponse]
+	deleteResourceDomain           *connect.Client[v1.DeleteResourceDomainRequest, v1.DeleteResourceDomainResponse]
+	setResourceDomainAccessControl *connect.Client[v1.SetResourceDomainAccessControlRequest, v1.SetResourceDomainAccessControlResponse]
+	setResourceDomainRedirect      *connect.Client[v1.SetResourceDomainRedirectRequest, v1.SetResourceDomainRedirectResponse]
+	setResourceDomainTLSPolicy     *connect.Client[v1.SetResourceDomainTLSPolicyRequest, v1.SetResourceDomainTLSPolicyResponse]
+	getDomainSetupInstructions     *connect.Client[v1.GetDomainSetupInstructionsRequest, v1.GetDomainSetupInstructionsResponse]
+	listLocoOwnedDomains           *connect.Client[v1.ListLocoOwnedDomainsRequest, v1.ListLocoOwnedDomainsResponse]
+	checkDomainAvailability        *connect.Client[v1.CheckDomainAvailabilityRequest, v1.CheckDomainAvailabilityResponse]
 }
 
 // CreatePlatformDomain calls domain.v1.DomainService.CreatePlatformDomain.
@@ -237,6 +285,26 @@ func (c *domainServiceClient) DeleteResourceDomain(ctx context.Context, req *con
 	return c.deleteResourceDomain.CallUnary(ctx, req)
 }
 
+// SetResourceDomainAccessControl calls domain.v1.DomainService.SetResourceDomainAccessControl.
+func (c *domainServiceClient) SetResourceDomainAccessControl(ctx context.Context, req *connect.Request[v1.SetResourceDomainAccessControlRequest]) (*connect.Response[v1.SetResourceDomainAccessControlResponse], error) {
+	return c.setResourceDomainAccessControl.CallUnary(ctx, req)
+}
+
+// SetResourceDomainRedirect calls domain.v1.DomainService.SetResourceDomainRedirect.
+func (c *domainServiceClient) SetResourceDomainRedirect(ctx context.Context, req *connect.Request[v1.SetResourceDomainRedirectRequest]) (*connect.Response[v1.SetResourceDomainRedirectResponse], error) {
+	return c.setResourceDomainRedirect.CallUnary(ctx, req)
+}
+
+// SetResourceDomainTLSPolicy calls domain.v1.DomainService.SetResourceDomainTLSPolicy.
+func (c *domainServiceClient) SetResourceDomainTLSPolicy(ctx context.Context, req *connect.Request[v1.SetResourceDomainTLSPolicyRequest]) (*connect.Response[v1.SetResourceDomainTLSPolicyResponse], error) {
+	return c.setResourceDomainTLSPolicy.CallUnary(ctx, req)
+}
+
+// GetDomainSetupInstructions calls domain.v1.DomainService.GetDomainSetupInstructions.
+func (c *domainServiceClient) GetDomainSetupInstructions(ctx context.Context, req *connect.Request[v1.GetDomainSetupInstructionsRequest]) (*connect.Response[v1.GetDomainSetupInstructionsResponse], error) {
+	return c.getDomainSetupInstructions.CallUnary(ctx, req)
+}
+
 // ListLocoOwnedDomains calls domain.v1.DomainService.ListLocoOwnedDomains.
 func (c *domainServiceClient) ListLocoOwnedDomains(ctx context.Context, req *connect.Request[v1.ListLocoOwnedDomainsRequest]) (*connect.Response[v1.ListLocoOwnedDomainsResponse], error) {
 	return c.listLocoOwnedDomains.CallUnary(ctx, req)
@@ -269,6 +337,14 @@ type DomainServiceHandler interface {
 	SetPrimaryResourceDomain(context.Context, *connect.Request[v1.SetPrimaryResourceDomainRequest]) (*connect.Response[v1.SetPrimaryResourceDomainResponse], error)
 	// DeleteResourceDomain removes a domain from a resource.
 	DeleteResourceDomain(context.Context, *connect.Request[v1.DeleteResourceDomainRequest]) (*connect.Response[v1.DeleteResourceDomainResponse], error)
+	// SetResourceDomainAccessControl replaces or clears the access control on a resource domain.
+	SetResourceDomainAccessControl(context.Context, *connect.Request[v1.SetResourceDomainAccessControlRequest]) (*connect.Response[v1.SetResourceDomainAccessControlResponse], error)
+	// SetResourceDomainRedirect makes a resource domain redirect elsewhere, or serve the resource again.
+	SetResourceDomainRedirect(context.Context, *connect.Request[v1.SetResourceDomainRedirectRequest]) (*connect.Response[v1.SetResourceDomainRedirectResponse], error)
+	// SetResourceDomainTLSPolicy replaces or clears the TLS policy of a resource domain.
+	SetResourceDomainTLSPolicy(context.Context, *connect.Request[v1.SetResourceDomainTLSPolicyRequest]) (*connect.Response[v1.SetResourceDomainTLSPolicyResponse], error)
+	// GetDomainSetupInstructions returns the DNS records a user-provided domain needs, per DNS provider.
+	GetDomainSetupInstructions(context.Context, *connect.Request[v1.GetDomainSetupInstructionsRequest]) (*connect.Response[v1.GetDomainSetupInstructionsResponse], error)
 	// Queries
 	// ListLocoOwnedDomains lists all domains owned by Loco with resources.
 	ListLocoOwnedDomains(context.Context, *connect.Request[v1.ListLocoOwnedDomainsRequest]) (*connect.Response[v1.ListLocoOwnedDomainsResponse], error)
@@ -337,6 +413,30 @@ func NewDomainServiceHandler(svc DomainServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(domainServiceMethods.ByName("DeleteResourceDomain")),
 		connect.WithHandlerOptions(opts...),
 	)
+	domainServiceSetResourceDomainAccessControlHandler := connect.NewUnaryHandler(
+		DomainServiceSetResourceDomainAccessControlProcedure,
+		svc.SetResourceDomainAccessControl,
+		connect.WithSchema(domainServiceMethods.ByName("SetResourceDomainAccessControl")),
+		connect.WithHandlerOptions(opts...),
+	)
+	domainServiceSetResourceDomainRedirectHandler := connect.NewUnaryHandler(
+		DomainServiceSetResourceDomainRedirectProcedure,
+		svc.SetResourceDomainRedirect,
+		connect.WithSchema(domainServiceMethods.ByName("SetResourceDomainRedirect")),
+		connect.WithHandlerOptions(opts...),
+	)
+	domainServiceSetResourceDomainTLSPolicyHandler := connect.NewUnaryHandler(
+		DomainServiceSetResourceDomainTLSPolicyProcedure,
+		svc.SetResourceDomainTLSPolicy,
+		connect.WithSchema(domainServiceMethods.ByName("SetResourceDomainTLSPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	domainServiceGetDomainSetupInstructionsHandler := connect.NewUnaryHandler(
+		DomainServiceGetDomainSetupInstructionsProcedure,
+		svc.GetDomainSetupInstructions,
+		connect.WithSchema(domainServiceMethods.ByName("GetDomainSetupInstructions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	domainServiceListLocoOwnedDomainsHandler := connect.NewUnaryHandler(
 		DomainServiceListLocoOwnedDomainsProcedure,
 		svc.ListLocoOwnedDomains,
@@ -369,6 +469,14 @@ func NewDomainServiceHandler(svc DomainServiceHandler, opts ...connect.HandlerOp
 			domainServiceSetPrimaryResourceDomainHandler.ServeHTTP(w, r)
 		case DomainServiceDeleteResourceDomainProcedure:
 			domainServiceDeleteResourceDomainHandler.ServeHTTP(w, r)
+		case DomainServiceSetResourceDomainAccessControlProcedure:
+			domainServiceSetResourceDomainAccessControlHandler.ServeHTTP(w, r)
+		case DomainServiceSetResourceDomainRedirectProcedure:
+			domainServiceSetResourceDomainRedirectHandler.ServeHTTP(w, r)
+		case DomainServiceSetResourceDomainTLSPolicyProcedure:
+			domainServiceSetResourceDomainTLSPolicyHandler.ServeHTTP(w, r)
+		case DomainServiceGetDomainSetupInstructionsProcedure:
+			domainServiceGetDomainSetupInstructionsHandler.ServeHTTP(w, r)
 		case DomainServiceListLocoOwnedDomainsProcedure:
 			domainServiceListLocoOwnedDomainsHandler.ServeHTTP(w, r)
 		case DomainServiceCheckDomainAvailabilityProcedure:
@@ -418,6 +526,22 @@ func (UnimplementedDomainServiceHandler) DeleteResourceDomain(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("domain.v1.DomainService.DeleteResourceDomain is not implemented"))
 }
 
+func (UnimplementedDomainServiceHandler) SetResourceDomainAccessControl(context.Context, *connect.Request[v1.SetResourceDomainAccessControlRequest]) (*connect.Response[v1.SetResourceDomainAccessControlResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("domain.v1.DomainService.SetResourceDomainAccessControl is not implemented"))
+}
+
+func (UnimplementedDomainServiceHandler) SetResourceDomainRedirect(context.Context, *connect.Request[v1.SetResourceDomainRedirectRequest]) (*connect.Response[v1.SetResourceDomainRedirectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("domain.v1.DomainService.SetResourceDomainRedirect is not implemented"))
+}
+
+func (UnimplementedDomainServiceHandler) SetResourceDomainTLSPolicy(context.Context, *connect.Request[v1.SetResourceDomainTLSPolicyRequest]) (*connect.Response[v1.SetResourceDomainTLSPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("domain.v1.DomainService.SetResourceDomainTLSPolicy is not implemented"))
+}
+
+func (UnimplementedDomainServiceHandler) GetDomainSetupInstructions(context.Context, *connect.Request[v1.GetDomainSetupInstructionsRequest]) (*connect.Response[v1.GetDomainSetupInstructionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("domain.v1.DomainService.GetDomainSetupInstructions is not implemented"))
+}
+
 func (UnimplementedDomainServiceHandler) ListLocoOwnedDomains(context.Context, *connect.Request[v1.ListLocoOwnedDomainsRequest]) (*connect.Response[v1.ListLocoOwnedDomainsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("domain.v1.DomainService.ListLocoOwnedDomains is not implemented"))
 }
@@ -0,0 +1,1046 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: notification/v1/notification.proto
+
+package notificationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NotificationEvent is something users can be notified about.
+type NotificationEvent int32
+
+const (
+	NotificationEvent_NOTIFICATION_EVENT_UNSPECIFIED         NotificationEvent = 0
+	NotificationEvent_NOTIFICATION_EVENT_DEPLOYMENT_FAILED   NotificationEvent = 1
+	NotificationEvent_NOTIFICATION_EVENT_DOMAIN_VERIFICATION NotificationEvent = 2 // a custom domain passed or failed verification
+	NotificationEvent_NOTIFICATION_EVENT_INVITATION          NotificationEvent = 3 // the user was added to a workspace
+	NotificationEvent_NOTIFICATION_EVENT_WORKSPACE_DIGEST    NotificationEvent = 4 // the weekly summary of a workspace's activity
+)
+
+// Enum value maps for NotificationEvent.
+var (
+	NotificationEvent_name = map[int32]string{
+		0: "NOTIFICATION_EVENT_UNSPECIFIED",
+		1: "NOTIFICATION_EVENT_DEPLOYMENT_FAILED",
+		2: "NOTIFICATION_EVENT_DOMAIN_VERIFICATION",
+		3: "NOTIFICATION_EVENT_INVITATION",
+		4: "NOTIFICATION_EVENT_WORKSPACE_DIGEST",
+	}
+	NotificationEvent_value = map[string]int32{
+		"NOTIFICATION_EVENT_UNSPECIFIED":         0,
+		"NOTIFICATION_EVENT_DEPLOYMENT_FAILED":   1,
+		"NOTIFICATION_EVENT_DOMAIN_VERIFICATION": 2,
+		"NOTIFICATION_EVENT_INVITATION":          3,
+		"NOTIFICATION_EVENT_WORKSPACE_DIGEST":    4,
+	}
+)
+
+func (x NotificationEvent) Enum() *NotificationEvent {
+	p := new(NotificationEvent)
+	*p = x
+	return p
+}
+
+func (x NotificationEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_notification_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationEvent) Type() protoreflect.EnumType {
+	return &file_notification_v1_notification_proto_enumTypes[0]
+}
+
+func (x NotificationEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationEvent.Descriptor instead.
+func (NotificationEvent) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+// NotificationPreference is how a user wants to hear about one event.
+type NotificationPreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         NotificationEvent      `protobuf:"varint,1,opt,name=event,proto3,enum=notification.v1.NotificationEvent" json:"event,omitempty"`
+	Email         bool                   `protobuf:"varint,2,opt,name=email,proto3" json:"email,omitempty"`
+	WebhookUrl    string                 `protobuf:"bytes,3,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"` // https URL notifications are posted to as JSON; empty for none
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreference) Reset() {
+	*x = NotificationPreference{}
+	mi := &file_notification_v1_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreference) ProtoMessage() {}
+
+func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationPreference) GetEvent() NotificationEvent {
+	if x != nil {
+		return x.Event
+	}
+	return NotificationEvent_NOTIFICATION_EVENT_UNSPECIFIED
+}
+
+func (x *NotificationPreference) GetEmail() bool {
+	if x != nil {
+		return x.Email
+	}
+	return false
+}
+
+func (x *NotificationPreference) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+// WorkspaceNotificationDefault is whether members of a workspace get email about an event
+// when they have not set a preference of their own.
+type WorkspaceNotificationDefault struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         NotificationEvent      `protobuf:"varint,1,opt,name=event,proto3,enum=notification.v1.NotificationEvent" json:"event,omitempty"`
+	Email         bool                   `protobuf:"varint,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceNotificationDefault) Reset() {
+	*x = WorkspaceNotificationDefault{}
+	mi := &file_notification_v1_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceNotificationDefault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceNotificationDefault) ProtoMessage() {}
+
+func (x *WorkspaceNotificationDefault) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceNotificationDefault.ProtoReflect.Descriptor instead.
+func (*WorkspaceNotificationDefault) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WorkspaceNotificationDefault) GetEvent() NotificationEvent {
+	if x != nil {
+		return x.Event
+	}
+	return NotificationEvent_NOTIFICATION_EVENT_UNSPECIFIED
+}
+
+func (x *WorkspaceNotificationDefault) GetEmail() bool {
+	if x != nil {
+		return x.Email
+	}
+	return false
+}
+
+// Notification is an entry in the caller's inbox.
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event         NotificationEvent      `protobuf:"varint,2,opt,name=event,proto3,enum=notification.v1.NotificationEvent" json:"event,omitempty"`
+	WorkspaceId   *int64                 `protobuf:"varint,3,opt,name=workspace_id,json=workspaceId,proto3,oneof" json:"workspace_id,omitempty"`
+	Subject       string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Data          map[string]string      `protobuf:"bytes,6,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // IDs of what the notification is about, such as resource_id
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReadAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=read_at,json=readAt,proto3,oneof" json:"read_at,omitempty"` // unset while unread
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Notification) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Notification) GetEvent() NotificationEvent {
+	if x != nil {
+		return x.Event
+	}
+	return NotificationEvent_NOTIFICATION_EVENT_UNSPECIFIED
+}
+
+func (x *Notification) GetWorkspaceId() int64 {
+	if x != nil && x.WorkspaceId != nil {
+		return *x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *Notification) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetReadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+// GetNotificationPreferencesRequest is the request to list the caller's preferences.
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
+// GetNotificationPreferencesResponse contains the caller's preferences.
+type GetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetNotificationPreferencesResponse) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// UpdateNotificationPreferencesRequest is the request to replace the caller's preferences.
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// UpdateNotificationPreferencesResponse contains the caller's preferences as stored.
+type UpdateNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesResponse) Reset() {
+	*x = UpdateNotificationPreferencesResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateNotificationPreferencesResponse) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// GetWorkspaceNotificationDefaultsRequest is the request to list a workspace's defaults.
+type GetWorkspaceNotificationDefaultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceNotificationDefaultsRequest) Reset() {
+	*x = GetWorkspaceNotificationDefaultsRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceNotificationDefaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceNotificationDefaultsRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceNotificationDefaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceNotificationDefaultsRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceNotificationDefaultsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetWorkspaceNotificationDefaultsRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+// GetWorkspaceNotificationDefaultsResponse contains a workspace's defaults.
+type GetWorkspaceNotificationDefaultsResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Defaults      []*WorkspaceNotificationDefault `protobuf:"bytes,1,rep,name=defaults,proto3" json:"defaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceNotificationDefaultsResponse) Reset() {
+	*x = GetWorkspaceNotificationDefaultsResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceNotificationDefaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceNotificationDefaultsResponse) ProtoMessage() {}
+
+func (x *GetWorkspaceNotificationDefaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceNotificationDefaultsResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceNotificationDefaultsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetWorkspaceNotificationDefaultsResponse) GetDefaults() []*WorkspaceNotificationDefault {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+// UpdateWorkspaceNotificationDefaultsRequest is the request to replace a workspace's defaults.
+type UpdateWorkspaceNotificationDefaultsRequest struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	WorkspaceId   int64                           `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Defaults      []*WorkspaceNotificationDefault `protobuf:"bytes,2,rep,name=defaults,proto3" json:"defaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkspaceNotificationDefaultsRequest) Reset() {
+	*x = UpdateWorkspaceNotificationDefaultsRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkspaceNotificationDefaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceNotificationDefaultsRequest) ProtoMessage() {}
+
+func (x *UpdateWorkspaceNotificationDefaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceNotificationDefaultsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceNotificationDefaultsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateWorkspaceNotificationDefaultsRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *UpdateWorkspaceNotificationDefaultsRequest) GetDefaults() []*WorkspaceNotificationDefault {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+// UpdateWorkspaceNotificationDefaultsResponse contains the workspace's defaults as stored.
+type UpdateWorkspaceNotificationDefaultsResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Defaults      []*WorkspaceNotificationDefault `protobuf:"bytes,1,rep,name=defaults,proto3" json:"defaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkspaceNotificationDefaultsResponse) Reset() {
+	*x = UpdateWorkspaceNotificationDefaultsResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkspaceNotificationDefaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceNotificationDefaultsResponse) ProtoMessage() {}
+
+func (x *UpdateWorkspaceNotificationDefaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceNotificationDefaultsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceNotificationDefaultsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateWorkspaceNotificationDefaultsResponse) GetDefaults() []*WorkspaceNotificationDefault {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+// ListNotificationsRequest is the request to list the caller's inbox.
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,3,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+// ListNotificationsResponse contains a page of the caller's inbox.
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	UnreadCount   int64                  `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListNotificationsResponse) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+// MarkNotificationReadRequest is the request to mark notifications as read. With neither field
+// set, every notification is marked read.
+type MarkNotificationReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *int64                 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                         // the one notification to mark read
+	UpToId        *int64                 `protobuf:"varint,2,opt,name=up_to_id,json=upToId,proto3,oneof" json:"up_to_id,omitempty"` // marks this notification and every older one read
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationReadRequest) Reset() {
+	*x = MarkNotificationReadRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationReadRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MarkNotificationReadRequest) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *MarkNotificationReadRequest) GetUpToId() int64 {
+	if x != nil && x.UpToId != nil {
+		return *x.UpToId
+	}
+	return 0
+}
+
+// MarkNotificationReadResponse contains how many notifications are left unread.
+type MarkNotificationReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnreadCount   int64                  `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationReadResponse) Reset() {
+	*x = MarkNotificationReadResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationReadResponse) ProtoMessage() {}
+
+func (x *MarkNotificationReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkNotificationReadResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MarkNotificationReadResponse) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+var File_notification_v1_notification_proto protoreflect.FileDescriptor
+
+const file_notification_v1_notification_proto_rawDesc = "" +
+	"\n" +
+	"\"notification/v1/notification.proto\x12\x0fnotification.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x89\x01\n" +
+	"\x16NotificationPreference\x128\n" +
+	"\x05event\x18\x01 \x01(\x0e2\".notification.v1.NotificationEventR\x05event\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\bR\x05email\x12\x1f\n" +
+	"\vwebhook_url\x18\x03 \x01(\tR\n" +
+	"webhookUrl\"n\n" +
+	"\x1cWorkspaceNotificationDefault\x128\n" +
+	"\x05event\x18\x01 \x01(\x0e2\".notification.v1.NotificationEventR\x05event\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\bR\x05email\"\xb6\x03\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x128\n" +
+	"\x05event\x18\x02 \x01(\x0e2\".notification.v1.NotificationEventR\x05event\x12&\n" +
+	"\fworkspace_id\x18\x03 \x01(\x03H\x00R\vworkspaceId\x88\x01\x01\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12;\n" +
+	"\x04data\x18\x06 \x03(\v2'.notification.v1.Notification.DataEntryR\x04data\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\aread_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x06readAt\x88\x01\x01\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0f\n" +
+	"\r_workspace_idB\n" +
+	"\n" +
+	"\b_read_at\"#\n" +
+	"!GetNotificationPreferencesRequest\"o\n" +
+	"\"GetNotificationPreferencesResponse\x12I\n" +
+	"\vpreferences\x18\x01 \x03(\v2'.notification.v1.NotificationPreferenceR\vpreferences\"q\n" +
+	"$UpdateNotificationPreferencesRequest\x12I\n" +
+	"\vpreferences\x18\x01 \x03(\v2'.notification.v1.NotificationPreferenceR\vpreferences\"r\n" +
+	"%UpdateNotificationPreferencesResponse\x12I\n" +
+	"\vpreferences\x18\x01 \x03(\v2'.notification.v1.NotificationPreferenceR\vpreferences\"L\n" +
+	"'GetWorkspaceNotificationDefaultsRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"u\n" +
+	"(GetWorkspaceNotificationDefaultsResponse\x12I\n" +
+	"\bdefaults\x18\x01 \x03(\v2-.notification.v1.WorkspaceNotificationDefaultR\bdefaults\"\x9a\x01\n" +
+	"*UpdateWorkspaceNotificationDefaultsRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12I\n" +
+	"\bdefaults\x18\x02 \x03(\v2-.notification.v1.WorkspaceNotificationDefaultR\bdefaults\"x\n" +
+	"+UpdateWorkspaceNotificationDefaultsResponse\x12I\n" +
+	"\bdefaults\x18\x01 \x03(\v2-.notification.v1.WorkspaceNotificationDefaultR\bdefaults\"w\n" +
+	"\x18ListNotificationsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\vunread_only\x18\x03 \x01(\bR\n" +
+	"unreadOnly\"\xab\x01\n" +
+	"\x19ListNotificationsResponse\x12C\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x1d.notification.v1.NotificationR\rnotifications\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12!\n" +
+	"\funread_count\x18\x03 \x01(\x03R\vunreadCount\"e\n" +
+	"\x1bMarkNotificationReadRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\x03H\x00R\x02id\x88\x01\x01\x12\x1d\n" +
+	"\bup_to_id\x18\x02 \x01(\x03H\x01R\x06upToId\x88\x01\x01B\x05\n" +
+	"\x03_idB\v\n" +
+	"\t_up_to_id\"A\n" +
+	"\x1cMarkNotificationReadResponse\x12!\n" +
+	"\funread_count\x18\x01 \x01(\x03R\vunreadCount*\xd9\x01\n" +
+	"\x11NotificationEvent\x12\"\n" +
+	"\x1eNOTIFICATION_EVENT_UNSPECIFIED\x10\x00\x12(\n" +
+	"$NOTIFICATION_EVENT_DEPLOYMENT_FAILED\x10\x01\x12*\n" +
+	"&NOTIFICATION_EVENT_DOMAIN_VERIFICATION\x10\x02\x12!\n" +
+	"\x1dNOTIFICATION_EVENT_INVITATION\x10\x03\x12'\n" +
+	"#NOTIFICATION_EVENT_WORKSPACE_DIGEST\x10\x042\xcc\x06\n" +
+	"\x13NotificationService\x12\x85\x01\n" +
+	"\x1aGetNotificationPreferences\x122.notification.v1.GetNotificationPreferencesRequest\x1a3.notification.v1.GetNotificationPreferencesResponse\x12\x8e\x01\n" +
+	"\x1dUpdateNotificationPreferences\x125.notification.v1.UpdateNotificationPreferencesRequest\x1a6.notification.v1.UpdateNotificationPreferencesResponse\x12\x97\x01\n" +
+	" GetWorkspaceNotificationDefaults\x128.notification.v1.GetWorkspaceNotificationDefaultsRequest\x1a9.notification.v1.GetWorkspaceNotificationDefaultsResponse\x12\xa0\x01\n" +
+	"#UpdateWorkspaceNotificationDefaults\x12;.notification.v1.UpdateWorkspaceNotificationDefaultsRequest\x1a<.notification.v1.UpdateWorkspaceNotificationDefaultsResponse\x12j\n" +
+	"\x11ListNotifications\x12).notification.v1.ListNotificationsRequest\x1a*.notification.v1.ListNotificationsResponse\x12s\n" +
+	"\x14MarkNotificationRead\x12,.notification.v1.MarkNotificationReadRequest\x1a-.notification.v1.MarkNotificationReadResponseBGZEgithub.com/team-loco/loco/shared/proto/notification/v1;notificationv1b\x06proto3"
+
+var (
+	file_notification_v1_notification_proto_rawDescOnce sync.Once
+	file_notification_v1_notification_proto_rawDescData []byte
+)
+
+func file_notification_v1_notification_proto_rawDescGZIP() []byte {
+	file_notification_v1_notification_proto_rawDescOnce.Do(func() {
+		file_notification_v1_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_v1_notification_proto_rawDesc), len(file_notification_v1_notification_proto_rawDesc)))
+	})
+	return file_notification_v1_notification_proto_rawDescData
+}
+
+var file_notification_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_notification_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_notification_v1_notification_proto_goTypes = []any{
+	(NotificationEvent)(0),                              // 0: notification.v1.NotificationEvent
+	(*NotificationPreference)(nil),                      // 1: notification.v1.NotificationPreference
+	(*WorkspaceNotificationDefault)(nil),                // 2: notification.v1.WorkspaceNotificationDefault
+	(*Notification)(nil),                                // 3: notification.v1.Notification
+	(*GetNotificationPreferencesRequest)(nil),           // 4: notification.v1.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),          // 5: notification.v1.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil),        // 6: notification.v1.UpdateNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesResponse)(nil),       // 7: notification.v1.UpdateNotificationPreferencesResponse
+	(*GetWorkspaceNotificationDefaultsRequest)(nil),     // 8: notification.v1.GetWorkspaceNotificationDefaultsRequest
+	(*GetWorkspaceNotificationDefaultsResponse)(nil),    // 9: notification.v1.GetWorkspaceNotificationDefaultsResponse
+	(*UpdateWorkspaceNotificationDefaultsRequest)(nil),  // 10: notification.v1.UpdateWorkspaceNotificationDefaultsRequest
+	(*UpdateWorkspaceNotificationDefaultsResponse)(nil), // 11: notification.v1.UpdateWorkspaceNotificationDefaultsResponse
+	(*ListNotificationsRequest)(nil),                    // 12: notification.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),                   // 13: notification.v1.ListNotificationsResponse
+	(*MarkNotificationReadRequest)(nil),                 // 14: notification.v1.MarkNotificationReadRequest
+	(*MarkNotificationReadResponse)(nil),                // 15: notification.v1.MarkNotificationReadResponse
+	nil,                                                 // 16: notification.v1.Notification.DataEntry
+	(*timestamppb.Timestamp)(nil),                       // 17: google.protobuf.Timestamp
+}
+var file_notification_v1_notification_proto_depIdxs = []int32{
+	0,  // 0: notification.v1.NotificationPreference.event:type_name -> notification.v1.NotificationEvent
+	0,  // 1: notification.v1.WorkspaceNotificationDefault.event:type_name -> notification.v1.NotificationEvent
+	0,  // 2: notification.v1.Notification.event:type_name -> notification.v1.NotificationEvent
+	16, // 3: notification.v1.Notification.data:type_name -> notification.v1.Notification.DataEntry
+	17, // 4: notification.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
+	17, // 5: notification.v1.Notification.read_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: notification.v1.GetNotificationPreferencesResponse.preferences:type_name -> notification.v1.NotificationPreference
+	1,  // 7: notification.v1.UpdateNotificationPreferencesRequest.preferences:type_name -> notification.v1.NotificationPreference
+	1,  // 8: notification.v1.UpdateNotificationPreferencesResponse.preferences:type_name -> notification.v1.NotificationPreference
+	2,  // 9: notification.v1.GetWorkspaceNotificationDefaultsResponse.defaults:type_name -> notification.v1.WorkspaceNotificationDefault
+	2,  // 10: notification.v1.UpdateWorkspaceNotificationDefaultsRequest.defaults:type_name -> notification.v1.WorkspaceNotificationDefault
+	2,  // 11: notification.v1.UpdateWorkspaceNotificationDefaultsResponse.defaults:type_name -> notification.v1.WorkspaceNotificationDefault
+	3,  // 12: notification.v1.ListNotificationsResponse.notifications:type_name -> notification.v1.Notification
+	4,  // 13: notification.v1.NotificationService.GetNotificationPreferences:input_type -> notification.v1.GetNotificationPreferencesRequest
+	6,  // 14: notification.v1.NotificationService.UpdateNotificationPreferences:input_type -> notification.v1.UpdateNotificationPreferencesRequest
+	8,  // 15: notification.v1.NotificationService.GetWorkspaceNotificationDefaults:input_type -> notification.v1.GetWorkspaceNotificationDefaultsRequest
+	10, // 16: notification.v1.NotificationService.UpdateWorkspaceNotificationDefaults:input_type -> notification.v1.UpdateWorkspaceNotificationDefaultsRequest
+	12, // 17: notification.v1.NotificationService.ListNotifications:input_type -> notification.v1.ListNotificationsRequest
+	14, // 18: notification.v1.NotificationService.MarkNotificationRead:input_type -> notification.v1.MarkNotificationReadRequest
+	5,  // 19: notification.v1.NotificationService.GetNotificationPreferences:output_type -> notification.v1.GetNotificationPreferencesResponse
+	7,  // 20: notification.v1.NotificationService.UpdateNotificationPreferences:output_type -> notification.v1.UpdateNotificationPreferencesResponse
+	9,  // 21: notification.v1.NotificationService.GetWorkspaceNotificationDefaults:output_type -> notification.v1.GetWorkspaceNotificationDefaultsResponse
+	11, // 22: notification.v1.NotificationService.UpdateWorkspaceNotificationDefaults:output_type -> notification.v1.UpdateWorkspaceNotificationDefaultsResponse
+	13, // 23: notification.v1.NotificationService.ListNotifications:output_type -> notification.v1.ListNotificationsResponse
+	15, // 24: notification.v1.NotificationService.MarkNotificationRead:output_type -> notification.v1.MarkNotificationReadResponse
+	19, // [19:25] is the sub-list for method output_type
+	13, // [13:19] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_notification_proto_init() }
+func file_notification_v1_notification_proto_init() {
+	if File_notification_v1_notification_proto != nil {
+		return
+	}
+	file_notification_v1_notification_proto_msgTypes[2].OneofWrappers = []any{}
+	file_notification_v1_notification_proto_msgTypes[13].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_notification_proto_rawDesc), len(file_notification_v1_notification_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_v1_notification_proto_goTypes,
+		DependencyIndexes: file_notification_v1_notification_proto_depIdxs,
+		EnumInfos:         file_notification_v1_notification_proto_enumTypes,
+		MessageInfos:      file_notification_v1_notification_proto_msgTypes,
+	}.Build()
+	File_notification_v1_notification_proto = out.File
+	file_notification_v1_notification_proto_goTypes = nil
+	file_notification_v1_notification_proto_depIdxs = nil
+}
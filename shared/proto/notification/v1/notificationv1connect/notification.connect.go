@@ -0,0 +1,272 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: notification/v1/notification.proto
+
+package notificationv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/team-loco/loco/shared/proto/notification/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// NotificationServiceName is the fully-qualified name of the NotificationService service.
+	NotificationServiceName = "notification.v1.NotificationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// NotificationServiceGetNotificationPreferencesProcedure is the fully-qualified name of the
+	// NotificationService's GetNotificationPreferences RPC.
+	NotificationServiceGetNotificationPreferencesProcedure = "/notification.v1.NotificationService/GetNotificationPreferences"
+	// NotificationServiceUpdateNotificationPreferencesProcedure is the fully-qualified name of the
+	// NotificationService's UpdateNotificationPreferences RPC.
+	NotificationServiceUpdateNotificationPreferencesProcedure = "/notification.v1.NotificationService/UpdateNotificationPreferences"
+	// NotificationServiceGetWorkspaceNotificationDefaultsProcedure is the fully-qualified name of the
+	// NotificationService's GetWorkspaceNotificationDefaults RPC.
+	NotificationServiceGetWorkspaceNotificationDefaultsProcedure = "/notification.v1.NotificationService/GetWorkspaceNotificationDefaults"
+	// NotificationServiceUpdateWorkspaceNotificationDefaultsProcedure is the fully-qualified name of
+	// the NotificationService's UpdateWorkspaceNotificationDefaults RPC.
+	NotificationServiceUpdateWorkspaceNotificationDefaultsProcedure = "/notification.v1.NotificationService/UpdateWorkspaceNotificationDefaults"
+	// NotificationServiceListNotificationsProcedure is the fully-qualified name of the
+	// NotificationService's ListNotifications RPC.
+	NotificationServiceListNotificationsProcedure = "/notification.v1.NotificationService/ListNotifications"
+	// NotificationServiceMarkNotificationReadProcedure is the fully-qualified name of the
+	// NotificationService's MarkNotificationRead RPC.
+	NotificationServiceMarkNotificationReadProcedure = "/notification.v1.NotificationService/MarkNotificationRead"
+)
+
+// NotificationServiceClient is a client for the notification.v1.NotificationService service.
+type NotificationServiceClient interface {
+	// GetNotificationPreferences lists the caller's preferences. Events without one follow workspace defaults.
+	GetNotificationPreferences(context.Context, *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error)
+	// UpdateNotificationPreferences sets the caller's preferences for the given events and resets others to the defaults.
+	UpdateNotificationPreferences(context.Context, *connect.Request[v1.UpdateNotificationPreferencesRequest]) (*connect.Response[v1.UpdateNotificationPreferencesResponse], error)
+	// GetWorkspaceNotificationDefaults lists a workspace's defaults.
+	GetWorkspaceNotificationDefaults(context.Context, *connect.Request[v1.GetWorkspaceNotificationDefaultsRequest]) (*connect.Response[v1.GetWorkspaceNotificationDefaultsResponse], error)
+	// UpdateWorkspaceNotificationDefaults sets a workspace's defaults for the given events and removes
+	// those for events not given.
+	UpdateWorkspaceNotificationDefaults(context.Context, *connect.Request[v1.UpdateWorkspaceNotificationDefaultsRequest]) (*connect.Response[v1.UpdateWorkspaceNotificationDefaultsResponse], error)
+	// ListNotifications lists the caller's inbox, newest first.
+	ListNotifications(context.Context, *connect.Request[v1.ListNotificationsRequest]) (*connect.Response[v1.ListNotificationsResponse], error)
+	// MarkNotificationRead marks one notification, or every notification up to an ID, as read.
+	MarkNotificationRead(context.Context, *connect.Request[v1.MarkNotificationReadRequest]) (*connect.Response[v1.MarkNotificationReadResponse], error)
+}
+
+// NewNotificationServiceClient constructs a client for the notification.v1.NotificationService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) NotificationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	notificationServiceMethods := v1.File_notification_v1_notification_proto.Services().ByName("NotificationService").Methods()
+	return &notificationServiceClient{
+		getNotificationPreferences: connect.NewClient[v1.GetNotificationPreferencesRequest, v1.GetNotificationPreferencesResponse](
+			httpClient,
+			baseURL+NotificationServiceGetNotificationPreferencesProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("GetNotificationPreferences")),
+			connect.WithClientOptions(opts...),
+		),
+		updateNotificationPreferences: connect.NewClient[v1.UpdateNotificationPreferencesRequest, v1.UpdateNotificationPreferencesResponse](
+			httpClient,
+			baseURL+NotificationServiceUpdateNotificationPreferencesProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("UpdateNotificationPreferences")),
+			connect.WithClientOptions(opts...),
+		),
+		getWorkspaceNotificationDefaults: connect.NewClient[v1.GetWorkspaceNotificationDefaultsRequest, v1.GetWorkspaceNotificationDefaultsResponse](
+			httpClient,
+			baseURL+NotificationServiceGetWorkspaceNotificationDefaultsProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("GetWorkspaceNotificationDefaults")),
+			connect.WithClientOptions(opts...),
+		),
+		updateWorkspaceNotificationDefaults: connect.NewClient[v1.UpdateWorkspaceNotificationDefaultsRequest, v1.UpdateWorkspaceNotificationDefaultsResponse](
+			httpClient,
+			baseURL+NotificationServiceUpdateWorkspaceNotificationDefaultsProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("UpdateWorkspaceNotificationDefaults")),
+			connect.WithClientOptions(opts...),
+		),
+		listNotifications: connect.NewClient[v1.ListNotificationsRequest, v1.ListNotificationsResponse](
+			httpClient,
+			baseURL+NotificationServiceListNotificationsProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("ListNotifications")),
+			connect.WithClientOptions(opts...),
+		),
+		markNotificationRead: connect.NewClient[v1.MarkNotificationReadRequest, v1.MarkNotificationReadResponse](
+			httpClient,
+			baseURL+NotificationServiceMarkNotificationReadProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("MarkNotificationRead")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// notificationServiceClient implements NotificationServiceClient.
+type notificationServiceClient struct {
+	getNotificationPreferences          *connect.Client[v1.GetNotificationPreferencesRequest, v1.GetNotificationPreferencesResponse]
+	updateNotificationPreferences       *connect.Client[v1.UpdateNotificationPreferencesRequest, v1.UpdateNotificationPreferencesResponse]
+	getWorkspaceNotificationDefaults    *connect.Client[v1.GetWorkspaceNotificationDefaultsRequest, v1.GetWorkspaceNotificationDefaultsResponse]
+	updateWorkspaceNotificationDefaults *connect.Client[v1.UpdateWorkspaceNotificationDefaultsRequest, v1.UpdateWorkspaceNotificationDefaultsResponse]
+	listNotifications                   *connect.Client[v1.ListNotificationsRequest, v1.ListNotificationsResponse]
+	markNotificationRead                *connect.Client[v1.MarkNotificationReadRequest, v1.MarkNotificationReadResponse]
+}
+
+// GetNotificationPreferences calls notification.v1.NotificationService.GetNotificationPreferences.
+func (c *notificationServiceClient) GetNotificationPreferences(ctx context.Context, req *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error) {
+	return c.getNotificationPreferences.CallUnary(ctx, req)
+}
+
+// UpdateNotificationPreferences calls
+// notification.v1.NotificationService.UpdateNotificationPreferences.
+func (c *notificationServiceClient) UpdateNotificationPreferences(ctx context.Context, req *connect.Request[v1.UpdateNotificationPreferencesRequest]) (*connect.Response[v1.UpdateNotificationPreferencesResponse], error) {
+	return c.updateNotificationPreferences.CallUnary(ctx, req)
+}
+
+// GetWorkspaceNotificationDefaults calls
+// notification.v1.NotificationService.GetWorkspaceNotificationDefaults.
+func (c *notificationServiceClient) GetWorkspaceNotificationDefaults(ctx context.Context, req *connect.Request[v1.GetWorkspaceNotificationDefaultsRequest]) (*connect.Response[v1.GetWorkspaceNotificationDefaultsResponse], error) {
+	return c.getWorkspaceNotificationDefaults.CallUnary(ctx, req)
+}
+
+// UpdateWorkspaceNotificationDefaults calls
+// notification.v1.NotificationService.UpdateWorkspaceNotificationDefaults.
+func (c *notificationServiceClient) UpdateWorkspaceNotificationDefaults(ctx context.Context, req *connect.Request[v1.UpdateWorkspaceNotificationDefaultsRequest]) (*connect.Response[v1.UpdateWorkspaceNotificationDefaultsResponse], error) {
+	return c.updateWorkspaceNotificationDefaults.CallUnary(ctx, req)
+}
+
+// ListNotifications calls notification.v1.NotificationService.ListNotifications.
+func (c *notificationServiceClient) ListNotifications(ctx context.Context, req *connect.Request[v1.ListNotificationsRequest]) (*connect.Response[v1.ListNotificationsResponse], error) {
+	return c.listNotifications.CallUnary(ctx, req)
+}
+
+// MarkNotificationRead calls notification.v1.NotificationService.MarkNotificationRead.
+func (c *notificationServiceClient) MarkNotificationRead(ctx context.Context, req *connect.Request[v1.MarkNotificationReadRequest]) (*connect.Response[v1.MarkNotificationReadResponse], error) {
+	return c.markNotificationRead.CallUnary(ctx, req)
+}
+
+// NotificationServiceHandler is an implementation of the notification.v1.NotificationService
+// service.
+type NotificationServiceHandler interface {
+	// GetNotificationPreferences lists the caller's preferences. Events without one follow workspace defaults.
+	GetNotificationPreferences(context.Context, *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error)
+	// UpdateNotificationPreferences sets the caller's preferences for the given events and resets others to the defaults.
+	UpdateNotificationPreferences(context.Context, *connect.Request[v1.UpdateNotificationPreferencesRequest]) (*connect.Response[v1.UpdateNotificationPreferencesResponse], error)
+	// GetWorkspaceNotificationDefaults lists a workspace's defaults.
+	GetWorkspaceNotificationDefaults(context.Context, *connect.Request[v1.GetWorkspaceNotificationDefaultsRequest]) (*connect.Response[v1.GetWorkspaceNotificationDefaultsResponse], error)
+	// UpdateWorkspaceNotificationDefaults sets a workspace's defaults for the given events and removes
+	// those for events not given.
+	UpdateWorkspaceNotificationDefaults(context.Context, *connect.Request[v1.UpdateWorkspaceNotificationDefaultsRequest]) (*connect.Response[v1.UpdateWorkspaceNotificationDefaultsResponse], error)
+	// ListNotifications lists the caller's inbox, newest first.
+	ListNotifications(context.Context, *connect.Request[v1.ListNotificationsRequest]) (*connect.Response[v1.ListNotificationsResponse], error)
+	// MarkNotificationRead marks one notification, or every notification up to an ID, as read.
+	MarkNotificationRead(context.Context, *connect.Request[v1.MarkNotificationReadRequest]) (*connect.Response[v1.MarkNotificationReadResponse], error)
+}
+
+// NewNotificationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	notificationServiceMethods := v1.File_notification_v1_notification_proto.Services().ByName("NotificationService").Methods()
+	notificationServiceGetNotificationPreferencesHandler := connect.NewUnaryHandler(
+		NotificationServiceGetNotificationPreferencesProcedure,
+		svc.GetNotificationPreferences,
+		connect.WithSchema(notificationServiceMethods.ByName("GetNotificationPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceUpdateNotificationPreferencesHandler := connect.NewUnaryHandler(
+		NotificationServiceUpdateNotificationPreferencesProcedure,
+		svc.UpdateNotificationPreferences,
+		connect.WithSchema(notificationServiceMethods.ByName("UpdateNotificationPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceGetWorkspaceNotificationDefaultsHandler := connect.NewUnaryHandler(
+		NotificationServiceGetWorkspaceNotificationDefaultsProcedure,
+		svc.GetWorkspaceNotificationDefaults,
+		connect.WithSchema(notificationServiceMethods.ByName("GetWorkspaceNotificationDefaults")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceUpdateWorkspaceNotificationDefaultsHandler := connect.NewUnaryHandler(
+		NotificationServiceUpdateWorkspaceNotificationDefaultsProcedure,
+		svc.UpdateWorkspaceNotificationDefaults,
+		connect.WithSchema(notificationServiceMethods.ByName("UpdateWorkspaceNotificationDefaults")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceListNotificationsHandler := connect.NewUnaryHandler(
+		NotificationServiceListNotificationsProcedure,
+		svc.ListNotifications,
+		connect.WithSchema(notificationServiceMethods.ByName("ListNotifications")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceMarkNotificationReadHandler := connect.NewUnaryHandler(
+		NotificationServiceMarkNotificationReadProcedure,
+		svc.MarkNotificationRead,
+		connect.WithSchema(notificationServiceMethods.ByName("MarkNotificationRead")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/notification.v1.NotificationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case NotificationServiceGetNotificationPreferencesProcedure:
+			notificationServiceGetNotificationPreferencesHandler.ServeHTTP(w, r)
+		case NotificationServiceUpdateNotificationPreferencesProcedure:
+			notificationServiceUpdateNotificationPreferencesHandler.ServeHTTP(w, r)
+		case NotificationServiceGetWorkspaceNotificationDefaultsProcedure:
+			notificationServiceGetWorkspaceNotificationDefaultsHandler.ServeHTTP(w, r)
+		case NotificationServiceUpdateWorkspaceNotificationDefaultsProcedure:
+			notificationServiceUpdateWorkspaceNotificationDefaultsHandler.ServeHTTP(w, r)
+		case NotificationServiceListNotificationsProcedure:
+			notificationServiceListNotificationsHandler.ServeHTTP(w, r)
+		case NotificationServiceMarkNotificationReadProcedure:
+			notificationServiceMarkNotificationReadHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedNotificationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedNotificationServiceHandler struct{}
+
+func (UnimplementedNotificationServiceHandler) GetNotificationPreferences(context.Context, *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("notification.v1.NotificationService.GetNotificationPreferences is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) UpdateNotificationPreferences(context.Context, *connect.Request[v1.UpdateNotificationPreferencesRequest]) (*connect.Response[v1.UpdateNotificationPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("notification.v1.NotificationService.UpdateNotificationPreferences is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) GetWorkspaceNotificationDefaults(context.Context, *connect.Request[v1.GetWorkspaceNotificationDefaultsRequest]) (*connect.Response[v1.GetWorkspaceNotificationDefaultsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("notification.v1.NotificationService.GetWorkspaceNotificationDefaults is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) UpdateWorkspaceNotificationDefaults(context.Context, *connect.Request[v1.UpdateWorkspaceNotificationDefaultsRequest]) (*connect.Response[v1.UpdateWorkspaceNotificationDefaultsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("notification.v1.NotificationService.UpdateWorkspaceNotificationDefaults is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) ListNotifications(context.Context, *connect.Request[v1.ListNotificationsRequest]) (*connect.Response[v1.ListNotificationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("notification.v1.NotificationService.ListNotifications is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) MarkNotificationRead(context.Context, *connect.Request[v1.MarkNotificationReadRequest]) (*connect.Response[v1.MarkNotificationReadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("notification.v1.NotificationService.MarkNotificationRead is not implemented"))
+}
@@ -27,6 +27,9 @@ type OAuthProvider int32
 const (
 	OAuthProvider_O_AUTH_PROVIDER_UNSPECIFIED OAuthProvider = 0
 	OAuthProvider_O_AUTH_PROVIDER_GITHUB      OAuthProvider = 1
+	OAuthProvider_O_AUTH_PROVIDER_GITLAB      OAuthProvider = 2
+	OAuthProvider_O_AUTH_PROVIDER_GOOGLE      OAuthProvider = 3
+	OAuthProvider_O_AUTH_PROVIDER_OIDC        OAuthProvider = 4 // the generic OpenID Connect provider configured on the server, e.g. an enterprise SSO
 )
 
 // Enum value maps for OAuthProvider.
@@ -34,10 +37,16 @@ var (
 	OAuthProvider_name = map[int32]string{
 		0: "O_AUTH_PROVIDER_UNSPECIFIED",
 		1: "O_AUTH_PROVIDER_GITHUB",
+		2: "O_AUTH_PROVIDER_GITLAB",
+		3: "O_AUTH_PROVIDER_GOOGLE",
+		4: "O_AUTH_PROVIDER_OIDC",
 	}
 	OAuthProvider_value = map[string]int32{
 		"O_AUTH_PROVIDER_UNSPECIFIED": 0,
 		"O_AUTH_PROVIDER_GITHUB":      1,
+		"O_AUTH_PROVIDER_GITLAB":      2,
+		"O_AUTH_PROVIDER_GOOGLE":      3,
+		"O_AUTH_PROVIDER_OIDC":        4,
 	}
 )
 
@@ -234,6 +243,8 @@ type ExchangeOAuthTokenResponse struct {
 	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // seconds
 	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	MfaRequired   bool                   `protobuf:"varint,5,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`   // loco_token only allows passing MFA, with UserService.VerifyMFA or ConfirmTOTP
+	RefreshToken  string                 `protobuf:"bytes,6,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // trades for new tokens with RefreshToken; empty while mfa_required is set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -296,6 +307,20 @@ func (x *ExchangeOAuthTokenResponse) GetName() string {
 	return ""
 }
 
+func (x *ExchangeOAuthTokenResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *ExchangeOAuthTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
 // GetOAuthAuthorizationURLRequest is the request to initiate OAuth authorization flow.
 type GetOAuthAuthorizationURLRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -485,6 +510,7 @@ type ExchangeOAuthCodeResponse struct {
 	ExpiresIn     int64                  `protobuf:"varint,1,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
 	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MfaRequired   bool                   `protobuf:"varint,4,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"` // the cookie only allows passing MFA, with UserService.VerifyMFA or ConfirmTOTP
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -540,52 +566,855 @@ func (x *ExchangeOAuthCodeResponse) GetName() string {
 	return ""
 }
 
-var File_oauth_v1_oauth_proto protoreflect.FileDescriptor
+func (x *ExchangeOAuthCodeResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
 
-const file_oauth_v1_oauth_proto_rawDesc = "" +
-	"\n" +
-	"\x14oauth/v1/oauth.proto\x12\boauth.v1\"M\n" +
-	"\x16GetOAuthDetailsRequest\x123\n" +
-	"\bprovider\x18\x01 \x01(\x0e2\x17.oauth.v1.OAuthProviderR\bprovider\"S\n" +
-	"\x17GetOAuthDetailsResponse\x12\x1b\n" +
-	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x1b\n" +
-	"\ttoken_ttl\x18\x02 \x01(\x01R\btokenTtl\"\xa0\x01\n" +
-	"\x19ExchangeOAuthTokenRequest\x123\n" +
-	"\bprovider\x18\x01 \x01(\x0e2\x17.oauth.v1.OAuthProviderR\bprovider\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\x128\n" +
-	"\x19create_user_if_not_exists\x18\x03 \x01(\bR\x15createUserIfNotExists\"\x87\x01\n" +
-	"\x1aExchangeOAuthTokenResponse\x12\x1d\n" +
-	"\n" +
-	"loco_token\x18\x01 \x01(\tR\tlocoToken\x12\x1d\n" +
-	"\n" +
-	"expires_in\x18\x02 \x01(\x03R\texpiresIn\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x12\n" +
-	"\x04name\x18\x04 \x01(\tR\x04name\"\x8f\x01\n" +
-	"\x1fGetOAuthAuthorizationURLRequest\x123\n" +
-	"\bprovider\x18\x01 \x01(\x0e2\x17.oauth.v1.OAuthProviderR\bprovider\x12\x14\n" +
-	"\x05state\x18\x02 \x01(\tR\x05state\x12!\n" +
-	"\fredirect_uri\x18\x03 \x01(\tR\vredirectUri\"e\n" +
-	" GetOAuthAuthorizationURLResponse\x12+\n" +
-	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\x12\x14\n" +
-	"\x05state\x18\x02 \x01(\tR\x05state\"\x9c\x01\n" +
-	"\x18ExchangeOAuthCodeRequest\x123\n" +
-	"\bprovider\x18\x01 \x01(\x0e2\x17.oauth.v1.OAuthProviderR\bprovider\x12\x12\n" +
-	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
-	"\x05state\x18\x03 \x01(\tR\x05state\x12!\n" +
-	"\fredirect_uri\x18\x04 \x01(\tR\vredirectUri\"g\n" +
-	"\x19ExchangeOAuthCodeResponse\x12\x1d\n" +
-	"\n" +
-	"expires_in\x18\x01 \x01(\x03R\texpiresIn\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name*L\n" +
+// SignUpWithEmailRequest creates an account that logs in with an email address and password.
+type SignUpWithEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignUpWithEmailRequest) Reset() {
+	*x = SignUpWithEmailRequest{}
+	mi := &file_oauth_v1_oauth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUpWithEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUpWithEmailRequest) ProtoMessage() {}
+
+func (x *SignUpWithEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oauth_v1_oauth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUpWithEmailRequest.ProtoReflect.Descriptor instead.
+func (*SignUpWithEmailRequest) Descriptor() ([]byte, []int) {
+	return file_oauth_v1_oauth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SignUpWithEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SignUpWithEmailRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SignUpWithEmailRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// SignUpWithEmailResponse is returned once the verification email is sent. An address that already has
+// an account gets the same response, and an email about it instead.
+type SignUpWithEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // no longer set, as it would tell new and existing addresses apart
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignUpWithEmailResponse) Reset() {
+	*x = SignUpWithEmailResponse{}
+	mi := &file_oauth_v1_oauth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUpWithEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUpWithEmailResponse) ProtoMessage() {}
+
+func (x *SignUpWithEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oauth_v1_oauth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUpWithEmailResponse.ProtoReflect.Descriptor instead.
+func (*SignUpWithEmailResponse) Descriptor() ([]byte, []int) {
+	return file_oauth_v1_oauth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SignUpWithEmailResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// VerifyEmailRequest carries the token from a verification email.
+type VerifyEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_oauth_v1_oauth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oauth_v1_oauth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_oauth_v1_oauth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// VerifyEmailResponse logs the verified user in.
+type VerifyEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocoToken     string                 `protobuf:"bytes,1,opt,name=loco_token,json=locoToken,proto3" json:"loco_token,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // seconds
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	MfaRequired   bool                   `protobuf:"varint,5,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`   // loco_token only allows passing MFA, with UserService.VerifyMFA or ConfirmTOTP
+	RefreshToken  string                 `protobuf:"bytes,6,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // trades for new tokens with RefreshToken; empty while mfa_required is set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	mi := &file_oauth_v1_oauth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oauth_v1_oauth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_oauth_v1_oauth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VerifyEmailResponse) GetLocoToken() string {
+	if x != nil {
+		return x.LocoToken
+	}
+	return ""
+}
+
+func (x *VerifyEmailResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *VerifyEmailResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VerifyEmailResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VerifyEmailResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *VerifyEmailResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// ResendVerificationEmailRequest asks for another verification email.
+type ResendVerificationEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationEmailRequest) Reset() {
+	*x = ResendVerificationEmailRequest{}
+	mi := &file_oauth_v1_oauth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationEmailRequest) ProtoMessage() {}
+
+func (x *ResendVerificationEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oauth_v1_oauth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationEmailRequest.ProtoReflect.Descriptor instead.
+func (*ResendVerificationEmailRequest) Descriptor() ([]byte, []int) {
+	return file_oauth_v1_oauth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResendVerificationEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// ResendVerificationEmailResponse is returned whether or not an email was sent, so addresses cannot be probed.
+type ResendVerificationEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationEmailResponse) Reset() {
+	*x = ResendVerificationEmailResponse{}
+	mi := &file_oauth_v1_oauth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationEmailResponse) ProtoMessage() {}
+
+func (x *ResendVerificationEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oauth_v1_oauth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationEmailResponse.ProtoReflect.Descriptor instead.
+func (*ResendVerificationEmailResponse) Descriptor() ([]byte, []int) {
+	return file_oauth_v1_oauth_proto_rawDescGZIP(), []int{13}
+}
+
+// LoginWithEmailRequest logs in with an email address and password.
+type LoginWithEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginWithEmailRequest) Reset() {
+	*x = LoginWithEmailRequest{}
+	mi := &file_oauth_v1_oauth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithEmailRequest) ProtoMessage() {}
+
+func (x *LoginWithEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oauth_v1_oauth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithEmailRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithEmailRequest) Descriptor() ([]byte, []int) {
+	return file_oauth_v1_oauth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LoginWithEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginWithEmailRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// LoginWithEmailResponse contains the Loco token of the logged in user.
+type LoginWithEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocoToken     string                 `protobuf:"bytes,1,opt,name=loco_token,json=locoToken,proto3" json:"loco_token,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // seconds
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	MfaRequired   bool                   `protobuf:"varint,5,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`   // loco_token only allows passing MFA, with UserService.VerifyMFA or ConfirmTOTP
+	RefreshToken  string                 `protobuf:"bytes,6,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // trades for new tokens with RefreshToken; empty while mfa_required is set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginWithEmailResponse) Reset() {
+	*x = LoginWithEmailResponse{}
+	mi := &file_oauth_v1_oauth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithEmailResponse) ProtoMessage() {}
+
+func (x *LoginWithEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oauth_v1_oauth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithEmailResponse.ProtoReflect.Descriptor instead.
+func (*LoginWithEmailResponse) Descriptor() ([]byte, []int) {
+	return file_oauth_v1_oauth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LoginWithEmailResponse) GetLocoToken() string {
+	if x != nil {
+		return x.LocoToken
+	}
+	return ""
+}
+
+func (x *LoginWithEmailResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *LoginWithEmailResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LoginWithEmailResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LoginWithEmailResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginWithEmailResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// RequestPasswordResetRequest asks for a password reset email.
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_oauth_v1_oauth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oauth_v1_oauth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_oauth_v1_oauth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// RequestPasswordResetResponse is returned whether or not an email was sent, so addresses cannot be probed.
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_oauth_v1_oauth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oauth_v1_oauth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_oauth_v1_oauth_proto_rawDescGZIP(), []int{17}
+}
+
+// ResetPasswordRequest sets a new password with the token from a password reset email.
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_oauth_v1_oauth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oauth_v1_oauth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_oauth_v1_oauth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// ResetPasswordResponse is returned once the password is changed. Existing sessions are logged out.
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_oauth_v1_oauth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oauth_v1_oauth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_oauth_v1_oauth_proto_rawDescGZIP(), []int{19}
+}
+
+// RefreshTokenRequest trades a refresh token for new tokens.
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // the web app leaves it empty and sends the loco_refresh cookie instead
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_oauth_v1_oauth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oauth_v1_oauth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_oauth_v1_oauth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// RefreshTokenResponse contains a new Loco token and the refresh token to use next time. The one sent is
+// used up; sending it again logs the session out.
+type RefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocoToken     string                 `protobuf:"bytes,1,opt,name=loco_token,json=locoToken,proto3" json:"loco_token,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // seconds
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_oauth_v1_oauth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oauth_v1_oauth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_oauth_v1_oauth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RefreshTokenResponse) GetLocoToken() string {
+	if x != nil {
+		return x.LocoToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *RefreshTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+var File_oauth_v1_oauth_proto protoreflect.FileDescriptor
+
+const file_oauth_v1_oauth_proto_rawDesc = "" +
+	"\n" +
+	"\x14oauth/v1/oauth.proto\x12\boauth.v1\"M\n" +
+	"\x16GetOAuthDetailsRequest\x123\n" +
+	"\bprovider\x18\x01 \x01(\x0e2\x17.oauth.v1.OAuthProviderR\bprovider\"S\n" +
+	"\x17GetOAuthDetailsResponse\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x1b\n" +
+	"\ttoken_ttl\x18\x02 \x01(\x01R\btokenTtl\"\xa0\x01\n" +
+	"\x19ExchangeOAuthTokenRequest\x123\n" +
+	"\bprovider\x18\x01 \x01(\x0e2\x17.oauth.v1.OAuthProviderR\bprovider\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x128\n" +
+	"\x19create_user_if_not_exists\x18\x03 \x01(\bR\x15createUserIfNotExists\"\xcf\x01\n" +
+	"\x1aExchangeOAuthTokenResponse\x12\x1d\n" +
+	"\n" +
+	"loco_token\x18\x01 \x01(\tR\tlocoToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12!\n" +
+	"\fmfa_required\x18\x05 \x01(\bR\vmfaRequired\x12#\n" +
+	"\rrefresh_token\x18\x06 \x01(\tR\frefreshToken\"\x8f\x01\n" +
+	"\x1fGetOAuthAuthorizationURLRequest\x123\n" +
+	"\bprovider\x18\x01 \x01(\x0e2\x17.oauth.v1.OAuthProviderR\bprovider\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12!\n" +
+	"\fredirect_uri\x18\x03 \x01(\tR\vredirectUri\"e\n" +
+	" GetOAuthAuthorizationURLResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"\x9c\x01\n" +
+	"\x18ExchangeOAuthCodeRequest\x123\n" +
+	"\bprovider\x18\x01 \x01(\x0e2\x17.oauth.v1.OAuthProviderR\bprovider\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12!\n" +
+	"\fredirect_uri\x18\x04 \x01(\tR\vredirectUri\"\x8a\x01\n" +
+	"\x19ExchangeOAuthCodeResponse\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x01 \x01(\x03R\texpiresIn\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\fmfa_required\x18\x04 \x01(\bR\vmfaRequired\"^\n" +
+	"\x16SignUpWithEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"2\n" +
+	"\x17SignUpWithEmailResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"*\n" +
+	"\x12VerifyEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xc8\x01\n" +
+	"\x13VerifyEmailResponse\x12\x1d\n" +
+	"\n" +
+	"loco_token\x18\x01 \x01(\tR\tlocoToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12!\n" +
+	"\fmfa_required\x18\x05 \x01(\bR\vmfaRequired\x12#\n" +
+	"\rrefresh_token\x18\x06 \x01(\tR\frefreshToken\"6\n" +
+	"\x1eResendVerificationEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"!\n" +
+	"\x1fResendVerificationEmailResponse\"I\n" +
+	"\x15LoginWithEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xcb\x01\n" +
+	"\x16LoginWithEmailResponse\x12\x1d\n" +
+	"\n" +
+	"loco_token\x18\x01 \x01(\tR\tlocoToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12!\n" +
+	"\fmfa_required\x18\x05 \x01(\bR\vmfaRequired\x12#\n" +
+	"\rrefresh_token\x18\x06 \x01(\tR\frefreshToken\"3\n" +
+	"\x1bRequestPasswordResetRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\x1e\n" +
+	"\x1cRequestPasswordResetResponse\"H\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x17\n" +
+	"\x15ResetPasswordResponse\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"y\n" +
+	"\x14RefreshTokenResponse\x12\x1d\n" +
+	"\n" +
+	"loco_token\x18\x01 \x01(\tR\tlocoToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken*\x9e\x01\n" +
 	"\rOAuthProvider\x12\x1f\n" +
 	"\x1bO_AUTH_PROVIDER_UNSPECIFIED\x10\x00\x12\x1a\n" +
-	"\x16O_AUTH_PROVIDER_GITHUB\x10\x012\x9e\x03\n" +
+	"\x16O_AUTH_PROVIDER_GITHUB\x10\x01\x12\x1a\n" +
+	"\x16O_AUTH_PROVIDER_GITLAB\x10\x02\x12\x1a\n" +
+	"\x16O_AUTH_PROVIDER_GOOGLE\x10\x03\x12\x18\n" +
+	"\x14O_AUTH_PROVIDER_OIDC\x10\x042\x9d\b\n" +
 	"\fOAuthService\x12X\n" +
 	"\x0fGetOAuthDetails\x12 .oauth.v1.GetOAuthDetailsRequest\x1a!.oauth.v1.GetOAuthDetailsResponse\"\x00\x12_\n" +
 	"\x12ExchangeOAuthToken\x12#.oauth.v1.ExchangeOAuthTokenRequest\x1a$.oauth.v1.ExchangeOAuthTokenResponse\x12s\n" +
 	"\x18GetOAuthAuthorizationURL\x12).oauth.v1.GetOAuthAuthorizationURLRequest\x1a*.oauth.v1.GetOAuthAuthorizationURLResponse\"\x00\x12^\n" +
-	"\x11ExchangeOAuthCode\x12\".oauth.v1.ExchangeOAuthCodeRequest\x1a#.oauth.v1.ExchangeOAuthCodeResponse\"\x00B9Z7github.com/team-loco/loco/shared/proto/oauth/v1;oauthv1b\x06proto3"
+	"\x11ExchangeOAuthCode\x12\".oauth.v1.ExchangeOAuthCodeRequest\x1a#.oauth.v1.ExchangeOAuthCodeResponse\"\x00\x12X\n" +
+	"\x0fSignUpWithEmail\x12 .oauth.v1.SignUpWithEmailRequest\x1a!.oauth.v1.SignUpWithEmailResponse\"\x00\x12L\n" +
+	"\vVerifyEmail\x12\x1c.oauth.v1.VerifyEmailRequest\x1a\x1d.oauth.v1.VerifyEmailResponse\"\x00\x12p\n" +
+	"\x17ResendVerificationEmail\x12(.oauth.v1.ResendVerificationEmailRequest\x1a).oauth.v1.ResendVerificationEmailResponse\"\x00\x12U\n" +
+	"\x0eLoginWithEmail\x12\x1f.oauth.v1.LoginWithEmailRequest\x1a .oauth.v1.LoginWithEmailResponse\"\x00\x12g\n" +
+	"\x14RequestPasswordReset\x12%.oauth.v1.RequestPasswordResetRequest\x1a&.oauth.v1.RequestPasswordResetResponse\"\x00\x12R\n" +
+	"\rResetPassword\x12\x1e.oauth.v1.ResetPasswordRequest\x1a\x1f.oauth.v1.ResetPasswordResponse\"\x00\x12O\n" +
+	"\fRefreshToken\x12\x1d.oauth.v1.RefreshTokenRequest\x1a\x1e.oauth.v1.RefreshTokenResponse\"\x00B9Z7github.com/team-loco/loco/shared/proto/oauth/v1;oauthv1b\x06proto3"
 
 var (
 	file_oauth_v1_oauth_proto_rawDescOnce sync.Once
@@ -600,7 +1429,7 @@ func file_oauth_v1_oauth_proto_rawDescGZIP() []byte {
 }
 
 var file_oauth_v1_oauth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_oauth_v1_oauth_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_oauth_v1_oauth_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_oauth_v1_oauth_proto_goTypes = []any{
 	(OAuthProvider)(0),                       // 0: oauth.v1.OAuthProvider
 	(*GetOAuthDetailsRequest)(nil),           // 1: oauth.v1.GetOAuthDetailsRequest
@@ -611,25 +1440,53 @@ var file_oauth_v1_oauth_proto_goTypes = []any{
 	(*GetOAuthAuthorizationURLResponse)(nil), // 6: oauth.v1.GetOAuthAuthorizationURLResponse
 	(*ExchangeOAuthCodeRequest)(nil),         // 7: oauth.v1.ExchangeOAuthCodeRequest
 	(*ExchangeOAuthCodeResponse)(nil),        // 8: oauth.v1.ExchangeOAuthCodeResponse
+	(*SignUpWithEmailRequest)(nil),           // 9: oauth.v1.SignUpWithEmailRequest
+	(*SignUpWithEmailResponse)(nil),          // 10: oauth.v1.SignUpWithEmailResponse
+	(*VerifyEmailRequest)(nil),               // 11: oauth.v1.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),              // 12: oauth.v1.VerifyEmailResponse
+	(*ResendVerificationEmailRequest)(nil),   // 13: oauth.v1.ResendVerificationEmailRequest
+	(*ResendVerificationEmailResponse)(nil),  // 14: oauth.v1.ResendVerificationEmailResponse
+	(*LoginWithEmailRequest)(nil),            // 15: oauth.v1.LoginWithEmailRequest
+	(*LoginWithEmailResponse)(nil),           // 16: oauth.v1.LoginWithEmailResponse
+	(*RequestPasswordResetRequest)(nil),      // 17: oauth.v1.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),     // 18: oauth.v1.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),             // 19: oauth.v1.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),            // 20: oauth.v1.ResetPasswordResponse
+	(*RefreshTokenRequest)(nil),              // 21: oauth.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),             // 22: oauth.v1.RefreshTokenResponse
 }
 var file_oauth_v1_oauth_proto_depIdxs = []int32{
-	0, // 0: oauth.v1.GetOAuthDetailsRequest.provider:type_name -> oauth.v1.OAuthProvider
-	0, // 1: oauth.v1.ExchangeOAuthTokenRequest.provider:type_name -> oauth.v1.OAuthProvider
-	0, // 2: oauth.v1.GetOAuthAuthorizationURLRequest.provider:type_name -> oauth.v1.OAuthProvider
-	0, // 3: oauth.v1.ExchangeOAuthCodeRequest.provider:type_name -> oauth.v1.OAuthProvider
-	1, // 4: oauth.v1.OAuthService.GetOAuthDetails:input_type -> oauth.v1.GetOAuthDetailsRequest
-	3, // 5: oauth.v1.OAuthService.ExchangeOAuthToken:input_type -> oauth.v1.ExchangeOAuthTokenRequest
-	5, // 6: oauth.v1.OAuthService.GetOAuthAuthorizationURL:input_type -> oauth.v1.GetOAuthAuthorizationURLRequest
-	7, // 7: oauth.v1.OAuthService.ExchangeOAuthCode:input_type -> oauth.v1.ExchangeOAuthCodeRequest
-	2, // 8: oauth.v1.OAuthService.GetOAuthDetails:output_type -> oauth.v1.GetOAuthDetailsResponse
-	4, // 9: oauth.v1.OAuthService.ExchangeOAuthToken:output_type -> oauth.v1.ExchangeOAuthTokenResponse
-	6, // 10: oauth.v1.OAuthService.GetOAuthAuthorizationURL:output_type -> oauth.v1.GetOAuthAuthorizationURLResponse
-	8, // 11: oauth.v1.OAuthService.ExchangeOAuthCode:output_type -> oauth.v1.ExchangeOAuthCodeResponse
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: oauth.v1.GetOAuthDetailsRequest.provider:type_name -> oauth.v1.OAuthProvider
+	0,  // 1: oauth.v1.ExchangeOAuthTokenRequest.provider:type_name -> oauth.v1.OAuthProvider
+	0,  // 2: oauth.v1.GetOAuthAuthorizationURLRequest.provider:type_name -> oauth.v1.OAuthProvider
+	0,  // 3: oauth.v1.ExchangeOAuthCodeRequest.provider:type_name -> oauth.v1.OAuthProvider
+	1,  // 4: oauth.v1.OAuthService.GetOAuthDetails:input_type -> oauth.v1.GetOAuthDetailsRequest
+	3,  // 5: oauth.v1.OAuthService.ExchangeOAuthToken:input_type -> oauth.v1.ExchangeOAuthTokenRequest
+	5,  // 6: oauth.v1.OAuthService.GetOAuthAuthorizationURL:input_type -> oauth.v1.GetOAuthAuthorizationURLRequest
+	7,  // 7: oauth.v1.OAuthService.ExchangeOAuthCode:input_type -> oauth.v1.ExchangeOAuthCodeRequest
+	9,  // 8: oauth.v1.OAuthService.SignUpWithEmail:input_type -> oauth.v1.SignUpWithEmailRequest
+	11, // 9: oauth.v1.OAuthService.VerifyEmail:input_type -> oauth.v1.VerifyEmailRequest
+	13, // 10: oauth.v1.OAuthService.ResendVerificationEmail:input_type -> oauth.v1.ResendVerificationEmailRequest
+	15, // 11: oauth.v1.OAuthService.LoginWithEmail:input_type -> oauth.v1.LoginWithEmailRequest
+	17, // 12: oauth.v1.OAuthService.RequestPasswordReset:input_type -> oauth.v1.RequestPasswordResetRequest
+	19, // 13: oauth.v1.OAuthService.ResetPassword:input_type -> oauth.v1.ResetPasswordRequest
+	21, // 14: oauth.v1.OAuthService.RefreshToken:input_type -> oauth.v1.RefreshTokenRequest
+	2,  // 15: oauth.v1.OAuthService.GetOAuthDetails:output_type -> oauth.v1.GetOAuthDetailsResponse
+	4,  // 16: oauth.v1.OAuthService.ExchangeOAuthToken:output_type -> oauth.v1.ExchangeOAuthTokenResponse
+	6,  // 17: oauth.v1.OAuthService.GetOAuthAuthorizationURL:output_type -> oauth.v1.GetOAuthAuthorizationURLResponse
+	8,  // 18: oauth.v1.OAuthService.ExchangeOAuthCode:output_type -> oauth.v1.ExchangeOAuthCodeResponse
+	10, // 19: oauth.v1.OAuthService.SignUpWithEmail:output_type -> oauth.v1.SignUpWithEmailResponse
+	12, // 20: oauth.v1.OAuthService.VerifyEmail:output_type -> oauth.v1.VerifyEmailResponse
+	14, // 21: oauth.v1.OAuthService.ResendVerificationEmail:output_type -> oauth.v1.ResendVerificationEmailResponse
+	16, // 22: oauth.v1.OAuthService.LoginWithEmail:output_type -> oauth.v1.LoginWithEmailResponse
+	18, // 23: oauth.v1.OAuthService.RequestPasswordReset:output_type -> oauth.v1.RequestPasswordResetResponse
+	20, // 24: oauth.v1.OAuthService.ResetPassword:output_type -> oauth.v1.ResetPasswordResponse
+	22, // 25: oauth.v1.OAuthService.RefreshToken:output_type -> oauth.v1.RefreshTokenResponse
+	15, // [15:26] is the sub-list for method output_type
+	4,  // [4:15] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_oauth_v1_oauth_proto_init() }
@@ -643,7 +1500,7 @@ func file_oauth_v1_oauth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_oauth_v1_oauth_proto_rawDesc), len(file_oauth_v1_oauth_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   8,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -45,6 +45,27 @@ const (
 	// OAuthServiceExchangeOAuthCodeProcedure is the fully-qualified name of the OAuthService's
 	// ExchangeOAuthCode RPC.
 	OAuthServiceExchangeOAuthCodeProcedure = "/oauth.v1.OAuthService/ExchangeOAuthCode"
+	// OAuthServiceSignUpWithEmailProcedure is the fully-qualified name of the OAuthService's
+	// SignUpWithEmail RPC.
+	OAuthServiceSignUpWithEmailProcedure = "/oauth.v1.OAuthService/SignUpWithEmail"
+	// OAuthServiceVerifyEmailProcedure is the fully-qualified name of the OAuthService's VerifyEmail
+	// RPC.
+	OAuthServiceVerifyEmailProcedure = "/oauth.v1.OAuthService/VerifyEmail"
+	// OAuthServiceResendVerificationEmailProcedure is the fully-qualified name of the OAuthService's
+	// ResendVerificationEmail RPC.
+	OAuthServiceResendVerificationEmailProcedure = "/oauth.v1.OAuthService/ResendVerificationEmail"
+	// OAuthServiceLoginWithEmailProcedure is the fully-qualified name of the OAuthService's
+	// LoginWithEmail RPC.
+	OAuthServiceLoginWithEmailProcedure = "/oauth.v1.OAuthService/LoginWithEmail"
+	// OAuthServiceRequestPasswordResetProcedure is the fully-qualified name of the OAuthService's
+	// RequestPasswordReset RPC.
+	OAuthServiceRequestPasswordResetProcedure = "/oauth.v1.OAuthService/RequestPasswordReset"
+	// OAuthServiceResetPasswordProcedure is the fully-qualified name of the OAuthService's
+	// ResetPassword RPC.
+	OAuthServiceResetPasswordProcedure = "/oauth.v1.OAuthService/ResetPassword"
+	// OAuthServiceRefreshTokenProcedure is the fully-qualified name of the OAuthService's RefreshToken
+	// RPC.
+	OAuthServiceRefreshTokenProcedure = "/oauth.v1.OAuthService/RefreshToken"
 )
 
 // OAuthServiceClient is a client for the oauth.v1.OAuthService service.
@@ -57,6 +78,20 @@ type OAuthServiceClient interface {
 	GetOAuthAuthorizationURL(context.Context, *connect.Request[v1.GetOAuthAuthorizationURLRequest]) (*connect.Response[v1.GetOAuthAuthorizationURLResponse], error)
 	// ExchangeOAuthCode exchanges an OAuth authorization code for a Loco token.
 	ExchangeOAuthCode(context.Context, *connect.Request[v1.ExchangeOAuthCodeRequest]) (*connect.Response[v1.ExchangeOAuthCodeResponse], error)
+	// SignUpWithEmail creates an account with an email address and password and sends a verification email.
+	SignUpWithEmail(context.Context, *connect.Request[v1.SignUpWithEmailRequest]) (*connect.Response[v1.SignUpWithEmailResponse], error)
+	// VerifyEmail verifies an email address with the token emailed to it and logs the user in.
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	// ResendVerificationEmail sends another verification email to an unverified address.
+	ResendVerificationEmail(context.Context, *connect.Request[v1.ResendVerificationEmailRequest]) (*connect.Response[v1.ResendVerificationEmailResponse], error)
+	// LoginWithEmail exchanges a verified email address and password for a Loco token.
+	LoginWithEmail(context.Context, *connect.Request[v1.LoginWithEmailRequest]) (*connect.Response[v1.LoginWithEmailResponse], error)
+	// RequestPasswordReset emails a password reset link to an account that has a password.
+	RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error)
+	// ResetPassword sets a new password with the token from a password reset email.
+	ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error)
+	// RefreshToken trades a refresh token, issued with every login, for a new Loco token and refresh token.
+	RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error)
 }
 
 // NewOAuthServiceClient constructs a client for the oauth.v1.OAuthService service. By default, it
@@ -94,6 +129,48 @@ func NewOAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(oAuthServiceMethods.ByName("ExchangeOAuthCode")),
 			connect.WithClientOptions(opts...),
 		),
+		signUpWithEmail: connect.NewClient[v1.SignUpWithEmailRequest, v1.SignUpWithEmailResponse](
+			httpClient,
+			baseURL+OAuthServiceSignUpWithEmailProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("SignUpWithEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyEmail: connect.NewClient[v1.VerifyEmailRequest, v1.VerifyEmailResponse](
+			httpClient,
+			baseURL+OAuthServiceVerifyEmailProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("VerifyEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		resendVerificationEmail: connect.NewClient[v1.ResendVerificationEmailRequest, v1.ResendVerificationEmailResponse](
+			httpClient,
+			baseURL+OAuthServiceResendVerificationEmailProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("ResendVerificationEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		loginWithEmail: connect.NewClient[v1.LoginWithEmailRequest, v1.LoginWithEmailResponse](
+			httpClient,
+			baseURL+OAuthServiceLoginWithEmailProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("LoginWithEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		requestPasswordReset: connect.NewClient[v1.RequestPasswordResetRequest, v1.RequestPasswordResetResponse](
+			httpClient,
+			baseURL+OAuthServiceRequestPasswordResetProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("RequestPasswordReset")),
+			connect.WithClientOptions(opts...),
+		),
+		resetPassword: connect.NewClient[v1.ResetPasswordRequest, v1.ResetPasswordResponse](
+			httpClient,
+			baseURL+OAuthServiceResetPasswordProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("ResetPassword")),
+			connect.WithClientOptions(opts...),
+		),
+		refreshToken: connect.NewClient[v1.RefreshTokenRequest, v1.RefreshTokenResponse](
+			httpClient,
+			baseURL+OAuthServiceRefreshTokenProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("RefreshToken")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -103,6 +180,13 @@ type oAuthServiceClient struct {
 	exchangeOAuthToken       *connect.Client[v1.ExchangeOAuthTokenRequest, v1.ExchangeOAuthTokenResponse]
 	getOAuthAuthorizationURL *connect.Client[v1.GetOAuthAuthorizationURLRequest, v1.GetOAuthAuthorizationURLResponse]
 	exchangeOAuthCode        *connect.Client[v1.ExchangeOAuthCodeRequest, v1.ExchangeOAuthCodeResponse]
+	signUpWithEmail          *connect.Client[v1.SignUpWithEmailRequest, v1.SignUpWithEmailResponse]
+	verifyEmail              *connect.Client[v1.VerifyEmailRequest, v1.VerifyEmailResponse]
+	resendVerificationEmail  *connect.Client[v1.ResendVerificationEmailRequest, v1.ResendVerificationEmailResponse]
+	loginWithEmail           *connect.Client[v1.LoginWithEmailRequest, v1.LoginWithEmailResponse]
+	requestPasswordReset     *connect.Client[v1.RequestPasswordResetRequest, v1.RequestPasswordResetResponse]
+	resetPassword            *connect.Client[v1.ResetPasswordRequest, v1.ResetPasswordResponse]
+	refreshToken             *connect.Client[v1.RefreshTokenRequest, v1.RefreshTokenResponse]
 }
 
 // GetOAuthDetails calls oauth.v1.OAuthService.GetOAuthDetails.
@@ -125,6 +209,41 @@ func (c *oAuthServiceClient) ExchangeOAuthCode(ctx context.Context, req *connect
 	return c.exchangeOAuthCode.CallUnary(ctx, req)
 }
 
+// SignUpWithEmail calls oauth.v1.OAuthService.SignUpWithEmail.
+func (c *oAuthServiceClient) SignUpWithEmail(ctx context.Context, req *connect.Request[v1.SignUpWithEmailRequest]) (*connect.Response[v1.SignUpWithEmailResponse], error) {
+	return c.signUpWithEmail.CallUnary(ctx, req)
+}
+
+// VerifyEmail calls oauth.v1.OAuthService.VerifyEmail.
+func (c *oAuthServiceClient) VerifyEmail(ctx context.Context, req *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return c.verifyEmail.CallUnary(ctx, req)
+}
+
+// ResendVerificationEmail calls oauth.v1.OAuthService.ResendVerificationEmail.
+func (c *oAuthServiceClient) ResendVerificationEmail(ctx context.Context, req *connect.Request[v1.ResendVerificationEmailRequest]) (*connect.Response[v1.ResendVerificationEmailResponse], error) {
+	return c.resendVerificationEmail.CallUnary(ctx, req)
+}
+
+// LoginWithEmail calls oauth.v1.OAuthService.LoginWithEmail.
+func (c *oAuthServiceClient) LoginWithEmail(ctx context.Context, req *connect.Request[v1.LoginWithEmailRequest]) (*connect.Response[v1.LoginWithEmailResponse], error) {
+	return c.loginWithEmail.CallUnary(ctx, req)
+}
+
+// RequestPasswordReset calls oauth.v1.OAuthService.RequestPasswordReset.
+func (c *oAuthServiceClient) RequestPasswordReset(ctx context.Context, req *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error) {
+	return c.requestPasswordReset.CallUnary(ctx, req)
+}
+
+// ResetPassword calls oauth.v1.OAuthService.ResetPassword.
+func (c *oAuthServiceClient) ResetPassword(ctx context.Context, req *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error) {
+	return c.resetPassword.CallUnary(ctx, req)
+}
+
+// RefreshToken calls oauth.v1.OAuthService.RefreshToken.
+func (c *oAuthServiceClient) RefreshToken(ctx context.Context, req *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error) {
+	return c.refreshToken.CallUnary(ctx, req)
+}
+
 // OAuthServiceHandler is an implementation of the oauth.v1.OAuthService service.
 type OAuthServiceHandler interface {
 	// GetOAuthDetails retrieves OAuth configuration for a provider.
@@ -135,6 +254,20 @@ type OAuthServiceHandler interface {
 	GetOAuthAuthorizationURL(context.Context, *connect.Request[v1.GetOAuthAuthorizationURLRequest]) (*connect.Response[v1.GetOAuthAuthorizationURLResponse], error)
 	// ExchangeOAuthCode exchanges an OAuth authorization code for a Loco token.
 	ExchangeOAuthCode(context.Context, *connect.Request[v1.ExchangeOAuthCodeRequest]) (*connect.Response[v1.ExchangeOAuthCodeResponse], error)
+	// SignUpWithEmail creates an account with an email address and password and sends a verification email.
+	SignUpWithEmail(context.Context, *connect.Request[v1.SignUpWithEmailRequest]) (*connect.Response[v1.SignUpWithEmailResponse], error)
+	// VerifyEmail verifies an email address with the token emailed to it and logs the user in.
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	// ResendVerificationEmail sends another verification email to an unverified address.
+	ResendVerificationEmail(context.Context, *connect.Request[v1.ResendVerificationEmailRequest]) (*connect.Response[v1.ResendVerificationEmailResponse], error)
+	// LoginWithEmail exchanges a verified email address and password for a Loco token.
+	LoginWithEmail(context.Context, *connect.Request[v1.LoginWithEmailRequest]) (*connect.Response[v1.LoginWithEmailResponse], error)
+	// RequestPasswordReset emails a password reset link to an account that has a password.
+	RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error)
+	// ResetPassword sets a new password with the token from a password reset email.
+	ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error)
+	// RefreshToken trades a refresh token, issued with every login, for a new Loco token and refresh token.
+	RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error)
 }
 
 // NewOAuthServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -168,6 +301,48 @@ func NewOAuthServiceHandler(svc OAuthServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(oAuthServiceMethods.ByName("ExchangeOAuthCode")),
 		connect.WithHandlerOptions(opts...),
 	)
+	oAuthServiceSignUpWithEmailHandler := connect.NewUnaryHandler(
+		OAuthServiceSignUpWithEmailProcedure,
+		svc.SignUpWithEmail,
+		connect.WithSchema(oAuthServiceMethods.ByName("SignUpWithEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceVerifyEmailHandler := connect.NewUnaryHandler(
+		OAuthServiceVerifyEmailProcedure,
+		svc.VerifyEmail,
+		connect.WithSchema(oAuthServiceMethods.ByName("VerifyEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceResendVerificationEmailHandler := connect.NewUnaryHandler(
+		OAuthServiceResendVerificationEmailProcedure,
+		svc.ResendVerificationEmail,
+		connect.WithSchema(oAuthServiceMethods.ByName("ResendVerificationEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceLoginWithEmailHandler := connect.NewUnaryHandler(
+		OAuthServiceLoginWithEmailProcedure,
+		svc.LoginWithEmail,
+		connect.WithSchema(oAuthServiceMethods.ByName("LoginWithEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceRequestPasswordResetHandler := connect.NewUnaryHandler(
+		OAuthServiceRequestPasswordResetProcedure,
+		svc.RequestPasswordReset,
+		connect.WithSchema(oAuthServiceMethods.ByName("RequestPasswordReset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceResetPasswordHandler := connect.NewUnaryHandler(
+		OAuthServiceResetPasswordProcedure,
+		svc.ResetPassword,
+		connect.WithSchema(oAuthServiceMethods.ByName("ResetPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceRefreshTokenHandler := connect.NewUnaryHandler(
+		OAuthServiceRefreshTokenProcedure,
+		svc.RefreshToken,
+		connect.WithSchema(oAuthServiceMethods.ByName("RefreshToken")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/oauth.v1.OAuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case OAuthServiceGetOAuthDetailsProcedure:
@@ -178,6 +353,20 @@ func NewOAuthServiceHandler(svc OAuthServiceHandler, opts ...connect.HandlerOpti
 			oAuthServiceGetOAuthAuthorizationURLHandler.ServeHTTP(w, r)
 		case OAuthServiceExchangeOAuthCodeProcedure:
 			oAuthServiceExchangeOAuthCodeHandler.ServeHTTP(w, r)
+		case OAuthServiceSignUpWithEmailProcedure:
+			oAuthServiceSignUpWithEmailHandler.ServeHTTP(w, r)
+		case OAuthServiceVerifyEmailProcedure:
+			oAuthServiceVerifyEmailHandler.ServeHTTP(w, r)
+		case OAuthServiceResendVerificationEmailProcedure:
+			oAuthServiceResendVerificationEmailHandler.ServeHTTP(w, r)
+		case OAuthServiceLoginWithEmailProcedure:
+			oAuthServiceLoginWithEmailHandler.ServeHTTP(w, r)
+		case OAuthServiceRequestPasswordResetProcedure:
+			oAuthServiceRequestPasswordResetHandler.ServeHTTP(w, r)
+		case OAuthServiceResetPasswordProcedure:
+			oAuthServiceResetPasswordHandler.ServeHTTP(w, r)
+		case OAuthServiceRefreshTokenProcedure:
+			oAuthServiceRefreshTokenHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -202,3 +391,31 @@ func (UnimplementedOAuthServiceHandler) GetOAuthAuthorizationURL(context.Context
 func (UnimplementedOAuthServiceHandler) ExchangeOAuthCode(context.Context, *connect.Request[v1.ExchangeOAuthCodeRequest]) (*connect.Response[v1.ExchangeOAuthCodeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oauth.v1.OAuthService.ExchangeOAuthCode is not implemented"))
 }
+
+func (UnimplementedOAuthServiceHandler) SignUpWithEmail(context.Context, *connect.Request[v1.SignUpWithEmailRequest]) (*connect.Response[v1.SignUpWithEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oauth.v1.OAuthService.SignUpWithEmail is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oauth.v1.OAuthService.VerifyEmail is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) ResendVerificationEmail(context.Context, *connect.Request[v1.ResendVerificationEmailRequest]) (*connect.Response[v1.ResendVerificationEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oauth.v1.OAuthService.ResendVerificationEmail is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) LoginWithEmail(context.Context, *connect.Request[v1.LoginWithEmailRequest]) (*connect.Response[v1.LoginWithEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oauth.v1.OAuthService.LoginWithEmail is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oauth.v1.OAuthService.RequestPasswordReset is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oauth.v1.OAuthService.ResetPassword is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oauth.v1.OAuthService.RefreshToken is not implemented"))
+}
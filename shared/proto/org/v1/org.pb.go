@@ -7,6 +7,7 @@
 package orgv1
 
 import (
+	v1 "github.com/team-loco/loco/shared/proto/token/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
@@ -23,16 +24,74 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// OrgDeletionStatus is how far a cascading deletion has got.
+type OrgDeletionStatus int32
+
+const (
+	OrgDeletionStatus_ORG_DELETION_STATUS_UNSPECIFIED OrgDeletionStatus = 0
+	OrgDeletionStatus_ORG_DELETION_STATUS_PENDING     OrgDeletionStatus = 1
+	OrgDeletionStatus_ORG_DELETION_STATUS_RUNNING     OrgDeletionStatus = 2
+	OrgDeletionStatus_ORG_DELETION_STATUS_COMPLETED   OrgDeletionStatus = 3
+	OrgDeletionStatus_ORG_DELETION_STATUS_FAILED      OrgDeletionStatus = 4
+)
+
+// Enum value maps for OrgDeletionStatus.
+var (
+	OrgDeletionStatus_name = map[int32]string{
+		0: "ORG_DELETION_STATUS_UNSPECIFIED",
+		1: "ORG_DELETION_STATUS_PENDING",
+		2: "ORG_DELETION_STATUS_RUNNING",
+		3: "ORG_DELETION_STATUS_COMPLETED",
+		4: "ORG_DELETION_STATUS_FAILED",
+	}
+	OrgDeletionStatus_value = map[string]int32{
+		"ORG_DELETION_STATUS_UNSPECIFIED": 0,
+		"ORG_DELETION_STATUS_PENDING":     1,
+		"ORG_DELETION_STATUS_RUNNING":     2,
+		"ORG_DELETION_STATUS_COMPLETED":   3,
+		"ORG_DELETION_STATUS_FAILED":      4,
+	}
+)
+
+func (x OrgDeletionStatus) Enum() *OrgDeletionStatus {
+	p := new(OrgDeletionStatus)
+	*p = x
+	return p
+}
+
+func (x OrgDeletionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrgDeletionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_org_v1_org_proto_enumTypes[0].Descriptor()
+}
+
+func (OrgDeletionStatus) Type() protoreflect.EnumType {
+	return &file_org_v1_org_proto_enumTypes[0]
+}
+
+func (x OrgDeletionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrgDeletionStatus.Descriptor instead.
+func (OrgDeletionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{0}
+}
+
 // Organization represents a top-level organization container for users, workspaces, and resources.
 type Organization struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedBy     int64                  `protobuf:"varint,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedBy          int64                  `protobuf:"varint,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletionProtection bool                   `protobuf:"varint,6,opt,name=deletion_protection,json=deletionProtection,proto3" json:"deletion_protection,omitempty"` // nothing in the organization can be deleted while set
+	RequireMfa         bool                   `protobuf:"varint,7,opt,name=require_mfa,json=requireMfa,proto3" json:"require_mfa,omitempty"`                         // members must pass a TOTP check to log in while set
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Organization) Reset() {
@@ -100,6 +159,20 @@ func (x *Organization) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Organization) GetDeletionProtection() bool {
+	if x != nil {
+		return x.DeletionProtection
+	}
+	return false
+}
+
+func (x *Organization) GetRequireMfa() bool {
+	if x != nil {
+		return x.RequireMfa
+	}
+	return false
+}
+
 // WorkspaceSummary provides a lightweight summary of a workspace for listing operations.
 type WorkspaceSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -686,12 +759,13 @@ func (x *User) GetAvatarUrl() string {
 
 // ListOrgWorkspacesRequest is the request to list workspaces in an organization.
 type ListOrgWorkspacesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // default: 50, max: 200
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // cursor from previous page (base64-encoded timestamp+id)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrgId           int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	PageSize        int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // default: 50, max: 200
+	PageToken       string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // cursor from previous page (base64-encoded timestamp+id)
+	IncludeArchived bool                   `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListOrgWorkspacesRequest) Reset() {
@@ -745,6 +819,13 @@ func (x *ListOrgWorkspacesRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListOrgWorkspacesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 // ListOrgWorkspacesResponse is the response containing workspace list.
 type ListOrgWorkspacesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -908,6 +989,8 @@ func (x *UpdateOrgResponse) GetOrgId() int64 {
 type DeleteOrgRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Cascade       bool                   `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`             // also delete the organization's workspaces and resources; without it, deleting an org with resources fails
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // only report what would be deleted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -949,9 +1032,25 @@ func (x *DeleteOrgRequest) GetOrgId() int64 {
 	return 0
 }
 
+func (x *DeleteOrgRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+func (x *DeleteOrgRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 // DeleteOrgResponse is the response after deleting an organization.
 type DeleteOrgResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *OrgDeletionReport     `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Deletion      *OrgDeletion           `protobuf:"bytes,2,opt,name=deletion,proto3,oneof" json:"deletion,omitempty"` // set when a cascading deletion was started
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -986,90 +1085,1053 @@ func (*DeleteOrgResponse) Descriptor() ([]byte, []int) {
 	return file_org_v1_org_proto_rawDescGZIP(), []int{16}
 }
 
-var File_org_v1_org_proto protoreflect.FileDescriptor
+func (x *DeleteOrgResponse) GetReport() *OrgDeletionReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
 
-const file_org_v1_org_proto_rawDesc = "" +
-	"\n" +
-	"\x10org/v1/org.proto\x12\x06org.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc7\x01\n" +
-	"\fOrganization\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\x03 \x01(\x03R\tcreatedBy\x129\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x90\x01\n" +
-	"\x10WorkspaceSummary\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\x03 \x01(\x03R\tcreatedBy\x129\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"4\n" +
-	"\x10CreateOrgRequest\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
-	"\x05_name\"*\n" +
-	"\x11CreateOrgResponse\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\"L\n" +
-	"\rGetOrgRequest\x12\x17\n" +
-	"\x06org_id\x18\x01 \x01(\x03H\x00R\x05orgId\x12\x1b\n" +
-	"\borg_name\x18\x02 \x01(\tH\x00R\aorgNameB\x05\n" +
-	"\x03key\"J\n" +
-	"\x0eGetOrgResponse\x128\n" +
-	"\forganization\x18\x01 \x01(\v2\x14.org.v1.OrganizationR\forganization\"j\n" +
-	"\x13ListUserOrgsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"h\n" +
-	"\x14ListUserOrgsResponse\x12(\n" +
-	"\x04orgs\x18\x01 \x03(\v2\x14.org.v1.OrganizationR\x04orgs\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"h\n" +
-	"\x13ListOrgUsersRequest\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"b\n" +
-	"\x14ListOrgUsersResponse\x12\"\n" +
-	"\x05users\x18\x01 \x03(\v2\f.org.v1.UserR\x05users\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"_\n" +
-	"\x04User\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
-	"\n" +
-	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\"m\n" +
-	"\x18ListOrgWorkspacesRequest\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"}\n" +
-	"\x19ListOrgWorkspacesResponse\x128\n" +
-	"\n" +
-	"workspaces\x18\x01 \x03(\v2\x18.org.v1.WorkspaceSummaryR\n" +
-	"workspaces\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x88\x01\n" +
-	"\x10UpdateOrgRequest\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12;\n" +
-	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\x12\x17\n" +
-	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
-	"\x05_name\"*\n" +
-	"\x11UpdateOrgResponse\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\")\n" +
-	"\x10DeleteOrgRequest\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\"\x13\n" +
-	"\x11DeleteOrgResponse2\xfb\x03\n" +
+func (x *DeleteOrgResponse) GetDeletion() *OrgDeletion {
+	if x != nil {
+		return x.Deletion
+	}
+	return nil
+}
+
+// OrgDeletionReport lists everything deleting an organization affects.
+type OrgDeletionReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspaces    []*AffectedWorkspace   `protobuf:"bytes,1,rep,name=workspaces,proto3" json:"workspaces,omitempty"`
+	Resources     int32                  `protobuf:"varint,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	Domains       int32                  `protobuf:"varint,3,opt,name=domains,proto3" json:"domains,omitempty"`
+	Deployments   int32                  `protobuf:"varint,4,opt,name=deployments,proto3" json:"deployments,omitempty"`
+	Blockers      []string               `protobuf:"bytes,5,rep,name=blockers,proto3" json:"blockers,omitempty"` // why the organization cannot be deleted right now, if anything
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrgDeletionReport) Reset() {
+	*x = OrgDeletionReport{}
+	mi := &file_org_v1_org_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrgDeletionReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgDeletionReport) ProtoMessage() {}
+
+func (x *OrgDeletionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgDeletionReport.ProtoReflect.Descriptor instead.
+func (*OrgDeletionReport) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OrgDeletionReport) GetWorkspaces() []*AffectedWorkspace {
+	if x != nil {
+		return x.Workspaces
+	}
+	return nil
+}
+
+func (x *OrgDeletionReport) GetResources() int32 {
+	if x != nil {
+		return x.Resources
+	}
+	return 0
+}
+
+func (x *OrgDeletionReport) GetDomains() int32 {
+	if x != nil {
+		return x.Domains
+	}
+	return 0
+}
+
+func (x *OrgDeletionReport) GetDeployments() int32 {
+	if x != nil {
+		return x.Deployments
+	}
+	return 0
+}
+
+func (x *OrgDeletionReport) GetBlockers() []string {
+	if x != nil {
+		return x.Blockers
+	}
+	return nil
+}
+
+// AffectedWorkspace is a workspace that deleting an organization removes.
+type AffectedWorkspace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Resources     []*AffectedResource    `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AffectedWorkspace) Reset() {
+	*x = AffectedWorkspace{}
+	mi := &file_org_v1_org_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AffectedWorkspace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AffectedWorkspace) ProtoMessage() {}
+
+func (x *AffectedWorkspace) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AffectedWorkspace.ProtoReflect.Descriptor instead.
+func (*AffectedWorkspace) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AffectedWorkspace) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AffectedWorkspace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AffectedWorkspace) GetResources() []*AffectedResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// AffectedResource is a resource that deleting an organization tears down.
+type AffectedResource struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Domains           []string               `protobuf:"bytes,3,rep,name=domains,proto3" json:"domains,omitempty"`
+	Deployments       int32                  `protobuf:"varint,4,opt,name=deployments,proto3" json:"deployments,omitempty"`
+	ActiveDeployments int32                  `protobuf:"varint,5,opt,name=active_deployments,json=activeDeployments,proto3" json:"active_deployments,omitempty"`
+	Locked            bool                   `protobuf:"varint,6,opt,name=locked,proto3" json:"locked,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AffectedResource) Reset() {
+	*x = AffectedResource{}
+	mi := &file_org_v1_org_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AffectedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AffectedResource) ProtoMessage() {}
+
+func (x *AffectedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AffectedResource.ProtoReflect.Descriptor instead.
+func (*AffectedResource) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AffectedResource) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AffectedResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AffectedResource) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *AffectedResource) GetDeployments() int32 {
+	if x != nil {
+		return x.Deployments
+	}
+	return 0
+}
+
+func (x *AffectedResource) GetActiveDeployments() int32 {
+	if x != nil {
+		return x.ActiveDeployments
+	}
+	return 0
+}
+
+func (x *AffectedResource) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+// OrgDeletion tracks a cascading organization deletion.
+type OrgDeletion struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId            int64                  `protobuf:"varint,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	OrgName          string                 `protobuf:"bytes,3,opt,name=org_name,json=orgName,proto3" json:"org_name,omitempty"`
+	Status           OrgDeletionStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=org.v1.OrgDeletionStatus" json:"status,omitempty"`
+	TotalResources   int32                  `protobuf:"varint,5,opt,name=total_resources,json=totalResources,proto3" json:"total_resources,omitempty"`
+	DeletedResources int32                  `protobuf:"varint,6,opt,name=deleted_resources,json=deletedResources,proto3" json:"deleted_resources,omitempty"`
+	Error            string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"` // why the deletion failed
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OrgDeletion) Reset() {
+	*x = OrgDeletion{}
+	mi := &file_org_v1_org_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrgDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgDeletion) ProtoMessage() {}
+
+func (x *OrgDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgDeletion.ProtoReflect.Descriptor instead.
+func (*OrgDeletion) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OrgDeletion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrgDeletion) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *OrgDeletion) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *OrgDeletion) GetStatus() OrgDeletionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrgDeletionStatus_ORG_DELETION_STATUS_UNSPECIFIED
+}
+
+func (x *OrgDeletion) GetTotalResources() int32 {
+	if x != nil {
+		return x.TotalResources
+	}
+	return 0
+}
+
+func (x *OrgDeletion) GetDeletedResources() int32 {
+	if x != nil {
+		return x.DeletedResources
+	}
+	return 0
+}
+
+func (x *OrgDeletion) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *OrgDeletion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OrgDeletion) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *OrgDeletion) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// GetOrgDeletionRequest is the request to get a cascading deletion's progress.
+type GetOrgDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeletionId    int64                  `protobuf:"varint,1,opt,name=deletion_id,json=deletionId,proto3" json:"deletion_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrgDeletionRequest) Reset() {
+	*x = GetOrgDeletionRequest{}
+	mi := &file_org_v1_org_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrgDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrgDeletionRequest) ProtoMessage() {}
+
+func (x *GetOrgDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrgDeletionRequest.ProtoReflect.Descriptor instead.
+func (*GetOrgDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetOrgDeletionRequest) GetDeletionId() int64 {
+	if x != nil {
+		return x.DeletionId
+	}
+	return 0
+}
+
+// GetOrgDeletionResponse is the response containing the deletion.
+type GetOrgDeletionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deletion      *OrgDeletion           `protobuf:"bytes,1,opt,name=deletion,proto3" json:"deletion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrgDeletionResponse) Reset() {
+	*x = GetOrgDeletionResponse{}
+	mi := &file_org_v1_org_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrgDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrgDeletionResponse) ProtoMessage() {}
+
+func (x *GetOrgDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrgDeletionResponse.ProtoReflect.Descriptor instead.
+func (*GetOrgDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetOrgDeletionResponse) GetDeletion() *OrgDeletion {
+	if x != nil {
+		return x.Deletion
+	}
+	return nil
+}
+
+// SetOrgDeletionProtectionRequest is the request to turn deletion protection on or off.
+type SetOrgDeletionProtectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOrgDeletionProtectionRequest) Reset() {
+	*x = SetOrgDeletionProtectionRequest{}
+	mi := &file_org_v1_org_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOrgDeletionProtectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrgDeletionProtectionRequest) ProtoMessage() {}
+
+func (x *SetOrgDeletionProtectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrgDeletionProtectionRequest.ProtoReflect.Descriptor instead.
+func (*SetOrgDeletionProtectionRequest) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetOrgDeletionProtectionRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *SetOrgDeletionProtectionRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// SetOrgDeletionProtectionResponse is the response containing the updated organization.
+type SetOrgDeletionProtectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOrgDeletionProtectionResponse) Reset() {
+	*x = SetOrgDeletionProtectionResponse{}
+	mi := &file_org_v1_org_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOrgDeletionProtectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrgDeletionProtectionResponse) ProtoMessage() {}
+
+func (x *SetOrgDeletionProtectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrgDeletionProtectionResponse.ProtoReflect.Descriptor instead.
+func (*SetOrgDeletionProtectionResponse) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetOrgDeletionProtectionResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+// SetOrgRequireMFARequest is the request to turn the MFA requirement on or off.
+type SetOrgRequireMFARequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOrgRequireMFARequest) Reset() {
+	*x = SetOrgRequireMFARequest{}
+	mi := &file_org_v1_org_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOrgRequireMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrgRequireMFARequest) ProtoMessage() {}
+
+func (x *SetOrgRequireMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrgRequireMFARequest.ProtoReflect.Descriptor instead.
+func (*SetOrgRequireMFARequest) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetOrgRequireMFARequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *SetOrgRequireMFARequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// SetOrgRequireMFAResponse is the response containing the updated organization.
+type SetOrgRequireMFAResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOrgRequireMFAResponse) Reset() {
+	*x = SetOrgRequireMFAResponse{}
+	mi := &file_org_v1_org_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOrgRequireMFAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrgRequireMFAResponse) ProtoMessage() {}
+
+func (x *SetOrgRequireMFAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrgRequireMFAResponse.ProtoReflect.Descriptor instead.
+func (*SetOrgRequireMFAResponse) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetOrgRequireMFAResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+// DirectoryGroupRoles are the scopes members of a directory group get.
+type DirectoryGroupRoles struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupName     string                 `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"` // the displayName of the SCIM group
+	Scopes        []*v1.EntityScope      `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DirectoryGroupRoles) Reset() {
+	*x = DirectoryGroupRoles{}
+	mi := &file_org_v1_org_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DirectoryGroupRoles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectoryGroupRoles) ProtoMessage() {}
+
+func (x *DirectoryGroupRoles) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectoryGroupRoles.ProtoReflect.Descriptor instead.
+func (*DirectoryGroupRoles) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DirectoryGroupRoles) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *DirectoryGroupRoles) GetScopes() []*v1.EntityScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// SetDirectoryGroupRolesRequest is the request to map a directory group to scopes.
+type SetDirectoryGroupRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Scopes        []*v1.EntityScope      `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"` // must be within the org; the caller must hold each scope itself
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDirectoryGroupRolesRequest) Reset() {
+	*x = SetDirectoryGroupRolesRequest{}
+	mi := &file_org_v1_org_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDirectoryGroupRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDirectoryGroupRolesRequest) ProtoMessage() {}
+
+func (x *SetDirectoryGroupRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDirectoryGroupRolesRequest.ProtoReflect.Descriptor instead.
+func (*SetDirectoryGroupRolesRequest) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetDirectoryGroupRolesRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *SetDirectoryGroupRolesRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *SetDirectoryGroupRolesRequest) GetScopes() []*v1.EntityScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// SetDirectoryGroupRolesResponse is the response after mapping a directory group.
+type SetDirectoryGroupRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDirectoryGroupRolesResponse) Reset() {
+	*x = SetDirectoryGroupRolesResponse{}
+	mi := &file_org_v1_org_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDirectoryGroupRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDirectoryGroupRolesResponse) ProtoMessage() {}
+
+func (x *SetDirectoryGroupRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDirectoryGroupRolesResponse.ProtoReflect.Descriptor instead.
+func (*SetDirectoryGroupRolesResponse) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{29}
+}
+
+// ListDirectoryGroupRolesRequest is the request to list an organization's directory group mappings.
+type ListDirectoryGroupRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDirectoryGroupRolesRequest) Reset() {
+	*x = ListDirectoryGroupRolesRequest{}
+	mi := &file_org_v1_org_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDirectoryGroupRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDirectoryGroupRolesRequest) ProtoMessage() {}
+
+func (x *ListDirectoryGroupRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDirectoryGroupRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListDirectoryGroupRolesRequest) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListDirectoryGroupRolesRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+// ListDirectoryGroupRolesResponse is the response containing the mappings, by group name.
+type ListDirectoryGroupRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*DirectoryGroupRoles `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDirectoryGroupRolesResponse) Reset() {
+	*x = ListDirectoryGroupRolesResponse{}
+	mi := &file_org_v1_org_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDirectoryGroupRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDirectoryGroupRolesResponse) ProtoMessage() {}
+
+func (x *ListDirectoryGroupRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_v1_org_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDirectoryGroupRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListDirectoryGroupRolesResponse) Descriptor() ([]byte, []int) {
+	return file_org_v1_org_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListDirectoryGroupRolesResponse) GetGroups() []*DirectoryGroupRoles {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+var File_org_v1_org_proto protoreflect.FileDescriptor
+
+const file_org_v1_org_proto_rawDesc = "" +
+	"\n" +
+	"\x10org/v1/org.proto\x12\x06org.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14token/v1/token.proto\"\x99\x02\n" +
+	"\fOrganization\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\x03R\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
+	"\x13deletion_protection\x18\x06 \x01(\bR\x12deletionProtection\x12\x1f\n" +
+	"\vrequire_mfa\x18\a \x01(\bR\n" +
+	"requireMfa\"\x90\x01\n" +
+	"\x10WorkspaceSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\x03R\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"4\n" +
+	"\x10CreateOrgRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"*\n" +
+	"\x11CreateOrgResponse\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\"L\n" +
+	"\rGetOrgRequest\x12\x17\n" +
+	"\x06org_id\x18\x01 \x01(\x03H\x00R\x05orgId\x12\x1b\n" +
+	"\borg_name\x18\x02 \x01(\tH\x00R\aorgNameB\x05\n" +
+	"\x03key\"J\n" +
+	"\x0eGetOrgResponse\x128\n" +
+	"\forganization\x18\x01 \x01(\v2\x14.org.v1.OrganizationR\forganization\"j\n" +
+	"\x13ListUserOrgsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"h\n" +
+	"\x14ListUserOrgsResponse\x12(\n" +
+	"\x04orgs\x18\x01 \x03(\v2\x14.org.v1.OrganizationR\x04orgs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"h\n" +
+	"\x13ListOrgUsersRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"b\n" +
+	"\x14ListOrgUsersResponse\x12\"\n" +
+	"\x05users\x18\x01 \x03(\v2\f.org.v1.UserR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"_\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\"\x98\x01\n" +
+	"\x18ListOrgWorkspacesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12)\n" +
+	"\x10include_archived\x18\x04 \x01(\bR\x0fincludeArchived\"}\n" +
+	"\x19ListOrgWorkspacesResponse\x128\n" +
+	"\n" +
+	"workspaces\x18\x01 \x03(\v2\x18.org.v1.WorkspaceSummaryR\n" +
+	"workspaces\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x88\x01\n" +
+	"\x10UpdateOrgRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"*\n" +
+	"\x11UpdateOrgResponse\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\"\\\n" +
+	"\x10DeleteOrgRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x18\n" +
+	"\acascade\x18\x02 \x01(\bR\acascade\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\x89\x01\n" +
+	"\x11DeleteOrgResponse\x121\n" +
+	"\x06report\x18\x01 \x01(\v2\x19.org.v1.OrgDeletionReportR\x06report\x124\n" +
+	"\bdeletion\x18\x02 \x01(\v2\x13.org.v1.OrgDeletionH\x00R\bdeletion\x88\x01\x01B\v\n" +
+	"\t_deletion\"\xc4\x01\n" +
+	"\x11OrgDeletionReport\x129\n" +
+	"\n" +
+	"workspaces\x18\x01 \x03(\v2\x19.org.v1.AffectedWorkspaceR\n" +
+	"workspaces\x12\x1c\n" +
+	"\tresources\x18\x02 \x01(\x05R\tresources\x12\x18\n" +
+	"\adomains\x18\x03 \x01(\x05R\adomains\x12 \n" +
+	"\vdeployments\x18\x04 \x01(\x05R\vdeployments\x12\x1a\n" +
+	"\bblockers\x18\x05 \x03(\tR\bblockers\"o\n" +
+	"\x11AffectedWorkspace\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x126\n" +
+	"\tresources\x18\x03 \x03(\v2\x18.org.v1.AffectedResourceR\tresources\"\xb9\x01\n" +
+	"\x10AffectedResource\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\adomains\x18\x03 \x03(\tR\adomains\x12 \n" +
+	"\vdeployments\x18\x04 \x01(\x05R\vdeployments\x12-\n" +
+	"\x12active_deployments\x18\x05 \x01(\x05R\x11activeDeployments\x12\x16\n" +
+	"\x06locked\x18\x06 \x01(\bR\x06locked\"\xa3\x03\n" +
+	"\vOrgDeletion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\x03R\x05orgId\x12\x19\n" +
+	"\borg_name\x18\x03 \x01(\tR\aorgName\x121\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x19.org.v1.OrgDeletionStatusR\x06status\x12'\n" +
+	"\x0ftotal_resources\x18\x05 \x01(\x05R\x0etotalResources\x12+\n" +
+	"\x11deleted_resources\x18\x06 \x01(\x05R\x10deletedResources\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"8\n" +
+	"\x15GetOrgDeletionRequest\x12\x1f\n" +
+	"\vdeletion_id\x18\x01 \x01(\x03R\n" +
+	"deletionId\"I\n" +
+	"\x16GetOrgDeletionResponse\x12/\n" +
+	"\bdeletion\x18\x01 \x01(\v2\x13.org.v1.OrgDeletionR\bdeletion\"R\n" +
+	"\x1fSetOrgDeletionProtectionRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"\\\n" +
+	" SetOrgDeletionProtectionResponse\x128\n" +
+	"\forganization\x18\x01 \x01(\v2\x14.org.v1.OrganizationR\forganization\"J\n" +
+	"\x17SetOrgRequireMFARequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"T\n" +
+	"\x18SetOrgRequireMFAResponse\x128\n" +
+	"\forganization\x18\x01 \x01(\v2\x14.org.v1.OrganizationR\forganization\"c\n" +
+	"\x13DirectoryGroupRoles\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x01 \x01(\tR\tgroupName\x12-\n" +
+	"\x06scopes\x18\x02 \x03(\v2\x15.token.v1.EntityScopeR\x06scopes\"\x84\x01\n" +
+	"\x1dSetDirectoryGroupRolesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x02 \x01(\tR\tgroupName\x12-\n" +
+	"\x06scopes\x18\x03 \x03(\v2\x15.token.v1.EntityScopeR\x06scopes\" \n" +
+	"\x1eSetDirectoryGroupRolesResponse\"7\n" +
+	"\x1eListDirectoryGroupRolesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\"V\n" +
+	"\x1fListDirectoryGroupRolesResponse\x123\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1b.org.v1.DirectoryGroupRolesR\x06groups*\xbd\x01\n" +
+	"\x11OrgDeletionStatus\x12#\n" +
+	"\x1fORG_DELETION_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bORG_DELETION_STATUS_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bORG_DELETION_STATUS_RUNNING\x10\x02\x12!\n" +
+	"\x1dORG_DELETION_STATUS_COMPLETED\x10\x03\x12\x1e\n" +
+	"\x1aORG_DELETION_STATUS_FAILED\x10\x042\xe7\a\n" +
 	"\n" +
 	"OrgService\x12@\n" +
 	"\tCreateOrg\x12\x18.org.v1.CreateOrgRequest\x1a\x19.org.v1.CreateOrgResponse\x127\n" +
 	"\x06GetOrg\x12\x15.org.v1.GetOrgRequest\x1a\x16.org.v1.GetOrgResponse\x12@\n" +
 	"\tUpdateOrg\x12\x18.org.v1.UpdateOrgRequest\x1a\x19.org.v1.UpdateOrgResponse\x12@\n" +
-	"\tDeleteOrg\x12\x18.org.v1.DeleteOrgRequest\x1a\x19.org.v1.DeleteOrgResponse\x12I\n" +
+	"\tDeleteOrg\x12\x18.org.v1.DeleteOrgRequest\x1a\x19.org.v1.DeleteOrgResponse\x12O\n" +
+	"\x0eGetOrgDeletion\x12\x1d.org.v1.GetOrgDeletionRequest\x1a\x1e.org.v1.GetOrgDeletionResponse\x12m\n" +
+	"\x18SetOrgDeletionProtection\x12'.org.v1.SetOrgDeletionProtectionRequest\x1a(.org.v1.SetOrgDeletionProtectionResponse\x12U\n" +
+	"\x10SetOrgRequireMFA\x12\x1f.org.v1.SetOrgRequireMFARequest\x1a .org.v1.SetOrgRequireMFAResponse\x12I\n" +
 	"\fListUserOrgs\x12\x1b.org.v1.ListUserOrgsRequest\x1a\x1c.org.v1.ListUserOrgsResponse\x12I\n" +
 	"\fListOrgUsers\x12\x1b.org.v1.ListOrgUsersRequest\x1a\x1c.org.v1.ListOrgUsersResponse\x12X\n" +
-	"\x11ListOrgWorkspaces\x12 .org.v1.ListOrgWorkspacesRequest\x1a!.org.v1.ListOrgWorkspacesResponseB5Z3github.com/team-loco/loco/shared/proto/org/v1;orgv1b\x06proto3"
+	"\x11ListOrgWorkspaces\x12 .org.v1.ListOrgWorkspacesRequest\x1a!.org.v1.ListOrgWorkspacesResponse\x12g\n" +
+	"\x16SetDirectoryGroupRoles\x12%.org.v1.SetDirectoryGroupRolesRequest\x1a&.org.v1.SetDirectoryGroupRolesResponse\x12j\n" +
+	"\x17ListDirectoryGroupRoles\x12&.org.v1.ListDirectoryGroupRolesRequest\x1a'.org.v1.ListDirectoryGroupRolesResponseB5Z3github.com/team-loco/loco/shared/proto/org/v1;orgv1b\x06proto3"
 
 var (
 	file_org_v1_org_proto_rawDescOnce sync.Once
@@ -1083,56 +2145,98 @@ func file_org_v1_org_proto_rawDescGZIP() []byte {
 	return file_org_v1_org_proto_rawDescData
 }
 
-var file_org_v1_org_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_org_v1_org_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_org_v1_org_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_org_v1_org_proto_goTypes = []any{
-	(*Organization)(nil),              // 0: org.v1.Organization
-	(*WorkspaceSummary)(nil),          // 1: org.v1.WorkspaceSummary
-	(*CreateOrgRequest)(nil),          // 2: org.v1.CreateOrgRequest
-	(*CreateOrgResponse)(nil),         // 3: org.v1.CreateOrgResponse
-	(*GetOrgRequest)(nil),             // 4: org.v1.GetOrgRequest
-	(*GetOrgResponse)(nil),            // 5: org.v1.GetOrgResponse
-	(*ListUserOrgsRequest)(nil),       // 6: org.v1.ListUserOrgsRequest
-	(*ListUserOrgsResponse)(nil),      // 7: org.v1.ListUserOrgsResponse
-	(*ListOrgUsersRequest)(nil),       // 8: org.v1.ListOrgUsersRequest
-	(*ListOrgUsersResponse)(nil),      // 9: org.v1.ListOrgUsersResponse
-	(*User)(nil),                      // 10: org.v1.User
-	(*ListOrgWorkspacesRequest)(nil),  // 11: org.v1.ListOrgWorkspacesRequest
-	(*ListOrgWorkspacesResponse)(nil), // 12: org.v1.ListOrgWorkspacesResponse
-	(*UpdateOrgRequest)(nil),          // 13: org.v1.UpdateOrgRequest
-	(*UpdateOrgResponse)(nil),         // 14: org.v1.UpdateOrgResponse
-	(*DeleteOrgRequest)(nil),          // 15: org.v1.DeleteOrgRequest
-	(*DeleteOrgResponse)(nil),         // 16: org.v1.DeleteOrgResponse
-	(*timestamppb.Timestamp)(nil),     // 17: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),     // 18: google.protobuf.FieldMask
+	(OrgDeletionStatus)(0),                   // 0: org.v1.OrgDeletionStatus
+	(*Organization)(nil),                     // 1: org.v1.Organization
+	(*WorkspaceSummary)(nil),                 // 2: org.v1.WorkspaceSummary
+	(*CreateOrgRequest)(nil),                 // 3: org.v1.CreateOrgRequest
+	(*CreateOrgResponse)(nil),                // 4: org.v1.CreateOrgResponse
+	(*GetOrgRequest)(nil),                    // 5: org.v1.GetOrgRequest
+	(*GetOrgResponse)(nil),                   // 6: org.v1.GetOrgResponse
+	(*ListUserOrgsRequest)(nil),              // 7: org.v1.ListUserOrgsRequest
+	(*ListUserOrgsResponse)(nil),             // 8: org.v1.ListUserOrgsResponse
+	(*ListOrgUsersRequest)(nil),              // 9: org.v1.ListOrgUsersRequest
+	(*ListOrgUsersResponse)(nil),             // 10: org.v1.ListOrgUsersResponse
+	(*User)(nil),                             // 11: org.v1.User
+	(*ListOrgWorkspacesRequest)(nil),         // 12: org.v1.ListOrgWorkspacesRequest
+	(*ListOrgWorkspacesResponse)(nil),        // 13: org.v1.ListOrgWorkspacesResponse
+	(*UpdateOrgRequest)(nil),                 // 14: org.v1.UpdateOrgRequest
+	(*UpdateOrgResponse)(nil),                // 15: org.v1.UpdateOrgResponse
+	(*DeleteOrgRequest)(nil),                 // 16: org.v1.DeleteOrgRequest
+	(*DeleteOrgResponse)(nil),                // 17: org.v1.DeleteOrgResponse
+	(*OrgDeletionReport)(nil),                // 18: org.v1.OrgDeletionReport
+	(*AffectedWorkspace)(nil),                // 19: org.v1.AffectedWorkspace
+	(*AffectedResource)(nil),                 // 20: org.v1.AffectedResource
+	(*OrgDeletion)(nil),                      // 21: org.v1.OrgDeletion
+	(*GetOrgDeletionRequest)(nil),            // 22: org.v1.GetOrgDeletionRequest
+	(*GetOrgDeletionResponse)(nil),           // 23: org.v1.GetOrgDeletionResponse
+	(*SetOrgDeletionProtectionRequest)(nil),  // 24: org.v1.SetOrgDeletionProtectionRequest
+	(*SetOrgDeletionProtectionResponse)(nil), // 25: org.v1.SetOrgDeletionProtectionResponse
+	(*SetOrgRequireMFARequest)(nil),          // 26: org.v1.SetOrgRequireMFARequest
+	(*SetOrgRequireMFAResponse)(nil),         // 27: org.v1.SetOrgRequireMFAResponse
+	(*DirectoryGroupRoles)(nil),              // 28: org.v1.DirectoryGroupRoles
+	(*SetDirectoryGroupRolesRequest)(nil),    // 29: org.v1.SetDirectoryGroupRolesRequest
+	(*SetDirectoryGroupRolesResponse)(nil),   // 30: org.v1.SetDirectoryGroupRolesResponse
+	(*ListDirectoryGroupRolesRequest)(nil),   // 31: org.v1.ListDirectoryGroupRolesRequest
+	(*ListDirectoryGroupRolesResponse)(nil),  // 32: org.v1.ListDirectoryGroupRolesResponse
+	(*timestamppb.Timestamp)(nil),            // 33: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),            // 34: google.protobuf.FieldMask
+	(*v1.EntityScope)(nil),                   // 35: token.v1.EntityScope
 }
 var file_org_v1_org_proto_depIdxs = []int32{
-	17, // 0: org.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
-	17, // 1: org.v1.Organization.updated_at:type_name -> google.protobuf.Timestamp
-	17, // 2: org.v1.WorkspaceSummary.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: org.v1.GetOrgResponse.organization:type_name -> org.v1.Organization
-	0,  // 4: org.v1.ListUserOrgsResponse.orgs:type_name -> org.v1.Organization
-	10, // 5: org.v1.ListOrgUsersResponse.users:type_name -> org.v1.User
-	1,  // 6: org.v1.ListOrgWorkspacesResponse.workspaces:type_name -> org.v1.WorkspaceSummary
-	18, // 7: org.v1.UpdateOrgRequest.update_mask:type_name -> google.protobuf.FieldMask
-	2,  // 8: org.v1.OrgService.CreateOrg:input_type -> org.v1.CreateOrgRequest
-	4,  // 9: org.v1.OrgService.GetOrg:input_type -> org.v1.GetOrgRequest
-	13, // 10: org.v1.OrgService.UpdateOrg:input_type -> org.v1.UpdateOrgRequest
-	15, // 11: org.v1.OrgService.DeleteOrg:input_type -> org.v1.DeleteOrgRequest
-	6,  // 12: org.v1.OrgService.ListUserOrgs:input_type -> org.v1.ListUserOrgsRequest
-	8,  // 13: org.v1.OrgService.ListOrgUsers:input_type -> org.v1.ListOrgUsersRequest
-	11, // 14: org.v1.OrgService.ListOrgWorkspaces:input_type -> org.v1.ListOrgWorkspacesRequest
-	3,  // 15: org.v1.OrgService.CreateOrg:output_type -> org.v1.CreateOrgResponse
-	5,  // 16: org.v1.OrgService.GetOrg:output_type -> org.v1.GetOrgResponse
-	14, // 17: org.v1.OrgService.UpdateOrg:output_type -> org.v1.UpdateOrgResponse
-	16, // 18: org.v1.OrgService.DeleteOrg:output_type -> org.v1.DeleteOrgResponse
-	7,  // 19: org.v1.OrgService.ListUserOrgs:output_type -> org.v1.ListUserOrgsResponse
-	9,  // 20: org.v1.OrgService.ListOrgUsers:output_type -> org.v1.ListOrgUsersResponse
-	12, // 21: org.v1.OrgService.ListOrgWorkspaces:output_type -> org.v1.ListOrgWorkspacesResponse
-	15, // [15:22] is the sub-list for method output_type
-	8,  // [8:15] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	33, // 0: org.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
+	33, // 1: org.v1.Organization.updated_at:type_name -> google.protobuf.Timestamp
+	33, // 2: org.v1.WorkspaceSummary.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: org.v1.GetOrgResponse.organization:type_name -> org.v1.Organization
+	1,  // 4: org.v1.ListUserOrgsResponse.orgs:type_name -> org.v1.Organization
+	11, // 5: org.v1.ListOrgUsersResponse.users:type_name -> org.v1.User
+	2,  // 6: org.v1.ListOrgWorkspacesResponse.workspaces:type_name -> org.v1.WorkspaceSummary
+	34, // 7: org.v1.UpdateOrgRequest.update_mask:type_name -> google.protobuf.FieldMask
+	18, // 8: org.v1.DeleteOrgResponse.report:type_name -> org.v1.OrgDeletionReport
+	21, // 9: org.v1.DeleteOrgResponse.deletion:type_name -> org.v1.OrgDeletion
+	19, // 10: org.v1.OrgDeletionReport.workspaces:type_name -> org.v1.AffectedWorkspace
+	20, // 11: org.v1.AffectedWorkspace.resources:type_name -> org.v1.AffectedResource
+	0,  // 12: org.v1.OrgDeletion.status:type_name -> org.v1.OrgDeletionStatus
+	33, // 13: org.v1.OrgDeletion.created_at:type_name -> google.protobuf.Timestamp
+	33, // 14: org.v1.OrgDeletion.updated_at:type_name -> google.protobuf.Timestamp
+	33, // 15: org.v1.OrgDeletion.completed_at:type_name -> google.protobuf.Timestamp
+	21, // 16: org.v1.GetOrgDeletionResponse.deletion:type_name -> org.v1.OrgDeletion
+	1,  // 17: org.v1.SetOrgDeletionProtectionResponse.organization:type_name -> org.v1.Organization
+	1,  // 18: org.v1.SetOrgRequireMFAResponse.organization:type_name -> org.v1.Organization
+	35, // 19: org.v1.DirectoryGroupRoles.scopes:type_name -> token.v1.EntityScope
+	35, // 20: org.v1.SetDirectoryGroupRolesRequest.scopes:type_name -> token.v1.EntityScope
+	28, // 21: org.v1.ListDirectoryGroupRolesResponse.groups:type_name -> org.v1.DirectoryGroupRoles
+	3,  // 22: org.v1.OrgService.CreateOrg:input_type -> org.v1.CreateOrgRequest
+	5,  // 23: org.v1.OrgService.GetOrg:input_type -> org.v1.GetOrgRequest
+	14, // 24: org.v1.OrgService.UpdateOrg:input_type -> org.v1.UpdateOrgRequest
+	16, // 25: org.v1.OrgService.DeleteOrg:input_type -> org.v1.DeleteOrgRequest
+	22, // 26: org.v1.OrgService.GetOrgDeletion:input_type -> org.v1.GetOrgDeletionRequest
+	24, // 27: org.v1.OrgService.SetOrgDeletionProtection:input_type -> org.v1.SetOrgDeletionProtectionRequest
+	26, // 28: org.v1.OrgService.SetOrgRequireMFA:input_type -> org.v1.SetOrgRequireMFARequest
+	7,  // 29: org.v1.OrgService.ListUserOrgs:input_type -> org.v1.ListUserOrgsRequest
+	9,  // 30: org.v1.OrgService.ListOrgUsers:input_type -> org.v1.ListOrgUsersRequest
+	12, // 31: org.v1.OrgService.ListOrgWorkspaces:input_type -> org.v1.ListOrgWorkspacesRequest
+	29, // 32: org.v1.OrgService.SetDirectoryGroupRoles:input_type -> org.v1.SetDirectoryGroupRolesRequest
+	31, // 33: org.v1.OrgService.ListDirectoryGroupRoles:input_type -> org.v1.ListDirectoryGroupRolesRequest
+	4,  // 34: org.v1.OrgService.CreateOrg:output_type -> org.v1.CreateOrgResponse
+	6,  // 35: org.v1.OrgService.GetOrg:output_type -> org.v1.GetOrgResponse
+	15, // 36: org.v1.OrgService.UpdateOrg:output_type -> org.v1.UpdateOrgResponse
+	17, // 37: org.v1.OrgService.DeleteOrg:output_type -> org.v1.DeleteOrgResponse
+	23, // 38: org.v1.OrgService.GetOrgDeletion:output_type -> org.v1.GetOrgDeletionResponse
+	25, // 39: org.v1.OrgService.SetOrgDeletionProtection:output_type -> org.v1.SetOrgDeletionProtectionResponse
+	27, // 40: org.v1.OrgService.SetOrgRequireMFA:output_type -> org.v1.SetOrgRequireMFAResponse
+	8,  // 41: org.v1.OrgService.ListUserOrgs:output_type -> org.v1.ListUserOrgsResponse
+	10, // 42: org.v1.OrgService.ListOrgUsers:output_type -> org.v1.ListOrgUsersResponse
+	13, // 43: org.v1.OrgService.ListOrgWorkspaces:output_type -> org.v1.ListOrgWorkspacesResponse
+	30, // 44: org.v1.OrgService.SetDirectoryGroupRoles:output_type -> org.v1.SetDirectoryGroupRolesResponse
+	32, // 45: org.v1.OrgService.ListDirectoryGroupRoles:output_type -> org.v1.ListDirectoryGroupRolesResponse
+	34, // [34:46] is the sub-list for method output_type
+	22, // [22:34] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_org_v1_org_proto_init() }
@@ -1146,18 +2250,20 @@ func file_org_v1_org_proto_init() {
 		(*GetOrgRequest_OrgName)(nil),
 	}
 	file_org_v1_org_proto_msgTypes[13].OneofWrappers = []any{}
+	file_org_v1_org_proto_msgTypes[16].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_org_v1_org_proto_rawDesc), len(file_org_v1_org_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   17,
+			NumEnums:      1,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_org_v1_org_proto_goTypes,
 		DependencyIndexes: file_org_v1_org_proto_depIdxs,
+		EnumInfos:         file_org_v1_org_proto_enumTypes,
 		MessageInfos:      file_org_v1_org_proto_msgTypes,
 	}.Build()
 	File_org_v1_org_proto = out.File
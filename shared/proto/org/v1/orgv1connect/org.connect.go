@@ -41,6 +41,15 @@ const (
 	OrgServiceUpdateOrgProcedure = "/org.v1.OrgService/UpdateOrg"
 	// OrgServiceDeleteOrgProcedure is the fully-qualified name of the OrgService's DeleteOrg RPC.
 	OrgServiceDeleteOrgProcedure = "/org.v1.OrgService/DeleteOrg"
+	// OrgServiceGetOrgDeletionProcedure is the fully-qualified name of the OrgService's GetOrgDeletion
+	// RPC.
+	OrgServiceGetOrgDeletionProcedure = "/org.v1.OrgService/GetOrgDeletion"
+	// OrgServiceSetOrgDeletionProtectionProcedure is the fully-qualified name of the OrgService's
+	// SetOrgDeletionProtection RPC.
+	OrgServiceSetOrgDeletionProtectionProcedure = "/org.v1.OrgService/SetOrgDeletionProtection"
+	// OrgServiceSetOrgRequireMFAProcedure is the fully-qualified name of the OrgService's
+	// SetOrgRequireMFA RPC.
+	OrgServiceSetOrgRequireMFAProcedure = "/org.v1.OrgService/SetOrgRequireMFA"
 	// OrgServiceListUserOrgsProcedure is the fully-qualified name of the OrgService's ListUserOrgs RPC.
 	OrgServiceListUserOrgsProcedure = "/org.v1.OrgService/ListUserOrgs"
 	// OrgServiceListOrgUsersProcedure is the fully-qualified name of the OrgService's ListOrgUsers RPC.
@@ -48,6 +57,12 @@ const (
 	// OrgServiceListOrgWorkspacesProcedure is the fully-qualified name of the OrgService's
 	// ListOrgWorkspaces RPC.
 	OrgServiceListOrgWorkspacesProcedure = "/org.v1.OrgService/ListOrgWorkspaces"
+	// OrgServiceSetDirectoryGroupRolesProcedure is the fully-qualified name of the OrgService's
+	// SetDirectoryGroupRoles RPC.
+	OrgServiceSetDirectoryGroupRolesProcedure = "/org.v1.OrgService/SetDirectoryGroupRoles"
+	// OrgServiceListDirectoryGroupRolesProcedure is the fully-qualified name of the OrgService's
+	// ListDirectoryGroupRoles RPC.
+	OrgServiceListDirectoryGroupRolesProcedure = "/org.v1.OrgService/ListDirectoryGroupRoles"
 )
 
 // OrgServiceClient is a client for the org.v1.OrgService service.
@@ -58,14 +73,28 @@ type OrgServiceClient interface {
 	GetOrg(context.Context, *connect.Request[v1.GetOrgRequest]) (*connect.Response[v1.GetOrgResponse], error)
 	// UpdateOrg updates organization information.
 	UpdateOrg(context.Context, *connect.Request[v1.UpdateOrgRequest]) (*connect.Response[v1.UpdateOrgResponse], error)
-	// DeleteOrg deletes an organization.
+	// DeleteOrg deletes an organization. With cascade set, its workspaces, resources, domains and deployments
+	// are torn down in the background; with dry_run set, nothing is deleted and only the report is returned.
 	DeleteOrg(context.Context, *connect.Request[v1.DeleteOrgRequest]) (*connect.Response[v1.DeleteOrgResponse], error)
+	// GetOrgDeletion reports the progress of a cascading organization deletion.
+	GetOrgDeletion(context.Context, *connect.Request[v1.GetOrgDeletionRequest]) (*connect.Response[v1.GetOrgDeletionResponse], error)
+	// SetOrgDeletionProtection turns deletion protection on or off. While on, deleting the organization or any
+	// workspace or resource in it fails.
+	SetOrgDeletionProtection(context.Context, *connect.Request[v1.SetOrgDeletionProtectionRequest]) (*connect.Response[v1.SetOrgDeletionProtectionResponse], error)
+	// SetOrgRequireMFA turns the MFA requirement on or off. While on, members only get a full login token after
+	// passing a TOTP check, enrolling first if they have not.
+	SetOrgRequireMFA(context.Context, *connect.Request[v1.SetOrgRequireMFARequest]) (*connect.Response[v1.SetOrgRequireMFAResponse], error)
 	// ListUserOrgs lists organizations for a user.
 	ListUserOrgs(context.Context, *connect.Request[v1.ListUserOrgsRequest]) (*connect.Response[v1.ListUserOrgsResponse], error)
 	// ListOrgUsers lists users in an organization.
 	ListOrgUsers(context.Context, *connect.Request[v1.ListOrgUsersRequest]) (*connect.Response[v1.ListOrgUsersResponse], error)
 	// ListOrgWorkspaces lists workspaces in an organization.
 	ListOrgWorkspaces(context.Context, *connect.Request[v1.ListOrgWorkspacesRequest]) (*connect.Response[v1.ListOrgWorkspacesResponse], error)
+	// SetDirectoryGroupRoles maps a group pushed by the org's identity provider over SCIM to the scopes its
+	// members get. Members are resynced right away. Setting no scopes removes the mapping.
+	SetDirectoryGroupRoles(context.Context, *connect.Request[v1.SetDirectoryGroupRolesRequest]) (*connect.Response[v1.SetDirectoryGroupRolesResponse], error)
+	// ListDirectoryGroupRoles lists an organization's directory group mappings.
+	ListDirectoryGroupRoles(context.Context, *connect.Request[v1.ListDirectoryGroupRolesRequest]) (*connect.Response[v1.ListDirectoryGroupRolesResponse], error)
 }
 
 // NewOrgServiceClient constructs a client for the org.v1.OrgService service. By default, it uses
@@ -103,6 +132,24 @@ func NewOrgServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(orgServiceMethods.ByName("DeleteOrg")),
 			connect.WithClientOptions(opts...),
 		),
+		getOrgDeletion: connect.NewClient[v1.GetOrgDeletionRequest, v1.GetOrgDeletionResponse](
+			httpClient,
+			baseURL+OrgServiceGetOrgDeletionProcedure,
+			connect.WithSchema(orgServiceMethods.ByName("GetOrgDeletion")),
+			connect.WithClientOptions(opts...),
+		),
+		setOrgDeletionProtection: connect.NewClient[v1.SetOrgDeletionProtectionRequest, v1.SetOrgDeletionProtectionResponse](
+			httpClient,
+			baseURL+OrgServiceSetOrgDeletionProtectionProcedure,
+			connect.WithSchema(orgServiceMethods.ByName("SetOrgDeletionProtection")),
+			connect.WithClientOptions(opts...),
+		),
+		setOrgRequireMFA: connect.NewClient[v1.SetOrgRequireMFARequest, v1.SetOrgRequireMFAResponse](
+			httpClient,
+			baseURL+OrgServiceSetOrgRequireMFAProcedure,
+			connect.WithSchema(orgServiceMethods.ByName("SetOrgRequireMFA")),
+			connect.WithClientOptions(opts...),
+		),
 		listUserOrgs: connect.NewClient[v1.ListUserOrgsRequest, v1.ListUserOrgsResponse](
 			httpClient,
 			baseURL+OrgServiceListUserOrgsProcedure,
@@ -121,18 +168,35 @@ func NewOrgServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(orgServiceMethods.ByName("ListOrgWorkspaces")),
 			connect.WithClientOptions(opts...),
 		),
+		setDirectoryGroupRoles: connect.NewClient[v1.SetDirectoryGroupRolesRequest, v1.SetDirectoryGroupRolesResponse](
+			httpClient,
+			baseURL+OrgServiceSetDirectoryGroupRolesProcedure,
+			connect.WithSchema(orgServiceMethods.ByName("SetDirectoryGroupRoles")),
+			connect.WithClientOptions(opts...),
+		),
+		listDirectoryGroupRoles: connect.NewClient[v1.ListDirectoryGroupRolesRequest, v1.ListDirectoryGroupRolesResponse](
+			httpClient,
+			baseURL+OrgServiceListDirectoryGroupRolesProcedure,
+			connect.WithSchema(orgServiceMethods.ByName("ListDirectoryGroupRoles")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // orgServiceClient implements OrgServiceClient.
 type orgServiceClient struct {
-	createOrg         *connect.Client[v1.CreateOrgRequest, v1.CreateOrgResponse]
-	getOrg            *connect.Client[v1.GetOrgRequest, v1.GetOrgResponse]
-	updateOrg         *connect.Client[v1.UpdateOrgRequest, v1.UpdateOrgResponse]
-	deleteOrg         *connect.Client[v1.DeleteOrgRequest, v1.DeleteOrgResponse]
-	listUserOrgs      *connect.Client[v1.ListUserOrgsRequest, v1.ListUserOrgsResponse]
-	listOrgUsers      *connect.Client[v1.ListOrgUsersRequest, v1.ListOrgUsersResponse]
-	listOrgWorkspaces *connect.Client[v1.ListOrgWorkspacesRequest, v1.ListOrgWorkspacesResponse]
+	createOrg                *connect.Client[v1.CreateOrgRequest, v1.CreateOrgResponse]
+	getOrg                   *connect.Client[v1.GetOrgRequest, v1.GetOrgResponse]
+	updateOrg                *connect.Client[v1.UpdateOrgRequest, v1.UpdateOrgResponse]
+	deleteOrg                *connect.Client[v1.DeleteOrgRequest, v1.DeleteOrgResponse]
+	getOrgDeletion           *connect.Client[v1.GetOrgDeletionRequest, v1.GetOrgDeletionResponse]
+	setOrgDeletionProtection *connect.Client[v1.SetOrgDeletionProtectionRequest, v1.SetOrgDeletionProtectionResponse]
+	setOrgRequireMFA         *connect.Client[v1.SetOrgRequireMFARequest, v1.SetOrgRequireMFAResponse]
+	listUserOrgs             *connect.Client[v1.ListUserOrgsRequest, v1.ListUserOrgsResponse]
+	listOrgUsers             *connect.Client[v1.ListOrgUsersRequest, v1.ListOrgUsersResponse]
+	listOrgWorkspaces        *connect.Client[v1.ListOrgWorkspacesRequest, v1.ListOrgWorkspacesResponse]
+	setDirectoryGroupRoles   *connect.Client[v1.SetDirectoryGroupRolesRequest, v1.SetDirectoryGroupRolesResponse]
+	listDirectoryGroupRoles  *connect.Client[v1.ListDirectoryGroupRolesRequest, v1.ListDirectoryGroupRolesResponse]
 }
 
 // CreateOrg calls org.v1.OrgService.CreateOrg.
@@ -155,6 +219,21 @@ func (c *orgServiceClient) DeleteOrg(ctx context.Context, req *connect.Request[v
 	return c.deleteOrg.CallUnary(ctx, req)
 }
 
+// GetOrgDeletion calls org.v1.OrgService.GetOrgDeletion.
+func (c *orgServiceClient) GetOrgDeletion(ctx context.Context, req *connect.Request[v1.GetOrgDeletionRequest]) (*connect.Response[v1.GetOrgDeletionResponse], error) {
+	return c.getOrgDeletion.CallUnary(ctx, req)
+}
+
+// SetOrgDeletionProtection calls org.v1.OrgService.SetOrgDeletionProtection.
+func (c *orgServiceClient) SetOrgDeletionProtection(ctx context.Context, req *connect.Request[v1.SetOrgDeletionProtectionRequest]) (*connect.Response[v1.SetOrgDeletionProtectionResponse], error) {
+	return c.setOrgDeletionProtection.CallUnary(ctx, req)
+}
+
+// SetOrgRequireMFA calls org.v1.OrgService.SetOrgRequireMFA.
+func (c *orgServiceClient) SetOrgRequireMFA(ctx context.Context, req *connect.Request[v1.SetOrgRequireMFARequest]) (*connect.Response[v1.SetOrgRequireMFAResponse], error) {
+	return c.setOrgRequireMFA.CallUnary(ctx, req)
+}
+
 // ListUserOrgs calls org.v1.OrgService.ListUserOrgs.
 func (c *orgServiceClient) ListUserOrgs(ctx context.Context, req *connect.Request[v1.ListUserOrgsRequest]) (*connect.Response[v1.ListUserOrgsResponse], error) {
 	return c.listUserOrgs.CallUnary(ctx, req)
@@ -170,6 +249,16 @@ func (c *orgServiceClient) ListOrgWorkspaces(ctx context.Context, req *connect.R
 	return c.listOrgWorkspaces.CallUnary(ctx, req)
 }
 
+// SetDirectoryGroupRoles calls org.v1.OrgService.SetDirectoryGroupRoles.
+func (c *orgServiceClient) SetDirectoryGroupRoles(ctx context.Context, req *connect.Request[v1.SetDirectoryGroupRolesRequest]) (*connect.Response[v1.SetDirectoryGroupRolesResponse], error) {
+	return c.setDirectoryGroupRoles.CallUnary(ctx, req)
+}
+
+// ListDirectoryGroupRoles calls org.v1.OrgService.ListDirectoryGroupRoles.
+func (c *orgServiceClient) ListDirectoryGroupRoles(ctx context.Context, req *connect.Request[v1.ListDirectoryGroupRolesRequest]) (*connect.Response[v1.ListDirectoryGroupRolesResponse], error) {
+	return c.listDirectoryGroupRoles.CallUnary(ctx, req)
+}
+
 // OrgServiceHandler is an implementation of the org.v1.OrgService service.
 type OrgServiceHandler interface {
 	// CreateOrg creates a new organization.
@@ -178,14 +267,28 @@ type OrgServiceHandler interface {
 	GetOrg(context.Context, *connect.Request[v1.GetOrgRequest]) (*connect.Response[v1.GetOrgResponse], error)
 	// UpdateOrg updates organization information.
 	UpdateOrg(context.Context, *connect.Request[v1.UpdateOrgRequest]) (*connect.Response[v1.UpdateOrgResponse], error)
-	// DeleteOrg deletes an organization.
+	// DeleteOrg deletes an organization. With cascade set, its workspaces, resources, domains and deployments
+	// are torn down in the background; with dry_run set, nothing is deleted and only the report is returned.
 	DeleteOrg(context.Context, *connect.Request[v1.DeleteOrgRequest]) (*connect.Response[v1.DeleteOrgResponse], error)
+	// GetOrgDeletion reports the progress of a cascading organization deletion.
+	GetOrgDeletion(context.Context, *connect.Request[v1.GetOrgDeletionRequest]) (*connect.Response[v1.GetOrgDeletionResponse], error)
+	// SetOrgDeletionProtection turns deletion protection on or off. While on, deleting the organization or any
+	// workspace or resource in it fails.
+	SetOrgDeletionProtection(context.Context, *connect.Request[v1.SetOrgDeletionProtectionRequest]) (*connect.Response[v1.SetOrgDeletionProtectionResponse], error)
+	// SetOrgRequireMFA turns the MFA requirement on or off. While on, members only get a full login token after
+	// passing a TOTP check, enrolling first if they have not.
+	SetOrgRequireMFA(context.Context, *connect.Request[v1.SetOrgRequireMFARequest]) (*connect.Response[v1.SetOrgRequireMFAResponse], error)
 	// ListUserOrgs lists organizations for a user.
 	ListUserOrgs(context.Context, *connect.Request[v1.ListUserOrgsRequest]) (*connect.Response[v1.ListUserOrgsResponse], error)
 	// ListOrgUsers lists users in an organization.
 	ListOrgUsers(context.Context, *connect.Request[v1.ListOrgUsersRequest]) (*connect.Response[v1.ListOrgUsersResponse], error)
 	// ListOrgWorkspaces lists workspaces in an organization.
 	ListOrgWorkspaces(context.Context, *connect.Request[v1.ListOrgWorkspacesRequest]) (*connect.Response[v1.ListOrgWorkspacesResponse], error)
+	// SetDirectoryGroupRoles maps a group pushed by the org's identity provider over SCIM to the scopes its
+	// members get. Members are resynced right away. Setting no scopes removes the mapping.
+	SetDirectoryGroupRoles(context.Context, *connect.Request[v1.SetDirectoryGroupRolesRequest]) (*connect.Response[v1.SetDirectoryGroupRolesResponse], error)
+	// ListDirectoryGroupRoles lists an organization's directory group mappings.
+	ListDirectoryGroupRoles(context.Context, *connect.Request[v1.ListDirectoryGroupRolesRequest]) (*connect.Response[v1.ListDirectoryGroupRolesResponse], error)
 }
 
 // NewOrgServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -219,6 +322,24 @@ func NewOrgServiceHandler(svc OrgServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(orgServiceMethods.ByName("DeleteOrg")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orgServiceGetOrgDeletionHandler := connect.NewUnaryHandler(
+		OrgServiceGetOrgDeletionProcedure,
+		svc.GetOrgDeletion,
+		connect.WithSchema(orgServiceMethods.ByName("GetOrgDeletion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orgServiceSetOrgDeletionProtectionHandler := connect.NewUnaryHandler(
+		OrgServiceSetOrgDeletionProtectionProcedure,
+		svc.SetOrgDeletionProtection,
+		connect.WithSchema(orgServiceMethods.ByName("SetOrgDeletionProtection")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orgServiceSetOrgRequireMFAHandler := connect.NewUnaryHandler(
+		OrgServiceSetOrgRequireMFAProcedure,
+		svc.SetOrgRequireMFA,
+		connect.WithSchema(orgServiceMethods.ByName("SetOrgRequireMFA")),
+		connect.WithHandlerOptions(opts...),
+	)
 	orgServiceListUserOrgsHandler := connect.NewUnaryHandler(
 		OrgServiceListUserOrgsProcedure,
 		svc.ListUserOrgs,
@@ -237,6 +358,18 @@ func NewOrgServiceHandler(svc OrgServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(orgServiceMethods.ByName("ListOrgWorkspaces")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orgServiceSetDirectoryGroupRolesHandler := connect.NewUnaryHandler(
+		OrgServiceSetDirectoryGroupRolesProcedure,
+		svc.SetDirectoryGroupRoles,
+		connect.WithSchema(orgServiceMethods.ByName("SetDirectoryGroupRoles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orgServiceListDirectoryGroupRolesHandler := connect.NewUnaryHandler(
+		OrgServiceListDirectoryGroupRolesProcedure,
+		svc.ListDirectoryGroupRoles,
+		connect.WithSchema(orgServiceMethods.ByName("ListDirectoryGroupRoles")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/org.v1.OrgService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case OrgServiceCreateOrgProcedure:
@@ -247,12 +380,22 @@ func NewOrgServiceHandler(svc OrgServiceHandler, opts ...connect.HandlerOption)
 			orgServiceUpdateOrgHandler.ServeHTTP(w, r)
 		case OrgServiceDeleteOrgProcedure:
 			orgServiceDeleteOrgHandler.ServeHTTP(w, r)
+		case OrgServiceGetOrgDeletionProcedure:
+			orgServiceGetOrgDeletionHandler.ServeHTTP(w, r)
+		case OrgServiceSetOrgDeletionProtectionProcedure:
+			orgServiceSetOrgDeletionProtectionHandler.ServeHTTP(w, r)
+		case OrgServiceSetOrgRequireMFAProcedure:
+			orgServiceSetOrgRequireMFAHandler.ServeHTTP(w, r)
 		case OrgServiceListUserOrgsProcedure:
 			orgServiceListUserOrgsHandler.ServeHTTP(w, r)
 		case OrgServiceListOrgUsersProcedure:
 			orgServiceListOrgUsersHandler.ServeHTTP(w, r)
 		case OrgServiceListOrgWorkspacesProcedure:
 			orgServiceListOrgWorkspacesHandler.ServeHTTP(w, r)
+		case OrgServiceSetDirectoryGroupRolesProcedure:
+			orgServiceSetDirectoryGroupRolesHandler.ServeHTTP(w, r)
+		case OrgServiceListDirectoryGroupRolesProcedure:
+			orgServiceListDirectoryGroupRolesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -278,6 +421,18 @@ func (UnimplementedOrgServiceHandler) DeleteOrg(context.Context, *connect.Reques
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("org.v1.OrgService.DeleteOrg is not implemented"))
 }
 
+func (UnimplementedOrgServiceHandler) GetOrgDeletion(context.Context, *connect.Request[v1.GetOrgDeletionRequest]) (*connect.Response[v1.GetOrgDeletionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("org.v1.OrgService.GetOrgDeletion is not implemented"))
+}
+
+func (UnimplementedOrgServiceHandler) SetOrgDeletionProtection(context.Context, *connect.Request[v1.SetOrgDeletionProtectionRequest]) (*connect.Response[v1.SetOrgDeletionProtectionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("org.v1.OrgService.SetOrgDeletionProtection is not implemented"))
+}
+
+func (UnimplementedOrgServiceHandler) SetOrgRequireMFA(context.Context, *connect.Request[v1.SetOrgRequireMFARequest]) (*connect.Response[v1.SetOrgRequireMFAResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("org.v1.OrgService.SetOrgRequireMFA is not implemented"))
+}
+
 func (UnimplementedOrgServiceHandler) ListUserOrgs(context.Context, *connect.Request[v1.ListUserOrgsRequest]) (*connect.Response[v1.ListUserOrgsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("org.v1.OrgService.ListUserOrgs is not implemented"))
 }
@@ -289,3 +444,11 @@ func (UnimplementedOrgServiceHandler) ListOrgUsers(context.Context, *connect.Req
 func (UnimplementedOrgServiceHandler) ListOrgWorkspaces(context.Context, *connect.Request[v1.ListOrgWorkspacesRequest]) (*connect.Response[v1.ListOrgWorkspacesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("org.v1.OrgService.ListOrgWorkspaces is not implemented"))
 }
+
+func (UnimplementedOrgServiceHandler) SetDirectoryGroupRoles(context.Context, *connect.Request[v1.SetDirectoryGroupRolesRequest]) (*connect.Response[v1.SetDirectoryGroupRolesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("org.v1.OrgService.SetDirectoryGroupRoles is not implemented"))
+}
+
+func (UnimplementedOrgServiceHandler) ListDirectoryGroupRoles(context.Context, *connect.Request[v1.ListDirectoryGroupRolesRequest]) (*connect.Response[v1.ListDirectoryGroupRolesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("org.v1.OrgService.ListDirectoryGroupRoles is not implemented"))
+}
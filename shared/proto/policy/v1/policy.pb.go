@@ -0,0 +1,1293 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: policy/v1/policy.proto
+
+package policyv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RequiredLabels requires resources to carry labels with these keys.
+type RequiredLabels struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequiredLabels) Reset() {
+	*x = RequiredLabels{}
+	mi := &file_policy_v1_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequiredLabels) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequiredLabels) ProtoMessage() {}
+
+func (x *RequiredLabels) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequiredLabels.ProtoReflect.Descriptor instead.
+func (*RequiredLabels) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RequiredLabels) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// RegistryList lists registries or repository prefixes, e.g. "ghcr.io" or "ghcr.io/acme". Docker
+// Hub images are matched as docker.io/library/<name> and docker.io/<user>/<name>.
+type RegistryList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Registries    []string               `protobuf:"bytes,1,rep,name=registries,proto3" json:"registries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegistryList) Reset() {
+	*x = RegistryList{}
+	mi := &file_policy_v1_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistryList) ProtoMessage() {}
+
+func (x *RegistryList) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistryList.ProtoReflect.Descriptor instead.
+func (*RegistryList) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegistryList) GetRegistries() []string {
+	if x != nil {
+		return x.Registries
+	}
+	return nil
+}
+
+// MaxReplicas caps the replicas a service may run in a region, including what autoscaling and
+// cron schedules may scale it to.
+type MaxReplicas struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Max           int32                  `protobuf:"varint,1,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaxReplicas) Reset() {
+	*x = MaxReplicas{}
+	mi := &file_policy_v1_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaxReplicas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaxReplicas) ProtoMessage() {}
+
+func (x *MaxReplicas) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaxReplicas.ProtoReflect.Descriptor instead.
+func (*MaxReplicas) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MaxReplicas) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// RequireHealthCheck requires deployments to have a health check with a path.
+type RequireHealthCheck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequireHealthCheck) Reset() {
+	*x = RequireHealthCheck{}
+	mi := &file_policy_v1_policy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequireHealthCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequireHealthCheck) ProtoMessage() {}
+
+func (x *RequireHealthCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequireHealthCheck.ProtoReflect.Descriptor instead.
+func (*RequireHealthCheck) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{3}
+}
+
+// SignerIdentity is a keyless (Fulcio) signer: the OIDC issuer that vouched for the signer and the
+// signer's email or URI, e.g. a CI workflow.
+type SignerIdentity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issuer        string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"` // e.g. https://token.actions.githubusercontent.com
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	SubjectRegexp bool                   `protobuf:"varint,3,opt,name=subject_regexp,json=subjectRegexp,proto3" json:"subject_regexp,omitempty"` // subject is a regular expression matched against the whole subject
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignerIdentity) Reset() {
+	*x = SignerIdentity{}
+	mi := &file_policy_v1_policy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignerIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignerIdentity) ProtoMessage() {}
+
+func (x *SignerIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignerIdentity.ProtoReflect.Descriptor instead.
+func (*SignerIdentity) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SignerIdentity) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *SignerIdentity) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SignerIdentity) GetSubjectRegexp() bool {
+	if x != nil {
+		return x.SubjectRegexp
+	}
+	return false
+}
+
+// RequireSignature requires deployed images to carry a cosign signature made with one of the keys
+// or by one of the identities.
+type RequireSignature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublicKeys    []string               `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"` // PEM-encoded
+	Identities    []*SignerIdentity      `protobuf:"bytes,2,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequireSignature) Reset() {
+	*x = RequireSignature{}
+	mi := &file_policy_v1_policy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequireSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequireSignature) ProtoMessage() {}
+
+func (x *RequireSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequireSignature.ProtoReflect.Descriptor instead.
+func (*RequireSignature) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RequireSignature) GetPublicKeys() []string {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+func (x *RequireSignature) GetIdentities() []*SignerIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+// PolicyRule is what a policy requires.
+type PolicyRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Rule:
+	//
+	//	*PolicyRule_RequiredLabels
+	//	*PolicyRule_AllowedRegistries
+	//	*PolicyRule_ForbiddenRegistries
+	//	*PolicyRule_MaxReplicas
+	//	*PolicyRule_RequireHealthCheck
+	//	*PolicyRule_RequireSignature
+	Rule          isPolicyRule_Rule `protobuf_oneof:"rule"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyRule) Reset() {
+	*x = PolicyRule{}
+	mi := &file_policy_v1_policy_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyRule) ProtoMessage() {}
+
+func (x *PolicyRule) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyRule.ProtoReflect.Descriptor instead.
+func (*PolicyRule) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PolicyRule) GetRule() isPolicyRule_Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *PolicyRule) GetRequiredLabels() *RequiredLabels {
+	if x != nil {
+		if x, ok := x.Rule.(*PolicyRule_RequiredLabels); ok {
+			return x.RequiredLabels
+		}
+	}
+	return nil
+}
+
+func (x *PolicyRule) GetAllowedRegistries() *RegistryList {
+	if x != nil {
+		if x, ok := x.Rule.(*PolicyRule_AllowedRegistries); ok {
+			return x.AllowedRegistries
+		}
+	}
+	return nil
+}
+
+func (x *PolicyRule) GetForbiddenRegistries() *RegistryList {
+	if x != nil {
+		if x, ok := x.Rule.(*PolicyRule_ForbiddenRegistries); ok {
+			return x.ForbiddenRegistries
+		}
+	}
+	return nil
+}
+
+func (x *PolicyRule) GetMaxReplicas() *MaxReplicas {
+	if x != nil {
+		if x, ok := x.Rule.(*PolicyRule_MaxReplicas); ok {
+			return x.MaxReplicas
+		}
+	}
+	return nil
+}
+
+func (x *PolicyRule) GetRequireHealthCheck() *RequireHealthCheck {
+	if x != nil {
+		if x, ok := x.Rule.(*PolicyRule_RequireHealthCheck); ok {
+			return x.RequireHealthCheck
+		}
+	}
+	return nil
+}
+
+func (x *PolicyRule) GetRequireSignature() *RequireSignature {
+	if x != nil {
+		if x, ok := x.Rule.(*PolicyRule_RequireSignature); ok {
+			return x.RequireSignature
+		}
+	}
+	return nil
+}
+
+type isPolicyRule_Rule interface {
+	isPolicyRule_Rule()
+}
+
+type PolicyRule_RequiredLabels struct {
+	RequiredLabels *RequiredLabels `protobuf:"bytes,1,opt,name=required_labels,json=requiredLabels,proto3,oneof"` // checked on resources and deployments
+}
+
+type PolicyRule_AllowedRegistries struct {
+	AllowedRegistries *RegistryList `protobuf:"bytes,2,opt,name=allowed_registries,json=allowedRegistries,proto3,oneof"` // deployed images must come from one of these
+}
+
+type PolicyRule_ForbiddenRegistries struct {
+	ForbiddenRegistries *RegistryList `protobuf:"bytes,3,opt,name=forbidden_registries,json=forbiddenRegistries,proto3,oneof"` // deployed images must not come from any of these
+}
+
+type PolicyRule_MaxReplicas struct {
+	MaxReplicas *MaxReplicas `protobuf:"bytes,4,opt,name=max_replicas,json=maxReplicas,proto3,oneof"` // checked on resources and deployments
+}
+
+type PolicyRule_RequireHealthCheck struct {
+	RequireHealthCheck *RequireHealthCheck `protobuf:"bytes,5,opt,name=require_health_check,json=requireHealthCheck,proto3,oneof"` // checked on deployments
+}
+
+type PolicyRule_RequireSignature struct {
+	RequireSignature *RequireSignature `protobuf:"bytes,6,opt,name=require_signature,json=requireSignature,proto3,oneof"` // checked on deployments, and again by the cluster before rolling out
+}
+
+func (*PolicyRule_RequiredLabels) isPolicyRule_Rule() {}
+
+func (*PolicyRule_AllowedRegistries) isPolicyRule_Rule() {}
+
+func (*PolicyRule_ForbiddenRegistries) isPolicyRule_Rule() {}
+
+func (*PolicyRule_MaxReplicas) isPolicyRule_Rule() {}
+
+func (*PolicyRule_RequireHealthCheck) isPolicyRule_Rule() {}
+
+func (*PolicyRule_RequireSignature) isPolicyRule_Rule() {}
+
+// Policy is a rule resources and deployments of an org must follow. The enabled policies are
+// checked when a resource is created and when it is deployed; a request that breaks any fails
+// with FAILED_PRECONDITION and a PolicyViolations error detail.
+type Policy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId         int64                  `protobuf:"varint,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	WorkspaceId   *int64                 `protobuf:"varint,3,opt,name=workspace_id,json=workspaceId,proto3,oneof" json:"workspace_id,omitempty"` // unset for every workspace of the org
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Rule          *PolicyRule            `protobuf:"bytes,6,opt,name=rule,proto3" json:"rule,omitempty"`
+	Enabled       bool                   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Policy) Reset() {
+	*x = Policy{}
+	mi := &file_policy_v1_policy_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Policy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Policy) ProtoMessage() {}
+
+func (x *Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Policy.ProtoReflect.Descriptor instead.
+func (*Policy) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Policy) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Policy) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *Policy) GetWorkspaceId() int64 {
+	if x != nil && x.WorkspaceId != nil {
+		return *x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *Policy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Policy) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Policy) GetRule() *PolicyRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *Policy) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Policy) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Policy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// PolicyViolation is one way a resource or deployment breaks a policy.
+type PolicyViolation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	PolicyName    string                 `protobuf:"bytes,2,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	Field         string                 `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"` // what breaks the policy, e.g. labels.team or build.image
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyViolation) Reset() {
+	*x = PolicyViolation{}
+	mi := &file_policy_v1_policy_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyViolation) ProtoMessage() {}
+
+func (x *PolicyViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyViolation.ProtoReflect.Descriptor instead.
+func (*PolicyViolation) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PolicyViolation) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *PolicyViolation) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *PolicyViolation) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *PolicyViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// PolicyViolations is the error detail of a request refused by policies.
+type PolicyViolations struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Violations    []*PolicyViolation     `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyViolations) Reset() {
+	*x = PolicyViolations{}
+	mi := &file_policy_v1_policy_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyViolations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyViolations) ProtoMessage() {}
+
+func (x *PolicyViolations) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyViolations.ProtoReflect.Descriptor instead.
+func (*PolicyViolations) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PolicyViolations) GetViolations() []*PolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// CreatePolicyRequest is the request to create a policy.
+type CreatePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	WorkspaceId   *int64                 `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3,oneof" json:"workspace_id,omitempty"` // must belong to org_id; unset for every workspace of the org
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Rule          *PolicyRule            `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePolicyRequest) Reset() {
+	*x = CreatePolicyRequest{}
+	mi := &file_policy_v1_policy_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePolicyRequest) ProtoMessage() {}
+
+func (x *CreatePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePolicyRequest.ProtoReflect.Descriptor instead.
+func (*CreatePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreatePolicyRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *CreatePolicyRequest) GetWorkspaceId() int64 {
+	if x != nil && x.WorkspaceId != nil {
+		return *x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *CreatePolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePolicyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePolicyRequest) GetRule() *PolicyRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// CreatePolicyResponse contains the created policy.
+type CreatePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *Policy                `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePolicyResponse) Reset() {
+	*x = CreatePolicyResponse{}
+	mi := &file_policy_v1_policy_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePolicyResponse) ProtoMessage() {}
+
+func (x *CreatePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePolicyResponse.ProtoReflect.Descriptor instead.
+func (*CreatePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreatePolicyResponse) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// ListPoliciesRequest is the request to list an org's policies.
+type ListPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoliciesRequest) Reset() {
+	*x = ListPoliciesRequest{}
+	mi := &file_policy_v1_policy_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoliciesRequest) ProtoMessage() {}
+
+func (x *ListPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPoliciesRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+// ListPoliciesResponse contains an org's policies.
+type ListPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*Policy              `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoliciesResponse) Reset() {
+	*x = ListPoliciesResponse{}
+	mi := &file_policy_v1_policy_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoliciesResponse) ProtoMessage() {}
+
+func (x *ListPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListPoliciesResponse) GetPolicies() []*Policy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+// UpdatePolicyRequest is the request to change a policy. Unset fields are left as they are.
+type UpdatePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Rule          *PolicyRule            `protobuf:"bytes,4,opt,name=rule,proto3,oneof" json:"rule,omitempty"`
+	Enabled       *bool                  `protobuf:"varint,5,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePolicyRequest) Reset() {
+	*x = UpdatePolicyRequest{}
+	mi := &file_policy_v1_policy_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePolicyRequest) ProtoMessage() {}
+
+func (x *UpdatePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdatePolicyRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdatePolicyRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePolicyRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePolicyRequest) GetRule() *PolicyRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *UpdatePolicyRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// UpdatePolicyResponse contains the policy as stored.
+type UpdatePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *Policy                `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePolicyResponse) Reset() {
+	*x = UpdatePolicyResponse{}
+	mi := &file_policy_v1_policy_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePolicyResponse) ProtoMessage() {}
+
+func (x *UpdatePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdatePolicyResponse) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// DeletePolicyRequest is the request to delete a policy.
+type DeletePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePolicyRequest) Reset() {
+	*x = DeletePolicyRequest{}
+	mi := &file_policy_v1_policy_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePolicyRequest) ProtoMessage() {}
+
+func (x *DeletePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeletePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeletePolicyRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeletePolicyResponse is empty.
+type DeletePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePolicyResponse) Reset() {
+	*x = DeletePolicyResponse{}
+	mi := &file_policy_v1_policy_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePolicyResponse) ProtoMessage() {}
+
+func (x *DeletePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeletePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{17}
+}
+
+var File_policy_v1_policy_proto protoreflect.FileDescriptor
+
+const file_policy_v1_policy_proto_rawDesc = "" +
+	"\n" +
+	"\x16policy/v1/policy.proto\x12\tpolicy.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"$\n" +
+	"\x0eRequiredLabels\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\".\n" +
+	"\fRegistryList\x12\x1e\n" +
+	"\n" +
+	"registries\x18\x01 \x03(\tR\n" +
+	"registries\"\x1f\n" +
+	"\vMaxReplicas\x12\x10\n" +
+	"\x03max\x18\x01 \x01(\x05R\x03max\"\x14\n" +
+	"\x12RequireHealthCheck\"i\n" +
+	"\x0eSignerIdentity\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12%\n" +
+	"\x0esubject_regexp\x18\x03 \x01(\bR\rsubjectRegexp\"n\n" +
+	"\x10RequireSignature\x12\x1f\n" +
+	"\vpublic_keys\x18\x01 \x03(\tR\n" +
+	"publicKeys\x129\n" +
+	"\n" +
+	"identities\x18\x02 \x03(\v2\x19.policy.v1.SignerIdentityR\n" +
+	"identities\"\xce\x03\n" +
+	"\n" +
+	"PolicyRule\x12D\n" +
+	"\x0frequired_labels\x18\x01 \x01(\v2\x19.policy.v1.RequiredLabelsH\x00R\x0erequiredLabels\x12H\n" +
+	"\x12allowed_registries\x18\x02 \x01(\v2\x17.policy.v1.RegistryListH\x00R\x11allowedRegistries\x12L\n" +
+	"\x14forbidden_registries\x18\x03 \x01(\v2\x17.policy.v1.RegistryListH\x00R\x13forbiddenRegistries\x12;\n" +
+	"\fmax_replicas\x18\x04 \x01(\v2\x16.policy.v1.MaxReplicasH\x00R\vmaxReplicas\x12Q\n" +
+	"\x14require_health_check\x18\x05 \x01(\v2\x1d.policy.v1.RequireHealthCheckH\x00R\x12requireHealthCheck\x12J\n" +
+	"\x11require_signature\x18\x06 \x01(\v2\x1b.policy.v1.RequireSignatureH\x00R\x10requireSignatureB\x06\n" +
+	"\x04rule\"\xd9\x02\n" +
+	"\x06Policy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\x03R\x05orgId\x12&\n" +
+	"\fworkspace_id\x18\x03 \x01(\x03H\x00R\vworkspaceId\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12)\n" +
+	"\x04rule\x18\x06 \x01(\v2\x15.policy.v1.PolicyRuleR\x04rule\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0f\n" +
+	"\r_workspace_id\"\x7f\n" +
+	"\x0fPolicyViolation\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\x12\x1f\n" +
+	"\vpolicy_name\x18\x02 \x01(\tR\n" +
+	"policyName\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"N\n" +
+	"\x10PolicyViolations\x12:\n" +
+	"\n" +
+	"violations\x18\x01 \x03(\v2\x1a.policy.v1.PolicyViolationR\n" +
+	"violations\"\xc6\x01\n" +
+	"\x13CreatePolicyRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12&\n" +
+	"\fworkspace_id\x18\x02 \x01(\x03H\x00R\vworkspaceId\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12)\n" +
+	"\x04rule\x18\x05 \x01(\v2\x15.policy.v1.PolicyRuleR\x04ruleB\x0f\n" +
+	"\r_workspace_id\"A\n" +
+	"\x14CreatePolicyResponse\x12)\n" +
+	"\x06policy\x18\x01 \x01(\v2\x11.policy.v1.PolicyR\x06policy\",\n" +
+	"\x13ListPoliciesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\"E\n" +
+	"\x14ListPoliciesResponse\x12-\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x11.policy.v1.PolicyR\bpolicies\"\xe2\x01\n" +
+	"\x13UpdatePolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12.\n" +
+	"\x04rule\x18\x04 \x01(\v2\x15.policy.v1.PolicyRuleH\x02R\x04rule\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x05 \x01(\bH\x03R\aenabled\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\a\n" +
+	"\x05_ruleB\n" +
+	"\n" +
+	"\b_enabled\"A\n" +
+	"\x14UpdatePolicyResponse\x12)\n" +
+	"\x06policy\x18\x01 \x01(\v2\x11.policy.v1.PolicyR\x06policy\"%\n" +
+	"\x13DeletePolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x16\n" +
+	"\x14DeletePolicyResponse2\xd3\x02\n" +
+	"\rPolicyService\x12O\n" +
+	"\fCreatePolicy\x12\x1e.policy.v1.CreatePolicyRequest\x1a\x1f.policy.v1.CreatePolicyResponse\x12O\n" +
+	"\fListPolicies\x12\x1e.policy.v1.ListPoliciesRequest\x1a\x1f.policy.v1.ListPoliciesResponse\x12O\n" +
+	"\fUpdatePolicy\x12\x1e.policy.v1.UpdatePolicyRequest\x1a\x1f.policy.v1.UpdatePolicyResponse\x12O\n" +
+	"\fDeletePolicy\x12\x1e.policy.v1.DeletePolicyRequest\x1a\x1f.policy.v1.DeletePolicyResponseB;Z9github.com/team-loco/loco/shared/proto/policy/v1;policyv1b\x06proto3"
+
+var (
+	file_policy_v1_policy_proto_rawDescOnce sync.Once
+	file_policy_v1_policy_proto_rawDescData []byte
+)
+
+func file_policy_v1_policy_proto_rawDescGZIP() []byte {
+	file_policy_v1_policy_proto_rawDescOnce.Do(func() {
+		file_policy_v1_policy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_policy_v1_policy_proto_rawDesc), len(file_policy_v1_policy_proto_rawDesc)))
+	})
+	return file_policy_v1_policy_proto_rawDescData
+}
+
+var file_policy_v1_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_policy_v1_policy_proto_goTypes = []any{
+	(*RequiredLabels)(nil),        // 0: policy.v1.RequiredLabels
+	(*RegistryList)(nil),          // 1: policy.v1.RegistryList
+	(*MaxReplicas)(nil),           // 2: policy.v1.MaxReplicas
+	(*RequireHealthCheck)(nil),    // 3: policy.v1.RequireHealthCheck
+	(*SignerIdentity)(nil),        // 4: policy.v1.SignerIdentity
+	(*RequireSignature)(nil),      // 5: policy.v1.RequireSignature
+	(*PolicyRule)(nil),            // 6: policy.v1.PolicyRule
+	(*Policy)(nil),                // 7: policy.v1.Policy
+	(*PolicyViolation)(nil),       // 8: policy.v1.PolicyViolation
+	(*PolicyViolations)(nil),      // 9: policy.v1.PolicyViolations
+	(*CreatePolicyRequest)(nil),   // 10: policy.v1.CreatePolicyRequest
+	(*CreatePolicyResponse)(nil),  // 11: policy.v1.CreatePolicyResponse
+	(*ListPoliciesRequest)(nil),   // 12: policy.v1.ListPoliciesRequest
+	(*ListPoliciesResponse)(nil),  // 13: policy.v1.ListPoliciesResponse
+	(*UpdatePolicyRequest)(nil),   // 14: policy.v1.UpdatePolicyRequest
+	(*UpdatePolicyResponse)(nil),  // 15: policy.v1.UpdatePolicyResponse
+	(*DeletePolicyRequest)(nil),   // 16: policy.v1.DeletePolicyRequest
+	(*DeletePolicyResponse)(nil),  // 17: policy.v1.DeletePolicyResponse
+	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
+}
+var file_policy_v1_policy_proto_depIdxs = []int32{
+	4,  // 0: policy.v1.RequireSignature.identities:type_name -> policy.v1.SignerIdentity
+	0,  // 1: policy.v1.PolicyRule.required_labels:type_name -> policy.v1.RequiredLabels
+	1,  // 2: policy.v1.PolicyRule.allowed_registries:type_name -> policy.v1.RegistryList
+	1,  // 3: policy.v1.PolicyRule.forbidden_registries:type_name -> policy.v1.RegistryList
+	2,  // 4: policy.v1.PolicyRule.max_replicas:type_name -> policy.v1.MaxReplicas
+	3,  // 5: policy.v1.PolicyRule.require_health_check:type_name -> policy.v1.RequireHealthCheck
+	5,  // 6: policy.v1.PolicyRule.require_signature:type_name -> policy.v1.RequireSignature
+	6,  // 7: policy.v1.Policy.rule:type_name -> policy.v1.PolicyRule
+	18, // 8: policy.v1.Policy.created_at:type_name -> google.protobuf.Timestamp
+	18, // 9: policy.v1.Policy.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 10: policy.v1.PolicyViolations.violations:type_name -> policy.v1.PolicyViolation
+	6,  // 11: policy.v1.CreatePolicyRequest.rule:type_name -> policy.v1.PolicyRule
+	7,  // 12: policy.v1.CreatePolicyResponse.policy:type_name -> policy.v1.Policy
+	7,  // 13: policy.v1.ListPoliciesResponse.policies:type_name -> policy.v1.Policy
+	6,  // 14: policy.v1.UpdatePolicyRequest.rule:type_name -> policy.v1.PolicyRule
+	7,  // 15: policy.v1.UpdatePolicyResponse.policy:type_name -> policy.v1.Policy
+	10, // 16: policy.v1.PolicyService.CreatePolicy:input_type -> policy.v1.CreatePolicyRequest
+	12, // 17: policy.v1.PolicyService.ListPolicies:input_type -> policy.v1.ListPoliciesRequest
+	14, // 18: policy.v1.PolicyService.UpdatePolicy:input_type -> policy.v1.UpdatePolicyRequest
+	16, // 19: policy.v1.PolicyService.DeletePolicy:input_type -> policy.v1.DeletePolicyRequest
+	11, // 20: policy.v1.PolicyService.CreatePolicy:output_type -> policy.v1.CreatePolicyResponse
+	13, // 21: policy.v1.PolicyService.ListPolicies:output_type -> policy.v1.ListPoliciesResponse
+	15, // 22: policy.v1.PolicyService.UpdatePolicy:output_type -> policy.v1.UpdatePolicyResponse
+	17, // 23: policy.v1.PolicyService.DeletePolicy:output_type -> policy.v1.DeletePolicyResponse
+	20, // [20:24] is the sub-list for method output_type
+	16, // [16:20] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_policy_v1_policy_proto_init() }
+func file_policy_v1_policy_proto_init() {
+	if File_policy_v1_policy_proto != nil {
+		return
+	}
+	file_policy_v1_policy_proto_msgTypes[6].OneofWrappers = []any{
+		(*PolicyRule_RequiredLabels)(nil),
+		(*PolicyRule_AllowedRegistries)(nil),
+		(*PolicyRule_ForbiddenRegistries)(nil),
+		(*PolicyRule_MaxReplicas)(nil),
+		(*PolicyRule_RequireHealthCheck)(nil),
+		(*PolicyRule_RequireSignature)(nil),
+	}
+	file_policy_v1_policy_proto_msgTypes[7].OneofWrappers = []any{}
+	file_policy_v1_policy_proto_msgTypes[10].OneofWrappers = []any{}
+	file_policy_v1_policy_proto_msgTypes[14].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_policy_v1_policy_proto_rawDesc), len(file_policy_v1_policy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_policy_v1_policy_proto_goTypes,
+		DependencyIndexes: file_policy_v1_policy_proto_depIdxs,
+		MessageInfos:      file_policy_v1_policy_proto_msgTypes,
+	}.Build()
+	File_policy_v1_policy_proto = out.File
+	file_policy_v1_policy_proto_goTypes = nil
+	file_policy_v1_policy_proto_depIdxs = nil
+}
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: policy/v1/policy.proto
+
+package policyv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/team-loco/loco/shared/proto/policy/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PolicyServiceName is the fully-qualified name of the PolicyService service.
+	PolicyServiceName = "policy.v1.PolicyService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PolicyServiceCreatePolicyProcedure is the fully-qualified name of the PolicyService's
+	// CreatePolicy RPC.
+	PolicyServiceCreatePolicyProcedure = "/policy.v1.PolicyService/CreatePolicy"
+	// PolicyServiceListPoliciesProcedure is the fully-qualified name of the PolicyService's
+	// ListPolicies RPC.
+	PolicyServiceListPoliciesProcedure = "/policy.v1.PolicyService/ListPolicies"
+	// PolicyServiceUpdatePolicyProcedure is the fully-qualified name of the PolicyService's
+	// UpdatePolicy RPC.
+	PolicyServiceUpdatePolicyProcedure = "/policy.v1.PolicyService/UpdatePolicy"
+	// PolicyServiceDeletePolicyProcedure is the fully-qualified name of the PolicyService's
+	// DeletePolicy RPC.
+	PolicyServiceDeletePolicyProcedure = "/policy.v1.PolicyService/DeletePolicy"
+)
+
+// PolicyServiceClient is a client for the policy.v1.PolicyService service.
+type PolicyServiceClient interface {
+	// CreatePolicy creates a policy. It applies to resources created and deployed from then on.
+	CreatePolicy(context.Context, *connect.Request[v1.CreatePolicyRequest]) (*connect.Response[v1.CreatePolicyResponse], error)
+	// ListPolicies lists an org's policies.
+	ListPolicies(context.Context, *connect.Request[v1.ListPoliciesRequest]) (*connect.Response[v1.ListPoliciesResponse], error)
+	// UpdatePolicy changes the given fields of a policy.
+	UpdatePolicy(context.Context, *connect.Request[v1.UpdatePolicyRequest]) (*connect.Response[v1.UpdatePolicyResponse], error)
+	// DeletePolicy deletes a policy.
+	DeletePolicy(context.Context, *connect.Request[v1.DeletePolicyRequest]) (*connect.Response[v1.DeletePolicyResponse], error)
+}
+
+// NewPolicyServiceClient constructs a client for the policy.v1.PolicyService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPolicyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PolicyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	policyServiceMethods := v1.File_policy_v1_policy_proto.Services().ByName("PolicyService").Methods()
+	return &policyServiceClient{
+		createPolicy: connect.NewClient[v1.CreatePolicyRequest, v1.CreatePolicyResponse](
+			httpClient,
+			baseURL+PolicyServiceCreatePolicyProcedure,
+			connect.WithSchema(policyServiceMethods.ByName("CreatePolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		listPolicies: connect.NewClient[v1.ListPoliciesRequest, v1.ListPoliciesResponse](
+			httpClient,
+			baseURL+PolicyServiceListPoliciesProcedure,
+			connect.WithSchema(policyServiceMethods.ByName("ListPolicies")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePolicy: connect.NewClient[v1.UpdatePolicyRequest, v1.UpdatePolicyResponse](
+			httpClient,
+			baseURL+PolicyServiceUpdatePolicyProcedure,
+			connect.WithSchema(policyServiceMethods.ByName("UpdatePolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePolicy: connect.NewClient[v1.DeletePolicyRequest, v1.DeletePolicyResponse](
+			httpClient,
+			baseURL+PolicyServiceDeletePolicyProcedure,
+			connect.WithSchema(policyServiceMethods.ByName("DeletePolicy")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// policyServiceClient implements PolicyServiceClient.
+type policyServiceClient struct {
+	createPolicy *connect.Client[v1.CreatePolicyRequest, v1.CreatePolicyResponse]
+	listPolicies *connect.Client[v1.ListPoliciesRequest, v1.ListPoliciesResponse]
+	updatePolicy *connect.Client[v1.UpdatePolicyRequest, v1.UpdatePolicyResponse]
+	deletePolicy *connect.Client[v1.DeletePolicyRequest, v1.DeletePolicyResponse]
+}
+
+// CreatePolicy calls policy.v1.PolicyService.CreatePolicy.
+func (c *policyServiceClient) CreatePolicy(ctx context.Context, req *connect.Request[v1.CreatePolicyRequest]) (*connect.Response[v1.CreatePolicyResponse], error) {
+	return c.createPolicy.CallUnary(ctx, req)
+}
+
+// ListPolicies calls policy.v1.PolicyService.ListPolicies.
+func (c *policyServiceClient) ListPolicies(ctx context.Context, req *connect.Request[v1.ListPoliciesRequest]) (*connect.Response[v1.ListPoliciesResponse], error) {
+	return c.listPolicies.CallUnary(ctx, req)
+}
+
+// UpdatePolicy calls policy.v1.PolicyService.UpdatePolicy.
+func (c *policyServiceClient) UpdatePolicy(ctx context.Context, req *connect.Request[v1.UpdatePolicyRequest]) (*connect.Response[v1.UpdatePolicyResponse], error) {
+	return c.updatePolicy.CallUnary(ctx, req)
+}
+
+// DeletePolicy calls policy.v1.PolicyService.DeletePolicy.
+func (c *policyServiceClient) DeletePolicy(ctx context.Context, req *connect.Request[v1.DeletePolicyRequest]) (*connect.Response[v1.DeletePolicyResponse], error) {
+	return c.deletePolicy.CallUnary(ctx, req)
+}
+
+// PolicyServiceHandler is an implementation of the policy.v1.PolicyService service.
+type PolicyServiceHandler interface {
+	// CreatePolicy creates a policy. It applies to resources created and deployed from then on.
+	CreatePolicy(context.Context, *connect.Request[v1.CreatePolicyRequest]) (*connect.Response[v1.CreatePolicyResponse], error)
+	// ListPolicies lists an org's policies.
+	ListPolicies(context.Context, *connect.Request[v1.ListPoliciesRequest]) (*connect.Response[v1.ListPoliciesResponse], error)
+	// UpdatePolicy changes the given fields of a policy.
+	UpdatePolicy(context.Context, *connect.Request[v1.UpdatePolicyRequest]) (*connect.Response[v1.UpdatePolicyResponse], error)
+	// DeletePolicy deletes a policy.
+	DeletePolicy(context.Context, *connect.Request[v1.DeletePolicyRequest]) (*connect.Response[v1.DeletePolicyResponse], error)
+}
+
+// NewPolicyServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPolicyServiceHandler(svc PolicyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	policyServiceMethods := v1.File_policy_v1_policy_proto.Services().ByName("PolicyService").Methods()
+	policyServiceCreatePolicyHandler := connect.NewUnaryHandler(
+		PolicyServiceCreatePolicyProcedure,
+		svc.CreatePolicy,
+		connect.WithSchema(policyServiceMethods.ByName("CreatePolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	policyServiceListPoliciesHandler := connect.NewUnaryHandler(
+		PolicyServiceListPoliciesProcedure,
+		svc.ListPolicies,
+		connect.WithSchema(policyServiceMethods.ByName("ListPolicies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	policyServiceUpdatePolicyHandler := connect.NewUnaryHandler(
+		PolicyServiceUpdatePolicyProcedure,
+		svc.UpdatePolicy,
+		connect.WithSchema(policyServiceMethods.ByName("UpdatePolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	policyServiceDeletePolicyHandler := connect.NewUnaryHandler(
+		PolicyServiceDeletePolicyProcedure,
+		svc.DeletePolicy,
+		connect.WithSchema(policyServiceMethods.ByName("DeletePolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/policy.v1.PolicyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PolicyServiceCreatePolicyProcedure:
+			policyServiceCreatePolicyHandler.ServeHTTP(w, r)
+		case PolicyServiceListPoliciesProcedure:
+			policyServiceListPoliciesHandler.ServeHTTP(w, r)
+		case PolicyServiceUpdatePolicyProcedure:
+			policyServiceUpdatePolicyHandler.ServeHTTP(w, r)
+		case PolicyServiceDeletePolicyProcedure:
+			policyServiceDeletePolicyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPolicyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPolicyServiceHandler struct{}
+
+func (UnimplementedPolicyServiceHandler) CreatePolicy(context.Context, *connect.Request[v1.CreatePolicyRequest]) (*connect.Response[v1.CreatePolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("policy.v1.PolicyService.CreatePolicy is not implemented"))
+}
+
+func (UnimplementedPolicyServiceHandler) ListPolicies(context.Context, *connect.Request[v1.ListPoliciesRequest]) (*connect.Response[v1.ListPoliciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("policy.v1.PolicyService.ListPolicies is not implemented"))
+}
+
+func (UnimplementedPolicyServiceHandler) UpdatePolicy(context.Context, *connect.Request[v1.UpdatePolicyRequest]) (*connect.Response[v1.UpdatePolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("policy.v1.PolicyService.UpdatePolicy is not implemented"))
+}
+
+func (UnimplementedPolicyServiceHandler) DeletePolicy(context.Context, *connect.Request[v1.DeletePolicyRequest]) (*connect.Response[v1.DeletePolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("policy.v1.PolicyService.DeletePolicy is not implemented"))
+}
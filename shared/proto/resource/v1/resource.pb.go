@@ -9,6 +9,7 @@ package resourcev1
 import (
 	v1 "github.com/team-loco/loco/shared/proto/deployment/v1"
 	v11 "github.com/team-loco/loco/shared/proto/domain/v1"
+	v12 "github.com/team-loco/loco/shared/proto/token/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
@@ -208,14 +209,138 @@ func (RegionIntentStatus) EnumDescriptor() ([]byte, []int) {
 	return file_resource_v1_resource_proto_rawDescGZIP(), []int{2}
 }
 
+// ConfigValueType is the type an env var's value must parse as. Values referencing a secret are not
+// checked, since the API never sees them.
+type ConfigValueType int32
+
+const (
+	ConfigValueType_CONFIG_VALUE_TYPE_UNSPECIFIED ConfigValueType = 0
+	ConfigValueType_CONFIG_VALUE_TYPE_STRING      ConfigValueType = 1
+	ConfigValueType_CONFIG_VALUE_TYPE_INT         ConfigValueType = 2
+	ConfigValueType_CONFIG_VALUE_TYPE_BOOL        ConfigValueType = 3
+	ConfigValueType_CONFIG_VALUE_TYPE_URL         ConfigValueType = 4
+	ConfigValueType_CONFIG_VALUE_TYPE_DURATION    ConfigValueType = 5 // Go duration, e.g. 30s or 5m
+)
+
+// Enum value maps for ConfigValueType.
+var (
+	ConfigValueType_name = map[int32]string{
+		0: "CONFIG_VALUE_TYPE_UNSPECIFIED",
+		1: "CONFIG_VALUE_TYPE_STRING",
+		2: "CONFIG_VALUE_TYPE_INT",
+		3: "CONFIG_VALUE_TYPE_BOOL",
+		4: "CONFIG_VALUE_TYPE_URL",
+		5: "CONFIG_VALUE_TYPE_DURATION",
+	}
+	ConfigValueType_value = map[string]int32{
+		"CONFIG_VALUE_TYPE_UNSPECIFIED": 0,
+		"CONFIG_VALUE_TYPE_STRING":      1,
+		"CONFIG_VALUE_TYPE_INT":         2,
+		"CONFIG_VALUE_TYPE_BOOL":        3,
+		"CONFIG_VALUE_TYPE_URL":         4,
+		"CONFIG_VALUE_TYPE_DURATION":    5,
+	}
+)
+
+func (x ConfigValueType) Enum() *ConfigValueType {
+	p := new(ConfigValueType)
+	*p = x
+	return p
+}
+
+func (x ConfigValueType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigValueType) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_v1_resource_proto_enumTypes[3].Descriptor()
+}
+
+func (ConfigValueType) Type() protoreflect.EnumType {
+	return &file_resource_v1_resource_proto_enumTypes[3]
+}
+
+func (x ConfigValueType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigValueType.Descriptor instead.
+func (ConfigValueType) EnumDescriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{3}
+}
+
+// TimelineEntryKind is what a timeline entry records.
+type TimelineEntryKind int32
+
+const (
+	TimelineEntryKind_TIMELINE_ENTRY_KIND_UNSPECIFIED    TimelineEntryKind = 0
+	TimelineEntryKind_TIMELINE_ENTRY_KIND_DEPLOYMENT     TimelineEntryKind = 1
+	TimelineEntryKind_TIMELINE_ENTRY_KIND_SCALED         TimelineEntryKind = 2
+	TimelineEntryKind_TIMELINE_ENTRY_KIND_ENV_CHANGED    TimelineEntryKind = 3
+	TimelineEntryKind_TIMELINE_ENTRY_KIND_DOMAIN_CHANGED TimelineEntryKind = 4
+	TimelineEntryKind_TIMELINE_ENTRY_KIND_INCIDENT       TimelineEntryKind = 5 // a stretch of degraded or unavailable status
+)
+
+// Enum value maps for TimelineEntryKind.
+var (
+	TimelineEntryKind_name = map[int32]string{
+		0: "TIMELINE_ENTRY_KIND_UNSPECIFIED",
+		1: "TIMELINE_ENTRY_KIND_DEPLOYMENT",
+		2: "TIMELINE_ENTRY_KIND_SCALED",
+		3: "TIMELINE_ENTRY_KIND_ENV_CHANGED",
+		4: "TIMELINE_ENTRY_KIND_DOMAIN_CHANGED",
+		5: "TIMELINE_ENTRY_KIND_INCIDENT",
+	}
+	TimelineEntryKind_value = map[string]int32{
+		"TIMELINE_ENTRY_KIND_UNSPECIFIED":    0,
+		"TIMELINE_ENTRY_KIND_DEPLOYMENT":     1,
+		"TIMELINE_ENTRY_KIND_SCALED":         2,
+		"TIMELINE_ENTRY_KIND_ENV_CHANGED":    3,
+		"TIMELINE_ENTRY_KIND_DOMAIN_CHANGED": 4,
+		"TIMELINE_ENTRY_KIND_INCIDENT":       5,
+	}
+)
+
+func (x TimelineEntryKind) Enum() *TimelineEntryKind {
+	p := new(TimelineEntryKind)
+	*p = x
+	return p
+}
+
+func (x TimelineEntryKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimelineEntryKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_v1_resource_proto_enumTypes[4].Descriptor()
+}
+
+func (TimelineEntryKind) Type() protoreflect.EnumType {
+	return &file_resource_v1_resource_proto_enumTypes[4]
+}
+
+func (x TimelineEntryKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimelineEntryKind.Descriptor instead.
+func (TimelineEntryKind) EnumDescriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{4}
+}
+
 // RoutingConfig defines routing configuration for a resource.
 type RoutingConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Port          int32                  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`                                  // application port
-	PathPrefix    string                 `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`     // e.g., "/"
-	IdleTimeout   int32                  `protobuf:"varint,3,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"` // seconds
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Port           int32                  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`                                           // application port
+	PathPrefix     string                 `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`              // e.g., "/"
+	IdleTimeout    int32                  `protobuf:"varint,3,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`          // seconds
+	ErrorPage      *ErrorPageConfig       `protobuf:"bytes,4,opt,name=error_page,json=errorPage,proto3,oneof" json:"error_page,omitempty"`           // page shown when the service returns 502-504 or is unreachable
+	Protocol       string                 `protobuf:"bytes,5,opt,name=protocol,proto3" json:"protocol,omitempty"`                                    // "http" (default), "h2c", "grpc", or "websocket"
+	RequestTimeout int32                  `protobuf:"varint,6,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"` // seconds; 0 keeps the gateway default
+	MaxBodySize    string                 `protobuf:"bytes,7,opt,name=max_body_size,json=maxBodySize,proto3" json:"max_body_size,omitempty"`         // e.g., "10Mi"; empty keeps the gateway default
+	Ports          []*PortConfig          `protobuf:"bytes,8,rep,name=ports,proto3" json:"ports,omitempty"`                                          // ports besides the application port, e.g. metrics
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *RoutingConfig) Reset() {
@@ -269,6 +394,173 @@ func (x *RoutingConfig) GetIdleTimeout() int32 {
 	return 0
 }
 
+func (x *RoutingConfig) GetErrorPage() *ErrorPageConfig {
+	if x != nil {
+		return x.ErrorPage
+	}
+	return nil
+}
+
+func (x *RoutingConfig) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *RoutingConfig) GetRequestTimeout() int32 {
+	if x != nil {
+		return x.RequestTimeout
+	}
+	return 0
+}
+
+func (x *RoutingConfig) GetMaxBodySize() string {
+	if x != nil {
+		return x.MaxBodySize
+	}
+	return ""
+}
+
+func (x *RoutingConfig) GetPorts() []*PortConfig {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+// PortConfig is a further named port of a service. A port with a path prefix is routed from the
+// service's hostname, e.g. /admin to 8081; one without is only reachable inside the cluster, e.g.
+// metrics on 9090.
+type PortConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // e.g., "metrics"
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	PathPrefix    string                 `protobuf:"bytes,3,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"` // empty keeps the port internal-only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortConfig) Reset() {
+	*x = PortConfig{}
+	mi := &file_resource_v1_resource_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortConfig) ProtoMessage() {}
+
+func (x *PortConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortConfig.ProtoReflect.Descriptor instead.
+func (*PortConfig) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PortConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PortConfig) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *PortConfig) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+// ErrorPageConfig customizes the branded page the gateway serves when a service is unavailable.
+type ErrorPageConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disabled      bool                   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"` // pass upstream errors through unchanged
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Message       *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	Html          *string                `protobuf:"bytes,4,opt,name=html,proto3,oneof" json:"html,omitempty"` // replaces the default page entirely
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorPageConfig) Reset() {
+	*x = ErrorPageConfig{}
+	mi := &file_resource_v1_resource_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorPageConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorPageConfig) ProtoMessage() {}
+
+func (x *ErrorPageConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorPageConfig.ProtoReflect.Descriptor instead.
+func (*ErrorPageConfig) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ErrorPageConfig) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *ErrorPageConfig) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *ErrorPageConfig) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *ErrorPageConfig) GetHtml() string {
+	if x != nil && x.Html != nil {
+		return *x.Html
+	}
+	return ""
+}
+
 // LoggingConfig defines logging configuration.
 type LoggingConfig struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -281,7 +573,7 @@ type LoggingConfig struct {
 
 func (x *LoggingConfig) Reset() {
 	*x = LoggingConfig{}
-	mi := &file_resource_v1_resource_proto_msgTypes[1]
+	mi := &file_resource_v1_resource_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -293,7 +585,7 @@ func (x *LoggingConfig) String() string {
 func (*LoggingConfig) ProtoMessage() {}
 
 func (x *LoggingConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[1]
+	mi := &file_resource_v1_resource_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,7 +598,7 @@ func (x *LoggingConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoggingConfig.ProtoReflect.Descriptor instead.
 func (*LoggingConfig) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{1}
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *LoggingConfig) GetEnabled() bool {
@@ -342,7 +634,7 @@ type MetricsConfig struct {
 
 func (x *MetricsConfig) Reset() {
 	*x = MetricsConfig{}
-	mi := &file_resource_v1_resource_proto_msgTypes[2]
+	mi := &file_resource_v1_resource_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -354,7 +646,7 @@ func (x *MetricsConfig) String() string {
 func (*MetricsConfig) ProtoMessage() {}
 
 func (x *MetricsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[2]
+	mi := &file_resource_v1_resource_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,7 +659,7 @@ func (x *MetricsConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetricsConfig.ProtoReflect.Descriptor instead.
 func (*MetricsConfig) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{2}
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MetricsConfig) GetEnabled() bool {
@@ -403,7 +695,7 @@ type TracingConfig struct {
 
 func (x *TracingConfig) Reset() {
 	*x = TracingConfig{}
-	mi := &file_resource_v1_resource_proto_msgTypes[3]
+	mi := &file_resource_v1_resource_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -415,7 +707,7 @@ func (x *TracingConfig) String() string {
 func (*TracingConfig) ProtoMessage() {}
 
 func (x *TracingConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[3]
+	mi := &file_resource_v1_resource_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -428,7 +720,7 @@ func (x *TracingConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TracingConfig.ProtoReflect.Descriptor instead.
 func (*TracingConfig) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{3}
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TracingConfig) GetEnabled() bool {
@@ -464,7 +756,7 @@ type ObservabilityConfig struct {
 
 func (x *ObservabilityConfig) Reset() {
 	*x = ObservabilityConfig{}
-	mi := &file_resource_v1_resource_proto_msgTypes[4]
+	mi := &file_resource_v1_resource_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -476,7 +768,7 @@ func (x *ObservabilityConfig) String() string {
 func (*ObservabilityConfig) ProtoMessage() {}
 
 func (x *ObservabilityConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[4]
+	mi := &file_resource_v1_resource_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,7 +781,7 @@ func (x *ObservabilityConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObservabilityConfig.ProtoReflect.Descriptor instead.
 func (*ObservabilityConfig) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{4}
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ObservabilityConfig) GetLogging() *LoggingConfig {
@@ -529,7 +821,7 @@ type RegionTarget struct {
 
 func (x *RegionTarget) Reset() {
 	*x = RegionTarget{}
-	mi := &file_resource_v1_resource_proto_msgTypes[5]
+	mi := &file_resource_v1_resource_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -541,7 +833,7 @@ func (x *RegionTarget) String() string {
 func (*RegionTarget) ProtoMessage() {}
 
 func (x *RegionTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[5]
+	mi := &file_resource_v1_resource_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -554,7 +846,7 @@ func (x *RegionTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegionTarget.ProtoReflect.Descriptor instead.
 func (*RegionTarget) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{5}
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RegionTarget) GetEnabled() bool {
@@ -613,13 +905,19 @@ type ServiceSpec struct {
 	Observability *ObservabilityConfig     `protobuf:"bytes,2,opt,name=observability,proto3" json:"observability,omitempty"`
 	Regions       map[string]*RegionTarget `protobuf:"bytes,3,rep,name=regions,proto3" json:"regions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // key = region name
 	HealthCheck   *v1.HealthCheckConfig    `protobuf:"bytes,4,opt,name=health_check,json=healthCheck,proto3,oneof" json:"health_check,omitempty"`                                          // health check defaults
+	ScaleToZero   *ScaleToZeroConfig       `protobuf:"bytes,5,opt,name=scale_to_zero,json=scaleToZero,proto3,oneof" json:"scale_to_zero,omitempty"`                                        // unset keeps the service always running
+	ConfigSchema  *ConfigSchema            `protobuf:"bytes,6,opt,name=config_schema,json=configSchema,proto3,oneof" json:"config_schema,omitempty"`                                       // unset accepts any env vars
+	Scheduling    *SchedulingConfig        `protobuf:"bytes,7,opt,name=scheduling,proto3,oneof" json:"scheduling,omitempty"`                                                               // unset runs on any node
+	Command       []string                 `protobuf:"bytes,8,rep,name=command,proto3" json:"command,omitempty"`                                                                           // replaces the image's entrypoint, so one image can run web and worker variants
+	Args          []string                 `protobuf:"bytes,9,rep,name=args,proto3" json:"args,omitempty"`                                                                                 // replaces the image's default arguments
+	WorkingDir    *string                  `protobuf:"bytes,10,opt,name=working_dir,json=workingDir,proto3,oneof" json:"working_dir,omitempty"`                                            // unset uses the image's working directory
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ServiceSpec) Reset() {
 	*x = ServiceSpec{}
-	mi := &file_resource_v1_resource_proto_msgTypes[6]
+	mi := &file_resource_v1_resource_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -631,7 +929,7 @@ func (x *ServiceSpec) String() string {
 func (*ServiceSpec) ProtoMessage() {}
 
 func (x *ServiceSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[6]
+	mi := &file_resource_v1_resource_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,7 +942,7 @@ func (x *ServiceSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceSpec.ProtoReflect.Descriptor instead.
 func (*ServiceSpec) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{6}
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ServiceSpec) GetRouting() *RoutingConfig {
@@ -675,65 +973,75 @@ func (x *ServiceSpec) GetHealthCheck() *v1.HealthCheckConfig {
 	return nil
 }
 
-// DatabaseSpec is a placeholder for DATABASE type resources (future implementation).
-type DatabaseSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ServiceSpec) GetScaleToZero() *ScaleToZeroConfig {
+	if x != nil {
+		return x.ScaleToZero
+	}
+	return nil
 }
 
-func (x *DatabaseSpec) Reset() {
-	*x = DatabaseSpec{}
-	mi := &file_resource_v1_resource_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ServiceSpec) GetConfigSchema() *ConfigSchema {
+	if x != nil {
+		return x.ConfigSchema
+	}
+	return nil
 }
 
-func (x *DatabaseSpec) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ServiceSpec) GetScheduling() *SchedulingConfig {
+	if x != nil {
+		return x.Scheduling
+	}
+	return nil
 }
 
-func (*DatabaseSpec) ProtoMessage() {}
+func (x *ServiceSpec) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
 
-func (x *DatabaseSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[7]
+func (x *ServiceSpec) GetArgs() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Args
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DatabaseSpec.ProtoReflect.Descriptor instead.
-func (*DatabaseSpec) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{7}
+func (x *ServiceSpec) GetWorkingDir() string {
+	if x != nil && x.WorkingDir != nil {
+		return *x.WorkingDir
+	}
+	return ""
 }
 
-// CacheSpec is a placeholder for CACHE type resources (future implementation).
-type CacheSpec struct {
+// SchedulingConfig places a service's pods on dedicated node pools, e.g. GPU or arm64 nodes, and
+// spreads them across zones. Node selectors and tolerations must match a node pool of the cluster
+// serving each of the service's regions.
+type SchedulingConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeSelector  map[string]string      `protobuf:"bytes,1,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // node labels pods must run on, e.g. kubernetes.io/arch: arm64
+	Tolerations   []*Toleration          `protobuf:"bytes,2,rep,name=tolerations,proto3" json:"tolerations,omitempty"`                                                                                                 // node pool taints pods may run despite
+	Spread        []*SpreadConstraint    `protobuf:"bytes,3,rep,name=spread,proto3" json:"spread,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CacheSpec) Reset() {
-	*x = CacheSpec{}
-	mi := &file_resource_v1_resource_proto_msgTypes[8]
+func (x *SchedulingConfig) Reset() {
+	*x = SchedulingConfig{}
+	mi := &file_resource_v1_resource_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CacheSpec) String() string {
+func (x *SchedulingConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CacheSpec) ProtoMessage() {}
+func (*SchedulingConfig) ProtoMessage() {}
 
-func (x *CacheSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[8]
+func (x *SchedulingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,69 +1052,56 @@ func (x *CacheSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CacheSpec.ProtoReflect.Descriptor instead.
-func (*CacheSpec) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{8}
-}
-
-// QueueSpec is a placeholder for QUEUE type resources (future implementation).
-type QueueSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *QueueSpec) Reset() {
-	*x = QueueSpec{}
-	mi := &file_resource_v1_resource_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+// Deprecated: Use SchedulingConfig.ProtoReflect.Descriptor instead.
+func (*SchedulingConfig) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *QueueSpec) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SchedulingConfig) GetNodeSelector() map[string]string {
+	if x != nil {
+		return x.NodeSelector
+	}
+	return nil
 }
 
-func (*QueueSpec) ProtoMessage() {}
-
-func (x *QueueSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[9]
+func (x *SchedulingConfig) GetTolerations() []*Toleration {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Tolerations
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use QueueSpec.ProtoReflect.Descriptor instead.
-func (*QueueSpec) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{9}
+func (x *SchedulingConfig) GetSpread() []*SpreadConstraint {
+	if x != nil {
+		return x.Spread
+	}
+	return nil
 }
 
-// BlobSpec is a placeholder for BLOB type resources (future implementation).
-type BlobSpec struct {
+// Toleration lets pods run on nodes with a matching taint.
+type Toleration struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`   // empty tolerates any value
+	Effect        string                 `protobuf:"bytes,3,opt,name=effect,proto3" json:"effect,omitempty"` // NoSchedule, PreferNoSchedule or NoExecute; empty tolerates all three
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlobSpec) Reset() {
-	*x = BlobSpec{}
+func (x *Toleration) Reset() {
+	*x = Toleration{}
 	mi := &file_resource_v1_resource_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlobSpec) String() string {
+func (x *Toleration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlobSpec) ProtoMessage() {}
+func (*Toleration) ProtoMessage() {}
 
-func (x *BlobSpec) ProtoReflect() protoreflect.Message {
+func (x *Toleration) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_v1_resource_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -818,41 +1113,56 @@ func (x *BlobSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlobSpec.ProtoReflect.Descriptor instead.
-func (*BlobSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use Toleration.ProtoReflect.Descriptor instead.
+func (*Toleration) Descriptor() ([]byte, []int) {
 	return file_resource_v1_resource_proto_rawDescGZIP(), []int{10}
 }
 
-// ResourceSpec defines the global infrastructure intent for a resource.
-// Uses oneof to support different resource types.
-type ResourceSpec struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Spec:
-	//
-	//	*ResourceSpec_Service
-	//	*ResourceSpec_Database
-	//	*ResourceSpec_Cache
-	//	*ResourceSpec_Queue
-	//	*ResourceSpec_Blob
-	Spec          isResourceSpec_Spec `protobuf_oneof:"spec"`
+func (x *Toleration) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Toleration) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Toleration) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+// SpreadConstraint spreads a service's pods across the values of a node label.
+type SpreadConstraint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopologyKey   string                 `protobuf:"bytes,1,opt,name=topology_key,json=topologyKey,proto3" json:"topology_key,omitempty"` // e.g. topology.kubernetes.io/zone or kubernetes.io/hostname
+	MaxSkew       int32                  `protobuf:"varint,2,opt,name=max_skew,json=maxSkew,proto3" json:"max_skew,omitempty"`            // most pods one value may have over another; defaults to 1
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`                         // leave pods pending rather than skew further; otherwise best effort
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResourceSpec) Reset() {
-	*x = ResourceSpec{}
+func (x *SpreadConstraint) Reset() {
+	*x = SpreadConstraint{}
 	mi := &file_resource_v1_resource_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResourceSpec) String() string {
+func (x *SpreadConstraint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceSpec) ProtoMessage() {}
+func (*SpreadConstraint) ProtoMessage() {}
 
-func (x *ResourceSpec) ProtoReflect() protoreflect.Message {
+func (x *SpreadConstraint) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_v1_resource_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -864,132 +1174,106 @@ func (x *ResourceSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceSpec.ProtoReflect.Descriptor instead.
-func (*ResourceSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use SpreadConstraint.ProtoReflect.Descriptor instead.
+func (*SpreadConstraint) Descriptor() ([]byte, []int) {
 	return file_resource_v1_resource_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ResourceSpec) GetSpec() isResourceSpec_Spec {
-	if x != nil {
-		return x.Spec
-	}
-	return nil
-}
-
-func (x *ResourceSpec) GetService() *ServiceSpec {
-	if x != nil {
-		if x, ok := x.Spec.(*ResourceSpec_Service); ok {
-			return x.Service
-		}
-	}
-	return nil
-}
-
-func (x *ResourceSpec) GetDatabase() *DatabaseSpec {
+func (x *SpreadConstraint) GetTopologyKey() string {
 	if x != nil {
-		if x, ok := x.Spec.(*ResourceSpec_Database); ok {
-			return x.Database
-		}
+		return x.TopologyKey
 	}
-	return nil
+	return ""
 }
 
-func (x *ResourceSpec) GetCache() *CacheSpec {
+func (x *SpreadConstraint) GetMaxSkew() int32 {
 	if x != nil {
-		if x, ok := x.Spec.(*ResourceSpec_Cache); ok {
-			return x.Cache
-		}
+		return x.MaxSkew
 	}
-	return nil
+	return 0
 }
 
-func (x *ResourceSpec) GetQueue() *QueueSpec {
+func (x *SpreadConstraint) GetRequired() bool {
 	if x != nil {
-		if x, ok := x.Spec.(*ResourceSpec_Queue); ok {
-			return x.Queue
-		}
+		return x.Required
 	}
-	return nil
+	return false
 }
 
-func (x *ResourceSpec) GetBlob() *BlobSpec {
-	if x != nil {
-		if x, ok := x.Spec.(*ResourceSpec_Blob); ok {
-			return x.Blob
-		}
-	}
-	return nil
+// ConfigSchema declares the env vars a service expects. Env updates are checked against it, and
+// deployments are refused while a required key is missing from the service's env and the env it
+// inherits from its workspace, config groups and environment.
+type ConfigSchema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*ConfigKey           `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type isResourceSpec_Spec interface {
-	isResourceSpec_Spec()
+func (x *ConfigSchema) Reset() {
+	*x = ConfigSchema{}
+	mi := &file_resource_v1_resource_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type ResourceSpec_Service struct {
-	Service *ServiceSpec `protobuf:"bytes,1,opt,name=service,proto3,oneof"`
+func (x *ConfigSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ResourceSpec_Database struct {
-	Database *DatabaseSpec `protobuf:"bytes,2,opt,name=database,proto3,oneof"`
-}
+func (*ConfigSchema) ProtoMessage() {}
 
-type ResourceSpec_Cache struct {
-	Cache *CacheSpec `protobuf:"bytes,3,opt,name=cache,proto3,oneof"`
+func (x *ConfigSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ResourceSpec_Queue struct {
-	Queue *QueueSpec `protobuf:"bytes,4,opt,name=queue,proto3,oneof"`
+// Deprecated: Use ConfigSchema.ProtoReflect.Descriptor instead.
+func (*ConfigSchema) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{12}
 }
 
-type ResourceSpec_Blob struct {
-	Blob *BlobSpec `protobuf:"bytes,5,opt,name=blob,proto3,oneof"`
+func (x *ConfigSchema) GetKeys() []*ConfigKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
 }
 
-func (*ResourceSpec_Service) isResourceSpec_Spec() {}
-
-func (*ResourceSpec_Database) isResourceSpec_Spec() {}
-
-func (*ResourceSpec_Cache) isResourceSpec_Spec() {}
-
-func (*ResourceSpec_Queue) isResourceSpec_Spec() {}
-
-func (*ResourceSpec_Blob) isResourceSpec_Spec() {}
-
-// Resource represents a resource in a workspace.
-type Resource struct {
+// ConfigKey describes one env var a service expects.
+type ConfigKey struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	WorkspaceId   int64                  `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Type          ResourceType           `protobuf:"varint,4,opt,name=type,proto3,enum=resource.v1.ResourceType" json:"type,omitempty"`
-	Domains       []*v11.ResourceDomain  `protobuf:"bytes,5,rep,name=domains,proto3" json:"domains,omitempty"`
-	Regions       []*RegionConfig        `protobuf:"bytes,6,rep,name=regions,proto3" json:"regions,omitempty"`
-	Status        ResourceStatus         `protobuf:"varint,7,opt,name=status,proto3,enum=resource.v1.ResourceStatus" json:"status,omitempty"`
-	Spec          *ResourceSpec          `protobuf:"bytes,8,opt,name=spec,proto3,oneof" json:"spec,omitempty"`
-	SpecVersion   int32                  `protobuf:"varint,9,opt,name=spec_version,json=specVersion,proto3" json:"spec_version,omitempty"`
-	Description   *string                `protobuf:"bytes,10,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	CreatedBy     int64                  `protobuf:"varint,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Required      bool                   `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	Type          ConfigValueType        `protobuf:"varint,3,opt,name=type,proto3,enum=resource.v1.ConfigValueType" json:"type,omitempty"` // unspecified accepts any value
+	Pattern       string                 `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`                             // regular expression the whole value must match, if set
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Resource) Reset() {
-	*x = Resource{}
-	mi := &file_resource_v1_resource_proto_msgTypes[12]
+func (x *ConfigKey) Reset() {
+	*x = ConfigKey{}
+	mi := &file_resource_v1_resource_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Resource) String() string {
+func (x *ConfigKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Resource) ProtoMessage() {}
+func (*ConfigKey) ProtoMessage() {}
 
-func (x *Resource) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[12]
+func (x *ConfigKey) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,70 +1284,494 @@ func (x *Resource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
-func (*Resource) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ConfigKey.ProtoReflect.Descriptor instead.
+func (*ConfigKey) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Resource) GetId() int64 {
+func (x *ConfigKey) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Resource) GetWorkspaceId() int64 {
+func (x *ConfigKey) GetRequired() bool {
 	if x != nil {
-		return x.WorkspaceId
+		return x.Required
 	}
-	return 0
+	return false
 }
 
-func (x *Resource) GetName() string {
+func (x *ConfigKey) GetType() ConfigValueType {
 	if x != nil {
-		return x.Name
+		return x.Type
 	}
-	return ""
+	return ConfigValueType_CONFIG_VALUE_TYPE_UNSPECIFIED
 }
 
-func (x *Resource) GetType() ResourceType {
+func (x *ConfigKey) GetPattern() string {
 	if x != nil {
-		return x.Type
+		return x.Pattern
 	}
-	return ResourceType_RESOURCE_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *Resource) GetDomains() []*v11.ResourceDomain {
+func (x *ConfigKey) GetDescription() string {
 	if x != nil {
-		return x.Domains
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *Resource) GetRegions() []*RegionConfig {
-	if x != nil {
-		return x.Regions
-	}
-	return nil
+// ScaleToZeroConfig runs a service only while it receives requests. After a quiet period it scales
+// to zero replicas; the next request is held at the gateway while a replica starts. It cannot be
+// combined with scaler triggers.
+type ScaleToZeroConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ScaledownPeriod   int32                  `protobuf:"varint,1,opt,name=scaledown_period,json=scaledownPeriod,proto3" json:"scaledown_period,omitempty"`       // seconds without requests before scaling to zero; defaults to 300
+	TargetConcurrency int32                  `protobuf:"varint,2,opt,name=target_concurrency,json=targetConcurrency,proto3" json:"target_concurrency,omitempty"` // in-flight requests per replica before scaling out; defaults to 100
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Resource) GetStatus() ResourceStatus {
+func (x *ScaleToZeroConfig) Reset() {
+	*x = ScaleToZeroConfig{}
+	mi := &file_resource_v1_resource_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleToZeroConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleToZeroConfig) ProtoMessage() {}
+
+func (x *ScaleToZeroConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[14]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ResourceStatus_RESOURCE_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Resource) GetSpec() *ResourceSpec {
+// Deprecated: Use ScaleToZeroConfig.ProtoReflect.Descriptor instead.
+func (*ScaleToZeroConfig) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ScaleToZeroConfig) GetScaledownPeriod() int32 {
 	if x != nil {
-		return x.Spec
+		return x.ScaledownPeriod
 	}
-	return nil
+	return 0
 }
 
-func (x *Resource) GetSpecVersion() int32 {
+func (x *ScaleToZeroConfig) GetTargetConcurrency() int32 {
 	if x != nil {
-		return x.SpecVersion
+		return x.TargetConcurrency
+	}
+	return 0
+}
+
+// DatabaseSpec is a placeholder for DATABASE type resources (future implementation).
+type DatabaseSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseSpec) Reset() {
+	*x = DatabaseSpec{}
+	mi := &file_resource_v1_resource_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseSpec) ProtoMessage() {}
+
+func (x *DatabaseSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseSpec.ProtoReflect.Descriptor instead.
+func (*DatabaseSpec) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{15}
+}
+
+// CacheSpec is a placeholder for CACHE type resources (future implementation).
+type CacheSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheSpec) Reset() {
+	*x = CacheSpec{}
+	mi := &file_resource_v1_resource_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheSpec) ProtoMessage() {}
+
+func (x *CacheSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheSpec.ProtoReflect.Descriptor instead.
+func (*CacheSpec) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{16}
+}
+
+// QueueSpec is a placeholder for QUEUE type resources (future implementation).
+type QueueSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueSpec) Reset() {
+	*x = QueueSpec{}
+	mi := &file_resource_v1_resource_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueSpec) ProtoMessage() {}
+
+func (x *QueueSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueSpec.ProtoReflect.Descriptor instead.
+func (*QueueSpec) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{17}
+}
+
+// BlobSpec is a placeholder for BLOB type resources (future implementation).
+type BlobSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlobSpec) Reset() {
+	*x = BlobSpec{}
+	mi := &file_resource_v1_resource_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlobSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobSpec) ProtoMessage() {}
+
+func (x *BlobSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobSpec.ProtoReflect.Descriptor instead.
+func (*BlobSpec) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{18}
+}
+
+// ResourceSpec defines the global infrastructure intent for a resource.
+// Uses oneof to support different resource types.
+type ResourceSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Spec:
+	//
+	//	*ResourceSpec_Service
+	//	*ResourceSpec_Database
+	//	*ResourceSpec_Cache
+	//	*ResourceSpec_Queue
+	//	*ResourceSpec_Blob
+	Spec          isResourceSpec_Spec `protobuf_oneof:"spec"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceSpec) Reset() {
+	*x = ResourceSpec{}
+	mi := &file_resource_v1_resource_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceSpec) ProtoMessage() {}
+
+func (x *ResourceSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceSpec.ProtoReflect.Descriptor instead.
+func (*ResourceSpec) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ResourceSpec) GetSpec() isResourceSpec_Spec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ResourceSpec) GetService() *ServiceSpec {
+	if x != nil {
+		if x, ok := x.Spec.(*ResourceSpec_Service); ok {
+			return x.Service
+		}
+	}
+	return nil
+}
+
+func (x *ResourceSpec) GetDatabase() *DatabaseSpec {
+	if x != nil {
+		if x, ok := x.Spec.(*ResourceSpec_Database); ok {
+			return x.Database
+		}
+	}
+	return nil
+}
+
+func (x *ResourceSpec) GetCache() *CacheSpec {
+	if x != nil {
+		if x, ok := x.Spec.(*ResourceSpec_Cache); ok {
+			return x.Cache
+		}
+	}
+	return nil
+}
+
+func (x *ResourceSpec) GetQueue() *QueueSpec {
+	if x != nil {
+		if x, ok := x.Spec.(*ResourceSpec_Queue); ok {
+			return x.Queue
+		}
+	}
+	return nil
+}
+
+func (x *ResourceSpec) GetBlob() *BlobSpec {
+	if x != nil {
+		if x, ok := x.Spec.(*ResourceSpec_Blob); ok {
+			return x.Blob
+		}
+	}
+	return nil
+}
+
+type isResourceSpec_Spec interface {
+	isResourceSpec_Spec()
+}
+
+type ResourceSpec_Service struct {
+	Service *ServiceSpec `protobuf:"bytes,1,opt,name=service,proto3,oneof"`
+}
+
+type ResourceSpec_Database struct {
+	Database *DatabaseSpec `protobuf:"bytes,2,opt,name=database,proto3,oneof"`
+}
+
+type ResourceSpec_Cache struct {
+	Cache *CacheSpec `protobuf:"bytes,3,opt,name=cache,proto3,oneof"`
+}
+
+type ResourceSpec_Queue struct {
+	Queue *QueueSpec `protobuf:"bytes,4,opt,name=queue,proto3,oneof"`
+}
+
+type ResourceSpec_Blob struct {
+	Blob *BlobSpec `protobuf:"bytes,5,opt,name=blob,proto3,oneof"`
+}
+
+func (*ResourceSpec_Service) isResourceSpec_Spec() {}
+
+func (*ResourceSpec_Database) isResourceSpec_Spec() {}
+
+func (*ResourceSpec_Cache) isResourceSpec_Spec() {}
+
+func (*ResourceSpec_Queue) isResourceSpec_Spec() {}
+
+func (*ResourceSpec_Blob) isResourceSpec_Spec() {}
+
+// Resource represents a resource in a workspace.
+type Resource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId   int64                  `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          ResourceType           `protobuf:"varint,4,opt,name=type,proto3,enum=resource.v1.ResourceType" json:"type,omitempty"`
+	Domains       []*v11.ResourceDomain  `protobuf:"bytes,5,rep,name=domains,proto3" json:"domains,omitempty"`
+	Regions       []*RegionConfig        `protobuf:"bytes,6,rep,name=regions,proto3" json:"regions,omitempty"`
+	Status        ResourceStatus         `protobuf:"varint,7,opt,name=status,proto3,enum=resource.v1.ResourceStatus" json:"status,omitempty"`
+	Spec          *ResourceSpec          `protobuf:"bytes,8,opt,name=spec,proto3,oneof" json:"spec,omitempty"`
+	SpecVersion   int32                  `protobuf:"varint,9,opt,name=spec_version,json=specVersion,proto3" json:"spec_version,omitempty"`
+	Description   *string                `protobuf:"bytes,10,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	CreatedBy     int64                  `protobuf:"varint,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	EnvironmentId *int64                 `protobuf:"varint,14,opt,name=environment_id,json=environmentId,proto3,oneof" json:"environment_id,omitempty"`
+	ExternalId    *string                `protobuf:"bytes,15,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,16,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Version       int64                  `protobuf:"varint,17,opt,name=version,proto3" json:"version,omitempty"` // goes up when name, description, spec or labels change
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	mi := &file_resource_v1_resource_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Resource) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Resource) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *Resource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Resource) GetType() ResourceType {
+	if x != nil {
+		return x.Type
+	}
+	return ResourceType_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *Resource) GetDomains() []*v11.ResourceDomain {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *Resource) GetRegions() []*RegionConfig {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *Resource) GetStatus() ResourceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ResourceStatus_RESOURCE_STATUS_UNSPECIFIED
+}
+
+func (x *Resource) GetSpec() *ResourceSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Resource) GetSpecVersion() int32 {
+	if x != nil {
+		return x.SpecVersion
 	}
 	return 0
 }
@@ -1072,56 +1780,2115 @@ func (x *Resource) GetDescription() string {
 	if x != nil && x.Description != nil {
 		return *x.Description
 	}
-	return ""
+	return ""
+}
+
+func (x *Resource) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Resource) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Resource) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Resource) GetEnvironmentId() int64 {
+	if x != nil && x.EnvironmentId != nil {
+		return *x.EnvironmentId
+	}
+	return 0
+}
+
+func (x *Resource) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *Resource) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Resource) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// RegionConfig represents a region deployment intent for a resource.
+type RegionConfig struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Region           string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	IsPrimary        bool                   `protobuf:"varint,2,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	Status           RegionIntentStatus     `protobuf:"varint,3,opt,name=status,proto3,enum=resource.v1.RegionIntentStatus" json:"status,omitempty"`
+	LastError        *string                `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	FailoverPriority int32                  `protobuf:"varint,5,opt,name=failover_priority,json=failoverPriority,proto3" json:"failover_priority,omitempty"` // 0 for the primary; standbys take over in ascending order
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RegionConfig) Reset() {
+	*x = RegionConfig{}
+	mi := &file_resource_v1_resource_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionConfig) ProtoMessage() {}
+
+func (x *RegionConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionConfig.ProtoReflect.Descriptor instead.
+func (*RegionConfig) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RegionConfig) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *RegionConfig) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+func (x *RegionConfig) GetStatus() RegionIntentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RegionIntentStatus_REGION_INTENT_STATUS_UNSPECIFIED
+}
+
+func (x *RegionConfig) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *RegionConfig) GetFailoverPriority() int32 {
+	if x != nil {
+		return x.FailoverPriority
+	}
+	return 0
+}
+
+// CreateResourceRequest is the request to create a new resource.
+type CreateResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          ResourceType           `protobuf:"varint,3,opt,name=type,proto3,enum=resource.v1.ResourceType" json:"type,omitempty"`
+	Domain        *v11.DomainInput       `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	Spec          *ResourceSpec          `protobuf:"bytes,5,opt,name=spec,proto3" json:"spec,omitempty"`
+	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	EnvironmentId *int64                 `protobuf:"varint,7,opt,name=environment_id,json=environmentId,proto3,oneof" json:"environment_id,omitempty"` // must belong to workspace_id
+	// external_id is a caller-chosen ID, unique within the workspace. Creating a resource again
+	// with the same external_id returns the existing resource instead of failing.
+	ExternalId    *string           `protobuf:"bytes,8,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+	Labels        map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResourceRequest) Reset() {
+	*x = CreateResourceRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResourceRequest) ProtoMessage() {}
+
+func (x *CreateResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResourceRequest.ProtoReflect.Descriptor instead.
+func (*CreateResourceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateResourceRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *CreateResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateResourceRequest) GetType() ResourceType {
+	if x != nil {
+		return x.Type
+	}
+	return ResourceType_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateResourceRequest) GetDomain() *v11.DomainInput {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+func (x *CreateResourceRequest) GetSpec() *ResourceSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *CreateResourceRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateResourceRequest) GetEnvironmentId() int64 {
+	if x != nil && x.EnvironmentId != nil {
+		return *x.EnvironmentId
+	}
+	return 0
+}
+
+func (x *CreateResourceRequest) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *CreateResourceRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// CreateResourceResponse is the response containing the created resource.
+type CreateResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Resource      *Resource              `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"` // as stored, so clients need no follow-up read
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResourceResponse) Reset() {
+	*x = CreateResourceResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResourceResponse) ProtoMessage() {}
+
+func (x *CreateResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResourceResponse.ProtoReflect.Descriptor instead.
+func (*CreateResourceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateResourceResponse) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *CreateResourceResponse) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// GetResourceNameKey is used to lookup a resource by name within a workspace.
+type GetResourceNameKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceNameKey) Reset() {
+	*x = GetResourceNameKey{}
+	mi := &file_resource_v1_resource_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceNameKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceNameKey) ProtoMessage() {}
+
+func (x *GetResourceNameKey) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceNameKey.ProtoReflect.Descriptor instead.
+func (*GetResourceNameKey) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetResourceNameKey) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *GetResourceNameKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetResourceExternalKey is used to lookup a resource by external ID within a workspace.
+type GetResourceExternalKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceExternalKey) Reset() {
+	*x = GetResourceExternalKey{}
+	mi := &file_resource_v1_resource_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceExternalKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceExternalKey) ProtoMessage() {}
+
+func (x *GetResourceExternalKey) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceExternalKey.ProtoReflect.Descriptor instead.
+func (*GetResourceExternalKey) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetResourceExternalKey) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *GetResourceExternalKey) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+// GetResourceRequest is the request to retrieve a resource.
+type GetResourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Key:
+	//
+	//	*GetResourceRequest_ResourceId
+	//	*GetResourceRequest_NameKey
+	//	*GetResourceRequest_ExternalKey
+	Key           isGetResourceRequest_Key `protobuf_oneof:"key"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceRequest) Reset() {
+	*x = GetResourceRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceRequest) ProtoMessage() {}
+
+func (x *GetResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetResourceRequest) GetKey() isGetResourceRequest_Key {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *GetResourceRequest) GetResourceId() int64 {
+	if x != nil {
+		if x, ok := x.Key.(*GetResourceRequest_ResourceId); ok {
+			return x.ResourceId
+		}
+	}
+	return 0
+}
+
+func (x *GetResourceRequest) GetNameKey() *GetResourceNameKey {
+	if x != nil {
+		if x, ok := x.Key.(*GetResourceRequest_NameKey); ok {
+			return x.NameKey
+		}
+	}
+	return nil
+}
+
+func (x *GetResourceRequest) GetExternalKey() *GetResourceExternalKey {
+	if x != nil {
+		if x, ok := x.Key.(*GetResourceRequest_ExternalKey); ok {
+			return x.ExternalKey
+		}
+	}
+	return nil
+}
+
+type isGetResourceRequest_Key interface {
+	isGetResourceRequest_Key()
+}
+
+type GetResourceRequest_ResourceId struct {
+	ResourceId int64 `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3,oneof"`
+}
+
+type GetResourceRequest_NameKey struct {
+	NameKey *GetResourceNameKey `protobuf:"bytes,2,opt,name=name_key,json=nameKey,proto3,oneof"`
+}
+
+type GetResourceRequest_ExternalKey struct {
+	ExternalKey *GetResourceExternalKey `protobuf:"bytes,3,opt,name=external_key,json=externalKey,proto3,oneof"`
+}
+
+func (*GetResourceRequest_ResourceId) isGetResourceRequest_Key() {}
+
+func (*GetResourceRequest_NameKey) isGetResourceRequest_Key() {}
+
+func (*GetResourceRequest_ExternalKey) isGetResourceRequest_Key() {}
+
+// GetResourceResponse is the response containing the resource.
+type GetResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceResponse) Reset() {
+	*x = GetResourceResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceResponse) ProtoMessage() {}
+
+func (x *GetResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetResourceResponse) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// ListWorkspaceResourcesRequest is the request to list resources.
+type ListWorkspaceResourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                      // default: 50, max: 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                    // cursor from previous page (base64-encoded timestamp+id)
+	EnvironmentId *int64                 `protobuf:"varint,4,opt,name=environment_id,json=environmentId,proto3,oneof" json:"environment_id,omitempty"` // only list resources in this environment
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspaceResourcesRequest) Reset() {
+	*x = ListWorkspaceResourcesRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspaceResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceResourcesRequest) ProtoMessage() {}
+
+func (x *ListWorkspaceResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListWorkspaceResourcesRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *ListWorkspaceResourcesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWorkspaceResourcesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListWorkspaceResourcesRequest) GetEnvironmentId() int64 {
+	if x != nil && x.EnvironmentId != nil {
+		return *x.EnvironmentId
+	}
+	return 0
+}
+
+// ListWorkspaceResourcesResponse is the response containing the list of resources.
+type ListWorkspaceResourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resources     []*Resource            `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty if no more pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspaceResourcesResponse) Reset() {
+	*x = ListWorkspaceResourcesResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspaceResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceResourcesResponse) ProtoMessage() {}
+
+func (x *ListWorkspaceResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListWorkspaceResourcesResponse) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ListWorkspaceResourcesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdateResourceRequest is the request to update a resource.
+// update_mask lists the fields to change (name, description, spec, labels), so a field in the
+// mask that is unset is cleared. When update_mask is empty, only set fields are changed.
+// Immutable fields such as type, workspace_id and environment_id are rejected. A spec must keep
+// the resource's type and regions; regions are changed with PromoteRegion and ScaleResource.
+type UpdateResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Spec          *ResourceSpec          `protobuf:"bytes,5,opt,name=spec,proto3" json:"spec,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Version       *int64                 `protobuf:"varint,7,opt,name=version,proto3,oneof" json:"version,omitempty"` // the Resource.version read before editing; the update fails if it changed since
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateResourceRequest) Reset() {
+	*x = UpdateResourceRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResourceRequest) ProtoMessage() {}
+
+func (x *UpdateResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResourceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateResourceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateResourceRequest) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *UpdateResourceRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateResourceRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateResourceRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateResourceRequest) GetSpec() *ResourceSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *UpdateResourceRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UpdateResourceRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// UpdateResourceResponse is the response containing the updated resource.
+type UpdateResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Resource      *Resource              `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateResourceResponse) Reset() {
+	*x = UpdateResourceResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResourceResponse) ProtoMessage() {}
+
+func (x *UpdateResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResourceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResourceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateResourceResponse) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *UpdateResourceResponse) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// DeleteResourceRequest is the request to delete a resource.
+type DeleteResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResourceRequest) Reset() {
+	*x = DeleteResourceRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceRequest) ProtoMessage() {}
+
+func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResourceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteResourceRequest) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+// DeleteResourceResponse is the response after deleting a resource.
+type DeleteResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResourceResponse) Reset() {
+	*x = DeleteResourceResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceResponse) ProtoMessage() {}
+
+func (x *DeleteResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResourceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{33}
+}
+
+// RegionInfo represents available region information.
+type RegionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	HealthStatus  string                 `protobuf:"bytes,3,opt,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegionInfo) Reset() {
+	*x = RegionInfo{}
+	mi := &file_resource_v1_resource_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionInfo) ProtoMessage() {}
+
+func (x *RegionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionInfo.ProtoReflect.Descriptor instead.
+func (*RegionInfo) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RegionInfo) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *RegionInfo) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *RegionInfo) GetHealthStatus() string {
+	if x != nil {
+		return x.HealthStatus
+	}
+	return ""
+}
+
+// ListRegionsRequest is the request to list available deployment regions.
+type ListRegionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionsRequest) Reset() {
+	*x = ListRegionsRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsRequest) ProtoMessage() {}
+
+func (x *ListRegionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRegionsRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{35}
+}
+
+// ListRegionsResponse is the response containing available regions.
+type ListRegionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Regions       []*RegionInfo          `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionsResponse) Reset() {
+	*x = ListRegionsResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsResponse) ProtoMessage() {}
+
+func (x *ListRegionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRegionsResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListRegionsResponse) GetRegions() []*RegionInfo {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+// EstimateCostRequest is the request to estimate the cost of a resource spec.
+type EstimateCostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Spec          *ResourceSpec          `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"` // only service specs can be priced
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EstimateCostRequest) Reset() {
+	*x = EstimateCostRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateCostRequest) ProtoMessage() {}
+
+func (x *EstimateCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateCostRequest.ProtoReflect.Descriptor instead.
+func (*EstimateCostRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EstimateCostRequest) GetSpec() *ResourceSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+// RegionCostEstimate is the monthly cost of one region of a spec.
+type RegionCostEstimate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Region          string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	MinReplicas     int32                  `protobuf:"varint,2,opt,name=min_replicas,json=minReplicas,proto3" json:"min_replicas,omitempty"`
+	MaxReplicas     int32                  `protobuf:"varint,3,opt,name=max_replicas,json=maxReplicas,proto3" json:"max_replicas,omitempty"`
+	MinMonthlyCents int64                  `protobuf:"varint,4,opt,name=min_monthly_cents,json=minMonthlyCents,proto3" json:"min_monthly_cents,omitempty"` // at min_replicas
+	MaxMonthlyCents int64                  `protobuf:"varint,5,opt,name=max_monthly_cents,json=maxMonthlyCents,proto3" json:"max_monthly_cents,omitempty"` // at max_replicas
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RegionCostEstimate) Reset() {
+	*x = RegionCostEstimate{}
+	mi := &file_resource_v1_resource_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionCostEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionCostEstimate) ProtoMessage() {}
+
+func (x *RegionCostEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionCostEstimate.ProtoReflect.Descriptor instead.
+func (*RegionCostEstimate) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RegionCostEstimate) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *RegionCostEstimate) GetMinReplicas() int32 {
+	if x != nil {
+		return x.MinReplicas
+	}
+	return 0
+}
+
+func (x *RegionCostEstimate) GetMaxReplicas() int32 {
+	if x != nil {
+		return x.MaxReplicas
+	}
+	return 0
+}
+
+func (x *RegionCostEstimate) GetMinMonthlyCents() int64 {
+	if x != nil {
+		return x.MinMonthlyCents
+	}
+	return 0
+}
+
+func (x *RegionCostEstimate) GetMaxMonthlyCents() int64 {
+	if x != nil {
+		return x.MaxMonthlyCents
+	}
+	return 0
+}
+
+// EstimateCostResponse is the projected monthly cost of a spec across its enabled regions.
+type EstimateCostResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Currency        string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217 code, e.g. "USD"
+	Regions         []*RegionCostEstimate  `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions,omitempty"`   // ordered by region
+	MinMonthlyCents int64                  `protobuf:"varint,3,opt,name=min_monthly_cents,json=minMonthlyCents,proto3" json:"min_monthly_cents,omitempty"`
+	MaxMonthlyCents int64                  `protobuf:"varint,4,opt,name=max_monthly_cents,json=maxMonthlyCents,proto3" json:"max_monthly_cents,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EstimateCostResponse) Reset() {
+	*x = EstimateCostResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateCostResponse) ProtoMessage() {}
+
+func (x *EstimateCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateCostResponse.ProtoReflect.Descriptor instead.
+func (*EstimateCostResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *EstimateCostResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *EstimateCostResponse) GetRegions() []*RegionCostEstimate {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *EstimateCostResponse) GetMinMonthlyCents() int64 {
+	if x != nil {
+		return x.MinMonthlyCents
+	}
+	return 0
+}
+
+func (x *EstimateCostResponse) GetMaxMonthlyCents() int64 {
+	if x != nil {
+		return x.MaxMonthlyCents
+	}
+	return 0
+}
+
+// GetResourceStatusRequest is the request to retrieve resource status.
+type GetResourceStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceStatusRequest) Reset() {
+	*x = GetResourceStatusRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceStatusRequest) ProtoMessage() {}
+
+func (x *GetResourceStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceStatusRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetResourceStatusRequest) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+// DeploymentStatus represents the status of a resource deployment, including phase, replica count, and messages.
+type DeploymentStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status          v1.DeploymentPhase     `protobuf:"varint,2,opt,name=status,proto3,enum=deployment.v1.DeploymentPhase" json:"status,omitempty"`
+	Replicas        int32                  `protobuf:"varint,3,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	Message         *string                `protobuf:"bytes,4,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	Drift           []*DriftedField        `protobuf:"bytes,5,rep,name=drift,proto3" json:"drift,omitempty"` // empty when the cluster matches the deployment spec
+	DriftDetectedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=drift_detected_at,json=driftDetectedAt,proto3,oneof" json:"drift_detected_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeploymentStatus) Reset() {
+	*x = DeploymentStatus{}
+	mi := &file_resource_v1_resource_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeploymentStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentStatus) ProtoMessage() {}
+
+func (x *DeploymentStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentStatus.ProtoReflect.Descriptor instead.
+func (*DeploymentStatus) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeploymentStatus) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeploymentStatus) GetStatus() v1.DeploymentPhase {
+	if x != nil {
+		return x.Status
+	}
+	return v1.DeploymentPhase(0)
+}
+
+func (x *DeploymentStatus) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *DeploymentStatus) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *DeploymentStatus) GetDrift() []*DriftedField {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+func (x *DeploymentStatus) GetDriftDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DriftDetectedAt
+	}
+	return nil
+}
+
+// DriftedField is a single field whose live cluster value differs from the deployment spec.
+type DriftedField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // e.g. "image", "replicas"
+	Expected      string                 `protobuf:"bytes,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual        string                 `protobuf:"bytes,3,opt,name=actual,proto3" json:"actual,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriftedField) Reset() {
+	*x = DriftedField{}
+	mi := &file_resource_v1_resource_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriftedField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftedField) ProtoMessage() {}
+
+func (x *DriftedField) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftedField.ProtoReflect.Descriptor instead.
+func (*DriftedField) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DriftedField) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *DriftedField) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *DriftedField) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+// GetResourceStatusResponse is the response containing resource status information.
+type GetResourceStatusResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Resource          *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	CurrentDeployment *DeploymentStatus      `protobuf:"bytes,2,opt,name=current_deployment,json=currentDeployment,proto3" json:"current_deployment,omitempty"`
+	LatestProbe       *HealthProbe           `protobuf:"bytes,3,opt,name=latest_probe,json=latestProbe,proto3,oneof" json:"latest_probe,omitempty"` // unset until the resource has been probed
+	Domains           []*DomainRoutingStatus `protobuf:"bytes,4,rep,name=domains,proto3" json:"domains,omitempty"`                                  // one per domain of the resource
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetResourceStatusResponse) Reset() {
+	*x = GetResourceStatusResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceStatusResponse) ProtoMessage() {}
+
+func (x *GetResourceStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceStatusResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetResourceStatusResponse) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *GetResourceStatusResponse) GetCurrentDeployment() *DeploymentStatus {
+	if x != nil {
+		return x.CurrentDeployment
+	}
+	return nil
+}
+
+func (x *GetResourceStatusResponse) GetLatestProbe() *HealthProbe {
+	if x != nil {
+		return x.LatestProbe
+	}
+	return nil
+}
+
+func (x *GetResourceStatusResponse) GetDomains() []*DomainRoutingStatus {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+// DomainRoutingStatus is whether traffic for one of a resource's domains reaches it, so a service
+// that deployed but answers 404 shows why.
+type DomainRoutingStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Domain          string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Routed          bool                   `protobuf:"varint,2,opt,name=routed,proto3" json:"routed,omitempty"`                                          // the running deployment serves this domain; others are picked up by the next deployment
+	RouteProgrammed bool                   `protobuf:"varint,3,opt,name=route_programmed,json=routeProgrammed,proto3" json:"route_programmed,omitempty"` // the gateway accepted the route and is serving it
+	DnsResolved     *bool                  `protobuf:"varint,4,opt,name=dns_resolved,json=dnsResolved,proto3,oneof" json:"dns_resolved,omitempty"`       // unset until the domain has been looked up
+	Message         string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`                                         // what traffic is waiting for; empty once it flows
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DomainRoutingStatus) Reset() {
+	*x = DomainRoutingStatus{}
+	mi := &file_resource_v1_resource_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DomainRoutingStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainRoutingStatus) ProtoMessage() {}
+
+func (x *DomainRoutingStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainRoutingStatus.ProtoReflect.Descriptor instead.
+func (*DomainRoutingStatus) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DomainRoutingStatus) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *DomainRoutingStatus) GetRouted() bool {
+	if x != nil {
+		return x.Routed
+	}
+	return false
+}
+
+func (x *DomainRoutingStatus) GetRouteProgrammed() bool {
+	if x != nil {
+		return x.RouteProgrammed
+	}
+	return false
+}
+
+func (x *DomainRoutingStatus) GetDnsResolved() bool {
+	if x != nil && x.DnsResolved != nil {
+		return *x.DnsResolved
+	}
+	return false
+}
+
+func (x *DomainRoutingStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// HealthProbe is the result of requesting a service's health path on its primary domain from outside the cluster.
+type HealthProbe struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Ok            bool                   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 0 when no response was received
+	LatencyMs     int32                  `protobuf:"varint,4,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthProbe) Reset() {
+	*x = HealthProbe{}
+	mi := &file_resource_v1_resource_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthProbe) ProtoMessage() {}
+
+func (x *HealthProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthProbe.ProtoReflect.Descriptor instead.
+func (*HealthProbe) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *HealthProbe) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HealthProbe) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *HealthProbe) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *HealthProbe) GetLatencyMs() int32 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *HealthProbe) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *HealthProbe) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+// WatchResourceStatusRequest is the request to stream a resource's status.
+type WatchResourceStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchResourceStatusRequest) Reset() {
+	*x = WatchResourceStatusRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchResourceStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchResourceStatusRequest) ProtoMessage() {}
+
+func (x *WatchResourceStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchResourceStatusRequest.ProtoReflect.Descriptor instead.
+func (*WatchResourceStatusRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WatchResourceStatusRequest) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+// WatchResourceStatusResponse is the resource's status after a change; the first one is sent right away.
+type WatchResourceStatusResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Resource          *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	CurrentDeployment *DeploymentStatus      `protobuf:"bytes,2,opt,name=current_deployment,json=currentDeployment,proto3" json:"current_deployment,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WatchResourceStatusResponse) Reset() {
+	*x = WatchResourceStatusResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchResourceStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchResourceStatusResponse) ProtoMessage() {}
+
+func (x *WatchResourceStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchResourceStatusResponse.ProtoReflect.Descriptor instead.
+func (*WatchResourceStatusResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *WatchResourceStatusResponse) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *WatchResourceStatusResponse) GetCurrentDeployment() *DeploymentStatus {
+	if x != nil {
+		return x.CurrentDeployment
+	}
+	return nil
+}
+
+// WatchLogsRequest is the request to stream resource logs.
+type WatchLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Follow        *bool                  `protobuf:"varint,3,opt,name=follow,proto3,oneof" json:"follow,omitempty"`
+	Filter        *string                `protobuf:"bytes,4,opt,name=filter,proto3,oneof" json:"filter,omitempty"`                               // only lines matching this expression are sent
+	FilterRegex   *bool                  `protobuf:"varint,5,opt,name=filter_regex,json=filterRegex,proto3,oneof" json:"filter_regex,omitempty"` // treat filter as a regular expression instead of a substring
+	Exclude       *string                `protobuf:"bytes,6,opt,name=exclude,proto3,oneof" json:"exclude,omitempty"`                             // lines matching this expression are dropped (same mode as filter)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchLogsRequest) Reset() {
+	*x = WatchLogsRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchLogsRequest) ProtoMessage() {}
+
+func (x *WatchLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *Resource) GetCreatedBy() int64 {
+// Deprecated: Use WatchLogsRequest.ProtoReflect.Descriptor instead.
+func (*WatchLogsRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *WatchLogsRequest) GetResourceId() int64 {
 	if x != nil {
-		return x.CreatedBy
+		return x.ResourceId
 	}
 	return 0
 }
 
-func (x *Resource) GetCreatedAt() *timestamppb.Timestamp {
+func (x *WatchLogsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *WatchLogsRequest) GetFollow() bool {
+	if x != nil && x.Follow != nil {
+		return *x.Follow
+	}
+	return false
+}
+
+func (x *WatchLogsRequest) GetFilter() string {
+	if x != nil && x.Filter != nil {
+		return *x.Filter
+	}
+	return ""
+}
+
+func (x *WatchLogsRequest) GetFilterRegex() bool {
+	if x != nil && x.FilterRegex != nil {
+		return *x.FilterRegex
+	}
+	return false
+}
+
+func (x *WatchLogsRequest) GetExclude() string {
+	if x != nil && x.Exclude != nil {
+		return *x.Exclude
+	}
+	return ""
+}
+
+// WatchLogsResponse represents a single log line from a pod container within a resource.
+type WatchLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PodName       string                 `protobuf:"bytes,1,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Container     string                 `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Log           string                 `protobuf:"bytes,5,opt,name=log,proto3" json:"log,omitempty"`
+	Level         string                 `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchLogsResponse) Reset() {
+	*x = WatchLogsResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchLogsResponse) ProtoMessage() {}
+
+func (x *WatchLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[49]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchLogsResponse.ProtoReflect.Descriptor instead.
+func (*WatchLogsResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WatchLogsResponse) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *WatchLogsResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WatchLogsResponse) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *WatchLogsResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *WatchLogsResponse) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+func (x *WatchLogsResponse) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+// ObjectReference identifies the Kubernetes object an event is about.
+type ObjectReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // e.g. "Pod", "Deployment", "HorizontalPodAutoscaler", "HTTPRoute"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectReference) Reset() {
+	*x = ObjectReference{}
+	mi := &file_resource_v1_resource_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectReference) ProtoMessage() {}
+
+func (x *ObjectReference) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectReference.ProtoReflect.Descriptor instead.
+func (*ObjectReference) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ObjectReference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ObjectReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ObjectReference) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// Event represents a Kubernetes event related to a resource (e.g., pod created, failed, crash loop, scaled).
+type Event struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Type           string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	PodName        string                 `protobuf:"bytes,5,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"` // set only when the involved object is a pod
+	InvolvedObject *ObjectReference       `protobuf:"bytes,6,opt,name=involved_object,json=involvedObject,proto3" json:"involved_object,omitempty"`
+	Count          int32                  `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`                                     // number of times this event has occurred
+	LastTimestamp  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"` // most recent occurrence
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_resource_v1_resource_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Event) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Event) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Event) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *Event) GetInvolvedObject() *ObjectReference {
+	if x != nil {
+		return x.InvolvedObject
 	}
 	return nil
 }
 
-func (x *Resource) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Event) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Event) GetLastTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.LastTimestamp
 	}
 	return nil
 }
 
-// RegionConfig represents a region deployment intent for a resource.
-type RegionConfig struct {
+// ListResourceEventsRequest is the request to retrieve resource events.
+type ListResourceEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
-	IsPrimary     bool                   `protobuf:"varint,2,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
-	Status        RegionIntentStatus     `protobuf:"varint,3,opt,name=status,proto3,enum=resource.v1.RegionIntentStatus" json:"status,omitempty"`
-	LastError     *string                `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"` // max number of events to return
+	Kinds         []string               `protobuf:"bytes,3,rep,name=kinds,proto3" json:"kinds,omitempty"`        // only events for these involved object kinds; empty returns all kinds
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegionConfig) Reset() {
-	*x = RegionConfig{}
-	mi := &file_resource_v1_resource_proto_msgTypes[13]
+func (x *ListResourceEventsRequest) Reset() {
+	*x = ListResourceEventsRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegionConfig) String() string {
+func (x *ListResourceEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegionConfig) ProtoMessage() {}
+func (*ListResourceEventsRequest) ProtoMessage() {}
 
-func (x *RegionConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[13]
+func (x *ListResourceEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1132,67 +3899,55 @@ func (x *RegionConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegionConfig.ProtoReflect.Descriptor instead.
-func (*RegionConfig) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListResourceEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListResourceEventsRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *RegionConfig) GetRegion() string {
+func (x *ListResourceEventsRequest) GetResourceId() int64 {
 	if x != nil {
-		return x.Region
+		return x.ResourceId
 	}
-	return ""
+	return 0
 }
 
-func (x *RegionConfig) GetIsPrimary() bool {
-	if x != nil {
-		return x.IsPrimary
+func (x *ListResourceEventsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
-	return false
+	return 0
 }
 
-func (x *RegionConfig) GetStatus() RegionIntentStatus {
+func (x *ListResourceEventsRequest) GetKinds() []string {
 	if x != nil {
-		return x.Status
-	}
-	return RegionIntentStatus_REGION_INTENT_STATUS_UNSPECIFIED
-}
-
-func (x *RegionConfig) GetLastError() string {
-	if x != nil && x.LastError != nil {
-		return *x.LastError
+		return x.Kinds
 	}
-	return ""
+	return nil
 }
 
-// CreateResourceRequest is the request to create a new resource.
-type CreateResourceRequest struct {
+// ListResourceEventsResponse is the response containing resource events.
+type ListResourceEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type          ResourceType           `protobuf:"varint,3,opt,name=type,proto3,enum=resource.v1.ResourceType" json:"type,omitempty"`
-	Domain        *v11.DomainInput       `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
-	Spec          *ResourceSpec          `protobuf:"bytes,5,opt,name=spec,proto3" json:"spec,omitempty"`
-	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateResourceRequest) Reset() {
-	*x = CreateResourceRequest{}
-	mi := &file_resource_v1_resource_proto_msgTypes[14]
+func (x *ListResourceEventsResponse) Reset() {
+	*x = ListResourceEventsResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateResourceRequest) String() string {
+func (x *ListResourceEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateResourceRequest) ProtoMessage() {}
+func (*ListResourceEventsResponse) ProtoMessage() {}
 
-func (x *CreateResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[14]
+func (x *ListResourceEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,76 +3958,43 @@ func (x *CreateResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateResourceRequest.ProtoReflect.Descriptor instead.
-func (*CreateResourceRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *CreateResourceRequest) GetWorkspaceId() int64 {
-	if x != nil {
-		return x.WorkspaceId
-	}
-	return 0
-}
-
-func (x *CreateResourceRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateResourceRequest) GetType() ResourceType {
-	if x != nil {
-		return x.Type
-	}
-	return ResourceType_RESOURCE_TYPE_UNSPECIFIED
-}
-
-func (x *CreateResourceRequest) GetDomain() *v11.DomainInput {
-	if x != nil {
-		return x.Domain
-	}
-	return nil
+// Deprecated: Use ListResourceEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListResourceEventsResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *CreateResourceRequest) GetSpec() *ResourceSpec {
+func (x *ListResourceEventsResponse) GetEvents() []*Event {
 	if x != nil {
-		return x.Spec
+		return x.Events
 	}
 	return nil
 }
 
-func (x *CreateResourceRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
-}
-
-// CreateResourceResponse is the response containing the created resource ID.
-type CreateResourceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// StreamEventsRequest is the request to stream resource events.
+type StreamEventsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId      int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	ResourceVersion *string                `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3,oneof" json:"resource_version,omitempty"` // resume after this version (from a previous StreamEventsResponse)
+	Kinds           []string               `protobuf:"bytes,3,rep,name=kinds,proto3" json:"kinds,omitempty"`                                                  // only events for these involved object kinds; empty streams all kinds
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateResourceResponse) Reset() {
-	*x = CreateResourceResponse{}
-	mi := &file_resource_v1_resource_proto_msgTypes[15]
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateResourceResponse) String() string {
+func (x *StreamEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateResourceResponse) ProtoMessage() {}
+func (*StreamEventsRequest) ProtoMessage() {}
 
-func (x *CreateResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[15]
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1283,42 +4005,56 @@ func (x *CreateResourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateResourceResponse.ProtoReflect.Descriptor instead.
-func (*CreateResourceResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *CreateResourceResponse) GetResourceId() int64 {
+func (x *StreamEventsRequest) GetResourceId() int64 {
 	if x != nil {
 		return x.ResourceId
 	}
 	return 0
 }
 
-// GetResourceNameKey is used to lookup a resource by name within a workspace.
-type GetResourceNameKey struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StreamEventsRequest) GetResourceVersion() string {
+	if x != nil && x.ResourceVersion != nil {
+		return *x.ResourceVersion
+	}
+	return ""
 }
 
-func (x *GetResourceNameKey) Reset() {
-	*x = GetResourceNameKey{}
-	mi := &file_resource_v1_resource_proto_msgTypes[16]
+func (x *StreamEventsRequest) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+// StreamEventsResponse carries a single event observed on the resource.
+type StreamEventsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Event           *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	ResourceVersion string                 `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"` // pass back in StreamEventsRequest to resume after reconnecting
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StreamEventsResponse) Reset() {
+	*x = StreamEventsResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResourceNameKey) String() string {
+func (x *StreamEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResourceNameKey) ProtoMessage() {}
+func (*StreamEventsResponse) ProtoMessage() {}
 
-func (x *GetResourceNameKey) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[16]
+func (x *StreamEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1329,52 +4065,55 @@ func (x *GetResourceNameKey) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResourceNameKey.ProtoReflect.Descriptor instead.
-func (*GetResourceNameKey) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use StreamEventsResponse.ProtoReflect.Descriptor instead.
+func (*StreamEventsResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetResourceNameKey) GetWorkspaceId() int64 {
+func (x *StreamEventsResponse) GetEvent() *Event {
 	if x != nil {
-		return x.WorkspaceId
+		return x.Event
 	}
-	return 0
+	return nil
 }
 
-func (x *GetResourceNameKey) GetName() string {
+func (x *StreamEventsResponse) GetResourceVersion() string {
 	if x != nil {
-		return x.Name
+		return x.ResourceVersion
 	}
 	return ""
 }
 
-// GetResourceRequest is the request to retrieve a resource.
-type GetResourceRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Key:
-	//
-	//	*GetResourceRequest_ResourceId
-	//	*GetResourceRequest_NameKey
-	Key           isGetResourceRequest_Key `protobuf_oneof:"key"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// TimelineEntry is one thing that happened to a resource.
+type TimelineEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Kind            TimelineEntryKind      `protobuf:"varint,1,opt,name=kind,proto3,enum=resource.v1.TimelineEntryKind" json:"kind,omitempty"`
+	OccurredAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Summary         string                 `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`                                                                            // one line, e.g. "Scaled to 4 replicas"
+	ActorId         *int64                 `protobuf:"varint,4,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`                                                      // user who made the change; unset for changes the platform made
+	DeploymentId    *int64                 `protobuf:"varint,5,opt,name=deployment_id,json=deploymentId,proto3,oneof" json:"deployment_id,omitempty"`                                       // deployments only
+	DeploymentPhase v1.DeploymentPhase     `protobuf:"varint,6,opt,name=deployment_phase,json=deploymentPhase,proto3,enum=deployment.v1.DeploymentPhase" json:"deployment_phase,omitempty"` // deployments only
+	IncidentStatus  ResourceStatus         `protobuf:"varint,7,opt,name=incident_status,json=incidentStatus,proto3,enum=resource.v1.ResourceStatus" json:"incident_status,omitempty"`       // incidents only
+	EndedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at,omitempty"`                                                       // incidents only; unset while ongoing
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetResourceRequest) Reset() {
-	*x = GetResourceRequest{}
-	mi := &file_resource_v1_resource_proto_msgTypes[17]
+func (x *TimelineEntry) Reset() {
+	*x = TimelineEntry{}
+	mi := &file_resource_v1_resource_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResourceRequest) String() string {
+func (x *TimelineEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResourceRequest) ProtoMessage() {}
+func (*TimelineEntry) ProtoMessage() {}
 
-func (x *GetResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[17]
+func (x *TimelineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,122 +4124,93 @@ func (x *GetResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResourceRequest.ProtoReflect.Descriptor instead.
-func (*GetResourceRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use TimelineEntry.ProtoReflect.Descriptor instead.
+func (*TimelineEntry) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetResourceRequest) GetKey() isGetResourceRequest_Key {
+func (x *TimelineEntry) GetKind() TimelineEntryKind {
 	if x != nil {
-		return x.Key
+		return x.Kind
 	}
-	return nil
+	return TimelineEntryKind_TIMELINE_ENTRY_KIND_UNSPECIFIED
 }
 
-func (x *GetResourceRequest) GetResourceId() int64 {
+func (x *TimelineEntry) GetOccurredAt() *timestamppb.Timestamp {
 	if x != nil {
-		if x, ok := x.Key.(*GetResourceRequest_ResourceId); ok {
-			return x.ResourceId
-		}
+		return x.OccurredAt
 	}
-	return 0
+	return nil
 }
 
-func (x *GetResourceRequest) GetNameKey() *GetResourceNameKey {
+func (x *TimelineEntry) GetSummary() string {
 	if x != nil {
-		if x, ok := x.Key.(*GetResourceRequest_NameKey); ok {
-			return x.NameKey
-		}
+		return x.Summary
 	}
-	return nil
-}
-
-type isGetResourceRequest_Key interface {
-	isGetResourceRequest_Key()
-}
-
-type GetResourceRequest_ResourceId struct {
-	ResourceId int64 `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3,oneof"`
-}
-
-type GetResourceRequest_NameKey struct {
-	NameKey *GetResourceNameKey `protobuf:"bytes,2,opt,name=name_key,json=nameKey,proto3,oneof"`
-}
-
-func (*GetResourceRequest_ResourceId) isGetResourceRequest_Key() {}
-
-func (*GetResourceRequest_NameKey) isGetResourceRequest_Key() {}
-
-// GetResourceResponse is the response containing the resource.
-type GetResourceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetResourceResponse) Reset() {
-	*x = GetResourceResponse{}
-	mi := &file_resource_v1_resource_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TimelineEntry) GetActorId() int64 {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return 0
 }
 
-func (x *GetResourceResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TimelineEntry) GetDeploymentId() int64 {
+	if x != nil && x.DeploymentId != nil {
+		return *x.DeploymentId
+	}
+	return 0
 }
 
-func (*GetResourceResponse) ProtoMessage() {}
-
-func (x *GetResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[18]
+func (x *TimelineEntry) GetDeploymentPhase() v1.DeploymentPhase {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DeploymentPhase
 	}
-	return mi.MessageOf(x)
+	return v1.DeploymentPhase(0)
 }
 
-// Deprecated: Use GetResourceResponse.ProtoReflect.Descriptor instead.
-func (*GetResourceResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{18}
+func (x *TimelineEntry) GetIncidentStatus() ResourceStatus {
+	if x != nil {
+		return x.IncidentStatus
+	}
+	return ResourceStatus_RESOURCE_STATUS_UNSPECIFIED
 }
 
-func (x *GetResourceResponse) GetResource() *Resource {
+func (x *TimelineEntry) GetEndedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Resource
+		return x.EndedAt
 	}
 	return nil
 }
 
-// ListWorkspaceResourcesRequest is the request to list resources.
-type ListWorkspaceResourcesRequest struct {
+// GetResourceTimelineRequest is the request to list a resource's timeline.
+type GetResourceTimelineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // default: 50, max: 200
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // cursor from previous page (base64-encoded timestamp+id)
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                     // default: 50, max: 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                   // cursor from previous page
+	Kinds         []TimelineEntryKind    `protobuf:"varint,4,rep,packed,name=kinds,proto3,enum=resource.v1.TimelineEntryKind" json:"kinds,omitempty"` // empty lists every kind
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWorkspaceResourcesRequest) Reset() {
-	*x = ListWorkspaceResourcesRequest{}
-	mi := &file_resource_v1_resource_proto_msgTypes[19]
+func (x *GetResourceTimelineRequest) Reset() {
+	*x = GetResourceTimelineRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWorkspaceResourcesRequest) String() string {
+func (x *GetResourceTimelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWorkspaceResourcesRequest) ProtoMessage() {}
+func (*GetResourceTimelineRequest) ProtoMessage() {}
 
-func (x *ListWorkspaceResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[19]
+func (x *GetResourceTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1511,56 +4221,63 @@ func (x *ListWorkspaceResourcesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWorkspaceResourcesRequest.ProtoReflect.Descriptor instead.
-func (*ListWorkspaceResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetResourceTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ListWorkspaceResourcesRequest) GetWorkspaceId() int64 {
+func (x *GetResourceTimelineRequest) GetResourceId() int64 {
 	if x != nil {
-		return x.WorkspaceId
+		return x.ResourceId
 	}
 	return 0
 }
 
-func (x *ListWorkspaceResourcesRequest) GetPageSize() int32 {
+func (x *GetResourceTimelineRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListWorkspaceResourcesRequest) GetPageToken() string {
+func (x *GetResourceTimelineRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-// ListWorkspaceResourcesResponse is the response containing the list of resources.
-type ListWorkspaceResourcesResponse struct {
+func (x *GetResourceTimelineRequest) GetKinds() []TimelineEntryKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+// GetResourceTimelineResponse is the response containing a page of a resource's timeline.
+type GetResourceTimelineResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resources     []*Resource            `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	Entries       []*TimelineEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`                                    // newest first
 	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty if no more pages
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWorkspaceResourcesResponse) Reset() {
-	*x = ListWorkspaceResourcesResponse{}
-	mi := &file_resource_v1_resource_proto_msgTypes[20]
+func (x *GetResourceTimelineResponse) Reset() {
+	*x = GetResourceTimelineResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWorkspaceResourcesResponse) String() string {
+func (x *GetResourceTimelineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWorkspaceResourcesResponse) ProtoMessage() {}
+func (*GetResourceTimelineResponse) ProtoMessage() {}
 
-func (x *ListWorkspaceResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[20]
+func (x *GetResourceTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1571,51 +4288,53 @@ func (x *ListWorkspaceResourcesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWorkspaceResourcesResponse.ProtoReflect.Descriptor instead.
-func (*ListWorkspaceResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetResourceTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ListWorkspaceResourcesResponse) GetResources() []*Resource {
+func (x *GetResourceTimelineResponse) GetEntries() []*TimelineEntry {
 	if x != nil {
-		return x.Resources
+		return x.Entries
 	}
 	return nil
 }
 
-func (x *ListWorkspaceResourcesResponse) GetNextPageToken() string {
+func (x *GetResourceTimelineResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-// UpdateResourceRequest is the request to update a resource.
-type UpdateResourceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ScaleResourceRequest is the request to scale a resource.
+type ScaleResourceRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId        int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Replicas          *int32                 `protobuf:"varint,2,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
+	Cpu               *string                `protobuf:"bytes,3,opt,name=cpu,proto3,oneof" json:"cpu,omitempty"`
+	Memory            *string                `protobuf:"bytes,4,opt,name=memory,proto3,oneof" json:"memory,omitempty"`
+	Region            *string                `protobuf:"bytes,5,opt,name=region,proto3,oneof" json:"region,omitempty"`                                                 // if provided, scale only this region; otherwise scale all regions
+	DeploymentVersion *int64                 `protobuf:"varint,6,opt,name=deployment_version,json=deploymentVersion,proto3,oneof" json:"deployment_version,omitempty"` // the Deployment.version of the active deployment read before scaling; the scale fails if it changed since
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *UpdateResourceRequest) Reset() {
-	*x = UpdateResourceRequest{}
-	mi := &file_resource_v1_resource_proto_msgTypes[21]
+func (x *ScaleResourceRequest) Reset() {
+	*x = ScaleResourceRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateResourceRequest) String() string {
+func (x *ScaleResourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateResourceRequest) ProtoMessage() {}
+func (*ScaleResourceRequest) ProtoMessage() {}
 
-func (x *UpdateResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[21]
+func (x *ScaleResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1626,62 +4345,75 @@ func (x *UpdateResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateResourceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateResourceRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ScaleResourceRequest.ProtoReflect.Descriptor instead.
+func (*ScaleResourceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *UpdateResourceRequest) GetResourceId() int64 {
+func (x *ScaleResourceRequest) GetResourceId() int64 {
 	if x != nil {
 		return x.ResourceId
 	}
 	return 0
 }
 
-func (x *UpdateResourceRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
-	if x != nil {
-		return x.UpdateMask
+func (x *ScaleResourceRequest) GetReplicas() int32 {
+	if x != nil && x.Replicas != nil {
+		return *x.Replicas
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateResourceRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *ScaleResourceRequest) GetCpu() string {
+	if x != nil && x.Cpu != nil {
+		return *x.Cpu
 	}
 	return ""
 }
 
-func (x *UpdateResourceRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *ScaleResourceRequest) GetMemory() string {
+	if x != nil && x.Memory != nil {
+		return *x.Memory
 	}
 	return ""
 }
 
-// UpdateResourceResponse is the response containing the updated resource ID.
-type UpdateResourceResponse struct {
+func (x *ScaleResourceRequest) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return ""
+}
+
+func (x *ScaleResourceRequest) GetDeploymentVersion() int64 {
+	if x != nil && x.DeploymentVersion != nil {
+		return *x.DeploymentVersion
+	}
+	return 0
+}
+
+// ScaleResourceResponse is the response after scaling a resource.
+type ScaleResourceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateResourceResponse) Reset() {
-	*x = UpdateResourceResponse{}
-	mi := &file_resource_v1_resource_proto_msgTypes[22]
+func (x *ScaleResourceResponse) Reset() {
+	*x = ScaleResourceResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateResourceResponse) String() string {
+func (x *ScaleResourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateResourceResponse) ProtoMessage() {}
+func (*ScaleResourceResponse) ProtoMessage() {}
 
-func (x *UpdateResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[22]
+func (x *ScaleResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1692,41 +4424,36 @@ func (x *UpdateResourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateResourceResponse.ProtoReflect.Descriptor instead.
-func (*UpdateResourceResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *UpdateResourceResponse) GetResourceId() int64 {
-	if x != nil {
-		return x.ResourceId
-	}
-	return 0
+// Deprecated: Use ScaleResourceResponse.ProtoReflect.Descriptor instead.
+func (*ScaleResourceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{60}
 }
 
-// DeleteResourceRequest is the request to delete a resource.
-type DeleteResourceRequest struct {
+// UpdateResourceEnvRequest is the request to update resource environment variables.
+type UpdateResourceEnvRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Env           map[string]string      `protobuf:"bytes,2,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Region        *string                `protobuf:"bytes,3,opt,name=region,proto3,oneof" json:"region,omitempty"` // if provided, update only this region; otherwise update all regions
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteResourceRequest) Reset() {
-	*x = DeleteResourceRequest{}
-	mi := &file_resource_v1_resource_proto_msgTypes[23]
+func (x *UpdateResourceEnvRequest) Reset() {
+	*x = UpdateResourceEnvRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResourceRequest) String() string {
+func (x *UpdateResourceEnvRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResourceRequest) ProtoMessage() {}
+func (*UpdateResourceEnvRequest) ProtoMessage() {}
 
-func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[23]
+func (x *UpdateResourceEnvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1737,80 +4464,54 @@ func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResourceRequest.ProtoReflect.Descriptor instead.
-func (*DeleteResourceRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UpdateResourceEnvRequest.ProtoReflect.Descriptor instead.
+func (*UpdateResourceEnvRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *DeleteResourceRequest) GetResourceId() int64 {
+func (x *UpdateResourceEnvRequest) GetResourceId() int64 {
 	if x != nil {
 		return x.ResourceId
 	}
 	return 0
 }
 
-// DeleteResourceResponse is the response after deleting a resource.
-type DeleteResourceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteResourceResponse) Reset() {
-	*x = DeleteResourceResponse{}
-	mi := &file_resource_v1_resource_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteResourceResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteResourceResponse) ProtoMessage() {}
-
-func (x *DeleteResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[24]
+func (x *UpdateResourceEnvRequest) GetEnv() map[string]string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Env
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DeleteResourceResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResourceResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{24}
+func (x *UpdateResourceEnvRequest) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return ""
 }
 
-// RegionInfo represents available region information.
-type RegionInfo struct {
+// UpdateResourceEnvResponse is the response after updating resource environment variables.
+type UpdateResourceEnvResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
-	IsDefault     bool                   `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	HealthStatus  string                 `protobuf:"bytes,3,opt,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegionInfo) Reset() {
-	*x = RegionInfo{}
-	mi := &file_resource_v1_resource_proto_msgTypes[25]
+func (x *UpdateResourceEnvResponse) Reset() {
+	*x = UpdateResourceEnvResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegionInfo) String() string {
+func (x *UpdateResourceEnvResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegionInfo) ProtoMessage() {}
+func (*UpdateResourceEnvResponse) ProtoMessage() {}
 
-func (x *RegionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[25]
+func (x *UpdateResourceEnvResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1821,54 +4522,34 @@ func (x *RegionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegionInfo.ProtoReflect.Descriptor instead.
-func (*RegionInfo) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *RegionInfo) GetRegion() string {
-	if x != nil {
-		return x.Region
-	}
-	return ""
-}
-
-func (x *RegionInfo) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
-	}
-	return false
-}
-
-func (x *RegionInfo) GetHealthStatus() string {
-	if x != nil {
-		return x.HealthStatus
-	}
-	return ""
+// Deprecated: Use UpdateResourceEnvResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResourceEnvResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{62}
 }
 
-// ListRegionsRequest is the request to list available deployment regions.
-type ListRegionsRequest struct {
+// RestartResourceRequest is the request to restart a resource's pods.
+type RestartResourceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *ListRegionsRequest) Reset() {
-	*x = ListRegionsRequest{}
-	mi := &file_resource_v1_resource_proto_msgTypes[26]
+
+func (x *RestartResourceRequest) Reset() {
+	*x = RestartResourceRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRegionsRequest) String() string {
+func (x *RestartResourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRegionsRequest) ProtoMessage() {}
+func (*RestartResourceRequest) ProtoMessage() {}
 
-func (x *ListRegionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[26]
+func (x *RestartResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1879,34 +4560,41 @@ func (x *ListRegionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRegionsRequest.ProtoReflect.Descriptor instead.
-func (*ListRegionsRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use RestartResourceRequest.ProtoReflect.Descriptor instead.
+func (*RestartResourceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{63}
 }
 
-// ListRegionsResponse is the response containing available regions.
-type ListRegionsResponse struct {
+func (x *RestartResourceRequest) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+// RestartResourceResponse contains the deployment recording the restart.
+type RestartResourceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Regions       []*RegionInfo          `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
+	DeploymentId  int64                  `protobuf:"varint,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRegionsResponse) Reset() {
-	*x = ListRegionsResponse{}
-	mi := &file_resource_v1_resource_proto_msgTypes[27]
+func (x *RestartResourceResponse) Reset() {
+	*x = RestartResourceResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRegionsResponse) String() string {
+func (x *RestartResourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRegionsResponse) ProtoMessage() {}
+func (*RestartResourceResponse) ProtoMessage() {}
 
-func (x *ListRegionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[27]
+func (x *RestartResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1917,41 +4605,43 @@ func (x *ListRegionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRegionsResponse.ProtoReflect.Descriptor instead.
-func (*ListRegionsResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use RestartResourceResponse.ProtoReflect.Descriptor instead.
+func (*RestartResourceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ListRegionsResponse) GetRegions() []*RegionInfo {
+func (x *RestartResourceResponse) GetDeploymentId() int64 {
 	if x != nil {
-		return x.Regions
+		return x.DeploymentId
 	}
-	return nil
+	return 0
 }
 
-// GetResourceStatusRequest is the request to retrieve resource status.
-type GetResourceStatusRequest struct {
+// PromoteRegionRequest is the request to promote a standby region to primary.
+type PromoteRegionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"` // skip health checks on the target region, e.g. during a regional outage
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetResourceStatusRequest) Reset() {
-	*x = GetResourceStatusRequest{}
-	mi := &file_resource_v1_resource_proto_msgTypes[28]
+func (x *PromoteRegionRequest) Reset() {
+	*x = PromoteRegionRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResourceStatusRequest) String() string {
+func (x *PromoteRegionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResourceStatusRequest) ProtoMessage() {}
+func (*PromoteRegionRequest) ProtoMessage() {}
 
-func (x *GetResourceStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[28]
+func (x *PromoteRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1962,44 +4652,55 @@ func (x *GetResourceStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResourceStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetResourceStatusRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use PromoteRegionRequest.ProtoReflect.Descriptor instead.
+func (*PromoteRegionRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetResourceStatusRequest) GetResourceId() int64 {
+func (x *PromoteRegionRequest) GetResourceId() int64 {
 	if x != nil {
 		return x.ResourceId
 	}
 	return 0
 }
 
-// DeploymentStatus represents the status of a resource deployment, including phase, replica count, and messages.
-type DeploymentStatus struct {
+func (x *PromoteRegionRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *PromoteRegionRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// PromoteRegionResponse is the response containing the resource's regions in their new failover order.
+type PromoteRegionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        v1.DeploymentPhase     `protobuf:"varint,2,opt,name=status,proto3,enum=deployment.v1.DeploymentPhase" json:"status,omitempty"`
-	Replicas      int32                  `protobuf:"varint,3,opt,name=replicas,proto3" json:"replicas,omitempty"`
-	Message       *string                `protobuf:"bytes,4,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	Regions       []*RegionConfig        `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeploymentStatus) Reset() {
-	*x = DeploymentStatus{}
-	mi := &file_resource_v1_resource_proto_msgTypes[29]
+func (x *PromoteRegionResponse) Reset() {
+	*x = PromoteRegionResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeploymentStatus) String() string {
+func (x *PromoteRegionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeploymentStatus) ProtoMessage() {}
+func (*PromoteRegionResponse) ProtoMessage() {}
 
-func (x *DeploymentStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[29]
+func (x *PromoteRegionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2010,63 +4711,94 @@ func (x *DeploymentStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeploymentStatus.ProtoReflect.Descriptor instead.
-func (*DeploymentStatus) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use PromoteRegionResponse.ProtoReflect.Descriptor instead.
+func (*PromoteRegionResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *DeploymentStatus) GetId() int64 {
+func (x *PromoteRegionResponse) GetRegions() []*RegionConfig {
 	if x != nil {
-		return x.Id
+		return x.Regions
 	}
-	return 0
+	return nil
 }
 
-func (x *DeploymentStatus) GetStatus() v1.DeploymentPhase {
+// SuspendResourceRequest is the request to suspend a resource.
+type SuspendResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Message       *string                `protobuf:"bytes,2,opt,name=message,proto3,oneof" json:"message,omitempty"` // shown on the maintenance page; a default is used if omitted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendResourceRequest) Reset() {
+	*x = SuspendResourceRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendResourceRequest) ProtoMessage() {}
+
+func (x *SuspendResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[67]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return v1.DeploymentPhase(0)
+	return mi.MessageOf(x)
 }
 
-func (x *DeploymentStatus) GetReplicas() int32 {
+// Deprecated: Use SuspendResourceRequest.ProtoReflect.Descriptor instead.
+func (*SuspendResourceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SuspendResourceRequest) GetResourceId() int64 {
 	if x != nil {
-		return x.Replicas
+		return x.ResourceId
 	}
 	return 0
 }
 
-func (x *DeploymentStatus) GetMessage() string {
+func (x *SuspendResourceRequest) GetMessage() string {
 	if x != nil && x.Message != nil {
 		return *x.Message
 	}
 	return ""
 }
 
-// GetResourceStatusResponse is the response containing resource status information.
-type GetResourceStatusResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Resource          *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	CurrentDeployment *DeploymentStatus      `protobuf:"bytes,2,opt,name=current_deployment,json=currentDeployment,proto3" json:"current_deployment,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// SuspendResourceResponse is the response after suspending a resource.
+type SuspendResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetResourceStatusResponse) Reset() {
-	*x = GetResourceStatusResponse{}
-	mi := &file_resource_v1_resource_proto_msgTypes[30]
+func (x *SuspendResourceResponse) Reset() {
+	*x = SuspendResourceResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResourceStatusResponse) String() string {
+func (x *SuspendResourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResourceStatusResponse) ProtoMessage() {}
+func (*SuspendResourceResponse) ProtoMessage() {}
 
-func (x *GetResourceStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[30]
+func (x *SuspendResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2077,50 +4809,41 @@ func (x *GetResourceStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResourceStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetResourceStatusResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use SuspendResourceResponse.ProtoReflect.Descriptor instead.
+func (*SuspendResourceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetResourceStatusResponse) GetResource() *Resource {
+func (x *SuspendResourceResponse) GetResource() *Resource {
 	if x != nil {
 		return x.Resource
 	}
 	return nil
 }
 
-func (x *GetResourceStatusResponse) GetCurrentDeployment() *DeploymentStatus {
-	if x != nil {
-		return x.CurrentDeployment
-	}
-	return nil
-}
-
-// WatchLogsRequest is the request to stream resource logs.
-type WatchLogsRequest struct {
+// ResumeResourceRequest is the request to resume a suspended resource.
+type ResumeResourceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Follow        *bool                  `protobuf:"varint,3,opt,name=follow,proto3,oneof" json:"follow,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WatchLogsRequest) Reset() {
-	*x = WatchLogsRequest{}
-	mi := &file_resource_v1_resource_proto_msgTypes[31]
+func (x *ResumeResourceRequest) Reset() {
+	*x = ResumeResourceRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WatchLogsRequest) String() string {
+func (x *ResumeResourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchLogsRequest) ProtoMessage() {}
+func (*ResumeResourceRequest) ProtoMessage() {}
 
-func (x *WatchLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[31]
+func (x *ResumeResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2131,60 +4854,90 @@ func (x *WatchLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchLogsRequest.ProtoReflect.Descriptor instead.
-func (*WatchLogsRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ResumeResourceRequest.ProtoReflect.Descriptor instead.
+func (*ResumeResourceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *WatchLogsRequest) GetResourceId() int64 {
+func (x *ResumeResourceRequest) GetResourceId() int64 {
 	if x != nil {
 		return x.ResourceId
 	}
 	return 0
 }
 
-func (x *WatchLogsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+// ResumeResourceResponse is the response after resuming a resource.
+type ResumeResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeResourceResponse) Reset() {
+	*x = ResumeResourceResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeResourceResponse) ProtoMessage() {}
+
+func (x *ResumeResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *WatchLogsRequest) GetFollow() bool {
-	if x != nil && x.Follow != nil {
-		return *x.Follow
+// Deprecated: Use ResumeResourceResponse.ProtoReflect.Descriptor instead.
+func (*ResumeResourceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ResumeResourceResponse) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
 	}
-	return false
+	return nil
 }
 
-// WatchLogsResponse represents a single log line from a pod container within a resource.
-type WatchLogsResponse struct {
+// ResourceLock protects a resource from being deleted or scaled to zero.
+type ResourceLock struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PodName       string                 `protobuf:"bytes,1,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Container     string                 `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Log           string                 `protobuf:"bytes,5,opt,name=log,proto3" json:"log,omitempty"`
-	Level         string                 `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	LockedByType  v12.EntityType         `protobuf:"varint,3,opt,name=locked_by_type,json=lockedByType,proto3,enum=token.v1.EntityType" json:"locked_by_type,omitempty"`
+	LockedById    int64                  `protobuf:"varint,4,opt,name=locked_by_id,json=lockedById,proto3" json:"locked_by_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WatchLogsResponse) Reset() {
-	*x = WatchLogsResponse{}
-	mi := &file_resource_v1_resource_proto_msgTypes[32]
+func (x *ResourceLock) Reset() {
+	*x = ResourceLock{}
+	mi := &file_resource_v1_resource_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WatchLogsResponse) String() string {
+func (x *ResourceLock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchLogsResponse) ProtoMessage() {}
+func (*ResourceLock) ProtoMessage() {}
 
-func (x *WatchLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[32]
+func (x *ResourceLock) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2195,80 +4948,70 @@ func (x *WatchLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchLogsResponse.ProtoReflect.Descriptor instead.
-func (*WatchLogsResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *WatchLogsResponse) GetPodName() string {
-	if x != nil {
-		return x.PodName
-	}
-	return ""
+// Deprecated: Use ResourceLock.ProtoReflect.Descriptor instead.
+func (*ResourceLock) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *WatchLogsResponse) GetNamespace() string {
+func (x *ResourceLock) GetResourceId() int64 {
 	if x != nil {
-		return x.Namespace
+		return x.ResourceId
 	}
-	return ""
+	return 0
 }
 
-func (x *WatchLogsResponse) GetContainer() string {
+func (x *ResourceLock) GetReason() string {
 	if x != nil {
-		return x.Container
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *WatchLogsResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *ResourceLock) GetLockedByType() v12.EntityType {
 	if x != nil {
-		return x.Timestamp
+		return x.LockedByType
 	}
-	return nil
+	return v12.EntityType(0)
 }
 
-func (x *WatchLogsResponse) GetLog() string {
+func (x *ResourceLock) GetLockedById() int64 {
 	if x != nil {
-		return x.Log
+		return x.LockedById
 	}
-	return ""
+	return 0
 }
 
-func (x *WatchLogsResponse) GetLevel() string {
+func (x *ResourceLock) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Level
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-// Event represents a Kubernetes event related to a resource (e.g., pod created, failed, crash loop).
-type Event struct {
+// LockResourceRequest is the request to lock a resource.
+type LockResourceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	PodName       string                 `protobuf:"bytes,5,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // shown when an operation is refused
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
-	mi := &file_resource_v1_resource_proto_msgTypes[33]
+func (x *LockResourceRequest) Reset() {
+	*x = LockResourceRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event) String() string {
+func (x *LockResourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*LockResourceRequest) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[33]
+func (x *LockResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2279,70 +5022,93 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use LockResourceRequest.ProtoReflect.Descriptor instead.
+func (*LockResourceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *Event) GetTimestamp() *timestamppb.Timestamp {
+func (x *LockResourceRequest) GetResourceId() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.ResourceId
 	}
-	return nil
+	return 0
+}
+
+func (x *LockResourceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// LockResourceResponse is the response containing the lock.
+type LockResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lock          *ResourceLock          `protobuf:"bytes,1,opt,name=lock,proto3" json:"lock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
+func (x *LockResourceResponse) Reset() {
+	*x = LockResourceResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+func (x *LockResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Event) GetType() string {
+func (*LockResourceResponse) ProtoMessage() {}
+
+func (x *LockResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[73]
 	if x != nil {
-		return x.Type
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Event) GetPodName() string {
+// Deprecated: Use LockResourceResponse.ProtoReflect.Descriptor instead.
+func (*LockResourceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *LockResourceResponse) GetLock() *ResourceLock {
 	if x != nil {
-		return x.PodName
+		return x.Lock
 	}
-	return ""
+	return nil
 }
 
-// ListResourceEventsRequest is the request to retrieve resource events.
-type ListResourceEventsRequest struct {
+// UnlockResourceRequest is the request to unlock a resource.
+type UnlockResourceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"` // max number of events to return
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListResourceEventsRequest) Reset() {
-	*x = ListResourceEventsRequest{}
-	mi := &file_resource_v1_resource_proto_msgTypes[34]
+func (x *UnlockResourceRequest) Reset() {
+	*x = UnlockResourceRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListResourceEventsRequest) String() string {
+func (x *UnlockResourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResourceEventsRequest) ProtoMessage() {}
+func (*UnlockResourceRequest) ProtoMessage() {}
 
-func (x *ListResourceEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[34]
+func (x *UnlockResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2353,48 +5119,40 @@ func (x *ListResourceEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResourceEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListResourceEventsRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use UnlockResourceRequest.ProtoReflect.Descriptor instead.
+func (*UnlockResourceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ListResourceEventsRequest) GetResourceId() int64 {
+func (x *UnlockResourceRequest) GetResourceId() int64 {
 	if x != nil {
 		return x.ResourceId
 	}
 	return 0
 }
 
-func (x *ListResourceEventsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
-	}
-	return 0
-}
-
-// ListResourceEventsResponse is the response containing resource events.
-type ListResourceEventsResponse struct {
+// UnlockResourceResponse is the response after unlocking a resource.
+type UnlockResourceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListResourceEventsResponse) Reset() {
-	*x = ListResourceEventsResponse{}
-	mi := &file_resource_v1_resource_proto_msgTypes[35]
+func (x *UnlockResourceResponse) Reset() {
+	*x = UnlockResourceResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListResourceEventsResponse) String() string {
+func (x *UnlockResourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResourceEventsResponse) ProtoMessage() {}
+func (*UnlockResourceResponse) ProtoMessage() {}
 
-func (x *ListResourceEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[35]
+func (x *UnlockResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2405,45 +5163,40 @@ func (x *ListResourceEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResourceEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListResourceEventsResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *ListResourceEventsResponse) GetEvents() []*Event {
-	if x != nil {
-		return x.Events
-	}
-	return nil
+// Deprecated: Use UnlockResourceResponse.ProtoReflect.Descriptor instead.
+func (*UnlockResourceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{75}
 }
 
-// ScaleResourceRequest is the request to scale a resource.
-type ScaleResourceRequest struct {
+// StatusPage is a resource's public status page, showing its status, uptime and incident history.
+type StatusPage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	Replicas      *int32                 `protobuf:"varint,2,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
-	Cpu           *string                `protobuf:"bytes,3,opt,name=cpu,proto3,oneof" json:"cpu,omitempty"`
-	Memory        *string                `protobuf:"bytes,4,opt,name=memory,proto3,oneof" json:"memory,omitempty"`
-	Region        *string                `protobuf:"bytes,5,opt,name=region,proto3,oneof" json:"region,omitempty"` // if provided, scale only this region; otherwise scale all regions
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"` // the resource name when empty
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,5,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	AccentColor   string                 `protobuf:"bytes,6,opt,name=accent_color,json=accentColor,proto3" json:"accent_color,omitempty"` // #rgb or #rrggbb
+	Url           string                 `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`                                    // where the page is served, status.<primary domain>; empty without a primary domain
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScaleResourceRequest) Reset() {
-	*x = ScaleResourceRequest{}
-	mi := &file_resource_v1_resource_proto_msgTypes[36]
+func (x *StatusPage) Reset() {
+	*x = StatusPage{}
+	mi := &file_resource_v1_resource_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScaleResourceRequest) String() string {
+func (x *StatusPage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScaleResourceRequest) ProtoMessage() {}
+func (*StatusPage) ProtoMessage() {}
 
-func (x *ScaleResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[36]
+func (x *StatusPage) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2454,68 +5207,83 @@ func (x *ScaleResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScaleResourceRequest.ProtoReflect.Descriptor instead.
-func (*ScaleResourceRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use StatusPage.ProtoReflect.Descriptor instead.
+func (*StatusPage) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ScaleResourceRequest) GetResourceId() int64 {
+func (x *StatusPage) GetResourceId() int64 {
 	if x != nil {
 		return x.ResourceId
 	}
 	return 0
 }
 
-func (x *ScaleResourceRequest) GetReplicas() int32 {
-	if x != nil && x.Replicas != nil {
-		return *x.Replicas
+func (x *StatusPage) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
 	}
-	return 0
+	return false
 }
 
-func (x *ScaleResourceRequest) GetCpu() string {
-	if x != nil && x.Cpu != nil {
-		return *x.Cpu
+func (x *StatusPage) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (x *ScaleResourceRequest) GetMemory() string {
-	if x != nil && x.Memory != nil {
-		return *x.Memory
+func (x *StatusPage) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ScaleResourceRequest) GetRegion() string {
-	if x != nil && x.Region != nil {
-		return *x.Region
+func (x *StatusPage) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
 	}
 	return ""
 }
 
-// ScaleResourceResponse is the response after scaling a resource.
-type ScaleResourceResponse struct {
+func (x *StatusPage) GetAccentColor() string {
+	if x != nil {
+		return x.AccentColor
+	}
+	return ""
+}
+
+func (x *StatusPage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// GetStatusPageRequest is the request to get a resource's status page.
+type GetStatusPageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScaleResourceResponse) Reset() {
-	*x = ScaleResourceResponse{}
-	mi := &file_resource_v1_resource_proto_msgTypes[37]
+func (x *GetStatusPageRequest) Reset() {
+	*x = GetStatusPageRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScaleResourceResponse) String() string {
+func (x *GetStatusPageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScaleResourceResponse) ProtoMessage() {}
+func (*GetStatusPageRequest) ProtoMessage() {}
 
-func (x *ScaleResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[37]
+func (x *GetStatusPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2526,36 +5294,91 @@ func (x *ScaleResourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScaleResourceResponse.ProtoReflect.Descriptor instead.
-func (*ScaleResourceResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetStatusPageRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusPageRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{77}
 }
 
-// UpdateResourceEnvRequest is the request to update resource environment variables.
-type UpdateResourceEnvRequest struct {
+func (x *GetStatusPageRequest) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+// GetStatusPageResponse contains the status page.
+type GetStatusPageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusPage    *StatusPage            `protobuf:"bytes,1,opt,name=status_page,json=statusPage,proto3" json:"status_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusPageResponse) Reset() {
+	*x = GetStatusPageResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusPageResponse) ProtoMessage() {}
+
+func (x *GetStatusPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusPageResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusPageResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetStatusPageResponse) GetStatusPage() *StatusPage {
+	if x != nil {
+		return x.StatusPage
+	}
+	return nil
+}
+
+// UpdateStatusPageRequest is the request to change a resource's status page. Unset fields are left as they are.
+type UpdateStatusPageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	Env           map[string]string      `protobuf:"bytes,2,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Region        *string                `protobuf:"bytes,3,opt,name=region,proto3,oneof" json:"region,omitempty"` // if provided, update only this region; otherwise update all regions
+	Enabled       *bool                  `protobuf:"varint,2,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Title         *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	LogoUrl       *string                `protobuf:"bytes,5,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`             // https URL; empty for none
+	AccentColor   *string                `protobuf:"bytes,6,opt,name=accent_color,json=accentColor,proto3,oneof" json:"accent_color,omitempty"` // #rgb or #rrggbb; empty for the default
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateResourceEnvRequest) Reset() {
-	*x = UpdateResourceEnvRequest{}
-	mi := &file_resource_v1_resource_proto_msgTypes[38]
+func (x *UpdateStatusPageRequest) Reset() {
+	*x = UpdateStatusPageRequest{}
+	mi := &file_resource_v1_resource_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateResourceEnvRequest) String() string {
+func (x *UpdateStatusPageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateResourceEnvRequest) ProtoMessage() {}
+func (*UpdateStatusPageRequest) ProtoMessage() {}
 
-func (x *UpdateResourceEnvRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[38]
+func (x *UpdateStatusPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2566,54 +5389,76 @@ func (x *UpdateResourceEnvRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateResourceEnvRequest.ProtoReflect.Descriptor instead.
-func (*UpdateResourceEnvRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use UpdateStatusPageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStatusPageRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *UpdateResourceEnvRequest) GetResourceId() int64 {
+func (x *UpdateStatusPageRequest) GetResourceId() int64 {
 	if x != nil {
 		return x.ResourceId
 	}
 	return 0
 }
 
-func (x *UpdateResourceEnvRequest) GetEnv() map[string]string {
-	if x != nil {
-		return x.Env
+func (x *UpdateStatusPageRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *UpdateResourceEnvRequest) GetRegion() string {
-	if x != nil && x.Region != nil {
-		return *x.Region
+func (x *UpdateStatusPageRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
 	}
 	return ""
 }
 
-// UpdateResourceEnvResponse is the response after updating resource environment variables.
-type UpdateResourceEnvResponse struct {
+func (x *UpdateStatusPageRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateStatusPageRequest) GetLogoUrl() string {
+	if x != nil && x.LogoUrl != nil {
+		return *x.LogoUrl
+	}
+	return ""
+}
+
+func (x *UpdateStatusPageRequest) GetAccentColor() string {
+	if x != nil && x.AccentColor != nil {
+		return *x.AccentColor
+	}
+	return ""
+}
+
+// UpdateStatusPageResponse contains the status page as stored.
+type UpdateStatusPageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusPage    *StatusPage            `protobuf:"bytes,1,opt,name=status_page,json=statusPage,proto3" json:"status_page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateResourceEnvResponse) Reset() {
-	*x = UpdateResourceEnvResponse{}
-	mi := &file_resource_v1_resource_proto_msgTypes[39]
+func (x *UpdateStatusPageResponse) Reset() {
+	*x = UpdateStatusPageResponse{}
+	mi := &file_resource_v1_resource_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateResourceEnvResponse) String() string {
+func (x *UpdateStatusPageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateResourceEnvResponse) ProtoMessage() {}
+func (*UpdateStatusPageResponse) ProtoMessage() {}
 
-func (x *UpdateResourceEnvResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_proto_msgTypes[39]
+func (x *UpdateStatusPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2624,21 +5469,50 @@ func (x *UpdateResourceEnvResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateResourceEnvResponse.ProtoReflect.Descriptor instead.
-func (*UpdateResourceEnvResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use UpdateStatusPageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStatusPageResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UpdateStatusPageResponse) GetStatusPage() *StatusPage {
+	if x != nil {
+		return x.StatusPage
+	}
+	return nil
 }
 
 var File_resource_v1_resource_proto protoreflect.FileDescriptor
 
 const file_resource_v1_resource_proto_rawDesc = "" +
 	"\n" +
-	"\x1aresource/v1/resource.proto\x12\vresource.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1edeployment/v1/deployment.proto\x1a\x16domain/v1/domain.proto\"g\n" +
+	"\x1aresource/v1/resource.proto\x12\vresource.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1edeployment/v1/deployment.proto\x1a\x16domain/v1/domain.proto\x1a\x14token/v1/token.proto\"\xd0\x02\n" +
 	"\rRoutingConfig\x12\x12\n" +
 	"\x04port\x18\x01 \x01(\x05R\x04port\x12\x1f\n" +
 	"\vpath_prefix\x18\x02 \x01(\tR\n" +
 	"pathPrefix\x12!\n" +
-	"\fidle_timeout\x18\x03 \x01(\x05R\vidleTimeout\"t\n" +
+	"\fidle_timeout\x18\x03 \x01(\x05R\vidleTimeout\x12@\n" +
+	"\n" +
+	"error_page\x18\x04 \x01(\v2\x1c.resource.v1.ErrorPageConfigH\x00R\terrorPage\x88\x01\x01\x12\x1a\n" +
+	"\bprotocol\x18\x05 \x01(\tR\bprotocol\x12'\n" +
+	"\x0frequest_timeout\x18\x06 \x01(\x05R\x0erequestTimeout\x12\"\n" +
+	"\rmax_body_size\x18\a \x01(\tR\vmaxBodySize\x12-\n" +
+	"\x05ports\x18\b \x03(\v2\x17.resource.v1.PortConfigR\x05portsB\r\n" +
+	"\v_error_page\"U\n" +
+	"\n" +
+	"PortConfig\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1f\n" +
+	"\vpath_prefix\x18\x03 \x01(\tR\n" +
+	"pathPrefix\"\x9f\x01\n" +
+	"\x0fErrorPageConfig\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
+	"\amessage\x18\x03 \x01(\tH\x01R\amessage\x88\x01\x01\x12\x17\n" +
+	"\x04html\x18\x04 \x01(\tH\x02R\x04html\x88\x01\x01B\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_messageB\a\n" +
+	"\x05_html\"t\n" +
 	"\rLoggingConfig\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12)\n" +
 	"\x10retention_period\x18\x02 \x01(\tR\x0fretentionPeriod\x12\x1e\n" +
@@ -2670,16 +5544,57 @@ const file_resource_v1_resource_proto_rawDesc = "" +
 	"\fmax_replicas\x18\x06 \x01(\x05R\vmaxReplicas\x125\n" +
 	"\ascalers\x18\a \x01(\v2\x16.deployment.v1.ScalersH\x00R\ascalers\x88\x01\x01B\n" +
 	"\n" +
-	"\b_scalers\"\xfe\x02\n" +
+	"\b_scalers\"\xe7\x05\n" +
 	"\vServiceSpec\x124\n" +
 	"\arouting\x18\x01 \x01(\v2\x1a.resource.v1.RoutingConfigR\arouting\x12F\n" +
 	"\robservability\x18\x02 \x01(\v2 .resource.v1.ObservabilityConfigR\robservability\x12?\n" +
 	"\aregions\x18\x03 \x03(\v2%.resource.v1.ServiceSpec.RegionsEntryR\aregions\x12H\n" +
-	"\fhealth_check\x18\x04 \x01(\v2 .deployment.v1.HealthCheckConfigH\x00R\vhealthCheck\x88\x01\x01\x1aU\n" +
+	"\fhealth_check\x18\x04 \x01(\v2 .deployment.v1.HealthCheckConfigH\x00R\vhealthCheck\x88\x01\x01\x12G\n" +
+	"\rscale_to_zero\x18\x05 \x01(\v2\x1e.resource.v1.ScaleToZeroConfigH\x01R\vscaleToZero\x88\x01\x01\x12C\n" +
+	"\rconfig_schema\x18\x06 \x01(\v2\x19.resource.v1.ConfigSchemaH\x02R\fconfigSchema\x88\x01\x01\x12B\n" +
+	"\n" +
+	"scheduling\x18\a \x01(\v2\x1d.resource.v1.SchedulingConfigH\x03R\n" +
+	"scheduling\x88\x01\x01\x12\x18\n" +
+	"\acommand\x18\b \x03(\tR\acommand\x12\x12\n" +
+	"\x04args\x18\t \x03(\tR\x04args\x12$\n" +
+	"\vworking_dir\x18\n" +
+	" \x01(\tH\x04R\n" +
+	"workingDir\x88\x01\x01\x1aU\n" +
 	"\fRegionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
 	"\x05value\x18\x02 \x01(\v2\x19.resource.v1.RegionTargetR\x05value:\x028\x01B\x0f\n" +
-	"\r_health_check\"\x0e\n" +
+	"\r_health_checkB\x10\n" +
+	"\x0e_scale_to_zeroB\x10\n" +
+	"\x0e_config_schemaB\r\n" +
+	"\v_schedulingB\x0e\n" +
+	"\f_working_dir\"\x9b\x02\n" +
+	"\x10SchedulingConfig\x12T\n" +
+	"\rnode_selector\x18\x01 \x03(\v2/.resource.v1.SchedulingConfig.NodeSelectorEntryR\fnodeSelector\x129\n" +
+	"\vtolerations\x18\x02 \x03(\v2\x17.resource.v1.TolerationR\vtolerations\x125\n" +
+	"\x06spread\x18\x03 \x03(\v2\x1d.resource.v1.SpreadConstraintR\x06spread\x1a?\n" +
+	"\x11NodeSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"L\n" +
+	"\n" +
+	"Toleration\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x16\n" +
+	"\x06effect\x18\x03 \x01(\tR\x06effect\"l\n" +
+	"\x10SpreadConstraint\x12!\n" +
+	"\ftopology_key\x18\x01 \x01(\tR\vtopologyKey\x12\x19\n" +
+	"\bmax_skew\x18\x02 \x01(\x05R\amaxSkew\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\":\n" +
+	"\fConfigSchema\x12*\n" +
+	"\x04keys\x18\x01 \x03(\v2\x16.resource.v1.ConfigKeyR\x04keys\"\xa9\x01\n" +
+	"\tConfigKey\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\bR\brequired\x120\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1c.resource.v1.ConfigValueTypeR\x04type\x12\x18\n" +
+	"\apattern\x18\x04 \x01(\tR\apattern\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"m\n" +
+	"\x11ScaleToZeroConfig\x12)\n" +
+	"\x10scaledown_period\x18\x01 \x01(\x05R\x0fscaledownPeriod\x12-\n" +
+	"\x12target_concurrency\x18\x02 \x01(\x05R\x11targetConcurrency\"\x0e\n" +
 	"\fDatabaseSpec\"\v\n" +
 	"\tCacheSpec\"\v\n" +
 	"\tQueueSpec\"\n" +
@@ -2691,7 +5606,7 @@ const file_resource_v1_resource_proto_rawDesc = "" +
 	"\x05cache\x18\x03 \x01(\v2\x16.resource.v1.CacheSpecH\x00R\x05cache\x12.\n" +
 	"\x05queue\x18\x04 \x01(\v2\x16.resource.v1.QueueSpecH\x00R\x05queue\x12+\n" +
 	"\x04blob\x18\x05 \x01(\v2\x15.resource.v1.BlobSpecH\x00R\x04blobB\x06\n" +
-	"\x04spec\"\xcb\x04\n" +
+	"\x04spec\"\xd0\x06\n" +
 	"\bResource\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
 	"\fworkspace_id\x18\x02 \x01(\x03R\vworkspaceId\x12\x12\n" +
@@ -2709,58 +5624,95 @@ const file_resource_v1_resource_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\a\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12*\n" +
+	"\x0eenvironment_id\x18\x0e \x01(\x03H\x02R\renvironmentId\x88\x01\x01\x12$\n" +
+	"\vexternal_id\x18\x0f \x01(\tH\x03R\n" +
+	"externalId\x88\x01\x01\x129\n" +
+	"\x06labels\x18\x10 \x03(\v2!.resource.v1.Resource.LabelsEntryR\x06labels\x12\x18\n" +
+	"\aversion\x18\x11 \x01(\x03R\aversion\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
 	"\x05_specB\x0e\n" +
-	"\f_description\"\xb1\x01\n" +
+	"\f_descriptionB\x11\n" +
+	"\x0f_environment_idB\x0e\n" +
+	"\f_external_id\"\xde\x01\n" +
 	"\fRegionConfig\x12\x16\n" +
 	"\x06region\x18\x01 \x01(\tR\x06region\x12\x1d\n" +
 	"\n" +
 	"is_primary\x18\x02 \x01(\bR\tisPrimary\x127\n" +
 	"\x06status\x18\x03 \x01(\x0e2\x1f.resource.v1.RegionIntentStatusR\x06status\x12\"\n" +
 	"\n" +
-	"last_error\x18\x04 \x01(\tH\x00R\tlastError\x88\x01\x01B\r\n" +
-	"\v_last_error\"\x93\x02\n" +
+	"last_error\x18\x04 \x01(\tH\x00R\tlastError\x88\x01\x01\x12+\n" +
+	"\x11failover_priority\x18\x05 \x01(\x05R\x10failoverPriorityB\r\n" +
+	"\v_last_error\"\x8b\x04\n" +
 	"\x15CreateResourceRequest\x12!\n" +
 	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12-\n" +
 	"\x04type\x18\x03 \x01(\x0e2\x19.resource.v1.ResourceTypeR\x04type\x12.\n" +
 	"\x06domain\x18\x04 \x01(\v2\x16.domain.v1.DomainInputR\x06domain\x12-\n" +
 	"\x04spec\x18\x05 \x01(\v2\x19.resource.v1.ResourceSpecR\x04spec\x12%\n" +
-	"\vdescription\x18\x06 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
-	"\f_description\"9\n" +
+	"\vdescription\x18\x06 \x01(\tH\x00R\vdescription\x88\x01\x01\x12*\n" +
+	"\x0eenvironment_id\x18\a \x01(\x03H\x01R\renvironmentId\x88\x01\x01\x12$\n" +
+	"\vexternal_id\x18\b \x01(\tH\x02R\n" +
+	"externalId\x88\x01\x01\x12F\n" +
+	"\x06labels\x18\t \x03(\v2..resource.v1.CreateResourceRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_descriptionB\x11\n" +
+	"\x0f_environment_idB\x0e\n" +
+	"\f_external_id\"l\n" +
 	"\x16CreateResourceResponse\x12\x1f\n" +
 	"\vresource_id\x18\x01 \x01(\x03R\n" +
-	"resourceId\"K\n" +
+	"resourceId\x121\n" +
+	"\bresource\x18\x02 \x01(\v2\x15.resource.v1.ResourceR\bresource\"K\n" +
 	"\x12GetResourceNameKey\x12!\n" +
 	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"|\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\\\n" +
+	"\x16GetResourceExternalKey\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x1f\n" +
+	"\vexternal_id\x18\x02 \x01(\tR\n" +
+	"externalId\"\xc6\x01\n" +
 	"\x12GetResourceRequest\x12!\n" +
 	"\vresource_id\x18\x01 \x01(\x03H\x00R\n" +
 	"resourceId\x12<\n" +
-	"\bname_key\x18\x02 \x01(\v2\x1f.resource.v1.GetResourceNameKeyH\x00R\anameKeyB\x05\n" +
+	"\bname_key\x18\x02 \x01(\v2\x1f.resource.v1.GetResourceNameKeyH\x00R\anameKey\x12H\n" +
+	"\fexternal_key\x18\x03 \x01(\v2#.resource.v1.GetResourceExternalKeyH\x00R\vexternalKeyB\x05\n" +
 	"\x03key\"H\n" +
 	"\x13GetResourceResponse\x121\n" +
-	"\bresource\x18\x01 \x01(\v2\x15.resource.v1.ResourceR\bresource\"~\n" +
+	"\bresource\x18\x01 \x01(\v2\x15.resource.v1.ResourceR\bresource\"\xbd\x01\n" +
 	"\x1dListWorkspaceResourcesRequest\x12!\n" +
 	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"}\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12*\n" +
+	"\x0eenvironment_id\x18\x04 \x01(\x03H\x00R\renvironmentId\x88\x01\x01B\x11\n" +
+	"\x0f_environment_id\"}\n" +
 	"\x1eListWorkspaceResourcesResponse\x123\n" +
 	"\tresources\x18\x01 \x03(\v2\x15.resource.v1.ResourceR\tresources\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xce\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xab\x03\n" +
 	"\x15UpdateResourceRequest\x12\x1f\n" +
 	"\vresource_id\x18\x01 \x01(\x03R\n" +
 	"resourceId\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\x12\x17\n" +
 	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01B\a\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12-\n" +
+	"\x04spec\x18\x05 \x01(\v2\x19.resource.v1.ResourceSpecR\x04spec\x12F\n" +
+	"\x06labels\x18\x06 \x03(\v2..resource.v1.UpdateResourceRequest.LabelsEntryR\x06labels\x12\x1d\n" +
+	"\aversion\x18\a \x01(\x03H\x02R\aversion\x88\x01\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
-	"\f_description\"9\n" +
+	"\f_descriptionB\n" +
+	"\n" +
+	"\b_version\"l\n" +
 	"\x16UpdateResourceResponse\x12\x1f\n" +
 	"\vresource_id\x18\x01 \x01(\x03R\n" +
-	"resourceId\"8\n" +
+	"resourceId\x121\n" +
+	"\bresource\x18\x02 \x01(\v2\x15.resource.v1.ResourceR\bresource\"8\n" +
 	"\x15DeleteResourceRequest\x12\x1f\n" +
 	"\vresource_id\x18\x01 \x01(\x03R\n" +
 	"resourceId\"\x18\n" +
@@ -2773,58 +5725,153 @@ const file_resource_v1_resource_proto_rawDesc = "" +
 	"\rhealth_status\x18\x03 \x01(\tR\fhealthStatus\"\x14\n" +
 	"\x12ListRegionsRequest\"H\n" +
 	"\x13ListRegionsResponse\x121\n" +
-	"\aregions\x18\x01 \x03(\v2\x17.resource.v1.RegionInfoR\aregions\";\n" +
+	"\aregions\x18\x01 \x03(\v2\x17.resource.v1.RegionInfoR\aregions\"D\n" +
+	"\x13EstimateCostRequest\x12-\n" +
+	"\x04spec\x18\x01 \x01(\v2\x19.resource.v1.ResourceSpecR\x04spec\"\xca\x01\n" +
+	"\x12RegionCostEstimate\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12!\n" +
+	"\fmin_replicas\x18\x02 \x01(\x05R\vminReplicas\x12!\n" +
+	"\fmax_replicas\x18\x03 \x01(\x05R\vmaxReplicas\x12*\n" +
+	"\x11min_monthly_cents\x18\x04 \x01(\x03R\x0fminMonthlyCents\x12*\n" +
+	"\x11max_monthly_cents\x18\x05 \x01(\x03R\x0fmaxMonthlyCents\"\xc5\x01\n" +
+	"\x14EstimateCostResponse\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x129\n" +
+	"\aregions\x18\x02 \x03(\v2\x1f.resource.v1.RegionCostEstimateR\aregions\x12*\n" +
+	"\x11min_monthly_cents\x18\x03 \x01(\x03R\x0fminMonthlyCents\x12*\n" +
+	"\x11max_monthly_cents\x18\x04 \x01(\x03R\x0fmaxMonthlyCents\";\n" +
 	"\x18GetResourceStatusRequest\x12\x1f\n" +
 	"\vresource_id\x18\x01 \x01(\x03R\n" +
-	"resourceId\"\xa1\x01\n" +
+	"resourceId\"\xb5\x02\n" +
 	"\x10DeploymentStatus\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x126\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1e.deployment.v1.DeploymentPhaseR\x06status\x12\x1a\n" +
 	"\breplicas\x18\x03 \x01(\x05R\breplicas\x12\x1d\n" +
-	"\amessage\x18\x04 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
+	"\amessage\x18\x04 \x01(\tH\x00R\amessage\x88\x01\x01\x12/\n" +
+	"\x05drift\x18\x05 \x03(\v2\x19.resource.v1.DriftedFieldR\x05drift\x12K\n" +
+	"\x11drift_detected_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x0fdriftDetectedAt\x88\x01\x01B\n" +
 	"\n" +
-	"\b_message\"\x9c\x01\n" +
+	"\b_messageB\x14\n" +
+	"\x12_drift_detected_at\"X\n" +
+	"\fDriftedField\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1a\n" +
+	"\bexpected\x18\x02 \x01(\tR\bexpected\x12\x16\n" +
+	"\x06actual\x18\x03 \x01(\tR\x06actual\"\xab\x02\n" +
 	"\x19GetResourceStatusResponse\x121\n" +
 	"\bresource\x18\x01 \x01(\v2\x15.resource.v1.ResourceR\bresource\x12L\n" +
-	"\x12current_deployment\x18\x02 \x01(\v2\x1d.resource.v1.DeploymentStatusR\x11currentDeployment\"\x80\x01\n" +
+	"\x12current_deployment\x18\x02 \x01(\v2\x1d.resource.v1.DeploymentStatusR\x11currentDeployment\x12@\n" +
+	"\flatest_probe\x18\x03 \x01(\v2\x18.resource.v1.HealthProbeH\x00R\vlatestProbe\x88\x01\x01\x12:\n" +
+	"\adomains\x18\x04 \x03(\v2 .resource.v1.DomainRoutingStatusR\adomainsB\x0f\n" +
+	"\r_latest_probe\"\xc3\x01\n" +
+	"\x13DomainRoutingStatus\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x16\n" +
+	"\x06routed\x18\x02 \x01(\bR\x06routed\x12)\n" +
+	"\x10route_programmed\x18\x03 \x01(\bR\x0frouteProgrammed\x12&\n" +
+	"\fdns_resolved\x18\x04 \x01(\bH\x00R\vdnsResolved\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessageB\x0f\n" +
+	"\r_dns_resolved\"\xc0\x01\n" +
+	"\vHealthProbe\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\x12\x1f\n" +
+	"\vstatus_code\x18\x03 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x04 \x01(\x05R\tlatencyMs\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"checked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\"=\n" +
+	"\x1aWatchResourceStatusRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\"\x9e\x01\n" +
+	"\x1bWatchResourceStatusResponse\x121\n" +
+	"\bresource\x18\x01 \x01(\v2\x15.resource.v1.ResourceR\bresource\x12L\n" +
+	"\x12current_deployment\x18\x02 \x01(\v2\x1d.resource.v1.DeploymentStatusR\x11currentDeployment\"\x8c\x02\n" +
 	"\x10WatchLogsRequest\x12\x1f\n" +
 	"\vresource_id\x18\x01 \x01(\x03R\n" +
 	"resourceId\x12\x19\n" +
 	"\x05limit\x18\x02 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
-	"\x06follow\x18\x03 \x01(\bH\x01R\x06follow\x88\x01\x01B\b\n" +
+	"\x06follow\x18\x03 \x01(\bH\x01R\x06follow\x88\x01\x01\x12\x1b\n" +
+	"\x06filter\x18\x04 \x01(\tH\x02R\x06filter\x88\x01\x01\x12&\n" +
+	"\ffilter_regex\x18\x05 \x01(\bH\x03R\vfilterRegex\x88\x01\x01\x12\x1d\n" +
+	"\aexclude\x18\x06 \x01(\tH\x04R\aexclude\x88\x01\x01B\b\n" +
 	"\x06_limitB\t\n" +
-	"\a_follow\"\xcc\x01\n" +
+	"\a_followB\t\n" +
+	"\a_filterB\x0f\n" +
+	"\r_filter_regexB\n" +
+	"\n" +
+	"\b_exclude\"\xcc\x01\n" +
 	"\x11WatchLogsResponse\x12\x19\n" +
 	"\bpod_name\x18\x01 \x01(\tR\apodName\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1c\n" +
 	"\tcontainer\x18\x03 \x01(\tR\tcontainer\x128\n" +
 	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x10\n" +
 	"\x03log\x18\x05 \x01(\tR\x03log\x12\x14\n" +
-	"\x05level\x18\x06 \x01(\tR\x05level\"\xa2\x01\n" +
+	"\x05level\x18\x06 \x01(\tR\x05level\"W\n" +
+	"\x0fObjectReference\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\"\xc2\x02\n" +
 	"\x05Event\x128\n" +
 	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12\x12\n" +
 	"\x04type\x18\x04 \x01(\tR\x04type\x12\x19\n" +
-	"\bpod_name\x18\x05 \x01(\tR\apodName\"a\n" +
+	"\bpod_name\x18\x05 \x01(\tR\apodName\x12E\n" +
+	"\x0finvolved_object\x18\x06 \x01(\v2\x1c.resource.v1.ObjectReferenceR\x0einvolvedObject\x12\x14\n" +
+	"\x05count\x18\a \x01(\x05R\x05count\x12A\n" +
+	"\x0elast_timestamp\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"w\n" +
 	"\x19ListResourceEventsRequest\x12\x1f\n" +
 	"\vresource_id\x18\x01 \x01(\x03R\n" +
 	"resourceId\x12\x19\n" +
-	"\x05limit\x18\x02 \x01(\x05H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x05limit\x18\x02 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x14\n" +
+	"\x05kinds\x18\x03 \x03(\tR\x05kindsB\b\n" +
 	"\x06_limit\"H\n" +
 	"\x1aListResourceEventsResponse\x12*\n" +
-	"\x06events\x18\x01 \x03(\v2\x12.resource.v1.EventR\x06events\"\xd4\x01\n" +
+	"\x06events\x18\x01 \x03(\v2\x12.resource.v1.EventR\x06events\"\x91\x01\n" +
+	"\x13StreamEventsRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12.\n" +
+	"\x10resource_version\x18\x02 \x01(\tH\x00R\x0fresourceVersion\x88\x01\x01\x12\x14\n" +
+	"\x05kinds\x18\x03 \x03(\tR\x05kindsB\x13\n" +
+	"\x11_resource_version\"k\n" +
+	"\x14StreamEventsResponse\x12(\n" +
+	"\x05event\x18\x01 \x01(\v2\x12.resource.v1.EventR\x05event\x12)\n" +
+	"\x10resource_version\x18\x02 \x01(\tR\x0fresourceVersion\"\xdd\x03\n" +
+	"\rTimelineEntry\x122\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1e.resource.v1.TimelineEntryKindR\x04kind\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x18\n" +
+	"\asummary\x18\x03 \x01(\tR\asummary\x12\x1e\n" +
+	"\bactor_id\x18\x04 \x01(\x03H\x00R\aactorId\x88\x01\x01\x12(\n" +
+	"\rdeployment_id\x18\x05 \x01(\x03H\x01R\fdeploymentId\x88\x01\x01\x12I\n" +
+	"\x10deployment_phase\x18\x06 \x01(\x0e2\x1e.deployment.v1.DeploymentPhaseR\x0fdeploymentPhase\x12D\n" +
+	"\x0fincident_status\x18\a \x01(\x0e2\x1b.resource.v1.ResourceStatusR\x0eincidentStatus\x12:\n" +
+	"\bended_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x02R\aendedAt\x88\x01\x01B\v\n" +
+	"\t_actor_idB\x10\n" +
+	"\x0e_deployment_idB\v\n" +
+	"\t_ended_at\"\xaf\x01\n" +
+	"\x1aGetResourceTimelineRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x124\n" +
+	"\x05kinds\x18\x04 \x03(\x0e2\x1e.resource.v1.TimelineEntryKindR\x05kinds\"{\n" +
+	"\x1bGetResourceTimelineResponse\x124\n" +
+	"\aentries\x18\x01 \x03(\v2\x1a.resource.v1.TimelineEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9f\x02\n" +
 	"\x14ScaleResourceRequest\x12\x1f\n" +
 	"\vresource_id\x18\x01 \x01(\x03R\n" +
 	"resourceId\x12\x1f\n" +
 	"\breplicas\x18\x02 \x01(\x05H\x00R\breplicas\x88\x01\x01\x12\x15\n" +
 	"\x03cpu\x18\x03 \x01(\tH\x01R\x03cpu\x88\x01\x01\x12\x1b\n" +
 	"\x06memory\x18\x04 \x01(\tH\x02R\x06memory\x88\x01\x01\x12\x1b\n" +
-	"\x06region\x18\x05 \x01(\tH\x03R\x06region\x88\x01\x01B\v\n" +
+	"\x06region\x18\x05 \x01(\tH\x03R\x06region\x88\x01\x01\x122\n" +
+	"\x12deployment_version\x18\x06 \x01(\x03H\x04R\x11deploymentVersion\x88\x01\x01B\v\n" +
 	"\t_replicasB\x06\n" +
 	"\x04_cpuB\t\n" +
 	"\a_memoryB\t\n" +
-	"\a_region\"\x17\n" +
+	"\a_regionB\x15\n" +
+	"\x13_deployment_version\"\x17\n" +
 	"\x15ScaleResourceResponse\"\xdd\x01\n" +
 	"\x18UpdateResourceEnvRequest\x12\x1f\n" +
 	"\vresource_id\x18\x01 \x01(\x03R\n" +
@@ -2835,7 +5882,84 @@ const file_resource_v1_resource_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\t\n" +
 	"\a_region\"\x1b\n" +
-	"\x19UpdateResourceEnvResponse*\xca\x01\n" +
+	"\x19UpdateResourceEnvResponse\"9\n" +
+	"\x16RestartResourceRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\">\n" +
+	"\x17RestartResourceResponse\x12#\n" +
+	"\rdeployment_id\x18\x01 \x01(\x03R\fdeploymentId\"e\n" +
+	"\x14PromoteRegionRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"L\n" +
+	"\x15PromoteRegionResponse\x123\n" +
+	"\aregions\x18\x01 \x03(\v2\x19.resource.v1.RegionConfigR\aregions\"d\n" +
+	"\x16SuspendResourceRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x1d\n" +
+	"\amessage\x18\x02 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
+	"\n" +
+	"\b_message\"L\n" +
+	"\x17SuspendResourceResponse\x121\n" +
+	"\bresource\x18\x01 \x01(\v2\x15.resource.v1.ResourceR\bresource\"8\n" +
+	"\x15ResumeResourceRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\"K\n" +
+	"\x16ResumeResourceResponse\x121\n" +
+	"\bresource\x18\x01 \x01(\v2\x15.resource.v1.ResourceR\bresource\"\xe0\x01\n" +
+	"\fResourceLock\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12:\n" +
+	"\x0elocked_by_type\x18\x03 \x01(\x0e2\x14.token.v1.EntityTypeR\flockedByType\x12 \n" +
+	"\flocked_by_id\x18\x04 \x01(\x03R\n" +
+	"lockedById\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"N\n" +
+	"\x13LockResourceRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"E\n" +
+	"\x14LockResourceResponse\x12-\n" +
+	"\x04lock\x18\x01 \x01(\v2\x19.resource.v1.ResourceLockR\x04lock\"8\n" +
+	"\x15UnlockResourceRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\"\x18\n" +
+	"\x16UnlockResourceResponse\"\xcf\x01\n" +
+	"\n" +
+	"StatusPage\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x19\n" +
+	"\blogo_url\x18\x05 \x01(\tR\alogoUrl\x12!\n" +
+	"\faccent_color\x18\x06 \x01(\tR\vaccentColor\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\"7\n" +
+	"\x14GetStatusPageRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\"Q\n" +
+	"\x15GetStatusPageResponse\x128\n" +
+	"\vstatus_page\x18\x01 \x01(\v2\x17.resource.v1.StatusPageR\n" +
+	"statusPage\"\xa7\x02\n" +
+	"\x17UpdateStatusPageRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x1d\n" +
+	"\aenabled\x18\x02 \x01(\bH\x00R\aenabled\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x01R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01\x12\x1e\n" +
+	"\blogo_url\x18\x05 \x01(\tH\x03R\alogoUrl\x88\x01\x01\x12&\n" +
+	"\faccent_color\x18\x06 \x01(\tH\x04R\vaccentColor\x88\x01\x01B\n" +
+	"\n" +
+	"\b_enabledB\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_logo_urlB\x0f\n" +
+	"\r_accent_color\"T\n" +
+	"\x18UpdateStatusPageResponse\x128\n" +
+	"\vstatus_page\x18\x01 \x01(\v2\x17.resource.v1.StatusPageR\n" +
+	"statusPage*\xca\x01\n" +
 	"\fResourceType\x12\x1d\n" +
 	"\x19RESOURCE_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15RESOURCE_TYPE_SERVICE\x10\x01\x12\x1a\n" +
@@ -2858,19 +5982,45 @@ const file_resource_v1_resource_proto_rawDesc = "" +
 	"\x1bREGION_INTENT_STATUS_ACTIVE\x10\x03\x12!\n" +
 	"\x1dREGION_INTENT_STATUS_DEGRADED\x10\x04\x12!\n" +
 	"\x1dREGION_INTENT_STATUS_REMOVING\x10\x05\x12\x1f\n" +
-	"\x1bREGION_INTENT_STATUS_FAILED\x10\x062\x8e\b\n" +
+	"\x1bREGION_INTENT_STATUS_FAILED\x10\x06*\xc4\x01\n" +
+	"\x0fConfigValueType\x12!\n" +
+	"\x1dCONFIG_VALUE_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18CONFIG_VALUE_TYPE_STRING\x10\x01\x12\x19\n" +
+	"\x15CONFIG_VALUE_TYPE_INT\x10\x02\x12\x1a\n" +
+	"\x16CONFIG_VALUE_TYPE_BOOL\x10\x03\x12\x19\n" +
+	"\x15CONFIG_VALUE_TYPE_URL\x10\x04\x12\x1e\n" +
+	"\x1aCONFIG_VALUE_TYPE_DURATION\x10\x05*\xeb\x01\n" +
+	"\x11TimelineEntryKind\x12#\n" +
+	"\x1fTIMELINE_ENTRY_KIND_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eTIMELINE_ENTRY_KIND_DEPLOYMENT\x10\x01\x12\x1e\n" +
+	"\x1aTIMELINE_ENTRY_KIND_SCALED\x10\x02\x12#\n" +
+	"\x1fTIMELINE_ENTRY_KIND_ENV_CHANGED\x10\x03\x12&\n" +
+	"\"TIMELINE_ENTRY_KIND_DOMAIN_CHANGED\x10\x04\x12 \n" +
+	"\x1cTIMELINE_ENTRY_KIND_INCIDENT\x10\x052\xe8\x10\n" +
 	"\x0fResourceService\x12Y\n" +
 	"\x0eCreateResource\x12\".resource.v1.CreateResourceRequest\x1a#.resource.v1.CreateResourceResponse\x12P\n" +
 	"\vGetResource\x12\x1f.resource.v1.GetResourceRequest\x1a .resource.v1.GetResourceResponse\x12Y\n" +
 	"\x0eUpdateResource\x12\".resource.v1.UpdateResourceRequest\x1a#.resource.v1.UpdateResourceResponse\x12Y\n" +
 	"\x0eDeleteResource\x12\".resource.v1.DeleteResourceRequest\x1a#.resource.v1.DeleteResourceResponse\x12q\n" +
 	"\x16ListWorkspaceResources\x12*.resource.v1.ListWorkspaceResourcesRequest\x1a+.resource.v1.ListWorkspaceResourcesResponse\x12b\n" +
-	"\x11GetResourceStatus\x12%.resource.v1.GetResourceStatusRequest\x1a&.resource.v1.GetResourceStatusResponse\x12P\n" +
-	"\vListRegions\x12\x1f.resource.v1.ListRegionsRequest\x1a .resource.v1.ListRegionsResponse\x12L\n" +
+	"\x11GetResourceStatus\x12%.resource.v1.GetResourceStatusRequest\x1a&.resource.v1.GetResourceStatusResponse\x12j\n" +
+	"\x13WatchResourceStatus\x12'.resource.v1.WatchResourceStatusRequest\x1a(.resource.v1.WatchResourceStatusResponse0\x01\x12P\n" +
+	"\vListRegions\x12\x1f.resource.v1.ListRegionsRequest\x1a .resource.v1.ListRegionsResponse\x12S\n" +
+	"\fEstimateCost\x12 .resource.v1.EstimateCostRequest\x1a!.resource.v1.EstimateCostResponse\x12L\n" +
 	"\tWatchLogs\x12\x1d.resource.v1.WatchLogsRequest\x1a\x1e.resource.v1.WatchLogsResponse0\x01\x12e\n" +
-	"\x12ListResourceEvents\x12&.resource.v1.ListResourceEventsRequest\x1a'.resource.v1.ListResourceEventsResponse\x12V\n" +
+	"\x12ListResourceEvents\x12&.resource.v1.ListResourceEventsRequest\x1a'.resource.v1.ListResourceEventsResponse\x12U\n" +
+	"\fStreamEvents\x12 .resource.v1.StreamEventsRequest\x1a!.resource.v1.StreamEventsResponse0\x01\x12h\n" +
+	"\x13GetResourceTimeline\x12'.resource.v1.GetResourceTimelineRequest\x1a(.resource.v1.GetResourceTimelineResponse\x12V\n" +
 	"\rScaleResource\x12!.resource.v1.ScaleResourceRequest\x1a\".resource.v1.ScaleResourceResponse\x12b\n" +
-	"\x11UpdateResourceEnv\x12%.resource.v1.UpdateResourceEnvRequest\x1a&.resource.v1.UpdateResourceEnvResponseB?Z=github.com/team-loco/loco/shared/proto/resource/v1;resourcev1b\x06proto3"
+	"\x11UpdateResourceEnv\x12%.resource.v1.UpdateResourceEnvRequest\x1a&.resource.v1.UpdateResourceEnvResponse\x12\\\n" +
+	"\x0fRestartResource\x12#.resource.v1.RestartResourceRequest\x1a$.resource.v1.RestartResourceResponse\x12V\n" +
+	"\rPromoteRegion\x12!.resource.v1.PromoteRegionRequest\x1a\".resource.v1.PromoteRegionResponse\x12\\\n" +
+	"\x0fSuspendResource\x12#.resource.v1.SuspendResourceRequest\x1a$.resource.v1.SuspendResourceResponse\x12Y\n" +
+	"\x0eResumeResource\x12\".resource.v1.ResumeResourceRequest\x1a#.resource.v1.ResumeResourceResponse\x12S\n" +
+	"\fLockResource\x12 .resource.v1.LockResourceRequest\x1a!.resource.v1.LockResourceResponse\x12Y\n" +
+	"\x0eUnlockResource\x12\".resource.v1.UnlockResourceRequest\x1a#.resource.v1.UnlockResourceResponse\x12V\n" +
+	"\rGetStatusPage\x12!.resource.v1.GetStatusPageRequest\x1a\".resource.v1.GetStatusPageResponse\x12_\n" +
+	"\x10UpdateStatusPage\x12$.resource.v1.UpdateStatusPageRequest\x1a%.resource.v1.UpdateStatusPageResponseB?Z=github.com/team-loco/loco/shared/proto/resource/v1;resourcev1b\x06proto3"
 
 var (
 	file_resource_v1_resource_proto_rawDescOnce sync.Once
@@ -2884,129 +6034,245 @@ func file_resource_v1_resource_proto_rawDescGZIP() []byte {
 	return file_resource_v1_resource_proto_rawDescData
 }
 
-var file_resource_v1_resource_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_resource_v1_resource_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_resource_v1_resource_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_resource_v1_resource_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
 var file_resource_v1_resource_proto_goTypes = []any{
 	(ResourceType)(0),                      // 0: resource.v1.ResourceType
 	(ResourceStatus)(0),                    // 1: resource.v1.ResourceStatus
 	(RegionIntentStatus)(0),                // 2: resource.v1.RegionIntentStatus
-	(*RoutingConfig)(nil),                  // 3: resource.v1.RoutingConfig
-	(*LoggingConfig)(nil),                  // 4: resource.v1.LoggingConfig
-	(*MetricsConfig)(nil),                  // 5: resource.v1.MetricsConfig
-	(*TracingConfig)(nil),                  // 6: resource.v1.TracingConfig
-	(*ObservabilityConfig)(nil),            // 7: resource.v1.ObservabilityConfig
-	(*RegionTarget)(nil),                   // 8: resource.v1.RegionTarget
-	(*ServiceSpec)(nil),                    // 9: resource.v1.ServiceSpec
-	(*DatabaseSpec)(nil),                   // 10: resource.v1.DatabaseSpec
-	(*CacheSpec)(nil),                      // 11: resource.v1.CacheSpec
-	(*QueueSpec)(nil),                      // 12: resource.v1.QueueSpec
-	(*BlobSpec)(nil),                       // 13: resource.v1.BlobSpec
-	(*ResourceSpec)(nil),                   // 14: resource.v1.ResourceSpec
-	(*Resource)(nil),                       // 15: resource.v1.Resource
-	(*RegionConfig)(nil),                   // 16: resource.v1.RegionConfig
-	(*CreateResourceRequest)(nil),          // 17: resource.v1.CreateResourceRequest
-	(*CreateResourceResponse)(nil),         // 18: resource.v1.CreateResourceResponse
-	(*GetResourceNameKey)(nil),             // 19: resource.v1.GetResourceNameKey
-	(*GetResourceRequest)(nil),             // 20: resource.v1.GetResourceRequest
-	(*GetResourceResponse)(nil),            // 21: resource.v1.GetResourceResponse
-	(*ListWorkspaceResourcesRequest)(nil),  // 22: resource.v1.ListWorkspaceResourcesRequest
-	(*ListWorkspaceResourcesResponse)(nil), // 23: resource.v1.ListWorkspaceResourcesResponse
-	(*UpdateResourceRequest)(nil),          // 24: resource.v1.UpdateResourceRequest
-	(*UpdateResourceResponse)(nil),         // 25: resource.v1.UpdateResourceResponse
-	(*DeleteResourceRequest)(nil),          // 26: resource.v1.DeleteResourceRequest
-	(*DeleteResourceResponse)(nil),         // 27: resource.v1.DeleteResourceResponse
-	(*RegionInfo)(nil),                     // 28: resource.v1.RegionInfo
-	(*ListRegionsRequest)(nil),             // 29: resource.v1.ListRegionsRequest
-	(*ListRegionsResponse)(nil),            // 30: resource.v1.ListRegionsResponse
-	(*GetResourceStatusRequest)(nil),       // 31: resource.v1.GetResourceStatusRequest
-	(*DeploymentStatus)(nil),               // 32: resource.v1.DeploymentStatus
-	(*GetResourceStatusResponse)(nil),      // 33: resource.v1.GetResourceStatusResponse
-	(*WatchLogsRequest)(nil),               // 34: resource.v1.WatchLogsRequest
-	(*WatchLogsResponse)(nil),              // 35: resource.v1.WatchLogsResponse
-	(*Event)(nil),                          // 36: resource.v1.Event
-	(*ListResourceEventsRequest)(nil),      // 37: resource.v1.ListResourceEventsRequest
-	(*ListResourceEventsResponse)(nil),     // 38: resource.v1.ListResourceEventsResponse
-	(*ScaleResourceRequest)(nil),           // 39: resource.v1.ScaleResourceRequest
-	(*ScaleResourceResponse)(nil),          // 40: resource.v1.ScaleResourceResponse
-	(*UpdateResourceEnvRequest)(nil),       // 41: resource.v1.UpdateResourceEnvRequest
-	(*UpdateResourceEnvResponse)(nil),      // 42: resource.v1.UpdateResourceEnvResponse
-	nil,                                    // 43: resource.v1.TracingConfig.TagsEntry
-	nil,                                    // 44: resource.v1.ServiceSpec.RegionsEntry
-	nil,                                    // 45: resource.v1.UpdateResourceEnvRequest.EnvEntry
-	(*v1.Scalers)(nil),                     // 46: deployment.v1.Scalers
-	(*v1.HealthCheckConfig)(nil),           // 47: deployment.v1.HealthCheckConfig
-	(*v11.ResourceDomain)(nil),             // 48: domain.v1.ResourceDomain
-	(*timestamppb.Timestamp)(nil),          // 49: google.protobuf.Timestamp
-	(*v11.DomainInput)(nil),                // 50: domain.v1.DomainInput
-	(*fieldmaskpb.FieldMask)(nil),          // 51: google.protobuf.FieldMask
-	(v1.DeploymentPhase)(0),                // 52: deployment.v1.DeploymentPhase
+	(ConfigValueType)(0),                   // 3: resource.v1.ConfigValueType
+	(TimelineEntryKind)(0),                 // 4: resource.v1.TimelineEntryKind
+	(*RoutingConfig)(nil),                  // 5: resource.v1.RoutingConfig
+	(*PortConfig)(nil),                     // 6: resource.v1.PortConfig
+	(*ErrorPageConfig)(nil),                // 7: resource.v1.ErrorPageConfig
+	(*LoggingConfig)(nil),                  // 8: resource.v1.LoggingConfig
+	(*MetricsConfig)(nil),                  // 9: resource.v1.MetricsConfig
+	(*TracingConfig)(nil),                  // 10: resource.v1.TracingConfig
+	(*ObservabilityConfig)(nil),            // 11: resource.v1.ObservabilityConfig
+	(*RegionTarget)(nil),                   // 12: resource.v1.RegionTarget
+	(*ServiceSpec)(nil),                    // 13: resource.v1.ServiceSpec
+	(*SchedulingConfig)(nil),               // 14: resource.v1.SchedulingConfig
+	(*Toleration)(nil),                     // 15: resource.v1.Toleration
+	(*SpreadConstraint)(nil),               // 16: resource.v1.SpreadConstraint
+	(*ConfigSchema)(nil),                   // 17: resource.v1.ConfigSchema
+	(*ConfigKey)(nil),                      // 18: resource.v1.ConfigKey
+	(*ScaleToZeroConfig)(nil),              // 19: resource.v1.ScaleToZeroConfig
+	(*DatabaseSpec)(nil),                   // 20: resource.v1.DatabaseSpec
+	(*CacheSpec)(nil),                      // 21: resource.v1.CacheSpec
+	(*QueueSpec)(nil),                      // 22: resource.v1.QueueSpec
+	(*BlobSpec)(nil),                       // 23: resource.v1.BlobSpec
+	(*ResourceSpec)(nil),                   // 24: resource.v1.ResourceSpec
+	(*Resource)(nil),                       // 25: resource.v1.Resource
+	(*RegionConfig)(nil),                   // 26: resource.v1.RegionConfig
+	(*CreateResourceRequest)(nil),          // 27: resource.v1.CreateResourceRequest
+	(*CreateResourceResponse)(nil),         // 28: resource.v1.CreateResourceResponse
+	(*GetResourceNameKey)(nil),             // 29: resource.v1.GetResourceNameKey
+	(*GetResourceExternalKey)(nil),         // 30: resource.v1.GetResourceExternalKey
+	(*GetResourceRequest)(nil),             // 31: resource.v1.GetResourceRequest
+	(*GetResourceResponse)(nil),            // 32: resource.v1.GetResourceResponse
+	(*ListWorkspaceResourcesRequest)(nil),  // 33: resource.v1.ListWorkspaceResourcesRequest
+	(*ListWorkspaceResourcesResponse)(nil), // 34: resource.v1.ListWorkspaceResourcesResponse
+	(*UpdateResourceRequest)(nil),          // 35: resource.v1.UpdateResourceRequest
+	(*UpdateResourceResponse)(nil),         // 36: resource.v1.UpdateResourceResponse
+	(*DeleteResourceRequest)(nil),          // 37: resource.v1.DeleteResourceRequest
+	(*DeleteResourceResponse)(nil),         // 38: resource.v1.DeleteResourceResponse
+	(*RegionInfo)(nil),                     // 39: resource.v1.RegionInfo
+	(*ListRegionsRequest)(nil),             // 40: resource.v1.ListRegionsRequest
+	(*ListRegionsResponse)(nil),            // 41: resource.v1.ListRegionsResponse
+	(*EstimateCostRequest)(nil),            // 42: resource.v1.EstimateCostRequest
+	(*RegionCostEstimate)(nil),             // 43: resource.v1.RegionCostEstimate
+	(*EstimateCostResponse)(nil),           // 44: resource.v1.EstimateCostResponse
+	(*GetResourceStatusRequest)(nil),       // 45: resource.v1.GetResourceStatusRequest
+	(*DeploymentStatus)(nil),               // 46: resource.v1.DeploymentStatus
+	(*DriftedField)(nil),                   // 47: resource.v1.DriftedField
+	(*GetResourceStatusResponse)(nil),      // 48: resource.v1.GetResourceStatusResponse
+	(*DomainRoutingStatus)(nil),            // 49: resource.v1.DomainRoutingStatus
+	(*HealthProbe)(nil),                    // 50: resource.v1.HealthProbe
+	(*WatchResourceStatusRequest)(nil),     // 51: resource.v1.WatchResourceStatusRequest
+	(*WatchResourceStatusResponse)(nil),    // 52: resource.v1.WatchResourceStatusResponse
+	(*WatchLogsRequest)(nil),               // 53: resource.v1.WatchLogsRequest
+	(*WatchLogsResponse)(nil),              // 54: resource.v1.WatchLogsResponse
+	(*ObjectReference)(nil),                // 55: resource.v1.ObjectReference
+	(*Event)(nil),                          // 56: resource.v1.Event
+	(*ListResourceEventsRequest)(nil),      // 57: resource.v1.ListResourceEventsRequest
+	(*ListResourceEventsResponse)(nil),     // 58: resource.v1.ListResourceEventsResponse
+	(*StreamEventsRequest)(nil),            // 59: resource.v1.StreamEventsRequest
+	(*StreamEventsResponse)(nil),           // 60: resource.v1.StreamEventsResponse
+	(*TimelineEntry)(nil),                  // 61: resource.v1.TimelineEntry
+	(*GetResourceTimelineRequest)(nil),     // 62: resource.v1.GetResourceTimelineRequest
+	(*GetResourceTimelineResponse)(nil),    // 63: resource.v1.GetResourceTimelineResponse
+	(*ScaleResourceRequest)(nil),           // 64: resource.v1.ScaleResourceRequest
+	(*ScaleResourceResponse)(nil),          // 65: resource.v1.ScaleResourceResponse
+	(*UpdateResourceEnvRequest)(nil),       // 66: resource.v1.UpdateResourceEnvRequest
+	(*UpdateResourceEnvResponse)(nil),      // 67: resource.v1.UpdateResourceEnvResponse
+	(*RestartResourceRequest)(nil),         // 68: resource.v1.RestartResourceRequest
+	(*RestartResourceResponse)(nil),        // 69: resource.v1.RestartResourceResponse
+	(*PromoteRegionRequest)(nil),           // 70: resource.v1.PromoteRegionRequest
+	(*PromoteRegionResponse)(nil),          // 71: resource.v1.PromoteRegionResponse
+	(*SuspendResourceRequest)(nil),         // 72: resource.v1.SuspendResourceRequest
+	(*SuspendResourceResponse)(nil),        // 73: resource.v1.SuspendResourceResponse
+	(*ResumeResourceRequest)(nil),          // 74: resource.v1.ResumeResourceRequest
+	(*ResumeResourceResponse)(nil),         // 75: resource.v1.ResumeResourceResponse
+	(*ResourceLock)(nil),                   // 76: resource.v1.ResourceLock
+	(*LockResourceRequest)(nil),            // 77: resource.v1.LockResourceRequest
+	(*LockResourceResponse)(nil),           // 78: resource.v1.LockResourceResponse
+	(*UnlockResourceRequest)(nil),          // 79: resource.v1.UnlockResourceRequest
+	(*UnlockResourceResponse)(nil),         // 80: resource.v1.UnlockResourceResponse
+	(*StatusPage)(nil),                     // 81: resource.v1.StatusPage
+	(*GetStatusPageRequest)(nil),           // 82: resource.v1.GetStatusPageRequest
+	(*GetStatusPageResponse)(nil),          // 83: resource.v1.GetStatusPageResponse
+	(*UpdateStatusPageRequest)(nil),        // 84: resource.v1.UpdateStatusPageRequest
+	(*UpdateStatusPageResponse)(nil),       // 85: resource.v1.UpdateStatusPageResponse
+	nil,                                    // 86: resource.v1.TracingConfig.TagsEntry
+	nil,                                    // 87: resource.v1.ServiceSpec.RegionsEntry
+	nil,                                    // 88: resource.v1.SchedulingConfig.NodeSelectorEntry
+	nil,                                    // 89: resource.v1.Resource.LabelsEntry
+	nil,                                    // 90: resource.v1.CreateResourceRequest.LabelsEntry
+	nil,                                    // 91: resource.v1.UpdateResourceRequest.LabelsEntry
+	nil,                                    // 92: resource.v1.UpdateResourceEnvRequest.EnvEntry
+	(*v1.Scalers)(nil),                     // 93: deployment.v1.Scalers
+	(*v1.HealthCheckConfig)(nil),           // 94: deployment.v1.HealthCheckConfig
+	(*v11.ResourceDomain)(nil),             // 95: domain.v1.ResourceDomain
+	(*timestamppb.Timestamp)(nil),          // 96: google.protobuf.Timestamp
+	(*v11.DomainInput)(nil),                // 97: domain.v1.DomainInput
+	(*fieldmaskpb.FieldMask)(nil),          // 98: google.protobuf.FieldMask
+	(v1.DeploymentPhase)(0),                // 99: deployment.v1.DeploymentPhase
+	(v12.EntityType)(0),                    // 100: token.v1.EntityType
 }
 var file_resource_v1_resource_proto_depIdxs = []int32{
-	43, // 0: resource.v1.TracingConfig.tags:type_name -> resource.v1.TracingConfig.TagsEntry
-	4,  // 1: resource.v1.ObservabilityConfig.logging:type_name -> resource.v1.LoggingConfig
-	5,  // 2: resource.v1.ObservabilityConfig.metrics:type_name -> resource.v1.MetricsConfig
-	6,  // 3: resource.v1.ObservabilityConfig.tracing:type_name -> resource.v1.TracingConfig
-	46, // 4: resource.v1.RegionTarget.scalers:type_name -> deployment.v1.Scalers
-	3,  // 5: resource.v1.ServiceSpec.routing:type_name -> resource.v1.RoutingConfig
-	7,  // 6: resource.v1.ServiceSpec.observability:type_name -> resource.v1.ObservabilityConfig
-	44, // 7: resource.v1.ServiceSpec.regions:type_name -> resource.v1.ServiceSpec.RegionsEntry
-	47, // 8: resource.v1.ServiceSpec.health_check:type_name -> deployment.v1.HealthCheckConfig
-	9,  // 9: resource.v1.ResourceSpec.service:type_name -> resource.v1.ServiceSpec
-	10, // 10: resource.v1.ResourceSpec.database:type_name -> resource.v1.DatabaseSpec
-	11, // 11: resource.v1.ResourceSpec.cache:type_name -> resource.v1.CacheSpec
-	12, // 12: resource.v1.ResourceSpec.queue:type_name -> resource.v1.QueueSpec
-	13, // 13: resource.v1.ResourceSpec.blob:type_name -> resource.v1.BlobSpec
-	0,  // 14: resource.v1.Resource.type:type_name -> resource.v1.ResourceType
-	48, // 15: resource.v1.Resource.domains:type_name -> domain.v1.ResourceDomain
-	16, // 16: resource.v1.Resource.regions:type_name -> resource.v1.RegionConfig
-	1,  // 17: resource.v1.Resource.status:type_name -> resource.v1.ResourceStatus
-	14, // 18: resource.v1.Resource.spec:type_name -> resource.v1.ResourceSpec
-	49, // 19: resource.v1.Resource.created_at:type_name -> google.protobuf.Timestamp
-	49, // 20: resource.v1.Resource.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 21: resource.v1.RegionConfig.status:type_name -> resource.v1.RegionIntentStatus
-	0,  // 22: resource.v1.CreateResourceRequest.type:type_name -> resource.v1.ResourceType
-	50, // 23: resource.v1.CreateResourceRequest.domain:type_name -> domain.v1.DomainInput
-	14, // 24: resource.v1.CreateResourceRequest.spec:type_name -> resource.v1.ResourceSpec
-	19, // 25: resource.v1.GetResourceRequest.name_key:type_name -> resource.v1.GetResourceNameKey
-	15, // 26: resource.v1.GetResourceResponse.resource:type_name -> resource.v1.Resource
-	15, // 27: resource.v1.ListWorkspaceResourcesResponse.resources:type_name -> resource.v1.Resource
-	51, // 28: resource.v1.UpdateResourceRequest.update_mask:type_name -> google.protobuf.FieldMask
-	28, // 29: resource.v1.ListRegionsResponse.regions:type_name -> resource.v1.RegionInfo
-	52, // 30: resource.v1.DeploymentStatus.status:type_name -> deployment.v1.DeploymentPhase
-	15, // 31: resource.v1.GetResourceStatusResponse.resource:type_name -> resource.v1.Resource
-	32, // 32: resource.v1.GetResourceStatusResponse.current_deployment:type_name -> resource.v1.DeploymentStatus
-	49, // 33: resource.v1.WatchLogsResponse.timestamp:type_name -> google.protobuf.Timestamp
-	49, // 34: resource.v1.Event.timestamp:type_name -> google.protobuf.Timestamp
-	36, // 35: resource.v1.ListResourceEventsResponse.events:type_name -> resource.v1.Event
-	45, // 36: resource.v1.UpdateResourceEnvRequest.env:type_name -> resource.v1.UpdateResourceEnvRequest.EnvEntry
-	8,  // 37: resource.v1.ServiceSpec.RegionsEntry.value:type_name -> resource.v1.RegionTarget
-	17, // 38: resource.v1.ResourceService.CreateResource:input_type -> resource.v1.CreateResourceRequest
-	20, // 39: resource.v1.ResourceService.GetResource:input_type -> resource.v1.GetResourceRequest
-	24, // 40: resource.v1.ResourceService.UpdateResource:input_type -> resource.v1.UpdateResourceRequest
-	26, // 41: resource.v1.ResourceService.DeleteResource:input_type -> resource.v1.DeleteResourceRequest
-	22, // 42: resource.v1.ResourceService.ListWorkspaceResources:input_type -> resource.v1.ListWorkspaceResourcesRequest
-	31, // 43: resource.v1.ResourceService.GetResourceStatus:input_type -> resource.v1.GetResourceStatusRequest
-	29, // 44: resource.v1.ResourceService.ListRegions:input_type -> resource.v1.ListRegionsRequest
-	34, // 45: resource.v1.ResourceService.WatchLogs:input_type -> resource.v1.WatchLogsRequest
-	37, // 46: resource.v1.ResourceService.ListResourceEvents:input_type -> resource.v1.ListResourceEventsRequest
-	39, // 47: resource.v1.ResourceService.ScaleResource:input_type -> resource.v1.ScaleResourceRequest
-	41, // 48: resource.v1.ResourceService.UpdateResourceEnv:input_type -> resource.v1.UpdateResourceEnvRequest
-	18, // 49: resource.v1.ResourceService.CreateResource:output_type -> resource.v1.CreateResourceResponse
-	21, // 50: resource.v1.ResourceService.GetResource:output_type -> resource.v1.GetResourceResponse
-	25, // 51: resource.v1.ResourceService.UpdateResource:output_type -> resource.v1.UpdateResourceResponse
-	27, // 52: resource.v1.ResourceService.DeleteResource:output_type -> resource.v1.DeleteResourceResponse
-	23, // 53: resource.v1.ResourceService.ListWorkspaceResources:output_type -> resource.v1.ListWorkspaceResourcesResponse
-	33, // 54: resource.v1.ResourceService.GetResourceStatus:output_type -> resource.v1.GetResourceStatusResponse
-	30, // 55: resource.v1.ResourceService.ListRegions:output_type -> resource.v1.ListRegionsResponse
-	35, // 56: resource.v1.ResourceService.WatchLogs:output_type -> resource.v1.WatchLogsResponse
-	38, // 57: resource.v1.ResourceService.ListResourceEvents:output_type -> resource.v1.ListResourceEventsResponse
-	40, // 58: resource.v1.ResourceService.ScaleResource:output_type -> resource.v1.ScaleResourceResponse
-	42, // 59: resource.v1.ResourceService.UpdateResourceEnv:output_type -> resource.v1.UpdateResourceEnvResponse
-	49, // [49:60] is the sub-list for method output_type
-	38, // [38:49] is the sub-list for method input_type
-	38, // [38:38] is the sub-list for extension type_name
-	38, // [38:38] is the sub-list for extension extendee
-	0,  // [0:38] is the sub-list for field type_name
+	7,   // 0: resource.v1.RoutingConfig.error_page:type_name -> resource.v1.ErrorPageConfig
+	6,   // 1: resource.v1.RoutingConfig.ports:type_name -> resource.v1.PortConfig
+	86,  // 2: resource.v1.TracingConfig.tags:type_name -> resource.v1.TracingConfig.TagsEntry
+	8,   // 3: resource.v1.ObservabilityConfig.logging:type_name -> resource.v1.LoggingConfig
+	9,   // 4: resource.v1.ObservabilityConfig.metrics:type_name -> resource.v1.MetricsConfig
+	10,  // 5: resource.v1.ObservabilityConfig.tracing:type_name -> resource.v1.TracingConfig
+	93,  // 6: resource.v1.RegionTarget.scalers:type_name -> deployment.v1.Scalers
+	5,   // 7: resource.v1.ServiceSpec.routing:type_name -> resource.v1.RoutingConfig
+	11,  // 8: resource.v1.ServiceSpec.observability:type_name -> resource.v1.ObservabilityConfig
+	87,  // 9: resource.v1.ServiceSpec.regions:type_name -> resource.v1.ServiceSpec.RegionsEntry
+	94,  // 10: resource.v1.ServiceSpec.health_check:type_name -> deployment.v1.HealthCheckConfig
+	19,  // 11: resource.v1.ServiceSpec.scale_to_zero:type_name -> resource.v1.ScaleToZeroConfig
+	17,  // 12: resource.v1.ServiceSpec.config_schema:type_name -> resource.v1.ConfigSchema
+	14,  // 13: resource.v1.ServiceSpec.scheduling:type_name -> resource.v1.SchedulingConfig
+	88,  // 14: resource.v1.SchedulingConfig.node_selector:type_name -> resource.v1.SchedulingConfig.NodeSelectorEntry
+	15,  // 15: resource.v1.SchedulingConfig.tolerations:type_name -> resource.v1.Toleration
+	16,  // 16: resource.v1.SchedulingConfig.spread:type_name -> resource.v1.SpreadConstraint
+	18,  // 17: resource.v1.ConfigSchema.keys:type_name -> resource.v1.ConfigKey
+	3,   // 18: resource.v1.ConfigKey.type:type_name -> resource.v1.ConfigValueType
+	13,  // 19: resource.v1.ResourceSpec.service:type_name -> resource.v1.ServiceSpec
+	20,  // 20: resource.v1.ResourceSpec.database:type_name -> resource.v1.DatabaseSpec
+	21,  // 21: resource.v1.ResourceSpec.cache:type_name -> resource.v1.CacheSpec
+	22,  // 22: resource.v1.ResourceSpec.queue:type_name -> resource.v1.QueueSpec
+	23,  // 23: resource.v1.ResourceSpec.blob:type_name -> resource.v1.BlobSpec
+	0,   // 24: resource.v1.Resource.type:type_name -> resource.v1.ResourceType
+	95,  // 25: resource.v1.Resource.domains:type_name -> domain.v1.ResourceDomain
+	26,  // 26: resource.v1.Resource.regions:type_name -> resource.v1.RegionConfig
+	1,   // 27: resource.v1.Resource.status:type_name -> resource.v1.ResourceStatus
+	24,  // 28: resource.v1.Resource.spec:type_name -> resource.v1.ResourceSpec
+	96,  // 29: resource.v1.Resource.created_at:type_name -> google.protobuf.Timestamp
+	96,  // 30: resource.v1.Resource.updated_at:type_name -> google.protobuf.Timestamp
+	89,  // 31: resource.v1.Resource.labels:type_name -> resource.v1.Resource.LabelsEntry
+	2,   // 32: resource.v1.RegionConfig.status:type_name -> resource.v1.RegionIntentStatus
+	0,   // 33: resource.v1.CreateResourceRequest.type:type_name -> resource.v1.ResourceType
+	97,  // 34: resource.v1.CreateResourceRequest.domain:type_name -> domain.v1.DomainInput
+	24,  // 35: resource.v1.CreateResourceRequest.spec:type_name -> resource.v1.ResourceSpec
+	90,  // 36: resource.v1.CreateResourceRequest.labels:type_name -> resource.v1.CreateResourceRequest.LabelsEntry
+	25,  // 37: resource.v1.CreateResourceResponse.resource:type_name -> resource.v1.Resource
+	29,  // 38: resource.v1.GetResourceRequest.name_key:type_name -> resource.v1.GetResourceNameKey
+	30,  // 39: resource.v1.GetResourceRequest.external_key:type_name -> resource.v1.GetResourceExternalKey
+	25,  // 40: resource.v1.GetResourceResponse.resource:type_name -> resource.v1.Resource
+	25,  // 41: resource.v1.ListWorkspaceResourcesResponse.resources:type_name -> resource.v1.Resource
+	98,  // 42: resource.v1.UpdateResourceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	24,  // 43: resource.v1.UpdateResourceRequest.spec:type_name -> resource.v1.ResourceSpec
+	91,  // 44: resource.v1.UpdateResourceRequest.labels:type_name -> resource.v1.UpdateResourceRequest.LabelsEntry
+	25,  // 45: resource.v1.UpdateResourceResponse.resource:type_name -> resource.v1.Resource
+	39,  // 46: resource.v1.ListRegionsResponse.regions:type_name -> resource.v1.RegionInfo
+	24,  // 47: resource.v1.EstimateCostRequest.spec:type_name -> resource.v1.ResourceSpec
+	43,  // 48: resource.v1.EstimateCostResponse.regions:type_name -> resource.v1.RegionCostEstimate
+	99,  // 49: resource.v1.DeploymentStatus.status:type_name -> deployment.v1.DeploymentPhase
+	47,  // 50: resource.v1.DeploymentStatus.drift:type_name -> resource.v1.DriftedField
+	96,  // 51: resource.v1.DeploymentStatus.drift_detected_at:type_name -> google.protobuf.Timestamp
+	25,  // 52: resource.v1.GetResourceStatusResponse.resource:type_name -> resource.v1.Resource
+	46,  // 53: resource.v1.GetResourceStatusResponse.current_deployment:type_name -> resource.v1.DeploymentStatus
+	50,  // 54: resource.v1.GetResourceStatusResponse.latest_probe:type_name -> resource.v1.HealthProbe
+	49,  // 55: resource.v1.GetResourceStatusResponse.domains:type_name -> resource.v1.DomainRoutingStatus
+	96,  // 56: resource.v1.HealthProbe.checked_at:type_name -> google.protobuf.Timestamp
+	25,  // 57: resource.v1.WatchResourceStatusResponse.resource:type_name -> resource.v1.Resource
+	46,  // 58: resource.v1.WatchResourceStatusResponse.current_deployment:type_name -> resource.v1.DeploymentStatus
+	96,  // 59: resource.v1.WatchLogsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	96,  // 60: resource.v1.Event.timestamp:type_name -> google.protobuf.Timestamp
+	55,  // 61: resource.v1.Event.involved_object:type_name -> resource.v1.ObjectReference
+	96,  // 62: resource.v1.Event.last_timestamp:type_name -> google.protobuf.Timestamp
+	56,  // 63: resource.v1.ListResourceEventsResponse.events:type_name -> resource.v1.Event
+	56,  // 64: resource.v1.StreamEventsResponse.event:type_name -> resource.v1.Event
+	4,   // 65: resource.v1.TimelineEntry.kind:type_name -> resource.v1.TimelineEntryKind
+	96,  // 66: resource.v1.TimelineEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	99,  // 67: resource.v1.TimelineEntry.deployment_phase:type_name -> deployment.v1.DeploymentPhase
+	1,   // 68: resource.v1.TimelineEntry.incident_status:type_name -> resource.v1.ResourceStatus
+	96,  // 69: resource.v1.TimelineEntry.ended_at:type_name -> google.protobuf.Timestamp
+	4,   // 70: resource.v1.GetResourceTimelineRequest.kinds:type_name -> resource.v1.TimelineEntryKind
+	61,  // 71: resource.v1.GetResourceTimelineResponse.entries:type_name -> resource.v1.TimelineEntry
+	92,  // 72: resource.v1.UpdateResourceEnvRequest.env:type_name -> resource.v1.UpdateResourceEnvRequest.EnvEntry
+	26,  // 73: resource.v1.PromoteRegionResponse.regions:type_name -> resource.v1.RegionConfig
+	25,  // 74: resource.v1.SuspendResourceResponse.resource:type_name -> resource.v1.Resource
+	25,  // 75: resource.v1.ResumeResourceResponse.resource:type_name -> resource.v1.Resource
+	100, // 76: resource.v1.ResourceLock.locked_by_type:type_name -> token.v1.EntityType
+	96,  // 77: resource.v1.ResourceLock.created_at:type_name -> google.protobuf.Timestamp
+	76,  // 78: resource.v1.LockResourceResponse.lock:type_name -> resource.v1.ResourceLock
+	81,  // 79: resource.v1.GetStatusPageResponse.status_page:type_name -> resource.v1.StatusPage
+	81,  // 80: resource.v1.UpdateStatusPageResponse.status_page:type_name -> resource.v1.StatusPage
+	12,  // 81: resource.v1.ServiceSpec.RegionsEntry.value:type_name -> resource.v1.RegionTarget
+	27,  // 82: resource.v1.ResourceService.CreateResource:input_type -> resource.v1.CreateResourceRequest
+	31,  // 83: resource.v1.ResourceService.GetResource:input_type -> resource.v1.GetResourceRequest
+	35,  // 84: resource.v1.ResourceService.UpdateResource:input_type -> resource.v1.UpdateResourceRequest
+	37,  // 85: resource.v1.ResourceService.DeleteResource:input_type -> resource.v1.DeleteResourceRequest
+	33,  // 86: resource.v1.ResourceService.ListWorkspaceResources:input_type -> resource.v1.ListWorkspaceResourcesRequest
+	45,  // 87: resource.v1.ResourceService.GetResourceStatus:input_type -> resource.v1.GetResourceStatusRequest
+	51,  // 88: resource.v1.ResourceService.WatchResourceStatus:input_type -> resource.v1.WatchResourceStatusRequest
+	40,  // 89: resource.v1.ResourceService.ListRegions:input_type -> resource.v1.ListRegionsRequest
+	42,  // 90: resource.v1.ResourceService.EstimateCost:input_type -> resource.v1.EstimateCostRequest
+	53,  // 91: resource.v1.ResourceService.WatchLogs:input_type -> resource.v1.WatchLogsRequest
+	57,  // 92: resource.v1.ResourceService.ListResourceEvents:input_type -> resource.v1.ListResourceEventsRequest
+	59,  // 93: resource.v1.ResourceService.StreamEvents:input_type -> resource.v1.StreamEventsRequest
+	62,  // 94: resource.v1.ResourceService.GetResourceTimeline:input_type -> resource.v1.GetResourceTimelineRequest
+	64,  // 95: resource.v1.ResourceService.ScaleResource:input_type -> resource.v1.ScaleResourceRequest
+	66,  // 96: resource.v1.ResourceService.UpdateResourceEnv:input_type -> resource.v1.UpdateResourceEnvRequest
+	68,  // 97: resource.v1.ResourceService.RestartResource:input_type -> resource.v1.RestartResourceRequest
+	70,  // 98: resource.v1.ResourceService.PromoteRegion:input_type -> resource.v1.PromoteRegionRequest
+	72,  // 99: resource.v1.ResourceService.SuspendResource:input_type -> resource.v1.SuspendResourceRequest
+	74,  // 100: resource.v1.ResourceService.ResumeResource:input_type -> resource.v1.ResumeResourceRequest
+	77,  // 101: resource.v1.ResourceService.LockResource:input_type -> resource.v1.LockResourceRequest
+	79,  // 102: resource.v1.ResourceService.UnlockResource:input_type -> resource.v1.UnlockResourceRequest
+	82,  // 103: resource.v1.ResourceService.GetStatusPage:input_type -> resource.v1.GetStatusPageRequest
+	84,  // 104: resource.v1.ResourceService.UpdateStatusPage:input_type -> resource.v1.UpdateStatusPageRequest
+	28,  // 105: resource.v1.ResourceService.CreateResource:output_type -> resource.v1.CreateResourceResponse
+	32,  // 106: resource.v1.ResourceService.GetResource:output_type -> resource.v1.GetResourceResponse
+	36,  // 107: resource.v1.ResourceService.UpdateResource:output_type -> resource.v1.UpdateResourceResponse
+	38,  // 108: resource.v1.ResourceService.DeleteResource:output_type -> resource.v1.DeleteResourceResponse
+	34,  // 109: resource.v1.ResourceService.ListWorkspaceResources:output_type -> resource.v1.ListWorkspaceResourcesResponse
+	48,  // 110: resource.v1.ResourceService.GetResourceStatus:output_type -> resource.v1.GetResourceStatusResponse
+	52,  // 111: resource.v1.ResourceService.WatchResourceStatus:output_type -> resource.v1.WatchResourceStatusResponse
+	41,  // 112: resource.v1.ResourceService.ListRegions:output_type -> resource.v1.ListRegionsResponse
+	44,  // 113: resource.v1.ResourceService.EstimateCost:output_type -> resource.v1.EstimateCostResponse
+	54,  // 114: resource.v1.ResourceService.WatchLogs:output_type -> resource.v1.WatchLogsResponse
+	58,  // 115: resource.v1.ResourceService.ListResourceEvents:output_type -> resource.v1.ListResourceEventsResponse
+	60,  // 116: resource.v1.ResourceService.StreamEvents:output_type -> resource.v1.StreamEventsResponse
+	63,  // 117: resource.v1.ResourceService.GetResourceTimeline:output_type -> resource.v1.GetResourceTimelineResponse
+	65,  // 118: resource.v1.ResourceService.ScaleResource:output_type -> resource.v1.ScaleResourceResponse
+	67,  // 119: resource.v1.ResourceService.UpdateResourceEnv:output_type -> resource.v1.UpdateResourceEnvResponse
+	69,  // 120: resource.v1.ResourceService.RestartResource:output_type -> resource.v1.RestartResourceResponse
+	71,  // 121: resource.v1.ResourceService.PromoteRegion:output_type -> resource.v1.PromoteRegionResponse
+	73,  // 122: resource.v1.ResourceService.SuspendResource:output_type -> resource.v1.SuspendResourceResponse
+	75,  // 123: resource.v1.ResourceService.ResumeResource:output_type -> resource.v1.ResumeResourceResponse
+	78,  // 124: resource.v1.ResourceService.LockResource:output_type -> resource.v1.LockResourceResponse
+	80,  // 125: resource.v1.ResourceService.UnlockResource:output_type -> resource.v1.UnlockResourceResponse
+	83,  // 126: resource.v1.ResourceService.GetStatusPage:output_type -> resource.v1.GetStatusPageResponse
+	85,  // 127: resource.v1.ResourceService.UpdateStatusPage:output_type -> resource.v1.UpdateStatusPageResponse
+	105, // [105:128] is the sub-list for method output_type
+	82,  // [82:105] is the sub-list for method input_type
+	82,  // [82:82] is the sub-list for extension type_name
+	82,  // [82:82] is the sub-list for extension extendee
+	0,   // [0:82] is the sub-list for field type_name
 }
 
 func init() { file_resource_v1_resource_proto_init() }
@@ -3014,35 +6280,45 @@ func file_resource_v1_resource_proto_init() {
 	if File_resource_v1_resource_proto != nil {
 		return
 	}
-	file_resource_v1_resource_proto_msgTypes[5].OneofWrappers = []any{}
-	file_resource_v1_resource_proto_msgTypes[6].OneofWrappers = []any{}
-	file_resource_v1_resource_proto_msgTypes[11].OneofWrappers = []any{
+	file_resource_v1_resource_proto_msgTypes[0].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[2].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[7].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[8].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[19].OneofWrappers = []any{
 		(*ResourceSpec_Service)(nil),
 		(*ResourceSpec_Database)(nil),
 		(*ResourceSpec_Cache)(nil),
 		(*ResourceSpec_Queue)(nil),
 		(*ResourceSpec_Blob)(nil),
 	}
-	file_resource_v1_resource_proto_msgTypes[12].OneofWrappers = []any{}
-	file_resource_v1_resource_proto_msgTypes[13].OneofWrappers = []any{}
-	file_resource_v1_resource_proto_msgTypes[14].OneofWrappers = []any{}
-	file_resource_v1_resource_proto_msgTypes[17].OneofWrappers = []any{
+	file_resource_v1_resource_proto_msgTypes[20].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[21].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[22].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[26].OneofWrappers = []any{
 		(*GetResourceRequest_ResourceId)(nil),
 		(*GetResourceRequest_NameKey)(nil),
-	}
-	file_resource_v1_resource_proto_msgTypes[21].OneofWrappers = []any{}
-	file_resource_v1_resource_proto_msgTypes[29].OneofWrappers = []any{}
-	file_resource_v1_resource_proto_msgTypes[31].OneofWrappers = []any{}
-	file_resource_v1_resource_proto_msgTypes[34].OneofWrappers = []any{}
-	file_resource_v1_resource_proto_msgTypes[36].OneofWrappers = []any{}
-	file_resource_v1_resource_proto_msgTypes[38].OneofWrappers = []any{}
+		(*GetResourceRequest_ExternalKey)(nil),
+	}
+	file_resource_v1_resource_proto_msgTypes[28].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[30].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[41].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[43].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[44].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[48].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[52].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[54].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[56].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[59].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[61].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[67].OneofWrappers = []any{}
+	file_resource_v1_resource_proto_msgTypes[79].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_v1_resource_proto_rawDesc), len(file_resource_v1_resource_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   43,
+			NumEnums:      5,
+			NumMessages:   88,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
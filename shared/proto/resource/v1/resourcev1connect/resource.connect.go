@@ -51,21 +51,57 @@ const (
 	// ResourceServiceGetResourceStatusProcedure is the fully-qualified name of the ResourceService's
 	// GetResourceStatus RPC.
 	ResourceServiceGetResourceStatusProcedure = "/resource.v1.ResourceService/GetResourceStatus"
+	// ResourceServiceWatchResourceStatusProcedure is the fully-qualified name of the ResourceService's
+	// WatchResourceStatus RPC.
+	ResourceServiceWatchResourceStatusProcedure = "/resource.v1.ResourceService/WatchResourceStatus"
 	// ResourceServiceListRegionsProcedure is the fully-qualified name of the ResourceService's
 	// ListRegions RPC.
 	ResourceServiceListRegionsProcedure = "/resource.v1.ResourceService/ListRegions"
+	// ResourceServiceEstimateCostProcedure is the fully-qualified name of the ResourceService's
+	// EstimateCost RPC.
+	ResourceServiceEstimateCostProcedure = "/resource.v1.ResourceService/EstimateCost"
 	// ResourceServiceWatchLogsProcedure is the fully-qualified name of the ResourceService's WatchLogs
 	// RPC.
 	ResourceServiceWatchLogsProcedure = "/resource.v1.ResourceService/WatchLogs"
 	// ResourceServiceListResourceEventsProcedure is the fully-qualified name of the ResourceService's
 	// ListResourceEvents RPC.
 	ResourceServiceListResourceEventsProcedure = "/resource.v1.ResourceService/ListResourceEvents"
+	// ResourceServiceStreamEventsProcedure is the fully-qualified name of the ResourceService's
+	// StreamEvents RPC.
+	ResourceServiceStreamEventsProcedure = "/resource.v1.ResourceService/StreamEvents"
+	// ResourceServiceGetResourceTimelineProcedure is the fully-qualified name of the ResourceService's
+	// GetResourceTimeline RPC.
+	ResourceServiceGetResourceTimelineProcedure = "/resource.v1.ResourceService/GetResourceTimeline"
 	// ResourceServiceScaleResourceProcedure is the fully-qualified name of the ResourceService's
 	// ScaleResource RPC.
 	ResourceServiceScaleResourceProcedure = "/resource.v1.ResourceService/ScaleResource"
 	// ResourceServiceUpdateResourceEnvProcedure is the fully-qualified name of the ResourceService's
 	// UpdateResourceEnv RPC.
 	ResourceServiceUpdateResourceEnvProcedure = "/resource.v1.ResourceService/UpdateResourceEnv"
+	// ResourceServiceRestartResourceProcedure is the fully-qualified name of the ResourceService's
+	// RestartResource RPC.
+	ResourceServiceRestartResourceProcedure = "/resource.v1.ResourceService/RestartResource"
+	// ResourceServicePromoteRegionProcedure is the fully-qualified name of the ResourceService's
+	// PromoteRegion RPC.
+	ResourceServicePromoteRegionProcedure = "/resource.v1.ResourceService/PromoteRegion"
+	// ResourceServiceSuspendResourceProcedure is the fully-qualified name of the ResourceService's
+	// SuspendResource RPC.
+	ResourceServiceSuspendResourceProcedure = "/resource.v1.ResourceService/SuspendResource"
+	// ResourceServiceResumeResourceProcedure is the fully-qualified name of the ResourceService's
+	// ResumeResource RPC.
+	ResourceServiceResumeResourceProcedure = "/resource.v1.ResourceService/ResumeResource"
+	// ResourceServiceLockResourceProcedure is the fully-qualified name of the ResourceService's
+	// LockResource RPC.
+	ResourceServiceLockResourceProcedure = "/resource.v1.ResourceService/LockResource"
+	// ResourceServiceUnlockResourceProcedure is the fully-qualified name of the ResourceService's
+	// UnlockResource RPC.
+	ResourceServiceUnlockResourceProcedure = "/resource.v1.ResourceService/UnlockResource"
+	// ResourceServiceGetStatusPageProcedure is the fully-qualified name of the ResourceService's
+	// GetStatusPage RPC.
+	ResourceServiceGetStatusPageProcedure = "/resource.v1.ResourceService/GetStatusPage"
+	// ResourceServiceUpdateStatusPageProcedure is the fully-qualified name of the ResourceService's
+	// UpdateStatusPage RPC.
+	ResourceServiceUpdateStatusPageProcedure = "/resource.v1.ResourceService/UpdateStatusPage"
 )
 
 // ResourceServiceClient is a client for the resource.v1.ResourceService service.
@@ -74,7 +110,8 @@ type ResourceServiceClient interface {
 	CreateResource(context.Context, *connect.Request[v1.CreateResourceRequest]) (*connect.Response[v1.CreateResourceResponse], error)
 	// GetResource retrieves a resource by ID or name.
 	GetResource(context.Context, *connect.Request[v1.GetResourceRequest]) (*connect.Response[v1.GetResourceResponse], error)
-	// UpdateResource updates a resource configuration.
+	// UpdateResource updates a resource configuration. Given a version, it fails with FAILED_PRECONDITION
+	// when the resource was changed since that version was read.
 	UpdateResource(context.Context, *connect.Request[v1.UpdateResourceRequest]) (*connect.Response[v1.UpdateResourceResponse], error)
 	// DeleteResource deletes a resource.
 	DeleteResource(context.Context, *connect.Request[v1.DeleteResourceRequest]) (*connect.Response[v1.DeleteResourceResponse], error)
@@ -82,19 +119,48 @@ type ResourceServiceClient interface {
 	ListWorkspaceResources(context.Context, *connect.Request[v1.ListWorkspaceResourcesRequest]) (*connect.Response[v1.ListWorkspaceResourcesResponse], error)
 	// GetResourceStatus retrieves the current status and deployment information of a resource.
 	GetResourceStatus(context.Context, *connect.Request[v1.GetResourceStatusRequest]) (*connect.Response[v1.GetResourceStatusResponse], error)
+	// WatchResourceStatus streams the resource's status each time it or its deployments change.
+	WatchResourceStatus(context.Context, *connect.Request[v1.WatchResourceStatusRequest]) (*connect.ServerStreamForClient[v1.WatchResourceStatusResponse], error)
 	// ListRegions lists available regions for resource deployment.
 	ListRegions(context.Context, *connect.Request[v1.ListRegionsRequest]) (*connect.Response[v1.ListRegionsResponse], error)
+	// EstimateCost projects the monthly cost of a resource spec from the pricing of the clusters
+	// serving its regions, so it can be shown before deploying.
+	EstimateCost(context.Context, *connect.Request[v1.EstimateCostRequest]) (*connect.Response[v1.EstimateCostResponse], error)
 	// Logs
 	// WatchLogs streams resource logs in real-time.
 	WatchLogs(context.Context, *connect.Request[v1.WatchLogsRequest]) (*connect.ServerStreamForClient[v1.WatchLogsResponse], error)
 	// Events
 	// ListResourceEvents retrieves events for a resource.
 	ListResourceEvents(context.Context, *connect.Request[v1.ListResourceEventsRequest]) (*connect.Response[v1.ListResourceEventsResponse], error)
+	// StreamEvents streams resource events live as they are recorded.
+	StreamEvents(context.Context, *connect.Request[v1.StreamEventsRequest]) (*connect.ServerStreamForClient[v1.StreamEventsResponse], error)
+	// GetResourceTimeline lists what happened to a resource, newest first: its deployments, scaling,
+	// env and domain changes, and incidents.
+	GetResourceTimeline(context.Context, *connect.Request[v1.GetResourceTimelineRequest]) (*connect.Response[v1.GetResourceTimelineResponse], error)
 	// Resource Operations
-	// ScaleResource adjusts resource replicas and resource allocation.
+	// ScaleResource adjusts resource replicas and resource allocation. Given a deployment_version, it fails
+	// with FAILED_PRECONDITION when the active deployment was changed or replaced since.
 	ScaleResource(context.Context, *connect.Request[v1.ScaleResourceRequest]) (*connect.Response[v1.ScaleResourceResponse], error)
 	// UpdateResourceEnv updates environment variables for a resource.
 	UpdateResourceEnv(context.Context, *connect.Request[v1.UpdateResourceEnvRequest]) (*connect.Response[v1.UpdateResourceEnvResponse], error)
+	// RestartResource rolls the resource's pods without changing anything, recording the restart in
+	// its deployment history.
+	RestartResource(context.Context, *connect.Request[v1.RestartResourceRequest]) (*connect.Response[v1.RestartResourceResponse], error)
+	// PromoteRegion makes a standby region the primary for a multi-region resource.
+	PromoteRegion(context.Context, *connect.Request[v1.PromoteRegionRequest]) (*connect.Response[v1.PromoteRegionResponse], error)
+	// SuspendResource scales a resource to zero and serves a maintenance page, keeping its data and domains.
+	SuspendResource(context.Context, *connect.Request[v1.SuspendResourceRequest]) (*connect.Response[v1.SuspendResourceResponse], error)
+	// ResumeResource restores a suspended resource to its active deployment.
+	ResumeResource(context.Context, *connect.Request[v1.ResumeResourceRequest]) (*connect.Response[v1.ResumeResourceResponse], error)
+	// LockResource protects a resource from being deleted or scaled to zero until an admin unlocks it.
+	// Locking a locked resource updates the reason.
+	LockResource(context.Context, *connect.Request[v1.LockResourceRequest]) (*connect.Response[v1.LockResourceResponse], error)
+	// UnlockResource removes a resource's lock.
+	UnlockResource(context.Context, *connect.Request[v1.UnlockResourceRequest]) (*connect.Response[v1.UnlockResourceResponse], error)
+	// GetStatusPage returns the settings of a resource's public status page.
+	GetStatusPage(context.Context, *connect.Request[v1.GetStatusPageRequest]) (*connect.Response[v1.GetStatusPageResponse], error)
+	// UpdateStatusPage turns a resource's public status page on or off and customizes it.
+	UpdateStatusPage(context.Context, *connect.Request[v1.UpdateStatusPageRequest]) (*connect.Response[v1.UpdateStatusPageResponse], error)
 }
 
 // NewResourceServiceClient constructs a client for the resource.v1.ResourceService service. By
@@ -144,12 +210,24 @@ func NewResourceServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(resourceServiceMethods.ByName("GetResourceStatus")),
 			connect.WithClientOptions(opts...),
 		),
+		watchResourceStatus: connect.NewClient[v1.WatchResourceStatusRequest, v1.WatchResourceStatusResponse](
+			httpClient,
+			baseURL+ResourceServiceWatchResourceStatusProcedure,
+			connect.WithSchema(resourceServiceMethods.ByName("WatchResourceStatus")),
+			connect.WithClientOptions(opts...),
+		),
 		listRegions: connect.NewClient[v1.ListRegionsRequest, v1.ListRegionsResponse](
 			httpClient,
 			baseURL+ResourceServiceListRegionsProcedure,
 			connect.WithSchema(resourceServiceMethods.ByName("ListRegions")),
 			connect.WithClientOptions(opts...),
 		),
+		estimateCost: connect.NewClient[v1.EstimateCostRequest, v1.EstimateCostResponse](
+			httpClient,
+			baseURL+ResourceServiceEstimateCostProcedure,
+			connect.WithSchema(resourceServiceMethods.ByName("EstimateCost")),
+			connect.WithClientOptions(opts...),
+		),
 		watchLogs: connect.NewClient[v1.WatchLogsRequest, v1.WatchLogsResponse](
 			httpClient,
 			baseURL+ResourceServiceWatchLogsProcedure,
@@ -162,6 +240,18 @@ func NewResourceServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(resourceServiceMethods.ByName("ListResourceEvents")),
 			connect.WithClientOptions(opts...),
 		),
+		streamEvents: connect.NewClient[v1.StreamEventsRequest, v1.StreamEventsResponse](
+			httpClient,
+			baseURL+ResourceServiceStreamEventsProcedure,
+			connect.WithSchema(resourceServiceMethods.ByName("StreamEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		getResourceTimeline: connect.NewClient[v1.GetResourceTimelineRequest, v1.GetResourceTimelineResponse](
+			httpClient,
+			baseURL+ResourceServiceGetResourceTimelineProcedure,
+			connect.WithSchema(resourceServiceMethods.ByName("GetResourceTimeline")),
+			connect.WithClientOptions(opts...),
+		),
 		scaleResource: connect.NewClient[v1.ScaleResourceRequest, v1.ScaleResourceResponse](
 			httpClient,
 			baseURL+ResourceServiceScaleResourceProcedure,
@@ -174,6 +264,54 @@ func NewResourceServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(resourceServiceMethods.ByName("UpdateResourceEnv")),
 			connect.WithClientOptions(opts...),
 		),
+		restartResource: connect.NewClient[v1.RestartResourceRequest, v1.RestartResourceResponse](
+			httpClient,
+			baseURL+ResourceServiceRestartResourceProcedure,
+			connect.WithSchema(resourceServiceMethods.ByName("RestartResource")),
+			connect.WithClientOptions(opts...),
+		),
+		promoteRegion: connect.NewClient[v1.PromoteRegionRequest, v1.PromoteRegionResponse](
+			httpClient,
+			baseURL+ResourceServicePromoteRegionProcedure,
+			connect.WithSchema(resourceServiceMethods.ByName("PromoteRegion")),
+			connect.WithClientOptions(opts...),
+		),
+		suspendResource: connect.NewClient[v1.SuspendResourceRequest, v1.SuspendResourceResponse](
+			httpClient,
+			baseURL+ResourceServiceSuspendResourceProcedure,
+			connect.WithSchema(resourceServiceMethods.ByName("SuspendResource")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeResource: connect.NewClient[v1.ResumeResourceRequest, v1.ResumeResourceResponse](
+			httpClient,
+			baseURL+ResourceServiceResumeResourceProcedure,
+			connect.WithSchema(resourceServiceMethods.ByName("ResumeResource")),
+			connect.WithClientOptions(opts...),
+		),
+		lockResource: connect.NewClient[v1.LockResourceRequest, v1.LockResourceResponse](
+			httpClient,
+			baseURL+ResourceServiceLockResourceProcedure,
+			connect.WithSchema(resourceServiceMethods.ByName("LockResource")),
+			connect.WithClientOptions(opts...),
+		),
+		unlockResource: connect.NewClient[v1.UnlockResourceRequest, v1.UnlockResourceResponse](
+			httpClient,
+			baseURL+ResourceServiceUnlockResourceProcedure,
+			connect.WithSchema(resourceServiceMethods.ByName("UnlockResource")),
+			connect.WithClientOptions(opts...),
+		),
+		getStatusPage: connect.NewClient[v1.GetStatusPageRequest, v1.GetStatusPageResponse](
+			httpClient,
+			baseURL+ResourceServiceGetStatusPageProcedure,
+			connect.WithSchema(resourceServiceMethods.ByName("GetStatusPage")),
+			connect.WithClientOptions(opts...),
+		),
+		updateStatusPage: connect.NewClient[v1.UpdateStatusPageRequest, v1.UpdateStatusPageResponse](
+			httpClient,
+			baseURL+ResourceServiceUpdateStatusPageProcedure,
+			connect.WithSchema(resourceServiceMethods.ByName("UpdateStatusPage")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -185,11 +323,23 @@ type resourceServiceClient struct {
 	deleteResource         *connect.Client[v1.DeleteResourceRequest, v1.DeleteResourceResponse]
 	listWorkspaceResources *connect.Client[v1.ListWorkspaceResourcesRequest, v1.ListWorkspaceResourcesResponse]
 	getResourceStatus      *connect.Client[v1.GetResourceStatusRequest, v1.GetResourceStatusResponse]
+	watchResourceStatus    *connect.Client[v1.WatchResourceStatusRequest, v1.WatchResourceStatusResponse]
 	listRegions            *connect.Client[v1.ListRegionsRequest, v1.ListRegionsResponse]
+	estimateCost           *connect.Client[v1.EstimateCostRequest, v1.EstimateCostResponse]
 	watchLogs              *connect.Client[v1.WatchLogsRequest, v1.WatchLogsResponse]
 	listResourceEvents     *connect.Client[v1.ListResourceEventsRequest, v1.ListResourceEventsResponse]
+	streamEvents           *connect.Client[v1.StreamEventsRequest, v1.StreamEventsResponse]
+	getResourceTimeline    *connect.Client[v1.GetResourceTimelineRequest, v1.GetResourceTimelineResponse]
 	scaleResource          *connect.Client[v1.ScaleResourceRequest, v1.ScaleResourceResponse]
 	updateResourceEnv      *connect.Client[v1.UpdateResourceEnvRequest, v1.UpdateResourceEnvResponse]
+	restartResource        *connect.Client[v1.RestartResourceRequest, v1.RestartResourceResponse]
+	promoteRegion          *connect.Client[v1.PromoteRegionRequest, v1.PromoteRegionResponse]
+	suspendResource        *connect.Client[v1.SuspendResourceRequest, v1.SuspendResourceResponse]
+	resumeResource         *connect.Client[v1.ResumeResourceRequest, v1.ResumeResourceResponse]
+	lockResource           *connect.Client[v1.LockResourceRequest, v1.LockResourceResponse]
+	unlockResource         *connect.Client[v1.UnlockResourceRequest, v1.UnlockResourceResponse]
+	getStatusPage          *connect.Client[v1.GetStatusPageRequest, v1.GetStatusPageResponse]
+	updateStatusPage       *connect.Client[v1.UpdateStatusPageRequest, v1.UpdateStatusPageResponse]
 }
 
 // CreateResource calls resource.v1.ResourceService.CreateResource.
@@ -222,11 +372,21 @@ func (c *resourceServiceClient) GetResourceStatus(ctx context.Context, req *conn
 	return c.getResourceStatus.CallUnary(ctx, req)
 }
 
+// WatchResourceStatus calls resource.v1.ResourceService.WatchResourceStatus.
+func (c *resourceServiceClient) WatchResourceStatus(ctx context.Context, req *connect.Request[v1.WatchResourceStatusRequest]) (*connect.ServerStreamForClient[v1.WatchResourceStatusResponse], error) {
+	return c.watchResourceStatus.CallServerStream(ctx, req)
+}
+
 // ListRegions calls resource.v1.ResourceService.ListRegions.
 func (c *resourceServiceClient) ListRegions(ctx context.Context, req *connect.Request[v1.ListRegionsRequest]) (*connect.Response[v1.ListRegionsResponse], error) {
 	return c.listRegions.CallUnary(ctx, req)
 }
 
+// EstimateCost calls resource.v1.ResourceService.EstimateCost.
+func (c *resourceServiceClient) EstimateCost(ctx context.Context, req *connect.Request[v1.EstimateCostRequest]) (*connect.Response[v1.EstimateCostResponse], error) {
+	return c.estimateCost.CallUnary(ctx, req)
+}
+
 // WatchLogs calls resource.v1.ResourceService.WatchLogs.
 func (c *resourceServiceClient) WatchLogs(ctx context.Context, req *connect.Request[v1.WatchLogsRequest]) (*connect.ServerStreamForClient[v1.WatchLogsResponse], error) {
 	return c.watchLogs.CallServerStream(ctx, req)
@@ -237,6 +397,16 @@ func (c *resourceServiceClient) ListResourceEvents(ctx context.Context, req *con
 	return c.listResourceEvents.CallUnary(ctx, req)
 }
 
+// StreamEvents calls resource.v1.ResourceService.StreamEvents.
+func (c *resourceServiceClient) StreamEvents(ctx context.Context, req *connect.Request[v1.StreamEventsRequest]) (*connect.ServerStreamForClient[v1.StreamEventsResponse], error) {
+	return c.streamEvents.CallServerStream(ctx, req)
+}
+
+// GetResourceTimeline calls resource.v1.ResourceService.GetResourceTimeline.
+func (c *resourceServiceClient) GetResourceTimeline(ctx context.Context, req *connect.Request[v1.GetResourceTimelineRequest]) (*connect.Response[v1.GetResourceTimelineResponse], error) {
+	return c.getResourceTimeline.CallUnary(ctx, req)
+}
+
 // ScaleResource calls resource.v1.ResourceService.ScaleResource.
 func (c *resourceServiceClient) ScaleResource(ctx context.Context, req *connect.Request[v1.ScaleResourceRequest]) (*connect.Response[v1.ScaleResourceResponse], error) {
 	return c.scaleResource.CallUnary(ctx, req)
@@ -247,13 +417,54 @@ func (c *resourceServiceClient) UpdateResourceEnv(ctx context.Context, req *conn
 	return c.updateResourceEnv.CallUnary(ctx, req)
 }
 
+// RestartResource calls resource.v1.ResourceService.RestartResource.
+func (c *resourceServiceClient) RestartResource(ctx context.Context, req *connect.Request[v1.RestartResourceRequest]) (*connect.Response[v1.RestartResourceResponse], error) {
+	return c.restartResource.CallUnary(ctx, req)
+}
+
+// PromoteRegion calls resource.v1.ResourceService.PromoteRegion.
+func (c *resourceServiceClient) PromoteRegion(ctx context.Context, req *connect.Request[v1.PromoteRegionRequest]) (*connect.Response[v1.PromoteRegionResponse], error) {
+	return c.promoteRegion.CallUnary(ctx, req)
+}
+
+// SuspendResource calls resource.v1.ResourceService.SuspendResource.
+func (c *resourceServiceClient) SuspendResource(ctx context.Context, req *connect.Request[v1.SuspendResourceRequest]) (*connect.Response[v1.SuspendResourceResponse], error) {
+	return c.suspendResource.CallUnary(ctx, req)
+}
+
+// ResumeResource calls resource.v1.ResourceService.ResumeResource.
+func (c *resourceServiceClient) ResumeResource(ctx context.Context, req *connect.Request[v1.ResumeResourceRequest]) (*connect.Response[v1.ResumeResourceResponse], error) {
+	return c.resumeResource.CallUnary(ctx, req)
+}
+
+// LockResource calls resource.v1.ResourceService.LockResource.
+func (c *resourceServiceClient) LockResource(ctx context.Context, req *connect.Request[v1.LockResourceRequest]) (*connect.Response[v1.LockResourceResponse], error) {
+	return c.lockResource.CallUnary(ctx, req)
+}
+
+// UnlockResource calls resource.v1.ResourceService.UnlockResource.
+func (c *resourceServiceClient) UnlockResource(ctx context.Context, req *connect.Request[v1.UnlockResourceRequest]) (*connect.Response[v1.UnlockResourceResponse], error) {
+	return c.unlockResource.CallUnary(ctx, req)
+}
+
+// GetStatusPage calls resource.v1.ResourceService.GetStatusPage.
+func (c *resourceServiceClient) GetStatusPage(ctx context.Context, req *connect.Request[v1.GetStatusPageRequest]) (*connect.Response[v1.GetStatusPageResponse], error) {
+	return c.getStatusPage.CallUnary(ctx, req)
+}
+
+// UpdateStatusPage calls resource.v1.ResourceService.UpdateStatusPage.
+func (c *resourceServiceClient) UpdateStatusPage(ctx context.Context, req *connect.Request[v1.UpdateStatusPageRequest]) (*connect.Response[v1.UpdateStatusPageResponse], error) {
+	return c.updateStatusPage.CallUnary(ctx, req)
+}
+
 // ResourceServiceHandler is an implementation of the resource.v1.ResourceService service.
 type ResourceServiceHandler interface {
 	// CreateResource creates a new resource.
 	CreateResource(context.Context, *connect.Request[v1.CreateResourceRequest]) (*connect.Response[v1.CreateResourceResponse], error)
 	// GetResource retrieves a resource by ID or name.
 	GetResource(context.Context, *connect.Request[v1.GetResourceRequest]) (*connect.Response[v1.GetResourceResponse], error)
-	// UpdateResource updates a resource configuration.
+	// UpdateResource updates a resource configuration. Given a version, it fails with FAILED_PRECONDITION
+	// when the resource was changed since that version was read.
 	UpdateResource(context.Context, *connect.Request[v1.UpdateResourceRequest]) (*connect.Response[v1.UpdateResourceResponse], error)
 	// DeleteResource deletes a resource.
 	DeleteResource(context.Context, *connect.Request[v1.DeleteResourceRequest]) (*connect.Response[v1.DeleteResourceResponse], error)
@@ -261,19 +472,48 @@ type ResourceServiceHandler interface {
 	ListWorkspaceResources(context.Context, *connect.Request[v1.ListWorkspaceResourcesRequest]) (*connect.Response[v1.ListWorkspaceResourcesResponse], error)
 	// GetResourceStatus retrieves the current status and deployment information of a resource.
 	GetResourceStatus(context.Context, *connect.Request[v1.GetResourceStatusRequest]) (*connect.Response[v1.GetResourceStatusResponse], error)
+	// WatchResourceStatus streams the resource's status each time it or its deployments change.
+	WatchResourceStatus(context.Context, *connect.Request[v1.WatchResourceStatusRequest], *connect.ServerStream[v1.WatchResourceStatusResponse]) error
 	// ListRegions lists available regions for resource deployment.
 	ListRegions(context.Context, *connect.Request[v1.ListRegionsRequest]) (*connect.Response[v1.ListRegionsResponse], error)
+	// EstimateCost projects the monthly cost of a resource spec from the pricing of the clusters
+	// serving its regions, so it can be shown before deploying.
+	EstimateCost(context.Context, *connect.Request[v1.EstimateCostRequest]) (*connect.Response[v1.EstimateCostResponse], error)
 	// Logs
 	// WatchLogs streams resource logs in real-time.
 	WatchLogs(context.Context, *connect.Request[v1.WatchLogsRequest], *connect.ServerStream[v1.WatchLogsResponse]) error
 	// Events
 	// ListResourceEvents retrieves events for a resource.
 	ListResourceEvents(context.Context, *connect.Request[v1.ListResourceEventsRequest]) (*connect.Response[v1.ListResourceEventsResponse], error)
+	// StreamEvents streams resource events live as they are recorded.
+	StreamEvents(context.Context, *connect.Request[v1.StreamEventsRequest], *connect.ServerStream[v1.StreamEventsResponse]) error
+	// GetResourceTimeline lists what happened to a resource, newest first: its deployments, scaling,
+	// env and domain changes, and incidents.
+	GetResourceTimeline(context.Context, *connect.Request[v1.GetResourceTimelineRequest]) (*connect.Response[v1.GetResourceTimelineResponse], error)
 	// Resource Operations
-	// ScaleResource adjusts resource replicas and resource allocation.
+	// ScaleResource adjusts resource replicas and resource allocation. Given a deployment_version, it fails
+	// with FAILED_PRECONDITION when the active deployment was changed or replaced since.
 	ScaleResource(context.Context, *connect.Request[v1.ScaleResourceRequest]) (*connect.Response[v1.ScaleResourceResponse], error)
 	// UpdateResourceEnv updates environment variables for a resource.
 	UpdateResourceEnv(context.Context, *connect.Request[v1.UpdateResourceEnvRequest]) (*connect.Response[v1.UpdateResourceEnvResponse], error)
+	// RestartResource rolls the resource's pods without changing anything, recording the restart in
+	// its deployment history.
+	RestartResource(context.Context, *connect.Request[v1.RestartResourceRequest]) (*connect.Response[v1.RestartResourceResponse], error)
+	// PromoteRegion makes a standby region the primary for a multi-region resource.
+	PromoteRegion(context.Context, *connect.Request[v1.PromoteRegionRequest]) (*connect.Response[v1.PromoteRegionResponse], error)
+	// SuspendResource scales a resource to zero and serves a maintenance page, keeping its data and domains.
+	SuspendResource(context.Context, *connect.Request[v1.SuspendResourceRequest]) (*connect.Response[v1.SuspendResourceResponse], error)
+	// ResumeResource restores a suspended resource to its active deployment.
+	ResumeResource(context.Context, *connect.Request[v1.ResumeResourceRequest]) (*connect.Response[v1.ResumeResourceResponse], error)
+	// LockResource protects a resource from being deleted or scaled to zero until an admin unlocks it.
+	// Locking a locked resource updates the reason.
+	LockResource(context.Context, *connect.Request[v1.LockResourceRequest]) (*connect.Response[v1.LockResourceResponse], error)
+	// UnlockResource removes a resource's lock.
+	UnlockResource(context.Context, *connect.Request[v1.UnlockResourceRequest]) (*connect.Response[v1.UnlockResourceResponse], error)
+	// GetStatusPage returns the settings of a resource's public status page.
+	GetStatusPage(context.Context, *connect.Request[v1.GetStatusPageRequest]) (*connect.Response[v1.GetStatusPageResponse], error)
+	// UpdateStatusPage turns a resource's public status page on or off and customizes it.
+	UpdateStatusPage(context.Context, *connect.Request[v1.UpdateStatusPageRequest]) (*connect.Response[v1.UpdateStatusPageResponse], error)
 }
 
 // NewResourceServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -319,12 +559,24 @@ func NewResourceServiceHandler(svc ResourceServiceHandler, opts ...connect.Handl
 		connect.WithSchema(resourceServiceMethods.ByName("GetResourceStatus")),
 		connect.WithHandlerOptions(opts...),
 	)
+	resourceServiceWatchResourceStatusHandler := connect.NewServerStreamHandler(
+		ResourceServiceWatchResourceStatusProcedure,
+		svc.WatchResourceStatus,
+		connect.WithSchema(resourceServiceMethods.ByName("WatchResourceStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
 	resourceServiceListRegionsHandler := connect.NewUnaryHandler(
 		ResourceServiceListRegionsProcedure,
 		svc.ListRegions,
 		connect.WithSchema(resourceServiceMethods.ByName("ListRegions")),
 		connect.WithHandlerOptions(opts...),
 	)
+	resourceServiceEstimateCostHandler := connect.NewUnaryHandler(
+		ResourceServiceEstimateCostProcedure,
+		svc.EstimateCost,
+		connect.WithSchema(resourceServiceMethods.ByName("EstimateCost")),
+		connect.WithHandlerOptions(opts...),
+	)
 	resourceServiceWatchLogsHandler := connect.NewServerStreamHandler(
 		ResourceServiceWatchLogsProcedure,
 		svc.WatchLogs,
@@ -337,6 +589,18 @@ func NewResourceServiceHandler(svc ResourceServiceHandler, opts ...connect.Handl
 		connect.WithSchema(resourceServiceMethods.ByName("ListResourceEvents")),
 		connect.WithHandlerOptions(opts...),
 	)
+	resourceServiceStreamEventsHandler := connect.NewServerStreamHandler(
+		ResourceServiceStreamEventsProcedure,
+		svc.StreamEvents,
+		connect.WithSchema(resourceServiceMethods.ByName("StreamEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	resourceServiceGetResourceTimelineHandler := connect.NewUnaryHandler(
+		ResourceServiceGetResourceTimelineProcedure,
+		svc.GetResourceTimeline,
+		connect.WithSchema(resourceServiceMethods.ByName("GetResourceTimeline")),
+		connect.WithHandlerOptions(opts...),
+	)
 	resourceServiceScaleResourceHandler := connect.NewUnaryHandler(
 		ResourceServiceScaleResourceProcedure,
 		svc.ScaleResource,
@@ -349,6 +613,54 @@ func NewResourceServiceHandler(svc ResourceServiceHandler, opts ...connect.Handl
 		connect.WithSchema(resourceServiceMethods.ByName("UpdateResourceEnv")),
 		connect.WithHandlerOptions(opts...),
 	)
+	resourceServiceRestartResourceHandler := connect.NewUnaryHandler(
+		ResourceServiceRestartResourceProcedure,
+		svc.RestartResource,
+		connect.WithSchema(resourceServiceMethods.ByName("RestartResource")),
+		connect.WithHandlerOptions(opts...),
+	)
+	resourceServicePromoteRegionHandler := connect.NewUnaryHandler(
+		ResourceServicePromoteRegionProcedure,
+		svc.PromoteRegion,
+		connect.WithSchema(resourceServiceMethods.ByName("PromoteRegion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	resourceServiceSuspendResourceHandler := connect.NewUnaryHandler(
+		ResourceServiceSuspendResourceProcedure,
+		svc.SuspendResource,
+		connect.WithSchema(resourceServiceMethods.ByName("SuspendResource")),
+		connect.WithHandlerOptions(opts...),
+	)
+	resourceServiceResumeResourceHandler := connect.NewUnaryHandler(
+		ResourceServiceResumeResourceProcedure,
+		svc.ResumeResource,
+		connect.WithSchema(resourceServiceMethods.ByName("ResumeResource")),
+		connect.WithHandlerOptions(opts...),
+	)
+	resourceServiceLockResourceHandler := connect.NewUnaryHandler(
+		ResourceServiceLockResourceProcedure,
+		svc.LockResource,
+		connect.WithSchema(resourceServiceMethods.ByName("LockResource")),
+		connect.WithHandlerOptions(opts...),
+	)
+	resourceServiceUnlockResourceHandler := connect.NewUnaryHandler(
+		ResourceServiceUnlockResourceProcedure,
+		svc.UnlockResource,
+		connect.WithSchema(resourceServiceMethods.ByName("UnlockResource")),
+		connect.WithHandlerOptions(opts...),
+	)
+	resourceServiceGetStatusPageHandler := connect.NewUnaryHandler(
+		ResourceServiceGetStatusPageProcedure,
+		svc.GetStatusPage,
+		connect.WithSchema(resourceServiceMethods.ByName("GetStatusPage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	resourceServiceUpdateStatusPageHandler := connect.NewUnaryHandler(
+		ResourceServiceUpdateStatusPageProcedure,
+		svc.UpdateStatusPage,
+		connect.WithSchema(resourceServiceMethods.ByName("UpdateStatusPage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/resource.v1.ResourceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ResourceServiceCreateResourceProcedure:
@@ -363,16 +675,40 @@ func NewResourceServiceHandler(svc ResourceServiceHandler, opts ...connect.Handl
 			resourceServiceListWorkspaceResourcesHandler.ServeHTTP(w, r)
 		case ResourceServiceGetResourceStatusProcedure:
 			resourceServiceGetResourceStatusHandler.ServeHTTP(w, r)
+		case ResourceServiceWatchResourceStatusProcedure:
+			resourceServiceWatchResourceStatusHandler.ServeHTTP(w, r)
 		case ResourceServiceListRegionsProcedure:
 			resourceServiceListRegionsHandler.ServeHTTP(w, r)
+		case ResourceServiceEstimateCostProcedure:
+			resourceServiceEstimateCostHandler.ServeHTTP(w, r)
 		case ResourceServiceWatchLogsProcedure:
 			resourceServiceWatchLogsHandler.ServeHTTP(w, r)
 		case ResourceServiceListResourceEventsProcedure:
 			resourceServiceListResourceEventsHandler.ServeHTTP(w, r)
+		case ResourceServiceStreamEventsProcedure:
+			resourceServiceStreamEventsHandler.ServeHTTP(w, r)
+		case ResourceServiceGetResourceTimelineProcedure:
+			resourceServiceGetResourceTimelineHandler.ServeHTTP(w, r)
 		case ResourceServiceScaleResourceProcedure:
 			resourceServiceScaleResourceHandler.ServeHTTP(w, r)
 		case ResourceServiceUpdateResourceEnvProcedure:
 			resourceServiceUpdateResourceEnvHandler.ServeHTTP(w, r)
+		case ResourceServiceRestartResourceProcedure:
+			resourceServiceRestartResourceHandler.ServeHTTP(w, r)
+		case ResourceServicePromoteRegionProcedure:
+			resourceServicePromoteRegionHandler.ServeHTTP(w, r)
+		case ResourceServiceSuspendResourceProcedure:
+			resourceServiceSuspendResourceHandler.ServeHTTP(w, r)
+		case ResourceServiceResumeResourceProcedure:
+			resourceServiceResumeResourceHandler.ServeHTTP(w, r)
+		case ResourceServiceLockResourceProcedure:
+			resourceServiceLockResourceHandler.ServeHTTP(w, r)
+		case ResourceServiceUnlockResourceProcedure:
+			resourceServiceUnlockResourceHandler.ServeHTTP(w, r)
+		case ResourceServiceGetStatusPageProcedure:
+			resourceServiceGetStatusPageHandler.ServeHTTP(w, r)
+		case ResourceServiceUpdateStatusPageProcedure:
+			resourceServiceUpdateStatusPageHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -406,10 +742,18 @@ func (UnimplementedResourceServiceHandler) GetResourceStatus(context.Context, *c
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.GetResourceStatus is not implemented"))
 }
 
+func (UnimplementedResourceServiceHandler) WatchResourceStatus(context.Context, *connect.Request[v1.WatchResourceStatusRequest], *connect.ServerStream[v1.WatchResourceStatusResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.WatchResourceStatus is not implemented"))
+}
+
 func (UnimplementedResourceServiceHandler) ListRegions(context.Context, *connect.Request[v1.ListRegionsRequest]) (*connect.Response[v1.ListRegionsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.ListRegions is not implemented"))
 }
 
+func (UnimplementedResourceServiceHandler) EstimateCost(context.Context, *connect.Request[v1.EstimateCostRequest]) (*connect.Response[v1.EstimateCostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.EstimateCost is not implemented"))
+}
+
 func (UnimplementedResourceServiceHandler) WatchLogs(context.Context, *connect.Request[v1.WatchLogsRequest], *connect.ServerStream[v1.WatchLogsResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.WatchLogs is not implemented"))
 }
@@ -418,6 +762,14 @@ func (UnimplementedResourceServiceHandler) ListResourceEvents(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.ListResourceEvents is not implemented"))
 }
 
+func (UnimplementedResourceServiceHandler) StreamEvents(context.Context, *connect.Request[v1.StreamEventsRequest], *connect.ServerStream[v1.StreamEventsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.StreamEvents is not implemented"))
+}
+
+func (UnimplementedResourceServiceHandler) GetResourceTimeline(context.Context, *connect.Request[v1.GetResourceTimelineRequest]) (*connect.Response[v1.GetResourceTimelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.GetResourceTimeline is not implemented"))
+}
+
 func (UnimplementedResourceServiceHandler) ScaleResource(context.Context, *connect.Request[v1.ScaleResourceRequest]) (*connect.Response[v1.ScaleResourceResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.ScaleResource is not implemented"))
 }
@@ -425,3 +777,35 @@ func (UnimplementedResourceServiceHandler) ScaleResource(context.Context, *conne
 func (UnimplementedResourceServiceHandler) UpdateResourceEnv(context.Context, *connect.Request[v1.UpdateResourceEnvRequest]) (*connect.Response[v1.UpdateResourceEnvResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.UpdateResourceEnv is not implemented"))
 }
+
+func (UnimplementedResourceServiceHandler) RestartResource(context.Context, *connect.Request[v1.RestartResourceRequest]) (*connect.Response[v1.RestartResourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.RestartResource is not implemented"))
+}
+
+func (UnimplementedResourceServiceHandler) PromoteRegion(context.Context, *connect.Request[v1.PromoteRegionRequest]) (*connect.Response[v1.PromoteRegionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.PromoteRegion is not implemented"))
+}
+
+func (UnimplementedResourceServiceHandler) SuspendResource(context.Context, *connect.Request[v1.SuspendResourceRequest]) (*connect.Response[v1.SuspendResourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.SuspendResource is not implemented"))
+}
+
+func (UnimplementedResourceServiceHandler) ResumeResource(context.Context, *connect.Request[v1.ResumeResourceRequest]) (*connect.Response[v1.ResumeResourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.ResumeResource is not implemented"))
+}
+
+func (UnimplementedResourceServiceHandler) LockResource(context.Context, *connect.Request[v1.LockResourceRequest]) (*connect.Response[v1.LockResourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.LockResource is not implemented"))
+}
+
+func (UnimplementedResourceServiceHandler) UnlockResource(context.Context, *connect.Request[v1.UnlockResourceRequest]) (*connect.Response[v1.UnlockResourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.UnlockResource is not implemented"))
+}
+
+func (UnimplementedResourceServiceHandler) GetStatusPage(context.Context, *connect.Request[v1.GetStatusPageRequest]) (*connect.Response[v1.GetStatusPageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.GetStatusPage is not implemented"))
+}
+
+func (UnimplementedResourceServiceHandler) UpdateStatusPage(context.Context, *connect.Request[v1.UpdateStatusPageRequest]) (*connect.Response[v1.UpdateStatusPageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resource.v1.ResourceService.UpdateStatusPage is not implemented"))
+}
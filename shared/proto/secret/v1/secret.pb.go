@@ -0,0 +1,460 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: secret/v1/secret.proto
+
+package secretv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SecretStore is a named set of secret entries in a workspace. Env vars reference an entry as
+// secret://<store>/<key>; the value is handed to the pod without appearing in the resource's spec.
+// Entry values are write-only and never returned.
+type SecretStore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Keys          []string               `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"` // sorted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretStore) Reset() {
+	*x = SecretStore{}
+	mi := &file_secret_v1_secret_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretStore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretStore) ProtoMessage() {}
+
+func (x *SecretStore) ProtoReflect() protoreflect.Message {
+	mi := &file_secret_v1_secret_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretStore.ProtoReflect.Descriptor instead.
+func (*SecretStore) Descriptor() ([]byte, []int) {
+	return file_secret_v1_secret_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SecretStore) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretStore) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// SetSecretRequest is the request to set a secret store entry.
+type SetSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Store         string                 `protobuf:"bytes,2,opt,name=store,proto3" json:"store,omitempty"` // 1-32 lowercase letters, digits or dashes
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`     // 1-253 letters, digits, dashes, dots or underscores
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSecretRequest) Reset() {
+	*x = SetSecretRequest{}
+	mi := &file_secret_v1_secret_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSecretRequest) ProtoMessage() {}
+
+func (x *SetSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secret_v1_secret_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSecretRequest.ProtoReflect.Descriptor instead.
+func (*SetSecretRequest) Descriptor() ([]byte, []int) {
+	return file_secret_v1_secret_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetSecretRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *SetSecretRequest) GetStore() string {
+	if x != nil {
+		return x.Store
+	}
+	return ""
+}
+
+func (x *SetSecretRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetSecretRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// SetSecretResponse is the response to setting a secret store entry.
+type SetSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSecretResponse) Reset() {
+	*x = SetSecretResponse{}
+	mi := &file_secret_v1_secret_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSecretResponse) ProtoMessage() {}
+
+func (x *SetSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secret_v1_secret_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSecretResponse.ProtoReflect.Descriptor instead.
+func (*SetSecretResponse) Descriptor() ([]byte, []int) {
+	return file_secret_v1_secret_proto_rawDescGZIP(), []int{2}
+}
+
+// DeleteSecretRequest is the request to delete a secret store entry.
+type DeleteSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Store         string                 `protobuf:"bytes,2,opt,name=store,proto3" json:"store,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecretRequest) Reset() {
+	*x = DeleteSecretRequest{}
+	mi := &file_secret_v1_secret_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretRequest) ProtoMessage() {}
+
+func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secret_v1_secret_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
+	return file_secret_v1_secret_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteSecretRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *DeleteSecretRequest) GetStore() string {
+	if x != nil {
+		return x.Store
+	}
+	return ""
+}
+
+func (x *DeleteSecretRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// DeleteSecretResponse is the response to deleting a secret store entry.
+type DeleteSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecretResponse) Reset() {
+	*x = DeleteSecretResponse{}
+	mi := &file_secret_v1_secret_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretResponse) ProtoMessage() {}
+
+func (x *DeleteSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secret_v1_secret_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSecretResponse) Descriptor() ([]byte, []int) {
+	return file_secret_v1_secret_proto_rawDescGZIP(), []int{4}
+}
+
+// ListSecretStoresRequest is the request to list a workspace's secret stores.
+type ListSecretStoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretStoresRequest) Reset() {
+	*x = ListSecretStoresRequest{}
+	mi := &file_secret_v1_secret_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretStoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretStoresRequest) ProtoMessage() {}
+
+func (x *ListSecretStoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secret_v1_secret_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretStoresRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretStoresRequest) Descriptor() ([]byte, []int) {
+	return file_secret_v1_secret_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSecretStoresRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+// ListSecretStoresResponse is the response containing the secret stores, by name.
+type ListSecretStoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stores        []*SecretStore         `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretStoresResponse) Reset() {
+	*x = ListSecretStoresResponse{}
+	mi := &file_secret_v1_secret_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretStoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretStoresResponse) ProtoMessage() {}
+
+func (x *ListSecretStoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secret_v1_secret_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretStoresResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretStoresResponse) Descriptor() ([]byte, []int) {
+	return file_secret_v1_secret_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSecretStoresResponse) GetStores() []*SecretStore {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+var File_secret_v1_secret_proto protoreflect.FileDescriptor
+
+const file_secret_v1_secret_proto_rawDesc = "" +
+	"\n" +
+	"\x16secret/v1/secret.proto\x12\tsecret.v1\"5\n" +
+	"\vSecretStore\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04keys\x18\x02 \x03(\tR\x04keys\"s\n" +
+	"\x10SetSecretRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x14\n" +
+	"\x05store\x18\x02 \x01(\tR\x05store\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\"\x13\n" +
+	"\x11SetSecretResponse\"`\n" +
+	"\x13DeleteSecretRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x14\n" +
+	"\x05store\x18\x02 \x01(\tR\x05store\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\"\x16\n" +
+	"\x14DeleteSecretResponse\"<\n" +
+	"\x17ListSecretStoresRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"J\n" +
+	"\x18ListSecretStoresResponse\x12.\n" +
+	"\x06stores\x18\x01 \x03(\v2\x16.secret.v1.SecretStoreR\x06stores2\x85\x02\n" +
+	"\rSecretService\x12F\n" +
+	"\tSetSecret\x12\x1b.secret.v1.SetSecretRequest\x1a\x1c.secret.v1.SetSecretResponse\x12O\n" +
+	"\fDeleteSecret\x12\x1e.secret.v1.DeleteSecretRequest\x1a\x1f.secret.v1.DeleteSecretResponse\x12[\n" +
+	"\x10ListSecretStores\x12\".secret.v1.ListSecretStoresRequest\x1a#.secret.v1.ListSecretStoresResponseB;Z9github.com/team-loco/loco/shared/proto/secret/v1;secretv1b\x06proto3"
+
+var (
+	file_secret_v1_secret_proto_rawDescOnce sync.Once
+	file_secret_v1_secret_proto_rawDescData []byte
+)
+
+func file_secret_v1_secret_proto_rawDescGZIP() []byte {
+	file_secret_v1_secret_proto_rawDescOnce.Do(func() {
+		file_secret_v1_secret_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_secret_v1_secret_proto_rawDesc), len(file_secret_v1_secret_proto_rawDesc)))
+	})
+	return file_secret_v1_secret_proto_rawDescData
+}
+
+var file_secret_v1_secret_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_secret_v1_secret_proto_goTypes = []any{
+	(*SecretStore)(nil),              // 0: secret.v1.SecretStore
+	(*SetSecretRequest)(nil),         // 1: secret.v1.SetSecretRequest
+	(*SetSecretResponse)(nil),        // 2: secret.v1.SetSecretResponse
+	(*DeleteSecretRequest)(nil),      // 3: secret.v1.DeleteSecretRequest
+	(*DeleteSecretResponse)(nil),     // 4: secret.v1.DeleteSecretResponse
+	(*ListSecretStoresRequest)(nil),  // 5: secret.v1.ListSecretStoresRequest
+	(*ListSecretStoresResponse)(nil), // 6: secret.v1.ListSecretStoresResponse
+}
+var file_secret_v1_secret_proto_depIdxs = []int32{
+	0, // 0: secret.v1.ListSecretStoresResponse.stores:type_name -> secret.v1.SecretStore
+	1, // 1: secret.v1.SecretService.SetSecret:input_type -> secret.v1.SetSecretRequest
+	3, // 2: secret.v1.SecretService.DeleteSecret:input_type -> secret.v1.DeleteSecretRequest
+	5, // 3: secret.v1.SecretService.ListSecretStores:input_type -> secret.v1.ListSecretStoresRequest
+	2, // 4: secret.v1.SecretService.SetSecret:output_type -> secret.v1.SetSecretResponse
+	4, // 5: secret.v1.SecretService.DeleteSecret:output_type -> secret.v1.DeleteSecretResponse
+	6, // 6: secret.v1.SecretService.ListSecretStores:output_type -> secret.v1.ListSecretStoresResponse
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_secret_v1_secret_proto_init() }
+func file_secret_v1_secret_proto_init() {
+	if File_secret_v1_secret_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_secret_v1_secret_proto_rawDesc), len(file_secret_v1_secret_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_secret_v1_secret_proto_goTypes,
+		DependencyIndexes: file_secret_v1_secret_proto_depIdxs,
+		MessageInfos:      file_secret_v1_secret_proto_msgTypes,
+	}.Build()
+	File_secret_v1_secret_proto = out.File
+	file_secret_v1_secret_proto_goTypes = nil
+	file_secret_v1_secret_proto_depIdxs = nil
+}
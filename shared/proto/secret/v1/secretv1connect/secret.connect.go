@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: secret/v1/secret.proto
+
+package secretv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/team-loco/loco/shared/proto/secret/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SecretServiceName is the fully-qualified name of the SecretService service.
+	SecretServiceName = "secret.v1.SecretService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SecretServiceSetSecretProcedure is the fully-qualified name of the SecretService's SetSecret RPC.
+	SecretServiceSetSecretProcedure = "/secret.v1.SecretService/SetSecret"
+	// SecretServiceDeleteSecretProcedure is the fully-qualified name of the SecretService's
+	// DeleteSecret RPC.
+	SecretServiceDeleteSecretProcedure = "/secret.v1.SecretService/DeleteSecret"
+	// SecretServiceListSecretStoresProcedure is the fully-qualified name of the SecretService's
+	// ListSecretStores RPC.
+	SecretServiceListSecretStoresProcedure = "/secret.v1.SecretService/ListSecretStores"
+)
+
+// SecretServiceClient is a client for the secret.v1.SecretService service.
+type SecretServiceClient interface {
+	// SetSecret sets an entry of a secret store, creating the store if needed. Resources referencing the
+	// entry pick up the new value on their next deployment.
+	SetSecret(context.Context, *connect.Request[v1.SetSecretRequest]) (*connect.Response[v1.SetSecretResponse], error)
+	// DeleteSecret deletes an entry of a secret store; the store is deleted with its last entry.
+	DeleteSecret(context.Context, *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.DeleteSecretResponse], error)
+	// ListSecretStores lists the secret stores of a workspace and the keys of their entries.
+	ListSecretStores(context.Context, *connect.Request[v1.ListSecretStoresRequest]) (*connect.Response[v1.ListSecretStoresResponse], error)
+}
+
+// NewSecretServiceClient constructs a client for the secret.v1.SecretService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSecretServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SecretServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	secretServiceMethods := v1.File_secret_v1_secret_proto.Services().ByName("SecretService").Methods()
+	return &secretServiceClient{
+		setSecret: connect.NewClient[v1.SetSecretRequest, v1.SetSecretResponse](
+			httpClient,
+			baseURL+SecretServiceSetSecretProcedure,
+			connect.WithSchema(secretServiceMethods.ByName("SetSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSecret: connect.NewClient[v1.DeleteSecretRequest, v1.DeleteSecretResponse](
+			httpClient,
+			baseURL+SecretServiceDeleteSecretProcedure,
+			connect.WithSchema(secretServiceMethods.ByName("DeleteSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		listSecretStores: connect.NewClient[v1.ListSecretStoresRequest, v1.ListSecretStoresResponse](
+			httpClient,
+			baseURL+SecretServiceListSecretStoresProcedure,
+			connect.WithSchema(secretServiceMethods.ByName("ListSecretStores")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// secretServiceClient implements SecretServiceClient.
+type secretServiceClient struct {
+	setSecret        *connect.Client[v1.SetSecretRequest, v1.SetSecretResponse]
+	deleteSecret     *connect.Client[v1.DeleteSecretRequest, v1.DeleteSecretResponse]
+	listSecretStores *connect.Client[v1.ListSecretStoresRequest, v1.ListSecretStoresResponse]
+}
+
+// SetSecret calls secret.v1.SecretService.SetSecret.
+func (c *secretServiceClient) SetSecret(ctx context.Context, req *connect.Request[v1.SetSecretRequest]) (*connect.Response[v1.SetSecretResponse], error) {
+	return c.setSecret.CallUnary(ctx, req)
+}
+
+// DeleteSecret calls secret.v1.SecretService.DeleteSecret.
+func (c *secretServiceClient) DeleteSecret(ctx context.Context, req *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.DeleteSecretResponse], error) {
+	return c.deleteSecret.CallUnary(ctx, req)
+}
+
+// ListSecretStores calls secret.v1.SecretService.ListSecretStores.
+func (c *secretServiceClient) ListSecretStores(ctx context.Context, req *connect.Request[v1.ListSecretStoresRequest]) (*connect.Response[v1.ListSecretStoresResponse], error) {
+	return c.listSecretStores.CallUnary(ctx, req)
+}
+
+// SecretServiceHandler is an implementation of the secret.v1.SecretService service.
+type SecretServiceHandler interface {
+	// SetSecret sets an entry of a secret store, creating the store if needed. Resources referencing the
+	// entry pick up the new value on their next deployment.
+	SetSecret(context.Context, *connect.Request[v1.SetSecretRequest]) (*connect.Response[v1.SetSecretResponse], error)
+	// DeleteSecret deletes an entry of a secret store; the store is deleted with its last entry.
+	DeleteSecret(context.Context, *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.DeleteSecretResponse], error)
+	// ListSecretStores lists the secret stores of a workspace and the keys of their entries.
+	ListSecretStores(context.Context, *connect.Request[v1.ListSecretStoresRequest]) (*connect.Response[v1.ListSecretStoresResponse], error)
+}
+
+// NewSecretServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSecretServiceHandler(svc SecretServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	secretServiceMethods := v1.File_secret_v1_secret_proto.Services().ByName("SecretService").Methods()
+	secretServiceSetSecretHandler := connect.NewUnaryHandler(
+		SecretServiceSetSecretProcedure,
+		svc.SetSecret,
+		connect.WithSchema(secretServiceMethods.ByName("SetSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretServiceDeleteSecretHandler := connect.NewUnaryHandler(
+		SecretServiceDeleteSecretProcedure,
+		svc.DeleteSecret,
+		connect.WithSchema(secretServiceMethods.ByName("DeleteSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretServiceListSecretStoresHandler := connect.NewUnaryHandler(
+		SecretServiceListSecretStoresProcedure,
+		svc.ListSecretStores,
+		connect.WithSchema(secretServiceMethods.ByName("ListSecretStores")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/secret.v1.SecretService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SecretServiceSetSecretProcedure:
+			secretServiceSetSecretHandler.ServeHTTP(w, r)
+		case SecretServiceDeleteSecretProcedure:
+			secretServiceDeleteSecretHandler.ServeHTTP(w, r)
+		case SecretServiceListSecretStoresProcedure:
+			secretServiceListSecretStoresHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSecretServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSecretServiceHandler struct{}
+
+func (UnimplementedSecretServiceHandler) SetSecret(context.Context, *connect.Request[v1.SetSecretRequest]) (*connect.Response[v1.SetSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secret.v1.SecretService.SetSecret is not implemented"))
+}
+
+func (UnimplementedSecretServiceHandler) DeleteSecret(context.Context, *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.DeleteSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secret.v1.SecretService.DeleteSecret is not implemented"))
+}
+
+func (UnimplementedSecretServiceHandler) ListSecretStores(context.Context, *connect.Request[v1.ListSecretStoresRequest]) (*connect.Response[v1.ListSecretStoresResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secret.v1.SecretService.ListSecretStores is not implemented"))
+}
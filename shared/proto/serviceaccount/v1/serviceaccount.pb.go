@@ -0,0 +1,729 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: serviceaccount/v1/serviceaccount.proto
+
+package serviceaccountv1
+
+import (
+	v1 "github.com/team-loco/loco/shared/proto/token/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ServiceAccount is a non-human principal owned by a workspace, such as a CI pipeline or an agent.
+// It holds its own scopes, and tokens are issued to it through TokenService.CreateToken with
+// entity_type ENTITY_TYPE_SERVICE_ACCOUNT.
+type ServiceAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId   int64                  `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Scopes        []*v1.EntityScope      `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"` // always within the owning workspace
+	CreatedBy     int64                  `protobuf:"varint,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceAccount) Reset() {
+	*x = ServiceAccount{}
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAccount) ProtoMessage() {}
+
+func (x *ServiceAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceAccount.ProtoReflect.Descriptor instead.
+func (*ServiceAccount) Descriptor() ([]byte, []int) {
+	return file_serviceaccount_v1_serviceaccount_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServiceAccount) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ServiceAccount) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *ServiceAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetScopes() []*v1.EntityScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *ServiceAccount) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateServiceAccountRequest is the request to create a service account.
+type CreateServiceAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Scopes        []*v1.EntityScope      `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"` // the caller must hold each scope itself
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountRequest) Reset() {
+	*x = CreateServiceAccountRequest{}
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountRequest) ProtoMessage() {}
+
+func (x *CreateServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_serviceaccount_v1_serviceaccount_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateServiceAccountRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *CreateServiceAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetScopes() []*v1.EntityScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// CreateServiceAccountResponse is the response containing the created service account.
+type CreateServiceAccountResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccount *ServiceAccount        `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountResponse) Reset() {
+	*x = CreateServiceAccountResponse{}
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountResponse) ProtoMessage() {}
+
+func (x *CreateServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_serviceaccount_v1_serviceaccount_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateServiceAccountResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return nil
+}
+
+// GetServiceAccountRequest is the request to retrieve a service account.
+type GetServiceAccountRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccountId int64                  `protobuf:"varint,1,opt,name=service_account_id,json=serviceAccountId,proto3" json:"service_account_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetServiceAccountRequest) Reset() {
+	*x = GetServiceAccountRequest{}
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceAccountRequest) ProtoMessage() {}
+
+func (x *GetServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_serviceaccount_v1_serviceaccount_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetServiceAccountRequest) GetServiceAccountId() int64 {
+	if x != nil {
+		return x.ServiceAccountId
+	}
+	return 0
+}
+
+// GetServiceAccountResponse is the response containing the service account.
+type GetServiceAccountResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccount *ServiceAccount        `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetServiceAccountResponse) Reset() {
+	*x = GetServiceAccountResponse{}
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceAccountResponse) ProtoMessage() {}
+
+func (x *GetServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_serviceaccount_v1_serviceaccount_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetServiceAccountResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return nil
+}
+
+// ListServiceAccountsRequest is the request to list a workspace's service accounts.
+type ListServiceAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountsRequest) Reset() {
+	*x = ListServiceAccountsRequest{}
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountsRequest) ProtoMessage() {}
+
+func (x *ListServiceAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListServiceAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_serviceaccount_v1_serviceaccount_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListServiceAccountsRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+// ListServiceAccountsResponse is the response containing the service accounts, oldest first.
+type ListServiceAccountsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccounts []*ServiceAccount      `protobuf:"bytes,1,rep,name=service_accounts,json=serviceAccounts,proto3" json:"service_accounts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountsResponse) Reset() {
+	*x = ListServiceAccountsResponse{}
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountsResponse) ProtoMessage() {}
+
+func (x *ListServiceAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListServiceAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_serviceaccount_v1_serviceaccount_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListServiceAccountsResponse) GetServiceAccounts() []*ServiceAccount {
+	if x != nil {
+		return x.ServiceAccounts
+	}
+	return nil
+}
+
+// SetServiceAccountScopesRequest is the request to replace a service account's scopes.
+type SetServiceAccountScopesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccountId int64                  `protobuf:"varint,1,opt,name=service_account_id,json=serviceAccountId,proto3" json:"service_account_id,omitempty"`
+	Scopes           []*v1.EntityScope      `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"` // the caller must hold each scope itself
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetServiceAccountScopesRequest) Reset() {
+	*x = SetServiceAccountScopesRequest{}
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetServiceAccountScopesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetServiceAccountScopesRequest) ProtoMessage() {}
+
+func (x *SetServiceAccountScopesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetServiceAccountScopesRequest.ProtoReflect.Descriptor instead.
+func (*SetServiceAccountScopesRequest) Descriptor() ([]byte, []int) {
+	return file_serviceaccount_v1_serviceaccount_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetServiceAccountScopesRequest) GetServiceAccountId() int64 {
+	if x != nil {
+		return x.ServiceAccountId
+	}
+	return 0
+}
+
+func (x *SetServiceAccountScopesRequest) GetScopes() []*v1.EntityScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// SetServiceAccountScopesResponse is the response containing the updated service account.
+type SetServiceAccountScopesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccount *ServiceAccount        `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetServiceAccountScopesResponse) Reset() {
+	*x = SetServiceAccountScopesResponse{}
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetServiceAccountScopesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetServiceAccountScopesResponse) ProtoMessage() {}
+
+func (x *SetServiceAccountScopesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetServiceAccountScopesResponse.ProtoReflect.Descriptor instead.
+func (*SetServiceAccountScopesResponse) Descriptor() ([]byte, []int) {
+	return file_serviceaccount_v1_serviceaccount_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetServiceAccountScopesResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return nil
+}
+
+// DeleteServiceAccountRequest is the request to delete a service account.
+type DeleteServiceAccountRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccountId int64                  `protobuf:"varint,1,opt,name=service_account_id,json=serviceAccountId,proto3" json:"service_account_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteServiceAccountRequest) Reset() {
+	*x = DeleteServiceAccountRequest{}
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceAccountRequest) ProtoMessage() {}
+
+func (x *DeleteServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_serviceaccount_v1_serviceaccount_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteServiceAccountRequest) GetServiceAccountId() int64 {
+	if x != nil {
+		return x.ServiceAccountId
+	}
+	return 0
+}
+
+// DeleteServiceAccountResponse is the response after deleting a service account.
+type DeleteServiceAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceAccountResponse) Reset() {
+	*x = DeleteServiceAccountResponse{}
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceAccountResponse) ProtoMessage() {}
+
+func (x *DeleteServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceaccount_v1_serviceaccount_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_serviceaccount_v1_serviceaccount_proto_rawDescGZIP(), []int{10}
+}
+
+var File_serviceaccount_v1_serviceaccount_proto protoreflect.FileDescriptor
+
+const file_serviceaccount_v1_serviceaccount_proto_rawDesc = "" +
+	"\n" +
+	"&serviceaccount/v1/serviceaccount.proto\x12\x11serviceaccount.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14token/v1/token.proto\"\xbd\x02\n" +
+	"\x0eServiceAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\x03R\vworkspaceId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12-\n" +
+	"\x06scopes\x18\x05 \x03(\v2\x15.token.v1.EntityScopeR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\x03R\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xba\x01\n" +
+	"\x1bCreateServiceAccountRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12-\n" +
+	"\x06scopes\x18\x04 \x03(\v2\x15.token.v1.EntityScopeR\x06scopesB\x0e\n" +
+	"\f_description\"j\n" +
+	"\x1cCreateServiceAccountResponse\x12J\n" +
+	"\x0fservice_account\x18\x01 \x01(\v2!.serviceaccount.v1.ServiceAccountR\x0eserviceAccount\"H\n" +
+	"\x18GetServiceAccountRequest\x12,\n" +
+	"\x12service_account_id\x18\x01 \x01(\x03R\x10serviceAccountId\"g\n" +
+	"\x19GetServiceAccountResponse\x12J\n" +
+	"\x0fservice_account\x18\x01 \x01(\v2!.serviceaccount.v1.ServiceAccountR\x0eserviceAccount\"?\n" +
+	"\x1aListServiceAccountsRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"k\n" +
+	"\x1bListServiceAccountsResponse\x12L\n" +
+	"\x10service_accounts\x18\x01 \x03(\v2!.serviceaccount.v1.ServiceAccountR\x0fserviceAccounts\"}\n" +
+	"\x1eSetServiceAccountScopesRequest\x12,\n" +
+	"\x12service_account_id\x18\x01 \x01(\x03R\x10serviceAccountId\x12-\n" +
+	"\x06scopes\x18\x02 \x03(\v2\x15.token.v1.EntityScopeR\x06scopes\"m\n" +
+	"\x1fSetServiceAccountScopesResponse\x12J\n" +
+	"\x0fservice_account\x18\x01 \x01(\v2!.serviceaccount.v1.ServiceAccountR\x0eserviceAccount\"K\n" +
+	"\x1bDeleteServiceAccountRequest\x12,\n" +
+	"\x12service_account_id\x18\x01 \x01(\x03R\x10serviceAccountId\"\x1e\n" +
+	"\x1cDeleteServiceAccountResponse2\xf2\x04\n" +
+	"\x15ServiceAccountService\x12w\n" +
+	"\x14CreateServiceAccount\x12..serviceaccount.v1.CreateServiceAccountRequest\x1a/.serviceaccount.v1.CreateServiceAccountResponse\x12n\n" +
+	"\x11GetServiceAccount\x12+.serviceaccount.v1.GetServiceAccountRequest\x1a,.serviceaccount.v1.GetServiceAccountResponse\x12t\n" +
+	"\x13ListServiceAccounts\x12-.serviceaccount.v1.ListServiceAccountsRequest\x1a..serviceaccount.v1.ListServiceAccountsResponse\x12\x80\x01\n" +
+	"\x17SetServiceAccountScopes\x121.serviceaccount.v1.SetServiceAccountScopesRequest\x1a2.serviceaccount.v1.SetServiceAccountScopesResponse\x12w\n" +
+	"\x14DeleteServiceAccount\x12..serviceaccount.v1.DeleteServiceAccountRequest\x1a/.serviceaccount.v1.DeleteServiceAccountResponseBKZIgithub.com/team-loco/loco/shared/proto/serviceaccount/v1;serviceaccountv1b\x06proto3"
+
+var (
+	file_serviceaccount_v1_serviceaccount_proto_rawDescOnce sync.Once
+	file_serviceaccount_v1_serviceaccount_proto_rawDescData []byte
+)
+
+func file_serviceaccount_v1_serviceaccount_proto_rawDescGZIP() []byte {
+	file_serviceaccount_v1_serviceaccount_proto_rawDescOnce.Do(func() {
+		file_serviceaccount_v1_serviceaccount_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_serviceaccount_v1_serviceaccount_proto_rawDesc), len(file_serviceaccount_v1_serviceaccount_proto_rawDesc)))
+	})
+	return file_serviceaccount_v1_serviceaccount_proto_rawDescData
+}
+
+var file_serviceaccount_v1_serviceaccount_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_serviceaccount_v1_serviceaccount_proto_goTypes = []any{
+	(*ServiceAccount)(nil),                  // 0: serviceaccount.v1.ServiceAccount
+	(*CreateServiceAccountRequest)(nil),     // 1: serviceaccount.v1.CreateServiceAccountRequest
+	(*CreateServiceAccountResponse)(nil),    // 2: serviceaccount.v1.CreateServiceAccountResponse
+	(*GetServiceAccountRequest)(nil),        // 3: serviceaccount.v1.GetServiceAccountRequest
+	(*GetServiceAccountResponse)(nil),       // 4: serviceaccount.v1.GetServiceAccountResponse
+	(*ListServiceAccountsRequest)(nil),      // 5: serviceaccount.v1.ListServiceAccountsRequest
+	(*ListServiceAccountsResponse)(nil),     // 6: serviceaccount.v1.ListServiceAccountsResponse
+	(*SetServiceAccountScopesRequest)(nil),  // 7: serviceaccount.v1.SetServiceAccountScopesRequest
+	(*SetServiceAccountScopesResponse)(nil), // 8: serviceaccount.v1.SetServiceAccountScopesResponse
+	(*DeleteServiceAccountRequest)(nil),     // 9: serviceaccount.v1.DeleteServiceAccountRequest
+	(*DeleteServiceAccountResponse)(nil),    // 10: serviceaccount.v1.DeleteServiceAccountResponse
+	(*v1.EntityScope)(nil),                  // 11: token.v1.EntityScope
+	(*timestamppb.Timestamp)(nil),           // 12: google.protobuf.Timestamp
+}
+var file_serviceaccount_v1_serviceaccount_proto_depIdxs = []int32{
+	11, // 0: serviceaccount.v1.ServiceAccount.scopes:type_name -> token.v1.EntityScope
+	12, // 1: serviceaccount.v1.ServiceAccount.created_at:type_name -> google.protobuf.Timestamp
+	12, // 2: serviceaccount.v1.ServiceAccount.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 3: serviceaccount.v1.CreateServiceAccountRequest.scopes:type_name -> token.v1.EntityScope
+	0,  // 4: serviceaccount.v1.CreateServiceAccountResponse.service_account:type_name -> serviceaccount.v1.ServiceAccount
+	0,  // 5: serviceaccount.v1.GetServiceAccountResponse.service_account:type_name -> serviceaccount.v1.ServiceAccount
+	0,  // 6: serviceaccount.v1.ListServiceAccountsResponse.service_accounts:type_name -> serviceaccount.v1.ServiceAccount
+	11, // 7: serviceaccount.v1.SetServiceAccountScopesRequest.scopes:type_name -> token.v1.EntityScope
+	0,  // 8: serviceaccount.v1.SetServiceAccountScopesResponse.service_account:type_name -> serviceaccount.v1.ServiceAccount
+	1,  // 9: serviceaccount.v1.ServiceAccountService.CreateServiceAccount:input_type -> serviceaccount.v1.CreateServiceAccountRequest
+	3,  // 10: serviceaccount.v1.ServiceAccountService.GetServiceAccount:input_type -> serviceaccount.v1.GetServiceAccountRequest
+	5,  // 11: serviceaccount.v1.ServiceAccountService.ListServiceAccounts:input_type -> serviceaccount.v1.ListServiceAccountsRequest
+	7,  // 12: serviceaccount.v1.ServiceAccountService.SetServiceAccountScopes:input_type -> serviceaccount.v1.SetServiceAccountScopesRequest
+	9,  // 13: serviceaccount.v1.ServiceAccountService.DeleteServiceAccount:input_type -> serviceaccount.v1.DeleteServiceAccountRequest
+	2,  // 14: serviceaccount.v1.ServiceAccountService.CreateServiceAccount:output_type -> serviceaccount.v1.CreateServiceAccountResponse
+	4,  // 15: serviceaccount.v1.ServiceAccountService.GetServiceAccount:output_type -> serviceaccount.v1.GetServiceAccountResponse
+	6,  // 16: serviceaccount.v1.ServiceAccountService.ListServiceAccounts:output_type -> serviceaccount.v1.ListServiceAccountsResponse
+	8,  // 17: serviceaccount.v1.ServiceAccountService.SetServiceAccountScopes:output_type -> serviceaccount.v1.SetServiceAccountScopesResponse
+	10, // 18: serviceaccount.v1.ServiceAccountService.DeleteServiceAccount:output_type -> serviceaccount.v1.DeleteServiceAccountResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_serviceaccount_v1_serviceaccount_proto_init() }
+func file_serviceaccount_v1_serviceaccount_proto_init() {
+	if File_serviceaccount_v1_serviceaccount_proto != nil {
+		return
+	}
+	file_serviceaccount_v1_serviceaccount_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_serviceaccount_v1_serviceaccount_proto_rawDesc), len(file_serviceaccount_v1_serviceaccount_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_serviceaccount_v1_serviceaccount_proto_goTypes,
+		DependencyIndexes: file_serviceaccount_v1_serviceaccount_proto_depIdxs,
+		MessageInfos:      file_serviceaccount_v1_serviceaccount_proto_msgTypes,
+	}.Build()
+	File_serviceaccount_v1_serviceaccount_proto = out.File
+	file_serviceaccount_v1_serviceaccount_proto_goTypes = nil
+	file_serviceaccount_v1_serviceaccount_proto_depIdxs = nil
+}
@@ -0,0 +1,236 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: serviceaccount/v1/serviceaccount.proto
+
+package serviceaccountv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/team-loco/loco/shared/proto/serviceaccount/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ServiceAccountServiceName is the fully-qualified name of the ServiceAccountService service.
+	ServiceAccountServiceName = "serviceaccount.v1.ServiceAccountService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ServiceAccountServiceCreateServiceAccountProcedure is the fully-qualified name of the
+	// ServiceAccountService's CreateServiceAccount RPC.
+	ServiceAccountServiceCreateServiceAccountProcedure = "/serviceaccount.v1.ServiceAccountService/CreateServiceAccount"
+	// ServiceAccountServiceGetServiceAccountProcedure is the fully-qualified name of the
+	// ServiceAccountService's GetServiceAccount RPC.
+	ServiceAccountServiceGetServiceAccountProcedure = "/serviceaccount.v1.ServiceAccountService/GetServiceAccount"
+	// ServiceAccountServiceListServiceAccountsProcedure is the fully-qualified name of the
+	// ServiceAccountService's ListServiceAccounts RPC.
+	ServiceAccountServiceListServiceAccountsProcedure = "/serviceaccount.v1.ServiceAccountService/ListServiceAccounts"
+	// ServiceAccountServiceSetServiceAccountScopesProcedure is the fully-qualified name of the
+	// ServiceAccountService's SetServiceAccountScopes RPC.
+	ServiceAccountServiceSetServiceAccountScopesProcedure = "/serviceaccount.v1.ServiceAccountService/SetServiceAccountScopes"
+	// ServiceAccountServiceDeleteServiceAccountProcedure is the fully-qualified name of the
+	// ServiceAccountService's DeleteServiceAccount RPC.
+	ServiceAccountServiceDeleteServiceAccountProcedure = "/serviceaccount.v1.ServiceAccountService/DeleteServiceAccount"
+)
+
+// ServiceAccountServiceClient is a client for the serviceaccount.v1.ServiceAccountService service.
+type ServiceAccountServiceClient interface {
+	// CreateServiceAccount creates a service account in a workspace.
+	CreateServiceAccount(context.Context, *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error)
+	// GetServiceAccount retrieves a service account by ID.
+	GetServiceAccount(context.Context, *connect.Request[v1.GetServiceAccountRequest]) (*connect.Response[v1.GetServiceAccountResponse], error)
+	// ListServiceAccounts lists the service accounts of a workspace.
+	ListServiceAccounts(context.Context, *connect.Request[v1.ListServiceAccountsRequest]) (*connect.Response[v1.ListServiceAccountsResponse], error)
+	// SetServiceAccountScopes replaces a service account's scopes. Existing tokens keep the scopes they were issued with.
+	SetServiceAccountScopes(context.Context, *connect.Request[v1.SetServiceAccountScopesRequest]) (*connect.Response[v1.SetServiceAccountScopesResponse], error)
+	// DeleteServiceAccount deletes a service account and revokes its tokens.
+	DeleteServiceAccount(context.Context, *connect.Request[v1.DeleteServiceAccountRequest]) (*connect.Response[v1.DeleteServiceAccountResponse], error)
+}
+
+// NewServiceAccountServiceClient constructs a client for the
+// serviceaccount.v1.ServiceAccountService service. By default, it uses the Connect protocol with
+// the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use
+// the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewServiceAccountServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceAccountServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	serviceAccountServiceMethods := v1.File_serviceaccount_v1_serviceaccount_proto.Services().ByName("ServiceAccountService").Methods()
+	return &serviceAccountServiceClient{
+		createServiceAccount: connect.NewClient[v1.CreateServiceAccountRequest, v1.CreateServiceAccountResponse](
+			httpClient,
+			baseURL+ServiceAccountServiceCreateServiceAccountProcedure,
+			connect.WithSchema(serviceAccountServiceMethods.ByName("CreateServiceAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		getServiceAccount: connect.NewClient[v1.GetServiceAccountRequest, v1.GetServiceAccountResponse](
+			httpClient,
+			baseURL+ServiceAccountServiceGetServiceAccountProcedure,
+			connect.WithSchema(serviceAccountServiceMethods.ByName("GetServiceAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		listServiceAccounts: connect.NewClient[v1.ListServiceAccountsRequest, v1.ListServiceAccountsResponse](
+			httpClient,
+			baseURL+ServiceAccountServiceListServiceAccountsProcedure,
+			connect.WithSchema(serviceAccountServiceMethods.ByName("ListServiceAccounts")),
+			connect.WithClientOptions(opts...),
+		),
+		setServiceAccountScopes: connect.NewClient[v1.SetServiceAccountScopesRequest, v1.SetServiceAccountScopesResponse](
+			httpClient,
+			baseURL+ServiceAccountServiceSetServiceAccountScopesProcedure,
+			connect.WithSchema(serviceAccountServiceMethods.ByName("SetServiceAccountScopes")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteServiceAccount: connect.NewClient[v1.DeleteServiceAccountRequest, v1.DeleteServiceAccountResponse](
+			httpClient,
+			baseURL+ServiceAccountServiceDeleteServiceAccountProcedure,
+			connect.WithSchema(serviceAccountServiceMethods.ByName("DeleteServiceAccount")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// serviceAccountServiceClient implements ServiceAccountServiceClient.
+type serviceAccountServiceClient struct {
+	createServiceAccount    *connect.Client[v1.CreateServiceAccountRequest, v1.CreateServiceAccountResponse]
+	getServiceAccount       *connect.Client[v1.GetServiceAccountRequest, v1.GetServiceAccountResponse]
+	listServiceAccounts     *connect.Client[v1.ListServiceAccountsRequest, v1.ListServiceAccountsResponse]
+	setServiceAccountScopes *connect.Client[v1.SetServiceAccountScopesRequest, v1.SetServiceAccountScopesResponse]
+	deleteServiceAccount    *connect.Client[v1.DeleteServiceAccountRequest, v1.DeleteServiceAccountResponse]
+}
+
+// CreateServiceAccount calls serviceaccount.v1.ServiceAccountService.CreateServiceAccount.
+func (c *serviceAccountServiceClient) CreateServiceAccount(ctx context.Context, req *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error) {
+	return c.createServiceAccount.CallUnary(ctx, req)
+}
+
+// GetServiceAccount calls serviceaccount.v1.ServiceAccountService.GetServiceAccount.
+func (c *serviceAccountServiceClient) GetServiceAccount(ctx context.Context, req *connect.Request[v1.GetServiceAccountRequest]) (*connect.Response[v1.GetServiceAccountResponse], error) {
+	return c.getServiceAccount.CallUnary(ctx, req)
+}
+
+// ListServiceAccounts calls serviceaccount.v1.ServiceAccountService.ListServiceAccounts.
+func (c *serviceAccountServiceClient) ListServiceAccounts(ctx context.Context, req *connect.Request[v1.ListServiceAccountsRequest]) (*connect.Response[v1.ListServiceAccountsResponse], error) {
+	return c.listServiceAccounts.CallUnary(ctx, req)
+}
+
+// SetServiceAccountScopes calls serviceaccount.v1.ServiceAccountService.SetServiceAccountScopes.
+func (c *serviceAccountServiceClient) SetServiceAccountScopes(ctx context.Context, req *connect.Request[v1.SetServiceAccountScopesRequest]) (*connect.Response[v1.SetServiceAccountScopesResponse], error) {
+	return c.setServiceAccountScopes.CallUnary(ctx, req)
+}
+
+// DeleteServiceAccount calls serviceaccount.v1.ServiceAccountService.DeleteServiceAccount.
+func (c *serviceAccountServiceClient) DeleteServiceAccount(ctx context.Context, req *connect.Request[v1.DeleteServiceAccountRequest]) (*connect.Response[v1.DeleteServiceAccountResponse], error) {
+	return c.deleteServiceAccount.CallUnary(ctx, req)
+}
+
+// ServiceAccountServiceHandler is an implementation of the serviceaccount.v1.ServiceAccountService
+// service.
+type ServiceAccountServiceHandler interface {
+	// CreateServiceAccount creates a service account in a workspace.
+	CreateServiceAccount(context.Context, *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error)
+	// GetServiceAccount retrieves a service account by ID.
+	GetServiceAccount(context.Context, *connect.Request[v1.GetServiceAccountRequest]) (*connect.Response[v1.GetServiceAccountResponse], error)
+	// ListServiceAccounts lists the service accounts of a workspace.
+	ListServiceAccounts(context.Context, *connect.Request[v1.ListServiceAccountsRequest]) (*connect.Response[v1.ListServiceAccountsResponse], error)
+	// SetServiceAccountScopes replaces a service account's scopes. Existing tokens keep the scopes they were issued with.
+	SetServiceAccountScopes(context.Context, *connect.Request[v1.SetServiceAccountScopesRequest]) (*connect.Response[v1.SetServiceAccountScopesResponse], error)
+	// DeleteServiceAccount deletes a service account and revokes its tokens.
+	DeleteServiceAccount(context.Context, *connect.Request[v1.DeleteServiceAccountRequest]) (*connect.Response[v1.DeleteServiceAccountResponse], error)
+}
+
+// NewServiceAccountServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewServiceAccountServiceHandler(svc ServiceAccountServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceAccountServiceMethods := v1.File_serviceaccount_v1_serviceaccount_proto.Services().ByName("ServiceAccountService").Methods()
+	serviceAccountServiceCreateServiceAccountHandler := connect.NewUnaryHandler(
+		ServiceAccountServiceCreateServiceAccountProcedure,
+		svc.CreateServiceAccount,
+		connect.WithSchema(serviceAccountServiceMethods.ByName("CreateServiceAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceAccountServiceGetServiceAccountHandler := connect.NewUnaryHandler(
+		ServiceAccountServiceGetServiceAccountProcedure,
+		svc.GetServiceAccount,
+		connect.WithSchema(serviceAccountServiceMethods.ByName("GetServiceAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceAccountServiceListServiceAccountsHandler := connect.NewUnaryHandler(
+		ServiceAccountServiceListServiceAccountsProcedure,
+		svc.ListServiceAccounts,
+		connect.WithSchema(serviceAccountServiceMethods.ByName("ListServiceAccounts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceAccountServiceSetServiceAccountScopesHandler := connect.NewUnaryHandler(
+		ServiceAccountServiceSetServiceAccountScopesProcedure,
+		svc.SetServiceAccountScopes,
+		connect.WithSchema(serviceAccountServiceMethods.ByName("SetServiceAccountScopes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceAccountServiceDeleteServiceAccountHandler := connect.NewUnaryHandler(
+		ServiceAccountServiceDeleteServiceAccountProcedure,
+		svc.DeleteServiceAccount,
+		connect.WithSchema(serviceAccountServiceMethods.ByName("DeleteServiceAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/serviceaccount.v1.ServiceAccountService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ServiceAccountServiceCreateServiceAccountProcedure:
+			serviceAccountServiceCreateServiceAccountHandler.ServeHTTP(w, r)
+		case ServiceAccountServiceGetServiceAccountProcedure:
+			serviceAccountServiceGetServiceAccountHandler.ServeHTTP(w, r)
+		case ServiceAccountServiceListServiceAccountsProcedure:
+			serviceAccountServiceListServiceAccountsHandler.ServeHTTP(w, r)
+		case ServiceAccountServiceSetServiceAccountScopesProcedure:
+			serviceAccountServiceSetServiceAccountScopesHandler.ServeHTTP(w, r)
+		case ServiceAccountServiceDeleteServiceAccountProcedure:
+			serviceAccountServiceDeleteServiceAccountHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedServiceAccountServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedServiceAccountServiceHandler struct{}
+
+func (UnimplementedServiceAccountServiceHandler) CreateServiceAccount(context.Context, *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("serviceaccount.v1.ServiceAccountService.CreateServiceAccount is not implemented"))
+}
+
+func (UnimplementedServiceAccountServiceHandler) GetServiceAccount(context.Context, *connect.Request[v1.GetServiceAccountRequest]) (*connect.Response[v1.GetServiceAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("serviceaccount.v1.ServiceAccountService.GetServiceAccount is not implemented"))
+}
+
+func (UnimplementedServiceAccountServiceHandler) ListServiceAccounts(context.Context, *connect.Request[v1.ListServiceAccountsRequest]) (*connect.Response[v1.ListServiceAccountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("serviceaccount.v1.ServiceAccountService.ListServiceAccounts is not implemented"))
+}
+
+func (UnimplementedServiceAccountServiceHandler) SetServiceAccountScopes(context.Context, *connect.Request[v1.SetServiceAccountScopesRequest]) (*connect.Response[v1.SetServiceAccountScopesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("serviceaccount.v1.ServiceAccountService.SetServiceAccountScopes is not implemented"))
+}
+
+func (UnimplementedServiceAccountServiceHandler) DeleteServiceAccount(context.Context, *connect.Request[v1.DeleteServiceAccountRequest]) (*connect.Response[v1.DeleteServiceAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("serviceaccount.v1.ServiceAccountService.DeleteServiceAccount is not implemented"))
+}
@@ -0,0 +1,866 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: slack/v1/slack.proto
+
+package slackv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SlackInstallation is the Slack team an organization installed the loco app in.
+type SlackInstallation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	TeamId        string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	InstalledBy   *int64                 `protobuf:"varint,4,opt,name=installed_by,json=installedBy,proto3,oneof" json:"installed_by,omitempty"` // the user who installed it
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlackInstallation) Reset() {
+	*x = SlackInstallation{}
+	mi := &file_slack_v1_slack_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlackInstallation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlackInstallation) ProtoMessage() {}
+
+func (x *SlackInstallation) ProtoReflect() protoreflect.Message {
+	mi := &file_slack_v1_slack_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlackInstallation.ProtoReflect.Descriptor instead.
+func (*SlackInstallation) Descriptor() ([]byte, []int) {
+	return file_slack_v1_slack_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SlackInstallation) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *SlackInstallation) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *SlackInstallation) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SlackInstallation) GetInstalledBy() int64 {
+	if x != nil && x.InstalledBy != nil {
+		return *x.InstalledBy
+	}
+	return 0
+}
+
+func (x *SlackInstallation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// SlackChannel is the channel a workspace posts to, and the slash command channel for it.
+type SlackChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ChannelName   string                 `protobuf:"bytes,3,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	Deployments   bool                   `protobuf:"varint,4,opt,name=deployments,proto3" json:"deployments,omitempty"` // post when deployments start, go live and fail
+	Alerts        bool                   `protobuf:"varint,5,opt,name=alerts,proto3" json:"alerts,omitempty"`           // post alerts of the workspace's rules
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlackChannel) Reset() {
+	*x = SlackChannel{}
+	mi := &file_slack_v1_slack_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlackChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlackChannel) ProtoMessage() {}
+
+func (x *SlackChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_slack_v1_slack_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlackChannel.ProtoReflect.Descriptor instead.
+func (*SlackChannel) Descriptor() ([]byte, []int) {
+	return file_slack_v1_slack_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SlackChannel) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *SlackChannel) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SlackChannel) GetChannelName() string {
+	if x != nil {
+		return x.ChannelName
+	}
+	return ""
+}
+
+func (x *SlackChannel) GetDeployments() bool {
+	if x != nil {
+		return x.Deployments
+	}
+	return false
+}
+
+func (x *SlackChannel) GetAlerts() bool {
+	if x != nil {
+		return x.Alerts
+	}
+	return false
+}
+
+// GetSlackInstallURLRequest is the request for an install URL.
+type GetSlackInstallURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSlackInstallURLRequest) Reset() {
+	*x = GetSlackInstallURLRequest{}
+	mi := &file_slack_v1_slack_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSlackInstallURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlackInstallURLRequest) ProtoMessage() {}
+
+func (x *GetSlackInstallURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_slack_v1_slack_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlackInstallURLRequest.ProtoReflect.Descriptor instead.
+func (*GetSlackInstallURLRequest) Descriptor() ([]byte, []int) {
+	return file_slack_v1_slack_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSlackInstallURLRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+// GetSlackInstallURLResponse contains the install URL, valid for 15 minutes.
+type GetSlackInstallURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSlackInstallURLResponse) Reset() {
+	*x = GetSlackInstallURLResponse{}
+	mi := &file_slack_v1_slack_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSlackInstallURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlackInstallURLResponse) ProtoMessage() {}
+
+func (x *GetSlackInstallURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_slack_v1_slack_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlackInstallURLResponse.ProtoReflect.Descriptor instead.
+func (*GetSlackInstallURLResponse) Descriptor() ([]byte, []int) {
+	return file_slack_v1_slack_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSlackInstallURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// GetSlackInstallationRequest is the request for an org's installation.
+type GetSlackInstallationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSlackInstallationRequest) Reset() {
+	*x = GetSlackInstallationRequest{}
+	mi := &file_slack_v1_slack_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSlackInstallationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlackInstallationRequest) ProtoMessage() {}
+
+func (x *GetSlackInstallationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_slack_v1_slack_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlackInstallationRequest.ProtoReflect.Descriptor instead.
+func (*GetSlackInstallationRequest) Descriptor() ([]byte, []int) {
+	return file_slack_v1_slack_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSlackInstallationRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+// GetSlackInstallationResponse contains the org's installation.
+type GetSlackInstallationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Installation  *SlackInstallation     `protobuf:"bytes,1,opt,name=installation,proto3,oneof" json:"installation,omitempty"` // unset when the app is not installed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSlackInstallationResponse) Reset() {
+	*x = GetSlackInstallationResponse{}
+	mi := &file_slack_v1_slack_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSlackInstallationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlackInstallationResponse) ProtoMessage() {}
+
+func (x *GetSlackInstallationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_slack_v1_slack_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlackInstallationResponse.ProtoReflect.Descriptor instead.
+func (*GetSlackInstallationResponse) Descriptor() ([]byte, []int) {
+	return file_slack_v1_slack_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSlackInstallationResponse) GetInstallation() *SlackInstallation {
+	if x != nil {
+		return x.Installation
+	}
+	return nil
+}
+
+// DeleteSlackInstallationRequest is the request to uninstall the app from an org.
+type DeleteSlackInstallationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSlackInstallationRequest) Reset() {
+	*x = DeleteSlackInstallationRequest{}
+	mi := &file_slack_v1_slack_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSlackInstallationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSlackInstallationRequest) ProtoMessage() {}
+
+func (x *DeleteSlackInstallationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_slack_v1_slack_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSlackInstallationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSlackInstallationRequest) Descriptor() ([]byte, []int) {
+	return file_slack_v1_slack_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteSlackInstallationRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+// DeleteSlackInstallationResponse is empty.
+type DeleteSlackInstallationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSlackInstallationResponse) Reset() {
+	*x = DeleteSlackInstallationResponse{}
+	mi := &file_slack_v1_slack_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSlackInstallationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSlackInstallationResponse) ProtoMessage() {}
+
+func (x *DeleteSlackInstallationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_slack_v1_slack_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSlackInstallationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSlackInstallationResponse) Descriptor() ([]byte, []int) {
+	return file_slack_v1_slack_proto_rawDescGZIP(), []int{7}
+}
+
+// GetWorkspaceSlackChannelRequest is the request for a workspace's channel.
+type GetWorkspaceSlackChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceSlackChannelRequest) Reset() {
+	*x = GetWorkspaceSlackChannelRequest{}
+	mi := &file_slack_v1_slack_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceSlackChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceSlackChannelRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceSlackChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_slack_v1_slack_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceSlackChannelRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceSlackChannelRequest) Descriptor() ([]byte, []int) {
+	return file_slack_v1_slack_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetWorkspaceSlackChannelRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+// GetWorkspaceSlackChannelResponse contains the workspace's channel.
+type GetWorkspaceSlackChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *SlackChannel          `protobuf:"bytes,1,opt,name=channel,proto3,oneof" json:"channel,omitempty"` // unset when the workspace has none
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceSlackChannelResponse) Reset() {
+	*x = GetWorkspaceSlackChannelResponse{}
+	mi := &file_slack_v1_slack_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceSlackChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceSlackChannelResponse) ProtoMessage() {}
+
+func (x *GetWorkspaceSlackChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_slack_v1_slack_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceSlackChannelResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceSlackChannelResponse) Descriptor() ([]byte, []int) {
+	return file_slack_v1_slack_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetWorkspaceSlackChannelResponse) GetChannel() *SlackChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+// SetWorkspaceSlackChannelRequest is the request to set a workspace's channel.
+type SetWorkspaceSlackChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // e.g. C024BE91L
+	Deployments   *bool                  `protobuf:"varint,3,opt,name=deployments,proto3,oneof" json:"deployments,omitempty"`       // defaults to true
+	Alerts        *bool                  `protobuf:"varint,4,opt,name=alerts,proto3,oneof" json:"alerts,omitempty"`                 // defaults to true
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWorkspaceSlackChannelRequest) Reset() {
+	*x = SetWorkspaceSlackChannelRequest{}
+	mi := &file_slack_v1_slack_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorkspaceSlackChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkspaceSlackChannelRequest) ProtoMessage() {}
+
+func (x *SetWorkspaceSlackChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_slack_v1_slack_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorkspaceSlackChannelRequest.ProtoReflect.Descriptor instead.
+func (*SetWorkspaceSlackChannelRequest) Descriptor() ([]byte, []int) {
+	return file_slack_v1_slack_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetWorkspaceSlackChannelRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *SetWorkspaceSlackChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SetWorkspaceSlackChannelRequest) GetDeployments() bool {
+	if x != nil && x.Deployments != nil {
+		return *x.Deployments
+	}
+	return false
+}
+
+func (x *SetWorkspaceSlackChannelRequest) GetAlerts() bool {
+	if x != nil && x.Alerts != nil {
+		return *x.Alerts
+	}
+	return false
+}
+
+// SetWorkspaceSlackChannelResponse contains the channel as stored.
+type SetWorkspaceSlackChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *SlackChannel          `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWorkspaceSlackChannelResponse) Reset() {
+	*x = SetWorkspaceSlackChannelResponse{}
+	mi := &file_slack_v1_slack_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorkspaceSlackChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkspaceSlackChannelResponse) ProtoMessage() {}
+
+func (x *SetWorkspaceSlackChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_slack_v1_slack_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorkspaceSlackChannelResponse.ProtoReflect.Descriptor instead.
+func (*SetWorkspaceSlackChannelResponse) Descriptor() ([]byte, []int) {
+	return file_slack_v1_slack_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetWorkspaceSlackChannelResponse) GetChannel() *SlackChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+// DeleteWorkspaceSlackChannelRequest is the request to remove a workspace's channel.
+type DeleteWorkspaceSlackChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWorkspaceSlackChannelRequest) Reset() {
+	*x = DeleteWorkspaceSlackChannelRequest{}
+	mi := &file_slack_v1_slack_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWorkspaceSlackChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkspaceSlackChannelRequest) ProtoMessage() {}
+
+func (x *DeleteWorkspaceSlackChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_slack_v1_slack_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkspaceSlackChannelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceSlackChannelRequest) Descriptor() ([]byte, []int) {
+	return file_slack_v1_slack_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteWorkspaceSlackChannelRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+// DeleteWorkspaceSlackChannelResponse is empty.
+type DeleteWorkspaceSlackChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWorkspaceSlackChannelResponse) Reset() {
+	*x = DeleteWorkspaceSlackChannelResponse{}
+	mi := &file_slack_v1_slack_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWorkspaceSlackChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkspaceSlackChannelResponse) ProtoMessage() {}
+
+func (x *DeleteWorkspaceSlackChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_slack_v1_slack_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkspaceSlackChannelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceSlackChannelResponse) Descriptor() ([]byte, []int) {
+	return file_slack_v1_slack_proto_rawDescGZIP(), []int{13}
+}
+
+var File_slack_v1_slack_proto protoreflect.FileDescriptor
+
+const file_slack_v1_slack_proto_rawDesc = "" +
+	"\n" +
+	"\x14slack/v1/slack.proto\x12\bslack.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd4\x01\n" +
+	"\x11SlackInstallation\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\tR\x06teamId\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12&\n" +
+	"\finstalled_by\x18\x04 \x01(\x03H\x00R\vinstalledBy\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0f\n" +
+	"\r_installed_by\"\xad\x01\n" +
+	"\fSlackChannel\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12!\n" +
+	"\fchannel_name\x18\x03 \x01(\tR\vchannelName\x12 \n" +
+	"\vdeployments\x18\x04 \x01(\bR\vdeployments\x12\x16\n" +
+	"\x06alerts\x18\x05 \x01(\bR\x06alerts\"2\n" +
+	"\x19GetSlackInstallURLRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\".\n" +
+	"\x1aGetSlackInstallURLResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"4\n" +
+	"\x1bGetSlackInstallationRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\"u\n" +
+	"\x1cGetSlackInstallationResponse\x12D\n" +
+	"\finstallation\x18\x01 \x01(\v2\x1b.slack.v1.SlackInstallationH\x00R\finstallation\x88\x01\x01B\x0f\n" +
+	"\r_installation\"7\n" +
+	"\x1eDeleteSlackInstallationRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\"!\n" +
+	"\x1fDeleteSlackInstallationResponse\"D\n" +
+	"\x1fGetWorkspaceSlackChannelRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"e\n" +
+	" GetWorkspaceSlackChannelResponse\x125\n" +
+	"\achannel\x18\x01 \x01(\v2\x16.slack.v1.SlackChannelH\x00R\achannel\x88\x01\x01B\n" +
+	"\n" +
+	"\b_channel\"\xc2\x01\n" +
+	"\x1fSetWorkspaceSlackChannelRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12%\n" +
+	"\vdeployments\x18\x03 \x01(\bH\x00R\vdeployments\x88\x01\x01\x12\x1b\n" +
+	"\x06alerts\x18\x04 \x01(\bH\x01R\x06alerts\x88\x01\x01B\x0e\n" +
+	"\f_deploymentsB\t\n" +
+	"\a_alerts\"T\n" +
+	" SetWorkspaceSlackChannelResponse\x120\n" +
+	"\achannel\x18\x01 \x01(\v2\x16.slack.v1.SlackChannelR\achannel\"G\n" +
+	"\"DeleteWorkspaceSlackChannelRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"%\n" +
+	"#DeleteWorkspaceSlackChannelResponse2\xa8\x05\n" +
+	"\fSlackService\x12_\n" +
+	"\x12GetSlackInstallURL\x12#.slack.v1.GetSlackInstallURLRequest\x1a$.slack.v1.GetSlackInstallURLResponse\x12e\n" +
+	"\x14GetSlackInstallation\x12%.slack.v1.GetSlackInstallationRequest\x1a&.slack.v1.GetSlackInstallationResponse\x12n\n" +
+	"\x17DeleteSlackInstallation\x12(.slack.v1.DeleteSlackInstallationRequest\x1a).slack.v1.DeleteSlackInstallationResponse\x12q\n" +
+	"\x18GetWorkspaceSlackChannel\x12).slack.v1.GetWorkspaceSlackChannelRequest\x1a*.slack.v1.GetWorkspaceSlackChannelResponse\x12q\n" +
+	"\x18SetWorkspaceSlackChannel\x12).slack.v1.SetWorkspaceSlackChannelRequest\x1a*.slack.v1.SetWorkspaceSlackChannelResponse\x12z\n" +
+	"\x1bDeleteWorkspaceSlackChannel\x12,.slack.v1.DeleteWorkspaceSlackChannelRequest\x1a-.slack.v1.DeleteWorkspaceSlackChannelResponseB9Z7github.com/team-loco/loco/shared/proto/slack/v1;slackv1b\x06proto3"
+
+var (
+	file_slack_v1_slack_proto_rawDescOnce sync.Once
+	file_slack_v1_slack_proto_rawDescData []byte
+)
+
+func file_slack_v1_slack_proto_rawDescGZIP() []byte {
+	file_slack_v1_slack_proto_rawDescOnce.Do(func() {
+		file_slack_v1_slack_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_slack_v1_slack_proto_rawDesc), len(file_slack_v1_slack_proto_rawDesc)))
+	})
+	return file_slack_v1_slack_proto_rawDescData
+}
+
+var file_slack_v1_slack_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_slack_v1_slack_proto_goTypes = []any{
+	(*SlackInstallation)(nil),                   // 0: slack.v1.SlackInstallation
+	(*SlackChannel)(nil),                        // 1: slack.v1.SlackChannel
+	(*GetSlackInstallURLRequest)(nil),           // 2: slack.v1.GetSlackInstallURLRequest
+	(*GetSlackInstallURLResponse)(nil),          // 3: slack.v1.GetSlackInstallURLResponse
+	(*GetSlackInstallationRequest)(nil),         // 4: slack.v1.GetSlackInstallationRequest
+	(*GetSlackInstallationResponse)(nil),        // 5: slack.v1.GetSlackInstallationResponse
+	(*DeleteSlackInstallationRequest)(nil),      // 6: slack.v1.DeleteSlackInstallationRequest
+	(*DeleteSlackInstallationResponse)(nil),     // 7: slack.v1.DeleteSlackInstallationResponse
+	(*GetWorkspaceSlackChannelRequest)(nil),     // 8: slack.v1.GetWorkspaceSlackChannelRequest
+	(*GetWorkspaceSlackChannelResponse)(nil),    // 9: slack.v1.GetWorkspaceSlackChannelResponse
+	(*SetWorkspaceSlackChannelRequest)(nil),     // 10: slack.v1.SetWorkspaceSlackChannelRequest
+	(*SetWorkspaceSlackChannelResponse)(nil),    // 11: slack.v1.SetWorkspaceSlackChannelResponse
+	(*DeleteWorkspaceSlackChannelRequest)(nil),  // 12: slack.v1.DeleteWorkspaceSlackChannelRequest
+	(*DeleteWorkspaceSlackChannelResponse)(nil), // 13: slack.v1.DeleteWorkspaceSlackChannelResponse
+	(*timestamppb.Timestamp)(nil),               // 14: google.protobuf.Timestamp
+}
+var file_slack_v1_slack_proto_depIdxs = []int32{
+	14, // 0: slack.v1.SlackInstallation.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: slack.v1.GetSlackInstallationResponse.installation:type_name -> slack.v1.SlackInstallation
+	1,  // 2: slack.v1.GetWorkspaceSlackChannelResponse.channel:type_name -> slack.v1.SlackChannel
+	1,  // 3: slack.v1.SetWorkspaceSlackChannelResponse.channel:type_name -> slack.v1.SlackChannel
+	2,  // 4: slack.v1.SlackService.GetSlackInstallURL:input_type -> slack.v1.GetSlackInstallURLRequest
+	4,  // 5: slack.v1.SlackService.GetSlackInstallation:input_type -> slack.v1.GetSlackInstallationRequest
+	6,  // 6: slack.v1.SlackService.DeleteSlackInstallation:input_type -> slack.v1.DeleteSlackInstallationRequest
+	8,  // 7: slack.v1.SlackService.GetWorkspaceSlackChannel:input_type -> slack.v1.GetWorkspaceSlackChannelRequest
+	10, // 8: slack.v1.SlackService.SetWorkspaceSlackChannel:input_type -> slack.v1.SetWorkspaceSlackChannelRequest
+	12, // 9: slack.v1.SlackService.DeleteWorkspaceSlackChannel:input_type -> slack.v1.DeleteWorkspaceSlackChannelRequest
+	3,  // 10: slack.v1.SlackService.GetSlackInstallURL:output_type -> slack.v1.GetSlackInstallURLResponse
+	5,  // 11: slack.v1.SlackService.GetSlackInstallation:output_type -> slack.v1.GetSlackInstallationResponse
+	7,  // 12: slack.v1.SlackService.DeleteSlackInstallation:output_type -> slack.v1.DeleteSlackInstallationResponse
+	9,  // 13: slack.v1.SlackService.GetWorkspaceSlackChannel:output_type -> slack.v1.GetWorkspaceSlackChannelResponse
+	11, // 14: slack.v1.SlackService.SetWorkspaceSlackChannel:output_type -> slack.v1.SetWorkspaceSlackChannelResponse
+	13, // 15: slack.v1.SlackService.DeleteWorkspaceSlackChannel:output_type -> slack.v1.DeleteWorkspaceSlackChannelResponse
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_slack_v1_slack_proto_init() }
+func file_slack_v1_slack_proto_init() {
+	if File_slack_v1_slack_proto != nil {
+		return
+	}
+	file_slack_v1_slack_proto_msgTypes[0].OneofWrappers = []any{}
+	file_slack_v1_slack_proto_msgTypes[5].OneofWrappers = []any{}
+	file_slack_v1_slack_proto_msgTypes[9].OneofWrappers = []any{}
+	file_slack_v1_slack_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_slack_v1_slack_proto_rawDesc), len(file_slack_v1_slack_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_slack_v1_slack_proto_goTypes,
+		DependencyIndexes: file_slack_v1_slack_proto_depIdxs,
+		MessageInfos:      file_slack_v1_slack_proto_msgTypes,
+	}.Build()
+	File_slack_v1_slack_proto = out.File
+	file_slack_v1_slack_proto_goTypes = nil
+	file_slack_v1_slack_proto_depIdxs = nil
+}
@@ -0,0 +1,266 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: slack/v1/slack.proto
+
+package slackv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/team-loco/loco/shared/proto/slack/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SlackServiceName is the fully-qualified name of the SlackService service.
+	SlackServiceName = "slack.v1.SlackService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SlackServiceGetSlackInstallURLProcedure is the fully-qualified name of the SlackService's
+	// GetSlackInstallURL RPC.
+	SlackServiceGetSlackInstallURLProcedure = "/slack.v1.SlackService/GetSlackInstallURL"
+	// SlackServiceGetSlackInstallationProcedure is the fully-qualified name of the SlackService's
+	// GetSlackInstallation RPC.
+	SlackServiceGetSlackInstallationProcedure = "/slack.v1.SlackService/GetSlackInstallation"
+	// SlackServiceDeleteSlackInstallationProcedure is the fully-qualified name of the SlackService's
+	// DeleteSlackInstallation RPC.
+	SlackServiceDeleteSlackInstallationProcedure = "/slack.v1.SlackService/DeleteSlackInstallation"
+	// SlackServiceGetWorkspaceSlackChannelProcedure is the fully-qualified name of the SlackService's
+	// GetWorkspaceSlackChannel RPC.
+	SlackServiceGetWorkspaceSlackChannelProcedure = "/slack.v1.SlackService/GetWorkspaceSlackChannel"
+	// SlackServiceSetWorkspaceSlackChannelProcedure is the fully-qualified name of the SlackService's
+	// SetWorkspaceSlackChannel RPC.
+	SlackServiceSetWorkspaceSlackChannelProcedure = "/slack.v1.SlackService/SetWorkspaceSlackChannel"
+	// SlackServiceDeleteWorkspaceSlackChannelProcedure is the fully-qualified name of the
+	// SlackService's DeleteWorkspaceSlackChannel RPC.
+	SlackServiceDeleteWorkspaceSlackChannelProcedure = "/slack.v1.SlackService/DeleteWorkspaceSlackChannel"
+)
+
+// SlackServiceClient is a client for the slack.v1.SlackService service.
+type SlackServiceClient interface {
+	// GetSlackInstallURL returns where an org admin goes to install the app. Requires admin on the org.
+	GetSlackInstallURL(context.Context, *connect.Request[v1.GetSlackInstallURLRequest]) (*connect.Response[v1.GetSlackInstallURLResponse], error)
+	// GetSlackInstallation returns the org's installation, if any.
+	GetSlackInstallation(context.Context, *connect.Request[v1.GetSlackInstallationRequest]) (*connect.Response[v1.GetSlackInstallationResponse], error)
+	// DeleteSlackInstallation uninstalls the app from the org and removes its workspaces' channels.
+	DeleteSlackInstallation(context.Context, *connect.Request[v1.DeleteSlackInstallationRequest]) (*connect.Response[v1.DeleteSlackInstallationResponse], error)
+	// GetWorkspaceSlackChannel returns the workspace's channel, if any.
+	GetWorkspaceSlackChannel(context.Context, *connect.Request[v1.GetWorkspaceSlackChannelRequest]) (*connect.Response[v1.GetWorkspaceSlackChannelResponse], error)
+	// SetWorkspaceSlackChannel sets the workspace's channel. The app must be able to see the channel.
+	SetWorkspaceSlackChannel(context.Context, *connect.Request[v1.SetWorkspaceSlackChannelRequest]) (*connect.Response[v1.SetWorkspaceSlackChannelResponse], error)
+	// DeleteWorkspaceSlackChannel stops the workspace posting to Slack.
+	DeleteWorkspaceSlackChannel(context.Context, *connect.Request[v1.DeleteWorkspaceSlackChannelRequest]) (*connect.Response[v1.DeleteWorkspaceSlackChannelResponse], error)
+}
+
+// NewSlackServiceClient constructs a client for the slack.v1.SlackService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSlackServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SlackServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	slackServiceMethods := v1.File_slack_v1_slack_proto.Services().ByName("SlackService").Methods()
+	return &slackServiceClient{
+		getSlackInstallURL: connect.NewClient[v1.GetSlackInstallURLRequest, v1.GetSlackInstallURLResponse](
+			httpClient,
+			baseURL+SlackServiceGetSlackInstallURLProcedure,
+			connect.WithSchema(slackServiceMethods.ByName("GetSlackInstallURL")),
+			connect.WithClientOptions(opts...),
+		),
+		getSlackInstallation: connect.NewClient[v1.GetSlackInstallationRequest, v1.GetSlackInstallationResponse](
+			httpClient,
+			baseURL+SlackServiceGetSlackInstallationProcedure,
+			connect.WithSchema(slackServiceMethods.ByName("GetSlackInstallation")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSlackInstallation: connect.NewClient[v1.DeleteSlackInstallationRequest, v1.DeleteSlackInstallationResponse](
+			httpClient,
+			baseURL+SlackServiceDeleteSlackInstallationProcedure,
+			connect.WithSchema(slackServiceMethods.ByName("DeleteSlackInstallation")),
+			connect.WithClientOptions(opts...),
+		),
+		getWorkspaceSlackChannel: connect.NewClient[v1.GetWorkspaceSlackChannelRequest, v1.GetWorkspaceSlackChannelResponse](
+			httpClient,
+			baseURL+SlackServiceGetWorkspaceSlackChannelProcedure,
+			connect.WithSchema(slackServiceMethods.ByName("GetWorkspaceSlackChannel")),
+			connect.WithClientOptions(opts...),
+		),
+		setWorkspaceSlackChannel: connect.NewClient[v1.SetWorkspaceSlackChannelRequest, v1.SetWorkspaceSlackChannelResponse](
+			httpClient,
+			baseURL+SlackServiceSetWorkspaceSlackChannelProcedure,
+			connect.WithSchema(slackServiceMethods.ByName("SetWorkspaceSlackChannel")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWorkspaceSlackChannel: connect.NewClient[v1.DeleteWorkspaceSlackChannelRequest, v1.DeleteWorkspaceSlackChannelResponse](
+			httpClient,
+			baseURL+SlackServiceDeleteWorkspaceSlackChannelProcedure,
+			connect.WithSchema(slackServiceMethods.ByName("DeleteWorkspaceSlackChannel")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// slackServiceClient implements SlackServiceClient.
+type slackServiceClient struct {
+	getSlackInstallURL          *connect.Client[v1.GetSlackInstallURLRequest, v1.GetSlackInstallURLResponse]
+	getSlackInstallation        *connect.Client[v1.GetSlackInstallationRequest, v1.GetSlackInstallationResponse]
+	deleteSlackInstallation     *connect.Client[v1.DeleteSlackInstallationRequest, v1.DeleteSlackInstallationResponse]
+	getWorkspaceSlackChannel    *connect.Client[v1.GetWorkspaceSlackChannelRequest, v1.GetWorkspaceSlackChannelResponse]
+	setWorkspaceSlackChannel    *connect.Client[v1.SetWorkspaceSlackChannelRequest, v1.SetWorkspaceSlackChannelResponse]
+	deleteWorkspaceSlackChannel *connect.Client[v1.DeleteWorkspaceSlackChannelRequest, v1.DeleteWorkspaceSlackChannelResponse]
+}
+
+// GetSlackInstallURL calls slack.v1.SlackService.GetSlackInstallURL.
+func (c *slackServiceClient) GetSlackInstallURL(ctx context.Context, req *connect.Request[v1.GetSlackInstallURLRequest]) (*connect.Response[v1.GetSlackInstallURLResponse], error) {
+	return c.getSlackInstallURL.CallUnary(ctx, req)
+}
+
+// GetSlackInstallation calls slack.v1.SlackService.GetSlackInstallation.
+func (c *slackServiceClient) GetSlackInstallation(ctx context.Context, req *connect.Request[v1.GetSlackInstallationRequest]) (*connect.Response[v1.GetSlackInstallationResponse], error) {
+	return c.getSlackInstallation.CallUnary(ctx, req)
+}
+
+// DeleteSlackInstallation calls slack.v1.SlackService.DeleteSlackInstallation.
+func (c *slackServiceClient) DeleteSlackInstallation(ctx context.Context, req *connect.Request[v1.DeleteSlackInstallationRequest]) (*connect.Response[v1.DeleteSlackInstallationResponse], error) {
+	return c.deleteSlackInstallation.CallUnary(ctx, req)
+}
+
+// GetWorkspaceSlackChannel calls slack.v1.SlackService.GetWorkspaceSlackChannel.
+func (c *slackServiceClient) GetWorkspaceSlackChannel(ctx context.Context, req *connect.Request[v1.GetWorkspaceSlackChannelRequest]) (*connect.Response[v1.GetWorkspaceSlackChannelResponse], error) {
+	return c.getWorkspaceSlackChannel.CallUnary(ctx, req)
+}
+
+// SetWorkspaceSlackChannel calls slack.v1.SlackService.SetWorkspaceSlackChannel.
+func (c *slackServiceClient) SetWorkspaceSlackChannel(ctx context.Context, req *connect.Request[v1.SetWorkspaceSlackChannelRequest]) (*connect.Response[v1.SetWorkspaceSlackChannelResponse], error) {
+	return c.setWorkspaceSlackChannel.CallUnary(ctx, req)
+}
+
+// DeleteWorkspaceSlackChannel calls slack.v1.SlackService.DeleteWorkspaceSlackChannel.
+func (c *slackServiceClient) DeleteWorkspaceSlackChannel(ctx context.Context, req *connect.Request[v1.DeleteWorkspaceSlackChannelRequest]) (*connect.Response[v1.DeleteWorkspaceSlackChannelResponse], error) {
+	return c.deleteWorkspaceSlackChannel.CallUnary(ctx, req)
+}
+
+// SlackServiceHandler is an implementation of the slack.v1.SlackService service.
+type SlackServiceHandler interface {
+	// GetSlackInstallURL returns where an org admin goes to install the app. Requires admin on the org.
+	GetSlackInstallURL(context.Context, *connect.Request[v1.GetSlackInstallURLRequest]) (*connect.Response[v1.GetSlackInstallURLResponse], error)
+	// GetSlackInstallation returns the org's installation, if any.
+	GetSlackInstallation(context.Context, *connect.Request[v1.GetSlackInstallationRequest]) (*connect.Response[v1.GetSlackInstallationResponse], error)
+	// DeleteSlackInstallation uninstalls the app from the org and removes its workspaces' channels.
+	DeleteSlackInstallation(context.Context, *connect.Request[v1.DeleteSlackInstallationRequest]) (*connect.Response[v1.DeleteSlackInstallationResponse], error)
+	// GetWorkspaceSlackChannel returns the workspace's channel, if any.
+	GetWorkspaceSlackChannel(context.Context, *connect.Request[v1.GetWorkspaceSlackChannelRequest]) (*connect.Response[v1.GetWorkspaceSlackChannelResponse], error)
+	// SetWorkspaceSlackChannel sets the workspace's channel. The app must be able to see the channel.
+	SetWorkspaceSlackChannel(context.Context, *connect.Request[v1.SetWorkspaceSlackChannelRequest]) (*connect.Response[v1.SetWorkspaceSlackChannelResponse], error)
+	// DeleteWorkspaceSlackChannel stops the workspace posting to Slack.
+	DeleteWorkspaceSlackChannel(context.Context, *connect.Request[v1.DeleteWorkspaceSlackChannelRequest]) (*connect.Response[v1.DeleteWorkspaceSlackChannelResponse], error)
+}
+
+// NewSlackServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSlackServiceHandler(svc SlackServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	slackServiceMethods := v1.File_slack_v1_slack_proto.Services().ByName("SlackService").Methods()
+	slackServiceGetSlackInstallURLHandler := connect.NewUnaryHandler(
+		SlackServiceGetSlackInstallURLProcedure,
+		svc.GetSlackInstallURL,
+		connect.WithSchema(slackServiceMethods.ByName("GetSlackInstallURL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	slackServiceGetSlackInstallationHandler := connect.NewUnaryHandler(
+		SlackServiceGetSlackInstallationProcedure,
+		svc.GetSlackInstallation,
+		connect.WithSchema(slackServiceMethods.ByName("GetSlackInstallation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	slackServiceDeleteSlackInstallationHandler := connect.NewUnaryHandler(
+		SlackServiceDeleteSlackInstallationProcedure,
+		svc.DeleteSlackInstallation,
+		connect.WithSchema(slackServiceMethods.ByName("DeleteSlackInstallation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	slackServiceGetWorkspaceSlackChannelHandler := connect.NewUnaryHandler(
+		SlackServiceGetWorkspaceSlackChannelProcedure,
+		svc.GetWorkspaceSlackChannel,
+		connect.WithSchema(slackServiceMethods.ByName("GetWorkspaceSlackChannel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	slackServiceSetWorkspaceSlackChannelHandler := connect.NewUnaryHandler(
+		SlackServiceSetWorkspaceSlackChannelProcedure,
+		svc.SetWorkspaceSlackChannel,
+		connect.WithSchema(slackServiceMethods.ByName("SetWorkspaceSlackChannel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	slackServiceDeleteWorkspaceSlackChannelHandler := connect.NewUnaryHandler(
+		SlackServiceDeleteWorkspaceSlackChannelProcedure,
+		svc.DeleteWorkspaceSlackChannel,
+		connect.WithSchema(slackServiceMethods.ByName("DeleteWorkspaceSlackChannel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/slack.v1.SlackService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SlackServiceGetSlackInstallURLProcedure:
+			slackServiceGetSlackInstallURLHandler.ServeHTTP(w, r)
+		case SlackServiceGetSlackInstallationProcedure:
+			slackServiceGetSlackInstallationHandler.ServeHTTP(w, r)
+		case SlackServiceDeleteSlackInstallationProcedure:
+			slackServiceDeleteSlackInstallationHandler.ServeHTTP(w, r)
+		case SlackServiceGetWorkspaceSlackChannelProcedure:
+			slackServiceGetWorkspaceSlackChannelHandler.ServeHTTP(w, r)
+		case SlackServiceSetWorkspaceSlackChannelProcedure:
+			slackServiceSetWorkspaceSlackChannelHandler.ServeHTTP(w, r)
+		case SlackServiceDeleteWorkspaceSlackChannelProcedure:
+			slackServiceDeleteWorkspaceSlackChannelHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSlackServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSlackServiceHandler struct{}
+
+func (UnimplementedSlackServiceHandler) GetSlackInstallURL(context.Context, *connect.Request[v1.GetSlackInstallURLRequest]) (*connect.Response[v1.GetSlackInstallURLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("slack.v1.SlackService.GetSlackInstallURL is not implemented"))
+}
+
+func (UnimplementedSlackServiceHandler) GetSlackInstallation(context.Context, *connect.Request[v1.GetSlackInstallationRequest]) (*connect.Response[v1.GetSlackInstallationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("slack.v1.SlackService.GetSlackInstallation is not implemented"))
+}
+
+func (UnimplementedSlackServiceHandler) DeleteSlackInstallation(context.Context, *connect.Request[v1.DeleteSlackInstallationRequest]) (*connect.Response[v1.DeleteSlackInstallationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("slack.v1.SlackService.DeleteSlackInstallation is not implemented"))
+}
+
+func (UnimplementedSlackServiceHandler) GetWorkspaceSlackChannel(context.Context, *connect.Request[v1.GetWorkspaceSlackChannelRequest]) (*connect.Response[v1.GetWorkspaceSlackChannelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("slack.v1.SlackService.GetWorkspaceSlackChannel is not implemented"))
+}
+
+func (UnimplementedSlackServiceHandler) SetWorkspaceSlackChannel(context.Context, *connect.Request[v1.SetWorkspaceSlackChannelRequest]) (*connect.Response[v1.SetWorkspaceSlackChannelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("slack.v1.SlackService.SetWorkspaceSlackChannel is not implemented"))
+}
+
+func (UnimplementedSlackServiceHandler) DeleteWorkspaceSlackChannel(context.Context, *connect.Request[v1.DeleteWorkspaceSlackChannelRequest]) (*connect.Response[v1.DeleteWorkspaceSlackChannelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("slack.v1.SlackService.DeleteWorkspaceSlackChannel is not implemented"))
+}
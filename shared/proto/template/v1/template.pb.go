@@ -0,0 +1,784 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: template/v1/template.proto
+
+package templatev1
+
+import (
+	v11 "github.com/team-loco/loco/shared/proto/deployment/v1"
+	v1 "github.com/team-loco/loco/shared/proto/resource/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TemplateParameter is a value the user supplies when instantiating a template.
+// Names, subdomains and env vars of the template's resources reference it as ${name}.
+type TemplateParameter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Generated     bool                   `protobuf:"varint,5,opt,name=generated,proto3" json:"generated,omitempty"` // a random secret is generated when the value is omitted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateParameter) Reset() {
+	*x = TemplateParameter{}
+	mi := &file_template_v1_template_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateParameter) ProtoMessage() {}
+
+func (x *TemplateParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateParameter.ProtoReflect.Descriptor instead.
+func (*TemplateParameter) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TemplateParameter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateParameter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TemplateParameter) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *TemplateParameter) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *TemplateParameter) GetGenerated() bool {
+	if x != nil {
+		return x.Generated
+	}
+	return false
+}
+
+// TemplateResource is a service created when a template is instantiated.
+type TemplateResource struct {
+	state      protoimpl.MessageState     `protogen:"open.v1"`
+	Key        string                     `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`               // identifies the resource within the template, e.g. "db"
+	Name       string                     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`             // e.g. "${app_name}-db"
+	Subdomain  string                     `protobuf:"bytes,3,opt,name=subdomain,proto3" json:"subdomain,omitempty"`   // platform subdomain, e.g. "${app_name}"
+	Spec       *v1.ServiceSpec            `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`             // regions are filled in at instantiation
+	Deployment *v11.ServiceDeploymentSpec `protobuf:"bytes,5,opt,name=deployment,proto3" json:"deployment,omitempty"` // initial deployment
+	// keys of resources this one connects to. they are created first, and env vars
+	// may reference their in-cluster address as ${key.host} and ${key.port}.
+	Links         []string `protobuf:"bytes,6,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateResource) Reset() {
+	*x = TemplateResource{}
+	mi := &file_template_v1_template_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateResource) ProtoMessage() {}
+
+func (x *TemplateResource) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateResource.ProtoReflect.Descriptor instead.
+func (*TemplateResource) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TemplateResource) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TemplateResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateResource) GetSubdomain() string {
+	if x != nil {
+		return x.Subdomain
+	}
+	return ""
+}
+
+func (x *TemplateResource) GetSpec() *v1.ServiceSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *TemplateResource) GetDeployment() *v11.ServiceDeploymentSpec {
+	if x != nil {
+		return x.Deployment
+	}
+	return nil
+}
+
+func (x *TemplateResource) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// Template is a curated blueprint of one or more services that can be deployed in one step.
+type Template struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // e.g. "postgres-api"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Parameters    []*TemplateParameter   `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	Resources     []*TemplateResource    `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Template) Reset() {
+	*x = Template{}
+	mi := &file_template_v1_template_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Template) ProtoMessage() {}
+
+func (x *Template) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Template.ProtoReflect.Descriptor instead.
+func (*Template) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Template) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Template) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Template) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Template) GetParameters() []*TemplateParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *Template) GetResources() []*TemplateResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// InstantiatedResource is a resource created from a template.
+type InstantiatedResource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ResourceId    int64                  `protobuf:"varint,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Domain        string                 `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	DeploymentId  int64                  `protobuf:"varint,5,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiatedResource) Reset() {
+	*x = InstantiatedResource{}
+	mi := &file_template_v1_template_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiatedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiatedResource) ProtoMessage() {}
+
+func (x *InstantiatedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiatedResource.ProtoReflect.Descriptor instead.
+func (*InstantiatedResource) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InstantiatedResource) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *InstantiatedResource) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *InstantiatedResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstantiatedResource) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *InstantiatedResource) GetDeploymentId() int64 {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return 0
+}
+
+// ListTemplatesRequest is the request to list templates.
+type ListTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_template_v1_template_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{4}
+}
+
+// ListTemplatesResponse is the response containing the template catalog.
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*Template            `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_template_v1_template_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// GetTemplateRequest is the request to retrieve a template.
+type GetTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateRequest) Reset() {
+	*x = GetTemplateRequest{}
+	mi := &file_template_v1_template_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateRequest) ProtoMessage() {}
+
+func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// GetTemplateResponse is the response containing the template.
+type GetTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateResponse) Reset() {
+	*x = GetTemplateResponse{}
+	mi := &file_template_v1_template_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateResponse) ProtoMessage() {}
+
+func (x *GetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTemplateResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// InstantiateTemplateRequest is the request to deploy a template into a workspace.
+type InstantiateTemplateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId      int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	TemplateId       string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Parameters       map[string]string      `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PlatformDomainId int64                  `protobuf:"varint,4,opt,name=platform_domain_id,json=platformDomainId,proto3" json:"platform_domain_id,omitempty"` // platform domain the template's subdomains are created under
+	Region           string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	EnvironmentId    *int64                 `protobuf:"varint,6,opt,name=environment_id,json=environmentId,proto3,oneof" json:"environment_id,omitempty"` // must belong to workspace_id
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InstantiateTemplateRequest) Reset() {
+	*x = InstantiateTemplateRequest{}
+	mi := &file_template_v1_template_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTemplateRequest) ProtoMessage() {}
+
+func (x *InstantiateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*InstantiateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InstantiateTemplateRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *InstantiateTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *InstantiateTemplateRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *InstantiateTemplateRequest) GetPlatformDomainId() int64 {
+	if x != nil {
+		return x.PlatformDomainId
+	}
+	return 0
+}
+
+func (x *InstantiateTemplateRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *InstantiateTemplateRequest) GetEnvironmentId() int64 {
+	if x != nil && x.EnvironmentId != nil {
+		return *x.EnvironmentId
+	}
+	return 0
+}
+
+// InstantiateTemplateResponse is the response containing the created resources, in creation order.
+type InstantiateTemplateResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Resources     []*InstantiatedResource `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiateTemplateResponse) Reset() {
+	*x = InstantiateTemplateResponse{}
+	mi := &file_template_v1_template_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTemplateResponse) ProtoMessage() {}
+
+func (x *InstantiateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*InstantiateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InstantiateTemplateResponse) GetResources() []*InstantiatedResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+var File_template_v1_template_proto protoreflect.FileDescriptor
+
+const file_template_v1_template_proto_rawDesc = "" +
+	"\n" +
+	"\x1atemplate/v1/template.proto\x12\vtemplate.v1\x1a\x1edeployment/v1/deployment.proto\x1a\x1aresource/v1/resource.proto\"\xa8\x01\n" +
+	"\x11TemplateParameter\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12#\n" +
+	"\rdefault_value\x18\x03 \x01(\tR\fdefaultValue\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x1c\n" +
+	"\tgenerated\x18\x05 \x01(\bR\tgenerated\"\xe0\x01\n" +
+	"\x10TemplateResource\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tsubdomain\x18\x03 \x01(\tR\tsubdomain\x12,\n" +
+	"\x04spec\x18\x04 \x01(\v2\x18.resource.v1.ServiceSpecR\x04spec\x12D\n" +
+	"\n" +
+	"deployment\x18\x05 \x01(\v2$.deployment.v1.ServiceDeploymentSpecR\n" +
+	"deployment\x12\x14\n" +
+	"\x05links\x18\x06 \x03(\tR\x05links\"\xcd\x01\n" +
+	"\bTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12>\n" +
+	"\n" +
+	"parameters\x18\x04 \x03(\v2\x1e.template.v1.TemplateParameterR\n" +
+	"parameters\x12;\n" +
+	"\tresources\x18\x05 \x03(\v2\x1d.template.v1.TemplateResourceR\tresources\"\x9a\x01\n" +
+	"\x14InstantiatedResource\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\x03R\n" +
+	"resourceId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06domain\x18\x04 \x01(\tR\x06domain\x12#\n" +
+	"\rdeployment_id\x18\x05 \x01(\x03R\fdeploymentId\"\x16\n" +
+	"\x14ListTemplatesRequest\"L\n" +
+	"\x15ListTemplatesResponse\x123\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x15.template.v1.TemplateR\ttemplates\"5\n" +
+	"\x12GetTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"H\n" +
+	"\x13GetTemplateResponse\x121\n" +
+	"\btemplate\x18\x01 \x01(\v2\x15.template.v1.TemplateR\btemplate\"\xfd\x02\n" +
+	"\x1aInstantiateTemplateRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\x12W\n" +
+	"\n" +
+	"parameters\x18\x03 \x03(\v27.template.v1.InstantiateTemplateRequest.ParametersEntryR\n" +
+	"parameters\x12,\n" +
+	"\x12platform_domain_id\x18\x04 \x01(\x03R\x10platformDomainId\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12*\n" +
+	"\x0eenvironment_id\x18\x06 \x01(\x03H\x00R\renvironmentId\x88\x01\x01\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x11\n" +
+	"\x0f_environment_id\"^\n" +
+	"\x1bInstantiateTemplateResponse\x12?\n" +
+	"\tresources\x18\x01 \x03(\v2!.template.v1.InstantiatedResourceR\tresources2\xa5\x02\n" +
+	"\x0fTemplateService\x12V\n" +
+	"\rListTemplates\x12!.template.v1.ListTemplatesRequest\x1a\".template.v1.ListTemplatesResponse\x12P\n" +
+	"\vGetTemplate\x12\x1f.template.v1.GetTemplateRequest\x1a .template.v1.GetTemplateResponse\x12h\n" +
+	"\x13InstantiateTemplate\x12'.template.v1.InstantiateTemplateRequest\x1a(.template.v1.InstantiateTemplateResponseB?Z=github.com/team-loco/loco/shared/proto/template/v1;templatev1b\x06proto3"
+
+var (
+	file_template_v1_template_proto_rawDescOnce sync.Once
+	file_template_v1_template_proto_rawDescData []byte
+)
+
+func file_template_v1_template_proto_rawDescGZIP() []byte {
+	file_template_v1_template_proto_rawDescOnce.Do(func() {
+		file_template_v1_template_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_template_v1_template_proto_rawDesc), len(file_template_v1_template_proto_rawDesc)))
+	})
+	return file_template_v1_template_proto_rawDescData
+}
+
+var file_template_v1_template_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_template_v1_template_proto_goTypes = []any{
+	(*TemplateParameter)(nil),           // 0: template.v1.TemplateParameter
+	(*TemplateResource)(nil),            // 1: template.v1.TemplateResource
+	(*Template)(nil),                    // 2: template.v1.Template
+	(*InstantiatedResource)(nil),        // 3: template.v1.InstantiatedResource
+	(*ListTemplatesRequest)(nil),        // 4: template.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),       // 5: template.v1.ListTemplatesResponse
+	(*GetTemplateRequest)(nil),          // 6: template.v1.GetTemplateRequest
+	(*GetTemplateResponse)(nil),         // 7: template.v1.GetTemplateResponse
+	(*InstantiateTemplateRequest)(nil),  // 8: template.v1.InstantiateTemplateRequest
+	(*InstantiateTemplateResponse)(nil), // 9: template.v1.InstantiateTemplateResponse
+	nil,                                 // 10: template.v1.InstantiateTemplateRequest.ParametersEntry
+	(*v1.ServiceSpec)(nil),              // 11: resource.v1.ServiceSpec
+	(*v11.ServiceDeploymentSpec)(nil),   // 12: deployment.v1.ServiceDeploymentSpec
+}
+var file_template_v1_template_proto_depIdxs = []int32{
+	11, // 0: template.v1.TemplateResource.spec:type_name -> resource.v1.ServiceSpec
+	12, // 1: template.v1.TemplateResource.deployment:type_name -> deployment.v1.ServiceDeploymentSpec
+	0,  // 2: template.v1.Template.parameters:type_name -> template.v1.TemplateParameter
+	1,  // 3: template.v1.Template.resources:type_name -> template.v1.TemplateResource
+	2,  // 4: template.v1.ListTemplatesResponse.templates:type_name -> template.v1.Template
+	2,  // 5: template.v1.GetTemplateResponse.template:type_name -> template.v1.Template
+	10, // 6: template.v1.InstantiateTemplateRequest.parameters:type_name -> template.v1.InstantiateTemplateRequest.ParametersEntry
+	3,  // 7: template.v1.InstantiateTemplateResponse.resources:type_name -> template.v1.InstantiatedResource
+	4,  // 8: template.v1.TemplateService.ListTemplates:input_type -> template.v1.ListTemplatesRequest
+	6,  // 9: template.v1.TemplateService.GetTemplate:input_type -> template.v1.GetTemplateRequest
+	8,  // 10: template.v1.TemplateService.InstantiateTemplate:input_type -> template.v1.InstantiateTemplateRequest
+	5,  // 11: template.v1.TemplateService.ListTemplates:output_type -> template.v1.ListTemplatesResponse
+	7,  // 12: template.v1.TemplateService.GetTemplate:output_type -> template.v1.GetTemplateResponse
+	9,  // 13: template.v1.TemplateService.InstantiateTemplate:output_type -> template.v1.InstantiateTemplateResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_template_v1_template_proto_init() }
+func file_template_v1_template_proto_init() {
+	if File_template_v1_template_proto != nil {
+		return
+	}
+	file_template_v1_template_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_template_v1_template_proto_rawDesc), len(file_template_v1_template_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_template_v1_template_proto_goTypes,
+		DependencyIndexes: file_template_v1_template_proto_depIdxs,
+		MessageInfos:      file_template_v1_template_proto_msgTypes,
+	}.Build()
+	File_template_v1_template_proto = out.File
+	file_template_v1_template_proto_goTypes = nil
+	file_template_v1_template_proto_depIdxs = nil
+}
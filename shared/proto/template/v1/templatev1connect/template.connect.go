@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: template/v1/template.proto
+
+package templatev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/team-loco/loco/shared/proto/template/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TemplateServiceName is the fully-qualified name of the TemplateService service.
+	TemplateServiceName = "template.v1.TemplateService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TemplateServiceListTemplatesProcedure is the fully-qualified name of the TemplateService's
+	// ListTemplates RPC.
+	TemplateServiceListTemplatesProcedure = "/template.v1.TemplateService/ListTemplates"
+	// TemplateServiceGetTemplateProcedure is the fully-qualified name of the TemplateService's
+	// GetTemplate RPC.
+	TemplateServiceGetTemplateProcedure = "/template.v1.TemplateService/GetTemplate"
+	// TemplateServiceInstantiateTemplateProcedure is the fully-qualified name of the TemplateService's
+	// InstantiateTemplate RPC.
+	TemplateServiceInstantiateTemplateProcedure = "/template.v1.TemplateService/InstantiateTemplate"
+)
+
+// TemplateServiceClient is a client for the template.v1.TemplateService service.
+type TemplateServiceClient interface {
+	// ListTemplates lists the templates in the catalog.
+	ListTemplates(context.Context, *connect.Request[v1.ListTemplatesRequest]) (*connect.Response[v1.ListTemplatesResponse], error)
+	// GetTemplate retrieves a template by ID.
+	GetTemplate(context.Context, *connect.Request[v1.GetTemplateRequest]) (*connect.Response[v1.GetTemplateResponse], error)
+	// InstantiateTemplate creates and deploys a template's resources, links and domains in a workspace.
+	InstantiateTemplate(context.Context, *connect.Request[v1.InstantiateTemplateRequest]) (*connect.Response[v1.InstantiateTemplateResponse], error)
+}
+
+// NewTemplateServiceClient constructs a client for the template.v1.TemplateService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTemplateServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TemplateServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	templateServiceMethods := v1.File_template_v1_template_proto.Services().ByName("TemplateService").Methods()
+	return &templateServiceClient{
+		listTemplates: connect.NewClient[v1.ListTemplatesRequest, v1.ListTemplatesResponse](
+			httpClient,
+			baseURL+TemplateServiceListTemplatesProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("ListTemplates")),
+			connect.WithClientOptions(opts...),
+		),
+		getTemplate: connect.NewClient[v1.GetTemplateRequest, v1.GetTemplateResponse](
+			httpClient,
+			baseURL+TemplateServiceGetTemplateProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("GetTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		instantiateTemplate: connect.NewClient[v1.InstantiateTemplateRequest, v1.InstantiateTemplateResponse](
+			httpClient,
+			baseURL+TemplateServiceInstantiateTemplateProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("InstantiateTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// templateServiceClient implements TemplateServiceClient.
+type templateServiceClient struct {
+	listTemplates       *connect.Client[v1.ListTemplatesRequest, v1.ListTemplatesResponse]
+	getTemplate         *connect.Client[v1.GetTemplateRequest, v1.GetTemplateResponse]
+	instantiateTemplate *connect.Client[v1.InstantiateTemplateRequest, v1.InstantiateTemplateResponse]
+}
+
+// ListTemplates calls template.v1.TemplateService.ListTemplates.
+func (c *templateServiceClient) ListTemplates(ctx context.Context, req *connect.Request[v1.ListTemplatesRequest]) (*connect.Response[v1.ListTemplatesResponse], error) {
+	return c.listTemplates.CallUnary(ctx, req)
+}
+
+// GetTemplate calls template.v1.TemplateService.GetTemplate.
+func (c *templateServiceClient) GetTemplate(ctx context.Context, req *connect.Request[v1.GetTemplateRequest]) (*connect.Response[v1.GetTemplateResponse], error) {
+	return c.getTemplate.CallUnary(ctx, req)
+}
+
+// InstantiateTemplate calls template.v1.TemplateService.InstantiateTemplate.
+func (c *templateServiceClient) InstantiateTemplate(ctx context.Context, req *connect.Request[v1.InstantiateTemplateRequest]) (*connect.Response[v1.InstantiateTemplateResponse], error) {
+	return c.instantiateTemplate.CallUnary(ctx, req)
+}
+
+// TemplateServiceHandler is an implementation of the template.v1.TemplateService service.
+type TemplateServiceHandler interface {
+	// ListTemplates lists the templates in the catalog.
+	ListTemplates(context.Context, *connect.Request[v1.ListTemplatesRequest]) (*connect.Response[v1.ListTemplatesResponse], error)
+	// GetTemplate retrieves a template by ID.
+	GetTemplate(context.Context, *connect.Request[v1.GetTemplateRequest]) (*connect.Response[v1.GetTemplateResponse], error)
+	// InstantiateTemplate creates and deploys a template's resources, links and domains in a workspace.
+	InstantiateTemplate(context.Context, *connect.Request[v1.InstantiateTemplateRequest]) (*connect.Response[v1.InstantiateTemplateResponse], error)
+}
+
+// NewTemplateServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTemplateServiceHandler(svc TemplateServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	templateServiceMethods := v1.File_template_v1_template_proto.Services().ByName("TemplateService").Methods()
+	templateServiceListTemplatesHandler := connect.NewUnaryHandler(
+		TemplateServiceListTemplatesProcedure,
+		svc.ListTemplates,
+		connect.WithSchema(templateServiceMethods.ByName("ListTemplates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceGetTemplateHandler := connect.NewUnaryHandler(
+		TemplateServiceGetTemplateProcedure,
+		svc.GetTemplate,
+		connect.WithSchema(templateServiceMethods.ByName("GetTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceInstantiateTemplateHandler := connect.NewUnaryHandler(
+		TemplateServiceInstantiateTemplateProcedure,
+		svc.InstantiateTemplate,
+		connect.WithSchema(templateServiceMethods.ByName("InstantiateTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/template.v1.TemplateService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TemplateServiceListTemplatesProcedure:
+			templateServiceListTemplatesHandler.ServeHTTP(w, r)
+		case TemplateServiceGetTemplateProcedure:
+			templateServiceGetTemplateHandler.ServeHTTP(w, r)
+		case TemplateServiceInstantiateTemplateProcedure:
+			templateServiceInstantiateTemplateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTemplateServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTemplateServiceHandler struct{}
+
+func (UnimplementedTemplateServiceHandler) ListTemplates(context.Context, *connect.Request[v1.ListTemplatesRequest]) (*connect.Response[v1.ListTemplatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("template.v1.TemplateService.ListTemplates is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) GetTemplate(context.Context, *connect.Request[v1.GetTemplateRequest]) (*connect.Response[v1.GetTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("template.v1.TemplateService.GetTemplate is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) InstantiateTemplate(context.Context, *connect.Request[v1.InstantiateTemplateRequest]) (*connect.Response[v1.InstantiateTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("template.v1.TemplateService.InstantiateTemplate is not implemented"))
+}
@@ -26,12 +26,14 @@ const (
 type EntityType int32
 
 const (
-	EntityType_ENTITY_TYPE_UNSPECIFIED  EntityType = 0
-	EntityType_ENTITY_TYPE_SYSTEM       EntityType = 1
-	EntityType_ENTITY_TYPE_ORGANIZATION EntityType = 2
-	EntityType_ENTITY_TYPE_WORKSPACE    EntityType = 3
-	EntityType_ENTITY_TYPE_RESOURCE     EntityType = 4
-	EntityType_ENTITY_TYPE_USER         EntityType = 5
+	EntityType_ENTITY_TYPE_UNSPECIFIED     EntityType = 0
+	EntityType_ENTITY_TYPE_SYSTEM          EntityType = 1
+	EntityType_ENTITY_TYPE_ORGANIZATION    EntityType = 2
+	EntityType_ENTITY_TYPE_WORKSPACE       EntityType = 3
+	EntityType_ENTITY_TYPE_RESOURCE        EntityType = 4
+	EntityType_ENTITY_TYPE_USER            EntityType = 5
+	EntityType_ENTITY_TYPE_ENVIRONMENT     EntityType = 6
+	EntityType_ENTITY_TYPE_SERVICE_ACCOUNT EntityType = 7
 )
 
 // Enum value maps for EntityType.
@@ -43,14 +45,18 @@ var (
 		3: "ENTITY_TYPE_WORKSPACE",
 		4: "ENTITY_TYPE_RESOURCE",
 		5: "ENTITY_TYPE_USER",
+		6: "ENTITY_TYPE_ENVIRONMENT",
+		7: "ENTITY_TYPE_SERVICE_ACCOUNT",
 	}
 	EntityType_value = map[string]int32{
-		"ENTITY_TYPE_UNSPECIFIED":  0,
-		"ENTITY_TYPE_SYSTEM":       1,
-		"ENTITY_TYPE_ORGANIZATION": 2,
-		"ENTITY_TYPE_WORKSPACE":    3,
-		"ENTITY_TYPE_RESOURCE":     4,
-		"ENTITY_TYPE_USER":         5,
+		"ENTITY_TYPE_UNSPECIFIED":     0,
+		"ENTITY_TYPE_SYSTEM":          1,
+		"ENTITY_TYPE_ORGANIZATION":    2,
+		"ENTITY_TYPE_WORKSPACE":       3,
+		"ENTITY_TYPE_RESOURCE":        4,
+		"ENTITY_TYPE_USER":            5,
+		"ENTITY_TYPE_ENVIRONMENT":     6,
+		"ENTITY_TYPE_SERVICE_ACCOUNT": 7,
 	}
 )
 
@@ -85,10 +91,14 @@ func (EntityType) EnumDescriptor() ([]byte, []int) {
 type Scope int32
 
 const (
-	Scope_SCOPE_UNSPECIFIED Scope = 0
-	Scope_SCOPE_READ        Scope = 1
-	Scope_SCOPE_WRITE       Scope = 2
-	Scope_SCOPE_ADMIN       Scope = 3
+	Scope_SCOPE_UNSPECIFIED   Scope = 0
+	Scope_SCOPE_READ          Scope = 1
+	Scope_SCOPE_WRITE         Scope = 2
+	Scope_SCOPE_ADMIN         Scope = 3
+	Scope_SCOPE_LOGS_READ     Scope = 4 // Stream logs, implied by SCOPE_READ
+	Scope_SCOPE_ENV_WRITE     Scope = 5 // Change env vars, implied by SCOPE_WRITE
+	Scope_SCOPE_DEPLOY_CREATE Scope = 6 // Deploy, implied by SCOPE_WRITE
+	Scope_SCOPE_DOMAIN_MANAGE Scope = 7 // Manage domains, implied by SCOPE_WRITE
 )
 
 // Enum value maps for Scope.
@@ -98,12 +108,20 @@ var (
 		1: "SCOPE_READ",
 		2: "SCOPE_WRITE",
 		3: "SCOPE_ADMIN",
+		4: "SCOPE_LOGS_READ",
+		5: "SCOPE_ENV_WRITE",
+		6: "SCOPE_DEPLOY_CREATE",
+		7: "SCOPE_DOMAIN_MANAGE",
 	}
 	Scope_value = map[string]int32{
-		"SCOPE_UNSPECIFIED": 0,
-		"SCOPE_READ":        1,
-		"SCOPE_WRITE":       2,
-		"SCOPE_ADMIN":       3,
+		"SCOPE_UNSPECIFIED":   0,
+		"SCOPE_READ":          1,
+		"SCOPE_WRITE":         2,
+		"SCOPE_ADMIN":         3,
+		"SCOPE_LOGS_READ":     4,
+		"SCOPE_ENV_WRITE":     5,
+		"SCOPE_DEPLOY_CREATE": 6,
+		"SCOPE_DOMAIN_MANAGE": 7,
 	}
 )
 
@@ -712,6 +730,120 @@ func (*RevokeTokenResponse) Descriptor() ([]byte, []int) {
 	return file_token_v1_token_proto_rawDescGZIP(), []int{9}
 }
 
+// MintTokenRequest is the request to mint a scoped-down child of the calling token.
+type MintTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                        // Human-readable token name
+	Scopes        []*EntityScope         `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`                                    // Scopes to grant, each implied by the calling token's scopes
+	ExpiresInSec  int64                  `protobuf:"varint,3,opt,name=expires_in_sec,json=expiresInSec,proto3" json:"expires_in_sec,omitempty"` // Expiration duration in seconds, no later than the calling token expires
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintTokenRequest) Reset() {
+	*x = MintTokenRequest{}
+	mi := &file_token_v1_token_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintTokenRequest) ProtoMessage() {}
+
+func (x *MintTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintTokenRequest.ProtoReflect.Descriptor instead.
+func (*MintTokenRequest) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MintTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MintTokenRequest) GetScopes() []*EntityScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *MintTokenRequest) GetExpiresInSec() int64 {
+	if x != nil {
+		return x.ExpiresInSec
+	}
+	return 0
+}
+
+// MintTokenResponse contains the minted token string and metadata.
+type MintTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                      // The actual token string (only returned on creation)
+	TokenMetadata *Token                 `protobuf:"bytes,2,opt,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"` // Token metadata
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintTokenResponse) Reset() {
+	*x = MintTokenResponse{}
+	mi := &file_token_v1_token_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintTokenResponse) ProtoMessage() {}
+
+func (x *MintTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintTokenResponse.ProtoReflect.Descriptor instead.
+func (*MintTokenResponse) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MintTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *MintTokenResponse) GetTokenMetadata() *Token {
+	if x != nil {
+		return x.TokenMetadata
+	}
+	return nil
+}
+
 var File_token_v1_token_proto protoreflect.FileDescriptor
 
 const file_token_v1_token_proto_rawDesc = "" +
@@ -760,7 +892,14 @@ const file_token_v1_token_proto_rawDesc = "" +
 	"\ventity_type\x18\x02 \x01(\x0e2\x14.token.v1.EntityTypeR\n" +
 	"entityType\x12\x1b\n" +
 	"\tentity_id\x18\x03 \x01(\x03R\bentityId\"\x15\n" +
-	"\x13RevokeTokenResponse*\xaa\x01\n" +
+	"\x13RevokeTokenResponse\"{\n" +
+	"\x10MintTokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12-\n" +
+	"\x06scopes\x18\x02 \x03(\v2\x15.token.v1.EntityScopeR\x06scopes\x12$\n" +
+	"\x0eexpires_in_sec\x18\x03 \x01(\x03R\fexpiresInSec\"a\n" +
+	"\x11MintTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x126\n" +
+	"\x0etoken_metadata\x18\x02 \x01(\v2\x0f.token.v1.TokenR\rtokenMetadata*\xe8\x01\n" +
 	"\n" +
 	"EntityType\x12\x1b\n" +
 	"\x17ENTITY_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
@@ -768,19 +907,26 @@ const file_token_v1_token_proto_rawDesc = "" +
 	"\x18ENTITY_TYPE_ORGANIZATION\x10\x02\x12\x19\n" +
 	"\x15ENTITY_TYPE_WORKSPACE\x10\x03\x12\x18\n" +
 	"\x14ENTITY_TYPE_RESOURCE\x10\x04\x12\x14\n" +
-	"\x10ENTITY_TYPE_USER\x10\x05*P\n" +
+	"\x10ENTITY_TYPE_USER\x10\x05\x12\x1b\n" +
+	"\x17ENTITY_TYPE_ENVIRONMENT\x10\x06\x12\x1f\n" +
+	"\x1bENTITY_TYPE_SERVICE_ACCOUNT\x10\a*\xac\x01\n" +
 	"\x05Scope\x12\x15\n" +
 	"\x11SCOPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
 	"\n" +
 	"SCOPE_READ\x10\x01\x12\x0f\n" +
 	"\vSCOPE_WRITE\x10\x02\x12\x0f\n" +
-	"\vSCOPE_ADMIN\x10\x032\xb2\x02\n" +
+	"\vSCOPE_ADMIN\x10\x03\x12\x13\n" +
+	"\x0fSCOPE_LOGS_READ\x10\x04\x12\x13\n" +
+	"\x0fSCOPE_ENV_WRITE\x10\x05\x12\x17\n" +
+	"\x13SCOPE_DEPLOY_CREATE\x10\x06\x12\x17\n" +
+	"\x13SCOPE_DOMAIN_MANAGE\x10\a2\xf8\x02\n" +
 	"\fTokenService\x12J\n" +
 	"\vCreateToken\x12\x1c.token.v1.CreateTokenRequest\x1a\x1d.token.v1.CreateTokenResponse\x12G\n" +
 	"\n" +
 	"ListTokens\x12\x1b.token.v1.ListTokensRequest\x1a\x1c.token.v1.ListTokensResponse\x12A\n" +
 	"\bGetToken\x12\x19.token.v1.GetTokenRequest\x1a\x1a.token.v1.GetTokenResponse\x12J\n" +
-	"\vRevokeToken\x12\x1c.token.v1.RevokeTokenRequest\x1a\x1d.token.v1.RevokeTokenResponseB9Z7github.com/team-loco/loco/shared/proto/token/v1;tokenv1b\x06proto3"
+	"\vRevokeToken\x12\x1c.token.v1.RevokeTokenRequest\x1a\x1d.token.v1.RevokeTokenResponse\x12D\n" +
+	"\tMintToken\x12\x1a.token.v1.MintTokenRequest\x1a\x1b.token.v1.MintTokenResponseB9Z7github.com/team-loco/loco/shared/proto/token/v1;tokenv1b\x06proto3"
 
 var (
 	file_token_v1_token_proto_rawDescOnce sync.Once
@@ -795,7 +941,7 @@ func file_token_v1_token_proto_rawDescGZIP() []byte {
 }
 
 var file_token_v1_token_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_token_v1_token_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_token_v1_token_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_token_v1_token_proto_goTypes = []any{
 	(EntityType)(0),               // 0: token.v1.EntityType
 	(Scope)(0),                    // 1: token.v1.Scope
@@ -809,15 +955,17 @@ var file_token_v1_token_proto_goTypes = []any{
 	(*GetTokenResponse)(nil),      // 9: token.v1.GetTokenResponse
 	(*RevokeTokenRequest)(nil),    // 10: token.v1.RevokeTokenRequest
 	(*RevokeTokenResponse)(nil),   // 11: token.v1.RevokeTokenResponse
-	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+	(*MintTokenRequest)(nil),      // 12: token.v1.MintTokenRequest
+	(*MintTokenResponse)(nil),     // 13: token.v1.MintTokenResponse
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
 }
 var file_token_v1_token_proto_depIdxs = []int32{
 	1,  // 0: token.v1.EntityScope.scope:type_name -> token.v1.Scope
 	0,  // 1: token.v1.EntityScope.entity_type:type_name -> token.v1.EntityType
 	0,  // 2: token.v1.Token.entity_type:type_name -> token.v1.EntityType
 	2,  // 3: token.v1.Token.scopes:type_name -> token.v1.EntityScope
-	12, // 4: token.v1.Token.expires_at:type_name -> google.protobuf.Timestamp
-	12, // 5: token.v1.Token.created_at:type_name -> google.protobuf.Timestamp
+	14, // 4: token.v1.Token.expires_at:type_name -> google.protobuf.Timestamp
+	14, // 5: token.v1.Token.created_at:type_name -> google.protobuf.Timestamp
 	0,  // 6: token.v1.CreateTokenRequest.entity_type:type_name -> token.v1.EntityType
 	2,  // 7: token.v1.CreateTokenRequest.scopes:type_name -> token.v1.EntityScope
 	3,  // 8: token.v1.CreateTokenResponse.token_metadata:type_name -> token.v1.Token
@@ -826,19 +974,23 @@ var file_token_v1_token_proto_depIdxs = []int32{
 	0,  // 11: token.v1.GetTokenRequest.entity_type:type_name -> token.v1.EntityType
 	3,  // 12: token.v1.GetTokenResponse.token:type_name -> token.v1.Token
 	0,  // 13: token.v1.RevokeTokenRequest.entity_type:type_name -> token.v1.EntityType
-	4,  // 14: token.v1.TokenService.CreateToken:input_type -> token.v1.CreateTokenRequest
-	6,  // 15: token.v1.TokenService.ListTokens:input_type -> token.v1.ListTokensRequest
-	8,  // 16: token.v1.TokenService.GetToken:input_type -> token.v1.GetTokenRequest
-	10, // 17: token.v1.TokenService.RevokeToken:input_type -> token.v1.RevokeTokenRequest
-	5,  // 18: token.v1.TokenService.CreateToken:output_type -> token.v1.CreateTokenResponse
-	7,  // 19: token.v1.TokenService.ListTokens:output_type -> token.v1.ListTokensResponse
-	9,  // 20: token.v1.TokenService.GetToken:output_type -> token.v1.GetTokenResponse
-	11, // 21: token.v1.TokenService.RevokeToken:output_type -> token.v1.RevokeTokenResponse
-	18, // [18:22] is the sub-list for method output_type
-	14, // [14:18] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	2,  // 14: token.v1.MintTokenRequest.scopes:type_name -> token.v1.EntityScope
+	3,  // 15: token.v1.MintTokenResponse.token_metadata:type_name -> token.v1.Token
+	4,  // 16: token.v1.TokenService.CreateToken:input_type -> token.v1.CreateTokenRequest
+	6,  // 17: token.v1.TokenService.ListTokens:input_type -> token.v1.ListTokensRequest
+	8,  // 18: token.v1.TokenService.GetToken:input_type -> token.v1.GetTokenRequest
+	10, // 19: token.v1.TokenService.RevokeToken:input_type -> token.v1.RevokeTokenRequest
+	12, // 20: token.v1.TokenService.MintToken:input_type -> token.v1.MintTokenRequest
+	5,  // 21: token.v1.TokenService.CreateToken:output_type -> token.v1.CreateTokenResponse
+	7,  // 22: token.v1.TokenService.ListTokens:output_type -> token.v1.ListTokensResponse
+	9,  // 23: token.v1.TokenService.GetToken:output_type -> token.v1.GetTokenResponse
+	11, // 24: token.v1.TokenService.RevokeToken:output_type -> token.v1.RevokeTokenResponse
+	13, // 25: token.v1.TokenService.MintToken:output_type -> token.v1.MintTokenResponse
+	21, // [21:26] is the sub-list for method output_type
+	16, // [16:21] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_token_v1_token_proto_init() }
@@ -852,7 +1004,7 @@ func file_token_v1_token_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_token_v1_token_proto_rawDesc), len(file_token_v1_token_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
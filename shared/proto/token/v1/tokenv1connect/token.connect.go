@@ -43,6 +43,8 @@ const (
 	// TokenServiceRevokeTokenProcedure is the fully-qualified name of the TokenService's RevokeToken
 	// RPC.
 	TokenServiceRevokeTokenProcedure = "/token.v1.TokenService/RevokeToken"
+	// TokenServiceMintTokenProcedure is the fully-qualified name of the TokenService's MintToken RPC.
+	TokenServiceMintTokenProcedure = "/token.v1.TokenService/MintToken"
 )
 
 // TokenServiceClient is a client for the token.v1.TokenService service.
@@ -55,6 +57,9 @@ type TokenServiceClient interface {
 	GetToken(context.Context, *connect.Request[v1.GetTokenRequest]) (*connect.Response[v1.GetTokenResponse], error)
 	// RevokeToken revokes/deletes a token.
 	RevokeToken(context.Context, *connect.Request[v1.RevokeTokenRequest]) (*connect.Response[v1.RevokeTokenResponse], error)
+	// MintToken mints a child of the calling token, restricted to a subset of its scopes and a shorter lifetime.
+	// The child is revoked along with the calling token.
+	MintToken(context.Context, *connect.Request[v1.MintTokenRequest]) (*connect.Response[v1.MintTokenResponse], error)
 }
 
 // NewTokenServiceClient constructs a client for the token.v1.TokenService service. By default, it
@@ -92,6 +97,12 @@ func NewTokenServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(tokenServiceMethods.ByName("RevokeToken")),
 			connect.WithClientOptions(opts...),
 		),
+		mintToken: connect.NewClient[v1.MintTokenRequest, v1.MintTokenResponse](
+			httpClient,
+			baseURL+TokenServiceMintTokenProcedure,
+			connect.WithSchema(tokenServiceMethods.ByName("MintToken")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -101,6 +112,7 @@ type tokenServiceClient struct {
 	listTokens  *connect.Client[v1.ListTokensRequest, v1.ListTokensResponse]
 	getToken    *connect.Client[v1.GetTokenRequest, v1.GetTokenResponse]
 	revokeToken *connect.Client[v1.RevokeTokenRequest, v1.RevokeTokenResponse]
+	mintToken   *connect.Client[v1.MintTokenRequest, v1.MintTokenResponse]
 }
 
 // CreateToken calls token.v1.TokenService.CreateToken.
@@ -123,6 +135,11 @@ func (c *tokenServiceClient) RevokeToken(ctx context.Context, req *connect.Reque
 	return c.revokeToken.CallUnary(ctx, req)
 }
 
+// MintToken calls token.v1.TokenService.MintToken.
+func (c *tokenServiceClient) MintToken(ctx context.Context, req *connect.Request[v1.MintTokenRequest]) (*connect.Response[v1.MintTokenResponse], error) {
+	return c.mintToken.CallUnary(ctx, req)
+}
+
 // TokenServiceHandler is an implementation of the token.v1.TokenService service.
 type TokenServiceHandler interface {
 	// CreateToken issues a new token for a specific entity with defined scopes.
@@ -133,6 +150,9 @@ type TokenServiceHandler interface {
 	GetToken(context.Context, *connect.Request[v1.GetTokenRequest]) (*connect.Response[v1.GetTokenResponse], error)
 	// RevokeToken revokes/deletes a token.
 	RevokeToken(context.Context, *connect.Request[v1.RevokeTokenRequest]) (*connect.Response[v1.RevokeTokenResponse], error)
+	// MintToken mints a child of the calling token, restricted to a subset of its scopes and a shorter lifetime.
+	// The child is revoked along with the calling token.
+	MintToken(context.Context, *connect.Request[v1.MintTokenRequest]) (*connect.Response[v1.MintTokenResponse], error)
 }
 
 // NewTokenServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -166,6 +186,12 @@ func NewTokenServiceHandler(svc TokenServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(tokenServiceMethods.ByName("RevokeToken")),
 		connect.WithHandlerOptions(opts...),
 	)
+	tokenServiceMintTokenHandler := connect.NewUnaryHandler(
+		TokenServiceMintTokenProcedure,
+		svc.MintToken,
+		connect.WithSchema(tokenServiceMethods.ByName("MintToken")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/token.v1.TokenService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TokenServiceCreateTokenProcedure:
@@ -176,6 +202,8 @@ func NewTokenServiceHandler(svc TokenServiceHandler, opts ...connect.HandlerOpti
 			tokenServiceGetTokenHandler.ServeHTTP(w, r)
 		case TokenServiceRevokeTokenProcedure:
 			tokenServiceRevokeTokenHandler.ServeHTTP(w, r)
+		case TokenServiceMintTokenProcedure:
+			tokenServiceMintTokenHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -200,3 +228,7 @@ func (UnimplementedTokenServiceHandler) GetToken(context.Context, *connect.Reque
 func (UnimplementedTokenServiceHandler) RevokeToken(context.Context, *connect.Request[v1.RevokeTokenRequest]) (*connect.Response[v1.RevokeTokenResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("token.v1.TokenService.RevokeToken is not implemented"))
 }
+
+func (UnimplementedTokenServiceHandler) MintToken(context.Context, *connect.Request[v1.MintTokenRequest]) (*connect.Response[v1.MintTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("token.v1.TokenService.MintToken is not implemented"))
+}
@@ -617,10 +617,11 @@ func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
 
 // WhoAmIResponse is the response containing the current user.
 type WhoAmIResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	User           *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ImpersonatorId *int64                 `protobuf:"varint,2,opt,name=impersonator_id,json=impersonatorId,proto3,oneof" json:"impersonator_id,omitempty"` // the platform admin acting as the user, when the token is an impersonation
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *WhoAmIResponse) Reset() {
@@ -660,6 +661,13 @@ func (x *WhoAmIResponse) GetUser() *User {
 	return nil
 }
 
+func (x *WhoAmIResponse) GetImpersonatorId() int64 {
+	if x != nil && x.ImpersonatorId != nil {
+		return *x.ImpersonatorId
+	}
+	return 0
+}
+
 // DeleteUserRequest is the request to delete a user.
 type DeleteUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -816,6 +824,652 @@ func (*LogoutResponse) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
 }
 
+// DeleteMyAccountRequest is the request to delete the current user's account.
+type DeleteMyAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfirmEmail  string                 `protobuf:"bytes,1,opt,name=confirm_email,json=confirmEmail,proto3" json:"confirm_email,omitempty"` // must match the account's email
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyAccountRequest) Reset() {
+	*x = DeleteMyAccountRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyAccountRequest) ProtoMessage() {}
+
+func (x *DeleteMyAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMyAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteMyAccountRequest) GetConfirmEmail() string {
+	if x != nil {
+		return x.ConfirmEmail
+	}
+	return ""
+}
+
+// DeleteMyAccountResponse is the response after deleting the current user's account.
+type DeleteMyAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyAccountResponse) Reset() {
+	*x = DeleteMyAccountResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyAccountResponse) ProtoMessage() {}
+
+func (x *DeleteMyAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMyAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+// ExportMyDataRequest is the request to export the current user's records.
+type ExportMyDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+// ExportMyDataResponse carries the export as a JSON document.
+type ExportMyDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Archive       []byte                 `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataResponse) Reset() {
+	*x = ExportMyDataResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataResponse) ProtoMessage() {}
+
+func (x *ExportMyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportMyDataResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExportMyDataResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ExportMyDataResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportMyDataResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// GetMFAStatusRequest is the request to get the current user's MFA status.
+type GetMFAStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMFAStatusRequest) Reset() {
+	*x = GetMFAStatusRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMFAStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMFAStatusRequest) ProtoMessage() {}
+
+func (x *GetMFAStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMFAStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetMFAStatusRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+// GetMFAStatusResponse is the current user's MFA status.
+type GetMFAStatusResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Enabled                bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RequiringOrganizations []string               `protobuf:"bytes,2,rep,name=requiring_organizations,json=requiringOrganizations,proto3" json:"requiring_organizations,omitempty"` // names of the user's organizations that require MFA
+	Pending                bool                   `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`                                                            // the caller's token is a login waiting on MFA
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetMFAStatusResponse) Reset() {
+	*x = GetMFAStatusResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMFAStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMFAStatusResponse) ProtoMessage() {}
+
+func (x *GetMFAStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMFAStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetMFAStatusResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetMFAStatusResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetMFAStatusResponse) GetRequiringOrganizations() []string {
+	if x != nil {
+		return x.RequiringOrganizations
+	}
+	return nil
+}
+
+func (x *GetMFAStatusResponse) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+// EnrollTOTPRequest is the request to start enrolling in TOTP MFA.
+type EnrollTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPRequest) Reset() {
+	*x = EnrollTOTPRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPRequest) ProtoMessage() {}
+
+func (x *EnrollTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPRequest.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+// EnrollTOTPResponse carries the secret to add to an authenticator app.
+type EnrollTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"` // base32, for entering by hand
+	Uri           string                 `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`       // otpauth:// URI, usually shown as a QR code
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPResponse) Reset() {
+	*x = EnrollTOTPResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPResponse) ProtoMessage() {}
+
+func (x *EnrollTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EnrollTOTPResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTOTPResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+// ConfirmTOTPRequest finishes enrolling in TOTP MFA.
+type ConfirmTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPRequest) Reset() {
+	*x = ConfirmTOTPRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPRequest) ProtoMessage() {}
+
+func (x *ConfirmTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ConfirmTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// ConfirmTOTPResponse is returned once TOTP MFA is enabled.
+type ConfirmTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocoToken     string                 `protobuf:"bytes,1,opt,name=loco_token,json=locoToken,proto3" json:"loco_token,omitempty"`          // set when the caller's login was waiting on MFA; it replaces that login's token
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // set along with loco_token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPResponse) Reset() {
+	*x = ConfirmTOTPResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPResponse) ProtoMessage() {}
+
+func (x *ConfirmTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ConfirmTOTPResponse) GetLocoToken() string {
+	if x != nil {
+		return x.LocoToken
+	}
+	return ""
+}
+
+func (x *ConfirmTOTPResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// VerifyMFARequest completes a login waiting on MFA.
+type VerifyMFARequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMFARequest) Reset() {
+	*x = VerifyMFARequest{}
+	mi := &file_user_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFARequest) ProtoMessage() {}
+
+func (x *VerifyMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFARequest.ProtoReflect.Descriptor instead.
+func (*VerifyMFARequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VerifyMFARequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// VerifyMFAResponse contains the full login token, which replaces the caller's token.
+type VerifyMFAResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocoToken     string                 `protobuf:"bytes,1,opt,name=loco_token,json=locoToken,proto3" json:"loco_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMFAResponse) Reset() {
+	*x = VerifyMFAResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFAResponse) ProtoMessage() {}
+
+func (x *VerifyMFAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFAResponse.ProtoReflect.Descriptor instead.
+func (*VerifyMFAResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VerifyMFAResponse) GetLocoToken() string {
+	if x != nil {
+		return x.LocoToken
+	}
+	return ""
+}
+
+func (x *VerifyMFAResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// DisableTOTPRequest is the request to remove TOTP MFA.
+type DisableTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTOTPRequest) Reset() {
+	*x = DisableTOTPRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPRequest) ProtoMessage() {}
+
+func (x *DisableTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTOTPRequest.ProtoReflect.Descriptor instead.
+func (*DisableTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DisableTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// DisableTOTPResponse is the response after removing TOTP MFA.
+type DisableTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTOTPResponse) Reset() {
+	*x = DisableTOTPResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPResponse) ProtoMessage() {}
+
+func (x *DisableTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTOTPResponse.ProtoReflect.Descriptor instead.
+func (*DisableTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{28}
+}
+
 var File_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_proto_rawDesc = "" +
@@ -868,14 +1522,48 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x11ListUsersResponse\x12#\n" +
 	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x0f\n" +
-	"\rWhoAmIRequest\"3\n" +
+	"\rWhoAmIRequest\"u\n" +
 	"\x0eWhoAmIResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\",\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\x12,\n" +
+	"\x0fimpersonator_id\x18\x02 \x01(\x03H\x00R\x0eimpersonatorId\x88\x01\x01B\x12\n" +
+	"\x10_impersonator_id\",\n" +
 	"\x11DeleteUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x14\n" +
 	"\x12DeleteUserResponse\"\x0f\n" +
 	"\rLogoutRequest\"\x10\n" +
-	"\x0eLogoutResponse2\xda\x03\n" +
+	"\x0eLogoutResponse\"=\n" +
+	"\x16DeleteMyAccountRequest\x12#\n" +
+	"\rconfirm_email\x18\x01 \x01(\tR\fconfirmEmail\"\x19\n" +
+	"\x17DeleteMyAccountResponse\"\x15\n" +
+	"\x13ExportMyDataRequest\"o\n" +
+	"\x14ExportMyDataResponse\x12\x18\n" +
+	"\aarchive\x18\x01 \x01(\fR\aarchive\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"\x15\n" +
+	"\x13GetMFAStatusRequest\"\x83\x01\n" +
+	"\x14GetMFAStatusResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x127\n" +
+	"\x17requiring_organizations\x18\x02 \x03(\tR\x16requiringOrganizations\x12\x18\n" +
+	"\apending\x18\x03 \x01(\bR\apending\"\x13\n" +
+	"\x11EnrollTOTPRequest\">\n" +
+	"\x12EnrollTOTPResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x10\n" +
+	"\x03uri\x18\x02 \x01(\tR\x03uri\"(\n" +
+	"\x12ConfirmTOTPRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"Y\n" +
+	"\x13ConfirmTOTPResponse\x12\x1d\n" +
+	"\n" +
+	"loco_token\x18\x01 \x01(\tR\tlocoToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"&\n" +
+	"\x10VerifyMFARequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"W\n" +
+	"\x11VerifyMFAResponse\x12\x1d\n" +
+	"\n" +
+	"loco_token\x18\x01 \x01(\tR\tlocoToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"(\n" +
+	"\x12DisableTOTPRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x15\n" +
+	"\x13DisableTOTPResponse2\xe9\a\n" +
 	"\vUserService\x12E\n" +
 	"\n" +
 	"CreateUser\x12\x1a.user.v1.CreateUserRequest\x1a\x1b.user.v1.CreateUserResponse\x12<\n" +
@@ -886,7 +1574,15 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\tListUsers\x12\x19.user.v1.ListUsersRequest\x1a\x1a.user.v1.ListUsersResponse\x12E\n" +
 	"\n" +
 	"DeleteUser\x12\x1a.user.v1.DeleteUserRequest\x1a\x1b.user.v1.DeleteUserResponse\x129\n" +
-	"\x06Logout\x12\x16.user.v1.LogoutRequest\x1a\x17.user.v1.LogoutResponseB7Z5github.com/team-loco/loco/shared/proto/user/v1;userv1b\x06proto3"
+	"\x06Logout\x12\x16.user.v1.LogoutRequest\x1a\x17.user.v1.LogoutResponse\x12T\n" +
+	"\x0fDeleteMyAccount\x12\x1f.user.v1.DeleteMyAccountRequest\x1a .user.v1.DeleteMyAccountResponse\x12K\n" +
+	"\fExportMyData\x12\x1c.user.v1.ExportMyDataRequest\x1a\x1d.user.v1.ExportMyDataResponse\x12K\n" +
+	"\fGetMFAStatus\x12\x1c.user.v1.GetMFAStatusRequest\x1a\x1d.user.v1.GetMFAStatusResponse\x12E\n" +
+	"\n" +
+	"EnrollTOTP\x12\x1a.user.v1.EnrollTOTPRequest\x1a\x1b.user.v1.EnrollTOTPResponse\x12H\n" +
+	"\vConfirmTOTP\x12\x1b.user.v1.ConfirmTOTPRequest\x1a\x1c.user.v1.ConfirmTOTPResponse\x12B\n" +
+	"\tVerifyMFA\x12\x19.user.v1.VerifyMFARequest\x1a\x1a.user.v1.VerifyMFAResponse\x12H\n" +
+	"\vDisableTOTP\x12\x1b.user.v1.DisableTOTPRequest\x1a\x1c.user.v1.DisableTOTPResponseB7Z5github.com/team-loco/loco/shared/proto/user/v1;userv1b\x06proto3"
 
 var (
 	file_user_v1_user_proto_rawDescOnce sync.Once
@@ -900,31 +1596,45 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_user_v1_user_proto_rawDescData
 }
 
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_user_v1_user_proto_goTypes = []any{
-	(*User)(nil),                  // 0: user.v1.User
-	(*CreateUserRequest)(nil),     // 1: user.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),    // 2: user.v1.CreateUserResponse
-	(*GetUserRequest)(nil),        // 3: user.v1.GetUserRequest
-	(*GetUserResponse)(nil),       // 4: user.v1.GetUserResponse
-	(*UpdateUserRequest)(nil),     // 5: user.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),    // 6: user.v1.UpdateUserResponse
-	(*ListUsersRequest)(nil),      // 7: user.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),     // 8: user.v1.ListUsersResponse
-	(*WhoAmIRequest)(nil),         // 9: user.v1.WhoAmIRequest
-	(*WhoAmIResponse)(nil),        // 10: user.v1.WhoAmIResponse
-	(*DeleteUserRequest)(nil),     // 11: user.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),    // 12: user.v1.DeleteUserResponse
-	(*LogoutRequest)(nil),         // 13: user.v1.LogoutRequest
-	(*LogoutResponse)(nil),        // 14: user.v1.LogoutResponse
-	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil), // 16: google.protobuf.FieldMask
+	(*User)(nil),                    // 0: user.v1.User
+	(*CreateUserRequest)(nil),       // 1: user.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),      // 2: user.v1.CreateUserResponse
+	(*GetUserRequest)(nil),          // 3: user.v1.GetUserRequest
+	(*GetUserResponse)(nil),         // 4: user.v1.GetUserResponse
+	(*UpdateUserRequest)(nil),       // 5: user.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),      // 6: user.v1.UpdateUserResponse
+	(*ListUsersRequest)(nil),        // 7: user.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),       // 8: user.v1.ListUsersResponse
+	(*WhoAmIRequest)(nil),           // 9: user.v1.WhoAmIRequest
+	(*WhoAmIResponse)(nil),          // 10: user.v1.WhoAmIResponse
+	(*DeleteUserRequest)(nil),       // 11: user.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),      // 12: user.v1.DeleteUserResponse
+	(*LogoutRequest)(nil),           // 13: user.v1.LogoutRequest
+	(*LogoutResponse)(nil),          // 14: user.v1.LogoutResponse
+	(*DeleteMyAccountRequest)(nil),  // 15: user.v1.DeleteMyAccountRequest
+	(*DeleteMyAccountResponse)(nil), // 16: user.v1.DeleteMyAccountResponse
+	(*ExportMyDataRequest)(nil),     // 17: user.v1.ExportMyDataRequest
+	(*ExportMyDataResponse)(nil),    // 18: user.v1.ExportMyDataResponse
+	(*GetMFAStatusRequest)(nil),     // 19: user.v1.GetMFAStatusRequest
+	(*GetMFAStatusResponse)(nil),    // 20: user.v1.GetMFAStatusResponse
+	(*EnrollTOTPRequest)(nil),       // 21: user.v1.EnrollTOTPRequest
+	(*EnrollTOTPResponse)(nil),      // 22: user.v1.EnrollTOTPResponse
+	(*ConfirmTOTPRequest)(nil),      // 23: user.v1.ConfirmTOTPRequest
+	(*ConfirmTOTPResponse)(nil),     // 24: user.v1.ConfirmTOTPResponse
+	(*VerifyMFARequest)(nil),        // 25: user.v1.VerifyMFARequest
+	(*VerifyMFAResponse)(nil),       // 26: user.v1.VerifyMFAResponse
+	(*DisableTOTPRequest)(nil),      // 27: user.v1.DisableTOTPRequest
+	(*DisableTOTPResponse)(nil),     // 28: user.v1.DisableTOTPResponse
+	(*timestamppb.Timestamp)(nil),   // 29: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),   // 30: google.protobuf.FieldMask
 }
 var file_user_v1_user_proto_depIdxs = []int32{
-	15, // 0: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	15, // 1: user.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 0: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	29, // 1: user.v1.User.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: user.v1.GetUserResponse.user:type_name -> user.v1.User
-	16, // 3: user.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	30, // 3: user.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
 	0,  // 4: user.v1.ListUsersResponse.users:type_name -> user.v1.User
 	0,  // 5: user.v1.WhoAmIResponse.user:type_name -> user.v1.User
 	1,  // 6: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
@@ -934,15 +1644,29 @@ var file_user_v1_user_proto_depIdxs = []int32{
 	7,  // 10: user.v1.UserService.ListUsers:input_type -> user.v1.ListUsersRequest
 	11, // 11: user.v1.UserService.DeleteUser:input_type -> user.v1.DeleteUserRequest
 	13, // 12: user.v1.UserService.Logout:input_type -> user.v1.LogoutRequest
-	2,  // 13: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserResponse
-	4,  // 14: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
-	10, // 15: user.v1.UserService.WhoAmI:output_type -> user.v1.WhoAmIResponse
-	6,  // 16: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
-	8,  // 17: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersResponse
-	12, // 18: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserResponse
-	14, // 19: user.v1.UserService.Logout:output_type -> user.v1.LogoutResponse
-	13, // [13:20] is the sub-list for method output_type
-	6,  // [6:13] is the sub-list for method input_type
+	15, // 13: user.v1.UserService.DeleteMyAccount:input_type -> user.v1.DeleteMyAccountRequest
+	17, // 14: user.v1.UserService.ExportMyData:input_type -> user.v1.ExportMyDataRequest
+	19, // 15: user.v1.UserService.GetMFAStatus:input_type -> user.v1.GetMFAStatusRequest
+	21, // 16: user.v1.UserService.EnrollTOTP:input_type -> user.v1.EnrollTOTPRequest
+	23, // 17: user.v1.UserService.ConfirmTOTP:input_type -> user.v1.ConfirmTOTPRequest
+	25, // 18: user.v1.UserService.VerifyMFA:input_type -> user.v1.VerifyMFARequest
+	27, // 19: user.v1.UserService.DisableTOTP:input_type -> user.v1.DisableTOTPRequest
+	2,  // 20: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserResponse
+	4,  // 21: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
+	10, // 22: user.v1.UserService.WhoAmI:output_type -> user.v1.WhoAmIResponse
+	6,  // 23: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
+	8,  // 24: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersResponse
+	12, // 25: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserResponse
+	14, // 26: user.v1.UserService.Logout:output_type -> user.v1.LogoutResponse
+	16, // 27: user.v1.UserService.DeleteMyAccount:output_type -> user.v1.DeleteMyAccountResponse
+	18, // 28: user.v1.UserService.ExportMyData:output_type -> user.v1.ExportMyDataResponse
+	20, // 29: user.v1.UserService.GetMFAStatus:output_type -> user.v1.GetMFAStatusResponse
+	22, // 30: user.v1.UserService.EnrollTOTP:output_type -> user.v1.EnrollTOTPResponse
+	24, // 31: user.v1.UserService.ConfirmTOTP:output_type -> user.v1.ConfirmTOTPResponse
+	26, // 32: user.v1.UserService.VerifyMFA:output_type -> user.v1.VerifyMFAResponse
+	28, // 33: user.v1.UserService.DisableTOTP:output_type -> user.v1.DisableTOTPResponse
+	20, // [20:34] is the sub-list for method output_type
+	6,  // [6:20] is the sub-list for method input_type
 	6,  // [6:6] is the sub-list for extension type_name
 	6,  // [6:6] is the sub-list for extension extendee
 	0,  // [0:6] is the sub-list for field type_name
@@ -959,13 +1683,14 @@ func file_user_v1_user_proto_init() {
 		(*GetUserRequest_Email)(nil),
 	}
 	file_user_v1_user_proto_msgTypes[5].OneofWrappers = []any{}
+	file_user_v1_user_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -47,6 +47,23 @@ const (
 	UserServiceDeleteUserProcedure = "/user.v1.UserService/DeleteUser"
 	// UserServiceLogoutProcedure is the fully-qualified name of the UserService's Logout RPC.
 	UserServiceLogoutProcedure = "/user.v1.UserService/Logout"
+	// UserServiceDeleteMyAccountProcedure is the fully-qualified name of the UserService's
+	// DeleteMyAccount RPC.
+	UserServiceDeleteMyAccountProcedure = "/user.v1.UserService/DeleteMyAccount"
+	// UserServiceExportMyDataProcedure is the fully-qualified name of the UserService's ExportMyData
+	// RPC.
+	UserServiceExportMyDataProcedure = "/user.v1.UserService/ExportMyData"
+	// UserServiceGetMFAStatusProcedure is the fully-qualified name of the UserService's GetMFAStatus
+	// RPC.
+	UserServiceGetMFAStatusProcedure = "/user.v1.UserService/GetMFAStatus"
+	// UserServiceEnrollTOTPProcedure is the fully-qualified name of the UserService's EnrollTOTP RPC.
+	UserServiceEnrollTOTPProcedure = "/user.v1.UserService/EnrollTOTP"
+	// UserServiceConfirmTOTPProcedure is the fully-qualified name of the UserService's ConfirmTOTP RPC.
+	UserServiceConfirmTOTPProcedure = "/user.v1.UserService/ConfirmTOTP"
+	// UserServiceVerifyMFAProcedure is the fully-qualified name of the UserService's VerifyMFA RPC.
+	UserServiceVerifyMFAProcedure = "/user.v1.UserService/VerifyMFA"
+	// UserServiceDisableTOTPProcedure is the fully-qualified name of the UserService's DisableTOTP RPC.
+	UserServiceDisableTOTPProcedure = "/user.v1.UserService/DisableTOTP"
 )
 
 // UserServiceClient is a client for the user.v1.UserService service.
@@ -65,6 +82,22 @@ type UserServiceClient interface {
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
 	// Logout logs out the current user.
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
+	// DeleteMyAccount deletes the current user's account and revokes their tokens. Organizations
+	// the user solely administers must be handed to another admin or deleted first.
+	DeleteMyAccount(context.Context, *connect.Request[v1.DeleteMyAccountRequest]) (*connect.Response[v1.DeleteMyAccountResponse], error)
+	// ExportMyData returns a machine-readable archive of the current user's records.
+	ExportMyData(context.Context, *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error)
+	// GetMFAStatus reports whether the current user has enrolled in MFA and whether an organization requires it.
+	GetMFAStatus(context.Context, *connect.Request[v1.GetMFAStatusRequest]) (*connect.Response[v1.GetMFAStatusResponse], error)
+	// EnrollTOTP starts enrolling the current user in TOTP MFA, returning the secret to add to an authenticator app.
+	EnrollTOTP(context.Context, *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error)
+	// ConfirmTOTP finishes enrolling with a code from the authenticator app. Called with a login waiting on MFA,
+	// it also completes the login.
+	ConfirmTOTP(context.Context, *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error)
+	// VerifyMFA completes a login waiting on MFA with a TOTP code.
+	VerifyMFA(context.Context, *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error)
+	// DisableTOTP removes the current user's TOTP MFA, unless an organization of theirs requires it.
+	DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the user.v1.UserService service. By default, it uses
@@ -120,18 +153,67 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("Logout")),
 			connect.WithClientOptions(opts...),
 		),
+		deleteMyAccount: connect.NewClient[v1.DeleteMyAccountRequest, v1.DeleteMyAccountResponse](
+			httpClient,
+			baseURL+UserServiceDeleteMyAccountProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteMyAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		exportMyData: connect.NewClient[v1.ExportMyDataRequest, v1.ExportMyDataResponse](
+			httpClient,
+			baseURL+UserServiceExportMyDataProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ExportMyData")),
+			connect.WithClientOptions(opts...),
+		),
+		getMFAStatus: connect.NewClient[v1.GetMFAStatusRequest, v1.GetMFAStatusResponse](
+			httpClient,
+			baseURL+UserServiceGetMFAStatusProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetMFAStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		enrollTOTP: connect.NewClient[v1.EnrollTOTPRequest, v1.EnrollTOTPResponse](
+			httpClient,
+			baseURL+UserServiceEnrollTOTPProcedure,
+			connect.WithSchema(userServiceMethods.ByName("EnrollTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmTOTP: connect.NewClient[v1.ConfirmTOTPRequest, v1.ConfirmTOTPResponse](
+			httpClient,
+			baseURL+UserServiceConfirmTOTPProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ConfirmTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyMFA: connect.NewClient[v1.VerifyMFARequest, v1.VerifyMFAResponse](
+			httpClient,
+			baseURL+UserServiceVerifyMFAProcedure,
+			connect.WithSchema(userServiceMethods.ByName("VerifyMFA")),
+			connect.WithClientOptions(opts...),
+		),
+		disableTOTP: connect.NewClient[v1.DisableTOTPRequest, v1.DisableTOTPResponse](
+			httpClient,
+			baseURL+UserServiceDisableTOTPProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DisableTOTP")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	createUser *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
-	getUser    *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
-	whoAmI     *connect.Client[v1.WhoAmIRequest, v1.WhoAmIResponse]
-	updateUser *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
-	listUsers  *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
-	deleteUser *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
-	logout     *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	createUser      *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
+	getUser         *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	whoAmI          *connect.Client[v1.WhoAmIRequest, v1.WhoAmIResponse]
+	updateUser      *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	listUsers       *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	deleteUser      *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	logout          *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	deleteMyAccount *connect.Client[v1.DeleteMyAccountRequest, v1.DeleteMyAccountResponse]
+	exportMyData    *connect.Client[v1.ExportMyDataRequest, v1.ExportMyDataResponse]
+	getMFAStatus    *connect.Client[v1.GetMFAStatusRequest, v1.GetMFAStatusResponse]
+	enrollTOTP      *connect.Client[v1.EnrollTOTPRequest, v1.EnrollTOTPResponse]
+	confirmTOTP     *connect.Client[v1.ConfirmTOTPRequest, v1.ConfirmTOTPResponse]
+	verifyMFA       *connect.Client[v1.VerifyMFARequest, v1.VerifyMFAResponse]
+	disableTOTP     *connect.Client[v1.DisableTOTPRequest, v1.DisableTOTPResponse]
 }
 
 // CreateUser calls user.v1.UserService.CreateUser.
@@ -169,6 +251,41 @@ func (c *userServiceClient) Logout(ctx context.Context, req *connect.Request[v1.
 	return c.logout.CallUnary(ctx, req)
 }
 
+// DeleteMyAccount calls user.v1.UserService.DeleteMyAccount.
+func (c *userServiceClient) DeleteMyAccount(ctx context.Context, req *connect.Request[v1.DeleteMyAccountRequest]) (*connect.Response[v1.DeleteMyAccountResponse], error) {
+	return c.deleteMyAccount.CallUnary(ctx, req)
+}
+
+// ExportMyData calls user.v1.UserService.ExportMyData.
+func (c *userServiceClient) ExportMyData(ctx context.Context, req *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error) {
+	return c.exportMyData.CallUnary(ctx, req)
+}
+
+// GetMFAStatus calls user.v1.UserService.GetMFAStatus.
+func (c *userServiceClient) GetMFAStatus(ctx context.Context, req *connect.Request[v1.GetMFAStatusRequest]) (*connect.Response[v1.GetMFAStatusResponse], error) {
+	return c.getMFAStatus.CallUnary(ctx, req)
+}
+
+// EnrollTOTP calls user.v1.UserService.EnrollTOTP.
+func (c *userServiceClient) EnrollTOTP(ctx context.Context, req *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error) {
+	return c.enrollTOTP.CallUnary(ctx, req)
+}
+
+// ConfirmTOTP calls user.v1.UserService.ConfirmTOTP.
+func (c *userServiceClient) ConfirmTOTP(ctx context.Context, req *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error) {
+	return c.confirmTOTP.CallUnary(ctx, req)
+}
+
+// VerifyMFA calls user.v1.UserService.VerifyMFA.
+func (c *userServiceClient) VerifyMFA(ctx context.Context, req *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error) {
+	return c.verifyMFA.CallUnary(ctx, req)
+}
+
+// DisableTOTP calls user.v1.UserService.DisableTOTP.
+func (c *userServiceClient) DisableTOTP(ctx context.Context, req *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	return c.disableTOTP.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the user.v1.UserService service.
 type UserServiceHandler interface {
 	// CreateUser creates a new user account.
@@ -185,6 +302,22 @@ type UserServiceHandler interface {
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
 	// Logout logs out the current user.
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
+	// DeleteMyAccount deletes the current user's account and revokes their tokens. Organizations
+	// the user solely administers must be handed to another admin or deleted first.
+	DeleteMyAccount(context.Context, *connect.Request[v1.DeleteMyAccountRequest]) (*connect.Response[v1.DeleteMyAccountResponse], error)
+	// ExportMyData returns a machine-readable archive of the current user's records.
+	ExportMyData(context.Context, *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error)
+	// GetMFAStatus reports whether the current user has enrolled in MFA and whether an organization requires it.
+	GetMFAStatus(context.Context, *connect.Request[v1.GetMFAStatusRequest]) (*connect.Response[v1.GetMFAStatusResponse], error)
+	// EnrollTOTP starts enrolling the current user in TOTP MFA, returning the secret to add to an authenticator app.
+	EnrollTOTP(context.Context, *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error)
+	// ConfirmTOTP finishes enrolling with a code from the authenticator app. Called with a login waiting on MFA,
+	// it also completes the login.
+	ConfirmTOTP(context.Context, *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error)
+	// VerifyMFA completes a login waiting on MFA with a TOTP code.
+	VerifyMFA(context.Context, *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error)
+	// DisableTOTP removes the current user's TOTP MFA, unless an organization of theirs requires it.
+	DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -236,6 +369,48 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("Logout")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceDeleteMyAccountHandler := connect.NewUnaryHandler(
+		UserServiceDeleteMyAccountProcedure,
+		svc.DeleteMyAccount,
+		connect.WithSchema(userServiceMethods.ByName("DeleteMyAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceExportMyDataHandler := connect.NewUnaryHandler(
+		UserServiceExportMyDataProcedure,
+		svc.ExportMyData,
+		connect.WithSchema(userServiceMethods.ByName("ExportMyData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetMFAStatusHandler := connect.NewUnaryHandler(
+		UserServiceGetMFAStatusProcedure,
+		svc.GetMFAStatus,
+		connect.WithSchema(userServiceMethods.ByName("GetMFAStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceEnrollTOTPHandler := connect.NewUnaryHandler(
+		UserServiceEnrollTOTPProcedure,
+		svc.EnrollTOTP,
+		connect.WithSchema(userServiceMethods.ByName("EnrollTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceConfirmTOTPHandler := connect.NewUnaryHandler(
+		UserServiceConfirmTOTPProcedure,
+		svc.ConfirmTOTP,
+		connect.WithSchema(userServiceMethods.ByName("ConfirmTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceVerifyMFAHandler := connect.NewUnaryHandler(
+		UserServiceVerifyMFAProcedure,
+		svc.VerifyMFA,
+		connect.WithSchema(userServiceMethods.ByName("VerifyMFA")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDisableTOTPHandler := connect.NewUnaryHandler(
+		UserServiceDisableTOTPProcedure,
+		svc.DisableTOTP,
+		connect.WithSchema(userServiceMethods.ByName("DisableTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/user.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceCreateUserProcedure:
@@ -252,6 +427,20 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceDeleteUserHandler.ServeHTTP(w, r)
 		case UserServiceLogoutProcedure:
 			userServiceLogoutHandler.ServeHTTP(w, r)
+		case UserServiceDeleteMyAccountProcedure:
+			userServiceDeleteMyAccountHandler.ServeHTTP(w, r)
+		case UserServiceExportMyDataProcedure:
+			userServiceExportMyDataHandler.ServeHTTP(w, r)
+		case UserServiceGetMFAStatusProcedure:
+			userServiceGetMFAStatusHandler.ServeHTTP(w, r)
+		case UserServiceEnrollTOTPProcedure:
+			userServiceEnrollTOTPHandler.ServeHTTP(w, r)
+		case UserServiceConfirmTOTPProcedure:
+			userServiceConfirmTOTPHandler.ServeHTTP(w, r)
+		case UserServiceVerifyMFAProcedure:
+			userServiceVerifyMFAHandler.ServeHTTP(w, r)
+		case UserServiceDisableTOTPProcedure:
+			userServiceDisableTOTPHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -288,3 +477,31 @@ func (UnimplementedUserServiceHandler) DeleteUser(context.Context, *connect.Requ
 func (UnimplementedUserServiceHandler) Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.Logout is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) DeleteMyAccount(context.Context, *connect.Request[v1.DeleteMyAccountRequest]) (*connect.Response[v1.DeleteMyAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.DeleteMyAccount is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ExportMyData(context.Context, *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ExportMyData is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetMFAStatus(context.Context, *connect.Request[v1.GetMFAStatusRequest]) (*connect.Response[v1.GetMFAStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.GetMFAStatus is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) EnrollTOTP(context.Context, *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.EnrollTOTP is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ConfirmTOTP(context.Context, *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ConfirmTOTP is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) VerifyMFA(context.Context, *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.VerifyMFA is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.DisableTOTP is not implemented"))
+}
@@ -25,16 +25,21 @@ const (
 
 // Workspace represents a project container within an organization where resources are deployed and managed.
 type Workspace struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrgId         int64                  `protobuf:"varint,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedBy     int64                  `protobuf:"varint,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	Id                           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId                        int64                  `protobuf:"varint,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name                         string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description                  string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedBy                    int64                  `protobuf:"varint,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt                    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt                    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeploymentHistoryLimit       int32                  `protobuf:"varint,8,opt,name=deployment_history_limit,json=deploymentHistoryLimit,proto3" json:"deployment_history_limit,omitempty"` // inactive deployments kept per resource; 0 keeps all
+	ExternalId                   *string                `protobuf:"bytes,9,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+	Labels                       map[string]string      `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ArchivedAt                   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`                                                          // unset unless archived
+	BlockCriticalVulnerabilities bool                   `protobuf:"varint,12,opt,name=block_critical_vulnerabilities,json=blockCriticalVulnerabilities,proto3" json:"block_critical_vulnerabilities,omitempty"` // refuse to deploy images with critical vulnerabilities, or that could not be scanned
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *Workspace) Reset() {
@@ -116,6 +121,41 @@ func (x *Workspace) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Workspace) GetDeploymentHistoryLimit() int32 {
+	if x != nil {
+		return x.DeploymentHistoryLimit
+	}
+	return 0
+}
+
+func (x *Workspace) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *Workspace) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Workspace) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Workspace) GetBlockCriticalVulnerabilities() bool {
+	if x != nil {
+		return x.BlockCriticalVulnerabilities
+	}
+	return false
+}
+
 // WorkspaceMember represents a user's membership and role assignment in a workspace.
 type WorkspaceMember struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -280,10 +320,14 @@ func (x *WorkspaceMemberWithUser) GetUserAvatarUrl() string {
 
 // CreateWorkspaceRequest is the request to create a new workspace.
 type CreateWorkspaceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	OrgId       int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// external_id is a caller-chosen ID, unique within the org. Creating a workspace again
+	// with the same external_id returns the existing workspace instead of failing.
+	ExternalId    *string           `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+	Labels        map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -339,10 +383,25 @@ func (x *CreateWorkspaceRequest) GetDescription() string {
 	return ""
 }
 
-// CreateWorkspaceResponse is the response containing the created workspace ID.
+func (x *CreateWorkspaceRequest) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// CreateWorkspaceResponse is the response containing the created workspace.
 type CreateWorkspaceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Workspace     *Workspace             `protobuf:"bytes,2,opt,name=workspace,proto3" json:"workspace,omitempty"` // as stored, so clients need no follow-up read
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -384,6 +443,13 @@ func (x *CreateWorkspaceResponse) GetWorkspaceId() int64 {
 	return 0
 }
 
+func (x *CreateWorkspaceResponse) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
 // GetWorkspaceRequest is the request to retrieve a workspace.
 type GetWorkspaceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -476,12 +542,13 @@ func (x *GetWorkspaceResponse) GetWorkspace() *Workspace {
 
 // ListUserWorkspacesRequest is the request to list workspaces for a user.
 type ListUserWorkspacesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // default: 50, max: 200
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // cursor from previous page (base64-encoded timestamp+id)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize        int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // default: 50, max: 200
+	PageToken       string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // cursor from previous page (base64-encoded timestamp+id)
+	IncludeArchived bool                   `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListUserWorkspacesRequest) Reset() {
@@ -535,6 +602,13 @@ func (x *ListUserWorkspacesRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListUserWorkspacesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 // ListUserWorkspacesResponse contains the list of user's workspaces.
 type ListUserWorkspacesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -590,12 +664,13 @@ func (x *ListUserWorkspacesResponse) GetNextPageToken() string {
 
 // ListOrgWorkspacesRequest is the request to list workspaces in an organization.
 type ListOrgWorkspacesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrgId         int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // default: 50, max: 200
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // cursor from previous page (base64-encoded timestamp+id)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrgId           int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	PageSize        int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // default: 50, max: 200
+	PageToken       string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // cursor from previous page (base64-encoded timestamp+id)
+	IncludeArchived bool                   `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListOrgWorkspacesRequest) Reset() {
@@ -649,6 +724,13 @@ func (x *ListOrgWorkspacesRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListOrgWorkspacesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 // ListOrgWorkspacesResponse contains the list of workspaces.
 type ListOrgWorkspacesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -703,14 +785,20 @@ func (x *ListOrgWorkspacesResponse) GetNextPageToken() string {
 }
 
 // UpdateWorkspaceRequest is the request to update a workspace.
+// update_mask lists the fields to change (name, description, deployment_history_limit, labels,
+// block_critical_vulnerabilities), so a field in the mask that is unset is cleared. When
+// update_mask is empty, only set fields are changed. Immutable fields such as org_id are rejected.
 type UpdateWorkspaceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId                  int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UpdateMask                   *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Name                         *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description                  *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	DeploymentHistoryLimit       *int32                 `protobuf:"varint,5,opt,name=deployment_history_limit,json=deploymentHistoryLimit,proto3,oneof" json:"deployment_history_limit,omitempty"` // inactive deployments kept per resource; 0 keeps all
+	Labels                       map[string]string      `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	BlockCriticalVulnerabilities *bool                  `protobuf:"varint,7,opt,name=block_critical_vulnerabilities,json=blockCriticalVulnerabilities,proto3,oneof" json:"block_critical_vulnerabilities,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *UpdateWorkspaceRequest) Reset() {
@@ -771,10 +859,32 @@ func (x *UpdateWorkspaceRequest) GetDescription() string {
 	return ""
 }
 
-// UpdateWorkspaceResponse is the response containing the updated workspace ID.
+func (x *UpdateWorkspaceRequest) GetDeploymentHistoryLimit() int32 {
+	if x != nil && x.DeploymentHistoryLimit != nil {
+		return *x.DeploymentHistoryLimit
+	}
+	return 0
+}
+
+func (x *UpdateWorkspaceRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UpdateWorkspaceRequest) GetBlockCriticalVulnerabilities() bool {
+	if x != nil && x.BlockCriticalVulnerabilities != nil {
+		return *x.BlockCriticalVulnerabilities
+	}
+	return false
+}
+
+// UpdateWorkspaceResponse is the response containing the updated workspace.
 type UpdateWorkspaceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Workspace     *Workspace             `protobuf:"bytes,2,opt,name=workspace,proto3" json:"workspace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -816,6 +926,13 @@ func (x *UpdateWorkspaceResponse) GetWorkspaceId() int64 {
 	return 0
 }
 
+func (x *UpdateWorkspaceResponse) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
 // DeleteWorkspaceRequest is the request to delete a workspace.
 type DeleteWorkspaceRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -1224,112 +1341,1272 @@ func (x *ListWorkspaceMembersResponse) GetNextPageToken() string {
 	return ""
 }
 
-var File_workspace_v1_workspace_proto protoreflect.FileDescriptor
+// ExportWorkspaceRequest is the request to export a workspace as a bundle.
+type ExportWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_workspace_v1_workspace_proto_rawDesc = "" +
-	"\n" +
-	"\x1cworkspace/v1/workspace.proto\x12\fworkspace.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfd\x01\n" +
-	"\tWorkspace\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
-	"\x06org_id\x18\x02 \x01(\x03R\x05orgId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\x05 \x01(\x03R\tcreatedBy\x129\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9c\x01\n" +
-	"\x0fWorkspaceMember\x12!\n" +
-	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
-	"\x04role\x18\x03 \x01(\tR\x04role\x129\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x88\x02\n" +
-	"\x17WorkspaceMemberWithUser\x12!\n" +
-	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
-	"\x04role\x18\x03 \x01(\tR\x04role\x129\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
-	"\tuser_name\x18\x05 \x01(\tR\buserName\x12\x1d\n" +
-	"\n" +
-	"user_email\x18\x06 \x01(\tR\tuserEmail\x12&\n" +
-	"\x0fuser_avatar_url\x18\a \x01(\tR\ruserAvatarUrl\"z\n" +
-	"\x16CreateWorkspaceRequest\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
-	"\f_description\"<\n" +
-	"\x17CreateWorkspaceResponse\x12!\n" +
-	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"8\n" +
-	"\x13GetWorkspaceRequest\x12!\n" +
-	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"M\n" +
-	"\x14GetWorkspaceResponse\x125\n" +
-	"\tworkspace\x18\x01 \x01(\v2\x17.workspace.v1.WorkspaceR\tworkspace\"p\n" +
-	"\x19ListUserWorkspacesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"}\n" +
-	"\x1aListUserWorkspacesResponse\x127\n" +
-	"\n" +
-	"workspaces\x18\x01 \x03(\v2\x17.workspace.v1.WorkspaceR\n" +
-	"workspaces\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"m\n" +
-	"\x18ListOrgWorkspacesRequest\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"|\n" +
-	"\x19ListOrgWorkspacesResponse\x127\n" +
-	"\n" +
-	"workspaces\x18\x01 \x03(\v2\x17.workspace.v1.WorkspaceR\n" +
-	"workspaces\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd1\x01\n" +
-	"\x16UpdateWorkspaceRequest\x12!\n" +
-	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12;\n" +
-	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\x12\x17\n" +
-	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01B\a\n" +
-	"\x05_nameB\x0e\n" +
-	"\f_description\"<\n" +
-	"\x17UpdateWorkspaceResponse\x12!\n" +
-	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"k\n" +
-	"\x16DeleteWorkspaceRequest\x12!\n" +
-	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12.\n" +
-	"\x13confirm_delete_apps\x18\x02 \x01(\bR\x11confirmDeleteApps\"\x19\n" +
-	"\x17DeleteWorkspaceResponse\"e\n" +
-	"\x13CreateMemberRequest\x12!\n" +
-	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
-	"\x04role\x18\x03 \x01(\tR\x04role\"R\n" +
-	"\x14CreateMemberResponse\x12!\n" +
-	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\"Q\n" +
-	"\x13DeleteMemberRequest\x12!\n" +
-	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x16\n" +
-	"\x14DeleteMemberResponse\"|\n" +
-	"\x1bListWorkspaceMembersRequest\x12!\n" +
-	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"\x87\x01\n" +
-	"\x1cListWorkspaceMembersResponse\x12?\n" +
-	"\amembers\x18\x01 \x03(\v2%.workspace.v1.WorkspaceMemberWithUserR\amembers\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xf5\x06\n" +
+func (x *ExportWorkspaceRequest) Reset() {
+	*x = ExportWorkspaceRequest{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWorkspaceRequest) ProtoMessage() {}
+
+func (x *ExportWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExportWorkspaceRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+// ExportWorkspaceResponse contains the exported bundle.
+type ExportWorkspaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bundle        string                 `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"` // YAML
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportWorkspaceResponse) Reset() {
+	*x = ExportWorkspaceResponse{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWorkspaceResponse) ProtoMessage() {}
+
+func (x *ExportWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExportWorkspaceResponse) GetBundle() string {
+	if x != nil {
+		return x.Bundle
+	}
+	return ""
+}
+
+// ImportWorkspaceRequest is the request to import a bundle into a workspace.
+type ImportWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Bundle        string                 `protobuf:"bytes,2,opt,name=bundle,proto3" json:"bundle,omitempty"`                                                                             // YAML, as produced by ExportWorkspace
+	Secrets       map[string]string      `protobuf:"bytes,3,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // values for the bundle's secret references, keyed by reference
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                                              // validate the bundle and report what would be created
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportWorkspaceRequest) Reset() {
+	*x = ImportWorkspaceRequest{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportWorkspaceRequest) ProtoMessage() {}
+
+func (x *ImportWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*ImportWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportWorkspaceRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *ImportWorkspaceRequest) GetBundle() string {
+	if x != nil {
+		return x.Bundle
+	}
+	return ""
+}
+
+func (x *ImportWorkspaceRequest) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *ImportWorkspaceRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ImportedResource is a resource created by an import.
+type ImportedResource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Environment   *string                `protobuf:"bytes,2,opt,name=environment,proto3,oneof" json:"environment,omitempty"`
+	ResourceId    int64                  `protobuf:"varint,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 0 for dry runs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedResource) Reset() {
+	*x = ImportedResource{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedResource) ProtoMessage() {}
+
+func (x *ImportedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedResource.ProtoReflect.Descriptor instead.
+func (*ImportedResource) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportedResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportedResource) GetEnvironment() string {
+	if x != nil && x.Environment != nil {
+		return *x.Environment
+	}
+	return ""
+}
+
+func (x *ImportedResource) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+// ImportWorkspaceResponse reports what an import created.
+type ImportWorkspaceResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Environments []string               `protobuf:"bytes,1,rep,name=environments,proto3" json:"environments,omitempty"` // environments created; existing ones are reused as-is
+	Resources    []*ImportedResource    `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
+	// secret references that were not applied: environment env vars missing from secrets, and every
+	// resource env var, which is set with the resource's first deployment.
+	UnresolvedSecrets []string `protobuf:"bytes,3,rep,name=unresolved_secrets,json=unresolvedSecrets,proto3" json:"unresolved_secrets,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ImportWorkspaceResponse) Reset() {
+	*x = ImportWorkspaceResponse{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportWorkspaceResponse) ProtoMessage() {}
+
+func (x *ImportWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*ImportWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportWorkspaceResponse) GetEnvironments() []string {
+	if x != nil {
+		return x.Environments
+	}
+	return nil
+}
+
+func (x *ImportWorkspaceResponse) GetResources() []*ImportedResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ImportWorkspaceResponse) GetUnresolvedSecrets() []string {
+	if x != nil {
+		return x.UnresolvedSecrets
+	}
+	return nil
+}
+
+// ArchiveWorkspaceRequest is the request to archive a workspace.
+type ArchiveWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Message       *string                `protobuf:"bytes,2,opt,name=message,proto3,oneof" json:"message,omitempty"` // maintenance message the suspended resources show
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveWorkspaceRequest) Reset() {
+	*x = ArchiveWorkspaceRequest{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveWorkspaceRequest) ProtoMessage() {}
+
+func (x *ArchiveWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ArchiveWorkspaceRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *ArchiveWorkspaceRequest) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+// ArchiveWorkspaceResponse is the response containing the archived workspace.
+type ArchiveWorkspaceResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Workspace          *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	SuspendedResources int32                  `protobuf:"varint,2,opt,name=suspended_resources,json=suspendedResources,proto3" json:"suspended_resources,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ArchiveWorkspaceResponse) Reset() {
+	*x = ArchiveWorkspaceResponse{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveWorkspaceResponse) ProtoMessage() {}
+
+func (x *ArchiveWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ArchiveWorkspaceResponse) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+func (x *ArchiveWorkspaceResponse) GetSuspendedResources() int32 {
+	if x != nil {
+		return x.SuspendedResources
+	}
+	return 0
+}
+
+// UnarchiveWorkspaceRequest is the request to reactivate an archived workspace.
+type UnarchiveWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveWorkspaceRequest) Reset() {
+	*x = UnarchiveWorkspaceRequest{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveWorkspaceRequest) ProtoMessage() {}
+
+func (x *UnarchiveWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnarchiveWorkspaceRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+// UnarchiveWorkspaceResponse is the response containing the reactivated workspace.
+type UnarchiveWorkspaceResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Workspace        *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	ResumedResources int32                  `protobuf:"varint,2,opt,name=resumed_resources,json=resumedResources,proto3" json:"resumed_resources,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UnarchiveWorkspaceResponse) Reset() {
+	*x = UnarchiveWorkspaceResponse{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveWorkspaceResponse) ProtoMessage() {}
+
+func (x *UnarchiveWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnarchiveWorkspaceResponse) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+func (x *UnarchiveWorkspaceResponse) GetResumedResources() int32 {
+	if x != nil {
+		return x.ResumedResources
+	}
+	return 0
+}
+
+// GetWorkspaceEnvRequest is the request to get a workspace's shared env vars.
+type GetWorkspaceEnvRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceEnvRequest) Reset() {
+	*x = GetWorkspaceEnvRequest{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceEnvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceEnvRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceEnvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceEnvRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceEnvRequest) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetWorkspaceEnvRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+// StaleResource is a resource whose active deployment predates the last change to its workspace's shared
+// env vars, so it runs with the old values until redeployed.
+type StaleResource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaleResource) Reset() {
+	*x = StaleResource{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaleResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleResource) ProtoMessage() {}
+
+func (x *StaleResource) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleResource.ProtoReflect.Descriptor instead.
+func (*StaleResource) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StaleResource) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *StaleResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetWorkspaceEnvResponse is the response containing a workspace's shared env vars.
+type GetWorkspaceEnvResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Env            map[string]string      `protobuf:"bytes,1,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // unset if never set
+	StaleResources []*StaleResource       `protobuf:"bytes,3,rep,name=stale_resources,json=staleResources,proto3" json:"stale_resources,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceEnvResponse) Reset() {
+	*x = GetWorkspaceEnvResponse{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceEnvResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceEnvResponse) ProtoMessage() {}
+
+func (x *GetWorkspaceEnvResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceEnvResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceEnvResponse) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetWorkspaceEnvResponse) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *GetWorkspaceEnvResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GetWorkspaceEnvResponse) GetStaleResources() []*StaleResource {
+	if x != nil {
+		return x.StaleResources
+	}
+	return nil
+}
+
+// UpdateWorkspaceEnvRequest is the request to replace a workspace's shared env vars.
+type UpdateWorkspaceEnvRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Env           map[string]string      `protobuf:"bytes,2,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // replaces every shared env var; empty removes them all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkspaceEnvRequest) Reset() {
+	*x = UpdateWorkspaceEnvRequest{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkspaceEnvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceEnvRequest) ProtoMessage() {}
+
+func (x *UpdateWorkspaceEnvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceEnvRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceEnvRequest) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateWorkspaceEnvRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *UpdateWorkspaceEnvRequest) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+// UpdateWorkspaceEnvResponse is the response containing the updated env vars and the resources to redeploy.
+type UpdateWorkspaceEnvResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Env            map[string]string      `protobuf:"bytes,1,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Changed        bool                   `protobuf:"varint,2,opt,name=changed,proto3" json:"changed,omitempty"` // false when the env vars were already set to these values
+	StaleResources []*StaleResource       `protobuf:"bytes,3,rep,name=stale_resources,json=staleResources,proto3" json:"stale_resources,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateWorkspaceEnvResponse) Reset() {
+	*x = UpdateWorkspaceEnvResponse{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkspaceEnvResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceEnvResponse) ProtoMessage() {}
+
+func (x *UpdateWorkspaceEnvResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceEnvResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceEnvResponse) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateWorkspaceEnvResponse) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *UpdateWorkspaceEnvResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *UpdateWorkspaceEnvResponse) GetStaleResources() []*StaleResource {
+	if x != nil {
+		return x.StaleResources
+	}
+	return nil
+}
+
+// GetWorkspaceDigestRequest is the request to get a workspace's weekly digest.
+type GetWorkspaceDigestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	WeekOf        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=week_of,json=weekOf,proto3" json:"week_of,omitempty"` // any time in the week to summarize; unset for the latest compiled digest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceDigestRequest) Reset() {
+	*x = GetWorkspaceDigestRequest{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceDigestRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceDigestRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceDigestRequest) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetWorkspaceDigestRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *GetWorkspaceDigestRequest) GetWeekOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WeekOf
+	}
+	return nil
+}
+
+// DigestResource is one of a workspace's busiest resources over a digest's week.
+type DigestResource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ReplicaHours  int64                  `protobuf:"varint,3,opt,name=replica_hours,json=replicaHours,proto3" json:"replica_hours,omitempty"`
+	CostMicros    int64                  `protobuf:"varint,4,opt,name=cost_micros,json=costMicros,proto3" json:"cost_micros,omitempty"` // millionths of currency
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigestResource) Reset() {
+	*x = DigestResource{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestResource) ProtoMessage() {}
+
+func (x *DigestResource) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestResource.ProtoReflect.Descriptor instead.
+func (*DigestResource) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DigestResource) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *DigestResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DigestResource) GetReplicaHours() int64 {
+	if x != nil {
+		return x.ReplicaHours
+	}
+	return 0
+}
+
+func (x *DigestResource) GetCostMicros() int64 {
+	if x != nil {
+		return x.CostMicros
+	}
+	return 0
+}
+
+func (x *DigestResource) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// WorkspaceDigest summarizes a workspace's activity over the week from week_start to week_end.
+type WorkspaceDigest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId       int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	WeekStart         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"` // Monday at midnight UTC
+	WeekEnd           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=week_end,json=weekEnd,proto3" json:"week_end,omitempty"`
+	Deployments       int32                  `protobuf:"varint,4,opt,name=deployments,proto3" json:"deployments,omitempty"`
+	FailedDeployments int32                  `protobuf:"varint,5,opt,name=failed_deployments,json=failedDeployments,proto3" json:"failed_deployments,omitempty"`
+	FailureRate       float64                `protobuf:"fixed64,6,opt,name=failure_rate,json=failureRate,proto3" json:"failure_rate,omitempty"`  // failed_deployments / deployments, 0 without deployments
+	TopResources      []*DigestResource      `protobuf:"bytes,7,rep,name=top_resources,json=topResources,proto3" json:"top_resources,omitempty"` // busiest first, by replica hours
+	Partial           bool                   `protobuf:"varint,8,opt,name=partial,proto3" json:"partial,omitempty"`                              // the week is not over yet
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WorkspaceDigest) Reset() {
+	*x = WorkspaceDigest{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceDigest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceDigest) ProtoMessage() {}
+
+func (x *WorkspaceDigest) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceDigest.ProtoReflect.Descriptor instead.
+func (*WorkspaceDigest) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WorkspaceDigest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *WorkspaceDigest) GetWeekStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WeekStart
+	}
+	return nil
+}
+
+func (x *WorkspaceDigest) GetWeekEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WeekEnd
+	}
+	return nil
+}
+
+func (x *WorkspaceDigest) GetDeployments() int32 {
+	if x != nil {
+		return x.Deployments
+	}
+	return 0
+}
+
+func (x *WorkspaceDigest) GetFailedDeployments() int32 {
+	if x != nil {
+		return x.FailedDeployments
+	}
+	return 0
+}
+
+func (x *WorkspaceDigest) GetFailureRate() float64 {
+	if x != nil {
+		return x.FailureRate
+	}
+	return 0
+}
+
+func (x *WorkspaceDigest) GetTopResources() []*DigestResource {
+	if x != nil {
+		return x.TopResources
+	}
+	return nil
+}
+
+func (x *WorkspaceDigest) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+// GetWorkspaceDigestResponse is the response containing the digest.
+type GetWorkspaceDigestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digest        *WorkspaceDigest       `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceDigestResponse) Reset() {
+	*x = GetWorkspaceDigestResponse{}
+	mi := &file_workspace_v1_workspace_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceDigestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceDigestResponse) ProtoMessage() {}
+
+func (x *GetWorkspaceDigestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_v1_workspace_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceDigestResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceDigestResponse) Descriptor() ([]byte, []int) {
+	return file_workspace_v1_workspace_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetWorkspaceDigestResponse) GetDigest() *WorkspaceDigest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+var File_workspace_v1_workspace_proto protoreflect.FileDescriptor
+
+const file_workspace_v1_workspace_proto_rawDesc = "" +
+	"\n" +
+	"\x1cworkspace/v1/workspace.proto\x12\fworkspace.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe8\x04\n" +
+	"\tWorkspace\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\x03R\x05orgId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\x03R\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x128\n" +
+	"\x18deployment_history_limit\x18\b \x01(\x05R\x16deploymentHistoryLimit\x12$\n" +
+	"\vexternal_id\x18\t \x01(\tH\x00R\n" +
+	"externalId\x88\x01\x01\x12;\n" +
+	"\x06labels\x18\n" +
+	" \x03(\v2#.workspace.v1.Workspace.LabelsEntryR\x06labels\x12;\n" +
+	"\varchived_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12D\n" +
+	"\x1eblock_critical_vulnerabilities\x18\f \x01(\bR\x1cblockCriticalVulnerabilities\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_external_id\"\x9c\x01\n" +
+	"\x0fWorkspaceMember\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x88\x02\n" +
+	"\x17WorkspaceMemberWithUser\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tuser_name\x18\x05 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x06 \x01(\tR\tuserEmail\x12&\n" +
+	"\x0fuser_avatar_url\x18\a \x01(\tR\ruserAvatarUrl\"\xb5\x02\n" +
+	"\x16CreateWorkspaceRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12$\n" +
+	"\vexternal_id\x18\x04 \x01(\tH\x01R\n" +
+	"externalId\x88\x01\x01\x12H\n" +
+	"\x06labels\x18\x05 \x03(\v20.workspace.v1.CreateWorkspaceRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_external_id\"s\n" +
+	"\x17CreateWorkspaceResponse\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x125\n" +
+	"\tworkspace\x18\x02 \x01(\v2\x17.workspace.v1.WorkspaceR\tworkspace\"8\n" +
+	"\x13GetWorkspaceRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"M\n" +
+	"\x14GetWorkspaceResponse\x125\n" +
+	"\tworkspace\x18\x01 \x01(\v2\x17.workspace.v1.WorkspaceR\tworkspace\"\x9b\x01\n" +
+	"\x19ListUserWorkspacesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12)\n" +
+	"\x10include_archived\x18\x04 \x01(\bR\x0fincludeArchived\"}\n" +
+	"\x1aListUserWorkspacesResponse\x127\n" +
+	"\n" +
+	"workspaces\x18\x01 \x03(\v2\x17.workspace.v1.WorkspaceR\n" +
+	"workspaces\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x98\x01\n" +
+	"\x18ListOrgWorkspacesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\x03R\x05orgId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12)\n" +
+	"\x10include_archived\x18\x04 \x01(\bR\x0fincludeArchived\"|\n" +
+	"\x19ListOrgWorkspacesResponse\x127\n" +
+	"\n" +
+	"workspaces\x18\x01 \x03(\v2\x17.workspace.v1.WorkspaceR\n" +
+	"workspaces\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa0\x04\n" +
+	"\x16UpdateWorkspaceRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12=\n" +
+	"\x18deployment_history_limit\x18\x05 \x01(\x05H\x02R\x16deploymentHistoryLimit\x88\x01\x01\x12H\n" +
+	"\x06labels\x18\x06 \x03(\v20.workspace.v1.UpdateWorkspaceRequest.LabelsEntryR\x06labels\x12I\n" +
+	"\x1eblock_critical_vulnerabilities\x18\a \x01(\bH\x03R\x1cblockCriticalVulnerabilities\x88\x01\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\x1b\n" +
+	"\x19_deployment_history_limitB!\n" +
+	"\x1f_block_critical_vulnerabilities\"s\n" +
+	"\x17UpdateWorkspaceResponse\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x125\n" +
+	"\tworkspace\x18\x02 \x01(\v2\x17.workspace.v1.WorkspaceR\tworkspace\"k\n" +
+	"\x16DeleteWorkspaceRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12.\n" +
+	"\x13confirm_delete_apps\x18\x02 \x01(\bR\x11confirmDeleteApps\"\x19\n" +
+	"\x17DeleteWorkspaceResponse\"e\n" +
+	"\x13CreateMemberRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"R\n" +
+	"\x14CreateMemberResponse\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"Q\n" +
+	"\x13DeleteMemberRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x16\n" +
+	"\x14DeleteMemberResponse\"|\n" +
+	"\x1bListWorkspaceMembersRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x87\x01\n" +
+	"\x1cListWorkspaceMembersResponse\x12?\n" +
+	"\amembers\x18\x01 \x03(\v2%.workspace.v1.WorkspaceMemberWithUserR\amembers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\";\n" +
+	"\x16ExportWorkspaceRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"1\n" +
+	"\x17ExportWorkspaceResponse\x12\x16\n" +
+	"\x06bundle\x18\x01 \x01(\tR\x06bundle\"\xf5\x01\n" +
+	"\x16ImportWorkspaceRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x16\n" +
+	"\x06bundle\x18\x02 \x01(\tR\x06bundle\x12K\n" +
+	"\asecrets\x18\x03 \x03(\v21.workspace.v1.ImportWorkspaceRequest.SecretsEntryR\asecrets\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x1a:\n" +
+	"\fSecretsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"~\n" +
+	"\x10ImportedResource\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\venvironment\x18\x02 \x01(\tH\x00R\venvironment\x88\x01\x01\x12\x1f\n" +
+	"\vresource_id\x18\x03 \x01(\x03R\n" +
+	"resourceIdB\x0e\n" +
+	"\f_environment\"\xaa\x01\n" +
+	"\x17ImportWorkspaceResponse\x12\"\n" +
+	"\fenvironments\x18\x01 \x03(\tR\fenvironments\x12<\n" +
+	"\tresources\x18\x02 \x03(\v2\x1e.workspace.v1.ImportedResourceR\tresources\x12-\n" +
+	"\x12unresolved_secrets\x18\x03 \x03(\tR\x11unresolvedSecrets\"g\n" +
+	"\x17ArchiveWorkspaceRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x1d\n" +
+	"\amessage\x18\x02 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
+	"\n" +
+	"\b_message\"\x82\x01\n" +
+	"\x18ArchiveWorkspaceResponse\x125\n" +
+	"\tworkspace\x18\x01 \x01(\v2\x17.workspace.v1.WorkspaceR\tworkspace\x12/\n" +
+	"\x13suspended_resources\x18\x02 \x01(\x05R\x12suspendedResources\">\n" +
+	"\x19UnarchiveWorkspaceRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"\x80\x01\n" +
+	"\x1aUnarchiveWorkspaceResponse\x125\n" +
+	"\tworkspace\x18\x01 \x01(\v2\x17.workspace.v1.WorkspaceR\tworkspace\x12+\n" +
+	"\x11resumed_resources\x18\x02 \x01(\x05R\x10resumedResources\";\n" +
+	"\x16GetWorkspaceEnvRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"D\n" +
+	"\rStaleResource\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x94\x02\n" +
+	"\x17GetWorkspaceEnvResponse\x12@\n" +
+	"\x03env\x18\x01 \x03(\v2..workspace.v1.GetWorkspaceEnvResponse.EnvEntryR\x03env\x129\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12D\n" +
+	"\x0fstale_resources\x18\x03 \x03(\v2\x1b.workspace.v1.StaleResourceR\x0estaleResources\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xba\x01\n" +
+	"\x19UpdateWorkspaceEnvRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12B\n" +
+	"\x03env\x18\x02 \x03(\v20.workspace.v1.UpdateWorkspaceEnvRequest.EnvEntryR\x03env\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf9\x01\n" +
+	"\x1aUpdateWorkspaceEnvResponse\x12C\n" +
+	"\x03env\x18\x01 \x03(\v21.workspace.v1.UpdateWorkspaceEnvResponse.EnvEntryR\x03env\x12\x18\n" +
+	"\achanged\x18\x02 \x01(\bR\achanged\x12D\n" +
+	"\x0fstale_resources\x18\x03 \x03(\v2\x1b.workspace.v1.StaleResourceR\x0estaleResources\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"s\n" +
+	"\x19GetWorkspaceDigestRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x123\n" +
+	"\aweek_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06weekOf\"\xa7\x01\n" +
+	"\x0eDigestResource\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rreplica_hours\x18\x03 \x01(\x03R\freplicaHours\x12\x1f\n" +
+	"\vcost_micros\x18\x04 \x01(\x03R\n" +
+	"costMicros\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"\xf7\x02\n" +
+	"\x0fWorkspaceDigest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x129\n" +
+	"\n" +
+	"week_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tweekStart\x125\n" +
+	"\bweek_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aweekEnd\x12 \n" +
+	"\vdeployments\x18\x04 \x01(\x05R\vdeployments\x12-\n" +
+	"\x12failed_deployments\x18\x05 \x01(\x05R\x11failedDeployments\x12!\n" +
+	"\ffailure_rate\x18\x06 \x01(\x01R\vfailureRate\x12A\n" +
+	"\rtop_resources\x18\a \x03(\v2\x1c.workspace.v1.DigestResourceR\ftopResources\x12\x18\n" +
+	"\apartial\x18\b \x01(\bR\apartial\"S\n" +
+	"\x1aGetWorkspaceDigestResponse\x125\n" +
+	"\x06digest\x18\x01 \x01(\v2\x1d.workspace.v1.WorkspaceDigestR\x06digest2\xb3\f\n" +
 	"\x10WorkspaceService\x12^\n" +
 	"\x0fCreateWorkspace\x12$.workspace.v1.CreateWorkspaceRequest\x1a%.workspace.v1.CreateWorkspaceResponse\x12U\n" +
 	"\fGetWorkspace\x12!.workspace.v1.GetWorkspaceRequest\x1a\".workspace.v1.GetWorkspaceResponse\x12^\n" +
 	"\x0fUpdateWorkspace\x12$.workspace.v1.UpdateWorkspaceRequest\x1a%.workspace.v1.UpdateWorkspaceResponse\x12^\n" +
-	"\x0fDeleteWorkspace\x12$.workspace.v1.DeleteWorkspaceRequest\x1a%.workspace.v1.DeleteWorkspaceResponse\x12g\n" +
+	"\x0fDeleteWorkspace\x12$.workspace.v1.DeleteWorkspaceRequest\x1a%.workspace.v1.DeleteWorkspaceResponse\x12a\n" +
+	"\x10ArchiveWorkspace\x12%.workspace.v1.ArchiveWorkspaceRequest\x1a&.workspace.v1.ArchiveWorkspaceResponse\x12g\n" +
+	"\x12UnarchiveWorkspace\x12'.workspace.v1.UnarchiveWorkspaceRequest\x1a(.workspace.v1.UnarchiveWorkspaceResponse\x12^\n" +
+	"\x0fGetWorkspaceEnv\x12$.workspace.v1.GetWorkspaceEnvRequest\x1a%.workspace.v1.GetWorkspaceEnvResponse\x12g\n" +
+	"\x12UpdateWorkspaceEnv\x12'.workspace.v1.UpdateWorkspaceEnvRequest\x1a(.workspace.v1.UpdateWorkspaceEnvResponse\x12g\n" +
 	"\x12ListUserWorkspaces\x12'.workspace.v1.ListUserWorkspacesRequest\x1a(.workspace.v1.ListUserWorkspacesResponse\x12d\n" +
 	"\x11ListOrgWorkspaces\x12&.workspace.v1.ListOrgWorkspacesRequest\x1a'.workspace.v1.ListOrgWorkspacesResponse\x12U\n" +
 	"\fCreateMember\x12!.workspace.v1.CreateMemberRequest\x1a\".workspace.v1.CreateMemberResponse\x12U\n" +
 	"\fDeleteMember\x12!.workspace.v1.DeleteMemberRequest\x1a\".workspace.v1.DeleteMemberResponse\x12m\n" +
-	"\x14ListWorkspaceMembers\x12).workspace.v1.ListWorkspaceMembersRequest\x1a*.workspace.v1.ListWorkspaceMembersResponseBAZ?github.com/team-loco/loco/shared/proto/workspace/v1;workspacev1b\x06proto3"
+	"\x14ListWorkspaceMembers\x12).workspace.v1.ListWorkspaceMembersRequest\x1a*.workspace.v1.ListWorkspaceMembersResponse\x12^\n" +
+	"\x0fExportWorkspace\x12$.workspace.v1.ExportWorkspaceRequest\x1a%.workspace.v1.ExportWorkspaceResponse\x12^\n" +
+	"\x0fImportWorkspace\x12$.workspace.v1.ImportWorkspaceRequest\x1a%.workspace.v1.ImportWorkspaceResponse\x12g\n" +
+	"\x12GetWorkspaceDigest\x12'.workspace.v1.GetWorkspaceDigestRequest\x1a(.workspace.v1.GetWorkspaceDigestResponseBAZ?github.com/team-loco/loco/shared/proto/workspace/v1;workspacev1b\x06proto3"
 
 var (
 	file_workspace_v1_workspace_proto_rawDescOnce sync.Once
@@ -1343,7 +2620,7 @@ func file_workspace_v1_workspace_proto_rawDescGZIP() []byte {
 	return file_workspace_v1_workspace_proto_rawDescData
 }
 
-var file_workspace_v1_workspace_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_workspace_v1_workspace_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_workspace_v1_workspace_proto_goTypes = []any{
 	(*Workspace)(nil),                    // 0: workspace.v1.Workspace
 	(*WorkspaceMember)(nil),              // 1: workspace.v1.WorkspaceMember
@@ -1366,42 +2643,102 @@ var file_workspace_v1_workspace_proto_goTypes = []any{
 	(*DeleteMemberResponse)(nil),         // 18: workspace.v1.DeleteMemberResponse
 	(*ListWorkspaceMembersRequest)(nil),  // 19: workspace.v1.ListWorkspaceMembersRequest
 	(*ListWorkspaceMembersResponse)(nil), // 20: workspace.v1.ListWorkspaceMembersResponse
-	(*timestamppb.Timestamp)(nil),        // 21: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),        // 22: google.protobuf.FieldMask
+	(*ExportWorkspaceRequest)(nil),       // 21: workspace.v1.ExportWorkspaceRequest
+	(*ExportWorkspaceResponse)(nil),      // 22: workspace.v1.ExportWorkspaceResponse
+	(*ImportWorkspaceRequest)(nil),       // 23: workspace.v1.ImportWorkspaceRequest
+	(*ImportedResource)(nil),             // 24: workspace.v1.ImportedResource
+	(*ImportWorkspaceResponse)(nil),      // 25: workspace.v1.ImportWorkspaceResponse
+	(*ArchiveWorkspaceRequest)(nil),      // 26: workspace.v1.ArchiveWorkspaceRequest
+	(*ArchiveWorkspaceResponse)(nil),     // 27: workspace.v1.ArchiveWorkspaceResponse
+	(*UnarchiveWorkspaceRequest)(nil),    // 28: workspace.v1.UnarchiveWorkspaceRequest
+	(*UnarchiveWorkspaceResponse)(nil),   // 29: workspace.v1.UnarchiveWorkspaceResponse
+	(*GetWorkspaceEnvRequest)(nil),       // 30: workspace.v1.GetWorkspaceEnvRequest
+	(*StaleResource)(nil),                // 31: workspace.v1.StaleResource
+	(*GetWorkspaceEnvResponse)(nil),      // 32: workspace.v1.GetWorkspaceEnvResponse
+	(*UpdateWorkspaceEnvRequest)(nil),    // 33: workspace.v1.UpdateWorkspaceEnvRequest
+	(*UpdateWorkspaceEnvResponse)(nil),   // 34: workspace.v1.UpdateWorkspaceEnvResponse
+	(*GetWorkspaceDigestRequest)(nil),    // 35: workspace.v1.GetWorkspaceDigestRequest
+	(*DigestResource)(nil),               // 36: workspace.v1.DigestResource
+	(*WorkspaceDigest)(nil),              // 37: workspace.v1.WorkspaceDigest
+	(*GetWorkspaceDigestResponse)(nil),   // 38: workspace.v1.GetWorkspaceDigestResponse
+	nil,                                  // 39: workspace.v1.Workspace.LabelsEntry
+	nil,                                  // 40: workspace.v1.CreateWorkspaceRequest.LabelsEntry
+	nil,                                  // 41: workspace.v1.UpdateWorkspaceRequest.LabelsEntry
+	nil,                                  // 42: workspace.v1.ImportWorkspaceRequest.SecretsEntry
+	nil,                                  // 43: workspace.v1.GetWorkspaceEnvResponse.EnvEntry
+	nil,                                  // 44: workspace.v1.UpdateWorkspaceEnvRequest.EnvEntry
+	nil,                                  // 45: workspace.v1.UpdateWorkspaceEnvResponse.EnvEntry
+	(*timestamppb.Timestamp)(nil),        // 46: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),        // 47: google.protobuf.FieldMask
 }
 var file_workspace_v1_workspace_proto_depIdxs = []int32{
-	21, // 0: workspace.v1.Workspace.created_at:type_name -> google.protobuf.Timestamp
-	21, // 1: workspace.v1.Workspace.updated_at:type_name -> google.protobuf.Timestamp
-	21, // 2: workspace.v1.WorkspaceMember.created_at:type_name -> google.protobuf.Timestamp
-	21, // 3: workspace.v1.WorkspaceMemberWithUser.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: workspace.v1.GetWorkspaceResponse.workspace:type_name -> workspace.v1.Workspace
-	0,  // 5: workspace.v1.ListUserWorkspacesResponse.workspaces:type_name -> workspace.v1.Workspace
-	0,  // 6: workspace.v1.ListOrgWorkspacesResponse.workspaces:type_name -> workspace.v1.Workspace
-	22, // 7: workspace.v1.UpdateWorkspaceRequest.update_mask:type_name -> google.protobuf.FieldMask
-	2,  // 8: workspace.v1.ListWorkspaceMembersResponse.members:type_name -> workspace.v1.WorkspaceMemberWithUser
-	3,  // 9: workspace.v1.WorkspaceService.CreateWorkspace:input_type -> workspace.v1.CreateWorkspaceRequest
-	5,  // 10: workspace.v1.WorkspaceService.GetWorkspace:input_type -> workspace.v1.GetWorkspaceRequest
-	11, // 11: workspace.v1.WorkspaceService.UpdateWorkspace:input_type -> workspace.v1.UpdateWorkspaceRequest
-	13, // 12: workspace.v1.WorkspaceService.DeleteWorkspace:input_type -> workspace.v1.DeleteWorkspaceRequest
-	7,  // 13: workspace.v1.WorkspaceService.ListUserWorkspaces:input_type -> workspace.v1.ListUserWorkspacesRequest
-	9,  // 14: workspace.v1.WorkspaceService.ListOrgWorkspaces:input_type -> workspace.v1.ListOrgWorkspacesRequest
-	15, // 15: workspace.v1.WorkspaceService.CreateMember:input_type -> workspace.v1.CreateMemberRequest
-	17, // 16: workspace.v1.WorkspaceService.DeleteMember:input_type -> workspace.v1.DeleteMemberRequest
-	19, // 17: workspace.v1.WorkspaceService.ListWorkspaceMembers:input_type -> workspace.v1.ListWorkspaceMembersRequest
-	4,  // 18: workspace.v1.WorkspaceService.CreateWorkspace:output_type -> workspace.v1.CreateWorkspaceResponse
-	6,  // 19: workspace.v1.WorkspaceService.GetWorkspace:output_type -> workspace.v1.GetWorkspaceResponse
-	12, // 20: workspace.v1.WorkspaceService.UpdateWorkspace:output_type -> workspace.v1.UpdateWorkspaceResponse
-	14, // 21: workspace.v1.WorkspaceService.DeleteWorkspace:output_type -> workspace.v1.DeleteWorkspaceResponse
-	8,  // 22: workspace.v1.WorkspaceService.ListUserWorkspaces:output_type -> workspace.v1.ListUserWorkspacesResponse
-	10, // 23: workspace.v1.WorkspaceService.ListOrgWorkspaces:output_type -> workspace.v1.ListOrgWorkspacesResponse
-	16, // 24: workspace.v1.WorkspaceService.CreateMember:output_type -> workspace.v1.CreateMemberResponse
-	18, // 25: workspace.v1.WorkspaceService.DeleteMember:output_type -> workspace.v1.DeleteMemberResponse
-	20, // 26: workspace.v1.WorkspaceService.ListWorkspaceMembers:output_type -> workspace.v1.ListWorkspaceMembersResponse
-	18, // [18:27] is the sub-list for method output_type
-	9,  // [9:18] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	46, // 0: workspace.v1.Workspace.created_at:type_name -> google.protobuf.Timestamp
+	46, // 1: workspace.v1.Workspace.updated_at:type_name -> google.protobuf.Timestamp
+	39, // 2: workspace.v1.Workspace.labels:type_name -> workspace.v1.Workspace.LabelsEntry
+	46, // 3: workspace.v1.Workspace.archived_at:type_name -> google.protobuf.Timestamp
+	46, // 4: workspace.v1.WorkspaceMember.created_at:type_name -> google.protobuf.Timestamp
+	46, // 5: workspace.v1.WorkspaceMemberWithUser.created_at:type_name -> google.protobuf.Timestamp
+	40, // 6: workspace.v1.CreateWorkspaceRequest.labels:type_name -> workspace.v1.CreateWorkspaceRequest.LabelsEntry
+	0,  // 7: workspace.v1.CreateWorkspaceResponse.workspace:type_name -> workspace.v1.Workspace
+	0,  // 8: workspace.v1.GetWorkspaceResponse.workspace:type_name -> workspace.v1.Workspace
+	0,  // 9: workspace.v1.ListUserWorkspacesResponse.workspaces:type_name -> workspace.v1.Workspace
+	0,  // 10: workspace.v1.ListOrgWorkspacesResponse.workspaces:type_name -> workspace.v1.Workspace
+	47, // 11: workspace.v1.UpdateWorkspaceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	41, // 12: workspace.v1.UpdateWorkspaceRequest.labels:type_name -> workspace.v1.UpdateWorkspaceRequest.LabelsEntry
+	0,  // 13: workspace.v1.UpdateWorkspaceResponse.workspace:type_name -> workspace.v1.Workspace
+	2,  // 14: workspace.v1.ListWorkspaceMembersResponse.members:type_name -> workspace.v1.WorkspaceMemberWithUser
+	42, // 15: workspace.v1.ImportWorkspaceRequest.secrets:type_name -> workspace.v1.ImportWorkspaceRequest.SecretsEntry
+	24, // 16: workspace.v1.ImportWorkspaceResponse.resources:type_name -> workspace.v1.ImportedResource
+	0,  // 17: workspace.v1.ArchiveWorkspaceResponse.workspace:type_name -> workspace.v1.Workspace
+	0,  // 18: workspace.v1.UnarchiveWorkspaceResponse.workspace:type_name -> workspace.v1.Workspace
+	43, // 19: workspace.v1.GetWorkspaceEnvResponse.env:type_name -> workspace.v1.GetWorkspaceEnvResponse.EnvEntry
+	46, // 20: workspace.v1.GetWorkspaceEnvResponse.updated_at:type_name -> google.protobuf.Timestamp
+	31, // 21: workspace.v1.GetWorkspaceEnvResponse.stale_resources:type_name -> workspace.v1.StaleResource
+	44, // 22: workspace.v1.UpdateWorkspaceEnvRequest.env:type_name -> workspace.v1.UpdateWorkspaceEnvRequest.EnvEntry
+	45, // 23: workspace.v1.UpdateWorkspaceEnvResponse.env:type_name -> workspace.v1.UpdateWorkspaceEnvResponse.EnvEntry
+	31, // 24: workspace.v1.UpdateWorkspaceEnvResponse.stale_resources:type_name -> workspace.v1.StaleResource
+	46, // 25: workspace.v1.GetWorkspaceDigestRequest.week_of:type_name -> google.protobuf.Timestamp
+	46, // 26: workspace.v1.WorkspaceDigest.week_start:type_name -> google.protobuf.Timestamp
+	46, // 27: workspace.v1.WorkspaceDigest.week_end:type_name -> google.protobuf.Timestamp
+	36, // 28: workspace.v1.WorkspaceDigest.top_resources:type_name -> workspace.v1.DigestResource
+	37, // 29: workspace.v1.GetWorkspaceDigestResponse.digest:type_name -> workspace.v1.WorkspaceDigest
+	3,  // 30: workspace.v1.WorkspaceService.CreateWorkspace:input_type -> workspace.v1.CreateWorkspaceRequest
+	5,  // 31: workspace.v1.WorkspaceService.GetWorkspace:input_type -> workspace.v1.GetWorkspaceRequest
+	11, // 32: workspace.v1.WorkspaceService.UpdateWorkspace:input_type -> workspace.v1.UpdateWorkspaceRequest
+	13, // 33: workspace.v1.WorkspaceService.DeleteWorkspace:input_type -> workspace.v1.DeleteWorkspaceRequest
+	26, // 34: workspace.v1.WorkspaceService.ArchiveWorkspace:input_type -> workspace.v1.ArchiveWorkspaceRequest
+	28, // 35: workspace.v1.WorkspaceService.UnarchiveWorkspace:input_type -> workspace.v1.UnarchiveWorkspaceRequest
+	30, // 36: workspace.v1.WorkspaceService.GetWorkspaceEnv:input_type -> workspace.v1.GetWorkspaceEnvRequest
+	33, // 37: workspace.v1.WorkspaceService.UpdateWorkspaceEnv:input_type -> workspace.v1.UpdateWorkspaceEnvRequest
+	7,  // 38: workspace.v1.WorkspaceService.ListUserWorkspaces:input_type -> workspace.v1.ListUserWorkspacesRequest
+	9,  // 39: workspace.v1.WorkspaceService.ListOrgWorkspaces:input_type -> workspace.v1.ListOrgWorkspacesRequest
+	15, // 40: workspace.v1.WorkspaceService.CreateMember:input_type -> workspace.v1.CreateMemberRequest
+	17, // 41: workspace.v1.WorkspaceService.DeleteMember:input_type -> workspace.v1.DeleteMemberRequest
+	19, // 42: workspace.v1.WorkspaceService.ListWorkspaceMembers:input_type -> workspace.v1.ListWorkspaceMembersRequest
+	21, // 43: workspace.v1.WorkspaceService.ExportWorkspace:input_type -> workspace.v1.ExportWorkspaceRequest
+	23, // 44: workspace.v1.WorkspaceService.ImportWorkspace:input_type -> workspace.v1.ImportWorkspaceRequest
+	35, // 45: workspace.v1.WorkspaceService.GetWorkspaceDigest:input_type -> workspace.v1.GetWorkspaceDigestRequest
+	4,  // 46: workspace.v1.WorkspaceService.CreateWorkspace:output_type -> workspace.v1.CreateWorkspaceResponse
+	6,  // 47: workspace.v1.WorkspaceService.GetWorkspace:output_type -> workspace.v1.GetWorkspaceResponse
+	12, // 48: workspace.v1.WorkspaceService.UpdateWorkspace:output_type -> workspace.v1.UpdateWorkspaceResponse
+	14, // 49: workspace.v1.WorkspaceService.DeleteWorkspace:output_type -> workspace.v1.DeleteWorkspaceResponse
+	27, // 50: workspace.v1.WorkspaceService.ArchiveWorkspace:output_type -> workspace.v1.ArchiveWorkspaceResponse
+	29, // 51: workspace.v1.WorkspaceService.UnarchiveWorkspace:output_type -> workspace.v1.UnarchiveWorkspaceResponse
+	32, // 52: workspace.v1.WorkspaceService.GetWorkspaceEnv:output_type -> workspace.v1.GetWorkspaceEnvResponse
+	34, // 53: workspace.v1.WorkspaceService.UpdateWorkspaceEnv:output_type -> workspace.v1.UpdateWorkspaceEnvResponse
+	8,  // 54: workspace.v1.WorkspaceService.ListUserWorkspaces:output_type -> workspace.v1.ListUserWorkspacesResponse
+	10, // 55: workspace.v1.WorkspaceService.ListOrgWorkspaces:output_type -> workspace.v1.ListOrgWorkspacesResponse
+	16, // 56: workspace.v1.WorkspaceService.CreateMember:output_type -> workspace.v1.CreateMemberResponse
+	18, // 57: workspace.v1.WorkspaceService.DeleteMember:output_type -> workspace.v1.DeleteMemberResponse
+	20, // 58: workspace.v1.WorkspaceService.ListWorkspaceMembers:output_type -> workspace.v1.ListWorkspaceMembersResponse
+	22, // 59: workspace.v1.WorkspaceService.ExportWorkspace:output_type -> workspace.v1.ExportWorkspaceResponse
+	25, // 60: workspace.v1.WorkspaceService.ImportWorkspace:output_type -> workspace.v1.ImportWorkspaceResponse
+	38, // 61: workspace.v1.WorkspaceService.GetWorkspaceDigest:output_type -> workspace.v1.GetWorkspaceDigestResponse
+	46, // [46:62] is the sub-list for method output_type
+	30, // [30:46] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_workspace_v1_workspace_proto_init() }
@@ -1409,15 +2746,18 @@ func file_workspace_v1_workspace_proto_init() {
 	if File_workspace_v1_workspace_proto != nil {
 		return
 	}
+	file_workspace_v1_workspace_proto_msgTypes[0].OneofWrappers = []any{}
 	file_workspace_v1_workspace_proto_msgTypes[3].OneofWrappers = []any{}
 	file_workspace_v1_workspace_proto_msgTypes[11].OneofWrappers = []any{}
+	file_workspace_v1_workspace_proto_msgTypes[24].OneofWrappers = []any{}
+	file_workspace_v1_workspace_proto_msgTypes[26].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_workspace_v1_workspace_proto_rawDesc), len(file_workspace_v1_workspace_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -45,6 +45,18 @@ const (
 	// WorkspaceServiceDeleteWorkspaceProcedure is the fully-qualified name of the WorkspaceService's
 	// DeleteWorkspace RPC.
 	WorkspaceServiceDeleteWorkspaceProcedure = "/workspace.v1.WorkspaceService/DeleteWorkspace"
+	// WorkspaceServiceArchiveWorkspaceProcedure is the fully-qualified name of the WorkspaceService's
+	// ArchiveWorkspace RPC.
+	WorkspaceServiceArchiveWorkspaceProcedure = "/workspace.v1.WorkspaceService/ArchiveWorkspace"
+	// WorkspaceServiceUnarchiveWorkspaceProcedure is the fully-qualified name of the WorkspaceService's
+	// UnarchiveWorkspace RPC.
+	WorkspaceServiceUnarchiveWorkspaceProcedure = "/workspace.v1.WorkspaceService/UnarchiveWorkspace"
+	// WorkspaceServiceGetWorkspaceEnvProcedure is the fully-qualified name of the WorkspaceService's
+	// GetWorkspaceEnv RPC.
+	WorkspaceServiceGetWorkspaceEnvProcedure = "/workspace.v1.WorkspaceService/GetWorkspaceEnv"
+	// WorkspaceServiceUpdateWorkspaceEnvProcedure is the fully-qualified name of the WorkspaceService's
+	// UpdateWorkspaceEnv RPC.
+	WorkspaceServiceUpdateWorkspaceEnvProcedure = "/workspace.v1.WorkspaceService/UpdateWorkspaceEnv"
 	// WorkspaceServiceListUserWorkspacesProcedure is the fully-qualified name of the WorkspaceService's
 	// ListUserWorkspaces RPC.
 	WorkspaceServiceListUserWorkspacesProcedure = "/workspace.v1.WorkspaceService/ListUserWorkspaces"
@@ -60,6 +72,15 @@ const (
 	// WorkspaceServiceListWorkspaceMembersProcedure is the fully-qualified name of the
 	// WorkspaceService's ListWorkspaceMembers RPC.
 	WorkspaceServiceListWorkspaceMembersProcedure = "/workspace.v1.WorkspaceService/ListWorkspaceMembers"
+	// WorkspaceServiceExportWorkspaceProcedure is the fully-qualified name of the WorkspaceService's
+	// ExportWorkspace RPC.
+	WorkspaceServiceExportWorkspaceProcedure = "/workspace.v1.WorkspaceService/ExportWorkspace"
+	// WorkspaceServiceImportWorkspaceProcedure is the fully-qualified name of the WorkspaceService's
+	// ImportWorkspace RPC.
+	WorkspaceServiceImportWorkspaceProcedure = "/workspace.v1.WorkspaceService/ImportWorkspace"
+	// WorkspaceServiceGetWorkspaceDigestProcedure is the fully-qualified name of the WorkspaceService's
+	// GetWorkspaceDigest RPC.
+	WorkspaceServiceGetWorkspaceDigestProcedure = "/workspace.v1.WorkspaceService/GetWorkspaceDigest"
 )
 
 // WorkspaceServiceClient is a client for the workspace.v1.WorkspaceService service.
@@ -72,6 +93,17 @@ type WorkspaceServiceClient interface {
 	UpdateWorkspace(context.Context, *connect.Request[v1.UpdateWorkspaceRequest]) (*connect.Response[v1.UpdateWorkspaceResponse], error)
 	// DeleteWorkspace deletes a workspace and optionally its resources.
 	DeleteWorkspace(context.Context, *connect.Request[v1.DeleteWorkspaceRequest]) (*connect.Response[v1.DeleteWorkspaceResponse], error)
+	// ArchiveWorkspace hides a workspace from default listings, blocks new deployments and suspends its
+	// resources. Configuration, domains and deployment history are kept.
+	ArchiveWorkspace(context.Context, *connect.Request[v1.ArchiveWorkspaceRequest]) (*connect.Response[v1.ArchiveWorkspaceResponse], error)
+	// UnarchiveWorkspace reactivates an archived workspace, resuming the resources archiving suspended.
+	UnarchiveWorkspace(context.Context, *connect.Request[v1.UnarchiveWorkspaceRequest]) (*connect.Response[v1.UnarchiveWorkspaceResponse], error)
+	// GetWorkspaceEnv returns the env vars shared by every resource in a workspace.
+	GetWorkspaceEnv(context.Context, *connect.Request[v1.GetWorkspaceEnvRequest]) (*connect.Response[v1.GetWorkspaceEnvResponse], error)
+	// UpdateWorkspaceEnv replaces the env vars shared by every resource in a workspace. Each resource's own
+	// env vars win over them. They take effect on each resource's next deployment; the response lists the
+	// resources to redeploy.
+	UpdateWorkspaceEnv(context.Context, *connect.Request[v1.UpdateWorkspaceEnvRequest]) (*connect.Response[v1.UpdateWorkspaceEnvResponse], error)
 	// ListUserWorkspaces lists all workspaces for a user.
 	ListUserWorkspaces(context.Context, *connect.Request[v1.ListUserWorkspacesRequest]) (*connect.Response[v1.ListUserWorkspacesResponse], error)
 	// ListOrgWorkspaces lists all workspaces in an organization.
@@ -82,6 +114,15 @@ type WorkspaceServiceClient interface {
 	DeleteMember(context.Context, *connect.Request[v1.DeleteMemberRequest]) (*connect.Response[v1.DeleteMemberResponse], error)
 	// ListWorkspaceMembers lists all members of a workspace with pagination.
 	ListWorkspaceMembers(context.Context, *connect.Request[v1.ListWorkspaceMembersRequest]) (*connect.Response[v1.ListWorkspaceMembersResponse], error)
+	// ExportWorkspace produces a declarative YAML bundle of a workspace's environments, resources, domains,
+	// env vars and links. Env var values are never included; the bundle references them by name instead.
+	ExportWorkspace(context.Context, *connect.Request[v1.ExportWorkspaceRequest]) (*connect.Response[v1.ExportWorkspaceResponse], error)
+	// ImportWorkspace recreates the contents of a bundle in a workspace. Nothing is created if any part fails.
+	ImportWorkspace(context.Context, *connect.Request[v1.ImportWorkspaceRequest]) (*connect.Response[v1.ImportWorkspaceResponse], error)
+	// GetWorkspaceDigest returns the summary of a workspace's activity over a week: how many deployments
+	// it started, how many failed, and its busiest resources. Digests of finished weeks are compiled
+	// weekly; the current week is summarized so far.
+	GetWorkspaceDigest(context.Context, *connect.Request[v1.GetWorkspaceDigestRequest]) (*connect.Response[v1.GetWorkspaceDigestResponse], error)
 }
 
 // NewWorkspaceServiceClient constructs a client for the workspace.v1.WorkspaceService service. By
@@ -119,6 +160,30 @@ func NewWorkspaceServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(workspaceServiceMethods.ByName("DeleteWorkspace")),
 			connect.WithClientOptions(opts...),
 		),
+		archiveWorkspace: connect.NewClient[v1.ArchiveWorkspaceRequest, v1.ArchiveWorkspaceResponse](
+			httpClient,
+			baseURL+WorkspaceServiceArchiveWorkspaceProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("ArchiveWorkspace")),
+			connect.WithClientOptions(opts...),
+		),
+		unarchiveWorkspace: connect.NewClient[v1.UnarchiveWorkspaceRequest, v1.UnarchiveWorkspaceResponse](
+			httpClient,
+			baseURL+WorkspaceServiceUnarchiveWorkspaceProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("UnarchiveWorkspace")),
+			connect.WithClientOptions(opts...),
+		),
+		getWorkspaceEnv: connect.NewClient[v1.GetWorkspaceEnvRequest, v1.GetWorkspaceEnvResponse](
+			httpClient,
+			baseURL+WorkspaceServiceGetWorkspaceEnvProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("GetWorkspaceEnv")),
+			connect.WithClientOptions(opts...),
+		),
+		updateWorkspaceEnv: connect.NewClient[v1.UpdateWorkspaceEnvRequest, v1.UpdateWorkspaceEnvResponse](
+			httpClient,
+			baseURL+WorkspaceServiceUpdateWorkspaceEnvProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("UpdateWorkspaceEnv")),
+			connect.WithClientOptions(opts...),
+		),
 		listUserWorkspaces: connect.NewClient[v1.ListUserWorkspacesRequest, v1.ListUserWorkspacesResponse](
 			httpClient,
 			baseURL+WorkspaceServiceListUserWorkspacesProcedure,
@@ -149,6 +214,24 @@ func NewWorkspaceServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(workspaceServiceMethods.ByName("ListWorkspaceMembers")),
 			connect.WithClientOptions(opts...),
 		),
+		exportWorkspace: connect.NewClient[v1.ExportWorkspaceRequest, v1.ExportWorkspaceResponse](
+			httpClient,
+			baseURL+WorkspaceServiceExportWorkspaceProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("ExportWorkspace")),
+			connect.WithClientOptions(opts...),
+		),
+		importWorkspace: connect.NewClient[v1.ImportWorkspaceRequest, v1.ImportWorkspaceResponse](
+			httpClient,
+			baseURL+WorkspaceServiceImportWorkspaceProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("ImportWorkspace")),
+			connect.WithClientOptions(opts...),
+		),
+		getWorkspaceDigest: connect.NewClient[v1.GetWorkspaceDigestRequest, v1.GetWorkspaceDigestResponse](
+			httpClient,
+			baseURL+WorkspaceServiceGetWorkspaceDigestProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("GetWorkspaceDigest")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -158,11 +241,18 @@ type workspaceServiceClient struct {
 	getWorkspace         *connect.Client[v1.GetWorkspaceRequest, v1.GetWorkspaceResponse]
 	updateWorkspace      *connect.Client[v1.UpdateWorkspaceRequest, v1.UpdateWorkspaceResponse]
 	deleteWorkspace      *connect.Client[v1.DeleteWorkspaceRequest, v1.DeleteWorkspaceResponse]
+	archiveWorkspace     *connect.Client[v1.ArchiveWorkspaceRequest, v1.ArchiveWorkspaceResponse]
+	unarchiveWorkspace   *connect.Client[v1.UnarchiveWorkspaceRequest, v1.UnarchiveWorkspaceResponse]
+	getWorkspaceEnv      *connect.Client[v1.GetWorkspaceEnvRequest, v1.GetWorkspaceEnvResponse]
+	updateWorkspaceEnv   *connect.Client[v1.UpdateWorkspaceEnvRequest, v1.UpdateWorkspaceEnvResponse]
 	listUserWorkspaces   *connect.Client[v1.ListUserWorkspacesRequest, v1.ListUserWorkspacesResponse]
 	listOrgWorkspaces    *connect.Client[v1.ListOrgWorkspacesRequest, v1.ListOrgWorkspacesResponse]
 	createMember         *connect.Client[v1.CreateMemberRequest, v1.CreateMemberResponse]
 	deleteMember         *connect.Client[v1.DeleteMemberRequest, v1.DeleteMemberResponse]
 	listWorkspaceMembers *connect.Client[v1.ListWorkspaceMembersRequest, v1.ListWorkspaceMembersResponse]
+	exportWorkspace      *connect.Client[v1.ExportWorkspaceRequest, v1.ExportWorkspaceResponse]
+	importWorkspace      *connect.Client[v1.ImportWorkspaceRequest, v1.ImportWorkspaceResponse]
+	getWorkspaceDigest   *connect.Client[v1.GetWorkspaceDigestRequest, v1.GetWorkspaceDigestResponse]
 }
 
 // CreateWorkspace calls workspace.v1.WorkspaceService.CreateWorkspace.
@@ -185,6 +275,26 @@ func (c *workspaceServiceClient) DeleteWorkspace(ctx context.Context, req *conne
 	return c.deleteWorkspace.CallUnary(ctx, req)
 }
 
+// ArchiveWorkspace calls workspace.v1.WorkspaceService.ArchiveWorkspace.
+func (c *workspaceServiceClient) ArchiveWorkspace(ctx context.Context, req *connect.Request[v1.ArchiveWorkspaceRequest]) (*connect.Response[v1.ArchiveWorkspaceResponse], error) {
+	return c.archiveWorkspace.CallUnary(ctx, req)
+}
+
+// UnarchiveWorkspace calls workspace.v1.WorkspaceService.UnarchiveWorkspace.
+func (c *workspaceServiceClient) UnarchiveWorkspace(ctx context.Context, req *connect.Request[v1.UnarchiveWorkspaceRequest]) (*connect.Response[v1.UnarchiveWorkspaceResponse], error) {
+	return c.unarchiveWorkspace.CallUnary(ctx, req)
+}
+
+// GetWorkspaceEnv calls workspace.v1.WorkspaceService.GetWorkspaceEnv.
+func (c *workspaceServiceClient) GetWorkspaceEnv(ctx context.Context, req *connect.Request[v1.GetWorkspaceEnvRequest]) (*connect.Response[v1.GetWorkspaceEnvResponse], error) {
+	return c.getWorkspaceEnv.CallUnary(ctx, req)
+}
+
+// UpdateWorkspaceEnv calls workspace.v1.WorkspaceService.UpdateWorkspaceEnv.
+func (c *workspaceServiceClient) UpdateWorkspaceEnv(ctx context.Context, req *connect.Request[v1.UpdateWorkspaceEnvRequest]) (*connect.Response[v1.UpdateWorkspaceEnvResponse], error) {
+	return c.updateWorkspaceEnv.CallUnary(ctx, req)
+}
+
 // ListUserWorkspaces calls workspace.v1.WorkspaceService.ListUserWorkspaces.
 func (c *workspaceServiceClient) ListUserWorkspaces(ctx context.Context, req *connect.Request[v1.ListUserWorkspacesRequest]) (*connect.Response[v1.ListUserWorkspacesResponse], error) {
 	return c.listUserWorkspaces.CallUnary(ctx, req)
@@ -210,6 +320,21 @@ func (c *workspaceServiceClient) ListWorkspaceMembers(ctx context.Context, req *
 	return c.listWorkspaceMembers.CallUnary(ctx, req)
 }
 
+// ExportWorkspace calls workspace.v1.WorkspaceService.ExportWorkspace.
+func (c *workspaceServiceClient) ExportWorkspace(ctx context.Context, req *connect.Request[v1.ExportWorkspaceRequest]) (*connect.Response[v1.ExportWorkspaceResponse], error) {
+	return c.exportWorkspace.CallUnary(ctx, req)
+}
+
+// ImportWorkspace calls workspace.v1.WorkspaceService.ImportWorkspace.
+func (c *workspaceServiceClient) ImportWorkspace(ctx context.Context, req *connect.Request[v1.ImportWorkspaceRequest]) (*connect.Response[v1.ImportWorkspaceResponse], error) {
+	return c.importWorkspace.CallUnary(ctx, req)
+}
+
+// GetWorkspaceDigest calls workspace.v1.WorkspaceService.GetWorkspaceDigest.
+func (c *workspaceServiceClient) GetWorkspaceDigest(ctx context.Context, req *connect.Request[v1.GetWorkspaceDigestRequest]) (*connect.Response[v1.GetWorkspaceDigestResponse], error) {
+	return c.getWorkspaceDigest.CallUnary(ctx, req)
+}
+
 // WorkspaceServiceHandler is an implementation of the workspace.v1.WorkspaceService service.
 type WorkspaceServiceHandler interface {
 	// CreateWorkspace creates a new workspace.
@@ -220,6 +345,17 @@ type WorkspaceServiceHandler interface {
 	UpdateWorkspace(context.Context, *connect.Request[v1.UpdateWorkspaceRequest]) (*connect.Response[v1.UpdateWorkspaceResponse], error)
 	// DeleteWorkspace deletes a workspace and optionally its resources.
 	DeleteWorkspace(context.Context, *connect.Request[v1.DeleteWorkspaceRequest]) (*connect.Response[v1.DeleteWorkspaceResponse], error)
+	// ArchiveWorkspace hides a workspace from default listings, blocks new deployments and suspends its
+	// resources. Configuration, domains and deployment history are kept.
+	ArchiveWorkspace(context.Context, *connect.Request[v1.ArchiveWorkspaceRequest]) (*connect.Response[v1.ArchiveWorkspaceResponse], error)
+	// UnarchiveWorkspace reactivates an archived workspace, resuming the resources archiving suspended.
+	UnarchiveWorkspace(context.Context, *connect.Request[v1.UnarchiveWorkspaceRequest]) (*connect.Response[v1.UnarchiveWorkspaceResponse], error)
+	// GetWorkspaceEnv returns the env vars shared by every resource in a workspace.
+	GetWorkspaceEnv(context.Context, *connect.Request[v1.GetWorkspaceEnvRequest]) (*connect.Response[v1.GetWorkspaceEnvResponse], error)
+	// UpdateWorkspaceEnv replaces the env vars shared by every resource in a workspace. Each resource's own
+	// env vars win over them. They take effect on each resource's next deployment; the response lists the
+	// resources to redeploy.
+	UpdateWorkspaceEnv(context.Context, *connect.Request[v1.UpdateWorkspaceEnvRequest]) (*connect.Response[v1.UpdateWorkspaceEnvResponse], error)
 	// ListUserWorkspaces lists all workspaces for a user.
 	ListUserWorkspaces(context.Context, *connect.Request[v1.ListUserWorkspacesRequest]) (*connect.Response[v1.ListUserWorkspacesResponse], error)
 	// ListOrgWorkspaces lists all workspaces in an organization.
@@ -230,6 +366,15 @@ type WorkspaceServiceHandler interface {
 	DeleteMember(context.Context, *connect.Request[v1.DeleteMemberRequest]) (*connect.Response[v1.DeleteMemberResponse], error)
 	// ListWorkspaceMembers lists all members of a workspace with pagination.
 	ListWorkspaceMembers(context.Context, *connect.Request[v1.ListWorkspaceMembersRequest]) (*connect.Response[v1.ListWorkspaceMembersResponse], error)
+	// ExportWorkspace produces a declarative YAML bundle of a workspace's environments, resources, domains,
+	// env vars and links. Env var values are never included; the bundle references them by name instead.
+	ExportWorkspace(context.Context, *connect.Request[v1.ExportWorkspaceRequest]) (*connect.Response[v1.ExportWorkspaceResponse], error)
+	// ImportWorkspace recreates the contents of a bundle in a workspace. Nothing is created if any part fails.
+	ImportWorkspace(context.Context, *connect.Request[v1.ImportWorkspaceRequest]) (*connect.Response[v1.ImportWorkspaceResponse], error)
+	// GetWorkspaceDigest returns the summary of a workspace's activity over a week: how many deployments
+	// it started, how many failed, and its busiest resources. Digests of finished weeks are compiled
+	// weekly; the current week is summarized so far.
+	GetWorkspaceDigest(context.Context, *connect.Request[v1.GetWorkspaceDigestRequest]) (*connect.Response[v1.GetWorkspaceDigestResponse], error)
 }
 
 // NewWorkspaceServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -263,6 +408,30 @@ func NewWorkspaceServiceHandler(svc WorkspaceServiceHandler, opts ...connect.Han
 		connect.WithSchema(workspaceServiceMethods.ByName("DeleteWorkspace")),
 		connect.WithHandlerOptions(opts...),
 	)
+	workspaceServiceArchiveWorkspaceHandler := connect.NewUnaryHandler(
+		WorkspaceServiceArchiveWorkspaceProcedure,
+		svc.ArchiveWorkspace,
+		connect.WithSchema(workspaceServiceMethods.ByName("ArchiveWorkspace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceUnarchiveWorkspaceHandler := connect.NewUnaryHandler(
+		WorkspaceServiceUnarchiveWorkspaceProcedure,
+		svc.UnarchiveWorkspace,
+		connect.WithSchema(workspaceServiceMethods.ByName("UnarchiveWorkspace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceGetWorkspaceEnvHandler := connect.NewUnaryHandler(
+		WorkspaceServiceGetWorkspaceEnvProcedure,
+		svc.GetWorkspaceEnv,
+		connect.WithSchema(workspaceServiceMethods.ByName("GetWorkspaceEnv")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceUpdateWorkspaceEnvHandler := connect.NewUnaryHandler(
+		WorkspaceServiceUpdateWorkspaceEnvProcedure,
+		svc.UpdateWorkspaceEnv,
+		connect.WithSchema(workspaceServiceMethods.ByName("UpdateWorkspaceEnv")),
+		connect.WithHandlerOptions(opts...),
+	)
 	workspaceServiceListUserWorkspacesHandler := connect.NewUnaryHandler(
 		WorkspaceServiceListUserWorkspacesProcedure,
 		svc.ListUserWorkspaces,
@@ -293,6 +462,24 @@ func NewWorkspaceServiceHandler(svc WorkspaceServiceHandler, opts ...connect.Han
 		connect.WithSchema(workspaceServiceMethods.ByName("ListWorkspaceMembers")),
 		connect.WithHandlerOptions(opts...),
 	)
+	workspaceServiceExportWorkspaceHandler := connect.NewUnaryHandler(
+		WorkspaceServiceExportWorkspaceProcedure,
+		svc.ExportWorkspace,
+		connect.WithSchema(workspaceServiceMethods.ByName("ExportWorkspace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceImportWorkspaceHandler := connect.NewUnaryHandler(
+		WorkspaceServiceImportWorkspaceProcedure,
+		svc.ImportWorkspace,
+		connect.WithSchema(workspaceServiceMethods.ByName("ImportWorkspace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workspaceServiceGetWorkspaceDigestHandler := connect.NewUnaryHandler(
+		WorkspaceServiceGetWorkspaceDigestProcedure,
+		svc.GetWorkspaceDigest,
+		connect.WithSchema(workspaceServiceMethods.ByName("GetWorkspaceDigest")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/workspace.v1.WorkspaceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case WorkspaceServiceCreateWorkspaceProcedure:
@@ -303,6 +490,14 @@ func NewWorkspaceServiceHandler(svc WorkspaceServiceHandler, opts ...connect.Han
 			workspaceServiceUpdateWorkspaceHandler.ServeHTTP(w, r)
 		case WorkspaceServiceDeleteWorkspaceProcedure:
 			workspaceServiceDeleteWorkspaceHandler.ServeHTTP(w, r)
+		case WorkspaceServiceArchiveWorkspaceProcedure:
+			workspaceServiceArchiveWorkspaceHandler.ServeHTTP(w, r)
+		case WorkspaceServiceUnarchiveWorkspaceProcedure:
+			workspaceServiceUnarchiveWorkspaceHandler.ServeHTTP(w, r)
+		case WorkspaceServiceGetWorkspaceEnvProcedure:
+			workspaceServiceGetWorkspaceEnvHandler.ServeHTTP(w, r)
+		case WorkspaceServiceUpdateWorkspaceEnvProcedure:
+			workspaceServiceUpdateWorkspaceEnvHandler.ServeHTTP(w, r)
 		case WorkspaceServiceListUserWorkspacesProcedure:
 			workspaceServiceListUserWorkspacesHandler.ServeHTTP(w, r)
 		case WorkspaceServiceListOrgWorkspacesProcedure:
@@ -313,6 +508,12 @@ func NewWorkspaceServiceHandler(svc WorkspaceServiceHandler, opts ...connect.Han
 			workspaceServiceDeleteMemberHandler.ServeHTTP(w, r)
 		case WorkspaceServiceListWorkspaceMembersProcedure:
 			workspaceServiceListWorkspaceMembersHandler.ServeHTTP(w, r)
+		case WorkspaceServiceExportWorkspaceProcedure:
+			workspaceServiceExportWorkspaceHandler.ServeHTTP(w, r)
+		case WorkspaceServiceImportWorkspaceProcedure:
+			workspaceServiceImportWorkspaceHandler.ServeHTTP(w, r)
+		case WorkspaceServiceGetWorkspaceDigestProcedure:
+			workspaceServiceGetWorkspaceDigestHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -338,6 +539,22 @@ func (UnimplementedWorkspaceServiceHandler) DeleteWorkspace(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.DeleteWorkspace is not implemented"))
 }
 
+func (UnimplementedWorkspaceServiceHandler) ArchiveWorkspace(context.Context, *connect.Request[v1.ArchiveWorkspaceRequest]) (*connect.Response[v1.ArchiveWorkspaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.ArchiveWorkspace is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) UnarchiveWorkspace(context.Context, *connect.Request[v1.UnarchiveWorkspaceRequest]) (*connect.Response[v1.UnarchiveWorkspaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.UnarchiveWorkspace is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) GetWorkspaceEnv(context.Context, *connect.Request[v1.GetWorkspaceEnvRequest]) (*connect.Response[v1.GetWorkspaceEnvResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.GetWorkspaceEnv is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) UpdateWorkspaceEnv(context.Context, *connect.Request[v1.UpdateWorkspaceEnvRequest]) (*connect.Response[v1.UpdateWorkspaceEnvResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.UpdateWorkspaceEnv is not implemented"))
+}
+
 func (UnimplementedWorkspaceServiceHandler) ListUserWorkspaces(context.Context, *connect.Request[v1.ListUserWorkspacesRequest]) (*connect.Response[v1.ListUserWorkspacesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.ListUserWorkspaces is not implemented"))
 }
@@ -357,3 +574,15 @@ func (UnimplementedWorkspaceServiceHandler) DeleteMember(context.Context, *conne
 func (UnimplementedWorkspaceServiceHandler) ListWorkspaceMembers(context.Context, *connect.Request[v1.ListWorkspaceMembersRequest]) (*connect.Response[v1.ListWorkspaceMembersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.ListWorkspaceMembers is not implemented"))
 }
+
+func (UnimplementedWorkspaceServiceHandler) ExportWorkspace(context.Context, *connect.Request[v1.ExportWorkspaceRequest]) (*connect.Response[v1.ExportWorkspaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.ExportWorkspace is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) ImportWorkspace(context.Context, *connect.Request[v1.ImportWorkspaceRequest]) (*connect.Response[v1.ImportWorkspaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.ImportWorkspace is not implemented"))
+}
+
+func (UnimplementedWorkspaceServiceHandler) GetWorkspaceDigest(context.Context, *connect.Request[v1.GetWorkspaceDigestRequest]) (*connect.Response[v1.GetWorkspaceDigestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("workspace.v1.WorkspaceService.GetWorkspaceDigest is not implemented"))
+}